@@ -153,6 +153,7 @@ func (Dev) InitStack() error {
 		"--no-secret",
 		"--redirect-uri", "local-callback",
 		"--redirect-uri", "code",
+		"--grants", "authorization_code,refresh_token,device_code",
 	); err != nil {
 		return err
 	}
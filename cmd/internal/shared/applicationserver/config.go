@@ -22,6 +22,7 @@ import (
 	"go.thethings.network/lorawan-stack/pkg/applicationserver"
 	"go.thethings.network/lorawan-stack/pkg/applicationserver/io/web"
 	"go.thethings.network/lorawan-stack/pkg/config"
+	"go.thethings.network/lorawan-stack/pkg/messageprocessors/wasm"
 )
 
 // DefaultApplicationServerConfig is the default configuration for the Application Server.
@@ -40,4 +41,11 @@ var DefaultApplicationServerConfig = applicationserver.Config{
 		Workers:   16,
 		Downlinks: web.DownlinksConfig{PublicAddress: shared.DefaultPublicURL + "/api/v3"},
 	},
+	Formatters: applicationserver.FormattersConfig{
+		WASM: wasm.DefaultOptions,
+	},
+	LocationSolvers: applicationserver.LocationSolversConfig{
+		QueueSize: 16,
+		Workers:   16,
+	},
 }
@@ -27,6 +27,7 @@ import (
 // DefaultGatewayServerConfig is the default configuration for the GatewayServer.
 var DefaultGatewayServerConfig = gatewayserver.Config{
 	RequireRegisteredGateways: false,
+	NotifyDisconnectedAfter:   6 * time.Hour,
 	Forward: map[string][]string{
 		"": {"00000000/0"},
 	},
@@ -62,4 +62,7 @@ func init() {
 	DefaultIdentityServerConfig.ProfilePicture.UseGravatar = true
 	DefaultIdentityServerConfig.EndDevicePicture.Bucket = "end_device_pictures"
 	DefaultIdentityServerConfig.EndDevicePicture.BucketURL = path.Join(shared.DefaultAssetsBaseURL, "blob", "end_device_pictures")
+	DefaultIdentityServerConfig.Purge.Interval = time.Hour
+	DefaultIdentityServerConfig.APIKeys.LastUsedInterval = time.Minute
+	DefaultIdentityServerConfig.Notifications.Email = []string{"api_key_changed", "api_key_created", "approval_requested", "collaborator_changed", "gateway_disconnected"}
 }
@@ -19,6 +19,7 @@ import (
 	"os"
 	"strings"
 
+	"github.com/gogo/protobuf/types"
 	"github.com/spf13/cobra"
 	"go.thethings.network/lorawan-stack/cmd/ttn-lw-cli/internal/api"
 	"go.thethings.network/lorawan-stack/cmd/ttn-lw-cli/internal/io"
@@ -204,7 +205,22 @@ var (
 				return errNoAPIKeyRights
 			}
 
-			res, err := createApplicationAPIKey(ctx, *appID, name, rights...)
+			var options ttnpb.APIKey
+			if _, err := getAPIKeyOptions(cmd.Flags(), &options); err != nil {
+				return err
+			}
+
+			is, err := api.Dial(ctx, config.IdentityServerGRPCAddress)
+			if err != nil {
+				return err
+			}
+			res, err := ttnpb.NewApplicationAccessClient(is).CreateAPIKey(ctx, &ttnpb.CreateApplicationAPIKeyRequest{
+				ApplicationIdentifiers: *appID,
+				Name:                   name,
+				Rights:                 rights,
+				ExpiresAt:              options.ExpiresAt,
+				Restrictions:           options.Restrictions,
+			})
 			if err != nil {
 				return err
 			}
@@ -237,17 +253,24 @@ var (
 				return errNoAPIKeyRights
 			}
 
+			apiKey := ttnpb.APIKey{
+				ID:     id,
+				Name:   name,
+				Rights: rights,
+			}
+			paths, err := getAPIKeyOptions(cmd.Flags(), &apiKey)
+			if err != nil {
+				return err
+			}
+
 			is, err := api.Dial(ctx, config.IdentityServerGRPCAddress)
 			if err != nil {
 				return err
 			}
 			_, err = ttnpb.NewApplicationAccessClient(is).UpdateAPIKey(ctx, &ttnpb.UpdateApplicationAPIKeyRequest{
 				ApplicationIdentifiers: *appID,
-				APIKey: ttnpb.APIKey{
-					ID:     id,
-					Name:   name,
-					Rights: rights,
-				},
+				APIKey:                 apiKey,
+				FieldMask:              types.FieldMask{Paths: append([]string{"name", "rights"}, paths...)},
 			})
 			if err != nil {
 				return err
@@ -312,10 +335,12 @@ func init() {
 	applicationAPIKeys.AddCommand(applicationAPIKeysList)
 	applicationAPIKeysCreate.Flags().String("name", "", "")
 	applicationAPIKeysCreate.Flags().AddFlagSet(applicationRightsFlags)
+	applicationAPIKeysCreate.Flags().AddFlagSet(applicationAPIKeyOptionsFlags())
 	applicationAPIKeys.AddCommand(applicationAPIKeysCreate)
 	applicationAPIKeysUpdate.Flags().String("api-key-id", "", "")
 	applicationAPIKeysUpdate.Flags().String("name", "", "")
 	applicationAPIKeysUpdate.Flags().AddFlagSet(applicationRightsFlags)
+	applicationAPIKeysUpdate.Flags().AddFlagSet(applicationAPIKeyOptionsFlags())
 	applicationAPIKeys.AddCommand(applicationAPIKeysUpdate)
 	applicationAPIKeysDelete.Flags().String("api-key-id", "", "")
 	applicationAPIKeys.AddCommand(applicationAPIKeysDelete)
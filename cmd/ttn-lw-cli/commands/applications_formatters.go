@@ -0,0 +1,100 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"os"
+
+	"github.com/spf13/cobra"
+	"go.thethings.network/lorawan-stack/cmd/ttn-lw-cli/internal/api"
+	"go.thethings.network/lorawan-stack/cmd/ttn-lw-cli/internal/io"
+	"go.thethings.network/lorawan-stack/cmd/ttn-lw-cli/internal/util"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+)
+
+type testUplinkFormatterParams struct {
+	VersionIDs         ttnpb.EndDeviceVersionIdentifiers `protobuf:"bytes,1,opt,name=version_ids,json=versionIds,proto3" json:"version_ids"`
+	Formatter          ttnpb.PayloadFormatter            `protobuf:"varint,2,opt,name=formatter,proto3,enum=ttn.lorawan.v3.PayloadFormatter" json:"formatter,omitempty"`
+	FormatterParameter string                            `protobuf:"bytes,3,opt,name=formatter_parameter,json=formatterParameter,proto3" json:"formatter_parameter,omitempty"`
+	FPort              uint32                            `protobuf:"varint,4,opt,name=f_port,json=fPort,proto3" json:"f_port,omitempty"`
+	FRMPayload         []byte                            `protobuf:"bytes,5,opt,name=frm_payload,json=frmPayload,proto3" json:"frm_payload,omitempty"`
+}
+
+var testUplinkFormatterFlags = util.FieldFlags(&testUplinkFormatterParams{})
+
+var (
+	applicationsFormattersCommand = &cobra.Command{
+		Use:     "formatters",
+		Aliases: []string{"formatter"},
+		Short:   "Application payload formatter commands",
+	}
+	applicationsFormattersTestCommand = &cobra.Command{
+		Use:   "test [application-id]",
+		Short: "Test an uplink payload formatter",
+		Long: `Test an uplink payload formatter
+
+The formatter is run by the Application Server on the given FRMPayload and
+FPort. The result contains the decoded payload, the warnings and errors
+returned by the formatter and the time it took to run the formatter.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			appID := getApplicationID(cmd.Flags(), args)
+			if appID == nil {
+				return errNoApplicationID
+			}
+
+			var params testUplinkFormatterParams
+			if err := util.SetFields(&params, testUplinkFormatterFlags); err != nil {
+				return err
+			}
+			if localFile, _ := cmd.Flags().GetString("formatter-parameter-local-file"); localFile != "" {
+				parameter, err := getDataBytes("formatter-parameter", cmd.Flags())
+				if err != nil {
+					return err
+				}
+				params.FormatterParameter = string(parameter)
+			}
+
+			req := &ttnpb.TestUplinkFormatterRequest{
+				ApplicationIdentifiers: *appID,
+				Formatter:              params.Formatter,
+				FormatterParameter:     params.FormatterParameter,
+				FPort:                  params.FPort,
+				FRMPayload:             params.FRMPayload,
+			}
+			if params.VersionIDs != (ttnpb.EndDeviceVersionIdentifiers{}) {
+				req.VersionIDs = &params.VersionIDs
+			}
+
+			as, err := api.Dial(ctx, config.ApplicationServerGRPCAddress)
+			if err != nil {
+				return err
+			}
+			res, err := ttnpb.NewAsClient(as).TestUplinkFormatter(ctx, req)
+			if err != nil {
+				return err
+			}
+
+			return io.Write(os.Stdout, config.OutputFormat, res)
+		},
+	}
+)
+
+func init() {
+	applicationsFormattersTestCommand.Flags().AddFlagSet(applicationIDFlags())
+	applicationsFormattersTestCommand.Flags().AddFlagSet(testUplinkFormatterFlags)
+	applicationsFormattersTestCommand.Flags().AddFlagSet(dataFlags("formatter-parameter", "formatter parameter"))
+	applicationsFormattersCommand.AddCommand(applicationsFormattersTestCommand)
+	applicationsCommand.AddCommand(applicationsFormattersCommand)
+}
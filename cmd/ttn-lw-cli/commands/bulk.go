@@ -18,8 +18,14 @@ import (
 	"io"
 
 	"github.com/spf13/cobra"
+	"go.thethings.network/lorawan-stack/pkg/errors"
 )
 
+// bulkBatchSize is the maximum number of entities that asBatchedBulk handles in a single batch.
+const bulkBatchSize = 100
+
+var errBulkFailed = errors.DefineAborted("bulk_failed", "{failed} of {total} operations failed")
+
 // asBulk enables some commands to do bulk operations.
 // If there is a non-nil input decoder, asBulk keeps executing the same command
 // until it returns an error (the input decoder returns io.EOF when it's done).
@@ -40,3 +46,47 @@ func asBulk(runE func(cmd *cobra.Command, args []string) error) func(cmd *cobra.
 		}
 	}
 }
+
+// asBatchedBulk enables some commands to do bulk operations in batches.
+// If there is a non-nil input decoder, asBatchedBulk keeps executing prepare
+// until it returns an error (the input decoder returns io.EOF when it's done),
+// and passes the prepared entities to flush in batches of at most bulkBatchSize.
+// The last batch is flushed before the error is returned.
+// Entities for which prepare returns nil are skipped. The flush function returns
+// the number of entities of the batch that failed, so that a batch can partially
+// fail without stopping the bulk operation.
+// If the input decoder is nil, the command is executed only once.
+func asBatchedBulk(
+	runE func(cmd *cobra.Command, args []string) error,
+	prepare func(cmd *cobra.Command, args []string) (interface{}, error),
+	flush func(cmd *cobra.Command, batch []interface{}) (failed int),
+) func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		if inputDecoder == nil {
+			return runE(cmd, args)
+		}
+		var total, failed int
+		batch := make([]interface{}, 0, bulkBatchSize)
+		for {
+			entity, err := prepare(cmd, args)
+			if err == nil && entity != nil {
+				batch = append(batch, entity)
+			}
+			if len(batch) == bulkBatchSize || err != nil && len(batch) > 0 {
+				total += len(batch)
+				failed += flush(cmd, batch)
+				batch = batch[:0]
+			}
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				return err
+			}
+		}
+		if failed > 0 {
+			return errBulkFailed.WithAttributes("failed", failed, "total", total)
+		}
+		return nil
+	}
+}
@@ -46,6 +46,7 @@ type Config struct {
 	DeviceTemplateConverterGRPCAddress string `name:"device-template-converter-grpc-address" description:"Device Template Converter address"`
 	DeviceClaimingServerGRPCAddress    string `name:"device-claiming-server-grpc-address" description:"Device Claiming Server address"`
 	QRCodeGeneratorGRPCAddress         string `name:"qr-code-generator-grpc-address" description:"QR Code Generator address"`
+	DeviceRepositoryGRPCAddress        string `name:"device-repository-grpc-address" description:"Device Repository address"`
 	Insecure                           bool   `name:"insecure" description:"Connect without TLS"`
 	CA                                 string `name:"ca" description:"CA certificate file"`
 }
@@ -93,6 +94,7 @@ var DefaultConfig = Config{
 	DeviceTemplateConverterGRPCAddress: clusterGRPCAddress,
 	DeviceClaimingServerGRPCAddress:    clusterGRPCAddress,
 	QRCodeGeneratorGRPCAddress:         clusterGRPCAddress,
+	DeviceRepositoryGRPCAddress:        clusterGRPCAddress,
 }
 
 var configCommand = commands.Config(mgr)
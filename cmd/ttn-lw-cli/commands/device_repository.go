@@ -0,0 +1,255 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"os"
+
+	"github.com/spf13/cobra"
+	"go.thethings.network/lorawan-stack/cmd/ttn-lw-cli/internal/api"
+	"go.thethings.network/lorawan-stack/cmd/ttn-lw-cli/internal/io"
+	"go.thethings.network/lorawan-stack/cmd/ttn-lw-cli/internal/util"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+)
+
+type listEndDeviceModelsParams struct {
+	BrandID        string           `protobuf:"bytes,1,opt,name=brand_id,json=brandId,proto3" json:"brand_id,omitempty"`
+	Search         string           `protobuf:"bytes,2,opt,name=search,proto3" json:"search,omitempty"`
+	LoRaWANVersion ttnpb.MACVersion `protobuf:"varint,3,opt,name=lorawan_version,json=lorawanVersion,proto3,enum=ttn.lorawan.v3.MACVersion" json:"lorawan_version,omitempty"`
+	BandID         string           `protobuf:"bytes,4,opt,name=band_id,json=bandId,proto3" json:"band_id,omitempty"`
+	ProfileID      string           `protobuf:"bytes,5,opt,name=profile_id,json=profileId,proto3" json:"profile_id,omitempty"`
+}
+
+type getEndDeviceTemplateParams struct {
+	VersionIDs ttnpb.EndDeviceVersionIdentifiers `protobuf:"bytes,1,opt,name=version_ids,json=versionIds,proto3" json:"version_ids"`
+	BandID     string                            `protobuf:"bytes,2,opt,name=band_id,json=bandId,proto3" json:"band_id,omitempty"`
+}
+
+var (
+	listEndDeviceModelsFlags  = util.FieldFlags(&listEndDeviceModelsParams{})
+	getEndDeviceTemplateFlags = util.FieldFlags(&getEndDeviceTemplateParams{})
+
+	errNoBrandID = errors.DefineInvalidArgument("no_brand_id", "no brand ID set")
+	errNoModelID = errors.DefineInvalidArgument("no_model_id", "no model ID set")
+	errNoBandID  = errors.DefineInvalidArgument("no_band_id", "no band ID set")
+)
+
+func getBrandAndModelID(cmd *cobra.Command, args []string) (brandID, modelID string, err error) {
+	switch len(args) {
+	case 0:
+	case 1:
+		brandID = args[0]
+	case 2:
+		brandID, modelID = args[0], args[1]
+	default:
+		logger.Warn("Multiple IDs found in arguments, considering the first")
+		brandID, modelID = args[0], args[1]
+	}
+	if brandID == "" {
+		brandID, _ = cmd.Flags().GetString("brand-id")
+	}
+	if modelID == "" {
+		modelID, _ = cmd.Flags().GetString("model-id")
+	}
+	if brandID == "" {
+		return "", "", errNoBrandID
+	}
+	if modelID == "" {
+		return "", "", errNoModelID
+	}
+	return brandID, modelID, nil
+}
+
+var (
+	deviceRepositoryCommand = &cobra.Command{
+		Use:     "device-repository",
+		Aliases: []string{"dr"},
+		Short:   "Device Repository commands",
+	}
+	deviceRepositoryBrandsCommand = &cobra.Command{
+		Use:   "brands",
+		Short: "List end device brands",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			search, _ := cmd.Flags().GetString("search")
+
+			dr, err := api.Dial(ctx, config.DeviceRepositoryGRPCAddress)
+			if err != nil {
+				return err
+			}
+			limit, page, opt, getTotal := withPagination(cmd.Flags())
+			res, err := ttnpb.NewDeviceRepositoryClient(dr).ListBrands(ctx, &ttnpb.ListEndDeviceBrandsRequest{
+				Search: search,
+				Limit:  limit,
+				Page:   page,
+			}, opt)
+			if err != nil {
+				return err
+			}
+			getTotal()
+
+			return io.Write(os.Stdout, config.OutputFormat, res.Brands)
+		},
+	}
+	deviceRepositoryModelsCommand = &cobra.Command{
+		Use:   "models",
+		Short: "List end device models",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var params listEndDeviceModelsParams
+			if err := util.SetFields(&params, listEndDeviceModelsFlags); err != nil {
+				return err
+			}
+
+			dr, err := api.Dial(ctx, config.DeviceRepositoryGRPCAddress)
+			if err != nil {
+				return err
+			}
+			limit, page, opt, getTotal := withPagination(cmd.Flags())
+			res, err := ttnpb.NewDeviceRepositoryClient(dr).ListModels(ctx, &ttnpb.ListEndDeviceModelsRequest{
+				BrandID:        params.BrandID,
+				Search:         params.Search,
+				LoRaWANVersion: params.LoRaWANVersion,
+				BandID:         params.BandID,
+				ProfileID:      params.ProfileID,
+				Limit:          limit,
+				Page:           page,
+			}, opt)
+			if err != nil {
+				return err
+			}
+			getTotal()
+
+			return io.Write(os.Stdout, config.OutputFormat, res.Models)
+		},
+	}
+	deviceRepositoryVersionsCommand = &cobra.Command{
+		Use:   "versions [brand-id] [model-id]",
+		Short: "List the versions of an end device model",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			brandID, modelID, err := getBrandAndModelID(cmd, args)
+			if err != nil {
+				return err
+			}
+			bandID, _ := cmd.Flags().GetString("band-id")
+
+			dr, err := api.Dial(ctx, config.DeviceRepositoryGRPCAddress)
+			if err != nil {
+				return err
+			}
+			res, err := ttnpb.NewDeviceRepositoryClient(dr).ListVersions(ctx, &ttnpb.ListEndDeviceVersionsRequest{
+				BrandID: brandID,
+				ModelID: modelID,
+				BandID:  bandID,
+			})
+			if err != nil {
+				return err
+			}
+
+			return io.Write(os.Stdout, config.OutputFormat, res.Versions)
+		},
+	}
+	deviceRepositoryTemplateCommand = &cobra.Command{
+		Use:   "template",
+		Short: "Get an end device template",
+		Long: `Get an end device template
+
+The template contains the LoRaWAN versions, MAC settings, capabilities and
+payload formatters of the end device version for the given band. If the
+hardware or firmware version are not set, the latest version is used.
+
+The template can be used with the end-devices templates commands, for
+example to create end devices with the end-devices templates execute
+command.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var params getEndDeviceTemplateParams
+			if err := util.SetFields(&params, getEndDeviceTemplateFlags); err != nil {
+				return err
+			}
+			if params.VersionIDs.BrandID == "" {
+				return errNoBrandID
+			}
+			if params.VersionIDs.ModelID == "" {
+				return errNoModelID
+			}
+			if params.BandID == "" {
+				return errNoBandID
+			}
+
+			dr, err := api.Dial(ctx, config.DeviceRepositoryGRPCAddress)
+			if err != nil {
+				return err
+			}
+			res, err := ttnpb.NewDeviceRepositoryClient(dr).GetTemplate(ctx, &ttnpb.GetEndDeviceTemplateRequest{
+				VersionIDs: params.VersionIDs,
+				BandID:     params.BandID,
+			})
+			if err != nil {
+				return err
+			}
+
+			return io.Write(os.Stdout, config.OutputFormat, res)
+		},
+	}
+)
+
+// applyDeviceRepositoryTemplate sets the fields of the end device template of the version of the device for the
+// given band, and returns the paths that are set. Fields that are in paths, and whether the device supports join,
+// take precedence over the template.
+func applyDeviceRepositoryTemplate(device *ttnpb.EndDevice, bandID string, paths []string) ([]string, error) {
+	if device.VersionIDs == nil || device.VersionIDs.BrandID == "" {
+		return nil, errNoBrandID
+	}
+	if device.VersionIDs.ModelID == "" {
+		return nil, errNoModelID
+	}
+	dr, err := api.Dial(ctx, config.DeviceRepositoryGRPCAddress)
+	if err != nil {
+		return nil, err
+	}
+	template, err := ttnpb.NewDeviceRepositoryClient(dr).GetTemplate(ctx, &ttnpb.GetEndDeviceTemplateRequest{
+		VersionIDs: *device.VersionIDs,
+		BandID:     bandID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	var templatePaths []string
+	for _, path := range template.FieldMask.Paths {
+		if path == "supports_join" || ttnpb.HasAnyField(paths, path) || ttnpb.HasAnyField([]string{path}, paths...) {
+			continue
+		}
+		templatePaths = append(templatePaths, path)
+	}
+	if err := device.SetFields(&template.EndDevice, templatePaths...); err != nil {
+		return nil, err
+	}
+	return templatePaths, nil
+}
+
+func init() {
+	deviceRepositoryBrandsCommand.Flags().String("search", "", "only return brands with the given text in the ID or name")
+	deviceRepositoryBrandsCommand.Flags().AddFlagSet(paginationFlags())
+	deviceRepositoryCommand.AddCommand(deviceRepositoryBrandsCommand)
+	deviceRepositoryModelsCommand.Flags().AddFlagSet(listEndDeviceModelsFlags)
+	deviceRepositoryModelsCommand.Flags().AddFlagSet(paginationFlags())
+	deviceRepositoryCommand.AddCommand(deviceRepositoryModelsCommand)
+	deviceRepositoryVersionsCommand.Flags().String("brand-id", "", "")
+	deviceRepositoryVersionsCommand.Flags().String("model-id", "", "")
+	deviceRepositoryVersionsCommand.Flags().String("band-id", "", "apply the end device profile of the given band")
+	deviceRepositoryCommand.AddCommand(deviceRepositoryVersionsCommand)
+	deviceRepositoryTemplateCommand.Flags().AddFlagSet(getEndDeviceTemplateFlags)
+	deviceRepositoryCommand.AddCommand(deviceRepositoryTemplateCommand)
+	Root.AddCommand(deviceRepositoryCommand)
+}
@@ -0,0 +1,211 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"os"
+	"strings"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"go.thethings.network/lorawan-stack/cmd/ttn-lw-cli/internal/api"
+	"go.thethings.network/lorawan-stack/cmd/ttn-lw-cli/internal/io"
+	"go.thethings.network/lorawan-stack/cmd/ttn-lw-cli/internal/util"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+)
+
+var (
+	selectEndDeviceProfileFlags = util.FieldMaskFlags(&ttnpb.EndDeviceProfile{})
+	setEndDeviceProfileFlags    = util.FieldFlags(&ttnpb.EndDeviceProfile{})
+)
+
+func endDeviceProfileIDFlags() *pflag.FlagSet {
+	flagSet := &pflag.FlagSet{}
+	flagSet.String("application-id", "", "application of the profile (global profile if not set)")
+	flagSet.String("profile-id", "", "")
+	return flagSet
+}
+
+var errNoProfileID = errors.DefineInvalidArgument("no_profile_id", "no profile ID set")
+
+func getEndDeviceProfileApplicationID(flagSet *pflag.FlagSet) *ttnpb.ApplicationIdentifiers {
+	applicationID, _ := flagSet.GetString("application-id")
+	if applicationID == "" {
+		return nil
+	}
+	return &ttnpb.ApplicationIdentifiers{ApplicationID: applicationID}
+}
+
+func getEndDeviceProfileID(flagSet *pflag.FlagSet, args []string) (*ttnpb.EndDeviceProfileIdentifiers, error) {
+	profileID, _ := flagSet.GetString("profile-id")
+	switch len(args) {
+	case 0:
+	case 1:
+		profileID = args[0]
+	default:
+		logger.Warn("Multiple IDs found in arguments, considering the first")
+		profileID = args[0]
+	}
+	if profileID == "" {
+		return nil, errNoProfileID
+	}
+	return &ttnpb.EndDeviceProfileIdentifiers{
+		ApplicationIDs: getEndDeviceProfileApplicationID(flagSet),
+		ProfileID:      profileID,
+	}, nil
+}
+
+func getEndDeviceProfileSelectPaths(flagSet *pflag.FlagSet) []string {
+	paths := util.SelectFieldMask(flagSet, selectEndDeviceProfileFlags)
+	if len(paths) == 0 {
+		logger.Warn("No fields selected, will select everything")
+		selectEndDeviceProfileFlags.VisitAll(func(flag *pflag.Flag) {
+			paths = append(paths, strings.Replace(flag.Name, "-", "_", -1))
+		})
+	}
+	return paths
+}
+
+var (
+	endDeviceProfilesCommand = &cobra.Command{
+		Use:     "profiles",
+		Aliases: []string{"profile"},
+		Short:   "End device profile commands",
+		Long: `End device profile commands
+
+End device profiles are stored in the Network Server. Profiles of an
+application can be used by the end devices of the application. Global profiles
+are managed by administrators and can be used by all end devices.`,
+	}
+	endDeviceProfilesGetCommand = &cobra.Command{
+		Use:     "get [profile-id]",
+		Aliases: []string{"info"},
+		Short:   "Get the properties of an end device profile",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			profileID, err := getEndDeviceProfileID(cmd.Flags(), args)
+			if err != nil {
+				return err
+			}
+			ns, err := api.Dial(ctx, config.NetworkServerGRPCAddress)
+			if err != nil {
+				return err
+			}
+			res, err := ttnpb.NewNsEndDeviceProfileRegistryClient(ns).Get(ctx, &ttnpb.GetEndDeviceProfileRequest{
+				EndDeviceProfileIdentifiers: *profileID,
+				FieldMask:                   types.FieldMask{Paths: getEndDeviceProfileSelectPaths(cmd.Flags())},
+			})
+			if err != nil {
+				return err
+			}
+
+			return io.Write(os.Stdout, config.OutputFormat, res)
+		},
+	}
+	endDeviceProfilesListCommand = &cobra.Command{
+		Use:     "list",
+		Aliases: []string{"ls"},
+		Short:   "List end device profiles",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			limit, page, opt, getTotal := withPagination(cmd.Flags())
+			ns, err := api.Dial(ctx, config.NetworkServerGRPCAddress)
+			if err != nil {
+				return err
+			}
+			res, err := ttnpb.NewNsEndDeviceProfileRegistryClient(ns).List(ctx, &ttnpb.ListEndDeviceProfilesRequest{
+				ApplicationIDs: getEndDeviceProfileApplicationID(cmd.Flags()),
+				FieldMask:      types.FieldMask{Paths: getEndDeviceProfileSelectPaths(cmd.Flags())},
+				Limit:          limit,
+				Page:           page,
+			}, opt)
+			if err != nil {
+				return err
+			}
+			getTotal()
+
+			return io.Write(os.Stdout, config.OutputFormat, res.Profiles)
+		},
+	}
+	endDeviceProfilesSetCommand = &cobra.Command{
+		Use:     "set [profile-id]",
+		Aliases: []string{"create", "update"},
+		Short:   "Set the properties of an end device profile",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			profileID, err := getEndDeviceProfileID(cmd.Flags(), args)
+			if err != nil {
+				return err
+			}
+			paths := util.UpdateFieldMask(cmd.Flags(), setEndDeviceProfileFlags)
+
+			var profile ttnpb.EndDeviceProfile
+			if err = util.SetFields(&profile, setEndDeviceProfileFlags); err != nil {
+				return err
+			}
+			profile.EndDeviceProfileIdentifiers = *profileID
+
+			ns, err := api.Dial(ctx, config.NetworkServerGRPCAddress)
+			if err != nil {
+				return err
+			}
+			res, err := ttnpb.NewNsEndDeviceProfileRegistryClient(ns).Set(ctx, &ttnpb.SetEndDeviceProfileRequest{
+				Profile:   profile,
+				FieldMask: types.FieldMask{Paths: paths},
+			})
+			if err != nil {
+				return err
+			}
+
+			return io.Write(os.Stdout, config.OutputFormat, res)
+		},
+	}
+	endDeviceProfilesDeleteCommand = &cobra.Command{
+		Use:   "delete [profile-id]",
+		Short: "Delete an end device profile",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			profileID, err := getEndDeviceProfileID(cmd.Flags(), args)
+			if err != nil {
+				return err
+			}
+
+			ns, err := api.Dial(ctx, config.NetworkServerGRPCAddress)
+			if err != nil {
+				return err
+			}
+			_, err = ttnpb.NewNsEndDeviceProfileRegistryClient(ns).Delete(ctx, profileID)
+			if err != nil {
+				return err
+			}
+
+			return nil
+		},
+	}
+)
+
+func init() {
+	endDeviceProfilesGetCommand.Flags().AddFlagSet(endDeviceProfileIDFlags())
+	endDeviceProfilesGetCommand.Flags().AddFlagSet(selectEndDeviceProfileFlags)
+	endDeviceProfilesCommand.AddCommand(endDeviceProfilesGetCommand)
+	endDeviceProfilesListCommand.Flags().String("application-id", "", "application of the profiles (global profiles if not set)")
+	endDeviceProfilesListCommand.Flags().AddFlagSet(selectEndDeviceProfileFlags)
+	endDeviceProfilesListCommand.Flags().AddFlagSet(paginationFlags())
+	endDeviceProfilesCommand.AddCommand(endDeviceProfilesListCommand)
+	endDeviceProfilesSetCommand.Flags().AddFlagSet(endDeviceProfileIDFlags())
+	endDeviceProfilesSetCommand.Flags().AddFlagSet(setEndDeviceProfileFlags)
+	endDeviceProfilesCommand.AddCommand(endDeviceProfilesSetCommand)
+	endDeviceProfilesDeleteCommand.Flags().AddFlagSet(endDeviceProfileIDFlags())
+	endDeviceProfilesCommand.AddCommand(endDeviceProfilesDeleteCommand)
+	endDevicesCommand.AddCommand(endDeviceProfilesCommand)
+}
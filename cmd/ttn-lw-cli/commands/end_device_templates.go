@@ -308,10 +308,19 @@ This command takes end device templates from stdin.`,
 			if err != nil {
 				return err
 			}
-			stream, err := ttnpb.NewEndDeviceTemplateConverterClient(dtc).Convert(ctx, &ttnpb.ConvertEndDeviceTemplateRequest{
+			req := &ttnpb.ConvertEndDeviceTemplateRequest{
 				FormatID: formatID,
 				Data:     data,
-			})
+			}
+			if cmd.Flags().Changed("separator") || cmd.Flags().Changed("base64") {
+				separator, _ := cmd.Flags().GetString("separator")
+				useBase64, _ := cmd.Flags().GetBool("base64")
+				req.CSVOptions = &ttnpb.ConvertEndDeviceTemplateRequest_CSVOptions{
+					Separator: separator,
+					Base64:    useBase64,
+				}
+			}
+			stream, err := ttnpb.NewEndDeviceTemplateConverterClient(dtc).Convert(ctx, req)
 			if err != nil {
 				return err
 			}
@@ -450,6 +459,8 @@ func init() {
 	endDeviceTemplatesCommand.AddCommand(endDeviceTemplatesListFormats)
 	endDeviceTemplatesFromDataCommand.Flags().AddFlagSet(templateFormatIDFlags())
 	endDeviceTemplatesFromDataCommand.Flags().AddFlagSet(dataFlags("", ""))
+	endDeviceTemplatesFromDataCommand.Flags().String("separator", ",", "separator of CSV columns")
+	endDeviceTemplatesFromDataCommand.Flags().Bool("base64", false, "decode EUIs, addresses and keys in CSV as base64 instead of hex")
 	endDeviceTemplatesCommand.AddCommand(endDeviceTemplatesFromDataCommand)
 	endDeviceTemplatesMapCommand.Flags().AddFlagSet(dataFlags("input", "input file"))
 	endDeviceTemplatesMapCommand.Flags().AddFlagSet(dataFlags("mapping", "mapping file"))
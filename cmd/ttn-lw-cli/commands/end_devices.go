@@ -31,6 +31,7 @@ import (
 	"go.thethings.network/lorawan-stack/cmd/ttn-lw-cli/internal/api"
 	"go.thethings.network/lorawan-stack/cmd/ttn-lw-cli/internal/io"
 	"go.thethings.network/lorawan-stack/cmd/ttn-lw-cli/internal/util"
+	"go.thethings.network/lorawan-stack/pkg/devicetemplates"
 	"go.thethings.network/lorawan-stack/pkg/errors"
 	"go.thethings.network/lorawan-stack/pkg/log"
 	"go.thethings.network/lorawan-stack/pkg/random"
@@ -84,6 +85,7 @@ func forwardDeprecatedDeviceFlags(flagSet *pflag.FlagSet) {
 
 var (
 	errEndDeviceEUIUpdate           = errors.DefineInvalidArgument("end_device_eui_update", "end device EUIs can not be updated")
+	errEndDeviceExportFormat        = errors.DefineInvalidArgument("end_device_export_format", "unsupported end device export format `{format}`")
 	errEndDeviceKeysWithProvisioner = errors.DefineInvalidArgument("end_device_keys_provisioner", "end device ABP or OTAA keys cannot be set when there is a provisioner")
 	errInconsistentEndDeviceEUI     = errors.DefineInvalidArgument("inconsistent_end_device_eui", "given end device EUIs do not match registered EUIs")
 	errInvalidDataRateIndex         = errors.DefineInvalidArgument("data_rate_index", "Data rate index is invalid")
@@ -331,170 +333,128 @@ var (
 			return io.Write(os.Stdout, config.OutputFormat, device)
 		},
 	}
-	endDevicesCreateCommand = &cobra.Command{
-		Use:     "create [application-id] [device-id]",
-		Aliases: []string{"add", "register"},
-		Short:   "Create an end device",
-		RunE: asBulk(func(cmd *cobra.Command, args []string) (err error) {
+	endDevicesExportCommand = &cobra.Command{
+		Use:   "export [application-id]",
+		Short: "Export end devices",
+		Long: `Export end devices
+
+The end devices are exported with the selected fields. In the CSV format, each
+selected field is a column. Attributes are selected with --attribute-columns.
+The CSV output can be converted to end device templates with the csv format of
+the end device template converter, using the same --separator and --base64
+flags with end-device-templates from-data.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
 			forwardDeprecatedDeviceFlags(cmd.Flags())
 
-			devID, err := getEndDeviceID(cmd.Flags(), args, false)
-			if err != nil {
-				return err
+			appID := getApplicationID(cmd.Flags(), args)
+			if appID == nil {
+				return errNoApplicationID
 			}
-			paths := util.UpdateFieldMask(cmd.Flags(), setEndDeviceFlags, attributesFlags())
+			paths := util.SelectFieldMask(cmd.Flags(), selectEndDeviceFlags)
 
-			var device ttnpb.EndDevice
-			if inputDecoder != nil {
-				decodedPaths, err := inputDecoder.Decode(&device)
+			var write func(*ttnpb.EndDevice) error
+			flush := func() error { return nil }
+			switch format, _ := cmd.Flags().GetString("format"); format {
+			case "json":
+				write = func(device *ttnpb.EndDevice) error {
+					return io.Write(os.Stdout, config.OutputFormat, device)
+				}
+			case "csv":
+				columns, err := devicetemplates.CSVColumns(append([]string{"ids.device_id", "ids.join_eui", "ids.dev_eui"}, paths...)...)
 				if err != nil {
 					return err
 				}
-				paths = append(paths, ttnpb.FlattenPaths(decodedPaths, endDeviceFlattenPaths)...)
-			}
-
-			setDefaults, _ := cmd.Flags().GetBool("defaults")
-			if setDefaults {
-				if config.NetworkServerEnabled {
-					device.NetworkServerAddress = getHost(config.NetworkServerGRPCAddress)
-					paths = append(paths, "network_server_address")
+				attributes, _ := cmd.Flags().GetStringSlice("attribute-columns")
+				for _, key := range attributes {
+					columns = append(columns, "attributes."+key)
 				}
-				if config.ApplicationServerEnabled {
-					device.ApplicationServerAddress = getHost(config.ApplicationServerGRPCAddress)
-					paths = append(paths, "application_server_address")
+				if len(attributes) > 0 {
+					paths = append(paths, "attributes")
 				}
-			}
-
-			if picture, err := cmd.Flags().GetString("picture"); err == nil && picture != "" {
-				device.Picture, err = readPicture(picture)
+				separator, _ := cmd.Flags().GetString("separator")
+				useBase64, _ := cmd.Flags().GetBool("base64")
+				w, err := devicetemplates.NewCSVWriter(os.Stdout, devicetemplates.CSVConfig{
+					Separator: separator,
+					Base64:    useBase64,
+				}, columns)
 				if err != nil {
 					return err
 				}
+				write, flush = w.Write, w.Flush
+			default:
+				return errEndDeviceExportFormat.WithAttributes("format", format)
 			}
 
-			abp, _ := cmd.Flags().GetBool("abp")
-			multicast, _ := cmd.Flags().GetBool("multicast")
-			if abp || multicast {
-				device.SupportsJoin = false
-				if config.NetworkServerEnabled {
-					paths = append(paths, "supports_join")
+			isPaths, nsPaths, asPaths, jsPaths := splitEndDeviceGetPaths(paths...)
+			if len(nsPaths) > 0 {
+				isPaths = append(isPaths, "network_server_address")
+			}
+			if len(asPaths) > 0 {
+				isPaths = append(isPaths, "application_server_address")
+			}
+			if len(jsPaths) > 0 {
+				isPaths = append(isPaths, "join_server_address")
+			}
+
+			is, err := api.Dial(ctx, config.IdentityServerGRPCAddress)
+			if err != nil {
+				return err
+			}
+			const limit = 100
+			for page := uint32(1); ; page++ {
+				res, err := ttnpb.NewEndDeviceRegistryClient(is).List(ctx, &ttnpb.ListEndDevicesRequest{
+					ApplicationIdentifiers: *appID,
+					FieldMask:              pbtypes.FieldMask{Paths: isPaths},
+					Limit:                  limit,
+					Page:                   page,
+					Order:                  "device_id",
+				})
+				if err != nil {
+					return err
 				}
-				if withSession, _ := cmd.Flags().GetBool("with-session"); withSession {
-					if device.ProvisionerID != "" {
-						return errEndDeviceKeysWithProvisioner
+				for _, device := range res.EndDevices {
+					devNSPaths, devASPaths, devJSPaths := nsPaths, asPaths, jsPaths
+					if device.JoinServerAddress == "" {
+						devJSPaths = nil
 					}
-					// TODO: Generate DevAddr in cluster NetID (https://github.com/TheThingsNetwork/lorawan-stack/issues/47).
-					devAddr, err := generateDevAddr(types.NetID{})
-					if err != nil {
-						return err
+					nsMismatch, asMismatch, jsMismatch := compareServerAddressesEndDevice(device, config)
+					if nsMismatch {
+						devNSPaths = nil
 					}
-					device.DevAddr = &devAddr
-					device.Session = &ttnpb.Session{
-						DevAddr: devAddr,
-						SessionKeys: ttnpb.SessionKeys{
-							SessionKeyID: generateBytes(16),
-							FNwkSIntKey:  &ttnpb.KeyEnvelope{Key: generateKey()},
-							AppSKey:      &ttnpb.KeyEnvelope{Key: generateKey()},
-						},
+					if asMismatch {
+						devASPaths = nil
 					}
-					paths = append(paths,
-						"session.keys.session_key_id",
-						"session.keys.f_nwk_s_int_key.key",
-						"session.keys.app_s_key.key",
-						"session.dev_addr",
-					)
-					var macVersion ttnpb.MACVersion
-					s, err := setEndDeviceFlags.GetString("lorawan_version")
-					if err != nil {
-						return err
-					}
-					if err := macVersion.UnmarshalText([]byte(s)); err != nil {
-						return err
-					}
-					if err := macVersion.Validate(); err != nil {
-						return errInvalidMACVerson
-					}
-					if macVersion.Compare(ttnpb.MAC_V1_1) >= 0 {
-						device.Session.SessionKeys.SNwkSIntKey = &ttnpb.KeyEnvelope{Key: generateKey()}
-						device.Session.SessionKeys.NwkSEncKey = &ttnpb.KeyEnvelope{Key: generateKey()}
-						paths = append(paths,
-							"session.keys.s_nwk_s_int_key.key",
-							"session.keys.nwk_s_enc_key.key",
-						)
-					}
-				}
-			} else {
-				device.SupportsJoin = true
-				if config.NetworkServerEnabled {
-					paths = append(paths, "supports_join")
-				}
-				if setDefaults {
-					if config.JoinServerEnabled {
-						device.JoinServerAddress = getHost(config.JoinServerGRPCAddress)
-						paths = append(paths,
-							"join_server_address",
-						)
+					if jsMismatch {
+						devJSPaths = nil
 					}
-				}
-				if withKeys, _ := cmd.Flags().GetBool("with-root-keys"); withKeys {
-					if device.ProvisionerID != "" {
-						return errEndDeviceKeysWithProvisioner
+					if len(devNSPaths)+len(devASPaths)+len(devJSPaths) > 0 {
+						res, err := getEndDevice(device.EndDeviceIdentifiers, devNSPaths, devASPaths, devJSPaths, true)
+						if err != nil {
+							return err
+						}
+						device.SetFields(res, "ids.dev_addr")
+						device.SetFields(res, append(append(devNSPaths, devASPaths...), devJSPaths...)...)
 					}
-					// TODO: Set JoinEUI and DevEUI (https://github.com/TheThingsNetwork/lorawan-stack/issues/47).
-					device.RootKeys = &ttnpb.RootKeys{
-						RootKeyID: "ttn-lw-cli-generated",
-						AppKey:    &ttnpb.KeyEnvelope{Key: generateKey()},
-						NwkKey:    &ttnpb.KeyEnvelope{Key: generateKey()},
+					if err := write(device); err != nil {
+						return err
 					}
-					paths = append(paths,
-						"root_keys.root_key_id",
-						"root_keys.app_key.key",
-						"root_keys.nwk_key.key",
-					)
 				}
-			}
-			if withClaimAuthenticationCode, _ := cmd.Flags().GetBool("with-claim-authentication-code"); withClaimAuthenticationCode {
-				device.ClaimAuthenticationCode = &ttnpb.EndDeviceAuthenticationCode{
-					Value: strings.ToUpper(hex.EncodeToString(random.Bytes(4))),
+				if len(res.EndDevices) < limit {
+					return flush()
 				}
-				paths = append(paths, "claim_authentication_code")
 			}
-
-			if err = util.SetFields(&device, setEndDeviceFlags); err != nil {
+		},
+	}
+	endDevicesCreateCommand = &cobra.Command{
+		Use:     "create [application-id] [device-id]",
+		Aliases: []string{"add", "register"},
+		Short:   "Create an end device",
+		RunE: asBatchedBulk(func(cmd *cobra.Command, args []string) error {
+			prepared, err := prepareEndDeviceCreate(cmd, args)
+			if err != nil {
 				return err
 			}
-
-			device.Attributes = mergeAttributes(device.Attributes, cmd.Flags())
-			if devID != nil {
-				if devID.DeviceID != "" {
-					device.DeviceID = devID.DeviceID
-				}
-				if devID.ApplicationID != "" {
-					device.ApplicationID = devID.ApplicationID
-				}
-				if device.SupportsJoin {
-					if devID.JoinEUI != nil {
-						device.JoinEUI = devID.JoinEUI
-					}
-					if devID.DevEUI != nil {
-						device.DevEUI = devID.DevEUI
-					}
-				}
-			}
-
-			if device.ApplicationID == "" {
-				return errNoApplicationID
-			}
-			if device.DeviceID == "" {
-				return errNoEndDeviceID
-			}
-
-			isPaths, nsPaths, asPaths, jsPaths := splitEndDeviceSetPaths(device.SupportsJoin, paths...)
-
-			// Require EUIs for devices that need to be added to the Join Server.
-			if len(jsPaths) > 0 && (device.JoinEUI == nil || device.DevEUI == nil) {
-				return errNoEndDeviceEUI
-			}
+			device := prepared.device
 
 			is, err := api.Dial(ctx, config.IdentityServerGRPCAddress)
 			if err != nil {
@@ -507,9 +467,9 @@ var (
 				return err
 			}
 
-			device.SetFields(isRes, append(isPaths, "created_at", "updated_at")...)
+			device.SetFields(isRes, append(prepared.isPaths, "created_at", "updated_at")...)
 
-			res, err := setEndDevice(&device, nil, nsPaths, asPaths, jsPaths, true, false)
+			res, err := setEndDevice(&device, nil, prepared.nsPaths, prepared.asPaths, prepared.jsPaths, true, false)
 			if err != nil {
 				logger.WithError(err).Error("Could not create end device, rolling back...")
 				if err := deleteEndDevice(context.Background(), &device.EndDeviceIdentifiers); err != nil {
@@ -518,7 +478,7 @@ var (
 				return err
 			}
 
-			device.SetFields(res, append(append(nsPaths, asPaths...), jsPaths...)...)
+			device.SetFields(res, append(append(prepared.nsPaths, prepared.asPaths...), prepared.jsPaths...)...)
 			if device.CreatedAt.IsZero() || (!res.CreatedAt.IsZero() && res.CreatedAt.Before(res.CreatedAt)) {
 				device.CreatedAt = res.CreatedAt
 			}
@@ -527,62 +487,23 @@ var (
 			}
 
 			return io.Write(os.Stdout, config.OutputFormat, &device)
+		}, func(cmd *cobra.Command, args []string) (interface{}, error) {
+			return prepareEndDeviceCreate(cmd, args)
+		}, func(cmd *cobra.Command, batch []interface{}) int {
+			return createEndDevices(preparedEndDevices(batch))
 		}),
 	}
 	endDevicesUpdateCommand = &cobra.Command{
 		Use:     "update [application-id] [device-id]",
 		Aliases: []string{"set"},
 		Short:   "Update an end device",
-		RunE: func(cmd *cobra.Command, args []string) error {
-			forwardDeprecatedDeviceFlags(cmd.Flags())
-
-			devID, err := getEndDeviceID(cmd.Flags(), args, true)
-			if err != nil {
-				return err
-			}
-			paths := util.UpdateFieldMask(cmd.Flags(), setEndDeviceFlags, attributesFlags(), endDevicePictureFlags)
-			if len(paths) == 0 {
-				logger.Warn("No fields selected, won't update anything")
-				return nil
-			}
-			var device ttnpb.EndDevice
-			if ttnpb.HasAnyField(paths, setEndDeviceToJS...) {
-				device.SupportsJoin = true
-			}
-			if err = util.SetFields(&device, setEndDeviceFlags); err != nil {
+		RunE: asBatchedBulk(func(cmd *cobra.Command, args []string) error {
+			prepared, err := prepareEndDeviceUpdate(cmd, args)
+			if err != nil || prepared == nil {
 				return err
 			}
-			device.Attributes = mergeAttributes(device.Attributes, cmd.Flags())
-			device.EndDeviceIdentifiers = *devID
-
-			isPaths, nsPaths, asPaths, jsPaths := splitEndDeviceSetPaths(device.SupportsJoin, paths...)
-
-			if len(nsPaths) > 0 && config.NetworkServerEnabled {
-				if device.NetworkServerAddress == "" {
-					device.NetworkServerAddress = getHost(config.NetworkServerGRPCAddress)
-				}
-				isPaths = append(isPaths, "network_server_address")
-			}
-			if len(asPaths) > 0 && config.ApplicationServerEnabled {
-				if device.ApplicationServerAddress == "" {
-					device.ApplicationServerAddress = getHost(config.ApplicationServerGRPCAddress)
-				}
-				isPaths = append(isPaths, "application_server_address")
-			}
-			if len(jsPaths) > 0 && config.JoinServerEnabled {
-				if device.JoinServerAddress == "" {
-					device.JoinServerAddress = getHost(config.JoinServerGRPCAddress)
-				}
-				isPaths = append(isPaths, "join_server_address")
-			}
-
-			if picture, err := cmd.Flags().GetString("picture"); err == nil && picture != "" {
-				device.Picture, err = readPicture(picture)
-				if err != nil {
-					return err
-				}
-				isPaths = append(paths, "picture")
-			}
+			device := prepared.device
+			isPaths, nsPaths, asPaths, jsPaths := prepared.isPaths, prepared.nsPaths, prepared.asPaths, prepared.jsPaths
 
 			is, err := api.Dial(ctx, config.IdentityServerGRPCAddress)
 			if err != nil {
@@ -590,36 +511,15 @@ var (
 			}
 			logger.WithField("paths", isPaths).Debug("Get end device from Identity Server")
 			existingDevice, err := ttnpb.NewEndDeviceRegistryClient(is).Get(ctx, &ttnpb.GetEndDeviceRequest{
-				EndDeviceIdentifiers: *devID,
+				EndDeviceIdentifiers: device.EndDeviceIdentifiers,
 				FieldMask:            pbtypes.FieldMask{Paths: isPaths},
 			})
 			if err != nil {
 				return err
 			}
 
-			// EUIs can not be updated, so we only accept EUI flags if they are equal to the existing ones.
-			if device.JoinEUI != nil {
-				if existingDevice.JoinEUI != nil && *device.JoinEUI != *existingDevice.JoinEUI {
-					return errEndDeviceEUIUpdate
-				}
-			} else {
-				device.JoinEUI = existingDevice.JoinEUI
-			}
-			if device.DevEUI != nil {
-				if existingDevice.DevEUI != nil && *device.DevEUI != *existingDevice.DevEUI {
-					return errEndDeviceEUIUpdate
-				}
-			} else {
-				device.DevEUI = existingDevice.DevEUI
-			}
-
-			// Require EUIs for devices that need to be updated in the Join Server.
-			if len(jsPaths) > 0 && (device.JoinEUI == nil || device.DevEUI == nil) {
-				return errNoEndDeviceEUI
-			}
-
-			if nsMismatch, asMismatch, jsMismatch := compareServerAddressesEndDevice(existingDevice, config); nsMismatch || asMismatch || jsMismatch {
-				return errAddressMismatchEndDevice
+			if err := checkEndDeviceUpdate(&device, existingDevice, jsPaths); err != nil {
+				return err
 			}
 
 			touch, _ := cmd.Flags().GetBool("touch")
@@ -629,7 +529,16 @@ var (
 			}
 
 			return io.Write(os.Stdout, config.OutputFormat, res)
-		},
+		}, func(cmd *cobra.Command, args []string) (interface{}, error) {
+			prepared, err := prepareEndDeviceUpdate(cmd, args)
+			if err != nil || prepared == nil {
+				return nil, err
+			}
+			return prepared, nil
+		}, func(cmd *cobra.Command, batch []interface{}) int {
+			touch, _ := cmd.Flags().GetBool("touch")
+			return updateEndDevices(preparedEndDevices(batch), touch)
+		}),
 	}
 	// TODO: Remove (https://github.com/TheThingsNetwork/lorawan-stack/issues/999)
 	endDevicesProvisionCommand = &cobra.Command{
@@ -726,7 +635,7 @@ var (
 	endDevicesDeleteCommand = &cobra.Command{
 		Use:   "delete [application-id] [device-id]",
 		Short: "Delete an end device",
-		RunE: func(cmd *cobra.Command, args []string) error {
+		RunE: asBatchedBulk(func(cmd *cobra.Command, args []string) error {
 			devID, err := getEndDeviceID(cmd.Flags(), args, true)
 			if err != nil {
 				return err
@@ -748,28 +657,20 @@ var (
 				return err
 			}
 
-			// EUIs must match registered EUIs if set.
-			if devID.JoinEUI != nil {
-				if existingDevice.JoinEUI != nil && *devID.JoinEUI != *existingDevice.JoinEUI {
-					return errInconsistentEndDeviceEUI
-				}
-			} else {
-				devID.JoinEUI = existingDevice.JoinEUI
-			}
-			if devID.DevEUI != nil {
-				if existingDevice.DevEUI != nil && *devID.DevEUI != *existingDevice.DevEUI {
-					return errInconsistentEndDeviceEUI
-				}
-			} else {
-				devID.DevEUI = existingDevice.DevEUI
-			}
-
-			if nsMismatch, asMismatch, jsMismatch := compareServerAddressesEndDevice(existingDevice, config); nsMismatch || asMismatch || jsMismatch {
-				return errAddressMismatchEndDevice
+			if err := checkEndDeviceDelete(devID, existingDevice); err != nil {
+				return err
 			}
 
 			return deleteEndDevice(ctx, devID)
-		},
+		}, func(cmd *cobra.Command, args []string) (interface{}, error) {
+			var device ttnpb.EndDevice
+			if _, err := inputDecoder.Decode(&device); err != nil {
+				return nil, err
+			}
+			return &preparedEndDevice{device: device}, nil
+		}, func(cmd *cobra.Command, batch []interface{}) int {
+			return deleteEndDevices(preparedEndDevices(batch))
+		}),
 	}
 	endDevicesClaimCommand = &cobra.Command{
 		Use:   "claim [application-id]",
@@ -1118,6 +1019,13 @@ func init() {
 	endDevicesGetCommand.Flags().AddFlagSet(endDeviceIDFlags())
 	endDevicesGetCommand.Flags().AddFlagSet(selectEndDeviceFlags)
 	endDevicesCommand.AddCommand(endDevicesGetCommand)
+	endDevicesExportCommand.Flags().AddFlagSet(applicationIDFlags())
+	endDevicesExportCommand.Flags().AddFlagSet(selectEndDeviceFlags)
+	endDevicesExportCommand.Flags().String("format", "json", "output format (json, csv)")
+	endDevicesExportCommand.Flags().String("separator", ",", "separator of CSV columns")
+	endDevicesExportCommand.Flags().Bool("base64", false, "encode EUIs, addresses and keys in CSV as base64 instead of hex")
+	endDevicesExportCommand.Flags().StringSlice("attribute-columns", nil, "attribute keys to export as CSV columns")
+	endDevicesCommand.AddCommand(endDevicesExportCommand)
 	endDevicesCreateCommand.Flags().AddFlagSet(endDeviceIDFlags())
 	endDevicesCreateCommand.Flags().AddFlagSet(setEndDeviceFlags)
 	endDevicesCreateCommand.Flags().AddFlagSet(attributesFlags())
@@ -1126,6 +1034,7 @@ func init() {
 	endDevicesCreateCommand.Flags().Bool("abp", false, "configure end device as ABP")
 	endDevicesCreateCommand.Flags().Bool("with-session", false, "generate ABP session DevAddr and keys")
 	endDevicesCreateCommand.Flags().Bool("with-claim-authentication-code", false, "generate claim authentication code of 4 bytes")
+	endDevicesCreateCommand.Flags().String("band-id", "", "apply the end device profile of the given band from the Device Repository")
 	endDevicesCreateCommand.Flags().AddFlagSet(endDevicePictureFlags)
 	endDevicesCommand.AddCommand(endDevicesCreateCommand)
 	endDevicesUpdateCommand.Flags().AddFlagSet(endDeviceIDFlags())
@@ -1171,6 +1080,274 @@ func init() {
 	endDeviceTemplatesExecuteCommand.Flags().AddFlagSet(setEndDeviceFlags)
 }
 
+// prepareEndDeviceCreate prepares the end device to create from the flags, arguments and input.
+func prepareEndDeviceCreate(cmd *cobra.Command, args []string) (*preparedEndDevice, error) {
+	forwardDeprecatedDeviceFlags(cmd.Flags())
+
+	devID, err := getEndDeviceID(cmd.Flags(), args, false)
+	if err != nil {
+		return nil, err
+	}
+	paths := util.UpdateFieldMask(cmd.Flags(), setEndDeviceFlags, attributesFlags())
+
+	var device ttnpb.EndDevice
+	var decodedABP bool
+	if inputDecoder != nil {
+		decodedPaths, err := inputDecoder.Decode(&device)
+		if err != nil {
+			return nil, err
+		}
+		paths = append(paths, ttnpb.FlattenPaths(decodedPaths, endDeviceFlattenPaths)...)
+		// End devices from stdin with a session that do not support join, for example migrated end devices, are ABP.
+		decodedABP = device.Session != nil && !device.SupportsJoin
+	}
+
+	setDefaults, _ := cmd.Flags().GetBool("defaults")
+	if setDefaults {
+		if config.NetworkServerEnabled {
+			device.NetworkServerAddress = getHost(config.NetworkServerGRPCAddress)
+			paths = append(paths, "network_server_address")
+		}
+		if config.ApplicationServerEnabled {
+			device.ApplicationServerAddress = getHost(config.ApplicationServerGRPCAddress)
+			paths = append(paths, "application_server_address")
+		}
+	}
+
+	if picture, err := cmd.Flags().GetString("picture"); err == nil && picture != "" {
+		device.Picture, err = readPicture(picture)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	abp, _ := cmd.Flags().GetBool("abp")
+	multicast, _ := cmd.Flags().GetBool("multicast")
+	if abp || multicast || decodedABP {
+		device.SupportsJoin = false
+		if config.NetworkServerEnabled {
+			paths = append(paths, "supports_join")
+		}
+		if withSession, _ := cmd.Flags().GetBool("with-session"); withSession {
+			if device.ProvisionerID != "" {
+				return nil, errEndDeviceKeysWithProvisioner
+			}
+			// TODO: Generate DevAddr in cluster NetID (https://github.com/TheThingsNetwork/lorawan-stack/issues/47).
+			devAddr, err := generateDevAddr(types.NetID{})
+			if err != nil {
+				return nil, err
+			}
+			device.DevAddr = &devAddr
+			device.Session = &ttnpb.Session{
+				DevAddr: devAddr,
+				SessionKeys: ttnpb.SessionKeys{
+					SessionKeyID: generateBytes(16),
+					FNwkSIntKey:  &ttnpb.KeyEnvelope{Key: generateKey()},
+					AppSKey:      &ttnpb.KeyEnvelope{Key: generateKey()},
+				},
+			}
+			paths = append(paths,
+				"session.keys.session_key_id",
+				"session.keys.f_nwk_s_int_key.key",
+				"session.keys.app_s_key.key",
+				"session.dev_addr",
+			)
+			var macVersion ttnpb.MACVersion
+			s, err := setEndDeviceFlags.GetString("lorawan_version")
+			if err != nil {
+				return nil, err
+			}
+			if err := macVersion.UnmarshalText([]byte(s)); err != nil {
+				return nil, err
+			}
+			if err := macVersion.Validate(); err != nil {
+				return nil, errInvalidMACVerson
+			}
+			if macVersion.Compare(ttnpb.MAC_V1_1) >= 0 {
+				device.Session.SessionKeys.SNwkSIntKey = &ttnpb.KeyEnvelope{Key: generateKey()}
+				device.Session.SessionKeys.NwkSEncKey = &ttnpb.KeyEnvelope{Key: generateKey()}
+				paths = append(paths,
+					"session.keys.s_nwk_s_int_key.key",
+					"session.keys.nwk_s_enc_key.key",
+				)
+			}
+		}
+	} else {
+		device.SupportsJoin = true
+		if config.NetworkServerEnabled {
+			paths = append(paths, "supports_join")
+		}
+		if setDefaults {
+			if config.JoinServerEnabled {
+				device.JoinServerAddress = getHost(config.JoinServerGRPCAddress)
+				paths = append(paths,
+					"join_server_address",
+				)
+			}
+		}
+		if withKeys, _ := cmd.Flags().GetBool("with-root-keys"); withKeys {
+			if device.ProvisionerID != "" {
+				return nil, errEndDeviceKeysWithProvisioner
+			}
+			// TODO: Set JoinEUI and DevEUI (https://github.com/TheThingsNetwork/lorawan-stack/issues/47).
+			device.RootKeys = &ttnpb.RootKeys{
+				RootKeyID: "ttn-lw-cli-generated",
+				AppKey:    &ttnpb.KeyEnvelope{Key: generateKey()},
+				NwkKey:    &ttnpb.KeyEnvelope{Key: generateKey()},
+			}
+			paths = append(paths,
+				"root_keys.root_key_id",
+				"root_keys.app_key.key",
+				"root_keys.nwk_key.key",
+			)
+		}
+	}
+	if withClaimAuthenticationCode, _ := cmd.Flags().GetBool("with-claim-authentication-code"); withClaimAuthenticationCode {
+		device.ClaimAuthenticationCode = &ttnpb.EndDeviceAuthenticationCode{
+			Value: strings.ToUpper(hex.EncodeToString(random.Bytes(4))),
+		}
+		paths = append(paths, "claim_authentication_code")
+	}
+
+	if err = util.SetFields(&device, setEndDeviceFlags); err != nil {
+		return nil, err
+	}
+
+	if bandID, _ := cmd.Flags().GetString("band-id"); bandID != "" {
+		templatePaths, err := applyDeviceRepositoryTemplate(&device, bandID, paths)
+		if err != nil {
+			return nil, err
+		}
+		paths = append(paths, templatePaths...)
+	}
+
+	device.Attributes = mergeAttributes(device.Attributes, cmd.Flags())
+	if devID != nil {
+		if devID.DeviceID != "" {
+			device.DeviceID = devID.DeviceID
+		}
+		if devID.ApplicationID != "" {
+			device.ApplicationID = devID.ApplicationID
+		}
+		if device.SupportsJoin {
+			if devID.JoinEUI != nil {
+				device.JoinEUI = devID.JoinEUI
+			}
+			if devID.DevEUI != nil {
+				device.DevEUI = devID.DevEUI
+			}
+		}
+	}
+
+	if device.ApplicationID == "" {
+		return nil, errNoApplicationID
+	}
+	if device.DeviceID == "" {
+		return nil, errNoEndDeviceID
+	}
+
+	isPaths, nsPaths, asPaths, jsPaths := splitEndDeviceSetPaths(device.SupportsJoin, paths...)
+
+	// Require EUIs for devices that need to be added to the Join Server.
+	if len(jsPaths) > 0 && (device.JoinEUI == nil || device.DevEUI == nil) {
+		return nil, errNoEndDeviceEUI
+	}
+
+	return &preparedEndDevice{
+		device:  device,
+		isPaths: isPaths,
+		nsPaths: nsPaths,
+		asPaths: asPaths,
+		jsPaths: jsPaths,
+	}, nil
+}
+
+// prepareEndDeviceUpdate prepares the end device to update from the flags, arguments and input.
+// If there are no fields to update, prepareEndDeviceUpdate returns nil.
+func prepareEndDeviceUpdate(cmd *cobra.Command, args []string) (*preparedEndDevice, error) {
+	forwardDeprecatedDeviceFlags(cmd.Flags())
+
+	devID, err := getEndDeviceID(cmd.Flags(), args, inputDecoder == nil)
+	if err != nil {
+		return nil, err
+	}
+	paths := util.UpdateFieldMask(cmd.Flags(), setEndDeviceFlags, attributesFlags(), endDevicePictureFlags)
+	var device ttnpb.EndDevice
+	if inputDecoder != nil {
+		decodedPaths, err := inputDecoder.Decode(&device)
+		if err != nil {
+			return nil, err
+		}
+		paths = append(paths, nonImplicitPaths(ttnpb.FlattenPaths(decodedPaths, endDeviceFlattenPaths)...)...)
+	}
+	if len(paths) == 0 {
+		logger.Warn("No fields selected, won't update anything")
+		return nil, nil
+	}
+	if ttnpb.HasAnyField(paths, setEndDeviceToJS...) {
+		device.SupportsJoin = true
+	}
+	if err = util.SetFields(&device, setEndDeviceFlags); err != nil {
+		return nil, err
+	}
+	device.Attributes = mergeAttributes(device.Attributes, cmd.Flags())
+	if devID.DeviceID != "" {
+		device.DeviceID = devID.DeviceID
+	}
+	if devID.ApplicationID != "" {
+		device.ApplicationID = devID.ApplicationID
+	}
+	if devID.JoinEUI != nil {
+		device.JoinEUI = devID.JoinEUI
+	}
+	if devID.DevEUI != nil {
+		device.DevEUI = devID.DevEUI
+	}
+	if device.ApplicationID == "" {
+		return nil, errNoApplicationID
+	}
+	if device.DeviceID == "" {
+		return nil, errNoEndDeviceID
+	}
+
+	isPaths, nsPaths, asPaths, jsPaths := splitEndDeviceSetPaths(device.SupportsJoin, paths...)
+
+	if len(nsPaths) > 0 && config.NetworkServerEnabled {
+		if device.NetworkServerAddress == "" {
+			device.NetworkServerAddress = getHost(config.NetworkServerGRPCAddress)
+		}
+		isPaths = append(isPaths, "network_server_address")
+	}
+	if len(asPaths) > 0 && config.ApplicationServerEnabled {
+		if device.ApplicationServerAddress == "" {
+			device.ApplicationServerAddress = getHost(config.ApplicationServerGRPCAddress)
+		}
+		isPaths = append(isPaths, "application_server_address")
+	}
+	if len(jsPaths) > 0 && config.JoinServerEnabled {
+		if device.JoinServerAddress == "" {
+			device.JoinServerAddress = getHost(config.JoinServerGRPCAddress)
+		}
+		isPaths = append(isPaths, "join_server_address")
+	}
+
+	if picture, err := cmd.Flags().GetString("picture"); err == nil && picture != "" {
+		device.Picture, err = readPicture(picture)
+		if err != nil {
+			return nil, err
+		}
+		isPaths = append(paths, "picture")
+	}
+
+	return &preparedEndDevice{
+		device:  device,
+		isPaths: isPaths,
+		nsPaths: nsPaths,
+		asPaths: asPaths,
+		jsPaths: jsPaths,
+	}, nil
+}
+
 var errAddressMismatchEndDevice = errors.DefineAborted("end_device_server_address_mismatch", "Network/Application/Join Server address mismatch")
 
 func compareServerAddressesEndDevice(device *ttnpb.EndDevice, config *Config) (nsMismatch, asMismatch, jsMismatch bool) {
@@ -1198,3 +1375,58 @@ func compareServerAddressesEndDevice(device *ttnpb.EndDevice, config *Config) (n
 	}
 	return
 }
+
+// checkEndDeviceUpdate checks the end device to update against the existing end device in the Identity Server.
+// The EUIs of the end device are set to the existing EUIs if they are not set.
+func checkEndDeviceUpdate(device, existingDevice *ttnpb.EndDevice, jsPaths []string) error {
+	// EUIs can not be updated, so we only accept EUI flags if they are equal to the existing ones.
+	if device.JoinEUI != nil {
+		if existingDevice.JoinEUI != nil && *device.JoinEUI != *existingDevice.JoinEUI {
+			return errEndDeviceEUIUpdate
+		}
+	} else {
+		device.JoinEUI = existingDevice.JoinEUI
+	}
+	if device.DevEUI != nil {
+		if existingDevice.DevEUI != nil && *device.DevEUI != *existingDevice.DevEUI {
+			return errEndDeviceEUIUpdate
+		}
+	} else {
+		device.DevEUI = existingDevice.DevEUI
+	}
+
+	// Require EUIs for devices that need to be updated in the Join Server.
+	if len(jsPaths) > 0 && (device.JoinEUI == nil || device.DevEUI == nil) {
+		return errNoEndDeviceEUI
+	}
+
+	if nsMismatch, asMismatch, jsMismatch := compareServerAddressesEndDevice(existingDevice, config); nsMismatch || asMismatch || jsMismatch {
+		return errAddressMismatchEndDevice
+	}
+	return nil
+}
+
+// checkEndDeviceDelete checks the identifiers of the end device to delete against the existing end device in the Identity Server.
+// The EUIs of the identifiers are set to the existing EUIs if they are not set.
+func checkEndDeviceDelete(devID *ttnpb.EndDeviceIdentifiers, existingDevice *ttnpb.EndDevice) error {
+	// EUIs must match registered EUIs if set.
+	if devID.JoinEUI != nil {
+		if existingDevice.JoinEUI != nil && *devID.JoinEUI != *existingDevice.JoinEUI {
+			return errInconsistentEndDeviceEUI
+		}
+	} else {
+		devID.JoinEUI = existingDevice.JoinEUI
+	}
+	if devID.DevEUI != nil {
+		if existingDevice.DevEUI != nil && *devID.DevEUI != *existingDevice.DevEUI {
+			return errInconsistentEndDeviceEUI
+		}
+	} else {
+		devID.DevEUI = existingDevice.DevEUI
+	}
+
+	if nsMismatch, asMismatch, jsMismatch := compareServerAddressesEndDevice(existingDevice, config); nsMismatch || asMismatch || jsMismatch {
+		return errAddressMismatchEndDevice
+	}
+	return nil
+}
@@ -0,0 +1,501 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"context"
+	"os"
+	"sync"
+
+	pbtypes "github.com/gogo/protobuf/types"
+	"go.thethings.network/lorawan-stack/cmd/ttn-lw-cli/internal/api"
+	"go.thethings.network/lorawan-stack/cmd/ttn-lw-cli/internal/io"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/log"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"google.golang.org/grpc"
+)
+
+var errBatchResults = errors.DefineDataLoss("batch_results", "expected `{expected}` batch results but got `{actual}`")
+
+// preparedEndDevice is an end device with the paths to set in each of the registries.
+type preparedEndDevice struct {
+	device                             ttnpb.EndDevice
+	isPaths, nsPaths, asPaths, jsPaths []string
+}
+
+func preparedEndDevices(batch []interface{}) []*preparedEndDevice {
+	devs := make([]*preparedEndDevice, len(batch))
+	for i, dev := range batch {
+		devs[i] = dev.(*preparedEndDevice)
+	}
+	return devs
+}
+
+// groupEndDevicesByApplication groups the end devices by application, in order of appearance.
+// This is needed because all end devices of a batch request must be in the same application.
+func groupEndDevicesByApplication(devs []*preparedEndDevice) [][]*preparedEndDevice {
+	var groups [][]*preparedEndDevice
+	indices := make(map[string]int)
+	for _, dev := range devs {
+		i, ok := indices[dev.device.ApplicationID]
+		if !ok {
+			i = len(groups)
+			indices[dev.device.ApplicationID] = i
+			groups = append(groups, nil)
+		}
+		groups[i] = append(groups[i], dev)
+	}
+	return groups
+}
+
+func logEndDeviceError(ids ttnpb.EndDeviceIdentifiers, err error, msg string) {
+	logger.WithError(err).WithFields(log.Fields(
+		"application_id", ids.ApplicationID,
+		"device_id", ids.DeviceID,
+	)).Error(msg)
+}
+
+func mergeEndDeviceTimestamps(dst, src *ttnpb.EndDevice) {
+	if dst.CreatedAt.IsZero() || (!src.CreatedAt.IsZero() && src.CreatedAt.Before(dst.CreatedAt)) {
+		dst.CreatedAt = src.CreatedAt
+	}
+	if src.UpdatedAt.After(dst.UpdatedAt) {
+		dst.UpdatedAt = src.UpdatedAt
+	}
+}
+
+// batchEndDevices sends the end devices for which include returns true in a single batch request, and
+// returns the resulting end device or error of each end device. If the batch request fails as a whole,
+// the error is returned for all end devices in the batch.
+func batchEndDevices(n int, include func(i int) bool, send func(indices []int) (*ttnpb.BatchEndDevicesResponse, error)) ([]*ttnpb.EndDevice, []error) {
+	devs, errs := make([]*ttnpb.EndDevice, n), make([]error, n)
+	var indices []int
+	for i := 0; i < n; i++ {
+		if include(i) {
+			indices = append(indices, i)
+		}
+	}
+	if len(indices) == 0 {
+		return devs, errs
+	}
+	res, err := send(indices)
+	if err == nil && len(res.Results) != len(indices) {
+		err = errBatchResults.WithAttributes(
+			"expected", len(indices),
+			"actual", len(res.Results),
+		)
+	}
+	for j, i := range indices {
+		switch {
+		case err != nil:
+			errs[i] = err
+		case res.Results[j].Error != nil:
+			errs[i] = ttnpb.ErrorDetailsFromProto(res.Results[j].Error)
+		case res.Results[j].EndDevice != nil:
+			devs[i] = res.Results[j].EndDevice
+		default:
+			devs[i] = &ttnpb.EndDevice{}
+		}
+	}
+	return devs, errs
+}
+
+func batchGetEndDevicesFromIS(appIDs ttnpb.ApplicationIdentifiers, devs []*preparedEndDevice, paths func(*preparedEndDevice) []string) ([]*ttnpb.EndDevice, []error) {
+	return batchEndDevices(len(devs), func(int) bool { return true }, func(indices []int) (*ttnpb.BatchEndDevicesResponse, error) {
+		is, err := api.Dial(ctx, config.IdentityServerGRPCAddress)
+		if err != nil {
+			return nil, err
+		}
+		req := &ttnpb.BatchGetEndDevicesRequest{
+			ApplicationIdentifiers: appIDs,
+			Requests:               make([]*ttnpb.GetEndDeviceRequest, 0, len(indices)),
+		}
+		for _, i := range indices {
+			req.Requests = append(req.Requests, &ttnpb.GetEndDeviceRequest{
+				EndDeviceIdentifiers: devs[i].device.EndDeviceIdentifiers,
+				FieldMask:            pbtypes.FieldMask{Paths: paths(devs[i])},
+			})
+		}
+		logger.WithField("end_devices", len(indices)).Debug("Get end devices from Identity Server")
+		return ttnpb.NewEndDeviceRegistryClient(is).BatchGet(ctx, req)
+	})
+}
+
+type batchSetEndDevicesFunc func(context.Context, *ttnpb.BatchSetEndDevicesRequest, ...grpc.CallOption) (*ttnpb.BatchEndDevicesResponse, error)
+
+// setEndDevices sets the end devices of the application in the registries, like setEndDevice does for
+// a single end device. The Network Server, Application Server and Join Server are called concurrently.
+// End devices with an error in errs are skipped, and errs is updated with the errors of end devices that fail.
+func setEndDevices(appIDs ttnpb.ApplicationIdentifiers, devs []*preparedEndDevice, errs []error, isCreate, touch bool) []*ttnpb.EndDevice {
+	res := make([]*ttnpb.EndDevice, len(devs))
+	for i, dev := range devs {
+		res[i] = &ttnpb.EndDevice{}
+		res[i].SetFields(&dev.device, "ids", "created_at", "updated_at")
+	}
+	merge := func(results []*ttnpb.EndDevice, resultErrs []error, paths func(*preparedEndDevice) []string) {
+		for i, result := range results {
+			if resultErrs[i] != nil {
+				if errs[i] == nil {
+					errs[i] = resultErrs[i]
+				}
+				continue
+			}
+			if result == nil {
+				continue
+			}
+			res[i].SetFields(result, paths(devs[i])...)
+			mergeEndDeviceTimestamps(res[i], result)
+		}
+	}
+
+	if !isCreate {
+		isPaths := func(dev *preparedEndDevice) []string { return dev.isPaths }
+		isRes, isErrs := batchEndDevices(len(devs), func(i int) bool {
+			return errs[i] == nil && len(devs[i].isPaths) > 0
+		}, func(indices []int) (*ttnpb.BatchEndDevicesResponse, error) {
+			is, err := api.Dial(ctx, config.IdentityServerGRPCAddress)
+			if err != nil {
+				return nil, err
+			}
+			req := &ttnpb.BatchUpdateEndDevicesRequest{
+				ApplicationIdentifiers: appIDs,
+				Requests:               make([]*ttnpb.UpdateEndDeviceRequest, 0, len(indices)),
+			}
+			for _, i := range indices {
+				var isDevice ttnpb.EndDevice
+				isDevice.SetFields(&devs[i].device, append(devs[i].isPaths, "ids")...)
+				req.Requests = append(req.Requests, &ttnpb.UpdateEndDeviceRequest{
+					EndDevice: isDevice,
+					FieldMask: pbtypes.FieldMask{Paths: devs[i].isPaths},
+				})
+			}
+			logger.WithField("end_devices", len(indices)).Debug("Set end devices on Identity Server")
+			return ttnpb.NewEndDeviceRegistryClient(is).BatchUpdate(ctx, req)
+		})
+		merge(isRes, isErrs, isPaths)
+	}
+
+	type registry struct {
+		name    string
+		enabled bool
+		address string
+		paths   func(*preparedEndDevice) []string
+		include func(*preparedEndDevice) bool
+		client  func(*grpc.ClientConn) batchSetEndDevicesFunc
+	}
+	registries := []registry{
+		{
+			name:    "Join Server",
+			enabled: config.JoinServerEnabled,
+			address: config.JoinServerGRPCAddress,
+			paths:   func(dev *preparedEndDevice) []string { return dev.jsPaths },
+			include: func(dev *preparedEndDevice) bool { return len(dev.jsPaths) > 0 || touch && dev.device.SupportsJoin },
+			client: func(cc *grpc.ClientConn) batchSetEndDevicesFunc {
+				return ttnpb.NewJsEndDeviceRegistryClient(cc).BatchSet
+			},
+		},
+		{
+			name:    "Network Server",
+			enabled: config.NetworkServerEnabled,
+			address: config.NetworkServerGRPCAddress,
+			paths:   func(dev *preparedEndDevice) []string { return dev.nsPaths },
+			include: func(dev *preparedEndDevice) bool { return len(dev.nsPaths) > 0 || isCreate || touch },
+			client: func(cc *grpc.ClientConn) batchSetEndDevicesFunc {
+				return ttnpb.NewNsEndDeviceRegistryClient(cc).BatchSet
+			},
+		},
+		{
+			name:    "Application Server",
+			enabled: config.ApplicationServerEnabled,
+			address: config.ApplicationServerGRPCAddress,
+			paths:   func(dev *preparedEndDevice) []string { return dev.asPaths },
+			include: func(dev *preparedEndDevice) bool { return len(dev.asPaths) > 0 || isCreate || touch },
+			client: func(cc *grpc.ClientConn) batchSetEndDevicesFunc {
+				return ttnpb.NewAsEndDeviceRegistryClient(cc).BatchSet
+			},
+		},
+	}
+	results := make([][]*ttnpb.EndDevice, len(registries))
+	resultErrs := make([][]error, len(registries))
+	var wg sync.WaitGroup
+	for j, r := range registries {
+		if !r.enabled {
+			for _, dev := range devs {
+				if paths := r.paths(dev); len(paths) > 0 {
+					logger.WithField("paths", paths).Warnf("%s disabled but fields specified to set", r.name)
+					break
+				}
+			}
+			continue
+		}
+		wg.Add(1)
+		go func(j int, r registry) {
+			defer wg.Done()
+			results[j], resultErrs[j] = batchEndDevices(len(devs), func(i int) bool {
+				return errs[i] == nil && r.include(devs[i])
+			}, func(indices []int) (*ttnpb.BatchEndDevicesResponse, error) {
+				cc, err := api.Dial(ctx, r.address)
+				if err != nil {
+					return nil, err
+				}
+				req := &ttnpb.BatchSetEndDevicesRequest{
+					ApplicationIdentifiers: appIDs,
+					Requests:               make([]*ttnpb.SetEndDeviceRequest, 0, len(indices)),
+				}
+				for _, i := range indices {
+					paths := r.paths(devs[i])
+					var dev ttnpb.EndDevice
+					dev.SetFields(&devs[i].device, append(paths, "ids")...)
+					req.Requests = append(req.Requests, &ttnpb.SetEndDeviceRequest{
+						EndDevice: dev,
+						FieldMask: pbtypes.FieldMask{Paths: paths},
+					})
+				}
+				logger.WithField("end_devices", len(indices)).Debugf("Set end devices on %s", r.name)
+				return r.client(cc)(ctx, req)
+			})
+		}(j, r)
+	}
+	wg.Wait()
+	for j, r := range registries {
+		if results[j] != nil {
+			merge(results[j], resultErrs[j], r.paths)
+		}
+	}
+	return res
+}
+
+type batchDeleteEndDevicesFunc func(context.Context, *ttnpb.BatchDeleteEndDevicesRequest, ...grpc.CallOption) (*ttnpb.BatchEndDevicesResponse, error)
+
+// deleteApplicationEndDevices deletes the end devices of the application from the registries, like
+// deleteEndDevice does for a single end device. The Network Server, Application Server and Join Server
+// are called concurrently, and the end devices are deleted from the Identity Server last.
+// End devices with an error in errs are skipped, and errs is updated with the errors of end devices that fail.
+func deleteApplicationEndDevices(ctx context.Context, appIDs ttnpb.ApplicationIdentifiers, devs []*preparedEndDevice, errs []error) {
+	send := func(address string, client func(*grpc.ClientConn) batchDeleteEndDevicesFunc) func(indices []int) (*ttnpb.BatchEndDevicesResponse, error) {
+		return func(indices []int) (*ttnpb.BatchEndDevicesResponse, error) {
+			cc, err := api.Dial(ctx, address)
+			if err != nil {
+				return nil, err
+			}
+			req := &ttnpb.BatchDeleteEndDevicesRequest{
+				ApplicationIdentifiers: appIDs,
+				EndDeviceIDs:           make([]*ttnpb.EndDeviceIdentifiers, 0, len(indices)),
+			}
+			for _, i := range indices {
+				ids := devs[i].device.EndDeviceIdentifiers
+				req.EndDeviceIDs = append(req.EndDeviceIDs, &ids)
+			}
+			return client(cc)(ctx, req)
+		}
+	}
+
+	type registry struct {
+		name    string
+		enabled bool
+		address string
+		include func(*preparedEndDevice) bool
+		client  func(*grpc.ClientConn) batchDeleteEndDevicesFunc
+	}
+	registries := []registry{
+		{
+			name:    "Application Server",
+			enabled: config.ApplicationServerEnabled,
+			address: config.ApplicationServerGRPCAddress,
+			include: func(*preparedEndDevice) bool { return true },
+			client: func(cc *grpc.ClientConn) batchDeleteEndDevicesFunc {
+				return ttnpb.NewAsEndDeviceRegistryClient(cc).BatchDelete
+			},
+		},
+		{
+			name:    "Network Server",
+			enabled: config.NetworkServerEnabled,
+			address: config.NetworkServerGRPCAddress,
+			include: func(*preparedEndDevice) bool { return true },
+			client: func(cc *grpc.ClientConn) batchDeleteEndDevicesFunc {
+				return ttnpb.NewNsEndDeviceRegistryClient(cc).BatchDelete
+			},
+		},
+		{
+			name:    "Join Server",
+			enabled: config.JoinServerEnabled,
+			address: config.JoinServerGRPCAddress,
+			include: func(dev *preparedEndDevice) bool { return dev.device.JoinEUI != nil && dev.device.DevEUI != nil },
+			client: func(cc *grpc.ClientConn) batchDeleteEndDevicesFunc {
+				return ttnpb.NewJsEndDeviceRegistryClient(cc).BatchDelete
+			},
+		},
+	}
+	resultErrs := make([][]error, len(registries))
+	var wg sync.WaitGroup
+	for j, r := range registries {
+		if !r.enabled {
+			continue
+		}
+		wg.Add(1)
+		go func(j int, r registry) {
+			defer wg.Done()
+			_, resultErrs[j] = batchEndDevices(len(devs), func(i int) bool {
+				return errs[i] == nil && r.include(devs[i])
+			}, send(r.address, r.client))
+		}(j, r)
+	}
+	wg.Wait()
+	for j, r := range registries {
+		for i, err := range resultErrs[j] {
+			if errors.IsNotFound(err) {
+				logEndDeviceError(devs[i].device.EndDeviceIdentifiers, err, "Could not delete end device from "+r.name)
+			} else if err != nil && errs[i] == nil {
+				errs[i] = err
+			}
+		}
+	}
+
+	_, isErrs := batchEndDevices(len(devs), func(i int) bool {
+		return errs[i] == nil
+	}, send(config.IdentityServerGRPCAddress, func(cc *grpc.ClientConn) batchDeleteEndDevicesFunc {
+		return ttnpb.NewEndDeviceRegistryClient(cc).BatchDelete
+	}))
+	for i, err := range isErrs {
+		if err != nil {
+			errs[i] = err
+		}
+	}
+}
+
+// createEndDevices creates the end devices with batch requests, like the create command does for a
+// single end device. End devices that are created in the Identity Server but fail to be created in
+// any of the other registries are rolled back. It returns the number of end devices that failed.
+func createEndDevices(devs []*preparedEndDevice) (failed int) {
+	for _, devs := range groupEndDevicesByApplication(devs) {
+		appIDs := devs[0].device.ApplicationIdentifiers
+		isRes, errs := batchEndDevices(len(devs), func(int) bool { return true }, func(indices []int) (*ttnpb.BatchEndDevicesResponse, error) {
+			is, err := api.Dial(ctx, config.IdentityServerGRPCAddress)
+			if err != nil {
+				return nil, err
+			}
+			req := &ttnpb.BatchCreateEndDevicesRequest{
+				ApplicationIdentifiers: appIDs,
+				Requests:               make([]*ttnpb.CreateEndDeviceRequest, 0, len(indices)),
+			}
+			for _, i := range indices {
+				req.Requests = append(req.Requests, &ttnpb.CreateEndDeviceRequest{
+					EndDevice: devs[i].device,
+				})
+			}
+			logger.WithField("end_devices", len(indices)).Debug("Create end devices on Identity Server")
+			return ttnpb.NewEndDeviceRegistryClient(is).BatchCreate(ctx, req)
+		})
+		created := make([]bool, len(devs))
+		for i, dev := range devs {
+			if errs[i] != nil {
+				continue
+			}
+			created[i] = true
+			dev.device.SetFields(isRes[i], append(dev.isPaths, "created_at", "updated_at")...)
+		}
+
+		res := setEndDevices(appIDs, devs, errs, true, false)
+
+		var rollback []*preparedEndDevice
+		for i, dev := range devs {
+			if errs[i] != nil {
+				failed++
+				logEndDeviceError(dev.device.EndDeviceIdentifiers, errs[i], "Could not create end device")
+				if created[i] {
+					rollback = append(rollback, dev)
+				}
+				continue
+			}
+			dev.device.SetFields(res[i], append(append(dev.nsPaths, dev.asPaths...), dev.jsPaths...)...)
+			mergeEndDeviceTimestamps(&dev.device, res[i])
+			if err := io.Write(os.Stdout, config.OutputFormat, &dev.device); err != nil {
+				logEndDeviceError(dev.device.EndDeviceIdentifiers, err, "Could not write end device")
+			}
+		}
+		if len(rollback) > 0 {
+			logger.WithField("end_devices", len(rollback)).Error("Could not create end devices, rolling back...")
+			rollbackErrs := make([]error, len(rollback))
+			deleteApplicationEndDevices(context.Background(), appIDs, rollback, rollbackErrs)
+			for i, err := range rollbackErrs {
+				if err != nil {
+					logEndDeviceError(rollback[i].device.EndDeviceIdentifiers, err, "Could not roll back end device creation")
+				}
+			}
+		}
+	}
+	return failed
+}
+
+// updateEndDevices updates the end devices with batch requests, like the update command does for a
+// single end device. It returns the number of end devices that failed.
+func updateEndDevices(devs []*preparedEndDevice, touch bool) (failed int) {
+	for _, devs := range groupEndDevicesByApplication(devs) {
+		appIDs := devs[0].device.ApplicationIdentifiers
+		existingDevices, errs := batchGetEndDevicesFromIS(appIDs, devs, func(dev *preparedEndDevice) []string {
+			return dev.isPaths
+		})
+		for i, dev := range devs {
+			if errs[i] == nil {
+				errs[i] = checkEndDeviceUpdate(&dev.device, existingDevices[i], dev.jsPaths)
+			}
+		}
+
+		res := setEndDevices(appIDs, devs, errs, false, touch)
+
+		for i, dev := range devs {
+			if errs[i] != nil {
+				failed++
+				logEndDeviceError(dev.device.EndDeviceIdentifiers, errs[i], "Could not update end device")
+				continue
+			}
+			if err := io.Write(os.Stdout, config.OutputFormat, res[i]); err != nil {
+				logEndDeviceError(dev.device.EndDeviceIdentifiers, err, "Could not write end device")
+			}
+		}
+	}
+	return failed
+}
+
+// deleteEndDevices deletes the end devices with batch requests, like the delete command does for a
+// single end device. It returns the number of end devices that failed.
+func deleteEndDevices(devs []*preparedEndDevice) (failed int) {
+	for _, devs := range groupEndDevicesByApplication(devs) {
+		appIDs := devs[0].device.ApplicationIdentifiers
+		existingDevices, errs := batchGetEndDevicesFromIS(appIDs, devs, func(*preparedEndDevice) []string {
+			return []string{
+				"network_server_address",
+				"application_server_address",
+				"join_server_address",
+			}
+		})
+		for i, dev := range devs {
+			if errs[i] == nil {
+				errs[i] = checkEndDeviceDelete(&dev.device.EndDeviceIdentifiers, existingDevices[i])
+			}
+		}
+
+		deleteApplicationEndDevices(ctx, appIDs, devs, errs)
+
+		for i, dev := range devs {
+			if errs[i] != nil {
+				failed++
+				logEndDeviceError(dev.device.EndDeviceIdentifiers, errs[i], "Could not delete end device")
+			}
+		}
+	}
+	return failed
+}
@@ -19,6 +19,7 @@ import (
 	"io/ioutil"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/spf13/pflag"
 	"go.thethings.network/lorawan-stack/pkg/errors"
@@ -90,6 +91,17 @@ func mergeAttributes(attributes map[string]string, flagSet *pflag.FlagSet) map[s
 	return mergeKV(attributes, kv)
 }
 
+func deletedFlags() *pflag.FlagSet {
+	flagSet := &pflag.FlagSet{}
+	flagSet.Bool("deleted", false, "only list recently deleted entities (admin only)")
+	return flagSet
+}
+
+func getDeleted(flagSet *pflag.FlagSet) bool {
+	deleted, _ := flagSet.GetBool("deleted")
+	return deleted
+}
+
 func rightsFlags(filter func(string) bool) *pflag.FlagSet {
 	flagSet := &pflag.FlagSet{}
 	for right := range ttnpb.Right_value {
@@ -129,6 +141,50 @@ func getAPIKeyID(flagSet *pflag.FlagSet, args []string, i int) string {
 	return apiKeyID
 }
 
+func apiKeyOptionsFlags() *pflag.FlagSet {
+	flagSet := &pflag.FlagSet{}
+	flagSet.String("expires-at", "", "time after which the API key is no longer valid (RFC3339)")
+	flagSet.StringSlice("source-cidrs", nil, "source IP address ranges (CIDR) from which the API key may be used")
+	return flagSet
+}
+
+func applicationAPIKeyOptionsFlags() *pflag.FlagSet {
+	flagSet := apiKeyOptionsFlags()
+	flagSet.StringSlice("end-device-ids", nil, "IDs of the end devices that the API key is limited to")
+	return flagSet
+}
+
+var errInvalidAPIKeyExpiry = errors.DefineInvalidArgument("invalid_api_key_expiry", "invalid API key expiry `{expires_at}`")
+
+// getAPIKeyOptions sets the expiry and restrictions of the API key from the flags
+// and returns the field mask paths of the options that were set.
+// The restrictions are set as a whole.
+func getAPIKeyOptions(flagSet *pflag.FlagSet, key *ttnpb.APIKey) (paths []string, err error) {
+	if flagSet.Changed("expires-at") {
+		expiresAt, _ := flagSet.GetString("expires-at")
+		if expiresAt != "" {
+			t, err := time.Parse(time.RFC3339, expiresAt)
+			if err != nil {
+				return nil, errInvalidAPIKeyExpiry.WithCause(err).WithAttributes("expires_at", expiresAt)
+			}
+			key.ExpiresAt = &t
+		}
+		paths = append(paths, "expires_at")
+	}
+	if flagSet.Changed("source-cidrs") || flagSet.Changed("end-device-ids") {
+		sourceCIDRs, _ := flagSet.GetStringSlice("source-cidrs")
+		endDeviceIDs, _ := flagSet.GetStringSlice("end-device-ids")
+		if len(sourceCIDRs) > 0 || len(endDeviceIDs) > 0 {
+			key.Restrictions = &ttnpb.APIKeyRestrictions{
+				SourceCIDRs:  sourceCIDRs,
+				EndDeviceIDs: endDeviceIDs,
+			}
+		}
+		paths = append(paths, "restrictions")
+	}
+	return paths, nil
+}
+
 func searchFlags() *pflag.FlagSet {
 	flagSet := &pflag.FlagSet{}
 	flagSet.String("id-contains", "", "")
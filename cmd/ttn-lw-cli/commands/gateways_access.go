@@ -18,6 +18,7 @@ import (
 	"os"
 	"strings"
 
+	"github.com/gogo/protobuf/types"
 	"github.com/spf13/cobra"
 	"go.thethings.network/lorawan-stack/cmd/ttn-lw-cli/internal/api"
 	"go.thethings.network/lorawan-stack/cmd/ttn-lw-cli/internal/io"
@@ -191,6 +192,11 @@ var (
 				return errNoAPIKeyRights
 			}
 
+			var options ttnpb.APIKey
+			if _, err := getAPIKeyOptions(cmd.Flags(), &options); err != nil {
+				return err
+			}
+
 			is, err := api.Dial(ctx, config.IdentityServerGRPCAddress)
 			if err != nil {
 				return err
@@ -199,6 +205,8 @@ var (
 				GatewayIdentifiers: *gtwID,
 				Name:               name,
 				Rights:             rights,
+				ExpiresAt:          options.ExpiresAt,
+				Restrictions:       options.Restrictions,
 			})
 			if err != nil {
 				return err
@@ -232,17 +240,24 @@ var (
 				return errNoAPIKeyRights
 			}
 
+			apiKey := ttnpb.APIKey{
+				ID:     id,
+				Name:   name,
+				Rights: rights,
+			}
+			paths, err := getAPIKeyOptions(cmd.Flags(), &apiKey)
+			if err != nil {
+				return err
+			}
+
 			is, err := api.Dial(ctx, config.IdentityServerGRPCAddress)
 			if err != nil {
 				return err
 			}
 			_, err = ttnpb.NewGatewayAccessClient(is).UpdateAPIKey(ctx, &ttnpb.UpdateGatewayAPIKeyRequest{
 				GatewayIdentifiers: *gtwID,
-				APIKey: ttnpb.APIKey{
-					ID:     id,
-					Name:   name,
-					Rights: rights,
-				},
+				APIKey:             apiKey,
+				FieldMask:          types.FieldMask{Paths: append([]string{"name", "rights"}, paths...)},
 			})
 			if err != nil {
 				return err
@@ -307,10 +322,12 @@ func init() {
 	gatewayAPIKeys.AddCommand(gatewayAPIKeysList)
 	gatewayAPIKeysCreate.Flags().String("name", "", "")
 	gatewayAPIKeysCreate.Flags().AddFlagSet(gatewayRightsFlags)
+	gatewayAPIKeysCreate.Flags().AddFlagSet(apiKeyOptionsFlags())
 	gatewayAPIKeys.AddCommand(gatewayAPIKeysCreate)
 	gatewayAPIKeysUpdate.Flags().String("api-key-id", "", "")
 	gatewayAPIKeysUpdate.Flags().String("name", "", "")
 	gatewayAPIKeysUpdate.Flags().AddFlagSet(gatewayRightsFlags)
+	gatewayAPIKeysUpdate.Flags().AddFlagSet(apiKeyOptionsFlags())
 	gatewayAPIKeys.AddCommand(gatewayAPIKeysUpdate)
 	gatewayAPIKeysDelete.Flags().String("api-key-id", "", "")
 	gatewayAPIKeys.AddCommand(gatewayAPIKeysDelete)
@@ -96,6 +96,21 @@ var (
 
 			var token *oauth2.Token
 
+			deviceCode, err := cmd.Flags().GetBool("device-code")
+			if err != nil {
+				return err
+			}
+			if deviceCode {
+				token, err = deviceCodeLogin(ctx)
+				if err != nil {
+					logger.WithError(err).Error("Could not get OAuth access token with device code")
+					return err
+				}
+				logger.Info("Got OAuth access token")
+				cache.Set("oauth_token", token)
+				return nil
+			}
+
 			if callback {
 				oauth2Config.RedirectURL = "local-callback" // NOTE: The "?port=11885" is implicit.
 
@@ -189,6 +204,7 @@ var (
 func init() {
 	loginCommand.Flags().Bool("callback", true, "use local OAuth callback endpoint")
 	loginCommand.Flags().String("api-key", "", "API key to login with (instead of using OAuth)")
+	loginCommand.Flags().Bool("device-code", false, "login with a device code that is authorized in a browser on any device")
 	Root.AddCommand(loginCommand)
 	Root.AddCommand(logoutCommand)
 }
@@ -0,0 +1,141 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/pkg/browser"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"golang.org/x/oauth2"
+)
+
+const deviceCodeGrantType = "urn:ietf:params:oauth:grant-type:device_code"
+
+var (
+	errDeviceAuthorization = errors.Define("device_authorization", "device authorization failed with `{error}`: {description}")
+	errDeviceCodeExpired   = errors.DefineUnauthenticated("device_code_expired", "device code expired")
+)
+
+type deviceAuthorizationResponse struct {
+	DeviceCode              string `json:"device_code"`
+	UserCode                string `json:"user_code"`
+	VerificationURI         string `json:"verification_uri"`
+	VerificationURIComplete string `json:"verification_uri_complete"`
+	ExpiresIn               int    `json:"expires_in"`
+	Interval                int    `json:"interval"`
+	Error                   string `json:"error"`
+	ErrorDescription        string `json:"error_description"`
+}
+
+type deviceTokenResponse struct {
+	AccessToken      string `json:"access_token"`
+	TokenType        string `json:"token_type"`
+	RefreshToken     string `json:"refresh_token"`
+	ExpiresIn        int    `json:"expires_in"`
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description"`
+}
+
+// postDeviceForm posts the form to the OAuth server and decodes the JSON response into res.
+// Error responses as specified in RFC 8628 are decoded as well, so the status code is not checked.
+func postDeviceForm(ctx context.Context, target string, values url.Values, res interface{}) error {
+	req, err := http.NewRequest(http.MethodPost, target, strings.NewReader(values.Encode()))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	resp, err := http.DefaultClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	return json.NewDecoder(resp.Body).Decode(res)
+}
+
+// deviceCodeLogin obtains an OAuth token using the device authorization grant, as specified in RFC 8628.
+// The user authorizes the CLI on the verification page, which can be opened on a different device.
+func deviceCodeLogin(ctx context.Context) (*oauth2.Token, error) {
+	values := url.Values{
+		"client_id": []string{oauth2Config.ClientID},
+	}
+	if oauth2Config.ClientSecret != "" {
+		values.Set("client_secret", oauth2Config.ClientSecret)
+	}
+	var authorization deviceAuthorizationResponse
+	if err := postDeviceForm(ctx, fmt.Sprintf("%s/device_authorization", config.OAuthServerAddress), values, &authorization); err != nil {
+		return nil, err
+	}
+	if authorization.DeviceCode == "" {
+		return nil, errDeviceAuthorization.WithAttributes("error", authorization.Error, "description", authorization.ErrorDescription)
+	}
+
+	logger.Infof("Go to %s and enter the code %s", authorization.VerificationURI, authorization.UserCode)
+	if authorization.VerificationURIComplete != "" {
+		if err := browser.OpenURL(authorization.VerificationURIComplete); err != nil {
+			logger.WithError(err).Debug("Could not open your browser")
+		}
+	}
+	logger.Info("Waiting for your authorization...")
+
+	interval := time.Duration(authorization.Interval) * time.Second
+	if interval <= 0 {
+		interval = 5 * time.Second
+	}
+	expiry := time.Now().Add(time.Duration(authorization.ExpiresIn) * time.Second)
+	values.Set("grant_type", deviceCodeGrantType)
+	values.Set("device_code", authorization.DeviceCode)
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(interval):
+		}
+		if authorization.ExpiresIn > 0 && time.Now().After(expiry) {
+			return nil, errDeviceCodeExpired
+		}
+		var res deviceTokenResponse
+		if err := postDeviceForm(ctx, oauth2Config.Endpoint.TokenURL, values, &res); err != nil {
+			return nil, err
+		}
+		switch res.Error {
+		case "":
+			token := &oauth2.Token{
+				AccessToken:  res.AccessToken,
+				TokenType:    res.TokenType,
+				RefreshToken: res.RefreshToken,
+			}
+			if res.ExpiresIn > 0 {
+				token.Expiry = time.Now().Add(time.Duration(res.ExpiresIn) * time.Second)
+			}
+			return token, nil
+		case "authorization_pending":
+			continue
+		case "slow_down":
+			interval += 5 * time.Second
+			continue
+		case "expired_token":
+			return nil, errDeviceCodeExpired
+		default:
+			return nil, errDeviceAuthorization.WithAttributes("error", res.Error, "description", res.ErrorDescription)
+		}
+	}
+}
@@ -80,6 +80,7 @@ var (
 				Limit:        limit,
 				Page:         page,
 				Order:        getOrder(cmd.Flags()),
+				Deleted:      getDeleted(cmd.Flags()),
 			}, opt)
 			if err != nil {
 				return err
@@ -238,6 +239,48 @@ var (
 			return nil
 		},
 	}
+	organizationsRestoreCommand = &cobra.Command{
+		Use:   "restore [organization-id]",
+		Short: "Restore a recently deleted organization",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			orgID := getOrganizationID(cmd.Flags(), args)
+			if orgID == nil {
+				return errNoOrganizationID
+			}
+
+			is, err := api.Dial(ctx, config.IdentityServerGRPCAddress)
+			if err != nil {
+				return err
+			}
+			_, err = ttnpb.NewOrganizationRegistryClient(is).Restore(ctx, orgID)
+			if err != nil {
+				return err
+			}
+
+			return nil
+		},
+	}
+	organizationsPurgeCommand = &cobra.Command{
+		Use:   "purge [organization-id]",
+		Short: "Permanently delete an organization (admin only)",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			orgID := getOrganizationID(cmd.Flags(), args)
+			if orgID == nil {
+				return errNoOrganizationID
+			}
+
+			is, err := api.Dial(ctx, config.IdentityServerGRPCAddress)
+			if err != nil {
+				return err
+			}
+			_, err = ttnpb.NewOrganizationRegistryClient(is).Purge(ctx, orgID)
+			if err != nil {
+				return err
+			}
+
+			return nil
+		},
+	}
 	organizationsContactInfoCommand = contactInfoCommands("organization", func(cmd *cobra.Command, args []string) (*ttnpb.EntityIdentifiers, error) {
 		orgID := getOrganizationID(cmd.Flags(), args)
 		if orgID == nil {
@@ -252,6 +295,7 @@ func init() {
 	organizationsListCommand.Flags().AddFlagSet(selectOrganizationFlags)
 	organizationsListCommand.Flags().AddFlagSet(paginationFlags())
 	organizationsListCommand.Flags().AddFlagSet(orderFlags())
+	organizationsListCommand.Flags().AddFlagSet(deletedFlags())
 	organizationsCommand.AddCommand(organizationsListCommand)
 	organizationsSearchCommand.Flags().AddFlagSet(searchFlags())
 	organizationsSearchCommand.Flags().AddFlagSet(selectOrganizationFlags)
@@ -270,6 +314,10 @@ func init() {
 	organizationsCommand.AddCommand(organizationsUpdateCommand)
 	organizationsDeleteCommand.Flags().AddFlagSet(organizationIDFlags())
 	organizationsCommand.AddCommand(organizationsDeleteCommand)
+	organizationsRestoreCommand.Flags().AddFlagSet(organizationIDFlags())
+	organizationsCommand.AddCommand(organizationsRestoreCommand)
+	organizationsPurgeCommand.Flags().AddFlagSet(organizationIDFlags())
+	organizationsCommand.AddCommand(organizationsPurgeCommand)
 	organizationsContactInfoCommand.PersistentFlags().AddFlagSet(organizationIDFlags())
 	organizationsCommand.AddCommand(organizationsContactInfoCommand)
 	Root.AddCommand(organizationsCommand)
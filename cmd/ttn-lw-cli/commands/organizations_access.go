@@ -18,6 +18,7 @@ import (
 	"os"
 	"strings"
 
+	"github.com/gogo/protobuf/types"
 	"github.com/spf13/cobra"
 	"go.thethings.network/lorawan-stack/cmd/ttn-lw-cli/internal/api"
 	"go.thethings.network/lorawan-stack/cmd/ttn-lw-cli/internal/io"
@@ -191,6 +192,11 @@ var (
 				return errNoAPIKeyRights
 			}
 
+			var options ttnpb.APIKey
+			if _, err := getAPIKeyOptions(cmd.Flags(), &options); err != nil {
+				return err
+			}
+
 			is, err := api.Dial(ctx, config.IdentityServerGRPCAddress)
 			if err != nil {
 				return err
@@ -199,6 +205,8 @@ var (
 				OrganizationIdentifiers: *orgID,
 				Name:                    name,
 				Rights:                  rights,
+				ExpiresAt:               options.ExpiresAt,
+				Restrictions:            options.Restrictions,
 			})
 			if err != nil {
 				return err
@@ -232,17 +240,24 @@ var (
 				return errNoAPIKeyRights
 			}
 
+			apiKey := ttnpb.APIKey{
+				ID:     id,
+				Name:   name,
+				Rights: rights,
+			}
+			paths, err := getAPIKeyOptions(cmd.Flags(), &apiKey)
+			if err != nil {
+				return err
+			}
+
 			is, err := api.Dial(ctx, config.IdentityServerGRPCAddress)
 			if err != nil {
 				return err
 			}
 			_, err = ttnpb.NewOrganizationAccessClient(is).UpdateAPIKey(ctx, &ttnpb.UpdateOrganizationAPIKeyRequest{
 				OrganizationIdentifiers: *orgID,
-				APIKey: ttnpb.APIKey{
-					ID:     id,
-					Name:   name,
-					Rights: rights,
-				},
+				APIKey:                  apiKey,
+				FieldMask:               types.FieldMask{Paths: append([]string{"name", "rights"}, paths...)},
 			})
 			if err != nil {
 				return err
@@ -312,10 +327,12 @@ func init() {
 	organizationAPIKeys.AddCommand(organizationAPIKeysList)
 	organizationAPIKeysCreate.Flags().String("name", "", "")
 	organizationAPIKeysCreate.Flags().AddFlagSet(organizationRightsFlags)
+	organizationAPIKeysCreate.Flags().AddFlagSet(apiKeyOptionsFlags())
 	organizationAPIKeys.AddCommand(organizationAPIKeysCreate)
 	organizationAPIKeysUpdate.Flags().String("api-key-id", "", "")
 	organizationAPIKeysUpdate.Flags().String("name", "", "")
 	organizationAPIKeysUpdate.Flags().AddFlagSet(organizationRightsFlags)
+	organizationAPIKeysUpdate.Flags().AddFlagSet(apiKeyOptionsFlags())
 	organizationAPIKeys.AddCommand(organizationAPIKeysUpdate)
 	organizationAPIKeysDelete.Flags().String("api-key-id", "", "")
 	organizationAPIKeys.AddCommand(organizationAPIKeysDelete)
@@ -0,0 +1,379 @@
+// Copyright © 2026 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	stdio "io"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"go.thethings.network/lorawan-stack/cmd/ttn-lw-cli/internal/api"
+	"go.thethings.network/lorawan-stack/cmd/ttn-lw-cli/internal/io"
+	"go.thethings.network/lorawan-stack/cmd/ttn-lw-cli/internal/simulator"
+	"go.thethings.network/lorawan-stack/pkg/band"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/jsonpb"
+	"go.thethings.network/lorawan-stack/pkg/random"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/types"
+)
+
+var (
+	errSimulateTransport = errors.DefineInvalidArgument("simulate_transport", "invalid transport `{transport}`")
+	errSimulateNoDevices = errors.DefineInvalidArgument("simulate_no_devices", "no devices to simulate")
+)
+
+const simulateUDPKeepAlive = 10 * time.Second
+
+func simulateGatewayFlags() *pflag.FlagSet {
+	flagSet := &pflag.FlagSet{}
+	flagSet.String("transport", "grpc", "gateway connection to use (grpc, udp, mqtt, basic-station)")
+	flagSet.String("udp-address", "", "address of the Gateway Server UDP endpoint (default is the host of the Gateway Server gRPC address with port 1700)")
+	flagSet.String("mqtt-address", "", "URL of the Gateway Server MQTT endpoint (default is the host of the Gateway Server gRPC address with port 1882)")
+	flagSet.String("basic-station-address", "", "URL of the Gateway Server LoRa Basics Station LNS endpoint (default is the host of the Gateway Server gRPC address with port 1887)")
+	flagSet.String("band-id", band.EU_863_870, "")
+	return flagSet
+}
+
+func simulateDeviceFlags() *pflag.FlagSet {
+	flagSet := &pflag.FlagSet{}
+	flagSet.String("lorawan-version", "1.0.3", "LoRaWAN version of devices that do not specify it")
+	flagSet.String("lorawan-phy-version", "1.0.3-a", "LoRaWAN PHY version of devices that do not specify it")
+	flagSet.String("join-eui", "", "JoinEUI of the device (when not reading devices from stdin)")
+	flagSet.String("dev-eui", "", "DevEUI of the device (when not reading devices from stdin)")
+	flagSet.String("app-key", "", "AppKey of the device (when not reading devices from stdin)")
+	flagSet.String("nwk-key", "", "NwkKey of the device (when not reading devices from stdin)")
+	flagSet.String("state-dir", "", "directory to persist the device state in")
+	flagSet.Int("uplinks", 10, "number of data uplinks to send per device (0 is unlimited)")
+	flagSet.Duration("interval", 10*time.Second, "interval between uplinks")
+	flagSet.Duration("rx-timeout", 10*time.Second, "how long to wait for downlinks after the last uplink")
+	flagSet.Uint32("f-port", 1, "")
+	flagSet.String("frm-payload", "", "application payload (hex)")
+	flagSet.Bool("confirmed", false, "send confirmed uplinks")
+	flagSet.Bool("adr", true, "enable adaptive data rate")
+	flagSet.Int("data-rate-index", -1, "initial data rate index (default is the maximum data rate of the first channel)")
+	flagSet.Float32("rssi", -50, "RSSI of uplinks")
+	flagSet.Float32("snr", 7, "SNR of uplinks")
+	flagSet.Uint32("battery", 255, "battery level reported in DevStatusAns (0 is external power, 255 is unknown)")
+	return flagSet
+}
+
+func simulateStateFile(dir string, dev *ttnpb.EndDevice) string {
+	return filepath.Join(dir, strings.ToLower(dev.DevEUI.String())+".json")
+}
+
+func loadSimulatedDevice(dir string, dev *ttnpb.EndDevice) error {
+	b, err := ioutil.ReadFile(simulateStateFile(dir, dev))
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	return jsonpb.TTN().NewDecoder(bytes.NewReader(b)).Decode(dev)
+}
+
+func saveSimulatedDevice(dir string) func(*ttnpb.EndDevice) error {
+	return func(dev *ttnpb.EndDevice) error {
+		b, err := jsonpb.TTN().Marshal(dev)
+		if err != nil {
+			return err
+		}
+		return ioutil.WriteFile(simulateStateFile(dir, dev), b, 0600)
+	}
+}
+
+func getSimulatedDevices(flagSet *pflag.FlagSet) ([]*ttnpb.EndDevice, error) {
+	var devs []*ttnpb.EndDevice
+	if inputDecoder != nil {
+		for {
+			var dev ttnpb.EndDevice
+			if _, err := inputDecoder.Decode(&dev); err != nil {
+				if err == stdio.EOF {
+					break
+				}
+				return nil, err
+			}
+			devs = append(devs, &dev)
+		}
+	} else if devEUIHex, _ := flagSet.GetString("dev-eui"); devEUIHex != "" {
+		dev := &ttnpb.EndDevice{
+			SupportsJoin: true,
+			RootKeys:     &ttnpb.RootKeys{},
+		}
+		var devEUI, joinEUI types.EUI64
+		if err := devEUI.UnmarshalText([]byte(devEUIHex)); err != nil {
+			return nil, err
+		}
+		joinEUIHex, _ := flagSet.GetString("join-eui")
+		if err := joinEUI.UnmarshalText([]byte(joinEUIHex)); err != nil {
+			return nil, err
+		}
+		dev.DevEUI, dev.JoinEUI = &devEUI, &joinEUI
+		if appKeyHex, _ := flagSet.GetString("app-key"); appKeyHex != "" {
+			var appKey types.AES128Key
+			if err := appKey.UnmarshalText([]byte(appKeyHex)); err != nil {
+				return nil, err
+			}
+			dev.RootKeys.AppKey = &ttnpb.KeyEnvelope{Key: &appKey}
+		}
+		if nwkKeyHex, _ := flagSet.GetString("nwk-key"); nwkKeyHex != "" {
+			var nwkKey types.AES128Key
+			if err := nwkKey.UnmarshalText([]byte(nwkKeyHex)); err != nil {
+				return nil, err
+			}
+			dev.RootKeys.NwkKey = &ttnpb.KeyEnvelope{Key: &nwkKey}
+		}
+		devs = append(devs, dev)
+	}
+	if len(devs) == 0 {
+		return nil, errSimulateNoDevices
+	}
+
+	var macVersion ttnpb.MACVersion
+	macVersionText, _ := flagSet.GetString("lorawan-version")
+	if err := macVersion.UnmarshalText([]byte(macVersionText)); err != nil {
+		return nil, errInvalidMACVerson
+	}
+	var phyVersion ttnpb.PHYVersion
+	phyVersionText, _ := flagSet.GetString("lorawan-phy-version")
+	if err := phyVersion.UnmarshalText([]byte(phyVersionText)); err != nil {
+		return nil, errInvalidPHYVerson
+	}
+	for _, dev := range devs {
+		if dev.LoRaWANVersion == ttnpb.MAC_UNKNOWN {
+			dev.LoRaWANVersion = macVersion
+		}
+		if dev.LoRaWANPHYVersion == ttnpb.PHY_UNKNOWN {
+			dev.LoRaWANPHYVersion = phyVersion
+		}
+	}
+	return devs, nil
+}
+
+func simulateGatewayServerAddress(port string) (string, error) {
+	host, _, err := net.SplitHostPort(config.GatewayServerGRPCAddress)
+	if err != nil {
+		return "", err
+	}
+	return net.JoinHostPort(host, port), nil
+}
+
+func connectSimulatedGateway(flagSet *pflag.FlagSet, ids ttnpb.GatewayIdentifiers) (simulator.Gateway, error) {
+	apiKey, _ := flagSet.GetString("gateway-api-key")
+	switch transport, _ := flagSet.GetString("transport"); transport {
+	case "grpc":
+		if ids.GatewayID == "" {
+			return nil, errNoGatewayID
+		}
+		gs, err := api.Dial(ctx, config.GatewayServerGRPCAddress)
+		if err != nil {
+			return nil, err
+		}
+		return simulator.LinkGRPC(ctx, gs, ids, apiKey)
+	case "udp":
+		address, _ := flagSet.GetString("udp-address")
+		if address == "" {
+			var err error
+			if address, err = simulateGatewayServerAddress("1700"); err != nil {
+				return nil, err
+			}
+		}
+		return simulator.ConnectUDP(ctx, address, ids, simulateUDPKeepAlive)
+	case "mqtt":
+		if ids.GatewayID == "" {
+			return nil, errNoGatewayID
+		}
+		address, _ := flagSet.GetString("mqtt-address")
+		if address == "" {
+			hostPort, err := simulateGatewayServerAddress("1882")
+			if err != nil {
+				return nil, err
+			}
+			address = fmt.Sprintf("tcp://%s", hostPort)
+		}
+		return simulator.ConnectMQTT(ctx, address, ids, apiKey)
+	case "basic-station":
+		address, _ := flagSet.GetString("basic-station-address")
+		if address == "" {
+			hostPort, err := simulateGatewayServerAddress("1887")
+			if err != nil {
+				return nil, err
+			}
+			address = fmt.Sprintf("ws://%s", hostPort)
+		}
+		bandID, _ := flagSet.GetString("band-id")
+		return simulator.ConnectBasicStation(ctx, address, ids, bandID, apiKey)
+	default:
+		return nil, errSimulateTransport.WithAttributes("transport", transport)
+	}
+}
+
+func newSimulatedDevices(flagSet *pflag.FlagSet) ([]*simulator.Device, error) {
+	bandID, _ := flagSet.GetString("band-id")
+	phy, err := band.GetByID(bandID)
+	if err != nil {
+		return nil, err
+	}
+	devs, err := getSimulatedDevices(flagSet)
+	if err != nil {
+		return nil, err
+	}
+
+	var frmPayload []byte
+	if frmPayloadHex, _ := flagSet.GetString("frm-payload"); frmPayloadHex != "" {
+		if frmPayload, err = hex.DecodeString(frmPayloadHex); err != nil {
+			return nil, err
+		}
+	}
+	uplinks, _ := flagSet.GetInt("uplinks")
+	interval, _ := flagSet.GetDuration("interval")
+	rxTimeout, _ := flagSet.GetDuration("rx-timeout")
+	fPort, _ := flagSet.GetUint32("f-port")
+	confirmed, _ := flagSet.GetBool("confirmed")
+	adr, _ := flagSet.GetBool("adr")
+	rssi, _ := flagSet.GetFloat32("rssi")
+	snr, _ := flagSet.GetFloat32("snr")
+	battery, _ := flagSet.GetUint32("battery")
+	stateDir, _ := flagSet.GetString("state-dir")
+	if stateDir != "" {
+		if err := os.MkdirAll(stateDir, 0700); err != nil {
+			return nil, err
+		}
+	}
+
+	devices := make([]*simulator.Device, 0, len(devs))
+	for _, dev := range devs {
+		phy, err := phy.Version(dev.LoRaWANPHYVersion)
+		if err != nil {
+			return nil, err
+		}
+		drIdx := phy.UplinkChannels[0].MaxDataRate
+		if i, _ := flagSet.GetInt("data-rate-index"); i >= 0 {
+			drIdx = ttnpb.DataRateIndex(i)
+		}
+		conf := simulator.DeviceConfig{
+			Uplinks:       uplinks,
+			Interval:      interval,
+			RxTimeout:     rxTimeout,
+			FPort:         fPort,
+			FRMPayload:    frmPayload,
+			Confirmed:     confirmed,
+			ADR:           adr,
+			DataRateIndex: drIdx,
+			RSSI:          rssi,
+			SNR:           snr,
+			Battery:       battery,
+		}
+		if stateDir != "" {
+			if dev.DevEUI == nil {
+				return nil, errNoEndDeviceEUI
+			}
+			if err := loadSimulatedDevice(stateDir, dev); err != nil {
+				return nil, err
+			}
+			conf.Save = saveSimulatedDevice(stateDir)
+		}
+		device, err := simulator.NewDevice(dev, phy, conf)
+		if err != nil {
+			return nil, err
+		}
+		devices = append(devices, device)
+	}
+	return devices, nil
+}
+
+var simulateDeviceCommand = &cobra.Command{
+	Use:   "device",
+	Short: "Simulate end devices that join and send uplinks (EXPERIMENTAL)",
+	Long: `Simulate end devices that join and send uplinks (EXPERIMENTAL)
+
+The devices are read from stdin (JSON end devices with root keys) or
+configured with the --join-eui, --dev-eui, --app-key and --nwk-key flags.
+The devices perform OTAA if they have no session, send uplinks every interval
+and handle downlinks including LinkADRReq, DevStatusReq and RxParamSetupReq.
+When a state directory is set, the device state is persisted so that the
+simulation can be continued with the same session later.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		devices, err := newSimulatedDevices(cmd.Flags())
+		if err != nil {
+			return err
+		}
+		interval, _ := cmd.Flags().GetDuration("interval")
+
+		gtwID, err := getGatewayID(cmd.Flags(), nil, false)
+		if err != nil {
+			return err
+		}
+		gtw, err := connectSimulatedGateway(cmd.Flags(), *gtwID)
+		if err != nil {
+			return err
+		}
+		dispatched := make(chan int)
+		go func() {
+			dispatched <- simulator.Dispatch(gtw, devices)
+		}()
+
+		logger.WithField("devices", len(devices)).Info("Start simulation")
+		errs := make([]error, len(devices))
+		var wg sync.WaitGroup
+		for i, device := range devices {
+			wg.Add(1)
+			go func(i int, device *simulator.Device) {
+				defer wg.Done()
+				if len(devices) > 1 && interval > 0 {
+					// Spread the uplinks of the devices over the interval.
+					select {
+					case <-ctx.Done():
+						return
+					case <-time.After(time.Duration(random.Intn(int(interval)))):
+					}
+				}
+				errs[i] = device.Run(ctx, gtw.Send)
+			}(i, device)
+		}
+		wg.Wait()
+		gtw.Close()
+		if unhandled := <-dispatched; unhandled > 0 {
+			logger.WithField("count", unhandled).Warn("Received downlinks that were not handled by any device")
+		}
+
+		results := make([]simulator.Result, len(devices))
+		for i, device := range devices {
+			results[i] = device.Result()
+			if errs[i] != nil && errs[i] != ctx.Err() {
+				results[i].Error = errs[i].Error()
+			}
+		}
+		return io.Write(os.Stdout, config.OutputFormat, results)
+	},
+}
+
+func init() {
+	simulateDeviceCommand.Flags().AddFlagSet(gatewayIDFlags())
+	simulateDeviceCommand.Flags().AddFlagSet(simulateGatewayFlags())
+	simulateDeviceCommand.Flags().AddFlagSet(simulateDeviceFlags())
+	simulateCommand.AddCommand(simulateDeviceCommand)
+}
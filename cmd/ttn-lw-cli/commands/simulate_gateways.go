@@ -0,0 +1,208 @@
+// Copyright © 2026 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"go.thethings.network/lorawan-stack/cmd/ttn-lw-cli/internal/api"
+	"go.thethings.network/lorawan-stack/cmd/ttn-lw-cli/internal/io"
+	"go.thethings.network/lorawan-stack/cmd/ttn-lw-cli/internal/simulator"
+	"go.thethings.network/lorawan-stack/pkg/band"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/log"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/types"
+)
+
+func simulateGatewaysFlags() *pflag.FlagSet {
+	flagSet := &pflag.FlagSet{}
+	flagSet.Int("gateways", 10, "number of gateways to simulate")
+	flagSet.String("gateway-id-prefix", "simulated-gateway", "prefix of the gateway IDs; gateway IDs are the prefix followed by a sequence number starting at 1")
+	flagSet.String("gateway-eui-base", "", "EUI of the first gateway; the EUIs of the other gateways are incremented (required for udp and basic-station)")
+	flagSet.Duration("duration", time.Minute, "duration during which uplinks are generated")
+	flagSet.Duration("grace", 10*time.Second, "how long to wait for events and downlinks after the last uplink")
+	flagSet.Float64("uplink-rate", 1, "number of generated uplinks per second over all gateways (0 is only devices from stdin)")
+	flagSet.Int("payload-size", 20, "size of the application payload of generated uplinks, which determines the airtime with the data rate")
+	flagSet.Int("uplink-data-rate-index", -1, "data rate index of generated uplinks, which determines the airtime with the payload size (default is the maximum data rate of the first channel)")
+	flagSet.Int("duplicates", 1, "number of gateways that receive each uplink")
+	flagSet.Duration("duplicate-delay", 20*time.Millisecond, "maximum delay between the receptions of the same uplink by different gateways")
+	flagSet.Bool("events", true, "subscribe to the events of the gateways to measure latency and downlink delivery (requires rights to read gateway traffic)")
+	return flagSet
+}
+
+func getSimulatedGatewayIDs(flagSet *pflag.FlagSet) ([]ttnpb.GatewayIdentifiers, error) {
+	n, _ := flagSet.GetInt("gateways")
+	prefix, _ := flagSet.GetString("gateway-id-prefix")
+	var euiBase uint64
+	euiBaseHex, _ := flagSet.GetString("gateway-eui-base")
+	if euiBaseHex != "" {
+		var eui types.EUI64
+		if err := eui.UnmarshalText([]byte(euiBaseHex)); err != nil {
+			return nil, err
+		}
+		euiBase = binary.BigEndian.Uint64(eui[:])
+	}
+	ids := make([]ttnpb.GatewayIdentifiers, n)
+	for i := range ids {
+		ids[i].GatewayID = fmt.Sprintf("%s-%d", prefix, i+1)
+		if euiBaseHex != "" {
+			var eui types.EUI64
+			binary.BigEndian.PutUint64(eui[:], euiBase+uint64(i))
+			ids[i].EUI = &eui
+		}
+		if err := ids[i].ValidateFields("gateway_id"); err != nil {
+			return nil, err
+		}
+	}
+	return ids, nil
+}
+
+var simulateGatewaysCommand = &cobra.Command{
+	Use:   "gateways",
+	Short: "Generate load with simulated gateways (EXPERIMENTAL)",
+	Long: `Generate load with simulated gateways (EXPERIMENTAL)
+
+The gateways connect to the Gateway Server with gRPC, UDP, MQTT or LoRa Basics
+Station and send uplinks at the configured rate. Each uplink is received by
+the configured number of gateways with a random delay between the duplicates.
+Generated uplinks have a random DevAddr and MIC, so they are dropped by the
+Network Server. To generate downlink traffic, pass end devices on stdin; these
+are simulated as with the device command and their uplinks are sent through
+the gateways as well.
+
+The gateways must be registered. When subscribing to the events of the
+gateways, the latency between the gateways and the Gateway Server, the latency
+of the Network Server and the downlink delivery rate are measured. The
+gateway latency is only accurate if the clocks are synchronized.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		bandID, _ := cmd.Flags().GetString("band-id")
+		phy, err := band.GetByID(bandID)
+		if err != nil {
+			return err
+		}
+		ids, err := getSimulatedGatewayIDs(cmd.Flags())
+		if err != nil {
+			return err
+		}
+		var devices []*simulator.Device
+		if devEUIHex, _ := cmd.Flags().GetString("dev-eui"); inputDecoder != nil || devEUIHex != "" {
+			if devices, err = newSimulatedDevices(cmd.Flags()); err != nil {
+				return err
+			}
+		}
+
+		duration, _ := cmd.Flags().GetDuration("duration")
+		grace, _ := cmd.Flags().GetDuration("grace")
+		uplinkRate, _ := cmd.Flags().GetFloat64("uplink-rate")
+		payloadSize, _ := cmd.Flags().GetInt("payload-size")
+		duplicates, _ := cmd.Flags().GetInt("duplicates")
+		duplicateDelay, _ := cmd.Flags().GetDuration("duplicate-delay")
+		rssi, _ := cmd.Flags().GetFloat32("rssi")
+		snr, _ := cmd.Flags().GetFloat32("snr")
+		drIdx := phy.UplinkChannels[0].MaxDataRate
+		if i, _ := cmd.Flags().GetInt("uplink-data-rate-index"); i >= 0 {
+			drIdx = ttnpb.DataRateIndex(i)
+		}
+
+		gateways := make([]simulator.Gateway, 0, len(ids))
+		closeGateways := func() {
+			for _, gtw := range gateways {
+				gtw.Close()
+			}
+		}
+		for _, gtwIDs := range ids {
+			gtw, err := connectSimulatedGateway(cmd.Flags(), gtwIDs)
+			if err != nil {
+				closeGateways()
+				return err
+			}
+			gateways = append(gateways, gtw)
+		}
+		logger.WithField("gateways", len(gateways)).Info("Connected gateways")
+
+		load, err := simulator.NewLoad(gateways, ids, phy, simulator.LoadConfig{
+			Duration:       duration,
+			Grace:          grace,
+			UplinkRate:     uplinkRate,
+			PayloadSize:    payloadSize,
+			DataRateIndex:  drIdx,
+			Duplicates:     duplicates,
+			DuplicateDelay: duplicateDelay,
+			RSSI:           rssi,
+			SNR:            snr,
+		})
+		if err != nil {
+			closeGateways()
+			return err
+		}
+
+		if withEvents, _ := cmd.Flags().GetBool("events"); withEvents {
+			gs, err := api.Dial(ctx, config.GatewayServerGRPCAddress)
+			if err != nil {
+				closeGateways()
+				return err
+			}
+			entityIDs := make([]*ttnpb.EntityIdentifiers, len(ids))
+			for i, gtwIDs := range ids {
+				entityIDs[i] = gtwIDs.EntityIdentifiers()
+			}
+			streamCtx, cancel := context.WithCancel(ctx)
+			defer cancel()
+			stream, err := ttnpb.NewEventsClient(gs).Stream(streamCtx, &ttnpb.StreamEventsRequest{
+				Identifiers: entityIDs,
+			})
+			if err != nil {
+				closeGateways()
+				return err
+			}
+			go func() {
+				for {
+					evt, err := stream.Recv()
+					if err != nil {
+						if !errors.IsCanceled(err) {
+							logger.WithError(err).Warn("Event stream closed")
+						}
+						return
+					}
+					load.HandleEvent(evt)
+				}
+			}()
+		}
+
+		logger.WithFields(log.Fields(
+			"duration", duration,
+			"uplink_rate", uplinkRate,
+			"devices", len(devices),
+		)).Info("Start load")
+		if err := load.Run(ctx, devices); err != nil {
+			return err
+		}
+		return io.Write(os.Stdout, config.OutputFormat, load.Result())
+	},
+}
+
+func init() {
+	simulateGatewaysCommand.Flags().AddFlagSet(simulateGatewaysFlags())
+	simulateGatewaysCommand.Flags().AddFlagSet(simulateGatewayFlags())
+	simulateGatewaysCommand.Flags().AddFlagSet(simulateDeviceFlags())
+	simulateCommand.AddCommand(simulateGatewaysCommand)
+}
@@ -18,6 +18,7 @@ import (
 	"os"
 	"strings"
 
+	"github.com/gogo/protobuf/types"
 	"github.com/spf13/cobra"
 	"go.thethings.network/lorawan-stack/cmd/ttn-lw-cli/internal/api"
 	"go.thethings.network/lorawan-stack/cmd/ttn-lw-cli/internal/io"
@@ -93,6 +94,11 @@ var (
 				return errNoAPIKeyRights
 			}
 
+			var options ttnpb.APIKey
+			if _, err := getAPIKeyOptions(cmd.Flags(), &options); err != nil {
+				return err
+			}
+
 			is, err := api.Dial(ctx, config.IdentityServerGRPCAddress)
 			if err != nil {
 				return err
@@ -101,6 +107,8 @@ var (
 				UserIdentifiers: *usrID,
 				Name:            name,
 				Rights:          rights,
+				ExpiresAt:       options.ExpiresAt,
+				Restrictions:    options.Restrictions,
 			})
 			if err != nil {
 				return err
@@ -134,17 +142,24 @@ var (
 				return errNoAPIKeyRights
 			}
 
+			apiKey := ttnpb.APIKey{
+				ID:     id,
+				Name:   name,
+				Rights: rights,
+			}
+			paths, err := getAPIKeyOptions(cmd.Flags(), &apiKey)
+			if err != nil {
+				return err
+			}
+
 			is, err := api.Dial(ctx, config.IdentityServerGRPCAddress)
 			if err != nil {
 				return err
 			}
 			_, err = ttnpb.NewUserAccessClient(is).UpdateAPIKey(ctx, &ttnpb.UpdateUserAPIKeyRequest{
 				UserIdentifiers: *usrID,
-				APIKey: ttnpb.APIKey{
-					ID:     id,
-					Name:   name,
-					Rights: rights,
-				},
+				APIKey:          apiKey,
+				FieldMask:       types.FieldMask{Paths: append([]string{"name", "rights"}, paths...)},
 			})
 			if err != nil {
 				return err
@@ -204,10 +219,12 @@ func init() {
 	userAPIKeys.AddCommand(userAPIKeysList)
 	userAPIKeysCreate.Flags().String("name", "", "")
 	userAPIKeysCreate.Flags().AddFlagSet(userRightsFlags)
+	userAPIKeysCreate.Flags().AddFlagSet(apiKeyOptionsFlags())
 	userAPIKeys.AddCommand(userAPIKeysCreate)
 	userAPIKeysUpdate.Flags().String("api-key-id", "", "")
 	userAPIKeysUpdate.Flags().String("name", "", "")
 	userAPIKeysUpdate.Flags().AddFlagSet(userRightsFlags)
+	userAPIKeysUpdate.Flags().AddFlagSet(apiKeyOptionsFlags())
 	userAPIKeys.AddCommand(userAPIKeysUpdate)
 	userAPIKeysDelete.Flags().String("api-key-id", "", "")
 	userAPIKeys.AddCommand(userAPIKeysDelete)
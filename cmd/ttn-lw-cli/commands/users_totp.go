@@ -0,0 +1,170 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"os"
+
+	"github.com/spf13/cobra"
+	"go.thethings.network/lorawan-stack/cmd/ttn-lw-cli/internal/api"
+	"go.thethings.network/lorawan-stack/cmd/ttn-lw-cli/internal/io"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+)
+
+var (
+	usersTOTPCommand = &cobra.Command{
+		Use:   "totp",
+		Short: "Manage TOTP two-factor authentication of users",
+	}
+	usersTOTPGetCommand = &cobra.Command{
+		Use:   "get [user-id]",
+		Short: "Get the TOTP status of a user",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			usrID := getUserID(cmd.Flags(), args)
+			if usrID == nil {
+				return errNoUserID
+			}
+
+			is, err := api.Dial(ctx, config.IdentityServerGRPCAddress)
+			if err != nil {
+				return err
+			}
+			res, err := ttnpb.NewUserTOTPRegistryClient(is).Get(ctx, usrID)
+			if err != nil {
+				return err
+			}
+
+			return io.Write(os.Stdout, config.OutputFormat, res)
+		},
+	}
+	usersTOTPEnrollCommand = &cobra.Command{
+		Use:   "enroll [user-id]",
+		Short: "Enroll a new TOTP secret for a user",
+		Long: `Enroll a new TOTP secret for a user.
+
+Configure the returned secret (or URI) in an authenticator app, and enable
+TOTP with a code from the app using the "enable" command.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			usrID := getUserID(cmd.Flags(), args)
+			if usrID == nil {
+				return errNoUserID
+			}
+
+			is, err := api.Dial(ctx, config.IdentityServerGRPCAddress)
+			if err != nil {
+				return err
+			}
+			res, err := ttnpb.NewUserTOTPRegistryClient(is).Enroll(ctx, usrID)
+			if err != nil {
+				return err
+			}
+
+			return io.Write(os.Stdout, config.OutputFormat, res)
+		},
+	}
+	usersTOTPEnableCommand = &cobra.Command{
+		Use:   "enable [user-id]",
+		Short: "Enable TOTP for a user",
+		Long: `Enable TOTP for a user.
+
+This returns the recovery codes of the user. Store them in a safe place,
+as they are not shown again.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			usrID := getUserID(cmd.Flags(), args)
+			if usrID == nil {
+				return errNoUserID
+			}
+			code, _ := cmd.Flags().GetString("code")
+
+			is, err := api.Dial(ctx, config.IdentityServerGRPCAddress)
+			if err != nil {
+				return err
+			}
+			res, err := ttnpb.NewUserTOTPRegistryClient(is).Enable(ctx, &ttnpb.EnableUserTOTPRequest{
+				UserIdentifiers: *usrID,
+				Code:            code,
+			})
+			if err != nil {
+				return err
+			}
+
+			return io.Write(os.Stdout, config.OutputFormat, res)
+		},
+	}
+	usersTOTPDisableCommand = &cobra.Command{
+		Use:   "disable [user-id]",
+		Short: "Disable TOTP for a user",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			usrID := getUserID(cmd.Flags(), args)
+			if usrID == nil {
+				return errNoUserID
+			}
+			code, _ := cmd.Flags().GetString("code")
+
+			is, err := api.Dial(ctx, config.IdentityServerGRPCAddress)
+			if err != nil {
+				return err
+			}
+			_, err = ttnpb.NewUserTOTPRegistryClient(is).Disable(ctx, &ttnpb.DisableUserTOTPRequest{
+				UserIdentifiers: *usrID,
+				Code:            code,
+			})
+
+			return err
+		},
+	}
+	usersTOTPGenerateRecoveryCodesCommand = &cobra.Command{
+		Use:   "generate-recovery-codes [user-id]",
+		Short: "Generate new recovery codes for a user",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			usrID := getUserID(cmd.Flags(), args)
+			if usrID == nil {
+				return errNoUserID
+			}
+			code, _ := cmd.Flags().GetString("code")
+
+			is, err := api.Dial(ctx, config.IdentityServerGRPCAddress)
+			if err != nil {
+				return err
+			}
+			res, err := ttnpb.NewUserTOTPRegistryClient(is).GenerateRecoveryCodes(ctx, &ttnpb.GenerateUserRecoveryCodesRequest{
+				UserIdentifiers: *usrID,
+				Code:            code,
+			})
+			if err != nil {
+				return err
+			}
+
+			return io.Write(os.Stdout, config.OutputFormat, res)
+		},
+	}
+)
+
+func init() {
+	usersTOTPGetCommand.Flags().AddFlagSet(userIDFlags())
+	usersTOTPCommand.AddCommand(usersTOTPGetCommand)
+	usersTOTPEnrollCommand.Flags().AddFlagSet(userIDFlags())
+	usersTOTPCommand.AddCommand(usersTOTPEnrollCommand)
+	usersTOTPEnableCommand.Flags().AddFlagSet(userIDFlags())
+	usersTOTPEnableCommand.Flags().String("code", "", "current TOTP code")
+	usersTOTPCommand.AddCommand(usersTOTPEnableCommand)
+	usersTOTPDisableCommand.Flags().AddFlagSet(userIDFlags())
+	usersTOTPDisableCommand.Flags().String("code", "", "current TOTP code or unused recovery code")
+	usersTOTPCommand.AddCommand(usersTOTPDisableCommand)
+	usersTOTPGenerateRecoveryCodesCommand.Flags().AddFlagSet(userIDFlags())
+	usersTOTPGenerateRecoveryCodesCommand.Flags().String("code", "", "current TOTP code")
+	usersTOTPCommand.AddCommand(usersTOTPGenerateRecoveryCodesCommand)
+	usersCommand.AddCommand(usersTOTPCommand)
+}
@@ -0,0 +1,735 @@
+// Copyright © 2026 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package simulator
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"sync"
+	"time"
+
+	"go.thethings.network/lorawan-stack/pkg/band"
+	"go.thethings.network/lorawan-stack/pkg/crypto"
+	"go.thethings.network/lorawan-stack/pkg/encoding/lorawan"
+	"go.thethings.network/lorawan-stack/pkg/random"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/types"
+)
+
+const (
+	// adrAckLimit is the number of uplinks without downlink after which the device sets ADRAckReq.
+	adrAckLimit = 64
+	// adrAckDelay is the number of uplinks after adrAckLimit after which the device backs off.
+	adrAckDelay = 32
+	// maxFOptsLen is the maximum length of the FOpts field.
+	maxFOptsLen = 15
+)
+
+// DeviceConfig configures the behavior of a simulated end device.
+type DeviceConfig struct {
+	// Uplinks is the number of data uplinks to send. If zero, the device sends uplinks until the context is done.
+	Uplinks int
+	// Interval is the interval between uplinks.
+	Interval time.Duration
+	// RxTimeout is how long to wait for downlinks after the last uplink.
+	RxTimeout time.Duration
+	// FPort is the FPort of data uplinks.
+	FPort uint32
+	// FRMPayload is the application payload of data uplinks.
+	FRMPayload []byte
+	// Confirmed indicates whether data uplinks are confirmed.
+	Confirmed bool
+	// ADR indicates whether the device enables adaptive data rate.
+	ADR bool
+	// DataRateIndex is the initial data rate index.
+	DataRateIndex ttnpb.DataRateIndex
+	// RSSI is the RSSI reported in the uplink metadata.
+	RSSI float32
+	// SNR is the SNR reported in the uplink metadata and the margin reported in DevStatusAns.
+	SNR float32
+	// Battery is the battery level reported in DevStatusAns.
+	Battery uint32
+	// Save is called with the device state when the session changes. It may be nil.
+	Save func(*ttnpb.EndDevice) error
+}
+
+// Result contains the results of a simulated end device.
+type Result struct {
+	DeviceID           string              `json:"device_id,omitempty"`
+	DevEUI             *types.EUI64        `json:"dev_eui,omitempty"`
+	DevAddr            *types.DevAddr      `json:"dev_addr,omitempty"`
+	JoinRequests       uint32              `json:"join_requests"`
+	JoinAccepts        uint32              `json:"join_accepts"`
+	Uplinks            uint32              `json:"uplinks"`
+	ConfirmedUplinks   uint32              `json:"confirmed_uplinks"`
+	Acknowledgments    uint32              `json:"acknowledgments"`
+	Downlinks          uint32              `json:"downlinks"`
+	ConfirmedDownlinks uint32              `json:"confirmed_downlinks"`
+	MACCommands        map[string]uint32   `json:"mac_commands,omitempty"`
+	LastFCntUp         uint32              `json:"last_f_cnt_up"`
+	DataRateIndex      ttnpb.DataRateIndex `json:"data_rate_index"`
+	TxPowerIndex       uint32              `json:"tx_power_index"`
+	Error              string              `json:"error,omitempty"`
+}
+
+// Device is a simulated end device.
+// The state of the device, including the session and MAC parameters, is kept in an end device.
+type Device struct {
+	phy    band.Band
+	config DeviceConfig
+	joined chan struct{}
+
+	mu              sync.Mutex
+	dev             *ttnpb.EndDevice
+	result          Result
+	joinPending     bool
+	devNonce        types.DevNonce
+	ackPending      bool
+	confFCntDown    uint32
+	confirmedFCntUp *uint32
+	adrAckCnt       uint32
+}
+
+// NewDevice returns a new simulated end device.
+// If the end device has no session, the device performs OTAA before sending data uplinks.
+func NewDevice(dev *ttnpb.EndDevice, phy band.Band, config DeviceConfig) (*Device, error) {
+	if config.Interval <= 0 {
+		return nil, errInterval
+	}
+	if dev.DevEUI == nil || dev.DevEUI.IsZero() {
+		return nil, errNoDevEUI
+	}
+	if ses := dev.Session; ses != nil {
+		if dev.LoRaWANVersion.Compare(ttnpb.MAC_V1_1) < 0 {
+			// LoRaWAN 1.0.x devices use the same NwkSKey for all network session keys.
+			if ses.SNwkSIntKey == nil {
+				ses.SNwkSIntKey = ses.FNwkSIntKey
+			}
+			if ses.NwkSEncKey == nil {
+				ses.NwkSEncKey = ses.FNwkSIntKey
+			}
+		}
+		if ses.AppSKey.GetKey() == nil || ses.FNwkSIntKey.GetKey() == nil ||
+			ses.SNwkSIntKey.GetKey() == nil || ses.NwkSEncKey.GetKey() == nil {
+			return nil, errNoSessionKeys
+		}
+	} else {
+		if !dev.SupportsJoin {
+			return nil, errNoSession
+		}
+		if dev.JoinEUI == nil {
+			return nil, errNoJoinEUI
+		}
+		if dev.GetRootKeys().GetAppKey().GetKey() == nil ||
+			dev.LoRaWANVersion.Compare(ttnpb.MAC_V1_1) >= 0 && dev.GetRootKeys().GetNwkKey().GetKey() == nil {
+			return nil, errNoRootKeys
+		}
+	}
+	d := &Device{
+		phy:    phy,
+		config: config,
+		joined: make(chan struct{}, 1),
+		dev:    dev,
+		result: Result{
+			DeviceID:    dev.DeviceID,
+			DevEUI:      dev.DevEUI,
+			DevAddr:     dev.DevAddr,
+			MACCommands: make(map[string]uint32),
+		},
+	}
+	if dev.MACState == nil {
+		d.resetMACState()
+	}
+	if dev.Session != nil {
+		d.result.DevAddr = &dev.Session.DevAddr
+		d.result.LastFCntUp = dev.Session.LastFCntUp
+	}
+	return d, nil
+}
+
+func (d *Device) resetMACState() {
+	channels := make([]*ttnpb.MACParameters_Channel, 0, len(d.phy.UplinkChannels))
+	for i, ch := range d.phy.UplinkChannels {
+		channel := &ttnpb.MACParameters_Channel{
+			UplinkFrequency:   ch.Frequency,
+			DownlinkFrequency: ch.Frequency,
+			MinDataRateIndex:  ch.MinDataRate,
+			MaxDataRateIndex:  ch.MaxDataRate,
+			EnableUplink:      true,
+		}
+		if i < len(d.phy.DownlinkChannels) {
+			channel.DownlinkFrequency = d.phy.DownlinkChannels[i].Frequency
+		}
+		channels = append(channels, channel)
+	}
+	d.dev.MACState = &ttnpb.MACState{
+		CurrentParameters: ttnpb.MACParameters{
+			MaxEIRP:          d.phy.DefaultMaxEIRP,
+			ADRDataRateIndex: d.config.DataRateIndex,
+			ADRNbTrans:       1,
+			Rx1Delay:         ttnpb.RxDelay(d.phy.ReceiveDelay1 / time.Second),
+			Rx2DataRateIndex: d.phy.DefaultRx2Parameters.DataRateIndex,
+			Rx2Frequency:     d.phy.DefaultRx2Parameters.Frequency,
+			Channels:         channels,
+		},
+		DeviceClass:    ttnpb.CLASS_A,
+		LoRaWANVersion: d.dev.LoRaWANVersion,
+	}
+}
+
+// Result returns the current results of the device.
+func (d *Device) Result() Result {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	res := d.result
+	res.MACCommands = make(map[string]uint32, len(d.result.MACCommands))
+	for k, v := range d.result.MACCommands {
+		res.MACCommands[k] = v
+	}
+	res.DataRateIndex = d.dev.MACState.CurrentParameters.ADRDataRateIndex
+	res.TxPowerIndex = d.dev.MACState.CurrentParameters.ADRTxPowerIndex
+	return res
+}
+
+// Run sends uplinks until the configured number of data uplinks is sent or until the context is done.
+// If the device has no session, it sends a join-request first and it repeats the join-request every interval until a join-accept is received.
+func (d *Device) Run(ctx context.Context, send func(*ttnpb.UplinkMessage) error) error {
+	ticker := time.NewTicker(d.config.Interval)
+	defer func() { ticker.Stop() }()
+	for sent := 0; d.config.Uplinks == 0 || sent < d.config.Uplinks; {
+		msg, isData, err := d.nextUplink()
+		if err != nil {
+			return err
+		}
+		if err := send(msg); err != nil {
+			return err
+		}
+		if isData {
+			sent++
+			if sent == d.config.Uplinks {
+				break
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-d.joined:
+			ticker.Stop()
+			ticker = time.NewTicker(d.config.Interval)
+		case <-ticker.C:
+		}
+	}
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-time.After(d.config.RxTimeout):
+		return nil
+	}
+}
+
+func (d *Device) save() error {
+	if d.config.Save == nil {
+		return nil
+	}
+	return d.config.Save(d.dev)
+}
+
+func (d *Device) nextUplink() (*ttnpb.UplinkMessage, bool, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.dev.Session == nil {
+		msg, err := d.joinRequest()
+		return msg, false, err
+	}
+	msg, err := d.dataUplink()
+	if err != nil {
+		return nil, false, err
+	}
+	return msg, true, d.save()
+}
+
+func (d *Device) selectChannel() (int, *ttnpb.MACParameters_Channel, error) {
+	drIdx := d.dev.MACState.CurrentParameters.ADRDataRateIndex
+	var idxs []int
+	for i, ch := range d.dev.MACState.CurrentParameters.Channels {
+		if ch == nil || !ch.EnableUplink || ch.UplinkFrequency == 0 {
+			continue
+		}
+		if drIdx < ch.MinDataRateIndex || drIdx > ch.MaxDataRateIndex {
+			continue
+		}
+		idxs = append(idxs, i)
+	}
+	if len(idxs) == 0 {
+		return 0, nil, errNoChannel.WithAttributes("data_rate_index", drIdx)
+	}
+	i := idxs[random.Intn(len(idxs))]
+	return i, d.dev.MACState.CurrentParameters.Channels[i], nil
+}
+
+func (d *Device) uplinkMessage(rawPayload []byte, chIdx int, ch *ttnpb.MACParameters_Channel) (*ttnpb.UplinkMessage, error) {
+	drIdx := d.dev.MACState.CurrentParameters.ADRDataRateIndex
+	dr, ok := d.phy.DataRates[drIdx]
+	if !ok {
+		return nil, errDataRateIndex.WithAttributes("data_rate_index", drIdx)
+	}
+	now := time.Now()
+	timestamp := uint32(now.UnixNano() / 1000)
+	settings := ttnpb.TxSettings{
+		DataRate:      dr.Rate,
+		DataRateIndex: drIdx,
+		Frequency:     ch.UplinkFrequency,
+		Timestamp:     timestamp,
+		Time:          &now,
+	}
+	if dr.Rate.GetLoRa() != nil {
+		settings.CodingRate = "4/5"
+	}
+	return &ttnpb.UplinkMessage{
+		RawPayload: rawPayload,
+		Settings:   settings,
+		RxMetadata: []*ttnpb.RxMetadata{
+			{
+				Time:         &now,
+				Timestamp:    timestamp,
+				RSSI:         d.config.RSSI,
+				ChannelRSSI:  d.config.RSSI,
+				SNR:          d.config.SNR,
+				ChannelIndex: uint32(chIdx),
+			},
+		},
+	}, nil
+}
+
+// joinKey returns the root key that is used for the join-request MIC and join-accept encryption.
+func (d *Device) joinKey() types.AES128Key {
+	if d.dev.LoRaWANVersion.Compare(ttnpb.MAC_V1_1) >= 0 {
+		return *d.dev.RootKeys.NwkKey.Key
+	}
+	return *d.dev.RootKeys.AppKey.Key
+}
+
+func (d *Device) joinRequest() (*ttnpb.UplinkMessage, error) {
+	d.resetMACState()
+	chIdx, ch, err := d.selectChannel()
+	if err != nil {
+		return nil, err
+	}
+
+	if d.dev.LastDevNonce == 0 && d.dev.LoRaWANVersion.Compare(ttnpb.MAC_V1_1) < 0 {
+		// Devices without persisted state start at a random DevNonce, as LoRaWAN 1.0.x Join Servers reject used DevNonces.
+		d.dev.LastDevNonce = uint32(random.Intn(0xffff))
+	}
+	d.dev.LastDevNonce = (d.dev.LastDevNonce + 1) & 0xffff
+	binary.BigEndian.PutUint16(d.devNonce[:], uint16(d.dev.LastDevNonce))
+
+	msg := ttnpb.Message{
+		MHDR: ttnpb.MHDR{
+			MType: ttnpb.MType_JOIN_REQUEST,
+			Major: ttnpb.Major_LORAWAN_R1,
+		},
+		Payload: &ttnpb.Message_JoinRequestPayload{
+			JoinRequestPayload: &ttnpb.JoinRequestPayload{
+				JoinEUI:  *d.dev.JoinEUI,
+				DevEUI:   *d.dev.DevEUI,
+				DevNonce: d.devNonce,
+			},
+		},
+	}
+	buf, err := lorawan.MarshalMessage(msg)
+	if err != nil {
+		return nil, err
+	}
+	mic, err := crypto.ComputeJoinRequestMIC(d.joinKey(), buf)
+	if err != nil {
+		return nil, err
+	}
+	d.joinPending = true
+	d.result.JoinRequests++
+	return d.uplinkMessage(append(buf, mic[:]...), chIdx, ch)
+}
+
+func (d *Device) dataUplink() (*ttnpb.UplinkMessage, error) {
+	ses := d.dev.Session
+	macState := d.dev.MACState
+	params := &macState.CurrentParameters
+
+	if d.config.ADR && d.adrAckCnt >= adrAckLimit+adrAckDelay && (d.adrAckCnt-adrAckLimit)%adrAckDelay == 0 {
+		// Back off when the network does not respond: first restore the maximum transmission power,
+		// then lower the data rate and finally enable the default channels.
+		switch {
+		case params.ADRTxPowerIndex > 0:
+			params.ADRTxPowerIndex = 0
+		case params.ADRDataRateIndex > d.phy.UplinkChannels[0].MinDataRate:
+			params.ADRDataRateIndex--
+		default:
+			for i := range d.phy.UplinkChannels {
+				if i < len(params.Channels) && params.Channels[i] != nil {
+					params.Channels[i].EnableUplink = true
+				}
+			}
+		}
+	}
+
+	chIdx, ch, err := d.selectChannel()
+	if err != nil {
+		return nil, err
+	}
+
+	fCnt := ses.LastFCntUp
+	if fCnt > 0 || len(macState.RecentUplinks) > 0 {
+		fCnt++
+	}
+
+	var cmdBuf []byte
+	var sticky []*ttnpb.MACCommand
+	for _, cmd := range macState.QueuedResponses {
+		if cmdBuf, err = lorawan.DefaultMACCommands.AppendUplink(d.phy, cmdBuf, *cmd); err != nil {
+			return nil, err
+		}
+		switch cmd.CID {
+		case ttnpb.CID_RX_PARAM_SETUP, ttnpb.CID_RX_TIMING_SETUP:
+			// These answers are repeated in every uplink until a downlink is received.
+			sticky = append(sticky, cmd)
+		}
+	}
+	macState.QueuedResponses = sticky
+
+	fPort, frmPayload := d.config.FPort, d.config.FRMPayload
+	var fOpts []byte
+	if len(cmdBuf) > maxFOptsLen {
+		fPort, frmPayload = 0, cmdBuf
+	} else {
+		fOpts = cmdBuf
+	}
+	if len(fOpts) > 0 && macState.LoRaWANVersion.EncryptFOpts() {
+		if fOpts, err = crypto.EncryptUplink(*ses.NwkSEncKey.Key, ses.DevAddr, fCnt, fOpts); err != nil {
+			return nil, err
+		}
+	}
+	if len(frmPayload) > 0 {
+		key := *ses.AppSKey.Key
+		if fPort == 0 {
+			key = *ses.NwkSEncKey.Key
+		}
+		if frmPayload, err = crypto.EncryptUplink(key, ses.DevAddr, fCnt, frmPayload); err != nil {
+			return nil, err
+		}
+	}
+
+	msg := ttnpb.Message{
+		MHDR: ttnpb.MHDR{
+			MType: ttnpb.MType_UNCONFIRMED_UP,
+			Major: ttnpb.Major_LORAWAN_R1,
+		},
+		Payload: &ttnpb.Message_MACPayload{
+			MACPayload: &ttnpb.MACPayload{
+				FHDR: ttnpb.FHDR{
+					DevAddr: ses.DevAddr,
+					FCtrl: ttnpb.FCtrl{
+						ADR:       d.config.ADR,
+						ADRAckReq: d.config.ADR && d.adrAckCnt >= adrAckLimit,
+						Ack:       d.ackPending,
+					},
+					FCnt:  fCnt,
+					FOpts: fOpts,
+				},
+				FPort:      fPort,
+				FRMPayload: frmPayload,
+			},
+		},
+	}
+	if d.config.Confirmed {
+		msg.MType = ttnpb.MType_CONFIRMED_UP
+	}
+	buf, err := lorawan.MarshalMessage(msg)
+	if err != nil {
+		return nil, err
+	}
+	var mic [4]byte
+	if macState.LoRaWANVersion.Compare(ttnpb.MAC_V1_1) >= 0 {
+		var confFCnt uint32
+		if d.ackPending {
+			confFCnt = d.confFCntDown
+		}
+		mic, err = crypto.ComputeUplinkMIC(
+			*ses.SNwkSIntKey.Key,
+			*ses.FNwkSIntKey.Key,
+			confFCnt,
+			uint8(params.ADRDataRateIndex),
+			uint8(chIdx),
+			ses.DevAddr,
+			fCnt,
+			buf,
+		)
+	} else {
+		mic, err = crypto.ComputeLegacyUplinkMIC(*ses.FNwkSIntKey.Key, ses.DevAddr, fCnt, buf)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	up, err := d.uplinkMessage(append(buf, mic[:]...), chIdx, ch)
+	if err != nil {
+		return nil, err
+	}
+	ses.LastFCntUp = fCnt
+	macState.RecentUplinks = []*ttnpb.UplinkMessage{up}
+	d.ackPending = false
+	if d.config.ADR {
+		d.adrAckCnt++
+	}
+	if d.config.Confirmed {
+		d.confirmedFCntUp = &fCnt
+		d.result.ConfirmedUplinks++
+	} else {
+		d.confirmedFCntUp = nil
+	}
+	d.result.Uplinks++
+	d.result.LastFCntUp = fCnt
+	return up, nil
+}
+
+// HandleDownlink handles the downlink message if it is intended for the device.
+// It returns whether the device handled the downlink message.
+func (d *Device) HandleDownlink(msg *ttnpb.DownlinkMessage) bool {
+	if len(msg.RawPayload) < 5 {
+		return false
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	switch ttnpb.MType(msg.RawPayload[0] >> 5) {
+	case ttnpb.MType_JOIN_ACCEPT:
+		if !d.joinPending {
+			return false
+		}
+		return d.handleJoinAccept(msg.RawPayload)
+	case ttnpb.MType_UNCONFIRMED_DOWN, ttnpb.MType_CONFIRMED_DOWN:
+		if d.dev.Session == nil {
+			return false
+		}
+		return d.handleDataDownlink(msg.RawPayload)
+	default:
+		return false
+	}
+}
+
+func (d *Device) handleJoinAccept(rawPayload []byte) bool {
+	key := d.joinKey()
+	payload, err := crypto.DecryptJoinAccept(key, rawPayload[1:])
+	if err != nil {
+		return false
+	}
+	joinAcceptBytes := payload[:len(payload)-4]
+	joinAccept := &ttnpb.JoinAcceptPayload{}
+	if err := lorawan.UnmarshalJoinAcceptPayload(joinAcceptBytes, joinAccept); err != nil {
+		return false
+	}
+
+	optNeg := d.dev.LoRaWANVersion.Compare(ttnpb.MAC_V1_1) >= 0 && joinAccept.OptNeg
+	var expectedMIC [4]byte
+	if optNeg {
+		expectedMIC, err = crypto.ComputeJoinAcceptMIC(
+			crypto.DeriveJSIntKey(key, *d.dev.DevEUI),
+			0xff,
+			*d.dev.JoinEUI,
+			d.devNonce,
+			append([]byte{rawPayload[0]}, joinAcceptBytes...),
+		)
+	} else {
+		expectedMIC, err = crypto.ComputeLegacyJoinAcceptMIC(key, append([]byte{rawPayload[0]}, joinAcceptBytes...))
+	}
+	if err != nil || !bytes.Equal(payload[len(payload)-4:], expectedMIC[:]) {
+		return false
+	}
+
+	ses := &ttnpb.Session{
+		DevAddr: joinAccept.DevAddr,
+	}
+	if optNeg {
+		appSKey := crypto.DeriveAppSKey(*d.dev.RootKeys.AppKey.Key, joinAccept.JoinNonce, *d.dev.JoinEUI, d.devNonce)
+		fNwkSIntKey := crypto.DeriveFNwkSIntKey(key, joinAccept.JoinNonce, *d.dev.JoinEUI, d.devNonce)
+		sNwkSIntKey := crypto.DeriveSNwkSIntKey(key, joinAccept.JoinNonce, *d.dev.JoinEUI, d.devNonce)
+		nwkSEncKey := crypto.DeriveNwkSEncKey(key, joinAccept.JoinNonce, *d.dev.JoinEUI, d.devNonce)
+		ses.SessionKeys = ttnpb.SessionKeys{
+			AppSKey:     &ttnpb.KeyEnvelope{Key: &appSKey},
+			FNwkSIntKey: &ttnpb.KeyEnvelope{Key: &fNwkSIntKey},
+			SNwkSIntKey: &ttnpb.KeyEnvelope{Key: &sNwkSIntKey},
+			NwkSEncKey:  &ttnpb.KeyEnvelope{Key: &nwkSEncKey},
+		}
+	} else {
+		appSKey := crypto.DeriveLegacyAppSKey(key, joinAccept.JoinNonce, joinAccept.NetID, d.devNonce)
+		nwkSKey := crypto.DeriveLegacyNwkSKey(key, joinAccept.JoinNonce, joinAccept.NetID, d.devNonce)
+		ses.SessionKeys = ttnpb.SessionKeys{
+			AppSKey:     &ttnpb.KeyEnvelope{Key: &appSKey},
+			FNwkSIntKey: &ttnpb.KeyEnvelope{Key: &nwkSKey},
+			SNwkSIntKey: &ttnpb.KeyEnvelope{Key: &nwkSKey},
+			NwkSEncKey:  &ttnpb.KeyEnvelope{Key: &nwkSKey},
+		}
+	}
+	d.dev.Session = ses
+	d.dev.DevAddr = &ses.DevAddr
+
+	macState := d.dev.MACState
+	if d.dev.LoRaWANVersion.Compare(ttnpb.MAC_V1_1) >= 0 && !optNeg {
+		// LoRaWAN 1.1 devices fall back to LoRaWAN 1.0.x if the network does not support LoRaWAN 1.1.
+		macState.LoRaWANVersion = ttnpb.MAC_V1_0_3
+	}
+	params := &macState.CurrentParameters
+	params.Rx1DataRateOffset = joinAccept.Rx1DROffset
+	params.Rx2DataRateIndex = joinAccept.Rx2DR
+	if joinAccept.RxDelay != 0 {
+		params.Rx1Delay = joinAccept.RxDelay
+	}
+	if cfList := joinAccept.CFList; cfList != nil {
+		switch cfList.Type {
+		case ttnpb.CFListType_FREQUENCIES:
+			for i, freq := range cfList.Freq {
+				idx := len(d.phy.UplinkChannels) + i
+				for len(params.Channels) <= idx {
+					params.Channels = append(params.Channels, nil)
+				}
+				params.Channels[idx] = &ttnpb.MACParameters_Channel{
+					UplinkFrequency:   uint64(freq) * 100,
+					DownlinkFrequency: uint64(freq) * 100,
+					MinDataRateIndex:  d.phy.UplinkChannels[0].MinDataRate,
+					MaxDataRateIndex:  d.phy.UplinkChannels[0].MaxDataRate,
+					EnableUplink:      freq != 0,
+				}
+			}
+		case ttnpb.CFListType_CHANNEL_MASKS:
+			for i, enabled := range cfList.ChMasks {
+				if i < len(params.Channels) && params.Channels[i] != nil {
+					params.Channels[i].EnableUplink = enabled
+				}
+			}
+		}
+	}
+
+	d.joinPending = false
+	d.ackPending = false
+	d.confirmedFCntUp = nil
+	d.adrAckCnt = 0
+	d.result.JoinAccepts++
+	d.result.DevAddr = d.dev.DevAddr
+	d.result.LastFCntUp = 0
+	select {
+	case d.joined <- struct{}{}:
+	default:
+	}
+	if err := d.save(); err != nil {
+		d.result.Error = err.Error()
+	}
+	return true
+}
+
+// fullFCnt returns the 32-bit frame counter given the last frame counter and the 16 least significant bits.
+func fullFCnt(last, fCnt uint32) uint32 {
+	full := last&^0xffff | fCnt&0xffff
+	if full < last {
+		full += 0x10000
+	}
+	return full
+}
+
+func (d *Device) handleDataDownlink(rawPayload []byte) bool {
+	msg := &ttnpb.Message{}
+	if err := lorawan.UnmarshalMessage(rawPayload, msg); err != nil {
+		return false
+	}
+	pld := msg.GetMACPayload()
+	ses := d.dev.Session
+	if pld == nil || pld.DevAddr != ses.DevAddr {
+		return false
+	}
+	macState := d.dev.MACState
+
+	isNwk := pld.FPort == 0 || macState.LoRaWANVersion.Compare(ttnpb.MAC_V1_1) < 0
+	var fCnt uint32
+	if isNwk {
+		fCnt = fullFCnt(ses.LastNFCntDown, pld.FCnt)
+	} else {
+		fCnt = fullFCnt(ses.LastAFCntDown, pld.FCnt)
+	}
+
+	var expectedMIC [4]byte
+	var err error
+	if macState.LoRaWANVersion.Compare(ttnpb.MAC_V1_1) >= 0 {
+		var confFCnt uint32
+		if pld.Ack && d.confirmedFCntUp != nil {
+			confFCnt = *d.confirmedFCntUp
+		}
+		expectedMIC, err = crypto.ComputeDownlinkMIC(*ses.SNwkSIntKey.Key, ses.DevAddr, confFCnt, fCnt, rawPayload[:len(rawPayload)-4])
+	} else {
+		expectedMIC, err = crypto.ComputeLegacyDownlinkMIC(*ses.FNwkSIntKey.Key, ses.DevAddr, fCnt, rawPayload[:len(rawPayload)-4])
+	}
+	if err != nil || !bytes.Equal(msg.MIC, expectedMIC[:]) {
+		return false
+	}
+
+	if isNwk {
+		ses.LastNFCntDown = fCnt
+	} else {
+		ses.LastAFCntDown = fCnt
+	}
+	d.result.Downlinks++
+	d.adrAckCnt = 0
+	if pld.Ack && d.confirmedFCntUp != nil {
+		d.confirmedFCntUp = nil
+		d.result.Acknowledgments++
+	}
+	if msg.MType == ttnpb.MType_CONFIRMED_DOWN {
+		d.ackPending = true
+		d.confFCntDown = fCnt
+		d.result.ConfirmedDownlinks++
+	}
+	// A Class A downlink confirms the sticky MAC command answers.
+	macState.QueuedResponses = macState.QueuedResponses[:0]
+
+	cmdBuf := pld.FOpts
+	if len(cmdBuf) > 0 && macState.LoRaWANVersion.EncryptFOpts() {
+		if cmdBuf, err = crypto.DecryptDownlink(*ses.NwkSEncKey.Key, ses.DevAddr, ses.LastNFCntDown, cmdBuf); err != nil {
+			return true
+		}
+	}
+	if len(pld.FRMPayload) > 0 {
+		key := *ses.AppSKey.Key
+		if pld.FPort == 0 {
+			key = *ses.NwkSEncKey.Key
+		}
+		frmPayload, err := crypto.DecryptDownlink(key, ses.DevAddr, fCnt, pld.FRMPayload)
+		if err != nil {
+			return true
+		}
+		if pld.FPort == 0 {
+			cmdBuf = frmPayload
+		}
+	}
+
+	var cmds []*ttnpb.MACCommand
+	for r := bytes.NewReader(cmdBuf); r.Len() > 0; {
+		cmd := &ttnpb.MACCommand{}
+		if err := lorawan.DefaultMACCommands.ReadDownlink(d.phy, r, cmd); err != nil {
+			break
+		}
+		cmds = append(cmds, cmd)
+	}
+	d.handleMACCommands(cmds)
+	if err := d.save(); err != nil {
+		d.result.Error = err.Error()
+	}
+	return true
+}
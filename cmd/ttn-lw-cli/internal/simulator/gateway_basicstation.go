@@ -0,0 +1,159 @@
+// Copyright © 2026 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package simulator
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"go.thethings.network/lorawan-stack/pkg/gatewayserver/io"
+	"go.thethings.network/lorawan-stack/pkg/gatewayserver/io/basicstationlns/messages"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+)
+
+type basicStationGateway struct {
+	ids       ttnpb.GatewayIdentifiers
+	bandID    string
+	cancel    context.CancelFunc
+	writeMu   sync.Mutex
+	ws        *websocket.Conn
+	downlinks chan *ttnpb.DownlinkMessage
+}
+
+// ConnectBasicStation connects a simulated gateway to the Gateway Server using the LoRa Basics Station LNS protocol.
+// The address is the URL of the LNS endpoint, for example ws://localhost:1887.
+// If the API key is empty, the connection is not authenticated.
+func ConnectBasicStation(ctx context.Context, address string, ids ttnpb.GatewayIdentifiers, bandID, apiKey string) (Gateway, error) {
+	if ids.EUI == nil {
+		return nil, errNoGatewayEUI
+	}
+	header := http.Header{}
+	if apiKey != "" {
+		header.Set("Authorization", fmt.Sprintf("Bearer %s", apiKey))
+	}
+	url := fmt.Sprintf("%s/traffic/eui-%s", strings.TrimSuffix(address, "/"), ids.EUI.String())
+	ws, _, err := websocket.DefaultDialer.DialContext(ctx, url, header)
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	gtw := &basicStationGateway{
+		ids:       ids,
+		bandID:    bandID,
+		cancel:    cancel,
+		ws:        ws,
+		downlinks: make(chan *ttnpb.DownlinkMessage),
+	}
+	if err := gtw.write(messages.Version{
+		Station:  "simulator",
+		Model:    "simulator",
+		Protocol: 2,
+	}); err != nil {
+		gtw.Close()
+		return nil, err
+	}
+	go gtw.read(ctx)
+	return gtw, nil
+}
+
+func (g *basicStationGateway) write(msg interface{}) error {
+	buf, err := json.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	g.writeMu.Lock()
+	defer g.writeMu.Unlock()
+	return g.ws.WriteMessage(websocket.TextMessage, buf)
+}
+
+func (g *basicStationGateway) read(ctx context.Context) {
+	defer close(g.downlinks)
+	for {
+		_, data, err := g.ws.ReadMessage()
+		if err != nil {
+			return
+		}
+		if typ, err := messages.Type(data); err != nil || typ != messages.TypeDownstreamDownlinkMessage {
+			continue
+		}
+		var dnmsg messages.DownlinkMessage
+		if err := json.Unmarshal(data, &dnmsg); err != nil {
+			continue
+		}
+		rawPayload, err := hex.DecodeString(dnmsg.Pdu)
+		if err != nil {
+			continue
+		}
+		msg := dnmsg.ToDownlinkMessage()
+		msg.RawPayload = rawPayload
+		if err := g.write(messages.TxConfirmation{
+			Diid:   dnmsg.Diid,
+			RCtx:   dnmsg.RCtx,
+			XTime:  dnmsg.XTime,
+			TxTime: float64(time.Now().UnixNano()) / float64(time.Second),
+		}); err != nil {
+			return
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case g.downlinks <- &msg:
+		}
+	}
+}
+
+// Send implements Gateway.
+// Only join-requests and data uplinks are supported.
+func (g *basicStationGateway) Send(msg *ttnpb.UplinkMessage) error {
+	for _, md := range msg.RxMetadata {
+		md.GatewayIdentifiers = g.ids
+		token, err := io.UplinkToken(ttnpb.GatewayAntennaIdentifiers{
+			GatewayIdentifiers: g.ids,
+			AntennaIndex:       md.AntennaIndex,
+		}, md.Timestamp)
+		if err != nil {
+			return err
+		}
+		md.UplinkToken = token
+	}
+	if len(msg.RawPayload) > 0 && ttnpb.MType(msg.RawPayload[0]>>5) == ttnpb.MType_JOIN_REQUEST {
+		var jreq messages.JoinRequest
+		if err := jreq.FromUplinkMessage(msg, g.bandID); err != nil {
+			return err
+		}
+		return g.write(jreq)
+	}
+	var updf messages.UplinkDataFrame
+	if err := updf.FromUplinkMessage(msg, g.bandID); err != nil {
+		return err
+	}
+	return g.write(updf)
+}
+
+// Downlinks implements Gateway.
+func (g *basicStationGateway) Downlinks() <-chan *ttnpb.DownlinkMessage { return g.downlinks }
+
+// Close implements Gateway.
+func (g *basicStationGateway) Close() error {
+	g.cancel()
+	return g.ws.Close()
+}
@@ -0,0 +1,114 @@
+// Copyright © 2026 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package simulator
+
+import (
+	"context"
+	"sync"
+
+	"go.thethings.network/lorawan-stack/pkg/rpcmetadata"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"google.golang.org/grpc"
+)
+
+type grpcGateway struct {
+	ids       ttnpb.GatewayIdentifiers
+	cancel    context.CancelFunc
+	sendMu    sync.Mutex
+	link      ttnpb.GtwGs_LinkGatewayClient
+	downlinks chan *ttnpb.DownlinkMessage
+}
+
+// LinkGRPC links a simulated gateway to the Gateway Server using gRPC.
+// If the API key is empty, the call credentials of the client connection are used.
+func LinkGRPC(ctx context.Context, cc *grpc.ClientConn, ids ttnpb.GatewayIdentifiers, apiKey string) (Gateway, error) {
+	md := rpcmetadata.MD{
+		ID: ids.GatewayID,
+	}
+	if apiKey != "" {
+		md.AuthType = "Bearer"
+		md.AuthValue = apiKey
+	}
+	ctx, cancel := context.WithCancel(md.ToOutgoingContext(ctx))
+	link, err := ttnpb.NewGtwGsClient(cc).LinkGateway(ctx)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	// Send empty upstream message to start the stream.
+	if err := link.Send(&ttnpb.GatewayUp{}); err != nil {
+		cancel()
+		return nil, err
+	}
+	gtw := &grpcGateway{
+		ids:       ids,
+		cancel:    cancel,
+		link:      link,
+		downlinks: make(chan *ttnpb.DownlinkMessage),
+	}
+	go func() {
+		defer close(gtw.downlinks)
+		for {
+			down, err := link.Recv()
+			if err != nil {
+				return
+			}
+			if down.DownlinkMessage == nil {
+				continue
+			}
+			gtw.sendMu.Lock()
+			err = link.Send(&ttnpb.GatewayUp{
+				TxAcknowledgment: &ttnpb.TxAcknowledgment{
+					CorrelationIDs: down.DownlinkMessage.CorrelationIDs,
+					Result:         ttnpb.TxAcknowledgment_SUCCESS,
+				},
+			})
+			gtw.sendMu.Unlock()
+			if err != nil {
+				return
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case gtw.downlinks <- down.DownlinkMessage:
+			}
+		}
+	}()
+	return gtw, nil
+}
+
+// Send implements Gateway.
+func (g *grpcGateway) Send(msg *ttnpb.UplinkMessage) error {
+	for _, md := range msg.RxMetadata {
+		md.GatewayIdentifiers = g.ids
+	}
+	g.sendMu.Lock()
+	defer g.sendMu.Unlock()
+	return g.link.Send(&ttnpb.GatewayUp{
+		UplinkMessages: []*ttnpb.UplinkMessage{msg},
+	})
+}
+
+// Downlinks implements Gateway.
+func (g *grpcGateway) Downlinks() <-chan *ttnpb.DownlinkMessage { return g.downlinks }
+
+// Close implements Gateway.
+func (g *grpcGateway) Close() error {
+	g.sendMu.Lock()
+	err := g.link.CloseSend()
+	g.sendMu.Unlock()
+	g.cancel()
+	return err
+}
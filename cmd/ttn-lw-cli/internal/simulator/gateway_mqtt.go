@@ -0,0 +1,127 @@
+// Copyright © 2026 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package simulator
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/TheThingsIndustries/mystique/pkg/topic"
+	mqtt "github.com/eclipse/paho.mqtt.golang"
+	gsmqtt "go.thethings.network/lorawan-stack/pkg/gatewayserver/io/mqtt"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/unique"
+)
+
+const mqttTimeout = 10 * time.Second
+
+type mqttGateway struct {
+	ids       ttnpb.GatewayIdentifiers
+	uid       string
+	client    mqtt.Client
+	done      chan struct{}
+	mu        sync.RWMutex
+	closed    bool
+	downlinks chan *ttnpb.DownlinkMessage
+}
+
+// ConnectMQTT connects a simulated gateway to the Gateway Server using MQTT with the Protocol Buffers format.
+// The address is the URL of the MQTT server, for example tcp://localhost:1882.
+func ConnectMQTT(ctx context.Context, address string, ids ttnpb.GatewayIdentifiers, apiKey string) (Gateway, error) {
+	uid := unique.ID(ctx, ids)
+	gtw := &mqttGateway{
+		ids:       ids,
+		uid:       uid,
+		done:      make(chan struct{}),
+		downlinks: make(chan *ttnpb.DownlinkMessage),
+	}
+	opts := mqtt.NewClientOptions()
+	opts.AddBroker(address)
+	opts.SetUsername(uid)
+	opts.SetPassword(apiKey)
+	opts.SetAutoReconnect(false)
+	opts.SetConnectTimeout(mqttTimeout)
+	gtw.client = mqtt.NewClient(opts)
+	if token := gtw.client.Connect(); !token.WaitTimeout(mqttTimeout) {
+		return nil, context.DeadlineExceeded
+	} else if err := token.Error(); err != nil {
+		return nil, err
+	}
+	token := gtw.client.Subscribe(topic.Join(gsmqtt.Protobuf.DownlinkTopic(uid)), 0, gtw.handleDownlink)
+	if !token.WaitTimeout(mqttTimeout) {
+		gtw.client.Disconnect(0)
+		return nil, context.DeadlineExceeded
+	} else if err := token.Error(); err != nil {
+		gtw.client.Disconnect(0)
+		return nil, err
+	}
+	return gtw, nil
+}
+
+func (g *mqttGateway) handleDownlink(_ mqtt.Client, raw mqtt.Message) {
+	msg := &ttnpb.DownlinkMessage{}
+	if err := msg.Unmarshal(raw.Payload()); err != nil {
+		return
+	}
+	ack, err := (&ttnpb.TxAcknowledgment{
+		CorrelationIDs: msg.CorrelationIDs,
+		Result:         ttnpb.TxAcknowledgment_SUCCESS,
+	}).Marshal()
+	if err != nil {
+		return
+	}
+	g.client.Publish(topic.Join(gsmqtt.Protobuf.TxAckTopic(g.uid)), 0, false, ack)
+
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+	if g.closed {
+		return
+	}
+	select {
+	case <-g.done:
+	case g.downlinks <- msg:
+	}
+}
+
+// Send implements Gateway.
+func (g *mqttGateway) Send(msg *ttnpb.UplinkMessage) error {
+	for _, md := range msg.RxMetadata {
+		md.GatewayIdentifiers = g.ids
+	}
+	buf, err := msg.Marshal()
+	if err != nil {
+		return err
+	}
+	token := g.client.Publish(topic.Join(gsmqtt.Protobuf.UplinkTopic(g.uid)), 0, false, buf)
+	if !token.WaitTimeout(mqttTimeout) {
+		return context.DeadlineExceeded
+	}
+	return token.Error()
+}
+
+// Downlinks implements Gateway.
+func (g *mqttGateway) Downlinks() <-chan *ttnpb.DownlinkMessage { return g.downlinks }
+
+// Close implements Gateway.
+func (g *mqttGateway) Close() error {
+	close(g.done)
+	g.client.Disconnect(uint(mqttTimeout / time.Millisecond))
+	g.mu.Lock()
+	g.closed = true
+	close(g.downlinks)
+	g.mu.Unlock()
+	return nil
+}
@@ -0,0 +1,152 @@
+// Copyright © 2026 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package simulator
+
+import (
+	"context"
+	"encoding/binary"
+	"net"
+	"sync/atomic"
+	"time"
+
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb/udp"
+)
+
+type udpGateway struct {
+	ids       ttnpb.GatewayIdentifiers
+	cancel    context.CancelFunc
+	conn      net.Conn
+	token     uint32
+	downlinks chan *ttnpb.DownlinkMessage
+}
+
+// ConnectUDP connects a simulated gateway to the Gateway Server using the UDP packet forwarder protocol.
+// The gateway sends a PULL_DATA message every keepAlive interval.
+func ConnectUDP(ctx context.Context, address string, ids ttnpb.GatewayIdentifiers, keepAlive time.Duration) (Gateway, error) {
+	if ids.EUI == nil {
+		return nil, errNoGatewayEUI
+	}
+	conn, err := net.Dial("udp", address)
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	gtw := &udpGateway{
+		ids:       ids,
+		cancel:    cancel,
+		conn:      conn,
+		downlinks: make(chan *ttnpb.DownlinkMessage),
+	}
+	if err := gtw.write(udp.PullData, gtw.nextToken(), nil); err != nil {
+		gtw.Close()
+		return nil, err
+	}
+	go func() {
+		ticker := time.NewTicker(keepAlive)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				gtw.write(udp.PullData, gtw.nextToken(), nil)
+			}
+		}
+	}()
+	go gtw.read(ctx)
+	return gtw, nil
+}
+
+func (g *udpGateway) nextToken() (token [2]byte) {
+	binary.BigEndian.PutUint16(token[:], uint16(atomic.AddUint32(&g.token, 1)))
+	return token
+}
+
+func (g *udpGateway) write(typ udp.PacketType, token [2]byte, data *udp.Data) error {
+	buf, err := udp.Packet{
+		ProtocolVersion: udp.Version2,
+		Token:           token,
+		PacketType:      typ,
+		GatewayEUI:      g.ids.EUI,
+		Data:            data,
+	}.MarshalBinary()
+	if err != nil {
+		return err
+	}
+	_, err = g.conn.Write(buf)
+	return err
+}
+
+func (g *udpGateway) read(ctx context.Context) {
+	defer close(g.downlinks)
+	buf := make([]byte, 65507)
+	for {
+		n, err := g.conn.Read(buf)
+		if err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			// Errors like ICMP port unreachable are reported on read, but they do not close the connection.
+			continue
+		}
+		var packet udp.Packet
+		if err := packet.UnmarshalBinary(buf[:n]); err != nil {
+			continue
+		}
+		if packet.PacketType != udp.PullResp || packet.Data == nil || packet.Data.TxPacket == nil {
+			continue
+		}
+		msg, err := udp.ToDownlinkMessage(packet.Data.TxPacket)
+		if err != nil {
+			continue
+		}
+		if err := g.write(udp.TxAck, packet.Token, &udp.Data{
+			TxPacketAck: &udp.TxPacketAck{Error: udp.TxErrNone},
+		}); err != nil {
+			return
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case g.downlinks <- msg:
+		}
+	}
+}
+
+// Send implements Gateway.
+func (g *udpGateway) Send(msg *ttnpb.UplinkMessage) error {
+	for _, md := range msg.RxMetadata {
+		md.GatewayIdentifiers = g.ids
+	}
+	rxs, _, _ := udp.FromGatewayUp(&ttnpb.GatewayUp{
+		UplinkMessages: []*ttnpb.UplinkMessage{msg},
+	})
+	for _, rx := range rxs {
+		rx.Stat = 1
+	}
+	return g.write(udp.PushData, g.nextToken(), &udp.Data{
+		RxPacket: rxs,
+	})
+}
+
+// Downlinks implements Gateway.
+func (g *udpGateway) Downlinks() <-chan *ttnpb.DownlinkMessage { return g.downlinks }
+
+// Close implements Gateway.
+func (g *udpGateway) Close() error {
+	g.cancel()
+	return g.conn.Close()
+}
@@ -0,0 +1,478 @@
+// Copyright © 2026 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package simulator
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	pbtypes "github.com/gogo/protobuf/types"
+	"go.thethings.network/lorawan-stack/pkg/band"
+	"go.thethings.network/lorawan-stack/pkg/encoding/lorawan"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/random"
+	"go.thethings.network/lorawan-stack/pkg/toa"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/types"
+)
+
+var (
+	errNoGateways  = errors.DefineInvalidArgument("no_gateways", "no gateways to generate load with")
+	errUplinkRate  = errors.DefineInvalidArgument("uplink_rate", "uplink rate must not be negative")
+	errPayloadSize = errors.DefineInvalidArgument("payload_size", "payload size must be between 0 and `{max}`")
+)
+
+// LoadConfig configures the load that is generated by simulated gateways.
+type LoadConfig struct {
+	// Duration is the duration during which uplinks are generated.
+	Duration time.Duration
+	// Grace is how long to wait for events and downlinks after the last uplink.
+	Grace time.Duration
+	// UplinkRate is the number of generated uplinks per second over all gateways.
+	// Each uplink is received by Duplicates gateways. If zero, only uplinks of devices are sent.
+	UplinkRate float64
+	// PayloadSize is the size of the FRMPayload of generated uplinks.
+	PayloadSize int
+	// DataRateIndex is the data rate index of generated uplinks.
+	DataRateIndex ttnpb.DataRateIndex
+	// Duplicates is the number of gateways that receive each uplink.
+	Duplicates int
+	// DuplicateDelay is the maximum delay between the receptions of the same uplink by different gateways.
+	DuplicateDelay time.Duration
+	// RSSI is the RSSI reported in the metadata of generated uplinks.
+	RSSI float32
+	// SNR is the SNR reported in the metadata of generated uplinks.
+	SNR float32
+}
+
+// LatencyStats contains latency statistics in milliseconds.
+type LatencyStats struct {
+	Count  int     `json:"count"`
+	Min    float64 `json:"min_ms"`
+	Mean   float64 `json:"mean_ms"`
+	Median float64 `json:"median_ms"`
+	P95    float64 `json:"p95_ms"`
+	P99    float64 `json:"p99_ms"`
+	Max    float64 `json:"max_ms"`
+}
+
+func newLatencyStats(latencies []time.Duration) LatencyStats {
+	if len(latencies) == 0 {
+		return LatencyStats{}
+	}
+	sorted := make([]time.Duration, len(latencies))
+	copy(sorted, latencies)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	ms := func(d time.Duration) float64 { return float64(d) / float64(time.Millisecond) }
+	percentile := func(p int) float64 { return ms(sorted[(len(sorted)-1)*p/100]) }
+	var sum time.Duration
+	for _, d := range sorted {
+		sum += d
+	}
+	return LatencyStats{
+		Count:  len(sorted),
+		Min:    ms(sorted[0]),
+		Mean:   ms(sum / time.Duration(len(sorted))),
+		Median: percentile(50),
+		P95:    percentile(95),
+		P99:    percentile(99),
+		Max:    ms(sorted[len(sorted)-1]),
+	}
+}
+
+// LoadResult contains the results of a load test.
+// The counts of the Gateway Server are based on the events of the gateways.
+type LoadResult struct {
+	Gateways int `json:"gateways"`
+	// Uplinks is the number of unique uplinks that were sent.
+	Uplinks uint32 `json:"uplinks"`
+	// UplinkReceptions is the number of uplinks that were sent by the gateways, including duplicates.
+	UplinkReceptions uint32 `json:"uplink_receptions"`
+	// UplinkErrors is the number of uplinks that the gateways failed to send.
+	UplinkErrors uint32 `json:"uplink_errors"`
+	// Airtime is the airtime of a generated uplink in milliseconds.
+	Airtime float64 `json:"airtime_ms"`
+	// TotalAirtime is the total airtime of the unique uplinks in milliseconds.
+	TotalAirtime float64 `json:"total_airtime_ms"`
+
+	UplinksReceived  uint32 `json:"uplinks_received"`
+	UplinksForwarded uint32 `json:"uplinks_forwarded"`
+	UplinksDropped   uint32 `json:"uplinks_dropped"`
+	UplinksFailed    uint32 `json:"uplinks_failed"`
+	// GatewayLatency is the time between sending an uplink and the Gateway Server receiving it.
+	// This is only accurate if the clocks of the simulator and the Gateway Server are synchronized.
+	GatewayLatency LatencyStats `json:"gateway_latency"`
+	// NetworkServerLatency is the time between the Gateway Server receiving an uplink and
+	// the Network Server accepting or rejecting it.
+	NetworkServerLatency LatencyStats `json:"network_server_latency"`
+
+	DownlinksSent     uint32 `json:"downlinks_sent"`
+	DownlinksReceived uint32 `json:"downlinks_received"`
+	TxSuccess         uint32 `json:"tx_success"`
+	TxFailures        uint32 `json:"tx_failures"`
+	// DownlinkDeliveryRate is the fraction of the downlinks sent by the Gateway Server that were received.
+	DownlinkDeliveryRate float64 `json:"downlink_delivery_rate"`
+	// DownlinkDropRate is the fraction of the downlinks sent by the Gateway Server that were not received.
+	DownlinkDropRate float64 `json:"downlink_drop_rate"`
+
+	Devices []Result `json:"devices,omitempty"`
+}
+
+type receptionKey struct {
+	gatewayID  string
+	rawPayload string
+}
+
+// Load generates uplink traffic with simulated gateways and measures the handling by the Gateway Server.
+type Load struct {
+	config      LoadConfig
+	gateways    []Gateway
+	ids         []ttnpb.GatewayIdentifiers
+	settings    ttnpb.TxSettings
+	frequencies []uint64
+	airtime     time.Duration
+	wg          sync.WaitGroup
+
+	mu                   sync.Mutex
+	result               LoadResult
+	totalAirtime         time.Duration
+	sent                 map[receptionKey]time.Time
+	received             map[string]time.Time
+	gatewayLatency       []time.Duration
+	networkServerLatency []time.Duration
+}
+
+// NewLoad returns a new load generator for the given gateways.
+// The gateway identifiers must match the events of the gateways.
+func NewLoad(gateways []Gateway, ids []ttnpb.GatewayIdentifiers, phy band.Band, config LoadConfig) (*Load, error) {
+	if len(gateways) == 0 || len(gateways) != len(ids) {
+		return nil, errNoGateways
+	}
+	if config.UplinkRate < 0 {
+		return nil, errUplinkRate
+	}
+	dr, ok := phy.DataRates[config.DataRateIndex]
+	if !ok {
+		return nil, errDataRateIndex.WithAttributes("data_rate_index", config.DataRateIndex)
+	}
+	// The MACPayload consists of the FHDR without FOpts, the FPort and the FRMPayload.
+	if max := int(dr.MaxMACPayloadSize(false)) - 7 - 1; config.PayloadSize < 0 || config.PayloadSize > max {
+		return nil, errPayloadSize.WithAttributes("max", max)
+	}
+	if config.Duplicates < 1 {
+		config.Duplicates = 1
+	}
+	if config.Duplicates > len(gateways) {
+		config.Duplicates = len(gateways)
+	}
+	var frequencies []uint64
+	for _, ch := range phy.UplinkChannels {
+		if ch.MinDataRate <= config.DataRateIndex && config.DataRateIndex <= ch.MaxDataRate {
+			frequencies = append(frequencies, ch.Frequency)
+		}
+	}
+	if len(frequencies) == 0 {
+		return nil, errNoChannel.WithAttributes("data_rate_index", config.DataRateIndex)
+	}
+	settings := ttnpb.TxSettings{
+		DataRate:      dr.Rate,
+		DataRateIndex: config.DataRateIndex,
+		Frequency:     frequencies[0],
+	}
+	if dr.Rate.GetLoRa() != nil {
+		settings.CodingRate = "4/5"
+	}
+	// The PHYPayload consists of the MHDR, the FHDR without FOpts, the FPort, the FRMPayload and the MIC.
+	airtime, err := toa.Compute(1+7+1+config.PayloadSize+4, settings)
+	if err != nil {
+		return nil, err
+	}
+	return &Load{
+		config:      config,
+		gateways:    gateways,
+		ids:         ids,
+		settings:    settings,
+		frequencies: frequencies,
+		airtime:     airtime,
+		result: LoadResult{
+			Gateways: len(gateways),
+			Airtime:  float64(airtime) / float64(time.Millisecond),
+		},
+		sent:     make(map[receptionKey]time.Time),
+		received: make(map[string]time.Time),
+	}, nil
+}
+
+// generate returns a new unconfirmed data uplink from a random DevAddr with a random payload and MIC
+// on a random channel.
+func (l *Load) generate() (*ttnpb.UplinkMessage, error) {
+	var devAddr types.DevAddr
+	random.Read(devAddr[:])
+	frmPayload := make([]byte, l.config.PayloadSize)
+	random.Read(frmPayload)
+	mic := make([]byte, 4)
+	random.Read(mic)
+	buf, err := lorawan.MarshalMessage(ttnpb.Message{
+		MHDR: ttnpb.MHDR{
+			MType: ttnpb.MType_UNCONFIRMED_UP,
+			Major: ttnpb.Major_LORAWAN_R1,
+		},
+		MIC: mic,
+		Payload: &ttnpb.Message_MACPayload{
+			MACPayload: &ttnpb.MACPayload{
+				FHDR: ttnpb.FHDR{
+					DevAddr: devAddr,
+					FCnt:    uint32(random.Intn(0xffff)),
+				},
+				FPort:      1,
+				FRMPayload: frmPayload,
+			},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	settings := l.settings
+	settings.Frequency = l.frequencies[random.Intn(len(l.frequencies))]
+	return &ttnpb.UplinkMessage{
+		RawPayload: buf,
+		Settings:   settings,
+		RxMetadata: []*ttnpb.RxMetadata{
+			{
+				RSSI:        l.config.RSSI,
+				ChannelRSSI: l.config.RSSI,
+				SNR:         l.config.SNR,
+			},
+		},
+	}, nil
+}
+
+// Send sends the uplink message through a random selection of gateways.
+// The first reception is sent immediately; duplicates are sent in the background after a random delay.
+func (l *Load) Send(msg *ttnpb.UplinkMessage) error {
+	airtime, _ := toa.Compute(len(msg.RawPayload), msg.Settings)
+	l.mu.Lock()
+	l.result.Uplinks++
+	l.totalAirtime += airtime
+	l.mu.Unlock()
+	idxs := make([]int, len(l.gateways))
+	for i := range idxs {
+		idxs[i] = i
+	}
+	for i := 0; i < l.config.Duplicates; i++ {
+		j := i + random.Intn(len(idxs)-i)
+		idxs[i], idxs[j] = idxs[j], idxs[i]
+	}
+	for _, i := range idxs[1:l.config.Duplicates] {
+		var delay time.Duration
+		if l.config.DuplicateDelay > 0 {
+			delay = time.Duration(random.Intn(int(l.config.DuplicateDelay)))
+		}
+		l.wg.Add(1)
+		go func(i int) {
+			defer l.wg.Done()
+			time.Sleep(delay)
+			l.sendReception(i, msg)
+		}(i)
+	}
+	return l.sendReception(idxs[0], msg)
+}
+
+// sendReception sends a copy of the uplink message through the gateway with the given index.
+func (l *Load) sendReception(i int, msg *ttnpb.UplinkMessage) error {
+	now := time.Now()
+	up := *msg
+	up.RxMetadata = make([]*ttnpb.RxMetadata, 0, len(msg.RxMetadata))
+	for _, md := range msg.RxMetadata {
+		md := *md
+		md.Time = &now
+		md.Timestamp = uint32(now.UnixNano() / 1000)
+		up.RxMetadata = append(up.RxMetadata, &md)
+	}
+	up.Settings.Time = &now
+	up.Settings.Timestamp = uint32(now.UnixNano() / 1000)
+
+	l.mu.Lock()
+	l.sent[receptionKey{l.ids[i].GatewayID, string(msg.RawPayload)}] = now
+	l.mu.Unlock()
+	err := l.gateways[i].Send(&up)
+	l.mu.Lock()
+	if err != nil {
+		l.result.UplinkErrors++
+	} else {
+		l.result.UplinkReceptions++
+	}
+	l.mu.Unlock()
+	return err
+}
+
+// HandleEvent handles an event of one of the gateways.
+func (l *Load) HandleEvent(evt *ttnpb.Event) {
+	var uplinkID string
+	for _, cid := range evt.CorrelationIDs {
+		if strings.HasPrefix(cid, "gs:uplink:") {
+			uplinkID = cid
+			break
+		}
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	switch evt.Name {
+	case "gs.up.receive":
+		l.result.UplinksReceived++
+		if uplinkID != "" {
+			l.received[uplinkID] = evt.Time
+		}
+		var gtwID string
+		for _, ids := range evt.Identifiers {
+			if ids := ids.GetGatewayIDs(); ids != nil {
+				gtwID = ids.GatewayID
+				break
+			}
+		}
+		var up ttnpb.UplinkMessage
+		if evt.Data == nil || pbtypes.UnmarshalAny(evt.Data, &up) != nil {
+			return
+		}
+		key := receptionKey{gtwID, string(up.RawPayload)}
+		if sentAt, ok := l.sent[key]; ok {
+			l.gatewayLatency = append(l.gatewayLatency, evt.Time.Sub(sentAt))
+			delete(l.sent, key)
+		}
+	case "gs.up.forward", "gs.up.drop", "gs.up.fail":
+		switch evt.Name {
+		case "gs.up.forward":
+			l.result.UplinksForwarded++
+		case "gs.up.drop":
+			l.result.UplinksDropped++
+		default:
+			l.result.UplinksFailed++
+		}
+		if receivedAt, ok := l.received[uplinkID]; ok {
+			l.networkServerLatency = append(l.networkServerLatency, evt.Time.Sub(receivedAt))
+			delete(l.received, uplinkID)
+		}
+	case "gs.down.send":
+		l.result.DownlinksSent++
+	case "gs.down.tx.success":
+		l.result.TxSuccess++
+	case "gs.down.tx.fail":
+		l.result.TxFailures++
+	}
+}
+
+// Run generates uplinks and runs the devices until the duration elapses or the context is done.
+// The downlinks received by the gateways are passed to the devices.
+// Run closes the gateways after the grace period.
+func (l *Load) Run(ctx context.Context, devices []*Device) error {
+	var downWg sync.WaitGroup
+	for _, gtw := range l.gateways {
+		downWg.Add(1)
+		go func(gtw Gateway) {
+			defer downWg.Done()
+			for msg := range gtw.Downlinks() {
+				l.mu.Lock()
+				l.result.DownlinksReceived++
+				l.mu.Unlock()
+				for _, dev := range devices {
+					if dev.HandleDownlink(msg) {
+						break
+					}
+				}
+			}
+		}(gtw)
+	}
+
+	runCtx, cancel := context.WithTimeout(ctx, l.config.Duration)
+	defer cancel()
+	devErrs := make([]error, len(devices))
+	var devWg sync.WaitGroup
+	for i, dev := range devices {
+		devWg.Add(1)
+		go func(i int, dev *Device) {
+			defer devWg.Done()
+			devErrs[i] = dev.Run(runCtx, l.Send)
+		}(i, dev)
+	}
+
+	var err error
+	if l.config.UplinkRate > 0 {
+		ticker := time.NewTicker(time.Duration(float64(time.Second) / l.config.UplinkRate))
+	generate:
+		for {
+			select {
+			case <-runCtx.Done():
+				break generate
+			case <-ticker.C:
+				var up *ttnpb.UplinkMessage
+				if up, err = l.generate(); err != nil {
+					break generate
+				}
+				l.wg.Add(1)
+				go func() {
+					defer l.wg.Done()
+					l.Send(up)
+				}()
+			}
+		}
+		ticker.Stop()
+	}
+	cancel()
+	devWg.Wait()
+	l.wg.Wait()
+
+	select {
+	case <-ctx.Done():
+	case <-time.After(l.config.Grace):
+	}
+	for _, gtw := range l.gateways {
+		gtw.Close()
+	}
+	downWg.Wait()
+
+	results := make([]Result, len(devices))
+	for i, dev := range devices {
+		results[i] = dev.Result()
+		if devErrs[i] != nil && devErrs[i] != runCtx.Err() {
+			results[i].Error = devErrs[i].Error()
+		}
+	}
+	l.mu.Lock()
+	l.result.Devices = results
+	l.mu.Unlock()
+	return err
+}
+
+// Result returns the results of the load test.
+func (l *Load) Result() LoadResult {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	res := l.result
+	res.TotalAirtime = float64(l.totalAirtime) / float64(time.Millisecond)
+	res.GatewayLatency = newLatencyStats(l.gatewayLatency)
+	res.NetworkServerLatency = newLatencyStats(l.networkServerLatency)
+	if res.DownlinksSent > 0 {
+		received := res.DownlinksReceived
+		if received > res.DownlinksSent {
+			received = res.DownlinksSent
+		}
+		res.DownlinkDeliveryRate = float64(received) / float64(res.DownlinksSent)
+		res.DownlinkDropRate = 1 - res.DownlinkDeliveryRate
+	}
+	return res
+}
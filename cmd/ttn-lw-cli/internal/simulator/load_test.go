@@ -0,0 +1,341 @@
+// Copyright © 2026 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package simulator
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	pbtypes "github.com/gogo/protobuf/types"
+	"github.com/smartystreets/assertions"
+	"github.com/smartystreets/assertions/should"
+	"go.thethings.network/lorawan-stack/pkg/band"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+)
+
+type mockGateway struct {
+	err       error
+	downlinks chan *ttnpb.DownlinkMessage
+	closeOnce sync.Once
+
+	mu      sync.Mutex
+	uplinks []*ttnpb.UplinkMessage
+}
+
+func newMockGateway(err error) *mockGateway {
+	return &mockGateway{
+		err:       err,
+		downlinks: make(chan *ttnpb.DownlinkMessage, 10),
+	}
+}
+
+func (g *mockGateway) Send(msg *ttnpb.UplinkMessage) error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.uplinks = append(g.uplinks, msg)
+	return g.err
+}
+
+func (g *mockGateway) Downlinks() <-chan *ttnpb.DownlinkMessage { return g.downlinks }
+
+func (g *mockGateway) Close() error {
+	g.closeOnce.Do(func() { close(g.downlinks) })
+	return nil
+}
+
+func (g *mockGateway) sent() []*ttnpb.UplinkMessage {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	return append([]*ttnpb.UplinkMessage(nil), g.uplinks...)
+}
+
+func newTestLoad(t *testing.T, gateways []*mockGateway, config LoadConfig) *Load {
+	phy, err := band.GetByID(band.EU_863_870)
+	if err != nil {
+		t.Fatalf("Failed to get band: %v", err)
+	}
+	gtws := make([]Gateway, len(gateways))
+	ids := make([]ttnpb.GatewayIdentifiers, len(gateways))
+	for i, gtw := range gateways {
+		gtws[i] = gtw
+		ids[i] = ttnpb.GatewayIdentifiers{GatewayID: "gtw-" + string('a'+rune(i))}
+	}
+	l, err := NewLoad(gtws, ids, phy, config)
+	if err != nil {
+		t.Fatalf("Failed to create load: %v", err)
+	}
+	return l
+}
+
+func TestNewLatencyStats(t *testing.T) {
+	a := assertions.New(t)
+
+	a.So(newLatencyStats(nil), should.Resemble, LatencyStats{})
+
+	latencies := make([]time.Duration, 0, 100)
+	for i := 100; i > 0; i-- {
+		latencies = append(latencies, time.Duration(i)*time.Millisecond)
+	}
+	a.So(newLatencyStats(latencies), should.Resemble, LatencyStats{
+		Count:  100,
+		Min:    1,
+		Mean:   50.5,
+		Median: 50,
+		P95:    95,
+		P99:    99,
+		Max:    100,
+	})
+	// The latencies are not modified.
+	a.So(latencies[0], should.Equal, 100*time.Millisecond)
+}
+
+func TestNewLoad(t *testing.T) {
+	phy, err := band.GetByID(band.EU_863_870)
+	if err != nil {
+		t.Fatalf("Failed to get band: %v", err)
+	}
+	gtws := []Gateway{newMockGateway(nil), newMockGateway(nil)}
+	ids := []ttnpb.GatewayIdentifiers{{GatewayID: "gtw-a"}, {GatewayID: "gtw-b"}}
+
+	for _, tc := range []struct {
+		Name           string
+		Gateways       []Gateway
+		Config         LoadConfig
+		ErrorAssertion func(error) bool
+	}{
+		{
+			Name:           "No gateways",
+			ErrorAssertion: errors.IsInvalidArgument,
+		},
+		{
+			Name:           "Negative rate",
+			Gateways:       gtws,
+			Config:         LoadConfig{UplinkRate: -1},
+			ErrorAssertion: errors.IsInvalidArgument,
+		},
+		{
+			Name:           "Invalid data rate",
+			Gateways:       gtws,
+			Config:         LoadConfig{DataRateIndex: ttnpb.DATA_RATE_12},
+			ErrorAssertion: errors.IsInvalidArgument,
+		},
+		{
+			Name:           "Payload too large",
+			Gateways:       gtws,
+			Config:         LoadConfig{DataRateIndex: ttnpb.DATA_RATE_0, PayloadSize: 52},
+			ErrorAssertion: errors.IsInvalidArgument,
+		},
+		{
+			Name:     "Valid",
+			Gateways: gtws,
+			Config:   LoadConfig{DataRateIndex: ttnpb.DATA_RATE_0, PayloadSize: 51},
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+			var gtwIDs []ttnpb.GatewayIdentifiers
+			if tc.Gateways != nil {
+				gtwIDs = ids
+			}
+			l, err := NewLoad(tc.Gateways, gtwIDs, phy, tc.Config)
+			if tc.ErrorAssertion != nil {
+				a.So(tc.ErrorAssertion(err), should.BeTrue)
+				return
+			}
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			a.So(l.config.Duplicates, should.Equal, 1)
+			a.So(l.result.Airtime, should.BeGreaterThan, 0.0)
+		})
+	}
+}
+
+func TestLoadSend(t *testing.T) {
+	a := assertions.New(t)
+	gateways := []*mockGateway{newMockGateway(nil), newMockGateway(nil), newMockGateway(errors.New("failed"))}
+	l := newTestLoad(t, gateways, LoadConfig{
+		DataRateIndex:  ttnpb.DATA_RATE_5,
+		PayloadSize:    10,
+		Duplicates:     5,
+		DuplicateDelay: time.Millisecond,
+	})
+	// Duplicates are capped at the number of gateways.
+	a.So(l.config.Duplicates, should.Equal, 3)
+
+	const uplinks = 10
+	for i := 0; i < uplinks; i++ {
+		up, err := l.generate()
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		a.So(up.RawPayload, should.HaveLength, 1+7+1+10+4)
+		l.Send(up)
+	}
+	l.wg.Wait()
+
+	// Each uplink is received once by every gateway.
+	for _, gtw := range gateways {
+		sent := gtw.sent()
+		payloads := make(map[string]bool)
+		for _, up := range sent {
+			payloads[string(up.RawPayload)] = true
+			if a.So(up.RxMetadata, should.HaveLength, 1) {
+				a.So(up.RxMetadata[0].Time, should.NotBeNil)
+			}
+		}
+		a.So(sent, should.HaveLength, uplinks)
+		a.So(payloads, should.HaveLength, uplinks)
+	}
+
+	res := l.Result()
+	a.So(res.Uplinks, should.Equal, uint32(uplinks))
+	a.So(res.UplinkReceptions, should.Equal, uint32(2*uplinks))
+	a.So(res.UplinkErrors, should.Equal, uint32(uplinks))
+	a.So(res.TotalAirtime, should.AlmostEqual, float64(uplinks)*res.Airtime, 0.001)
+}
+
+func TestLoadHandleEvent(t *testing.T) {
+	a := assertions.New(t)
+	gtw := newMockGateway(nil)
+	l := newTestLoad(t, []*mockGateway{gtw}, LoadConfig{
+		DataRateIndex: ttnpb.DATA_RATE_5,
+		PayloadSize:   10,
+	})
+	gtwIDs := l.ids[0].EntityIdentifiers()
+
+	up, err := l.generate()
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	if !a.So(l.Send(up), should.BeNil) {
+		t.FailNow()
+	}
+	sentAt := l.sent[receptionKey{l.ids[0].GatewayID, string(up.RawPayload)}]
+	data, err := pbtypes.MarshalAny(gtw.sent()[0])
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+
+	for _, evt := range []*ttnpb.Event{
+		{
+			Name:           "gs.up.receive",
+			Time:           sentAt.Add(10 * time.Millisecond),
+			Identifiers:    []*ttnpb.EntityIdentifiers{gtwIDs},
+			Data:           data,
+			CorrelationIDs: []string{"gs:conn:1", "gs:uplink:1"},
+		},
+		{
+			Name:           "gs.up.drop",
+			Time:           sentAt.Add(30 * time.Millisecond),
+			Identifiers:    []*ttnpb.EntityIdentifiers{gtwIDs},
+			CorrelationIDs: []string{"gs:conn:1", "gs:uplink:1"},
+		},
+		// Duplicate events do not count towards the latency.
+		{
+			Name:           "gs.up.receive",
+			Time:           sentAt.Add(time.Second),
+			Identifiers:    []*ttnpb.EntityIdentifiers{gtwIDs},
+			Data:           data,
+			CorrelationIDs: []string{"gs:conn:1", "gs:uplink:2"},
+		},
+		{
+			Name:           "gs.up.forward",
+			Time:           sentAt.Add(2 * time.Second),
+			Identifiers:    []*ttnpb.EntityIdentifiers{gtwIDs},
+			CorrelationIDs: []string{"gs:conn:1", "gs:uplink:2"},
+		},
+		{
+			Name:           "gs.up.fail",
+			Time:           sentAt.Add(3 * time.Second),
+			Identifiers:    []*ttnpb.EntityIdentifiers{gtwIDs},
+			CorrelationIDs: []string{"gs:conn:1", "gs:uplink:3"},
+		},
+		{Name: "gs.down.send"},
+		{Name: "gs.down.send"},
+		{Name: "gs.down.send"},
+		{Name: "gs.down.send"},
+		{Name: "gs.down.tx.success"},
+		{Name: "gs.down.tx.fail"},
+	} {
+		l.HandleEvent(evt)
+	}
+	l.mu.Lock()
+	l.result.DownlinksReceived = 3
+	l.mu.Unlock()
+
+	res := l.Result()
+	a.So(res.UplinksReceived, should.Equal, uint32(2))
+	a.So(res.UplinksForwarded, should.Equal, uint32(1))
+	a.So(res.UplinksDropped, should.Equal, uint32(1))
+	a.So(res.UplinksFailed, should.Equal, uint32(1))
+	a.So(res.GatewayLatency, should.Resemble, LatencyStats{
+		Count: 1, Min: 10, Mean: 10, Median: 10, P95: 10, P99: 10, Max: 10,
+	})
+	a.So(res.NetworkServerLatency.Count, should.Equal, 2)
+	a.So(res.NetworkServerLatency.Min, should.Equal, 20.0)
+	a.So(res.NetworkServerLatency.Max, should.Equal, 1000.0)
+	a.So(res.DownlinksSent, should.Equal, uint32(4))
+	a.So(res.TxSuccess, should.Equal, uint32(1))
+	a.So(res.TxFailures, should.Equal, uint32(1))
+	a.So(res.DownlinkDeliveryRate, should.Equal, 0.75)
+	a.So(res.DownlinkDropRate, should.Equal, 0.25)
+
+	// More received downlinks than sent downlinks, for instance by other gateways, are capped.
+	l.mu.Lock()
+	l.result.DownlinksReceived = 5
+	l.mu.Unlock()
+	res = l.Result()
+	a.So(res.DownlinkDeliveryRate, should.Equal, 1.0)
+	a.So(res.DownlinkDropRate, should.Equal, 0.0)
+}
+
+func TestLoadRun(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+	gateways := []*mockGateway{newMockGateway(nil), newMockGateway(nil)}
+	l := newTestLoad(t, gateways, LoadConfig{
+		Duration:      500 * time.Millisecond,
+		Grace:         10 * time.Millisecond,
+		UplinkRate:    40,
+		DataRateIndex: ttnpb.DATA_RATE_5,
+		PayloadSize:   10,
+		Duplicates:    2,
+	})
+	gateways[0].downlinks <- &ttnpb.DownlinkMessage{}
+
+	start := time.Now()
+	if !a.So(l.Run(ctx, nil), should.BeNil) {
+		t.FailNow()
+	}
+	a.So(time.Since(start), should.BeGreaterThanOrEqualTo, 500*time.Millisecond)
+
+	// 40 uplinks per second during 500 ms, allowing for missed ticks on a busy machine.
+	res := l.Result()
+	a.So(res.Uplinks, should.BeBetweenOrEqual, uint32(10), uint32(20))
+	a.So(res.UplinkReceptions, should.Equal, 2*res.Uplinks)
+	a.So(gateways[0].sent(), should.HaveLength, int(res.Uplinks))
+	a.So(gateways[1].sent(), should.HaveLength, int(res.Uplinks))
+	a.So(res.DownlinksReceived, should.Equal, uint32(1))
+
+	// The gateways are closed after the grace period.
+	for _, gtw := range gateways {
+		_, ok := <-gtw.Downlinks()
+		a.So(ok, should.BeFalse)
+	}
+}
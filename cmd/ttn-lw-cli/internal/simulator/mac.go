@@ -0,0 +1,195 @@
+// Copyright © 2026 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package simulator
+
+import (
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+)
+
+// handleMACCommands handles the MAC commands received in a downlink message and queues the answers.
+func (d *Device) handleMACCommands(cmds []*ttnpb.MACCommand) {
+	macState := d.dev.MACState
+	for i := 0; i < len(cmds); i++ {
+		cmd := cmds[i]
+		d.result.MACCommands[cmd.CID.String()]++
+		var ans *ttnpb.MACCommand
+		switch cmd.CID {
+		case ttnpb.CID_LINK_ADR:
+			reqs := []*ttnpb.MACCommand_LinkADRReq{cmd.GetLinkADRReq()}
+			if macState.LoRaWANVersion.Compare(ttnpb.MAC_V1_0_2) >= 0 {
+				// Since LoRaWAN 1.0.2, a contiguous block of LinkADRReq commands is handled atomically and answered once.
+				for i+1 < len(cmds) && cmds[i+1].CID == ttnpb.CID_LINK_ADR {
+					i++
+					d.result.MACCommands[cmds[i].CID.String()]++
+					reqs = append(reqs, cmds[i].GetLinkADRReq())
+				}
+			}
+			ans = d.handleLinkADRReq(reqs).MACCommand()
+		case ttnpb.CID_DEV_STATUS:
+			margin := int32(d.config.SNR)
+			if margin < -32 {
+				margin = -32
+			} else if margin > 31 {
+				margin = 31
+			}
+			ans = (&ttnpb.MACCommand_DevStatusAns{
+				Battery: d.config.Battery,
+				Margin:  margin,
+			}).MACCommand()
+		case ttnpb.CID_RX_PARAM_SETUP:
+			ans = d.handleRxParamSetupReq(cmd.GetRxParamSetupReq()).MACCommand()
+		case ttnpb.CID_RX_TIMING_SETUP:
+			delay := cmd.GetRxTimingSetupReq().Delay
+			if delay == ttnpb.RX_DELAY_0 {
+				delay = ttnpb.RX_DELAY_1
+			}
+			macState.CurrentParameters.Rx1Delay = delay
+			ans = ttnpb.CID_RX_TIMING_SETUP.MACCommand()
+		case ttnpb.CID_DUTY_CYCLE:
+			macState.CurrentParameters.MaxDutyCycle = cmd.GetDutyCycleReq().MaxDutyCycle
+			ans = ttnpb.CID_DUTY_CYCLE.MACCommand()
+		case ttnpb.CID_NEW_CHANNEL:
+			ans = d.handleNewChannelReq(cmd.GetNewChannelReq()).MACCommand()
+		}
+		if ans != nil {
+			macState.QueuedResponses = append(macState.QueuedResponses, ans)
+		}
+	}
+}
+
+func (d *Device) handleLinkADRReq(reqs []*ttnpb.MACCommand_LinkADRReq) *ttnpb.MACCommand_LinkADRAns {
+	params := &d.dev.MACState.CurrentParameters
+	ans := &ttnpb.MACCommand_LinkADRAns{
+		ChannelMaskAck:   true,
+		DataRateIndexAck: true,
+		TxPowerIndexAck:  true,
+	}
+
+	enabled := make([]bool, len(params.Channels))
+	for i, ch := range params.Channels {
+		enabled[i] = ch != nil && ch.EnableUplink
+	}
+	for _, req := range reqs {
+		var mask [16]bool
+		copy(mask[:], req.ChannelMask)
+		m, err := d.phy.ParseChMask(mask, uint8(req.ChannelMaskControl))
+		if err != nil {
+			ans.ChannelMaskAck = false
+			continue
+		}
+		for i, on := range m {
+			if int(i) >= len(enabled) || params.Channels[i] == nil {
+				// ChMaskCntl 6 enables all defined channels, so undefined channels are ignored.
+				if on && req.ChannelMaskControl != 6 {
+					ans.ChannelMaskAck = false
+				}
+				continue
+			}
+			enabled[i] = on
+		}
+	}
+
+	req := reqs[len(reqs)-1]
+	// Since LoRaWAN 1.1, the device keeps the current value if the data rate index or transmission power index is 15.
+	keep := d.dev.MACState.LoRaWANVersion.Compare(ttnpb.MAC_V1_1) >= 0
+	drIdx := req.DataRateIndex
+	if keep && drIdx == 15 {
+		drIdx = params.ADRDataRateIndex
+	}
+	txPowerIdx := req.TxPowerIndex
+	if keep && txPowerIdx == 15 {
+		txPowerIdx = params.ADRTxPowerIndex
+	}
+
+	var anyEnabled, drSupported bool
+	for i, on := range enabled {
+		if !on {
+			continue
+		}
+		anyEnabled = true
+		if ch := params.Channels[i]; drIdx >= ch.MinDataRateIndex && drIdx <= ch.MaxDataRateIndex {
+			drSupported = true
+		}
+	}
+	if !anyEnabled {
+		ans.ChannelMaskAck = false
+	}
+	if _, ok := d.phy.DataRates[drIdx]; !ok || !drSupported {
+		ans.DataRateIndexAck = false
+	}
+	if txPowerIdx > uint32(d.phy.MaxTxPowerIndex) {
+		ans.TxPowerIndexAck = false
+	}
+	if !ans.ChannelMaskAck || !ans.DataRateIndexAck || !ans.TxPowerIndexAck {
+		return ans
+	}
+
+	for i, on := range enabled {
+		if params.Channels[i] != nil {
+			params.Channels[i].EnableUplink = on
+		}
+	}
+	params.ADRDataRateIndex = drIdx
+	params.ADRTxPowerIndex = txPowerIdx
+	if req.NbTrans > 0 {
+		params.ADRNbTrans = req.NbTrans
+	} else {
+		params.ADRNbTrans = 1
+	}
+	return ans
+}
+
+func (d *Device) handleRxParamSetupReq(req *ttnpb.MACCommand_RxParamSetupReq) *ttnpb.MACCommand_RxParamSetupAns {
+	params := &d.dev.MACState.CurrentParameters
+	_, rx1Err := d.phy.Rx1DataRate(params.ADRDataRateIndex, req.Rx1DataRateOffset, false)
+	_, rx2DROK := d.phy.DataRates[req.Rx2DataRateIndex]
+	ans := &ttnpb.MACCommand_RxParamSetupAns{
+		Rx1DataRateOffsetAck: rx1Err == nil,
+		Rx2DataRateIndexAck:  rx2DROK,
+		Rx2FrequencyAck:      req.Rx2Frequency != 0,
+	}
+	if !ans.Rx1DataRateOffsetAck || !ans.Rx2DataRateIndexAck || !ans.Rx2FrequencyAck {
+		return ans
+	}
+	params.Rx1DataRateOffset = req.Rx1DataRateOffset
+	params.Rx2DataRateIndex = req.Rx2DataRateIndex
+	params.Rx2Frequency = req.Rx2Frequency
+	return ans
+}
+
+func (d *Device) handleNewChannelReq(req *ttnpb.MACCommand_NewChannelReq) *ttnpb.MACCommand_NewChannelAns {
+	params := &d.dev.MACState.CurrentParameters
+	_, minOK := d.phy.DataRates[req.MinDataRateIndex]
+	_, maxOK := d.phy.DataRates[req.MaxDataRateIndex]
+	ans := &ttnpb.MACCommand_NewChannelAns{
+		// The default channels cannot be modified.
+		FrequencyAck: int(req.ChannelIndex) >= len(d.phy.UplinkChannels) && req.ChannelIndex < uint32(d.phy.MaxUplinkChannels),
+		DataRateAck:  minOK && maxOK && req.MinDataRateIndex <= req.MaxDataRateIndex,
+	}
+	if !ans.FrequencyAck || !ans.DataRateAck {
+		return ans
+	}
+	for uint32(len(params.Channels)) <= req.ChannelIndex {
+		params.Channels = append(params.Channels, nil)
+	}
+	params.Channels[req.ChannelIndex] = &ttnpb.MACParameters_Channel{
+		UplinkFrequency:   req.Frequency,
+		DownlinkFrequency: req.Frequency,
+		MinDataRateIndex:  req.MinDataRateIndex,
+		MaxDataRateIndex:  req.MaxDataRateIndex,
+		EnableUplink:      req.Frequency != 0,
+	}
+	return ans
+}
@@ -0,0 +1,485 @@
+// Copyright © 2026 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package simulator
+
+import (
+	"testing"
+
+	"github.com/smartystreets/assertions"
+	"github.com/smartystreets/assertions/should"
+	"go.thethings.network/lorawan-stack/pkg/band"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+)
+
+func newMACTestDevice(t *testing.T, version ttnpb.MACVersion) *Device {
+	phy, err := band.GetByID(band.EU_863_870)
+	if err != nil {
+		t.Fatalf("Failed to get band: %v", err)
+	}
+	d := &Device{
+		phy:    phy,
+		config: DeviceConfig{DataRateIndex: ttnpb.DATA_RATE_2},
+		dev:    &ttnpb.EndDevice{LoRaWANVersion: version},
+		result: Result{MACCommands: make(map[string]uint32)},
+	}
+	d.resetMACState()
+	d.dev.MACState.CurrentParameters.ADRTxPowerIndex = 1
+	return d
+}
+
+func channelMask(channels ...int) []bool {
+	mask := make([]bool, 16)
+	for _, i := range channels {
+		mask[i] = true
+	}
+	return mask
+}
+
+func enabledChannels(d *Device) []bool {
+	enabled := make([]bool, 0, len(d.dev.MACState.CurrentParameters.Channels))
+	for _, ch := range d.dev.MACState.CurrentParameters.Channels {
+		enabled = append(enabled, ch != nil && ch.EnableUplink)
+	}
+	return enabled
+}
+
+func TestHandleLinkADRReq(t *testing.T) {
+	for _, tc := range []struct {
+		Name                  string
+		Version               ttnpb.MACVersion
+		Requests              []*ttnpb.MACCommand_LinkADRReq
+		ExpectedAnswer        *ttnpb.MACCommand_LinkADRAns
+		ExpectedEnabled       []bool
+		ExpectedDataRateIndex ttnpb.DataRateIndex
+		ExpectedTxPowerIndex  uint32
+		ExpectedNbTrans       uint32
+	}{
+		{
+			Name:    "Accept",
+			Version: ttnpb.MAC_V1_0_3,
+			Requests: []*ttnpb.MACCommand_LinkADRReq{
+				{
+					ChannelMask:   channelMask(0, 1),
+					DataRateIndex: ttnpb.DATA_RATE_5,
+					TxPowerIndex:  3,
+					NbTrans:       2,
+				},
+			},
+			ExpectedAnswer: &ttnpb.MACCommand_LinkADRAns{
+				ChannelMaskAck:   true,
+				DataRateIndexAck: true,
+				TxPowerIndexAck:  true,
+			},
+			ExpectedEnabled:       []bool{true, true, false},
+			ExpectedDataRateIndex: ttnpb.DATA_RATE_5,
+			ExpectedTxPowerIndex:  3,
+			ExpectedNbTrans:       2,
+		},
+		{
+			Name:    "All channels on",
+			Version: ttnpb.MAC_V1_0_3,
+			Requests: []*ttnpb.MACCommand_LinkADRReq{
+				{
+					ChannelMaskControl: 6,
+					DataRateIndex:      ttnpb.DATA_RATE_4,
+					TxPowerIndex:       2,
+				},
+			},
+			ExpectedAnswer: &ttnpb.MACCommand_LinkADRAns{
+				ChannelMaskAck:   true,
+				DataRateIndexAck: true,
+				TxPowerIndexAck:  true,
+			},
+			ExpectedEnabled:       []bool{true, true, true},
+			ExpectedDataRateIndex: ttnpb.DATA_RATE_4,
+			ExpectedTxPowerIndex:  2,
+			ExpectedNbTrans:       1,
+		},
+		{
+			Name:    "Block",
+			Version: ttnpb.MAC_V1_0_2,
+			Requests: []*ttnpb.MACCommand_LinkADRReq{
+				{
+					ChannelMask:   channelMask(0),
+					DataRateIndex: ttnpb.DATA_RATE_1,
+					TxPowerIndex:  5,
+				},
+				{
+					ChannelMask:   channelMask(0, 2),
+					DataRateIndex: ttnpb.DATA_RATE_3,
+					TxPowerIndex:  4,
+					NbTrans:       3,
+				},
+			},
+			ExpectedAnswer: &ttnpb.MACCommand_LinkADRAns{
+				ChannelMaskAck:   true,
+				DataRateIndexAck: true,
+				TxPowerIndexAck:  true,
+			},
+			ExpectedEnabled:       []bool{true, false, true},
+			ExpectedDataRateIndex: ttnpb.DATA_RATE_3,
+			ExpectedTxPowerIndex:  4,
+			ExpectedNbTrans:       3,
+		},
+		{
+			Name:    "Keep 1.1",
+			Version: ttnpb.MAC_V1_1,
+			Requests: []*ttnpb.MACCommand_LinkADRReq{
+				{
+					ChannelMask:   channelMask(1, 2),
+					DataRateIndex: ttnpb.DATA_RATE_15,
+					TxPowerIndex:  15,
+				},
+			},
+			ExpectedAnswer: &ttnpb.MACCommand_LinkADRAns{
+				ChannelMaskAck:   true,
+				DataRateIndexAck: true,
+				TxPowerIndexAck:  true,
+			},
+			ExpectedEnabled:       []bool{false, true, true},
+			ExpectedDataRateIndex: ttnpb.DATA_RATE_2,
+			ExpectedTxPowerIndex:  1,
+			ExpectedNbTrans:       1,
+		},
+		{
+			Name:    "Index 15 before 1.1",
+			Version: ttnpb.MAC_V1_0_3,
+			Requests: []*ttnpb.MACCommand_LinkADRReq{
+				{
+					ChannelMask:   channelMask(0, 1, 2),
+					DataRateIndex: ttnpb.DATA_RATE_15,
+					TxPowerIndex:  15,
+				},
+			},
+			ExpectedAnswer: &ttnpb.MACCommand_LinkADRAns{
+				ChannelMaskAck: true,
+			},
+			ExpectedEnabled:       []bool{true, true, true},
+			ExpectedDataRateIndex: ttnpb.DATA_RATE_2,
+			ExpectedTxPowerIndex:  1,
+			ExpectedNbTrans:       1,
+		},
+		{
+			Name:    "Undefined channel",
+			Version: ttnpb.MAC_V1_0_3,
+			Requests: []*ttnpb.MACCommand_LinkADRReq{
+				{
+					ChannelMask:   channelMask(0, 5),
+					DataRateIndex: ttnpb.DATA_RATE_5,
+					TxPowerIndex:  3,
+				},
+			},
+			ExpectedAnswer: &ttnpb.MACCommand_LinkADRAns{
+				DataRateIndexAck: true,
+				TxPowerIndexAck:  true,
+			},
+			ExpectedEnabled:       []bool{true, true, true},
+			ExpectedDataRateIndex: ttnpb.DATA_RATE_2,
+			ExpectedTxPowerIndex:  1,
+			ExpectedNbTrans:       1,
+		},
+		{
+			Name:    "No channels",
+			Version: ttnpb.MAC_V1_0_3,
+			Requests: []*ttnpb.MACCommand_LinkADRReq{
+				{
+					ChannelMask:   channelMask(),
+					DataRateIndex: ttnpb.DATA_RATE_5,
+					TxPowerIndex:  3,
+				},
+			},
+			ExpectedAnswer: &ttnpb.MACCommand_LinkADRAns{
+				TxPowerIndexAck: true,
+			},
+			ExpectedEnabled:       []bool{true, true, true},
+			ExpectedDataRateIndex: ttnpb.DATA_RATE_2,
+			ExpectedTxPowerIndex:  1,
+			ExpectedNbTrans:       1,
+		},
+		{
+			Name:    "Unsupported data rate",
+			Version: ttnpb.MAC_V1_0_3,
+			Requests: []*ttnpb.MACCommand_LinkADRReq{
+				{
+					ChannelMask:   channelMask(0, 1, 2),
+					DataRateIndex: ttnpb.DATA_RATE_7,
+					TxPowerIndex:  3,
+				},
+			},
+			ExpectedAnswer: &ttnpb.MACCommand_LinkADRAns{
+				ChannelMaskAck:  true,
+				TxPowerIndexAck: true,
+			},
+			ExpectedEnabled:       []bool{true, true, true},
+			ExpectedDataRateIndex: ttnpb.DATA_RATE_2,
+			ExpectedTxPowerIndex:  1,
+			ExpectedNbTrans:       1,
+		},
+		{
+			Name:    "Unsupported transmission power",
+			Version: ttnpb.MAC_V1_0_3,
+			Requests: []*ttnpb.MACCommand_LinkADRReq{
+				{
+					ChannelMask:   channelMask(0, 1, 2),
+					DataRateIndex: ttnpb.DATA_RATE_5,
+					TxPowerIndex:  9,
+				},
+			},
+			ExpectedAnswer: &ttnpb.MACCommand_LinkADRAns{
+				ChannelMaskAck:   true,
+				DataRateIndexAck: true,
+			},
+			ExpectedEnabled:       []bool{true, true, true},
+			ExpectedDataRateIndex: ttnpb.DATA_RATE_2,
+			ExpectedTxPowerIndex:  1,
+			ExpectedNbTrans:       1,
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+			d := newMACTestDevice(t, tc.Version)
+
+			cmds := make([]*ttnpb.MACCommand, 0, len(tc.Requests))
+			for _, req := range tc.Requests {
+				cmds = append(cmds, req.MACCommand())
+			}
+			d.handleMACCommands(cmds)
+
+			a.So(d.result.MACCommands[ttnpb.CID_LINK_ADR.String()], should.Equal, uint32(len(tc.Requests)))
+			if a.So(d.dev.MACState.QueuedResponses, should.HaveLength, 1) {
+				a.So(d.dev.MACState.QueuedResponses[0].GetLinkADRAns(), should.Resemble, tc.ExpectedAnswer)
+			}
+			params := d.dev.MACState.CurrentParameters
+			a.So(enabledChannels(d), should.Resemble, tc.ExpectedEnabled)
+			a.So(params.ADRDataRateIndex, should.Equal, tc.ExpectedDataRateIndex)
+			a.So(params.ADRTxPowerIndex, should.Equal, tc.ExpectedTxPowerIndex)
+			a.So(params.ADRNbTrans, should.Equal, tc.ExpectedNbTrans)
+		})
+	}
+
+	t.Run("No block before 1.0.2", func(t *testing.T) {
+		a := assertions.New(t)
+		d := newMACTestDevice(t, ttnpb.MAC_V1_0_1)
+
+		d.handleMACCommands([]*ttnpb.MACCommand{
+			(&ttnpb.MACCommand_LinkADRReq{
+				ChannelMask:   channelMask(0),
+				DataRateIndex: ttnpb.DATA_RATE_1,
+			}).MACCommand(),
+			(&ttnpb.MACCommand_LinkADRReq{
+				ChannelMask:   channelMask(1),
+				DataRateIndex: ttnpb.DATA_RATE_3,
+			}).MACCommand(),
+		})
+
+		a.So(d.dev.MACState.QueuedResponses, should.HaveLength, 2)
+		a.So(enabledChannels(d), should.Resemble, []bool{false, true, false})
+		a.So(d.dev.MACState.CurrentParameters.ADRDataRateIndex, should.Equal, ttnpb.DATA_RATE_3)
+	})
+}
+
+func TestHandleRxParamSetupReq(t *testing.T) {
+	for _, tc := range []struct {
+		Name           string
+		Request        *ttnpb.MACCommand_RxParamSetupReq
+		ExpectedAnswer *ttnpb.MACCommand_RxParamSetupAns
+		Applied        bool
+	}{
+		{
+			Name: "Accept",
+			Request: &ttnpb.MACCommand_RxParamSetupReq{
+				Rx1DataRateOffset: 2,
+				Rx2DataRateIndex:  ttnpb.DATA_RATE_3,
+				Rx2Frequency:      869525000,
+			},
+			ExpectedAnswer: &ttnpb.MACCommand_RxParamSetupAns{
+				Rx1DataRateOffsetAck: true,
+				Rx2DataRateIndexAck:  true,
+				Rx2FrequencyAck:      true,
+			},
+			Applied: true,
+		},
+		{
+			Name: "Invalid Rx1 data rate offset",
+			Request: &ttnpb.MACCommand_RxParamSetupReq{
+				Rx1DataRateOffset: 6,
+				Rx2DataRateIndex:  ttnpb.DATA_RATE_3,
+				Rx2Frequency:      869525000,
+			},
+			ExpectedAnswer: &ttnpb.MACCommand_RxParamSetupAns{
+				Rx2DataRateIndexAck: true,
+				Rx2FrequencyAck:     true,
+			},
+		},
+		{
+			Name: "Invalid Rx2 data rate",
+			Request: &ttnpb.MACCommand_RxParamSetupReq{
+				Rx1DataRateOffset: 2,
+				Rx2DataRateIndex:  ttnpb.DATA_RATE_12,
+				Rx2Frequency:      869525000,
+			},
+			ExpectedAnswer: &ttnpb.MACCommand_RxParamSetupAns{
+				Rx1DataRateOffsetAck: true,
+				Rx2FrequencyAck:      true,
+			},
+		},
+		{
+			Name: "Invalid Rx2 frequency",
+			Request: &ttnpb.MACCommand_RxParamSetupReq{
+				Rx1DataRateOffset: 2,
+				Rx2DataRateIndex:  ttnpb.DATA_RATE_3,
+			},
+			ExpectedAnswer: &ttnpb.MACCommand_RxParamSetupAns{
+				Rx1DataRateOffsetAck: true,
+				Rx2DataRateIndexAck:  true,
+			},
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+			d := newMACTestDevice(t, ttnpb.MAC_V1_0_3)
+			before := d.dev.MACState.CurrentParameters
+
+			d.handleMACCommands([]*ttnpb.MACCommand{tc.Request.MACCommand()})
+
+			if a.So(d.dev.MACState.QueuedResponses, should.HaveLength, 1) {
+				a.So(d.dev.MACState.QueuedResponses[0].GetRxParamSetupAns(), should.Resemble, tc.ExpectedAnswer)
+			}
+			params := d.dev.MACState.CurrentParameters
+			if tc.Applied {
+				a.So(params.Rx1DataRateOffset, should.Equal, tc.Request.Rx1DataRateOffset)
+				a.So(params.Rx2DataRateIndex, should.Equal, tc.Request.Rx2DataRateIndex)
+				a.So(params.Rx2Frequency, should.Equal, tc.Request.Rx2Frequency)
+			} else {
+				a.So(params.Rx1DataRateOffset, should.Equal, before.Rx1DataRateOffset)
+				a.So(params.Rx2DataRateIndex, should.Equal, before.Rx2DataRateIndex)
+				a.So(params.Rx2Frequency, should.Equal, before.Rx2Frequency)
+			}
+		})
+	}
+}
+
+func TestHandleNewChannelReq(t *testing.T) {
+	for _, tc := range []struct {
+		Name            string
+		Request         *ttnpb.MACCommand_NewChannelReq
+		ExpectedAnswer  *ttnpb.MACCommand_NewChannelAns
+		ExpectedChannel *ttnpb.MACParameters_Channel
+	}{
+		{
+			Name: "Accept",
+			Request: &ttnpb.MACCommand_NewChannelReq{
+				ChannelIndex:     3,
+				Frequency:        867100000,
+				MinDataRateIndex: ttnpb.DATA_RATE_0,
+				MaxDataRateIndex: ttnpb.DATA_RATE_5,
+			},
+			ExpectedAnswer: &ttnpb.MACCommand_NewChannelAns{
+				FrequencyAck: true,
+				DataRateAck:  true,
+			},
+			ExpectedChannel: &ttnpb.MACParameters_Channel{
+				UplinkFrequency:   867100000,
+				DownlinkFrequency: 867100000,
+				MinDataRateIndex:  ttnpb.DATA_RATE_0,
+				MaxDataRateIndex:  ttnpb.DATA_RATE_5,
+				EnableUplink:      true,
+			},
+		},
+		{
+			Name: "Disable",
+			Request: &ttnpb.MACCommand_NewChannelReq{
+				ChannelIndex:     7,
+				MinDataRateIndex: ttnpb.DATA_RATE_0,
+				MaxDataRateIndex: ttnpb.DATA_RATE_5,
+			},
+			ExpectedAnswer: &ttnpb.MACCommand_NewChannelAns{
+				FrequencyAck: true,
+				DataRateAck:  true,
+			},
+			ExpectedChannel: &ttnpb.MACParameters_Channel{
+				MinDataRateIndex: ttnpb.DATA_RATE_0,
+				MaxDataRateIndex: ttnpb.DATA_RATE_5,
+			},
+		},
+		{
+			Name: "Default channel",
+			Request: &ttnpb.MACCommand_NewChannelReq{
+				ChannelIndex:     1,
+				Frequency:        867100000,
+				MinDataRateIndex: ttnpb.DATA_RATE_0,
+				MaxDataRateIndex: ttnpb.DATA_RATE_5,
+			},
+			ExpectedAnswer: &ttnpb.MACCommand_NewChannelAns{
+				DataRateAck: true,
+			},
+		},
+		{
+			Name: "Channel index too high",
+			Request: &ttnpb.MACCommand_NewChannelReq{
+				ChannelIndex:     16,
+				Frequency:        867100000,
+				MinDataRateIndex: ttnpb.DATA_RATE_0,
+				MaxDataRateIndex: ttnpb.DATA_RATE_5,
+			},
+			ExpectedAnswer: &ttnpb.MACCommand_NewChannelAns{
+				DataRateAck: true,
+			},
+		},
+		{
+			Name: "Invalid data rate range",
+			Request: &ttnpb.MACCommand_NewChannelReq{
+				ChannelIndex:     3,
+				Frequency:        867100000,
+				MinDataRateIndex: ttnpb.DATA_RATE_5,
+				MaxDataRateIndex: ttnpb.DATA_RATE_0,
+			},
+			ExpectedAnswer: &ttnpb.MACCommand_NewChannelAns{
+				FrequencyAck: true,
+			},
+		},
+		{
+			Name: "Undefined data rate",
+			Request: &ttnpb.MACCommand_NewChannelReq{
+				ChannelIndex:     3,
+				Frequency:        867100000,
+				MinDataRateIndex: ttnpb.DATA_RATE_0,
+				MaxDataRateIndex: ttnpb.DATA_RATE_9,
+			},
+			ExpectedAnswer: &ttnpb.MACCommand_NewChannelAns{
+				FrequencyAck: true,
+			},
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+			d := newMACTestDevice(t, ttnpb.MAC_V1_0_3)
+
+			d.handleMACCommands([]*ttnpb.MACCommand{tc.Request.MACCommand()})
+
+			if a.So(d.dev.MACState.QueuedResponses, should.HaveLength, 1) {
+				a.So(d.dev.MACState.QueuedResponses[0].GetNewChannelAns(), should.Resemble, tc.ExpectedAnswer)
+			}
+			channels := d.dev.MACState.CurrentParameters.Channels
+			if tc.ExpectedChannel == nil {
+				a.So(channels, should.HaveLength, 3)
+				return
+			}
+			if a.So(uint32(len(channels)), should.BeGreaterThan, tc.Request.ChannelIndex) {
+				a.So(channels[tc.Request.ChannelIndex], should.Resemble, tc.ExpectedChannel)
+			}
+		})
+	}
+}
@@ -0,0 +1,62 @@
+// Copyright © 2026 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package simulator implements simulated LoRaWAN end devices and gateways.
+package simulator
+
+import (
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+)
+
+var (
+	errNoDevEUI      = errors.DefineInvalidArgument("no_dev_eui", "no DevEUI set")
+	errNoJoinEUI     = errors.DefineInvalidArgument("no_join_eui", "no JoinEUI set")
+	errNoRootKeys    = errors.DefineInvalidArgument("no_root_keys", "no root keys set")
+	errNoSession     = errors.DefineInvalidArgument("no_session", "no session set for device that does not support join")
+	errNoSessionKeys = errors.DefineInvalidArgument("no_session_keys", "no session keys set")
+	errInterval      = errors.DefineInvalidArgument("interval", "interval must be positive")
+	errNoGatewayEUI  = errors.DefineInvalidArgument("no_gateway_eui", "no gateway EUI set")
+	errDataRateIndex = errors.DefineInvalidArgument("data_rate_index", "invalid data rate index `{data_rate_index}`")
+	errNoChannel     = errors.DefineFailedPrecondition("no_channel", "no enabled channel for data rate index `{data_rate_index}`")
+)
+
+// Gateway is a simulated gateway that is connected to a Gateway Server.
+type Gateway interface {
+	// Send sends the uplink message to the Gateway Server.
+	Send(*ttnpb.UplinkMessage) error
+	// Downlinks returns the downlink messages received from the Gateway Server.
+	// The channel is closed when the connection is closed.
+	Downlinks() <-chan *ttnpb.DownlinkMessage
+	// Close closes the connection.
+	Close() error
+}
+
+// Dispatch passes the downlink messages received by the gateway to the devices until the connection is closed.
+// It returns the number of downlink messages that were not handled by any of the devices.
+func Dispatch(gtw Gateway, devices []*Device) (unhandled int) {
+	for msg := range gtw.Downlinks() {
+		handled := false
+		for _, dev := range devices {
+			if dev.HandleDownlink(msg) {
+				handled = true
+				break
+			}
+		}
+		if !handled {
+			unhandled++
+		}
+	}
+	return unhandled
+}
@@ -16,16 +16,20 @@ package commands
 
 import (
 	"context"
+	"strings"
 	"time"
 
 	pbtypes "github.com/gogo/protobuf/types"
 	"github.com/jinzhu/gorm"
 	"github.com/spf13/cobra"
 	"go.thethings.network/lorawan-stack/pkg/auth"
+	"go.thethings.network/lorawan-stack/pkg/errors"
 	"go.thethings.network/lorawan-stack/pkg/identityserver/store"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
 )
 
+var errUnknownGrant = errors.DefineInvalidArgument("unknown_grant", "unknown grant `{grant}`")
+
 var (
 	createOAuthClient = &cobra.Command{
 		Use:   "create-oauth-client",
@@ -88,6 +92,18 @@ var (
 			if err != nil {
 				return err
 			}
+			grantNames, err := cmd.Flags().GetStringSlice("grants")
+			if err != nil {
+				return err
+			}
+			grants := make([]ttnpb.GrantType, 0, len(grantNames))
+			for _, grantName := range grantNames {
+				grant, ok := ttnpb.GrantType_value["GRANT_"+strings.ToUpper(grantName)]
+				if !ok {
+					return errUnknownGrant.WithAttributes("grant", grantName)
+				}
+				grants = append(grants, ttnpb.GrantType(grant))
+			}
 
 			cliFieldMask := &pbtypes.FieldMask{Paths: []string{
 				"name",
@@ -116,7 +132,7 @@ var (
 				cli.State = ttnpb.STATE_APPROVED
 				cli.SkipAuthorization = authorized
 				cli.Endorsed = endorsed
-				cli.Grants = []ttnpb.GrantType{ttnpb.GRANT_AUTHORIZATION_CODE, ttnpb.GRANT_REFRESH_TOKEN}
+				cli.Grants = grants
 				cli.Rights = []ttnpb.Right{ttnpb.RIGHT_ALL}
 
 				if cliExists {
@@ -168,5 +184,6 @@ func init() {
 	createOAuthClient.Flags().StringSlice("redirect-uri", []string{}, "Redirect URIs of the OAuth client")
 	createOAuthClient.Flags().Bool("authorized", true, "Mark OAuth client as pre-authorized")
 	createOAuthClient.Flags().Bool("endorsed", true, "Mark OAuth client as endorsed ")
+	createOAuthClient.Flags().StringSlice("grants", []string{"authorization_code", "refresh_token"}, "Grants of the OAuth client (authorization_code, password, refresh_token, device_code)")
 	isDBCommand.AddCommand(createOAuthClient)
 }
@@ -29,6 +29,7 @@ import (
 	asredis "go.thethings.network/lorawan-stack/pkg/applicationserver/redis"
 	"go.thethings.network/lorawan-stack/pkg/component"
 	"go.thethings.network/lorawan-stack/pkg/console"
+	"go.thethings.network/lorawan-stack/pkg/devicerepository"
 	"go.thethings.network/lorawan-stack/pkg/devicetemplateconverter"
 	"go.thethings.network/lorawan-stack/pkg/errors"
 	"go.thethings.network/lorawan-stack/pkg/events"
@@ -48,7 +49,7 @@ import (
 var errUnknownComponent = errors.DefineInvalidArgument("unknown_component", "unknown component `{component}`")
 
 var startCommand = &cobra.Command{
-	Use:   "start [is|gs|ns|as|js|console|gcs|dtc|qrg|all]... [flags]",
+	Use:   "start [is|gs|ns|as|js|console|gcs|dtc|qrg|dr|all]... [flags]",
 	Short: "Start The Things Stack",
 	RunE: func(cmd *cobra.Command, args []string) error {
 		var start struct {
@@ -61,6 +62,7 @@ var startCommand = &cobra.Command{
 			GatewayConfigurationServer bool
 			DeviceTemplateConverter    bool
 			QRCodeGenerator            bool
+			DeviceRepository           bool
 		}
 		startDefault := len(args) == 0
 		for _, arg := range args {
@@ -91,6 +93,8 @@ var startCommand = &cobra.Command{
 				start.DeviceTemplateConverter = true
 			case "qrg":
 				start.QRCodeGenerator = true
+			case "dr":
+				start.DeviceRepository = true
 			case "all":
 				start.IdentityServer = true
 				start.GatewayServer = true
@@ -101,6 +105,7 @@ var startCommand = &cobra.Command{
 				start.GatewayConfigurationServer = true
 				start.DeviceTemplateConverter = true
 				start.QRCodeGenerator = true
+				start.DeviceRepository = true
 			default:
 				return errUnknownComponent.WithAttributes("component", arg)
 			}
@@ -164,6 +169,10 @@ var startCommand = &cobra.Command{
 				Redis:     config.Redis,
 				Namespace: []string{"ns", "devices"},
 			})}
+			config.NS.EndDeviceProfiles = &nsredis.EndDeviceProfileRegistry{Redis: redis.New(&redis.Config{
+				Redis:     config.Redis,
+				Namespace: []string{"ns", "profiles"},
+			})}
 			nsDownlinkTasks := nsredis.NewDownlinkTaskQueue(redis.New(&redis.Config{
 				Redis:     config.Redis,
 				Namespace: []string{"ns", "tasks"},
@@ -266,6 +275,15 @@ var startCommand = &cobra.Command{
 			_ = qrg
 		}
 
+		if start.DeviceRepository || startDefault {
+			logger.Info("Setting up Device Repository")
+			dr, err := devicerepository.New(c, &config.DR)
+			if err != nil {
+				return shared.ErrInitializeDeviceRepository.WithCause(err)
+			}
+			_ = dr
+		}
+
 		if rootRedirect != nil {
 			c.RegisterWeb(rootRedirect)
 		}
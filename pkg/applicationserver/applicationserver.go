@@ -48,7 +48,9 @@ import (
 	"go.thethings.network/lorawan-stack/pkg/messageprocessors"
 	"go.thethings.network/lorawan-stack/pkg/messageprocessors/cayennelpp"
 	"go.thethings.network/lorawan-stack/pkg/messageprocessors/javascript"
+	"go.thethings.network/lorawan-stack/pkg/messageprocessors/wasm"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/types"
 	"go.thethings.network/lorawan-stack/pkg/unique"
 	"google.golang.org/grpc"
 )
@@ -70,6 +72,8 @@ type ApplicationServer struct {
 	webhookTemplates *web.TemplateStore
 	pubsub           *pubsub.PubSub
 	appPackages      packages.Server
+	locationSolvers  []LocationSolver
+	locationQueues   []chan *locationRequest
 
 	links              sync.Map
 	linkErrors         sync.Map
@@ -126,6 +130,11 @@ func New(c *component.Component, conf *Config) (as *ApplicationServer, err error
 		return nil, err
 	}
 
+	wasmOptions := conf.Formatters.WASM
+	if wasmOptions == (wasm.Options{}) {
+		wasmOptions = wasm.DefaultOptions
+	}
+	wasmFormatter := wasm.New(wasmOptions)
 	as = &ApplicationServer{
 		Component:      c,
 		ctx:            ctx,
@@ -140,10 +149,12 @@ func New(c *component.Component, conf *Config) (as *ApplicationServer, err error
 			upFormatters: map[ttnpb.PayloadFormatter]messageprocessors.PayloadDecoder{
 				ttnpb.PayloadFormatter_FORMATTER_JAVASCRIPT: javascript.New(),
 				ttnpb.PayloadFormatter_FORMATTER_CAYENNELPP: cayennelpp.New(),
+				ttnpb.PayloadFormatter_FORMATTER_WASM:       wasmFormatter,
 			},
 			downFormatters: map[ttnpb.PayloadFormatter]messageprocessors.PayloadEncoder{
 				ttnpb.PayloadFormatter_FORMATTER_JAVASCRIPT: javascript.New(),
 				ttnpb.PayloadFormatter_FORMATTER_CAYENNELPP: cayennelpp.New(),
+				ttnpb.PayloadFormatter_FORMATTER_WASM:       wasmFormatter,
 			},
 		},
 		interopClient: interopCl,
@@ -224,6 +235,20 @@ func New(c *component.Component, conf *Config) (as *ApplicationServer, err error
 		c.RegisterGRPC(as.appPackages)
 	}
 
+	if as.locationSolvers, err = conf.LocationSolvers.NewLocationSolvers(); err != nil {
+		return nil, err
+	} else if len(as.locationSolvers) > 0 {
+		workers := conf.LocationSolvers.Workers
+		if workers < 1 {
+			workers = 1
+		}
+		as.locationQueues = make([]chan *locationRequest, workers)
+		for i := range as.locationQueues {
+			as.locationQueues[i] = make(chan *locationRequest, conf.LocationSolvers.QueueSize)
+		}
+		c.RegisterTask(as.Context(), "solve_locations", as.runLocationSolvers, component.TaskRestartOnFailure)
+	}
+
 	c.RegisterGRPC(as)
 	if as.linkMode == LinkAll {
 		c.RegisterTask(as.Context(), "link_all", as.linkAll, component.TaskRestartOnFailure)
@@ -511,15 +536,17 @@ func (as *ApplicationServer) handleUp(ctx context.Context, up *ttnpb.Application
 	case *ttnpb.ApplicationUp_DownlinkQueueInvalidated:
 		return as.handleDownlinkQueueInvalidated(ctx, up.EndDeviceIdentifiers, p.DownlinkQueueInvalidated, link)
 	case *ttnpb.ApplicationUp_DownlinkQueued:
-		return as.decryptDownlinkMessage(ctx, up.EndDeviceIdentifiers, p.DownlinkQueued)
+		return as.decryptDownlinkMessage(ctx, up.EndDeviceIdentifiers, p.DownlinkQueued, link)
 	case *ttnpb.ApplicationUp_DownlinkSent:
-		return as.decryptDownlinkMessage(ctx, up.EndDeviceIdentifiers, p.DownlinkSent)
+		return as.decryptDownlinkMessage(ctx, up.EndDeviceIdentifiers, p.DownlinkSent, link)
 	case *ttnpb.ApplicationUp_DownlinkFailed:
-		return as.decryptDownlinkMessage(ctx, up.EndDeviceIdentifiers, &p.DownlinkFailed.ApplicationDownlink)
+		return as.decryptDownlinkMessage(ctx, up.EndDeviceIdentifiers, &p.DownlinkFailed.ApplicationDownlink, link)
 	case *ttnpb.ApplicationUp_DownlinkAck:
-		return as.decryptDownlinkMessage(ctx, up.EndDeviceIdentifiers, p.DownlinkAck)
+		return as.decryptDownlinkMessage(ctx, up.EndDeviceIdentifiers, p.DownlinkAck, link)
 	case *ttnpb.ApplicationUp_DownlinkNack:
 		return as.handleDownlinkNack(ctx, up.EndDeviceIdentifiers, p.DownlinkNack, link)
+	case *ttnpb.ApplicationUp_SessionSwitch:
+		return as.handleSessionSwitch(ctx, up.EndDeviceIdentifiers, p.SessionSwitch, link)
 	default:
 		return nil
 	}
@@ -590,7 +617,6 @@ func (as *ApplicationServer) handleJoinAccept(ctx context.Context, ids ttnpb.End
 
 func (as *ApplicationServer) handleUplink(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, uplink *ttnpb.ApplicationUplink, link *link) error {
 	ctx = log.NewContextWithField(ctx, "session_key_id", uplink.SessionKeyID)
-	logger := log.FromContext(ctx)
 	dev, err := as.deviceRegistry.Set(ctx, ids,
 		[]string{
 			"formatters",
@@ -605,52 +631,11 @@ func (as *ApplicationServer) handleUplink(ctx context.Context, ids ttnpb.EndDevi
 				return nil, nil, errDeviceNotFound.WithAttributes("device_uid", unique.ID(ctx, ids))
 			}
 			if dev.Session == nil || !bytes.Equal(dev.Session.SessionKeyID, uplink.SessionKeyID) {
-				previousSession := dev.Session
-				if dev.PendingSession != nil && bytes.Equal(dev.PendingSession.SessionKeyID, uplink.SessionKeyID) {
-					logger.Debug("Switch to pending session")
-					dev.Session = dev.PendingSession
-					mask = append(mask, "session")
-				} else {
-					appSKey, err := as.fetchAppSKey(ctx, ids, uplink.SessionKeyID)
-					if err != nil {
-						return nil, nil, errFetchAppSKey.WithCause(err)
-					}
-					dev.Session = &ttnpb.Session{
-						DevAddr: *ids.DevAddr,
-						SessionKeys: ttnpb.SessionKeys{
-							SessionKeyID: uplink.SessionKeyID,
-							AppSKey:      &appSKey,
-						},
-						StartedAt: time.Now().UTC(),
-					}
-					dev.DevAddr = ids.DevAddr
-					mask = append(mask, "session", "ids.dev_addr")
-					logger.Debug("Restored session")
-				}
-				dev.PendingSession = nil
-				mask = append(mask, "pending_session")
-
-				// At this point, the application downlink queue in the Network Server is invalid; recalculation is necessary.
-				// Next AFCntDown 1 is assumed. If this is a LoRaWAN 1.0.x end device and the Network Server sent MAC layer
-				// downlink already, the Network Server will trigger the DownlinkQueueInvalidated event. Therefore, this
-				// recalculation may result in another recalculation.
-				client := ttnpb.NewAsNsClient(link.conn)
-				res, err := client.DownlinkQueueList(ctx, &ids, link.callOpts...)
+				sessionMask, err := as.switchSession(ctx, ids, dev, uplink.SessionKeyID, *ids.DevAddr, link)
 				if err != nil {
-					log.WithError(err).Warn("Failed to list downlink queue for recalculation; clearing the downlink queue")
-					req := &ttnpb.DownlinkQueueRequest{
-						EndDeviceIdentifiers: ids,
-					}
-					_, err = client.DownlinkQueueReplace(ctx, req, link.callOpts...)
-					if err != nil {
-						log.WithError(err).Warn("Failed to clear the downlink queue; any queued items in the Network Server are invalid")
-						events.Publish(evtInvalidQueueDataDown(ctx, ids, err))
-					} else {
-						events.Publish(evtLostQueueDataDown(ctx, ids, err))
-					}
-				} else if err := as.recalculateDownlinkQueue(ctx, dev, previousSession, res.Downlinks, 1, link); err != nil {
-					log.WithError(err).Warn("Failed to recalculate downlink queue")
+					return nil, nil, err
 				}
+				mask = append(mask, sessionMask...)
 			} else if dev.Session.AppSKey == nil {
 				return nil, nil, errNoAppSKey
 			}
@@ -668,7 +653,98 @@ func (as *ApplicationServer) handleUplink(ctx context.Context, ids ttnpb.EndDevi
 		uplink.AppSKey = dev.Session.AppSKey
 		uplink.LastAFCntDown = dev.Session.LastAFCntDown
 	}
-	// TODO: Run uplink messages through location solvers async (https://github.com/TheThingsNetwork/lorawan-stack/issues/37)
+	as.solveLocation(ctx, ids, uplink, link)
+	return nil
+}
+
+// switchSession switches the session of the given end device to the session identified by the given session key ID.
+// If the pending session matches, it becomes the current session. Otherwise, the session is restored by fetching the
+// AppSKey from the Join Server. The downlink queue in the Network Server is recalculated for the new session.
+// This should be called as part of a device registry transaction. The returned field mask contains the changed paths.
+func (as *ApplicationServer) switchSession(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, dev *ttnpb.EndDevice, sessionKeyID []byte, devAddr types.DevAddr, link *link) ([]string, error) {
+	logger := log.FromContext(ctx)
+	var mask []string
+	previousSession := dev.Session
+	if dev.PendingSession != nil && bytes.Equal(dev.PendingSession.SessionKeyID, sessionKeyID) {
+		logger.Debug("Switch to pending session")
+		dev.Session = dev.PendingSession
+		mask = append(mask, "session")
+	} else {
+		appSKey, err := as.fetchAppSKey(ctx, ids, sessionKeyID)
+		if err != nil {
+			return nil, errFetchAppSKey.WithCause(err)
+		}
+		dev.Session = &ttnpb.Session{
+			DevAddr: devAddr,
+			SessionKeys: ttnpb.SessionKeys{
+				SessionKeyID: sessionKeyID,
+				AppSKey:      &appSKey,
+			},
+			StartedAt: time.Now().UTC(),
+		}
+		dev.DevAddr = &devAddr
+		mask = append(mask, "session", "ids.dev_addr")
+		logger.Debug("Restored session")
+	}
+	dev.PendingSession = nil
+	mask = append(mask, "pending_session")
+
+	// At this point, the application downlink queue in the Network Server is invalid; recalculation is necessary.
+	// Next AFCntDown 1 is assumed. If this is a LoRaWAN 1.0.x end device and the Network Server sent MAC layer
+	// downlink already, the Network Server will trigger the DownlinkQueueInvalidated event. Therefore, this
+	// recalculation may result in another recalculation.
+	client := ttnpb.NewAsNsClient(link.conn)
+	res, err := client.DownlinkQueueList(ctx, &ids, link.callOpts...)
+	if err != nil {
+		log.WithError(err).Warn("Failed to list downlink queue for recalculation; clearing the downlink queue")
+		req := &ttnpb.DownlinkQueueRequest{
+			EndDeviceIdentifiers: ids,
+		}
+		_, err = client.DownlinkQueueReplace(ctx, req, link.callOpts...)
+		if err != nil {
+			log.WithError(err).Warn("Failed to clear the downlink queue; any queued items in the Network Server are invalid")
+			events.Publish(evtInvalidQueueDataDown(ctx, ids, err))
+		} else {
+			events.Publish(evtLostQueueDataDown(ctx, ids, err))
+		}
+	} else if err := as.recalculateDownlinkQueue(ctx, dev, previousSession, res.Downlinks, 1, link); err != nil {
+		log.WithError(err).Warn("Failed to recalculate downlink queue")
+	}
+	return mask, nil
+}
+
+func (as *ApplicationServer) handleSessionSwitch(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, sessionSwitch *ttnpb.ApplicationSessionSwitch, link *link) error {
+	ctx = log.NewContextWithField(ctx, "session_key_id", sessionSwitch.SessionKeyID)
+	ids.DevAddr = &sessionSwitch.DevAddr
+	_, err := as.deviceRegistry.Set(ctx, ids,
+		[]string{
+			"pending_session",
+			"session",
+			"skip_payload_crypto",
+		},
+		func(dev *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error) {
+			if dev == nil {
+				return nil, nil, errDeviceNotFound.WithAttributes("device_uid", unique.ID(ctx, ids))
+			}
+			if dev.Session != nil && bytes.Equal(dev.Session.SessionKeyID, sessionSwitch.SessionKeyID) {
+				if dev.PendingSession == nil {
+					return dev, nil, nil
+				}
+				// The end device kept using the current session, so the pending session is no longer valid.
+				dev.PendingSession = nil
+				return dev, []string{"pending_session"}, nil
+			}
+			mask, err := as.switchSession(ctx, ids, dev, sessionSwitch.SessionKeyID, sessionSwitch.DevAddr, link)
+			if err != nil {
+				return nil, nil, err
+			}
+			return dev, mask, nil
+		},
+	)
+	if err != nil {
+		return err
+	}
+	events.Publish(evtSwitchSession(ctx, ids, nil))
 	return nil
 }
 
@@ -720,14 +796,19 @@ func (as *ApplicationServer) handleDownlinkNack(ctx context.Context, ids ttnpb.E
 		}
 	}
 	// Decrypt the message as it will be sent to upstream after handling it.
-	if err := as.decryptDownlinkMessage(ctx, ids, msg); err != nil {
+	if err := as.decryptDownlinkMessage(ctx, ids, msg, link); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (as *ApplicationServer) decryptDownlinkMessage(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, msg *ttnpb.ApplicationDownlink) error {
-	dev, err := as.deviceRegistry.Get(ctx, ids, []string{"session", "skip_payload_crypto"})
+func (as *ApplicationServer) decryptDownlinkMessage(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, msg *ttnpb.ApplicationDownlink, link *link) error {
+	dev, err := as.deviceRegistry.Get(ctx, ids, []string{
+		"formatters",
+		"session",
+		"skip_payload_crypto",
+		"version_ids",
+	})
 	if err != nil {
 		return err
 	}
@@ -745,6 +826,7 @@ func (as *ApplicationServer) decryptDownlinkMessage(ctx context.Context, ids ttn
 	if err != nil {
 		return err
 	}
+	as.decodeDownlink(ctx, dev, msg, link.DefaultFormatters)
 	return nil
 }
 
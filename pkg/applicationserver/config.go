@@ -23,10 +23,12 @@ import (
 	"go.thethings.network/lorawan-stack/pkg/applicationserver/io/packages"
 	"go.thethings.network/lorawan-stack/pkg/applicationserver/io/pubsub"
 	"go.thethings.network/lorawan-stack/pkg/applicationserver/io/web"
+	"go.thethings.network/lorawan-stack/pkg/applicationserver/locationsolver"
 	"go.thethings.network/lorawan-stack/pkg/component"
 	"go.thethings.network/lorawan-stack/pkg/config"
 	"go.thethings.network/lorawan-stack/pkg/errors"
 	"go.thethings.network/lorawan-stack/pkg/log"
+	"go.thethings.network/lorawan-stack/pkg/messageprocessors/wasm"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
 )
 
@@ -61,6 +63,8 @@ type Config struct {
 	PubSub              PubSubConfig              `name:"pubsub" description:"Pub/sub messaging configuration"`
 	ApplicationPackages ApplicationPackagesConfig `name:"application-packages" description:"Application packages configuration"`
 	Interop             InteropConfig             `name:"interop" description:"Interop client configuration"`
+	LocationSolvers     LocationSolversConfig     `name:"location-solvers" description:"Location solvers configuration"`
+	Formatters          FormattersConfig          `name:"formatters" description:"Payload formatters configuration"`
 	DeviceKEKLabel      string                    `name:"device-kek-label" description:"Label of KEK used to encrypt device keys at rest"`
 }
 
@@ -104,6 +108,38 @@ type ApplicationPackagesConfig struct {
 	Registry packages.Registry `name:"-"`
 }
 
+// FormattersConfig contains the configuration of the payload formatters.
+type FormattersConfig struct {
+	WASM wasm.Options `name:"wasm" description:"WebAssembly payload formatter configuration"`
+}
+
+// LocationSolversConfig contains the configuration of the location solvers.
+type LocationSolversConfig struct {
+	Solvers         []string      `name:"solvers" description:"Location solvers to run on uplink messages (centroid, tdoa)"`
+	Timeout         time.Duration `name:"timeout" description:"Timeout of solving the location of an uplink message"`
+	UpdateEndDevice bool          `name:"update-end-device" description:"Store solved locations in the end device locations in the Identity Server"`
+	QueueSize       int           `name:"queue-size" description:"Number of uplink messages to queue per worker"`
+	Workers         int           `name:"workers" description:"Number of workers to solve locations"`
+}
+
+var errLocationSolver = errors.DefineInvalidArgument("location_solver", "invalid location solver `{name}`")
+
+// NewLocationSolvers returns the location solvers based on the configuration.
+func (c LocationSolversConfig) NewLocationSolvers() ([]LocationSolver, error) {
+	solvers := make([]LocationSolver, 0, len(c.Solvers))
+	for _, name := range c.Solvers {
+		switch name {
+		case locationsolver.CentroidService:
+			solvers = append(solvers, locationsolver.Centroid{})
+		case locationsolver.TDOAService:
+			solvers = append(solvers, locationsolver.TDOA{})
+		default:
+			return nil, errLocationSolver.WithAttributes("name", name)
+		}
+	}
+	return solvers, nil
+}
+
 // NewWebhooks returns a new web.Webhooks based on the configuration.
 // If Target is empty, this method returns nil.
 func (c WebhooksConfig) NewWebhooks(ctx context.Context, server io.Server) (web.Webhooks, error) {
@@ -16,6 +16,7 @@ package applicationserver
 
 import (
 	"context"
+	"time"
 
 	"github.com/gogo/protobuf/types"
 	"go.thethings.network/lorawan-stack/pkg/auth/rights"
@@ -99,3 +100,40 @@ func (as *ApplicationServer) GetLinkStats(ctx context.Context, ids *ttnpb.Applic
 	}
 	return stats, nil
 }
+
+// TestUplinkFormatter implements ttnpb.AsServer.
+func (as *ApplicationServer) TestUplinkFormatter(ctx context.Context, req *ttnpb.TestUplinkFormatterRequest) (*ttnpb.TestUplinkFormatterResponse, error) {
+	if err := rights.RequireApplication(ctx, req.ApplicationIdentifiers, ttnpb.RIGHT_APPLICATION_LINK); err != nil {
+		return nil, err
+	}
+	ids := ttnpb.EndDeviceIdentifiers{
+		ApplicationIdentifiers: req.ApplicationIdentifiers,
+	}
+	uplink := &ttnpb.ApplicationUplink{
+		FPort:      req.FPort,
+		FRMPayload: req.FRMPayload,
+	}
+	start := time.Now()
+	err := as.formatter.Decode(ctx, ids, req.VersionIDs, uplink, req.Formatter, req.FormatterParameter)
+	res := &ttnpb.TestUplinkFormatterResponse{
+		DecodedPayload: uplink.DecodedPayload,
+		Warnings:       uplink.DecodedPayloadWarnings,
+		Duration:       time.Since(start),
+	}
+	if err != nil {
+		ttnErr, ok := errors.From(err)
+		if !ok {
+			return nil, err
+		}
+		// Formatters that return multiple errors set the individual errors in the details.
+		for _, d := range ttnErr.Details() {
+			if details, ok := d.(*ttnpb.ErrorDetails); ok {
+				res.Errors = append(res.Errors, details)
+			}
+		}
+		if len(res.Errors) == 0 {
+			res.Errors = append(res.Errors, ttnpb.ErrorDetailsToProto(ttnErr))
+		}
+	}
+	return res, nil
+}
@@ -20,6 +20,7 @@ import (
 	pbtypes "github.com/gogo/protobuf/types"
 	"go.thethings.network/lorawan-stack/pkg/auth/rights"
 	"go.thethings.network/lorawan-stack/pkg/crypto/cryptoutil"
+	"go.thethings.network/lorawan-stack/pkg/devicebatch"
 	"go.thethings.network/lorawan-stack/pkg/errors"
 	"go.thethings.network/lorawan-stack/pkg/events"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
@@ -47,7 +48,7 @@ type asEndDeviceRegistryServer struct {
 
 // Get implements ttnpb.AsEndDeviceRegistryServer.
 func (r asEndDeviceRegistryServer) Get(ctx context.Context, req *ttnpb.GetEndDeviceRequest) (*ttnpb.EndDevice, error) {
-	if err := rights.RequireApplication(ctx, req.ApplicationIdentifiers, ttnpb.RIGHT_APPLICATION_DEVICES_READ); err != nil {
+	if err := rights.RequireEndDevice(ctx, req.EndDeviceIdentifiers, ttnpb.RIGHT_APPLICATION_DEVICES_READ); err != nil {
 		return nil, err
 	}
 
@@ -119,7 +120,7 @@ func (r asEndDeviceRegistryServer) Set(ctx context.Context, req *ttnpb.SetEndDev
 		return nil, errInvalidFieldValue.WithAttributes("field", "session.dev_addr")
 	}
 
-	if err := rights.RequireApplication(ctx, req.EndDevice.ApplicationIdentifiers, ttnpb.RIGHT_APPLICATION_DEVICES_WRITE); err != nil {
+	if err := rights.RequireEndDevice(ctx, req.EndDevice.EndDeviceIdentifiers, ttnpb.RIGHT_APPLICATION_DEVICES_WRITE); err != nil {
 		return nil, err
 	}
 	if ttnpb.HasAnyField(req.FieldMask.Paths,
@@ -201,7 +202,7 @@ func (r asEndDeviceRegistryServer) Set(ctx context.Context, req *ttnpb.SetEndDev
 
 // Delete implements ttnpb.AsEndDeviceRegistryServer.
 func (r asEndDeviceRegistryServer) Delete(ctx context.Context, ids *ttnpb.EndDeviceIdentifiers) (*pbtypes.Empty, error) {
-	if err := rights.RequireApplication(ctx, ids.ApplicationIdentifiers, ttnpb.RIGHT_APPLICATION_DEVICES_WRITE); err != nil {
+	if err := rights.RequireEndDevice(ctx, *ids, ttnpb.RIGHT_APPLICATION_DEVICES_WRITE); err != nil {
 		return nil, err
 	}
 	var evt events.Event
@@ -219,3 +220,18 @@ func (r asEndDeviceRegistryServer) Delete(ctx context.Context, ids *ttnpb.EndDev
 	}
 	return ttnpb.Empty, nil
 }
+
+// BatchGet implements ttnpb.AsEndDeviceRegistryServer.
+func (r asEndDeviceRegistryServer) BatchGet(ctx context.Context, req *ttnpb.BatchGetEndDevicesRequest) (*ttnpb.BatchEndDevicesResponse, error) {
+	return devicebatch.Get(ctx, req, "/ttn.lorawan.v3.AsEndDeviceRegistry/Get", r.Get)
+}
+
+// BatchSet implements ttnpb.AsEndDeviceRegistryServer.
+func (r asEndDeviceRegistryServer) BatchSet(ctx context.Context, req *ttnpb.BatchSetEndDevicesRequest) (*ttnpb.BatchEndDevicesResponse, error) {
+	return devicebatch.Set(ctx, req, "/ttn.lorawan.v3.AsEndDeviceRegistry/Set", r.Set)
+}
+
+// BatchDelete implements ttnpb.AsEndDeviceRegistryServer.
+func (r asEndDeviceRegistryServer) BatchDelete(ctx context.Context, req *ttnpb.BatchDeleteEndDevicesRequest) (*ttnpb.BatchEndDevicesResponse, error) {
+	return devicebatch.Delete(ctx, req, "/ttn.lorawan.v3.AsEndDeviceRegistry/Delete", r.Delete)
+}
@@ -0,0 +1,176 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package applicationserver_test
+
+import (
+	"testing"
+
+	pbtypes "github.com/gogo/protobuf/types"
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/applicationserver"
+	"go.thethings.network/lorawan-stack/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/pkg/component"
+	componenttest "go.thethings.network/lorawan-stack/pkg/component/test"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/unique"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+func TestTestUplinkFormatter(t *testing.T) {
+	a := assertions.New(t)
+
+	c := componenttest.NewComponent(t, &component.Config{})
+	as, err := applicationserver.New(c, &applicationserver.Config{
+		LinkMode: "explicit",
+	})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	componenttest.StartComponent(t, c)
+	defer c.Close()
+
+	appID := ttnpb.ApplicationIdentifiers{ApplicationID: "foo-app"}
+	ctx := test.Context()
+
+	t.Run("PermissionDenied", func(t *testing.T) {
+		a := assertions.New(t)
+		_, err := as.TestUplinkFormatter(ctx, &ttnpb.TestUplinkFormatterRequest{
+			ApplicationIdentifiers: appID,
+			Formatter:              ttnpb.PayloadFormatter_FORMATTER_CAYENNELPP,
+			FPort:                  1,
+			FRMPayload:             []byte{0x01, 0x67, 0x00, 0xf0},
+		})
+		a.So(errors.IsPermissionDenied(err), should.BeTrue)
+	})
+
+	ctx = rights.NewContext(ctx, rights.Rights{
+		ApplicationRights: map[string]*ttnpb.Rights{
+			unique.ID(ctx, appID): {
+				Rights: []ttnpb.Right{ttnpb.RIGHT_APPLICATION_LINK},
+			},
+		},
+	})
+
+	for _, tc := range []struct {
+		Name           string
+		Request        *ttnpb.TestUplinkFormatterRequest
+		DecodedPayload *pbtypes.Struct
+		Warnings       []string
+		ErrorNames     []string
+		ErrorLine      float64
+	}{
+		{
+			Name: "CayenneLPP",
+			Request: &ttnpb.TestUplinkFormatterRequest{
+				Formatter:  ttnpb.PayloadFormatter_FORMATTER_CAYENNELPP,
+				FPort:      1,
+				FRMPayload: []byte{0x01, 0x67, 0x00, 0xf0},
+			},
+			DecodedPayload: &pbtypes.Struct{
+				Fields: map[string]*pbtypes.Value{
+					"temperature_1": {
+						Kind: &pbtypes.Value_NumberValue{NumberValue: 24},
+					},
+				},
+			},
+		},
+		{
+			Name: "JavaScript/Warnings",
+			Request: &ttnpb.TestUplinkFormatterRequest{
+				Formatter: ttnpb.PayloadFormatter_FORMATTER_JAVASCRIPT,
+				FormatterParameter: `function decodeUplink(input) {
+	return {
+		data: { port: input.fPort },
+		warnings: ['not implemented']
+	};
+}`,
+				FPort:      42,
+				FRMPayload: []byte{0x01},
+			},
+			DecodedPayload: &pbtypes.Struct{
+				Fields: map[string]*pbtypes.Value{
+					"port": {
+						Kind: &pbtypes.Value_NumberValue{NumberValue: 42},
+					},
+				},
+			},
+			Warnings: []string{"not implemented"},
+		},
+		{
+			Name: "JavaScript/SyntaxError",
+			Request: &ttnpb.TestUplinkFormatterRequest{
+				Formatter: ttnpb.PayloadFormatter_FORMATTER_JAVASCRIPT,
+				FormatterParameter: `function decodeUplink(input) {
+	return {
+		data: { port: input.fPort ]
+	};
+}`,
+				FPort:      42,
+				FRMPayload: []byte{0x01},
+			},
+			ErrorNames: []string{"syntax"},
+			ErrorLine:  4,
+		},
+		{
+			Name: "JavaScript/Errors",
+			Request: &ttnpb.TestUplinkFormatterRequest{
+				Formatter: ttnpb.PayloadFormatter_FORMATTER_JAVASCRIPT,
+				FormatterParameter: `function decodeUplink(input) {
+	return {
+		errors: ['unknown port', 'invalid length']
+	};
+}`,
+				FPort:      42,
+				FRMPayload: []byte{0x01},
+			},
+			ErrorNames: []string{"output_error", "output_error"},
+		},
+		{
+			Name: "None",
+			Request: &ttnpb.TestUplinkFormatterRequest{
+				Formatter:  ttnpb.PayloadFormatter_FORMATTER_NONE,
+				FPort:      1,
+				FRMPayload: []byte{0x01},
+			},
+			ErrorNames: []string{"formatter_not_configured"},
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+			tc.Request.ApplicationIdentifiers = appID
+			res, err := as.TestUplinkFormatter(ctx, tc.Request)
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			a.So(res.DecodedPayload, should.Resemble, tc.DecodedPayload)
+			a.So(res.Warnings, should.Resemble, tc.Warnings)
+			if len(tc.ErrorNames) == 0 {
+				a.So(res.Errors, should.BeEmpty)
+				return
+			}
+			if !a.So(res.Errors, should.HaveLength, len(tc.ErrorNames)) {
+				t.FailNow()
+			}
+			for i, name := range tc.ErrorNames {
+				a.So(res.Errors[i].Name, should.Equal, name)
+			}
+			if tc.ErrorLine > 0 {
+				a.So(res.Errors[0].Attributes.Fields["line"].GetNumberValue(), should.Equal, tc.ErrorLine)
+			}
+		})
+	}
+}
@@ -66,6 +66,18 @@ func (s *impl) Subscribe(ids *ttnpb.ApplicationIdentifiers, stream ttnpb.AppAs_S
 	if err := rights.RequireApplication(ctx, *ids, ttnpb.RIGHT_APPLICATION_TRAFFIC_READ); err != nil {
 		return err
 	}
+	// If the API key is restricted to specific end devices, only send the traffic of those end devices.
+	restrictedDeviceIDs, err := rights.EndDeviceRestriction(ctx, *ids)
+	if err != nil {
+		return err
+	}
+	var allowed map[string]bool
+	if len(restrictedDeviceIDs) > 0 {
+		allowed = make(map[string]bool, len(restrictedDeviceIDs))
+		for _, deviceID := range restrictedDeviceIDs {
+			allowed[deviceID] = true
+		}
+	}
 
 	if peer, ok := peer.FromContext(ctx); ok {
 		ctx = log.NewContextWithField(ctx, "remote_addr", peer.Addr.String())
@@ -88,6 +100,9 @@ func (s *impl) Subscribe(ids *ttnpb.ApplicationIdentifiers, stream ttnpb.AppAs_S
 		case <-sub.Context().Done():
 			return sub.Context().Err()
 		case up := <-sub.Up():
+			if allowed != nil && !allowed[up.DeviceID] {
+				continue
+			}
 			if err := stream.Send(up.ApplicationUp); err != nil {
 				logger.WithError(err).Warn("Failed to send message")
 				sub.Disconnect(err)
@@ -98,7 +113,7 @@ func (s *impl) Subscribe(ids *ttnpb.ApplicationIdentifiers, stream ttnpb.AppAs_S
 }
 
 func (s *impl) DownlinkQueuePush(ctx context.Context, req *ttnpb.DownlinkQueueRequest) (*pbtypes.Empty, error) {
-	if err := rights.RequireApplication(ctx, req.ApplicationIdentifiers, ttnpb.RIGHT_APPLICATION_TRAFFIC_DOWN_WRITE); err != nil {
+	if err := rights.RequireEndDevice(ctx, req.EndDeviceIdentifiers, ttnpb.RIGHT_APPLICATION_TRAFFIC_DOWN_WRITE); err != nil {
 		return nil, err
 	}
 	if err := s.server.DownlinkQueuePush(ctx, req.EndDeviceIdentifiers, req.Downlinks); err != nil {
@@ -108,7 +123,7 @@ func (s *impl) DownlinkQueuePush(ctx context.Context, req *ttnpb.DownlinkQueueRe
 }
 
 func (s *impl) DownlinkQueueReplace(ctx context.Context, req *ttnpb.DownlinkQueueRequest) (*pbtypes.Empty, error) {
-	if err := rights.RequireApplication(ctx, req.ApplicationIdentifiers, ttnpb.RIGHT_APPLICATION_TRAFFIC_DOWN_WRITE); err != nil {
+	if err := rights.RequireEndDevice(ctx, req.EndDeviceIdentifiers, ttnpb.RIGHT_APPLICATION_TRAFFIC_DOWN_WRITE); err != nil {
 		return nil, err
 	}
 	if err := s.server.DownlinkQueueReplace(ctx, req.EndDeviceIdentifiers, req.Downlinks); err != nil {
@@ -118,7 +133,7 @@ func (s *impl) DownlinkQueueReplace(ctx context.Context, req *ttnpb.DownlinkQueu
 }
 
 func (s *impl) DownlinkQueueList(ctx context.Context, ids *ttnpb.EndDeviceIdentifiers) (*ttnpb.ApplicationDownlinks, error) {
-	if err := rights.RequireApplication(ctx, ids.ApplicationIdentifiers, ttnpb.RIGHT_APPLICATION_TRAFFIC_READ); err != nil {
+	if err := rights.RequireEndDevice(ctx, *ids, ttnpb.RIGHT_APPLICATION_TRAFFIC_READ); err != nil {
 		return nil, err
 	}
 	items, err := s.server.DownlinkQueueList(ctx, *ids)
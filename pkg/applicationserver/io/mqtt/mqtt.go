@@ -36,6 +36,7 @@ import (
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
 	"go.thethings.network/lorawan-stack/pkg/unique"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
 )
 
 const qosUpstream byte = 0
@@ -71,6 +72,8 @@ func (s *srv) accept() error {
 
 		go func() {
 			ctx := log.NewContextWithFields(s.ctx, log.Fields("remote_addr", mqttConn.RemoteAddr().String()))
+			// Make the remote address available for checking the API key restrictions.
+			ctx = peer.NewContext(ctx, &peer.Peer{Addr: mqttConn.RemoteAddr()})
 			conn := &connection{server: s.server, mqtt: mqttConn, format: s.format}
 			if err := conn.setup(ctx); err != nil {
 				log.FromContext(ctx).WithError(err).Warn("Failed to setup connection")
@@ -252,23 +255,35 @@ func (c *connection) Connect(ctx context.Context, info *auth.Info) (context.Cont
 	access := topicAccess{
 		appUID: uid,
 	}
+	// If the API key is restricted to specific end devices, only allow the topics of those end devices.
+	deviceIDs, err := rights.EndDeviceRestriction(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	if len(deviceIDs) == 0 {
+		deviceIDs = []string{topic.PartWildcard}
+	}
 	if err := rights.RequireApplication(ctx, ids, ttnpb.RIGHT_APPLICATION_TRAFFIC_READ); err == nil {
-		access.reads = append(access.reads,
-			c.format.UplinkTopic(uid, topic.PartWildcard),
-			c.format.JoinAcceptTopic(uid, topic.PartWildcard),
-			c.format.DownlinkAckTopic(uid, topic.PartWildcard),
-			c.format.DownlinkNackTopic(uid, topic.PartWildcard),
-			c.format.DownlinkSentTopic(uid, topic.PartWildcard),
-			c.format.DownlinkFailedTopic(uid, topic.PartWildcard),
-			c.format.DownlinkQueuedTopic(uid, topic.PartWildcard),
-			c.format.LocationSolvedTopic(uid, topic.PartWildcard),
-		)
+		for _, deviceID := range deviceIDs {
+			access.reads = append(access.reads,
+				c.format.UplinkTopic(uid, deviceID),
+				c.format.JoinAcceptTopic(uid, deviceID),
+				c.format.DownlinkAckTopic(uid, deviceID),
+				c.format.DownlinkNackTopic(uid, deviceID),
+				c.format.DownlinkSentTopic(uid, deviceID),
+				c.format.DownlinkFailedTopic(uid, deviceID),
+				c.format.DownlinkQueuedTopic(uid, deviceID),
+				c.format.LocationSolvedTopic(uid, deviceID),
+			)
+		}
 	}
 	if err := rights.RequireApplication(ctx, ids, ttnpb.RIGHT_APPLICATION_TRAFFIC_DOWN_WRITE); err == nil {
-		access.writes = append(access.writes,
-			c.format.DownlinkPushTopic(uid, topic.PartWildcard),
-			c.format.DownlinkReplaceTopic(uid, topic.PartWildcard),
-		)
+		for _, deviceID := range deviceIDs {
+			access.writes = append(access.writes,
+				c.format.DownlinkPushTopic(uid, deviceID),
+				c.format.DownlinkReplaceTopic(uid, deviceID),
+			)
+		}
 	}
 	info.Metadata = access
 	info.Interface = c
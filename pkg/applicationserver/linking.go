@@ -334,7 +334,7 @@ func (l *link) sendUp(ctx context.Context, up *ttnpb.ApplicationUp, ack func() e
 	case *ttnpb.ApplicationUp_JoinAccept:
 		p.JoinAccept.AppSKey = nil
 		p.JoinAccept.InvalidatedDownlinks = nil
-	case *ttnpb.ApplicationUp_DownlinkQueueInvalidated:
+	case *ttnpb.ApplicationUp_DownlinkQueueInvalidated, *ttnpb.ApplicationUp_SessionSwitch:
 		return nil
 	}
 
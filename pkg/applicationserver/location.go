@@ -0,0 +1,181 @@
+// Copyright © 2026 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package applicationserver
+
+import (
+	"context"
+	"hash/fnv"
+	"sync"
+	"time"
+
+	pbtypes "github.com/gogo/protobuf/types"
+	"go.thethings.network/lorawan-stack/pkg/applicationserver/io"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/events"
+	"go.thethings.network/lorawan-stack/pkg/log"
+	"go.thethings.network/lorawan-stack/pkg/rpcmetadata"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/unique"
+	"google.golang.org/grpc"
+)
+
+// LocationSolver solves the location of end devices based on their uplink messages.
+type LocationSolver interface {
+	// Name returns the name of the location solver.
+	Name() string
+	// Solve returns the location of the end device that sent the given uplink message.
+	Solve(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, up *ttnpb.ApplicationUplink) (*ttnpb.ApplicationLocation, error)
+}
+
+type locationRequest struct {
+	ctx    context.Context
+	ids    ttnpb.EndDeviceIdentifiers
+	uplink *ttnpb.ApplicationUplink
+	link   *link
+}
+
+var errLocationQueueFull = errors.DefineResourceExhausted("location_queue_full", "the location solver queue is full")
+
+// solveLocation queues the uplink message to be run through the location solvers.
+// The requests of an end device are always handled by the same worker, so that they are handled in order.
+func (as *ApplicationServer) solveLocation(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, uplink *ttnpb.ApplicationUplink, link *link) {
+	if len(as.locationSolvers) == 0 || len(uplink.RxMetadata) == 0 {
+		return
+	}
+	h := fnv.New32a()
+	h.Write([]byte(unique.ID(ctx, ids)))
+	select {
+	case as.locationQueues[h.Sum32()%uint32(len(as.locationQueues))] <- &locationRequest{
+		ctx:    ctx,
+		ids:    ids,
+		uplink: uplink,
+		link:   link,
+	}:
+	default:
+		log.FromContext(ctx).WithError(errLocationQueueFull).Warn("Failed to solve location")
+	}
+}
+
+// runLocationSolvers starts a worker for each location solver queue.
+// This method blocks until the context is done and all workers are done.
+func (as *ApplicationServer) runLocationSolvers(ctx context.Context) error {
+	wg := sync.WaitGroup{}
+	for _, queue := range as.locationQueues {
+		wg.Add(1)
+		go func(queue <-chan *locationRequest) {
+			defer wg.Done()
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case req := <-queue:
+					as.handleLocationRequest(req)
+				}
+			}
+		}(queue)
+	}
+	<-ctx.Done()
+	wg.Wait()
+	return ctx.Err()
+}
+
+// handleLocationRequest runs the uplink message through the location solvers.
+// Solved locations are sent upstream and, if configured, stored in the end device locations in the Identity Server.
+func (as *ApplicationServer) handleLocationRequest(req *locationRequest) {
+	ctx, ids, link := req.ctx, req.ids, req.link
+	if timeout := as.config.LocationSolvers.Timeout; timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+	locations := make(map[string]*ttnpb.Location, len(as.locationSolvers))
+	for _, solver := range as.locationSolvers {
+		logger := log.FromContext(ctx).WithField("location_solver", solver.Name())
+		res, err := solver.Solve(ctx, ids, req.uplink)
+		if err != nil {
+			logger.WithError(err).Debug("Failed to solve location")
+			continue
+		}
+		now := time.Now().UTC()
+		up := &ttnpb.ApplicationUp{
+			EndDeviceIdentifiers: ids,
+			CorrelationIDs:       events.CorrelationIDsFromContext(ctx),
+			ReceivedAt:           &now,
+			Up: &ttnpb.ApplicationUp_LocationSolved{
+				LocationSolved: res,
+			},
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-link.ctx.Done():
+			return
+		case link.upCh <- &io.ContextualApplicationUp{
+			Context:       ctx,
+			ApplicationUp: up,
+		}:
+		}
+		registerForwardUp(ctx, up)
+		locations[res.Service] = &res.Location
+	}
+	if len(locations) == 0 || !as.config.LocationSolvers.UpdateEndDevice {
+		return
+	}
+	if err := as.updateEndDeviceLocations(ctx, ids, locations, link); err != nil {
+		log.FromContext(ctx).WithError(err).Warn("Failed to update end device locations")
+	}
+}
+
+// updateEndDeviceLocations merges the given locations with the end device locations in the Identity Server.
+// This uses the API key of the link, which needs the right to write end devices.
+// The caller must make sure that the locations of an end device are not updated concurrently.
+func (as *ApplicationServer) updateEndDeviceLocations(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, locations map[string]*ttnpb.Location, link *link) error {
+	cc, err := as.GetPeerConn(ctx, ttnpb.ClusterRole_ENTITY_REGISTRY, ids)
+	if err != nil {
+		return err
+	}
+	client := ttnpb.NewEndDeviceRegistryClient(cc)
+	callOpt := grpc.PerRPCCredentials(rpcmetadata.MD{
+		ID:            ids.ApplicationID,
+		AuthType:      "Bearer",
+		AuthValue:     link.APIKey,
+		AllowInsecure: !as.ClusterTLS(),
+	})
+	dev, err := client.Get(ctx, &ttnpb.GetEndDeviceRequest{
+		EndDeviceIdentifiers: ids,
+		FieldMask: pbtypes.FieldMask{
+			Paths: []string{"locations"},
+		},
+	}, callOpt)
+	if err != nil {
+		return err
+	}
+	if dev.Locations == nil {
+		dev.Locations = make(map[string]*ttnpb.Location, len(locations))
+	}
+	for service, location := range locations {
+		dev.Locations[service] = location
+	}
+	_, err = client.Update(ctx, &ttnpb.UpdateEndDeviceRequest{
+		EndDevice: ttnpb.EndDevice{
+			EndDeviceIdentifiers: ids,
+			Locations:            dev.Locations,
+		},
+		FieldMask: pbtypes.FieldMask{
+			Paths: []string{"locations"},
+		},
+	}, callOpt)
+	return err
+}
@@ -0,0 +1,147 @@
+// Copyright © 2026 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package applicationserver
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/applicationserver/io"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+type mockLocationSolver struct {
+	mu    sync.Mutex
+	fCnts []uint32
+}
+
+func (s *mockLocationSolver) Name() string { return "mock" }
+
+func (s *mockLocationSolver) Solve(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, up *ttnpb.ApplicationUplink) (*ttnpb.ApplicationLocation, error) {
+	s.mu.Lock()
+	s.fCnts = append(s.fCnts, up.FCnt)
+	s.mu.Unlock()
+	return &ttnpb.ApplicationLocation{
+		Service: "mock",
+		Location: ttnpb.Location{
+			Latitude:  float64(up.FCnt),
+			Longitude: 4.2,
+			Source:    ttnpb.SOURCE_COMBINED_GEOLOCATION,
+		},
+	}, nil
+}
+
+func TestSolveLocation(t *testing.T) {
+	a := assertions.New(t)
+
+	ctx, cancel := context.WithCancel(test.Context())
+	defer cancel()
+
+	solver := &mockLocationSolver{}
+	as := &ApplicationServer{
+		config:          &Config{},
+		locationSolvers: []LocationSolver{solver},
+		locationQueues: []chan *locationRequest{
+			make(chan *locationRequest, 4),
+			make(chan *locationRequest, 4),
+		},
+	}
+	link := &link{
+		ctx:  ctx,
+		upCh: make(chan *io.ContextualApplicationUp, 4),
+	}
+	ids := ttnpb.EndDeviceIdentifiers{
+		ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: "foo-app"},
+		DeviceID:               "foo-device",
+	}
+
+	// Uplink messages without metadata are not queued.
+	as.solveLocation(ctx, ids, &ttnpb.ApplicationUplink{FCnt: 0}, link)
+	for _, queue := range as.locationQueues {
+		a.So(queue, should.BeEmpty)
+	}
+
+	for fCnt := uint32(1); fCnt <= 3; fCnt++ {
+		as.solveLocation(ctx, ids, &ttnpb.ApplicationUplink{
+			FCnt: fCnt,
+			RxMetadata: []*ttnpb.RxMetadata{
+				{GatewayIdentifiers: ttnpb.GatewayIdentifiers{GatewayID: "foo-gateway"}},
+			},
+		}, link)
+	}
+
+	go as.runLocationSolvers(ctx)
+
+	for fCnt := uint32(1); fCnt <= 3; fCnt++ {
+		select {
+		case <-time.After((1 << 6) * test.Delay):
+			t.Fatal("Timed out waiting for location solved message")
+		case up := <-link.upCh:
+			a.So(up.EndDeviceIdentifiers, should.Resemble, ids)
+			a.So(up.ReceivedAt, should.NotBeNil)
+			a.So(up.Up, should.Resemble, &ttnpb.ApplicationUp_LocationSolved{
+				LocationSolved: &ttnpb.ApplicationLocation{
+					Service: "mock",
+					Location: ttnpb.Location{
+						Latitude:  float64(fCnt),
+						Longitude: 4.2,
+						Source:    ttnpb.SOURCE_COMBINED_GEOLOCATION,
+					},
+				},
+			})
+		}
+	}
+
+	// The uplink messages of an end device are handled in order.
+	solver.mu.Lock()
+	a.So(solver.fCnts, should.Resemble, []uint32{1, 2, 3})
+	solver.mu.Unlock()
+}
+
+func TestSolveLocationQueueFull(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+
+	as := &ApplicationServer{
+		config:          &Config{},
+		locationSolvers: []LocationSolver{&mockLocationSolver{}},
+		locationQueues: []chan *locationRequest{
+			make(chan *locationRequest, 1),
+		},
+	}
+	link := &link{
+		ctx:  ctx,
+		upCh: make(chan *io.ContextualApplicationUp, 1),
+	}
+	ids := ttnpb.EndDeviceIdentifiers{
+		ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: "foo-app"},
+		DeviceID:               "foo-device",
+	}
+	up := &ttnpb.ApplicationUplink{
+		RxMetadata: []*ttnpb.RxMetadata{
+			{GatewayIdentifiers: ttnpb.GatewayIdentifiers{GatewayID: "foo-gateway"}},
+		},
+	}
+
+	// Queueing does not block when the queue is full.
+	as.solveLocation(ctx, ids, up, link)
+	as.solveLocation(ctx, ids, up, link)
+	a.So(as.locationQueues[0], should.HaveLength, 1)
+}
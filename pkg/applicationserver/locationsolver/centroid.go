@@ -0,0 +1,91 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package locationsolver
+
+import (
+	"context"
+	"math"
+	"strconv"
+
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+)
+
+// CentroidService is the service name of the gateway centroid location solver.
+const CentroidService = "centroid"
+
+// Centroid is a location solver that estimates the location of an end device as the centroid of the locations
+// of the gateways that received the uplink message, weighted by the received signal strength.
+type Centroid struct{}
+
+// Name implements applicationserver.LocationSolver.
+func (Centroid) Name() string { return CentroidService }
+
+// Solve implements applicationserver.LocationSolver.
+func (Centroid) Solve(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, up *ttnpb.ApplicationUplink) (*ttnpb.ApplicationLocation, error) {
+	mds := make([]*ttnpb.RxMetadata, 0, len(up.RxMetadata))
+	maxSignal := math.Inf(-1)
+	for _, md := range up.RxMetadata {
+		if !validLocation(md.Location) {
+			continue
+		}
+		mds = append(mds, md)
+		if s := signalStrength(md); s > maxSignal {
+			maxSignal = s
+		}
+	}
+	if len(mds) == 0 {
+		return nil, errNotEnoughGateways.WithAttributes(
+			"requirement", "location",
+			"count", 0,
+			"minimum", 1,
+		)
+	}
+
+	// The weight of each gateway is its received power in linear scale, relative to the strongest gateway.
+	weights := make([]float64, len(mds))
+	var totalWeight, lat, lon, alt float64
+	for i, md := range mds {
+		w := math.Pow(10, (signalStrength(md)-maxSignal)/10)
+		weights[i] = w
+		totalWeight += w
+		lat += w * md.Location.Latitude
+		lon += w * md.Location.Longitude
+		alt += w * float64(md.Location.Altitude)
+	}
+	lat, lon, alt = lat/totalWeight, lon/totalWeight, alt/totalWeight
+
+	// The accuracy is the weighted root mean square distance of the gateways to the centroid.
+	proj := newProjection(lat, lon)
+	var spread float64
+	for i, md := range mds {
+		x, y := proj.toPlane(md.Location.Latitude, md.Location.Longitude)
+		spread += weights[i] * (x*x + y*y)
+	}
+	accuracy := math.Sqrt(spread / totalWeight)
+
+	return &ttnpb.ApplicationLocation{
+		Service: CentroidService,
+		Location: ttnpb.Location{
+			Latitude:  lat,
+			Longitude: lon,
+			Altitude:  int32(math.Round(alt)),
+			Accuracy:  int32(math.Ceil(accuracy)),
+			Source:    ttnpb.SOURCE_LORA_RSSI_GEOLOCATION,
+		},
+		Attributes: map[string]string{
+			"gateway_count": strconv.Itoa(len(mds)),
+		},
+	}, nil
+}
@@ -0,0 +1,81 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package locationsolver implements location solvers that estimate the location of end devices
+// based on the metadata of the gateways that received their uplink messages.
+package locationsolver
+
+import (
+	"math"
+
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+)
+
+var (
+	errNotEnoughGateways = errors.DefineFailedPrecondition(
+		"not_enough_gateways",
+		"not enough gateways with `{requirement}`; got {count}, need at least {minimum}",
+	)
+	errNoSolution = errors.DefineAborted("no_solution", "no solution found")
+)
+
+const earthRadius = 6371008.8 // Mean radius in meters.
+
+// projection is an equirectangular projection around an origin.
+// It is accurate for the distances over which LoRa gateways receive the same uplink message.
+type projection struct {
+	lat, lon, cosLat float64
+}
+
+func newProjection(lat, lon float64) projection {
+	return projection{
+		lat:    lat,
+		lon:    lon,
+		cosLat: math.Cos(lat * math.Pi / 180),
+	}
+}
+
+// toPlane returns the x (east) and y (north) offset in meters of the given coordinates relative to the origin.
+func (p projection) toPlane(lat, lon float64) (x, y float64) {
+	x = (lon - p.lon) * math.Pi / 180 * earthRadius * p.cosLat
+	y = (lat - p.lat) * math.Pi / 180 * earthRadius
+	return
+}
+
+// fromPlane returns the coordinates of the given offset in meters relative to the origin.
+func (p projection) fromPlane(x, y float64) (lat, lon float64) {
+	lat = p.lat + y/earthRadius*180/math.Pi
+	lon = p.lon + x/(earthRadius*p.cosLat)*180/math.Pi
+	return
+}
+
+// signalStrength returns the signal strength of the given metadata in dBm.
+// If the SNR is negative, the signal is below the noise floor and the RSSI overestimates the signal strength.
+func signalStrength(md *ttnpb.RxMetadata) float64 {
+	rssi := float64(md.RSSI)
+	if md.SignalRSSI != nil {
+		rssi = float64(md.SignalRSSI.Value)
+	}
+	if md.SNR < 0 {
+		rssi += float64(md.SNR)
+	}
+	return rssi
+}
+
+// validLocation returns whether the location can be used for solving.
+// The zero location is typically the result of gateways without GPS fix.
+func validLocation(loc *ttnpb.Location) bool {
+	return loc != nil && (loc.Latitude != 0 || loc.Longitude != 0)
+}
@@ -0,0 +1,268 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package locationsolver_test
+
+import (
+	"math"
+	"testing"
+
+	pbtypes "github.com/gogo/protobuf/types"
+	"github.com/smartystreets/assertions"
+	. "go.thethings.network/lorawan-stack/pkg/applicationserver/locationsolver"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+var registeredDevice = ttnpb.EndDeviceIdentifiers{
+	ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{
+		ApplicationID: "foo-app",
+	},
+	DeviceID: "foo-device",
+}
+
+// offset returns the location that is the given number of meters east and north of the given location.
+func offset(loc ttnpb.Location, east, north float64) *ttnpb.Location {
+	const earthRadius = 6371008.8
+	lat := loc.Latitude + north/earthRadius*180/math.Pi
+	lon := loc.Longitude + east/(earthRadius*math.Cos(loc.Latitude*math.Pi/180))*180/math.Pi
+	return &ttnpb.Location{
+		Latitude:  lat,
+		Longitude: lon,
+		Altitude:  loc.Altitude,
+		Source:    ttnpb.SOURCE_REGISTRY,
+	}
+}
+
+// distance returns the approximate distance in meters between the given locations.
+func distance(a, b ttnpb.Location) float64 {
+	const earthRadius = 6371008.8
+	x := (b.Longitude - a.Longitude) * math.Pi / 180 * earthRadius * math.Cos(a.Latitude*math.Pi/180)
+	y := (b.Latitude - a.Latitude) * math.Pi / 180 * earthRadius
+	return math.Hypot(x, y)
+}
+
+var origin = ttnpb.Location{
+	Latitude:  52.3676,
+	Longitude: 4.9041,
+	Altitude:  10,
+}
+
+func TestCentroid(t *testing.T) {
+	for _, tc := range []struct {
+		Name             string
+		RxMetadata       []*ttnpb.RxMetadata
+		ExpectedLocation *ttnpb.Location
+		MaxError         float64
+		ErrorAssertion   func(error) bool
+	}{
+		{
+			Name: "NoLocation",
+			RxMetadata: []*ttnpb.RxMetadata{
+				{
+					GatewayIdentifiers: ttnpb.GatewayIdentifiers{GatewayID: "gtw-1"},
+					RSSI:               -80,
+				},
+				{
+					GatewayIdentifiers: ttnpb.GatewayIdentifiers{GatewayID: "gtw-2"},
+					RSSI:               -80,
+					Location:           &ttnpb.Location{},
+				},
+			},
+			ErrorAssertion: errors.IsFailedPrecondition,
+		},
+		{
+			Name: "OneGateway",
+			RxMetadata: []*ttnpb.RxMetadata{
+				{
+					GatewayIdentifiers: ttnpb.GatewayIdentifiers{GatewayID: "gtw-1"},
+					RSSI:               -80,
+					Location:           offset(origin, 0, 0),
+				},
+			},
+			ExpectedLocation: &origin,
+			MaxError:         0.01,
+		},
+		{
+			Name: "EqualSignal",
+			RxMetadata: []*ttnpb.RxMetadata{
+				{
+					GatewayIdentifiers: ttnpb.GatewayIdentifiers{GatewayID: "gtw-1"},
+					RSSI:               -80,
+					SNR:                5,
+					Location:           offset(origin, -1000, 0),
+				},
+				{
+					GatewayIdentifiers: ttnpb.GatewayIdentifiers{GatewayID: "gtw-2"},
+					RSSI:               -80,
+					SNR:                5,
+					Location:           offset(origin, 1000, 0),
+				},
+			},
+			ExpectedLocation: &origin,
+			MaxError:         1,
+		},
+		{
+			Name: "StrongerSignal",
+			RxMetadata: []*ttnpb.RxMetadata{
+				{
+					GatewayIdentifiers: ttnpb.GatewayIdentifiers{GatewayID: "gtw-1"},
+					RSSI:               -70,
+					SNR:                5,
+					Location:           offset(origin, -1000, 0),
+				},
+				{
+					GatewayIdentifiers: ttnpb.GatewayIdentifiers{GatewayID: "gtw-2"},
+					RSSI:               -90,
+					SNR:                5,
+					Location:           offset(origin, 1000, 0),
+				},
+			},
+			// The weights are 1 and 0.01 relative to each other.
+			ExpectedLocation: offset(origin, -1000+2000*0.01/1.01, 0),
+			MaxError:         1,
+		},
+		{
+			Name: "NegativeSNR",
+			RxMetadata: []*ttnpb.RxMetadata{
+				{
+					GatewayIdentifiers: ttnpb.GatewayIdentifiers{GatewayID: "gtw-1"},
+					RSSI:               -100,
+					SignalRSSI:         &pbtypes.FloatValue{Value: -110},
+					SNR:                -10,
+					Location:           offset(origin, 0, -1000),
+				},
+				{
+					GatewayIdentifiers: ttnpb.GatewayIdentifiers{GatewayID: "gtw-2"},
+					RSSI:               -120,
+					SNR:                0,
+					Location:           offset(origin, 0, 1000),
+				},
+			},
+			ExpectedLocation: &origin,
+			MaxError:         1,
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+			res, err := Centroid{}.Solve(test.Context(), registeredDevice, &ttnpb.ApplicationUplink{
+				RxMetadata: tc.RxMetadata,
+			})
+			if tc.ErrorAssertion != nil {
+				a.So(tc.ErrorAssertion(err), should.BeTrue)
+				return
+			}
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			a.So(res.Service, should.Equal, CentroidService)
+			a.So(res.Source, should.Equal, ttnpb.SOURCE_LORA_RSSI_GEOLOCATION)
+			a.So(res.Altitude, should.Equal, tc.ExpectedLocation.Altitude)
+			a.So(distance(res.Location, *tc.ExpectedLocation), should.BeLessThan, tc.MaxError)
+		})
+	}
+}
+
+func TestTDOA(t *testing.T) {
+	const speedOfLight = 299792458
+
+	// rxMetadata returns the metadata of a gateway at the given offset to the origin, receiving a transmission
+	// at the given offset to the origin, where the transmission started at the given fine timestamp.
+	rxMetadata := func(id string, gtwEast, gtwNorth, devEast, devNorth float64, start uint64) *ttnpb.RxMetadata {
+		tof := math.Hypot(gtwEast-devEast, gtwNorth-devNorth) / speedOfLight * 1e9
+		return &ttnpb.RxMetadata{
+			GatewayIdentifiers: ttnpb.GatewayIdentifiers{GatewayID: id},
+			FineTimestamp:      (start + uint64(math.Round(tof))) % 1e9,
+			Location:           offset(origin, gtwEast, gtwNorth),
+		}
+	}
+
+	for _, tc := range []struct {
+		Name             string
+		RxMetadata       []*ttnpb.RxMetadata
+		ExpectedLocation *ttnpb.Location
+		MaxError         float64
+		ErrorAssertion   func(error) bool
+	}{
+		{
+			Name: "NoFineTimestamps",
+			RxMetadata: []*ttnpb.RxMetadata{
+				{
+					GatewayIdentifiers: ttnpb.GatewayIdentifiers{GatewayID: "gtw-1"},
+					Location:           offset(origin, -1000, 0),
+				},
+				{
+					GatewayIdentifiers: ttnpb.GatewayIdentifiers{GatewayID: "gtw-2"},
+					Location:           offset(origin, 1000, 0),
+				},
+				{
+					GatewayIdentifiers: ttnpb.GatewayIdentifiers{GatewayID: "gtw-3"},
+					Location:           offset(origin, 0, 1000),
+				},
+			},
+			ErrorAssertion: errors.IsFailedPrecondition,
+		},
+		{
+			Name: "TwoGateways",
+			RxMetadata: []*ttnpb.RxMetadata{
+				rxMetadata("gtw-1", -1000, 0, 0, 0, 1000),
+				rxMetadata("gtw-2", 1000, 0, 0, 0, 1000),
+			},
+			ErrorAssertion: errors.IsFailedPrecondition,
+		},
+		{
+			Name: "FourGateways",
+			RxMetadata: []*ttnpb.RxMetadata{
+				rxMetadata("gtw-1", -2000, -2000, 300, 500, 1000),
+				rxMetadata("gtw-2", 2000, -2000, 300, 500, 1000),
+				rxMetadata("gtw-3", 2000, 2000, 300, 500, 1000),
+				rxMetadata("gtw-4", -2000, 2000, 300, 500, 1000),
+			},
+			ExpectedLocation: offset(origin, 300, 500),
+			MaxError:         5,
+		},
+		{
+			Name: "WrappingFineTimestamps",
+			RxMetadata: []*ttnpb.RxMetadata{
+				rxMetadata("gtw-1", -2000, -2000, -700, 200, 1e9-5000),
+				rxMetadata("gtw-2", 2000, -2000, -700, 200, 1e9-5000),
+				rxMetadata("gtw-3", 2000, 2000, -700, 200, 1e9-5000),
+				rxMetadata("gtw-4", -2000, 2000, -700, 200, 1e9-5000),
+				rxMetadata("gtw-5", 0, 3000, -700, 200, 1e9-5000),
+			},
+			ExpectedLocation: offset(origin, -700, 200),
+			MaxError:         5,
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+			res, err := TDOA{}.Solve(test.Context(), registeredDevice, &ttnpb.ApplicationUplink{
+				RxMetadata: tc.RxMetadata,
+			})
+			if tc.ErrorAssertion != nil {
+				a.So(tc.ErrorAssertion(err), should.BeTrue)
+				return
+			}
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			a.So(res.Service, should.Equal, TDOAService)
+			a.So(res.Source, should.Equal, ttnpb.SOURCE_LORA_TDOA_GEOLOCATION)
+			a.So(res.Accuracy, should.BeGreaterThan, 0)
+			a.So(distance(res.Location, *tc.ExpectedLocation), should.BeLessThan, tc.MaxError)
+		})
+	}
+}
@@ -0,0 +1,199 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package locationsolver
+
+import (
+	"context"
+	"math"
+	"strconv"
+
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+)
+
+// TDOAService is the service name of the time difference of arrival location solver.
+const TDOAService = "tdoa"
+
+const (
+	speedOfLight = 299792458 // Meters per second.
+
+	// fineTimestampAccuracy is the assumed accuracy of fine timestamps of GPS synchronized gateways in nanoseconds.
+	fineTimestampAccuracy = 50
+
+	tdoaMinGateways    = 3
+	tdoaMaxIterations  = 50
+	tdoaConvergence    = 0.01   // Meters.
+	tdoaMaxSolveRadius = 100000 // Meters.
+)
+
+// TDOA is a location solver that estimates the location of an end device by multilateration, using the
+// differences between the fine timestamps at which the gateway antennas received the uplink message.
+// The gateways must be GPS synchronized and their antenna locations must be known.
+type TDOA struct{}
+
+// Name implements applicationserver.LocationSolver.
+func (TDOA) Name() string { return TDOAService }
+
+type tdoaMeasurement struct {
+	x, y      float64 // Antenna position in meters.
+	rangeDiff float64 // Range difference relative to the reference antenna in meters.
+}
+
+// Solve implements applicationserver.LocationSolver.
+func (TDOA) Solve(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, up *ttnpb.ApplicationUplink) (*ttnpb.ApplicationLocation, error) {
+	mds := make([]*ttnpb.RxMetadata, 0, len(up.RxMetadata))
+	for _, md := range up.RxMetadata {
+		if md.FineTimestamp == 0 || !validLocation(md.Location) {
+			continue
+		}
+		mds = append(mds, md)
+	}
+	if len(mds) < tdoaMinGateways {
+		return nil, errNotEnoughGateways.WithAttributes(
+			"requirement", "fine timestamp and location",
+			"count", len(mds),
+			"minimum", tdoaMinGateways,
+		)
+	}
+
+	var lat, lon, alt float64
+	for _, md := range mds {
+		lat += md.Location.Latitude
+		lon += md.Location.Longitude
+		alt += float64(md.Location.Altitude)
+	}
+	n := float64(len(mds))
+	proj := newProjection(lat/n, lon/n)
+
+	// Fine timestamps are the nanoseconds since the last PPS pulse, so they wrap around every second.
+	ref := int64(mds[0].FineTimestamp)
+	ms := make([]tdoaMeasurement, len(mds))
+	for i, md := range mds {
+		d := int64(md.FineTimestamp) - ref
+		switch {
+		case d > 5e8:
+			d -= 1e9
+		case d < -5e8:
+			d += 1e9
+		}
+		x, y := proj.toPlane(md.Location.Latitude, md.Location.Longitude)
+		ms[i] = tdoaMeasurement{
+			x:         x,
+			y:         y,
+			rangeDiff: float64(d) * speedOfLight / 1e9,
+		}
+	}
+
+	x, y, rms, ok := solveTDOA(ms)
+	if !ok || math.Hypot(x, y) > tdoaMaxSolveRadius {
+		return nil, errNoSolution
+	}
+	accuracy := rms + fineTimestampAccuracy*speedOfLight/1e9
+	solvedLat, solvedLon := proj.fromPlane(x, y)
+
+	return &ttnpb.ApplicationLocation{
+		Service: TDOAService,
+		Location: ttnpb.Location{
+			Latitude:  solvedLat,
+			Longitude: solvedLon,
+			Altitude:  int32(math.Round(alt / n)),
+			Accuracy:  int32(math.Ceil(accuracy)),
+			Source:    ttnpb.SOURCE_LORA_TDOA_GEOLOCATION,
+		},
+		Attributes: map[string]string{
+			"gateway_count": strconv.Itoa(len(mds)),
+		},
+	}, nil
+}
+
+// solveTDOA solves the position (x, y) and the unknown range offset b such that for each measurement,
+// the distance to the antenna plus b equals the range difference. It uses Gauss-Newton iteration starting
+// from the origin and returns the position and the root mean square residual in meters.
+func solveTDOA(ms []tdoaMeasurement) (x, y, rms float64, ok bool) {
+	var b float64
+	for _, m := range ms {
+		b += m.rangeDiff - math.Hypot(x-m.x, y-m.y)
+	}
+	b /= float64(len(ms))
+
+	var converged bool
+	for i := 0; i < tdoaMaxIterations && !converged; i++ {
+		var jtj [3][3]float64
+		var jtr [3]float64
+		for _, m := range ms {
+			d := math.Hypot(x-m.x, y-m.y)
+			row := [3]float64{0, 0, 1}
+			if d > 1e-6 {
+				row[0], row[1] = (x-m.x)/d, (y-m.y)/d
+			}
+			r := d + b - m.rangeDiff
+			for j := 0; j < 3; j++ {
+				for k := 0; k < 3; k++ {
+					jtj[j][k] += row[j] * row[k]
+				}
+				jtr[j] -= row[j] * r
+			}
+		}
+		delta, solved := solve3(jtj, jtr)
+		if !solved {
+			return 0, 0, 0, false
+		}
+		x, y, b = x+delta[0], y+delta[1], b+delta[2]
+		if math.IsNaN(x) || math.IsNaN(y) || math.IsNaN(b) {
+			return 0, 0, 0, false
+		}
+		converged = math.Hypot(delta[0], delta[1]) < tdoaConvergence
+	}
+	if !converged {
+		return 0, 0, 0, false
+	}
+
+	for _, m := range ms {
+		r := math.Hypot(x-m.x, y-m.y) + b - m.rangeDiff
+		rms += r * r
+	}
+	return x, y, math.Sqrt(rms / float64(len(ms))), true
+}
+
+// solve3 solves the linear system a * x = b using Gaussian elimination with partial pivoting.
+func solve3(a [3][3]float64, b [3]float64) (x [3]float64, ok bool) {
+	for col := 0; col < 3; col++ {
+		pivot := col
+		for row := col + 1; row < 3; row++ {
+			if math.Abs(a[row][col]) > math.Abs(a[pivot][col]) {
+				pivot = row
+			}
+		}
+		if math.Abs(a[pivot][col]) < 1e-12 {
+			return x, false
+		}
+		a[col], a[pivot] = a[pivot], a[col]
+		b[col], b[pivot] = b[pivot], b[col]
+		for row := col + 1; row < 3; row++ {
+			f := a[row][col] / a[col][col]
+			for k := col; k < 3; k++ {
+				a[row][k] -= f * a[col][k]
+			}
+			b[row] -= f * b[col]
+		}
+	}
+	for row := 2; row >= 0; row-- {
+		s := b[row]
+		for k := row + 1; k < 3; k++ {
+			s -= a[row][k] * x[k]
+		}
+		x[row] = s / a[row][row]
+	}
+	return x, true
+}
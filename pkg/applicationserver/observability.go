@@ -74,6 +74,14 @@ var (
 		"as.up.join.forward", "forward join-accept message",
 		ttnpb.RIGHT_APPLICATION_TRAFFIC_READ,
 	)
+	evtForwardLocationSolved = events.Define(
+		"as.up.location.forward", "forward location solved message",
+		ttnpb.RIGHT_APPLICATION_TRAFFIC_READ,
+	)
+	evtSwitchSession = events.Define(
+		"as.up.session.switch", "switch session",
+		ttnpb.RIGHT_APPLICATION_TRAFFIC_READ,
+	)
 	evtReceiveDataDown = events.Define(
 		"as.down.data.receive", "receive downlink data message",
 		ttnpb.RIGHT_APPLICATION_TRAFFIC_READ,
@@ -86,6 +94,10 @@ var (
 		"as.down.data.forward", "forward downlink data message",
 		ttnpb.RIGHT_APPLICATION_TRAFFIC_READ,
 	)
+	evtDecodeFailDataDown = events.Define(
+		"as.down.data.decode.fail", "decode downlink data message failure",
+		ttnpb.RIGHT_APPLICATION_TRAFFIC_READ,
+	)
 	evtLostQueueDataDown = events.Define(
 		"as.down.data.queue.lost", "lose downlink data queue",
 		ttnpb.RIGHT_APPLICATION_TRAFFIC_READ,
@@ -290,6 +302,8 @@ func registerForwardUp(ctx context.Context, msg *ttnpb.ApplicationUp) {
 		events.Publish(evtForwardJoinAccept(ctx, msg.EndDeviceIdentifiers, msg))
 	case *ttnpb.ApplicationUp_UplinkMessage:
 		events.Publish(evtForwardDataUp(ctx, msg.EndDeviceIdentifiers, msg))
+	case *ttnpb.ApplicationUp_LocationSolved:
+		events.Publish(evtForwardLocationSolved(ctx, msg.EndDeviceIdentifiers, msg))
 	}
 	asMetrics.uplinkForwarded.WithLabelValues(ctx, msg.ApplicationID).Inc()
 }
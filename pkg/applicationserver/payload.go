@@ -91,6 +91,25 @@ func (as *ApplicationServer) decryptAndDecode(ctx context.Context, dev *ttnpb.En
 	return nil
 }
 
+func (as *ApplicationServer) decodeDownlink(ctx context.Context, dev *ttnpb.EndDevice, downlink *ttnpb.ApplicationDownlink, defaultFormatters *ttnpb.MessagePayloadFormatters) {
+	if downlink.FRMPayload == nil || downlink.DecodedPayload != nil {
+		return
+	}
+	var formatter ttnpb.PayloadFormatter
+	var parameter string
+	if dev.Formatters != nil {
+		formatter, parameter = dev.Formatters.DownFormatter, dev.Formatters.DownFormatterParameter
+	} else if defaultFormatters != nil {
+		formatter, parameter = defaultFormatters.DownFormatter, defaultFormatters.DownFormatterParameter
+	}
+	if formatter != ttnpb.PayloadFormatter_FORMATTER_NONE {
+		if err := as.formatter.DecodeDownlink(ctx, dev.EndDeviceIdentifiers, dev.VersionIDs, downlink, formatter, parameter); err != nil {
+			log.FromContext(ctx).WithError(err).Warn("Payload decoding failed")
+			events.Publish(evtDecodeFailDataDown(ctx, dev.EndDeviceIdentifiers, err))
+		}
+	}
+}
+
 type payloadFormatter struct {
 	repository     *devicerepository.Client
 	upFormatters   map[ttnpb.PayloadFormatter]messageprocessors.PayloadDecoder
@@ -155,3 +174,26 @@ func (p payloadFormatter) Decode(ctx context.Context, ids ttnpb.EndDeviceIdentif
 	}
 	return nil
 }
+
+// DecodeDownlink decodes the downlink message using the downlink formatter, if the formatter supports it.
+func (p payloadFormatter) DecodeDownlink(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, version *ttnpb.EndDeviceVersionIdentifiers, msg *ttnpb.ApplicationDownlink, formatter ttnpb.PayloadFormatter, parameter string) error {
+	if formatter == ttnpb.PayloadFormatter_FORMATTER_REPOSITORY {
+		formatters, err := p.getRepositoryFormatters(version)
+		if err != nil {
+			return err
+		}
+		formatter, parameter = formatters.DownFormatter, formatters.DownFormatterParameter
+	}
+	mp, ok := p.downFormatters[formatter]
+	if !ok {
+		return errFormatterNotConfigured.WithAttributes("formatter", formatter)
+	}
+	decoder, ok := mp.(messageprocessors.PayloadDownlinkDecoder)
+	if !ok {
+		return nil
+	}
+	if err := decoder.DecodeDownlink(ctx, ids, version, msg, parameter); err != nil {
+		return err
+	}
+	return nil
+}
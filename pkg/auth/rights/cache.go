@@ -43,10 +43,11 @@ func newRes() *cachedRes {
 }
 
 type cachedRes struct {
-	waitChan chan struct{}
-	time     time.Time
-	rights   *ttnpb.Rights
-	err      error
+	waitChan   chan struct{}
+	time       time.Time
+	authExpiry time.Time
+	rights     *ttnpb.Rights
+	err        error
 }
 
 func (c *cachedRes) valid(successTTL, errorTTL time.Duration) bool {
@@ -59,6 +60,9 @@ func (c *cachedRes) valid(successTTL, errorTTL time.Duration) bool {
 		return true // still fetching...
 	}
 	now := now()
+	if !c.authExpiry.IsZero() && !now.Before(c.authExpiry) {
+		return false // the credentials expired, so the rights need to be fetched again.
+	}
 	if c.err != nil {
 		return now.Sub(c.time) <= errorTTL
 	}
@@ -152,7 +156,7 @@ func (f *inMemoryCache) ApplicationRights(ctx context.Context, appID ttnpb.Appli
 	if !res.valid(f.successTTL, f.errorTTL) {
 		res = newRes()
 		f.applicationRights[req] = res
-		go res.set(f.Fetcher.ApplicationRights(ctx, appID))
+		go res.set(f.Fetcher.ApplicationRights(withAuthExpiry(ctx, &res.authExpiry), appID))
 	}
 	f.maybeCleanup()
 	f.mu.Unlock()
@@ -168,7 +172,7 @@ func (f *inMemoryCache) ClientRights(ctx context.Context, clientID ttnpb.ClientI
 	if !res.valid(f.successTTL, f.errorTTL) {
 		res = newRes()
 		f.clientRights[req] = res
-		go res.set(f.Fetcher.ClientRights(ctx, clientID))
+		go res.set(f.Fetcher.ClientRights(withAuthExpiry(ctx, &res.authExpiry), clientID))
 	}
 	f.maybeCleanup()
 	f.mu.Unlock()
@@ -184,7 +188,7 @@ func (f *inMemoryCache) GatewayRights(ctx context.Context, gtwID ttnpb.GatewayId
 	if !res.valid(f.successTTL, f.errorTTL) {
 		res = newRes()
 		f.gatewayRights[req] = res
-		go res.set(f.Fetcher.GatewayRights(ctx, gtwID))
+		go res.set(f.Fetcher.GatewayRights(withAuthExpiry(ctx, &res.authExpiry), gtwID))
 	}
 	f.maybeCleanup()
 	f.mu.Unlock()
@@ -200,7 +204,7 @@ func (f *inMemoryCache) OrganizationRights(ctx context.Context, orgID ttnpb.Orga
 	if !res.valid(f.successTTL, f.errorTTL) {
 		res = newRes()
 		f.organizationRights[req] = res
-		go res.set(f.Fetcher.OrganizationRights(ctx, orgID))
+		go res.set(f.Fetcher.OrganizationRights(withAuthExpiry(ctx, &res.authExpiry), orgID))
 	}
 	f.maybeCleanup()
 	f.mu.Unlock()
@@ -216,7 +220,7 @@ func (f *inMemoryCache) UserRights(ctx context.Context, userID ttnpb.UserIdentif
 	if !res.valid(f.successTTL, f.errorTTL) {
 		res = newRes()
 		f.userRights[req] = res
-		go res.set(f.Fetcher.UserRights(ctx, userID))
+		go res.set(f.Fetcher.UserRights(withAuthExpiry(ctx, &res.authExpiry), userID))
 	}
 	f.maybeCleanup()
 	f.mu.Unlock()
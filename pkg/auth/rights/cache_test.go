@@ -22,7 +22,9 @@ import (
 	"github.com/smartystreets/assertions"
 	"github.com/smartystreets/assertions/should"
 	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
 	"go.thethings.network/lorawan-stack/pkg/util/test"
+	"google.golang.org/grpc/metadata"
 )
 
 var currentTime time.Time
@@ -53,9 +55,9 @@ func TestCache(t *testing.T) {
 	ctxA := context.WithValue(test.Context(), struct{}{}, "A")
 	res := fetchRights(ctxA, "foo", c)
 
-	a.So(mockFetcher.applicationCtx, should.Equal, ctxA)
-	a.So(mockFetcher.gatewayCtx, should.Equal, ctxA)
-	a.So(mockFetcher.organizationCtx, should.Equal, ctxA)
+	a.So(mockFetcher.applicationCtx.Value(struct{}{}), should.Equal, "A")
+	a.So(mockFetcher.gatewayCtx.Value(struct{}{}), should.Equal, "A")
+	a.So(mockFetcher.organizationCtx.Value(struct{}{}), should.Equal, "A")
 
 	a.So(res.AppErr, should.Resemble, mockFetcher.applicationError)
 	a.So(res.GtwErr, should.Resemble, mockFetcher.gatewayError)
@@ -66,17 +68,17 @@ func TestCache(t *testing.T) {
 	ctxB := context.WithValue(test.Context(), struct{}{}, "B")
 	res = fetchRights(ctxB, "foo", c)
 
-	a.So(mockFetcher.applicationCtx, should.Equal, ctxA)
-	a.So(mockFetcher.gatewayCtx, should.Equal, ctxA)
-	a.So(mockFetcher.organizationCtx, should.Equal, ctxA)
+	a.So(mockFetcher.applicationCtx.Value(struct{}{}), should.Equal, "A")
+	a.So(mockFetcher.gatewayCtx.Value(struct{}{}), should.Equal, "A")
+	a.So(mockFetcher.organizationCtx.Value(struct{}{}), should.Equal, "A")
 
 	timeTravel(31 * time.Second) // Error responses should be expired after 1 minute.
 
 	res = fetchRights(ctxB, "foo", c)
 
-	a.So(mockFetcher.applicationCtx, should.Equal, ctxB)
-	a.So(mockFetcher.gatewayCtx, should.Equal, ctxB)
-	a.So(mockFetcher.organizationCtx, should.Equal, ctxB)
+	a.So(mockFetcher.applicationCtx.Value(struct{}{}), should.Equal, "B")
+	a.So(mockFetcher.gatewayCtx.Value(struct{}{}), should.Equal, "B")
+	a.So(mockFetcher.organizationCtx.Value(struct{}{}), should.Equal, "B")
 
 	timeTravel(61 * time.Second)
 
@@ -84,25 +86,25 @@ func TestCache(t *testing.T) {
 
 	res = fetchRights(ctxA, "foo", c)
 
-	a.So(mockFetcher.applicationCtx, should.Equal, ctxA)
-	a.So(mockFetcher.gatewayCtx, should.Equal, ctxA)
-	a.So(mockFetcher.organizationCtx, should.Equal, ctxA)
+	a.So(mockFetcher.applicationCtx.Value(struct{}{}), should.Equal, "A")
+	a.So(mockFetcher.gatewayCtx.Value(struct{}{}), should.Equal, "A")
+	a.So(mockFetcher.organizationCtx.Value(struct{}{}), should.Equal, "A")
 
 	timeTravel(3 * time.Minute) // Success responses should be cached for 5 minutes.
 
 	res = fetchRights(ctxB, "foo", c)
 
-	a.So(mockFetcher.applicationCtx, should.Equal, ctxA)
-	a.So(mockFetcher.gatewayCtx, should.Equal, ctxA)
-	a.So(mockFetcher.organizationCtx, should.Equal, ctxA)
+	a.So(mockFetcher.applicationCtx.Value(struct{}{}), should.Equal, "A")
+	a.So(mockFetcher.gatewayCtx.Value(struct{}{}), should.Equal, "A")
+	a.So(mockFetcher.organizationCtx.Value(struct{}{}), should.Equal, "A")
 
 	timeTravel(3 * time.Minute) // Success responses should be expired after 5 minutes.
 
 	res = fetchRights(ctxB, "foo", c)
 
-	a.So(mockFetcher.applicationCtx, should.Equal, ctxB)
-	a.So(mockFetcher.gatewayCtx, should.Equal, ctxB)
-	a.So(mockFetcher.organizationCtx, should.Equal, ctxB)
+	a.So(mockFetcher.applicationCtx.Value(struct{}{}), should.Equal, "B")
+	a.So(mockFetcher.gatewayCtx.Value(struct{}{}), should.Equal, "B")
+	a.So(mockFetcher.organizationCtx.Value(struct{}{}), should.Equal, "B")
 
 	timeTravel(time.Hour)
 
@@ -112,3 +114,39 @@ func TestCache(t *testing.T) {
 	a.So(c.gatewayRights, should.BeEmpty)
 	a.So(c.organizationRights, should.BeEmpty)
 }
+
+func TestCacheAuthExpiry(t *testing.T) {
+	now = func() time.Time {
+		return currentTime
+	}
+
+	a := assertions.New(t)
+
+	var fetches int
+	fetcher := FetcherFunc(func(ctx context.Context, ids ttnpb.Identifiers) (*ttnpb.Rights, error) {
+		fetches++
+		reportAuthExpiry(ctx, metadata.Pairs(authExpiryHeader, currentTime.Add(time.Minute).Format(time.RFC3339Nano)))
+		return ttnpb.RightsFrom(ttnpb.RIGHT_APPLICATION_INFO), nil
+	})
+
+	c := NewInMemoryCache(fetcher, 5*time.Minute, time.Minute)
+
+	ctx := test.Context()
+	ids := ttnpb.ApplicationIdentifiers{ApplicationID: "foo"}
+
+	_, err := c.ApplicationRights(ctx, ids)
+	a.So(err, should.BeNil)
+	a.So(fetches, should.Equal, 1)
+
+	timeTravel(30 * time.Second) // The credentials are still valid.
+
+	_, err = c.ApplicationRights(ctx, ids)
+	a.So(err, should.BeNil)
+	a.So(fetches, should.Equal, 1)
+
+	timeTravel(31 * time.Second) // The credentials expired before the success TTL.
+
+	_, err = c.ApplicationRights(ctx, ids)
+	a.So(err, should.BeNil)
+	a.So(fetches, should.Equal, 2)
+}
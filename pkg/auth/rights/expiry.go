@@ -0,0 +1,55 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rights
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+const authExpiryHeader = "auth-expires-at"
+
+// SetAuthExpiry sets the time at which the credentials of the request expire in
+// the response headers. The access fetcher reports this time to the cache, so
+// that rights are not cached beyond the expiry of the credentials.
+func SetAuthExpiry(ctx context.Context, expiresAt time.Time) {
+	grpc.SetHeader(ctx, metadata.Pairs(authExpiryHeader, expiresAt.UTC().Format(time.RFC3339Nano))) // nolint:gas
+}
+
+type authExpiryKeyType struct{}
+
+var authExpiryKey authExpiryKeyType
+
+// withAuthExpiry returns a derived context in which fetchers report the time at
+// which the credentials of the request expire to expiresAt.
+func withAuthExpiry(ctx context.Context, expiresAt *time.Time) context.Context {
+	return context.WithValue(ctx, authExpiryKey, expiresAt)
+}
+
+// reportAuthExpiry reports the expiry in the response header md to the context.
+func reportAuthExpiry(ctx context.Context, md metadata.MD) {
+	expiresAt, ok := ctx.Value(authExpiryKey).(*time.Time)
+	if !ok {
+		return
+	}
+	if values := md.Get(authExpiryHeader); len(values) > 0 {
+		if t, err := time.Parse(time.RFC3339Nano, values[len(values)-1]); err == nil {
+			*expiresAt = t
+		}
+	}
+}
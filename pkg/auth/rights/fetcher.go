@@ -21,6 +21,7 @@ import (
 	"go.thethings.network/lorawan-stack/pkg/rpcmetadata"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
 )
 
 // Fetcher interface for rights fetching.
@@ -119,7 +120,9 @@ func (f accessFetcher) ApplicationRights(ctx context.Context, appID ttnpb.Applic
 	if err != nil {
 		return nil, err
 	}
-	rights, err := ttnpb.NewApplicationAccessClient(cc).ListRights(ctx, &appID, callOpt)
+	var md metadata.MD
+	rights, err := ttnpb.NewApplicationAccessClient(cc).ListRights(ctx, &appID, callOpt, grpc.Header(&md))
+	reportAuthExpiry(ctx, md)
 	registerRightsFetch(ctx, "application", rights, err)
 	if err != nil {
 		return nil, err
@@ -136,7 +139,9 @@ func (f accessFetcher) ClientRights(ctx context.Context, clientID ttnpb.ClientId
 	if err != nil {
 		return nil, err
 	}
-	rights, err := ttnpb.NewClientAccessClient(cc).ListRights(ctx, &clientID, callOpt)
+	var md metadata.MD
+	rights, err := ttnpb.NewClientAccessClient(cc).ListRights(ctx, &clientID, callOpt, grpc.Header(&md))
+	reportAuthExpiry(ctx, md)
 	registerRightsFetch(ctx, "client", rights, err)
 	if err != nil {
 		return nil, err
@@ -153,7 +158,9 @@ func (f accessFetcher) GatewayRights(ctx context.Context, gtwID ttnpb.GatewayIde
 	if err != nil {
 		return nil, err
 	}
-	rights, err := ttnpb.NewGatewayAccessClient(cc).ListRights(ctx, &gtwID, callOpt)
+	var md metadata.MD
+	rights, err := ttnpb.NewGatewayAccessClient(cc).ListRights(ctx, &gtwID, callOpt, grpc.Header(&md))
+	reportAuthExpiry(ctx, md)
 	registerRightsFetch(ctx, "gateway", rights, err)
 	if err != nil {
 		return nil, err
@@ -170,7 +177,9 @@ func (f accessFetcher) OrganizationRights(ctx context.Context, orgID ttnpb.Organ
 	if err != nil {
 		return nil, err
 	}
-	rights, err := ttnpb.NewOrganizationAccessClient(cc).ListRights(ctx, &orgID, callOpt)
+	var md metadata.MD
+	rights, err := ttnpb.NewOrganizationAccessClient(cc).ListRights(ctx, &orgID, callOpt, grpc.Header(&md))
+	reportAuthExpiry(ctx, md)
 	registerRightsFetch(ctx, "organization", rights, err)
 	if err != nil {
 		return nil, err
@@ -187,7 +196,9 @@ func (f accessFetcher) UserRights(ctx context.Context, userID ttnpb.UserIdentifi
 	if err != nil {
 		return nil, err
 	}
-	rights, err := ttnpb.NewUserAccessClient(cc).ListRights(ctx, &userID, callOpt)
+	var md metadata.MD
+	rights, err := ttnpb.NewUserAccessClient(cc).ListRights(ctx, &userID, callOpt, grpc.Header(&md))
+	reportAuthExpiry(ctx, md)
 	registerRightsFetch(ctx, "user", rights, err)
 	if err != nil {
 		return nil, err
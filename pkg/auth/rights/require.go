@@ -67,7 +67,8 @@ var (
 )
 
 // RequireApplication checks that context contains the required rights for the
-// given application ID.
+// given application ID, and that the request comes from an address that is
+// allowed by the restrictions of the API key, if any.
 func RequireApplication(ctx context.Context, id ttnpb.ApplicationIdentifiers, required ...ttnpb.Right) (err error) {
 	uid := unique.ID(ctx, id)
 	rights, err := ListApplication(ctx, id)
@@ -81,7 +82,7 @@ func RequireApplication(ctx context.Context, id ttnpb.ApplicationIdentifiers, re
 	if len(missing) > 0 {
 		return ErrInsufficientApplicationRights.WithAttributes("uid", uid, "missing", missing)
 	}
-	return nil
+	return checkSource(ctx, rights.GetRestrictions())
 }
 
 // RequireClient checks that context contains the required rights for the
@@ -99,7 +100,7 @@ func RequireClient(ctx context.Context, id ttnpb.ClientIdentifiers, required ...
 	if len(missing) > 0 {
 		return ErrInsufficientClientRights.WithAttributes("uid", uid, "missing", missing)
 	}
-	return nil
+	return checkSource(ctx, rights.GetRestrictions())
 }
 
 // RequireGateway checks that context contains the required rights for the
@@ -117,7 +118,7 @@ func RequireGateway(ctx context.Context, id ttnpb.GatewayIdentifiers, required .
 	if len(missing) > 0 {
 		return ErrInsufficientGatewayRights.WithAttributes("uid", uid, "missing", missing)
 	}
-	return nil
+	return checkSource(ctx, rights.GetRestrictions())
 }
 
 // RequireOrganization checks that context contains the required rights for the
@@ -135,7 +136,7 @@ func RequireOrganization(ctx context.Context, id ttnpb.OrganizationIdentifiers,
 	if len(missing) > 0 {
 		return ErrInsufficientOrganizationRights.WithAttributes("uid", uid, "missing", missing)
 	}
-	return nil
+	return checkSource(ctx, rights.GetRestrictions())
 }
 
 // RequireUser checks that context contains the required rights for the
@@ -153,5 +154,5 @@ func RequireUser(ctx context.Context, id ttnpb.UserIdentifiers, required ...ttnp
 	if len(missing) > 0 {
 		return ErrInsufficientUserRights.WithAttributes("uid", uid, "missing", missing)
 	}
-	return nil
+	return checkSource(ctx, rights.GetRestrictions())
 }
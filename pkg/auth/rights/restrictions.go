@@ -0,0 +1,93 @@
+// Copyright © 2026 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rights
+
+import (
+	"context"
+	"net"
+
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/rpcserver"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/unique"
+)
+
+// Errors for API key restrictions.
+var (
+	ErrSourceRestricted = errors.DefinePermissionDenied(
+		"source_restricted",
+		"API key can not be used from address `{address}`",
+	)
+	ErrEndDeviceRestricted = errors.DefinePermissionDenied(
+		"end_device_restricted",
+		"API key is not allowed to access end device `{device_uid}`",
+	)
+)
+
+// checkSource returns an error if the restrictions do not allow the source
+// address of the original request. Invalid CIDRs do not allow any address.
+func checkSource(ctx context.Context, restrictions *ttnpb.APIKeyRestrictions) error {
+	cidrs := restrictions.GetSourceCIDRs()
+	if len(cidrs) == 0 {
+		return nil
+	}
+	ip := rpcserver.SourceAddress(ctx)
+	if ip == nil {
+		return ErrSourceRestricted.WithAttributes("address", "unknown")
+	}
+	for _, cidr := range cidrs {
+		_, ipNet, err := net.ParseCIDR(cidr)
+		if err != nil {
+			return ErrSourceRestricted.WithCause(err).WithAttributes("address", ip.String())
+		}
+		if ipNet.Contains(ip) {
+			return nil
+		}
+	}
+	return ErrSourceRestricted.WithAttributes("address", ip.String())
+}
+
+// EndDeviceRestriction returns the IDs of the end devices of the given
+// application that the context is restricted to. It returns nil if the context
+// is not restricted to specific end devices.
+func EndDeviceRestriction(ctx context.Context, id ttnpb.ApplicationIdentifiers) ([]string, error) {
+	rights, err := ListApplication(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return rights.GetRestrictions().GetEndDeviceIDs(), nil
+}
+
+// RequireEndDevice checks that context contains the required rights for the
+// application of the given end device, and that the context is not restricted
+// to other end devices.
+func RequireEndDevice(ctx context.Context, id ttnpb.EndDeviceIdentifiers, required ...ttnpb.Right) error {
+	if err := RequireApplication(ctx, id.ApplicationIdentifiers, required...); err != nil {
+		return err
+	}
+	deviceIDs, err := EndDeviceRestriction(ctx, id.ApplicationIdentifiers)
+	if err != nil {
+		return err
+	}
+	if len(deviceIDs) == 0 {
+		return nil
+	}
+	for _, deviceID := range deviceIDs {
+		if deviceID == id.DeviceID {
+			return nil
+		}
+	}
+	return ErrEndDeviceRestricted.WithAttributes("device_uid", unique.ID(ctx, id))
+}
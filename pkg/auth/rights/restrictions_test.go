@@ -0,0 +1,117 @@
+// Copyright © 2026 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rights
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/smartystreets/assertions"
+	"github.com/smartystreets/assertions/should"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+	"google.golang.org/grpc/peer"
+)
+
+func restrictedFetcher(restrictions *ttnpb.APIKeyRestrictions) Fetcher {
+	return FetcherFunc(func(ctx context.Context, ids ttnpb.Identifiers) (*ttnpb.Rights, error) {
+		return &ttnpb.Rights{
+			Rights:       []ttnpb.Right{ttnpb.RIGHT_APPLICATION_DEVICES_READ},
+			Restrictions: restrictions,
+		}, nil
+	})
+}
+
+func withPeer(ctx context.Context, ip string) context.Context {
+	return peer.NewContext(ctx, &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP(ip), Port: 12345}})
+}
+
+func TestRequireSource(t *testing.T) {
+	a := assertions.New(t)
+	appIDs := ttnpb.ApplicationIdentifiers{ApplicationID: "foo-app"}
+	fetcher := restrictedFetcher(&ttnpb.APIKeyRestrictions{
+		SourceCIDRs: []string{"10.0.0.0/8", "2001:db8::/32"},
+	})
+
+	for _, tc := range []struct {
+		Name      string
+		Context   context.Context
+		Assertion func(error) bool
+	}{
+		{
+			Name:      "Allowed",
+			Context:   withPeer(test.Context(), "10.1.2.3"),
+			Assertion: func(err error) bool { return err == nil },
+		},
+		{
+			Name:      "AllowedIPv6",
+			Context:   withPeer(test.Context(), "2001:db8::1"),
+			Assertion: func(err error) bool { return err == nil },
+		},
+		{
+			Name:      "NotAllowed",
+			Context:   withPeer(test.Context(), "192.168.1.2"),
+			Assertion: func(err error) bool { return errors.Resemble(err, ErrSourceRestricted) },
+		},
+		{
+			Name:      "Unknown",
+			Context:   test.Context(),
+			Assertion: func(err error) bool { return errors.Resemble(err, ErrSourceRestricted) },
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			ctx := NewContextWithFetcher(tc.Context, fetcher)
+			err := RequireApplication(ctx, appIDs, ttnpb.RIGHT_APPLICATION_DEVICES_READ)
+			a.So(tc.Assertion(err), should.BeTrue)
+		})
+	}
+
+	invalidCtx := NewContextWithFetcher(withPeer(test.Context(), "10.1.2.3"), restrictedFetcher(&ttnpb.APIKeyRestrictions{
+		SourceCIDRs: []string{"10.0.0.0/33", "10.0.0.0/8"},
+	}))
+	err := RequireApplication(invalidCtx, appIDs, ttnpb.RIGHT_APPLICATION_DEVICES_READ)
+	a.So(errors.Resemble(err, ErrSourceRestricted), should.BeTrue)
+}
+
+func TestRequireEndDevice(t *testing.T) {
+	a := assertions.New(t)
+	appIDs := ttnpb.ApplicationIdentifiers{ApplicationID: "foo-app"}
+	restrictedCtx := NewContextWithFetcher(test.Context(), restrictedFetcher(&ttnpb.APIKeyRestrictions{
+		EndDeviceIDs: []string{"foo-dev"},
+	}))
+	unrestrictedCtx := NewContextWithFetcher(test.Context(), restrictedFetcher(nil))
+
+	err := RequireEndDevice(restrictedCtx, ttnpb.EndDeviceIdentifiers{ApplicationIdentifiers: appIDs, DeviceID: "foo-dev"}, ttnpb.RIGHT_APPLICATION_DEVICES_READ)
+	a.So(err, should.BeNil)
+
+	err = RequireEndDevice(restrictedCtx, ttnpb.EndDeviceIdentifiers{ApplicationIdentifiers: appIDs, DeviceID: "bar-dev"}, ttnpb.RIGHT_APPLICATION_DEVICES_READ)
+	a.So(errors.Resemble(err, ErrEndDeviceRestricted), should.BeTrue)
+
+	err = RequireEndDevice(restrictedCtx, ttnpb.EndDeviceIdentifiers{ApplicationIdentifiers: appIDs, DeviceID: "foo-dev"}, ttnpb.RIGHT_APPLICATION_DEVICES_WRITE)
+	a.So(errors.Resemble(err, ErrInsufficientApplicationRights), should.BeTrue)
+
+	err = RequireEndDevice(unrestrictedCtx, ttnpb.EndDeviceIdentifiers{ApplicationIdentifiers: appIDs, DeviceID: "bar-dev"}, ttnpb.RIGHT_APPLICATION_DEVICES_READ)
+	a.So(err, should.BeNil)
+
+	deviceIDs, err := EndDeviceRestriction(restrictedCtx, appIDs)
+	a.So(err, should.BeNil)
+	a.So(deviceIDs, should.Resemble, []string{"foo-dev"})
+
+	deviceIDs, err = EndDeviceRestriction(unrestrictedCtx, appIDs)
+	a.So(err, should.BeNil)
+	a.So(deviceIDs, should.BeEmpty)
+}
@@ -0,0 +1,125 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package totp implements time-based one-time passwords (TOTP) as specified
+// in RFC 6238, compatible with common authenticator apps.
+package totp
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/binary"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"go.thethings.network/lorawan-stack/pkg/errors"
+)
+
+const (
+	secretLength = 20
+	digits       = 6
+	period       = 30
+	// skew is the number of time steps before and after the current one that are also accepted.
+	skew = 1
+)
+
+var enc = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+var errInvalidSecret = errors.DefineInvalidArgument("secret", "invalid TOTP secret")
+
+// GenerateSecret generates a random shared secret, encoded in base32.
+func GenerateSecret() (string, error) {
+	var b [secretLength]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		return "", err
+	}
+	return enc.EncodeToString(b[:]), nil
+}
+
+func decodeSecret(secret string) ([]byte, error) {
+	key, err := enc.DecodeString(strings.ToUpper(strings.TrimRight(secret, "=")))
+	if err != nil || len(key) == 0 {
+		return nil, errInvalidSecret.WithCause(err)
+	}
+	return key, nil
+}
+
+func code(key []byte, counter uint64) string {
+	var msg [8]byte
+	binary.BigEndian.PutUint64(msg[:], counter)
+	mac := hmac.New(sha1.New, key)
+	mac.Write(msg[:])
+	sum := mac.Sum(nil)
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+	return fmt.Sprintf("%0*d", digits, value%1000000)
+}
+
+// Code returns the code of the shared secret at the given time.
+func Code(secret string, t time.Time) (string, error) {
+	key, err := decodeSecret(secret)
+	if err != nil {
+		return "", err
+	}
+	return code(key, uint64(t.Unix())/period), nil
+}
+
+// Validate returns whether the code is valid for the shared secret at the given time,
+// and the time step of the code. The codes of the time steps just before and after
+// the given time are also accepted, to allow for clock skew.
+// Codes of time steps up to and including lastStep are rejected, so that callers
+// that store the returned time step can accept each code only once.
+func Validate(secret, c string, t time.Time, lastStep int64) (step int64, valid bool, err error) {
+	key, err := decodeSecret(secret)
+	if err != nil {
+		return 0, false, err
+	}
+	if len(c) != digits {
+		return 0, false, nil
+	}
+	counter := t.Unix() / period
+	for i := counter - skew; i <= counter+skew; i++ {
+		if i <= lastStep {
+			continue
+		}
+		if subtle.ConstantTimeCompare([]byte(code(key, uint64(i))), []byte(c)) == 1 {
+			return i, true, nil
+		}
+	}
+	return 0, false, nil
+}
+
+// URI returns the otpauth:// URI of the shared secret, which authenticator apps
+// can import (typically from a QR code).
+func URI(issuer, accountName, secret string) string {
+	label := url.PathEscape(accountName)
+	if issuer != "" {
+		label = url.PathEscape(issuer) + ":" + label
+	}
+	query := url.Values{
+		"secret":    []string{secret},
+		"algorithm": []string{"SHA1"},
+		"digits":    []string{fmt.Sprint(digits)},
+		"period":    []string{fmt.Sprint(period)},
+	}
+	if issuer != "" {
+		query.Set("issuer", issuer)
+	}
+	return fmt.Sprintf("otpauth://totp/%s?%s", label, query.Encode())
+}
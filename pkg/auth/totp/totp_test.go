@@ -0,0 +1,104 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package totp_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	. "go.thethings.network/lorawan-stack/pkg/auth/totp"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+// rfcSecret is the base32 encoding of the SHA1 secret of the test vectors in RFC 6238.
+const rfcSecret = "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+
+func TestCode(t *testing.T) {
+	for _, tc := range []struct {
+		Time int64
+		Code string
+	}{
+		{Time: 59, Code: "287082"},
+		{Time: 1111111109, Code: "081804"},
+		{Time: 1111111111, Code: "050471"},
+		{Time: 1234567890, Code: "005924"},
+		{Time: 2000000000, Code: "279037"},
+		{Time: 20000000000, Code: "353130"},
+	} {
+		a := assertions.New(t)
+		code, err := Code(rfcSecret, time.Unix(tc.Time, 0))
+		a.So(err, should.BeNil)
+		a.So(code, should.Equal, tc.Code)
+	}
+
+	_, err := Code("not base32!", time.Now())
+	a := assertions.New(t)
+	a.So(errors.IsInvalidArgument(err), should.BeTrue)
+}
+
+func TestValidate(t *testing.T) {
+	a := assertions.New(t)
+
+	secret, err := GenerateSecret()
+	a.So(err, should.BeNil)
+	a.So(secret, should.HaveLength, 32)
+
+	now := time.Now()
+	code, err := Code(secret, now)
+	a.So(err, should.BeNil)
+
+	for _, tc := range []struct {
+		Time  time.Time
+		Valid bool
+	}{
+		{Time: now, Valid: true},
+		{Time: now.Add(-30 * time.Second), Valid: true},
+		{Time: now.Add(30 * time.Second), Valid: true},
+		{Time: now.Add(-90 * time.Second), Valid: false},
+		{Time: now.Add(90 * time.Second), Valid: false},
+	} {
+		step, valid, err := Validate(secret, code, tc.Time, 0)
+		a.So(err, should.BeNil)
+		a.So(valid, should.Equal, tc.Valid)
+		if tc.Valid {
+			a.So(step, should.Equal, now.Unix()/30)
+		}
+	}
+
+	_, valid, err := Validate(secret, "12345", now, 0)
+	a.So(err, should.BeNil)
+	a.So(valid, should.BeFalse)
+
+	// Reject codes of time steps that were already used.
+	_, valid, err = Validate(secret, code, now, now.Unix()/30)
+	a.So(err, should.BeNil)
+	a.So(valid, should.BeFalse)
+
+	step, valid, err := Validate(secret, code, now, now.Unix()/30-1)
+	a.So(err, should.BeNil)
+	a.So(valid, should.BeTrue)
+	a.So(step, should.Equal, now.Unix()/30)
+}
+
+func TestURI(t *testing.T) {
+	a := assertions.New(t)
+	a.So(
+		URI("The Things Stack", "admin", rfcSecret),
+		should.Equal,
+		"otpauth://totp/The%20Things%20Stack:admin?algorithm=SHA1&digits=6&issuer=The+Things+Stack&period=30&secret="+rfcSecret,
+	)
+}
@@ -28,6 +28,7 @@ import (
 	"go.thethings.network/lorawan-stack/pkg/log"
 	"go.thethings.network/lorawan-stack/pkg/random"
 	"go.thethings.network/lorawan-stack/pkg/rpcclient"
+	"go.thethings.network/lorawan-stack/pkg/rpcmiddleware/discover"
 	"go.thethings.network/lorawan-stack/pkg/rpcserver"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
 	"google.golang.org/grpc"
@@ -214,9 +215,9 @@ var errPeerEmptyTarget = errors.Define(
 func (c *cluster) Join() (err error) {
 	options := rpcclient.DefaultDialOptions(c.ctx)
 	if c.tls {
-		options = append(options, grpc.WithTransportCredentials(credentials.NewTLS(c.tlsConfig)))
+		options = append(options, discover.WithTransportCredentials(credentials.NewTLS(c.tlsConfig))...)
 	} else {
-		options = append(options, grpc.WithInsecure())
+		options = append(options, discover.WithInsecure()...)
 	}
 	for _, peer := range c.peers {
 		if peer.conn != nil {
@@ -16,6 +16,7 @@ package component
 
 import (
 	"context"
+	"crypto/tls"
 	"net"
 	"net/http"
 
@@ -80,9 +81,17 @@ func (c *Component) serveGRPC(lis net.Listener) error {
 }
 
 func (c *Component) grpcEndpoints() []Endpoint {
+	tlsOpts := []TLSConfigOption{
+		WithNextProtos("h2", "http/1.1"),
+	}
+	if len(c.interop.SenderClientCAs) > 0 {
+		// External Network Servers and Application Servers authenticate with client certificates issued by the client CAs
+		// of their sender IDs.
+		tlsOpts = append(tlsOpts, WithTLSClientAuth(tls.VerifyClientCertIfGiven, c.senderClientCAPool(), nil))
+	}
 	return []Endpoint{
 		NewTCPEndpoint(c.config.GRPC.Listen, "gRPC"),
-		NewTLSEndpoint(c.config.GRPC.ListenTLS, "gRPC", WithNextProtos("h2", "http/1.1")),
+		NewTLSEndpoint(c.config.GRPC.ListenTLS, "gRPC", tlsOpts...),
 	}
 }
 
@@ -41,17 +41,22 @@ func (c *Component) serveInterop(lis net.Listener) error {
 	return srv.Serve(lis)
 }
 
-func (c *Component) interopEndpoints() []Endpoint {
+// senderClientCAPool returns the pool of client CAs of all interop sender IDs.
+func (c *Component) senderClientCAPool() *x509.CertPool {
 	certPool := x509.NewCertPool()
 	for _, certs := range c.interop.SenderClientCAs {
 		for _, cert := range certs {
 			certPool.AddCert(cert)
 		}
 	}
+	return certPool
+}
+
+func (c *Component) interopEndpoints() []Endpoint {
 	return []Endpoint{
 		// TODO: Enable TCP endpoint (https://github.com/TheThingsNetwork/lorawan-stack/issues/717)
 		NewTLSEndpoint(c.config.Interop.ListenTLS, "Interop",
-			WithTLSClientAuth(tls.RequireAndVerifyClientCert, certPool, nil),
+			WithTLSClientAuth(tls.RequireAndVerifyClientCert, c.senderClientCAPool(), nil),
 			WithNextProtos("h2", "http/1.1"),
 		),
 	}
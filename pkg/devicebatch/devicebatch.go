@@ -0,0 +1,167 @@
+// Copyright © 2026 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package devicebatch implements batch RPCs of the end device registries.
+//
+// The requests of a batch are handled concurrently by the handler of the single RPC, as if they were separate calls.
+// The results contain the end device or the error for each request, so that a batch can partially fail.
+package devicebatch
+
+import (
+	"context"
+	"sync"
+
+	pbtypes "github.com/gogo/protobuf/types"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/rpcmiddleware/validator"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/unique"
+	"google.golang.org/grpc/status"
+)
+
+// MaxConcurrency is the maximum number of requests of a batch that are handled concurrently.
+const MaxConcurrency = 16
+
+var errApplication = errors.DefineInvalidArgument("application", "end device `{device_uid}` is not in application `{application_uid}`")
+
+type request struct {
+	ids ttnpb.EndDeviceIdentifiers
+	msg interface{}
+}
+
+func toErrorDetails(err error) *ttnpb.ErrorDetails {
+	ttnErr, ok := errors.From(err)
+	if !ok {
+		e := errors.FromGRPCStatus(status.Convert(err))
+		ttnErr = &e
+	}
+	return ttnpb.ErrorDetailsToProto(ttnErr)
+}
+
+// handle handles the requests concurrently and returns the results in the order of the requests.
+// Each request is validated as the request of the RPC with the given full method name and
+// must be for an end device of the application of the batch.
+func handle(ctx context.Context, appIDs ttnpb.ApplicationIdentifiers, fullMethod string, reqs []request, f func(context.Context, interface{}) (*ttnpb.EndDevice, error)) *ttnpb.BatchEndDevicesResponse {
+	res := &ttnpb.BatchEndDevicesResponse{
+		Results: make([]ttnpb.BatchEndDevicesResponse_Result, len(reqs)),
+	}
+	sem := make(chan struct{}, MaxConcurrency)
+	var wg sync.WaitGroup
+	for i, req := range reqs {
+		res.Results[i].IDs = req.ids
+		select {
+		case <-ctx.Done():
+			res.Results[i].Error = toErrorDetails(ctx.Err())
+			continue
+		case sem <- struct{}{}:
+		}
+		wg.Add(1)
+		go func(i int, req request) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			if err := validator.ValidateMessage(ctx, fullMethod, req.msg); err != nil {
+				res.Results[i].Error = toErrorDetails(err)
+				return
+			}
+			if req.ids.ApplicationIdentifiers != appIDs {
+				res.Results[i].Error = toErrorDetails(errApplication.WithAttributes(
+					"device_uid", unique.ID(ctx, req.ids),
+					"application_uid", unique.ID(ctx, appIDs),
+				))
+				return
+			}
+			dev, err := f(ctx, req.msg)
+			if err != nil {
+				res.Results[i].Error = toErrorDetails(err)
+				return
+			}
+			res.Results[i].EndDevice = dev
+		}(i, req)
+	}
+	wg.Wait()
+	return res
+}
+
+// Create handles the requests of a BatchCreate RPC with the Create RPC of the end device registry.
+func Create(ctx context.Context, req *ttnpb.BatchCreateEndDevicesRequest, fullMethod string, create func(context.Context, *ttnpb.CreateEndDeviceRequest) (*ttnpb.EndDevice, error)) (*ttnpb.BatchEndDevicesResponse, error) {
+	reqs := make([]request, len(req.Requests))
+	for i, r := range req.Requests {
+		if r == nil {
+			r = &ttnpb.CreateEndDeviceRequest{}
+		}
+		reqs[i] = request{ids: r.EndDeviceIdentifiers, msg: r}
+	}
+	return handle(ctx, req.ApplicationIdentifiers, fullMethod, reqs, func(ctx context.Context, msg interface{}) (*ttnpb.EndDevice, error) {
+		return create(ctx, msg.(*ttnpb.CreateEndDeviceRequest))
+	}), nil
+}
+
+// Get handles the requests of a BatchGet RPC with the Get RPC of the end device registry.
+func Get(ctx context.Context, req *ttnpb.BatchGetEndDevicesRequest, fullMethod string, get func(context.Context, *ttnpb.GetEndDeviceRequest) (*ttnpb.EndDevice, error)) (*ttnpb.BatchEndDevicesResponse, error) {
+	reqs := make([]request, len(req.Requests))
+	for i, r := range req.Requests {
+		if r == nil {
+			r = &ttnpb.GetEndDeviceRequest{}
+		}
+		reqs[i] = request{ids: r.EndDeviceIdentifiers, msg: r}
+	}
+	return handle(ctx, req.ApplicationIdentifiers, fullMethod, reqs, func(ctx context.Context, msg interface{}) (*ttnpb.EndDevice, error) {
+		return get(ctx, msg.(*ttnpb.GetEndDeviceRequest))
+	}), nil
+}
+
+// Update handles the requests of a BatchUpdate RPC with the Update RPC of the end device registry.
+func Update(ctx context.Context, req *ttnpb.BatchUpdateEndDevicesRequest, fullMethod string, update func(context.Context, *ttnpb.UpdateEndDeviceRequest) (*ttnpb.EndDevice, error)) (*ttnpb.BatchEndDevicesResponse, error) {
+	reqs := make([]request, len(req.Requests))
+	for i, r := range req.Requests {
+		if r == nil {
+			r = &ttnpb.UpdateEndDeviceRequest{}
+		}
+		reqs[i] = request{ids: r.EndDeviceIdentifiers, msg: r}
+	}
+	return handle(ctx, req.ApplicationIdentifiers, fullMethod, reqs, func(ctx context.Context, msg interface{}) (*ttnpb.EndDevice, error) {
+		return update(ctx, msg.(*ttnpb.UpdateEndDeviceRequest))
+	}), nil
+}
+
+// Set handles the requests of a BatchSet RPC with the Set RPC of the end device registry.
+func Set(ctx context.Context, req *ttnpb.BatchSetEndDevicesRequest, fullMethod string, set func(context.Context, *ttnpb.SetEndDeviceRequest) (*ttnpb.EndDevice, error)) (*ttnpb.BatchEndDevicesResponse, error) {
+	reqs := make([]request, len(req.Requests))
+	for i, r := range req.Requests {
+		if r == nil {
+			r = &ttnpb.SetEndDeviceRequest{}
+		}
+		reqs[i] = request{ids: r.EndDevice.EndDeviceIdentifiers, msg: r}
+	}
+	return handle(ctx, req.ApplicationIdentifiers, fullMethod, reqs, func(ctx context.Context, msg interface{}) (*ttnpb.EndDevice, error) {
+		return set(ctx, msg.(*ttnpb.SetEndDeviceRequest))
+	}), nil
+}
+
+// Delete handles the requests of a BatchDelete RPC with the Delete RPC of the end device registry.
+func Delete(ctx context.Context, req *ttnpb.BatchDeleteEndDevicesRequest, fullMethod string, del func(context.Context, *ttnpb.EndDeviceIdentifiers) (*pbtypes.Empty, error)) (*ttnpb.BatchEndDevicesResponse, error) {
+	reqs := make([]request, len(req.EndDeviceIDs))
+	for i, ids := range req.EndDeviceIDs {
+		if ids == nil {
+			ids = &ttnpb.EndDeviceIdentifiers{}
+		}
+		reqs[i] = request{ids: *ids, msg: ids}
+	}
+	return handle(ctx, req.ApplicationIdentifiers, fullMethod, reqs, func(ctx context.Context, msg interface{}) (*ttnpb.EndDevice, error) {
+		_, err := del(ctx, msg.(*ttnpb.EndDeviceIdentifiers))
+		return nil, err
+	}), nil
+}
@@ -0,0 +1,133 @@
+// Copyright © 2026 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package devicebatch_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	pbtypes "github.com/gogo/protobuf/types"
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/devicebatch"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/rpcmiddleware/validator"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+var errTestNotFound = errors.DefineNotFound("test_not_found", "test device not found")
+
+func TestGet(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+
+	validator.RegisterAllowedFieldMaskPaths("/test.Registry/Get", "name")
+
+	appIDs := ttnpb.ApplicationIdentifiers{ApplicationID: "test-app"}
+	deviceIDs := func(appID, devID string) ttnpb.EndDeviceIdentifiers {
+		return ttnpb.EndDeviceIdentifiers{
+			ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: appID},
+			DeviceID:               devID,
+		}
+	}
+
+	var reqs []*ttnpb.GetEndDeviceRequest
+	for i := 0; i < 3*devicebatch.MaxConcurrency; i++ {
+		reqs = append(reqs, &ttnpb.GetEndDeviceRequest{
+			EndDeviceIdentifiers: deviceIDs("test-app", fmt.Sprintf("dev-%d", i)),
+			FieldMask:            pbtypes.FieldMask{Paths: []string{"name"}},
+		})
+	}
+	reqs = append(reqs,
+		&ttnpb.GetEndDeviceRequest{
+			EndDeviceIdentifiers: deviceIDs("test-app", "not-found"),
+			FieldMask:            pbtypes.FieldMask{Paths: []string{"name"}},
+		},
+		&ttnpb.GetEndDeviceRequest{
+			EndDeviceIdentifiers: deviceIDs("other-app", "dev-0"),
+			FieldMask:            pbtypes.FieldMask{Paths: []string{"name"}},
+		},
+		&ttnpb.GetEndDeviceRequest{
+			EndDeviceIdentifiers: deviceIDs("test-app", "dev-0"),
+			FieldMask:            pbtypes.FieldMask{Paths: []string{"session"}},
+		},
+		&ttnpb.GetEndDeviceRequest{
+			EndDeviceIdentifiers: deviceIDs("test-app", "INVALID"),
+			FieldMask:            pbtypes.FieldMask{Paths: []string{"name"}},
+		},
+	)
+
+	res, err := devicebatch.Get(ctx, &ttnpb.BatchGetEndDevicesRequest{
+		ApplicationIdentifiers: appIDs,
+		Requests:               reqs,
+	}, "/test.Registry/Get", func(ctx context.Context, req *ttnpb.GetEndDeviceRequest) (*ttnpb.EndDevice, error) {
+		if req.DeviceID == "not-found" {
+			return nil, errTestNotFound
+		}
+		return &ttnpb.EndDevice{
+			EndDeviceIdentifiers: req.EndDeviceIdentifiers,
+			Name:                 req.DeviceID,
+		}, nil
+	})
+	if !a.So(err, should.BeNil) || !a.So(res.Results, should.HaveLength, len(reqs)) {
+		t.FailNow()
+	}
+
+	n := 3 * devicebatch.MaxConcurrency
+	for i, res := range res.Results[:n] {
+		a.So(res.IDs, should.Resemble, reqs[i].EndDeviceIdentifiers)
+		a.So(res.Error, should.BeNil)
+		if a.So(res.EndDevice, should.NotBeNil) {
+			a.So(res.EndDevice.Name, should.Equal, fmt.Sprintf("dev-%d", i))
+		}
+	}
+
+	for i, res := range res.Results[n:] {
+		a.So(res.IDs, should.Resemble, reqs[n+i].EndDeviceIdentifiers)
+		a.So(res.EndDevice, should.BeNil)
+		a.So(res.Error, should.NotBeNil)
+	}
+	a.So(errors.IsNotFound(ttnpb.ErrorDetailsFromProto(res.Results[n].Error)), should.BeTrue)
+	a.So(res.Results[n+1].Error.Name, should.Equal, "application")
+	a.So(errors.IsInvalidArgument(ttnpb.ErrorDetailsFromProto(res.Results[n+2].Error)), should.BeTrue)
+	a.So(errors.IsInvalidArgument(ttnpb.ErrorDetailsFromProto(res.Results[n+3].Error)), should.BeTrue)
+}
+
+func TestDelete(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+
+	appIDs := ttnpb.ApplicationIdentifiers{ApplicationID: "test-app"}
+	var deleted []string
+	res, err := devicebatch.Delete(ctx, &ttnpb.BatchDeleteEndDevicesRequest{
+		ApplicationIdentifiers: appIDs,
+		EndDeviceIDs: []*ttnpb.EndDeviceIdentifiers{
+			{ApplicationIdentifiers: appIDs, DeviceID: "dev-0"},
+			nil,
+		},
+	}, "/test.Registry/Delete", func(ctx context.Context, ids *ttnpb.EndDeviceIdentifiers) (*pbtypes.Empty, error) {
+		deleted = append(deleted, ids.DeviceID)
+		return &pbtypes.Empty{}, nil
+	})
+	if !a.So(err, should.BeNil) || !a.So(res.Results, should.HaveLength, 2) {
+		t.FailNow()
+	}
+	a.So(deleted, should.Resemble, []string{"dev-0"})
+	a.So(res.Results[0].Error, should.BeNil)
+	a.So(res.Results[0].EndDevice, should.BeNil)
+	a.So(res.Results[1].Error, should.NotBeNil)
+}
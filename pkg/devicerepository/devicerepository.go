@@ -16,6 +16,10 @@
 package devicerepository
 
 import (
+	"strings"
+	"time"
+
+	pbtypes "github.com/gogo/protobuf/types"
 	"go.thethings.network/lorawan-stack/pkg/errors"
 	"go.thethings.network/lorawan-stack/pkg/fetch"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
@@ -43,6 +47,7 @@ const (
 	brandsFile   = "brands.yml"
 	devicesFile  = "devices.yml"
 	versionsFile = "versions.yml"
+	profilesFile = "profiles.yml"
 )
 
 // Brands fetches and parses the list of brands.
@@ -113,9 +118,10 @@ type payloadFormats struct {
 }
 
 type endDeviceVersion struct {
-	FirmwareVersion string         `yaml:"firmware_version"`
-	Photos          []string       `yaml:"photos,omitempty"`
-	PayloadFormats  payloadFormats `yaml:"payload_format,omitempty"`
+	FirmwareVersion string            `yaml:"firmware_version"`
+	Photos          []string          `yaml:"photos,omitempty"`
+	PayloadFormats  payloadFormats    `yaml:"payload_format,omitempty"`
+	Profiles        map[string]string `yaml:"profiles,omitempty"`
 }
 
 var errInvalidPayloadFormatter = errors.DefineInvalidArgument("invalid_payload_formatter", "invalid payload formatter `{formatter}`")
@@ -182,9 +188,135 @@ func (c Client) DeviceVersions(brandID, modelID string) ([]ttnpb.EndDeviceVersio
 				},
 				Photos:            version.Photos,
 				DefaultFormatters: formatters,
+				ProfileIDs:        version.Profiles,
 			})
 		}
 	}
 
 	return versions, nil
 }
+
+// Profile is an end device profile of a brand.
+// Profiles are referenced by end device versions per band, and define the LoRaWAN versions, capabilities and MAC
+// settings of end devices.
+type Profile struct {
+	ID                string
+	Name              string
+	LoRaWANVersion    ttnpb.MACVersion
+	LoRaWANPHYVersion ttnpb.PHYVersion
+	SupportsJoin      bool
+	SupportsClassB    bool
+	SupportsClassC    bool
+	ResetsJoinNonces  bool
+	MACSettings       *ttnpb.MACSettings
+}
+
+type endDeviceProfileMACSettings struct {
+	ClassBTimeout            *time.Duration `yaml:"class_b_timeout,omitempty"`
+	ClassCTimeout            *time.Duration `yaml:"class_c_timeout,omitempty"`
+	Rx1Delay                 *uint32        `yaml:"rx1_delay,omitempty"`
+	Rx1DataRateOffset        *uint32        `yaml:"rx1_data_rate_offset,omitempty"`
+	Rx2DataRateIndex         *uint32        `yaml:"rx2_data_rate_index,omitempty"`
+	Rx2Frequency             *uint64        `yaml:"rx2_frequency,omitempty"`
+	PingSlotDataRateIndex    *uint32        `yaml:"ping_slot_data_rate_index,omitempty"`
+	PingSlotFrequency        *uint64        `yaml:"ping_slot_frequency,omitempty"`
+	FactoryPresetFrequencies []uint64       `yaml:"factory_preset_frequencies,omitempty"`
+	Supports32BitFCnt        *bool          `yaml:"supports_32_bit_f_cnt,omitempty"`
+	ResetsFCnt               *bool          `yaml:"resets_f_cnt,omitempty"`
+}
+
+func (s endDeviceProfileMACSettings) toPB() *ttnpb.MACSettings {
+	pb := &ttnpb.MACSettings{
+		ClassBTimeout:            s.ClassBTimeout,
+		ClassCTimeout:            s.ClassCTimeout,
+		FactoryPresetFrequencies: s.FactoryPresetFrequencies,
+	}
+	if s.Rx1Delay != nil {
+		pb.Rx1Delay = &ttnpb.RxDelayValue{Value: ttnpb.RxDelay(*s.Rx1Delay)}
+	}
+	if s.Rx1DataRateOffset != nil {
+		pb.Rx1DataRateOffset = &pbtypes.UInt32Value{Value: *s.Rx1DataRateOffset}
+	}
+	if s.Rx2DataRateIndex != nil {
+		pb.Rx2DataRateIndex = &ttnpb.DataRateIndexValue{Value: ttnpb.DataRateIndex(*s.Rx2DataRateIndex)}
+	}
+	if s.Rx2Frequency != nil {
+		pb.Rx2Frequency = &pbtypes.UInt64Value{Value: *s.Rx2Frequency}
+	}
+	if s.PingSlotDataRateIndex != nil {
+		pb.PingSlotDataRateIndex = &ttnpb.DataRateIndexValue{Value: ttnpb.DataRateIndex(*s.PingSlotDataRateIndex)}
+	}
+	if s.PingSlotFrequency != nil {
+		pb.PingSlotFrequency = &pbtypes.UInt64Value{Value: *s.PingSlotFrequency}
+	}
+	if s.Supports32BitFCnt != nil {
+		pb.Supports32BitFCnt = &pbtypes.BoolValue{Value: *s.Supports32BitFCnt}
+	}
+	if s.ResetsFCnt != nil {
+		pb.ResetsFCnt = &pbtypes.BoolValue{Value: *s.ResetsFCnt}
+	}
+	return pb
+}
+
+type endDeviceProfile struct {
+	Name              string                      `yaml:"name,omitempty"`
+	LoRaWANVersion    string                      `yaml:"lorawan_version"`
+	LoRaWANPHYVersion string                      `yaml:"lorawan_phy_version"`
+	SupportsJoin      bool                        `yaml:"supports_join,omitempty"`
+	SupportsClassB    bool                        `yaml:"supports_class_b,omitempty"`
+	SupportsClassC    bool                        `yaml:"supports_class_c,omitempty"`
+	ResetsJoinNonces  bool                        `yaml:"resets_join_nonces,omitempty"`
+	MACSettings       endDeviceProfileMACSettings `yaml:"mac_settings,omitempty"`
+}
+
+var errInvalidProfile = errors.DefineInvalidArgument("invalid_profile", "invalid profile `{profile_id}`")
+
+// normalizeVersion converts versions in Device Repository notation, like 1.0.2-b, to the notation of the enum values
+// of the LoRaWAN versions, like V1_0_2_REV_B. Other versions are returned as is.
+func normalizeVersion(s string) string {
+	if s == "" || s[0] < '0' || s[0] > '9' {
+		return s
+	}
+	s = strings.Replace(s, "-", "_REV_", 1)
+	return "V" + strings.ToUpper(strings.Replace(s, ".", "_", -1))
+}
+
+// Profiles fetches and parses the end device profiles of the brand.
+func (c Client) Profiles(brandID string) (map[string]Profile, error) {
+	content, err := c.Fetcher.File(brandID, profilesFile)
+	if err != nil {
+		return nil, errFetchFailed.WithCause(err).WithAttributes("filename", profilesFile)
+	}
+
+	l := &struct {
+		Version  string                      `yaml:"version"`
+		Profiles map[string]endDeviceProfile `yaml:"profiles,omitempty"`
+	}{}
+	if err = yaml.Unmarshal(content, l); err != nil {
+		return nil, errParseFailed.WithCause(err)
+	}
+
+	profiles := make(map[string]Profile)
+	for id, profile := range l.Profiles {
+		var macVersion ttnpb.MACVersion
+		if err := macVersion.UnmarshalText([]byte(normalizeVersion(profile.LoRaWANVersion))); err != nil {
+			return nil, errInvalidProfile.WithCause(err).WithAttributes("profile_id", id)
+		}
+		var phyVersion ttnpb.PHYVersion
+		if err := phyVersion.UnmarshalText([]byte(normalizeVersion(profile.LoRaWANPHYVersion))); err != nil {
+			return nil, errInvalidProfile.WithCause(err).WithAttributes("profile_id", id)
+		}
+		profiles[id] = Profile{
+			ID:                id,
+			Name:              profile.Name,
+			LoRaWANVersion:    macVersion,
+			LoRaWANPHYVersion: phyVersion,
+			SupportsJoin:      profile.SupportsJoin,
+			SupportsClassB:    profile.SupportsClassB,
+			SupportsClassC:    profile.SupportsClassC,
+			ResetsJoinNonces:  profile.ResetsJoinNonces,
+			MACSettings:       profile.MACSettings.toPB(),
+		}
+	}
+	return profiles, nil
+}
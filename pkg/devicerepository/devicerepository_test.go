@@ -233,3 +233,35 @@ func TestDeviceVersions(t *testing.T) {
 		})
 	}
 }
+
+func TestProfiles(t *testing.T) {
+	a := assertions.New(t)
+
+	profiles, err := Client{Fetcher: profilesFetcher}.Profiles("thethingsproducts")
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	if !a.So(profiles, should.HaveLength, 2) {
+		t.FailNow()
+	}
+	a.So(profiles["node-us915"], should.Resemble, Profile{
+		ID:                "node-us915",
+		Name:              "The Things Node US915",
+		LoRaWANVersion:    ttnpb.MAC_V1_0_3,
+		LoRaWANPHYVersion: ttnpb.PHY_V1_0_3_REV_A,
+		SupportsJoin:      true,
+		SupportsClassC:    true,
+		MACSettings:       &ttnpb.MACSettings{},
+	})
+
+	_, err = Client{Fetcher: profilesFetcher}.Profiles("othercorp")
+	a.So(errors.IsNotFound(err), should.BeTrue)
+
+	_, err = Client{Fetcher: fetch.NewMemFetcher(map[string][]byte{
+		"thethingsproducts/profiles.yml": []byte(`version: '3'
+profiles:
+  invalid:
+    lorawan_version: 9.9.9`),
+	})}.Profiles("thethingsproducts")
+	a.So(errors.IsInvalidArgument(err), should.BeTrue)
+}
@@ -0,0 +1,110 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package devicerepository
+
+import (
+	"context"
+	"strconv"
+
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// deviceRepositoryServer serves the Device Repository index.
+//
+// The Device Repository is public data, so like the Configuration service, the RPCs do not require authentication
+// and do not check rights. The RPCs only read from the in-memory index, and like other RPCs of the stack, they are
+// not rate limited.
+type deviceRepositoryServer struct {
+	DR *DeviceRepository
+}
+
+func setTotalHeader(ctx context.Context, total int) {
+	grpc.SetHeader(ctx, metadata.Pairs("x-total-count", strconv.Itoa(total)))
+}
+
+// paginate returns the start and end offsets of the page in a list of total items.
+func paginate(total int, limit, page uint32) (int, int) {
+	if limit == 0 {
+		return 0, total
+	}
+	if page == 0 {
+		page = 1
+	}
+	start := int(limit) * int(page-1)
+	if start > total {
+		start = total
+	}
+	end := start + int(limit)
+	if end > total {
+		end = total
+	}
+	return start, end
+}
+
+// ListBrands implements ttnpb.DeviceRepositoryServer.
+func (s *deviceRepositoryServer) ListBrands(ctx context.Context, req *ttnpb.ListEndDeviceBrandsRequest) (*ttnpb.EndDeviceBrands, error) {
+	brands := s.DR.index.Brands(req.Search)
+	setTotalHeader(ctx, len(brands))
+	start, end := paginate(len(brands), req.Limit, req.Page)
+	res := &ttnpb.EndDeviceBrands{
+		Brands: make([]*ttnpb.EndDeviceBrand, 0, end-start),
+	}
+	for i := start; i < end; i++ {
+		res.Brands = append(res.Brands, &brands[i])
+	}
+	return res, nil
+}
+
+// ListModels implements ttnpb.DeviceRepositoryServer.
+func (s *deviceRepositoryServer) ListModels(ctx context.Context, req *ttnpb.ListEndDeviceModelsRequest) (*ttnpb.EndDeviceModels, error) {
+	models := s.DR.index.Models(ModelFilter{
+		BrandID:        req.BrandID,
+		Search:         req.Search,
+		LoRaWANVersion: req.LoRaWANVersion,
+		BandID:         req.BandID,
+		ProfileID:      req.ProfileID,
+	})
+	setTotalHeader(ctx, len(models))
+	start, end := paginate(len(models), req.Limit, req.Page)
+	res := &ttnpb.EndDeviceModels{
+		Models: make([]*ttnpb.EndDeviceModel, 0, end-start),
+	}
+	for i := start; i < end; i++ {
+		res.Models = append(res.Models, &models[i])
+	}
+	return res, nil
+}
+
+// ListVersions implements ttnpb.DeviceRepositoryServer.
+func (s *deviceRepositoryServer) ListVersions(ctx context.Context, req *ttnpb.ListEndDeviceVersionsRequest) (*ttnpb.EndDeviceVersions, error) {
+	versions, err := s.DR.index.Versions(req.BrandID, req.ModelID, req.BandID)
+	if err != nil {
+		return nil, err
+	}
+	res := &ttnpb.EndDeviceVersions{
+		Versions: make([]*ttnpb.EndDeviceVersion, 0, len(versions)),
+	}
+	for i := range versions {
+		res.Versions = append(res.Versions, &versions[i])
+	}
+	return res, nil
+}
+
+// GetTemplate implements ttnpb.DeviceRepositoryServer.
+func (s *deviceRepositoryServer) GetTemplate(ctx context.Context, req *ttnpb.GetEndDeviceTemplateRequest) (*ttnpb.EndDeviceTemplate, error) {
+	return s.DR.index.Template(req.VersionIDs, req.BandID)
+}
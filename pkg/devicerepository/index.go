@@ -0,0 +1,281 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package devicerepository
+
+import (
+	"sort"
+	"strings"
+
+	pbtypes "github.com/gogo/protobuf/types"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+)
+
+type indexedModel struct {
+	ttnpb.EndDeviceModel
+	versions []ttnpb.EndDeviceVersion
+}
+
+type indexedBrand struct {
+	ttnpb.EndDeviceBrand
+	models   []*indexedModel
+	profiles map[string]Profile
+}
+
+// Index is an in-memory index of the Device Repository.
+// The index is built once from the files of the repository, for example a local checkout of the Device Repository.
+type Index struct {
+	brands []*indexedBrand
+}
+
+// NewIndex fetches all brands, models, versions and profiles from the Device Repository and returns the index.
+// Brands without profiles are indexed without profiles.
+func NewIndex(c Client) (*Index, error) {
+	brands, err := c.Brands()
+	if err != nil {
+		return nil, err
+	}
+	idx := &Index{}
+	for _, brand := range brands {
+		b := &indexedBrand{
+			EndDeviceBrand: brand,
+		}
+		b.profiles, err = c.Profiles(brand.ID)
+		if err != nil && !errors.IsNotFound(err) {
+			return nil, err
+		}
+		models, err := c.DeviceModels(brand.ID)
+		if err != nil && !errors.IsNotFound(err) {
+			return nil, err
+		}
+		for _, model := range models {
+			versions, err := c.DeviceVersions(brand.ID, model.ID)
+			if err != nil && !errors.IsNotFound(err) {
+				return nil, err
+			}
+			sort.Slice(versions, func(i, j int) bool {
+				if versions[i].HardwareVersion != versions[j].HardwareVersion {
+					return versions[i].HardwareVersion < versions[j].HardwareVersion
+				}
+				return versions[i].FirmwareVersion < versions[j].FirmwareVersion
+			})
+			b.models = append(b.models, &indexedModel{
+				EndDeviceModel: model,
+				versions:       versions,
+			})
+		}
+		sort.Slice(b.models, func(i, j int) bool { return b.models[i].ID < b.models[j].ID })
+		idx.brands = append(idx.brands, b)
+	}
+	sort.Slice(idx.brands, func(i, j int) bool { return idx.brands[i].ID < idx.brands[j].ID })
+	return idx, nil
+}
+
+func matches(search string, values ...string) bool {
+	if search == "" {
+		return true
+	}
+	search = strings.ToLower(search)
+	for _, v := range values {
+		if strings.Contains(strings.ToLower(v), search) {
+			return true
+		}
+	}
+	return false
+}
+
+func (idx *Index) brand(id string) (*indexedBrand, bool) {
+	i := sort.Search(len(idx.brands), func(i int) bool { return idx.brands[i].ID >= id })
+	if i < len(idx.brands) && idx.brands[i].ID == id {
+		return idx.brands[i], true
+	}
+	return nil, false
+}
+
+func (b *indexedBrand) model(id string) (*indexedModel, bool) {
+	i := sort.Search(len(b.models), func(i int) bool { return b.models[i].ID >= id })
+	if i < len(b.models) && b.models[i].ID == id {
+		return b.models[i], true
+	}
+	return nil, false
+}
+
+// Brands returns the brands that contain the search text in their ID or name, ordered by ID.
+func (idx *Index) Brands(search string) []ttnpb.EndDeviceBrand {
+	var res []ttnpb.EndDeviceBrand
+	for _, b := range idx.brands {
+		if matches(search, b.ID, b.Name) {
+			res = append(res, b.EndDeviceBrand)
+		}
+	}
+	return res
+}
+
+// ModelFilter filters end device models.
+// Empty fields match all models.
+type ModelFilter struct {
+	BrandID        string
+	Search         string
+	LoRaWANVersion ttnpb.MACVersion
+	BandID         string
+	ProfileID      string
+}
+
+func (f ModelFilter) matchesVersion(b *indexedBrand, v ttnpb.EndDeviceVersion) bool {
+	if f.BandID == "" && f.ProfileID == "" && f.LoRaWANVersion == ttnpb.MAC_UNKNOWN {
+		return true
+	}
+	for bandID, profileID := range v.ProfileIDs {
+		if f.BandID != "" && bandID != f.BandID {
+			continue
+		}
+		if f.ProfileID != "" && profileID != f.ProfileID {
+			continue
+		}
+		if f.LoRaWANVersion != ttnpb.MAC_UNKNOWN {
+			if profile, ok := b.profiles[profileID]; !ok || profile.LoRaWANVersion != f.LoRaWANVersion {
+				continue
+			}
+		}
+		return true
+	}
+	return false
+}
+
+// Models returns the models that match the filter, ordered by brand ID and model ID.
+func (idx *Index) Models(filter ModelFilter) []ttnpb.EndDeviceModel {
+	var res []ttnpb.EndDeviceModel
+	for _, b := range idx.brands {
+		if filter.BrandID != "" && b.ID != filter.BrandID {
+			continue
+		}
+		for _, m := range b.models {
+			if !matches(filter.Search, m.ID, m.Name) {
+				continue
+			}
+			for _, v := range m.versions {
+				if filter.matchesVersion(b, v) {
+					res = append(res, m.EndDeviceModel)
+					break
+				}
+			}
+		}
+	}
+	return res
+}
+
+var (
+	errBrandNotFound   = errors.DefineNotFound("brand_not_found", "brand `{brand_id}` not found")
+	errModelNotFound   = errors.DefineNotFound("model_not_found", "model `{model_id}` of brand `{brand_id}` not found")
+	errVersionNotFound = errors.DefineNotFound("version_not_found", "hardware version `{hardware_version}` and firmware version `{firmware_version}` not found")
+	errProfileNotFound = errors.DefineNotFound("profile_not_found", "profile for band `{band_id}` not found")
+)
+
+// applyProfile sets the LoRaWAN versions, capabilities and MAC settings of the profile in the version.
+func applyProfile(v *ttnpb.EndDeviceVersion, p Profile) {
+	v.LoRaWANVersion = p.LoRaWANVersion
+	v.LoRaWANPHYVersion = p.LoRaWANPHYVersion
+	v.SupportsJoin = p.SupportsJoin
+	v.SupportsClassB = p.SupportsClassB
+	v.SupportsClassC = p.SupportsClassC
+	v.ResetsJoinNonces = p.ResetsJoinNonces
+	v.DefaultMACSettings = p.MACSettings
+}
+
+// Versions returns the versions of the model, ordered by hardware version and firmware version.
+// If the band ID is set, only versions with a profile for the band are returned, and the profile is applied to the
+// returned versions.
+func (idx *Index) Versions(brandID, modelID, bandID string) ([]ttnpb.EndDeviceVersion, error) {
+	b, ok := idx.brand(brandID)
+	if !ok {
+		return nil, errBrandNotFound.WithAttributes("brand_id", brandID)
+	}
+	m, ok := b.model(modelID)
+	if !ok {
+		return nil, errModelNotFound.WithAttributes("brand_id", brandID, "model_id", modelID)
+	}
+	if bandID == "" {
+		return m.versions, nil
+	}
+	var res []ttnpb.EndDeviceVersion
+	for _, v := range m.versions {
+		profile, ok := b.profiles[v.ProfileIDs[bandID]]
+		if !ok {
+			continue
+		}
+		applyProfile(&v, profile)
+		res = append(res, v)
+	}
+	return res, nil
+}
+
+// Template returns the end device template for the given version and band.
+// If the hardware or firmware version are empty, the latest version of the model is used.
+func (idx *Index) Template(ids ttnpb.EndDeviceVersionIdentifiers, bandID string) (*ttnpb.EndDeviceTemplate, error) {
+	versions, err := idx.Versions(ids.BrandID, ids.ModelID, "")
+	if err != nil {
+		return nil, err
+	}
+	var version *ttnpb.EndDeviceVersion
+	for i, v := range versions {
+		if ids.HardwareVersion != "" && v.HardwareVersion != ids.HardwareVersion ||
+			ids.FirmwareVersion != "" && v.FirmwareVersion != ids.FirmwareVersion {
+			continue
+		}
+		// Versions are sorted, so the last match is the latest version.
+		version = &versions[i]
+	}
+	if version == nil {
+		return nil, errVersionNotFound.WithAttributes(
+			"hardware_version", ids.HardwareVersion,
+			"firmware_version", ids.FirmwareVersion,
+		)
+	}
+	b, _ := idx.brand(ids.BrandID)
+	profile, ok := b.profiles[version.ProfileIDs[bandID]]
+	if !ok {
+		return nil, errProfileNotFound.WithAttributes("band_id", bandID)
+	}
+	versionIDs, formatters := version.EndDeviceVersionIdentifiers, version.DefaultFormatters
+	dev := ttnpb.EndDevice{
+		VersionIDs:        &versionIDs,
+		LoRaWANVersion:    profile.LoRaWANVersion,
+		LoRaWANPHYVersion: profile.LoRaWANPHYVersion,
+		SupportsJoin:      profile.SupportsJoin,
+		SupportsClassB:    profile.SupportsClassB,
+		SupportsClassC:    profile.SupportsClassC,
+		ResetsJoinNonces:  profile.ResetsJoinNonces,
+		MACSettings:       profile.MACSettings,
+		Formatters:        &formatters,
+	}
+	paths := []string{
+		"formatters",
+		"lorawan_phy_version",
+		"lorawan_version",
+		"resets_join_nonces",
+		"supports_class_b",
+		"supports_class_c",
+		"supports_join",
+		"version_ids",
+	}
+	if dev.MACSettings != nil {
+		paths = append(paths, "mac_settings")
+	}
+	sort.Strings(paths)
+	return &ttnpb.EndDeviceTemplate{
+		EndDevice: dev,
+		FieldMask: pbtypes.FieldMask{Paths: paths},
+	}, nil
+}
@@ -0,0 +1,223 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package devicerepository_test
+
+import (
+	"testing"
+
+	pbtypes "github.com/gogo/protobuf/types"
+	"github.com/smartystreets/assertions"
+	. "go.thethings.network/lorawan-stack/pkg/devicerepository"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/fetch"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+var profilesFetcher = fetch.NewMemFetcher(map[string][]byte{
+	"brands.yml": []byte(`version: '3'
+brands:
+  thethingsproducts:
+    name: The Things Products
+  othercorp:
+    name: Other Corp`),
+	"thethingsproducts/devices.yml": []byte(`version: '3'
+devices:
+  thethingsuno:
+    name: The Things Uno
+  thethingsnode:
+    name: The Things Node`),
+	"thethingsproducts/profiles.yml": []byte(`version: '3'
+profiles:
+  uno-eu868:
+    name: The Things Uno EU868
+    lorawan_version: 1.0.2
+    lorawan_phy_version: 1.0.2-b
+    supports_join: true
+    mac_settings:
+      rx1_delay: 5
+      supports_32_bit_f_cnt: true
+  node-us915:
+    name: The Things Node US915
+    lorawan_version: 1.0.3
+    lorawan_phy_version: 1.0.3-a
+    supports_join: true
+    supports_class_c: true`),
+	"thethingsproducts/thethingsuno/versions.yml": []byte(`version: '3'
+hardware_versions:
+  '1.0':
+    - firmware_version: '1.1'
+      profiles:
+        EU_863_870: uno-eu868
+    - firmware_version: '1.0'`),
+	"thethingsproducts/thethingsnode/versions.yml": []byte(`version: '3'
+hardware_versions:
+  '1.0':
+    - firmware_version: '1.0'
+      profiles:
+        US_902_928: node-us915`),
+	"othercorp/devices.yml": []byte(`version: '3'
+devices:
+  sensor:
+    name: Sensor`),
+	"othercorp/sensor/versions.yml": []byte(`version: '3'
+hardware_versions:
+  '2.0':
+    - firmware_version: '2.0'`),
+})
+
+func TestIndex(t *testing.T) {
+	a := assertions.New(t)
+
+	idx, err := NewIndex(Client{Fetcher: profilesFetcher})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+
+	t.Run("Brands", func(t *testing.T) {
+		a := assertions.New(t)
+		brands := idx.Brands("")
+		if a.So(brands, should.HaveLength, 2) {
+			a.So(brands[0].ID, should.Equal, "othercorp")
+			a.So(brands[1].ID, should.Equal, "thethingsproducts")
+		}
+		brands = idx.Brands("things")
+		if a.So(brands, should.HaveLength, 1) {
+			a.So(brands[0].ID, should.Equal, "thethingsproducts")
+		}
+		a.So(idx.Brands("unknown"), should.BeEmpty)
+	})
+
+	t.Run("Models", func(t *testing.T) {
+		for _, tc := range []struct {
+			Name     string
+			Filter   ModelFilter
+			ModelIDs []string
+		}{
+			{
+				Name:     "All",
+				ModelIDs: []string{"sensor", "thethingsnode", "thethingsuno"},
+			},
+			{
+				Name:     "Brand",
+				Filter:   ModelFilter{BrandID: "thethingsproducts"},
+				ModelIDs: []string{"thethingsnode", "thethingsuno"},
+			},
+			{
+				Name:     "Search",
+				Filter:   ModelFilter{Search: "UNO"},
+				ModelIDs: []string{"thethingsuno"},
+			},
+			{
+				Name:     "Band",
+				Filter:   ModelFilter{BandID: "US_902_928"},
+				ModelIDs: []string{"thethingsnode"},
+			},
+			{
+				Name:     "LoRaWANVersion",
+				Filter:   ModelFilter{LoRaWANVersion: ttnpb.MAC_V1_0_2},
+				ModelIDs: []string{"thethingsuno"},
+			},
+			{
+				Name:   "Profile",
+				Filter: ModelFilter{ProfileID: "uno-eu868", BandID: "US_902_928"},
+			},
+		} {
+			t.Run(tc.Name, func(t *testing.T) {
+				a := assertions.New(t)
+				var ids []string
+				for _, m := range idx.Models(tc.Filter) {
+					ids = append(ids, m.ID)
+				}
+				a.So(ids, should.Resemble, tc.ModelIDs)
+			})
+		}
+	})
+
+	t.Run("Versions", func(t *testing.T) {
+		a := assertions.New(t)
+
+		_, err := idx.Versions("unknown", "thethingsuno", "")
+		a.So(errors.IsNotFound(err), should.BeTrue)
+		_, err = idx.Versions("thethingsproducts", "unknown", "")
+		a.So(errors.IsNotFound(err), should.BeTrue)
+
+		versions, err := idx.Versions("thethingsproducts", "thethingsuno", "")
+		a.So(err, should.BeNil)
+		if a.So(versions, should.HaveLength, 2) {
+			a.So(versions[0].FirmwareVersion, should.Equal, "1.0")
+			a.So(versions[1].FirmwareVersion, should.Equal, "1.1")
+			a.So(versions[1].LoRaWANVersion, should.Equal, ttnpb.MAC_UNKNOWN)
+		}
+
+		versions, err = idx.Versions("thethingsproducts", "thethingsuno", "EU_863_870")
+		a.So(err, should.BeNil)
+		if a.So(versions, should.HaveLength, 1) {
+			a.So(versions[0].FirmwareVersion, should.Equal, "1.1")
+			a.So(versions[0].LoRaWANVersion, should.Equal, ttnpb.MAC_V1_0_2)
+			a.So(versions[0].LoRaWANPHYVersion, should.Equal, ttnpb.PHY_V1_0_2_REV_B)
+			a.So(versions[0].SupportsJoin, should.BeTrue)
+		}
+	})
+
+	t.Run("Template", func(t *testing.T) {
+		a := assertions.New(t)
+
+		_, err := idx.Template(ttnpb.EndDeviceVersionIdentifiers{
+			BrandID: "thethingsproducts",
+			ModelID: "thethingsuno",
+		}, "US_902_928")
+		a.So(errors.IsNotFound(err), should.BeTrue)
+
+		_, err = idx.Template(ttnpb.EndDeviceVersionIdentifiers{
+			BrandID:         "thethingsproducts",
+			ModelID:         "thethingsuno",
+			HardwareVersion: "2.0",
+		}, "EU_863_870")
+		a.So(errors.IsNotFound(err), should.BeTrue)
+
+		tmpl, err := idx.Template(ttnpb.EndDeviceVersionIdentifiers{
+			BrandID: "thethingsproducts",
+			ModelID: "thethingsuno",
+		}, "EU_863_870")
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		a.So(tmpl.EndDevice.VersionIDs, should.Resemble, &ttnpb.EndDeviceVersionIdentifiers{
+			BrandID:         "thethingsproducts",
+			ModelID:         "thethingsuno",
+			HardwareVersion: "1.0",
+			FirmwareVersion: "1.1",
+		})
+		a.So(tmpl.EndDevice.LoRaWANVersion, should.Equal, ttnpb.MAC_V1_0_2)
+		a.So(tmpl.EndDevice.LoRaWANPHYVersion, should.Equal, ttnpb.PHY_V1_0_2_REV_B)
+		a.So(tmpl.EndDevice.SupportsJoin, should.BeTrue)
+		a.So(tmpl.EndDevice.MACSettings, should.Resemble, &ttnpb.MACSettings{
+			Rx1Delay:          &ttnpb.RxDelayValue{Value: ttnpb.RX_DELAY_5},
+			Supports32BitFCnt: &pbtypes.BoolValue{Value: true},
+		})
+		a.So(tmpl.FieldMask.Paths, should.Resemble, []string{
+			"formatters",
+			"lorawan_phy_version",
+			"lorawan_version",
+			"mac_settings",
+			"resets_join_nonces",
+			"supports_class_b",
+			"supports_class_c",
+			"supports_join",
+			"version_ids",
+		})
+	})
+}
@@ -0,0 +1,94 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package devicerepository
+
+import (
+	"context"
+
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"go.thethings.network/lorawan-stack/pkg/component"
+	"go.thethings.network/lorawan-stack/pkg/log"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"google.golang.org/grpc"
+)
+
+// Config represents the Device Repository configuration.
+type Config struct {
+}
+
+// DeviceRepository implements the Device Repository component.
+//
+// The Device Repository exposes the DeviceRepository service. It serves an index of the end device brands, models,
+// versions and profiles that is built from the device repository source in the service configuration, for example
+// a directory with a local checkout of the Device Repository.
+type DeviceRepository struct {
+	*component.Component
+	ctx context.Context
+
+	index *Index
+
+	grpc struct {
+		deviceRepository *deviceRepositoryServer
+	}
+}
+
+// New returns a new *DeviceRepository.
+func New(c *component.Component, conf *Config) (*DeviceRepository, error) {
+	ctx := log.NewContextWithField(c.Context(), "namespace", "devicerepository")
+
+	fetcher, err := c.GetBaseConfig(ctx).DeviceRepositoryFetcher(ctx)
+	if err != nil {
+		return nil, err
+	}
+	index := &Index{}
+	if fetcher != nil {
+		index, err = NewIndex(Client{Fetcher: fetcher})
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		log.FromContext(ctx).Warn("No device repository source configured")
+	}
+
+	dr := &DeviceRepository{
+		Component: c,
+		ctx:       ctx,
+		index:     index,
+	}
+	dr.grpc.deviceRepository = &deviceRepositoryServer{DR: dr}
+
+	c.RegisterGRPC(dr)
+	return dr, nil
+}
+
+// Context returns the context of the Device Repository.
+func (dr *DeviceRepository) Context() context.Context {
+	return dr.ctx
+}
+
+// Roles returns the roles that the Device Repository fulfills.
+func (dr *DeviceRepository) Roles() []ttnpb.ClusterRole {
+	return []ttnpb.ClusterRole{ttnpb.ClusterRole_DEVICE_REPOSITORY}
+}
+
+// RegisterServices registers services provided by dr at s.
+func (dr *DeviceRepository) RegisterServices(s *grpc.Server) {
+	ttnpb.RegisterDeviceRepositoryServer(s, dr.grpc.deviceRepository)
+}
+
+// RegisterHandlers registers gRPC handlers.
+func (dr *DeviceRepository) RegisterHandlers(s *runtime.ServeMux, conn *grpc.ClientConn) {
+	ttnpb.RegisterDeviceRepositoryHandler(dr.Context(), s, conn)
+}
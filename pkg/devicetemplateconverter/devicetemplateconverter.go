@@ -29,7 +29,8 @@ import (
 
 // Config represents the DeviceTemplateConverter configuration.
 type Config struct {
-	Enabled []string `name:"enabled" description:"Enabled converters"`
+	Enabled []string                  `name:"enabled" description:"Enabled converters"`
+	CSV     devicetemplates.CSVConfig `name:"csv"`
 }
 
 // DeviceTemplateConverter implements the Device Template Converter component.
@@ -46,13 +47,19 @@ type DeviceTemplateConverter struct {
 	}
 }
 
-var errNotFound = errors.DefineNotFound("converter", "converter `{id}` not found")
+var (
+	errNotFound   = errors.DefineNotFound("converter", "converter `{id}` not found")
+	errCSVOptions = errors.DefineInvalidArgument("csv_options", "converter `{id}` does not support CSV options")
+)
 
 // New returns a new *DeviceTemplateConverter.
 func New(c *component.Component, conf *Config) (*DeviceTemplateConverter, error) {
 	converters := make(map[string]devicetemplates.Converter, len(conf.Enabled))
 	for _, id := range conf.Enabled {
 		converter := devicetemplates.GetConverter(id)
+		if id == "csv" {
+			converter = devicetemplates.NewCSV(conf.CSV)
+		}
 		if converter == nil {
 			return nil, errNotFound.WithAttributes("id", id)
 		}
@@ -19,6 +19,7 @@ import (
 	"context"
 
 	pbtypes "github.com/gogo/protobuf/types"
+	"go.thethings.network/lorawan-stack/pkg/devicetemplates"
 	"go.thethings.network/lorawan-stack/pkg/errorcontext"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
 )
@@ -44,6 +45,13 @@ func (s *endDeviceTemplateConverterServer) Convert(req *ttnpb.ConvertEndDeviceTe
 	if !ok {
 		return errNotFound.WithAttributes("id", req.FormatID)
 	}
+	if req.CSVOptions != nil {
+		csv, ok := converter.(*devicetemplates.CSV)
+		if !ok {
+			return errCSVOptions.WithAttributes("id", req.FormatID)
+		}
+		converter = csv.WithOptions(req.CSVOptions)
+	}
 	ctx, cancel := errorcontext.New(res.Context())
 	ch := make(chan *ttnpb.EndDeviceTemplate)
 	go func() {
@@ -16,6 +16,7 @@ package devicetemplateconverter_test
 
 import (
 	"bufio"
+	"bytes"
 	"context"
 	"fmt"
 	"io"
@@ -27,8 +28,10 @@ import (
 	componenttest "go.thethings.network/lorawan-stack/pkg/component/test"
 	. "go.thethings.network/lorawan-stack/pkg/devicetemplateconverter"
 	"go.thethings.network/lorawan-stack/pkg/devicetemplates"
+	"go.thethings.network/lorawan-stack/pkg/errors"
 	"go.thethings.network/lorawan-stack/pkg/log"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/types"
 	"go.thethings.network/lorawan-stack/pkg/util/test"
 	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
 )
@@ -123,3 +126,69 @@ func TestConvertEndDeviceTemplate(t *testing.T) {
 		},
 	})
 }
+
+func TestConvertCSVOptions(t *testing.T) {
+	a := assertions.New(t)
+	ctx := log.NewContext(test.Context(), test.GetLogger(t))
+
+	c := componenttest.NewComponent(t, &component.Config{})
+	test.Must(New(c, &Config{
+		Enabled: []string{"csv"},
+	}))
+	componenttest.StartComponent(t, c)
+	defer c.Close()
+
+	mustHavePeer(ctx, c, ttnpb.ClusterRole_DEVICE_TEMPLATE_CONVERTER)
+
+	client := ttnpb.NewEndDeviceTemplateConverterClient(c.LoopbackConn())
+
+	// Export an end device with the options of the CLI, and import it with the same options.
+	dev := &ttnpb.EndDevice{
+		EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+			DeviceID: "dev1",
+			DevEUI:   &types.EUI64{0x00, 0x04, 0xa3, 0x0b, 0x00, 0x1c, 0x05, 0x30},
+			JoinEUI:  &types.EUI64{0x70, 0xb3, 0xd5, 0x7e, 0xd0, 0x00, 0x00, 0x00},
+		},
+	}
+	options := &ttnpb.ConvertEndDeviceTemplateRequest_CSVOptions{
+		Separator: ";",
+		Base64:    true,
+	}
+	var buf bytes.Buffer
+	w, err := devicetemplates.NewCSVWriter(&buf, devicetemplates.CSVConfig{
+		Separator: options.Separator,
+		Base64:    options.Base64,
+	}, []string{"ids.device_id", "ids.join_eui", "ids.dev_eui"})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(w.Write(dev), should.BeNil)
+	a.So(w.Flush(), should.BeNil)
+
+	stream, err := client.Convert(ctx, &ttnpb.ConvertEndDeviceTemplateRequest{
+		FormatID:   "csv",
+		Data:       buf.Bytes(),
+		CSVOptions: options,
+	})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	tmpl, err := stream.Recv()
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(tmpl.EndDevice.EndDeviceIdentifiers, should.Resemble, dev.EndDeviceIdentifiers)
+	_, err = stream.Recv()
+	a.So(err, should.Equal, io.EOF)
+
+	// The configured options of the converter do not match the data.
+	stream, err = client.Convert(ctx, &ttnpb.ConvertEndDeviceTemplateRequest{
+		FormatID: "csv",
+		Data:     buf.Bytes(),
+	})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	_, err = stream.Recv()
+	a.So(errors.IsInvalidArgument(err), should.BeTrue)
+}
@@ -0,0 +1,237 @@
+// Copyright © 2026 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package devicetemplates
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	pbtypes "github.com/gogo/protobuf/types"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/types"
+)
+
+// chirpStackDevice is an end device as returned by the ChirpStack Application Server API.
+// The device, device profile, keys and activation are returned by separate API calls, and they are merged in a
+// single object.
+type chirpStackDevice struct {
+	Device struct {
+		DevEUI        types.EUI64       `json:"devEUI"`
+		Name          string            `json:"name"`
+		Description   string            `json:"description"`
+		SkipFCntCheck bool              `json:"skipFCntCheck"`
+		Tags          map[string]string `json:"tags"`
+	} `json:"device"`
+	DeviceProfile struct {
+		MACVersion        string `json:"macVersion"`
+		RegParamsRevision string `json:"regParamsRevision"`
+		SupportsJoin      bool   `json:"supportsJoin"`
+		SupportsClassB    bool   `json:"supportsClassB"`
+		SupportsClassC    bool   `json:"supportsClassC"`
+		Supports32BitFCnt bool   `json:"supports32BitFCnt"`
+	} `json:"deviceProfile"`
+	DeviceKeys *struct {
+		NwkKey types.AES128Key `json:"nwkKey"`
+		AppKey types.AES128Key `json:"appKey"`
+	} `json:"deviceKeys"`
+	DeviceActivation *struct {
+		DevAddr     types.DevAddr   `json:"devAddr"`
+		AppSKey     types.AES128Key `json:"appSKey"`
+		NwkSEncKey  types.AES128Key `json:"nwkSEncKey"`
+		SNwkSIntKey types.AES128Key `json:"sNwkSIntKey"`
+		FNwkSIntKey types.AES128Key `json:"fNwkSIntKey"`
+		FCntUp      uint32          `json:"fCntUp"`
+		NFCntDown   uint32          `json:"nFCntDown"`
+		AFCntDown   uint32          `json:"aFCntDown"`
+	} `json:"deviceActivation"`
+}
+
+var (
+	errChirpStackData              = errors.DefineInvalidArgument("chirpstack_data", "invalid ChirpStack data")
+	errChirpStackMACVersion        = errors.DefineInvalidArgument("chirpstack_mac_version", "unknown ChirpStack MAC version `{mac_version}`")
+	errChirpStackRegParamsRevision = errors.DefineInvalidArgument("chirpstack_reg_params_revision", "unknown ChirpStack regional parameters revision `{revision}` for MAC version `{mac_version}`")
+)
+
+var chirpStackMACVersions = map[string]ttnpb.MACVersion{
+	"1.0.0": ttnpb.MAC_V1_0,
+	"1.0.1": ttnpb.MAC_V1_0_1,
+	"1.0.2": ttnpb.MAC_V1_0_2,
+	"1.0.3": ttnpb.MAC_V1_0_3,
+	"1.1.0": ttnpb.MAC_V1_1,
+}
+
+var chirpStackPHYVersions = map[ttnpb.MACVersion]map[string]ttnpb.PHYVersion{
+	ttnpb.MAC_V1_0: {
+		"A": ttnpb.PHY_V1_0,
+	},
+	ttnpb.MAC_V1_0_1: {
+		"A": ttnpb.PHY_V1_0_1,
+	},
+	ttnpb.MAC_V1_0_2: {
+		"A": ttnpb.PHY_V1_0_2_REV_A,
+		"B": ttnpb.PHY_V1_0_2_REV_B,
+	},
+	ttnpb.MAC_V1_0_3: {
+		"A": ttnpb.PHY_V1_0_3_REV_A,
+	},
+	ttnpb.MAC_V1_1: {
+		"A": ttnpb.PHY_V1_1_REV_A,
+		"B": ttnpb.PHY_V1_1_REV_B,
+	},
+}
+
+// lastFCnt returns the last used frame counter value, given the next frame counter value.
+func lastFCnt(next uint32) uint32 {
+	if next == 0 {
+		return 0
+	}
+	return next - 1
+}
+
+// chirpStack is a converter for end devices exported from ChirpStack.
+type chirpStack struct{}
+
+func (chirpStack) Format() *ttnpb.EndDeviceTemplateFormat {
+	return &ttnpb.EndDeviceTemplateFormat{
+		Name:           "ChirpStack",
+		Description:    "JSON end devices with device profile, keys and activation exported from the ChirpStack Application Server API.",
+		FileExtensions: []string{".json"},
+	}
+}
+
+// Convert decodes the given end devices.
+// The input data is an array of end devices or a single end device. Each end device is an object with `device`,
+// `deviceProfile`, `deviceKeys` and `deviceActivation`, as returned by the ChirpStack Application Server API.
+// ChirpStack stores the next frame counters, while The Things Stack stores the last used frame counters.
+func (chirpStack) Convert(ctx context.Context, r io.Reader, ch chan<- *ttnpb.EndDeviceTemplate) error {
+	defer close(ch)
+
+	entries, err := readJSONEntries(r)
+	if err != nil {
+		return errChirpStackData.WithCause(err)
+	}
+
+	for _, entry := range entries {
+		var cs chirpStackDevice
+		if err := json.Unmarshal(entry, &cs); err != nil {
+			return errChirpStackData.WithCause(err)
+		}
+		macVersion, ok := chirpStackMACVersions[cs.DeviceProfile.MACVersion]
+		if !ok {
+			return errChirpStackMACVersion.WithAttributes("mac_version", cs.DeviceProfile.MACVersion)
+		}
+		phyVersion, ok := chirpStackPHYVersions[macVersion][cs.DeviceProfile.RegParamsRevision]
+		if !ok {
+			return errChirpStackRegParamsRevision.WithAttributes(
+				"revision", cs.DeviceProfile.RegParamsRevision,
+				"mac_version", cs.DeviceProfile.MACVersion,
+			)
+		}
+		devEUI := cs.Device.DevEUI
+
+		tmpl := &ttnpb.EndDeviceTemplate{
+			EndDevice: ttnpb.EndDevice{
+				EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+					DeviceID: strings.ToLower(fmt.Sprintf("eui-%s", devEUI)),
+					DevEUI:   &devEUI,
+				},
+				Name:              cs.Device.Name,
+				Description:       cs.Device.Description,
+				Attributes:        cs.Device.Tags,
+				LoRaWANVersion:    macVersion,
+				LoRaWANPHYVersion: phyVersion,
+				SupportsJoin:      cs.DeviceProfile.SupportsJoin,
+				SupportsClassB:    cs.DeviceProfile.SupportsClassB,
+				SupportsClassC:    cs.DeviceProfile.SupportsClassC,
+				MACSettings: &ttnpb.MACSettings{
+					Supports32BitFCnt: &pbtypes.BoolValue{Value: cs.DeviceProfile.Supports32BitFCnt},
+					ResetsFCnt:        &pbtypes.BoolValue{Value: cs.Device.SkipFCntCheck},
+				},
+			},
+			FieldMask: pbtypes.FieldMask{
+				Paths: []string{
+					"description",
+					"ids.dev_eui",
+					"ids.device_id",
+					"lorawan_phy_version",
+					"lorawan_version",
+					"mac_settings.resets_f_cnt",
+					"mac_settings.supports_32_bit_f_cnt",
+					"name",
+					"supports_class_b",
+					"supports_class_c",
+					"supports_join",
+				},
+			},
+			MappingKey: devEUI.String(),
+		}
+		if len(cs.Device.Tags) > 0 {
+			tmpl.FieldMask.Paths = append(tmpl.FieldMask.Paths, "attributes")
+		}
+		if keys := cs.DeviceKeys; keys != nil && cs.DeviceProfile.SupportsJoin {
+			tmpl.EndDevice.RootKeys = &ttnpb.RootKeys{}
+			if macVersion.Compare(ttnpb.MAC_V1_1) >= 0 {
+				tmpl.EndDevice.RootKeys.NwkKey = &ttnpb.KeyEnvelope{Key: &keys.NwkKey}
+				tmpl.EndDevice.RootKeys.AppKey = &ttnpb.KeyEnvelope{Key: &keys.AppKey}
+				tmpl.FieldMask.Paths = append(tmpl.FieldMask.Paths, "root_keys.app_key.key", "root_keys.nwk_key.key")
+			} else {
+				// ChirpStack stores the AppKey of LoRaWAN 1.0.x end devices as NwkKey.
+				tmpl.EndDevice.RootKeys.AppKey = &ttnpb.KeyEnvelope{Key: &keys.NwkKey}
+				tmpl.FieldMask.Paths = append(tmpl.FieldMask.Paths, "root_keys.app_key.key")
+			}
+		}
+		if act := cs.DeviceActivation; act != nil && !act.DevAddr.IsZero() {
+			session := &ttnpb.Session{
+				DevAddr: act.DevAddr,
+				SessionKeys: ttnpb.SessionKeys{
+					FNwkSIntKey: &ttnpb.KeyEnvelope{Key: &act.FNwkSIntKey},
+					AppSKey:     &ttnpb.KeyEnvelope{Key: &act.AppSKey},
+				},
+				LastFCntUp:    lastFCnt(act.FCntUp),
+				LastNFCntDown: lastFCnt(act.NFCntDown),
+			}
+			tmpl.FieldMask.Paths = append(tmpl.FieldMask.Paths,
+				"ids.dev_addr",
+				"session.dev_addr",
+				"session.keys.app_s_key.key",
+				"session.keys.f_nwk_s_int_key.key",
+				"session.last_f_cnt_up",
+				"session.last_n_f_cnt_down",
+			)
+			if macVersion.Compare(ttnpb.MAC_V1_1) >= 0 {
+				session.SessionKeys.SNwkSIntKey = &ttnpb.KeyEnvelope{Key: &act.SNwkSIntKey}
+				session.SessionKeys.NwkSEncKey = &ttnpb.KeyEnvelope{Key: &act.NwkSEncKey}
+				session.LastAFCntDown = lastFCnt(act.AFCntDown)
+				tmpl.FieldMask.Paths = append(tmpl.FieldMask.Paths,
+					"session.keys.nwk_s_enc_key.key",
+					"session.keys.s_nwk_s_int_key.key",
+					"session.last_a_f_cnt_down",
+				)
+			}
+			tmpl.EndDevice.DevAddr = &session.DevAddr
+			tmpl.EndDevice.Session = session
+		}
+		ch <- tmpl
+	}
+	return nil
+}
+
+func init() {
+	RegisterConverter("chirpstack", chirpStack{})
+}
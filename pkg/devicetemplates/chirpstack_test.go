@@ -0,0 +1,128 @@
+// Copyright © 2026 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package devicetemplates_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/smartystreets/assertions"
+	. "go.thethings.network/lorawan-stack/pkg/devicetemplates"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/log"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/types"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+func TestChirpStack(t *testing.T) {
+	a := assertions.New(t)
+	ctx := log.NewContext(test.Context(), test.GetLogger(t))
+
+	converter := GetConverter("chirpstack")
+	if !a.So(converter, should.NotBeNil) {
+		t.FailNow()
+	}
+
+	format := converter.Format()
+	a.So(format.Name, should.Equal, "ChirpStack")
+
+	data := []byte(`[{
+		"device": {
+			"devEUI": "0004a30b001c0530",
+			"name": "Device 1",
+			"description": "LoRaWAN 1.0.3 device",
+			"tags": {"foo": "bar"}
+		},
+		"deviceProfile": {
+			"macVersion": "1.0.3",
+			"regParamsRevision": "A",
+			"supportsJoin": true,
+			"supportsClassC": true
+		},
+		"deviceKeys": {
+			"nwkKey": "0102030405060708090a0b0c0d0e0f10",
+			"appKey": "00000000000000000000000000000000"
+		},
+		"deviceActivation": {
+			"devAddr": "26011234",
+			"appSKey": "08070605040302010807060504030201",
+			"nwkSEncKey": "01020304050607080102030405060708",
+			"sNwkSIntKey": "01020304050607080102030405060708",
+			"fNwkSIntKey": "01020304050607080102030405060708",
+			"fCntUp": 43,
+			"nFCntDown": 4,
+			"aFCntDown": 0
+		}
+	}, {
+		"device": {
+			"devEUI": "0004a30b001c0531",
+			"name": "Device 2",
+			"skipFCntCheck": true
+		},
+		"deviceProfile": {
+			"macVersion": "1.1.0",
+			"regParamsRevision": "B",
+			"supportsJoin": true
+		},
+		"deviceKeys": {
+			"nwkKey": "0102030405060708090a0b0c0d0e0f10",
+			"appKey": "100f0e0d0c0b0a090807060504030201"
+		}
+	}]`)
+
+	ch := make(chan *ttnpb.EndDeviceTemplate, 2)
+	err := converter.Convert(ctx, bytes.NewReader(data), ch)
+	a.So(err, should.BeNil)
+
+	dev1, ok := <-ch
+	if !a.So(ok, should.BeTrue) {
+		t.FailNow()
+	}
+	a.So(dev1.EndDevice.DeviceID, should.Equal, "eui-0004a30b001c0530")
+	a.So(dev1.EndDevice.Name, should.Equal, "Device 1")
+	a.So(dev1.EndDevice.LoRaWANVersion, should.Equal, ttnpb.MAC_V1_0_3)
+	a.So(dev1.EndDevice.LoRaWANPHYVersion, should.Equal, ttnpb.PHY_V1_0_3_REV_A)
+	a.So(dev1.EndDevice.SupportsClassC, should.BeTrue)
+	a.So(dev1.EndDevice.RootKeys.GetAppKey().GetKey(), should.Resemble, &types.AES128Key{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f, 0x10})
+	a.So(dev1.EndDevice.RootKeys.GetNwkKey(), should.BeNil)
+	if a.So(dev1.EndDevice.Session, should.NotBeNil) {
+		a.So(dev1.EndDevice.Session.DevAddr, should.Equal, types.DevAddr{0x26, 0x01, 0x12, 0x34})
+		a.So(dev1.EndDevice.Session.LastFCntUp, should.Equal, 42)
+		a.So(dev1.EndDevice.Session.LastNFCntDown, should.Equal, 3)
+		a.So(dev1.EndDevice.Session.SNwkSIntKey, should.BeNil)
+	}
+	a.So(dev1.FieldMask.Paths, should.Contain, "attributes")
+	a.So(dev1.FieldMask.Paths, should.NotContain, "session.keys.s_nwk_s_int_key.key")
+
+	dev2, ok := <-ch
+	if !a.So(ok, should.BeTrue) {
+		t.FailNow()
+	}
+	a.So(dev2.EndDevice.LoRaWANVersion, should.Equal, ttnpb.MAC_V1_1)
+	a.So(dev2.EndDevice.LoRaWANPHYVersion, should.Equal, ttnpb.PHY_V1_1_REV_B)
+	a.So(dev2.EndDevice.MACSettings.GetResetsFCnt().GetValue(), should.BeTrue)
+	a.So(dev2.EndDevice.RootKeys.GetNwkKey().GetKey(), should.Resemble, &types.AES128Key{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f, 0x10})
+	a.So(dev2.EndDevice.RootKeys.GetAppKey().GetKey(), should.Resemble, &types.AES128Key{0x10, 0x0f, 0x0e, 0x0d, 0x0c, 0x0b, 0x0a, 0x09, 0x08, 0x07, 0x06, 0x05, 0x04, 0x03, 0x02, 0x01})
+	a.So(dev2.EndDevice.Session, should.BeNil)
+
+	_, ok = <-ch
+	a.So(ok, should.BeFalse)
+
+	ch = make(chan *ttnpb.EndDeviceTemplate, 1)
+	err = converter.Convert(ctx, bytes.NewReader([]byte(`{"device": {"devEUI": "0004a30b001c0530"}, "deviceProfile": {"macVersion": "1.2.0"}}`)), ch)
+	a.So(errors.IsInvalidArgument(err), should.BeTrue)
+}
@@ -0,0 +1,541 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package devicetemplates
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/csv"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	pbtypes "github.com/gogo/protobuf/types"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/types"
+)
+
+// CSVConfig is the configuration of the CSV converter.
+type CSVConfig struct {
+	Separator         string `name:"separator" description:"Separator of the columns (default is comma)"`
+	Base64            bool   `name:"base64" description:"EUIs, addresses and keys are base64 encoded instead of hex encoded"`
+	FrequencyPlanID   string `name:"frequency-plan-id" description:"Frequency plan ID of end devices without frequency plan ID column"`
+	LoRaWANVersion    string `name:"lorawan-version" description:"LoRaWAN version of end devices without LoRaWAN version column"`
+	LoRaWANPHYVersion string `name:"lorawan-phy-version" description:"LoRaWAN PHY version of end devices without LoRaWAN PHY version column"`
+}
+
+var (
+	errCSVData      = errors.DefineInvalidArgument("csv_data", "invalid CSV data")
+	errCSVSeparator = errors.DefineInvalidArgument("csv_separator", "invalid CSV separator `{separator}`")
+	errCSVColumn    = errors.DefineInvalidArgument("csv_column", "unsupported CSV column `{column}`")
+	errCSVRow       = errors.DefineInvalidArgument("csv_row", "invalid CSV row {row}")
+	errCSVValue     = errors.DefineInvalidArgument("csv_value", "invalid value for `{column}`")
+	errCSVLength    = errors.DefineInvalidArgument("csv_length", "invalid length {length}, expected {expected}")
+)
+
+func (c CSVConfig) separator() (rune, error) {
+	if c.Separator == "" {
+		return ',', nil
+	}
+	r, n := utf8.DecodeRuneInString(c.Separator)
+	if n != len(c.Separator) || r == '"' || r == '\r' || r == '\n' || r == utf8.RuneError {
+		return 0, errCSVSeparator.WithAttributes("separator", c.Separator)
+	}
+	return r, nil
+}
+
+func (c CSVConfig) encodeBytes(b []byte) string {
+	if c.Base64 {
+		return base64.StdEncoding.EncodeToString(b)
+	}
+	return strings.ToUpper(hex.EncodeToString(b))
+}
+
+func (c CSVConfig) decodeBytes(s string, dst []byte) error {
+	var b []byte
+	var err error
+	if c.Base64 {
+		b, err = base64.StdEncoding.DecodeString(s)
+	} else {
+		b, err = hex.DecodeString(s)
+	}
+	if err != nil {
+		return err
+	}
+	if len(b) != len(dst) {
+		return errCSVLength.WithAttributes("length", len(b), "expected", len(dst))
+	}
+	copy(dst, b)
+	return nil
+}
+
+// csvField is a CSV column of an end device field. The value of get is empty if the field is not set.
+type csvField struct {
+	get func(CSVConfig, *ttnpb.EndDevice) string
+	set func(CSVConfig, *ttnpb.EndDevice, string) error
+}
+
+func csvStringField(get func(*ttnpb.EndDevice) string, set func(*ttnpb.EndDevice, string)) csvField {
+	return csvField{
+		get: func(_ CSVConfig, dev *ttnpb.EndDevice) string { return get(dev) },
+		set: func(_ CSVConfig, dev *ttnpb.EndDevice, s string) error {
+			set(dev, s)
+			return nil
+		},
+	}
+}
+
+func csvBoolField(get func(*ttnpb.EndDevice) bool, set func(*ttnpb.EndDevice, bool)) csvField {
+	return csvField{
+		get: func(_ CSVConfig, dev *ttnpb.EndDevice) string { return strconv.FormatBool(get(dev)) },
+		set: func(_ CSVConfig, dev *ttnpb.EndDevice, s string) error {
+			v, err := strconv.ParseBool(s)
+			if err != nil {
+				return err
+			}
+			set(dev, v)
+			return nil
+		},
+	}
+}
+
+func csvFCntField(get func(*ttnpb.Session) uint32, set func(*ttnpb.Session, uint32)) csvField {
+	return csvField{
+		get: func(_ CSVConfig, dev *ttnpb.EndDevice) string {
+			if dev.Session == nil {
+				return ""
+			}
+			return strconv.FormatUint(uint64(get(dev.Session)), 10)
+		},
+		set: func(_ CSVConfig, dev *ttnpb.EndDevice, s string) error {
+			v, err := strconv.ParseUint(s, 10, 32)
+			if err != nil {
+				return err
+			}
+			if dev.Session == nil {
+				dev.Session = &ttnpb.Session{}
+			}
+			set(dev.Session, uint32(v))
+			return nil
+		},
+	}
+}
+
+func csvEUIField(get func(*ttnpb.EndDevice) **types.EUI64) csvField {
+	return csvField{
+		get: func(conf CSVConfig, dev *ttnpb.EndDevice) string {
+			if eui := *get(dev); eui != nil {
+				return conf.encodeBytes(eui[:])
+			}
+			return ""
+		},
+		set: func(conf CSVConfig, dev *ttnpb.EndDevice, s string) error {
+			var eui types.EUI64
+			if err := conf.decodeBytes(s, eui[:]); err != nil {
+				return err
+			}
+			*get(dev) = &eui
+			return nil
+		},
+	}
+}
+
+func csvKeyField(get func(*ttnpb.EndDevice) *ttnpb.KeyEnvelope, set func(*ttnpb.EndDevice, *ttnpb.KeyEnvelope)) csvField {
+	return csvField{
+		get: func(conf CSVConfig, dev *ttnpb.EndDevice) string {
+			if key := get(dev).GetKey(); key != nil {
+				return conf.encodeBytes(key[:])
+			}
+			return ""
+		},
+		set: func(conf CSVConfig, dev *ttnpb.EndDevice, s string) error {
+			var key types.AES128Key
+			if err := conf.decodeBytes(s, key[:]); err != nil {
+				return err
+			}
+			set(dev, &ttnpb.KeyEnvelope{Key: &key})
+			return nil
+		},
+	}
+}
+
+func rootKeys(dev *ttnpb.EndDevice) *ttnpb.RootKeys {
+	if dev.RootKeys == nil {
+		dev.RootKeys = &ttnpb.RootKeys{}
+	}
+	return dev.RootKeys
+}
+
+func sessionKeys(dev *ttnpb.EndDevice) *ttnpb.SessionKeys {
+	if dev.Session == nil {
+		return nil
+	}
+	return &dev.Session.SessionKeys
+}
+
+func session(dev *ttnpb.EndDevice) *ttnpb.Session {
+	if dev.Session == nil {
+		dev.Session = &ttnpb.Session{}
+	}
+	return dev.Session
+}
+
+var csvFields = map[string]csvField{
+	"ids.device_id": csvStringField(
+		func(dev *ttnpb.EndDevice) string { return dev.DeviceID },
+		func(dev *ttnpb.EndDevice, s string) { dev.DeviceID = s },
+	),
+	"ids.dev_eui":  csvEUIField(func(dev *ttnpb.EndDevice) **types.EUI64 { return &dev.DevEUI }),
+	"ids.join_eui": csvEUIField(func(dev *ttnpb.EndDevice) **types.EUI64 { return &dev.JoinEUI }),
+	"name": csvStringField(
+		func(dev *ttnpb.EndDevice) string { return dev.Name },
+		func(dev *ttnpb.EndDevice, s string) { dev.Name = s },
+	),
+	"description": csvStringField(
+		func(dev *ttnpb.EndDevice) string { return dev.Description },
+		func(dev *ttnpb.EndDevice, s string) { dev.Description = s },
+	),
+	"frequency_plan_id": csvStringField(
+		func(dev *ttnpb.EndDevice) string { return dev.FrequencyPlanID },
+		func(dev *ttnpb.EndDevice, s string) { dev.FrequencyPlanID = s },
+	),
+	"lorawan_version": {
+		get: func(_ CSVConfig, dev *ttnpb.EndDevice) string {
+			if dev.LoRaWANVersion == ttnpb.MAC_UNKNOWN {
+				return ""
+			}
+			return dev.LoRaWANVersion.String()
+		},
+		set: func(_ CSVConfig, dev *ttnpb.EndDevice, s string) error {
+			return dev.LoRaWANVersion.UnmarshalText([]byte(s))
+		},
+	},
+	"lorawan_phy_version": {
+		get: func(_ CSVConfig, dev *ttnpb.EndDevice) string {
+			if dev.LoRaWANPHYVersion == ttnpb.PHY_UNKNOWN {
+				return ""
+			}
+			return dev.LoRaWANPHYVersion.String()
+		},
+		set: func(_ CSVConfig, dev *ttnpb.EndDevice, s string) error {
+			return dev.LoRaWANPHYVersion.UnmarshalText([]byte(s))
+		},
+	},
+	"supports_join": csvBoolField(
+		func(dev *ttnpb.EndDevice) bool { return dev.SupportsJoin },
+		func(dev *ttnpb.EndDevice, v bool) { dev.SupportsJoin = v },
+	),
+	"supports_class_b": csvBoolField(
+		func(dev *ttnpb.EndDevice) bool { return dev.SupportsClassB },
+		func(dev *ttnpb.EndDevice, v bool) { dev.SupportsClassB = v },
+	),
+	"supports_class_c": csvBoolField(
+		func(dev *ttnpb.EndDevice) bool { return dev.SupportsClassC },
+		func(dev *ttnpb.EndDevice, v bool) { dev.SupportsClassC = v },
+	),
+	"root_keys.root_key_id": csvStringField(
+		func(dev *ttnpb.EndDevice) string { return dev.GetRootKeys().GetRootKeyID() },
+		func(dev *ttnpb.EndDevice, s string) { rootKeys(dev).RootKeyID = s },
+	),
+	"root_keys.app_key.key": csvKeyField(
+		func(dev *ttnpb.EndDevice) *ttnpb.KeyEnvelope { return dev.GetRootKeys().GetAppKey() },
+		func(dev *ttnpb.EndDevice, key *ttnpb.KeyEnvelope) { rootKeys(dev).AppKey = key },
+	),
+	"root_keys.nwk_key.key": csvKeyField(
+		func(dev *ttnpb.EndDevice) *ttnpb.KeyEnvelope { return dev.GetRootKeys().GetNwkKey() },
+		func(dev *ttnpb.EndDevice, key *ttnpb.KeyEnvelope) { rootKeys(dev).NwkKey = key },
+	),
+	"session.dev_addr": {
+		get: func(conf CSVConfig, dev *ttnpb.EndDevice) string {
+			if dev.Session == nil {
+				return ""
+			}
+			return conf.encodeBytes(dev.Session.DevAddr[:])
+		},
+		set: func(conf CSVConfig, dev *ttnpb.EndDevice, s string) error {
+			var devAddr types.DevAddr
+			if err := conf.decodeBytes(s, devAddr[:]); err != nil {
+				return err
+			}
+			session(dev).DevAddr = devAddr
+			dev.DevAddr = &devAddr
+			return nil
+		},
+	},
+	"session.keys.app_s_key.key": csvKeyField(
+		func(dev *ttnpb.EndDevice) *ttnpb.KeyEnvelope { return sessionKeys(dev).GetAppSKey() },
+		func(dev *ttnpb.EndDevice, key *ttnpb.KeyEnvelope) { session(dev).AppSKey = key },
+	),
+	"session.keys.f_nwk_s_int_key.key": csvKeyField(
+		func(dev *ttnpb.EndDevice) *ttnpb.KeyEnvelope { return sessionKeys(dev).GetFNwkSIntKey() },
+		func(dev *ttnpb.EndDevice, key *ttnpb.KeyEnvelope) { session(dev).FNwkSIntKey = key },
+	),
+	"session.keys.s_nwk_s_int_key.key": csvKeyField(
+		func(dev *ttnpb.EndDevice) *ttnpb.KeyEnvelope { return sessionKeys(dev).GetSNwkSIntKey() },
+		func(dev *ttnpb.EndDevice, key *ttnpb.KeyEnvelope) { session(dev).SNwkSIntKey = key },
+	),
+	"session.keys.nwk_s_enc_key.key": csvKeyField(
+		func(dev *ttnpb.EndDevice) *ttnpb.KeyEnvelope { return sessionKeys(dev).GetNwkSEncKey() },
+		func(dev *ttnpb.EndDevice, key *ttnpb.KeyEnvelope) { session(dev).NwkSEncKey = key },
+	),
+	"session.last_f_cnt_up": csvFCntField(
+		func(s *ttnpb.Session) uint32 { return s.LastFCntUp },
+		func(s *ttnpb.Session, v uint32) { s.LastFCntUp = v },
+	),
+	"session.last_n_f_cnt_down": csvFCntField(
+		func(s *ttnpb.Session) uint32 { return s.LastNFCntDown },
+		func(s *ttnpb.Session, v uint32) { s.LastNFCntDown = v },
+	),
+	"session.last_a_f_cnt_down": csvFCntField(
+		func(s *ttnpb.Session) uint32 { return s.LastAFCntDown },
+		func(s *ttnpb.Session, v uint32) { s.LastAFCntDown = v },
+	),
+}
+
+const csvAttributePrefix = "attributes."
+
+func getCSVField(column string) (csvField, bool) {
+	if strings.HasPrefix(column, csvAttributePrefix) && len(column) > len(csvAttributePrefix) {
+		key := strings.TrimPrefix(column, csvAttributePrefix)
+		return csvStringField(
+			func(dev *ttnpb.EndDevice) string { return dev.Attributes[key] },
+			func(dev *ttnpb.EndDevice, s string) {
+				if dev.Attributes == nil {
+					dev.Attributes = make(map[string]string)
+				}
+				dev.Attributes[key] = s
+			},
+		), true
+	}
+	field, ok := csvFields[column]
+	return field, ok
+}
+
+// csvFieldPath returns the field mask path of the column.
+func csvFieldPath(column string) string {
+	if strings.HasPrefix(column, csvAttributePrefix) {
+		return "attributes"
+	}
+	return column
+}
+
+// CSVColumns returns the CSV columns of the given field mask paths. Paths that are not a column select all columns
+// with that prefix. Attributes are selected with `attributes.<key>`.
+func CSVColumns(paths ...string) ([]string, error) {
+	var columns []string
+	for _, path := range paths {
+		if _, ok := getCSVField(path); ok {
+			columns = append(columns, path)
+			continue
+		}
+		var sub []string
+		for column := range csvFields {
+			if strings.HasPrefix(column, path+".") {
+				sub = append(sub, column)
+			}
+		}
+		if len(sub) == 0 {
+			return nil, errCSVColumn.WithAttributes("column", path)
+		}
+		sort.Strings(sub)
+		columns = append(columns, sub...)
+	}
+	return columns, nil
+}
+
+// CSV is a converter for end devices in CSV files.
+type CSV struct {
+	config CSVConfig
+}
+
+// NewCSV returns a new CSV converter.
+func NewCSV(conf CSVConfig) *CSV {
+	return &CSV{config: conf}
+}
+
+// WithOptions returns a copy of the CSV converter with the separator and encoding of the given options.
+func (c *CSV) WithOptions(opts *ttnpb.ConvertEndDeviceTemplateRequest_CSVOptions) *CSV {
+	conf := c.config
+	conf.Separator = opts.GetSeparator()
+	conf.Base64 = opts.GetBase64()
+	return &CSV{config: conf}
+}
+
+func (c *CSV) Format() *ttnpb.EndDeviceTemplateFormat {
+	return &ttnpb.EndDeviceTemplateFormat{
+		Name:           "CSV",
+		Description:    "CSV file with a header of end device field mask paths.",
+		FileExtensions: []string{".csv"},
+	}
+}
+
+// Convert decodes the given CSV data.
+// The first row is a header with the field mask paths of the columns. Empty values are not set.
+// All rows are validated before the first end device template is sent.
+func (c *CSV) Convert(ctx context.Context, r io.Reader, ch chan<- *ttnpb.EndDeviceTemplate) error {
+	defer close(ch)
+
+	separator, err := c.config.separator()
+	if err != nil {
+		return err
+	}
+	var defaults ttnpb.EndDevice
+	var defaultPaths []string
+	if c.config.FrequencyPlanID != "" {
+		defaults.FrequencyPlanID = c.config.FrequencyPlanID
+		defaultPaths = append(defaultPaths, "frequency_plan_id")
+	}
+	if c.config.LoRaWANVersion != "" {
+		if err := defaults.LoRaWANVersion.UnmarshalText([]byte(c.config.LoRaWANVersion)); err != nil {
+			return err
+		}
+		defaultPaths = append(defaultPaths, "lorawan_version")
+	}
+	if c.config.LoRaWANPHYVersion != "" {
+		if err := defaults.LoRaWANPHYVersion.UnmarshalText([]byte(c.config.LoRaWANPHYVersion)); err != nil {
+			return err
+		}
+		defaultPaths = append(defaultPaths, "lorawan_phy_version")
+	}
+
+	cr := csv.NewReader(r)
+	cr.Comma = separator
+	cr.TrimLeadingSpace = true
+	header, err := cr.Read()
+	if err != nil {
+		return errCSVData.WithCause(err)
+	}
+	fields := make([]csvField, len(header))
+	for i, column := range header {
+		column = strings.TrimSpace(column)
+		field, ok := getCSVField(column)
+		if !ok {
+			return errCSVColumn.WithAttributes("column", column)
+		}
+		header[i], fields[i] = column, field
+	}
+
+	var templates []*ttnpb.EndDeviceTemplate
+	for row := 2; ; row++ {
+		record, err := cr.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return errCSVData.WithCause(err)
+		}
+		tmpl := &ttnpb.EndDeviceTemplate{}
+		dev := &tmpl.EndDevice
+		if err := dev.SetFields(&defaults, defaultPaths...); err != nil {
+			return err
+		}
+		paths := append([]string(nil), defaultPaths...)
+		for i, value := range record {
+			value = strings.TrimSpace(value)
+			if value == "" {
+				continue
+			}
+			if err := fields[i].set(c.config, dev, value); err != nil {
+				return errCSVRow.WithAttributes("row", row).WithCause(
+					errCSVValue.WithAttributes("column", header[i]).WithCause(err),
+				)
+			}
+			paths = append(paths, csvFieldPath(header[i]))
+			if header[i] == "session.dev_addr" {
+				paths = append(paths, "ids.dev_addr")
+			}
+		}
+		if dev.DeviceID == "" && dev.DevEUI != nil {
+			dev.DeviceID = strings.ToLower(fmt.Sprintf("eui-%s", dev.DevEUI))
+			paths = append(paths, "ids.device_id")
+		}
+		paths = ttnpb.BottomLevelFields(paths)
+		sort.Strings(paths)
+		if err := dev.ValidateFields(paths...); err != nil {
+			return errCSVRow.WithAttributes("row", row).WithCause(err)
+		}
+		tmpl.FieldMask = pbtypes.FieldMask{Paths: paths}
+		tmpl.MappingKey = dev.DeviceID
+		templates = append(templates, tmpl)
+	}
+
+	for _, tmpl := range templates {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case ch <- tmpl:
+		}
+	}
+	return nil
+}
+
+// CSVWriter writes end devices as CSV.
+type CSVWriter struct {
+	config  CSVConfig
+	w       *csv.Writer
+	columns []string
+	fields  []csvField
+}
+
+// NewCSVWriter returns a new CSVWriter that writes the given columns. The header is written immediately.
+func NewCSVWriter(w io.Writer, conf CSVConfig, columns []string) (*CSVWriter, error) {
+	separator, err := conf.separator()
+	if err != nil {
+		return nil, err
+	}
+	fields := make([]csvField, len(columns))
+	for i, column := range columns {
+		field, ok := getCSVField(column)
+		if !ok {
+			return nil, errCSVColumn.WithAttributes("column", column)
+		}
+		fields[i] = field
+	}
+	cw := csv.NewWriter(w)
+	cw.Comma = separator
+	if err := cw.Write(columns); err != nil {
+		return nil, err
+	}
+	return &CSVWriter{
+		config:  conf,
+		w:       cw,
+		columns: columns,
+		fields:  fields,
+	}, nil
+}
+
+// Write writes the end device as a row.
+func (w *CSVWriter) Write(dev *ttnpb.EndDevice) error {
+	record := make([]string, len(w.fields))
+	for i, field := range w.fields {
+		record[i] = field.get(w.config, dev)
+	}
+	return w.w.Write(record)
+}
+
+// Flush writes buffered rows and returns any error that occurred.
+func (w *CSVWriter) Flush() error {
+	w.w.Flush()
+	return w.w.Error()
+}
+
+func init() {
+	RegisterConverter("csv", NewCSV(CSVConfig{}))
+}
@@ -0,0 +1,215 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package devicetemplates_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/smartystreets/assertions"
+	. "go.thethings.network/lorawan-stack/pkg/devicetemplates"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/log"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/types"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+func TestCSV(t *testing.T) {
+	a := assertions.New(t)
+	ctx := log.NewContext(test.Context(), test.GetLogger(t))
+
+	converter := GetConverter("csv")
+	if !a.So(converter, should.NotBeNil) {
+		t.FailNow()
+	}
+	a.So(converter.Format().Name, should.Equal, "CSV")
+
+	data := `ids.dev_eui,ids.join_eui,root_keys.app_key.key,lorawan_version,attributes.serial
+0004A30B001C0530,70B3D57ED0000000,0102030405060708090A0B0C0D0E0F10,1.0.3,SN-1
+0004A30B001C0531,70B3D57ED0000000,100F0E0D0C0B0A090807060504030201,1.0.3,
+`
+	ch := make(chan *ttnpb.EndDeviceTemplate, 2)
+	err := converter.Convert(ctx, strings.NewReader(data), ch)
+	a.So(err, should.BeNil)
+
+	dev1, ok := <-ch
+	if !a.So(ok, should.BeTrue) {
+		t.FailNow()
+	}
+	a.So(dev1.EndDevice.DeviceID, should.Equal, "eui-0004a30b001c0530")
+	a.So(dev1.MappingKey, should.Equal, "eui-0004a30b001c0530")
+	a.So(dev1.EndDevice.DevEUI, should.Resemble, &types.EUI64{0x00, 0x04, 0xa3, 0x0b, 0x00, 0x1c, 0x05, 0x30})
+	a.So(dev1.EndDevice.JoinEUI, should.Resemble, &types.EUI64{0x70, 0xb3, 0xd5, 0x7e, 0xd0, 0x00, 0x00, 0x00})
+	a.So(dev1.EndDevice.RootKeys.GetAppKey().GetKey(), should.Resemble, &types.AES128Key{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f, 0x10})
+	a.So(dev1.EndDevice.LoRaWANVersion, should.Equal, ttnpb.MAC_V1_0_3)
+	a.So(dev1.EndDevice.Attributes, should.Resemble, map[string]string{"serial": "SN-1"})
+	a.So(dev1.FieldMask.Paths, should.Resemble, []string{
+		"attributes",
+		"ids.dev_eui",
+		"ids.device_id",
+		"ids.join_eui",
+		"lorawan_version",
+		"root_keys.app_key.key",
+	})
+
+	dev2, ok := <-ch
+	if !a.So(ok, should.BeTrue) {
+		t.FailNow()
+	}
+	a.So(dev2.EndDevice.Attributes, should.BeEmpty)
+	a.So(dev2.FieldMask.Paths, should.NotContain, "attributes")
+
+	_, ok = <-ch
+	a.So(ok, should.BeFalse)
+
+	for _, tc := range []struct {
+		Name string
+		Data string
+	}{
+		{
+			Name: "UnknownColumn",
+			Data: "ids.device_id,foo\ntest,bar\n",
+		},
+		{
+			Name: "InvalidKey",
+			Data: "ids.device_id,root_keys.app_key.key\ntest,0102\n",
+		},
+		{
+			Name: "InvalidDeviceID",
+			Data: "ids.device_id\ntest_device\n",
+		},
+		{
+			Name: "InvalidSecondRow",
+			Data: "ids.device_id,lorawan_version\ntest,1.0.3\ntest2,1.2.3\n",
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+			ch := make(chan *ttnpb.EndDeviceTemplate, 2)
+			err := converter.Convert(ctx, strings.NewReader(tc.Data), ch)
+			a.So(errors.IsInvalidArgument(err), should.BeTrue)
+			_, ok := <-ch
+			a.So(ok, should.BeFalse)
+		})
+	}
+}
+
+func TestCSVConfig(t *testing.T) {
+	a := assertions.New(t)
+	ctx := log.NewContext(test.Context(), test.GetLogger(t))
+
+	converter := NewCSV(CSVConfig{
+		Separator:         ";",
+		Base64:            true,
+		FrequencyPlanID:   "EU_863_870",
+		LoRaWANVersion:    "1.0.2",
+		LoRaWANPHYVersion: "1.0.2-b",
+	})
+
+	data := `ids.device_id;ids.dev_eui;lorawan_version;session.dev_addr;session.keys.f_nwk_s_int_key.key;session.last_f_cnt_up
+abp-device;AASjCwAcBTA=;1.0.3;JgESNA==;AQIDBAUGBwgJCgsMDQ4PEA==;42
+`
+	ch := make(chan *ttnpb.EndDeviceTemplate, 1)
+	err := converter.Convert(ctx, strings.NewReader(data), ch)
+	a.So(err, should.BeNil)
+
+	dev, ok := <-ch
+	if !a.So(ok, should.BeTrue) {
+		t.FailNow()
+	}
+	a.So(dev.EndDevice.DeviceID, should.Equal, "abp-device")
+	a.So(dev.EndDevice.DevEUI, should.Resemble, &types.EUI64{0x00, 0x04, 0xa3, 0x0b, 0x00, 0x1c, 0x05, 0x30})
+	a.So(dev.EndDevice.FrequencyPlanID, should.Equal, "EU_863_870")
+	a.So(dev.EndDevice.LoRaWANVersion, should.Equal, ttnpb.MAC_V1_0_3)
+	a.So(dev.EndDevice.LoRaWANPHYVersion, should.Equal, ttnpb.PHY_V1_0_2_REV_B)
+	if a.So(dev.EndDevice.Session, should.NotBeNil) {
+		a.So(dev.EndDevice.Session.DevAddr, should.Equal, types.DevAddr{0x26, 0x01, 0x12, 0x34})
+		a.So(dev.EndDevice.Session.FNwkSIntKey.GetKey(), should.Resemble, &types.AES128Key{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f, 0x10})
+		a.So(dev.EndDevice.Session.LastFCntUp, should.Equal, 42)
+	}
+	a.So(dev.EndDevice.DevAddr, should.Resemble, &types.DevAddr{0x26, 0x01, 0x12, 0x34})
+	a.So(dev.FieldMask.Paths, should.Contain, "ids.dev_addr")
+	a.So(dev.FieldMask.Paths, should.Contain, "frequency_plan_id")
+}
+
+func TestCSVWriter(t *testing.T) {
+	a := assertions.New(t)
+	ctx := log.NewContext(test.Context(), test.GetLogger(t))
+
+	_, err := CSVColumns("foo")
+	a.So(errors.IsInvalidArgument(err), should.BeTrue)
+
+	columns, err := CSVColumns("ids.device_id", "ids.dev_eui", "root_keys", "attributes.serial")
+	a.So(err, should.BeNil)
+	a.So(columns, should.Resemble, []string{
+		"ids.device_id",
+		"ids.dev_eui",
+		"root_keys.app_key.key",
+		"root_keys.nwk_key.key",
+		"root_keys.root_key_id",
+		"attributes.serial",
+	})
+
+	devices := []*ttnpb.EndDevice{
+		{
+			EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+				DeviceID: "dev1",
+				DevEUI:   &types.EUI64{0x00, 0x04, 0xa3, 0x0b, 0x00, 0x1c, 0x05, 0x30},
+			},
+			RootKeys: &ttnpb.RootKeys{
+				AppKey: &ttnpb.KeyEnvelope{
+					Key: &types.AES128Key{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f, 0x10},
+				},
+			},
+			Attributes: map[string]string{"serial": "SN-1"},
+		},
+		{
+			EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+				DeviceID: "dev2",
+			},
+		},
+	}
+
+	var buf bytes.Buffer
+	w, err := NewCSVWriter(&buf, CSVConfig{}, columns)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	for _, dev := range devices {
+		a.So(w.Write(dev), should.BeNil)
+	}
+	a.So(w.Flush(), should.BeNil)
+	a.So(buf.String(), should.Equal, `ids.device_id,ids.dev_eui,root_keys.app_key.key,root_keys.nwk_key.key,root_keys.root_key_id,attributes.serial
+dev1,0004A30B001C0530,0102030405060708090A0B0C0D0E0F10,,,SN-1
+dev2,,,,,
+`)
+
+	ch := make(chan *ttnpb.EndDeviceTemplate, 2)
+	err = GetConverter("csv").Convert(ctx, &buf, ch)
+	a.So(err, should.BeNil)
+
+	for _, expected := range devices {
+		tmpl, ok := <-ch
+		if !a.So(ok, should.BeTrue) {
+			t.FailNow()
+		}
+		var dev ttnpb.EndDevice
+		a.So(dev.SetFields(expected, tmpl.FieldMask.Paths...), should.BeNil)
+		a.So(tmpl.EndDevice, should.Resemble, dev)
+	}
+}
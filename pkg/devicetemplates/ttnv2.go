@@ -0,0 +1,185 @@
+// Copyright © 2026 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package devicetemplates
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"strings"
+
+	pbtypes "github.com/gogo/protobuf/types"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/types"
+)
+
+// ttnV2Device is an end device as exported by ttnctl or through the Handler API of The Things Network v2.
+type ttnV2Device struct {
+	AppID         string            `json:"app_id"`
+	DevID         string            `json:"dev_id"`
+	Description   string            `json:"description"`
+	Latitude      float32           `json:"latitude"`
+	Longitude     float32           `json:"longitude"`
+	Altitude      int32             `json:"altitude"`
+	Attributes    map[string]string `json:"attributes"`
+	LoRaWANDevice struct {
+		AppEUI                types.EUI64     `json:"app_eui"`
+		DevEUI                types.EUI64     `json:"dev_eui"`
+		DevAddr               types.DevAddr   `json:"dev_addr"`
+		NwkSKey               types.AES128Key `json:"nwk_s_key"`
+		AppSKey               types.AES128Key `json:"app_s_key"`
+		AppKey                types.AES128Key `json:"app_key"`
+		FCntUp                uint32          `json:"f_cnt_up"`
+		FCntDown              uint32          `json:"f_cnt_down"`
+		DisableFCntCheck      bool            `json:"disable_f_cnt_check"`
+		Uses32BitFCnt         bool            `json:"uses32_bit_f_cnt"`
+		ActivationConstraints string          `json:"activation_constraints"`
+	} `json:"lorawan_device"`
+}
+
+var errTTNV2Data = errors.DefineInvalidArgument("ttnv2_data", "invalid The Things Network v2 data")
+
+// ttnV2 is a converter for end devices exported from The Things Network v2.
+type ttnV2 struct{}
+
+func (ttnV2) Format() *ttnpb.EndDeviceTemplateFormat {
+	return &ttnpb.EndDeviceTemplateFormat{
+		Name:           "The Things Network v2",
+		Description:    "JSON end devices exported with ttnctl or through the Handler API of The Things Network v2.",
+		FileExtensions: []string{".json"},
+	}
+}
+
+// Convert decodes the given end devices.
+// The input data is an array of end devices, an object with an array of end devices in `devices` or a single end device.
+// The Things Network v2 uses LoRaWAN 1.0.2 revision B. Over-the-air activated end devices that joined keep their
+// session, so that they do not need to rejoin. End devices with the frame counter check disabled are converted to end
+// devices that reset frame counters.
+func (ttnV2) Convert(ctx context.Context, r io.Reader, ch chan<- *ttnpb.EndDeviceTemplate) error {
+	defer close(ch)
+
+	entries, err := readJSONEntries(r)
+	if err != nil {
+		return errTTNV2Data.WithCause(err)
+	}
+	if len(entries) == 1 {
+		var list struct {
+			Devices []json.RawMessage `json:"devices"`
+		}
+		if err := json.Unmarshal(entries[0], &list); err == nil && list.Devices != nil {
+			entries = list.Devices
+		}
+	}
+
+	for _, entry := range entries {
+		var v2 ttnV2Device
+		if err := json.Unmarshal(entry, &v2); err != nil {
+			return errTTNV2Data.WithCause(err)
+		}
+		dev := v2.LoRaWANDevice
+		// Underscores are allowed in The Things Network v2 IDs, but not in The Things Stack.
+		deviceID := strings.ReplaceAll(v2.DevID, "_", "-")
+
+		tmpl := &ttnpb.EndDeviceTemplate{
+			EndDevice: ttnpb.EndDevice{
+				EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+					DeviceID: deviceID,
+				},
+				Description:       v2.Description,
+				Attributes:        v2.Attributes,
+				LoRaWANVersion:    ttnpb.MAC_V1_0_2,
+				LoRaWANPHYVersion: ttnpb.PHY_V1_0_2_REV_B,
+				MACSettings: &ttnpb.MACSettings{
+					Supports32BitFCnt: &pbtypes.BoolValue{Value: dev.Uses32BitFCnt},
+				},
+			},
+			FieldMask: pbtypes.FieldMask{
+				Paths: []string{
+					"description",
+					"ids.device_id",
+					"lorawan_phy_version",
+					"lorawan_version",
+					"mac_settings.supports_32_bit_f_cnt",
+					"supports_join",
+				},
+			},
+			MappingKey: v2.DevID,
+		}
+		if len(v2.Attributes) > 0 {
+			tmpl.FieldMask.Paths = append(tmpl.FieldMask.Paths, "attributes")
+		}
+		if dev.DisableFCntCheck {
+			// The Things Network v2 accepts any frame counter if the frame counter check is disabled. The Things Stack
+			// always checks frame counters, so this is approximated by accepting frame counter resets: the Network Server
+			// accepts frame counters that are lower than the last frame counter if they are within the maximum frame
+			// counter gap from 0, and resets the MAC state. Other frame counters that are lower are still rejected.
+			tmpl.EndDevice.MACSettings.ResetsFCnt = &pbtypes.BoolValue{Value: true}
+			tmpl.FieldMask.Paths = append(tmpl.FieldMask.Paths, "mac_settings.resets_f_cnt")
+		}
+		if v2.Latitude != 0 || v2.Longitude != 0 {
+			tmpl.EndDevice.Locations = map[string]*ttnpb.Location{
+				"user": {
+					Latitude:  float64(v2.Latitude),
+					Longitude: float64(v2.Longitude),
+					Altitude:  v2.Altitude,
+					Source:    ttnpb.SOURCE_REGISTRY,
+				},
+			}
+			tmpl.FieldMask.Paths = append(tmpl.FieldMask.Paths, "locations")
+		}
+		if !dev.DevEUI.IsZero() {
+			tmpl.EndDevice.DevEUI = &dev.DevEUI
+			tmpl.FieldMask.Paths = append(tmpl.FieldMask.Paths, "ids.dev_eui")
+		}
+		if !dev.AppEUI.IsZero() {
+			tmpl.EndDevice.JoinEUI = &dev.AppEUI
+			tmpl.FieldMask.Paths = append(tmpl.FieldMask.Paths, "ids.join_eui")
+		}
+		if dev.ActivationConstraints != "abp" && !dev.AppKey.IsZero() {
+			tmpl.EndDevice.SupportsJoin = true
+			tmpl.EndDevice.RootKeys = &ttnpb.RootKeys{
+				AppKey: &ttnpb.KeyEnvelope{Key: &dev.AppKey},
+			}
+			tmpl.FieldMask.Paths = append(tmpl.FieldMask.Paths, "root_keys.app_key.key")
+		}
+		if !dev.DevAddr.IsZero() && !dev.NwkSKey.IsZero() && !dev.AppSKey.IsZero() {
+			tmpl.EndDevice.DevAddr = &dev.DevAddr
+			tmpl.EndDevice.Session = &ttnpb.Session{
+				DevAddr: dev.DevAddr,
+				SessionKeys: ttnpb.SessionKeys{
+					FNwkSIntKey: &ttnpb.KeyEnvelope{Key: &dev.NwkSKey},
+					AppSKey:     &ttnpb.KeyEnvelope{Key: &dev.AppSKey},
+				},
+				LastFCntUp:    dev.FCntUp,
+				LastNFCntDown: dev.FCntDown,
+			}
+			tmpl.FieldMask.Paths = append(tmpl.FieldMask.Paths,
+				"ids.dev_addr",
+				"session.dev_addr",
+				"session.keys.app_s_key.key",
+				"session.keys.f_nwk_s_int_key.key",
+				"session.last_f_cnt_up",
+				"session.last_n_f_cnt_down",
+			)
+		}
+		ch <- tmpl
+	}
+	return nil
+}
+
+func init() {
+	RegisterConverter("the-things-network-v2", ttnV2{})
+}
@@ -0,0 +1,127 @@
+// Copyright © 2026 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package devicetemplates_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/smartystreets/assertions"
+	. "go.thethings.network/lorawan-stack/pkg/devicetemplates"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/log"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/types"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+func TestTTNV2(t *testing.T) {
+	a := assertions.New(t)
+	ctx := log.NewContext(test.Context(), test.GetLogger(t))
+
+	converter := GetConverter("the-things-network-v2")
+	if !a.So(converter, should.NotBeNil) {
+		t.FailNow()
+	}
+
+	format := converter.Format()
+	a.So(format.Name, should.Equal, "The Things Network v2")
+
+	data := []byte(`{"devices": [{
+		"app_id": "test-app",
+		"dev_id": "otaa_device",
+		"description": "OTAA device",
+		"latitude": 52.5,
+		"longitude": 4.5,
+		"altitude": 10,
+		"attributes": {"foo": "bar"},
+		"lorawan_device": {
+			"app_eui": "70B3D57ED0000000",
+			"dev_eui": "0004A30B001C0530",
+			"dev_addr": "26011234",
+			"nwk_s_key": "01020304050607080102030405060708",
+			"app_s_key": "08070605040302010807060504030201",
+			"app_key": "0102030405060708090A0B0C0D0E0F10",
+			"f_cnt_up": 42,
+			"f_cnt_down": 3,
+			"uses32_bit_f_cnt": true,
+			"activation_constraints": "otaa"
+		}
+	}, {
+		"app_id": "test-app",
+		"dev_id": "abp-device",
+		"lorawan_device": {
+			"app_eui": "70B3D57ED0000000",
+			"dev_eui": "0004A30B001C0531",
+			"dev_addr": "26015678",
+			"nwk_s_key": "01020304050607080102030405060708",
+			"app_s_key": "08070605040302010807060504030201",
+			"disable_f_cnt_check": true,
+			"activation_constraints": "abp"
+		}
+	}]}`)
+
+	ch := make(chan *ttnpb.EndDeviceTemplate, 2)
+	err := converter.Convert(ctx, bytes.NewReader(data), ch)
+	a.So(err, should.BeNil)
+
+	otaa, ok := <-ch
+	if !a.So(ok, should.BeTrue) {
+		t.FailNow()
+	}
+	a.So(otaa.EndDevice.DeviceID, should.Equal, "otaa-device")
+	a.So(otaa.MappingKey, should.Equal, "otaa_device")
+	a.So(otaa.EndDevice.JoinEUI, should.Resemble, &types.EUI64{0x70, 0xb3, 0xd5, 0x7e, 0xd0, 0x00, 0x00, 0x00})
+	a.So(otaa.EndDevice.DevEUI, should.Resemble, &types.EUI64{0x00, 0x04, 0xa3, 0x0b, 0x00, 0x1c, 0x05, 0x30})
+	a.So(otaa.EndDevice.SupportsJoin, should.BeTrue)
+	a.So(otaa.EndDevice.LoRaWANVersion, should.Equal, ttnpb.MAC_V1_0_2)
+	a.So(otaa.EndDevice.LoRaWANPHYVersion, should.Equal, ttnpb.PHY_V1_0_2_REV_B)
+	a.So(otaa.EndDevice.RootKeys.GetAppKey().GetKey(), should.Resemble, &types.AES128Key{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f, 0x10})
+	a.So(otaa.EndDevice.Attributes, should.Resemble, map[string]string{"foo": "bar"})
+	a.So(otaa.EndDevice.Locations["user"].GetAltitude(), should.Equal, 10)
+	a.So(otaa.EndDevice.MACSettings.GetSupports32BitFCnt().GetValue(), should.BeTrue)
+	if a.So(otaa.EndDevice.Session, should.NotBeNil) {
+		a.So(otaa.EndDevice.Session.DevAddr, should.Equal, types.DevAddr{0x26, 0x01, 0x12, 0x34})
+		a.So(otaa.EndDevice.Session.LastFCntUp, should.Equal, 42)
+		a.So(otaa.EndDevice.Session.LastNFCntDown, should.Equal, 3)
+	}
+	a.So(otaa.FieldMask.Paths, should.Contain, "root_keys.app_key.key")
+	a.So(otaa.FieldMask.Paths, should.Contain, "session.keys.f_nwk_s_int_key.key")
+	// The frame counter check is enabled, so the Network Server default applies.
+	a.So(otaa.EndDevice.MACSettings.GetResetsFCnt(), should.BeNil)
+	a.So(otaa.FieldMask.Paths, should.NotContain, "mac_settings.resets_f_cnt")
+
+	abp, ok := <-ch
+	if !a.So(ok, should.BeTrue) {
+		t.FailNow()
+	}
+	a.So(abp.EndDevice.DeviceID, should.Equal, "abp-device")
+	a.So(abp.EndDevice.SupportsJoin, should.BeFalse)
+	a.So(abp.EndDevice.RootKeys, should.BeNil)
+	a.So(abp.EndDevice.MACSettings.GetResetsFCnt().GetValue(), should.BeTrue)
+	a.So(abp.FieldMask.Paths, should.Contain, "mac_settings.resets_f_cnt")
+	if a.So(abp.EndDevice.Session, should.NotBeNil) {
+		a.So(abp.EndDevice.Session.AppSKey.GetKey(), should.Resemble, &types.AES128Key{0x08, 0x07, 0x06, 0x05, 0x04, 0x03, 0x02, 0x01, 0x08, 0x07, 0x06, 0x05, 0x04, 0x03, 0x02, 0x01})
+	}
+	a.So(abp.FieldMask.Paths, should.NotContain, "root_keys.app_key.key")
+
+	_, ok = <-ch
+	a.So(ok, should.BeFalse)
+
+	ch = make(chan *ttnpb.EndDeviceTemplate, 1)
+	err = converter.Convert(ctx, bytes.NewReader([]byte(`{"dev_id": "test", "lorawan_device": {"app_key": "invalid"}}`)), ch)
+	a.So(errors.IsInvalidArgument(err), should.BeTrue)
+}
@@ -0,0 +1,272 @@
+// Copyright © 2026 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package dns implements a caching DNS resolver for SRV records.
+package dns
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/random"
+	"golang.org/x/sync/singleflight"
+)
+
+const (
+	// DefaultTTL is the duration for which resolved records are cached.
+	// The TTL of the records is not used, as the Go resolver does not expose it.
+	DefaultTTL = time.Minute
+	// DefaultNegativeTTL is the duration for which failed lookups are cached.
+	DefaultNegativeTTL = 10 * time.Second
+	// DefaultLookupTimeout is the timeout of looking up records.
+	DefaultLookupTimeout = 10 * time.Second
+)
+
+var (
+	errNoSRVRecords = errors.DefineNotFound("no_srv_records", "no SRV records found for `{name}`")
+	errLookup       = errors.DefineUnavailable("lookup", "lookup `{name}` failed")
+)
+
+// IsNotFound returns whether the error indicates that no records exist for the name.
+func IsNotFound(err error) bool {
+	return errors.Resemble(err, errNoSRVRecords)
+}
+
+// SRVResolver resolves SRV records.
+type SRVResolver interface {
+	// LookupSRV returns the SRV records of the given service, protocol and domain name, as specified in RFC 2782.
+	// The records are ordered by priority and, within the same priority, randomly by weight, so that the records
+	// can be tried in order. Targets do not contain the trailing dot.
+	LookupSRV(ctx context.Context, service, proto, name string) ([]*net.SRV, error)
+}
+
+type cacheEntry struct {
+	srvs    []*net.SRV
+	err     error
+	expires time.Time
+}
+
+// Resolver is a SRV resolver that caches records.
+type Resolver struct {
+	resolver      *net.Resolver
+	ttl           time.Duration
+	negativeTTL   time.Duration
+	lookupTimeout time.Duration
+	timeNow       func() time.Time
+	intn          func(n int) int
+
+	group   singleflight.Group
+	cacheMu sync.RWMutex
+	cache   map[string]cacheEntry
+}
+
+// Option configures the Resolver.
+type Option func(*Resolver)
+
+// WithNameservers configures the Resolver to query the given nameservers, in turn, instead of the nameservers in
+// /etc/resolv.conf. Nameserver addresses may omit the port, in which case port 53 is used.
+func WithNameservers(addrs ...string) Option {
+	servers := make([]string, 0, len(addrs))
+	for _, addr := range addrs {
+		if _, _, err := net.SplitHostPort(addr); err != nil {
+			addr = net.JoinHostPort(addr, "53")
+		}
+		servers = append(servers, addr)
+	}
+	return func(r *Resolver) {
+		if len(servers) == 0 {
+			return
+		}
+		var next uint32
+		r.resolver = &net.Resolver{
+			PreferGo: true,
+			Dial: func(ctx context.Context, network, _ string) (net.Conn, error) {
+				server := servers[(atomic.AddUint32(&next, 1)-1)%uint32(len(servers))]
+				return new(net.Dialer).DialContext(ctx, network, server)
+			},
+		}
+	}
+}
+
+// WithTTL configures the duration for which resolved records are cached.
+func WithTTL(ttl time.Duration) Option {
+	return func(r *Resolver) {
+		r.ttl = ttl
+	}
+}
+
+// WithNegativeTTL configures the duration for which failed lookups are cached.
+func WithNegativeTTL(ttl time.Duration) Option {
+	return func(r *Resolver) {
+		r.negativeTTL = ttl
+	}
+}
+
+// WithLookupTimeout configures the timeout of looking up records.
+func WithLookupTimeout(timeout time.Duration) Option {
+	return func(r *Resolver) {
+		r.lookupTimeout = timeout
+	}
+}
+
+// NewResolver returns a new caching SRV resolver.
+func NewResolver(opts ...Option) *Resolver {
+	r := &Resolver{
+		resolver:      net.DefaultResolver,
+		ttl:           DefaultTTL,
+		negativeTTL:   DefaultNegativeTTL,
+		lookupTimeout: DefaultLookupTimeout,
+		timeNow:       time.Now,
+		intn:          random.Intn,
+		cache:         make(map[string]cacheEntry),
+	}
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
+// DefaultResolver is the default caching SRV resolver.
+var DefaultResolver = NewResolver()
+
+// LookupSRV implements SRVResolver.
+// Records are served from the cache until their TTL expires. Concurrent lookups of the same name are deduplicated.
+// The lookup is not bound to the context of the caller, as it is shared by the concurrent callers.
+func (r *Resolver) LookupSRV(ctx context.Context, service, proto, name string) ([]*net.SRV, error) {
+	fqdn := srvName(service, proto, name)
+	now := r.timeNow()
+	r.cacheMu.RLock()
+	entry, ok := r.cache[fqdn]
+	r.cacheMu.RUnlock()
+	if ok && now.Before(entry.expires) {
+		if entry.err != nil {
+			return nil, entry.err
+		}
+		return orderSRV(entry.srvs, r.intn), nil
+	}
+	ch := r.group.DoChan(fqdn, func() (interface{}, error) {
+		ctx, cancel := context.WithTimeout(context.Background(), r.lookupTimeout)
+		defer cancel()
+		srvs, err := r.lookup(ctx, fqdn)
+		ttl := r.ttl
+		if err != nil {
+			ttl = r.negativeTTL
+		}
+		r.cacheMu.Lock()
+		r.cache[fqdn] = cacheEntry{
+			srvs:    srvs,
+			err:     err,
+			expires: r.timeNow().Add(ttl),
+		}
+		r.cacheMu.Unlock()
+		return srvs, err
+	})
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case res := <-ch:
+		if res.Err != nil {
+			return nil, res.Err
+		}
+		return orderSRV(res.Val.([]*net.SRV), r.intn), nil
+	}
+}
+
+// lookup looks up the SRV records of the given fully qualified name.
+func (r *Resolver) lookup(ctx context.Context, name string) ([]*net.SRV, error) {
+	_, res, err := r.resolver.LookupSRV(ctx, "", "", name)
+	if err != nil {
+		if dnsErr, ok := err.(*net.DNSError); ok && dnsErr.IsNotFound {
+			return nil, errNoSRVRecords.WithCause(err).WithAttributes("name", name)
+		}
+		return nil, errLookup.WithCause(err).WithAttributes("name", name)
+	}
+	if len(res) == 0 {
+		return nil, errNoSRVRecords.WithAttributes("name", name)
+	}
+	srvs := make([]*net.SRV, len(res))
+	for i, srv := range res {
+		srvs[i] = &net.SRV{
+			Target:   strings.TrimSuffix(srv.Target, "."),
+			Port:     srv.Port,
+			Priority: srv.Priority,
+			Weight:   srv.Weight,
+		}
+	}
+	return srvs, nil
+}
+
+// srvName returns the name that is queried for SRV records, as specified in RFC 2782.
+// If service and proto are empty, name is queried directly.
+func srvName(service, proto, name string) string {
+	name = strings.TrimSuffix(name, ".")
+	if service == "" && proto == "" {
+		return name + "."
+	}
+	return fmt.Sprintf("_%s._%s.%s.", service, proto, name)
+}
+
+// orderSRV returns the SRV records in the order in which they should be tried, as specified in RFC 2782.
+// The records are sorted by ascending priority. Records with the same priority are ordered randomly, where the
+// probability of a record to come first is proportional to its weight. The given records are not modified.
+func orderSRV(srvs []*net.SRV, intn func(n int) int) []*net.SRV {
+	res := make([]*net.SRV, len(srvs))
+	copy(res, srvs)
+	sort.SliceStable(res, func(i, j int) bool {
+		if res[i].Priority != res[j].Priority {
+			return res[i].Priority < res[j].Priority
+		}
+		// Records with weight 0 are placed at the beginning, so that they have a very small chance of being selected.
+		return res[i].Weight == 0 && res[j].Weight != 0
+	})
+	for i := 0; i < len(res); {
+		j := i + 1
+		for j < len(res) && res[j].Priority == res[i].Priority {
+			j++
+		}
+		shuffleSRV(res[i:j], intn)
+		i = j
+	}
+	return res
+}
+
+// shuffleSRV orders the SRV records of the same priority by weight, using the selection algorithm of RFC 2782.
+func shuffleSRV(srvs []*net.SRV, intn func(n int) int) {
+	sum := 0
+	for _, srv := range srvs {
+		sum += int(srv.Weight)
+	}
+	for i := range srvs[:len(srvs)-1] {
+		n := intn(sum + 1)
+		running := 0
+		for j := i; j < len(srvs); j++ {
+			running += int(srvs[j].Weight)
+			if running >= n {
+				// Move the selected record to the front and keep the order of the records that are not ordered yet.
+				srv := srvs[j]
+				copy(srvs[i+1:j+1], srvs[i:j])
+				srvs[i] = srv
+				sum -= int(srv.Weight)
+				break
+			}
+		}
+	}
+}
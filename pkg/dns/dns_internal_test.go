@@ -0,0 +1,263 @@
+// Copyright © 2026 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dns
+
+import (
+	"context"
+	"net"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+	"golang.org/x/net/dns/dnsmessage"
+)
+
+// serveSRV serves the given SRV records on a local UDP nameserver and returns its address.
+func serveSRV(ctx context.Context, t *testing.T, records map[string][]dnsmessage.SRVResource, queries *uint32) string {
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Failed to listen: %v", err)
+	}
+	go func() {
+		<-ctx.Done()
+		conn.Close()
+	}()
+	go func() {
+		buf := make([]byte, 512)
+		for {
+			n, addr, err := conn.ReadFrom(buf)
+			if err != nil {
+				return
+			}
+			atomic.AddUint32(queries, 1)
+			var p dnsmessage.Parser
+			h, err := p.Start(buf[:n])
+			if err != nil {
+				continue
+			}
+			q, err := p.Question()
+			if err != nil {
+				continue
+			}
+			res := dnsmessage.Message{
+				Header: dnsmessage.Header{
+					ID:       h.ID,
+					Response: true,
+				},
+				Questions: []dnsmessage.Question{q},
+			}
+			srvs, ok := records[q.Name.String()]
+			if !ok {
+				res.Header.RCode = dnsmessage.RCodeNameError
+			}
+			for _, srv := range srvs {
+				srv := srv
+				res.Answers = append(res.Answers, dnsmessage.Resource{
+					Header: dnsmessage.ResourceHeader{
+						Name:  q.Name,
+						Type:  dnsmessage.TypeSRV,
+						Class: dnsmessage.ClassINET,
+						TTL:   60,
+					},
+					Body: &srv,
+				})
+			}
+			b, err := res.Pack()
+			if err != nil {
+				continue
+			}
+			conn.WriteTo(b, addr)
+		}
+	}()
+	return conn.LocalAddr().String()
+}
+
+func TestResolver(t *testing.T) {
+	a := assertions.New(t)
+	ctx, cancel := context.WithCancel(test.Context())
+	defer cancel()
+
+	var queries uint32
+	addr := serveSRV(ctx, t, map[string][]dnsmessage.SRVResource{
+		"_ttn-v3-grpcs._tcp.example.com.": {
+			{
+				Priority: 20,
+				Weight:   10,
+				Port:     8884,
+				Target:   dnsmessage.MustNewName("backup.example.com."),
+			},
+			{
+				Priority: 10,
+				Weight:   10,
+				Port:     8884,
+				Target:   dnsmessage.MustNewName("secondary.example.com."),
+			},
+			{
+				Priority: 10,
+				Weight:   20,
+				Port:     8885,
+				Target:   dnsmessage.MustNewName("primary.example.com."),
+			},
+		},
+	}, &queries)
+
+	now := time.Unix(0, 0)
+	r := NewResolver(WithNameservers(addr), WithTTL(time.Minute), WithNegativeTTL(10*time.Second))
+	r.timeNow = func() time.Time { return now }
+	// Select the record with weight 20 first, regardless of the order of the records with priority 10.
+	r.intn = func(int) int { return 15 }
+
+	expected := []*net.SRV{
+		{Target: "primary.example.com", Port: 8885, Priority: 10, Weight: 20},
+		{Target: "secondary.example.com", Port: 8884, Priority: 10, Weight: 10},
+		{Target: "backup.example.com", Port: 8884, Priority: 20, Weight: 10},
+	}
+
+	srvs, err := r.LookupSRV(ctx, "ttn-v3-grpcs", "tcp", "example.com")
+	a.So(err, should.BeNil)
+	a.So(srvs, should.Resemble, expected)
+	a.So(atomic.LoadUint32(&queries), should.Equal, 1)
+
+	// Served from cache.
+	now = now.Add(59 * time.Second)
+	srvs, err = r.LookupSRV(ctx, "ttn-v3-grpcs", "tcp", "example.com.")
+	a.So(err, should.BeNil)
+	a.So(srvs, should.Resemble, expected)
+	a.So(atomic.LoadUint32(&queries), should.Equal, 1)
+
+	// TTL expired.
+	now = now.Add(time.Second)
+	srvs, err = r.LookupSRV(ctx, "ttn-v3-grpcs", "tcp", "example.com")
+	a.So(err, should.BeNil)
+	a.So(srvs, should.Resemble, expected)
+	a.So(atomic.LoadUint32(&queries), should.Equal, 2)
+
+	// Not found is cached for the negative TTL.
+	_, err = r.LookupSRV(ctx, "ttn-v3-grpc", "tcp", "example.com")
+	a.So(IsNotFound(err), should.BeTrue)
+	a.So(atomic.LoadUint32(&queries), should.Equal, 3)
+	now = now.Add(9 * time.Second)
+	_, err = r.LookupSRV(ctx, "ttn-v3-grpc", "tcp", "example.com")
+	a.So(IsNotFound(err), should.BeTrue)
+	a.So(atomic.LoadUint32(&queries), should.Equal, 3)
+	now = now.Add(time.Second)
+	_, err = r.LookupSRV(ctx, "ttn-v3-grpc", "tcp", "example.com")
+	a.So(IsNotFound(err), should.BeTrue)
+	a.So(atomic.LoadUint32(&queries), should.Equal, 4)
+}
+
+func TestResolverCanceled(t *testing.T) {
+	a := assertions.New(t)
+	ctx, cancel := context.WithCancel(test.Context())
+	defer cancel()
+
+	var queries uint32
+	addr := serveSRV(ctx, t, map[string][]dnsmessage.SRVResource{
+		"_ttn-v3-grpcs._tcp.example.com.": {
+			{
+				Priority: 10,
+				Weight:   10,
+				Port:     8884,
+				Target:   dnsmessage.MustNewName("primary.example.com."),
+			},
+		},
+	}, &queries)
+
+	r := NewResolver(WithNameservers(addr))
+
+	// The lookup is not canceled when the context of the first caller is canceled.
+	canceledCtx, cancelCtx := context.WithCancel(ctx)
+	cancelCtx()
+	r.LookupSRV(canceledCtx, "ttn-v3-grpcs", "tcp", "example.com")
+
+	srvs, err := r.LookupSRV(ctx, "ttn-v3-grpcs", "tcp", "example.com")
+	a.So(err, should.BeNil)
+	a.So(srvs, should.Resemble, []*net.SRV{
+		{Target: "primary.example.com", Port: 8884, Priority: 10, Weight: 10},
+	})
+	a.So(atomic.LoadUint32(&queries), should.Equal, 1)
+}
+
+func TestOrderSRV(t *testing.T) {
+	zero := &net.SRV{Target: "zero.example.com", Priority: 10, Weight: 0}
+	light := &net.SRV{Target: "light.example.com", Priority: 10, Weight: 10}
+	heavy := &net.SRV{Target: "heavy.example.com", Priority: 10, Weight: 20}
+	backup := &net.SRV{Target: "backup.example.com", Priority: 20, Weight: 10}
+
+	for _, tc := range []struct {
+		Name     string
+		Intn     func(int) int
+		Expected []*net.SRV
+	}{
+		{
+			Name:     "First",
+			Intn:     func(int) int { return 0 },
+			Expected: []*net.SRV{zero, light, heavy, backup},
+		},
+		{
+			Name:     "Last",
+			Intn:     func(n int) int { return n - 1 },
+			Expected: []*net.SRV{heavy, light, zero, backup},
+		},
+		{
+			Name: "Middle",
+			Intn: func(n int) int {
+				if n == 31 {
+					return 10
+				}
+				return 0
+			},
+			Expected: []*net.SRV{light, zero, heavy, backup},
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+			srvs := []*net.SRV{backup, light, heavy, zero}
+			a.So(orderSRV(srvs, tc.Intn), should.Resemble, tc.Expected)
+			a.So(srvs, should.Resemble, []*net.SRV{backup, light, heavy, zero})
+		})
+	}
+}
+
+func TestSRVName(t *testing.T) {
+	for _, tc := range []struct {
+		Service, Proto, Name string
+		Expected             string
+	}{
+		{
+			Service:  "ttn-v3-grpcs",
+			Proto:    "tcp",
+			Name:     "example.com",
+			Expected: "_ttn-v3-grpcs._tcp.example.com.",
+		},
+		{
+			Service:  "ttn-v3-grpcs",
+			Proto:    "tcp",
+			Name:     "example.com.",
+			Expected: "_ttn-v3-grpcs._tcp.example.com.",
+		},
+		{
+			Name:     "_ttn-v3-grpcs._tcp.example.com",
+			Expected: "_ttn-v3-grpcs._tcp.example.com.",
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			assertions.New(t).So(srvName(tc.Service, tc.Proto, tc.Name), should.Equal, tc.Expected)
+		})
+	}
+}
@@ -513,6 +513,34 @@ func (fp *FrequencyPlan) FindSubBand(frequency uint64) (SubBandParameters, bool)
 	return SubBandParameters{}, false
 }
 
+// Comprises returns whether the frequency plan comprises the given frequency.
+// The frequency is comprised if it is the frequency of one of the channels, or if it is in one of the sub-bands of the
+// frequency plan or its band.
+func (fp *FrequencyPlan) Comprises(frequency uint64) bool {
+	for _, chs := range [][]Channel{fp.UplinkChannels, fp.DownlinkChannels} {
+		for _, ch := range chs {
+			if ch.Frequency == frequency {
+				return true
+			}
+		}
+	}
+	if fp.LoRaStandardChannel != nil && fp.LoRaStandardChannel.Frequency == frequency ||
+		fp.FSKChannel != nil && fp.FSKChannel.Frequency == frequency ||
+		fp.Rx2Channel != nil && fp.Rx2Channel.Frequency == frequency ||
+		fp.PingSlot != nil && fp.PingSlot.Frequency == frequency {
+		return true
+	}
+	if _, ok := fp.FindSubBand(frequency); ok {
+		return true
+	}
+	phy, err := band.GetByID(fp.BandID)
+	if err != nil {
+		return false
+	}
+	_, ok := phy.FindSubBand(frequency)
+	return ok
+}
+
 // FrequencyPlanDescription describes a frequency plan in the YAML format.
 type FrequencyPlanDescription struct {
 	// ID is the unique identifier of the frequency plan.
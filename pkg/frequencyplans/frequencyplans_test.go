@@ -488,3 +488,67 @@ dwell-time:
 		})
 	}
 }
+
+func TestComprises(t *testing.T) {
+	a := assertions.New(t)
+
+	store := frequencyplans.NewStore(fetch.NewMemFetcher(map[string][]byte{
+		"frequency-plans.yml": []byte(`- id: Test
+  description: Test
+  base-frequency: 868
+  file: test.yml
+`),
+		"test.yml": []byte(`band-id: EU_863_870
+sub-bands:
+- min-frequency: 433050000
+  max-frequency: 434790000
+  duty-cycle: 0.01
+uplink-channels:
+- frequency: 868100000
+lora-standard-channel:
+  frequency: 433175000
+rx2-channel:
+  frequency: 434665000
+`),
+	}))
+
+	fp, err := store.GetByID("Test")
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+
+	for _, tc := range []struct {
+		Frequency uint64
+		Expected  bool
+	}{
+		{
+			Frequency: 868100000,
+			Expected:  true,
+		},
+		{
+			Frequency: 433175000,
+			Expected:  true,
+		},
+		{
+			Frequency: 434665000,
+			Expected:  true,
+		},
+		{
+			Frequency: 434000000,
+			Expected:  true,
+		},
+		{
+			Frequency: 869525000,
+			Expected:  true,
+		},
+		{
+			Frequency: 915000000,
+			Expected:  false,
+		},
+	} {
+		t.Run(fmt.Sprintf("%v", tc.Frequency), func(t *testing.T) {
+			a := assertions.New(t)
+			a.So(fp.Comprises(tc.Frequency), should.Equal, tc.Expected)
+		})
+	}
+}
@@ -41,6 +41,8 @@ type BasicStationConfig struct {
 type Config struct {
 	RequireRegisteredGateways bool `name:"require-registered-gateways" description:"Require the gateways to be registered in the Identity Server"`
 
+	NotifyDisconnectedAfter time.Duration `name:"notify-disconnected-after" description:"Notify the collaborators of registered gateways that are disconnected for this duration (0 is disabled)"`
+
 	Forward map[string][]string `name:"forward" description:"Forward the DevAddr prefixes to the specified hosts"`
 
 	MQTT         config.MQTT        `name:"mqtt"`
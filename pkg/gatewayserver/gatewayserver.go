@@ -68,7 +68,8 @@ type GatewayServer struct {
 
 	upstreamHandlers map[string]upstream.Handler
 
-	connections sync.Map // string to connectionEntry
+	connections      sync.Map // string to connectionEntry
+	disconnectTimers sync.Map // string to *time.Timer
 }
 
 func (gs *GatewayServer) getRegistry(ctx context.Context, ids *ttnpb.GatewayIdentifiers) (ttnpb.GatewayRegistryClient, error) {
@@ -350,6 +351,7 @@ var errNewConnection = errors.DefineAborted("new_connection", "new connection fr
 type connectionEntry struct {
 	*io.Connection
 	upstreamDone chan struct{}
+	registered   bool
 }
 
 // Connect connects a gateway by its identifiers to the Gateway Server, and returns a io.Connection for traffic and
@@ -394,6 +396,7 @@ func (gs *GatewayServer) Connect(ctx context.Context, frontend io.Frontend, ids
 			},
 		},
 	}, callOpt)
+	var registered bool
 	if errors.IsNotFound(err) {
 		if gs.requireRegisteredGateways {
 			return nil, errGatewayNotRegistered.WithAttributes("gateway_uid", uid).WithCause(err)
@@ -414,6 +417,8 @@ func (gs *GatewayServer) Connect(ctx context.Context, frontend io.Frontend, ids
 		}
 	} else if err != nil {
 		return nil, err
+	} else {
+		registered = true
 	}
 
 	conn, err := io.NewConnection(ctx, frontend, gtw, gs.FrequencyPlans, gtw.EnforceDutyCycle, gtw.ScheduleAnytimeDelay)
@@ -423,6 +428,7 @@ func (gs *GatewayServer) Connect(ctx context.Context, frontend io.Frontend, ids
 	connEntry := connectionEntry{
 		Connection:   conn,
 		upstreamDone: make(chan struct{}),
+		registered:   registered,
 	}
 	for existing, exists := gs.connections.LoadOrStore(uid, connEntry); exists; existing, exists = gs.connections.LoadOrStore(uid, connEntry) {
 		existingConnEntry := existing.(connectionEntry)
@@ -434,6 +440,10 @@ func (gs *GatewayServer) Connect(ctx context.Context, frontend io.Frontend, ids
 		case <-existingConnEntry.upstreamDone:
 		}
 	}
+	if timer, ok := gs.disconnectTimers.Load(uid); ok {
+		timer.(*time.Timer).Stop()
+		gs.disconnectTimers.Delete(uid)
+	}
 	registerGatewayConnect(ctx, ids, frontend.Protocol())
 	logger.Info("Connected")
 	go gs.handleUpstream(connEntry)
@@ -459,6 +469,40 @@ func (gs *GatewayServer) GetConnection(ctx context.Context, ids ttnpb.GatewayIde
 	return entry.(connectionEntry).Connection, true
 }
 
+// notifyDisconnected notifies the collaborators of the gateway through the Identity Server, unless the gateway
+// reconnects within the configured duration. The connections are checked again when the duration expires, so that
+// a gateway that reconnected while the notification was due is not reported. Reconnecting to another Gateway Server
+// instance does not cancel the notification.
+func (gs *GatewayServer) notifyDisconnected(uid string, ids ttnpb.GatewayIdentifiers) {
+	timer := time.AfterFunc(gs.config.NotifyDisconnectedAfter, func() {
+		gs.disconnectTimers.Delete(uid)
+		ctx := gs.Context()
+		logger := log.FromContext(ctx).WithField("gateway_uid", uid)
+		if _, connected := gs.connections.Load(uid); connected {
+			logger.Debug("Gateway reconnected, skip disconnected notification")
+			return
+		}
+		cc, err := gs.GetPeerConn(ctx, ttnpb.ClusterRole_ENTITY_REGISTRY, &ids)
+		if err != nil {
+			logger.WithError(err).Warn("Failed to get Identity Server to notify about disconnected gateway")
+			return
+		}
+		_, err = ttnpb.NewNotificationServiceClient(cc).Create(ctx, &ttnpb.CreateNotificationRequest{
+			Notification: ttnpb.Notification{
+				NotificationType: ttnpb.NOTIFICATION_TYPE_GATEWAY_DISCONNECTED,
+				EntityIDs:        *ids.EntityIdentifiers(),
+			},
+		}, gs.WithClusterAuth())
+		if err != nil {
+			logger.WithError(err).Warn("Failed to notify about disconnected gateway")
+		}
+	})
+	if existing, loaded := gs.disconnectTimers.LoadOrStore(uid, timer); loaded {
+		existing.(*time.Timer).Stop()
+		gs.disconnectTimers.Store(uid, timer)
+	}
+}
+
 var (
 	errNoNetworkServer = errors.DefineNotFound("no_network_server", "no Network Server found to handle message")
 	errHostHandle      = errors.Define("host_handle", "host `{host}` failed to handle message")
@@ -496,6 +540,9 @@ func (gs *GatewayServer) handleUpstream(conn connectionEntry) {
 		gs.connections.Delete(unique.ID(ctx, ids))
 		registerGatewayDisconnect(ctx, ids, conn.Frontend().Protocol())
 		logger.Info("Disconnected")
+		if conn.registered && gs.config.NotifyDisconnectedAfter > 0 {
+			gs.notifyDisconnected(unique.ID(ctx, ids), ids)
+		}
 		close(conn.upstreamDone)
 	}()
 
@@ -552,6 +599,14 @@ func (gs *GatewayServer) handleUpstream(conn connectionEntry) {
 					} else {
 						registerDropStatus(ctx, conn.Gateway(), msg, item.host.name, err)
 					}
+				case *ttnpb.TxAcknowledgment:
+					handler := item.host.handler(msg.DownlinkMessage.EndDeviceIDs)
+					if handler == nil {
+						break
+					}
+					if err := handler.HandleTxAck(ctx, conn.Gateway().GatewayIdentifiers, msg); err != nil {
+						logger.WithField("name", item.host.name).WithError(err).Warn("Failed to forward Tx acknowledgment")
+					}
 				}
 			}
 		}
@@ -605,8 +660,11 @@ func (gs *GatewayServer) handleUpstream(conn connectionEntry) {
 			} else {
 				registerFailDownlink(ctx, conn.Gateway(), msg)
 			}
-			// TODO: Send Tx acknowledgement upstream (https://github.com/TheThingsNetwork/lorawan-stack/issues/76)
-			continue
+			if msg.DownlinkMessage == nil {
+				// The acknowledgment does not correspond to a downlink message sent by this connection.
+				continue
+			}
+			val = msg
 		}
 		for _, host := range hosts {
 			item := upstreamItem{
@@ -253,7 +253,6 @@ func (s *srv) handleTraffic(c echo.Context) (err error) {
 	}()
 
 	fps := conn.FrequencyPlans()
-	bandID := conn.BandID()
 
 	pingTicker := time.NewTicker(s.wsPingInterval)
 	defer pingTicker.Stop()
@@ -360,7 +359,7 @@ func (s *srv) handleTraffic(c echo.Context) (err error) {
 				"firmware", version.Firmware,
 				"model", version.Model,
 			))
-			cfg, err := pfconfig.GetRouterConfig(bandID, fps, version.IsProduction(), time.Now())
+			cfg, err := pfconfig.GetRouterConfig(conn.BandID(), fps, version.IsProduction(), time.Now())
 			if err != nil {
 				logger.WithError(err).Warn("Failed to generate router configuration")
 				return err
@@ -406,7 +405,7 @@ func (s *srv) handleTraffic(c echo.Context) (err error) {
 				logger.WithError(err).Debug("Failed to unmarshal join-request message")
 				return err
 			}
-			up, err := jreq.ToUplinkMessage(ids, bandID, receivedAt)
+			up, err := jreq.ToUplinkMessage(ids, conn.BandIDByFrequency(jreq.RadioMetaData.Frequency), receivedAt)
 			if err != nil {
 				logger.WithError(err).Debug("Failed to parse join-request message")
 				return err
@@ -423,7 +422,7 @@ func (s *srv) handleTraffic(c echo.Context) (err error) {
 				logger.WithError(err).Debug("Failed to unmarshal uplink data frame")
 				return err
 			}
-			up, err := updf.ToUplinkMessage(ids, bandID, receivedAt)
+			up, err := updf.ToUplinkMessage(ids, conn.BandIDByFrequency(updf.RadioMetaData.Frequency), receivedAt)
 			if err != nil {
 				logger.WithError(err).Debug("Failed to parse uplink data frame")
 				return err
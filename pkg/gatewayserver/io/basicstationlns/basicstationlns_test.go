@@ -26,6 +26,7 @@ import (
 	pbtypes "github.com/gogo/protobuf/types"
 	"github.com/gorilla/websocket"
 	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/band"
 	"go.thethings.network/lorawan-stack/pkg/basicstation"
 	"go.thethings.network/lorawan-stack/pkg/component"
 	componenttest "go.thethings.network/lorawan-stack/pkg/component/test"
@@ -718,6 +719,25 @@ func TestTraffic(t *testing.T) {
 		t.Fatal("Connection timeout")
 	}
 
+	downlinkMsg := &ttnpb.DownlinkMessage{
+		RawPayload: []byte("Ymxhamthc25kJ3M=="),
+		EndDeviceIDs: &ttnpb.EndDeviceIdentifiers{
+			DeviceID: "testdevice",
+			DevEUI:   eui64Ptr(types.EUI64{0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11}),
+		},
+		Settings: &ttnpb.DownlinkMessage_Request{
+			Request: &ttnpb.TxRequest{
+				Class:            ttnpb.CLASS_A,
+				Priority:         ttnpb.TxSchedulePriority_NORMAL,
+				Rx1Delay:         ttnpb.RX_DELAY_1,
+				Rx1DataRateIndex: 5,
+				Rx1Frequency:     868100000,
+				FrequencyPlanID:  test.EUFrequencyPlanID,
+			},
+		},
+		CorrelationIDs: []string{"correlation1", "correlation2"},
+	}
+
 	for _, tc := range []struct {
 		Name                    string
 		InputBSUpstream         interface{}
@@ -844,26 +864,8 @@ func TestTraffic(t *testing.T) {
 			},
 		},
 		{
-			Name: "Downlink",
-			InputNetworkDownstream: &ttnpb.DownlinkMessage{
-				RawPayload: []byte("Ymxhamthc25kJ3M=="),
-				EndDeviceIDs: &ttnpb.EndDeviceIdentifiers{
-					DeviceID: "testdevice",
-					DevEUI:   eui64Ptr(types.EUI64{0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11}),
-				},
-				Settings: &ttnpb.DownlinkMessage_Request{
-					Request: &ttnpb.TxRequest{
-						Class:            ttnpb.CLASS_A,
-						Priority:         ttnpb.TxSchedulePriority_NORMAL,
-						Rx1Delay:         ttnpb.RX_DELAY_1,
-						Rx1DataRateIndex: 5,
-						Rx1Frequency:     868100000,
-						FrequencyPlanID:  test.EUFrequencyPlanID,
-					},
-				},
-				CorrelationIDs: []string{"correlation1", "correlation2"},
-			},
-
+			Name:                   "Downlink",
+			InputNetworkDownstream: downlinkMsg,
 			InputDownlinkPath: &ttnpb.DownlinkPath{
 				Path: &ttnpb.DownlinkPath_UplinkToken{
 					UplinkToken: io.MustUplinkToken(ttnpb.GatewayAntennaIdentifiers{GatewayIdentifiers: registeredGatewayID}, 1553759666),
@@ -889,8 +891,9 @@ func TestTraffic(t *testing.T) {
 				XTime: 1548059982,
 			},
 			ExpectedNetworkUpstream: ttnpb.TxAcknowledgment{
-				CorrelationIDs: []string{"correlation1", "correlation2"},
-				Result:         ttnpb.TxAcknowledgment_SUCCESS,
+				CorrelationIDs:  []string{"correlation1", "correlation2"},
+				Result:          ttnpb.TxAcknowledgment_SUCCESS,
+				DownlinkMessage: downlinkMsg,
 			},
 		},
 		{
@@ -1368,3 +1371,171 @@ func TestPingPong(t *testing.T) {
 	}
 
 }
+
+func TestMixedBands(t *testing.T) {
+	a := assertions.New(t)
+	ctx := log.NewContext(test.Context(), test.GetLogger(t))
+	ctx = newContextWithRightsFetcher(ctx)
+	ctx, cancelCtx := context.WithCancel(ctx)
+	defer cancelCtx()
+
+	is, isAddr := mock.NewIS(ctx)
+	is.Add(ctx, registeredGatewayID, registeredGatewayToken)
+	c := componenttest.NewComponent(t, &component.Config{
+		ServiceBase: config.ServiceBase{
+			GRPC: config.GRPC{
+				Listen:                      ":0",
+				AllowInsecureForCredentials: true,
+			},
+			Cluster: config.Cluster{
+				IdentityServer: isAddr,
+			},
+		},
+	})
+	c.FrequencyPlans = frequencyplans.NewStore(test.FrequencyPlansFetcher)
+	componenttest.StartComponent(t, c)
+	defer c.Close()
+	mustHavePeer(ctx, c, ttnpb.ClusterRole_ENTITY_REGISTRY)
+	gs := mock.NewServer(c)
+	// The KR920 band does not define DR6, which is SF7BW250 in the EU868 band.
+	gs.RegisterGateway(ctx, registeredGatewayID, &ttnpb.Gateway{
+		GatewayIdentifiers: registeredGatewayID,
+		FrequencyPlanIDs:   []string{test.KRFrequencyPlanID, test.EUFrequencyPlanID},
+	})
+
+	bsWebServer := New(ctx, gs, false, defaultWSPingInterval)
+	lis, err := net.Listen("tcp", serverAddress)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	defer lis.Close()
+	go func() error {
+		return http.Serve(lis, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			bsWebServer.ServeHTTP(w, r)
+		}))
+	}()
+	servAddr := fmt.Sprintf("ws://%s", lis.Addr().String())
+
+	wsConn, _, err := websocket.DefaultDialer.Dial(servAddr+testTrafficEndPoint, nil)
+	if !a.So(err, should.BeNil) {
+		t.Fatalf("Connection failed: %v", err)
+	}
+	defer wsConn.Close()
+
+	var gsConn *io.Connection
+	select {
+	case gsConn = <-gs.Connections():
+	case <-time.After(timeout):
+		t.Fatal("Connection timeout")
+	}
+
+	t.Run("RouterConfig", func(t *testing.T) {
+		a := assertions.New(t)
+		req, err := json.Marshal(messages.Version{
+			Station:  "test-station",
+			Firmware: "1.0.0",
+			Package:  "test-package",
+			Model:    "test-model",
+			Protocol: 2,
+			Features: "prod gps",
+		})
+		if err != nil {
+			panic(err)
+		}
+		if err := wsConn.WriteMessage(websocket.TextMessage, req); err != nil {
+			t.Fatalf("Failed to write message: %v", err)
+		}
+		resCh := make(chan []byte)
+		go func() {
+			_, data, err := wsConn.ReadMessage()
+			if err != nil {
+				t.Fatalf("Failed to read message: %v", err)
+			}
+			resCh <- data
+		}()
+		select {
+		case res := <-resCh:
+			var cfg pfconfig.RouterConfig
+			if err := json.Unmarshal(res, &cfg); err != nil {
+				t.Fatalf("Failed to unmarshal response `%s`: %v", string(res), err)
+			}
+			a.So(cfg.Region, should.Equal, "KR920")
+			a.So(cfg.HardwareSpec, should.Equal, "sx1301/2")
+			a.So(cfg.DataRates[5], should.Resemble, [3]int{7, 125, 0})
+			a.So(cfg.DataRates[6], should.Resemble, [3]int{7, 250, 0})
+			a.So(cfg.SX1301Config, should.HaveLength, 2)
+		case <-time.After(timeout):
+			t.Fatalf("Read message timeout")
+		}
+		select {
+		case <-gsConn.Status():
+		case <-time.After(timeout):
+			t.Fatalf("Read message timeout")
+		}
+	})
+
+	for _, tc := range []struct {
+		Name             string
+		Frequency        uint64
+		DataRateIndex    int
+		ExpectedBandID   string
+		ExpectedDataRate ttnpb.DataRate
+	}{
+		{
+			Name:           "KR920",
+			Frequency:      922100000,
+			DataRateIndex:  5,
+			ExpectedBandID: band.KR_920_923,
+			ExpectedDataRate: ttnpb.DataRate{Modulation: &ttnpb.DataRate_LoRa{LoRa: &ttnpb.LoRaDataRate{
+				SpreadingFactor: 7,
+				Bandwidth:       125000,
+			}}},
+		},
+		{
+			Name:           "EU868",
+			Frequency:      868300000,
+			DataRateIndex:  6,
+			ExpectedBandID: band.EU_863_870,
+			ExpectedDataRate: ttnpb.DataRate{Modulation: &ttnpb.DataRate_LoRa{LoRa: &ttnpb.LoRaDataRate{
+				SpreadingFactor: 7,
+				Bandwidth:       250000,
+			}}},
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+			req, err := json.Marshal(messages.UplinkDataFrame{
+				MHdr:       0x40,
+				DevAddr:    0x11223344,
+				FCtrl:      0x30,
+				FCnt:       25,
+				FRMPayload: "5fcc",
+				MIC:        12345678,
+				RadioMetaData: messages.RadioMetaData{
+					DataRate:  tc.DataRateIndex,
+					Frequency: tc.Frequency,
+					UpInfo: messages.UpInfo{
+						RxTime: 1548059982,
+						XTime:  12666373963464220,
+						RSSI:   89,
+						SNR:    9.25,
+					},
+				},
+			})
+			if err != nil {
+				panic(err)
+			}
+			if err := wsConn.WriteMessage(websocket.TextMessage, req); err != nil {
+				t.Fatalf("Failed to write message: %v", err)
+			}
+			select {
+			case up := <-gsConn.Up():
+				a.So(up.BandID, should.Equal, tc.ExpectedBandID)
+				a.So(up.Settings.Frequency, should.Equal, tc.Frequency)
+				a.So(up.Settings.DataRate, should.Resemble, tc.ExpectedDataRate)
+			case <-time.After(timeout):
+				t.Fatalf("Read message timeout")
+			}
+		})
+	}
+}
@@ -31,8 +31,9 @@ import (
 )
 
 const (
-	bufferSize = 1 << 4
-	maxRTTs    = 1 << 5
+	bufferSize       = 1 << 4
+	maxRTTs          = 1 << 5
+	maxSentDownlinks = 1 << 5
 )
 
 // Frontend provides supported features by the gateway frontend.
@@ -75,13 +76,15 @@ type Connection struct {
 	ctx       context.Context
 	cancelCtx errorcontext.CancelFunc
 
-	frontend   Frontend
-	gateway    *ttnpb.Gateway
-	gatewayFPs map[string]*frequencyplans.FrequencyPlan
-	bandID     string
-	fps        *frequencyplans.Store
-	scheduler  *scheduling.Scheduler
-	rtts       *rtts
+	frontend      Frontend
+	gateway       *ttnpb.Gateway
+	gatewayFPs    map[string]*frequencyplans.FrequencyPlan
+	gatewayFPIDs  []string
+	bandID        string
+	fps           *frequencyplans.Store
+	scheduler     *scheduling.Scheduler
+	rtts          *rtts
+	sentDownlinks *sentDownlinks
 
 	upCh     chan *ttnpb.GatewayUplinkMessage
 	downCh   chan *ttnpb.DownlinkMessage
@@ -94,9 +97,9 @@ var (
 		"inconsistent_frequency_plans",
 		"inconsistent frequency plans configuration",
 	)
-	errFrequencyPlansNotFromSameBand = errors.DefineInvalidArgument(
-		"frequency_plans_not_from_same_band",
-		"frequency plans must be from the same band",
+	errBandNotConfigured = errors.DefineInvalidArgument(
+		"band_not_configured",
+		"band `{band_id}` of frequency plan `{id}` is not configured for this gateway",
 	)
 )
 
@@ -109,21 +112,23 @@ func NewConnection(ctx context.Context, frontend Frontend, gateway *ttnpb.Gatewa
 		return nil, err
 	}
 	gatewayFPs[fp0ID] = fp0
-	bandID := fp0.BandID
+	gatewayFPIDs := []string{fp0ID}
 
 	if len(gateway.FrequencyPlanIDs) > 0 {
 		if gateway.FrequencyPlanIDs[0] != fp0ID {
 			return nil, errInconsistentFrequencyPlans
 		}
 		for i := 1; i < len(gateway.FrequencyPlanIDs); i++ {
-			fpn, err := fps.GetByID(gateway.FrequencyPlanIDs[i])
+			fpnID := gateway.FrequencyPlanIDs[i]
+			if _, ok := gatewayFPs[fpnID]; ok {
+				continue
+			}
+			fpn, err := fps.GetByID(fpnID)
 			if err != nil {
 				return nil, err
 			}
-			if fpn.BandID != fp0.BandID {
-				return nil, errFrequencyPlansNotFromSameBand
-			}
-			gatewayFPs[gateway.FrequencyPlanIDs[i]] = fpn
+			gatewayFPs[fpnID] = fpn
+			gatewayFPIDs = append(gatewayFPIDs, fpnID)
 		}
 	}
 
@@ -136,18 +141,20 @@ func NewConnection(ctx context.Context, frontend Frontend, gateway *ttnpb.Gatewa
 		ctx:       ctx,
 		cancelCtx: cancelCtx,
 
-		frontend:    frontend,
-		gateway:     gateway,
-		gatewayFPs:  gatewayFPs,
-		bandID:      bandID,
-		fps:         fps,
-		scheduler:   scheduler,
-		rtts:        newRTTs(maxRTTs),
-		upCh:        make(chan *ttnpb.GatewayUplinkMessage, bufferSize),
-		downCh:      make(chan *ttnpb.DownlinkMessage, bufferSize),
-		statusCh:    make(chan *ttnpb.GatewayStatus, bufferSize),
-		txAckCh:     make(chan *ttnpb.TxAcknowledgment, bufferSize),
-		connectTime: time.Now().UnixNano(),
+		frontend:      frontend,
+		gateway:       gateway,
+		gatewayFPs:    gatewayFPs,
+		gatewayFPIDs:  gatewayFPIDs,
+		bandID:        fp0.BandID,
+		fps:           fps,
+		scheduler:     scheduler,
+		rtts:          newRTTs(maxRTTs),
+		sentDownlinks: newSentDownlinks(maxSentDownlinks),
+		upCh:          make(chan *ttnpb.GatewayUplinkMessage, bufferSize),
+		downCh:        make(chan *ttnpb.DownlinkMessage, bufferSize),
+		statusCh:      make(chan *ttnpb.GatewayStatus, bufferSize),
+		txAckCh:       make(chan *ttnpb.TxAcknowledgment, bufferSize),
+		connectTime:   time.Now().UnixNano(),
 	}, nil
 }
 
@@ -185,11 +192,6 @@ func (c *Connection) HandleUp(up *ttnpb.UplinkMessage) error {
 	}
 
 	for _, md := range up.RxMetadata {
-		if md.AntennaIndex != 0 {
-			// TODO: Support downlink path to multiple antennas (https://github.com/TheThingsNetwork/lorawan-stack/issues/48)
-			md.DownlinkPathConstraint = ttnpb.DOWNLINK_PATH_CONSTRAINT_NEVER
-			continue
-		}
 		buf, err := UplinkToken(ttnpb.GatewayAntennaIdentifiers{
 			GatewayIdentifiers: c.gateway.GatewayIdentifiers,
 			AntennaIndex:       md.AntennaIndex,
@@ -210,7 +212,7 @@ func (c *Connection) HandleUp(up *ttnpb.UplinkMessage) error {
 
 	msg := &ttnpb.GatewayUplinkMessage{
 		UplinkMessage: up,
-		BandID:        c.bandID,
+		BandID:        c.BandIDByFrequency(up.Settings.Frequency),
 	}
 
 	select {
@@ -239,8 +241,10 @@ func (c *Connection) HandleStatus(status *ttnpb.GatewayStatus) error {
 	return nil
 }
 
-// HandleTxAck sends the acknowledgment to the status channel.
+// HandleTxAck matches the acknowledgment with the sent downlink message and sends the acknowledgment to the status channel.
+// The acknowledged downlink message is matched by the correlation IDs of the acknowledgment.
 func (c *Connection) HandleTxAck(ack *ttnpb.TxAcknowledgment) error {
+	ack.DownlinkMessage, _ = c.sentDownlinks.Match(ack.CorrelationIDs)
 	select {
 	case <-c.ctx.Done():
 		return c.ctx.Err()
@@ -294,6 +298,7 @@ func (c *Connection) SendDown(msg *ttnpb.DownlinkMessage) error {
 	case c.downCh <- msg:
 		atomic.AddUint64(&c.downlinks, 1)
 		atomic.StoreInt64(&c.lastDownlinkTime, time.Now().UnixNano())
+		c.sentDownlinks.Record(msg)
 	default:
 		return errBufferFull
 	}
@@ -329,23 +334,29 @@ func (c *Connection) ScheduleDown(path *ttnpb.DownlinkPath, msg *ttnpb.DownlinkM
 	if fpID != "" {
 		fp = c.gatewayFPs[fpID]
 		if fp == nil {
-			// The requested frequency plan is not configured for the gateway. Load the plan and enforce that it's in the same band.
+			// The requested frequency plan is not configured for the gateway. Load the plan and enforce that it's in one of
+			// the bands of the gateway.
 			fp, err = c.fps.GetByID(fpID)
 			if err != nil {
 				return 0, errFrequencyPlanNotConfigured.WithCause(err).WithAttributes("id", request.FrequencyPlanID)
 			}
-			if fp.BandID != c.bandID {
-				return 0, errFrequencyPlansNotFromSameBand
+			if !c.hasBand(fp.BandID) {
+				return 0, errBandNotConfigured.WithAttributes(
+					"band_id", fp.BandID,
+					"id", request.FrequencyPlanID,
+				)
 			}
 		}
 	} else {
-		// Backwards compatibility. If there's no FrequencyPlanID in the TxRequest, then there must be only one Frequency Plan configured.
-		if len(c.gatewayFPs) != 1 {
-			return 0, errNoFrequencyPlanIDInTxRequest
+		// Backwards compatibility. If there's no FrequencyPlanID in the TxRequest, then there must be only one Frequency Plan
+		// configured, or the Frequency Plan must be resolvable from the frequency of the first receive window.
+		frequency := request.Rx1Frequency
+		if frequency == 0 {
+			frequency = request.Rx2Frequency
 		}
-		for _, v := range c.gatewayFPs {
-			fp = v
-			break
+		var ok bool
+		if fp, ok = c.frequencyPlanByFrequency(frequency); !ok && len(c.gatewayFPs) != 1 {
+			return 0, errNoFrequencyPlanIDInTxRequest
 		}
 	}
 	phy, err := band.GetByID(fp.BandID)
@@ -410,6 +421,7 @@ func (c *Connection) ScheduleDown(path *ttnpb.DownlinkPath, msg *ttnpb.DownlinkM
 				AntennaIndex: ids.AntennaIndex,
 			},
 		}
+		// The transmission power is the maximum EIRP minus the gain of the antenna that transmits.
 		if int(ids.AntennaIndex) < len(c.gateway.Antennas) {
 			settings.Downlink.TxPower -= c.gateway.Antennas[ids.AntennaIndex].Gain
 		}
@@ -545,10 +557,38 @@ func (c *Connection) RTTStats() (min, max, median time.Duration, count int) {
 // FrequencyPlans returns the frequency plans for the gateway.
 func (c *Connection) FrequencyPlans() map[string]*frequencyplans.FrequencyPlan { return c.gatewayFPs }
 
-// BandID returns the common band ID for the frequency plans in this connection.
-// TODO: Handle mixed bands (https://github.com/TheThingsNetwork/lorawan-stack/issues/1394)
+// BandID returns the band ID of the primary frequency plan in this connection.
+// Gateways may have frequency plans from multiple bands; use BandIDByFrequency to get the band of a transmission.
 func (c *Connection) BandID() string { return c.bandID }
 
+// BandIDByFrequency returns the band ID of the frequency plan that comprises the given frequency.
+// If no frequency plan comprises the frequency, the band ID of the primary frequency plan is returned.
+func (c *Connection) BandIDByFrequency(frequency uint64) string {
+	fp, _ := c.frequencyPlanByFrequency(frequency)
+	return fp.BandID
+}
+
+// frequencyPlanByFrequency returns the first frequency plan of the gateway that comprises the given frequency.
+// If no frequency plan comprises the frequency, the primary frequency plan is returned and ok is false.
+func (c *Connection) frequencyPlanByFrequency(frequency uint64) (fp *frequencyplans.FrequencyPlan, ok bool) {
+	for _, id := range c.gatewayFPIDs {
+		if fp := c.gatewayFPs[id]; fp.Comprises(frequency) {
+			return fp, true
+		}
+	}
+	return c.gatewayFPs[c.gatewayFPIDs[0]], false
+}
+
+// hasBand returns whether one of the frequency plans of the gateway is in the given band.
+func (c *Connection) hasBand(bandID string) bool {
+	for _, fp := range c.gatewayFPs {
+		if fp.BandID == bandID {
+			return true
+		}
+	}
+	return false
+}
+
 // SyncWithGatewayConcentrator synchronizes the clock with the given concentrator timestamp, the server time and the
 // relative gateway time that corresponds to the given timestamp.
 func (c *Connection) SyncWithGatewayConcentrator(timestamp uint32, server time.Time, concentrator scheduling.ConcentratorTime) {
@@ -21,6 +21,7 @@ import (
 
 	"github.com/smartystreets/assertions"
 	"go.thethings.network/lorawan-stack/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/pkg/band"
 	"go.thethings.network/lorawan-stack/pkg/component"
 	componenttest "go.thethings.network/lorawan-stack/pkg/component/test"
 	"go.thethings.network/lorawan-stack/pkg/errors"
@@ -529,3 +530,233 @@ func TestSubBandEIRPOverride(t *testing.T) {
 		})
 	}
 }
+
+func TestMultipleAntennas(t *testing.T) {
+	a := assertions.New(t)
+	ctx := log.NewContext(test.Context(), test.GetLogger(t))
+
+	c := componenttest.NewComponent(t, &component.Config{})
+	c.FrequencyPlans = frequencyplans.NewStore(test.FrequencyPlansFetcher)
+	gs := mock.NewServer(c)
+
+	ids := ttnpb.GatewayIdentifiers{GatewayID: "baz-gateway"}
+	gtw := &ttnpb.Gateway{
+		GatewayIdentifiers: ids,
+		FrequencyPlanID:    "EU_863_870",
+		LocationPublic:     true,
+		Antennas: []ttnpb.GatewayAntenna{
+			{
+				Gain: 3,
+				Location: ttnpb.Location{
+					Latitude:  52.37,
+					Longitude: 4.89,
+					Source:    ttnpb.SOURCE_REGISTRY,
+				},
+			},
+			{
+				Gain: 6,
+				Location: ttnpb.Location{
+					Latitude:  52.38,
+					Longitude: 4.90,
+					Source:    ttnpb.SOURCE_REGISTRY,
+				},
+			},
+		},
+	}
+	gs.RegisterGateway(ctx, ids, gtw)
+
+	gtwCtx := rights.NewContext(ctx, rights.Rights{
+		GatewayRights: map[string]*ttnpb.Rights{
+			unique.ID(ctx, ids): ttnpb.RightsFrom(ttnpb.RIGHT_GATEWAY_LINK),
+		},
+	})
+	frontend, err := mock.ConnectFrontend(gtwCtx, ids, gs)
+	if err != nil {
+		panic(err)
+	}
+	conn := gs.GetConnection(ctx, ids)
+
+	var tokens [][]byte
+	frontend.Up <- &ttnpb.UplinkMessage{
+		RxMetadata: []*ttnpb.RxMetadata{
+			{
+				AntennaIndex: 0,
+				Timestamp:    100,
+				RSSI:         -110,
+			},
+			{
+				AntennaIndex: 1,
+				Timestamp:    100,
+				RSSI:         -60,
+			},
+		},
+	}
+	select {
+	case up := <-conn.Up():
+		if !a.So(up.RxMetadata, should.HaveLength, 2) {
+			t.FailNow()
+		}
+		for i, md := range up.RxMetadata {
+			tokenIDs, timestamp, err := io.ParseUplinkToken(md.UplinkToken)
+			a.So(err, should.BeNil)
+			a.So(tokenIDs.GatewayIdentifiers, should.Resemble, ids)
+			a.So(tokenIDs.AntennaIndex, should.Equal, i)
+			a.So(timestamp, should.Equal, 100)
+			a.So(md.DownlinkPathConstraint, should.Equal, ttnpb.DOWNLINK_PATH_CONSTRAINT_NONE)
+			a.So(md.Location, should.Resemble, &gtw.Antennas[i].Location)
+			tokens = append(tokens, md.UplinkToken)
+		}
+	case <-time.After(timeout):
+		t.Fatalf("Expected uplink message time-out")
+	}
+
+	_, err = conn.ScheduleDown(&ttnpb.DownlinkPath{
+		Path: &ttnpb.DownlinkPath_UplinkToken{
+			UplinkToken: tokens[1],
+		},
+	}, &ttnpb.DownlinkMessage{
+		RawPayload: []byte{0x01},
+		Settings: &ttnpb.DownlinkMessage_Request{
+			Request: &ttnpb.TxRequest{
+				Class:            ttnpb.CLASS_A,
+				Priority:         ttnpb.TxSchedulePriority_NORMAL,
+				Rx1Delay:         ttnpb.RX_DELAY_1,
+				Rx1DataRateIndex: 5,
+				Rx1Frequency:     868100000,
+			},
+		},
+	})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	select {
+	case msg := <-frontend.Down:
+		scheduled := msg.GetScheduled()
+		if !a.So(scheduled, should.NotBeNil) {
+			t.FailNow()
+		}
+		a.So(scheduled.Downlink.AntennaIndex, should.Equal, 1)
+		a.So(scheduled.Downlink.TxPower, should.Equal, float32(16.15)-6)
+	case <-time.After(timeout):
+		t.Fatalf("Expected downlink message timeout")
+	}
+}
+
+func TestMixedBands(t *testing.T) {
+	ctx := log.NewContext(test.Context(), test.GetLogger(t))
+
+	c := componenttest.NewComponent(t, &component.Config{})
+	c.FrequencyPlans = frequencyplans.NewStore(test.FrequencyPlansFetcher)
+	gs := mock.NewServer(c)
+
+	ids := ttnpb.GatewayIdentifiers{GatewayID: "qux-gateway"}
+	gtw := &ttnpb.Gateway{
+		GatewayIdentifiers: ids,
+		FrequencyPlanIDs:   []string{test.EUFrequencyPlanID, test.KRFrequencyPlanID},
+	}
+	gs.RegisterGateway(ctx, ids, gtw)
+
+	gtwCtx := rights.NewContext(ctx, rights.Rights{
+		GatewayRights: map[string]*ttnpb.Rights{
+			unique.ID(ctx, ids): ttnpb.RightsFrom(ttnpb.RIGHT_GATEWAY_LINK),
+		},
+	})
+	frontend, err := mock.ConnectFrontend(gtwCtx, ids, gs)
+	if err != nil {
+		panic(err)
+	}
+	conn := gs.GetConnection(ctx, ids)
+
+	for i, tc := range []struct {
+		Name            string
+		Frequency       uint64
+		FrequencyPlanID string
+		ExpectedBandID  string
+		ExpectedTxPower float32
+	}{
+		{
+			Name:            "EU868",
+			Frequency:       868100000,
+			ExpectedBandID:  band.EU_863_870,
+			ExpectedTxPower: 16.15,
+		},
+		{
+			Name:            "KR920",
+			Frequency:       922100000,
+			ExpectedBandID:  band.KR_920_923,
+			ExpectedTxPower: 16.15,
+		},
+		{
+			// The frequency is outside of the sub-bands of the KR920 band, so the default maximum EIRP of the band applies.
+			Name:            "KR920DefaultEIRP",
+			Frequency:       923100000,
+			ExpectedBandID:  band.KR_920_923,
+			ExpectedTxPower: 14,
+		},
+		{
+			Name:            "KR920WithFrequencyPlanID",
+			Frequency:       923300000,
+			FrequencyPlanID: test.KRFrequencyPlanID,
+			ExpectedBandID:  band.KR_920_923,
+			ExpectedTxPower: 14,
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+
+			timestamp := uint32(i+1) * 10000000
+			frontend.Up <- &ttnpb.UplinkMessage{
+				Settings: ttnpb.TxSettings{
+					Frequency: tc.Frequency,
+					Timestamp: timestamp,
+				},
+				RxMetadata: []*ttnpb.RxMetadata{
+					{
+						Timestamp: timestamp,
+					},
+				},
+			}
+			var token []byte
+			select {
+			case up := <-conn.Up():
+				a.So(up.BandID, should.Equal, tc.ExpectedBandID)
+				a.So(conn.BandIDByFrequency(tc.Frequency), should.Equal, tc.ExpectedBandID)
+				token = up.RxMetadata[0].UplinkToken
+			case <-time.After(timeout):
+				t.Fatalf("Expected uplink message time-out")
+			}
+
+			_, err := conn.ScheduleDown(&ttnpb.DownlinkPath{
+				Path: &ttnpb.DownlinkPath_UplinkToken{
+					UplinkToken: token,
+				},
+			}, &ttnpb.DownlinkMessage{
+				RawPayload: []byte{0x01},
+				Settings: &ttnpb.DownlinkMessage_Request{
+					Request: &ttnpb.TxRequest{
+						Class:            ttnpb.CLASS_A,
+						Priority:         ttnpb.TxSchedulePriority_NORMAL,
+						Rx1Delay:         ttnpb.RX_DELAY_1,
+						Rx1DataRateIndex: 5,
+						Rx1Frequency:     tc.Frequency,
+						FrequencyPlanID:  tc.FrequencyPlanID,
+					},
+				},
+			})
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			select {
+			case msg := <-frontend.Down:
+				scheduled := msg.GetScheduled()
+				if !a.So(scheduled, should.NotBeNil) {
+					t.FailNow()
+				}
+				a.So(scheduled.Frequency, should.Equal, tc.Frequency)
+				a.So(scheduled.Downlink.TxPower, should.Equal, tc.ExpectedTxPower)
+			case <-time.After(timeout):
+				t.Fatalf("Expected downlink message timeout")
+			}
+		})
+	}
+}
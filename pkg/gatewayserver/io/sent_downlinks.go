@@ -0,0 +1,82 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package io
+
+import (
+	"sync"
+
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+)
+
+type sentDownlink struct {
+	correlationIDs []string
+	msg            *ttnpb.DownlinkMessage
+}
+
+type sentDownlinks struct {
+	count int
+	mu    sync.Mutex
+	items []sentDownlink
+}
+
+func newSentDownlinks(count int) *sentDownlinks {
+	return &sentDownlinks{
+		count: count,
+		items: make([]sentDownlink, 0, count+1),
+	}
+}
+
+// Record records the given downlink message as sent.
+// The correlation IDs are copied, so that the message can be matched with the correlation IDs at the time of sending.
+func (s *sentDownlinks) Record(msg *ttnpb.DownlinkMessage) {
+	if len(msg.CorrelationIDs) == 0 {
+		return
+	}
+	s.mu.Lock()
+	s.items = append(s.items, sentDownlink{
+		correlationIDs: append(make([]string, 0, len(msg.CorrelationIDs)), msg.CorrelationIDs...),
+		msg:            msg,
+	})
+	if len(s.items) > s.count {
+		s.items = append(s.items[:0], s.items[len(s.items)-s.count:]...)
+	}
+	s.mu.Unlock()
+}
+
+// Match returns the most recently sent downlink message of which all correlation IDs are in cids.
+// A matched downlink message is forgotten.
+func (s *sentDownlinks) Match(cids []string) (*ttnpb.DownlinkMessage, bool) {
+	if len(cids) == 0 {
+		return nil, false
+	}
+	set := make(map[string]struct{}, len(cids))
+	for _, cid := range cids {
+		set[cid] = struct{}{}
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+outer:
+	for i := len(s.items) - 1; i >= 0; i-- {
+		for _, cid := range s.items[i].correlationIDs {
+			if _, ok := set[cid]; !ok {
+				continue outer
+			}
+		}
+		msg := s.items[i].msg
+		s.items = append(s.items[:i], s.items[i+1:]...)
+		return msg, true
+	}
+	return nil, false
+}
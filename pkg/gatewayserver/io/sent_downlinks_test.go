@@ -0,0 +1,63 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package io
+
+import (
+	"testing"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+func TestSentDownlinks(t *testing.T) {
+	a := assertions.New(t)
+
+	downlinks := newSentDownlinks(2)
+
+	_, ok := downlinks.Match([]string{"ns:downlink:1"})
+	a.So(ok, should.BeFalse)
+
+	down1 := &ttnpb.DownlinkMessage{CorrelationIDs: []string{"ns:downlink:1", "ns:uplink:1"}}
+	down2 := &ttnpb.DownlinkMessage{CorrelationIDs: []string{"ns:downlink:2"}}
+	down3 := &ttnpb.DownlinkMessage{CorrelationIDs: []string{"ns:downlink:3"}}
+	downlinks.Record(down1)
+	downlinks.Record(down2)
+	downlinks.Record(&ttnpb.DownlinkMessage{})
+
+	// Correlation IDs appended after sending do not affect matching.
+	down1.CorrelationIDs = append(down1.CorrelationIDs, "gs:conn:1")
+
+	_, ok = downlinks.Match(nil)
+	a.So(ok, should.BeFalse)
+	_, ok = downlinks.Match([]string{"ns:downlink:1"})
+	a.So(ok, should.BeFalse)
+
+	down, ok := downlinks.Match([]string{"ns:uplink:1", "ns:downlink:1", "gs:uplink:1"})
+	a.So(ok, should.BeTrue)
+	a.So(down, should.Equal, down1)
+
+	_, ok = downlinks.Match([]string{"ns:uplink:1", "ns:downlink:1"})
+	a.So(ok, should.BeFalse)
+
+	downlinks.Record(down3)
+	downlinks.Record(down1)
+
+	_, ok = downlinks.Match([]string{"ns:downlink:2"})
+	a.So(ok, should.BeFalse)
+	down, ok = downlinks.Match([]string{"ns:downlink:3"})
+	a.So(ok, should.BeTrue)
+	a.So(down, should.Equal, down3)
+}
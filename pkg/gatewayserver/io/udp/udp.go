@@ -316,7 +316,6 @@ func (s *srv) handleUp(ctx context.Context, state *state, packet encoding.Packet
 		if rtt != nil {
 			state.io.RecordRTT(*rtt)
 		}
-		// TODO: Send event to NS (https://github.com/TheThingsNetwork/lorawan-stack/issues/76)
 	}
 
 	return nil
@@ -346,7 +345,7 @@ func (s *srv) handleDown(ctx context.Context, state *state) error {
 			tx, err := encoding.FromDownlinkMessage(down)
 			if err != nil {
 				logger.WithError(err).Warn("Failed to marshal downlink message")
-				// TODO: Report to Network Server: https://github.com/TheThingsNetwork/lorawan-stack/issues/76
+				reportTxFailure(ctx, state, down)
 				break
 			}
 			downlinkPath := state.lastDownlinkPath.Load().(downlinkPath)
@@ -365,7 +364,7 @@ func (s *srv) handleDown(ctx context.Context, state *state) error {
 				packet.Token = [2]byte{byte(token >> 8), byte(token)}
 				if err := s.write(packet); err != nil {
 					logger.WithError(err).Warn("Failed to write downlink message")
-					// TODO: Report to Network Server: https://github.com/TheThingsNetwork/lorawan-stack/issues/76
+					reportTxFailure(ctx, state, down)
 				}
 			}
 			canImmediate := atomic.LoadUint32(&state.receivedTxAck) == 1
@@ -401,6 +400,16 @@ func (s *srv) handleDown(ctx context.Context, state *state) error {
 	}
 }
 
+// reportTxFailure reports a downlink message that could not be sent to the gateway as failed Tx acknowledgment.
+func reportTxFailure(ctx context.Context, state *state, down *ttnpb.DownlinkMessage) {
+	if err := state.io.HandleTxAck(&ttnpb.TxAcknowledgment{
+		CorrelationIDs: down.CorrelationIDs,
+		Result:         ttnpb.TxAcknowledgment_UNKNOWN_ERROR,
+	}); err != nil {
+		log.FromContext(ctx).WithError(err).Warn("Failed to handle Tx acknowledgement")
+	}
+}
+
 func (s *srv) write(packet encoding.Packet) error {
 	buf, err := packet.MarshalBinary()
 	if err != nil {
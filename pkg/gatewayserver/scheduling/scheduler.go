@@ -223,7 +223,20 @@ func (s *Scheduler) newEmission(payloadSize int, settings ttnpb.TxSettings, star
 	if err != nil {
 		return Emission{}, err
 	}
+	// Only the frequency plans that comprise the frequency apply, so that the dwell time restrictions of one band do not
+	// apply to transmissions in another band. If no frequency plan comprises the frequency, all frequency plans apply.
+	fps := make([]*frequencyplans.FrequencyPlan, 0, len(s.fps))
 	for _, fp := range s.fps {
+		if fp.Comprises(settings.Frequency) {
+			fps = append(fps, fp)
+		}
+	}
+	if len(fps) == 0 {
+		for _, fp := range s.fps {
+			fps = append(fps, fp)
+		}
+	}
+	for _, fp := range fps {
 		if fp.RespectsDwellTime(true, settings.Frequency, d) {
 			return NewEmission(starts, d), nil
 		}
@@ -859,7 +859,7 @@ func TestSchedulerWithMultipleFrequencyPlans(t *testing.T) {
 		{
 			Name: "OverlappingSubBandsFromBand",
 			FrequencyPlans: map[string]*frequencyplans.FrequencyPlan{"AS_923": {
-				// This is a fictional test case since currently we don't support mix-band frequency plans (https://github.com/TheThingsNetwork/lorawan-stack/issues/1394).
+				// The sub-bands of the AS_923 and AU_915_928 bands overlap.
 				BandID: band.AS_923,
 				TimeOffAir: frequencyplans.TimeOffAir{
 					Duration: time.Second,
@@ -1003,3 +1003,75 @@ func TestSchedulingWithMultipleFrequencyPlans(t *testing.T) {
 	a.So(err, should.BeNil)
 	a.So(time.Duration(em.Starts()), should.Equal, 9*time.Second+scheduling.ScheduleTimeLong)
 }
+
+func TestSchedulingWithMixedBandDwellTime(t *testing.T) {
+	ctx := test.Context()
+	fps := map[string]*frequencyplans.FrequencyPlan{
+		test.EUFrequencyPlanID: {
+			BandID: band.EU_863_870,
+			TimeOffAir: frequencyplans.TimeOffAir{
+				Duration: time.Second,
+			},
+		},
+		"AS_923": {
+			BandID: band.AS_923,
+			TimeOffAir: frequencyplans.TimeOffAir{
+				Duration: time.Second,
+			},
+			DwellTime: frequencyplans.DwellTime{
+				Downlinks: boolPtr(true),
+				Duration:  durationPtr(400 * time.Millisecond),
+			},
+		},
+	}
+	timeSource := &mockTimeSource{
+		Time: time.Unix(0, 0),
+	}
+	scheduler, err := scheduling.NewScheduler(ctx, fps, true, nil, timeSource)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	scheduler.Sync(0, timeSource.Time)
+
+	for _, tc := range []struct {
+		Name          string
+		Frequency     uint64
+		Timestamp     uint32
+		ExpectedError *errors.Definition
+	}{
+		{
+			Name:      "EU868",
+			Frequency: 869525000,
+			Timestamp: 5000000,
+		},
+		{
+			Name:      "AS923",
+			Frequency: 923200000,
+			Timestamp: 10000000,
+			// Exceeding dwell time of 400 milliseconds in the AS923 band.
+			ExpectedError: &scheduling.ErrDwellTime,
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+			_, err := scheduler.ScheduleAt(ctx, 10, ttnpb.TxSettings{
+				DataRate: ttnpb.DataRate{
+					Modulation: &ttnpb.DataRate_LoRa{
+						LoRa: &ttnpb.LoRaDataRate{
+							Bandwidth:       125000,
+							SpreadingFactor: 12,
+						},
+					},
+				},
+				CodingRate: "4/5",
+				Frequency:  tc.Frequency,
+				Timestamp:  tc.Timestamp,
+			}, nil, ttnpb.TxSchedulePriority_NORMAL)
+			if tc.ExpectedError != nil {
+				a.So(err, should.HaveSameErrorDefinitionAs, *tc.ExpectedError)
+			} else {
+				a.So(err, should.BeNil)
+			}
+		})
+	}
+}
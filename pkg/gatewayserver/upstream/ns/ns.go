@@ -91,3 +91,20 @@ func (h *Handler) HandleUplink(ctx context.Context, _ ttnpb.GatewayIdentifiers,
 func (h *Handler) HandleStatus(context.Context, ttnpb.GatewayIdentifiers, *ttnpb.GatewayStatus) error {
 	return nil
 }
+
+// HandleTxAck implements upstream.Handler.
+func (h *Handler) HandleTxAck(ctx context.Context, gtwIDs ttnpb.GatewayIdentifiers, msg *ttnpb.TxAcknowledgment) error {
+	ids := msg.GetDownlinkMessage().GetEndDeviceIDs()
+	if ids == nil {
+		return nil
+	}
+	nsConn, err := h.cluster.GetPeerConn(ctx, ttnpb.ClusterRole_NETWORK_SERVER, *ids)
+	if err != nil {
+		return errNetworkServerNotFound.WithCause(err)
+	}
+	_, err = ttnpb.NewGsNsClient(nsConn).ReportTxAcknowledgment(ctx, &ttnpb.GatewayTxAcknowledgment{
+		GatewayIdentifiers: gtwIDs,
+		TxAck:              msg,
+	}, h.cluster.WithClusterAuth())
+	return err
+}
@@ -0,0 +1,77 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package identityserver
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/jinzhu/gorm"
+	"go.thethings.network/lorawan-stack/pkg/identityserver/store"
+	"go.thethings.network/lorawan-stack/pkg/log"
+)
+
+// apiKeyUsage keeps track of the last time that API keys were used, so that
+// the last used times can be written to the database in batches instead of on
+// every request.
+type apiKeyUsage struct {
+	mu       sync.Mutex
+	lastUsed map[string]time.Time
+}
+
+func (u *apiKeyUsage) record(id string, t time.Time) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	if u.lastUsed == nil {
+		u.lastUsed = make(map[string]time.Time)
+	}
+	if t.After(u.lastUsed[id]) {
+		u.lastUsed[id] = t
+	}
+}
+
+func (u *apiKeyUsage) take() map[string]time.Time {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	lastUsed := u.lastUsed
+	u.lastUsed = nil
+	return lastUsed
+}
+
+func (is *IdentityServer) flushAPIKeyUsage(ctx context.Context) error {
+	lastUsed := is.apiKeyUsage.take()
+	if len(lastUsed) == 0 {
+		return nil
+	}
+	return is.withDatabase(ctx, func(db *gorm.DB) error {
+		return store.GetAPIKeyStore(db).UpdateAPIKeyLastUsed(ctx, lastUsed)
+	})
+}
+
+func (is *IdentityServer) flushAPIKeyUsageTask(ctx context.Context) error {
+	ticker := time.NewTicker(is.config.APIKeys.LastUsedInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+		if err := is.flushAPIKeyUsage(ctx); err != nil {
+			log.FromContext(ctx).WithError(err).Warn("Failed to update last used time of API keys")
+		}
+	}
+}
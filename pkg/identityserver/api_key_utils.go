@@ -16,8 +16,12 @@ package identityserver
 
 import (
 	"context"
+	"net"
+	"time"
 
+	"github.com/gogo/protobuf/types"
 	"go.thethings.network/lorawan-stack/pkg/auth"
+	"go.thethings.network/lorawan-stack/pkg/errors"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
 )
 
@@ -42,3 +46,65 @@ func generateAPIKey(ctx context.Context, name string, rights ...ttnpb.Right) (ke
 	}
 	return key, token, nil
 }
+
+var (
+	errAPIKeyExpiresAtPast        = errors.DefineInvalidArgument("api_key_expires_at_past", "API key expiry `{expires_at}` is in the past")
+	errAPIKeySourceCIDR           = errors.DefineInvalidArgument("api_key_source_cidr", "invalid source CIDR `{cidr}`")
+	errAPIKeyEndDeviceRestriction = errors.DefineInvalidArgument("api_key_end_device_restriction", "end device restrictions are only supported for application API keys")
+)
+
+// validateAPIKeyOptions validates the expiry and restrictions of an API key of the given entity.
+func validateAPIKeyOptions(entityID ttnpb.Identifiers, expiresAt *time.Time, restrictions *ttnpb.APIKeyRestrictions) error {
+	if expiresAt != nil && expiresAt.Before(time.Now()) {
+		return errAPIKeyExpiresAtPast.WithAttributes("expires_at", expiresAt.Format(time.RFC3339))
+	}
+	for _, cidr := range restrictions.GetSourceCIDRs() {
+		if _, _, err := net.ParseCIDR(cidr); err != nil {
+			return errAPIKeySourceCIDR.WithCause(err).WithAttributes("cidr", cidr)
+		}
+	}
+	if len(restrictions.GetEndDeviceIDs()) > 0 && entityID.EntityType() != "application" {
+		return errAPIKeyEndDeviceRestriction
+	}
+	return nil
+}
+
+// validateAPIKeyUpdate validates the expiry and restrictions of an API key update,
+// if those are in the field mask.
+func validateAPIKeyUpdate(entityID ttnpb.Identifiers, update *ttnpb.APIKey, fieldMask types.FieldMask) error {
+	var (
+		expiresAt    *time.Time
+		restrictions *ttnpb.APIKeyRestrictions
+	)
+	if ttnpb.HasAnyField(fieldMask.Paths, "expires_at") {
+		expiresAt = update.ExpiresAt
+	}
+	if ttnpb.HasAnyField(fieldMask.Paths, "restrictions") {
+		restrictions = update.Restrictions
+	}
+	return validateAPIKeyOptions(entityID, expiresAt, restrictions)
+}
+
+// applyAPIKeyUpdate returns the result of applying the fields of update that are
+// in the field mask to the existing API key. If the field mask is empty, only the
+// name and rights are updated, as older clients do not set a field mask.
+func applyAPIKeyUpdate(existing, update *ttnpb.APIKey, fieldMask types.FieldMask) *ttnpb.APIKey {
+	paths := fieldMask.Paths
+	if len(paths) == 0 {
+		paths = []string{"name", "rights"}
+	}
+	key := *existing
+	if ttnpb.HasAnyField(paths, "name") {
+		key.Name = update.Name
+	}
+	if ttnpb.HasAnyField(paths, "rights") {
+		key.Rights = update.Rights
+	}
+	if ttnpb.HasAnyField(paths, "expires_at") {
+		key.ExpiresAt = update.ExpiresAt
+	}
+	if ttnpb.HasAnyField(paths, "restrictions") {
+		key.Restrictions = update.Restrictions
+	}
+	return &key
+}
@@ -20,12 +20,9 @@ import (
 	"github.com/gogo/protobuf/types"
 	"github.com/jinzhu/gorm"
 	"go.thethings.network/lorawan-stack/pkg/auth/rights"
-	"go.thethings.network/lorawan-stack/pkg/email"
 	"go.thethings.network/lorawan-stack/pkg/errors"
 	"go.thethings.network/lorawan-stack/pkg/events"
-	"go.thethings.network/lorawan-stack/pkg/identityserver/emails"
 	"go.thethings.network/lorawan-stack/pkg/identityserver/store"
-	"go.thethings.network/lorawan-stack/pkg/log"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
 )
 
@@ -59,7 +56,9 @@ func (is *IdentityServer) listApplicationRights(ctx context.Context, ids *ttnpb.
 	if err != nil {
 		return nil, err
 	}
-	return appRights.Intersect(ttnpb.AllApplicationRights), nil
+	res := appRights.Intersect(ttnpb.AllApplicationRights)
+	res.Restrictions = appRights.GetRestrictions()
+	return res, nil
 }
 
 func (is *IdentityServer) createApplicationAPIKey(ctx context.Context, req *ttnpb.CreateApplicationAPIKeyRequest) (key *ttnpb.APIKey, err error) {
@@ -71,10 +70,14 @@ func (is *IdentityServer) createApplicationAPIKey(ctx context.Context, req *ttnp
 	if err = rights.RequireApplication(ctx, req.ApplicationIdentifiers, req.Rights...); err != nil {
 		return nil, err
 	}
+	if err = validateAPIKeyOptions(req.ApplicationIdentifiers, req.ExpiresAt, req.Restrictions); err != nil {
+		return nil, err
+	}
 	key, token, err := generateAPIKey(ctx, req.Name, req.Rights...)
 	if err != nil {
 		return nil, err
 	}
+	key.ExpiresAt, key.Restrictions = req.ExpiresAt, req.Restrictions
 	err = is.withDatabase(ctx, func(db *gorm.DB) error {
 		return store.GetAPIKeyStore(db).CreateAPIKey(ctx, req.ApplicationIdentifiers, key)
 	})
@@ -83,13 +86,12 @@ func (is *IdentityServer) createApplicationAPIKey(ctx context.Context, req *ttnp
 	}
 	key.Key = token
 	events.Publish(evtCreateApplicationAPIKey(ctx, req.ApplicationIdentifiers, nil))
-	err = is.SendContactsEmail(ctx, req.EntityIdentifiers(), func(data emails.Data) email.MessageData {
-		data.SetEntity(req.EntityIdentifiers())
-		return &emails.APIKeyCreated{Data: data, Identifier: key.PrettyName(), Rights: key.Rights}
+	is.notify(ctx, &ttnpb.Notification{
+		NotificationType: ttnpb.NOTIFICATION_TYPE_API_KEY_CREATED,
+		EntityIDs:        *req.EntityIdentifiers(),
+		Subject:          key.PrettyName(),
+		Rights:           key.Rights,
 	})
-	if err != nil {
-		log.FromContext(ctx).WithError(err).Error("Could not send API key creation notification email")
-	}
 	return key, nil
 }
 
@@ -144,15 +146,20 @@ func (is *IdentityServer) updateApplicationAPIKey(ctx context.Context, req *ttnp
 		return nil, err
 	}
 
+	if err = validateAPIKeyUpdate(req.ApplicationIdentifiers, &req.APIKey, req.FieldMask); err != nil {
+		return nil, err
+	}
+
 	err = is.withDatabase(ctx, func(db *gorm.DB) (err error) {
-		if len(req.APIKey.Rights) > 0 {
-			_, key, err := store.GetAPIKeyStore(db).GetAPIKey(ctx, req.APIKey.ID)
-			if err != nil {
-				return err
-			}
+		_, existing, err := store.GetAPIKeyStore(db).GetAPIKey(ctx, req.APIKey.ID)
+		if err != nil {
+			return err
+		}
+		update := applyAPIKeyUpdate(existing, &req.APIKey, req.FieldMask)
 
-			newRights := ttnpb.RightsFrom(req.APIKey.Rights...)
-			existingRights := ttnpb.RightsFrom(key.Rights...)
+		if len(update.Rights) > 0 {
+			newRights := ttnpb.RightsFrom(update.Rights...)
+			existingRights := ttnpb.RightsFrom(existing.Rights...)
 
 			// Require the caller to have all added rights.
 			if err := rights.RequireApplication(ctx, req.ApplicationIdentifiers, newRights.Sub(existingRights).GetRights()...); err != nil {
@@ -164,28 +171,24 @@ func (is *IdentityServer) updateApplicationAPIKey(ctx context.Context, req *ttnp
 			}
 		}
 
-		key, err = store.GetAPIKeyStore(db).UpdateAPIKey(ctx, req.ApplicationIdentifiers, &req.APIKey)
+		key, err = store.GetAPIKeyStore(db).UpdateAPIKey(ctx, req.ApplicationIdentifiers, update)
 		return err
 	})
 	if err != nil {
 		return nil, err
 	}
 	if key == nil {
+		events.Publish(evtDeleteApplicationAPIKey(ctx, req.ApplicationIdentifiers, nil))
 		return &ttnpb.APIKey{}, nil
 	}
 	key.Key = ""
-	if len(req.Rights) > 0 {
-		events.Publish(evtUpdateApplicationAPIKey(ctx, req.ApplicationIdentifiers, nil))
-		err = is.SendContactsEmail(ctx, req.EntityIdentifiers(), func(data emails.Data) email.MessageData {
-			data.SetEntity(req.EntityIdentifiers())
-			return &emails.APIKeyChanged{Data: data, Identifier: key.PrettyName(), Rights: key.Rights}
-		})
-		if err != nil {
-			log.FromContext(ctx).WithError(err).Error("Could not send API key update notification email")
-		}
-	} else {
-		events.Publish(evtDeleteApplicationAPIKey(ctx, req.ApplicationIdentifiers, nil))
-	}
+	events.Publish(evtUpdateApplicationAPIKey(ctx, req.ApplicationIdentifiers, nil))
+	is.notify(ctx, &ttnpb.Notification{
+		NotificationType: ttnpb.NOTIFICATION_TYPE_API_KEY_CHANGED,
+		EntityIDs:        *req.EntityIdentifiers(),
+		Subject:          key.PrettyName(),
+		Rights:           key.Rights,
+	})
 	return key, nil
 }
 
@@ -221,11 +224,11 @@ func (is *IdentityServer) setApplicationCollaborator(ctx context.Context, req *t
 	}
 
 	err := is.withDatabase(ctx, func(db *gorm.DB) error {
-		store := is.getMembershipStore(ctx, db)
+		membershipStore := is.getMembershipStore(ctx, db)
+		newRights := ttnpb.RightsFrom(req.Collaborator.Rights...)
 
 		if len(req.Collaborator.Rights) > 0 {
-			newRights := ttnpb.RightsFrom(req.Collaborator.Rights...)
-			existingRights, err := store.GetMember(
+			existingRights, err := membershipStore.GetMember(
 				ctx,
 				&req.Collaborator.OrganizationOrUserIdentifiers,
 				req.ApplicationIdentifiers,
@@ -244,11 +247,16 @@ func (is *IdentityServer) setApplicationCollaborator(ctx context.Context, req *t
 			}
 		}
 
-		return store.SetMember(
+		// Do not leave the application without a member with all rights.
+		if err := store.CheckLastOwner(ctx, membershipStore, &req.Collaborator.OrganizationOrUserIdentifiers, req.ApplicationIdentifiers, newRights); err != nil {
+			return err
+		}
+
+		return membershipStore.SetMember(
 			ctx,
 			&req.Collaborator.OrganizationOrUserIdentifiers,
 			req.ApplicationIdentifiers,
-			ttnpb.RightsFrom(req.Collaborator.Rights...),
+			newRights,
 		)
 	})
 	if err != nil {
@@ -256,13 +264,12 @@ func (is *IdentityServer) setApplicationCollaborator(ctx context.Context, req *t
 	}
 	if len(req.Collaborator.Rights) > 0 {
 		events.Publish(evtUpdateApplicationCollaborator(ctx, ttnpb.CombineIdentifiers(req.ApplicationIdentifiers, req.Collaborator), nil))
-		err = is.SendContactsEmail(ctx, req.EntityIdentifiers(), func(data emails.Data) email.MessageData {
-			data.SetEntity(req.EntityIdentifiers())
-			return &emails.CollaboratorChanged{Data: data, Collaborator: req.Collaborator}
-		})
-		if err != nil {
-			log.FromContext(ctx).WithError(err).Error("Could not send collaborator updated notification email")
-		}
+		is.notify(ctx, &ttnpb.Notification{
+			NotificationType: ttnpb.NOTIFICATION_TYPE_COLLABORATOR_CHANGED,
+			EntityIDs:        *req.EntityIdentifiers(),
+			Subject:          req.Collaborator.IDString(),
+			Rights:           req.Collaborator.Rights,
+		}, req.Collaborator.GetUserIDs())
 	} else {
 		events.Publish(evtDeleteApplicationCollaborator(ctx, ttnpb.CombineIdentifiers(req.ApplicationIdentifiers, req.Collaborator), nil))
 	}
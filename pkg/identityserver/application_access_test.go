@@ -16,13 +16,19 @@ package identityserver
 
 import (
 	"testing"
+	"time"
 
+	"github.com/gogo/protobuf/types"
+	"github.com/jinzhu/gorm"
 	"github.com/smartystreets/assertions"
 	"github.com/smartystreets/assertions/should"
 	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/identityserver/store"
+	"go.thethings.network/lorawan-stack/pkg/rpcmetadata"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
 	"go.thethings.network/lorawan-stack/pkg/util/test"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
 )
 
 func init() {
@@ -350,6 +356,62 @@ func TestApplicationAccessCRUD(t *testing.T) {
 	})
 }
 
+func TestApplicationAccessLastOwner(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+
+	testWithIdentityServer(t, func(is *IdentityServer, cc *grpc.ClientConn) {
+		userID, creds := defaultUser.UserIdentifiers, userCreds(defaultUserIdx)
+		collaboratorID := collaboratorUser.UserIdentifiers.OrganizationOrUserIdentifiers()
+
+		created, err := ttnpb.NewApplicationRegistryClient(cc).Create(ctx, &ttnpb.CreateApplicationRequest{
+			Application: ttnpb.Application{
+				ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: "last-owner-app"},
+			},
+			Collaborator: *userID.OrganizationOrUserIdentifiers(),
+		}, creds)
+
+		a.So(err, should.BeNil)
+		if !a.So(created, should.NotBeNil) {
+			t.FailNow()
+		}
+
+		reg := ttnpb.NewApplicationAccessClient(cc)
+
+		_, err = reg.SetCollaborator(ctx, &ttnpb.SetApplicationCollaboratorRequest{
+			ApplicationIdentifiers: created.ApplicationIdentifiers,
+			Collaborator: ttnpb.Collaborator{
+				OrganizationOrUserIdentifiers: *userID.OrganizationOrUserIdentifiers(),
+				Rights:                        []ttnpb.Right{ttnpb.RIGHT_APPLICATION_INFO},
+			},
+		}, creds)
+
+		if a.So(err, should.NotBeNil) {
+			a.So(errors.IsFailedPrecondition(err), should.BeTrue)
+		}
+
+		_, err = reg.SetCollaborator(ctx, &ttnpb.SetApplicationCollaboratorRequest{
+			ApplicationIdentifiers: created.ApplicationIdentifiers,
+			Collaborator: ttnpb.Collaborator{
+				OrganizationOrUserIdentifiers: *collaboratorID,
+				Rights:                        []ttnpb.Right{ttnpb.RIGHT_APPLICATION_ALL},
+			},
+		}, creds)
+
+		a.So(err, should.BeNil)
+
+		_, err = reg.SetCollaborator(ctx, &ttnpb.SetApplicationCollaboratorRequest{
+			ApplicationIdentifiers: created.ApplicationIdentifiers,
+			Collaborator: ttnpb.Collaborator{
+				OrganizationOrUserIdentifiers: *userID.OrganizationOrUserIdentifiers(),
+				Rights:                        []ttnpb.Right{ttnpb.RIGHT_APPLICATION_INFO},
+			},
+		}, creds)
+
+		a.So(err, should.BeNil)
+	})
+}
+
 func TestApplicationAccessRights(t *testing.T) {
 	a := assertions.New(t)
 	ctx := test.Context()
@@ -559,3 +621,201 @@ func TestApplicationAccessRights(t *testing.T) {
 		}
 	})
 }
+
+func TestApplicationAPIKeyExpiryAndRestrictions(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+
+	testWithIdentityServer(t, func(is *IdentityServer, cc *grpc.ClientConn) {
+		is.config.APIKeys.LastUsedInterval = time.Minute
+
+		userID, creds := defaultUser.UserIdentifiers, userCreds(defaultUserIdx)
+		applicationID := userApplications(&userID).Applications[0].ApplicationIdentifiers
+
+		reg := ttnpb.NewApplicationAccessClient(cc)
+		devReg := ttnpb.NewEndDeviceRegistryClient(cc)
+		entityAccess := ttnpb.NewEntityAccessClient(cc)
+
+		past, future := time.Now().Add(-time.Hour), time.Now().Add(time.Hour)
+
+		_, err := reg.CreateAPIKey(ctx, &ttnpb.CreateApplicationAPIKeyRequest{
+			ApplicationIdentifiers: applicationID,
+			Rights:                 []ttnpb.Right{ttnpb.RIGHT_APPLICATION_INFO},
+			ExpiresAt:              &past,
+		}, creds)
+
+		if a.So(err, should.NotBeNil) {
+			a.So(errors.IsInvalidArgument(err), should.BeTrue)
+		}
+
+		_, err = reg.CreateAPIKey(ctx, &ttnpb.CreateApplicationAPIKeyRequest{
+			ApplicationIdentifiers: applicationID,
+			Rights:                 []ttnpb.Right{ttnpb.RIGHT_APPLICATION_INFO},
+			Restrictions:           &ttnpb.APIKeyRestrictions{SourceCIDRs: []string{"not-a-cidr"}},
+		}, creds)
+
+		if a.So(err, should.NotBeNil) {
+			a.So(errors.IsInvalidArgument(err), should.BeTrue)
+		}
+
+		created, err := reg.CreateAPIKey(ctx, &ttnpb.CreateApplicationAPIKeyRequest{
+			ApplicationIdentifiers: applicationID,
+			Name:                   "restricted",
+			Rights:                 []ttnpb.Right{ttnpb.RIGHT_APPLICATION_INFO, ttnpb.RIGHT_APPLICATION_DEVICES_READ},
+			ExpiresAt:              &future,
+			Restrictions: &ttnpb.APIKeyRestrictions{
+				SourceCIDRs:  []string{"192.0.2.0/24"},
+				EndDeviceIDs: []string{"restricted-dev"},
+			},
+		}, creds)
+
+		a.So(err, should.BeNil)
+		if !a.So(created, should.NotBeNil) {
+			t.FailNow()
+		}
+
+		restrictedCreds := grpc.PerRPCCredentials(rpcmetadata.MD{
+			AuthType:      "bearer",
+			AuthValue:     created.Key,
+			AllowInsecure: true,
+		})
+
+		_, err = devReg.Create(ctx, &ttnpb.CreateEndDeviceRequest{
+			EndDevice: ttnpb.EndDevice{
+				EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+					ApplicationIdentifiers: applicationID,
+					DeviceID:               "restricted-dev",
+				},
+			},
+		}, creds)
+
+		a.So(err, should.BeNil)
+
+		// The restrictions are returned with the rights, so that they can be enforced
+		// by the component that handles the original request.
+		restrictedRights, err := reg.ListRights(ctx, &applicationID, restrictedCreds)
+
+		if a.So(err, should.BeNil) && a.So(restrictedRights.Restrictions, should.NotBeNil) {
+			a.So(restrictedRights.Restrictions.SourceCIDRs, should.Resemble, []string{"192.0.2.0/24"})
+			a.So(restrictedRights.Restrictions.EndDeviceIDs, should.Resemble, []string{"restricted-dev"})
+		}
+
+		_, err = devReg.Get(ctx, &ttnpb.GetEndDeviceRequest{
+			EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+				ApplicationIdentifiers: applicationID,
+				DeviceID:               "restricted-dev",
+			},
+			FieldMask: types.FieldMask{Paths: []string{"name"}},
+		}, restrictedCreds)
+
+		if a.So(err, should.NotBeNil) {
+			a.So(errors.IsPermissionDenied(err), should.BeTrue)
+		}
+
+		allowedCtx := metadata.AppendToOutgoingContext(ctx, "x-forwarded-for", "192.0.2.1")
+
+		var md metadata.MD
+		_, err = entityAccess.AuthInfo(allowedCtx, ttnpb.Empty, restrictedCreds, grpc.Header(&md))
+
+		a.So(err, should.BeNil)
+		a.So(md.Get("auth-expires-at"), should.HaveLength, 1)
+
+		_, err = devReg.Get(allowedCtx, &ttnpb.GetEndDeviceRequest{
+			EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+				ApplicationIdentifiers: applicationID,
+				DeviceID:               "restricted-dev",
+			},
+			FieldMask: types.FieldMask{Paths: []string{"name"}},
+		}, restrictedCreds)
+
+		a.So(err, should.BeNil)
+
+		_, err = devReg.Get(allowedCtx, &ttnpb.GetEndDeviceRequest{
+			EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+				ApplicationIdentifiers: applicationID,
+				DeviceID:               "other-dev",
+			},
+			FieldMask: types.FieldMask{Paths: []string{"name"}},
+		}, restrictedCreds)
+
+		if a.So(err, should.NotBeNil) {
+			a.So(errors.IsPermissionDenied(err), should.BeTrue)
+		}
+
+		list, err := devReg.List(allowedCtx, &ttnpb.ListEndDevicesRequest{
+			ApplicationIdentifiers: applicationID,
+		}, restrictedCreds)
+
+		if a.So(err, should.BeNil) && a.So(list.EndDevices, should.HaveLength, 1) {
+			a.So(list.EndDevices[0].DeviceID, should.Equal, "restricted-dev")
+		}
+
+		err = is.flushAPIKeyUsage(ctx)
+
+		a.So(err, should.BeNil)
+
+		got, err := reg.GetAPIKey(ctx, &ttnpb.GetApplicationAPIKeyRequest{
+			ApplicationIdentifiers: applicationID,
+			KeyID:                  created.ID,
+		}, creds)
+
+		if a.So(err, should.BeNil) {
+			a.So(got.LastUsedAt, should.NotBeNil)
+		}
+
+		_, err = reg.UpdateAPIKey(ctx, &ttnpb.UpdateApplicationAPIKeyRequest{
+			ApplicationIdentifiers: applicationID,
+			APIKey: ttnpb.APIKey{
+				ID:        created.ID,
+				ExpiresAt: &past,
+			},
+			FieldMask: types.FieldMask{Paths: []string{"expires_at"}},
+		}, creds)
+
+		if a.So(err, should.NotBeNil) {
+			a.So(errors.IsInvalidArgument(err), should.BeTrue)
+		}
+
+		// Updating only the restrictions keeps the name, rights and expiry.
+		updated, err := reg.UpdateAPIKey(ctx, &ttnpb.UpdateApplicationAPIKeyRequest{
+			ApplicationIdentifiers: applicationID,
+			APIKey: ttnpb.APIKey{
+				ID: created.ID,
+				Restrictions: &ttnpb.APIKeyRestrictions{
+					EndDeviceIDs: []string{"restricted-dev"},
+				},
+			},
+			FieldMask: types.FieldMask{Paths: []string{"restrictions"}},
+		}, creds)
+
+		if a.So(err, should.BeNil) && a.So(updated, should.NotBeNil) {
+			a.So(updated.Name, should.Equal, created.Name)
+			a.So(updated.Rights, should.Resemble, created.Rights)
+			a.So(updated.ExpiresAt, should.NotBeNil)
+			a.So(updated.Restrictions.GetSourceCIDRs(), should.BeEmpty)
+		}
+
+		_, err = entityAccess.AuthInfo(ctx, ttnpb.Empty, restrictedCreds)
+
+		a.So(err, should.BeNil)
+
+		// The API only accepts an expiry in the future, so expire the API key in the database.
+		err = is.withDatabase(ctx, func(db *gorm.DB) error {
+			_, err := store.GetAPIKeyStore(db).UpdateAPIKey(ctx, applicationID, &ttnpb.APIKey{
+				ID:        created.ID,
+				Name:      created.Name,
+				Rights:    created.Rights,
+				ExpiresAt: &past,
+			})
+			return err
+		})
+
+		a.So(err, should.BeNil)
+
+		_, err = entityAccess.AuthInfo(ctx, ttnpb.Empty, restrictedCreds)
+
+		if a.So(err, should.NotBeNil) {
+			a.So(errors.IsUnauthenticated(err), should.BeTrue)
+		}
+	})
+}
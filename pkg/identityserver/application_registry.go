@@ -40,6 +40,14 @@ var (
 		"application.delete", "delete application",
 		ttnpb.RIGHT_APPLICATION_INFO,
 	)
+	evtRestoreApplication = events.Define(
+		"application.restore", "restore application",
+		ttnpb.RIGHT_APPLICATION_INFO,
+	)
+	evtPurgeApplication = events.Define(
+		"application.purge", "purge application",
+		ttnpb.RIGHT_APPLICATION_INFO,
+	)
 )
 
 func (is *IdentityServer) createApplication(ctx context.Context, req *ttnpb.CreateApplicationRequest) (app *ttnpb.Application, err error) {
@@ -120,8 +128,13 @@ func (is *IdentityServer) getApplication(ctx context.Context, req *ttnpb.GetAppl
 
 func (is *IdentityServer) listApplications(ctx context.Context, req *ttnpb.ListApplicationsRequest) (apps *ttnpb.Applications, err error) {
 	req.FieldMask.Paths = cleanFieldMaskPaths(ttnpb.ApplicationFieldPathsNested, req.FieldMask.Paths, getPaths, nil)
+	if req.Deleted {
+		if err = is.RequireAdmin(ctx); err != nil {
+			return nil, err
+		}
+	}
 	var includeIndirect bool
-	if req.Collaborator == nil {
+	if req.Collaborator == nil && !req.Deleted {
 		authInfo, err := is.authInfo(ctx)
 		if err != nil {
 			return nil, err
@@ -143,8 +156,12 @@ func (is *IdentityServer) listApplications(ctx context.Context, req *ttnpb.ListA
 		}
 	}
 	ctx = store.WithOrder(ctx, req.Order)
+	findCtx := ctx
+	if req.Deleted {
+		findCtx = store.WithSoftDeleted(ctx, true)
+	}
 	var total uint64
-	paginateCtx := store.WithPagination(ctx, req.Limit, req.Page, &total)
+	paginateCtx := store.WithPagination(findCtx, req.Limit, req.Page, &total)
 	defer func() {
 		if err == nil {
 			setTotalHeader(ctx, total)
@@ -152,6 +169,10 @@ func (is *IdentityServer) listApplications(ctx context.Context, req *ttnpb.ListA
 	}()
 	apps = &ttnpb.Applications{}
 	err = is.withDatabase(ctx, func(db *gorm.DB) error {
+		if req.Collaborator == nil {
+			apps.Applications, err = store.GetApplicationStore(db).FindApplications(paginateCtx, nil, &req.FieldMask)
+			return err
+		}
 		ids, err := is.getMembershipStore(ctx, db).FindMemberships(paginateCtx, req.Collaborator, "application", includeIndirect)
 		if err != nil {
 			return err
@@ -165,7 +186,7 @@ func (is *IdentityServer) listApplications(ctx context.Context, req *ttnpb.ListA
 				appIDs = append(appIDs, appID)
 			}
 		}
-		apps.Applications, err = store.GetApplicationStore(db).FindApplications(ctx, appIDs, &req.FieldMask)
+		apps.Applications, err = store.GetApplicationStore(db).FindApplications(findCtx, appIDs, &req.FieldMask)
 		if err != nil {
 			return err
 		}
@@ -175,6 +196,10 @@ func (is *IdentityServer) listApplications(ctx context.Context, req *ttnpb.ListA
 		return nil, err
 	}
 
+	if req.Deleted {
+		return apps, nil
+	}
+
 	for i, app := range apps.Applications {
 		if rights.RequireApplication(ctx, app.ApplicationIdentifiers, ttnpb.RIGHT_APPLICATION_INFO) != nil {
 			apps.Applications[i] = app.PublicSafe()
@@ -241,6 +266,30 @@ func (is *IdentityServer) deleteApplication(ctx context.Context, ids *ttnpb.Appl
 	return ttnpb.Empty, nil
 }
 
+func (is *IdentityServer) restoreApplication(ctx context.Context, ids *ttnpb.ApplicationIdentifiers) (*types.Empty, error) {
+	if err := rights.RequireApplication(store.WithSoftDeleted(ctx, false), *ids, ttnpb.RIGHT_APPLICATION_DELETE); err != nil {
+		return nil, err
+	}
+	err := is.withDatabase(ctx, func(db *gorm.DB) error {
+		return store.GetApplicationStore(db).RestoreApplication(ctx, ids)
+	})
+	if err != nil {
+		return nil, err
+	}
+	events.Publish(evtRestoreApplication(ctx, ids, nil))
+	return ttnpb.Empty, nil
+}
+
+func (is *IdentityServer) purgeApplication(ctx context.Context, ids *ttnpb.ApplicationIdentifiers) (*types.Empty, error) {
+	if err := is.RequireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	if err := is.purgeEntity(ctx, ids); err != nil {
+		return nil, err
+	}
+	return ttnpb.Empty, nil
+}
+
 type applicationRegistry struct {
 	*IdentityServer
 }
@@ -264,3 +313,11 @@ func (ar *applicationRegistry) Update(ctx context.Context, req *ttnpb.UpdateAppl
 func (ar *applicationRegistry) Delete(ctx context.Context, req *ttnpb.ApplicationIdentifiers) (*types.Empty, error) {
 	return ar.deleteApplication(ctx, req)
 }
+
+func (ar *applicationRegistry) Restore(ctx context.Context, req *ttnpb.ApplicationIdentifiers) (*types.Empty, error) {
+	return ar.restoreApplication(ctx, req)
+}
+
+func (ar *applicationRegistry) Purge(ctx context.Context, req *ttnpb.ApplicationIdentifiers) (*types.Empty, error) {
+	return ar.purgeApplication(ctx, req)
+}
@@ -200,6 +200,65 @@ func TestApplicationsCRUD(t *testing.T) {
 		_, err = reg.Delete(ctx, &created.ApplicationIdentifiers, creds)
 
 		a.So(err, should.BeNil)
+
+		_, err = reg.List(ctx, &ttnpb.ListApplicationsRequest{
+			FieldMask: types.FieldMask{Paths: []string{"name"}},
+			Deleted:   true,
+		}, creds)
+
+		if a.So(err, should.NotBeNil) {
+			a.So(errors.IsPermissionDenied(err), should.BeTrue)
+		}
+
+		list, err := reg.List(ctx, &ttnpb.ListApplicationsRequest{
+			FieldMask: types.FieldMask{Paths: []string{"name"}},
+			Deleted:   true,
+		}, userCreds(adminUserIdx))
+
+		a.So(err, should.BeNil)
+		if a.So(list, should.NotBeNil) && a.So(list.Applications, should.NotBeEmpty) {
+			var found bool
+			for _, item := range list.Applications {
+				if item.ApplicationIdentifiers == created.ApplicationIdentifiers {
+					found = true
+				}
+			}
+			a.So(found, should.BeTrue)
+		}
+
+		_, err = reg.Restore(ctx, &created.ApplicationIdentifiers, creds)
+
+		a.So(err, should.BeNil)
+
+		got, err = reg.Get(ctx, &ttnpb.GetApplicationRequest{
+			ApplicationIdentifiers: created.ApplicationIdentifiers,
+			FieldMask:              types.FieldMask{Paths: []string{"name"}},
+		}, creds)
+
+		a.So(err, should.BeNil)
+		if a.So(got, should.NotBeNil) {
+			a.So(got.Name, should.Equal, updated.Name)
+		}
+
+		_, err = reg.Delete(ctx, &created.ApplicationIdentifiers, creds)
+
+		a.So(err, should.BeNil)
+
+		_, err = reg.Purge(ctx, &created.ApplicationIdentifiers, creds)
+
+		if a.So(err, should.NotBeNil) {
+			a.So(errors.IsPermissionDenied(err), should.BeTrue)
+		}
+
+		_, err = reg.Purge(ctx, &created.ApplicationIdentifiers, userCreds(adminUserIdx))
+
+		a.So(err, should.BeNil)
+
+		_, err = reg.Restore(ctx, &created.ApplicationIdentifiers, userCreds(adminUserIdx))
+
+		if a.So(err, should.NotBeNil) {
+			a.So(errors.IsNotFound(err), should.BeTrue)
+		}
 	})
 }
 
@@ -20,12 +20,9 @@ import (
 	"github.com/gogo/protobuf/types"
 	"github.com/jinzhu/gorm"
 	"go.thethings.network/lorawan-stack/pkg/auth/rights"
-	"go.thethings.network/lorawan-stack/pkg/email"
 	"go.thethings.network/lorawan-stack/pkg/errors"
 	"go.thethings.network/lorawan-stack/pkg/events"
-	"go.thethings.network/lorawan-stack/pkg/identityserver/emails"
 	"go.thethings.network/lorawan-stack/pkg/identityserver/store"
-	"go.thethings.network/lorawan-stack/pkg/log"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
 )
 
@@ -47,7 +44,9 @@ func (is *IdentityServer) listClientRights(ctx context.Context, ids *ttnpb.Clien
 	if err != nil {
 		return nil, err
 	}
-	return cliRights.Intersect(ttnpb.AllClientRights), nil
+	res := cliRights.Intersect(ttnpb.AllClientRights)
+	res.Restrictions = cliRights.GetRestrictions()
+	return res, nil
 }
 
 func (is *IdentityServer) getClientCollaborator(ctx context.Context, req *ttnpb.GetClientCollaboratorRequest) (*ttnpb.GetCollaboratorResponse, error) {
@@ -82,11 +81,11 @@ func (is *IdentityServer) setClientCollaborator(ctx context.Context, req *ttnpb.
 	}
 
 	err := is.withDatabase(ctx, func(db *gorm.DB) error {
-		store := is.getMembershipStore(ctx, db)
+		membershipStore := is.getMembershipStore(ctx, db)
+		newRights := ttnpb.RightsFrom(req.Collaborator.Rights...)
 
 		if len(req.Collaborator.Rights) > 0 {
-			newRights := ttnpb.RightsFrom(req.Collaborator.Rights...)
-			existingRights, err := store.GetMember(
+			existingRights, err := membershipStore.GetMember(
 				ctx,
 				&req.Collaborator.OrganizationOrUserIdentifiers,
 				req.ClientIdentifiers,
@@ -105,11 +104,16 @@ func (is *IdentityServer) setClientCollaborator(ctx context.Context, req *ttnpb.
 			}
 		}
 
-		return store.SetMember(
+		// Do not leave the client without a member with all rights.
+		if err := store.CheckLastOwner(ctx, membershipStore, &req.Collaborator.OrganizationOrUserIdentifiers, req.ClientIdentifiers, newRights); err != nil {
+			return err
+		}
+
+		return membershipStore.SetMember(
 			ctx,
 			&req.Collaborator.OrganizationOrUserIdentifiers,
 			req.ClientIdentifiers,
-			ttnpb.RightsFrom(req.Collaborator.Rights...),
+			newRights,
 		)
 	})
 	if err != nil {
@@ -117,13 +121,12 @@ func (is *IdentityServer) setClientCollaborator(ctx context.Context, req *ttnpb.
 	}
 	if len(req.Collaborator.Rights) > 0 {
 		events.Publish(evtUpdateClientCollaborator(ctx, ttnpb.CombineIdentifiers(req.ClientIdentifiers, req.Collaborator), nil))
-		err = is.SendContactsEmail(ctx, req.EntityIdentifiers(), func(data emails.Data) email.MessageData {
-			data.SetEntity(req.EntityIdentifiers())
-			return &emails.CollaboratorChanged{Data: data, Collaborator: req.Collaborator}
-		})
-		if err != nil {
-			log.FromContext(ctx).WithError(err).Error("Could not send collaborator updated notification email")
-		}
+		is.notify(ctx, &ttnpb.Notification{
+			NotificationType: ttnpb.NOTIFICATION_TYPE_COLLABORATOR_CHANGED,
+			EntityIDs:        *req.EntityIdentifiers(),
+			Subject:          req.Collaborator.IDString(),
+			Rights:           req.Collaborator.Rights,
+		}, req.Collaborator.GetUserIDs())
 	} else {
 		events.Publish(evtDeleteClientCollaborator(ctx, ttnpb.CombineIdentifiers(req.ClientIdentifiers, req.Collaborator), nil))
 	}
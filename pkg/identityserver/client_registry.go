@@ -45,6 +45,14 @@ var (
 		"client.delete", "delete OAuth client",
 		ttnpb.RIGHT_CLIENT_ALL,
 	)
+	evtRestoreClient = events.Define(
+		"client.restore", "restore OAuth client",
+		ttnpb.RIGHT_CLIENT_ALL,
+	)
+	evtPurgeClient = events.Define(
+		"client.purge", "purge OAuth client",
+		ttnpb.RIGHT_CLIENT_ALL,
+	)
 )
 
 func (is *IdentityServer) createClient(ctx context.Context, req *ttnpb.CreateClientRequest) (cli *ttnpb.Client, err error) {
@@ -114,6 +122,12 @@ func (is *IdentityServer) createClient(ctx context.Context, req *ttnpb.CreateCli
 	cli.Secret = secret // Return the unhashed secret, in case it was generated.
 
 	events.Publish(evtCreateClient(ctx, req.ClientIdentifiers, nil))
+	if cli.State == ttnpb.STATE_REQUESTED {
+		is.notify(ctx, &ttnpb.Notification{
+			NotificationType: ttnpb.NOTIFICATION_TYPE_APPROVAL_REQUESTED,
+			EntityIDs:        *req.ClientIdentifiers.EntityIdentifiers(),
+		})
+	}
 	return cli, nil
 }
 
@@ -150,8 +164,13 @@ func (is *IdentityServer) getClient(ctx context.Context, req *ttnpb.GetClientReq
 
 func (is *IdentityServer) listClients(ctx context.Context, req *ttnpb.ListClientsRequest) (clis *ttnpb.Clients, err error) {
 	req.FieldMask.Paths = cleanFieldMaskPaths(ttnpb.ClientFieldPathsNested, req.FieldMask.Paths, getPaths, nil)
+	if req.Deleted {
+		if err = is.RequireAdmin(ctx); err != nil {
+			return nil, err
+		}
+	}
 	var includeIndirect bool
-	if req.Collaborator == nil {
+	if req.Collaborator == nil && !req.Deleted {
 		authInfo, err := is.authInfo(ctx)
 		if err != nil {
 			return nil, err
@@ -173,8 +192,12 @@ func (is *IdentityServer) listClients(ctx context.Context, req *ttnpb.ListClient
 		}
 	}
 	ctx = store.WithOrder(ctx, req.Order)
+	findCtx := ctx
+	if req.Deleted {
+		findCtx = store.WithSoftDeleted(ctx, true)
+	}
 	var total uint64
-	paginateCtx := store.WithPagination(ctx, req.Limit, req.Page, &total)
+	paginateCtx := store.WithPagination(findCtx, req.Limit, req.Page, &total)
 	defer func() {
 		if err == nil {
 			setTotalHeader(ctx, total)
@@ -182,6 +205,10 @@ func (is *IdentityServer) listClients(ctx context.Context, req *ttnpb.ListClient
 	}()
 	clis = &ttnpb.Clients{}
 	err = is.withDatabase(ctx, func(db *gorm.DB) error {
+		if req.Collaborator == nil {
+			clis.Clients, err = store.GetClientStore(db).FindClients(paginateCtx, nil, &req.FieldMask)
+			return err
+		}
 		ids, err := is.getMembershipStore(ctx, db).FindMemberships(paginateCtx, req.Collaborator, "client", includeIndirect)
 		if err != nil {
 			return err
@@ -195,7 +222,7 @@ func (is *IdentityServer) listClients(ctx context.Context, req *ttnpb.ListClient
 				cliIDs = append(cliIDs, cliID)
 			}
 		}
-		clis.Clients, err = store.GetClientStore(db).FindClients(ctx, cliIDs, &req.FieldMask)
+		clis.Clients, err = store.GetClientStore(db).FindClients(findCtx, cliIDs, &req.FieldMask)
 		if err != nil {
 			return err
 		}
@@ -205,6 +232,10 @@ func (is *IdentityServer) listClients(ctx context.Context, req *ttnpb.ListClient
 		return nil, err
 	}
 
+	if req.Deleted {
+		return clis, nil
+	}
+
 	for i, cli := range clis.Clients {
 		if rights.RequireClient(ctx, cli.ClientIdentifiers, ttnpb.RIGHT_CLIENT_ALL) != nil {
 			clis.Clients[i] = cli.PublicSafe()
@@ -235,7 +266,7 @@ func (is *IdentityServer) updateClient(ctx context.Context, req *ttnpb.UpdateCli
 		for _, path := range req.FieldMask.Paths {
 			switch path {
 			case "state", "skip_authorization", "endorsed", "grants":
-				return nil, errUpdateUserAdminField.WithAttributes("field", path)
+				return nil, errUpdateClientAdminField.WithAttributes("field", path)
 			}
 		}
 	}
@@ -284,6 +315,30 @@ func (is *IdentityServer) deleteClient(ctx context.Context, ids *ttnpb.ClientIde
 	return ttnpb.Empty, nil
 }
 
+func (is *IdentityServer) restoreClient(ctx context.Context, ids *ttnpb.ClientIdentifiers) (*types.Empty, error) {
+	if err := rights.RequireClient(store.WithSoftDeleted(ctx, false), *ids, ttnpb.RIGHT_CLIENT_ALL); err != nil {
+		return nil, err
+	}
+	err := is.withDatabase(ctx, func(db *gorm.DB) error {
+		return store.GetClientStore(db).RestoreClient(ctx, ids)
+	})
+	if err != nil {
+		return nil, err
+	}
+	events.Publish(evtRestoreClient(ctx, ids, nil))
+	return ttnpb.Empty, nil
+}
+
+func (is *IdentityServer) purgeClient(ctx context.Context, ids *ttnpb.ClientIdentifiers) (*types.Empty, error) {
+	if err := is.RequireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	if err := is.purgeEntity(ctx, ids); err != nil {
+		return nil, err
+	}
+	return ttnpb.Empty, nil
+}
+
 type clientRegistry struct {
 	*IdentityServer
 }
@@ -307,3 +362,11 @@ func (cr *clientRegistry) Update(ctx context.Context, req *ttnpb.UpdateClientReq
 func (cr *clientRegistry) Delete(ctx context.Context, req *ttnpb.ClientIdentifiers) (*types.Empty, error) {
 	return cr.deleteClient(ctx, req)
 }
+
+func (cr *clientRegistry) Restore(ctx context.Context, req *ttnpb.ClientIdentifiers) (*types.Empty, error) {
+	return cr.restoreClient(ctx, req)
+}
+
+func (cr *clientRegistry) Purge(ctx context.Context, req *ttnpb.ClientIdentifiers) (*types.Empty, error) {
+	return cr.purgeClient(ctx, req)
+}
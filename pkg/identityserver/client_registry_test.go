@@ -203,6 +203,100 @@ func TestClientsCRUD(t *testing.T) {
 	})
 }
 
+func TestClientsOrganizationCollaborator(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+
+	testWithIdentityServer(t, func(is *IdentityServer, cc *grpc.ClientConn) {
+		reg := ttnpb.NewClientRegistryClient(cc)
+
+		userID, creds := defaultUser.UserIdentifiers, userCreds(defaultUserIdx)
+		organizationID := userOrganizations(&userID).Organizations[0].OrganizationIdentifiers
+
+		created, err := reg.Create(ctx, &ttnpb.CreateClientRequest{
+			Client: ttnpb.Client{
+				ClientIdentifiers: ttnpb.ClientIdentifiers{ClientID: "foo-org-cli"},
+				Name:              "Foo Organization Client",
+				State:             ttnpb.STATE_APPROVED,
+			},
+			Collaborator: *organizationID.OrganizationOrUserIdentifiers(),
+		}, creds)
+
+		a.So(err, should.BeNil)
+		if a.So(created, should.NotBeNil) {
+			a.So(created.State, should.Equal, ttnpb.STATE_REQUESTED)
+		}
+
+		list, err := reg.List(ctx, &ttnpb.ListClientsRequest{
+			FieldMask:    types.FieldMask{Paths: []string{"name"}},
+			Collaborator: organizationID.OrganizationOrUserIdentifiers(),
+		}, creds)
+
+		a.So(err, should.BeNil)
+		if a.So(list, should.NotBeNil) {
+			var found bool
+			for _, item := range list.Clients {
+				if item.ClientIdentifiers == created.ClientIdentifiers {
+					found = true
+					a.So(item.Name, should.Equal, "Foo Organization Client")
+				}
+			}
+			a.So(found, should.BeTrue)
+		}
+
+		updated, err := reg.Update(ctx, &ttnpb.UpdateClientRequest{
+			Client: ttnpb.Client{
+				ClientIdentifiers: created.ClientIdentifiers,
+				Name:              "Updated Name",
+			},
+			FieldMask: types.FieldMask{Paths: []string{"name"}},
+		}, creds)
+
+		a.So(err, should.BeNil)
+		if a.So(updated, should.NotBeNil) {
+			a.So(updated.Name, should.Equal, "Updated Name")
+		}
+
+		_, err = reg.Update(ctx, &ttnpb.UpdateClientRequest{
+			Client: ttnpb.Client{
+				ClientIdentifiers: created.ClientIdentifiers,
+				State:             ttnpb.STATE_APPROVED,
+			},
+			FieldMask: types.FieldMask{Paths: []string{"state"}},
+		}, creds)
+
+		if a.So(err, should.NotBeNil) {
+			a.So(errors.IsPermissionDenied(err), should.BeTrue)
+		}
+
+		// Members of the organization can manage the collaborators of the requested client.
+		access := ttnpb.NewClientAccessClient(cc)
+
+		_, err = access.SetCollaborator(ctx, &ttnpb.SetClientCollaboratorRequest{
+			ClientIdentifiers: created.ClientIdentifiers,
+			Collaborator: ttnpb.Collaborator{
+				OrganizationOrUserIdentifiers: *userID.OrganizationOrUserIdentifiers(),
+				Rights:                        []ttnpb.Right{ttnpb.RIGHT_CLIENT_ALL},
+			},
+		}, creds)
+
+		a.So(err, should.BeNil)
+
+		collaborators, err := access.ListCollaborators(ctx, &ttnpb.ListClientCollaboratorsRequest{
+			ClientIdentifiers: created.ClientIdentifiers,
+		}, creds)
+
+		a.So(err, should.BeNil)
+		if a.So(collaborators, should.NotBeNil) {
+			a.So(collaborators.Collaborators, should.HaveLength, 2)
+		}
+
+		_, err = reg.Delete(ctx, &created.ClientIdentifiers, creds)
+
+		a.So(err, should.BeNil)
+	})
+}
+
 func TestClientsPagination(t *testing.T) {
 	a := assertions.New(t)
 
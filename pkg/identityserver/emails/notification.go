@@ -0,0 +1,58 @@
+// Copyright © 2026 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package emails
+
+import "go.thethings.network/lorawan-stack/pkg/ttnpb"
+
+// Notification is the email that is sent when a notification is delivered by email.
+type Notification struct {
+	Data
+	NotificationType string
+	Subject          string
+	Rights           []ttnpb.Right
+}
+
+// TemplateName returns the name of the template to use for this email.
+func (Notification) TemplateName() string { return "notification" }
+
+const notificationSubject = `New notification about {{.Entity.Type}} {{.Entity.ID}}`
+
+const notificationText = `Dear {{.User.Name}},
+
+{{if eq .NotificationType "collaborator_changed" -}}
+The collaborator "{{.Subject}}" of {{.Entity.Type}} "{{.Entity.ID}}" on {{.Network.Name}} now has the following rights:
+{{range $right := .Rights}} 
+{{$right}} {{end}}
+{{- else if eq .NotificationType "api_key_created" -}}
+A new API key "{{.Subject}}" has been created for {{.Entity.Type}} "{{.Entity.ID}}" on {{.Network.Name}} with the following rights:
+{{range $right := .Rights}} 
+{{$right}} {{end}}
+{{- else if eq .NotificationType "api_key_changed" -}}
+The API key "{{.Subject}}" of {{.Entity.Type}} "{{.Entity.ID}}" on {{.Network.Name}} has been changed and now has the following rights:
+{{range $right := .Rights}} 
+{{$right}} {{end}}
+{{- else if eq .NotificationType "gateway_disconnected" -}}
+The gateway "{{.Entity.ID}}" on {{.Network.Name}} has been disconnected from the Gateway Server and did not reconnect.
+{{- else if eq .NotificationType "approval_requested" -}}
+The {{.Entity.Type}} "{{.Entity.ID}}" on {{.Network.Name}} has been requested and needs to be approved by an admin.
+{{- else -}}
+There is a new notification about {{.Entity.Type}} "{{.Entity.ID}}" on {{.Network.Name}}.
+{{- end}}
+`
+
+// DefaultTemplates returns the default templates for this email.
+func (Notification) DefaultTemplates() (subject, html, text string) {
+	return notificationSubject, "", notificationText
+}
@@ -21,6 +21,7 @@ import (
 	"github.com/gogo/protobuf/types"
 	"github.com/jinzhu/gorm"
 	"go.thethings.network/lorawan-stack/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/pkg/devicebatch"
 	"go.thethings.network/lorawan-stack/pkg/events"
 	"go.thethings.network/lorawan-stack/pkg/identityserver/blacklist"
 	"go.thethings.network/lorawan-stack/pkg/identityserver/store"
@@ -43,7 +44,7 @@ var (
 )
 
 func (is *IdentityServer) createEndDevice(ctx context.Context, req *ttnpb.CreateEndDeviceRequest) (dev *ttnpb.EndDevice, err error) {
-	if err = rights.RequireApplication(ctx, req.EndDeviceIdentifiers.ApplicationIdentifiers, ttnpb.RIGHT_APPLICATION_DEVICES_WRITE); err != nil {
+	if err = rights.RequireEndDevice(ctx, req.EndDeviceIdentifiers, ttnpb.RIGHT_APPLICATION_DEVICES_WRITE); err != nil {
 		return nil, err
 	}
 	if err = blacklist.Check(ctx, req.DeviceID); err != nil {
@@ -72,7 +73,7 @@ func (is *IdentityServer) createEndDevice(ctx context.Context, req *ttnpb.Create
 }
 
 func (is *IdentityServer) getEndDevice(ctx context.Context, req *ttnpb.GetEndDeviceRequest) (dev *ttnpb.EndDevice, err error) {
-	if err = rights.RequireApplication(ctx, req.EndDeviceIdentifiers.ApplicationIdentifiers, ttnpb.RIGHT_APPLICATION_DEVICES_READ); err != nil {
+	if err = rights.RequireEndDevice(ctx, req.EndDeviceIdentifiers, ttnpb.RIGHT_APPLICATION_DEVICES_READ); err != nil {
 		return nil, err
 	}
 
@@ -116,6 +117,10 @@ func (is *IdentityServer) listEndDevices(ctx context.Context, req *ttnpb.ListEnd
 	if err = rights.RequireApplication(ctx, req.ApplicationIdentifiers, ttnpb.RIGHT_APPLICATION_DEVICES_READ); err != nil {
 		return nil, err
 	}
+	restrictedDeviceIDs, err := rights.EndDeviceRestriction(ctx, req.ApplicationIdentifiers)
+	if err != nil {
+		return nil, err
+	}
 	req.FieldMask.Paths = cleanFieldMaskPaths(ttnpb.EndDeviceFieldPathsNested, req.FieldMask.Paths, getPaths, nil)
 	ctx = store.WithOrder(ctx, req.Order)
 	var total uint64
@@ -127,7 +132,15 @@ func (is *IdentityServer) listEndDevices(ctx context.Context, req *ttnpb.ListEnd
 	}()
 	devs = &ttnpb.EndDevices{}
 	err = is.withDatabase(ctx, func(db *gorm.DB) (err error) {
-		devs.EndDevices, err = store.GetEndDeviceStore(db).ListEndDevices(ctx, &req.ApplicationIdentifiers, &req.FieldMask)
+		if len(restrictedDeviceIDs) > 0 {
+			ids := make([]*ttnpb.EndDeviceIdentifiers, len(restrictedDeviceIDs))
+			for i, deviceID := range restrictedDeviceIDs {
+				ids[i] = &ttnpb.EndDeviceIdentifiers{ApplicationIdentifiers: req.ApplicationIdentifiers, DeviceID: deviceID}
+			}
+			devs.EndDevices, err = store.GetEndDeviceStore(db).FindEndDevices(ctx, ids, &req.FieldMask)
+		} else {
+			devs.EndDevices, err = store.GetEndDeviceStore(db).ListEndDevices(ctx, &req.ApplicationIdentifiers, &req.FieldMask)
+		}
 		if err != nil {
 			return err
 		}
@@ -155,7 +168,7 @@ func (is *IdentityServer) setFullEndDevicePictureURL(ctx context.Context, dev *t
 }
 
 func (is *IdentityServer) updateEndDevice(ctx context.Context, req *ttnpb.UpdateEndDeviceRequest) (dev *ttnpb.EndDevice, err error) {
-	if err = rights.RequireApplication(ctx, req.EndDeviceIdentifiers.ApplicationIdentifiers, ttnpb.RIGHT_APPLICATION_DEVICES_WRITE); err != nil {
+	if err = rights.RequireEndDevice(ctx, req.EndDeviceIdentifiers, ttnpb.RIGHT_APPLICATION_DEVICES_WRITE); err != nil {
 		return nil, err
 	}
 	req.FieldMask.Paths = cleanFieldMaskPaths(ttnpb.EndDeviceFieldPathsNested, req.FieldMask.Paths, nil, getPaths)
@@ -187,7 +200,7 @@ func (is *IdentityServer) updateEndDevice(ctx context.Context, req *ttnpb.Update
 }
 
 func (is *IdentityServer) deleteEndDevice(ctx context.Context, ids *ttnpb.EndDeviceIdentifiers) (*types.Empty, error) {
-	if err := rights.RequireApplication(ctx, ids.ApplicationIdentifiers, ttnpb.RIGHT_APPLICATION_DEVICES_WRITE); err != nil {
+	if err := rights.RequireEndDevice(ctx, *ids, ttnpb.RIGHT_APPLICATION_DEVICES_WRITE); err != nil {
 		return nil, err
 	}
 	err := is.withDatabase(ctx, func(db *gorm.DB) error {
@@ -227,3 +240,19 @@ func (dr *endDeviceRegistry) Update(ctx context.Context, req *ttnpb.UpdateEndDev
 func (dr *endDeviceRegistry) Delete(ctx context.Context, req *ttnpb.EndDeviceIdentifiers) (*types.Empty, error) {
 	return dr.deleteEndDevice(ctx, req)
 }
+
+func (dr *endDeviceRegistry) BatchCreate(ctx context.Context, req *ttnpb.BatchCreateEndDevicesRequest) (*ttnpb.BatchEndDevicesResponse, error) {
+	return devicebatch.Create(ctx, req, "/ttn.lorawan.v3.EndDeviceRegistry/Create", dr.createEndDevice)
+}
+
+func (dr *endDeviceRegistry) BatchGet(ctx context.Context, req *ttnpb.BatchGetEndDevicesRequest) (*ttnpb.BatchEndDevicesResponse, error) {
+	return devicebatch.Get(ctx, req, "/ttn.lorawan.v3.EndDeviceRegistry/Get", dr.getEndDevice)
+}
+
+func (dr *endDeviceRegistry) BatchUpdate(ctx context.Context, req *ttnpb.BatchUpdateEndDevicesRequest) (*ttnpb.BatchEndDevicesResponse, error) {
+	return devicebatch.Update(ctx, req, "/ttn.lorawan.v3.EndDeviceRegistry/Update", dr.updateEndDevice)
+}
+
+func (dr *endDeviceRegistry) BatchDelete(ctx context.Context, req *ttnpb.BatchDeleteEndDevicesRequest) (*ttnpb.BatchEndDevicesResponse, error) {
+	return devicebatch.Delete(ctx, req, "/ttn.lorawan.v3.EndDeviceRegistry/Delete", dr.deleteEndDevice)
+}
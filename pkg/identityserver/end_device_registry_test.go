@@ -15,6 +15,7 @@
 package identityserver
 
 import (
+	"fmt"
 	"testing"
 	"time"
 
@@ -225,3 +226,132 @@ func TestEndDevicesCRUD(t *testing.T) {
 		}
 	})
 }
+
+func TestEndDevicesBatch(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+
+	testWithIdentityServer(t, func(is *IdentityServer, cc *grpc.ClientConn) {
+		reg := ttnpb.NewEndDeviceRegistryClient(cc)
+
+		userID := defaultUser.UserIdentifiers
+		creds := userCreds(defaultUserIdx)
+		app := userApplications(&userID).Applications[0]
+		otherAppIDs := ttnpb.ApplicationIdentifiers{ApplicationID: "other-app-id"}
+
+		deviceIDs := func(appIDs ttnpb.ApplicationIdentifiers, devID string) ttnpb.EndDeviceIdentifiers {
+			return ttnpb.EndDeviceIdentifiers{
+				ApplicationIdentifiers: appIDs,
+				DeviceID:               devID,
+			}
+		}
+
+		created, err := reg.BatchCreate(ctx, &ttnpb.BatchCreateEndDevicesRequest{
+			ApplicationIdentifiers: app.ApplicationIdentifiers,
+			Requests: []*ttnpb.CreateEndDeviceRequest{
+				{EndDevice: ttnpb.EndDevice{EndDeviceIdentifiers: deviceIDs(app.ApplicationIdentifiers, "test-device-1"), Name: "test-device-1"}},
+				{EndDevice: ttnpb.EndDevice{EndDeviceIdentifiers: deviceIDs(app.ApplicationIdentifiers, "test-device-2"), Name: "test-device-2"}},
+				{EndDevice: ttnpb.EndDevice{EndDeviceIdentifiers: deviceIDs(otherAppIDs, "test-device-3")}},
+				{EndDevice: ttnpb.EndDevice{EndDeviceIdentifiers: deviceIDs(app.ApplicationIdentifiers, "INVALID")}},
+			},
+		}, creds)
+
+		a.So(err, should.BeNil)
+		if a.So(created, should.NotBeNil) && a.So(created.Results, should.HaveLength, 4) {
+			for i, res := range created.Results[:2] {
+				a.So(res.Error, should.BeNil)
+				if a.So(res.EndDevice, should.NotBeNil) {
+					a.So(res.EndDevice.Name, should.Equal, fmt.Sprintf("test-device-%d", i+1))
+				}
+			}
+			for _, res := range created.Results[2:] {
+				a.So(res.EndDevice, should.BeNil)
+				if a.So(res.Error, should.NotBeNil) {
+					a.So(errors.IsInvalidArgument(ttnpb.ErrorDetailsFromProto(res.Error)), should.BeTrue)
+				}
+			}
+		}
+
+		getRequests := []*ttnpb.GetEndDeviceRequest{
+			{EndDeviceIdentifiers: deviceIDs(app.ApplicationIdentifiers, "test-device-1"), FieldMask: pbtypes.FieldMask{Paths: []string{"name"}}},
+			{EndDeviceIdentifiers: deviceIDs(app.ApplicationIdentifiers, "test-device-3"), FieldMask: pbtypes.FieldMask{Paths: []string{"name"}}},
+		}
+
+		got, err := reg.BatchGet(ctx, &ttnpb.BatchGetEndDevicesRequest{
+			ApplicationIdentifiers: app.ApplicationIdentifiers,
+			Requests:               getRequests,
+		}, creds)
+
+		a.So(err, should.BeNil)
+		if a.So(got, should.NotBeNil) && a.So(got.Results, should.HaveLength, 2) {
+			a.So(got.Results[0].Error, should.BeNil)
+			if a.So(got.Results[0].EndDevice, should.NotBeNil) {
+				a.So(got.Results[0].EndDevice.Name, should.Equal, "test-device-1")
+			}
+			if a.So(got.Results[1].Error, should.NotBeNil) {
+				a.So(errors.IsNotFound(ttnpb.ErrorDetailsFromProto(got.Results[1].Error)), should.BeTrue)
+			}
+		}
+
+		// Rights are checked for each request of the batch.
+		got, err = reg.BatchGet(ctx, &ttnpb.BatchGetEndDevicesRequest{
+			ApplicationIdentifiers: app.ApplicationIdentifiers,
+			Requests:               getRequests,
+		})
+
+		a.So(err, should.BeNil)
+		if a.So(got, should.NotBeNil) && a.So(got.Results, should.HaveLength, 2) {
+			for _, res := range got.Results {
+				a.So(res.EndDevice, should.BeNil)
+				if a.So(res.Error, should.NotBeNil) {
+					a.So(errors.IsPermissionDenied(ttnpb.ErrorDetailsFromProto(res.Error)), should.BeTrue)
+				}
+			}
+		}
+
+		tooMany := make([]*ttnpb.GetEndDeviceRequest, 101)
+		for i := range tooMany {
+			tooMany[i] = &ttnpb.GetEndDeviceRequest{
+				EndDeviceIdentifiers: deviceIDs(app.ApplicationIdentifiers, fmt.Sprintf("test-device-%d", i)),
+				FieldMask:            pbtypes.FieldMask{Paths: []string{"name"}},
+			}
+		}
+
+		_, err = reg.BatchGet(ctx, &ttnpb.BatchGetEndDevicesRequest{
+			ApplicationIdentifiers: app.ApplicationIdentifiers,
+			Requests:               tooMany,
+		}, creds)
+
+		if a.So(err, should.NotBeNil) {
+			a.So(errors.IsInvalidArgument(err), should.BeTrue)
+		}
+
+		deleted, err := reg.BatchDelete(ctx, &ttnpb.BatchDeleteEndDevicesRequest{
+			ApplicationIdentifiers: app.ApplicationIdentifiers,
+			EndDeviceIDs: []*ttnpb.EndDeviceIdentifiers{
+				{ApplicationIdentifiers: app.ApplicationIdentifiers, DeviceID: "test-device-1"},
+				{ApplicationIdentifiers: app.ApplicationIdentifiers, DeviceID: "test-device-2"},
+				{ApplicationIdentifiers: otherAppIDs, DeviceID: "test-device-1"},
+			},
+		}, creds)
+
+		a.So(err, should.BeNil)
+		if a.So(deleted, should.NotBeNil) && a.So(deleted.Results, should.HaveLength, 3) {
+			a.So(deleted.Results[0].Error, should.BeNil)
+			a.So(deleted.Results[1].Error, should.BeNil)
+			if a.So(deleted.Results[2].Error, should.NotBeNil) {
+				a.So(errors.IsInvalidArgument(ttnpb.ErrorDetailsFromProto(deleted.Results[2].Error)), should.BeTrue)
+			}
+		}
+
+		list, err := reg.List(ctx, &ttnpb.ListEndDevicesRequest{
+			FieldMask:              pbtypes.FieldMask{Paths: []string{"name"}},
+			ApplicationIdentifiers: app.ApplicationIdentifiers,
+		}, creds)
+
+		a.So(err, should.BeNil)
+		if a.So(list, should.NotBeNil) {
+			a.So(list.EndDevices, should.BeEmpty)
+		}
+	})
+}
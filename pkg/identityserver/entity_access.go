@@ -25,6 +25,7 @@ import (
 	"github.com/jinzhu/gorm"
 	"go.thethings.network/lorawan-stack/pkg/auth"
 	clusterauth "go.thethings.network/lorawan-stack/pkg/auth/cluster"
+	"go.thethings.network/lorawan-stack/pkg/auth/rights"
 	"go.thethings.network/lorawan-stack/pkg/errors"
 	"go.thethings.network/lorawan-stack/pkg/identityserver/store"
 	"go.thethings.network/lorawan-stack/pkg/rpcmetadata"
@@ -36,6 +37,7 @@ var (
 	errUnauthenticated          = errors.DefineUnauthenticated("unauthenticated", "unauthenticated")
 	errUnsupportedAuthorization = errors.DefineUnauthenticated("unsupported_authorization", "unsupported authorization method")
 	errAPIKeyNotFound           = errors.DefineUnauthenticated("api_key_not_found", "API key not found")
+	errAPIKeyExpired            = errors.DefineUnauthenticated("api_key_expired", "API key expired")
 	errInvalidAuthorization     = errors.DefineUnauthenticated("invalid_authorization", "invalid authorization")
 	errTokenNotFound            = errors.DefineUnauthenticated("token_not_found", "access token not found")
 	errTokenExpired             = errors.DefineUnauthenticated("token_expired", "access token expired")
@@ -120,6 +122,9 @@ func (is *IdentityServer) authInfo(ctx context.Context) (info *ttnpb.AuthInfoRes
 			if !valid {
 				return errInvalidAuthorization
 			}
+			if apiKey.ExpiresAt != nil && apiKey.ExpiresAt.Before(time.Now()) {
+				return errAPIKeyExpired
+			}
 			apiKey.Key = ""
 			apiKey.Rights = ttnpb.RightsFrom(apiKey.Rights...).Implied().GetRights()
 			res.AccessMethod = &ttnpb.AuthInfoResponse_APIKey{
@@ -205,6 +210,17 @@ func (is *IdentityServer) authInfo(ctx context.Context) (info *ttnpb.AuthInfoRes
 		return nil, err
 	}
 
+	if apiKey := res.GetAPIKey(); apiKey != nil {
+		if is.configFromContext(ctx).APIKeys.LastUsedInterval > 0 {
+			is.apiKeyUsage.record(apiKey.ID, time.Now())
+		}
+		if apiKey.ExpiresAt != nil {
+			rights.SetAuthExpiry(ctx, *apiKey.ExpiresAt)
+		}
+	} else if accessToken := res.GetOAuthAccessToken(); accessToken != nil {
+		rights.SetAuthExpiry(ctx, accessToken.ExpiresAt)
+	}
+
 	if user != nil {
 		if user.Admin {
 			res.IsAdmin = true
@@ -217,6 +233,23 @@ func (is *IdentityServer) authInfo(ctx context.Context) (info *ttnpb.AuthInfoRes
 			warning.Add(ctx, "Restricted rights until email address validated")
 		}
 
+		var missingTOTP bool
+		if conf := is.configFromContext(ctx).TwoFactor; conf.RequiredForAdmins || len(conf.RequiredForOrganizations) > 0 {
+			err = is.withDatabase(ctx, func(db *gorm.DB) (err error) {
+				missingTOTP, err = is.missingRequiredTOTP(ctx, db, user)
+				return err
+			})
+			if err != nil {
+				return nil, err
+			}
+		}
+		if missingTOTP {
+			// Go to profile page, enable two-factor authentication.
+			res.IsAdmin = false
+			restrictRights(res, ttnpb.RightsFrom(ttnpb.RIGHT_USER_INFO, ttnpb.RIGHT_USER_SETTINGS_BASIC))
+			warning.Add(ctx, "Restricted rights until two-factor authentication enabled")
+		}
+
 		switch user.State {
 		case ttnpb.STATE_REQUESTED:
 			// Go to profile page, edit basic settings (such as email), delete account.
@@ -20,12 +20,9 @@ import (
 	"github.com/gogo/protobuf/types"
 	"github.com/jinzhu/gorm"
 	"go.thethings.network/lorawan-stack/pkg/auth/rights"
-	"go.thethings.network/lorawan-stack/pkg/email"
 	"go.thethings.network/lorawan-stack/pkg/errors"
 	"go.thethings.network/lorawan-stack/pkg/events"
-	"go.thethings.network/lorawan-stack/pkg/identityserver/emails"
 	"go.thethings.network/lorawan-stack/pkg/identityserver/store"
-	"go.thethings.network/lorawan-stack/pkg/log"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
 )
 
@@ -59,7 +56,9 @@ func (is *IdentityServer) listGatewayRights(ctx context.Context, ids *ttnpb.Gate
 	if err != nil {
 		return nil, err
 	}
-	return gtwRights.Intersect(ttnpb.AllGatewayRights), nil
+	res := gtwRights.Intersect(ttnpb.AllGatewayRights)
+	res.Restrictions = gtwRights.GetRestrictions()
+	return res, nil
 }
 
 func (is *IdentityServer) createGatewayAPIKey(ctx context.Context, req *ttnpb.CreateGatewayAPIKeyRequest) (key *ttnpb.APIKey, err error) {
@@ -71,10 +70,14 @@ func (is *IdentityServer) createGatewayAPIKey(ctx context.Context, req *ttnpb.Cr
 	if err = rights.RequireGateway(ctx, req.GatewayIdentifiers, req.Rights...); err != nil {
 		return nil, err
 	}
+	if err = validateAPIKeyOptions(req.GatewayIdentifiers, req.ExpiresAt, req.Restrictions); err != nil {
+		return nil, err
+	}
 	key, token, err := generateAPIKey(ctx, req.Name, req.Rights...)
 	if err != nil {
 		return nil, err
 	}
+	key.ExpiresAt, key.Restrictions = req.ExpiresAt, req.Restrictions
 	err = is.withDatabase(ctx, func(db *gorm.DB) error {
 		return store.GetAPIKeyStore(db).CreateAPIKey(ctx, req.GatewayIdentifiers, key)
 	})
@@ -83,13 +86,12 @@ func (is *IdentityServer) createGatewayAPIKey(ctx context.Context, req *ttnpb.Cr
 	}
 	key.Key = token
 	events.Publish(evtCreateGatewayAPIKey(ctx, req.GatewayIdentifiers, nil))
-	err = is.SendContactsEmail(ctx, req.EntityIdentifiers(), func(data emails.Data) email.MessageData {
-		data.SetEntity(req.EntityIdentifiers())
-		return &emails.APIKeyCreated{Data: data, Identifier: key.PrettyName(), Rights: key.Rights}
+	is.notify(ctx, &ttnpb.Notification{
+		NotificationType: ttnpb.NOTIFICATION_TYPE_API_KEY_CREATED,
+		EntityIDs:        *req.EntityIdentifiers(),
+		Subject:          key.PrettyName(),
+		Rights:           key.Rights,
 	})
-	if err != nil {
-		log.FromContext(ctx).WithError(err).Error("Could not send API key creation notification email")
-	}
 	return key, nil
 }
 
@@ -144,15 +146,20 @@ func (is *IdentityServer) updateGatewayAPIKey(ctx context.Context, req *ttnpb.Up
 		return nil, err
 	}
 
+	if err = validateAPIKeyUpdate(req.GatewayIdentifiers, &req.APIKey, req.FieldMask); err != nil {
+		return nil, err
+	}
+
 	err = is.withDatabase(ctx, func(db *gorm.DB) (err error) {
-		if len(req.APIKey.Rights) > 0 {
-			_, key, err := store.GetAPIKeyStore(db).GetAPIKey(ctx, req.APIKey.ID)
-			if err != nil {
-				return err
-			}
+		_, existing, err := store.GetAPIKeyStore(db).GetAPIKey(ctx, req.APIKey.ID)
+		if err != nil {
+			return err
+		}
+		update := applyAPIKeyUpdate(existing, &req.APIKey, req.FieldMask)
 
-			newRights := ttnpb.RightsFrom(req.APIKey.Rights...)
-			existingRights := ttnpb.RightsFrom(key.Rights...)
+		if len(update.Rights) > 0 {
+			newRights := ttnpb.RightsFrom(update.Rights...)
+			existingRights := ttnpb.RightsFrom(existing.Rights...)
 
 			// Require the caller to have all added rights.
 			if err := rights.RequireGateway(ctx, req.GatewayIdentifiers, newRights.Sub(existingRights).GetRights()...); err != nil {
@@ -164,28 +171,24 @@ func (is *IdentityServer) updateGatewayAPIKey(ctx context.Context, req *ttnpb.Up
 			}
 		}
 
-		key, err = store.GetAPIKeyStore(db).UpdateAPIKey(ctx, req.GatewayIdentifiers, &req.APIKey)
+		key, err = store.GetAPIKeyStore(db).UpdateAPIKey(ctx, req.GatewayIdentifiers, update)
 		return err
 	})
 	if err != nil {
 		return nil, err
 	}
 	if key == nil {
+		events.Publish(evtDeleteGatewayAPIKey(ctx, req.GatewayIdentifiers, nil))
 		return &ttnpb.APIKey{}, nil
 	}
 	key.Key = ""
-	if len(req.Rights) > 0 {
-		events.Publish(evtUpdateGatewayAPIKey(ctx, req.GatewayIdentifiers, nil))
-		err = is.SendContactsEmail(ctx, req.EntityIdentifiers(), func(data emails.Data) email.MessageData {
-			data.SetEntity(req.EntityIdentifiers())
-			return &emails.APIKeyChanged{Data: data, Identifier: key.PrettyName(), Rights: key.Rights}
-		})
-		if err != nil {
-			log.FromContext(ctx).WithError(err).Error("Could not send API key update notification email")
-		}
-	} else {
-		events.Publish(evtDeleteGatewayAPIKey(ctx, req.GatewayIdentifiers, nil))
-	}
+	events.Publish(evtUpdateGatewayAPIKey(ctx, req.GatewayIdentifiers, nil))
+	is.notify(ctx, &ttnpb.Notification{
+		NotificationType: ttnpb.NOTIFICATION_TYPE_API_KEY_CHANGED,
+		EntityIDs:        *req.EntityIdentifiers(),
+		Subject:          key.PrettyName(),
+		Rights:           key.Rights,
+	})
 	return key, nil
 }
 
@@ -220,11 +223,11 @@ func (is *IdentityServer) setGatewayCollaborator(ctx context.Context, req *ttnpb
 		return nil, err
 	}
 	err := is.withDatabase(ctx, func(db *gorm.DB) error {
-		store := is.getMembershipStore(ctx, db)
+		membershipStore := is.getMembershipStore(ctx, db)
+		newRights := ttnpb.RightsFrom(req.Collaborator.Rights...)
 
 		if len(req.Collaborator.Rights) > 0 {
-			newRights := ttnpb.RightsFrom(req.Collaborator.Rights...)
-			existingRights, err := store.GetMember(
+			existingRights, err := membershipStore.GetMember(
 				ctx,
 				&req.Collaborator.OrganizationOrUserIdentifiers,
 				req.GatewayIdentifiers,
@@ -243,11 +246,16 @@ func (is *IdentityServer) setGatewayCollaborator(ctx context.Context, req *ttnpb
 			}
 		}
 
-		return store.SetMember(
+		// Do not leave the gateway without a member with all rights.
+		if err := store.CheckLastOwner(ctx, membershipStore, &req.Collaborator.OrganizationOrUserIdentifiers, req.GatewayIdentifiers, newRights); err != nil {
+			return err
+		}
+
+		return membershipStore.SetMember(
 			ctx,
 			&req.Collaborator.OrganizationOrUserIdentifiers,
 			req.GatewayIdentifiers,
-			ttnpb.RightsFrom(req.Collaborator.Rights...),
+			newRights,
 		)
 	})
 	if err != nil {
@@ -255,13 +263,12 @@ func (is *IdentityServer) setGatewayCollaborator(ctx context.Context, req *ttnpb
 	}
 	if len(req.Collaborator.Rights) > 0 {
 		events.Publish(evtUpdateGatewayCollaborator(ctx, ttnpb.CombineIdentifiers(req.GatewayIdentifiers, req.Collaborator), nil))
-		err = is.SendContactsEmail(ctx, req.EntityIdentifiers(), func(data emails.Data) email.MessageData {
-			data.SetEntity(req.EntityIdentifiers())
-			return &emails.CollaboratorChanged{Data: data, Collaborator: req.Collaborator}
-		})
-		if err != nil {
-			log.FromContext(ctx).WithError(err).Error("Could not send collaborator updated notification email")
-		}
+		is.notify(ctx, &ttnpb.Notification{
+			NotificationType: ttnpb.NOTIFICATION_TYPE_COLLABORATOR_CHANGED,
+			EntityIDs:        *req.EntityIdentifiers(),
+			Subject:          req.Collaborator.IDString(),
+			Rights:           req.Collaborator.Rights,
+		}, req.Collaborator.GetUserIDs())
 	} else {
 		events.Publish(evtDeleteGatewayCollaborator(ctx, ttnpb.CombineIdentifiers(req.GatewayIdentifiers, req.Collaborator), nil))
 	}
@@ -39,6 +39,14 @@ var (
 		"gateway.delete", "delete gateway",
 		ttnpb.RIGHT_GATEWAY_INFO,
 	)
+	evtRestoreGateway = events.Define(
+		"gateway.restore", "restore gateway",
+		ttnpb.RIGHT_GATEWAY_INFO,
+	)
+	evtPurgeGateway = events.Define(
+		"gateway.purge", "purge gateway",
+		ttnpb.RIGHT_GATEWAY_INFO,
+	)
 )
 
 func (is *IdentityServer) createGateway(ctx context.Context, req *ttnpb.CreateGatewayRequest) (gtw *ttnpb.Gateway, err error) {
@@ -164,8 +172,13 @@ func (is *IdentityServer) listGateways(ctx context.Context, req *ttnpb.ListGatew
 	}
 	req.FieldMask.Paths = cleanFieldMaskPaths(ttnpb.GatewayFieldPathsNested, req.FieldMask.Paths, getPaths, []string{"frequency_plan_id"})
 
+	if req.Deleted {
+		if err = is.RequireAdmin(ctx); err != nil {
+			return nil, err
+		}
+	}
 	var includeIndirect bool
-	if req.Collaborator == nil {
+	if req.Collaborator == nil && !req.Deleted {
 		authInfo, err := is.authInfo(ctx)
 		if err != nil {
 			return nil, err
@@ -187,8 +200,12 @@ func (is *IdentityServer) listGateways(ctx context.Context, req *ttnpb.ListGatew
 		}
 	}
 	ctx = store.WithOrder(ctx, req.Order)
+	findCtx := ctx
+	if req.Deleted {
+		findCtx = store.WithSoftDeleted(ctx, true)
+	}
 	var total uint64
-	paginateCtx := store.WithPagination(ctx, req.Limit, req.Page, &total)
+	paginateCtx := store.WithPagination(findCtx, req.Limit, req.Page, &total)
 	defer func() {
 		if err == nil {
 			setTotalHeader(ctx, total)
@@ -196,6 +213,10 @@ func (is *IdentityServer) listGateways(ctx context.Context, req *ttnpb.ListGatew
 	}()
 	gtws = &ttnpb.Gateways{}
 	err = is.withDatabase(ctx, func(db *gorm.DB) error {
+		if req.Collaborator == nil {
+			gtws.Gateways, err = store.GetGatewayStore(db).FindGateways(paginateCtx, nil, &req.FieldMask)
+			return err
+		}
 		ids, err := is.getMembershipStore(ctx, db).FindMemberships(paginateCtx, req.Collaborator, "gateway", includeIndirect)
 		if err != nil {
 			return err
@@ -209,7 +230,7 @@ func (is *IdentityServer) listGateways(ctx context.Context, req *ttnpb.ListGatew
 				gtwIDs = append(gtwIDs, gtwID)
 			}
 		}
-		gtws.Gateways, err = store.GetGatewayStore(db).FindGateways(ctx, gtwIDs, &req.FieldMask)
+		gtws.Gateways, err = store.GetGatewayStore(db).FindGateways(findCtx, gtwIDs, &req.FieldMask)
 		if err != nil {
 			return err
 		}
@@ -219,6 +240,10 @@ func (is *IdentityServer) listGateways(ctx context.Context, req *ttnpb.ListGatew
 		return nil, err
 	}
 
+	if req.Deleted {
+		return gtws, nil
+	}
+
 	for i, gtw := range gtws.Gateways {
 		// Backwards compatibility for frequency_plan_id field.
 		if len(gtw.FrequencyPlanIDs) > 0 {
@@ -292,6 +317,30 @@ func (is *IdentityServer) deleteGateway(ctx context.Context, ids *ttnpb.GatewayI
 	return ttnpb.Empty, nil
 }
 
+func (is *IdentityServer) restoreGateway(ctx context.Context, ids *ttnpb.GatewayIdentifiers) (*types.Empty, error) {
+	if err := rights.RequireGateway(store.WithSoftDeleted(ctx, false), *ids, ttnpb.RIGHT_GATEWAY_DELETE); err != nil {
+		return nil, err
+	}
+	err := is.withDatabase(ctx, func(db *gorm.DB) error {
+		return store.GetGatewayStore(db).RestoreGateway(ctx, ids)
+	})
+	if err != nil {
+		return nil, err
+	}
+	events.Publish(evtRestoreGateway(ctx, ids, nil))
+	return ttnpb.Empty, nil
+}
+
+func (is *IdentityServer) purgeGateway(ctx context.Context, ids *ttnpb.GatewayIdentifiers) (*types.Empty, error) {
+	if err := is.RequireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	if err := is.purgeEntity(ctx, ids); err != nil {
+		return nil, err
+	}
+	return ttnpb.Empty, nil
+}
+
 type gatewayRegistry struct {
 	*IdentityServer
 }
@@ -319,3 +368,11 @@ func (gr *gatewayRegistry) Update(ctx context.Context, req *ttnpb.UpdateGatewayR
 func (gr *gatewayRegistry) Delete(ctx context.Context, req *ttnpb.GatewayIdentifiers) (*types.Empty, error) {
 	return gr.deleteGateway(ctx, req)
 }
+
+func (gr *gatewayRegistry) Restore(ctx context.Context, req *ttnpb.GatewayIdentifiers) (*types.Empty, error) {
+	return gr.restoreGateway(ctx, req)
+}
+
+func (gr *gatewayRegistry) Purge(ctx context.Context, req *ttnpb.GatewayIdentifiers) (*types.Empty, error) {
+	return gr.purgeGateway(ctx, req)
+}
@@ -79,6 +79,21 @@ type Config struct {
 		SMTP         smtp.Config          `name:"smtp"`
 		Templates    emailTemplatesConfig `name:"templates"`
 	} `name:"email"`
+	TwoFactor struct {
+		RequiredForAdmins        bool     `name:"required-for-admins" description:"Require admin users to enable two-factor authentication"`
+		RequiredForOrganizations []string `name:"required-for-organizations" description:"Require members of these organizations to enable two-factor authentication"`
+		TOTPKEKLabel             string   `name:"totp-kek-label" description:"Label of KEK used to encrypt TOTP secrets at rest"`
+	} `name:"two-factor"`
+	Purge struct {
+		Retention time.Duration `name:"retention" description:"Retention of deleted entities before they are purged (0 is disabled)"`
+		Interval  time.Duration `name:"interval" description:"Interval between purges of deleted entities"`
+	} `name:"purge"`
+	APIKeys struct {
+		LastUsedInterval time.Duration `name:"last-used-interval" description:"Interval between updates of the last used time of API keys (0 is disabled)"`
+	} `name:"api-keys"`
+	Notifications struct {
+		Email []string `name:"email" description:"Types of notifications that are delivered by email, unless users change their preferences"`
+	} `name:"notifications"`
 }
 
 // IdentityServer implements the Identity Server component.
@@ -93,6 +108,7 @@ type IdentityServer struct {
 	redis          *redis.Client
 	emailTemplates *email.TemplateRegistry
 	oauth          oauth.Server
+	apiKeyUsage    apiKeyUsage
 }
 
 // Context returns the context of the Identity Server.
@@ -148,14 +164,20 @@ func New(c *component.Component, config *Config) (is *IdentityServer, err error)
 	is.oauth = oauth.NewServer(is.Context(), struct {
 		store.UserStore
 		store.UserSessionStore
+		store.UserTOTPStore
 		store.ClientStore
 		store.OAuthStore
+		store.MembershipStore
+		store.ExternalUserStore
 	}{
-		UserStore:        store.GetUserStore(is.db),
-		UserSessionStore: store.GetUserSessionStore(is.db),
-		ClientStore:      store.GetClientStore(is.db),
-		OAuthStore:       store.GetOAuthStore(is.db),
-	}, is.config.OAuth)
+		UserStore:         store.GetUserStore(is.db),
+		UserSessionStore:  store.GetUserSessionStore(is.db),
+		UserTOTPStore:     store.GetUserTOTPStore(is.db),
+		ClientStore:       store.GetClientStore(is.db),
+		OAuthStore:        store.GetOAuthStore(is.db),
+		MembershipStore:   store.GetMembershipStore(is.db),
+		ExternalUserStore: store.GetExternalUserStore(is.db),
+	}, c.KeyVault, is.config.OAuth)
 
 	c.AddContextFiller(func(ctx context.Context) context.Context {
 		ctx = is.withRequestAccessCache(ctx)
@@ -182,11 +204,20 @@ func New(c *component.Component, config *Config) (is *IdentityServer, err error)
 		hooks.RegisterUnaryHook("/ttn.lorawan.v3.OrganizationAccess", hook.name, hook.middleware)
 		hooks.RegisterUnaryHook("/ttn.lorawan.v3.UserRegistry", hook.name, hook.middleware)
 		hooks.RegisterUnaryHook("/ttn.lorawan.v3.UserAccess", hook.name, hook.middleware)
+		hooks.RegisterUnaryHook("/ttn.lorawan.v3.UserTOTPRegistry", hook.name, hook.middleware)
+		hooks.RegisterUnaryHook("/ttn.lorawan.v3.NotificationService", hook.name, hook.middleware)
 	}
 	hooks.RegisterUnaryHook("/ttn.lorawan.v3.EntityAccess", rpclog.NamespaceHook, rpclog.UnaryNamespaceHook("identityserver"))
 	hooks.RegisterUnaryHook("/ttn.lorawan.v3.EntityAccess", cluster.HookName, c.ClusterAuthUnaryHook())
 	hooks.RegisterUnaryHook("/ttn.lorawan.v3.OAuthAuthorizationRegistry", rpclog.NamespaceHook, rpclog.UnaryNamespaceHook("identityserver"))
 
+	if is.config.Purge.Retention > 0 && is.config.Purge.Interval > 0 {
+		c.RegisterTask(is.Context(), "purge_deleted", is.purgeDeletedTask, component.TaskRestartOnFailure, time.Minute)
+	}
+	if is.config.APIKeys.LastUsedInterval > 0 {
+		c.RegisterTask(is.Context(), "api_key_usage", is.flushAPIKeyUsageTask, component.TaskRestartOnFailure, time.Minute)
+	}
+
 	c.RegisterGRPC(is)
 	c.RegisterWeb(is.oauth)
 
@@ -212,6 +243,8 @@ func (is *IdentityServer) RegisterServices(s *grpc.Server) {
 	ttnpb.RegisterUserRegistryServer(s, &userRegistry{IdentityServer: is})
 	ttnpb.RegisterUserAccessServer(s, &userAccess{IdentityServer: is})
 	ttnpb.RegisterUserInvitationRegistryServer(s, &invitationRegistry{IdentityServer: is})
+	ttnpb.RegisterUserTOTPRegistryServer(s, &userTOTPRegistry{IdentityServer: is})
+	ttnpb.RegisterNotificationServiceServer(s, &notificationService{IdentityServer: is})
 	ttnpb.RegisterEntityRegistrySearchServer(s, &registrySearch{IdentityServer: is})
 	ttnpb.RegisterEndDeviceRegistrySearchServer(s, &registrySearch{IdentityServer: is})
 	ttnpb.RegisterOAuthAuthorizationRegistryServer(s, &oauthRegistry{IdentityServer: is})
@@ -233,6 +266,8 @@ func (is *IdentityServer) RegisterHandlers(s *runtime.ServeMux, conn *grpc.Clien
 	ttnpb.RegisterUserRegistryHandler(is.Context(), s, conn)
 	ttnpb.RegisterUserAccessHandler(is.Context(), s, conn)
 	ttnpb.RegisterUserInvitationRegistryHandler(is.Context(), s, conn)
+	ttnpb.RegisterUserTOTPRegistryHandler(is.Context(), s, conn)
+	ttnpb.RegisterNotificationServiceHandler(is.Context(), s, conn)
 	ttnpb.RegisterEntityRegistrySearchHandler(is.Context(), s, conn)
 	ttnpb.RegisterEndDeviceRegistrySearchHandler(is.Context(), s, conn)
 	ttnpb.RegisterOAuthAuthorizationRegistryHandler(is.Context(), s, conn)
@@ -17,6 +17,7 @@ package identityserver
 import (
 	"fmt"
 	"os"
+	"path/filepath"
 	"sync"
 	"testing"
 	"time"
@@ -301,10 +302,17 @@ func getIdentityServer(t *testing.T) (*IdentityServer, *grpc.ClientConn) {
 				Level: log.DebugLevel,
 			},
 		},
+		Blob: config.BlobConfig{
+			Provider: "local",
+			Local: config.BlobConfigLocal{
+				Directory: filepath.Join(os.TempDir(), "ttn-lw-is-test"),
+			},
+		},
 	}})
 	conf := &Config{
 		DatabaseURI: dbConnString,
 	}
+	conf.ProfilePicture.Bucket = "profile_pictures"
 	conf.UserRegistration.PasswordRequirements.MinLength = 10
 	conf.UserRegistration.PasswordRequirements.MaxLength = 1000
 	conf.Email.Templates.Static = map[string][]byte{
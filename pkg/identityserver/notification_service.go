@@ -0,0 +1,360 @@
+// Copyright © 2026 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package identityserver
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/jinzhu/gorm"
+	"go.thethings.network/lorawan-stack/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/pkg/component"
+	"go.thethings.network/lorawan-stack/pkg/email"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/identityserver/emails"
+	"go.thethings.network/lorawan-stack/pkg/identityserver/store"
+	"go.thethings.network/lorawan-stack/pkg/log"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+)
+
+var errNotificationType = errors.DefineInvalidArgument("notification_type", "notification type `{notification_type}` is not supported for {entity_type}")
+
+// notificationTypeName returns the name of the notification type, as used in
+// the configuration and in the notification email template.
+func notificationTypeName(notificationType ttnpb.NotificationType) string {
+	return strings.ToLower(strings.TrimPrefix(notificationType.String(), "NOTIFICATION_TYPE_"))
+}
+
+// notificationReceiverRights are the rights that users need on the entity of
+// a notification in order to receive it. Notifications about users are
+// received by the user itself.
+var notificationReceiverRights = map[ttnpb.NotificationType]map[string]ttnpb.Right{
+	ttnpb.NOTIFICATION_TYPE_COLLABORATOR_CHANGED: {
+		"application":  ttnpb.RIGHT_APPLICATION_SETTINGS_COLLABORATORS,
+		"client":       ttnpb.RIGHT_CLIENT_ALL,
+		"gateway":      ttnpb.RIGHT_GATEWAY_SETTINGS_COLLABORATORS,
+		"organization": ttnpb.RIGHT_ORGANIZATION_SETTINGS_MEMBERS,
+	},
+	ttnpb.NOTIFICATION_TYPE_API_KEY_CREATED: {
+		"application":  ttnpb.RIGHT_APPLICATION_SETTINGS_API_KEYS,
+		"gateway":      ttnpb.RIGHT_GATEWAY_SETTINGS_API_KEYS,
+		"organization": ttnpb.RIGHT_ORGANIZATION_SETTINGS_API_KEYS,
+		"user":         ttnpb.RIGHT_USER_SETTINGS_API_KEYS,
+	},
+	ttnpb.NOTIFICATION_TYPE_API_KEY_CHANGED: {
+		"application":  ttnpb.RIGHT_APPLICATION_SETTINGS_API_KEYS,
+		"gateway":      ttnpb.RIGHT_GATEWAY_SETTINGS_API_KEYS,
+		"organization": ttnpb.RIGHT_ORGANIZATION_SETTINGS_API_KEYS,
+		"user":         ttnpb.RIGHT_USER_SETTINGS_API_KEYS,
+	},
+	ttnpb.NOTIFICATION_TYPE_GATEWAY_DISCONNECTED: {
+		"gateway": ttnpb.RIGHT_GATEWAY_STATUS_READ,
+	},
+}
+
+// notificationReceivers returns the users that receive the notification.
+// These are the users that have the receiver rights on the entity, directly
+// or through organizations, or the admins for notifications that need approval.
+func (is *IdentityServer) notificationReceivers(ctx context.Context, db *gorm.DB, notification *ttnpb.Notification) ([]*ttnpb.UserIdentifiers, error) {
+	entityIDs := notification.EntityIDs.Identifiers()
+	if notification.NotificationType == ttnpb.NOTIFICATION_TYPE_APPROVAL_REQUESTED {
+		switch entityIDs.EntityType() {
+		case "client", "user":
+			return store.GetUserStore(db).FindAdmins(ctx)
+		}
+	}
+	right, ok := notificationReceiverRights[notification.NotificationType][entityIDs.EntityType()]
+	if !ok {
+		return nil, errNotificationType.WithAttributes(
+			"notification_type", notification.NotificationType.String(),
+			"entity_type", entityIDs.EntityType(),
+		)
+	}
+	if usrIDs := notification.EntityIDs.GetUserIDs(); usrIDs != nil {
+		return []*ttnpb.UserIdentifiers{usrIDs}, nil
+	}
+	membershipStore := is.getMembershipStore(ctx, db)
+	members, err := membershipStore.FindMembers(ctx, entityIDs)
+	if err != nil {
+		return nil, err
+	}
+	var receivers []*ttnpb.UserIdentifiers
+	for member, memberRights := range members {
+		if !memberRights.Implied().IncludesAll(right) {
+			continue
+		}
+		if usrIDs := member.GetUserIDs(); usrIDs != nil {
+			receivers = append(receivers, usrIDs)
+			continue
+		}
+		orgMembers, err := membershipStore.FindMembers(ctx, member.GetOrganizationIDs())
+		if err != nil {
+			return nil, err
+		}
+		for orgMember, orgMemberRights := range orgMembers {
+			if usrIDs := orgMember.GetUserIDs(); usrIDs != nil && orgMemberRights.Implied().IncludesAll(right) {
+				receivers = append(receivers, usrIDs)
+			}
+		}
+	}
+	return receivers, nil
+}
+
+// defaultNotificationPreferences returns the notification preferences of users
+// that did not set any preferences.
+func (is *IdentityServer) defaultNotificationPreferences(ctx context.Context) []*ttnpb.NotificationPreference {
+	email := make(map[string]bool)
+	for _, name := range is.configFromContext(ctx).Notifications.Email {
+		email[name] = true
+	}
+	preferences := make([]*ttnpb.NotificationPreference, 0, len(ttnpb.NotificationType_name)-1)
+	for i := 1; i < len(ttnpb.NotificationType_name); i++ {
+		notificationType := ttnpb.NotificationType(i)
+		preferences = append(preferences, &ttnpb.NotificationPreference{
+			NotificationType: notificationType,
+			Subscribed:       true,
+			Email:            email[notificationTypeName(notificationType)],
+		})
+	}
+	return preferences
+}
+
+// notificationPreferences returns the notification preferences of the user,
+// including the defaults of the notification types that the user did not set.
+func (is *IdentityServer) notificationPreferences(ctx context.Context, db *gorm.DB, ids *ttnpb.UserIdentifiers) ([]*ttnpb.NotificationPreference, error) {
+	preferences := is.defaultNotificationPreferences(ctx)
+	userPreferences, err := store.GetNotificationStore(db).GetNotificationPreferences(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	for _, userPreference := range userPreferences {
+		for i, preference := range preferences {
+			if preference.NotificationType == userPreference.NotificationType {
+				preferences[i] = userPreference
+			}
+		}
+	}
+	return preferences, nil
+}
+
+// notifyTimeout is the timeout for recording and delivering a notification.
+const notifyTimeout = time.Minute
+
+// notify records and delivers the notification in the background, so that
+// notifications do not delay or fail the request that triggered them.
+// Errors are logged. See createNotifications.
+func (is *IdentityServer) notify(ctx context.Context, notification *ttnpb.Notification, extraReceivers ...*ttnpb.UserIdentifiers) {
+	is.setNotificationSender(ctx, notification)
+	// The notification outlives the request, so it only keeps the logger and
+	// configuration of the request context.
+	notifyCtx := context.WithValue(log.NewContext(is.Context(), log.FromContext(ctx)), ctxKey, is.configFromContext(ctx))
+	is.StartTask(notifyCtx, "notify", func(ctx context.Context) error {
+		ctx, cancel := context.WithTimeout(ctx, notifyTimeout)
+		defer cancel()
+		if err := is.createNotifications(ctx, notification, extraReceivers...); err != nil {
+			log.FromContext(ctx).WithError(err).WithField(
+				"notification_type", notificationTypeName(notification.NotificationType),
+			).Error("Could not create notification")
+		}
+		return nil
+	}, component.TaskRestartNever, 0)
+}
+
+// setNotificationSender sets the user that triggered the notification as the
+// sender, if the sender is not set.
+func (is *IdentityServer) setNotificationSender(ctx context.Context, notification *ttnpb.Notification) {
+	if notification.SenderIDs != nil {
+		return
+	}
+	if authInfo, err := is.authInfo(ctx); err == nil {
+		notification.SenderIDs = authInfo.GetEntityIdentifiers().GetUserIDs()
+	}
+}
+
+// createNotifications records the notification for the users that receive it
+// and that are subscribed to its type, and delivers it by email to the users
+// that want that. Extra receivers, such as a collaborator that was added, can
+// be passed. The user that triggered the notification does not receive it,
+// unless the notification is about that user.
+func (is *IdentityServer) createNotifications(ctx context.Context, notification *ttnpb.Notification, extraReceivers ...*ttnpb.UserIdentifiers) error {
+	var emailReceivers []*ttnpb.UserIdentifiers
+	err := is.withDatabase(ctx, func(db *gorm.DB) error {
+		receivers, err := is.notificationReceivers(ctx, db, notification)
+		if err != nil {
+			return err
+		}
+		receivers = append(receivers, extraReceivers...)
+		seen := make(map[string]bool, len(receivers))
+		if senderIDs := notification.SenderIDs; senderIDs != nil && !senderIDs.Equal(notification.EntityIDs.GetUserIDs()) {
+			seen[senderIDs.UserID] = true
+		}
+		var subscribed []*ttnpb.UserIdentifiers
+		for _, receiverIDs := range receivers {
+			if receiverIDs == nil || seen[receiverIDs.UserID] {
+				continue
+			}
+			seen[receiverIDs.UserID] = true
+			preferences, err := is.notificationPreferences(ctx, db, receiverIDs)
+			if err != nil {
+				return err
+			}
+			for _, preference := range preferences {
+				if preference.NotificationType != notification.NotificationType || !preference.Subscribed {
+					continue
+				}
+				subscribed = append(subscribed, receiverIDs)
+				if preference.Email {
+					emailReceivers = append(emailReceivers, receiverIDs)
+				}
+			}
+		}
+		return store.GetNotificationStore(db).CreateNotification(ctx, notification, subscribed)
+	})
+	if err != nil {
+		return err
+	}
+	for _, receiverIDs := range emailReceivers {
+		err := is.SendUserEmail(ctx, receiverIDs, func(data emails.Data) email.MessageData {
+			data.SetEntity(&notification.EntityIDs)
+			return &emails.Notification{
+				Data:             data,
+				NotificationType: notificationTypeName(notification.NotificationType),
+				Subject:          notification.Subject,
+				Rights:           notification.Rights,
+			}
+		})
+		if err != nil {
+			log.FromContext(ctx).WithError(err).WithField("user_id", receiverIDs.UserID).Error("Could not send notification email")
+		}
+	}
+	return nil
+}
+
+func (is *IdentityServer) createNotification(ctx context.Context, req *ttnpb.CreateNotificationRequest) (*types.Empty, error) {
+	// Notifications are created by other components in the cluster, which have
+	// universal rights to read all entities.
+	if !is.UniversalRights(ctx).IncludesAll(ttnpb.AllClusterRights.GetRights()...) {
+		return nil, errPermissionDenied
+	}
+	notification := req.Notification
+	notification.ID, notification.ReadAt = "", nil
+	is.setNotificationSender(ctx, &notification)
+	if err := is.createNotifications(ctx, &notification); err != nil {
+		return nil, err
+	}
+	return ttnpb.Empty, nil
+}
+
+func (is *IdentityServer) listNotifications(ctx context.Context, req *ttnpb.ListNotificationsRequest) (notifications *ttnpb.Notifications, err error) {
+	if err = rights.RequireUser(ctx, req.UserIdentifiers, ttnpb.RIGHT_USER_INFO); err != nil {
+		return nil, err
+	}
+	var total uint64
+	ctx = store.WithPagination(ctx, req.Limit, req.Page, &total)
+	defer func() {
+		if err == nil {
+			setTotalHeader(ctx, total)
+		}
+	}()
+	notifications = &ttnpb.Notifications{}
+	err = is.withDatabase(ctx, func(db *gorm.DB) (err error) {
+		notifications.Notifications, err = store.GetNotificationStore(db).ListNotifications(ctx, &req.UserIdentifiers, req.UnreadOnly)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return notifications, nil
+}
+
+func (is *IdentityServer) markNotificationsRead(ctx context.Context, req *ttnpb.MarkNotificationsReadRequest) (*types.Empty, error) {
+	if err := rights.RequireUser(ctx, req.UserIdentifiers, ttnpb.RIGHT_USER_SETTINGS_BASIC); err != nil {
+		return nil, err
+	}
+	err := is.withDatabase(ctx, func(db *gorm.DB) error {
+		return store.GetNotificationStore(db).MarkNotificationsRead(ctx, &req.UserIdentifiers, req.IDs)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return ttnpb.Empty, nil
+}
+
+func (is *IdentityServer) getNotificationPreferences(ctx context.Context, ids *ttnpb.UserIdentifiers) (*ttnpb.NotificationPreferences, error) {
+	if err := rights.RequireUser(ctx, *ids, ttnpb.RIGHT_USER_INFO); err != nil {
+		return nil, err
+	}
+	res := &ttnpb.NotificationPreferences{}
+	err := is.withDatabase(ctx, func(db *gorm.DB) (err error) {
+		res.Preferences, err = is.notificationPreferences(ctx, db, ids)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (is *IdentityServer) setNotificationPreferences(ctx context.Context, req *ttnpb.SetNotificationPreferencesRequest) (*ttnpb.NotificationPreferences, error) {
+	if err := rights.RequireUser(ctx, req.UserIdentifiers, ttnpb.RIGHT_USER_SETTINGS_BASIC); err != nil {
+		return nil, err
+	}
+	for _, preference := range req.Preferences {
+		if preference.NotificationType == ttnpb.NOTIFICATION_TYPE_UNKNOWN {
+			return nil, errNotificationType.WithAttributes(
+				"notification_type", preference.NotificationType.String(),
+				"entity_type", "user",
+			)
+		}
+	}
+	res := &ttnpb.NotificationPreferences{}
+	err := is.withDatabase(ctx, func(db *gorm.DB) (err error) {
+		if err = store.GetNotificationStore(db).SetNotificationPreferences(ctx, &req.UserIdentifiers, req.Preferences); err != nil {
+			return err
+		}
+		res.Preferences, err = is.notificationPreferences(ctx, db, &req.UserIdentifiers)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+type notificationService struct {
+	*IdentityServer
+}
+
+func (ns *notificationService) Create(ctx context.Context, req *ttnpb.CreateNotificationRequest) (*types.Empty, error) {
+	return ns.createNotification(ctx, req)
+}
+
+func (ns *notificationService) List(ctx context.Context, req *ttnpb.ListNotificationsRequest) (*ttnpb.Notifications, error) {
+	return ns.listNotifications(ctx, req)
+}
+
+func (ns *notificationService) MarkRead(ctx context.Context, req *ttnpb.MarkNotificationsReadRequest) (*types.Empty, error) {
+	return ns.markNotificationsRead(ctx, req)
+}
+
+func (ns *notificationService) GetPreferences(ctx context.Context, req *ttnpb.UserIdentifiers) (*ttnpb.NotificationPreferences, error) {
+	return ns.getNotificationPreferences(ctx, req)
+}
+
+func (ns *notificationService) SetPreferences(ctx context.Context, req *ttnpb.SetNotificationPreferencesRequest) (*ttnpb.NotificationPreferences, error) {
+	return ns.setNotificationPreferences(ctx, req)
+}
@@ -0,0 +1,172 @@
+// Copyright © 2026 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package identityserver
+
+import (
+	"testing"
+
+	"github.com/smartystreets/assertions"
+	"github.com/smartystreets/assertions/should"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+	"google.golang.org/grpc"
+)
+
+func TestNotificationService(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+
+	testWithIdentityServer(t, func(is *IdentityServer, cc *grpc.ClientConn) {
+		userID, creds := defaultUser.UserIdentifiers, userCreds(defaultUserIdx)
+		gatewayID := userGateways(&userID).Gateways[0].GatewayIdentifiers
+		collaboratorID, collaboratorCreds := collaboratorUser.UserIdentifiers, userCreds(collaboratorUserIdx)
+
+		svc := ttnpb.NewNotificationServiceClient(cc)
+
+		_, err := svc.Create(ctx, &ttnpb.CreateNotificationRequest{
+			Notification: ttnpb.Notification{
+				NotificationType: ttnpb.NOTIFICATION_TYPE_GATEWAY_DISCONNECTED,
+				EntityIDs:        *gatewayID.EntityIdentifiers(),
+			},
+		}, creds)
+
+		if a.So(err, should.NotBeNil) {
+			a.So(errors.IsPermissionDenied(err), should.BeTrue)
+		}
+
+		_, err = svc.List(ctx, &ttnpb.ListNotificationsRequest{UserIdentifiers: collaboratorID}, creds)
+
+		if a.So(err, should.NotBeNil) {
+			a.So(errors.IsPermissionDenied(err), should.BeTrue)
+		}
+
+		_, err = ttnpb.NewGatewayAccessClient(cc).SetCollaborator(ctx, &ttnpb.SetGatewayCollaboratorRequest{
+			GatewayIdentifiers: gatewayID,
+			Collaborator: ttnpb.Collaborator{
+				OrganizationOrUserIdentifiers: *collaboratorID.OrganizationOrUserIdentifiers(),
+				Rights:                        []ttnpb.Right{ttnpb.RIGHT_GATEWAY_INFO},
+			},
+		}, creds)
+
+		a.So(err, should.BeNil)
+
+		findNotification := func(notifications *ttnpb.Notifications) *ttnpb.Notification {
+			for _, notification := range notifications.GetNotifications() {
+				if notification.NotificationType == ttnpb.NOTIFICATION_TYPE_COLLABORATOR_CHANGED &&
+					notification.EntityIDs.GetGatewayIDs().GetGatewayID() == gatewayID.GetGatewayID() {
+					return notification
+				}
+			}
+			return nil
+		}
+
+		notifications, err := svc.List(ctx, &ttnpb.ListNotificationsRequest{
+			UserIdentifiers: collaboratorID,
+			UnreadOnly:      true,
+		}, collaboratorCreds)
+
+		a.So(err, should.BeNil)
+		notification := findNotification(notifications)
+		if !a.So(notification, should.NotBeNil) {
+			t.FailNow()
+		}
+		a.So(notification.SenderIDs.GetUserID(), should.Equal, userID.GetUserID())
+		a.So(notification.Rights, should.Resemble, []ttnpb.Right{ttnpb.RIGHT_GATEWAY_INFO})
+		a.So(notification.ReadAt, should.BeNil)
+
+		_, err = svc.MarkRead(ctx, &ttnpb.MarkNotificationsReadRequest{
+			UserIdentifiers: collaboratorID,
+			IDs:             []string{notification.ID},
+		}, collaboratorCreds)
+
+		a.So(err, should.BeNil)
+
+		notifications, err = svc.List(ctx, &ttnpb.ListNotificationsRequest{
+			UserIdentifiers: collaboratorID,
+			UnreadOnly:      true,
+		}, collaboratorCreds)
+
+		a.So(err, should.BeNil)
+		a.So(findNotification(notifications), should.BeNil)
+
+		notifications, err = svc.List(ctx, &ttnpb.ListNotificationsRequest{
+			UserIdentifiers: collaboratorID,
+		}, collaboratorCreds)
+
+		a.So(err, should.BeNil)
+		if notification := findNotification(notifications); a.So(notification, should.NotBeNil) {
+			a.So(notification.ReadAt, should.NotBeNil)
+		}
+
+		preferences, err := svc.GetPreferences(ctx, &collaboratorID, collaboratorCreds)
+
+		a.So(err, should.BeNil)
+		if a.So(preferences, should.NotBeNil) {
+			for _, preference := range preferences.Preferences {
+				a.So(preference.Subscribed, should.BeTrue)
+			}
+		}
+
+		_, err = svc.SetPreferences(ctx, &ttnpb.SetNotificationPreferencesRequest{
+			UserIdentifiers: collaboratorID,
+			Preferences: []*ttnpb.NotificationPreference{
+				{NotificationType: ttnpb.NOTIFICATION_TYPE_UNKNOWN, Subscribed: true},
+			},
+		}, collaboratorCreds)
+
+		if a.So(err, should.NotBeNil) {
+			a.So(errors.IsInvalidArgument(err), should.BeTrue)
+		}
+
+		preferences, err = svc.SetPreferences(ctx, &ttnpb.SetNotificationPreferencesRequest{
+			UserIdentifiers: collaboratorID,
+			Preferences: []*ttnpb.NotificationPreference{
+				{NotificationType: ttnpb.NOTIFICATION_TYPE_COLLABORATOR_CHANGED, Subscribed: false},
+			},
+		}, collaboratorCreds)
+
+		a.So(err, should.BeNil)
+		if a.So(preferences, should.NotBeNil) {
+			for _, preference := range preferences.Preferences {
+				a.So(preference.Subscribed, should.Equal, preference.NotificationType != ttnpb.NOTIFICATION_TYPE_COLLABORATOR_CHANGED)
+			}
+		}
+
+		_, err = svc.MarkRead(ctx, &ttnpb.MarkNotificationsReadRequest{
+			UserIdentifiers: collaboratorID,
+		}, collaboratorCreds)
+
+		a.So(err, should.BeNil)
+
+		_, err = ttnpb.NewGatewayAccessClient(cc).SetCollaborator(ctx, &ttnpb.SetGatewayCollaboratorRequest{
+			GatewayIdentifiers: gatewayID,
+			Collaborator: ttnpb.Collaborator{
+				OrganizationOrUserIdentifiers: *collaboratorID.OrganizationOrUserIdentifiers(),
+				Rights:                        []ttnpb.Right{ttnpb.RIGHT_GATEWAY_INFO, ttnpb.RIGHT_GATEWAY_LOCATION_READ},
+			},
+		}, creds)
+
+		a.So(err, should.BeNil)
+
+		notifications, err = svc.List(ctx, &ttnpb.ListNotificationsRequest{
+			UserIdentifiers: collaboratorID,
+			UnreadOnly:      true,
+		}, collaboratorCreds)
+
+		a.So(err, should.BeNil)
+		a.So(findNotification(notifications), should.BeNil)
+	})
+}
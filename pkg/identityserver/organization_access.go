@@ -20,12 +20,9 @@ import (
 	"github.com/gogo/protobuf/types"
 	"github.com/jinzhu/gorm"
 	"go.thethings.network/lorawan-stack/pkg/auth/rights"
-	"go.thethings.network/lorawan-stack/pkg/email"
 	"go.thethings.network/lorawan-stack/pkg/errors"
 	"go.thethings.network/lorawan-stack/pkg/events"
-	"go.thethings.network/lorawan-stack/pkg/identityserver/emails"
 	"go.thethings.network/lorawan-stack/pkg/identityserver/store"
-	"go.thethings.network/lorawan-stack/pkg/log"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
 )
 
@@ -59,7 +56,9 @@ func (is *IdentityServer) listOrganizationRights(ctx context.Context, ids *ttnpb
 	if err != nil {
 		return nil, err
 	}
-	return orgRights.Intersect(ttnpb.AllEntityRights.Union(ttnpb.AllOrganizationRights)), nil
+	res := orgRights.Intersect(ttnpb.AllEntityRights.Union(ttnpb.AllOrganizationRights))
+	res.Restrictions = orgRights.GetRestrictions()
+	return res, nil
 }
 
 func (is *IdentityServer) createOrganizationAPIKey(ctx context.Context, req *ttnpb.CreateOrganizationAPIKeyRequest) (key *ttnpb.APIKey, err error) {
@@ -71,10 +70,14 @@ func (is *IdentityServer) createOrganizationAPIKey(ctx context.Context, req *ttn
 	if err = rights.RequireOrganization(ctx, req.OrganizationIdentifiers, req.Rights...); err != nil {
 		return nil, err
 	}
+	if err = validateAPIKeyOptions(req.OrganizationIdentifiers, req.ExpiresAt, req.Restrictions); err != nil {
+		return nil, err
+	}
 	key, token, err := generateAPIKey(ctx, req.Name, req.Rights...)
 	if err != nil {
 		return nil, err
 	}
+	key.ExpiresAt, key.Restrictions = req.ExpiresAt, req.Restrictions
 	err = is.withDatabase(ctx, func(db *gorm.DB) error {
 		return store.GetAPIKeyStore(db).CreateAPIKey(ctx, req.OrganizationIdentifiers, key)
 	})
@@ -83,13 +86,12 @@ func (is *IdentityServer) createOrganizationAPIKey(ctx context.Context, req *ttn
 	}
 	key.Key = token
 	events.Publish(evtCreateOrganizationAPIKey(ctx, req.OrganizationIdentifiers, nil))
-	err = is.SendContactsEmail(ctx, req.EntityIdentifiers(), func(data emails.Data) email.MessageData {
-		data.SetEntity(req.EntityIdentifiers())
-		return &emails.APIKeyCreated{Data: data, Identifier: key.PrettyName(), Rights: key.Rights}
+	is.notify(ctx, &ttnpb.Notification{
+		NotificationType: ttnpb.NOTIFICATION_TYPE_API_KEY_CREATED,
+		EntityIDs:        *req.EntityIdentifiers(),
+		Subject:          key.PrettyName(),
+		Rights:           key.Rights,
 	})
-	if err != nil {
-		log.FromContext(ctx).WithError(err).Error("Could not send API key creation notification email")
-	}
 	return key, nil
 }
 
@@ -144,15 +146,20 @@ func (is *IdentityServer) updateOrganizationAPIKey(ctx context.Context, req *ttn
 		return nil, err
 	}
 
+	if err = validateAPIKeyUpdate(req.OrganizationIdentifiers, &req.APIKey, req.FieldMask); err != nil {
+		return nil, err
+	}
+
 	err = is.withDatabase(ctx, func(db *gorm.DB) (err error) {
-		if len(req.APIKey.Rights) > 0 {
-			_, key, err := store.GetAPIKeyStore(db).GetAPIKey(ctx, req.APIKey.ID)
-			if err != nil {
-				return err
-			}
+		_, existing, err := store.GetAPIKeyStore(db).GetAPIKey(ctx, req.APIKey.ID)
+		if err != nil {
+			return err
+		}
+		update := applyAPIKeyUpdate(existing, &req.APIKey, req.FieldMask)
 
-			newRights := ttnpb.RightsFrom(req.APIKey.Rights...)
-			existingRights := ttnpb.RightsFrom(key.Rights...)
+		if len(update.Rights) > 0 {
+			newRights := ttnpb.RightsFrom(update.Rights...)
+			existingRights := ttnpb.RightsFrom(existing.Rights...)
 
 			// Require the caller to have all added rights.
 			if err := rights.RequireOrganization(ctx, req.OrganizationIdentifiers, newRights.Sub(existingRights).GetRights()...); err != nil {
@@ -164,28 +171,24 @@ func (is *IdentityServer) updateOrganizationAPIKey(ctx context.Context, req *ttn
 			}
 		}
 
-		key, err = store.GetAPIKeyStore(db).UpdateAPIKey(ctx, req.OrganizationIdentifiers, &req.APIKey)
+		key, err = store.GetAPIKeyStore(db).UpdateAPIKey(ctx, req.OrganizationIdentifiers, update)
 		return err
 	})
 	if err != nil {
 		return nil, err
 	}
 	if key == nil {
+		events.Publish(evtDeleteOrganizationAPIKey(ctx, req.OrganizationIdentifiers, nil))
 		return &ttnpb.APIKey{}, nil
 	}
 	key.Key = ""
-	if len(req.Rights) > 0 {
-		events.Publish(evtUpdateOrganizationAPIKey(ctx, req.OrganizationIdentifiers, nil))
-		err = is.SendContactsEmail(ctx, req.EntityIdentifiers(), func(data emails.Data) email.MessageData {
-			data.SetEntity(req.EntityIdentifiers())
-			return &emails.APIKeyChanged{Data: data, Identifier: key.PrettyName(), Rights: key.Rights}
-		})
-		if err != nil {
-			log.FromContext(ctx).WithError(err).Error("Could not send API key update notification email")
-		}
-	} else {
-		events.Publish(evtDeleteOrganizationAPIKey(ctx, req.OrganizationIdentifiers, nil))
-	}
+	events.Publish(evtUpdateOrganizationAPIKey(ctx, req.OrganizationIdentifiers, nil))
+	is.notify(ctx, &ttnpb.Notification{
+		NotificationType: ttnpb.NOTIFICATION_TYPE_API_KEY_CHANGED,
+		EntityIDs:        *req.EntityIdentifiers(),
+		Subject:          key.PrettyName(),
+		Rights:           key.Rights,
+	})
 	return key, nil
 }
 
@@ -221,11 +224,11 @@ func (is *IdentityServer) setOrganizationCollaborator(ctx context.Context, req *
 	}
 
 	err := is.withDatabase(ctx, func(db *gorm.DB) error {
-		store := is.getMembershipStore(ctx, db)
+		membershipStore := is.getMembershipStore(ctx, db)
+		newRights := ttnpb.RightsFrom(req.Collaborator.Rights...)
 
 		if len(req.Collaborator.Rights) > 0 {
-			newRights := ttnpb.RightsFrom(req.Collaborator.Rights...)
-			existingRights, err := store.GetMember(
+			existingRights, err := membershipStore.GetMember(
 				ctx,
 				&req.Collaborator.OrganizationOrUserIdentifiers,
 				req.OrganizationIdentifiers,
@@ -244,11 +247,16 @@ func (is *IdentityServer) setOrganizationCollaborator(ctx context.Context, req *
 			}
 		}
 
-		return store.SetMember(
+		// Do not leave the organization without a member with all rights.
+		if err := store.CheckLastOwner(ctx, membershipStore, &req.Collaborator.OrganizationOrUserIdentifiers, req.OrganizationIdentifiers, newRights); err != nil {
+			return err
+		}
+
+		return membershipStore.SetMember(
 			ctx,
 			&req.Collaborator.OrganizationOrUserIdentifiers,
 			req.OrganizationIdentifiers,
-			ttnpb.RightsFrom(req.Collaborator.Rights...),
+			newRights,
 		)
 	})
 	if err != nil {
@@ -256,13 +264,12 @@ func (is *IdentityServer) setOrganizationCollaborator(ctx context.Context, req *
 	}
 	if len(req.Collaborator.Rights) > 0 {
 		events.Publish(evtUpdateOrganizationCollaborator(ctx, ttnpb.CombineIdentifiers(req.OrganizationIdentifiers, req.Collaborator), nil))
-		err = is.SendContactsEmail(ctx, req.EntityIdentifiers(), func(data emails.Data) email.MessageData {
-			data.SetEntity(req.EntityIdentifiers())
-			return &emails.CollaboratorChanged{Data: data, Collaborator: req.Collaborator}
-		})
-		if err != nil {
-			log.FromContext(ctx).WithError(err).Error("Could not send collaborator updated notification email")
-		}
+		is.notify(ctx, &ttnpb.Notification{
+			NotificationType: ttnpb.NOTIFICATION_TYPE_COLLABORATOR_CHANGED,
+			EntityIDs:        *req.EntityIdentifiers(),
+			Subject:          req.Collaborator.IDString(),
+			Rights:           req.Collaborator.Rights,
+		}, req.Collaborator.GetUserIDs())
 	} else {
 		events.Publish(evtDeleteOrganizationCollaborator(ctx, ttnpb.CombineIdentifiers(req.OrganizationIdentifiers, req.Collaborator), nil))
 	}
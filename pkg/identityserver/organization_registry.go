@@ -40,6 +40,14 @@ var (
 		"organization.delete", "delete organization",
 		ttnpb.RIGHT_ORGANIZATION_INFO,
 	)
+	evtRestoreOrganization = events.Define(
+		"organization.restore", "restore organization",
+		ttnpb.RIGHT_ORGANIZATION_INFO,
+	)
+	evtPurgeOrganization = events.Define(
+		"organization.purge", "purge organization",
+		ttnpb.RIGHT_ORGANIZATION_INFO,
+	)
 )
 
 var errNestedOrganizations = errors.DefineInvalidArgument("nested_organizations", "organizations can not be nested")
@@ -120,8 +128,13 @@ func (is *IdentityServer) getOrganization(ctx context.Context, req *ttnpb.GetOrg
 
 func (is *IdentityServer) listOrganizations(ctx context.Context, req *ttnpb.ListOrganizationsRequest) (orgs *ttnpb.Organizations, err error) {
 	req.FieldMask.Paths = cleanFieldMaskPaths(ttnpb.OrganizationFieldPathsNested, req.FieldMask.Paths, getPaths, nil)
+	if req.Deleted {
+		if err = is.RequireAdmin(ctx); err != nil {
+			return nil, err
+		}
+	}
 	var includeIndirect bool
-	if req.Collaborator == nil {
+	if req.Collaborator == nil && !req.Deleted {
 		authInfo, err := is.authInfo(ctx)
 		if err != nil {
 			return nil, err
@@ -141,8 +154,12 @@ func (is *IdentityServer) listOrganizations(ctx context.Context, req *ttnpb.List
 		return nil, errNestedOrganizations
 	}
 	ctx = store.WithOrder(ctx, req.Order)
+	findCtx := ctx
+	if req.Deleted {
+		findCtx = store.WithSoftDeleted(ctx, true)
+	}
 	var total uint64
-	paginateCtx := store.WithPagination(ctx, req.Limit, req.Page, &total)
+	paginateCtx := store.WithPagination(findCtx, req.Limit, req.Page, &total)
 	defer func() {
 		if err == nil {
 			setTotalHeader(ctx, total)
@@ -150,6 +167,10 @@ func (is *IdentityServer) listOrganizations(ctx context.Context, req *ttnpb.List
 	}()
 	orgs = &ttnpb.Organizations{}
 	err = is.withDatabase(ctx, func(db *gorm.DB) (err error) {
+		if req.Collaborator == nil {
+			orgs.Organizations, err = store.GetOrganizationStore(db).FindOrganizations(paginateCtx, nil, &req.FieldMask)
+			return err
+		}
 		ids, err := is.getMembershipStore(ctx, db).FindMemberships(paginateCtx, req.Collaborator, "organization", includeIndirect)
 		if err != nil {
 			return err
@@ -163,7 +184,7 @@ func (is *IdentityServer) listOrganizations(ctx context.Context, req *ttnpb.List
 				orgIDs = append(orgIDs, orgID)
 			}
 		}
-		orgs.Organizations, err = store.GetOrganizationStore(db).FindOrganizations(ctx, orgIDs, &req.FieldMask)
+		orgs.Organizations, err = store.GetOrganizationStore(db).FindOrganizations(findCtx, orgIDs, &req.FieldMask)
 		if err != nil {
 			return err
 		}
@@ -173,6 +194,10 @@ func (is *IdentityServer) listOrganizations(ctx context.Context, req *ttnpb.List
 		return nil, err
 	}
 
+	if req.Deleted {
+		return orgs, nil
+	}
+
 	for i, org := range orgs.Organizations {
 		if rights.RequireOrganization(ctx, org.OrganizationIdentifiers, ttnpb.RIGHT_ORGANIZATION_INFO) != nil {
 			orgs.Organizations[i] = org.PublicSafe()
@@ -230,6 +255,30 @@ func (is *IdentityServer) deleteOrganization(ctx context.Context, ids *ttnpb.Org
 	return ttnpb.Empty, nil
 }
 
+func (is *IdentityServer) restoreOrganization(ctx context.Context, ids *ttnpb.OrganizationIdentifiers) (*types.Empty, error) {
+	if err := rights.RequireOrganization(store.WithSoftDeleted(ctx, false), *ids, ttnpb.RIGHT_ORGANIZATION_DELETE); err != nil {
+		return nil, err
+	}
+	err := is.withDatabase(ctx, func(db *gorm.DB) error {
+		return store.GetOrganizationStore(db).RestoreOrganization(ctx, ids)
+	})
+	if err != nil {
+		return nil, err
+	}
+	events.Publish(evtRestoreOrganization(ctx, ids, nil))
+	return ttnpb.Empty, nil
+}
+
+func (is *IdentityServer) purgeOrganization(ctx context.Context, ids *ttnpb.OrganizationIdentifiers) (*types.Empty, error) {
+	if err := is.RequireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	if err := is.purgeEntity(ctx, ids); err != nil {
+		return nil, err
+	}
+	return ttnpb.Empty, nil
+}
+
 type organizationRegistry struct {
 	*IdentityServer
 }
@@ -253,3 +302,11 @@ func (or *organizationRegistry) Update(ctx context.Context, req *ttnpb.UpdateOrg
 func (or *organizationRegistry) Delete(ctx context.Context, req *ttnpb.OrganizationIdentifiers) (*types.Empty, error) {
 	return or.deleteOrganization(ctx, req)
 }
+
+func (or *organizationRegistry) Restore(ctx context.Context, req *ttnpb.OrganizationIdentifiers) (*types.Empty, error) {
+	return or.restoreOrganization(ctx, req)
+}
+
+func (or *organizationRegistry) Purge(ctx context.Context, req *ttnpb.OrganizationIdentifiers) (*types.Empty, error) {
+	return or.purgeOrganization(ctx, req)
+}
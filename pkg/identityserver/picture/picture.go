@@ -29,6 +29,7 @@ import (
 	"go.thethings.network/lorawan-stack/pkg/errors"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
 	"gocloud.dev/blob"
+	"gocloud.dev/gcerrors"
 )
 
 func settings(format string) (encodingFormat imaging.Format, mimeType, extension string) {
@@ -173,3 +174,17 @@ func Store(ctx context.Context, bucket *blob.Bucket, prefix string, pic *ttnpb.P
 		Sizes: imagesBySize,
 	}, nil
 }
+
+// Delete the stored sizes of the picture from the bucket.
+// External pictures and sizes that no longer exist in the bucket are skipped.
+func Delete(ctx context.Context, bucket *blob.Bucket, pic *ttnpb.Picture) error {
+	for _, key := range pic.GetSizes() {
+		if key == "" || strings.Contains(key, "://") {
+			continue
+		}
+		if err := bucket.Delete(ctx, key); err != nil && gcerrors.Code(err) != gcerrors.NotFound {
+			return err
+		}
+	}
+	return nil
+}
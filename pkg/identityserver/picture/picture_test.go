@@ -116,4 +116,17 @@ func TestStore(t *testing.T) {
 	if a.So(pic, should.NotBeNil) && a.So(pic.Sizes, should.ContainKey, uint32(400)) {
 		a.So(pic.Sizes[400], should.Equal, "picture/400.png")
 	}
+
+	err = picture.Delete(ctx, bucket, pic)
+
+	a.So(err, should.BeNil)
+	for _, key := range pic.GetSizes() {
+		exists, err := bucket.Exists(ctx, key)
+		a.So(err, should.BeNil)
+		a.So(exists, should.BeFalse)
+	}
+
+	err = picture.Delete(ctx, bucket, pic)
+
+	a.So(err, should.BeNil)
 }
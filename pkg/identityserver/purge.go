@@ -0,0 +1,170 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package identityserver
+
+import (
+	"context"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/jinzhu/gorm"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/events"
+	"go.thethings.network/lorawan-stack/pkg/identityserver/picture"
+	"go.thethings.network/lorawan-stack/pkg/identityserver/store"
+	"go.thethings.network/lorawan-stack/pkg/log"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+)
+
+var idsFieldMask = &types.FieldMask{Paths: []string{"ids"}}
+
+var errPurgeEntityType = errors.DefineInvalidArgument("purge_entity_type", "can not purge entity of type `{entity_type}`")
+
+// purgeEntity permanently deletes the (soft-deleted) entity with the given
+// identifiers, along with the data that depends on it.
+func (is *IdentityServer) purgeEntity(ctx context.Context, id ttnpb.Identifiers) error {
+	var (
+		evt            events.Event
+		profilePicture *ttnpb.Picture
+	)
+	err := is.withDatabase(ctx, func(db *gorm.DB) error {
+		switch ids := id.(type) {
+		case *ttnpb.ApplicationIdentifiers:
+			total, err := store.GetEndDeviceStore(db).CountEndDevices(ctx, ids)
+			if err != nil {
+				return err
+			}
+			if total > 0 {
+				return errApplicationHasDevices.WithAttributes("count", int(total))
+			}
+			evt = evtPurgeApplication(ctx, ids, nil)
+			return store.GetApplicationStore(db).PurgeApplication(ctx, ids)
+		case *ttnpb.ClientIdentifiers:
+			evt = evtPurgeClient(ctx, ids, nil)
+			return store.GetClientStore(db).PurgeClient(ctx, ids)
+		case *ttnpb.GatewayIdentifiers:
+			evt = evtPurgeGateway(ctx, ids, nil)
+			return store.GetGatewayStore(db).PurgeGateway(ctx, ids)
+		case *ttnpb.OrganizationIdentifiers:
+			evt = evtPurgeOrganization(ctx, ids, nil)
+			return store.GetOrganizationStore(db).PurgeOrganization(ctx, ids)
+		case *ttnpb.UserIdentifiers:
+			usrStore := store.GetUserStore(db)
+			usr, err := usrStore.GetUser(store.WithSoftDeleted(ctx, true), ids, &types.FieldMask{Paths: []string{"profile_picture"}})
+			if err != nil {
+				return err
+			}
+			profilePicture = usr.ProfilePicture
+			evt = evtPurgeUser(ctx, ids, nil)
+			return usrStore.PurgeUser(ctx, ids)
+		default:
+			return errPurgeEntityType.WithAttributes("entity_type", id.EntityType())
+		}
+	})
+	if err != nil {
+		return err
+	}
+	if profilePicture != nil {
+		bucket, err := is.Component.GetBaseConfig(ctx).Blob.Bucket(ctx, is.configFromContext(ctx).ProfilePicture.Bucket)
+		if err != nil {
+			return err
+		}
+		if err = picture.Delete(ctx, bucket, profilePicture); err != nil {
+			log.FromContext(ctx).WithError(err).Warn("Failed to delete profile picture of purged user")
+		}
+	}
+	events.Publish(evt)
+	return nil
+}
+
+// purgeDeleted purges the entities that were deleted before the given time.
+func (is *IdentityServer) purgeDeleted(ctx context.Context, deletedBefore time.Time) error {
+	var ids []ttnpb.Identifiers
+	err := is.withDatabase(ctx, func(db *gorm.DB) error {
+		findCtx := store.WithSoftDeletedBefore(ctx, deletedBefore)
+		apps, err := store.GetApplicationStore(db).FindApplications(findCtx, nil, idsFieldMask)
+		if err != nil {
+			return err
+		}
+		for _, app := range apps {
+			ids = append(ids, &app.ApplicationIdentifiers)
+		}
+		clis, err := store.GetClientStore(db).FindClients(findCtx, nil, idsFieldMask)
+		if err != nil {
+			return err
+		}
+		for _, cli := range clis {
+			ids = append(ids, &cli.ClientIdentifiers)
+		}
+		gtws, err := store.GetGatewayStore(db).FindGateways(findCtx, nil, idsFieldMask)
+		if err != nil {
+			return err
+		}
+		for _, gtw := range gtws {
+			ids = append(ids, &gtw.GatewayIdentifiers)
+		}
+		orgs, err := store.GetOrganizationStore(db).FindOrganizations(findCtx, nil, idsFieldMask)
+		if err != nil {
+			return err
+		}
+		for _, org := range orgs {
+			ids = append(ids, &org.OrganizationIdentifiers)
+		}
+		usrs, err := store.GetUserStore(db).FindUsers(findCtx, nil, idsFieldMask)
+		if err != nil {
+			return err
+		}
+		for _, usr := range usrs {
+			ids = append(ids, &usr.UserIdentifiers)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	for _, id := range ids {
+		logger := log.FromContext(ctx).WithFields(log.Fields(
+			"entity_type", id.EntityType(),
+			"entity_id", id.IDString(),
+		))
+		if err := is.purgeEntity(ctx, id); err != nil {
+			if errors.IsNotFound(err) {
+				continue
+			}
+			// Entities that can not be purged yet (such as applications with devices) are retried later.
+			logger.WithError(err).Warn("Failed to purge deleted entity")
+			continue
+		}
+		logger.Debug("Purged deleted entity")
+	}
+	return nil
+}
+
+// purgeDeletedTask periodically purges entities that were deleted longer than
+// the configured retention ago.
+func (is *IdentityServer) purgeDeletedTask(ctx context.Context) error {
+	ticker := time.NewTicker(is.config.Purge.Interval)
+	defer ticker.Stop()
+	for {
+		if err := is.purgeDeleted(ctx, time.Now().Add(-is.config.Purge.Retention)); err != nil {
+			log.FromContext(ctx).WithError(err).Warn("Failed to purge deleted entities")
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
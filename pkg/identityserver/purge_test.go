@@ -0,0 +1,139 @@
+// Copyright © 2026 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package identityserver
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jinzhu/gorm"
+	"github.com/smartystreets/assertions"
+	"github.com/smartystreets/assertions/should"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/identityserver/store"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+	"google.golang.org/grpc"
+)
+
+func TestPurgeDeleted(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+
+	testWithIdentityServer(t, func(is *IdentityServer, cc *grpc.ClientConn) {
+		usrIDs := ttnpb.UserIdentifiers{UserID: "purge-test-user"}
+		liveAppIDs := ttnpb.ApplicationIdentifiers{ApplicationID: "purge-test-live-app"}
+		deletedAppIDs := ttnpb.ApplicationIdentifiers{ApplicationID: "purge-test-deleted-app"}
+
+		bucket, err := is.Component.GetBaseConfig(ctx).Blob.Bucket(ctx, is.config.ProfilePicture.Bucket)
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		pictureKey := "purge-test-user/original.png"
+		if err = bucket.WriteAll(ctx, pictureKey, []byte("picture"), nil); !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+
+		err = is.withDatabase(ctx, func(db *gorm.DB) error {
+			if _, err := store.GetUserStore(db).CreateUser(ctx, &ttnpb.User{
+				UserIdentifiers:     usrIDs,
+				PrimaryEmailAddress: "purge-test-user@example.com",
+				ProfilePicture: &ttnpb.Picture{
+					Sizes: map[uint32]string{0: pictureKey},
+				},
+			}); err != nil {
+				return err
+			}
+			for _, appIDs := range []ttnpb.ApplicationIdentifiers{liveAppIDs, deletedAppIDs} {
+				if _, err := store.GetApplicationStore(db).CreateApplication(ctx, &ttnpb.Application{
+					ApplicationIdentifiers: appIDs,
+				}); err != nil {
+					return err
+				}
+			}
+			if err := store.GetMembershipStore(db).SetMember(ctx, usrIDs.OrganizationOrUserIdentifiers(), liveAppIDs, ttnpb.RightsFrom(ttnpb.RIGHT_APPLICATION_ALL)); err != nil {
+				return err
+			}
+			keyStore := store.GetAPIKeyStore(db)
+			if err := keyStore.CreateAPIKey(ctx, usrIDs, &ttnpb.APIKey{
+				ID:     "PURGETESTUSERKEY",
+				Key:    "PURGETESTUSERKEY",
+				Rights: []ttnpb.Right{ttnpb.RIGHT_USER_ALL},
+			}); err != nil {
+				return err
+			}
+			if err := keyStore.CreateAPIKey(ctx, deletedAppIDs, &ttnpb.APIKey{
+				ID:     "PURGETESTAPPKEY",
+				Key:    "PURGETESTAPPKEY",
+				Rights: []ttnpb.Right{ttnpb.RIGHT_APPLICATION_ALL},
+			}); err != nil {
+				return err
+			}
+			if err := store.GetUserStore(db).DeleteUser(ctx, &usrIDs); err != nil {
+				return err
+			}
+			return store.GetApplicationStore(db).DeleteApplication(ctx, &deletedAppIDs)
+		})
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+
+		// Nothing is deleted before the retention.
+		err = is.purgeDeleted(ctx, time.Now().Add(-time.Hour))
+		a.So(err, should.BeNil)
+
+		err = is.withDatabase(ctx, func(db *gorm.DB) error {
+			_, err := store.GetUserStore(db).GetUser(store.WithSoftDeleted(ctx, true), &usrIDs, nil)
+			return err
+		})
+		a.So(err, should.BeNil)
+
+		err = is.purgeDeleted(ctx, time.Now().Add(time.Hour))
+		a.So(err, should.BeNil)
+
+		err = is.withDatabase(ctx, func(db *gorm.DB) error {
+			_, err := store.GetUserStore(db).GetUser(store.WithSoftDeleted(ctx, false), &usrIDs, nil)
+			if a.So(err, should.NotBeNil) {
+				a.So(errors.IsNotFound(err), should.BeTrue)
+			}
+			_, err = store.GetApplicationStore(db).GetApplication(store.WithSoftDeleted(ctx, false), &deletedAppIDs, nil)
+			if a.So(err, should.NotBeNil) {
+				a.So(errors.IsNotFound(err), should.BeTrue)
+			}
+
+			_, err = store.GetApplicationStore(db).GetApplication(ctx, &liveAppIDs, nil)
+			a.So(err, should.BeNil)
+
+			for _, keyID := range []string{"PURGETESTUSERKEY", "PURGETESTAPPKEY"} {
+				_, _, err = store.GetAPIKeyStore(db).GetAPIKey(ctx, keyID)
+				if a.So(err, should.NotBeNil) {
+					a.So(errors.IsNotFound(err), should.BeTrue)
+				}
+			}
+
+			members, err := store.GetMembershipStore(db).FindMembers(ctx, liveAppIDs)
+			a.So(err, should.BeNil)
+			for member := range members {
+				a.So(member.GetUserIDs().GetUserID(), should.NotEqual, usrIDs.UserID)
+			}
+			return nil
+		})
+		a.So(err, should.BeNil)
+
+		exists, err := bucket.Exists(ctx, pictureKey)
+		a.So(err, should.BeNil)
+		a.So(exists, should.BeFalse)
+	})
+}
@@ -268,6 +268,10 @@ func (rs *registrySearch) SearchEndDevices(ctx context.Context, req *ttnpb.Searc
 	if err != nil {
 		return nil, err
 	}
+	restrictedDeviceIDs, err := rights.EndDeviceRestriction(ctx, req.ApplicationIdentifiers)
+	if err != nil {
+		return nil, err
+	}
 	req.FieldMask.Paths = cleanFieldMaskPaths(ttnpb.EndDeviceFieldPathsNested, req.FieldMask.Paths, getPaths, nil)
 	ctx = store.WithOrder(ctx, req.Order)
 	var total uint64
@@ -283,6 +287,19 @@ func (rs *registrySearch) SearchEndDevices(ctx context.Context, req *ttnpb.Searc
 		if err != nil {
 			return err
 		}
+		if len(restrictedDeviceIDs) > 0 {
+			allowed := make(map[string]bool, len(restrictedDeviceIDs))
+			for _, deviceID := range restrictedDeviceIDs {
+				allowed[deviceID] = true
+			}
+			filtered := ids[:0]
+			for _, id := range ids {
+				if allowed[id.DeviceID] {
+					filtered = append(filtered, id)
+				}
+			}
+			ids = filtered
+		}
 		if len(ids) == 0 {
 			return nil
 		}
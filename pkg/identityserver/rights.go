@@ -120,6 +120,24 @@ func (is *IdentityServer) getRights(ctx context.Context, entityID ttnpb.Identifi
 	return entityRights, universalRights, err
 }
 
+// withRestrictions returns the rights with the restrictions of the API key of
+// the caller, if any. The restrictions are enforced by rights.Require* in the
+// component that handles the request, as only that component knows the source
+// address and end device of the original request.
+func (is *IdentityServer) withRestrictions(ctx context.Context, rights *ttnpb.Rights) (*ttnpb.Rights, error) {
+	if rights == nil {
+		return nil, nil
+	}
+	authInfo, err := is.authInfo(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if apiKey := authInfo.GetAPIKey(); apiKey != nil {
+		rights.Restrictions = apiKey.APIKey.Restrictions
+	}
+	return rights, nil
+}
+
 // ApplicationRights returns the rights the caller has on the given application.
 func (is *IdentityServer) ApplicationRights(ctx context.Context, appIDs ttnpb.ApplicationIdentifiers) (*ttnpb.Rights, error) {
 	entity, universal, err := is.getRights(ctx, appIDs)
@@ -127,7 +145,7 @@ func (is *IdentityServer) ApplicationRights(ctx context.Context, appIDs ttnpb.Ap
 		return nil, err
 	}
 	if entity != nil {
-		return entity.Union(universal), nil
+		return is.withRestrictions(ctx, entity.Union(universal))
 	}
 	if !is.IsAdmin(ctx) && universal == nil {
 		return &ttnpb.Rights{}, nil
@@ -139,7 +157,7 @@ func (is *IdentityServer) ApplicationRights(ctx context.Context, appIDs ttnpb.Ap
 	if err != nil {
 		return nil, err
 	}
-	return universal, nil
+	return is.withRestrictions(ctx, universal)
 }
 
 // ClientRights returns the rights the caller has on the given client.
@@ -149,7 +167,7 @@ func (is *IdentityServer) ClientRights(ctx context.Context, cliIDs ttnpb.ClientI
 		return nil, err
 	}
 	if entity != nil {
-		return entity.Union(universal), nil
+		return is.withRestrictions(ctx, entity.Union(universal))
 	}
 	if !is.IsAdmin(ctx) && universal == nil {
 		return &ttnpb.Rights{}, nil
@@ -161,7 +179,7 @@ func (is *IdentityServer) ClientRights(ctx context.Context, cliIDs ttnpb.ClientI
 	if err != nil {
 		return nil, err
 	}
-	return universal, nil
+	return is.withRestrictions(ctx, universal)
 }
 
 // GatewayRights returns the rights the caller has on the given gateway.
@@ -174,7 +192,7 @@ func (is *IdentityServer) GatewayRights(ctx context.Context, gtwIDs ttnpb.Gatewa
 		return nil, err
 	}
 	if entity != nil {
-		return entity.Union(universal), nil
+		return is.withRestrictions(ctx, entity.Union(universal))
 	}
 	if !is.IsAdmin(ctx) && universal == nil {
 		return &ttnpb.Rights{}, nil
@@ -186,7 +204,7 @@ func (is *IdentityServer) GatewayRights(ctx context.Context, gtwIDs ttnpb.Gatewa
 	if err != nil {
 		return nil, err
 	}
-	return universal, nil
+	return is.withRestrictions(ctx, universal)
 }
 
 // OrganizationRights returns the rights the caller has on the given organization.
@@ -199,7 +217,7 @@ func (is *IdentityServer) OrganizationRights(ctx context.Context, orgIDs ttnpb.O
 		return nil, err
 	}
 	if entity != nil {
-		return entity.Union(universal), nil
+		return is.withRestrictions(ctx, entity.Union(universal))
 	}
 	if !is.IsAdmin(ctx) && universal == nil {
 		return &ttnpb.Rights{}, nil
@@ -211,7 +229,7 @@ func (is *IdentityServer) OrganizationRights(ctx context.Context, orgIDs ttnpb.O
 	if err != nil {
 		return nil, err
 	}
-	return universal, nil
+	return is.withRestrictions(ctx, universal)
 }
 
 // UserRights returns the rights the caller has on the given user.
@@ -224,7 +242,7 @@ func (is *IdentityServer) UserRights(ctx context.Context, userIDs ttnpb.UserIden
 		return nil, err
 	}
 	if entity != nil {
-		return entity.Union(universal), nil
+		return is.withRestrictions(ctx, entity.Union(universal))
 	}
 	if !is.IsAdmin(ctx) && universal == nil {
 		return &ttnpb.Rights{}, nil
@@ -236,5 +254,5 @@ func (is *IdentityServer) UserRights(ctx context.Context, userIDs ttnpb.UserIden
 	if err != nil {
 		return nil, err
 	}
-	return universal, nil
+	return is.withRestrictions(ctx, universal)
 }
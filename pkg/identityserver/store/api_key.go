@@ -14,7 +14,12 @@
 
 package store
 
-import "go.thethings.network/lorawan-stack/pkg/ttnpb"
+import (
+	"time"
+
+	"github.com/lib/pq"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+)
 
 // APIKey model.
 type APIKey struct {
@@ -26,6 +31,12 @@ type APIKey struct {
 	Rights Rights `gorm:"type:INT ARRAY"`
 	Name   string `gorm:"type:VARCHAR"`
 
+	ExpiresAt  *time.Time
+	LastUsedAt *time.Time
+
+	SourceCIDRs  pq.StringArray `gorm:"type:VARCHAR ARRAY;column:source_cidrs"`
+	EndDeviceIDs pq.StringArray `gorm:"type:VARCHAR ARRAY;column:end_device_ids"`
+
 	EntityID   string `gorm:"type:UUID;index:api_key_entity_index;not null"`
 	EntityType string `gorm:"type:VARCHAR(32);index:api_key_entity_index;not null"`
 }
@@ -35,10 +46,27 @@ func init() {
 }
 
 func (k APIKey) toPB() *ttnpb.APIKey {
-	return &ttnpb.APIKey{
-		ID:     k.APIKeyID,
-		Key:    k.Key,
-		Name:   k.Name,
-		Rights: k.Rights.Rights,
+	pb := &ttnpb.APIKey{
+		ID:         k.APIKeyID,
+		Key:        k.Key,
+		Name:       k.Name,
+		Rights:     k.Rights.Rights,
+		ExpiresAt:  cleanTimePtr(k.ExpiresAt),
+		LastUsedAt: cleanTimePtr(k.LastUsedAt),
 	}
+	if len(k.SourceCIDRs) > 0 || len(k.EndDeviceIDs) > 0 {
+		pb.Restrictions = &ttnpb.APIKeyRestrictions{
+			SourceCIDRs:  k.SourceCIDRs,
+			EndDeviceIDs: k.EndDeviceIDs,
+		}
+	}
+	return pb
+}
+
+func (k *APIKey) fromPB(pb *ttnpb.APIKey) {
+	k.Name = pb.Name
+	k.Rights = Rights{Rights: pb.Rights}
+	k.ExpiresAt = cleanTimePtr(pb.ExpiresAt)
+	k.SourceCIDRs = pq.StringArray(pb.GetRestrictions().GetSourceCIDRs())
+	k.EndDeviceIDs = pq.StringArray(pb.GetRestrictions().GetEndDeviceIDs())
 }
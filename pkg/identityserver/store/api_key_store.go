@@ -17,6 +17,7 @@ package store
 import (
 	"context"
 	"runtime/trace"
+	"time"
 
 	"github.com/jinzhu/gorm"
 	"go.thethings.network/lorawan-stack/pkg/errors"
@@ -41,11 +42,10 @@ func (s *apiKeyStore) CreateAPIKey(ctx context.Context, entityID ttnpb.Identifie
 	model := &APIKey{
 		APIKeyID:   key.ID,
 		Key:        key.Key,
-		Rights:     Rights{Rights: key.Rights},
-		Name:       key.Name,
 		EntityID:   entity.PrimaryKey(),
 		EntityType: entityTypeForID(entityID),
 	}
+	model.fromPB(key)
 	return s.createEntity(ctx, model)
 }
 
@@ -125,10 +125,23 @@ func (s *apiKeyStore) UpdateAPIKey(ctx context.Context, entityID ttnpb.Identifie
 	if len(key.Rights) == 0 {
 		return nil, query.Delete(&keyModel).Error
 	}
-	keyModel.Name = key.Name
-	keyModel.Rights = Rights{Rights: key.Rights}
-	if err = query.Select("name", "rights", "updated_at").Save(&keyModel).Error; err != nil {
+	keyModel.fromPB(key)
+	if err = query.Select("name", "rights", "expires_at", "source_cidrs", "end_device_ids", "updated_at").Save(&keyModel).Error; err != nil {
 		return nil, err
 	}
 	return keyModel.toPB(), nil
 }
+
+func (s *apiKeyStore) UpdateAPIKeyLastUsed(ctx context.Context, lastUsed map[string]time.Time) error {
+	defer trace.StartRegion(ctx, "update api key last used").End()
+	for id, t := range lastUsed {
+		err := s.query(ctx, APIKey{}).
+			Where(APIKey{APIKeyID: id}).
+			Where("last_used_at IS NULL OR last_used_at < ?", t).
+			UpdateColumn("last_used_at", cleanTime(t)).Error
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
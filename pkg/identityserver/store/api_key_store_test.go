@@ -18,6 +18,7 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/jinzhu/gorm"
 	"github.com/smartystreets/assertions"
@@ -143,5 +144,64 @@ func TestAPIKeyStore(t *testing.T) {
 				a.So(keys, should.HaveLength, 0)
 			})
 		}
+
+		t.Run("Expiry and restrictions", func(t *testing.T) {
+			a := assertions.New(t)
+
+			expiresAt := cleanTime(time.Now().Add(time.Hour))
+			key := &ttnpb.APIKey{
+				ID:        "RESTRICTEDKEYID",
+				Key:       "RESTRICTEDKEY",
+				Name:      "Restricted API key",
+				Rights:    []ttnpb.Right{ttnpb.RIGHT_APPLICATION_TRAFFIC_READ},
+				ExpiresAt: &expiresAt,
+				Restrictions: &ttnpb.APIKeyRestrictions{
+					SourceCIDRs:  []string{"192.0.2.0/24"},
+					EndDeviceIDs: []string{"test-dev"},
+				},
+			}
+
+			err := store.CreateAPIKey(ctx, appIDs, key)
+
+			a.So(err, should.BeNil)
+
+			_, got, err := store.GetAPIKey(ctx, key.ID)
+
+			if a.So(err, should.BeNil) && a.So(got, should.NotBeNil) {
+				if a.So(got.ExpiresAt, should.NotBeNil) {
+					a.So(got.ExpiresAt.Equal(expiresAt), should.BeTrue)
+				}
+				a.So(got.LastUsedAt, should.BeNil)
+				a.So(got.Restrictions, should.Resemble, key.Restrictions)
+			}
+
+			lastUsed := cleanTime(time.Now())
+
+			err = store.UpdateAPIKeyLastUsed(ctx, map[string]time.Time{key.ID: lastUsed})
+
+			a.So(err, should.BeNil)
+
+			// An earlier time does not overwrite the last used time.
+			err = store.UpdateAPIKeyLastUsed(ctx, map[string]time.Time{key.ID: lastUsed.Add(-time.Minute)})
+
+			a.So(err, should.BeNil)
+
+			_, got, err = store.GetAPIKey(ctx, key.ID)
+
+			if a.So(err, should.BeNil) && a.So(got.LastUsedAt, should.NotBeNil) {
+				a.So(got.LastUsedAt.Equal(lastUsed), should.BeTrue)
+			}
+
+			updated, err := store.UpdateAPIKey(ctx, appIDs, &ttnpb.APIKey{
+				ID:     key.ID,
+				Name:   key.Name,
+				Rights: key.Rights,
+			})
+
+			if a.So(err, should.BeNil) && a.So(updated, should.NotBeNil) {
+				a.So(updated.ExpiresAt, should.BeNil)
+				a.So(updated.Restrictions, should.BeNil)
+			}
+		})
 	})
 }
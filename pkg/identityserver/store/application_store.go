@@ -154,3 +154,13 @@ func (s *applicationStore) DeleteApplication(ctx context.Context, id *ttnpb.Appl
 	defer trace.StartRegion(ctx, "delete application").End()
 	return s.deleteEntity(ctx, id)
 }
+
+func (s *applicationStore) RestoreApplication(ctx context.Context, id *ttnpb.ApplicationIdentifiers) error {
+	defer trace.StartRegion(ctx, "restore application").End()
+	return s.restoreEntity(ctx, id)
+}
+
+func (s *applicationStore) PurgeApplication(ctx context.Context, id *ttnpb.ApplicationIdentifiers) error {
+	defer trace.StartRegion(ctx, "purge application").End()
+	return s.purgeEntity(ctx, id)
+}
@@ -128,5 +128,58 @@ func TestClientStore(t *testing.T) {
 
 		a.So(err, should.BeNil)
 		a.So(list, should.BeEmpty)
+
+		list, err = store.FindClients(WithSoftDeleted(ctx, true), nil, nil)
+
+		a.So(err, should.BeNil)
+		if a.So(list, should.HaveLength, 1) {
+			a.So(list[0].ClientID, should.Equal, "foo")
+		}
+
+		list, err = store.FindClients(WithSoftDeletedBefore(ctx, time.Now().Add(-1*time.Hour)), nil, nil)
+
+		a.So(err, should.BeNil)
+		a.So(list, should.BeEmpty)
+
+		err = store.RestoreClient(ctx, &ttnpb.ClientIdentifiers{ClientID: "foo"})
+
+		a.So(err, should.BeNil)
+
+		got, err = store.GetClient(ctx, &ttnpb.ClientIdentifiers{ClientID: "foo"}, nil)
+
+		a.So(err, should.BeNil)
+		if a.So(got, should.NotBeNil) {
+			a.So(got.ClientID, should.Equal, "foo")
+		}
+
+		err = store.RestoreClient(ctx, &ttnpb.ClientIdentifiers{ClientID: "foo"})
+
+		if a.So(err, should.NotBeNil) {
+			a.So(errors.IsNotFound(err), should.BeTrue)
+		}
+
+		err = store.PurgeClient(ctx, &ttnpb.ClientIdentifiers{ClientID: "foo"})
+
+		if a.So(err, should.NotBeNil) {
+			a.So(errors.IsNotFound(err), should.BeTrue)
+		}
+
+		got, err = store.GetClient(ctx, &ttnpb.ClientIdentifiers{ClientID: "foo"}, nil)
+
+		a.So(err, should.BeNil)
+		a.So(got, should.NotBeNil)
+
+		err = store.DeleteClient(ctx, &ttnpb.ClientIdentifiers{ClientID: "foo"})
+
+		a.So(err, should.BeNil)
+
+		err = store.PurgeClient(ctx, &ttnpb.ClientIdentifiers{ClientID: "foo"})
+
+		a.So(err, should.BeNil)
+
+		list, err = store.FindClients(WithSoftDeleted(ctx, false), nil, nil)
+
+		a.So(err, should.BeNil)
+		a.So(list, should.BeEmpty)
 	})
 }
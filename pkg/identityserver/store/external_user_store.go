@@ -0,0 +1,71 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package store
+
+import (
+	"context"
+	"runtime/trace"
+
+	"github.com/jinzhu/gorm"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+)
+
+// GetExternalUserStore returns an ExternalUserStore on the given db (or transaction).
+func GetExternalUserStore(db *gorm.DB) ExternalUserStore {
+	return &externalUserStore{store: newStore(db)}
+}
+
+type externalUserStore struct {
+	*store
+}
+
+func (s *externalUserStore) GetExternalUser(ctx context.Context, providerID, externalID string) (*ttnpb.UserIdentifiers, error) {
+	defer trace.StartRegion(ctx, "get external user").End()
+	var externalUserModel ExternalUser
+	err := s.query(ctx, ExternalUser{}).
+		Where(ExternalUser{ProviderID: providerID, ExternalID: externalID}).
+		First(&externalUserModel).Error
+	if err != nil {
+		if gorm.IsRecordNotFoundError(err) {
+			return nil, errExternalUserNotFound.WithAttributes("provider_id", providerID, "external_id", externalID)
+		}
+		return nil, err
+	}
+	var userModel User
+	err = s.query(ctx, User{}).
+		Where(&User{Model: Model{ID: externalUserModel.UserID}}).
+		Preload("Account").
+		First(&userModel).Error
+	if err != nil {
+		if gorm.IsRecordNotFoundError(err) {
+			return nil, errExternalUserNotFound.WithAttributes("provider_id", providerID, "external_id", externalID)
+		}
+		return nil, err
+	}
+	return &ttnpb.UserIdentifiers{UserID: userModel.Account.UID}, nil
+}
+
+func (s *externalUserStore) CreateExternalUser(ctx context.Context, userIDs *ttnpb.UserIdentifiers, providerID, externalID string) error {
+	defer trace.StartRegion(ctx, "create external user").End()
+	user, err := s.findEntity(ctx, userIDs, "id")
+	if err != nil {
+		return err
+	}
+	return s.createEntity(ctx, &ExternalUser{
+		UserID:     user.PrimaryKey(),
+		ProviderID: providerID,
+		ExternalID: externalID,
+	})
+}
@@ -0,0 +1,81 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package store
+
+import (
+	"testing"
+
+	"github.com/jinzhu/gorm"
+	"github.com/smartystreets/assertions"
+	"github.com/smartystreets/assertions/should"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+)
+
+func TestExternalUserStore(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+
+	WithDB(t, func(t *testing.T, db *gorm.DB) {
+		prepareTest(db, &Account{}, &User{}, &ExternalUser{})
+
+		userIDs := ttnpb.UserIdentifiers{UserID: "test"}
+		doesNotExistIDs := ttnpb.UserIdentifiers{UserID: "does_not_exist"}
+
+		_, err := GetUserStore(db).CreateUser(ctx, &ttnpb.User{
+			UserIdentifiers:     userIDs,
+			PrimaryEmailAddress: "Test@Example.com",
+		})
+
+		a.So(err, should.BeNil)
+
+		usr, err := GetUserStore(db).GetUser(ctx, &ttnpb.UserIdentifiers{Email: "test@example.com"}, nil)
+
+		a.So(err, should.BeNil)
+		if a.So(usr, should.NotBeNil) {
+			a.So(usr.UserID, should.Equal, "test")
+		}
+
+		store := GetExternalUserStore(db)
+
+		err = store.CreateExternalUser(ctx, &doesNotExistIDs, "provider", "subject")
+
+		if a.So(err, should.NotBeNil) {
+			a.So(errors.IsNotFound(err), should.BeTrue)
+		}
+
+		_, err = store.GetExternalUser(ctx, "provider", "subject")
+
+		if a.So(err, should.NotBeNil) {
+			a.So(errors.IsNotFound(err), should.BeTrue)
+		}
+
+		err = store.CreateExternalUser(ctx, &userIDs, "provider", "subject")
+
+		a.So(err, should.BeNil)
+
+		ids, err := store.GetExternalUser(ctx, "provider", "subject")
+
+		a.So(err, should.BeNil)
+		a.So(ids, should.Resemble, &userIDs)
+
+		_, err = store.GetExternalUser(ctx, "other-provider", "subject")
+
+		if a.So(err, should.NotBeNil) {
+			a.So(errors.IsNotFound(err), should.BeTrue)
+		}
+	})
+}
@@ -164,3 +164,13 @@ func (s *gatewayStore) DeleteGateway(ctx context.Context, id *ttnpb.GatewayIdent
 	defer trace.StartRegion(ctx, "delete gateway").End()
 	return s.deleteEntity(ctx, id)
 }
+
+func (s *gatewayStore) RestoreGateway(ctx context.Context, id *ttnpb.GatewayIdentifiers) error {
+	defer trace.StartRegion(ctx, "restore gateway").End()
+	return s.restoreEntity(ctx, id)
+}
+
+func (s *gatewayStore) PurgeGateway(ctx context.Context, id *ttnpb.GatewayIdentifiers) error {
+	defer trace.StartRegion(ctx, "purge gateway").End()
+	return s.purgeEntity(ctx, id)
+}
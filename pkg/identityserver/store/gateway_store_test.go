@@ -173,6 +173,59 @@ func TestGatewayStore(t *testing.T) {
 		a.So(err, should.BeNil)
 		a.So(list, should.BeEmpty)
 
+		list, err = store.FindGateways(WithSoftDeleted(ctx, true), nil, nil)
+
+		a.So(err, should.BeNil)
+		if a.So(list, should.HaveLength, 1) {
+			a.So(list[0].GatewayID, should.Equal, "foo")
+		}
+
+		list, err = store.FindGateways(WithSoftDeletedBefore(ctx, time.Now().Add(-1*time.Hour)), nil, nil)
+
+		a.So(err, should.BeNil)
+		a.So(list, should.BeEmpty)
+
+		err = store.RestoreGateway(ctx, &ttnpb.GatewayIdentifiers{GatewayID: "foo"})
+
+		a.So(err, should.BeNil)
+
+		got, err = store.GetGateway(ctx, &ttnpb.GatewayIdentifiers{GatewayID: "foo"}, nil)
+
+		a.So(err, should.BeNil)
+		if a.So(got, should.NotBeNil) {
+			a.So(got.GatewayID, should.Equal, "foo")
+		}
+
+		err = store.RestoreGateway(ctx, &ttnpb.GatewayIdentifiers{GatewayID: "foo"})
+
+		if a.So(err, should.NotBeNil) {
+			a.So(errors.IsNotFound(err), should.BeTrue)
+		}
+
+		err = store.PurgeGateway(ctx, &ttnpb.GatewayIdentifiers{GatewayID: "foo"})
+
+		if a.So(err, should.NotBeNil) {
+			a.So(errors.IsNotFound(err), should.BeTrue)
+		}
+
+		got, err = store.GetGateway(ctx, &ttnpb.GatewayIdentifiers{GatewayID: "foo"}, nil)
+
+		a.So(err, should.BeNil)
+		a.So(got, should.NotBeNil)
+
+		err = store.DeleteGateway(ctx, &ttnpb.GatewayIdentifiers{GatewayID: "foo"})
+
+		a.So(err, should.BeNil)
+
+		err = store.PurgeGateway(ctx, &ttnpb.GatewayIdentifiers{GatewayID: "foo"})
+
+		a.So(err, should.BeNil)
+
+		list, err = store.FindGateways(WithSoftDeleted(ctx, false), nil, nil)
+
+		a.So(err, should.BeNil)
+		a.So(list, should.BeEmpty)
+
 		got, err = store.CreateGateway(ctx, &ttnpb.Gateway{
 			GatewayIdentifiers: ttnpb.GatewayIdentifiers{
 				GatewayID: "reuse-foo-eui",
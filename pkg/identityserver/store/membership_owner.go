@@ -0,0 +1,74 @@
+// Copyright © 2026 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package store
+
+import (
+	"context"
+
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+)
+
+var errLastOwner = errors.DefineFailedPrecondition(
+	"last_owner",
+	"`{member_id}` is the last member with all rights on `{entity_type}` `{entity_id}`",
+)
+
+// ownerRight returns the right that owners of the entity have.
+func ownerRight(entityID ttnpb.Identifiers) (ttnpb.Right, bool) {
+	switch entityID.EntityType() {
+	case "application":
+		return ttnpb.RIGHT_APPLICATION_ALL, true
+	case "client":
+		return ttnpb.RIGHT_CLIENT_ALL, true
+	case "gateway":
+		return ttnpb.RIGHT_GATEWAY_ALL, true
+	case "organization":
+		return ttnpb.RIGHT_ORGANIZATION_ALL, true
+	}
+	return 0, false
+}
+
+// CheckLastOwner returns an error if the member is the last direct member with
+// all rights on the entity, and setting its rights to the given rights would
+// leave the entity without such a member. Rights are deleted by passing no rights.
+func CheckLastOwner(ctx context.Context, s MembershipStore, id *ttnpb.OrganizationOrUserIdentifiers, entityID ttnpb.Identifiers, rights *ttnpb.Rights) error {
+	owner, ok := ownerRight(entityID)
+	if !ok || rights.Implied().IncludesAll(owner) {
+		return nil
+	}
+	members, err := s.FindMembers(ctx, entityID)
+	if err != nil {
+		return err
+	}
+	var isOwner bool
+	for memberIDs, memberRights := range members {
+		if !memberRights.Implied().IncludesAll(owner) {
+			continue
+		}
+		if memberIDs.EntityType() != id.EntityType() || memberIDs.IDString() != id.IDString() {
+			return nil
+		}
+		isOwner = true
+	}
+	if !isOwner {
+		return nil
+	}
+	return errLastOwner.WithAttributes(
+		"member_id", id.IDString(),
+		"entity_type", entityID.EntityType(),
+		"entity_id", entityID.IDString(),
+	)
+}
@@ -0,0 +1,100 @@
+// Copyright © 2026 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package store
+
+import (
+	"time"
+
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+)
+
+// Notification is a notification for a user.
+type Notification struct {
+	Model
+
+	User   *User
+	UserID string `gorm:"type:UUID;index:notification_user_index;not null"`
+
+	NotificationType int `gorm:"not null"`
+
+	// EntityType and EntityID refer to the (friendly) identifiers of the entity,
+	// so that notifications keep referring to entities that are purged.
+	EntityType string `gorm:"type:VARCHAR(32);not null"`
+	EntityID   string `gorm:"type:VARCHAR(36);not null"`
+
+	SenderID *string `gorm:"type:VARCHAR(36)"`
+
+	Subject string `gorm:"type:VARCHAR"`
+	Rights  Rights `gorm:"type:INT ARRAY"`
+
+	ReadAt *time.Time
+}
+
+func init() {
+	registerModel(&Notification{})
+}
+
+func (n Notification) toPB() *ttnpb.Notification {
+	pb := &ttnpb.Notification{
+		ID:               n.ID,
+		CreatedAt:        cleanTime(n.CreatedAt),
+		NotificationType: ttnpb.NotificationType(n.NotificationType),
+		Subject:          n.Subject,
+		Rights:           n.Rights.Rights,
+		ReadAt:           cleanTimePtr(n.ReadAt),
+	}
+	pb.EntityIDs = *buildIdentifiers(n.EntityType, n.EntityID).EntityIdentifiers()
+	if n.SenderID != nil {
+		pb.SenderIDs = &ttnpb.UserIdentifiers{UserID: *n.SenderID}
+	}
+	return pb
+}
+
+func (n *Notification) fromPB(pb *ttnpb.Notification) {
+	n.NotificationType = int(pb.NotificationType)
+	ids := pb.EntityIDs.Identifiers()
+	n.EntityType, n.EntityID = entityTypeForID(ids), ids.IDString()
+	if pb.SenderIDs != nil {
+		senderID := pb.SenderIDs.UserID
+		n.SenderID = &senderID
+	}
+	n.Subject = pb.Subject
+	n.Rights = Rights{Rights: pb.Rights}
+}
+
+// NotificationPreference is the preference of a user for a type of notifications.
+type NotificationPreference struct {
+	Model
+
+	User   *User
+	UserID string `gorm:"type:UUID;unique_index:notification_preference_index;not null"`
+
+	NotificationType int `gorm:"unique_index:notification_preference_index;not null"`
+
+	Subscribed bool
+	Email      bool
+}
+
+func init() {
+	registerModel(&NotificationPreference{})
+}
+
+func (p NotificationPreference) toPB() *ttnpb.NotificationPreference {
+	return &ttnpb.NotificationPreference{
+		NotificationType: ttnpb.NotificationType(p.NotificationType),
+		Subscribed:       p.Subscribed,
+		Email:            p.Email,
+	}
+}
@@ -0,0 +1,142 @@
+// Copyright © 2026 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package store
+
+import (
+	"context"
+	"runtime/trace"
+
+	"github.com/jinzhu/gorm"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+)
+
+// GetNotificationStore returns a NotificationStore on the given db (or transaction).
+func GetNotificationStore(db *gorm.DB) NotificationStore {
+	return &notificationStore{store: newStore(db)}
+}
+
+type notificationStore struct {
+	*store
+}
+
+func (s *notificationStore) CreateNotification(ctx context.Context, notification *ttnpb.Notification, receiverIDs []*ttnpb.UserIdentifiers) error {
+	defer trace.StartRegion(ctx, "create notification").End()
+	for _, receiverIDs := range receiverIDs {
+		user, err := s.findEntity(ctx, receiverIDs, "id")
+		if err != nil {
+			return err
+		}
+		model := &Notification{UserID: user.PrimaryKey()}
+		model.fromPB(notification)
+		if err = s.createEntity(ctx, model); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *notificationStore) ListNotifications(ctx context.Context, userIDs *ttnpb.UserIdentifiers, unreadOnly bool) ([]*ttnpb.Notification, error) {
+	defer trace.StartRegion(ctx, "list notifications").End()
+	user, err := s.findEntity(ctx, userIDs, "id")
+	if err != nil {
+		return nil, err
+	}
+	query := s.query(ctx, Notification{}).Where(Notification{UserID: user.PrimaryKey()})
+	if unreadOnly {
+		query = query.Where("read_at IS NULL")
+	}
+	query = query.Order(orderFromContext(ctx, "notifications", "created_at", "DESC"))
+	if limit, offset := limitAndOffsetFromContext(ctx); limit != 0 {
+		countTotal(ctx, query)
+		query = query.Limit(limit).Offset(offset)
+	}
+	var notificationModels []Notification
+	if err = query.Find(&notificationModels).Error; err != nil {
+		return nil, err
+	}
+	setTotal(ctx, uint64(len(notificationModels)))
+	notificationProtos := make([]*ttnpb.Notification, len(notificationModels))
+	for i, notification := range notificationModels {
+		notificationProtos[i] = notification.toPB()
+	}
+	return notificationProtos, nil
+}
+
+func (s *notificationStore) MarkNotificationsRead(ctx context.Context, userIDs *ttnpb.UserIdentifiers, ids []string) error {
+	defer trace.StartRegion(ctx, "mark notifications read").End()
+	user, err := s.findEntity(ctx, userIDs, "id")
+	if err != nil {
+		return err
+	}
+	query := s.query(ctx, Notification{}).
+		Where(Notification{UserID: user.PrimaryKey()}).
+		Where("read_at IS NULL")
+	if len(ids) > 0 {
+		query = query.Where("id IN (?)", ids)
+	}
+	return query.UpdateColumn("read_at", gorm.NowFunc()).Error
+}
+
+func (s *notificationStore) GetNotificationPreferences(ctx context.Context, userIDs *ttnpb.UserIdentifiers) ([]*ttnpb.NotificationPreference, error) {
+	defer trace.StartRegion(ctx, "get notification preferences").End()
+	user, err := s.findEntity(ctx, userIDs, "id")
+	if err != nil {
+		return nil, err
+	}
+	var preferenceModels []NotificationPreference
+	err = s.query(ctx, NotificationPreference{}).
+		Where(NotificationPreference{UserID: user.PrimaryKey()}).
+		Order("notification_type").
+		Find(&preferenceModels).Error
+	if err != nil {
+		return nil, err
+	}
+	preferenceProtos := make([]*ttnpb.NotificationPreference, len(preferenceModels))
+	for i, preference := range preferenceModels {
+		preferenceProtos[i] = preference.toPB()
+	}
+	return preferenceProtos, nil
+}
+
+func (s *notificationStore) SetNotificationPreferences(ctx context.Context, userIDs *ttnpb.UserIdentifiers, preferences []*ttnpb.NotificationPreference) error {
+	defer trace.StartRegion(ctx, "set notification preferences").End()
+	user, err := s.findEntity(ctx, userIDs, "id")
+	if err != nil {
+		return err
+	}
+	for _, preference := range preferences {
+		var preferenceModel NotificationPreference
+		err = s.query(ctx, NotificationPreference{}).Where(NotificationPreference{
+			UserID:           user.PrimaryKey(),
+			NotificationType: int(preference.NotificationType),
+		}).First(&preferenceModel).Error
+		switch {
+		case gorm.IsRecordNotFoundError(err):
+			err = s.createEntity(ctx, &NotificationPreference{
+				UserID:           user.PrimaryKey(),
+				NotificationType: int(preference.NotificationType),
+				Subscribed:       preference.Subscribed,
+				Email:            preference.Email,
+			})
+		case err == nil:
+			preferenceModel.Subscribed, preferenceModel.Email = preference.Subscribed, preference.Email
+			err = s.updateEntity(ctx, &preferenceModel, "subscribed", "email")
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,167 @@
+// Copyright © 2026 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package store
+
+import (
+	"testing"
+
+	"github.com/jinzhu/gorm"
+	"github.com/smartystreets/assertions"
+	"github.com/smartystreets/assertions/should"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+)
+
+func TestNotificationStore(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+
+	WithDB(t, func(t *testing.T, db *gorm.DB) {
+		prepareTest(db, &Account{}, &User{}, &Notification{}, &NotificationPreference{})
+
+		for _, uid := range []string{"test", "other", "admin"} {
+			user := &User{
+				Account: Account{
+					UID: uid,
+				},
+				Admin: uid == "admin",
+			}
+			if err := newStore(db).createEntity(ctx, user); err != nil {
+				panic(err)
+			}
+		}
+
+		userIDs := ttnpb.UserIdentifiers{UserID: "test"}
+		otherIDs := ttnpb.UserIdentifiers{UserID: "other"}
+		doesNotExistIDs := ttnpb.UserIdentifiers{UserID: "does_not_exist"}
+
+		admins, err := GetUserStore(db).FindAdmins(ctx)
+
+		a.So(err, should.BeNil)
+		a.So(admins, should.Resemble, []*ttnpb.UserIdentifiers{{UserID: "admin"}})
+
+		store := GetNotificationStore(db)
+
+		notification := &ttnpb.Notification{
+			NotificationType: ttnpb.NOTIFICATION_TYPE_API_KEY_CREATED,
+			EntityIDs:        *ttnpb.ApplicationIdentifiers{ApplicationID: "test-app"}.EntityIdentifiers(),
+			SenderIDs:        &otherIDs,
+			Subject:          "test-key",
+			Rights:           []ttnpb.Right{ttnpb.RIGHT_APPLICATION_INFO},
+		}
+
+		err = store.CreateNotification(ctx, notification, []*ttnpb.UserIdentifiers{&doesNotExistIDs})
+
+		if a.So(err, should.NotBeNil) {
+			a.So(errors.IsNotFound(err), should.BeTrue)
+		}
+
+		err = store.CreateNotification(ctx, notification, []*ttnpb.UserIdentifiers{&userIDs, &otherIDs})
+
+		a.So(err, should.BeNil)
+
+		err = store.CreateNotification(ctx, &ttnpb.Notification{
+			NotificationType: ttnpb.NOTIFICATION_TYPE_GATEWAY_DISCONNECTED,
+			EntityIDs:        *ttnpb.GatewayIdentifiers{GatewayID: "test-gtw"}.EntityIdentifiers(),
+		}, []*ttnpb.UserIdentifiers{&userIDs})
+
+		a.So(err, should.BeNil)
+
+		notifications, err := store.ListNotifications(ctx, &userIDs, false)
+
+		a.So(err, should.BeNil)
+		a.So(notifications, should.HaveLength, 2)
+		byType := make(map[ttnpb.NotificationType]*ttnpb.Notification)
+		for _, notification := range notifications {
+			byType[notification.NotificationType] = notification
+		}
+		apiKeyCreated, gatewayDisconnected := byType[ttnpb.NOTIFICATION_TYPE_API_KEY_CREATED], byType[ttnpb.NOTIFICATION_TYPE_GATEWAY_DISCONNECTED]
+		if a.So(apiKeyCreated, should.NotBeNil) {
+			a.So(apiKeyCreated.ID, should.NotBeEmpty)
+			a.So(apiKeyCreated.CreatedAt, should.NotBeZeroValue)
+			a.So(apiKeyCreated.EntityIDs.GetApplicationIDs().GetApplicationID(), should.Equal, "test-app")
+			a.So(apiKeyCreated.SenderIDs, should.Resemble, &otherIDs)
+			a.So(apiKeyCreated.Subject, should.Equal, "test-key")
+			a.So(apiKeyCreated.Rights, should.Resemble, []ttnpb.Right{ttnpb.RIGHT_APPLICATION_INFO})
+			a.So(apiKeyCreated.ReadAt, should.BeNil)
+		}
+		if a.So(gatewayDisconnected, should.NotBeNil) {
+			a.So(gatewayDisconnected.EntityIDs.GetGatewayIDs().GetGatewayID(), should.Equal, "test-gtw")
+			a.So(gatewayDisconnected.SenderIDs, should.BeNil)
+		}
+
+		err = store.MarkNotificationsRead(ctx, &userIDs, []string{apiKeyCreated.ID})
+
+		a.So(err, should.BeNil)
+
+		unread, err := store.ListNotifications(ctx, &userIDs, true)
+
+		a.So(err, should.BeNil)
+		if a.So(unread, should.HaveLength, 1) {
+			a.So(unread[0].ID, should.Equal, gatewayDisconnected.ID)
+		}
+
+		// Notifications of other users are not marked as read.
+		err = store.MarkNotificationsRead(ctx, &otherIDs, []string{gatewayDisconnected.ID})
+
+		a.So(err, should.BeNil)
+
+		unread, err = store.ListNotifications(ctx, &userIDs, true)
+
+		a.So(err, should.BeNil)
+		a.So(unread, should.HaveLength, 1)
+
+		err = store.MarkNotificationsRead(ctx, &userIDs, nil)
+
+		a.So(err, should.BeNil)
+
+		unread, err = store.ListNotifications(ctx, &userIDs, true)
+
+		a.So(err, should.BeNil)
+		a.So(unread, should.BeEmpty)
+
+		unread, err = store.ListNotifications(ctx, &otherIDs, true)
+
+		a.So(err, should.BeNil)
+		a.So(unread, should.HaveLength, 1)
+
+		preferences, err := store.GetNotificationPreferences(ctx, &userIDs)
+
+		a.So(err, should.BeNil)
+		a.So(preferences, should.BeEmpty)
+
+		err = store.SetNotificationPreferences(ctx, &userIDs, []*ttnpb.NotificationPreference{
+			{NotificationType: ttnpb.NOTIFICATION_TYPE_API_KEY_CREATED, Subscribed: true, Email: true},
+			{NotificationType: ttnpb.NOTIFICATION_TYPE_GATEWAY_DISCONNECTED, Subscribed: false},
+		})
+
+		a.So(err, should.BeNil)
+
+		err = store.SetNotificationPreferences(ctx, &userIDs, []*ttnpb.NotificationPreference{
+			{NotificationType: ttnpb.NOTIFICATION_TYPE_API_KEY_CREATED, Subscribed: true, Email: false},
+		})
+
+		a.So(err, should.BeNil)
+
+		preferences, err = store.GetNotificationPreferences(ctx, &userIDs)
+
+		a.So(err, should.BeNil)
+		a.So(preferences, should.Resemble, []*ttnpb.NotificationPreference{
+			{NotificationType: ttnpb.NOTIFICATION_TYPE_API_KEY_CREATED, Subscribed: true, Email: false},
+			{NotificationType: ttnpb.NOTIFICATION_TYPE_GATEWAY_DISCONNECTED, Subscribed: false},
+		})
+	})
+}
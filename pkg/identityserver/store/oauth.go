@@ -125,10 +125,50 @@ func (a AccessToken) toPB() *ttnpb.OAuthAccessToken {
 	return pb
 }
 
+// DeviceAuthorization model.
+type DeviceAuthorization struct {
+	Model
+
+	Client   *Client
+	ClientID string `gorm:"type:UUID;index;not null"`
+
+	User   *User
+	UserID *string `gorm:"type:UUID;index"`
+
+	Rights Rights `gorm:"type:INT ARRAY"`
+
+	DeviceCode string `gorm:"type:VARCHAR;unique_index:device_authorization_device_code_index;not null"`
+	UserCode   string `gorm:"type:VARCHAR;unique_index:device_authorization_user_code_index;not null"`
+	Denied     bool
+	ExpiresAt  time.Time
+
+	LastPolledAt *time.Time
+	PollInterval int64 `gorm:"default:0 not null"`
+}
+
+func (a DeviceAuthorization) toPB() *ttnpb.OAuthDeviceAuthorization {
+	pb := &ttnpb.OAuthDeviceAuthorization{
+		Rights:     a.Rights.Rights,
+		DeviceCode: a.DeviceCode,
+		UserCode:   a.UserCode,
+		Denied:     a.Denied,
+		CreatedAt:  cleanTime(a.CreatedAt),
+		ExpiresAt:  cleanTime(a.ExpiresAt),
+	}
+	if a.Client != nil {
+		pb.ClientIDs.ClientID = a.Client.ClientID
+	}
+	if a.User != nil {
+		pb.UserIDs.UserID = a.User.Account.UID
+	}
+	return pb
+}
+
 func init() {
 	registerModel(
 		&ClientAuthorization{},
 		&AuthorizationCode{},
 		&AccessToken{},
+		&DeviceAuthorization{},
 	)
 }
@@ -17,6 +17,7 @@ package store
 import (
 	"context"
 	"runtime/trace"
+	"time"
 
 	"github.com/jinzhu/gorm"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
@@ -296,3 +297,154 @@ func (s *oauthStore) DeleteAccessToken(ctx context.Context, id string) error {
 	}
 	return nil
 }
+
+func (s *oauthStore) CreateDeviceAuthorization(ctx context.Context, authorization *ttnpb.OAuthDeviceAuthorization) error {
+	defer trace.StartRegion(ctx, "create device authorization").End()
+	client, err := s.findEntity(ctx, authorization.ClientIDs, "id")
+	if err != nil {
+		return err
+	}
+	authModel := DeviceAuthorization{
+		ClientID:   client.PrimaryKey(),
+		Rights:     Rights{Rights: authorization.Rights},
+		DeviceCode: authorization.DeviceCode,
+		UserCode:   authorization.UserCode,
+		ExpiresAt:  authorization.ExpiresAt,
+	}
+	authModel.CreatedAt = cleanTime(authorization.CreatedAt)
+	return s.createEntity(ctx, &authModel)
+}
+
+func (s *oauthStore) getDeviceAuthorization(ctx context.Context, where DeviceAuthorization) (*DeviceAuthorization, error) {
+	var authModel DeviceAuthorization
+	err := s.query(ctx, DeviceAuthorization{}).Where(where).
+		Preload("Client").Preload("User.Account").First(&authModel).Error
+	if err != nil {
+		if gorm.IsRecordNotFoundError(err) {
+			return nil, errDeviceAuthorizationNotFound
+		}
+		return nil, err
+	}
+	return &authModel, nil
+}
+
+func (s *oauthStore) GetDeviceAuthorization(ctx context.Context, deviceCode string) (*ttnpb.OAuthDeviceAuthorization, error) {
+	defer trace.StartRegion(ctx, "get device authorization").End()
+	if deviceCode == "" {
+		return nil, errDeviceAuthorizationNotFound
+	}
+	authModel, err := s.getDeviceAuthorization(ctx, DeviceAuthorization{DeviceCode: deviceCode})
+	if err != nil {
+		return nil, err
+	}
+	return authModel.toPB(), nil
+}
+
+func (s *oauthStore) GetDeviceAuthorizationByUserCode(ctx context.Context, userCode string) (*ttnpb.OAuthDeviceAuthorization, error) {
+	defer trace.StartRegion(ctx, "get device authorization by user code").End()
+	if userCode == "" {
+		return nil, errDeviceAuthorizationNotFound
+	}
+	authModel, err := s.getDeviceAuthorization(ctx, DeviceAuthorization{UserCode: userCode})
+	if err != nil {
+		return nil, err
+	}
+	return authModel.toPB(), nil
+}
+
+func (s *oauthStore) UpdateDeviceAuthorization(ctx context.Context, authorization *ttnpb.OAuthDeviceAuthorization) error {
+	defer trace.StartRegion(ctx, "update device authorization").End()
+	if authorization.UserCode == "" {
+		return errDeviceAuthorizationNotFound
+	}
+	authModel, err := s.getDeviceAuthorization(ctx, DeviceAuthorization{UserCode: authorization.UserCode})
+	if err != nil {
+		return err
+	}
+	updates := map[string]interface{}{
+		"rights": Rights{Rights: authorization.Rights},
+		"denied": authorization.Denied,
+	}
+	if authorization.UserIDs.UserID != "" {
+		user, err := s.findEntity(ctx, authorization.UserIDs, "id")
+		if err != nil {
+			return err
+		}
+		updates["user_id"] = user.PrimaryKey()
+	}
+	// The condition on the user makes concurrent approvals of the same device authorization fail.
+	query := s.query(ctx, DeviceAuthorization{}).
+		Where("id = ? AND user_id IS NULL", authModel.ID).
+		Updates(updates)
+	if err = query.Error; err != nil {
+		return err
+	}
+	if query.RowsAffected == 0 {
+		return errDeviceAuthorizationUpdated
+	}
+	return nil
+}
+
+func (s *oauthStore) DeleteDeviceAuthorization(ctx context.Context, deviceCode string) error {
+	if deviceCode == "" {
+		return errDeviceAuthorizationNotFound
+	}
+	defer trace.StartRegion(ctx, "delete device authorization").End()
+	return s.query(ctx, DeviceAuthorization{}).Where(DeviceAuthorization{
+		DeviceCode: deviceCode,
+	}).Delete(&DeviceAuthorization{}).Error
+}
+
+func (s *oauthStore) PollDeviceAuthorization(ctx context.Context, deviceCode string, interval, increase time.Duration, at time.Time) (*ttnpb.OAuthDeviceAuthorization, error) {
+	defer trace.StartRegion(ctx, "poll device authorization").End()
+	if deviceCode == "" {
+		return nil, errDeviceAuthorizationNotFound
+	}
+	authModel, err := s.getDeviceAuthorization(ctx, DeviceAuthorization{DeviceCode: deviceCode})
+	if err != nil {
+		return nil, err
+	}
+	if pollInterval := time.Duration(authModel.PollInterval); pollInterval > interval {
+		interval = pollInterval
+	}
+	// The conditions on the last poll time and the poll interval make concurrent polls within the interval fail.
+	query := s.query(ctx, DeviceAuthorization{}).
+		Where("id = ? AND poll_interval = ? AND (last_polled_at IS NULL OR last_polled_at <= ?)", authModel.ID, authModel.PollInterval, cleanTime(at.Add(-interval))).
+		UpdateColumn("last_polled_at", cleanTime(at))
+	if err := query.Error; err != nil {
+		return nil, err
+	}
+	if query.RowsAffected == 0 {
+		// Polling too often postpones the next allowed poll and increases the interval.
+		if err := s.query(ctx, DeviceAuthorization{}).
+			Where("id = ?", authModel.ID).
+			UpdateColumns(map[string]interface{}{
+				"last_polled_at": cleanTime(at),
+				"poll_interval":  int64(interval + increase),
+			}).Error; err != nil {
+			return nil, err
+		}
+		return nil, errDeviceAuthorizationPolled
+	}
+	return authModel.toPB(), nil
+}
+
+func (s *oauthStore) RedeemDeviceAuthorization(ctx context.Context, deviceCode string) (*ttnpb.OAuthDeviceAuthorization, error) {
+	defer trace.StartRegion(ctx, "redeem device authorization").End()
+	if deviceCode == "" {
+		return nil, errDeviceAuthorizationNotFound
+	}
+	authModel, err := s.getDeviceAuthorization(ctx, DeviceAuthorization{DeviceCode: deviceCode})
+	if err != nil {
+		return nil, err
+	}
+	// Only one of concurrent redemptions of the same device code deletes the device authorization.
+	query := s.query(ctx, DeviceAuthorization{}).Where("id = ?", authModel.ID).Delete(&DeviceAuthorization{})
+	if err = query.Error; err != nil {
+		return nil, err
+	}
+	if query.RowsAffected == 0 {
+		return nil, errDeviceAuthorizationNotFound
+	}
+	return authModel.toPB(), nil
+}
@@ -34,6 +34,7 @@ func TestOAuthStore(t *testing.T) {
 			&ClientAuthorization{},
 			&AuthorizationCode{},
 			&AccessToken{},
+			&DeviceAuthorization{},
 			&User{},
 			&Client{},
 			&Account{},
@@ -252,5 +253,144 @@ func TestOAuthStore(t *testing.T) {
 			}
 			a.So(deleted, should.BeNil)
 		})
+
+		t.Run("Device Authorization", func(t *testing.T) {
+			a := assertions.New(t)
+
+			deviceCode, userCode := "DEVICECODE", "BCDF-GHJK"
+
+			start := time.Now()
+
+			err := store.CreateDeviceAuthorization(ctx, &ttnpb.OAuthDeviceAuthorization{
+				ClientIDs:  *clientIDs,
+				Rights:     rights,
+				DeviceCode: deviceCode,
+				UserCode:   userCode,
+				CreatedAt:  start,
+				ExpiresAt:  start.Add(10 * time.Minute),
+			})
+
+			a.So(err, should.BeNil)
+
+			pending, err := store.GetDeviceAuthorization(ctx, deviceCode)
+
+			a.So(err, should.BeNil)
+			if a.So(pending, should.NotBeNil) {
+				a.So(pending.ClientIDs.ClientID, should.Equal, clientIDs.ClientID)
+				a.So(pending.UserIDs.UserID, should.BeEmpty)
+				a.So(pending.UserCode, should.Equal, userCode)
+				a.So(pending.Denied, should.BeFalse)
+			}
+
+			err = store.UpdateDeviceAuthorization(ctx, &ttnpb.OAuthDeviceAuthorization{
+				UserIDs:  *userIDs,
+				Rights:   []ttnpb.Right{ttnpb.RIGHT_USER_INFO},
+				UserCode: userCode,
+			})
+
+			a.So(err, should.BeNil)
+
+			err = store.UpdateDeviceAuthorization(ctx, &ttnpb.OAuthDeviceAuthorization{
+				UserIDs:  *userIDs,
+				Rights:   []ttnpb.Right{ttnpb.RIGHT_USER_ALL},
+				UserCode: userCode,
+			})
+
+			if a.So(err, should.NotBeNil) {
+				a.So(errors.IsFailedPrecondition(err), should.BeTrue)
+			}
+
+			got, err := store.GetDeviceAuthorizationByUserCode(ctx, userCode)
+
+			a.So(err, should.BeNil)
+			if a.So(got, should.NotBeNil) {
+				a.So(got.ClientIDs.ClientID, should.Equal, clientIDs.ClientID)
+				a.So(got.UserIDs.UserID, should.Equal, userIDs.UserID)
+				a.So(got.Rights, should.Resemble, []ttnpb.Right{ttnpb.RIGHT_USER_INFO})
+				a.So(got.DeviceCode, should.Equal, deviceCode)
+				a.So(got.ExpiresAt, should.HappenAfter, start)
+			}
+
+			err = store.DeleteDeviceAuthorization(ctx, deviceCode)
+
+			a.So(err, should.BeNil)
+
+			deleted, err := store.GetDeviceAuthorization(ctx, deviceCode)
+
+			if a.So(err, should.NotBeNil) {
+				a.So(errors.IsNotFound(err), should.BeTrue)
+			}
+			a.So(deleted, should.BeNil)
+		})
+
+		t.Run("Device Authorization Poll And Redeem", func(t *testing.T) {
+			a := assertions.New(t)
+
+			deviceCode, userCode := "POLLDEVICECODE", "CDFG-HJKL"
+
+			start := time.Now()
+
+			err := store.CreateDeviceAuthorization(ctx, &ttnpb.OAuthDeviceAuthorization{
+				ClientIDs:  *clientIDs,
+				Rights:     rights,
+				DeviceCode: deviceCode,
+				UserCode:   userCode,
+				CreatedAt:  start,
+				ExpiresAt:  start.Add(10 * time.Minute),
+			})
+
+			a.So(err, should.BeNil)
+
+			polled, err := store.PollDeviceAuthorization(ctx, deviceCode, 5*time.Second, 5*time.Second, start)
+
+			a.So(err, should.BeNil)
+			if a.So(polled, should.NotBeNil) {
+				a.So(polled.UserCode, should.Equal, userCode)
+			}
+
+			_, err = store.PollDeviceAuthorization(ctx, deviceCode, 5*time.Second, 5*time.Second, start.Add(time.Second))
+
+			if a.So(err, should.NotBeNil) {
+				a.So(errors.IsResourceExhausted(err), should.BeTrue)
+			}
+
+			// The poll that was too early postpones the next allowed poll and increases the interval to 10 seconds.
+			_, err = store.PollDeviceAuthorization(ctx, deviceCode, 5*time.Second, 5*time.Second, start.Add(6*time.Second))
+
+			if a.So(err, should.NotBeNil) {
+				a.So(errors.IsResourceExhausted(err), should.BeTrue)
+			}
+
+			// The previous poll increased the interval to 15 seconds.
+			_, err = store.PollDeviceAuthorization(ctx, deviceCode, 5*time.Second, 5*time.Second, start.Add(20*time.Second))
+
+			if a.So(err, should.NotBeNil) {
+				a.So(errors.IsResourceExhausted(err), should.BeTrue)
+			}
+
+			_, err = store.PollDeviceAuthorization(ctx, deviceCode, 5*time.Second, 5*time.Second, start.Add(40*time.Second))
+
+			a.So(err, should.BeNil)
+
+			_, err = store.PollDeviceAuthorization(ctx, "OTHERDEVICECODE", 5*time.Second, 5*time.Second, start)
+
+			if a.So(err, should.NotBeNil) {
+				a.So(errors.IsNotFound(err), should.BeTrue)
+			}
+
+			redeemed, err := store.RedeemDeviceAuthorization(ctx, deviceCode)
+
+			a.So(err, should.BeNil)
+			if a.So(redeemed, should.NotBeNil) {
+				a.So(redeemed.ClientIDs.ClientID, should.Equal, clientIDs.ClientID)
+				a.So(redeemed.DeviceCode, should.Equal, deviceCode)
+			}
+
+			_, err = store.RedeemDeviceAuthorization(ctx, deviceCode)
+
+			if a.So(err, should.NotBeNil) {
+				a.So(errors.IsNotFound(err), should.BeTrue)
+			}
+		})
 	})
 }
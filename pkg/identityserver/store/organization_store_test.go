@@ -128,5 +128,58 @@ func TestOrganizationStore(t *testing.T) {
 
 		a.So(err, should.BeNil)
 		a.So(list, should.BeEmpty)
+
+		list, err = store.FindOrganizations(WithSoftDeleted(ctx, true), nil, nil)
+
+		a.So(err, should.BeNil)
+		if a.So(list, should.HaveLength, 1) {
+			a.So(list[0].OrganizationID, should.Equal, "foo")
+		}
+
+		list, err = store.FindOrganizations(WithSoftDeletedBefore(ctx, time.Now().Add(-1*time.Hour)), nil, nil)
+
+		a.So(err, should.BeNil)
+		a.So(list, should.BeEmpty)
+
+		err = store.RestoreOrganization(ctx, &ttnpb.OrganizationIdentifiers{OrganizationID: "foo"})
+
+		a.So(err, should.BeNil)
+
+		got, err = store.GetOrganization(ctx, &ttnpb.OrganizationIdentifiers{OrganizationID: "foo"}, nil)
+
+		a.So(err, should.BeNil)
+		if a.So(got, should.NotBeNil) {
+			a.So(got.OrganizationID, should.Equal, "foo")
+		}
+
+		err = store.RestoreOrganization(ctx, &ttnpb.OrganizationIdentifiers{OrganizationID: "foo"})
+
+		if a.So(err, should.NotBeNil) {
+			a.So(errors.IsNotFound(err), should.BeTrue)
+		}
+
+		err = store.PurgeOrganization(ctx, &ttnpb.OrganizationIdentifiers{OrganizationID: "foo"})
+
+		if a.So(err, should.NotBeNil) {
+			a.So(errors.IsNotFound(err), should.BeTrue)
+		}
+
+		got, err = store.GetOrganization(ctx, &ttnpb.OrganizationIdentifiers{OrganizationID: "foo"}, nil)
+
+		a.So(err, should.BeNil)
+		a.So(got, should.NotBeNil)
+
+		err = store.DeleteOrganization(ctx, &ttnpb.OrganizationIdentifiers{OrganizationID: "foo"})
+
+		a.So(err, should.BeNil)
+
+		err = store.PurgeOrganization(ctx, &ttnpb.OrganizationIdentifiers{OrganizationID: "foo"})
+
+		a.So(err, should.BeNil)
+
+		list, err = store.FindOrganizations(WithSoftDeleted(ctx, false), nil, nil)
+
+		a.So(err, should.BeNil)
+		a.So(list, should.BeEmpty)
 	})
 }
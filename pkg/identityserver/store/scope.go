@@ -177,6 +177,12 @@ func withUserID(id ...string) func(*gorm.DB) *gorm.DB {
 	}
 }
 
+func withPrimaryEmailAddress(email string) func(*gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		return db.Where("LOWER(users.primary_email_address) = LOWER(?)", email)
+	}
+}
+
 func splitEndDeviceIDString(s string) (appID string, devID string) {
 	sepIdx := strings.Index(s, ".")
 	return s[:sepIdx], s[sepIdx+1:]
@@ -0,0 +1,80 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package store
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jinzhu/gorm"
+)
+
+type softDeleteOptionsKeyType struct{}
+
+var softDeleteOptionsKey softDeleteOptionsKeyType
+
+type softDeleteOptions struct {
+	onlyDeleted   bool
+	deletedBefore time.Time
+}
+
+// WithSoftDeleted instructs the store to include soft-deleted entities in the results.
+// If onlyDeleted is true, only soft-deleted entities are returned.
+func WithSoftDeleted(ctx context.Context, onlyDeleted bool) context.Context {
+	return context.WithValue(ctx, softDeleteOptionsKey, softDeleteOptions{
+		onlyDeleted: onlyDeleted,
+	})
+}
+
+// WithSoftDeletedBefore instructs the store to only return entities that were
+// soft-deleted before the given time.
+func WithSoftDeletedBefore(ctx context.Context, deletedBefore time.Time) context.Context {
+	return context.WithValue(ctx, softDeleteOptionsKey, softDeleteOptions{
+		onlyDeleted:   true,
+		deletedBefore: deletedBefore,
+	})
+}
+
+// softDeletedTables are the tables of the entities that can be restored and purged.
+var softDeletedTables = map[string]struct{}{
+	"applications":  {},
+	"clients":       {},
+	"gateways":      {},
+	"organizations": {},
+	"users":         {},
+}
+
+// withSoftDeleted applies the soft-delete options from the context to queries
+// on the given table. Queries on other tables are not modified.
+func withSoftDeleted(ctx context.Context, table string) func(*gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		opts, ok := ctx.Value(softDeleteOptionsKey).(softDeleteOptions)
+		if !ok {
+			return db
+		}
+		if _, ok := softDeletedTables[table]; !ok {
+			return db
+		}
+		db = db.Unscoped()
+		if opts.onlyDeleted {
+			db = db.Where(fmt.Sprintf(`"%s"."deleted_at" IS NOT NULL`, table))
+		}
+		if !opts.deletedBefore.IsZero() {
+			db = db.Where(fmt.Sprintf(`"%s"."deleted_at" < ?`, table), cleanTime(opts.deletedBefore))
+		}
+		return db
+	}
+}
@@ -39,6 +39,9 @@ type store struct {
 
 func (s *store) query(ctx context.Context, model interface{}, funcs ...func(*gorm.DB) *gorm.DB) *gorm.DB {
 	query := s.DB.Model(model).Scopes(withContext(ctx))
+	if _, ok := ctx.Value(softDeleteOptionsKey).(softDeleteOptions); ok {
+		query = query.Scopes(withSoftDeleted(ctx, s.DB.NewScope(model).TableName()))
+	}
 	if len(funcs) > 0 {
 		query = query.Scopes(funcs...)
 	}
@@ -84,6 +87,85 @@ func (s *store) deleteEntity(ctx context.Context, entityID ttnpb.Identifiers) er
 	return s.DB.Delete(model).Error
 }
 
+func (s *store) restoreEntity(ctx context.Context, entityID ttnpb.Identifiers) error {
+	model, err := s.findEntity(WithSoftDeleted(ctx, true), entityID, "id")
+	if err != nil {
+		return err
+	}
+	if err = s.DB.Unscoped().Model(model).UpdateColumn("deleted_at", nil).Error; err != nil {
+		return err
+	}
+	switch entityType := entityTypeForID(entityID); entityType {
+	case "organization", "user":
+		return s.DB.Unscoped().Model(&Account{}).Where(Account{
+			AccountType: entityType,
+			AccountID:   model.PrimaryKey(),
+		}).UpdateColumn("deleted_at", nil).Error
+	}
+	return nil
+}
+
+func (s *store) purgeEntity(ctx context.Context, entityID ttnpb.Identifiers) error {
+	model, err := s.findEntity(WithSoftDeleted(ctx, true), entityID, "id")
+	if err != nil {
+		return err
+	}
+	entityType, id := entityTypeForID(entityID), model.PrimaryKey()
+	db := s.DB.Unscoped()
+	for _, dependent := range []interface{}{&Attribute{}, &APIKey{}, &ContactInfo{}, &Membership{}} {
+		if err = db.Where("entity_type = ? AND entity_id = ?", entityType, id).Delete(dependent).Error; err != nil {
+			return err
+		}
+	}
+	switch entityType {
+	case "organization", "user":
+		accountQuery := db.Model(&Account{}).
+			Select(`"accounts"."id"`).
+			Where(Account{AccountType: entityType, AccountID: id}).
+			QueryExpr()
+		if err = db.Where("account_id = (?)", accountQuery).Delete(&Membership{}).Error; err != nil {
+			return err
+		}
+		if err = db.Where(Account{AccountType: entityType, AccountID: id}).Delete(&Account{}).Error; err != nil {
+			return err
+		}
+	}
+	switch entityType {
+	case "client":
+		for _, dependent := range []interface{}{&ClientAuthorization{}, &AuthorizationCode{}, &AccessToken{}, &DeviceAuthorization{}} {
+			if err = db.Where("client_id = ?", id).Delete(dependent).Error; err != nil {
+				return err
+			}
+		}
+	case "gateway":
+		antennaQuery := db.Model(&GatewayAntenna{}).Select(`"gateway_antennas"."id"`).Where("gateway_id = ?", id).QueryExpr()
+		if err = db.Where("entity_type = ? AND entity_id IN (?)", "gateway", antennaQuery).Delete(&Attribute{}).Error; err != nil {
+			return err
+		}
+		if err = db.Where("gateway_id = ?", id).Delete(&GatewayAntenna{}).Error; err != nil {
+			return err
+		}
+	case "user":
+		for _, dependent := range []interface{}{
+			&UserSession{}, &UserTOTP{}, &UserRecoveryCode{}, &ExternalUser{},
+			&Notification{}, &NotificationPreference{},
+			&ClientAuthorization{}, &AuthorizationCode{}, &AccessToken{}, &DeviceAuthorization{},
+		} {
+			if err = db.Where("user_id = ?", id).Delete(dependent).Error; err != nil {
+				return err
+			}
+		}
+		if err = db.Model(&Invitation{}).Where("accepted_by_id = ?", id).UpdateColumn("accepted_by_id", nil).Error; err != nil {
+			return err
+		}
+		pictureQuery := db.Model(&User{}).Select(`"users"."profile_picture_id"`).Where(`"users"."id" = ?`, id).QueryExpr()
+		if err = db.Where("id = (?)", pictureQuery).Delete(&Picture{}).Error; err != nil {
+			return err
+		}
+	}
+	return db.Delete(model).Error
+}
+
 var (
 	errDatabase      = errors.DefineInternal("database", "database error")
 	errAlreadyExists = errors.DefineAlreadyExists("already_exists", "entity already exists", "field", "value")
@@ -224,10 +306,18 @@ var (
 	errOrganizationNotFound = errors.DefineNotFound("organization_not_found", "organization `{organization_id}` not found")
 	errUserNotFound         = errors.DefineNotFound("user_not_found", "user `{user_id}` not found")
 	errSessionNotFound      = errors.DefineNotFound("session_not_found", "session `{session_id}` for user `{user_id}` not found")
+	errTOTPNotFound         = errors.DefineNotFound("totp_not_found", "TOTP of user `{user_id}` not found")
+	errRecoveryCodeNotFound = errors.DefineNotFound("recovery_code_not_found", "recovery code not found")
+	errTOTPStepUsed         = errors.DefineAlreadyExists("totp_step_used", "TOTP code already used")
+	errTOTPAttemptLimit     = errors.DefineResourceExhausted("totp_attempt_limit", "too many two-factor authentication attempts, try again later")
+	errExternalUserNotFound = errors.DefineNotFound("external_user_not_found", "user `{external_id}` of provider `{provider_id}` not found")
 
-	errAuthorizationNotFound     = errors.DefineNotFound("authorization_not_found", "authorization of `{user_id}` for `{client_id}` not found")
-	errAuthorizationCodeNotFound = errors.DefineNotFound("authorization_code_not_found", "authorization code not found")
-	errAccessTokenNotFound       = errors.DefineNotFound("access_token_not_found", "access token not found")
+	errAuthorizationNotFound       = errors.DefineNotFound("authorization_not_found", "authorization of `{user_id}` for `{client_id}` not found")
+	errAuthorizationCodeNotFound   = errors.DefineNotFound("authorization_code_not_found", "authorization code not found")
+	errAccessTokenNotFound         = errors.DefineNotFound("access_token_not_found", "access token not found")
+	errDeviceAuthorizationNotFound = errors.DefineNotFound("device_authorization_not_found", "device authorization not found")
+	errDeviceAuthorizationPolled   = errors.DefineResourceExhausted("device_authorization_polled", "device authorization polled too often")
+	errDeviceAuthorizationUpdated  = errors.DefineFailedPrecondition("device_authorization_updated", "device authorization already authorized or denied")
 
 	errAPIKeyNotFound = errors.DefineNotFound("api_key_not_found", "API key not found")
 )
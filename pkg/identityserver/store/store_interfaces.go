@@ -16,6 +16,7 @@ package store
 
 import (
 	"context"
+	"time"
 
 	"github.com/gogo/protobuf/types"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
@@ -31,6 +32,8 @@ type ApplicationStore interface {
 	GetApplication(ctx context.Context, id *ttnpb.ApplicationIdentifiers, fieldMask *types.FieldMask) (*ttnpb.Application, error)
 	UpdateApplication(ctx context.Context, app *ttnpb.Application, fieldMask *types.FieldMask) (*ttnpb.Application, error)
 	DeleteApplication(ctx context.Context, id *ttnpb.ApplicationIdentifiers) error
+	RestoreApplication(ctx context.Context, id *ttnpb.ApplicationIdentifiers) error
+	PurgeApplication(ctx context.Context, id *ttnpb.ApplicationIdentifiers) error
 }
 
 // ClientStore interface for storing Clients.
@@ -43,6 +46,8 @@ type ClientStore interface {
 	GetClient(ctx context.Context, id *ttnpb.ClientIdentifiers, fieldMask *types.FieldMask) (*ttnpb.Client, error)
 	UpdateClient(ctx context.Context, cli *ttnpb.Client, fieldMask *types.FieldMask) (*ttnpb.Client, error)
 	DeleteClient(ctx context.Context, id *ttnpb.ClientIdentifiers) error
+	RestoreClient(ctx context.Context, id *ttnpb.ClientIdentifiers) error
+	PurgeClient(ctx context.Context, id *ttnpb.ClientIdentifiers) error
 }
 
 // EndDeviceStore interface for storing EndDevices.
@@ -69,6 +74,8 @@ type GatewayStore interface {
 	GetGateway(ctx context.Context, id *ttnpb.GatewayIdentifiers, fieldMask *types.FieldMask) (*ttnpb.Gateway, error)
 	UpdateGateway(ctx context.Context, gtw *ttnpb.Gateway, fieldMask *types.FieldMask) (*ttnpb.Gateway, error)
 	DeleteGateway(ctx context.Context, id *ttnpb.GatewayIdentifiers) error
+	RestoreGateway(ctx context.Context, id *ttnpb.GatewayIdentifiers) error
+	PurgeGateway(ctx context.Context, id *ttnpb.GatewayIdentifiers) error
 }
 
 // OrganizationStore interface for storing Organizations.
@@ -81,6 +88,8 @@ type OrganizationStore interface {
 	GetOrganization(ctx context.Context, id *ttnpb.OrganizationIdentifiers, fieldMask *types.FieldMask) (*ttnpb.Organization, error)
 	UpdateOrganization(ctx context.Context, org *ttnpb.Organization, fieldMask *types.FieldMask) (*ttnpb.Organization, error)
 	DeleteOrganization(ctx context.Context, id *ttnpb.OrganizationIdentifiers) error
+	RestoreOrganization(ctx context.Context, id *ttnpb.OrganizationIdentifiers) error
+	PurgeOrganization(ctx context.Context, id *ttnpb.OrganizationIdentifiers) error
 }
 
 // UserStore interface for storing Users.
@@ -93,6 +102,10 @@ type UserStore interface {
 	GetUser(ctx context.Context, id *ttnpb.UserIdentifiers, fieldMask *types.FieldMask) (*ttnpb.User, error)
 	UpdateUser(ctx context.Context, usr *ttnpb.User, fieldMask *types.FieldMask) (*ttnpb.User, error)
 	DeleteUser(ctx context.Context, id *ttnpb.UserIdentifiers) error
+	RestoreUser(ctx context.Context, id *ttnpb.UserIdentifiers) error
+	PurgeUser(ctx context.Context, id *ttnpb.UserIdentifiers) error
+	// Find the identifiers of the admin users.
+	FindAdmins(ctx context.Context) ([]*ttnpb.UserIdentifiers, error)
 }
 
 // UserSessionStore interface for storing User sessions.
@@ -106,6 +119,72 @@ type UserSessionStore interface {
 	DeleteSession(ctx context.Context, userIDs *ttnpb.UserIdentifiers, sessionID string) error
 }
 
+// TOTP is the TOTP two-factor authentication state of a user.
+type TOTP struct {
+	// Secret is the shared secret, which may be encrypted with a KEK.
+	Secret ttnpb.KeyEnvelope
+	// EnabledAt is nil if the secret is enrolled, but not yet enabled.
+	EnabledAt *time.Time
+	// LastUsedStep is the last time step of which the TOTP code was used.
+	LastUsedStep int64
+}
+
+// UserTOTPStore interface for storing the TOTP two-factor authentication
+// secrets and recovery codes of users.
+//
+// For internal use (by the Identity Server and OAuth server) only.
+type UserTOTPStore interface {
+	// Get the TOTP secret and state of the user.
+	GetTOTP(ctx context.Context, userIDs *ttnpb.UserIdentifiers) (*TOTP, error)
+	// Set the TOTP secret of the user, replacing any existing secret.
+	SetTOTP(ctx context.Context, userIDs *ttnpb.UserIdentifiers, secret ttnpb.KeyEnvelope, enabledAt *time.Time) error
+	// Mark the TOTP code of the time step as used. This returns an AlreadyExists
+	// error if the code of this time step or of a later time step was already used.
+	UseTOTPStep(ctx context.Context, userIDs *ttnpb.UserIdentifiers, step int64) error
+	// Count an attempt to use a two-factor code of the user. This returns a
+	// ResourceExhausted error if limit attempts were already counted and the last
+	// attempt was after the given time.
+	CountTOTPAttempt(ctx context.Context, userIDs *ttnpb.UserIdentifiers, limit int, since, at time.Time) error
+	// Reset the counted attempts of the user, once a two-factor code was accepted.
+	ResetTOTPAttempts(ctx context.Context, userIDs *ttnpb.UserIdentifiers) error
+	// Delete the TOTP secret and the recovery codes of the user.
+	DeleteTOTP(ctx context.Context, userIDs *ttnpb.UserIdentifiers) error
+	// Find the (hashed) recovery codes of the user.
+	FindRecoveryCodes(ctx context.Context, userIDs *ttnpb.UserIdentifiers) ([]string, error)
+	// Replace the recovery codes of the user with the given (hashed) codes.
+	SetRecoveryCodes(ctx context.Context, userIDs *ttnpb.UserIdentifiers, codes []string) error
+	// Delete a (hashed) recovery code of the user, once it is used.
+	DeleteRecoveryCode(ctx context.Context, userIDs *ttnpb.UserIdentifiers, code string) error
+}
+
+// ExternalUserStore interface for storing the links between users and their
+// accounts in upstream identity providers.
+//
+// For internal use (by the OAuth server) only.
+type ExternalUserStore interface {
+	// Get the IDs of the user that is linked to the account in the provider.
+	GetExternalUser(ctx context.Context, providerID, externalID string) (*ttnpb.UserIdentifiers, error)
+	// Link the user to the account in the provider.
+	CreateExternalUser(ctx context.Context, userIDs *ttnpb.UserIdentifiers, providerID, externalID string) error
+}
+
+// NotificationStore interface for storing the notifications of users and
+// their notification preferences.
+type NotificationStore interface {
+	// Create the notification for each of the receivers.
+	CreateNotification(ctx context.Context, notification *ttnpb.Notification, receiverIDs []*ttnpb.UserIdentifiers) error
+	// List the notifications of the user, newest first.
+	ListNotifications(ctx context.Context, userIDs *ttnpb.UserIdentifiers, unreadOnly bool) ([]*ttnpb.Notification, error)
+	// Mark notifications of the user as read. If no IDs are given, all
+	// notifications of the user are marked as read.
+	MarkNotificationsRead(ctx context.Context, userIDs *ttnpb.UserIdentifiers, ids []string) error
+	// Get the notification preferences that the user set.
+	GetNotificationPreferences(ctx context.Context, userIDs *ttnpb.UserIdentifiers) ([]*ttnpb.NotificationPreference, error)
+	// Set notification preferences of the user. Preferences of other
+	// notification types are not changed.
+	SetNotificationPreferences(ctx context.Context, userIDs *ttnpb.UserIdentifiers, preferences []*ttnpb.NotificationPreference) error
+}
+
 // MembershipStore interface for storing membership (collaboration) relations
 // between accounts (users or organizations) and entities (applications, clients,
 // gateways or organizations).
@@ -137,6 +216,8 @@ type APIKeyStore interface {
 	GetAPIKey(ctx context.Context, id string) (ttnpb.Identifiers, *ttnpb.APIKey, error)
 	// Update key rights on an entity. Rights can be deleted by not passing any rights, in which case the returned API key will be nil.
 	UpdateAPIKey(ctx context.Context, entityID ttnpb.Identifiers, key *ttnpb.APIKey) (*ttnpb.APIKey, error)
+	// Update the last used time of the API keys with the given IDs. Later times that are already stored are kept.
+	UpdateAPIKeyLastUsed(ctx context.Context, lastUsed map[string]time.Time) error
 }
 
 // OAuthStore interface for the OAuth server.
@@ -156,6 +237,20 @@ type OAuthStore interface {
 	ListAccessTokens(ctx context.Context, userIDs *ttnpb.UserIdentifiers, clientIDs *ttnpb.ClientIdentifiers) ([]*ttnpb.OAuthAccessToken, error)
 	GetAccessToken(ctx context.Context, id string) (*ttnpb.OAuthAccessToken, error)
 	DeleteAccessToken(ctx context.Context, id string) error
+
+	CreateDeviceAuthorization(ctx context.Context, authorization *ttnpb.OAuthDeviceAuthorization) error
+	GetDeviceAuthorization(ctx context.Context, deviceCode string) (*ttnpb.OAuthDeviceAuthorization, error)
+	GetDeviceAuthorizationByUserCode(ctx context.Context, userCode string) (*ttnpb.OAuthDeviceAuthorization, error)
+	// Update the user, rights and denied status of the device authorization with the user code of the given authorization.
+	// It returns a FailedPrecondition error if the device authorization was already authorized or denied.
+	UpdateDeviceAuthorization(ctx context.Context, authorization *ttnpb.OAuthDeviceAuthorization) error
+	DeleteDeviceAuthorization(ctx context.Context, deviceCode string) error
+	// PollDeviceAuthorization records a poll of the device authorization at the given time.
+	// It returns a ResourceExhausted error if the previous poll was less than the interval ago. Each such poll
+	// increases the interval of the device authorization by the given increase for all subsequent polls.
+	PollDeviceAuthorization(ctx context.Context, deviceCode string, interval, increase time.Duration, at time.Time) (*ttnpb.OAuthDeviceAuthorization, error)
+	// RedeemDeviceAuthorization deletes and returns the device authorization, so that it can only be redeemed once.
+	RedeemDeviceAuthorization(ctx context.Context, deviceCode string) (*ttnpb.OAuthDeviceAuthorization, error)
 }
 
 // InvitationStore interface for storing user invitations.
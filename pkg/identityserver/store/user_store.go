@@ -113,9 +113,29 @@ func (s *userStore) FindUsers(ctx context.Context, ids []*ttnpb.UserIdentifiers,
 	return userProtos, nil
 }
 
+func (s *userStore) FindAdmins(ctx context.Context) ([]*ttnpb.UserIdentifiers, error) {
+	defer trace.StartRegion(ctx, "find admins").End()
+	var userModels []User
+	err := s.query(ctx, User{}, withUserID()).
+		Where(`"users"."admin" = ?`, true).
+		Preload("Account").
+		Find(&userModels).Error
+	if err != nil {
+		return nil, err
+	}
+	userIDs := make([]*ttnpb.UserIdentifiers, len(userModels))
+	for i, userModel := range userModels {
+		userIDs[i] = &ttnpb.UserIdentifiers{UserID: userModel.Account.UID}
+	}
+	return userIDs, nil
+}
+
 func (s *userStore) GetUser(ctx context.Context, id *ttnpb.UserIdentifiers, fieldMask *types.FieldMask) (*ttnpb.User, error) {
 	defer trace.StartRegion(ctx, "get user").End()
 	query := s.query(ctx, User{}, withUserID(id.GetUserID()))
+	if id.GetUserID() == "" && id.GetEmail() != "" {
+		query = s.query(ctx, User{}, withPrimaryEmailAddress(id.GetEmail()))
+	}
 	query = selectUserFields(ctx, query, fieldMask)
 	var userModel User
 	if err := query.Preload("Account").First(&userModel).Error; err != nil {
@@ -178,3 +198,13 @@ func (s *userStore) DeleteUser(ctx context.Context, id *ttnpb.UserIdentifiers) (
 	defer trace.StartRegion(ctx, "delete user").End()
 	return s.deleteEntity(ctx, id)
 }
+
+func (s *userStore) RestoreUser(ctx context.Context, id *ttnpb.UserIdentifiers) (err error) {
+	defer trace.StartRegion(ctx, "restore user").End()
+	return s.restoreEntity(ctx, id)
+}
+
+func (s *userStore) PurgeUser(ctx context.Context, id *ttnpb.UserIdentifiers) (err error) {
+	defer trace.StartRegion(ctx, "purge user").End()
+	return s.purgeEntity(ctx, id)
+}
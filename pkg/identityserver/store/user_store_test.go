@@ -143,5 +143,59 @@ func TestUserStore(t *testing.T) {
 
 		a.So(err, should.BeNil)
 		a.So(list, should.BeEmpty)
+
+		list, err = store.FindUsers(WithSoftDeleted(ctx, true), nil, nil)
+
+		a.So(err, should.BeNil)
+		if a.So(list, should.HaveLength, 1) {
+			a.So(list[0].UserID, should.Equal, "foo")
+		}
+
+		list, err = store.FindUsers(WithSoftDeletedBefore(ctx, time.Now().Add(-1*time.Hour)), nil, nil)
+
+		a.So(err, should.BeNil)
+		a.So(list, should.BeEmpty)
+
+		err = store.RestoreUser(ctx, &ttnpb.UserIdentifiers{UserID: "foo"})
+
+		a.So(err, should.BeNil)
+
+		got, err = store.GetUser(ctx, &ttnpb.UserIdentifiers{UserID: "foo"}, nil)
+
+		a.So(err, should.BeNil)
+		if a.So(got, should.NotBeNil) {
+			a.So(got.UserID, should.Equal, "foo")
+		}
+
+		err = store.RestoreUser(ctx, &ttnpb.UserIdentifiers{UserID: "foo"})
+
+		if a.So(err, should.NotBeNil) {
+			a.So(errors.IsNotFound(err), should.BeTrue)
+		}
+
+		err = store.PurgeUser(ctx, &ttnpb.UserIdentifiers{UserID: "foo"})
+
+		if a.So(err, should.NotBeNil) {
+			a.So(errors.IsNotFound(err), should.BeTrue)
+		}
+
+		err = store.DeleteUser(ctx, &ttnpb.UserIdentifiers{UserID: "foo"})
+
+		a.So(err, should.BeNil)
+
+		err = store.PurgeUser(ctx, &ttnpb.UserIdentifiers{UserID: "foo"})
+
+		a.So(err, should.BeNil)
+
+		list, err = store.FindUsers(WithSoftDeleted(ctx, false), nil, nil)
+
+		a.So(err, should.BeNil)
+		a.So(list, should.BeEmpty)
+
+		_, err = store.CreateUser(ctx, &ttnpb.User{
+			UserIdentifiers: ttnpb.UserIdentifiers{UserID: "foo"},
+		})
+
+		a.So(err, should.BeNil)
 	})
 }
@@ -0,0 +1,55 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package store
+
+import "time"
+
+// UserTOTP is the TOTP two-factor authentication secret of a user.
+type UserTOTP struct {
+	Model
+
+	User   *User
+	UserID string `gorm:"type:UUID;unique_index:user_totp_user_index;not null"`
+
+	// Secret is the shared secret, encrypted with the KEK of SecretKEKLabel if set.
+	Secret         []byte `gorm:"type:BYTEA;not null"`
+	SecretKEKLabel string `gorm:"type:VARCHAR"`
+	EnabledAt      *time.Time
+
+	// LastUsedStep is the last time step of which the TOTP code was used.
+	LastUsedStep int64 `gorm:"not null;default:0"`
+
+	// Attempts is the number of attempts to use a two-factor code since the last successful one.
+	Attempts      int `gorm:"not null;default:0"`
+	LastAttemptAt *time.Time
+}
+
+func init() {
+	registerModel(&UserTOTP{})
+}
+
+// UserRecoveryCode is a recovery code that a user can use once instead of a TOTP code.
+type UserRecoveryCode struct {
+	Model
+
+	User   *User
+	UserID string `gorm:"type:UUID;index:user_recovery_code_user_index;not null"`
+
+	Code string `gorm:"type:VARCHAR;not null"` // this is the hash
+}
+
+func init() {
+	registerModel(&UserRecoveryCode{})
+}
@@ -0,0 +1,204 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package store
+
+import (
+	"context"
+	"runtime/trace"
+	"time"
+
+	"github.com/jinzhu/gorm"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+)
+
+// GetUserTOTPStore returns an UserTOTPStore on the given db (or transaction).
+func GetUserTOTPStore(db *gorm.DB) UserTOTPStore {
+	return &userTOTPStore{store: newStore(db)}
+}
+
+type userTOTPStore struct {
+	*store
+}
+
+func (s *userTOTPStore) GetTOTP(ctx context.Context, userIDs *ttnpb.UserIdentifiers) (*TOTP, error) {
+	defer trace.StartRegion(ctx, "get user TOTP").End()
+	user, err := s.findEntity(ctx, userIDs, "id")
+	if err != nil {
+		return nil, err
+	}
+	var totpModel UserTOTP
+	if err = s.query(ctx, UserTOTP{}).Where(UserTOTP{UserID: user.PrimaryKey()}).Find(&totpModel).Error; err != nil {
+		if gorm.IsRecordNotFoundError(err) {
+			return nil, errTOTPNotFound.WithAttributes("user_id", userIDs.UserID)
+		}
+		return nil, err
+	}
+	return &TOTP{
+		Secret: ttnpb.KeyEnvelope{
+			EncryptedKey: totpModel.Secret,
+			KEKLabel:     totpModel.SecretKEKLabel,
+		},
+		EnabledAt:    cleanTimePtr(totpModel.EnabledAt),
+		LastUsedStep: totpModel.LastUsedStep,
+	}, nil
+}
+
+func (s *userTOTPStore) SetTOTP(ctx context.Context, userIDs *ttnpb.UserIdentifiers, secret ttnpb.KeyEnvelope, enabledAt *time.Time) error {
+	defer trace.StartRegion(ctx, "set user TOTP").End()
+	user, err := s.findEntity(ctx, userIDs, "id")
+	if err != nil {
+		return err
+	}
+	var totpModel UserTOTP
+	err = s.query(ctx, UserTOTP{}).Where(UserTOTP{UserID: user.PrimaryKey()}).Find(&totpModel).Error
+	switch {
+	case gorm.IsRecordNotFoundError(err):
+		return s.createEntity(ctx, &UserTOTP{
+			UserID:         user.PrimaryKey(),
+			Secret:         secret.EncryptedKey,
+			SecretKEKLabel: secret.KEKLabel,
+			EnabledAt:      cleanTimePtr(enabledAt),
+		})
+	case err != nil:
+		return err
+	}
+	totpModel.Secret, totpModel.SecretKEKLabel = secret.EncryptedKey, secret.KEKLabel
+	totpModel.EnabledAt = cleanTimePtr(enabledAt)
+	return s.updateEntity(ctx, &totpModel, "secret", "secret_kek_label", "enabled_at")
+}
+
+func (s *userTOTPStore) UseTOTPStep(ctx context.Context, userIDs *ttnpb.UserIdentifiers, step int64) error {
+	defer trace.StartRegion(ctx, "use user TOTP step").End()
+	user, err := s.findEntity(ctx, userIDs, "id")
+	if err != nil {
+		return err
+	}
+	// The condition on the last used step makes concurrent uses of the same code fail.
+	query := s.query(ctx, UserTOTP{}).
+		Where("user_id = ? AND last_used_step < ?", user.PrimaryKey(), step).
+		UpdateColumn("last_used_step", step)
+	if err = query.Error; err != nil {
+		return err
+	}
+	if query.RowsAffected == 0 {
+		return errTOTPStepUsed
+	}
+	return nil
+}
+
+func (s *userTOTPStore) CountTOTPAttempt(ctx context.Context, userIDs *ttnpb.UserIdentifiers, limit int, since, at time.Time) error {
+	defer trace.StartRegion(ctx, "count user TOTP attempt").End()
+	user, err := s.findEntity(ctx, userIDs, "id")
+	if err != nil {
+		return err
+	}
+	// The attempt is counted with a single conditional update, so that concurrent
+	// attempts can not exceed the limit.
+	query := s.query(ctx, UserTOTP{}).
+		Where("user_id = ? AND (attempts < ? OR last_attempt_at IS NULL OR last_attempt_at < ?)", user.PrimaryKey(), limit, cleanTime(since)).
+		UpdateColumns(map[string]interface{}{
+			"attempts":        gorm.Expr("attempts + 1"),
+			"last_attempt_at": cleanTime(at),
+		})
+	if err = query.Error; err != nil {
+		return err
+	}
+	if query.RowsAffected == 0 {
+		var count int
+		if err = s.query(ctx, UserTOTP{}).Where(UserTOTP{UserID: user.PrimaryKey()}).Count(&count).Error; err != nil {
+			return err
+		}
+		if count == 0 {
+			return errTOTPNotFound.WithAttributes("user_id", userIDs.UserID)
+		}
+		return errTOTPAttemptLimit
+	}
+	return nil
+}
+
+func (s *userTOTPStore) ResetTOTPAttempts(ctx context.Context, userIDs *ttnpb.UserIdentifiers) error {
+	defer trace.StartRegion(ctx, "reset user TOTP attempts").End()
+	user, err := s.findEntity(ctx, userIDs, "id")
+	if err != nil {
+		return err
+	}
+	return s.query(ctx, UserTOTP{}).
+		Where(UserTOTP{UserID: user.PrimaryKey()}).
+		UpdateColumn("attempts", 0).Error
+}
+
+func (s *userTOTPStore) DeleteTOTP(ctx context.Context, userIDs *ttnpb.UserIdentifiers) error {
+	defer trace.StartRegion(ctx, "delete user TOTP").End()
+	user, err := s.findEntity(ctx, userIDs, "id")
+	if err != nil {
+		return err
+	}
+	for _, model := range []interface{}{&UserTOTP{}, &UserRecoveryCode{}} {
+		if err = s.query(ctx, model).Where("user_id = ?", user.PrimaryKey()).Delete(model).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *userTOTPStore) FindRecoveryCodes(ctx context.Context, userIDs *ttnpb.UserIdentifiers) ([]string, error) {
+	defer trace.StartRegion(ctx, "find user recovery codes").End()
+	user, err := s.findEntity(ctx, userIDs, "id")
+	if err != nil {
+		return nil, err
+	}
+	var codeModels []UserRecoveryCode
+	if err = s.query(ctx, UserRecoveryCode{}).Where(UserRecoveryCode{UserID: user.PrimaryKey()}).Find(&codeModels).Error; err != nil {
+		return nil, err
+	}
+	codes := make([]string, len(codeModels))
+	for i, codeModel := range codeModels {
+		codes[i] = codeModel.Code
+	}
+	return codes, nil
+}
+
+func (s *userTOTPStore) SetRecoveryCodes(ctx context.Context, userIDs *ttnpb.UserIdentifiers, codes []string) error {
+	defer trace.StartRegion(ctx, "set user recovery codes").End()
+	user, err := s.findEntity(ctx, userIDs, "id")
+	if err != nil {
+		return err
+	}
+	if err = s.query(ctx, UserRecoveryCode{}).Where("user_id = ?", user.PrimaryKey()).Delete(&UserRecoveryCode{}).Error; err != nil {
+		return err
+	}
+	for _, code := range codes {
+		if err = s.createEntity(ctx, &UserRecoveryCode{UserID: user.PrimaryKey(), Code: code}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *userTOTPStore) DeleteRecoveryCode(ctx context.Context, userIDs *ttnpb.UserIdentifiers, code string) error {
+	defer trace.StartRegion(ctx, "delete user recovery code").End()
+	user, err := s.findEntity(ctx, userIDs, "id")
+	if err != nil {
+		return err
+	}
+	query := s.query(ctx, UserRecoveryCode{}).Where(UserRecoveryCode{UserID: user.PrimaryKey(), Code: code}).Delete(&UserRecoveryCode{})
+	if err = query.Error; err != nil {
+		return err
+	}
+	if query.RowsAffected == 0 {
+		return errRecoveryCodeNotFound
+	}
+	return nil
+}
@@ -0,0 +1,194 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package store
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jinzhu/gorm"
+	"github.com/smartystreets/assertions"
+	"github.com/smartystreets/assertions/should"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+)
+
+func TestUserTOTPStore(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+
+	WithDB(t, func(t *testing.T, db *gorm.DB) {
+		prepareTest(db, &Account{}, &User{}, &UserTOTP{}, &UserRecoveryCode{})
+
+		user := &User{
+			Account: Account{
+				UID: "test",
+			},
+			Name: "Test User",
+		}
+
+		userIDs := ttnpb.UserIdentifiers{UserID: "test"}
+		doesNotExistIDs := ttnpb.UserIdentifiers{UserID: "does_not_exist"}
+
+		if err := newStore(db).createEntity(ctx, user); err != nil {
+			panic(err)
+		}
+
+		store := GetUserTOTPStore(db)
+
+		secret := ttnpb.KeyEnvelope{EncryptedKey: []byte("SECRET")}
+
+		err := store.SetTOTP(ctx, &doesNotExistIDs, secret, nil)
+
+		if a.So(err, should.NotBeNil) {
+			a.So(errors.IsNotFound(err), should.BeTrue)
+		}
+
+		_, err = store.GetTOTP(ctx, &userIDs)
+
+		if a.So(err, should.NotBeNil) {
+			a.So(errors.IsNotFound(err), should.BeTrue)
+		}
+
+		err = store.SetTOTP(ctx, &userIDs, secret, nil)
+
+		a.So(err, should.BeNil)
+
+		totp, err := store.GetTOTP(ctx, &userIDs)
+
+		if a.So(err, should.BeNil) && a.So(totp, should.NotBeNil) {
+			a.So(totp.Secret, should.Resemble, secret)
+			a.So(totp.EnabledAt, should.BeNil)
+			a.So(totp.LastUsedStep, should.Equal, 0)
+		}
+
+		otherSecret := ttnpb.KeyEnvelope{EncryptedKey: []byte("OTHER_SECRET"), KEKLabel: "test"}
+		now := time.Now()
+		err = store.SetTOTP(ctx, &userIDs, otherSecret, &now)
+
+		a.So(err, should.BeNil)
+
+		totp, err = store.GetTOTP(ctx, &userIDs)
+
+		if a.So(err, should.BeNil) && a.So(totp, should.NotBeNil) {
+			a.So(totp.Secret, should.Resemble, otherSecret)
+			if a.So(totp.EnabledAt, should.NotBeNil) {
+				a.So(*totp.EnabledAt, should.Equal, cleanTime(now))
+			}
+		}
+
+		err = store.UseTOTPStep(ctx, &userIDs, 42)
+
+		a.So(err, should.BeNil)
+
+		err = store.UseTOTPStep(ctx, &userIDs, 42)
+
+		if a.So(err, should.NotBeNil) {
+			a.So(errors.IsAlreadyExists(err), should.BeTrue)
+		}
+
+		err = store.UseTOTPStep(ctx, &userIDs, 41)
+
+		if a.So(err, should.NotBeNil) {
+			a.So(errors.IsAlreadyExists(err), should.BeTrue)
+		}
+
+		totp, err = store.GetTOTP(ctx, &userIDs)
+
+		if a.So(err, should.BeNil) && a.So(totp, should.NotBeNil) {
+			a.So(totp.LastUsedStep, should.Equal, 42)
+		}
+
+		err = store.CountTOTPAttempt(ctx, &doesNotExistIDs, 2, now.Add(-time.Minute), now)
+
+		if a.So(err, should.NotBeNil) {
+			a.So(errors.IsNotFound(err), should.BeTrue)
+		}
+
+		for i := 0; i < 2; i++ {
+			err = store.CountTOTPAttempt(ctx, &userIDs, 2, now.Add(-time.Minute), now)
+
+			a.So(err, should.BeNil)
+		}
+
+		err = store.CountTOTPAttempt(ctx, &userIDs, 2, now.Add(-time.Minute), now)
+
+		if a.So(err, should.NotBeNil) {
+			a.So(errors.IsResourceExhausted(err), should.BeTrue)
+		}
+
+		// Once the last attempt is long enough ago, another attempt is allowed.
+		err = store.CountTOTPAttempt(ctx, &userIDs, 2, now.Add(time.Minute), now.Add(2*time.Minute))
+
+		a.So(err, should.BeNil)
+
+		err = store.ResetTOTPAttempts(ctx, &userIDs)
+
+		a.So(err, should.BeNil)
+
+		err = store.CountTOTPAttempt(ctx, &userIDs, 2, now.Add(-time.Minute), now)
+
+		a.So(err, should.BeNil)
+
+		err = store.SetRecoveryCodes(ctx, &userIDs, []string{"HASH1", "HASH2"})
+
+		a.So(err, should.BeNil)
+
+		codes, err := store.FindRecoveryCodes(ctx, &userIDs)
+
+		a.So(err, should.BeNil)
+		a.So(codes, should.HaveLength, 2)
+
+		err = store.DeleteRecoveryCode(ctx, &userIDs, "HASH1")
+
+		a.So(err, should.BeNil)
+
+		err = store.DeleteRecoveryCode(ctx, &userIDs, "HASH1")
+
+		if a.So(err, should.NotBeNil) {
+			a.So(errors.IsNotFound(err), should.BeTrue)
+		}
+
+		codes, err = store.FindRecoveryCodes(ctx, &userIDs)
+
+		a.So(err, should.BeNil)
+		a.So(codes, should.Resemble, []string{"HASH2"})
+
+		err = store.SetRecoveryCodes(ctx, &userIDs, []string{"HASH3"})
+
+		a.So(err, should.BeNil)
+
+		codes, err = store.FindRecoveryCodes(ctx, &userIDs)
+
+		a.So(err, should.BeNil)
+		a.So(codes, should.Resemble, []string{"HASH3"})
+
+		err = store.DeleteTOTP(ctx, &userIDs)
+
+		a.So(err, should.BeNil)
+
+		_, err = store.GetTOTP(ctx, &userIDs)
+
+		if a.So(err, should.NotBeNil) {
+			a.So(errors.IsNotFound(err), should.BeTrue)
+		}
+
+		codes, err = store.FindRecoveryCodes(ctx, &userIDs)
+
+		a.So(err, should.BeNil)
+		a.So(codes, should.BeEmpty)
+	})
+}
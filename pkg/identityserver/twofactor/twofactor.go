@@ -0,0 +1,168 @@
+// Copyright © 2026 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package twofactor validates the two-factor authentication codes of users.
+// It is shared by the Identity Server and the OAuth server, so that both accept
+// the same codes and enforce the same limits.
+package twofactor
+
+import (
+	"context"
+	"runtime/trace"
+	"strings"
+	"time"
+
+	"go.thethings.network/lorawan-stack/pkg/auth"
+	"go.thethings.network/lorawan-stack/pkg/auth/totp"
+	"go.thethings.network/lorawan-stack/pkg/crypto"
+	"go.thethings.network/lorawan-stack/pkg/crypto/cryptoutil"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/events"
+	"go.thethings.network/lorawan-stack/pkg/identityserver/store"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+)
+
+var evtUseRecoveryCode = events.Define(
+	"user.recovery_codes.use", "use user recovery code",
+	ttnpb.RIGHT_USER_INFO,
+)
+
+const (
+	// attemptLimit is the number of attempts that users can make before they
+	// need to wait for attemptLockout since their last attempt.
+	attemptLimit   = 5
+	attemptLockout = 5 * time.Minute
+
+	totpCodeLength = 6
+)
+
+// WrapSecret encrypts the TOTP secret with the KEK of the given label.
+// If the KEK label is empty, the secret is returned in the clear.
+func WrapSecret(ctx context.Context, secret string, kekLabel string, kv crypto.KeyVault) (ttnpb.KeyEnvelope, error) {
+	if kekLabel == "" {
+		return ttnpb.KeyEnvelope{EncryptedKey: []byte(secret)}, nil
+	}
+	if kv == nil {
+		kv = cryptoutil.EmptyKeyVault
+	}
+	// The base32 encoding of the secret is wrapped, since its length is a multiple of 8 bytes.
+	wrapped, err := kv.Wrap(ctx, []byte(secret), kekLabel)
+	if err != nil {
+		return ttnpb.KeyEnvelope{}, err
+	}
+	return ttnpb.KeyEnvelope{
+		EncryptedKey: wrapped,
+		KEKLabel:     kekLabel,
+	}, nil
+}
+
+// UnwrapSecret decrypts the TOTP secret.
+// If the KEK label is empty, the secret is assumed to be stored in the clear.
+func UnwrapSecret(ctx context.Context, secret ttnpb.KeyEnvelope, kv crypto.KeyVault) (string, error) {
+	if secret.KEKLabel == "" {
+		return string(secret.EncryptedKey), nil
+	}
+	if kv == nil {
+		kv = cryptoutil.EmptyKeyVault
+	}
+	plaintext, err := kv.Unwrap(ctx, secret.EncryptedKey, secret.KEKLabel)
+	if err != nil {
+		return "", err
+	}
+	return string(plaintext), nil
+}
+
+// ValidateTOTP validates the TOTP code of the user.
+// Each code is accepted only once, and the number of attempts is limited.
+func ValidateTOTP(ctx context.Context, st store.UserTOTPStore, kv crypto.KeyVault, ids *ttnpb.UserIdentifiers, state *store.TOTP, code string, now time.Time) (bool, error) {
+	return validate(ctx, st, kv, ids, state, code, now, false)
+}
+
+// Validate validates the TOTP code or a recovery code of the user.
+// Each code is accepted only once, and the number of attempts is limited.
+// Recovery codes are deleted once they are used.
+func Validate(ctx context.Context, st store.UserTOTPStore, kv crypto.KeyVault, ids *ttnpb.UserIdentifiers, state *store.TOTP, code string, now time.Time) (bool, error) {
+	return validate(ctx, st, kv, ids, state, code, now, true)
+}
+
+func validate(ctx context.Context, st store.UserTOTPStore, kv crypto.KeyVault, ids *ttnpb.UserIdentifiers, state *store.TOTP, code string, now time.Time, allowRecoveryCode bool) (bool, error) {
+	// The attempt is counted before the code is validated, so that concurrent
+	// attempts are also limited.
+	if err := st.CountTOTPAttempt(ctx, ids, attemptLimit, now.Add(-attemptLockout), now); err != nil {
+		return false, err
+	}
+	code = strings.ToLower(strings.TrimSpace(code))
+	var (
+		valid bool
+		err   error
+	)
+	if len(code) == totpCodeLength || !allowRecoveryCode {
+		valid, err = validateTOTPCode(ctx, st, kv, ids, state, code, now)
+	} else {
+		valid, err = validateRecoveryCode(ctx, st, ids, code)
+	}
+	if err != nil || !valid {
+		return false, err
+	}
+	if err = st.ResetTOTPAttempts(ctx, ids); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func validateTOTPCode(ctx context.Context, st store.UserTOTPStore, kv crypto.KeyVault, ids *ttnpb.UserIdentifiers, state *store.TOTP, code string, now time.Time) (bool, error) {
+	secret, err := UnwrapSecret(ctx, state.Secret, kv)
+	if err != nil {
+		return false, err
+	}
+	step, valid, err := totp.Validate(secret, code, now, state.LastUsedStep)
+	if err != nil || !valid {
+		return false, err
+	}
+	if err = st.UseTOTPStep(ctx, ids, step); err != nil {
+		if errors.IsAlreadyExists(err) {
+			// The code was used concurrently.
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+func validateRecoveryCode(ctx context.Context, st store.UserTOTPStore, ids *ttnpb.UserIdentifiers, code string) (bool, error) {
+	hashes, err := st.FindRecoveryCodes(ctx, ids)
+	if err != nil {
+		return false, err
+	}
+	defer trace.StartRegion(ctx, "validate recovery code").End()
+	for _, hash := range hashes {
+		valid, err := auth.Validate(hash, code)
+		if err != nil {
+			return false, err
+		}
+		if !valid {
+			continue
+		}
+		if err = st.DeleteRecoveryCode(ctx, ids, hash); err != nil {
+			if errors.IsNotFound(err) {
+				// The code was used concurrently.
+				return false, nil
+			}
+			return false, err
+		}
+		events.Publish(evtUseRecoveryCode(ctx, ids, nil))
+		return true, nil
+	}
+	return false, nil
+}
@@ -0,0 +1,179 @@
+// Copyright © 2026 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package twofactor_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/auth"
+	"go.thethings.network/lorawan-stack/pkg/auth/totp"
+	"go.thethings.network/lorawan-stack/pkg/crypto/cryptoutil"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/identityserver/store"
+	. "go.thethings.network/lorawan-stack/pkg/identityserver/twofactor"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+var (
+	errStepUsed     = errors.DefineAlreadyExists("step_used", "step used")
+	errAttemptLimit = errors.DefineResourceExhausted("attempt_limit", "attempt limit")
+	errCodeNotFound = errors.DefineNotFound("code_not_found", "code not found")
+)
+
+type mockStore struct {
+	store.UserTOTPStore
+
+	lastUsedStep  int64
+	attempts      int
+	lastAttemptAt time.Time
+	recoveryCodes []string
+}
+
+func (s *mockStore) UseTOTPStep(ctx context.Context, userIDs *ttnpb.UserIdentifiers, step int64) error {
+	if step <= s.lastUsedStep {
+		return errStepUsed
+	}
+	s.lastUsedStep = step
+	return nil
+}
+
+func (s *mockStore) CountTOTPAttempt(ctx context.Context, userIDs *ttnpb.UserIdentifiers, limit int, since, at time.Time) error {
+	if s.attempts >= limit && !s.lastAttemptAt.Before(since) {
+		return errAttemptLimit
+	}
+	s.attempts++
+	s.lastAttemptAt = at
+	return nil
+}
+
+func (s *mockStore) ResetTOTPAttempts(ctx context.Context, userIDs *ttnpb.UserIdentifiers) error {
+	s.attempts = 0
+	return nil
+}
+
+func (s *mockStore) FindRecoveryCodes(ctx context.Context, userIDs *ttnpb.UserIdentifiers) ([]string, error) {
+	return s.recoveryCodes, nil
+}
+
+func (s *mockStore) DeleteRecoveryCode(ctx context.Context, userIDs *ttnpb.UserIdentifiers, code string) error {
+	for i, hash := range s.recoveryCodes {
+		if hash == code {
+			s.recoveryCodes = append(s.recoveryCodes[:i], s.recoveryCodes[i+1:]...)
+			return nil
+		}
+	}
+	return errCodeNotFound
+}
+
+func TestSecret(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+
+	kv := cryptoutil.NewMemKeyVault(map[string][]byte{
+		"test": {0x0, 0x1, 0x2, 0x3, 0x4, 0x5, 0x6, 0x7, 0x8, 0x9, 0xa, 0xb, 0xc, 0xd, 0xe, 0xf},
+	})
+
+	secret, err := totp.GenerateSecret()
+	a.So(err, should.BeNil)
+
+	clear, err := WrapSecret(ctx, secret, "", kv)
+	a.So(err, should.BeNil)
+	a.So(clear, should.Resemble, ttnpb.KeyEnvelope{EncryptedKey: []byte(secret)})
+
+	wrapped, err := WrapSecret(ctx, secret, "test", kv)
+	a.So(err, should.BeNil)
+	a.So(wrapped.KEKLabel, should.Equal, "test")
+	a.So(wrapped.EncryptedKey, should.NotResemble, []byte(secret))
+
+	for _, envelope := range []ttnpb.KeyEnvelope{clear, wrapped} {
+		unwrapped, err := UnwrapSecret(ctx, envelope, kv)
+		a.So(err, should.BeNil)
+		a.So(unwrapped, should.Equal, secret)
+	}
+
+	_, err = UnwrapSecret(ctx, ttnpb.KeyEnvelope{EncryptedKey: wrapped.EncryptedKey, KEKLabel: "unknown"}, kv)
+	a.So(errors.IsNotFound(err), should.BeTrue)
+}
+
+func TestValidate(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+
+	ids := &ttnpb.UserIdentifiers{UserID: "test"}
+	secret, err := totp.GenerateSecret()
+	a.So(err, should.BeNil)
+	now := time.Now()
+	code, err := totp.Code(secret, now)
+	a.So(err, should.BeNil)
+	recoveryCodeHash, err := auth.Hash(ctx, "abcde-fghij")
+	a.So(err, should.BeNil)
+
+	st := &mockStore{recoveryCodes: []string{recoveryCodeHash}}
+	state := &store.TOTP{Secret: ttnpb.KeyEnvelope{EncryptedKey: []byte(secret)}}
+
+	// Accept the TOTP code once.
+	valid, err := Validate(ctx, st, nil, ids, state, code, now)
+	a.So(err, should.BeNil)
+	a.So(valid, should.BeTrue)
+	a.So(st.lastUsedStep, should.Equal, now.Unix()/30)
+	a.So(st.attempts, should.Equal, 0)
+
+	valid, err = Validate(ctx, st, nil, ids, state, code, now)
+	a.So(err, should.BeNil)
+	a.So(valid, should.BeFalse)
+
+	// Recovery codes are not accepted instead of TOTP codes.
+	valid, err = ValidateTOTP(ctx, st, nil, ids, state, "abcde-fghij", now)
+	a.So(err, should.BeNil)
+	a.So(valid, should.BeFalse)
+	a.So(st.recoveryCodes, should.HaveLength, 1)
+
+	// Accept the recovery code once.
+	valid, err = Validate(ctx, st, nil, ids, state, " ABCDE-FGHIJ ", now)
+	a.So(err, should.BeNil)
+	a.So(valid, should.BeTrue)
+	a.So(st.recoveryCodes, should.BeEmpty)
+	a.So(st.attempts, should.Equal, 0)
+
+	valid, err = Validate(ctx, st, nil, ids, state, "abcde-fghij", now)
+	a.So(err, should.BeNil)
+	a.So(valid, should.BeFalse)
+
+	// Limit the number of attempts.
+	for st.attempts < 5 {
+		valid, err = Validate(ctx, st, nil, ids, state, "000000", now)
+		a.So(err, should.BeNil)
+		a.So(valid, should.BeFalse)
+	}
+	nextCode, err := totp.Code(secret, now.Add(30*time.Second))
+	a.So(err, should.BeNil)
+	_, err = Validate(ctx, st, nil, ids, state, nextCode, now)
+	a.So(errors.IsResourceExhausted(err), should.BeTrue)
+
+	// Allow attempts again after the lockout.
+	later := now.Add(6 * time.Minute)
+	laterCode, err := totp.Code(secret, later)
+	a.So(err, should.BeNil)
+	state.LastUsedStep = st.lastUsedStep
+	valid, err = Validate(ctx, st, nil, ids, state, laterCode, later)
+	a.So(err, should.BeNil)
+	a.So(valid, should.BeTrue)
+	a.So(st.attempts, should.Equal, 0)
+}
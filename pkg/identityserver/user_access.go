@@ -19,11 +19,8 @@ import (
 
 	"github.com/jinzhu/gorm"
 	"go.thethings.network/lorawan-stack/pkg/auth/rights"
-	"go.thethings.network/lorawan-stack/pkg/email"
 	"go.thethings.network/lorawan-stack/pkg/events"
-	"go.thethings.network/lorawan-stack/pkg/identityserver/emails"
 	"go.thethings.network/lorawan-stack/pkg/identityserver/store"
-	"go.thethings.network/lorawan-stack/pkg/log"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
 )
 
@@ -47,7 +44,9 @@ func (is *IdentityServer) listUserRights(ctx context.Context, ids *ttnpb.UserIde
 	if err != nil {
 		return nil, err
 	}
-	return usrRights.Intersect(ttnpb.AllEntityRights.Union(ttnpb.AllOrganizationRights, ttnpb.AllUserRights)), nil
+	res := usrRights.Intersect(ttnpb.AllEntityRights.Union(ttnpb.AllOrganizationRights, ttnpb.AllUserRights))
+	res.Restrictions = usrRights.GetRestrictions()
+	return res, nil
 }
 
 func (is *IdentityServer) createUserAPIKey(ctx context.Context, req *ttnpb.CreateUserAPIKeyRequest) (key *ttnpb.APIKey, err error) {
@@ -59,10 +58,14 @@ func (is *IdentityServer) createUserAPIKey(ctx context.Context, req *ttnpb.Creat
 	if err = rights.RequireUser(ctx, req.UserIdentifiers, req.Rights...); err != nil {
 		return nil, err
 	}
+	if err = validateAPIKeyOptions(req.UserIdentifiers, req.ExpiresAt, req.Restrictions); err != nil {
+		return nil, err
+	}
 	key, token, err := generateAPIKey(ctx, req.Name, req.Rights...)
 	if err != nil {
 		return nil, err
 	}
+	key.ExpiresAt, key.Restrictions = req.ExpiresAt, req.Restrictions
 	err = is.withDatabase(ctx, func(db *gorm.DB) error {
 		return store.GetAPIKeyStore(db).CreateAPIKey(ctx, req.UserIdentifiers, key)
 	})
@@ -71,13 +74,12 @@ func (is *IdentityServer) createUserAPIKey(ctx context.Context, req *ttnpb.Creat
 	}
 	key.Key = token
 	events.Publish(evtCreateUserAPIKey(ctx, req.UserIdentifiers, nil))
-	err = is.SendUserEmail(ctx, &req.UserIdentifiers, func(data emails.Data) email.MessageData {
-		data.SetEntity(req.EntityIdentifiers())
-		return &emails.APIKeyCreated{Data: data, Identifier: key.PrettyName(), Rights: key.Rights}
+	is.notify(ctx, &ttnpb.Notification{
+		NotificationType: ttnpb.NOTIFICATION_TYPE_API_KEY_CREATED,
+		EntityIDs:        *req.EntityIdentifiers(),
+		Subject:          key.PrettyName(),
+		Rights:           key.Rights,
 	})
-	if err != nil {
-		log.FromContext(ctx).WithError(err).Error("Could not send API key created notification email")
-	}
 	return key, nil
 }
 
@@ -132,15 +134,20 @@ func (is *IdentityServer) updateUserAPIKey(ctx context.Context, req *ttnpb.Updat
 		return nil, err
 	}
 
+	if err = validateAPIKeyUpdate(req.UserIdentifiers, &req.APIKey, req.FieldMask); err != nil {
+		return nil, err
+	}
+
 	err = is.withDatabase(ctx, func(db *gorm.DB) (err error) {
-		if len(req.APIKey.Rights) > 0 {
-			_, key, err := store.GetAPIKeyStore(db).GetAPIKey(ctx, req.APIKey.ID)
-			if err != nil {
-				return err
-			}
+		_, existing, err := store.GetAPIKeyStore(db).GetAPIKey(ctx, req.APIKey.ID)
+		if err != nil {
+			return err
+		}
+		update := applyAPIKeyUpdate(existing, &req.APIKey, req.FieldMask)
 
-			newRights := ttnpb.RightsFrom(req.APIKey.Rights...)
-			existingRights := ttnpb.RightsFrom(key.Rights...)
+		if len(update.Rights) > 0 {
+			newRights := ttnpb.RightsFrom(update.Rights...)
+			existingRights := ttnpb.RightsFrom(existing.Rights...)
 
 			// Require the caller to have all added rights.
 			if err := rights.RequireUser(ctx, req.UserIdentifiers, newRights.Sub(existingRights).GetRights()...); err != nil {
@@ -152,28 +159,24 @@ func (is *IdentityServer) updateUserAPIKey(ctx context.Context, req *ttnpb.Updat
 			}
 		}
 
-		key, err = store.GetAPIKeyStore(db).UpdateAPIKey(ctx, req.UserIdentifiers, &req.APIKey)
+		key, err = store.GetAPIKeyStore(db).UpdateAPIKey(ctx, req.UserIdentifiers, update)
 		return err
 	})
 	if err != nil {
 		return nil, err
 	}
 	if key == nil {
+		events.Publish(evtDeleteUserAPIKey(ctx, req.UserIdentifiers, nil))
 		return &ttnpb.APIKey{}, nil
 	}
 	key.Key = ""
-	if len(req.Rights) > 0 {
-		events.Publish(evtUpdateUserAPIKey(ctx, req.UserIdentifiers, nil))
-		err = is.SendUserEmail(ctx, &req.UserIdentifiers, func(data emails.Data) email.MessageData {
-			data.SetEntity(req.EntityIdentifiers())
-			return &emails.APIKeyChanged{Data: data, Identifier: key.PrettyName(), Rights: key.Rights}
-		})
-		if err != nil {
-			log.FromContext(ctx).WithError(err).Error("Could not send API key update notification email")
-		}
-	} else {
-		events.Publish(evtDeleteUserAPIKey(ctx, req.UserIdentifiers, nil))
-	}
+	events.Publish(evtUpdateUserAPIKey(ctx, req.UserIdentifiers, nil))
+	is.notify(ctx, &ttnpb.Notification{
+		NotificationType: ttnpb.NOTIFICATION_TYPE_API_KEY_CHANGED,
+		EntityIDs:        *req.EntityIdentifiers(),
+		Subject:          key.PrettyName(),
+		Rights:           key.Rights,
+	})
 	return key, nil
 }
 
@@ -50,6 +50,14 @@ var (
 		"user.delete", "delete user",
 		ttnpb.RIGHT_USER_INFO,
 	)
+	evtRestoreUser = events.Define(
+		"user.restore", "restore user",
+		ttnpb.RIGHT_USER_INFO,
+	)
+	evtPurgeUser = events.Define(
+		"user.purge", "purge user",
+		ttnpb.RIGHT_USER_INFO,
+	)
 	evtUpdateUserIncorrectPassword = events.Define(
 		"user.update.incorrect_password", "update user failure: incorrect password",
 		ttnpb.RIGHT_USER_INFO,
@@ -208,6 +216,12 @@ func (is *IdentityServer) createUser(ctx context.Context, req *ttnpb.CreateUserR
 
 	usr.Password = "" // Create doesn't have a FieldMask, so we need to manually remove the password.
 	events.Publish(evtCreateUser(ctx, req.UserIdentifiers, nil))
+	if usr.State == ttnpb.STATE_REQUESTED {
+		is.notify(ctx, &ttnpb.Notification{
+			NotificationType: ttnpb.NOTIFICATION_TYPE_APPROVAL_REQUESTED,
+			EntityIDs:        *req.UserIdentifiers.EntityIdentifiers(),
+		})
+	}
 	return usr, nil
 }
 
@@ -264,8 +278,12 @@ func (is *IdentityServer) listUsers(ctx context.Context, req *ttnpb.ListUsersReq
 		return nil, err
 	}
 	ctx = store.WithOrder(ctx, req.Order)
+	findCtx := ctx
+	if req.Deleted {
+		findCtx = store.WithSoftDeleted(ctx, true)
+	}
 	var total uint64
-	paginateCtx := store.WithPagination(ctx, req.Limit, req.Page, &total)
+	paginateCtx := store.WithPagination(findCtx, req.Limit, req.Page, &total)
 	defer func() {
 		if err == nil {
 			setTotalHeader(ctx, total)
@@ -466,10 +484,16 @@ func (is *IdentityServer) updateUserPassword(ctx context.Context, req *ttnpb.Upd
 			return err
 		}
 		if valid {
-			// TODO: Add when 2FA is enabled (https://github.com/TheThingsNetwork/lorawan-stack/issues/2)
-			// if err := rights.RequireUser(ctx, req.UserIdentifiers, ttnpb.RIGHT_USER_ALL); err != nil {
-			//	return err
-			// }
+			// The old password is not sufficient if the user enabled two-factor authentication.
+			enabled, err := totpEnabled(ctx, db, &req.UserIdentifiers)
+			if err != nil {
+				return err
+			}
+			if enabled {
+				if err := rights.RequireUser(ctx, req.UserIdentifiers, ttnpb.RIGHT_USER_ALL); err != nil {
+					return err
+				}
+			}
 		} else {
 			if usr.TemporaryPassword == "" {
 				events.Publish(evtUpdateUserIncorrectPassword(ctx, req.UserIdentifiers, nil))
@@ -599,6 +623,30 @@ func (is *IdentityServer) deleteUser(ctx context.Context, ids *ttnpb.UserIdentif
 	return ttnpb.Empty, nil
 }
 
+func (is *IdentityServer) restoreUser(ctx context.Context, ids *ttnpb.UserIdentifiers) (*types.Empty, error) {
+	if err := rights.RequireUser(store.WithSoftDeleted(ctx, false), *ids, ttnpb.RIGHT_USER_DELETE); err != nil {
+		return nil, err
+	}
+	err := is.withDatabase(ctx, func(db *gorm.DB) error {
+		return store.GetUserStore(db).RestoreUser(ctx, ids)
+	})
+	if err != nil {
+		return nil, err
+	}
+	events.Publish(evtRestoreUser(ctx, ids, nil))
+	return ttnpb.Empty, nil
+}
+
+func (is *IdentityServer) purgeUser(ctx context.Context, ids *ttnpb.UserIdentifiers) (*types.Empty, error) {
+	if err := is.RequireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	if err := is.purgeEntity(ctx, ids); err != nil {
+		return nil, err
+	}
+	return ttnpb.Empty, nil
+}
+
 type userRegistry struct {
 	*IdentityServer
 }
@@ -630,3 +678,11 @@ func (ur *userRegistry) CreateTemporaryPassword(ctx context.Context, req *ttnpb.
 func (ur *userRegistry) Delete(ctx context.Context, req *ttnpb.UserIdentifiers) (*types.Empty, error) {
 	return ur.deleteUser(ctx, req)
 }
+
+func (ur *userRegistry) Restore(ctx context.Context, req *ttnpb.UserIdentifiers) (*types.Empty, error) {
+	return ur.restoreUser(ctx, req)
+}
+
+func (ur *userRegistry) Purge(ctx context.Context, req *ttnpb.UserIdentifiers) (*types.Empty, error) {
+	return ur.purgeUser(ctx, req)
+}
@@ -0,0 +1,351 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package identityserver
+
+import (
+	"context"
+	"crypto/rand"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/jinzhu/gorm"
+	"go.thethings.network/lorawan-stack/pkg/auth"
+	"go.thethings.network/lorawan-stack/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/pkg/auth/totp"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/events"
+	"go.thethings.network/lorawan-stack/pkg/identityserver/store"
+	"go.thethings.network/lorawan-stack/pkg/identityserver/twofactor"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+)
+
+var (
+	evtEnableUserTOTP = events.Define(
+		"user.totp.enable", "enable user TOTP",
+		ttnpb.RIGHT_USER_INFO,
+	)
+	evtDisableUserTOTP = events.Define(
+		"user.totp.disable", "disable user TOTP",
+		ttnpb.RIGHT_USER_INFO,
+	)
+	evtGenerateUserRecoveryCodes = events.Define(
+		"user.recovery_codes.generate", "generate user recovery codes",
+		ttnpb.RIGHT_USER_INFO,
+	)
+	evtUserTOTPIncorrectCode = events.Define(
+		"user.totp.incorrect_code", "user TOTP failure: incorrect code",
+		ttnpb.RIGHT_USER_INFO,
+	)
+)
+
+var (
+	errTOTPNotEnrolled     = errors.DefineFailedPrecondition("totp_not_enrolled", "TOTP is not enrolled")
+	errTOTPNotEnabled      = errors.DefineFailedPrecondition("totp_not_enabled", "TOTP is not enabled")
+	errTOTPAlreadyEnabled  = errors.DefineFailedPrecondition("totp_already_enabled", "TOTP is already enabled")
+	errIncorrectTOTPCode   = errors.DefineInvalidArgument("incorrect_totp_code", "incorrect TOTP code")
+	errTwoFactorCodeNeeded = errors.DefineInvalidArgument("two_factor_code_needed", "TOTP code or recovery code needed")
+)
+
+const (
+	recoveryCodeCount  = 10
+	recoveryCodeLength = 10
+	// recoveryCodeAlphabet has 32 characters, so that random bytes map to it without bias.
+	recoveryCodeAlphabet = "abcdefghijklmnopqrstuvwxyz234567"
+)
+
+func generateRecoveryCodes() ([]string, error) {
+	codes := make([]string, recoveryCodeCount)
+	for i := range codes {
+		var b [recoveryCodeLength]byte
+		if _, err := rand.Read(b[:]); err != nil {
+			return nil, err
+		}
+		for j := range b {
+			b[j] = recoveryCodeAlphabet[int(b[j])%len(recoveryCodeAlphabet)]
+		}
+		codes[i] = string(b[:recoveryCodeLength/2]) + "-" + string(b[recoveryCodeLength/2:])
+	}
+	return codes, nil
+}
+
+// setRecoveryCodes generates new recovery codes for the user and stores them hashed.
+func setRecoveryCodes(ctx context.Context, db *gorm.DB, ids *ttnpb.UserIdentifiers) (*ttnpb.UserRecoveryCodes, error) {
+	codes, err := generateRecoveryCodes()
+	if err != nil {
+		return nil, err
+	}
+	hashes := make([]string, len(codes))
+	for i, code := range codes {
+		if hashes[i], err = auth.Hash(ctx, code); err != nil {
+			return nil, err
+		}
+	}
+	if err = store.GetUserTOTPStore(db).SetRecoveryCodes(ctx, ids, hashes); err != nil {
+		return nil, err
+	}
+	return &ttnpb.UserRecoveryCodes{Codes: codes}, nil
+}
+
+func (is *IdentityServer) getUserTOTP(ctx context.Context, ids *ttnpb.UserIdentifiers) (*ttnpb.UserTOTPStatus, error) {
+	if err := rights.RequireUser(ctx, *ids, ttnpb.RIGHT_USER_INFO); err != nil {
+		return nil, err
+	}
+	status := &ttnpb.UserTOTPStatus{}
+	err := is.withDatabase(ctx, func(db *gorm.DB) error {
+		totpStore := store.GetUserTOTPStore(db)
+		state, err := totpStore.GetTOTP(ctx, ids)
+		if err != nil {
+			if errors.IsNotFound(err) {
+				return nil
+			}
+			return err
+		}
+		if state.EnabledAt == nil {
+			return nil
+		}
+		status.Enabled, status.EnabledAt = true, state.EnabledAt
+		codes, err := totpStore.FindRecoveryCodes(ctx, ids)
+		if err != nil {
+			return err
+		}
+		status.RecoveryCodesRemaining = uint32(len(codes))
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return status, nil
+}
+
+func (is *IdentityServer) enrollUserTOTP(ctx context.Context, ids *ttnpb.UserIdentifiers) (*ttnpb.UserTOTPEnrollment, error) {
+	if err := rights.RequireUser(ctx, *ids, ttnpb.RIGHT_USER_SETTINGS_BASIC); err != nil {
+		return nil, err
+	}
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		return nil, err
+	}
+	wrapped, err := twofactor.WrapSecret(ctx, secret, is.configFromContext(ctx).TwoFactor.TOTPKEKLabel, is.KeyVault)
+	if err != nil {
+		return nil, err
+	}
+	err = is.withDatabase(ctx, func(db *gorm.DB) error {
+		totpStore := store.GetUserTOTPStore(db)
+		state, err := totpStore.GetTOTP(ctx, ids)
+		if err != nil && !errors.IsNotFound(err) {
+			return err
+		}
+		if state != nil && state.EnabledAt != nil {
+			return errTOTPAlreadyEnabled
+		}
+		return totpStore.SetTOTP(ctx, ids, wrapped, nil)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &ttnpb.UserTOTPEnrollment{
+		Secret: secret,
+		URI:    totp.URI(is.configFromContext(ctx).OAuth.UI.SiteName, ids.UserID, secret),
+	}, nil
+}
+
+func (is *IdentityServer) enableUserTOTP(ctx context.Context, req *ttnpb.EnableUserTOTPRequest) (codes *ttnpb.UserRecoveryCodes, err error) {
+	if err = rights.RequireUser(ctx, req.UserIdentifiers, ttnpb.RIGHT_USER_SETTINGS_BASIC); err != nil {
+		return nil, err
+	}
+	var (
+		state *store.TOTP
+		valid bool
+	)
+	// The code is validated in a separate transaction, so that the counted
+	// attempt is stored, even if the code is incorrect.
+	err = is.withDatabase(ctx, func(db *gorm.DB) (err error) {
+		totpStore := store.GetUserTOTPStore(db)
+		state, err = totpStore.GetTOTP(ctx, &req.UserIdentifiers)
+		if err != nil {
+			if errors.IsNotFound(err) {
+				return errTOTPNotEnrolled
+			}
+			return err
+		}
+		if state.EnabledAt != nil {
+			return errTOTPAlreadyEnabled
+		}
+		valid, err = twofactor.ValidateTOTP(ctx, totpStore, is.KeyVault, &req.UserIdentifiers, state, req.Code, time.Now())
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !valid {
+		events.Publish(evtUserTOTPIncorrectCode(ctx, req.UserIdentifiers, nil))
+		return nil, errIncorrectTOTPCode
+	}
+	err = is.withDatabase(ctx, func(db *gorm.DB) error {
+		now := time.Now()
+		if err := store.GetUserTOTPStore(db).SetTOTP(ctx, &req.UserIdentifiers, state.Secret, &now); err != nil {
+			return err
+		}
+		codes, err = setRecoveryCodes(ctx, db, &req.UserIdentifiers)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	events.Publish(evtEnableUserTOTP(ctx, req.UserIdentifiers, nil))
+	return codes, nil
+}
+
+func (is *IdentityServer) disableUserTOTP(ctx context.Context, req *ttnpb.DisableUserTOTPRequest) (*types.Empty, error) {
+	if err := rights.RequireUser(ctx, req.UserIdentifiers, ttnpb.RIGHT_USER_SETTINGS_BASIC); err != nil {
+		return nil, err
+	}
+	valid := true
+	err := is.withDatabase(ctx, func(db *gorm.DB) error {
+		totpStore := store.GetUserTOTPStore(db)
+		state, err := totpStore.GetTOTP(ctx, &req.UserIdentifiers)
+		if err != nil {
+			if errors.IsNotFound(err) {
+				return errTOTPNotEnabled
+			}
+			return err
+		}
+		// Admins can disable TOTP of users that lost their authenticator and recovery codes.
+		if state.EnabledAt == nil || is.IsAdmin(ctx) {
+			return nil
+		}
+		if req.Code == "" {
+			return errTwoFactorCodeNeeded
+		}
+		valid, err = twofactor.Validate(ctx, totpStore, is.KeyVault, &req.UserIdentifiers, state, req.Code, time.Now())
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !valid {
+		events.Publish(evtUserTOTPIncorrectCode(ctx, req.UserIdentifiers, nil))
+		return nil, errIncorrectTOTPCode
+	}
+	err = is.withDatabase(ctx, func(db *gorm.DB) error {
+		return store.GetUserTOTPStore(db).DeleteTOTP(ctx, &req.UserIdentifiers)
+	})
+	if err != nil {
+		return nil, err
+	}
+	events.Publish(evtDisableUserTOTP(ctx, req.UserIdentifiers, nil))
+	return ttnpb.Empty, nil
+}
+
+func (is *IdentityServer) generateUserRecoveryCodes(ctx context.Context, req *ttnpb.GenerateUserRecoveryCodesRequest) (codes *ttnpb.UserRecoveryCodes, err error) {
+	if err = rights.RequireUser(ctx, req.UserIdentifiers, ttnpb.RIGHT_USER_SETTINGS_BASIC); err != nil {
+		return nil, err
+	}
+	var valid bool
+	err = is.withDatabase(ctx, func(db *gorm.DB) error {
+		totpStore := store.GetUserTOTPStore(db)
+		state, err := totpStore.GetTOTP(ctx, &req.UserIdentifiers)
+		if err != nil && !errors.IsNotFound(err) {
+			return err
+		}
+		if state == nil || state.EnabledAt == nil {
+			return errTOTPNotEnabled
+		}
+		valid, err = twofactor.ValidateTOTP(ctx, totpStore, is.KeyVault, &req.UserIdentifiers, state, req.Code, time.Now())
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !valid {
+		events.Publish(evtUserTOTPIncorrectCode(ctx, req.UserIdentifiers, nil))
+		return nil, errIncorrectTOTPCode
+	}
+	err = is.withDatabase(ctx, func(db *gorm.DB) (err error) {
+		codes, err = setRecoveryCodes(ctx, db, &req.UserIdentifiers)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	events.Publish(evtGenerateUserRecoveryCodes(ctx, req.UserIdentifiers, nil))
+	return codes, nil
+}
+
+// totpEnabled returns whether the user enabled TOTP two-factor authentication.
+func totpEnabled(ctx context.Context, db *gorm.DB, ids *ttnpb.UserIdentifiers) (bool, error) {
+	state, err := store.GetUserTOTPStore(db).GetTOTP(ctx, ids)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	return state.EnabledAt != nil, nil
+}
+
+// missingRequiredTOTP returns whether the user is required to enable TOTP
+// two-factor authentication, but did not do so yet.
+func (is *IdentityServer) missingRequiredTOTP(ctx context.Context, db *gorm.DB, usr *ttnpb.User) (bool, error) {
+	conf := is.configFromContext(ctx).TwoFactor
+	required := conf.RequiredForAdmins && usr.Admin
+	if !required && len(conf.RequiredForOrganizations) > 0 {
+		orgs, err := is.getMembershipStore(ctx, db).FindMemberships(ctx, usr.OrganizationOrUserIdentifiers(), "organization", false)
+		if err != nil {
+			return false, err
+		}
+	findOrganization:
+		for _, org := range orgs {
+			for _, orgID := range conf.RequiredForOrganizations {
+				if org.IDString() == orgID {
+					required = true
+					break findOrganization
+				}
+			}
+		}
+	}
+	if !required {
+		return false, nil
+	}
+	enabled, err := totpEnabled(ctx, db, &usr.UserIdentifiers)
+	if err != nil {
+		return false, err
+	}
+	return !enabled, nil
+}
+
+type userTOTPRegistry struct {
+	*IdentityServer
+}
+
+func (tr *userTOTPRegistry) Get(ctx context.Context, req *ttnpb.UserIdentifiers) (*ttnpb.UserTOTPStatus, error) {
+	return tr.getUserTOTP(ctx, req)
+}
+
+func (tr *userTOTPRegistry) Enroll(ctx context.Context, req *ttnpb.UserIdentifiers) (*ttnpb.UserTOTPEnrollment, error) {
+	return tr.enrollUserTOTP(ctx, req)
+}
+
+func (tr *userTOTPRegistry) Enable(ctx context.Context, req *ttnpb.EnableUserTOTPRequest) (*ttnpb.UserRecoveryCodes, error) {
+	return tr.enableUserTOTP(ctx, req)
+}
+
+func (tr *userTOTPRegistry) Disable(ctx context.Context, req *ttnpb.DisableUserTOTPRequest) (*types.Empty, error) {
+	return tr.disableUserTOTP(ctx, req)
+}
+
+func (tr *userTOTPRegistry) GenerateRecoveryCodes(ctx context.Context, req *ttnpb.GenerateUserRecoveryCodesRequest) (*ttnpb.UserRecoveryCodes, error) {
+	return tr.generateUserRecoveryCodes(ctx, req)
+}
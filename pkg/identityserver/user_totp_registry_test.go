@@ -0,0 +1,216 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package identityserver
+
+import (
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"github.com/smartystreets/assertions/should"
+	"go.thethings.network/lorawan-stack/pkg/auth/totp"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestUserTOTPRegistry(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+
+	testWithIdentityServer(t, func(is *IdentityServer, cc *grpc.ClientConn) {
+		userID, creds := population.Users[defaultUserIdx].UserIdentifiers, userCreds(defaultUserIdx)
+
+		reg := ttnpb.NewUserTOTPRegistryClient(cc)
+
+		status, err := reg.Get(ctx, &userID, creds)
+
+		a.So(err, should.BeNil)
+		if a.So(status, should.NotBeNil) {
+			a.So(status.Enabled, should.BeFalse)
+		}
+
+		_, err = reg.Enable(ctx, &ttnpb.EnableUserTOTPRequest{UserIdentifiers: userID, Code: "123456"}, creds)
+
+		if a.So(err, should.NotBeNil) {
+			a.So(errors.IsFailedPrecondition(err), should.BeTrue)
+		}
+
+		enrollment, err := reg.Enroll(ctx, &userID, creds)
+
+		a.So(err, should.BeNil)
+		if !a.So(enrollment, should.NotBeNil) {
+			t.FailNow()
+		}
+		a.So(enrollment.URI, should.StartWith, "otpauth://totp/")
+		a.So(enrollment.URI, should.ContainSubstring, enrollment.Secret)
+
+		code, err := totp.Code(enrollment.Secret, time.Now().Add(-5*time.Minute))
+		a.So(err, should.BeNil)
+
+		_, err = reg.Enable(ctx, &ttnpb.EnableUserTOTPRequest{UserIdentifiers: userID, Code: code}, creds)
+
+		if a.So(err, should.NotBeNil) {
+			a.So(errors.IsInvalidArgument(err), should.BeTrue)
+		}
+
+		code, err = totp.Code(enrollment.Secret, time.Now())
+		a.So(err, should.BeNil)
+
+		recoveryCodes, err := reg.Enable(ctx, &ttnpb.EnableUserTOTPRequest{UserIdentifiers: userID, Code: code}, creds)
+
+		a.So(err, should.BeNil)
+		if a.So(recoveryCodes, should.NotBeNil) {
+			a.So(recoveryCodes.Codes, should.HaveLength, 10)
+		}
+
+		status, err = reg.Get(ctx, &userID, creds)
+
+		a.So(err, should.BeNil)
+		if a.So(status, should.NotBeNil) {
+			a.So(status.Enabled, should.BeTrue)
+			a.So(status.EnabledAt, should.NotBeNil)
+			a.So(status.RecoveryCodesRemaining, should.Equal, 10)
+		}
+
+		_, err = reg.Enroll(ctx, &userID, creds)
+
+		if a.So(err, should.NotBeNil) {
+			a.So(errors.IsFailedPrecondition(err), should.BeTrue)
+		}
+
+		// Codes can only be used once.
+		_, err = reg.GenerateRecoveryCodes(ctx, &ttnpb.GenerateUserRecoveryCodesRequest{UserIdentifiers: userID, Code: code}, creds)
+
+		if a.So(err, should.NotBeNil) {
+			a.So(errors.IsInvalidArgument(err), should.BeTrue)
+		}
+
+		code, err = totp.Code(enrollment.Secret, time.Now().Add(30*time.Second))
+		a.So(err, should.BeNil)
+
+		newRecoveryCodes, err := reg.GenerateRecoveryCodes(ctx, &ttnpb.GenerateUserRecoveryCodesRequest{UserIdentifiers: userID, Code: code}, creds)
+
+		a.So(err, should.BeNil)
+		if a.So(newRecoveryCodes, should.NotBeNil) {
+			a.So(newRecoveryCodes.Codes, should.HaveLength, 10)
+			a.So(newRecoveryCodes.Codes, should.NotResemble, recoveryCodes.Codes)
+		}
+
+		_, err = reg.Disable(ctx, &ttnpb.DisableUserTOTPRequest{UserIdentifiers: userID}, creds)
+
+		if a.So(err, should.NotBeNil) {
+			a.So(errors.IsInvalidArgument(err), should.BeTrue)
+		}
+
+		_, err = reg.Disable(ctx, &ttnpb.DisableUserTOTPRequest{UserIdentifiers: userID, Code: recoveryCodes.Codes[0]}, creds)
+
+		if a.So(err, should.NotBeNil) {
+			a.So(errors.IsInvalidArgument(err), should.BeTrue)
+		}
+
+		_, err = reg.Disable(ctx, &ttnpb.DisableUserTOTPRequest{UserIdentifiers: userID, Code: newRecoveryCodes.Codes[0]}, creds)
+
+		a.So(err, should.BeNil)
+
+		status, err = reg.Get(ctx, &userID, creds)
+
+		a.So(err, should.BeNil)
+		if a.So(status, should.NotBeNil) {
+			a.So(status.Enabled, should.BeFalse)
+		}
+
+		enrollment, err = reg.Enroll(ctx, &userID, creds)
+
+		a.So(err, should.BeNil)
+
+		code, err = totp.Code(enrollment.Secret, time.Now())
+		a.So(err, should.BeNil)
+
+		_, err = reg.Enable(ctx, &ttnpb.EnableUserTOTPRequest{UserIdentifiers: userID, Code: code}, creds)
+
+		a.So(err, should.BeNil)
+
+		// The number of attempts is limited.
+		for i := 0; i < 5; i++ {
+			_, err = reg.GenerateRecoveryCodes(ctx, &ttnpb.GenerateUserRecoveryCodesRequest{UserIdentifiers: userID, Code: "000000"}, creds)
+
+			if a.So(err, should.NotBeNil) {
+				a.So(errors.IsInvalidArgument(err), should.BeTrue)
+			}
+		}
+
+		code, err = totp.Code(enrollment.Secret, time.Now().Add(30*time.Second))
+		a.So(err, should.BeNil)
+
+		_, err = reg.GenerateRecoveryCodes(ctx, &ttnpb.GenerateUserRecoveryCodesRequest{UserIdentifiers: userID, Code: code}, creds)
+
+		if a.So(err, should.NotBeNil) {
+			a.So(errors.IsResourceExhausted(err), should.BeTrue)
+		}
+	})
+}
+
+func TestUserTOTPRequired(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+
+	testWithIdentityServer(t, func(is *IdentityServer, cc *grpc.ClientConn) {
+		is.config.TwoFactor.RequiredForAdmins = true
+
+		adminID, adminCreds := population.Users[adminUserIdx].UserIdentifiers, userCreds(adminUserIdx)
+
+		cli := ttnpb.NewEntityAccessClient(cc)
+		reg := ttnpb.NewUserTOTPRegistryClient(cc)
+
+		var md metadata.MD
+		authInfo, err := cli.AuthInfo(ctx, ttnpb.Empty, adminCreds, grpc.Header(&md))
+
+		a.So(err, should.BeNil)
+		a.So(md.Get("warning"), should.Contain, "Restricted rights until two-factor authentication enabled")
+		if a.So(authInfo, should.NotBeNil) {
+			a.So(authInfo.IsAdmin, should.BeFalse)
+			a.So(authInfo.UniversalRights.GetRights(), should.BeEmpty)
+		}
+
+		enrollment, err := reg.Enroll(ctx, &adminID, adminCreds)
+
+		a.So(err, should.BeNil)
+		if !a.So(enrollment, should.NotBeNil) {
+			t.FailNow()
+		}
+
+		code, err := totp.Code(enrollment.Secret, time.Now())
+		a.So(err, should.BeNil)
+
+		_, err = reg.Enable(ctx, &ttnpb.EnableUserTOTPRequest{UserIdentifiers: adminID, Code: code}, adminCreds)
+
+		a.So(err, should.BeNil)
+
+		authInfo, err = cli.AuthInfo(ctx, ttnpb.Empty, adminCreds)
+
+		a.So(err, should.BeNil)
+		if a.So(authInfo, should.NotBeNil) {
+			a.So(authInfo.IsAdmin, should.BeTrue)
+		}
+
+		// Admins do not need a code to disable TOTP.
+		_, err = reg.Disable(ctx, &ttnpb.DisableUserTOTPRequest{UserIdentifiers: adminID}, adminCreds)
+
+		a.So(err, should.BeNil)
+	})
+}
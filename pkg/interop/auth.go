@@ -0,0 +1,114 @@
+// Copyright © 2026 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package interop
+
+import (
+	"context"
+	"crypto/x509"
+	"strings"
+)
+
+// AuthInfo contains the authentication information of an external Network Server or Application Server.
+type AuthInfo struct {
+	// SenderIDs are the sender IDs that the peer is authenticated for. Sender IDs are NetIDs for Network Servers and
+	// AS-IDs for Application Servers.
+	SenderIDs []string
+}
+
+// HasSenderID returns whether the peer is authenticated for the given sender ID.
+func (a AuthInfo) HasSenderID(id string) bool {
+	for _, senderID := range a.SenderIDs {
+		if strings.EqualFold(senderID, id) {
+			return true
+		}
+	}
+	return false
+}
+
+type authInfoKeyType struct{}
+
+var authInfoKey authInfoKeyType
+
+// NewContextWithAuthInfo returns a new context with the given authentication information.
+func NewContextWithAuthInfo(ctx context.Context, info AuthInfo) context.Context {
+	return context.WithValue(ctx, authInfoKey, info)
+}
+
+// AuthInfoFromContext returns the authentication information from the given context.
+func AuthInfoFromContext(ctx context.Context) (AuthInfo, bool) {
+	if info, ok := ctx.Value(authInfoKey).(AuthInfo); ok {
+		return info, true
+	}
+	return AuthInfo{}, false
+}
+
+// lookupSenderClientCAs returns the client CAs of the given sender ID.
+// Sender IDs are hex encoded NetIDs and EUIs or AS-IDs, so they are matched case-insensitively.
+func (s *Server) lookupSenderClientCAs(senderID string) []*x509.Certificate {
+	if cas, ok := s.SenderClientCAs[senderID]; ok {
+		return cas
+	}
+	for id, cas := range s.SenderClientCAs {
+		if strings.EqualFold(id, senderID) {
+			return cas
+		}
+	}
+	return nil
+}
+
+// SenderIDs returns the sender IDs of which a client CA is in the given verified certificate chains, and to which
+// the peer certificate is issued.
+func (s *Server) SenderIDs(verifiedChains [][]*x509.Certificate) []string {
+	var ids []string
+	for id, cas := range s.SenderClientCAs {
+		if hasClientCA(verifiedChains, cas) && hasSenderID(verifiedChains, id) {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
+// hasSenderID returns whether the peer certificate of the given verified certificate chains is issued to the given
+// sender ID. The sender ID must be the common name of the subject or one of the DNS names of the certificate.
+// This prevents peers from using sender IDs of other peers that share the same client CA.
+func hasSenderID(verifiedChains [][]*x509.Certificate, senderID string) bool {
+	if len(verifiedChains) == 0 || len(verifiedChains[0]) == 0 {
+		return false
+	}
+	cert := verifiedChains[0][0]
+	if strings.EqualFold(cert.Subject.CommonName, senderID) {
+		return true
+	}
+	for _, name := range cert.DNSNames {
+		if strings.EqualFold(name, senderID) {
+			return true
+		}
+	}
+	return false
+}
+
+// hasClientCA returns whether one of the given client CAs is in the given verified certificate chains.
+func hasClientCA(verifiedChains [][]*x509.Certificate, cas []*x509.Certificate) bool {
+	for _, chain := range verifiedChains {
+		for _, cert := range chain {
+			for _, ca := range cas {
+				if cert.Equal(ca) {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,71 @@
+// Copyright © 2026 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package interop_test
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"testing"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/config"
+	. "go.thethings.network/lorawan-stack/pkg/interop"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+func TestAuthInfo(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+
+	_, ok := AuthInfoFromContext(ctx)
+	a.So(ok, should.BeFalse)
+
+	ctx = NewContextWithAuthInfo(ctx, AuthInfo{
+		SenderIDs: []string{"00002A", "as.test.org"},
+	})
+	info, ok := AuthInfoFromContext(ctx)
+	if !a.So(ok, should.BeTrue) {
+		t.FailNow()
+	}
+	a.So(info.HasSenderID("00002A"), should.BeTrue)
+	a.So(info.HasSenderID("00002a"), should.BeTrue)
+	a.So(info.HasSenderID("AS.test.org"), should.BeTrue)
+	a.So(info.HasSenderID("000042"), should.BeFalse)
+}
+
+func TestSenderIDs(t *testing.T) {
+	a := assertions.New(t)
+
+	s, err := NewServer(test.Context(), nil, config.InteropServer{
+		SenderClientCA: config.SenderClientCA{
+			Source:    "directory",
+			Directory: "testdata",
+		},
+	})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+
+	parse := func(b []byte) *x509.Certificate {
+		block, _ := pem.Decode(b)
+		return test.Must(x509.ParseCertificate(block.Bytes)).(*x509.Certificate)
+	}
+	clientCert, rootCA := parse(ClientCert), parse(RootCA)
+
+	// The client CA of 000002 is in the chain, but the client certificate is only issued to 000001.
+	a.So(s.SenderIDs([][]*x509.Certificate{{clientCert, rootCA}}), should.Resemble, []string{"000001"})
+	a.So(s.SenderIDs([][]*x509.Certificate{{clientCert}}), should.BeEmpty)
+}
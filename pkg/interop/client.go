@@ -32,6 +32,7 @@ import (
 
 	"github.com/oklog/ulid/v2"
 	"go.thethings.network/lorawan-stack/pkg/config"
+	"go.thethings.network/lorawan-stack/pkg/dns"
 	"go.thethings.network/lorawan-stack/pkg/encoding/lorawan"
 	"go.thethings.network/lorawan-stack/pkg/errors"
 	"go.thethings.network/lorawan-stack/pkg/fetch"
@@ -52,6 +53,14 @@ const (
 	LoRaAllianceNetIDDomain = "netids." + loRaAllianceDomain
 
 	defaultHTTPSPort = 443
+
+	// JoinServerSRVService is the SRV record service used to discover Join Server endpoints.
+	// For example, the endpoint of Join Server js.example.com is discovered through the SRV records of
+	// _lorawan-js._tcp.js.example.com.
+	JoinServerSRVService = "lorawan-js"
+
+	// NetworkServerSRVService is the SRV record service used to discover Network Server endpoints.
+	NetworkServerSRVService = "lorawan-ns"
 )
 
 // JoinServerProtocol represents the protocol used for connection to Join Server by interop client.
@@ -157,6 +166,33 @@ func JoinServerFQDN(eui types.EUI64, domain string) string {
 	)
 }
 
+// NetworkServerFQDN constructs Network Server FQDN using specified NetID under domain
+// according to LoRaWAN Backend Interfaces specification.
+// If domain is empty, LoRaAllianceNetIDDomain is used.
+func NetworkServerFQDN(netID types.NetID, domain string) string {
+	if domain == "" {
+		domain = LoRaAllianceNetIDDomain
+	}
+	return fmt.Sprintf("%x.%s", netID[:], domain)
+}
+
+// ResolveServerAddress resolves the host and port of the server with the given FQDN through the SRV records of
+// the given service. If a port is given or if no SRV records are found, fqdn and port are returned as is.
+// The first target in the order of RFC 2782 is used, and the SRV records are cached by the resolver.
+func ResolveServerAddress(ctx context.Context, r dns.SRVResolver, service, fqdn string, port uint32) (string, uint32) {
+	if port != 0 || r == nil {
+		return fqdn, port
+	}
+	srvs, err := r.LookupSRV(ctx, service, "tcp", fqdn)
+	if err != nil {
+		if !dns.IsNotFound(err) {
+			log.FromContext(ctx).WithError(err).WithField("fqdn", fqdn).Debug("Failed to look up SRV records")
+		}
+		return fqdn, port
+	}
+	return srvs[0].Target, uint32(srvs[0].Port)
+}
+
 func httpExchange(ctx context.Context, httpReq *http.Request, res interface{}, do func(*http.Request) (*http.Response, error)) error {
 	logger := log.FromContext(ctx).WithField("url", httpReq.URL)
 
@@ -188,12 +224,12 @@ func httpExchange(ctx context.Context, httpReq *http.Request, res interface{}, d
 
 type joinServerHTTPClient struct {
 	Client         http.Client
-	NewRequestFunc func(types.EUI64, func(jsRPCPaths) string, interface{}) (*http.Request, error)
+	NewRequestFunc func(context.Context, types.EUI64, func(jsRPCPaths) string, interface{}) (*http.Request, error)
 	Protocol       JoinServerProtocol
 }
 
 func (cl joinServerHTTPClient) exchange(ctx context.Context, joinEUI types.EUI64, pathFunc func(jsRPCPaths) string, req, res interface{}) error {
-	httpReq, err := cl.NewRequestFunc(joinEUI, pathFunc, req)
+	httpReq, err := cl.NewRequestFunc(ctx, joinEUI, pathFunc, req)
 	if err != nil {
 		return err
 	}
@@ -323,16 +359,17 @@ func GeneratedSessionKeyID(id []byte) bool {
 	return bytes.HasPrefix(id, generatedSessionKeyIDPrefix)
 }
 
-func makeJoinServerHTTPRequestFunc(scheme, dns, fqdn string, port uint32, rpcPaths jsRPCPaths, headers map[string]string) func(types.EUI64, func(jsRPCPaths) string, interface{}) (*http.Request, error) {
-	if port == 0 {
-		port = defaultHTTPSPort
-	}
-	return func(joinEUI types.EUI64, pathFunc func(jsRPCPaths) string, pld interface{}) (*http.Request, error) {
+func makeJoinServerHTTPRequestFunc(resolver dns.SRVResolver, scheme, domain, fqdn string, port uint32, rpcPaths jsRPCPaths, headers map[string]string) func(context.Context, types.EUI64, func(jsRPCPaths) string, interface{}) (*http.Request, error) {
+	return func(ctx context.Context, joinEUI types.EUI64, pathFunc func(jsRPCPaths) string, pld interface{}) (*http.Request, error) {
 		fqdn := fqdn // Create a new reference to fqdn to avoid mutating the variable in the outside scope.
 		if fqdn == "" {
-			fqdn = JoinServerFQDN(joinEUI, dns)
+			fqdn = JoinServerFQDN(joinEUI, domain)
+		}
+		host, port := ResolveServerAddress(ctx, resolver, JoinServerSRVService, fqdn, port)
+		if port == 0 {
+			port = defaultHTTPSPort
 		}
-		return newHTTPRequest(serverURL(scheme, fqdn, pathFunc(rpcPaths), port), pld, headers)
+		return newHTTPRequest(serverURL(scheme, host, pathFunc(rpcPaths), port), pld, headers)
 	}
 }
 
@@ -483,7 +520,7 @@ func NewClient(ctx context.Context, conf config.InteropClient) (*Client, error)
 				Client: http.Client{
 					Transport: tr,
 				},
-				NewRequestFunc: makeJoinServerHTTPRequestFunc("https", yamlJSConf.DNS, yamlJSConf.FQDN, yamlJSConf.Port, yamlJSConf.Paths, yamlJSConf.Headers),
+				NewRequestFunc: makeJoinServerHTTPRequestFunc(dns.DefaultResolver, "https", yamlJSConf.DNS, yamlJSConf.FQDN, yamlJSConf.Port, yamlJSConf.Paths, yamlJSConf.Headers),
 				Protocol:       yamlJSConf.Protocol,
 			}
 		default:
@@ -19,6 +19,7 @@ import (
 	"crypto/tls"
 	"fmt"
 	"io/ioutil"
+	"net"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
@@ -54,6 +55,77 @@ func TestJoinServerFQDN(t *testing.T) {
 	}
 }
 
+func TestNetworkServerFQDN(t *testing.T) {
+	for _, tc := range []struct {
+		NetID    types.NetID
+		Expected string
+	}{
+		{
+			NetID:    types.NetID{0x00, 0x00, 0x13},
+			Expected: "000013.netids.lora-alliance.org",
+		},
+		{
+			NetID:    types.NetID{0x60, 0x00, 0x2a},
+			Expected: "60002a.netids.lora-alliance.org",
+		},
+	} {
+		a := assertions.New(t)
+		a.So(NetworkServerFQDN(tc.NetID, ""), should.Equal, tc.Expected)
+	}
+}
+
+type mockSRVResolver map[string][]*net.SRV
+
+func (r mockSRVResolver) LookupSRV(ctx context.Context, service, proto, name string) ([]*net.SRV, error) {
+	srvs, ok := r[fmt.Sprintf("_%s._%s.%s", service, proto, name)]
+	if !ok {
+		return nil, &net.DNSError{Name: name, IsNotFound: true}
+	}
+	return srvs, nil
+}
+
+func TestResolveServerAddress(t *testing.T) {
+	r := mockSRVResolver{
+		"_lorawan-js._tcp.js.example.com": {
+			{Target: "js-1.example.com", Port: 8443, Priority: 10},
+			{Target: "js-2.example.com", Port: 8443, Priority: 20},
+		},
+	}
+	for _, tc := range []struct {
+		Name         string
+		FQDN         string
+		Port         uint32
+		ExpectedHost string
+		ExpectedPort uint32
+	}{
+		{
+			Name:         "SRV",
+			FQDN:         "js.example.com",
+			ExpectedHost: "js-1.example.com",
+			ExpectedPort: 8443,
+		},
+		{
+			Name:         "Port configured",
+			FQDN:         "js.example.com",
+			Port:         443,
+			ExpectedHost: "js.example.com",
+			ExpectedPort: 443,
+		},
+		{
+			Name:         "No SRV",
+			FQDN:         "other.example.com",
+			ExpectedHost: "other.example.com",
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+			host, port := ResolveServerAddress(test.Context(), r, JoinServerSRVService, tc.FQDN, tc.Port)
+			a.So(host, should.Equal, tc.ExpectedHost)
+			a.So(port, should.Equal, tc.ExpectedPort)
+		})
+	}
+}
+
 func TestGetAppSKey(t *testing.T) {
 	makeSessionKeyRequest := func() *ttnpb.SessionKeyRequest {
 		return &ttnpb.SessionKeyRequest{
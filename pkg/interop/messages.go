@@ -271,7 +271,7 @@ func parseMessage() echo.MiddlewareFunc {
 }
 
 // verifySenderID verifies whether the SenderID of the message is authorized for the request according to the trusted
-// certificates that are provided through the given callback.
+// certificates that are provided through the given callback, and whether the peer certificate is issued to the SenderID.
 func verifySenderID(getSenderClientCAs func(string) []*x509.Certificate) echo.MiddlewareFunc {
 	return func(next echo.HandlerFunc) echo.HandlerFunc {
 		return func(c echo.Context) error {
@@ -280,16 +280,9 @@ func verifySenderID(getSenderClientCAs func(string) []*x509.Certificate) echo.Mi
 			if len(senderClientCAs) == 0 {
 				return ErrUnknownSender
 			}
-			if state := c.Request().TLS; state != nil {
-				for _, chain := range state.VerifiedChains {
-					for _, cert := range chain {
-						for _, senderCA := range senderClientCAs {
-							if cert.Equal(senderCA) {
-								return next(c)
-							}
-						}
-					}
-				}
+			if state := c.Request().TLS; state != nil &&
+				hasClientCA(state.VerifiedChains, senderClientCAs) && hasSenderID(state.VerifiedChains, header.SenderID) {
+				return next(c)
 			}
 			// TODO: Check headers (https://github.com/TheThingsNetwork/lorawan-stack/issues/717)
 			return ErrUnknownSender
@@ -181,11 +181,6 @@ func NewServer(ctx context.Context, contextFiller fillcontext.Filler, conf confi
 			}
 		}
 	}
-	getSenderClientCAs := func(senderID string) []*x509.Certificate {
-		// TODO: Lookup client CAs by sender ID (https://github.com/TheThingsNetwork/lorawan-stack/issues/718)
-		return senderClientCAs[senderID]
-	}
-
 	server := echo.New()
 
 	server.Logger = web.NewNoopLogger()
@@ -203,21 +198,21 @@ func NewServer(ctx context.Context, contextFiller fillcontext.Filler, conf confi
 	noop := &noopServer{}
 	s := &Server{
 		SenderClientCAs: senderClientCAs,
-		rootGroup: server.Group(
-			"",
-			middleware.Log(logger),
-			middleware.Normalize(middleware.RedirectPermanent),
-			parseMessage(),
-			verifySenderID(getSenderClientCAs),
-		),
-		config: conf,
-		server: server,
-		js:     noop,
-		hNS:    noop,
-		sNS:    noop,
-		fNS:    noop,
-		as:     noop,
+		config:          conf,
+		server:          server,
+		js:              noop,
+		hNS:             noop,
+		sNS:             noop,
+		fNS:             noop,
+		as:              noop,
 	}
+	s.rootGroup = server.Group(
+		"",
+		middleware.Log(logger),
+		middleware.Normalize(middleware.RedirectPermanent),
+		parseMessage(),
+		verifySenderID(s.lookupSenderClientCAs),
+	)
 
 	// In 1.0, NS, JS and AS receive messages on the root path.
 	// In 1.1, only JS and AS receive messages on the root path. Since NS can play various roles (hNS, sNS and fNS), their
@@ -265,6 +260,10 @@ func (s *Server) handleRequest(c echo.Context) error {
 	ctx := events.ContextWithCorrelationID(c.Request().Context(), cid)
 	if state := c.Request().TLS; state != nil {
 		ctx = auth.NewContextWithX509DN(ctx, state.PeerCertificates[0].Subject)
+		header := c.Get(headerKey).(*RawMessageHeader)
+		ctx = NewContextWithAuthInfo(ctx, AuthInfo{
+			SenderIDs: []string{header.SenderID},
+		})
 	}
 
 	var ans interface{}
@@ -47,6 +47,7 @@ var (
 	errNoNwkKey                       = errors.DefineCorruption("no_nwk_key", "no NwkKey specified")
 	errNoNwkSEncKey                   = errors.DefineCorruption("no_nwk_s_enc_key", "no NwkSEncKey specified")
 	errNoPayload                      = errors.DefineInvalidArgument("no_payload", "no message payload specified")
+	errNoRejoinRequest                = errors.DefineInvalidArgument("no_rejoin_request", "no RejoinRequest specified")
 	errNoRootKeys                     = errors.DefineCorruption("no_root_keys", "no root keys specified")
 	errNoSNwkSIntKey                  = errors.DefineCorruption("no_s_nwk_s_int_key", "no SNwkSIntKey specified")
 	errPayloadLengthMismatch          = errors.DefineInvalidArgument("payload_length", "expected length of payload to be equal to 23 got {length}")
@@ -54,7 +55,10 @@ var (
 	errProvisionerDecode              = errors.Define("provisioner_decode", "failed to decode provisioning data")
 	errProvisionerNotFound            = errors.DefineNotFound("provisioner_not_found", "provisioner `{id}` not found")
 	errProvisioning                   = errors.DefineAborted("provisioning", "provisioning failed")
+	errRJCountTooHigh                 = errors.DefineInvalidArgument("rj_count_too_high", "RJcount is too high")
+	errRJCountTooSmall                = errors.DefineInvalidArgument("rj_count_too_small", "RJcount is too small")
 	errRegistryOperation              = errors.DefineInternal("registry_operation", "registry operation failed")
+	errRejoinMACVersion               = errors.DefineInvalidArgument("rejoin_mac_version", "rejoin-requests are not supported in MAC version `{version}`")
 	errReuseDevNonce                  = errors.DefineInvalidArgument("reuse_dev_nonce", "DevNonce has already been used")
 	errUnauthenticated                = errors.DefineUnauthenticated("unauthenticated", "unauthenticated")
 	errUnknownJoinEUI                 = errors.Define("unknown_join_eui", "JoinEUI specified is not known")
@@ -21,6 +21,7 @@ import (
 	"go.thethings.network/lorawan-stack/pkg/auth/rights"
 	"go.thethings.network/lorawan-stack/pkg/crypto/cryptoservices"
 	"go.thethings.network/lorawan-stack/pkg/crypto/cryptoutil"
+	"go.thethings.network/lorawan-stack/pkg/devicebatch"
 	"go.thethings.network/lorawan-stack/pkg/errors"
 	"go.thethings.network/lorawan-stack/pkg/events"
 	"go.thethings.network/lorawan-stack/pkg/log"
@@ -49,7 +50,7 @@ type jsEndDeviceRegistryServer struct {
 
 // Get implements ttnpb.JsEndDeviceRegistryServer.
 func (srv jsEndDeviceRegistryServer) Get(ctx context.Context, req *ttnpb.GetEndDeviceRequest) (*ttnpb.EndDevice, error) {
-	if err := rights.RequireApplication(ctx, req.ApplicationIdentifiers, ttnpb.RIGHT_APPLICATION_DEVICES_READ); err != nil {
+	if err := rights.RequireEndDevice(ctx, req.EndDeviceIdentifiers, ttnpb.RIGHT_APPLICATION_DEVICES_READ); err != nil {
 		return nil, err
 	}
 	gets := req.FieldMask.Paths
@@ -179,7 +180,7 @@ func (srv jsEndDeviceRegistryServer) Set(ctx context.Context, req *ttnpb.SetEndD
 		return nil, errNoDevEUI
 	}
 
-	if err = rights.RequireApplication(ctx, req.EndDevice.ApplicationIdentifiers, ttnpb.RIGHT_APPLICATION_DEVICES_WRITE); err != nil {
+	if err = rights.RequireEndDevice(ctx, req.EndDevice.EndDeviceIdentifiers, ttnpb.RIGHT_APPLICATION_DEVICES_WRITE); err != nil {
 		return nil, err
 	}
 	if ttnpb.HasAnyField(req.FieldMask.Paths,
@@ -281,7 +282,7 @@ func (srv jsEndDeviceRegistryServer) Provision(req *ttnpb.ProvisionEndDevicesReq
 
 // Delete implements ttnpb.JsEndDeviceRegistryServer.
 func (srv jsEndDeviceRegistryServer) Delete(ctx context.Context, ids *ttnpb.EndDeviceIdentifiers) (*pbtypes.Empty, error) {
-	if err := rights.RequireApplication(ctx, ids.ApplicationIdentifiers, ttnpb.RIGHT_APPLICATION_DEVICES_WRITE); err != nil {
+	if err := rights.RequireEndDevice(ctx, *ids, ttnpb.RIGHT_APPLICATION_DEVICES_WRITE); err != nil {
 		return nil, err
 	}
 	var evt events.Event
@@ -300,3 +301,18 @@ func (srv jsEndDeviceRegistryServer) Delete(ctx context.Context, ids *ttnpb.EndD
 	}
 	return ttnpb.Empty, err
 }
+
+// BatchGet implements ttnpb.JsEndDeviceRegistryServer.
+func (srv jsEndDeviceRegistryServer) BatchGet(ctx context.Context, req *ttnpb.BatchGetEndDevicesRequest) (*ttnpb.BatchEndDevicesResponse, error) {
+	return devicebatch.Get(ctx, req, "/ttn.lorawan.v3.JsEndDeviceRegistry/Get", srv.Get)
+}
+
+// BatchSet implements ttnpb.JsEndDeviceRegistryServer.
+func (srv jsEndDeviceRegistryServer) BatchSet(ctx context.Context, req *ttnpb.BatchSetEndDevicesRequest) (*ttnpb.BatchEndDevicesResponse, error) {
+	return devicebatch.Set(ctx, req, "/ttn.lorawan.v3.JsEndDeviceRegistry/Set", srv.Set)
+}
+
+// BatchDelete implements ttnpb.JsEndDeviceRegistryServer.
+func (srv jsEndDeviceRegistryServer) BatchDelete(ctx context.Context, req *ttnpb.BatchDeleteEndDevicesRequest) (*ttnpb.BatchEndDevicesResponse, error) {
+	return devicebatch.Delete(ctx, req, "/ttn.lorawan.v3.JsEndDeviceRegistry/Delete", srv.Delete)
+}
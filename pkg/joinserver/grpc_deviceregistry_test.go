@@ -16,6 +16,7 @@ package joinserver_test
 
 import (
 	"context"
+	"fmt"
 	"sync/atomic"
 	"testing"
 	"time"
@@ -1029,3 +1030,149 @@ func TestDeviceRegistryDelete(t *testing.T) {
 		})
 	}
 }
+
+func TestDeviceRegistryBatch(t *testing.T) {
+	a := assertions.New(t)
+
+	appIDs := ttnpb.ApplicationIdentifiers{ApplicationID: "foo-application"}
+	otherAppIDs := ttnpb.ApplicationIdentifiers{ApplicationID: "bar-application"}
+
+	var getByIDCalls, setByIDCalls uint64
+	js := test.Must(New(
+		componenttest.NewComponent(t, &component.Config{}),
+		&Config{
+			Devices: &MockDeviceRegistry{
+				GetByIDFunc: func(ctx context.Context, appID ttnpb.ApplicationIdentifiers, devID string, paths []string) (*ttnpb.EndDevice, error) {
+					atomic.AddUint64(&getByIDCalls, 1)
+					if devID == "not-found-device" {
+						return nil, errNotFound
+					}
+					return &ttnpb.EndDevice{
+						EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+							ApplicationIdentifiers: appID,
+							DeviceID:               devID,
+						},
+					}, nil
+				},
+				SetByIDFunc: func(ctx context.Context, appID ttnpb.ApplicationIdentifiers, devID string, paths []string, f func(*ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error)) (*ttnpb.EndDevice, error) {
+					atomic.AddUint64(&setByIDCalls, 1)
+					dev, _, err := f(&ttnpb.EndDevice{
+						EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+							ApplicationIdentifiers: appID,
+							DeviceID:               devID,
+						},
+					})
+					return dev, err
+				},
+			},
+		},
+	)).(*JoinServer)
+
+	js.AddContextFiller(func(ctx context.Context) context.Context {
+		return rights.NewContext(ctx, rights.Rights{
+			ApplicationRights: map[string]*ttnpb.Rights{
+				unique.ID(test.Context(), appIDs): {
+					Rights: []ttnpb.Right{
+						ttnpb.RIGHT_APPLICATION_DEVICES_READ,
+						ttnpb.RIGHT_APPLICATION_DEVICES_WRITE,
+					},
+				},
+			},
+		})
+	})
+	js.AddContextFiller(func(ctx context.Context) context.Context {
+		ctx, cancel := context.WithDeadline(ctx, time.Now().Add(Timeout))
+		_ = cancel
+		return ctx
+	})
+	js.AddContextFiller(func(ctx context.Context) context.Context {
+		return test.ContextWithT(ctx, t)
+	})
+	componenttest.StartComponent(t, js.Component)
+	defer js.Close()
+
+	ctx := js.FillContext(test.Context())
+	cl := ttnpb.NewJsEndDeviceRegistryClient(js.LoopbackConn())
+
+	getRequest := func(appIDs ttnpb.ApplicationIdentifiers, devID string, paths ...string) *ttnpb.GetEndDeviceRequest {
+		return &ttnpb.GetEndDeviceRequest{
+			EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+				ApplicationIdentifiers: appIDs,
+				DeviceID:               devID,
+			},
+			FieldMask: pbtypes.FieldMask{Paths: paths},
+		}
+	}
+
+	res, err := cl.BatchGet(ctx, &ttnpb.BatchGetEndDevicesRequest{
+		ApplicationIdentifiers: appIDs,
+		Requests: []*ttnpb.GetEndDeviceRequest{
+			getRequest(appIDs, "foo-device", "ids"),
+			getRequest(appIDs, "foo-device", "ids", "root_keys.app_key.key"),
+			getRequest(appIDs, "not-found-device", "ids"),
+			getRequest(otherAppIDs, "foo-device", "ids"),
+		},
+	})
+	if !a.So(err, should.BeNil) || !a.So(res.Results, should.HaveLength, 4) {
+		t.FailNow()
+	}
+	a.So(res.Results[0].Error, should.BeNil)
+	if a.So(res.Results[0].EndDevice, should.NotBeNil) {
+		a.So(res.Results[0].EndDevice.EndDeviceIdentifiers, should.Resemble, ttnpb.EndDeviceIdentifiers{
+			ApplicationIdentifiers: appIDs,
+			DeviceID:               "foo-device",
+		})
+	}
+	for _, res := range res.Results[1:] {
+		a.So(res.EndDevice, should.BeNil)
+		a.So(res.Error, should.NotBeNil)
+	}
+	a.So(errors.IsPermissionDenied(ttnpb.ErrorDetailsFromProto(res.Results[1].Error)), should.BeTrue)
+	a.So(errors.IsNotFound(ttnpb.ErrorDetailsFromProto(res.Results[2].Error)), should.BeTrue)
+	a.So(errors.IsInvalidArgument(ttnpb.ErrorDetailsFromProto(res.Results[3].Error)), should.BeTrue)
+	a.So(getByIDCalls, should.Equal, uint64(2))
+
+	reqs := make([]*ttnpb.GetEndDeviceRequest, 101)
+	for i := range reqs {
+		reqs[i] = getRequest(appIDs, fmt.Sprintf("foo-device-%d", i), "ids")
+	}
+	res, err = cl.BatchGet(ctx, &ttnpb.BatchGetEndDevicesRequest{
+		ApplicationIdentifiers: appIDs,
+		Requests:               reqs,
+	})
+	if a.So(err, should.NotBeNil) {
+		a.So(errors.IsInvalidArgument(err), should.BeTrue)
+	}
+	a.So(res, should.BeNil)
+	a.So(getByIDCalls, should.Equal, uint64(2))
+
+	res, err = cl.BatchDelete(ctx, &ttnpb.BatchDeleteEndDevicesRequest{
+		ApplicationIdentifiers: otherAppIDs,
+		EndDeviceIDs: []*ttnpb.EndDeviceIdentifiers{
+			{ApplicationIdentifiers: otherAppIDs, DeviceID: "foo-device"},
+			{ApplicationIdentifiers: appIDs, DeviceID: "foo-device"},
+		},
+	})
+	if !a.So(err, should.BeNil) || !a.So(res.Results, should.HaveLength, 2) {
+		t.FailNow()
+	}
+	a.So(errors.IsPermissionDenied(ttnpb.ErrorDetailsFromProto(res.Results[0].Error)), should.BeTrue)
+	a.So(errors.IsInvalidArgument(ttnpb.ErrorDetailsFromProto(res.Results[1].Error)), should.BeTrue)
+	a.So(setByIDCalls, should.Equal, uint64(0))
+
+	res, err = cl.BatchDelete(ctx, &ttnpb.BatchDeleteEndDevicesRequest{
+		ApplicationIdentifiers: appIDs,
+		EndDeviceIDs: []*ttnpb.EndDeviceIdentifiers{
+			{ApplicationIdentifiers: appIDs, DeviceID: "foo-device"},
+			{ApplicationIdentifiers: appIDs, DeviceID: "bar-device"},
+		},
+	})
+	if !a.So(err, should.BeNil) || !a.So(res.Results, should.HaveLength, 2) {
+		t.FailNow()
+	}
+	for _, res := range res.Results {
+		a.So(res.Error, should.BeNil)
+		a.So(res.EndDevice, should.BeNil)
+	}
+	a.So(setByIDCalls, should.Equal, uint64(2))
+}
@@ -18,6 +18,7 @@ package joinserver
 import (
 	"bytes"
 	"context"
+	"crypto/x509"
 	"crypto/x509/pkix"
 	"encoding/binary"
 	"io"
@@ -36,6 +37,7 @@ import (
 	clusterauth "go.thethings.network/lorawan-stack/pkg/auth/cluster"
 	"go.thethings.network/lorawan-stack/pkg/cluster"
 	"go.thethings.network/lorawan-stack/pkg/component"
+	"go.thethings.network/lorawan-stack/pkg/crypto"
 	"go.thethings.network/lorawan-stack/pkg/crypto/cryptoservices"
 	"go.thethings.network/lorawan-stack/pkg/crypto/cryptoutil"
 	"go.thethings.network/lorawan-stack/pkg/encoding/lorawan"
@@ -47,6 +49,8 @@ import (
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
 	"go.thethings.network/lorawan-stack/pkg/types"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
 )
 
 // Config represents the JoinServer configuration.
@@ -79,6 +83,9 @@ type JoinServer struct {
 		js        jsServer
 	}
 	interop interopServer
+
+	// peerSenderIDs returns the interop sender IDs of external peers by their verified certificate chains.
+	peerSenderIDs func(verifiedChains [][]*x509.Certificate) []string
 }
 
 // Context returns the context of the Join Server.
@@ -110,13 +117,14 @@ func New(c *component.Component, conf *Config) (*JoinServer, error) {
 	js.grpc.js = jsServer{JS: js}
 	js.interop = interopServer{JS: js}
 
-	// TODO: Support authentication from non-cluster-local NS and AS (https://github.com/TheThingsNetwork/lorawan-stack/issues/4).
 	hooks.RegisterUnaryHook("/ttn.lorawan.v3.NsJs", rpclog.NamespaceHook, rpclog.UnaryNamespaceHook("joinserver"))
 	hooks.RegisterUnaryHook("/ttn.lorawan.v3.AsJs", rpclog.NamespaceHook, rpclog.UnaryNamespaceHook("joinserver"))
 	hooks.RegisterUnaryHook("/ttn.lorawan.v3.Js", rpclog.NamespaceHook, rpclog.UnaryNamespaceHook("joinserver"))
 	hooks.RegisterUnaryHook("/ttn.lorawan.v3.NsJs", cluster.HookName, c.ClusterAuthUnaryHook())
 	hooks.RegisterUnaryHook("/ttn.lorawan.v3.AsJs", cluster.HookName, c.ClusterAuthUnaryHook())
 	hooks.RegisterUnaryHook("/ttn.lorawan.v3.Js", cluster.HookName, c.ClusterAuthUnaryHook())
+	hooks.RegisterUnaryHook("/ttn.lorawan.v3.NsJs", externalAuthHookName, js.externalAuthUnaryHook())
+	hooks.RegisterUnaryHook("/ttn.lorawan.v3.AsJs", externalAuthHookName, js.externalAuthUnaryHook())
 
 	c.RegisterGRPC(js)
 	c.RegisterInterop(js)
@@ -145,6 +153,39 @@ func (js *JoinServer) RegisterHandlers(s *runtime.ServeMux, conn *grpc.ClientCon
 // RegisterInterop registers the NS-JS and AS-JS interop services.
 func (js *JoinServer) RegisterInterop(srv *interop.Server) {
 	srv.RegisterJS(js.interop)
+	js.peerSenderIDs = srv.SenderIDs
+}
+
+const externalAuthHookName = "external-auth"
+
+// externalAuthUnaryHook authenticates external Network Servers and Application Servers that are not part of the
+// cluster by their TLS client certificate. The certificate must be issued by a client CA of an interop sender ID.
+// The distinguished name of the certificate and the sender IDs are added to the context.
+func (js *JoinServer) externalAuthUnaryHook() hooks.UnaryHandlerMiddleware {
+	return func(next grpc.UnaryHandler) grpc.UnaryHandler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			if clusterauth.Authorized(ctx) == nil || js.peerSenderIDs == nil {
+				return next(ctx, req)
+			}
+			p, ok := peer.FromContext(ctx)
+			if !ok {
+				return next(ctx, req)
+			}
+			tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo)
+			if !ok || len(tlsInfo.State.VerifiedChains) == 0 {
+				return next(ctx, req)
+			}
+			senderIDs := js.peerSenderIDs(tlsInfo.State.VerifiedChains)
+			if len(senderIDs) == 0 {
+				return nil, errCallerNotAuthorized.WithAttributes("name", tlsInfo.State.VerifiedChains[0][0].Subject.CommonName)
+			}
+			ctx = auth.NewContextWithX509DN(ctx, tlsInfo.State.VerifiedChains[0][0].Subject)
+			ctx = interop.NewContextWithAuthInfo(ctx, interop.AuthInfo{
+				SenderIDs: senderIDs,
+			})
+			return next(ctx, req)
+		}
+	}
 }
 
 var supportedMACVersions = [...]ttnpb.MACVersion{
@@ -163,6 +204,15 @@ func validateCallerByID(dn pkix.Name, id string) error {
 	return nil
 }
 
+// validateCallerByNetID validates that the caller is authenticated for the given NetID, if the caller is an external
+// Network Server that is authenticated by its interop sender ID.
+func validateCallerByNetID(ctx context.Context, dn pkix.Name, netID types.NetID) error {
+	if info, ok := interop.AuthInfoFromContext(ctx); ok && !info.HasSenderID(netID.String()) {
+		return errCallerNotAuthorized.WithAttributes("name", dn.CommonName)
+	}
+	return nil
+}
+
 // validateCallerByAddress validates that the host from the given address matches the common name of the given X.509 distinguished name.
 func validateCallerByAddress(dn pkix.Name, addr string) error {
 	host := addr
@@ -245,25 +295,58 @@ func (js *JoinServer) HandleJoin(ctx context.Context, req *ttnpb.JoinRequest) (r
 	if req.Payload.Major != ttnpb.Major_LORAWAN_R1 {
 		return nil, errUnsupportedLoRaWANMajorVersion.WithAttributes("major", req.Payload.Major)
 	}
-	if req.Payload.MType != ttnpb.MType_JOIN_REQUEST {
-		return nil, errWrongPayloadType.WithAttributes("type", req.Payload.MType)
-	}
 
-	pld := req.Payload.GetJoinRequestPayload()
-	if pld == nil {
-		return nil, errNoJoinRequest
+	var (
+		joinEUI, devEUI types.EUI64
+		// dn is the DevNonce of a join-request or the RJcount of a rejoin-request.
+		dn          types.DevNonce
+		joinReqType byte = 0xff
+		rejoinPld   *ttnpb.RejoinRequestPayload
+	)
+	switch req.Payload.MType {
+	case ttnpb.MType_JOIN_REQUEST:
+		pld := req.Payload.GetJoinRequestPayload()
+		if pld == nil {
+			return nil, errNoJoinRequest
+		}
+		joinEUI, devEUI, dn = pld.JoinEUI, pld.DevEUI, pld.DevNonce
+
+	case ttnpb.MType_REJOIN_REQUEST:
+		if req.SelectedMACVersion.Compare(ttnpb.MAC_V1_1) < 0 {
+			return nil, errRejoinMACVersion.WithAttributes("version", req.SelectedMACVersion)
+		}
+		rejoinPld = req.Payload.GetRejoinRequestPayload()
+		if rejoinPld == nil {
+			return nil, errNoRejoinRequest
+		}
+		devEUI = rejoinPld.DevEUI
+		switch rejoinPld.RejoinType {
+		case ttnpb.RejoinType_SESSION:
+			joinEUI = rejoinPld.JoinEUI
+		default:
+			// Rejoin-requests of type 0 and 2 do not contain the JoinEUI, the Network Server provides it.
+			if req.JoinEUI == nil {
+				return nil, errNoJoinEUI
+			}
+			joinEUI = *req.JoinEUI
+		}
+		joinReqType = byte(rejoinPld.RejoinType)
+		binary.BigEndian.PutUint16(dn[:], uint16(rejoinPld.RejoinCnt))
+
+	default:
+		return nil, errWrongPayloadType.WithAttributes("type", req.Payload.MType)
 	}
-	if pld.DevEUI.IsZero() {
+	if devEUI.IsZero() {
 		return nil, errNoDevEUI
 	}
 	logger = logger.WithFields(log.Fields(
-		"join_eui", pld.JoinEUI,
-		"dev_eui", pld.DevEUI,
+		"join_eui", joinEUI,
+		"dev_eui", devEUI,
 	))
 
 	var match bool
 	for _, p := range js.euiPrefixes {
-		if p.Matches(pld.JoinEUI) {
+		if p.Matches(joinEUI) {
 			match = true
 			break
 		}
@@ -273,13 +356,15 @@ func (js *JoinServer) HandleJoin(ctx context.Context, req *ttnpb.JoinRequest) (r
 	}
 
 	var handled bool
-	dev, err := js.devices.SetByEUI(ctx, pld.JoinEUI, pld.DevEUI,
+	dev, err := js.devices.SetByEUI(ctx, joinEUI, devEUI,
 		[]string{
 			"application_server_address",
 			"application_server_id",
 			"application_server_kek_label",
 			"last_dev_nonce",
 			"last_join_nonce",
+			"last_rj_count_0",
+			"last_rj_count_1",
 			"net_id",
 			"network_server_address",
 			"network_server_kek_label",
@@ -297,6 +382,9 @@ func (js *JoinServer) HandleJoin(ctx context.Context, req *ttnpb.JoinRequest) (r
 				if !req.NetID.Equal(*dev.NetID) {
 					return nil, nil, errNetIDMismatch.WithAttributes("net_id", req.NetID)
 				}
+				if err := validateCallerByNetID(ctx, dn, *dev.NetID); err != nil {
+					return nil, nil, err
+				}
 				if dev.NetworkServerAddress != "" {
 					if err := validateCallerByAddress(dn, dev.NetworkServerAddress); err != nil {
 						return nil, nil, err
@@ -304,10 +392,26 @@ func (js *JoinServer) HandleJoin(ctx context.Context, req *ttnpb.JoinRequest) (r
 				}
 			}
 
-			paths := make([]string, 0, 3)
+			paths := make([]string, 0, 4)
 
-			dn := uint32(binary.BigEndian.Uint16(pld.DevNonce[:]))
-			if req.SelectedMACVersion.Compare(ttnpb.MAC_V1_1) >= 0 {
+			if rejoinPld != nil {
+				rjc := rejoinPld.RejoinCnt
+				if rjc >= math.MaxUint16 {
+					return nil, nil, errRJCountTooHigh
+				}
+				if rejoinPld.RejoinType == ttnpb.RejoinType_SESSION {
+					if (rjc != 0 || dev.LastRJCount1 != 0) && rjc <= dev.LastRJCount1 {
+						return nil, nil, errRJCountTooSmall
+					}
+					dev.LastRJCount1 = rjc
+					paths = append(paths, "last_rj_count_1")
+				} else {
+					// Rejoin-requests of type 0 and 2 are checked for replays by the Network Server, which tracks
+					// RJcount0 per session. RJcount0 is reset each time a rejoin-accept is processed by the end device.
+					dev.LastRJCount0 = 0
+					paths = append(paths, "last_rj_count_0")
+				}
+			} else if dn := uint32(binary.BigEndian.Uint16(dn[:])); req.SelectedMACVersion.Compare(ttnpb.MAC_V1_1) >= 0 {
 				if (dn != 0 || dev.LastDevNonce != 0 || dev.LastJoinNonce != 0) && !dev.ResetsJoinNonces {
 					if dn <= dev.LastDevNonce {
 						return nil, nil, errDevNonceTooSmall
@@ -317,7 +421,9 @@ func (js *JoinServer) HandleJoin(ctx context.Context, req *ttnpb.JoinRequest) (r
 					}
 				}
 				dev.LastDevNonce = dn
-				paths = append(paths, "last_dev_nonce")
+				// RJcount0 is reset each time a join-accept is processed by the end device.
+				dev.LastRJCount0 = 0
+				paths = append(paths, "last_dev_nonce", "last_rj_count_0")
 			} else {
 				i := sort.Search(len(dev.UsedDevNonces), func(i int) bool { return dev.UsedDevNonces[i] >= dn })
 				if i >= len(dev.UsedDevNonces) || dev.UsedDevNonces[i] != dn {
@@ -419,26 +525,54 @@ func (js *JoinServer) HandleJoin(ctx context.Context, req *ttnpb.JoinRequest) (r
 			if err := cryptoDev.SetFields(dev, "ids", "provisioner_id", "provisioning_data"); err != nil {
 				return nil, nil, err
 			}
-			reqMIC, err := networkCryptoService.JoinRequestMIC(ctx, cryptoDev, req.SelectedMACVersion, req.RawPayload[:19])
-			if err != nil {
-				return nil, nil, errComputeMIC.WithCause(err)
-			}
-			if !bytes.Equal(reqMIC[:], req.RawPayload[19:]) {
-				return nil, nil, errMICMismatch
+			switch {
+			case rejoinPld == nil:
+				reqMIC, err := networkCryptoService.JoinRequestMIC(ctx, cryptoDev, req.SelectedMACVersion, req.RawPayload[:19])
+				if err != nil {
+					return nil, nil, errComputeMIC.WithCause(err)
+				}
+				if !bytes.Equal(reqMIC[:], req.RawPayload[19:]) {
+					return nil, nil, errMICMismatch
+				}
+
+			case rejoinPld.RejoinType == ttnpb.RejoinType_SESSION:
+				nwkKey, err := networkCryptoService.GetNwkKey(ctx, cryptoDev)
+				if err != nil {
+					return nil, nil, err
+				}
+				if nwkKey == nil {
+					return nil, nil, errNoNwkKey
+				}
+				n := len(req.RawPayload)
+				reqMIC, err := crypto.ComputeRejoinRequestMIC(crypto.DeriveJSIntKey(*nwkKey, devEUI), req.RawPayload[:n-4])
+				if err != nil {
+					return nil, nil, errComputeMIC.WithCause(err)
+				}
+				if !bytes.Equal(reqMIC[:], req.RawPayload[n-4:]) {
+					return nil, nil, errMICMismatch
+				}
+
+			default:
+				// The MIC of rejoin-requests of type 0 and 2 is computed using the SNwkSIntKey, which is verified by the Network Server.
 			}
-			resMIC, err := networkCryptoService.JoinAcceptMIC(ctx, cryptoDev, req.SelectedMACVersion, 0xff, pld.DevNonce, b)
+			resMIC, err := networkCryptoService.JoinAcceptMIC(ctx, cryptoDev, req.SelectedMACVersion, joinReqType, dn, b)
 			if err != nil {
 				return nil, nil, errComputeMIC.WithCause(err)
 			}
-			enc, err := networkCryptoService.EncryptJoinAccept(ctx, cryptoDev, req.SelectedMACVersion, append(b[1:], resMIC[:]...))
+			var enc []byte
+			if rejoinPld == nil {
+				enc, err = networkCryptoService.EncryptJoinAccept(ctx, cryptoDev, req.SelectedMACVersion, append(b[1:], resMIC[:]...))
+			} else {
+				enc, err = networkCryptoService.EncryptRejoinAccept(ctx, cryptoDev, req.SelectedMACVersion, append(b[1:], resMIC[:]...))
+			}
 			if err != nil {
 				return nil, nil, errEncryptPayload.WithCause(err)
 			}
-			nwkSKeys, err := networkCryptoService.DeriveNwkSKeys(ctx, cryptoDev, req.SelectedMACVersion, jn, pld.DevNonce, req.NetID)
+			nwkSKeys, err := networkCryptoService.DeriveNwkSKeys(ctx, cryptoDev, req.SelectedMACVersion, jn, dn, req.NetID)
 			if err != nil {
 				return nil, nil, errDeriveNwkSKeys.WithCause(err)
 			}
-			appSKey, err := applicationCryptoService.DeriveAppSKey(ctx, cryptoDev, req.SelectedMACVersion, jn, pld.DevNonce, req.NetID)
+			appSKey, err := applicationCryptoService.DeriveAppSKey(ctx, cryptoDev, req.SelectedMACVersion, jn, dn, req.NetID)
 			if err != nil {
 				return nil, nil, errDeriveAppSKey.WithCause(err)
 			}
@@ -534,12 +668,21 @@ func (js *JoinServer) GetNwkSKeys(ctx context.Context, req *ttnpb.SessionKeyRequ
 	if dn, ok := auth.X509DNFromContext(ctx); ok {
 		dev, err := js.devices.GetByEUI(ctx, req.JoinEUI, req.DevEUI,
 			[]string{
+				"net_id",
 				"network_server_address",
 			},
 		)
 		if err != nil {
 			return nil, errRegistryOperation.WithCause(err)
 		}
+		if _, ok := interop.AuthInfoFromContext(ctx); ok {
+			if dev.NetID == nil {
+				return nil, errNoNetID
+			}
+			if err := validateCallerByNetID(ctx, dn, *dev.NetID); err != nil {
+				return nil, err
+			}
+		}
 		if dev.NetworkServerAddress != "" {
 			if err := validateCallerByAddress(dn, dev.NetworkServerAddress); err != nil {
 				return nil, err
@@ -589,7 +732,11 @@ func (js *JoinServer) GetAppSKey(ctx context.Context, req *ttnpb.SessionKeyReque
 		if err != nil {
 			return nil, errRegistryOperation.WithCause(err)
 		}
-		if dev.ApplicationServerID != "" {
+		if info, ok := interop.AuthInfoFromContext(ctx); ok && dev.ApplicationServerID != "" {
+			if !info.HasSenderID(dev.ApplicationServerID) {
+				return nil, errCallerNotAuthorized.WithAttributes("name", dn.CommonName)
+			}
+		} else if dev.ApplicationServerID != "" {
 			if err := validateCallerByID(dn, dev.ApplicationServerID); err != nil {
 				return nil, err
 			}
@@ -30,6 +30,7 @@ import (
 	"go.thethings.network/lorawan-stack/pkg/config"
 	"go.thethings.network/lorawan-stack/pkg/crypto"
 	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/interop"
 	. "go.thethings.network/lorawan-stack/pkg/joinserver"
 	"go.thethings.network/lorawan-stack/pkg/joinserver/redis"
 	"go.thethings.network/lorawan-stack/pkg/log"
@@ -1932,6 +1933,123 @@ func TestHandleJoin(t *testing.T) {
 	}
 }
 
+func TestHandleRejoinType0(t *testing.T) {
+	a := assertions.New(t)
+
+	ctx := clusterauth.NewContext(test.Context(), nil)
+
+	redisClient, flush := test.NewRedis(t, "joinserver_test")
+	defer flush()
+	defer redisClient.Close()
+	devReg := &redis.DeviceRegistry{Redis: redisClient}
+	keyReg := &redis.KeyRegistry{Redis: redisClient}
+
+	c := componenttest.NewComponent(t, &component.Config{})
+	js := test.Must(New(
+		c,
+		&Config{
+			Devices:         devReg,
+			Keys:            keyReg,
+			JoinEUIPrefixes: joinEUIPrefixes,
+		},
+	)).(*JoinServer)
+	componenttest.StartComponent(t, c)
+
+	joinEUI := types.EUI64{0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}
+	devEUI := types.EUI64{0x42, 0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}
+
+	_, err := devReg.SetByID(ctx, ttnpb.ApplicationIdentifiers{ApplicationID: "test-app"}, "test-dev",
+		[]string{
+			"last_dev_nonce",
+			"last_join_nonce",
+			"last_rj_count_0",
+			"lorawan_version",
+			"network_server_address",
+			"root_keys",
+		},
+		func(stored *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error) {
+			return &ttnpb.EndDevice{
+				EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+					DevEUI:                 &devEUI,
+					JoinEUI:                &joinEUI,
+					ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: "test-app"},
+					DeviceID:               "test-dev",
+				},
+				LastDevNonce:  0x2442,
+				LastJoinNonce: 0x42fffe,
+				LastRJCount0:  0x42,
+				RootKeys: &ttnpb.RootKeys{
+					AppKey: &ttnpb.KeyEnvelope{
+						Key: &appKey,
+					},
+					NwkKey: &ttnpb.KeyEnvelope{
+						Key: &nwkKey,
+					},
+				},
+				LoRaWANVersion:       ttnpb.MAC_V1_1,
+				NetworkServerAddress: nsAddr,
+			}, []string{
+				"ids.application_ids",
+				"ids.dev_eui",
+				"ids.device_id",
+				"ids.join_eui",
+				"last_dev_nonce",
+				"last_join_nonce",
+				"last_rj_count_0",
+				"lorawan_version",
+				"network_server_address",
+				"root_keys",
+			}, nil
+		},
+	)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+
+	// The end device resets RJcount0 each time it processes a rejoin-accept, hence consecutive rejoin-requests
+	// of type 0 may carry the same RJcount0.
+	for i := 0; i < 2; i++ {
+		res, err := js.HandleJoin(ctx, &ttnpb.JoinRequest{
+			SelectedMACVersion: ttnpb.MAC_V1_1,
+			JoinEUI:            &joinEUI,
+			RawPayload: []byte{
+				/* MHDR */
+				0xc0,
+
+				/* MACPayload */
+				/** Rejoin Type **/
+				0x00,
+				/** NetID **/
+				0xff, 0xff, 0x42,
+				/** DevEUI **/
+				0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x42, 0x42,
+				/** RJcount0 **/
+				0x01, 0x00,
+
+				/* MIC */
+				0x03, 0x02, 0x01, 0x00,
+			},
+			DevAddr: types.DevAddr{0x42, 0xff, 0xff, 0xff},
+			NetID:   types.NetID{0x42, 0xff, 0xff},
+			DownlinkSettings: ttnpb.DLSettings{
+				OptNeg:      true,
+				Rx1DROffset: 0x7,
+				Rx2DR:       0xf,
+			},
+			RxDelay: 0x42,
+		})
+		if !a.So(err, should.BeNil) || !a.So(res, should.NotBeNil) {
+			t.Fatalf("Failed to handle rejoin-request %d: %s", i, err)
+		}
+
+		dev, err := devReg.GetByEUI(ctx, joinEUI, devEUI, []string{"last_rj_count_0"})
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		a.So(dev.LastRJCount0, should.Equal, uint32(0))
+	}
+}
+
 func TestGetNwkSKeys(t *testing.T) {
 	ctx := test.Context()
 
@@ -1941,9 +2059,10 @@ func TestGetNwkSKeys(t *testing.T) {
 		Name        string
 		ContextFunc func(context.Context) context.Context
 
-		GetByID     func(context.Context, types.EUI64, types.EUI64, []byte, []string) (*ttnpb.SessionKeys, error)
-		KeyRequest  *ttnpb.SessionKeyRequest
-		KeyResponse *ttnpb.NwkSKeysResponse
+		GetByID        func(context.Context, types.EUI64, types.EUI64, []byte, []string) (*ttnpb.SessionKeys, error)
+		GetDeviceByEUI func(context.Context, types.EUI64, types.EUI64, []string) (*ttnpb.ContextualEndDevice, error)
+		KeyRequest     *ttnpb.SessionKeyRequest
+		KeyResponse    *ttnpb.NwkSKeysResponse
 
 		ErrorAssertion func(*testing.T, error) bool
 	}{
@@ -2107,6 +2226,91 @@ func TestGetNwkSKeys(t *testing.T) {
 				},
 			},
 		},
+		{
+			Name: "NetID not authorized",
+			ContextFunc: func(ctx context.Context) context.Context {
+				ctx = auth.NewContextWithX509DN(ctx, pkix.Name{
+					CommonName: "ns.test.org",
+				})
+				return interop.NewContextWithAuthInfo(ctx, interop.AuthInfo{
+					SenderIDs: []string{"000013"},
+				})
+			},
+			GetDeviceByEUI: func(ctx context.Context, joinEUI, devEUI types.EUI64, paths []string) (*ttnpb.ContextualEndDevice, error) {
+				a := assertions.New(test.MustTFromContext(ctx))
+				a.So(joinEUI, should.Resemble, types.EUI64{0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff})
+				a.So(devEUI, should.Resemble, types.EUI64{0x42, 0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff})
+				a.So(paths, should.HaveSameElementsDeep, []string{
+					"net_id",
+					"network_server_address",
+				})
+				return &ttnpb.ContextualEndDevice{
+					Context: ctx,
+					EndDevice: &ttnpb.EndDevice{
+						NetID:                &types.NetID{0x00, 0x00, 0x42},
+						NetworkServerAddress: nsAddr,
+					},
+				}, nil
+			},
+			KeyRequest: &ttnpb.SessionKeyRequest{
+				JoinEUI:      types.EUI64{0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+				DevEUI:       types.EUI64{0x42, 0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+				SessionKeyID: []byte{0x11, 0x22, 0x33, 0x44},
+			},
+			ErrorAssertion: func(t *testing.T, err error) bool {
+				return assertions.New(t).So(err, should.HaveSameErrorDefinitionAs, ErrCallerNotAuthorized)
+			},
+		},
+		{
+			Name: "Matching request/interop auth",
+			ContextFunc: func(ctx context.Context) context.Context {
+				ctx = auth.NewContextWithX509DN(ctx, pkix.Name{
+					CommonName: "ns.test.org",
+				})
+				return interop.NewContextWithAuthInfo(ctx, interop.AuthInfo{
+					SenderIDs: []string{"000042"},
+				})
+			},
+			GetByID: func(ctx context.Context, joinEUI, devEUI types.EUI64, id []byte, paths []string) (*ttnpb.SessionKeys, error) {
+				return &ttnpb.SessionKeys{
+					SessionKeyID: []byte{0x11, 0x22, 0x33, 0x44},
+					FNwkSIntKey: &ttnpb.KeyEnvelope{
+						Key: KeyPtr(types.AES128Key{0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0xff}),
+					},
+					NwkSEncKey: &ttnpb.KeyEnvelope{
+						Key: KeyPtr(types.AES128Key{0x43, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0xff}),
+					},
+					SNwkSIntKey: &ttnpb.KeyEnvelope{
+						Key: KeyPtr(types.AES128Key{0x44, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0xff}),
+					},
+				}, nil
+			},
+			GetDeviceByEUI: func(ctx context.Context, joinEUI, devEUI types.EUI64, paths []string) (*ttnpb.ContextualEndDevice, error) {
+				return &ttnpb.ContextualEndDevice{
+					Context: ctx,
+					EndDevice: &ttnpb.EndDevice{
+						NetID:                &types.NetID{0x00, 0x00, 0x42},
+						NetworkServerAddress: nsAddr,
+					},
+				}, nil
+			},
+			KeyRequest: &ttnpb.SessionKeyRequest{
+				JoinEUI:      types.EUI64{0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+				DevEUI:       types.EUI64{0x42, 0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+				SessionKeyID: []byte{0x11, 0x22, 0x33, 0x44},
+			},
+			KeyResponse: &ttnpb.NwkSKeysResponse{
+				FNwkSIntKey: ttnpb.KeyEnvelope{
+					Key: KeyPtr(types.AES128Key{0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0xff}),
+				},
+				NwkSEncKey: ttnpb.KeyEnvelope{
+					Key: KeyPtr(types.AES128Key{0x43, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0xff}),
+				},
+				SNwkSIntKey: ttnpb.KeyEnvelope{
+					Key: KeyPtr(types.AES128Key{0x44, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0xff}),
+				},
+			},
+		},
 	} {
 		t.Run(tc.Name, func(t *testing.T) {
 			a := assertions.New(t)
@@ -2117,7 +2321,7 @@ func TestGetNwkSKeys(t *testing.T) {
 				c,
 				&Config{
 					Keys:    &MockKeyRegistry{GetByIDFunc: tc.GetByID},
-					Devices: &MockDeviceRegistry{},
+					Devices: &MockDeviceRegistry{GetByEUIFunc: tc.GetDeviceByEUI},
 				},
 			)).(*JoinServer)
 			componenttest.StartComponent(t, c)
@@ -2388,6 +2592,74 @@ func TestGetAppSKey(t *testing.T) {
 				},
 			},
 		},
+		{
+			Name: "AS-ID not authorized",
+			ContextFunc: func(ctx context.Context) context.Context {
+				ctx = auth.NewContextWithX509DN(ctx, pkix.Name{
+					CommonName: "test-as-id",
+				})
+				return interop.NewContextWithAuthInfo(ctx, interop.AuthInfo{
+					SenderIDs: []string{"other-as-id"},
+				})
+			},
+			GetDeviceByEUI: func(ctx context.Context, joinEUI, devEUI types.EUI64, paths []string) (*ttnpb.ContextualEndDevice, error) {
+				return &ttnpb.ContextualEndDevice{
+					Context: ctx,
+					EndDevice: &ttnpb.EndDevice{
+						ApplicationServerAddress: asAddr,
+						ApplicationServerID:      "test-as-id",
+					},
+				}, nil
+			},
+			KeyRequest: &ttnpb.SessionKeyRequest{
+				JoinEUI:      types.EUI64{0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+				DevEUI:       types.EUI64{0x42, 0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+				SessionKeyID: []byte{0x11, 0x22, 0x33, 0x44},
+			},
+			ErrorAssertion: func(t *testing.T, err error) bool {
+				return assertions.New(t).So(err, should.HaveSameErrorDefinitionAs, ErrCallerNotAuthorized)
+			},
+		},
+		{
+			Name: "Matching request/interop auth",
+			ContextFunc: func(ctx context.Context) context.Context {
+				ctx = auth.NewContextWithX509DN(ctx, pkix.Name{
+					CommonName: "as.test.org",
+				})
+				return interop.NewContextWithAuthInfo(ctx, interop.AuthInfo{
+					SenderIDs: []string{"test-as-id"},
+				})
+			},
+			GetKeyByID: func(ctx context.Context, joinEUI, devEUI types.EUI64, id []byte, paths []string) (*ttnpb.SessionKeys, error) {
+				return &ttnpb.SessionKeys{
+					SessionKeyID: []byte{0x11, 0x22, 0x33, 0x44},
+					AppSKey: &ttnpb.KeyEnvelope{
+						EncryptedKey: KeyToBytes(types.AES128Key{0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0xff}),
+						KEKLabel:     "test-kek",
+					},
+				}, nil
+			},
+			GetDeviceByEUI: func(ctx context.Context, joinEUI, devEUI types.EUI64, paths []string) (*ttnpb.ContextualEndDevice, error) {
+				return &ttnpb.ContextualEndDevice{
+					Context: ctx,
+					EndDevice: &ttnpb.EndDevice{
+						ApplicationServerAddress: asAddr,
+						ApplicationServerID:      "test-as-id",
+					},
+				}, nil
+			},
+			KeyRequest: &ttnpb.SessionKeyRequest{
+				JoinEUI:      types.EUI64{0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+				DevEUI:       types.EUI64{0x42, 0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+				SessionKeyID: []byte{0x11, 0x22, 0x33, 0x44},
+			},
+			KeyResponse: &ttnpb.AppSKeyResponse{
+				AppSKey: ttnpb.KeyEnvelope{
+					EncryptedKey: KeyToBytes(types.AES128Key{0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0xff}),
+					KEKLabel:     "test-kek",
+				},
+			},
+		},
 	} {
 		t.Run(tc.Name, func(t *testing.T) {
 			a := assertions.New(t)
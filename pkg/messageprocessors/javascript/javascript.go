@@ -20,6 +20,10 @@ import (
 	"fmt"
 	"reflect"
 	"runtime/trace"
+	"strings"
+	"time"
+
+	pbtypes "github.com/gogo/protobuf/types"
 
 	"go.thethings.network/lorawan-stack/pkg/errors"
 	"go.thethings.network/lorawan-stack/pkg/gogoproto"
@@ -55,13 +59,18 @@ func (h *host) createEnvironment(ids ttnpb.EndDeviceIdentifiers, version *ttnpb.
 }
 
 var (
-	errInput       = errors.DefineInvalidArgument("input", "invalid input")
-	errOutput      = errors.Define("output", "invalid output")
-	errOutputType  = errors.Define("output_type", "invalid output of type `{type}`")
-	errOutputRange = errors.Define("output_range", "output value `{value}` does not fall between `{low}` and `{high}`")
+	errInput        = errors.DefineInvalidArgument("input", "invalid input")
+	errOutput       = errors.Define("output", "invalid output")
+	errOutputType   = errors.Define("output_type", "invalid output of type `{type}`")
+	errOutputRange  = errors.Define("output_range", "output value `{value}` does not fall between `{low}` and `{high}`")
+	errOutputErrors = errors.DefineInvalidArgument("output_errors", "output errors: {errors}")
+	errOutputError  = errors.DefineInvalidArgument("output_error", "output error: {error}")
 )
 
 // Encode encodes the message's DecodedPayload to FRMPayload using the given script.
+// If the script defines an encodeDownlink function, it is called with an object containing the data, and it returns
+// an object with the bytes and optionally the fPort, warnings and errors. The warnings are set in the message's
+// DecodedPayloadWarnings. Otherwise, the Encoder function is called with the data and FPort, and it returns the bytes.
 func (h *host) Encode(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, version *ttnpb.EndDeviceVersionIdentifiers, msg *ttnpb.ApplicationDownlink, script string) error {
 	defer trace.StartRegion(ctx, "encode message").End()
 
@@ -76,80 +85,247 @@ func (h *host) Encode(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, versi
 	env := h.createEnvironment(ids, version)
 	env["payload"] = m
 	env["f_port"] = msg.FPort
-	script = fmt.Sprintf(`
-		%s
-		Encoder(env.payload, env.f_port)
+	// The script comes first so that the line numbers in errors match the line numbers in the script.
+	script = fmt.Sprintf(`%s
+		(function (input) {
+			if (typeof encodeDownlink === 'function') {
+				return encodeDownlink(input);
+			}
+			return { bytes: Encoder(input.data, env.f_port) };
+		})({ data: env.payload })
 	`, script)
 	value, err := h.engine.Run(ctx, script, env)
 	if err != nil {
 		return err
 	}
-	if value == nil || reflect.TypeOf(value).Kind() != reflect.Slice {
-		return errOutputType
+	output, ok := value.(map[string]interface{})
+	if !ok {
+		return errOutput
 	}
-	slice := reflect.ValueOf(value)
-	frmPayload := make([]byte, slice.Len())
-	for i := 0; i < slice.Len(); i++ {
-		val := slice.Index(i).Interface()
-		var b int64
-		switch i := val.(type) {
-		case int:
-			b = int64(i)
-		case int8:
-			b = int64(i)
-		case int16:
-			b = int64(i)
-		case int32:
-			b = int64(i)
-		case int64:
-			b = i
-		case uint8:
-			b = int64(i)
-		case uint16:
-			b = int64(i)
-		case uint32:
-			b = int64(i)
-		case uint64:
-			b = int64(i)
-		default:
-			return errOutputType.WithAttributes("type", fmt.Sprintf("%T", i))
+	warnings, err := outputWarnings(output)
+	if err != nil {
+		return err
+	}
+	frmPayload, err := toBytes(output["bytes"])
+	if err != nil {
+		return err
+	}
+	if fPort, ok := output["fPort"]; ok && fPort != nil {
+		n, err := toInt64(fPort)
+		if err != nil {
+			return err
 		}
-		if b < 0x00 || b > 0xFF {
+		if n < 1 || n > 255 {
 			return errOutputRange.WithAttributes(
-				"value", b,
-				"low", 0x00,
-				"high", 0xFF,
+				"value", n,
+				"low", 1,
+				"high", 255,
 			)
 		}
-		frmPayload[i] = byte(b)
+		msg.FPort = uint32(n)
 	}
 	msg.FRMPayload = frmPayload
+	msg.DecodedPayloadWarnings = warnings
 	return nil
 }
 
 // Decode decodes the message's FRMPayload to DecodedPayload using the given script.
+// If the script defines a decodeUplink function, it is called with an object containing the bytes, fPort and
+// recvTime, and it returns an object with the decoded data, and optionally warnings and errors. Otherwise, the Decoder
+// function is called with the bytes and FPort, and it returns the decoded data.
 func (h *host) Decode(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, version *ttnpb.EndDeviceVersionIdentifiers, msg *ttnpb.ApplicationUplink, script string) error {
 	defer trace.StartRegion(ctx, "decode message").End()
 
 	env := h.createEnvironment(ids, version)
 	env["payload"] = msg.FRMPayload
 	env["f_port"] = msg.FPort
-	script = fmt.Sprintf(`
-		%s
-		Decoder(env.payload, env.f_port)
+	if !msg.ReceivedAt.IsZero() {
+		env["recv_time"] = msg.ReceivedAt.UnixNano() / int64(time.Millisecond)
+	}
+	// The script comes first so that the line numbers in errors match the line numbers in the script.
+	script = fmt.Sprintf(`%s
+		(function (input) {
+			if (typeof decodeUplink === 'function') {
+				return decodeUplink(input);
+			}
+			return { data: Decoder(env.payload, input.fPort) };
+		})({
+			bytes: Array.prototype.slice.call(env.payload),
+			fPort: env.f_port,
+			recvTime: env.recv_time !== undefined ? new Date(env.recv_time) : undefined
+		})
+	`, script)
+	value, err := h.engine.Run(ctx, script, env)
+	if err != nil {
+		return err
+	}
+	decoded, warnings, err := decodedOutput(value)
+	if err != nil {
+		return err
+	}
+	msg.DecodedPayload = decoded
+	msg.DecodedPayloadWarnings = warnings
+	return nil
+}
+
+// DecodeDownlink decodes the message's FRMPayload to DecodedPayload using the given script.
+// The script must define a decodeDownlink function, which is called with an object containing the bytes and fPort,
+// and it returns an object with the decoded data, and optionally warnings and errors. If the script does not define
+// the decodeDownlink function, the message is not decoded.
+func (h *host) DecodeDownlink(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, version *ttnpb.EndDeviceVersionIdentifiers, msg *ttnpb.ApplicationDownlink, script string) error {
+	defer trace.StartRegion(ctx, "decode downlink message").End()
+
+	env := h.createEnvironment(ids, version)
+	env["payload"] = msg.FRMPayload
+	env["f_port"] = msg.FPort
+	// The script comes first so that the line numbers in errors match the line numbers in the script.
+	script = fmt.Sprintf(`%s
+		(function (input) {
+			if (typeof decodeDownlink === 'function') {
+				return decodeDownlink(input);
+			}
+			return null;
+		})({
+			bytes: Array.prototype.slice.call(env.payload),
+			fPort: env.f_port
+		})
 	`, script)
 	value, err := h.engine.Run(ctx, script, env)
 	if err != nil {
 		return err
 	}
-	m, ok := value.(map[string]interface{})
+	if value == nil {
+		return nil
+	}
+	decoded, warnings, err := decodedOutput(value)
+	if err != nil {
+		return err
+	}
+	msg.DecodedPayload = decoded
+	msg.DecodedPayloadWarnings = warnings
+	return nil
+}
+
+// decodedOutput converts the output of a decode function to the decoded payload and warnings.
+// If the output contains errors, an error with the errors is returned.
+func decodedOutput(value interface{}) (*pbtypes.Struct, []string, error) {
+	output, ok := value.(map[string]interface{})
+	if !ok {
+		return nil, nil, errOutput
+	}
+	warnings, err := outputWarnings(output)
+	if err != nil {
+		return nil, nil, err
+	}
+	m, ok := output["data"].(map[string]interface{})
 	if !ok {
-		return errOutput
+		return nil, nil, errOutput
 	}
 	s, err := gogoproto.Struct(m)
 	if err != nil {
-		return errOutput.WithCause(err)
+		return nil, nil, errOutput.WithCause(err)
 	}
-	msg.DecodedPayload = s
-	return nil
+	return s, warnings, nil
+}
+
+// outputWarnings returns the warnings in the output.
+// If the output contains errors, an error with the errors and warnings is returned.
+// Each error in the output is also set as an error in the details of the returned error.
+func outputWarnings(output map[string]interface{}) ([]string, error) {
+	warnings, err := toStrings(output["warnings"])
+	if err != nil {
+		return nil, err
+	}
+	errs, err := toStrings(output["errors"])
+	if err != nil {
+		return nil, err
+	}
+	if len(errs) > 0 {
+		err := errOutputErrors.WithAttributes(
+			"errors", strings.Join(errs, ", "),
+			"warnings", strings.Join(warnings, ", "),
+		)
+		for _, e := range errs {
+			err = err.WithDetails(ttnpb.ErrorDetailsToProto(errOutputError.WithAttributes("error", e)))
+		}
+		return nil, err
+	}
+	return warnings, nil
+}
+
+// toBytes converts the exported array value to a byte slice.
+func toBytes(value interface{}) ([]byte, error) {
+	if value == nil || reflect.TypeOf(value).Kind() != reflect.Slice {
+		return nil, errOutputType.WithAttributes("type", fmt.Sprintf("%T", value))
+	}
+	slice := reflect.ValueOf(value)
+	res := make([]byte, slice.Len())
+	for i := range res {
+		b, err := toInt64(slice.Index(i).Interface())
+		if err != nil {
+			return nil, err
+		}
+		if b < 0x00 || b > 0xFF {
+			return nil, errOutputRange.WithAttributes(
+				"value", b,
+				"low", 0x00,
+				"high", 0xFF,
+			)
+		}
+		res[i] = byte(b)
+	}
+	return res, nil
+}
+
+// toInt64 converts the exported integer value to an int64.
+func toInt64(value interface{}) (int64, error) {
+	switch i := value.(type) {
+	case int:
+		return int64(i), nil
+	case int8:
+		return int64(i), nil
+	case int16:
+		return int64(i), nil
+	case int32:
+		return int64(i), nil
+	case int64:
+		return i, nil
+	case uint8:
+		return int64(i), nil
+	case uint16:
+		return int64(i), nil
+	case uint32:
+		return int64(i), nil
+	case uint64:
+		return int64(i), nil
+	case float64:
+		if i == float64(int64(i)) {
+			return int64(i), nil
+		}
+	}
+	return 0, errOutputType.WithAttributes("type", fmt.Sprintf("%T", value))
+}
+
+// toStrings converts the exported array value to a slice of strings.
+func toStrings(value interface{}) ([]string, error) {
+	if value == nil {
+		return nil, nil
+	}
+	if reflect.TypeOf(value).Kind() != reflect.Slice {
+		return nil, errOutputType.WithAttributes("type", fmt.Sprintf("%T", value))
+	}
+	slice := reflect.ValueOf(value)
+	if slice.Len() == 0 {
+		return nil, nil
+	}
+	res := make([]string, slice.Len())
+	for i := range res {
+		switch val := slice.Index(i).Interface().(type) {
+		case string:
+			res[i] = val
+		default:
+			return nil, errOutputType.WithAttributes("type", fmt.Sprintf("%T", val))
+		}
+	}
+	return res, nil
 }
@@ -16,10 +16,13 @@ package javascript
 
 import (
 	"testing"
+	"time"
 
 	pbtypes "github.com/gogo/protobuf/types"
 	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/errors"
 	"go.thethings.network/lorawan-stack/pkg/gogoproto"
+	"go.thethings.network/lorawan-stack/pkg/messageprocessors"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
 	"go.thethings.network/lorawan-stack/pkg/types"
 	"go.thethings.network/lorawan-stack/pkg/util/test"
@@ -157,6 +160,58 @@ func TestEncode(t *testing.T) {
 		err := host.Encode(ctx, ids, version, message, script)
 		a.So(err, should.HaveSameErrorDefinitionAs, errOutputType)
 	}
+
+	// Encode with the bytes and FPort.
+	{
+		script := `
+		function encodeDownlink(input) {
+			var val = input.data.temperature * 100
+			return {
+				bytes: [(val >> 8) & 0xff, val & 0xff],
+				fPort: 42,
+				warnings: ['temperature below zero']
+			}
+		}
+		`
+		message := &ttnpb.ApplicationDownlink{
+			FPort:          1,
+			DecodedPayload: message.DecodedPayload,
+		}
+		err := host.Encode(ctx, ids, version, message, script)
+		a.So(err, should.BeNil)
+		a.So(message.FRMPayload, should.Resemble, []byte{247, 174})
+		a.So(message.FPort, should.Equal, 42)
+		a.So(message.DecodedPayloadWarnings, should.Resemble, []string{"temperature below zero"})
+	}
+
+	// Return errors.
+	{
+		script := `
+		function encodeDownlink(input) {
+			return {
+				errors: ['temperature out of range']
+			}
+		}
+		`
+		err := host.Encode(ctx, ids, version, message, script)
+		a.So(err, should.HaveSameErrorDefinitionAs, errOutputErrors)
+		a.So(errors.IsInvalidArgument(err), should.BeTrue)
+		a.So(errors.Attributes(err)["errors"], should.Equal, "temperature out of range")
+	}
+
+	// Return invalid FPort.
+	{
+		script := `
+		function encodeDownlink(input) {
+			return {
+				bytes: [1, 2, 3],
+				fPort: 256
+			}
+		}
+		`
+		err := host.Encode(ctx, ids, version, message, script)
+		a.So(err, should.HaveSameErrorDefinitionAs, errOutputRange)
+	}
 }
 
 func TestDecode(t *testing.T) {
@@ -247,4 +302,194 @@ func TestDecode(t *testing.T) {
 		err := host.Decode(ctx, ids, version, message, script)
 		a.So(err, should.NotBeNil)
 	}
+
+	// Decode with warnings.
+	{
+		script := `
+		function decodeUplink(input) {
+			return {
+				data: {
+					temperature: ((input.bytes[0] & 0x80 ? 0xffff : 0x0000) << 16 | input.bytes[0] << 8 | input.bytes[1]) / 100,
+					f_port: input.fPort
+				},
+				warnings: ['temperature below zero']
+			}
+		}
+		`
+		message := &ttnpb.ApplicationUplink{
+			FPort:      42,
+			FRMPayload: []byte{247, 174},
+		}
+		err := host.Decode(ctx, ids, version, message, script)
+		a.So(err, should.BeNil)
+		m, err := gogoproto.Map(message.DecodedPayload)
+		a.So(err, should.BeNil)
+		a.So(m, should.Resemble, map[string]interface{}{
+			"temperature": -21.3,
+			"f_port":      42.0,
+		})
+		a.So(message.DecodedPayloadWarnings, should.Resemble, []string{"temperature below zero"})
+	}
+
+	// Return errors.
+	{
+		script := `
+		function decodeUplink(input) {
+			return {
+				errors: ['unknown port', 'invalid length']
+			}
+		}
+		`
+		err := host.Decode(ctx, ids, version, message, script)
+		a.So(err, should.HaveSameErrorDefinitionAs, errOutputErrors)
+		details := errors.Details(err)
+		if a.So(details, should.HaveLength, 2) {
+			for i, expected := range []string{"unknown port", "invalid length"} {
+				d, ok := details[i].(*ttnpb.ErrorDetails)
+				if !a.So(ok, should.BeTrue) {
+					continue
+				}
+				a.So(d.Name, should.Equal, "output_error")
+				a.So(d.Attributes.Fields["error"].GetStringValue(), should.Equal, expected)
+			}
+		}
+	}
+
+	// Return invalid warnings.
+	{
+		script := `
+		function decodeUplink(input) {
+			return {
+				data: {},
+				warnings: [42]
+			}
+		}
+		`
+		err := host.Decode(ctx, ids, version, message, script)
+		a.So(err, should.HaveSameErrorDefinitionAs, errOutputType)
+	}
+
+	// Decode with the receive time.
+	{
+		script := `
+		function decodeUplink(input) {
+			return {
+				data: {
+					length: input.bytes.length,
+					is_array: Array.isArray(input.bytes),
+					recv_time: input.recvTime.toISOString()
+				}
+			}
+		}
+		`
+		message := &ttnpb.ApplicationUplink{
+			FPort:      42,
+			FRMPayload: []byte{247, 174},
+			ReceivedAt: time.Date(2020, time.February, 6, 14, 40, 0, 123000000, time.UTC),
+		}
+		err := host.Decode(ctx, ids, version, message, script)
+		a.So(err, should.BeNil)
+		m, err := gogoproto.Map(message.DecodedPayload)
+		a.So(err, should.BeNil)
+		a.So(m, should.Resemble, map[string]interface{}{
+			"length":    2.0,
+			"is_array":  true,
+			"recv_time": "2020-02-06T14:40:00.123Z",
+		})
+	}
+
+	// Report the line number of an error.
+	{
+		script := `function decodeUplink(input) {
+	var value = null;
+	return { data: { value: value.foo } };
+}`
+		err := host.Decode(ctx, ids, version, message, script)
+		a.So(err, should.NotBeNil)
+		a.So(errors.Attributes(err)["line"], should.Equal, 3)
+	}
+}
+
+func TestDecodeDownlink(t *testing.T) {
+	a := assertions.New(t)
+
+	ctx := test.Context()
+	host := New().(messageprocessors.PayloadDownlinkDecoder)
+
+	eui := types.EUI64{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08}
+	ids := ttnpb.EndDeviceIdentifiers{
+		ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{
+			ApplicationID: "foo-app",
+		},
+		DeviceID: "foo-device",
+		DevEUI:   &eui,
+	}
+	version := &ttnpb.EndDeviceVersionIdentifiers{
+		BrandID:         "The Things Products",
+		ModelID:         "The Things Uno",
+		HardwareVersion: "1.0",
+		FirmwareVersion: "1.0.0",
+	}
+
+	// No decodeDownlink function.
+	{
+		script := `
+		function Encoder(payload, f_port) {
+			return [1, 2, 3]
+		}
+		`
+		message := &ttnpb.ApplicationDownlink{
+			FPort:      42,
+			FRMPayload: []byte{247, 174},
+		}
+		err := host.DecodeDownlink(ctx, ids, version, message, script)
+		a.So(err, should.BeNil)
+		a.So(message.DecodedPayload, should.BeNil)
+	}
+
+	// Decode with warnings.
+	{
+		script := `
+		function decodeDownlink(input) {
+			return {
+				data: {
+					temperature: ((input.bytes[0] & 0x80 ? 0xffff : 0x0000) << 16 | input.bytes[0] << 8 | input.bytes[1]) / 100,
+					f_port: input.fPort
+				},
+				warnings: ['temperature below zero']
+			}
+		}
+		`
+		message := &ttnpb.ApplicationDownlink{
+			FPort:      42,
+			FRMPayload: []byte{247, 174},
+		}
+		err := host.DecodeDownlink(ctx, ids, version, message, script)
+		a.So(err, should.BeNil)
+		m, err := gogoproto.Map(message.DecodedPayload)
+		a.So(err, should.BeNil)
+		a.So(m, should.Resemble, map[string]interface{}{
+			"temperature": -21.3,
+			"f_port":      42.0,
+		})
+		a.So(message.DecodedPayloadWarnings, should.Resemble, []string{"temperature below zero"})
+	}
+
+	// Return errors.
+	{
+		script := `
+		function decodeDownlink(input) {
+			return {
+				errors: ['unknown port']
+			}
+		}
+		`
+		message := &ttnpb.ApplicationDownlink{
+			FPort:      42,
+			FRMPayload: []byte{247, 174},
+		}
+		err := host.DecodeDownlink(ctx, ids, version, message, script)
+		a.So(err, should.HaveSameErrorDefinitionAs, errOutputErrors)
+		a.So(message.DecodedPayload, should.BeNil)
+	}
 }
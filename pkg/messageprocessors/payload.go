@@ -30,6 +30,12 @@ type PayloadDecoder interface {
 	Decode(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, version *ttnpb.EndDeviceVersionIdentifiers, message *ttnpb.ApplicationUplink, parameter string) error
 }
 
+// PayloadDownlinkDecoder represents a payload decoder message processor that decodes downlink messages.
+// Payload encoders may implement this interface to decode the FRMPayload of downlink messages.
+type PayloadDownlinkDecoder interface {
+	DecodeDownlink(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, version *ttnpb.EndDeviceVersionIdentifiers, message *ttnpb.ApplicationDownlink, parameter string) error
+}
+
 // PayloadEncodeDecoder is the interface that groups the Encode and Decode methods.
 type PayloadEncodeDecoder interface {
 	PayloadEncoder
@@ -0,0 +1,295 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package wasm contains the WebAssembly payload formatter message processors.
+//
+// The parameter of the formatter is the base64 encoded WebAssembly binary module. The module is interpreted, so that the
+// number of executed instructions can be limited. It does not get access to WASI or any other host functions, and must
+// export:
+//
+//   - memory: the linear memory of the module.
+//   - alloc(size i32) i32: allocates size bytes and returns the offset in memory.
+//   - decode_uplink(ptr i32, len i32) i64: decodes the uplink input and returns the output location.
+//   - encode_downlink(ptr i32, len i32) i64: encodes the downlink input and returns the output location.
+//
+// The input is a JSON object that is written to memory allocated with alloc. It contains the fields f_port,
+// payload, dev_eui, brand, model, hardware_version and firmware_version, similar to the JavaScript formatter.
+// The output location is packed in the result as the offset in the upper 32 bits and the length in the lower 32 bits.
+// The output of decode_uplink is a JSON object with the decoded payload. The output of encode_downlink is the
+// raw FRMPayload.
+package wasm
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"runtime/trace"
+	"sync"
+	"time"
+
+	"github.com/perlin-network/life/compiler"
+	"github.com/perlin-network/life/exec"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/gogoproto"
+	"go.thethings.network/lorawan-stack/pkg/messageprocessors"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+)
+
+// Options contains the WebAssembly runtime options.
+type Options struct {
+	// MemoryLimitPages is the maximum number of 64 KiB memory pages a module can use.
+	MemoryLimitPages uint32 `name:"memory-limit-pages" description:"Maximum number of 64 KiB memory pages of a WebAssembly module"`
+	// Fuel is the maximum number of instructions of a single encode or decode call.
+	Fuel uint64 `name:"fuel" description:"Maximum number of instructions of a single WebAssembly encode or decode call"`
+}
+
+// DefaultOptions are the default Options.
+var DefaultOptions = Options{
+	MemoryLimitPages: 16,
+	Fuel:             10000000,
+}
+
+// maxCachedModules is the maximum number of compiled modules that are cached.
+const maxCachedModules = 1024
+
+type host struct {
+	options Options
+
+	modulesMu sync.Mutex
+	modules   map[[sha256.Size]byte]*exec.Module
+}
+
+// New creates and returns a new WebAssembly payload encoder and decoder.
+func New(options Options) messageprocessors.PayloadEncodeDecoder {
+	return &host{
+		options: options,
+		modules: make(map[[sha256.Size]byte]*exec.Module),
+	}
+}
+
+var (
+	errInput          = errors.DefineInvalidArgument("input", "invalid input")
+	errOutput         = errors.DefineInvalidArgument("output", "invalid output")
+	errModule         = errors.DefineInvalidArgument("module", "invalid WebAssembly module")
+	errImport         = errors.DefineInvalidArgument("import", "module imports `{module}.{name}`, which is not available")
+	errMemoryLimit    = errors.DefineInvalidArgument("memory_limit", "module memory exceeds the limit of `{pages}` pages")
+	errMissingExport  = errors.DefineInvalidArgument("missing_export", "module does not export `{name}`")
+	errRuntime        = errors.Define("runtime", "runtime error")
+	errFuel           = errors.DefineResourceExhausted("fuel", "execution exceeded the fuel limit of `{fuel}`")
+	errMemoryOverflow = errors.Define("memory_overflow", "memory access out of range")
+)
+
+// load returns the compiled WebAssembly module of the given parameter.
+// Modules are cached by the hash of the parameter, so that the uplink and downlink formatters of an application or
+// end device version do not evict each other. Compiled modules are never modified, and every call instantiates its own
+// virtual machine from the module, so evicting a module does not affect calls that are still using it.
+func (h *host) load(parameter string) (*exec.Module, error) {
+	hash := sha256.Sum256([]byte(parameter))
+
+	h.modulesMu.Lock()
+	module, ok := h.modules[hash]
+	h.modulesMu.Unlock()
+	if ok {
+		return module, nil
+	}
+
+	module, err := h.compile(parameter)
+	if err != nil {
+		return nil, err
+	}
+
+	h.modulesMu.Lock()
+	defer h.modulesMu.Unlock()
+	if len(h.modules) >= maxCachedModules {
+		for hash := range h.modules {
+			delete(h.modules, hash)
+			break
+		}
+	}
+	h.modules[hash] = module
+	return module, nil
+}
+
+// compile decodes and compiles the base64 encoded WebAssembly binary module.
+func (h *host) compile(parameter string) (*exec.Module, error) {
+	binary, err := base64.StdEncoding.DecodeString(parameter)
+	if err != nil {
+		return nil, errModule.WithCause(err)
+	}
+	module, err := exec.NewModule(binary, exec.VMConfig{
+		MaxMemoryPages: int(h.options.MemoryLimitPages),
+		GasLimit:       h.options.Fuel,
+	}, noImports{}, &compiler.SimpleGasPolicy{GasPerInstruction: 1})
+	if err != nil {
+		return nil, errModule.WithCause(err)
+	}
+	// The initial memory is allocated when a virtual machine is instantiated, which panics if it exceeds the limit.
+	if mem := module.Module.Base.Memory; mem != nil && len(mem.Entries) > 0 && mem.Entries[0].Limits.Initial > h.options.MemoryLimitPages {
+		return nil, errModule.WithCause(errMemoryLimit.WithAttributes("pages", h.options.MemoryLimitPages))
+	}
+	return module, nil
+}
+
+// noImports is an exec.ImportResolver that does not resolve any imports, so that modules do not get access to any
+// host functions.
+type noImports struct{}
+
+func (noImports) ResolveFunc(module, field string) exec.FunctionImport {
+	panic(errImport.WithAttributes("module", module, "name", field))
+}
+
+func (noImports) ResolveGlobal(module, field string) int64 {
+	panic(errImport.WithAttributes("module", module, "name", field))
+}
+
+// run instantiates the module, writes the input to its memory, calls the function and returns the output.
+// Every call gets a fresh instance, so no state is shared between calls.
+func (h *host) run(ctx context.Context, module *exec.Module, function string, input []byte) (output []byte, err error) {
+	defer trace.StartRegion(ctx, "run webassembly").End()
+
+	start := time.Now()
+	defer func() {
+		runLatency.Observe(time.Since(start).Seconds())
+		if err != nil {
+			runs.WithLabelValues("error").Inc()
+		} else {
+			runs.WithLabelValues("ok").Inc()
+		}
+	}()
+
+	vm := module.NewVirtualMachine()
+	if len(vm.Memory) == 0 {
+		return nil, errMissingExport.WithAttributes("name", "memory")
+	}
+	alloc, ok := vm.GetFunctionExport("alloc")
+	if !ok {
+		return nil, errMissingExport.WithAttributes("name", "alloc")
+	}
+	fn, ok := vm.GetFunctionExport(function)
+	if !ok {
+		return nil, errMissingExport.WithAttributes("name", function)
+	}
+
+	res, err := h.call(vm, alloc, int64(len(input)))
+	if err != nil {
+		return nil, err
+	}
+	ptr := uint64(uint32(res))
+	if ptr+uint64(len(input)) > uint64(len(vm.Memory)) {
+		return nil, errMemoryOverflow
+	}
+	copy(vm.Memory[ptr:], input)
+	res, err = h.call(vm, fn, int64(ptr), int64(len(input)))
+	if err != nil {
+		return nil, err
+	}
+	offset, length := uint64(uint32(uint64(res)>>32)), uint64(uint32(res))
+	if offset+length > uint64(len(vm.Memory)) {
+		return nil, errMemoryOverflow
+	}
+	// The memory is released with the virtual machine, so the output needs to be copied.
+	return append([]byte(nil), vm.Memory[offset:offset+length]...), nil
+}
+
+func (h *host) call(vm *exec.VirtualMachine, id int, params ...int64) (int64, error) {
+	res, err := vm.Run(id, params...)
+	if err != nil {
+		if h.options.Fuel > 0 && vm.Gas > h.options.Fuel {
+			return 0, errFuel.WithAttributes("fuel", h.options.Fuel)
+		}
+		return 0, errRuntime.WithCause(err)
+	}
+	return res, nil
+}
+
+func (h *host) createEnvironment(ids ttnpb.EndDeviceIdentifiers, version *ttnpb.EndDeviceVersionIdentifiers) map[string]interface{} {
+	env := make(map[string]interface{})
+	if ids.DevEUI != nil {
+		env["dev_eui"] = ids.DevEUI.String()
+	}
+	if version != nil {
+		env["brand"] = version.BrandID
+		env["model"] = version.ModelID
+		env["hardware_version"] = version.HardwareVersion
+		env["firmware_version"] = version.FirmwareVersion
+	}
+	return env
+}
+
+// Encode encodes the message's DecodedPayload to FRMPayload using the given WebAssembly module.
+func (h *host) Encode(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, version *ttnpb.EndDeviceVersionIdentifiers, msg *ttnpb.ApplicationDownlink, parameter string) error {
+	defer trace.StartRegion(ctx, "encode message").End()
+
+	decoded := msg.DecodedPayload
+	if decoded == nil {
+		return nil
+	}
+	m, err := gogoproto.Map(decoded)
+	if err != nil {
+		return errInput.WithCause(err)
+	}
+	env := h.createEnvironment(ids, version)
+	env["payload"] = m
+	env["f_port"] = msg.FPort
+	input, err := json.Marshal(env)
+	if err != nil {
+		return errInput.WithCause(err)
+	}
+	module, err := h.load(parameter)
+	if err != nil {
+		return err
+	}
+	output, err := h.run(ctx, module, "encode_downlink", input)
+	if err != nil {
+		return err
+	}
+	msg.FRMPayload = output
+	return nil
+}
+
+// Decode decodes the message's FRMPayload to DecodedPayload using the given WebAssembly module.
+func (h *host) Decode(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, version *ttnpb.EndDeviceVersionIdentifiers, msg *ttnpb.ApplicationUplink, parameter string) error {
+	defer trace.StartRegion(ctx, "decode message").End()
+
+	env := h.createEnvironment(ids, version)
+	payload := make([]int, len(msg.FRMPayload))
+	for i, b := range msg.FRMPayload {
+		payload[i] = int(b)
+	}
+	env["payload"] = payload
+	env["f_port"] = msg.FPort
+	input, err := json.Marshal(env)
+	if err != nil {
+		return errInput.WithCause(err)
+	}
+	module, err := h.load(parameter)
+	if err != nil {
+		return err
+	}
+	output, err := h.run(ctx, module, "decode_uplink", input)
+	if err != nil {
+		return err
+	}
+	var m map[string]interface{}
+	if err := json.Unmarshal(output, &m); err != nil {
+		return errOutput.WithCause(err)
+	}
+	s, err := gogoproto.Struct(m)
+	if err != nil {
+		return errOutput.WithCause(err)
+	}
+	msg.DecodedPayload = s
+	return nil
+}
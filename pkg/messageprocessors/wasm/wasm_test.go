@@ -0,0 +1,181 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package wasm
+
+import (
+	"crypto/sha256"
+	"testing"
+
+	pbtypes "github.com/gogo/protobuf/types"
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/gogoproto"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/types"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+// The test modules are hand-assembled. They all export memory and alloc, where alloc always returns offset 1024.
+const (
+	// echoModule decodes uplinks by returning the input as output, and encodes downlinks to the bytes 1, 2, 3.
+	echoModule = "AGFzbQEAAAABDAJgAX8Bf2ACf38BfgMEAwABAQUDAQABBzQEBm1lbW9yeQIABWFsbG9jAAANZGVjb2RlX3VwbGluawABD2VuY29kZV9kb3dubGluawACCh4DBQBBgAgLDAAgAK1CIIYgAa2ECwkAQoOAgICAAgsLCQEAQRALAwECAw=="
+	// loopModule loops forever on both decode and encode.
+	loopModule = "AGFzbQEAAAABDAJgAX8Bf2ACf38BfgMEAwABAQUDAQABBzQEBm1lbW9yeQIABWFsbG9jAAANZGVjb2RlX3VwbGluawABD2VuY29kZV9kb3dubGluawACChsDBQBBgAgLCQADQAwAC0IACwkAA0AMAAtCAAs="
+	// memoryModule is the echoModule with 64 pages of memory.
+	memoryModule = "AGFzbQEAAAABDAJgAX8Bf2ACf38BfgMEAwABAQUDAQBABzQEBm1lbW9yeQIABWFsbG9jAAANZGVjb2RlX3VwbGluawABD2VuY29kZV9kb3dubGluawACCh4DBQBBgAgLDAAgAK1CIIYgAa2ECwkAQoOAgICAAgs="
+	// noExportModule is the echoModule without decode_uplink and encode_downlink exports.
+	noExportModule = "AGFzbQEAAAABDAJgAX8Bf2ACf38BfgMEAwABAQUDAQABBxICBm1lbW9yeQIABWFsbG9jAAAKHgMFAEGACAsMACAArUIghiABrYQLCQBCg4CAgIACCw=="
+)
+
+func TestEncode(t *testing.T) {
+	a := assertions.New(t)
+
+	ctx := test.Context()
+	host := New(DefaultOptions)
+
+	ids := ttnpb.EndDeviceIdentifiers{
+		ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{
+			ApplicationID: "foo-app",
+		},
+		DeviceID: "foo-device",
+	}
+	version := &ttnpb.EndDeviceVersionIdentifiers{
+		BrandID:         "The Things Products",
+		ModelID:         "The Things Uno",
+		HardwareVersion: "1.0",
+		FirmwareVersion: "1.0.0",
+	}
+
+	message := &ttnpb.ApplicationDownlink{
+		DecodedPayload: &pbtypes.Struct{
+			Fields: map[string]*pbtypes.Value{
+				"temperature": {
+					Kind: &pbtypes.Value_NumberValue{
+						NumberValue: -21.3,
+					},
+				},
+			},
+		},
+	}
+
+	// Return constant byte array.
+	{
+		err := host.Encode(ctx, ids, version, message, echoModule)
+		a.So(err, should.BeNil)
+		a.So(message.FRMPayload, should.Resemble, []byte{1, 2, 3})
+	}
+
+	// Run out of fuel.
+	{
+		err := host.Encode(ctx, ids, version, message, loopModule)
+		a.So(err, should.HaveSameErrorDefinitionAs, errFuel)
+	}
+
+	// Missing export.
+	{
+		err := host.Encode(ctx, ids, version, message, noExportModule)
+		a.So(err, should.HaveSameErrorDefinitionAs, errMissingExport)
+	}
+}
+
+func TestDecode(t *testing.T) {
+	a := assertions.New(t)
+
+	ctx := test.Context()
+	h := New(DefaultOptions).(*host)
+
+	eui := types.EUI64{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08}
+	ids := ttnpb.EndDeviceIdentifiers{
+		ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{
+			ApplicationID: "foo-app",
+		},
+		DeviceID: "foo-device",
+		DevEUI:   &eui,
+	}
+	version := &ttnpb.EndDeviceVersionIdentifiers{
+		BrandID:         "The Things Products",
+		ModelID:         "The Things Uno",
+		HardwareVersion: "1.0",
+		FirmwareVersion: "1.0.0",
+	}
+
+	message := &ttnpb.ApplicationUplink{
+		FRMPayload: []byte{0xF7, 0xAE},
+		FPort:      42,
+	}
+
+	// Return the input.
+	{
+		err := h.Decode(ctx, ids, version, message, echoModule)
+		a.So(err, should.BeNil)
+		m, err := gogoproto.Map(message.DecodedPayload)
+		a.So(err, should.BeNil)
+		a.So(m, should.Resemble, map[string]interface{}{
+			"dev_eui":          "0102030405060708",
+			"brand":            "The Things Products",
+			"model":            "The Things Uno",
+			"hardware_version": "1.0",
+			"firmware_version": "1.0.0",
+			"f_port":           42.0,
+			"payload":          []interface{}{247.0, 174.0},
+		})
+	}
+
+	// Use the cached module.
+	{
+		message.DecodedPayload = nil
+		err := h.Decode(ctx, ids, version, message, echoModule)
+		a.So(err, should.BeNil)
+		a.So(message.DecodedPayload, should.NotBeNil)
+		a.So(h.modules, should.HaveLength, 1)
+	}
+
+	// Cache modules by parameter, so that modules of the same end device do not evict each other.
+	{
+		err := h.Decode(ctx, ids, version, message, loopModule)
+		a.So(err, should.HaveSameErrorDefinitionAs, errFuel)
+		a.So(h.modules, should.HaveLength, 2)
+
+		err = h.Encode(ctx, ids, version, &ttnpb.ApplicationDownlink{
+			DecodedPayload: &pbtypes.Struct{},
+		}, echoModule)
+		a.So(err, should.BeNil)
+		a.So(h.modules, should.HaveLength, 2)
+	}
+
+	// Exceed the memory limit.
+	{
+		err := h.Decode(ctx, ids, version, message, memoryModule)
+		a.So(err, should.HaveSameErrorDefinitionAs, errModule)
+		a.So(h.modules, should.HaveLength, 2)
+	}
+
+	// Invalid module.
+	{
+		err := h.Decode(ctx, ids, version, message, "AGFzbQ==")
+		a.So(err, should.HaveSameErrorDefinitionAs, errModule)
+		a.So(h.modules, should.HaveLength, 2)
+	}
+
+	// Reuse the compiled module, and instantiate a new virtual machine per call.
+	{
+		module := h.modules[sha256.Sum256([]byte(echoModule))]
+		message.DecodedPayload = nil
+		err := h.Decode(ctx, ids, version, message, echoModule)
+		a.So(err, should.BeNil)
+		a.So(message.DecodedPayload, should.NotBeNil)
+		a.So(h.modules[sha256.Sum256([]byte(echoModule))], should.PointTo, module)
+	}
+}
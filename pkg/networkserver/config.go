@@ -25,17 +25,18 @@ import (
 
 // Config represents the NetworkServer configuration.
 type Config struct {
-	ApplicationUplinks  ApplicationUplinkQueue `name:"-"`
-	Devices             DeviceRegistry         `name:"-"`
-	DownlinkTasks       DownlinkTaskQueue      `name:"-"`
-	NetID               types.NetID            `name:"net-id" description:"NetID of this Network Server"`
-	DevAddrPrefixes     []types.DevAddrPrefix  `name:"dev-addr-prefixes" description:"Device address prefixes of this Network Server"`
-	DeduplicationWindow time.Duration          `name:"deduplication-window" description:"Time window during which, duplicate messages are collected for metadata"`
-	CooldownWindow      time.Duration          `name:"cooldown-window" description:"Time window starting right after deduplication window, during which, duplicate messages are discarded"`
-	DownlinkPriorities  DownlinkPriorityConfig `name:"downlink-priorities" description:"Downlink message priorities"`
-	DefaultMACSettings  MACSettingConfig       `name:"default-mac-settings" description:"Default MAC settings to fallback to if not specified by device, band or frequency plan"`
-	Interop             config.InteropClient   `name:"interop" description:"Interop client configuration"`
-	DeviceKEKLabel      string                 `name:"device-kek-label" description:"Label of KEK used to encrypt device keys at rest"`
+	ApplicationUplinks  ApplicationUplinkQueue   `name:"-"`
+	Devices             DeviceRegistry           `name:"-"`
+	DownlinkTasks       DownlinkTaskQueue        `name:"-"`
+	EndDeviceProfiles   EndDeviceProfileRegistry `name:"-"`
+	NetID               types.NetID              `name:"net-id" description:"NetID of this Network Server"`
+	DevAddrPrefixes     []types.DevAddrPrefix    `name:"dev-addr-prefixes" description:"Device address prefixes of this Network Server"`
+	DeduplicationWindow time.Duration            `name:"deduplication-window" description:"Time window during which, duplicate messages are collected for metadata"`
+	CooldownWindow      time.Duration            `name:"cooldown-window" description:"Time window starting right after deduplication window, during which, duplicate messages are discarded"`
+	DownlinkPriorities  DownlinkPriorityConfig   `name:"downlink-priorities" description:"Downlink message priorities"`
+	DefaultMACSettings  MACSettingConfig         `name:"default-mac-settings" description:"Default MAC settings to fallback to if not specified by device, band or frequency plan"`
+	Interop             config.InteropClient     `name:"interop" description:"Interop client configuration"`
+	DeviceKEKLabel      string                   `name:"device-kek-label" description:"Label of KEK used to encrypt device keys at rest"`
 }
 
 // MACSettingConfig defines MAC-layer configuration.
@@ -115,7 +115,7 @@ func (ns *NetworkServer) updateDataDownlinkTask(ctx context.Context, dev *ttnpb.
 	} else {
 		delay := dev.MACState.CurrentParameters.Rx1Delay.Duration() / 2
 		var ok bool
-		t, _, ok = nextDataDownlinkAt(ctx, dev, phy, ns.defaultMACSettings, earliestAt.Add(delay))
+		t, _, ok = nextDataDownlinkAt(ctx, dev, phy, ns.endDeviceDefaultMACSettings(ctx, dev), earliestAt.Add(delay))
 		if !ok {
 			return nil
 		}
@@ -146,6 +146,7 @@ func (ns *NetworkServer) generateDataDownlink(ctx context.Context, dev *ttnpb.En
 	if dev.Session == nil {
 		return nil, generateDownlinkState{}, errEmptySession
 	}
+	defaults := ns.endDeviceDefaultMACSettings(ctx, dev)
 
 	ctx = log.NewContextWithFields(ctx, log.Fields(
 		"device_uid", unique.ID(ctx, dev.EndDeviceIdentifiers),
@@ -198,7 +199,7 @@ func (ns *NetworkServer) generateDataDownlink(ctx context.Context, dev *ttnpb.En
 				enqueueDutyCycleReq,
 				enqueueRxParamSetupReq,
 				func(ctx context.Context, dev *ttnpb.EndDevice, maxDownLen uint16, maxUpLen uint16) macCommandEnqueueState {
-					return enqueueDevStatusReq(ctx, dev, maxDownLen, maxUpLen, ns.defaultMACSettings, transmitAt)
+					return enqueueDevStatusReq(ctx, dev, maxDownLen, maxUpLen, defaults, transmitAt)
 				},
 				enqueueNewChannelReq,
 				func(ctx context.Context, dev *ttnpb.EndDevice, maxDownLen uint16, maxUpLen uint16) macCommandEnqueueState {
@@ -296,7 +297,7 @@ func (ns *NetworkServer) generateDataDownlink(ctx context.Context, dev *ttnpb.En
 			DevAddr: dev.Session.DevAddr,
 			FCtrl: ttnpb.FCtrl{
 				Ack: up != nil && up.Payload.MHDR.MType == ttnpb.MType_CONFIRMED_UP,
-				ADR: deviceUseADR(dev, ns.defaultMACSettings),
+				ADR: deviceUseADR(dev, defaults),
 			},
 		},
 	}
@@ -491,10 +492,10 @@ func (ns *NetworkServer) generateDataDownlink(ctx context.Context, dev *ttnpb.En
 		var confirmedAt time.Time
 		switch class {
 		case ttnpb.CLASS_B:
-			confirmedAt, _ = nextConfirmedClassBDownlinkAt(ctx, dev, ns.defaultMACSettings, transmitAt)
+			confirmedAt, _ = nextConfirmedClassBDownlinkAt(ctx, dev, defaults, transmitAt)
 
 		case ttnpb.CLASS_C:
-			confirmedAt, _ = nextConfirmedClassCDownlinkAt(ctx, dev, ns.defaultMACSettings, transmitAt)
+			confirmedAt, _ = nextConfirmedClassCDownlinkAt(ctx, dev, defaults, transmitAt)
 		}
 		if confirmedAt.After(transmitAt) {
 			logger.WithField("confirmed_at", confirmedAt).Debug("Confirmed class B/C downlink attempt performed too soon")
@@ -580,7 +581,24 @@ type downlinkPath struct {
 }
 
 func downlinkPathsFromMetadata(mds ...*ttnpb.RxMetadata) []downlinkPath {
-	mds = append(mds[:0:0], mds...)
+	// Select the antenna with the strongest signal per gateway. The antennas of a gateway share the same
+	// receiver, so the RSSI is compared rather than the SNR, which saturates for strong signals.
+	antennas := make(map[string]int, len(mds))
+	selected := make([]*ttnpb.RxMetadata, 0, len(mds))
+	for _, md := range mds {
+		if len(md.UplinkToken) == 0 || md.DownlinkPathConstraint == ttnpb.DOWNLINK_PATH_CONSTRAINT_NEVER {
+			continue
+		}
+		i, ok := antennas[md.GatewayID]
+		switch {
+		case !ok:
+			antennas[md.GatewayID] = len(selected)
+			selected = append(selected, md)
+		case md.RSSI > selected[i].RSSI:
+			selected[i] = md
+		}
+	}
+	mds = selected
 	sort.SliceStable(mds, func(i, j int) bool {
 		// TODO: Improve the sorting algorithm (https://github.com/TheThingsNetwork/lorawan-stack/issues/13)
 		return mds[i].SNR > mds[j].SNR
@@ -588,10 +606,6 @@ func downlinkPathsFromMetadata(mds ...*ttnpb.RxMetadata) []downlinkPath {
 	head := make([]downlinkPath, 0, len(mds))
 	tail := make([]downlinkPath, 0, len(mds))
 	for _, md := range mds {
-		if len(md.UplinkToken) == 0 || md.DownlinkPathConstraint == ttnpb.DOWNLINK_PATH_CONSTRAINT_NEVER {
-			continue
-		}
-
 		path := downlinkPath{
 			GatewayIdentifiers: md.GatewayIdentifiers,
 			DownlinkPath: &ttnpb.DownlinkPath{
@@ -695,10 +709,10 @@ func nonRetryableFixedPathGatewayError(err error) bool {
 	return errors.IsNotFound(err) || errors.IsDataLoss(err) || errors.IsFailedPrecondition(err)
 }
 
-// scheduleDownlinkByPaths attempts to schedule payload b using parameters in req using paths.
+// scheduleDownlinkByPaths attempts to schedule payload b destined for device identified by ids using parameters in req using paths.
 // scheduleDownlinkByPaths discards req.DownlinkPaths and mutates it arbitrarily.
 // scheduleDownlinkByPaths returns the scheduled downlink or error.
-func (ns *NetworkServer) scheduleDownlinkByPaths(ctx context.Context, req *ttnpb.TxRequest, b []byte, paths ...downlinkPath) (*scheduledDownlink, error) {
+func (ns *NetworkServer) scheduleDownlinkByPaths(ctx context.Context, req *ttnpb.TxRequest, ids ttnpb.EndDeviceIdentifiers, b []byte, paths ...downlinkPath) (*scheduledDownlink, error) {
 	if len(paths) == 0 {
 		return nil, errNoPath
 	}
@@ -743,6 +757,7 @@ func (ns *NetworkServer) scheduleDownlinkByPaths(ctx context.Context, req *ttnpb
 		req.DownlinkPaths = a.paths
 		down := &ttnpb.DownlinkMessage{
 			RawPayload:     b,
+			EndDeviceIDs:   &ids,
 			CorrelationIDs: events.CorrelationIDsFromContext(ctx),
 			Settings: &ttnpb.DownlinkMessage_Request{
 				Request: req,
@@ -1104,6 +1119,7 @@ func (ns *NetworkServer) attemptClassADataDownlink(ctx context.Context, dev *ttn
 	down, err := ns.scheduleDownlinkByPaths(
 		log.NewContext(ctx, loggerWithTxRequestFields(logger, req, attemptRx1, attemptRx2).WithField("rx1_delay", req.Rx1Delay)),
 		req,
+		dev.EndDeviceIdentifiers,
 		genDown.Payload,
 		paths...,
 	)
@@ -1130,12 +1146,13 @@ func (ns *NetworkServer) attemptClassADataDownlink(ctx context.Context, dev *ttn
 	if genState.ApplicationDownlink != nil {
 		sets = ttnpb.AddFields(sets, "queued_application_downlinks")
 	}
-	recordDataDownlink(dev, genDown, genState, down, ns.defaultMACSettings)
+	recordDataDownlink(dev, genDown, genState, down, ns.endDeviceDefaultMACSettings(ctx, dev))
 	return downlinkAttemptResult{
 		SetPaths: ttnpb.AddFields(sets,
 			"mac_state.last_confirmed_downlink_at",
 			"mac_state.pending_application_downlink",
 			"mac_state.pending_requests",
+			"mac_state.queued_force_rejoin_req",
 			"mac_state.queued_responses",
 			"mac_state.recent_downlinks",
 			"mac_state.rx_windows_available",
@@ -1174,6 +1191,7 @@ func (ns *NetworkServer) processDownlinkTask(ctx context.Context) error {
 				"mac_state",
 				"multicast",
 				"pending_mac_state",
+				"profile_ids",
 				"queued_application_downlinks",
 				"recent_downlinks",
 				"recent_uplinks",
@@ -1191,6 +1209,7 @@ func (ns *NetworkServer) processDownlinkTask(ctx context.Context) error {
 					logger.WithError(err).Error("Failed to get frequency plan of the device, retry downlink slot")
 					return dev, nil, nil
 				}
+				defaults := ns.endDeviceDefaultMACSettings(ctx, dev)
 
 				if dev.PendingMACState != nil &&
 					dev.PendingMACState.PendingJoinRequest == nil &&
@@ -1243,6 +1262,7 @@ func (ns *NetworkServer) processDownlinkTask(ctx context.Context) error {
 					down, err := ns.scheduleDownlinkByPaths(
 						log.NewContext(ctx, loggerWithTxRequestFields(logger, req, attemptRx1, attemptRx2).WithField("rx1_delay", req.Rx1Delay)),
 						req,
+						dev.EndDeviceIdentifiers,
 						dev.PendingMACState.QueuedJoinAccept.Payload,
 						paths...,
 					)
@@ -1324,7 +1344,7 @@ func (ns *NetworkServer) processDownlinkTask(ctx context.Context) error {
 				transmissionDelay := dev.MACState.CurrentParameters.Rx1Delay.Duration() / 2
 
 				// Class B/C data downlink
-				transmitAt, class, ok := nextDataDownlinkAt(ctx, dev, phy, ns.defaultMACSettings, timeNow().UTC().Add(transmissionDelay))
+				transmitAt, class, ok := nextDataDownlinkAt(ctx, dev, phy, defaults, timeNow().UTC().Add(transmissionDelay))
 				if !ok || class == ttnpb.CLASS_A {
 					logger.Debug("No class B/C downlink available, skip class B/C downlink slot")
 					return dev, sets, nil
@@ -1430,6 +1450,7 @@ func (ns *NetworkServer) processDownlinkTask(ctx context.Context) error {
 				down, err := ns.scheduleDownlinkByPaths(
 					log.NewContext(ctx, loggerWithTxRequestFields(logger, req, false, true)),
 					req,
+					dev.EndDeviceIdentifiers,
 					genDown.Payload,
 					paths...,
 				)
@@ -1493,7 +1514,7 @@ func (ns *NetworkServer) processDownlinkTask(ctx context.Context) error {
 					return dev, sets, nil
 				}
 
-				recordDataDownlink(dev, genDown, genState, down, ns.defaultMACSettings)
+				recordDataDownlink(dev, genDown, genState, down, defaults)
 				queuedEvents = append(queuedEvents, genState.Events...)
 				queuedApplicationUplinks = genState.appendApplicationUplinks(queuedApplicationUplinks, true)
 				if genState.ApplicationDownlink != nil {
@@ -1504,6 +1525,7 @@ func (ns *NetworkServer) processDownlinkTask(ctx context.Context) error {
 					"mac_state.last_network_initiated_downlink_at",
 					"mac_state.pending_application_downlink",
 					"mac_state.pending_requests",
+					"mac_state.queued_force_rejoin_req",
 					"mac_state.queued_responses",
 					"mac_state.recent_downlinks",
 					"mac_state.rx_windows_available",
@@ -53,6 +53,7 @@ func TestProcessDownlinkTask(t *testing.T) {
 		"mac_state",
 		"multicast",
 		"pending_mac_state",
+		"profile_ids",
 		"queued_application_downlinks",
 		"recent_downlinks",
 		"recent_uplinks",
@@ -146,7 +147,7 @@ func TestProcessDownlinkTask(t *testing.T) {
 		)
 	}
 
-	assertScheduleRxMetadataGateways := func(ctx context.Context, authCh <-chan test.ClusterAuthRequest, scheduleDownlink124Ch, scheduleDownlink3Ch <-chan NsGsScheduleDownlinkRequest, payload []byte, makeTxRequest func(paths ...*ttnpb.DownlinkPath) *ttnpb.TxRequest, resps ...NsGsScheduleDownlinkResponse) (*ttnpb.DownlinkMessage, bool) {
+	assertScheduleRxMetadataGateways := func(ctx context.Context, authCh <-chan test.ClusterAuthRequest, scheduleDownlink124Ch, scheduleDownlink3Ch <-chan NsGsScheduleDownlinkRequest, ids ttnpb.EndDeviceIdentifiers, payload []byte, makeTxRequest func(paths ...*ttnpb.DownlinkPath) *ttnpb.TxRequest, resps ...NsGsScheduleDownlinkResponse) (*ttnpb.DownlinkMessage, bool) {
 		if len(resps) < 1 || len(resps) > 3 {
 			panic("invalid response count specified")
 		}
@@ -164,6 +165,7 @@ func TestProcessDownlinkTask(t *testing.T) {
 				lastDown = &ttnpb.DownlinkMessage{
 					CorrelationIDs: correlationIDs,
 					RawPayload:     payload,
+					EndDeviceIDs:   &ids,
 					Settings: &ttnpb.DownlinkMessage_Request{
 						Request: makeTxRequest(
 							&ttnpb.DownlinkPath{
@@ -195,6 +197,7 @@ func TestProcessDownlinkTask(t *testing.T) {
 		lastDown = &ttnpb.DownlinkMessage{
 			CorrelationIDs: correlationIDs,
 			RawPayload:     payload,
+			EndDeviceIDs:   &ids,
 			Settings: &ttnpb.DownlinkMessage_Request{
 				Request: makeTxRequest(
 					&ttnpb.DownlinkPath{
@@ -222,6 +225,7 @@ func TestProcessDownlinkTask(t *testing.T) {
 		lastDown = &ttnpb.DownlinkMessage{
 			CorrelationIDs: correlationIDs,
 			RawPayload:     payload,
+			EndDeviceIDs:   &ids,
 			Settings: &ttnpb.DownlinkMessage_Request{
 				Request: makeTxRequest(
 					&ttnpb.DownlinkPath{
@@ -1683,6 +1687,7 @@ func TestProcessDownlinkTask(t *testing.T) {
 					env.Cluster.Auth,
 					scheduleDownlink124Ch,
 					scheduleDownlink3Ch,
+					getDevice.EndDeviceIdentifiers,
 					func() []byte {
 						b := []byte{
 							/* MHDR */
@@ -1954,6 +1959,7 @@ func TestProcessDownlinkTask(t *testing.T) {
 					env.Cluster.Auth,
 					scheduleDownlink124Ch,
 					scheduleDownlink3Ch,
+					getDevice.EndDeviceIdentifiers,
 					func() []byte {
 						b := []byte{
 							/* MHDR */
@@ -2065,6 +2071,7 @@ func TestProcessDownlinkTask(t *testing.T) {
 						"mac_state.last_confirmed_downlink_at",
 						"mac_state.pending_application_downlink",
 						"mac_state.pending_requests",
+						"mac_state.queued_force_rejoin_req",
 						"mac_state.queued_responses",
 						"mac_state.recent_downlinks",
 						"mac_state.rx_windows_available",
@@ -2248,6 +2255,7 @@ func TestProcessDownlinkTask(t *testing.T) {
 					env.Cluster.Auth,
 					scheduleDownlink124Ch,
 					scheduleDownlink3Ch,
+					getDevice.EndDeviceIdentifiers,
 					func() []byte {
 						b := []byte{
 							/* MHDR */
@@ -2353,6 +2361,7 @@ func TestProcessDownlinkTask(t *testing.T) {
 						"mac_state.last_confirmed_downlink_at",
 						"mac_state.pending_application_downlink",
 						"mac_state.pending_requests",
+						"mac_state.queued_force_rejoin_req",
 						"mac_state.queued_responses",
 						"mac_state.recent_downlinks",
 						"mac_state.rx_windows_available",
@@ -2535,6 +2544,7 @@ func TestProcessDownlinkTask(t *testing.T) {
 					env.Cluster.Auth,
 					scheduleDownlink124Ch,
 					scheduleDownlink3Ch,
+					getDevice.EndDeviceIdentifiers,
 					func() []byte {
 						b := []byte{
 							/* MHDR */
@@ -2664,6 +2674,7 @@ func TestProcessDownlinkTask(t *testing.T) {
 						"mac_state.last_confirmed_downlink_at",
 						"mac_state.pending_application_downlink",
 						"mac_state.pending_requests",
+						"mac_state.queued_force_rejoin_req",
 						"mac_state.queued_responses",
 						"mac_state.recent_downlinks",
 						"mac_state.rx_windows_available",
@@ -2857,6 +2868,7 @@ func TestProcessDownlinkTask(t *testing.T) {
 					env.Cluster.Auth,
 					scheduleDownlink124Ch,
 					scheduleDownlink3Ch,
+					getDevice.EndDeviceIdentifiers,
 					func() []byte {
 						b := []byte{
 							/* MHDR */
@@ -2951,6 +2963,7 @@ func TestProcessDownlinkTask(t *testing.T) {
 						"mac_state.last_network_initiated_downlink_at",
 						"mac_state.pending_application_downlink",
 						"mac_state.pending_requests",
+						"mac_state.queued_force_rejoin_req",
 						"mac_state.queued_responses",
 						"mac_state.recent_downlinks",
 						"mac_state.rx_windows_available",
@@ -3157,6 +3170,7 @@ func TestProcessDownlinkTask(t *testing.T) {
 					env.Cluster.Auth,
 					scheduleDownlink124Ch,
 					scheduleDownlink3Ch,
+					getDevice.EndDeviceIdentifiers,
 					func() []byte {
 						b := []byte{
 							/* MHDR */
@@ -3291,6 +3305,7 @@ func TestProcessDownlinkTask(t *testing.T) {
 						"mac_state.last_confirmed_downlink_at",
 						"mac_state.pending_application_downlink",
 						"mac_state.pending_requests",
+						"mac_state.queued_force_rejoin_req",
 						"mac_state.queued_responses",
 						"mac_state.recent_downlinks",
 						"mac_state.rx_windows_available",
@@ -3488,6 +3503,7 @@ func TestProcessDownlinkTask(t *testing.T) {
 					env.Cluster.Auth,
 					scheduleDownlink124Ch,
 					scheduleDownlink3Ch,
+					getDevice.EndDeviceIdentifiers,
 					func() []byte {
 						b := []byte{
 							/* MHDR */
@@ -3570,6 +3586,7 @@ func TestProcessDownlinkTask(t *testing.T) {
 					env.Cluster.Auth,
 					scheduleDownlink124Ch,
 					scheduleDownlink3Ch,
+					getDevice.EndDeviceIdentifiers,
 					func() []byte {
 						b := []byte{
 							/* MHDR */
@@ -3663,6 +3680,7 @@ func TestProcessDownlinkTask(t *testing.T) {
 						"mac_state.last_network_initiated_downlink_at",
 						"mac_state.pending_application_downlink",
 						"mac_state.pending_requests",
+						"mac_state.queued_force_rejoin_req",
 						"mac_state.queued_responses",
 						"mac_state.recent_downlinks",
 						"mac_state.rx_windows_available",
@@ -3858,6 +3876,7 @@ func TestProcessDownlinkTask(t *testing.T) {
 					env.Cluster.Auth,
 					scheduleDownlink124Ch,
 					scheduleDownlink3Ch,
+					getDevice.EndDeviceIdentifiers,
 					func() []byte {
 						b := []byte{
 							/* MHDR */
@@ -3940,6 +3959,7 @@ func TestProcessDownlinkTask(t *testing.T) {
 						"mac_state.last_network_initiated_downlink_at",
 						"mac_state.pending_application_downlink",
 						"mac_state.pending_requests",
+						"mac_state.queued_force_rejoin_req",
 						"mac_state.queued_responses",
 						"mac_state.recent_downlinks",
 						"mac_state.rx_windows_available",
@@ -4133,6 +4153,7 @@ func TestProcessDownlinkTask(t *testing.T) {
 					env.Cluster.Auth,
 					scheduleDownlink124Ch,
 					scheduleDownlink3Ch,
+					getDevice.EndDeviceIdentifiers,
 					func() []byte {
 						b := []byte{
 							/* MHDR */
@@ -4229,6 +4250,7 @@ func TestProcessDownlinkTask(t *testing.T) {
 					env.Cluster.Auth,
 					scheduleDownlink124Ch,
 					scheduleDownlink3Ch,
+					getDevice.EndDeviceIdentifiers,
 					func() []byte {
 						b := []byte{
 							/* MHDR */
@@ -4545,6 +4567,7 @@ func TestProcessDownlinkTask(t *testing.T) {
 					env.Cluster.Auth,
 					scheduleDownlink124Ch,
 					scheduleDownlink3Ch,
+					getDevice.EndDeviceIdentifiers,
 					func() []byte {
 						b := []byte{
 							/* MHDR */
@@ -4641,6 +4664,7 @@ func TestProcessDownlinkTask(t *testing.T) {
 					env.Cluster.Auth,
 					scheduleDownlink124Ch,
 					scheduleDownlink3Ch,
+					getDevice.EndDeviceIdentifiers,
 					func() []byte {
 						b := []byte{
 							/* MHDR */
@@ -5312,6 +5336,7 @@ func TestProcessDownlinkTask(t *testing.T) {
 					env.Cluster.Auth,
 					scheduleDownlink124Ch,
 					scheduleDownlink3Ch,
+					getDevice.EndDeviceIdentifiers,
 					bytes.Repeat([]byte{0x42}, 33),
 					func(paths ...*ttnpb.DownlinkPath) *ttnpb.TxRequest {
 						return &ttnpb.TxRequest{
@@ -6486,3 +6511,134 @@ func TestGenerateDownlink(t *testing.T) {
 		})
 	}
 }
+
+func TestDownlinkPathsFromMetadata(t *testing.T) {
+	for _, tc := range []struct {
+		Name          string
+		RxMetadata    []*ttnpb.RxMetadata
+		ExpectedPaths []downlinkPath
+	}{
+		{
+			Name: "no metadata",
+		},
+		{
+			Name: "no uplink token",
+			RxMetadata: []*ttnpb.RxMetadata{
+				{
+					GatewayIdentifiers: ttnpb.GatewayIdentifiers{GatewayID: "gateway-test-1"},
+					SNR:                5,
+				},
+			},
+		},
+		{
+			Name: "never constraint",
+			RxMetadata: []*ttnpb.RxMetadata{
+				{
+					GatewayIdentifiers:     ttnpb.GatewayIdentifiers{GatewayID: "gateway-test-1"},
+					SNR:                    5,
+					UplinkToken:            []byte("token-gtw-1"),
+					DownlinkPathConstraint: ttnpb.DOWNLINK_PATH_CONSTRAINT_NEVER,
+				},
+			},
+		},
+		{
+			Name: "sorted by SNR with prefer other constraint last",
+			RxMetadata: []*ttnpb.RxMetadata{
+				{
+					GatewayIdentifiers:     ttnpb.GatewayIdentifiers{GatewayID: "gateway-test-1"},
+					SNR:                    10,
+					UplinkToken:            []byte("token-gtw-1"),
+					DownlinkPathConstraint: ttnpb.DOWNLINK_PATH_CONSTRAINT_PREFER_OTHER,
+				},
+				{
+					GatewayIdentifiers: ttnpb.GatewayIdentifiers{GatewayID: "gateway-test-2"},
+					SNR:                -2,
+					UplinkToken:        []byte("token-gtw-2"),
+				},
+				{
+					GatewayIdentifiers: ttnpb.GatewayIdentifiers{GatewayID: "gateway-test-3"},
+					SNR:                5,
+					UplinkToken:        []byte("token-gtw-3"),
+				},
+			},
+			ExpectedPaths: []downlinkPath{
+				{
+					GatewayIdentifiers: ttnpb.GatewayIdentifiers{GatewayID: "gateway-test-3"},
+					DownlinkPath: &ttnpb.DownlinkPath{
+						Path: &ttnpb.DownlinkPath_UplinkToken{UplinkToken: []byte("token-gtw-3")},
+					},
+				},
+				{
+					GatewayIdentifiers: ttnpb.GatewayIdentifiers{GatewayID: "gateway-test-2"},
+					DownlinkPath: &ttnpb.DownlinkPath{
+						Path: &ttnpb.DownlinkPath_UplinkToken{UplinkToken: []byte("token-gtw-2")},
+					},
+				},
+				{
+					GatewayIdentifiers: ttnpb.GatewayIdentifiers{GatewayID: "gateway-test-1"},
+					DownlinkPath: &ttnpb.DownlinkPath{
+						Path: &ttnpb.DownlinkPath_UplinkToken{UplinkToken: []byte("token-gtw-1")},
+					},
+				},
+			},
+		},
+		{
+			Name: "strongest antenna per gateway",
+			RxMetadata: []*ttnpb.RxMetadata{
+				{
+					GatewayIdentifiers: ttnpb.GatewayIdentifiers{GatewayID: "gateway-test-1"},
+					AntennaIndex:       0,
+					RSSI:               -110,
+					SNR:                8,
+					UplinkToken:        []byte("token-gtw-1-ant-0"),
+				},
+				{
+					GatewayIdentifiers: ttnpb.GatewayIdentifiers{GatewayID: "gateway-test-2"},
+					RSSI:               -100,
+					SNR:                7,
+					UplinkToken:        []byte("token-gtw-2"),
+				},
+				{
+					GatewayIdentifiers: ttnpb.GatewayIdentifiers{GatewayID: "gateway-test-1"},
+					AntennaIndex:       1,
+					RSSI:               -60,
+					SNR:                9,
+					UplinkToken:        []byte("token-gtw-1-ant-1"),
+				},
+				{
+					GatewayIdentifiers: ttnpb.GatewayIdentifiers{GatewayID: "gateway-test-1"},
+					AntennaIndex:       2,
+					RSSI:               -90,
+					SNR:                9.5,
+					UplinkToken:        []byte("token-gtw-1-ant-2"),
+				},
+			},
+			ExpectedPaths: []downlinkPath{
+				{
+					GatewayIdentifiers: ttnpb.GatewayIdentifiers{GatewayID: "gateway-test-1"},
+					DownlinkPath: &ttnpb.DownlinkPath{
+						Path: &ttnpb.DownlinkPath_UplinkToken{UplinkToken: []byte("token-gtw-1-ant-1")},
+					},
+				},
+				{
+					GatewayIdentifiers: ttnpb.GatewayIdentifiers{GatewayID: "gateway-test-2"},
+					DownlinkPath: &ttnpb.DownlinkPath{
+						Path: &ttnpb.DownlinkPath_UplinkToken{UplinkToken: []byte("token-gtw-2")},
+					},
+				},
+			},
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+			mds := deepcopy.Copy(tc.RxMetadata).([]*ttnpb.RxMetadata)
+			paths := downlinkPathsFromMetadata(mds...)
+			if len(tc.ExpectedPaths) == 0 {
+				a.So(paths, should.BeEmpty)
+			} else {
+				a.So(paths, should.Resemble, tc.ExpectedPaths)
+			}
+			a.So(mds, should.Resemble, tc.RxMetadata)
+		})
+	}
+}
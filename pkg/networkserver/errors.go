@@ -20,6 +20,7 @@ import (
 
 var (
 	errABPJoinRequest             = errors.DefineInvalidArgument("abp_join_request", "received a join-request from ABP device")
+	errABPRejoinRequest           = errors.DefineInvalidArgument("abp_rejoin_request", "received a rejoin-request from ABP device")
 	errApplicationDownlinkTooLong = errors.DefineInvalidArgument("application_downlink_too_long", "application downlink payload length '{length}' exceeds maximum '{max}'")
 	errClassAMulticast            = errors.DefineInvalidArgument("class_a_multicast", "multicast device in class A mode")
 	errClassBCForClassA           = errors.DefineInvalidArgument("class_b_c_for_class_a", "class B/C downlink queued for device in class A mode")
@@ -45,6 +46,7 @@ var (
 	errInvalidFixedPaths          = errors.DefineInvalidArgument("fixed_paths", "invalid fixed paths set in application downlink")
 	errInvalidPayload             = errors.DefineInvalidArgument("payload", "invalid payload")
 	errJoinServerNotFound         = errors.DefineNotFound("join_server_not_found", "Join Server not found")
+	errNetIDMismatch              = errors.DefineInvalidArgument("net_id_mismatch", "NetID `{net_id}` does not match")
 	errMACRequestNotFound         = errors.DefineInvalidArgument("mac_request_not_found", "MAC response received, but corresponding request not found")
 	errNoDevEUI                   = errors.DefineInvalidArgument("no_dev_eui", "no DevEUI specified")
 	errNoJoinEUI                  = errors.DefineInvalidArgument("no_join_eui", "no JoinEUI specified")
@@ -52,7 +54,9 @@ var (
 	errNoPayload                  = errors.DefineInvalidArgument("no_payload", "no message payload specified")
 	errOutdatedData               = errors.DefineNotFound("outdated_data", "data is outdated")
 	errRawPayloadTooShort         = errors.Define("raw_payload_too_short", "length of RawPayload must not be less than 4")
+	errRJCount0TooSmall           = errors.DefineInvalidArgument("rj_count_0_too_small", "RJcount0 `{rj_count_0}` is too small")
 	errSchedule                   = errors.Define("schedule", "all downlink scheduling attempts failed")
+	errTransmissionFailed         = errors.DefineAborted("transmission_failed", "downlink transmission failed with result `{result}`")
 	errUnknownChannel             = errors.Define("unknown_chanel", "channel is unknown")
 	errUnknownMACState            = errors.DefineFailedPrecondition("unknown_mac_state", "MAC state is unknown")
 	errUnknownNwkSEncKey          = errors.DefineNotFound("unknown_nwk_s_enc_key", "NwkSEncKey is unknown")
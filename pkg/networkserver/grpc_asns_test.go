@@ -365,6 +365,7 @@ func TestDownlinkQueueReplace(t *testing.T) {
 					"multicast",
 					"pending_mac_state",
 					"pending_session",
+					"profile_ids",
 					"queued_application_downlinks",
 					"recent_uplinks",
 					"session",
@@ -428,6 +429,7 @@ func TestDownlinkQueueReplace(t *testing.T) {
 					"multicast",
 					"pending_mac_state",
 					"pending_session",
+					"profile_ids",
 					"queued_application_downlinks",
 					"recent_uplinks",
 					"session",
@@ -510,6 +512,7 @@ func TestDownlinkQueueReplace(t *testing.T) {
 					"multicast",
 					"pending_mac_state",
 					"pending_session",
+					"profile_ids",
 					"queued_application_downlinks",
 					"recent_uplinks",
 					"session",
@@ -600,6 +603,7 @@ func TestDownlinkQueueReplace(t *testing.T) {
 					"multicast",
 					"pending_mac_state",
 					"pending_session",
+					"profile_ids",
 					"queued_application_downlinks",
 					"recent_uplinks",
 					"session",
@@ -682,6 +686,7 @@ func TestDownlinkQueueReplace(t *testing.T) {
 					"multicast",
 					"pending_mac_state",
 					"pending_session",
+					"profile_ids",
 					"queued_application_downlinks",
 					"session",
 				})
@@ -747,6 +752,7 @@ func TestDownlinkQueueReplace(t *testing.T) {
 					"multicast",
 					"pending_mac_state",
 					"pending_session",
+					"profile_ids",
 					"queued_application_downlinks",
 					"session",
 				})
@@ -818,6 +824,7 @@ func TestDownlinkQueueReplace(t *testing.T) {
 					"multicast",
 					"pending_mac_state",
 					"pending_session",
+					"profile_ids",
 					"queued_application_downlinks",
 					"session",
 				})
@@ -999,6 +1006,7 @@ func TestDownlinkQueuePush(t *testing.T) {
 					"multicast",
 					"pending_mac_state",
 					"pending_session",
+					"profile_ids",
 					"queued_application_downlinks",
 					"recent_uplinks",
 					"session",
@@ -1063,6 +1071,7 @@ func TestDownlinkQueuePush(t *testing.T) {
 					"multicast",
 					"pending_mac_state",
 					"pending_session",
+					"profile_ids",
 					"queued_application_downlinks",
 					"recent_uplinks",
 					"session",
@@ -1145,6 +1154,7 @@ func TestDownlinkQueuePush(t *testing.T) {
 					"multicast",
 					"pending_mac_state",
 					"pending_session",
+					"profile_ids",
 					"queued_application_downlinks",
 					"recent_uplinks",
 					"session",
@@ -1234,6 +1244,7 @@ func TestDownlinkQueuePush(t *testing.T) {
 					"multicast",
 					"pending_mac_state",
 					"pending_session",
+					"profile_ids",
 					"queued_application_downlinks",
 					"recent_uplinks",
 					"session",
@@ -1323,6 +1334,7 @@ func TestDownlinkQueuePush(t *testing.T) {
 					"multicast",
 					"pending_mac_state",
 					"pending_session",
+					"profile_ids",
 					"queued_application_downlinks",
 					"recent_uplinks",
 					"session",
@@ -1404,6 +1416,7 @@ func TestDownlinkQueuePush(t *testing.T) {
 					"multicast",
 					"pending_mac_state",
 					"pending_session",
+					"profile_ids",
 					"queued_application_downlinks",
 					"recent_uplinks",
 					"session",
@@ -1481,6 +1494,7 @@ func TestDownlinkQueuePush(t *testing.T) {
 					"multicast",
 					"pending_mac_state",
 					"pending_session",
+					"profile_ids",
 					"queued_application_downlinks",
 					"recent_uplinks",
 					"session",
@@ -21,6 +21,7 @@ import (
 	pbtypes "github.com/gogo/protobuf/types"
 	"go.thethings.network/lorawan-stack/pkg/auth/rights"
 	"go.thethings.network/lorawan-stack/pkg/crypto/cryptoutil"
+	"go.thethings.network/lorawan-stack/pkg/devicebatch"
 	"go.thethings.network/lorawan-stack/pkg/encoding/lorawan"
 	"go.thethings.network/lorawan-stack/pkg/errors"
 	"go.thethings.network/lorawan-stack/pkg/events"
@@ -45,7 +46,7 @@ var (
 
 // Get implements NsEndDeviceRegistryServer.
 func (ns *NetworkServer) Get(ctx context.Context, req *ttnpb.GetEndDeviceRequest) (*ttnpb.EndDevice, error) {
-	if err := rights.RequireApplication(ctx, req.ApplicationIdentifiers, ttnpb.RIGHT_APPLICATION_DEVICES_READ); err != nil {
+	if err := rights.RequireEndDevice(ctx, req.EndDeviceIdentifiers, ttnpb.RIGHT_APPLICATION_DEVICES_READ); err != nil {
 		return nil, err
 	}
 	if ttnpb.HasAnyField(req.FieldMask.Paths, "queued_application_downlinks") {
@@ -282,7 +283,7 @@ func (ns *NetworkServer) Set(ctx context.Context, req *ttnpb.SetEndDeviceRequest
 		return nil, errInvalidFieldValue.WithAttributes("field", "supports_join")
 	}
 
-	if err = rights.RequireApplication(ctx, req.EndDevice.ApplicationIdentifiers, ttnpb.RIGHT_APPLICATION_DEVICES_WRITE); err != nil {
+	if err = rights.RequireEndDevice(ctx, req.EndDevice.EndDeviceIdentifiers, ttnpb.RIGHT_APPLICATION_DEVICES_WRITE); err != nil {
 		return nil, err
 	}
 	if ttnpb.HasAnyField(req.FieldMask.Paths,
@@ -375,6 +376,20 @@ func (ns *NetworkServer) Set(ctx context.Context, req *ttnpb.SetEndDeviceRequest
 		)
 	}
 
+	var profile *ttnpb.EndDeviceProfile
+	if !ttnpb.HasAnyField(sets, "profile_ids") {
+		req.EndDevice.ProfileIDs = nil
+	} else if req.EndDevice.ProfileIDs != nil {
+		profile, err = ns.getEndDeviceProfile(ctx, req.EndDevice.ApplicationIdentifiers, *req.EndDevice.ProfileIDs, []string{
+			"frequency_plan_id",
+			"lorawan_phy_version",
+			"lorawan_version",
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	gets := append(req.FieldMask.Paths[:0:0], req.FieldMask.Paths...)
 	var needsDownlinkCheck bool
 	if ttnpb.HasAnyField([]string{
@@ -382,6 +397,7 @@ func (ns *NetworkServer) Set(ctx context.Context, req *ttnpb.SetEndDeviceRequest
 		"lorawan_phy_version",
 		"mac_settings",
 		"mac_state",
+		"profile_ids",
 		"session",
 	}, req.FieldMask.Paths...) {
 		gets = ttnpb.AddFields(gets,
@@ -391,6 +407,7 @@ func (ns *NetworkServer) Set(ctx context.Context, req *ttnpb.SetEndDeviceRequest
 			"mac_settings",
 			"mac_state",
 			"multicast",
+			"profile_ids",
 			"queued_application_downlinks",
 			"recent_uplinks",
 			"session.dev_addr",
@@ -416,6 +433,16 @@ func (ns *NetworkServer) Set(ctx context.Context, req *ttnpb.SetEndDeviceRequest
 			); err != nil {
 				return nil, nil, errInvalidFieldMask.WithCause(err)
 			}
+			if ttnpb.HasAnyField(sets,
+				"mac_state.queued_force_rejoin_req",
+				"mac_state.queued_force_rejoin_req.data_rate_index",
+				"mac_state.queued_force_rejoin_req.max_retries",
+				"mac_state.queued_force_rejoin_req.period_exponent",
+				"mac_state.queued_force_rejoin_req.rejoin_type",
+			) && req.EndDevice.GetMACState().GetQueuedForceRejoinReq() != nil &&
+				(dev.MACState == nil || dev.MACState.LoRaWANVersion.Compare(ttnpb.MAC_V1_1) < 0) {
+				return nil, nil, errInvalidFieldValue.WithAttributes("field", "mac_state.queued_force_rejoin_req")
+			}
 			if ttnpb.HasAnyField(sets, "session.dev_addr") {
 				req.EndDevice.DevAddr = &req.EndDevice.Session.DevAddr
 				sets = append(sets, "ids.dev_addr")
@@ -439,6 +466,9 @@ func (ns *NetworkServer) Set(ctx context.Context, req *ttnpb.SetEndDeviceRequest
 		}
 
 		evt = evtCreateEndDevice(ctx, req.EndDevice.EndDeviceIdentifiers, nil)
+		if profile != nil {
+			sets = applyEndDeviceProfile(&req.EndDevice, sets, profile)
+		}
 		if err := ttnpb.RequireFields(sets,
 			"frequency_plan_id",
 			"lorawan_phy_version",
@@ -452,16 +482,17 @@ func (ns *NetworkServer) Set(ctx context.Context, req *ttnpb.SetEndDeviceRequest
 		if err != nil {
 			return nil, nil, err
 		}
+		defaults := ns.endDeviceDefaultMACSettings(ctx, &req.EndDevice)
 
 		if ttnpb.HasAnyField(sets, "supports_class_b") && req.EndDevice.SupportsClassB {
-			if ns.defaultMACSettings.PingSlotFrequency == nil && phy.PingSlotFrequency == nil {
+			if defaults.PingSlotFrequency == nil && phy.PingSlotFrequency == nil {
 				if err := ttnpb.RequireFields(sets,
 					"mac_settings.ping_slot_frequency.value",
 				); err != nil {
 					return nil, nil, errInvalidFieldMask.WithCause(err)
 				}
 			}
-			if ns.defaultMACSettings.PingSlotPeriodicity == nil && ttnpb.HasAnyField(req.FieldMask.Paths, "multicast") && req.EndDevice.Multicast {
+			if defaults.PingSlotPeriodicity == nil && ttnpb.HasAnyField(req.FieldMask.Paths, "multicast") && req.EndDevice.Multicast {
 				if err := ttnpb.RequireFields(sets,
 					"mac_settings.ping_slot_periodicity.value",
 				); err != nil {
@@ -549,7 +580,7 @@ func (ns *NetworkServer) Set(ctx context.Context, req *ttnpb.SetEndDeviceRequest
 			sets = append(sets, "session.started_at")
 		}
 
-		macState, err := newMACState(&req.EndDevice, ns.FrequencyPlans, ns.defaultMACSettings)
+		macState, err := newMACState(&req.EndDevice, ns.FrequencyPlans, defaults)
 		if err != nil {
 			return nil, nil, err
 		}
@@ -577,7 +608,7 @@ func (ns *NetworkServer) Set(ctx context.Context, req *ttnpb.SetEndDeviceRequest
 
 // Delete implements NsEndDeviceRegistryServer.
 func (ns *NetworkServer) Delete(ctx context.Context, req *ttnpb.EndDeviceIdentifiers) (*pbtypes.Empty, error) {
-	if err := rights.RequireApplication(ctx, req.ApplicationIdentifiers, ttnpb.RIGHT_APPLICATION_DEVICES_WRITE); err != nil {
+	if err := rights.RequireEndDevice(ctx, *req, ttnpb.RIGHT_APPLICATION_DEVICES_WRITE); err != nil {
 		return nil, err
 	}
 	var evt events.Event
@@ -595,3 +626,18 @@ func (ns *NetworkServer) Delete(ctx context.Context, req *ttnpb.EndDeviceIdentif
 	}
 	return ttnpb.Empty, err
 }
+
+// BatchGet implements NsEndDeviceRegistryServer.
+func (ns *NetworkServer) BatchGet(ctx context.Context, req *ttnpb.BatchGetEndDevicesRequest) (*ttnpb.BatchEndDevicesResponse, error) {
+	return devicebatch.Get(ctx, req, "/ttn.lorawan.v3.NsEndDeviceRegistry/Get", ns.Get)
+}
+
+// BatchSet implements NsEndDeviceRegistryServer.
+func (ns *NetworkServer) BatchSet(ctx context.Context, req *ttnpb.BatchSetEndDevicesRequest) (*ttnpb.BatchEndDevicesResponse, error) {
+	return devicebatch.Set(ctx, req, "/ttn.lorawan.v3.NsEndDeviceRegistry/Set", ns.Set)
+}
+
+// BatchDelete implements NsEndDeviceRegistryServer.
+func (ns *NetworkServer) BatchDelete(ctx context.Context, req *ttnpb.BatchDeleteEndDevicesRequest) (*ttnpb.BatchEndDevicesResponse, error) {
+	return devicebatch.Delete(ctx, req, "/ttn.lorawan.v3.NsEndDeviceRegistry/Delete", ns.Delete)
+}
@@ -561,6 +561,7 @@ func TestDeviceRegistrySet(t *testing.T) {
 					"mac_settings.adr_margin",
 					"mac_state",
 					"multicast",
+					"profile_ids",
 					"queued_application_downlinks",
 					"recent_uplinks",
 					"session.dev_addr",
@@ -645,6 +646,7 @@ func TestDeviceRegistrySet(t *testing.T) {
 					"mac_settings.adr_margin",
 					"mac_state",
 					"multicast",
+					"profile_ids",
 					"queued_application_downlinks",
 					"recent_uplinks",
 					"session.dev_addr",
@@ -770,6 +772,7 @@ func TestDeviceRegistrySet(t *testing.T) {
 					"mac_settings.use_adr",
 					"mac_state",
 					"multicast",
+					"profile_ids",
 					"queued_application_downlinks",
 					"recent_uplinks",
 					"session.dev_addr",
@@ -962,6 +965,7 @@ func TestDeviceRegistrySet(t *testing.T) {
 					"mac_settings.use_adr",
 					"mac_state",
 					"multicast",
+					"profile_ids",
 					"queued_application_downlinks",
 					"recent_uplinks",
 					"session.dev_addr",
@@ -1144,6 +1148,7 @@ func TestDeviceRegistrySet(t *testing.T) {
 					"mac_settings.use_adr",
 					"mac_state",
 					"multicast",
+					"profile_ids",
 					"queued_application_downlinks",
 					"recent_uplinks",
 					"session.dev_addr",
@@ -1329,6 +1334,7 @@ func TestDeviceRegistrySet(t *testing.T) {
 					"mac_settings",
 					"mac_state",
 					"multicast",
+					"profile_ids",
 					"queued_application_downlinks",
 					"recent_uplinks",
 					"session.dev_addr",
@@ -1402,6 +1408,7 @@ func TestDeviceRegistrySet(t *testing.T) {
 					"mac_state",
 					"mac_state.desired_parameters.rx2_frequency",
 					"multicast",
+					"profile_ids",
 					"queued_application_downlinks",
 					"recent_uplinks",
 					"session.dev_addr",
@@ -1708,3 +1715,155 @@ func TestDeviceRegistryDelete(t *testing.T) {
 		})
 	}
 }
+
+func TestDeviceRegistryBatch(t *testing.T) {
+	a := assertions.New(t)
+
+	appIDs := ttnpb.ApplicationIdentifiers{ApplicationID: "test-app-id"}
+	otherAppIDs := ttnpb.ApplicationIdentifiers{ApplicationID: "other-app-id"}
+	errTestNotFound := errors.DefineNotFound("batch_test_not_found", "device not found")
+
+	var getByIDCalls, setByIDCalls uint64
+	ns := test.Must(New(
+		componenttest.NewComponent(t, &component.Config{}),
+		&Config{
+			Devices: &MockDeviceRegistry{
+				GetByIDFunc: func(ctx context.Context, appID ttnpb.ApplicationIdentifiers, devID string, gets []string) (*ttnpb.EndDevice, context.Context, error) {
+					atomic.AddUint64(&getByIDCalls, 1)
+					if devID == "not-found-dev-id" {
+						return nil, ctx, errTestNotFound
+					}
+					return &ttnpb.EndDevice{
+						EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+							DeviceID:               devID,
+							ApplicationIdentifiers: appID,
+						},
+						FrequencyPlanID: test.EUFrequencyPlanID,
+					}, ctx, nil
+				},
+				SetByIDFunc: func(ctx context.Context, appID ttnpb.ApplicationIdentifiers, devID string, gets []string, f func(context.Context, *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error)) (*ttnpb.EndDevice, context.Context, error) {
+					atomic.AddUint64(&setByIDCalls, 1)
+					dev, _, err := f(ctx, &ttnpb.EndDevice{
+						EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+							DeviceID:               devID,
+							ApplicationIdentifiers: appID,
+						},
+					})
+					return dev, ctx, err
+				},
+			},
+			DownlinkTasks: &MockDownlinkTaskQueue{
+				PopFunc: DownlinkTaskPopBlockFunc,
+			},
+			DeduplicationWindow: 42,
+			CooldownWindow:      42,
+		})).(*NetworkServer)
+
+	ns.AddContextFiller(func(ctx context.Context) context.Context {
+		return rights.NewContext(ctx, rights.Rights{
+			ApplicationRights: map[string]*ttnpb.Rights{
+				unique.ID(test.Context(), appIDs): {
+					Rights: []ttnpb.Right{
+						ttnpb.RIGHT_APPLICATION_DEVICES_READ,
+						ttnpb.RIGHT_APPLICATION_DEVICES_WRITE,
+					},
+				},
+			},
+		})
+	})
+	ns.AddContextFiller(func(ctx context.Context) context.Context {
+		ctx, cancel := context.WithDeadline(ctx, time.Now().Add(Timeout))
+		_ = cancel
+		return ctx
+	})
+	ns.AddContextFiller(func(ctx context.Context) context.Context {
+		return test.ContextWithT(ctx, t)
+	})
+	componenttest.StartComponent(t, ns.Component)
+	defer ns.Close()
+
+	cl := ttnpb.NewNsEndDeviceRegistryClient(ns.LoopbackConn())
+
+	getRequest := func(appIDs ttnpb.ApplicationIdentifiers, devID string, paths ...string) *ttnpb.GetEndDeviceRequest {
+		return &ttnpb.GetEndDeviceRequest{
+			EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+				DeviceID:               devID,
+				ApplicationIdentifiers: appIDs,
+			},
+			FieldMask: pbtypes.FieldMask{Paths: paths},
+		}
+	}
+
+	res, err := cl.BatchGet(test.Context(), &ttnpb.BatchGetEndDevicesRequest{
+		ApplicationIdentifiers: appIDs,
+		Requests: []*ttnpb.GetEndDeviceRequest{
+			getRequest(appIDs, "test-dev-id", "frequency_plan_id"),
+			getRequest(appIDs, "test-dev-id", "pending_session.keys.f_nwk_s_int_key.key"),
+			getRequest(appIDs, "not-found-dev-id", "frequency_plan_id"),
+			getRequest(otherAppIDs, "test-dev-id", "frequency_plan_id"),
+		},
+	})
+	if !a.So(err, should.BeNil) || !a.So(res.Results, should.HaveLength, 4) {
+		t.FailNow()
+	}
+	a.So(res.Results[0].Error, should.BeNil)
+	a.So(res.Results[0].EndDevice, should.Resemble, &ttnpb.EndDevice{
+		EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+			DeviceID:               "test-dev-id",
+			ApplicationIdentifiers: appIDs,
+		},
+		FrequencyPlanID: test.EUFrequencyPlanID,
+	})
+	for _, res := range res.Results[1:] {
+		a.So(res.EndDevice, should.BeNil)
+		a.So(res.Error, should.NotBeNil)
+	}
+	a.So(errors.IsPermissionDenied(ttnpb.ErrorDetailsFromProto(res.Results[1].Error)), should.BeTrue)
+	a.So(errors.IsNotFound(ttnpb.ErrorDetailsFromProto(res.Results[2].Error)), should.BeTrue)
+	a.So(errors.IsInvalidArgument(ttnpb.ErrorDetailsFromProto(res.Results[3].Error)), should.BeTrue)
+	a.So(getByIDCalls, should.Equal, uint64(2))
+
+	reqs := make([]*ttnpb.GetEndDeviceRequest, 101)
+	for i := range reqs {
+		reqs[i] = getRequest(appIDs, fmt.Sprintf("test-dev-%d", i), "frequency_plan_id")
+	}
+	res, err = cl.BatchGet(test.Context(), &ttnpb.BatchGetEndDevicesRequest{
+		ApplicationIdentifiers: appIDs,
+		Requests:               reqs,
+	})
+	if a.So(err, should.NotBeNil) {
+		a.So(errors.IsInvalidArgument(err), should.BeTrue)
+	}
+	a.So(res, should.BeNil)
+	a.So(getByIDCalls, should.Equal, uint64(2))
+
+	res, err = cl.BatchDelete(test.Context(), &ttnpb.BatchDeleteEndDevicesRequest{
+		ApplicationIdentifiers: otherAppIDs,
+		EndDeviceIDs: []*ttnpb.EndDeviceIdentifiers{
+			{DeviceID: "test-dev-id", ApplicationIdentifiers: otherAppIDs},
+			{DeviceID: "test-dev-id", ApplicationIdentifiers: appIDs},
+		},
+	})
+	if !a.So(err, should.BeNil) || !a.So(res.Results, should.HaveLength, 2) {
+		t.FailNow()
+	}
+	a.So(errors.IsPermissionDenied(ttnpb.ErrorDetailsFromProto(res.Results[0].Error)), should.BeTrue)
+	a.So(errors.IsInvalidArgument(ttnpb.ErrorDetailsFromProto(res.Results[1].Error)), should.BeTrue)
+	a.So(setByIDCalls, should.Equal, uint64(0))
+
+	res, err = cl.BatchDelete(test.Context(), &ttnpb.BatchDeleteEndDevicesRequest{
+		ApplicationIdentifiers: appIDs,
+		EndDeviceIDs: []*ttnpb.EndDeviceIdentifiers{
+			{DeviceID: "test-dev-id", ApplicationIdentifiers: appIDs},
+			{DeviceID: "other-dev-id", ApplicationIdentifiers: appIDs},
+		},
+	})
+	if !a.So(err, should.BeNil) || !a.So(res.Results, should.HaveLength, 2) {
+		t.FailNow()
+	}
+	for _, res := range res.Results {
+		a.So(res.Error, should.BeNil)
+		a.So(res.EndDevice, should.BeNil)
+	}
+	a.So(setByIDCalls, should.Equal, uint64(2))
+}
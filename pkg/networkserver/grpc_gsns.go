@@ -36,8 +36,6 @@ import (
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
 	"go.thethings.network/lorawan-stack/pkg/types"
 	"go.thethings.network/lorawan-stack/pkg/unique"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
 )
 
 const (
@@ -152,6 +150,7 @@ type matchedDevice struct {
 	Context                  context.Context
 	ChannelIndex             uint8
 	DataRateIndex            ttnpb.DataRateIndex
+	DefaultMACSettings       ttnpb.MACSettings
 	DeferredMACHandlers      []macHandler
 	Device                   *ttnpb.EndDevice
 	FCnt                     uint32
@@ -205,6 +204,7 @@ func (ns *NetworkServer) matchAndHandleDataUplink(up *ttnpb.UplinkMessage, dedup
 			logger.WithError(err).Warn("Failed to get device's versioned band, skip")
 			continue
 		}
+		defaults := ns.endDeviceDefaultMACSettings(ctx, dev.EndDevice)
 
 		drIdx, err := searchDataRate(up.Settings.DataRate, dev.EndDevice, ns.FrequencyPlans)
 		if err != nil {
@@ -234,13 +234,14 @@ func (ns *NetworkServer) matchAndHandleDataUplink(up *ttnpb.UplinkMessage, dedup
 
 			matches = append(matches, device{
 				matchedDevice: matchedDevice{
-					phy:           phy,
-					Context:       ctx,
-					DataRateIndex: drIdx,
-					Device:        pendingDev,
-					FCnt:          pld.FCnt,
-					NbTrans:       1,
-					Pending:       true,
+					DefaultMACSettings: defaults,
+					phy:                phy,
+					Context:            ctx,
+					DataRateIndex:      drIdx,
+					Device:             pendingDev,
+					FCnt:               pld.FCnt,
+					NbTrans:            1,
+					Pending:            true,
 				},
 				gap:                        pld.FCnt,
 				pendingApplicationDownlink: pendingApplicationDownlink,
@@ -258,8 +259,8 @@ func (ns *NetworkServer) matchAndHandleDataUplink(up *ttnpb.UplinkMessage, dedup
 		supports32BitFCnt := true
 		if dev.GetMACSettings().GetSupports32BitFCnt() != nil {
 			supports32BitFCnt = dev.MACSettings.Supports32BitFCnt.Value
-		} else if ns.defaultMACSettings.GetSupports32BitFCnt() != nil {
-			supports32BitFCnt = ns.defaultMACSettings.Supports32BitFCnt.Value
+		} else if defaults.GetSupports32BitFCnt() != nil {
+			supports32BitFCnt = defaults.Supports32BitFCnt.Value
 		}
 
 		fCnt := pld.FCnt
@@ -315,12 +316,13 @@ func (ns *NetworkServer) matchAndHandleDataUplink(up *ttnpb.UplinkMessage, dedup
 			}
 			matches = append(matches, device{
 				matchedDevice: matchedDevice{
-					phy:           phy,
-					Context:       ctx,
-					DataRateIndex: drIdx,
-					Device:        dev.EndDevice,
-					FCnt:          dev.Session.LastFCntUp,
-					NbTrans:       nbTrans,
+					DefaultMACSettings: defaults,
+					phy:                phy,
+					Context:            ctx,
+					DataRateIndex:      drIdx,
+					Device:             dev.EndDevice,
+					FCnt:               dev.Session.LastFCntUp,
+					NbTrans:            nbTrans,
 				},
 				pendingApplicationDownlink: pendingApplicationDownlink,
 			})
@@ -328,12 +330,12 @@ func (ns *NetworkServer) matchAndHandleDataUplink(up *ttnpb.UplinkMessage, dedup
 		}
 
 		if fCnt < dev.Session.LastFCntUp {
-			if !resetsFCnt(dev.EndDevice, ns.defaultMACSettings) {
+			if !resetsFCnt(dev.EndDevice, defaults) {
 				logger.Debug("FCnt too low, skip")
 				continue
 			}
 
-			macState, err := newMACState(dev.EndDevice, ns.FrequencyPlans, ns.defaultMACSettings)
+			macState, err := newMACState(dev.EndDevice, ns.FrequencyPlans, defaults)
 			if err != nil {
 				logger.WithError(err).Warn("Failed to generate new MAC state")
 				continue
@@ -346,7 +348,8 @@ func (ns *NetworkServer) matchAndHandleDataUplink(up *ttnpb.UplinkMessage, dedup
 			gap := fCntResetGap(dev.Session.LastFCntUp, pld.FCnt)
 			matches = append(matches, device{
 				matchedDevice: matchedDevice{
-					phy: phy,
+					DefaultMACSettings: defaults,
+					phy:                phy,
 					Context: log.NewContextWithFields(ctx, log.Fields(
 						"f_cnt_gap", gap,
 						"f_cnt_reset", true,
@@ -368,8 +371,8 @@ func (ns *NetworkServer) matchAndHandleDataUplink(up *ttnpb.UplinkMessage, dedup
 		logger = logger.WithField("transmission", 1)
 		ctx = log.NewContext(ctx, logger)
 
-		if fCnt != pld.FCnt && resetsFCnt(dev.EndDevice, ns.defaultMACSettings) {
-			macState, err := newMACState(dev.EndDevice, ns.FrequencyPlans, ns.defaultMACSettings)
+		if fCnt != pld.FCnt && resetsFCnt(dev.EndDevice, defaults) {
+			macState, err := newMACState(dev.EndDevice, ns.FrequencyPlans, defaults)
 			if err != nil {
 				logger.WithError(err).Warn("Failed to generate new MAC state")
 				continue
@@ -381,7 +384,8 @@ func (ns *NetworkServer) matchAndHandleDataUplink(up *ttnpb.UplinkMessage, dedup
 				gap := fCntResetGap(dev.Session.LastFCntUp, pld.FCnt)
 				matches = append(matches, device{
 					matchedDevice: matchedDevice{
-						phy: phy,
+						DefaultMACSettings: defaults,
+						phy:                phy,
 						Context: log.NewContextWithFields(ctx, log.Fields(
 							"f_cnt_gap", gap,
 							"f_cnt_reset", true,
@@ -417,12 +421,13 @@ func (ns *NetworkServer) matchAndHandleDataUplink(up *ttnpb.UplinkMessage, dedup
 		}
 		matches = append(matches, device{
 			matchedDevice: matchedDevice{
-				phy:           phy,
-				Context:       ctx,
-				DataRateIndex: drIdx,
-				Device:        dev.EndDevice,
-				FCnt:          fCnt,
-				NbTrans:       1,
+				DefaultMACSettings: defaults,
+				phy:                phy,
+				Context:            ctx,
+				DataRateIndex:      drIdx,
+				Device:             dev.EndDevice,
+				FCnt:               fCnt,
+				NbTrans:            1,
 			},
 			gap:                        gap,
 			pendingApplicationDownlink: pendingApplicationDownlink,
@@ -590,7 +595,7 @@ matchLoop:
 			var err error
 			switch cmd.CID {
 			case ttnpb.CID_RESET:
-				evs, err = handleResetInd(ctx, match.Device, cmd.GetResetInd(), ns.FrequencyPlans, ns.defaultMACSettings)
+				evs, err = handleResetInd(ctx, match.Device, cmd.GetResetInd(), ns.FrequencyPlans, match.DefaultMACSettings)
 			case ttnpb.CID_LINK_CHECK:
 				if !deduplicated {
 					match.deferMACHandler(handleLinkCheckReq)
@@ -689,9 +694,30 @@ matchLoop:
 			}
 			match.SetPaths = append(match.SetPaths, "ids.dev_addr")
 		} else if match.Device.PendingSession != nil || match.Device.PendingMACState != nil {
-			// TODO: Notify AS of session recovery(https://github.com/TheThingsNetwork/lorawan-stack/issues/594)
+			// The device kept using the current session, so the pending session is discarded.
+			// The Application Server may have switched to the pending session already, so it is notified of the session
+			// the device uses.
+			logger.Debug("Recover session")
 			match.Device.PendingMACState = nil
 			match.Device.PendingSession = nil
+			match.QueuedApplicationUplinks = append(match.QueuedApplicationUplinks, &ttnpb.ApplicationUp{
+				EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+					DevAddr:                &pld.DevAddr,
+					JoinEUI:                match.Device.JoinEUI,
+					DevEUI:                 match.Device.DevEUI,
+					ApplicationIdentifiers: match.Device.ApplicationIdentifiers,
+					DeviceID:               match.Device.DeviceID,
+				},
+				CorrelationIDs: up.CorrelationIDs,
+				Up: &ttnpb.ApplicationUp_SessionSwitch{
+					SessionSwitch: &ttnpb.ApplicationSessionSwitch{
+						SessionKeyID: match.Device.Session.SessionKeyID,
+						DevAddr:      pld.DevAddr,
+						SwitchedAt:   up.ReceivedAt,
+					},
+				},
+			})
+			match.QueuedEvents = append(match.QueuedEvents, evtRecoverSession.BindData(nil))
 		}
 
 		chIdx, err := searchUplinkChannel(up.Settings.Frequency, match.Device.MACState)
@@ -808,6 +834,7 @@ var handleDataUplinkGetPaths = [...]string{
 	"multicast",
 	"pending_mac_state",
 	"pending_session",
+	"profile_ids",
 	"queued_application_downlinks",
 	"recent_downlinks",
 	"recent_uplinks",
@@ -886,8 +913,11 @@ func (ns *NetworkServer) handleDataUplink(ctx context.Context, up *ttnpb.UplinkM
 	}
 
 	var handleErr bool
-	stored, ctx, err := ns.devices.SetByID(ctx, matched.Device.ApplicationIdentifiers, matched.Device.DeviceID, handleDataUplinkGetPaths[:],
+	queuedEventCount, queuedApplicationUplinkCount := len(queuedEvents), len(queuedApplicationUplinks)
+	stored, ctx, err := setDeviceByIDOnUplink(ctx, ns.devices, up, matched.Device.ApplicationIdentifiers, matched.Device.DeviceID, handleDataUplinkGetPaths[:],
 		func(ctx context.Context, stored *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error) {
+			// The transaction may be retried, so discard anything queued by a previous attempt.
+			queuedEvents, queuedApplicationUplinks = queuedEvents[:queuedEventCount], queuedApplicationUplinks[:queuedApplicationUplinkCount]
 			if stored == nil {
 				logger.Warn("Device deleted during uplink handling, drop")
 				handleErr = true
@@ -926,10 +956,10 @@ func (ns *NetworkServer) handleDataUplink(ctx context.Context, up *ttnpb.UplinkM
 			}
 			stored.RecentADRUplinks = appendRecentUplink(stored.RecentADRUplinks, up, optimalADRUplinkCount)
 
-			if !deviceUseADR(stored, ns.defaultMACSettings) {
+			if !deviceUseADR(stored, matched.DefaultMACSettings) {
 				return stored, paths, nil
 			}
-			if err := adaptDataRate(stored, matched.phy, ns.defaultMACSettings); err != nil {
+			if err := adaptDataRate(stored, matched.phy, matched.DefaultMACSettings); err != nil {
 				handleErr = true
 				return nil, nil, err
 			}
@@ -937,7 +967,6 @@ func (ns *NetworkServer) handleDataUplink(ctx context.Context, up *ttnpb.UplinkM
 		})
 	if err != nil && !handleErr {
 		logger.WithError(err).Warn("Failed to update device in registry")
-		// TODO: Retry transaction. (https://github.com/TheThingsNetwork/lorawan-stack/issues/33)
 	}
 	if err != nil {
 		events.Publish(evtDropDataUplink(ctx, matched.Device.EndDeviceIdentifiers, err))
@@ -1033,6 +1062,7 @@ func (ns *NetworkServer) handleJoinRequest(ctx context.Context, up *ttnpb.Uplink
 			"lorawan_phy_version",
 			"lorawan_version",
 			"mac_settings",
+			"profile_ids",
 			"session",
 			"supports_class_b",
 			"supports_class_c",
@@ -1068,7 +1098,7 @@ func (ns *NetworkServer) handleJoinRequest(ctx context.Context, up *ttnpb.Uplink
 	logger = logger.WithField("dev_addr", devAddr)
 	ctx = log.NewContext(ctx, logger)
 
-	macState, err := newMACState(dev, ns.FrequencyPlans, ns.defaultMACSettings)
+	macState, err := newMACState(dev, ns.FrequencyPlans, ns.endDeviceDefaultMACSettings(ctx, dev))
 	if err != nil {
 		logger.WithError(err).Warn("Failed to reset device's MAC state")
 		return err
@@ -1103,6 +1133,17 @@ func (ns *NetworkServer) handleJoinRequest(ctx context.Context, up *ttnpb.Uplink
 
 	ctx = events.ContextWithCorrelationID(ctx, resp.CorrelationIDs...)
 
+	events.Publish(evtForwardJoinRequest(ctx, dev.EndDeviceIdentifiers, nil))
+	registerForwardJoinRequest(ctx, up)
+
+	return ns.queueJoinAccept(ctx, dev, up, acc, macState, phy, req, resp, respRecvAt)
+}
+
+// queueJoinAccept stores the join-accept in resp, which was received from the Join Server in response to req,
+// in the pending MAC state of dev and schedules it for transmission.
+func (ns *NetworkServer) queueJoinAccept(ctx context.Context, dev *ttnpb.EndDevice, up *ttnpb.UplinkMessage, acc *metadataAccumulator, macState *ttnpb.MACState, phy band.Band, req *ttnpb.JoinRequest, resp *ttnpb.JoinResponse, respRecvAt time.Time) error {
+	logger := log.FromContext(ctx)
+
 	keys := resp.SessionKeys
 	if !req.DownlinkSettings.OptNeg {
 		keys.NwkSEncKey = keys.FNwkSIntKey
@@ -1116,9 +1157,6 @@ func (ns *NetworkServer) handleJoinRequest(ctx context.Context, up *ttnpb.Uplink
 	}
 	macState.RxWindowsAvailable = true
 
-	events.Publish(evtForwardJoinRequest(ctx, dev.EndDeviceIdentifiers, nil))
-	registerForwardJoinRequest(ctx, up)
-
 	select {
 	case <-ctx.Done():
 		return ctx.Err()
@@ -1130,21 +1168,31 @@ func (ns *NetworkServer) handleJoinRequest(ctx context.Context, up *ttnpb.Uplink
 	registerMergeMetadata(ctx, up)
 
 	var invalidatedQueue []*ttnpb.ApplicationDownlink
-	dev, ctx, err = ns.devices.SetByID(ctx, dev.EndDeviceIdentifiers.ApplicationIdentifiers, dev.EndDeviceIdentifiers.DeviceID,
+	dev, ctx, err := setDeviceByIDOnUplink(ctx, ns.devices, up, dev.EndDeviceIdentifiers.ApplicationIdentifiers, dev.EndDeviceIdentifiers.DeviceID,
 		[]string{
 			"frequency_plan_id",
 			"lorawan_phy_version",
+			"mac_state.last_rj_count_0",
 			"queued_application_downlinks",
 			"recent_uplinks",
 		},
 		func(ctx context.Context, stored *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error) {
 			if stored == nil {
-				logger.Warn("Device deleted during join-request handling, drop")
+				logger.Warn("Device deleted during (re)join-request handling, drop")
 				return nil, nil, errOutdatedData
 			}
 
 			var paths []string
 
+			if pld := req.Payload.GetRejoinRequestPayload(); pld != nil && pld.RejoinType != ttnpb.RejoinType_SESSION && stored.MACState != nil {
+				// The RJcount0 of the current session is checked again, as a concurrent rejoin-request may have used it.
+				if err := validateRJCount0(stored.MACState, pld.RejoinCnt); err != nil {
+					return nil, nil, err
+				}
+				stored.MACState.LastRJCount0 = pld.RejoinCnt
+				paths = append(paths, "mac_state.last_rj_count_0")
+			}
+
 			stored.PendingMACState = macState
 			paths = append(paths, "pending_mac_state")
 
@@ -1171,16 +1219,13 @@ func (ns *NetworkServer) handleJoinRequest(ctx context.Context, up *ttnpb.Uplink
 		})
 	if err != nil {
 		logger.WithError(err).Warn("Failed to update device in registry")
-		// TODO: Retry transaction. (https://github.com/TheThingsNetwork/lorawan-stack/issues/33)
-	}
-	if err != nil {
 		return err
 	}
 
 	downAt := up.ReceivedAt.Add(-infrastructureDelay/2 + phy.JoinAcceptDelay1 - req.RxDelay.Duration()/2 - nsScheduleWindow())
 	logger.WithField("start_at", downAt).Debug("Add downlink task")
 	if err := ns.downlinkTasks.Add(ctx, dev.EndDeviceIdentifiers, downAt, true); err != nil {
-		logger.WithError(err).Error("Failed to add downlink task after join-request")
+		logger.WithError(err).Error("Failed to add downlink task after (re)join-request")
 	}
 	logger.Debug("Enqueue join-accept for sending to Application Server")
 	if err := ns.applicationUplinks.Add(ctx, &ttnpb.ApplicationUp{
@@ -1189,7 +1234,7 @@ func (ns *NetworkServer) handleJoinRequest(ctx context.Context, up *ttnpb.Uplink
 			DeviceID:               dev.EndDeviceIdentifiers.DeviceID,
 			DevEUI:                 dev.EndDeviceIdentifiers.DevEUI,
 			JoinEUI:                dev.EndDeviceIdentifiers.JoinEUI,
-			DevAddr:                &devAddr,
+			DevAddr:                &req.DevAddr,
 		},
 		CorrelationIDs: events.CorrelationIDsFromContext(ctx),
 		Up: &ttnpb.ApplicationUp_JoinAccept{JoinAccept: &ttnpb.ApplicationJoinAccept{
@@ -1204,14 +1249,192 @@ func (ns *NetworkServer) handleJoinRequest(ctx context.Context, up *ttnpb.Uplink
 	return nil
 }
 
+var rejoinRequestGetPaths = []string{
+	"frequency_plan_id",
+	"lorawan_phy_version",
+	"lorawan_version",
+	"mac_settings",
+	"mac_state",
+	"profile_ids",
+	"session",
+	"supports_class_b",
+	"supports_class_c",
+	"supports_join",
+}
+
+// validateRJCount0 checks that the RJcount0 of a rejoin-request of type 0 or 2 is greater than the last RJcount0
+// of the session of macState. The first rejoin-request of a session may have RJcount0 0.
+func validateRJCount0(macState *ttnpb.MACState, rjc uint32) error {
+	if macState == nil {
+		return nil
+	}
+	if (rjc != 0 || macState.LastRJCount0 != 0) && rjc <= macState.LastRJCount0 {
+		return errRJCount0TooSmall.WithAttributes("rj_count_0", rjc)
+	}
+	return nil
+}
+
+// matchRejoinRequestDevice returns the device, which sent the rejoin-request of type 0 or 2 in up.
+// These rejoin-requests do not contain the JoinEUI, hence the device is matched by DevEUI and the MIC,
+// which is computed using the SNwkSIntKey of the current session.
+func (ns *NetworkServer) matchRejoinRequestDevice(ctx context.Context, up *ttnpb.UplinkMessage) (*ttnpb.EndDevice, context.Context, error) {
+	pld := up.Payload.GetRejoinRequestPayload()
+	n := len(up.RawPayload)
+	if n < 4 {
+		return nil, ctx, errRawPayloadTooShort
+	}
+
+	var (
+		dev    *ttnpb.EndDevice
+		devCtx context.Context
+	)
+	if err := ns.devices.RangeByDevEUI(ctx, pld.DevEUI, rejoinRequestGetPaths, func(ctx context.Context, candidate *ttnpb.EndDevice) bool {
+		logger := log.FromContext(ctx).WithField("device_uid", unique.ID(ctx, candidate.EndDeviceIdentifiers))
+		if candidate.Session == nil || candidate.Session.SNwkSIntKey == nil {
+			logger.Debug("Device has no active session, skip")
+			return true
+		}
+		key, err := cryptoutil.UnwrapAES128Key(ctx, *candidate.Session.SNwkSIntKey, ns.KeyVault)
+		if err != nil {
+			logger.WithField("kek_label", candidate.Session.SNwkSIntKey.KEKLabel).WithError(err).Warn("Failed to unwrap SNwkSIntKey, skip")
+			return true
+		}
+		mic, err := crypto.ComputeRejoinRequestMIC(key, up.RawPayload[:n-4])
+		if err != nil {
+			logger.WithError(err).Warn("Failed to compute rejoin-request MIC, skip")
+			return true
+		}
+		if !bytes.Equal(mic[:], up.RawPayload[n-4:]) {
+			logger.Debug("MIC mismatch, skip")
+			return true
+		}
+		dev, devCtx = candidate, ctx
+		return false
+	}); err != nil {
+		return nil, ctx, err
+	}
+	if dev == nil {
+		return nil, ctx, errDeviceNotFound
+	}
+	return dev, devCtx, nil
+}
+
 func (ns *NetworkServer) handleRejoinRequest(ctx context.Context, up *ttnpb.UplinkMessage, acc *metadataAccumulator) (err error) {
 	defer func() {
 		if err != nil {
 			registerDropRejoinRequest(ctx, up, err)
 		}
 	}()
-	// TODO: Implement https://github.com/TheThingsNetwork/lorawan-stack/issues/8
-	return status.Errorf(codes.Unimplemented, "not implemented")
+
+	pld := up.Payload.GetRejoinRequestPayload()
+
+	logger := log.FromContext(ctx).WithFields(log.Fields(
+		"dev_eui", pld.DevEUI,
+		"rejoin_cnt", pld.RejoinCnt,
+		"rejoin_type", pld.RejoinType,
+	))
+	ctx = log.NewContext(ctx, logger)
+
+	var dev *ttnpb.EndDevice
+	switch pld.RejoinType {
+	case ttnpb.RejoinType_SESSION:
+		logger = logger.WithField("join_eui", pld.JoinEUI)
+		ctx = log.NewContext(ctx, logger)
+		dev, ctx, err = ns.devices.GetByEUI(ctx, pld.JoinEUI, pld.DevEUI, rejoinRequestGetPaths)
+
+	default:
+		if !pld.NetID.Equal(ns.netID) {
+			logger.WithField("net_id", pld.NetID).Debug("Rejoin-request NetID does not match, drop")
+			return errNetIDMismatch.WithAttributes("net_id", pld.NetID)
+		}
+		dev, ctx, err = ns.matchRejoinRequestDevice(ctx, up)
+	}
+	if err != nil {
+		logger.WithError(err).Debug("Failed to load device from registry")
+		return err
+	}
+
+	defer func(dev *ttnpb.EndDevice) {
+		if err != nil {
+			events.Publish(evtDropRejoinRequest(ctx, dev.EndDeviceIdentifiers, err))
+		}
+	}(dev)
+
+	logger = logger.WithField("device_uid", unique.ID(ctx, dev.EndDeviceIdentifiers))
+
+	if !dev.SupportsJoin {
+		logger.Warn("ABP device sent a rejoin-request, drop")
+		return errABPRejoinRequest
+	}
+	if dev.LoRaWANVersion.Compare(ttnpb.MAC_V1_1) < 0 {
+		logger.WithField("lorawan_version", dev.LoRaWANVersion).Warn("Pre-1.1 device sent a rejoin-request, drop")
+		return errUnsupportedLoRaWANVersion.WithAttributes("version", dev.LoRaWANVersion)
+	}
+
+	if pld.RejoinType != ttnpb.RejoinType_SESSION {
+		if err := validateRJCount0(dev.MACState, pld.RejoinCnt); err != nil {
+			logger.WithError(err).Debug("Rejoin-request is replayed, drop")
+			return err
+		}
+	}
+
+	ctx = log.NewContext(ctx, logger)
+
+	devAddr := ns.newDevAddr(ctx, dev)
+	for dev.Session != nil && devAddr.Equal(dev.Session.DevAddr) {
+		devAddr = ns.newDevAddr(ctx, dev)
+	}
+	logger = logger.WithField("dev_addr", devAddr)
+	ctx = log.NewContext(ctx, logger)
+
+	macState, err := newMACState(dev, ns.FrequencyPlans, ns.endDeviceDefaultMACSettings(ctx, dev))
+	if err != nil {
+		logger.WithError(err).Warn("Failed to reset device's MAC state")
+		return err
+	}
+
+	fp, phy, err := getDeviceBandVersion(dev, ns.FrequencyPlans)
+	if err != nil {
+		return err
+	}
+
+	req := &ttnpb.JoinRequest{
+		Payload:            up.Payload,
+		CorrelationIDs:     events.CorrelationIDsFromContext(ctx),
+		DevAddr:            devAddr,
+		NetID:              ns.netID,
+		RawPayload:         up.RawPayload,
+		SelectedMACVersion: dev.LoRaWANVersion,
+	}
+	if pld.RejoinType == ttnpb.RejoinType_KEYS && dev.MACState != nil {
+		// Rejoin-requests of type 2 only rekey the session, the radio parameters are kept.
+		macState.CurrentParameters = dev.MACState.CurrentParameters
+		macState.DesiredParameters = dev.MACState.DesiredParameters
+	} else {
+		req.CFList = frequencyplans.CFList(*fp, dev.LoRaWANPHYVersion)
+	}
+	if pld.RejoinType != ttnpb.RejoinType_SESSION {
+		req.JoinEUI = dev.JoinEUI
+	}
+	req.RxDelay = macState.DesiredParameters.Rx1Delay
+	req.DownlinkSettings = ttnpb.DLSettings{
+		Rx1DROffset: macState.DesiredParameters.Rx1DataRateOffset,
+		Rx2DR:       macState.DesiredParameters.Rx2DataRateIndex,
+		OptNeg:      true,
+	}
+
+	resp, err := ns.sendJoinRequest(ctx, dev.EndDeviceIdentifiers, req)
+	if err != nil {
+		return err
+	}
+	respRecvAt := timeNow()
+
+	ctx = events.ContextWithCorrelationID(ctx, resp.CorrelationIDs...)
+
+	events.Publish(evtForwardRejoinRequest(ctx, dev.EndDeviceIdentifiers, nil))
+	registerForwardRejoinRequest(ctx, up)
+
+	return ns.queueJoinAccept(ctx, dev, up, acc, macState, phy, req, resp, respRecvAt)
 }
 
 // HandleUplink is called by the Gateway Server when an uplink message arrives.
@@ -1275,3 +1498,107 @@ func (ns *NetworkServer) HandleUplink(ctx context.Context, up *ttnpb.UplinkMessa
 	logger.Debug("Handle uplink")
 	return ttnpb.Empty, handle(ctx, up, acc)
 }
+
+// ReportTxAcknowledgment is called by the Gateway Server when a gateway acknowledges the transmission of a downlink message.
+func (ns *NetworkServer) ReportTxAcknowledgment(ctx context.Context, msg *ttnpb.GatewayTxAcknowledgment) (*pbtypes.Empty, error) {
+	if err := clusterauth.Authorized(ctx); err != nil {
+		return nil, err
+	}
+	down := msg.TxAck.GetDownlinkMessage()
+	if down.GetEndDeviceIDs() == nil {
+		return ttnpb.Empty, nil
+	}
+	ctx = events.ContextWithCorrelationID(ctx, append(down.CorrelationIDs, msg.TxAck.CorrelationIDs...)...)
+	logger := log.FromContext(ctx).WithFields(log.Fields(
+		"gateway_uid", unique.ID(ctx, msg.GatewayIdentifiers),
+		"result", msg.TxAck.Result,
+	))
+	if msg.TxAck.Result == ttnpb.TxAcknowledgment_SUCCESS {
+		logger.Debug("Downlink transmitted")
+		events.Publish(evtTransmitDownlinkSuccess(ctx, *down.EndDeviceIDs, down))
+	} else {
+		logger.Debug("Downlink transmission failed")
+		events.Publish(evtTransmitDownlinkFail(ctx, *down.EndDeviceIDs, msg.TxAck.Result))
+	}
+	if err := ns.reportApplicationDownlinkResult(ctx, *down.EndDeviceIDs, down, msg.TxAck.Result); err != nil {
+		logger.WithError(err).Warn("Failed to report application downlink transmission result")
+	}
+	return ttnpb.Empty, nil
+}
+
+// reportApplicationDownlinkResult enqueues a downlink sent or failed application uplink for the application downlink in down, if any.
+// The downlink is matched with the recent downlinks of the device, so that only downlinks scheduled by the Network Server are reported.
+func (ns *NetworkServer) reportApplicationDownlinkResult(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, down *ttnpb.DownlinkMessage, result ttnpb.TxAcknowledgment_Result) error {
+	var msg ttnpb.Message
+	if err := lorawan.UnmarshalMessage(down.RawPayload, &msg); err != nil {
+		return errDecodePayload.WithCause(err)
+	}
+	if msg.MType != ttnpb.MType_UNCONFIRMED_DOWN && msg.MType != ttnpb.MType_CONFIRMED_DOWN {
+		return nil
+	}
+	pld := msg.GetMACPayload()
+	if pld == nil || pld.FPort == 0 {
+		return nil
+	}
+	dev, ctx, err := ns.devices.GetByID(ctx, ids.ApplicationIdentifiers, ids.DeviceID, []string{
+		"mac_state.lorawan_version",
+		"mac_state.recent_downlinks",
+		"session.dev_addr",
+		"session.keys.session_key_id",
+		"session.last_a_f_cnt_down",
+		"session.last_n_f_cnt_down",
+	})
+	if err != nil {
+		return err
+	}
+	if dev.MACState == nil || dev.Session == nil || !dev.Session.DevAddr.Equal(pld.DevAddr) {
+		return nil
+	}
+	var matched bool
+	for _, recent := range dev.MACState.RecentDownlinks {
+		if bytes.Equal(recent.RawPayload, down.RawPayload) {
+			matched = true
+			break
+		}
+	}
+	if !matched {
+		log.FromContext(ctx).Debug("Transmitted downlink does not match recent downlinks, skip reporting application downlink result")
+		return nil
+	}
+
+	// The frame counter in the payload contains only the 16 least significant bits, so the most significant bits
+	// are taken from the last downlink frame counter of the session.
+	lastFCnt := dev.Session.LastNFCntDown
+	if dev.MACState.LoRaWANVersion.Compare(ttnpb.MAC_V1_1) >= 0 {
+		lastFCnt = dev.Session.LastAFCntDown
+	}
+	fCnt := pld.FHDR.FCnt&0xffff | lastFCnt&^0xffff
+	if fCnt > lastFCnt && fCnt >= 0x10000 {
+		fCnt -= 0x10000
+	}
+	appDown := &ttnpb.ApplicationDownlink{
+		SessionKeyID:   dev.Session.SessionKeyID,
+		FPort:          pld.FPort,
+		FCnt:           fCnt,
+		FRMPayload:     pld.FRMPayload,
+		Confirmed:      msg.MType == ttnpb.MType_CONFIRMED_DOWN,
+		CorrelationIDs: down.CorrelationIDs,
+	}
+	up := &ttnpb.ApplicationUp{
+		EndDeviceIdentifiers: dev.EndDeviceIdentifiers,
+		CorrelationIDs:       events.CorrelationIDsFromContext(ctx),
+	}
+	if result == ttnpb.TxAcknowledgment_SUCCESS {
+		up.Up = &ttnpb.ApplicationUp_DownlinkSent{
+			DownlinkSent: appDown,
+		}
+	} else {
+		up.Up = &ttnpb.ApplicationUp_DownlinkFailed{
+			DownlinkFailed: &ttnpb.ApplicationDownlinkFailed{
+				ApplicationDownlink: *appDown,
+				Error:               *ttnpb.ErrorDetailsToProto(errTransmissionFailed.WithAttributes("result", result.String())),
+			},
+		}
+	}
+	return ns.applicationUplinks.Add(ctx, up)
+}
@@ -23,6 +23,7 @@ import (
 	"github.com/smartystreets/assertions"
 	"go.thethings.network/lorawan-stack/pkg/band"
 	"go.thethings.network/lorawan-stack/pkg/encoding/lorawan"
+	"go.thethings.network/lorawan-stack/pkg/errors"
 	"go.thethings.network/lorawan-stack/pkg/events"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
 	"go.thethings.network/lorawan-stack/pkg/types"
@@ -641,6 +642,112 @@ func TestMatchAndHandleUplink(t *testing.T) {
 				return assertions.New(test.MustTFromContext(ctx)).So(err, should.BeNil)
 			},
 		},
+
+		{
+			Name: "1.1/Session recovery",
+			Uplink: makeUplink(
+				&ttnpb.MACPayload{
+					FHDR: ttnpb.FHDR{
+						DevAddr: devAddr,
+						FCnt:    12,
+						FOpts:   MustEncryptUplink(nwkSEncKey, devAddr, 12, 0x02),
+					},
+					FPort:      0x01,
+					FRMPayload: []byte("test-frm-payload"),
+				},
+				false,
+				12,
+				0,
+				ttnpb.DATA_RATE_2,
+				1,
+				ttnpb.TxSettings{
+					DataRate: ttnpb.DataRate{
+						Modulation: &ttnpb.DataRate_LoRa{LoRa: &ttnpb.LoRaDataRate{
+							Bandwidth:       125000,
+							SpreadingFactor: 10,
+						}},
+					},
+					EnableCRC: true,
+					Frequency: 868300000,
+					Timestamp: 42,
+				},
+			),
+			Devices: []contextualEndDevice{
+				{
+					Context: deviceCtx,
+					EndDevice: &ttnpb.EndDevice{
+						EndDeviceIdentifiers: *makeABPIdentifiers(devAddr),
+						FrequencyPlanID:      test.EUFrequencyPlanID,
+						LoRaWANPHYVersion:    ttnpb.PHY_V1_1_REV_B,
+						LoRaWANVersion:       ttnpb.MAC_V1_1,
+						MACState:             MakeDefaultEU868MACState(ttnpb.CLASS_A, ttnpb.MAC_V1_1, ttnpb.PHY_V1_1_REV_B),
+						PendingMACState:      MakeDefaultEU868MACState(ttnpb.CLASS_A, ttnpb.MAC_V1_1, ttnpb.PHY_V1_1_REV_B),
+						PendingSession: func() *ttnpb.Session {
+							ses := makeSession(ttnpb.MAC_V1_1, types.DevAddr{0x42, 0xff, 0xff, 0xff}, 0)
+							ses.SessionKeyID = []byte("match-and-handle-uplink-test-pending-session-key-id")
+							return ses
+						}(),
+						Session: makeSession(ttnpb.MAC_V1_1, devAddr, 10),
+					},
+				},
+			},
+			DeviceAssertion: func(ctx context.Context, dev *matchedDevice, up *ttnpb.UplinkMessage) bool {
+				a := assertions.New(test.MustTFromContext(ctx))
+				if !a.So(dev, should.NotBeNil) ||
+					!a.So(dev.Device, should.NotBeNil) ||
+					!a.So(dev.Device.Session, should.NotBeNil) ||
+					!a.So(dev.QueuedEvents, should.ResembleEventDefinitionDataClosures, []events.DefinitionDataClosure{
+						evtRecoverSession.BindData(nil),
+					}) {
+					return false
+				}
+				macState := MakeDefaultEU868MACState(ttnpb.CLASS_A, ttnpb.MAC_V1_1, ttnpb.PHY_V1_1_REV_B)
+				macState.RxWindowsAvailable = true
+				expectedDev := &matchedDevice{
+					phy:                 test.Must(band.All[band.EU_863_870].Version(ttnpb.PHY_V1_1_REV_B)).(band.Band),
+					Context:             dev.Context,
+					ChannelIndex:        1,
+					DataRateIndex:       ttnpb.DATA_RATE_2,
+					DeferredMACHandlers: dev.DeferredMACHandlers,
+					Device: &ttnpb.EndDevice{
+						EndDeviceIdentifiers: *makeABPIdentifiers(devAddr),
+						FrequencyPlanID:      test.EUFrequencyPlanID,
+						LoRaWANPHYVersion:    ttnpb.PHY_V1_1_REV_B,
+						LoRaWANVersion:       ttnpb.MAC_V1_1,
+						MACState:             macState,
+						Session:              makeSession(ttnpb.MAC_V1_1, devAddr, 12),
+					},
+					FCnt:    12,
+					NbTrans: 1,
+					SetPaths: []string{
+						"mac_state",
+						"pending_mac_state",
+						"pending_session",
+						"session",
+					},
+					QueuedApplicationUplinks: []*ttnpb.ApplicationUp{
+						{
+							EndDeviceIdentifiers: *makeABPIdentifiers(devAddr),
+							CorrelationIDs:       correlationIDs[:],
+							Up: &ttnpb.ApplicationUp_SessionSwitch{
+								SessionSwitch: &ttnpb.ApplicationSessionSwitch{
+									SessionKeyID: makeSessionKeys(ttnpb.MAC_V1_1).SessionKeyID,
+									DevAddr:      devAddr,
+									SwitchedAt:   start,
+								},
+							},
+						},
+					},
+					QueuedEvents: dev.QueuedEvents,
+				}
+				return a.So(dev.DeferredMACHandlers, should.HaveLength, 1) &&
+					a.So(dev.Context, should.HaveParentContext, deviceCtx) &&
+					a.So(dev, should.HaveEmptyDiff, expectedDev)
+			},
+			ErrorAssertion: func(ctx context.Context, err error) bool {
+				return assertions.New(test.MustTFromContext(ctx)).So(err, should.BeNil)
+			},
+		},
 	} {
 		t.Run(tc.Name, func(t *testing.T) {
 			a := assertions.New(t)
@@ -663,3 +770,26 @@ func TestMatchAndHandleUplink(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateRJCount0(t *testing.T) {
+	a := assertions.New(t)
+
+	a.So(validateRJCount0(nil, 1), should.BeNil)
+
+	macState := &ttnpb.MACState{}
+
+	// First type 0 rejoin-request of the session.
+	a.So(validateRJCount0(macState, 1), should.BeNil)
+	macState.LastRJCount0 = 1
+
+	// Second type 0 rejoin-request of the session with the same RJcount0.
+	a.So(errors.IsInvalidArgument(validateRJCount0(macState, 1)), should.BeTrue)
+	a.So(errors.IsInvalidArgument(validateRJCount0(macState, 0)), should.BeTrue)
+
+	// Second type 0 rejoin-request of the session with an incremented RJcount0.
+	a.So(validateRJCount0(macState, 2), should.BeNil)
+
+	// The end device resets RJcount0 when it processes the rejoin-accept, which switches to a new MAC state.
+	a.So(validateRJCount0(&ttnpb.MACState{}, 0), should.BeNil)
+	a.So(validateRJCount0(&ttnpb.MACState{}, 1), should.BeNil)
+}
@@ -23,6 +23,7 @@ import (
 	"time"
 
 	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/encoding/lorawan"
 	"go.thethings.network/lorawan-stack/pkg/errors"
 	"go.thethings.network/lorawan-stack/pkg/events"
 	. "go.thethings.network/lorawan-stack/pkg/networkserver"
@@ -129,6 +130,7 @@ func TestHandleUplink(t *testing.T) {
 		"multicast",
 		"pending_mac_state",
 		"pending_session",
+		"profile_ids",
 		"queued_application_downlinks",
 		"recent_downlinks",
 		"recent_uplinks",
@@ -143,6 +145,7 @@ func TestHandleUplink(t *testing.T) {
 		"lorawan_phy_version",
 		"lorawan_version",
 		"mac_settings",
+		"profile_ids",
 		"session",
 		"supports_class_b",
 		"supports_class_c",
@@ -152,6 +155,7 @@ func TestHandleUplink(t *testing.T) {
 	joinSetByEUIGetPaths := [...]string{
 		"frequency_plan_id",
 		"lorawan_phy_version",
+		"mac_state.last_rj_count_0",
 		"queued_application_downlinks",
 		"recent_uplinks",
 	}
@@ -3233,3 +3237,247 @@ func TestHandleUplink(t *testing.T) {
 		})
 	}
 }
+
+func TestReportTxAcknowledgment(t *testing.T) {
+	ids := ttnpb.EndDeviceIdentifiers{
+		ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: "report-tx-ack-test-app-id"},
+		DeviceID:               "report-tx-ack-test-dev-id",
+	}
+	down := &ttnpb.DownlinkMessage{
+		RawPayload:   []byte{0x60, 0x42, 0x00, 0x00, 0x00, 0x00, 0x01, 0x00, 0x01, 0x02, 0x03, 0x04},
+		EndDeviceIDs: &ids,
+		Settings: &ttnpb.DownlinkMessage_Request{
+			Request: &ttnpb.TxRequest{
+				Class:            ttnpb.CLASS_A,
+				Rx1Delay:         ttnpb.RX_DELAY_1,
+				Rx1DataRateIndex: ttnpb.DATA_RATE_5,
+				Rx1Frequency:     868100000,
+			},
+		},
+		CorrelationIDs: []string{"ns:downlink:report-tx-ack-test"},
+	}
+
+	for _, tc := range []struct {
+		Name          string
+		TxAck         *ttnpb.TxAcknowledgment
+		EventName     string
+		EventData     interface{}
+		ExpectedEvent bool
+	}{
+		{
+			Name: "no downlink message",
+			TxAck: &ttnpb.TxAcknowledgment{
+				CorrelationIDs: []string{"gs:tx_ack:report-tx-ack-test"},
+				Result:         ttnpb.TxAcknowledgment_SUCCESS,
+			},
+		},
+		{
+			Name: "success",
+			TxAck: &ttnpb.TxAcknowledgment{
+				CorrelationIDs:  []string{"gs:tx_ack:report-tx-ack-test"},
+				Result:          ttnpb.TxAcknowledgment_SUCCESS,
+				DownlinkMessage: down,
+			},
+			EventName:     "ns.down.transmission.success",
+			EventData:     down,
+			ExpectedEvent: true,
+		},
+		{
+			Name: "too late",
+			TxAck: &ttnpb.TxAcknowledgment{
+				CorrelationIDs:  []string{"gs:tx_ack:report-tx-ack-test"},
+				Result:          ttnpb.TxAcknowledgment_TOO_LATE,
+				DownlinkMessage: down,
+			},
+			EventName:     "ns.down.transmission.fail",
+			EventData:     ttnpb.TxAcknowledgment_TOO_LATE,
+			ExpectedEvent: true,
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+
+			ns, ctx, env, stop := StartTest(t, Config{}, (1<<10)*test.Delay, true)
+			defer stop()
+
+			<-env.DownlinkTasks.Pop
+
+			errCh := make(chan error, 1)
+			go func() {
+				_, err := ttnpb.NewGsNsClient(ns.LoopbackConn()).ReportTxAcknowledgment(ctx, &ttnpb.GatewayTxAcknowledgment{
+					GatewayIdentifiers: ttnpb.GatewayIdentifiers{GatewayID: "report-tx-ack-test-gtw-id"},
+					TxAck:              tc.TxAck,
+				})
+				errCh <- err
+			}()
+
+			if tc.ExpectedEvent {
+				a.So(test.AssertEventPubSubPublishRequest(ctx, env.Events, func(ev events.Event) bool {
+					return a.So(ev.Name(), should.Equal, tc.EventName) &&
+						a.So(ev.Identifiers(), should.Resemble, []*ttnpb.EntityIdentifiers{ids.EntityIdentifiers()}) &&
+						a.So(ev.Data(), should.Resemble, tc.EventData) &&
+						a.So(ev.CorrelationIDs(), should.Contain, "ns:downlink:report-tx-ack-test") &&
+						a.So(ev.CorrelationIDs(), should.Contain, "gs:tx_ack:report-tx-ack-test")
+				}), should.BeTrue)
+			}
+
+			select {
+			case <-ctx.Done():
+				t.Error("Timed out while waiting for ReportTxAcknowledgment to return")
+			case err := <-errCh:
+				a.So(err, should.BeNil)
+			}
+			a.So(AssertNetworkServerClose(ctx, ns), should.BeTrue)
+		})
+	}
+}
+
+func TestReportTxAcknowledgmentApplicationDownlink(t *testing.T) {
+	ids := ttnpb.EndDeviceIdentifiers{
+		ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: "report-tx-ack-test-app-id"},
+		DeviceID:               "report-tx-ack-test-dev-id",
+	}
+	devAddr := types.DevAddr{0x42, 0x00, 0x00, 0x00}
+	makeDown := func(fPort uint32) *ttnpb.DownlinkMessage {
+		b, err := lorawan.MarshalMessage(ttnpb.Message{
+			MHDR: ttnpb.MHDR{
+				MType: ttnpb.MType_CONFIRMED_DOWN,
+				Major: ttnpb.Major_LORAWAN_R1,
+			},
+			Payload: &ttnpb.Message_MACPayload{
+				MACPayload: &ttnpb.MACPayload{
+					FHDR: ttnpb.FHDR{
+						DevAddr: devAddr,
+						FCnt:    0x0002,
+					},
+					FPort:      fPort,
+					FRMPayload: []byte{0x01, 0x02, 0x03},
+				},
+			},
+			MIC: []byte{0x01, 0x02, 0x03, 0x04},
+		})
+		if err != nil {
+			panic(err)
+		}
+		return &ttnpb.DownlinkMessage{
+			RawPayload:     b,
+			EndDeviceIDs:   &ids,
+			CorrelationIDs: []string{"ns:downlink:report-tx-ack-test"},
+		}
+	}
+	down := makeDown(42)
+
+	for _, tc := range []struct {
+		Name            string
+		Down            *ttnpb.DownlinkMessage
+		RecentDownlinks []*ttnpb.DownlinkMessage
+		Result          ttnpb.TxAcknowledgment_Result
+		AssertUp        func(*testing.T, *ttnpb.ApplicationUp)
+	}{
+		{
+			Name:            "sent",
+			Down:            down,
+			RecentDownlinks: []*ttnpb.DownlinkMessage{makeDown(1), down},
+			Result:          ttnpb.TxAcknowledgment_SUCCESS,
+			AssertUp: func(t *testing.T, up *ttnpb.ApplicationUp) {
+				a := assertions.New(t)
+				a.So(up.GetDownlinkSent(), should.Resemble, &ttnpb.ApplicationDownlink{
+					SessionKeyID:   []byte("test-session-key-id"),
+					FPort:          42,
+					FCnt:           0x10002,
+					FRMPayload:     []byte{0x01, 0x02, 0x03},
+					Confirmed:      true,
+					CorrelationIDs: down.CorrelationIDs,
+				})
+			},
+		},
+		{
+			Name:            "failed",
+			Down:            down,
+			RecentDownlinks: []*ttnpb.DownlinkMessage{down},
+			Result:          ttnpb.TxAcknowledgment_TOO_LATE,
+			AssertUp: func(t *testing.T, up *ttnpb.ApplicationUp) {
+				a := assertions.New(t)
+				if a.So(up.GetDownlinkFailed(), should.NotBeNil) {
+					a.So(up.GetDownlinkFailed().FCnt, should.Equal, uint32(0x10002))
+					a.So(up.GetDownlinkFailed().Error.Name, should.Equal, "transmission_failed")
+				}
+			},
+		},
+		{
+			Name:            "no match",
+			Down:            down,
+			RecentDownlinks: []*ttnpb.DownlinkMessage{makeDown(1)},
+			Result:          ttnpb.TxAcknowledgment_SUCCESS,
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+
+			ns, ctx, env, stop := StartTest(t, Config{
+				Devices: &MockDeviceRegistry{
+					GetByIDFunc: func(ctx context.Context, appID ttnpb.ApplicationIdentifiers, devID string, gets []string) (*ttnpb.EndDevice, context.Context, error) {
+						a.So(appID, should.Resemble, ids.ApplicationIdentifiers)
+						a.So(devID, should.Equal, ids.DeviceID)
+						return &ttnpb.EndDevice{
+							EndDeviceIdentifiers: ids,
+							MACState: &ttnpb.MACState{
+								LoRaWANVersion:  ttnpb.MAC_V1_0_3,
+								RecentDownlinks: tc.RecentDownlinks,
+							},
+							Session: &ttnpb.Session{
+								DevAddr:       devAddr,
+								LastNFCntDown: 0x10002,
+								SessionKeys: ttnpb.SessionKeys{
+									SessionKeyID: []byte("test-session-key-id"),
+								},
+							},
+						}, ctx, nil
+					},
+				},
+			}, (1<<10)*test.Delay, true)
+			defer stop()
+
+			<-env.DownlinkTasks.Pop
+
+			errCh := make(chan error, 1)
+			go func() {
+				_, err := ttnpb.NewGsNsClient(ns.LoopbackConn()).ReportTxAcknowledgment(ctx, &ttnpb.GatewayTxAcknowledgment{
+					GatewayIdentifiers: ttnpb.GatewayIdentifiers{GatewayID: "report-tx-ack-test-gtw-id"},
+					TxAck: &ttnpb.TxAcknowledgment{
+						CorrelationIDs:  []string{"gs:tx_ack:report-tx-ack-test"},
+						Result:          tc.Result,
+						DownlinkMessage: tc.Down,
+					},
+				})
+				errCh <- err
+			}()
+
+			a.So(test.AssertEventPubSubPublishRequest(ctx, env.Events, func(ev events.Event) bool {
+				return a.So(ev.Identifiers(), should.Resemble, []*ttnpb.EntityIdentifiers{ids.EntityIdentifiers()})
+			}), should.BeTrue)
+
+			if tc.AssertUp != nil {
+				select {
+				case <-ctx.Done():
+					t.Fatal("Timed out while waiting for application uplink to be queued")
+				case req := <-env.ApplicationUplinks.Add:
+					if a.So(req.Uplinks, should.HaveLength, 1) {
+						a.So(req.Uplinks[0].EndDeviceIdentifiers, should.Resemble, ids)
+						a.So(req.Uplinks[0].CorrelationIDs, should.Contain, "ns:downlink:report-tx-ack-test")
+						tc.AssertUp(t, req.Uplinks[0])
+					}
+					req.Response <- nil
+				}
+			}
+
+			select {
+			case <-ctx.Done():
+				t.Error("Timed out while waiting for ReportTxAcknowledgment to return")
+			case err := <-errCh:
+				a.So(err, should.BeNil)
+			}
+			a.So(AssertNetworkServerClose(ctx, ns), should.BeTrue)
+		})
+	}
+}
@@ -0,0 +1,139 @@
+// Copyright © 2026 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkserver
+
+import (
+	"context"
+	"sort"
+	"strconv"
+
+	pbtypes "github.com/gogo/protobuf/types"
+	"go.thethings.network/lorawan-stack/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/rpcmetadata"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+var errGlobalProfileAdmin = errors.DefinePermissionDenied("global_profile_admin", "only admins can manage global end device profiles")
+
+type nsEndDeviceProfileRegistryServer struct {
+	NS *NetworkServer
+}
+
+// authInfo returns the authentication information of the caller, as known by the Identity Server.
+func (s *nsEndDeviceProfileRegistryServer) authInfo(ctx context.Context) (*ttnpb.AuthInfoResponse, error) {
+	callOpt, err := rpcmetadata.WithForwardedAuth(ctx, s.NS.AllowInsecureForCredentials())
+	if err != nil {
+		return nil, err
+	}
+	cc, err := s.NS.GetPeerConn(ctx, ttnpb.ClusterRole_ENTITY_REGISTRY, nil)
+	if err != nil {
+		return nil, err
+	}
+	return ttnpb.NewEntityAccessClient(cc).AuthInfo(ctx, ttnpb.Empty, callOpt)
+}
+
+// requireRights checks that the caller has the given rights on the application of the profiles.
+// Global profiles can be read by all authenticated callers, but only admins can write them.
+func (s *nsEndDeviceProfileRegistryServer) requireRights(ctx context.Context, appIDs *ttnpb.ApplicationIdentifiers, write bool) error {
+	if appIDs != nil {
+		if write {
+			return rights.RequireApplication(ctx, *appIDs, ttnpb.RIGHT_APPLICATION_DEVICES_WRITE)
+		}
+		return rights.RequireApplication(ctx, *appIDs, ttnpb.RIGHT_APPLICATION_DEVICES_READ)
+	}
+	info, err := s.authInfo(ctx)
+	if err != nil {
+		return err
+	}
+	if write && !info.IsAdmin {
+		return errGlobalProfileAdmin
+	}
+	return nil
+}
+
+// Get implements ttnpb.NsEndDeviceProfileRegistryServer.
+func (s *nsEndDeviceProfileRegistryServer) Get(ctx context.Context, req *ttnpb.GetEndDeviceProfileRequest) (*ttnpb.EndDeviceProfile, error) {
+	if err := s.requireRights(ctx, req.ApplicationIDs, false); err != nil {
+		return nil, err
+	}
+	return s.NS.endDeviceProfiles.Get(ctx, req.EndDeviceProfileIdentifiers, req.FieldMask.Paths)
+}
+
+// List implements ttnpb.NsEndDeviceProfileRegistryServer.
+func (s *nsEndDeviceProfileRegistryServer) List(ctx context.Context, req *ttnpb.ListEndDeviceProfilesRequest) (*ttnpb.EndDeviceProfiles, error) {
+	if err := s.requireRights(ctx, req.ApplicationIDs, false); err != nil {
+		return nil, err
+	}
+	profiles, err := s.NS.endDeviceProfiles.List(ctx, req.ApplicationIDs, req.FieldMask.Paths)
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(profiles, func(i, j int) bool { return profiles[i].ProfileID < profiles[j].ProfileID })
+	grpc.SetHeader(ctx, metadata.Pairs("x-total-count", strconv.Itoa(len(profiles))))
+	if req.Limit != 0 {
+		page := req.Page
+		if page == 0 {
+			page = 1
+		}
+		start := int(req.Limit) * int(page-1)
+		if start > len(profiles) {
+			start = len(profiles)
+		}
+		end := start + int(req.Limit)
+		if end > len(profiles) {
+			end = len(profiles)
+		}
+		profiles = profiles[start:end]
+	}
+	return &ttnpb.EndDeviceProfiles{
+		Profiles: profiles,
+	}, nil
+}
+
+// Set implements ttnpb.NsEndDeviceProfileRegistryServer.
+func (s *nsEndDeviceProfileRegistryServer) Set(ctx context.Context, req *ttnpb.SetEndDeviceProfileRequest) (*ttnpb.EndDeviceProfile, error) {
+	if err := s.requireRights(ctx, req.Profile.ApplicationIDs, true); err != nil {
+		return nil, err
+	}
+	if ttnpb.HasAnyField(req.FieldMask.Paths, "frequency_plan_id") && req.Profile.FrequencyPlanID != "" {
+		if _, err := s.NS.FrequencyPlans.GetByID(req.Profile.FrequencyPlanID); err != nil {
+			return nil, err
+		}
+	}
+	return s.NS.endDeviceProfiles.Set(ctx, req.Profile.EndDeviceProfileIdentifiers, req.FieldMask.Paths, func(stored *ttnpb.EndDeviceProfile) (*ttnpb.EndDeviceProfile, []string, error) {
+		if stored == nil {
+			return &req.Profile, ttnpb.AddFields(req.FieldMask.Paths, "ids"), nil
+		}
+		return &req.Profile, req.FieldMask.Paths, nil
+	})
+}
+
+// Delete implements ttnpb.NsEndDeviceProfileRegistryServer.
+// End devices that use the deleted profile fall back to the default MAC settings of the Network Server.
+func (s *nsEndDeviceProfileRegistryServer) Delete(ctx context.Context, req *ttnpb.EndDeviceProfileIdentifiers) (*pbtypes.Empty, error) {
+	if err := s.requireRights(ctx, req.ApplicationIDs, true); err != nil {
+		return nil, err
+	}
+	_, err := s.NS.endDeviceProfiles.Set(ctx, *req, nil, func(*ttnpb.EndDeviceProfile) (*ttnpb.EndDeviceProfile, []string, error) {
+		return nil, nil, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return ttnpb.Empty, nil
+}
@@ -17,17 +17,51 @@ package networkserver
 import (
 	"context"
 
+	"go.thethings.network/lorawan-stack/pkg/events"
+	"go.thethings.network/lorawan-stack/pkg/log"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
 )
 
 var evtEnqueueForceRejoinRequest = defineEnqueueMACRequestEvent("force_rejoin", "force rejoin")()
 
+func deviceNeedsForceRejoinReq(dev *ttnpb.EndDevice) bool {
+	return dev.MACState != nil &&
+		dev.MACState.LoRaWANVersion.Compare(ttnpb.MAC_V1_1) >= 0 &&
+		dev.MACState.QueuedForceRejoinReq != nil
+}
+
 func enqueueForceRejoinReq(ctx context.Context, dev *ttnpb.EndDevice, maxDownLen, maxUpLen uint16) macCommandEnqueueState {
-	// TODO: Support rejoins. (https://github.com/TheThingsNetwork/lorawan-stack/issues/8)
-	_ = evtEnqueueForceRejoinRequest
-	return macCommandEnqueueState{
-		MaxDownLen: maxDownLen,
-		MaxUpLen:   maxUpLen,
-		Ok:         true,
+	if !deviceNeedsForceRejoinReq(dev) {
+		return macCommandEnqueueState{
+			MaxDownLen: maxDownLen,
+			MaxUpLen:   maxUpLen,
+			Ok:         true,
+		}
 	}
+
+	var st macCommandEnqueueState
+	dev.MACState.PendingRequests, st = enqueueMACCommand(ttnpb.CID_FORCE_REJOIN, maxDownLen, maxUpLen, func(nDown, nUp uint16) ([]*ttnpb.MACCommand, uint16, []events.DefinitionDataClosure, bool) {
+		if nDown < 1 {
+			return nil, 0, nil, false
+		}
+
+		// The device answers ForceRejoinReq with a rejoin-request, hence no MAC answer is expected.
+		req := dev.MACState.QueuedForceRejoinReq
+		dev.MACState.QueuedForceRejoinReq = nil
+		log.FromContext(ctx).WithFields(log.Fields(
+			"rejoin_type", req.RejoinType,
+			"data_rate_index", req.DataRateIndex,
+			"max_retries", req.MaxRetries,
+			"period_exponent", req.PeriodExponent,
+		)).Debug("Enqueued ForceRejoinReq")
+		return []*ttnpb.MACCommand{
+				req.MACCommand(),
+			},
+			0,
+			[]events.DefinitionDataClosure{
+				evtEnqueueForceRejoinRequest.BindData(req),
+			},
+			true
+	}, dev.MACState.PendingRequests...)
+	return st
 }
@@ -0,0 +1,177 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkserver
+
+import (
+	"testing"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/events"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+func TestNeedsForceRejoinReq(t *testing.T) {
+	type TestCase struct {
+		Name        string
+		InputDevice *ttnpb.EndDevice
+		Needs       bool
+	}
+	var tcs []TestCase
+
+	tcs = append(tcs,
+		TestCase{
+			Name:        "no MAC state",
+			InputDevice: &ttnpb.EndDevice{},
+		},
+	)
+	ForEachMACVersion(func(makeMACName func(parts ...string) string, macVersion ttnpb.MACVersion) {
+		tcs = append(tcs,
+			TestCase{
+				Name: makeMACName("no request"),
+				InputDevice: &ttnpb.EndDevice{
+					MACState: &ttnpb.MACState{
+						LoRaWANVersion: macVersion,
+					},
+				},
+			},
+			TestCase{
+				Name: makeMACName("queued request"),
+				InputDevice: &ttnpb.EndDevice{
+					MACState: &ttnpb.MACState{
+						LoRaWANVersion: macVersion,
+						QueuedForceRejoinReq: &ttnpb.MACCommand_ForceRejoinReq{
+							RejoinType: ttnpb.RejoinType_CONTEXT,
+						},
+					},
+				},
+				Needs: macVersion.Compare(ttnpb.MAC_V1_1) >= 0,
+			},
+		)
+	})
+
+	for _, tc := range tcs {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+
+			dev := CopyEndDevice(tc.InputDevice)
+			res := deviceNeedsForceRejoinReq(dev)
+			if tc.Needs {
+				a.So(res, should.BeTrue)
+			} else {
+				a.So(res, should.BeFalse)
+			}
+			a.So(dev, should.Resemble, tc.InputDevice)
+		})
+	}
+}
+
+func TestEnqueueForceRejoinReq(t *testing.T) {
+	req := &ttnpb.MACCommand_ForceRejoinReq{
+		RejoinType:     ttnpb.RejoinType_KEYS,
+		DataRateIndex:  ttnpb.DATA_RATE_2,
+		MaxRetries:     3,
+		PeriodExponent: ttnpb.REJOIN_PERIOD_1,
+	}
+
+	for _, tc := range []struct {
+		Name                               string
+		InputDevice, ExpectedDevice        *ttnpb.EndDevice
+		MaxDownlinkLength, MaxUplinkLength uint16
+		State                              macCommandEnqueueState
+	}{
+		{
+			Name: "no request",
+			InputDevice: &ttnpb.EndDevice{
+				MACState: &ttnpb.MACState{
+					LoRaWANVersion: ttnpb.MAC_V1_1,
+				},
+			},
+			ExpectedDevice: &ttnpb.EndDevice{
+				MACState: &ttnpb.MACState{
+					LoRaWANVersion: ttnpb.MAC_V1_1,
+				},
+			},
+			MaxDownlinkLength: 42,
+			MaxUplinkLength:   24,
+			State: macCommandEnqueueState{
+				MaxDownLen: 42,
+				MaxUpLen:   24,
+				Ok:         true,
+			},
+		},
+		{
+			Name: "payload fits",
+			InputDevice: &ttnpb.EndDevice{
+				MACState: &ttnpb.MACState{
+					LoRaWANVersion:       ttnpb.MAC_V1_1,
+					QueuedForceRejoinReq: req,
+				},
+			},
+			ExpectedDevice: &ttnpb.EndDevice{
+				MACState: &ttnpb.MACState{
+					LoRaWANVersion: ttnpb.MAC_V1_1,
+					PendingRequests: []*ttnpb.MACCommand{
+						req.MACCommand(),
+					},
+				},
+			},
+			MaxDownlinkLength: 42,
+			MaxUplinkLength:   24,
+			State: macCommandEnqueueState{
+				MaxDownLen: 39,
+				MaxUpLen:   24,
+				Ok:         true,
+				QueuedEvents: []events.DefinitionDataClosure{
+					evtEnqueueForceRejoinRequest.BindData(req),
+				},
+			},
+		},
+		{
+			Name: "downlink does not fit",
+			InputDevice: &ttnpb.EndDevice{
+				MACState: &ttnpb.MACState{
+					LoRaWANVersion:       ttnpb.MAC_V1_1,
+					QueuedForceRejoinReq: req,
+				},
+			},
+			ExpectedDevice: &ttnpb.EndDevice{
+				MACState: &ttnpb.MACState{
+					LoRaWANVersion:       ttnpb.MAC_V1_1,
+					QueuedForceRejoinReq: req,
+				},
+			},
+			MaxDownlinkLength: 2,
+			MaxUplinkLength:   24,
+			State: macCommandEnqueueState{
+				MaxDownLen: 2,
+				MaxUpLen:   24,
+			},
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+
+			dev := CopyEndDevice(tc.InputDevice)
+
+			st := enqueueForceRejoinReq(test.Context(), dev, tc.MaxDownlinkLength, tc.MaxUplinkLength)
+			a.So(dev, should.Resemble, tc.ExpectedDevice)
+			a.So(st.QueuedEvents, should.ResembleEventDefinitionDataClosures, tc.State.QueuedEvents)
+			st.QueuedEvents = tc.State.QueuedEvents
+			a.So(st, should.Resemble, tc.State)
+		})
+	}
+}
@@ -111,12 +111,13 @@ type InteropClient interface {
 
 // NetworkServer implements the Network Server component.
 //
-// The Network Server exposes the GsNs, AsNs, DeviceRegistry and ApplicationDownlinkQueue services.
+// The Network Server exposes the GsNs, AsNs, DeviceRegistry, EndDeviceProfileRegistry and ApplicationDownlinkQueue services.
 type NetworkServer struct {
 	*component.Component
 	ctx context.Context
 
-	devices DeviceRegistry
+	devices           DeviceRegistry
+	endDeviceProfiles EndDeviceProfileRegistry
 
 	netID      types.NetID
 	newDevAddr newDevAddrFunc
@@ -203,6 +204,7 @@ func New(c *component.Component, conf *Config, opts ...Option) (*NetworkServer,
 		collectionDone:       makeWindowEndAfterFunc(conf.DeduplicationWindow + conf.CooldownWindow),
 		devices:              conf.Devices,
 		downlinkTasks:        conf.DownlinkTasks,
+		endDeviceProfiles:    conf.EndDeviceProfiles,
 		metadataAccumulators: &sync.Map{},
 		metadataAccumulatorPool: &sync.Pool{
 			New: func() interface{} {
@@ -286,12 +288,18 @@ func (ns *NetworkServer) RegisterServices(s *grpc.Server) {
 	ttnpb.RegisterGsNsServer(s, ns)
 	ttnpb.RegisterAsNsServer(s, ns)
 	ttnpb.RegisterNsEndDeviceRegistryServer(s, ns)
+	if ns.endDeviceProfiles != nil {
+		ttnpb.RegisterNsEndDeviceProfileRegistryServer(s, &nsEndDeviceProfileRegistryServer{NS: ns})
+	}
 	ttnpb.RegisterNsServer(s, ns)
 }
 
 // RegisterHandlers registers gRPC handlers.
 func (ns *NetworkServer) RegisterHandlers(s *runtime.ServeMux, conn *grpc.ClientConn) {
 	ttnpb.RegisterNsEndDeviceRegistryHandler(ns.Context(), s, conn)
+	if ns.endDeviceProfiles != nil {
+		ttnpb.RegisterNsEndDeviceProfileRegistryHandler(ns.Context(), s, conn)
+	}
 	ttnpb.RegisterNsHandler(ns.Context(), s, conn)
 }
 
@@ -495,6 +495,12 @@ func handleClassAOTAAEU868FlowTest1_0_2(ctx context.Context, conn *grpc.ClientCo
 					a.So(msg.CorrelationIDs, should.HaveLength, 5) &&
 					a.So(msg, should.Resemble, &ttnpb.DownlinkMessage{
 						RawPayload: bytes.Repeat([]byte{0x42}, 33),
+						EndDeviceIDs: &ttnpb.EndDeviceIdentifiers{
+							DeviceID:               devID,
+							ApplicationIdentifiers: appID,
+							JoinEUI:                &types.EUI64{0x42, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00},
+							DevEUI:                 &types.EUI64{0x42, 0x42, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00},
+						},
 						Settings: &ttnpb.DownlinkMessage_Request{
 							Request: &ttnpb.TxRequest{
 								Class: ttnpb.CLASS_A,
@@ -865,6 +871,13 @@ func handleClassAOTAAEU868FlowTest1_0_2(ctx context.Context, conn *grpc.ClientCo
 								})).([]byte)...,
 							)...,
 						),
+						EndDeviceIDs: &ttnpb.EndDeviceIdentifiers{
+							DeviceID:               devID,
+							ApplicationIdentifiers: appID,
+							JoinEUI:                &types.EUI64{0x42, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00},
+							DevEUI:                 &types.EUI64{0x42, 0x42, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00},
+							DevAddr:                &devAddr,
+						},
 						Settings: &ttnpb.DownlinkMessage_Request{
 							Request: &ttnpb.TxRequest{
 								Class: ttnpb.CLASS_A,
@@ -1297,6 +1310,12 @@ func handleClassAOTAAUS915FlowTest1_0_3(ctx context.Context, conn *grpc.ClientCo
 					a.So(msg.CorrelationIDs, should.HaveLength, 5) &&
 					a.So(msg, should.Resemble, &ttnpb.DownlinkMessage{
 						RawPayload: bytes.Repeat([]byte{0x42}, 33),
+						EndDeviceIDs: &ttnpb.EndDeviceIdentifiers{
+							DeviceID:               devID,
+							ApplicationIdentifiers: appID,
+							JoinEUI:                &types.EUI64{0x42, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00},
+							DevEUI:                 &types.EUI64{0x42, 0x42, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00},
+						},
 						Settings: &ttnpb.DownlinkMessage_Request{
 							Request: &ttnpb.TxRequest{
 								Class: ttnpb.CLASS_A,
@@ -1667,6 +1686,13 @@ func handleClassAOTAAUS915FlowTest1_0_3(ctx context.Context, conn *grpc.ClientCo
 								})).([]byte)...,
 							)...,
 						),
+						EndDeviceIDs: &ttnpb.EndDeviceIdentifiers{
+							DeviceID:               devID,
+							ApplicationIdentifiers: appID,
+							JoinEUI:                &types.EUI64{0x42, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00},
+							DevEUI:                 &types.EUI64{0x42, 0x42, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00},
+							DevAddr:                &devAddr,
+						},
 						Settings: &ttnpb.DownlinkMessage_Request{
 							Request: &ttnpb.TxRequest{
 								Class: ttnpb.CLASS_A,
@@ -62,6 +62,8 @@ var (
 	EvtForwardJoinRequest      = evtForwardJoinRequest
 	EvtMergeMetadata           = evtMergeMetadata
 	EvtReceiveLinkCheckRequest = evtReceiveLinkCheckRequest
+	EvtTransmitDownlinkFail    = evtTransmitDownlinkFail
+	EvtTransmitDownlinkSuccess = evtTransmitDownlinkSuccess
 	EvtUpdateEndDevice         = evtUpdateEndDevice
 
 	Timeout = (1 << 10) * test.Delay
@@ -533,10 +535,11 @@ var _ DeviceRegistry = MockDeviceRegistry{}
 
 // MockDeviceRegistry is a mock DeviceRegistry used for testing.
 type MockDeviceRegistry struct {
-	GetByEUIFunc    func(ctx context.Context, joinEUI, devEUI types.EUI64, paths []string) (*ttnpb.EndDevice, context.Context, error)
-	GetByIDFunc     func(ctx context.Context, appID ttnpb.ApplicationIdentifiers, devID string, paths []string) (*ttnpb.EndDevice, context.Context, error)
-	RangeByAddrFunc func(ctx context.Context, devAddr types.DevAddr, paths []string, f func(context.Context, *ttnpb.EndDevice) bool) error
-	SetByIDFunc     func(ctx context.Context, appID ttnpb.ApplicationIdentifiers, devID string, paths []string, f func(context.Context, *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error)) (*ttnpb.EndDevice, context.Context, error)
+	GetByEUIFunc      func(ctx context.Context, joinEUI, devEUI types.EUI64, paths []string) (*ttnpb.EndDevice, context.Context, error)
+	GetByIDFunc       func(ctx context.Context, appID ttnpb.ApplicationIdentifiers, devID string, paths []string) (*ttnpb.EndDevice, context.Context, error)
+	RangeByAddrFunc   func(ctx context.Context, devAddr types.DevAddr, paths []string, f func(context.Context, *ttnpb.EndDevice) bool) error
+	RangeByDevEUIFunc func(ctx context.Context, devEUI types.EUI64, paths []string, f func(context.Context, *ttnpb.EndDevice) bool) error
+	SetByIDFunc       func(ctx context.Context, appID ttnpb.ApplicationIdentifiers, devID string, paths []string, f func(context.Context, *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error)) (*ttnpb.EndDevice, context.Context, error)
 }
 
 // GetByEUI calls GetByEUIFunc if set and panics otherwise.
@@ -563,6 +566,14 @@ func (m MockDeviceRegistry) RangeByAddr(ctx context.Context, devAddr types.DevAd
 	return m.RangeByAddrFunc(ctx, devAddr, paths, f)
 }
 
+// RangeByDevEUI calls RangeByDevEUIFunc if set and panics otherwise.
+func (m MockDeviceRegistry) RangeByDevEUI(ctx context.Context, devEUI types.EUI64, paths []string, f func(context.Context, *ttnpb.EndDevice) bool) error {
+	if m.RangeByDevEUIFunc == nil {
+		panic("RangeByDevEUI called, but not set")
+	}
+	return m.RangeByDevEUIFunc(ctx, devEUI, paths, f)
+}
+
 // SetByID calls SetByIDFunc if set and panics otherwise.
 func (m MockDeviceRegistry) SetByID(ctx context.Context, appID ttnpb.ApplicationIdentifiers, devID string, paths []string, f func(context.Context, *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error)) (*ttnpb.EndDevice, context.Context, error) {
 	if m.SetByIDFunc == nil {
@@ -1433,6 +1444,17 @@ func NewRedisDeviceRegistry(t testing.TB) (DeviceRegistry, func() error) {
 		}
 }
 
+func NewRedisEndDeviceProfileRegistry(t testing.TB) (EndDeviceProfileRegistry, func() error) {
+	cl, flush := test.NewRedis(t, append(redisNamespace[:], "profiles")...)
+	return &redis.EndDeviceProfileRegistry{
+			Redis: cl,
+		},
+		func() error {
+			flush()
+			return cl.Close()
+		}
+}
+
 func NewRedisDownlinkTaskQueue(t testing.TB) (DownlinkTaskQueue, func() error) {
 	a := assertions.New(t)
 
@@ -136,6 +136,18 @@ var (
 		"ns.up.rejoin.forward", "forward rejoin-request",
 		ttnpb.RIGHT_APPLICATION_TRAFFIC_READ,
 	)
+	evtRecoverSession = events.Define(
+		"ns.session.recover", "recover session",
+		ttnpb.RIGHT_APPLICATION_TRAFFIC_READ,
+	)
+	evtTransmitDownlinkSuccess = events.Define(
+		"ns.down.transmission.success", "downlink message transmitted by gateway",
+		ttnpb.RIGHT_APPLICATION_TRAFFIC_READ,
+	)
+	evtTransmitDownlinkFail = events.Define(
+		"ns.down.transmission.fail", "downlink message transmission by gateway failed",
+		ttnpb.RIGHT_APPLICATION_TRAFFIC_READ,
+	)
 	evtEnqueueProprietaryMACAnswer  = defineEnqueueMACAnswerEvent("proprietary", "proprietary MAC command")
 	evtEnqueueProprietaryMACRequest = defineEnqueueMACRequestEvent("proprietary", "proprietary MAC command")
 	evtReceiveProprietaryMAC        = events.Define(
@@ -196,6 +208,14 @@ var nsMetrics = &messageMetrics{
 		},
 		[]string{},
 	),
+	deviceRegistryConflicts: metrics.NewContextualCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: subsystem,
+			Name:      "device_registry_conflicts_total",
+			Help:      "Total number of device registry transactions that conflicted with a concurrent update",
+		},
+		[]string{messageType},
+	),
 }
 
 func init() {
@@ -208,6 +228,8 @@ type messageMetrics struct {
 	uplinkForwarded      *metrics.ContextualCounterVec
 	uplinkDropped        *metrics.ContextualCounterVec
 	uplinkGateways       *metrics.ContextualHistogramVec
+
+	deviceRegistryConflicts *metrics.ContextualCounterVec
 }
 
 func (m messageMetrics) Describe(ch chan<- *prometheus.Desc) {
@@ -216,6 +238,7 @@ func (m messageMetrics) Describe(ch chan<- *prometheus.Desc) {
 	m.uplinkForwarded.Describe(ch)
 	m.uplinkDropped.Describe(ch)
 	m.uplinkGateways.Describe(ch)
+	m.deviceRegistryConflicts.Describe(ch)
 }
 
 func (m messageMetrics) Collect(ch chan<- prometheus.Metric) {
@@ -224,6 +247,7 @@ func (m messageMetrics) Collect(ch chan<- prometheus.Metric) {
 	m.uplinkForwarded.Collect(ch)
 	m.uplinkDropped.Collect(ch)
 	m.uplinkGateways.Collect(ch)
+	m.deviceRegistryConflicts.Collect(ch)
 }
 
 func uplinkMTypeLabel(msg *ttnpb.UplinkMessage) string {
@@ -282,3 +306,7 @@ func registerDropRejoinRequest(ctx context.Context, msg *ttnpb.UplinkMessage, er
 		nsMetrics.uplinkDropped.WithLabelValues(ctx, uplinkMTypeLabel(msg), unknown).Inc()
 	}
 }
+
+func registerDeviceRegistryConflict(ctx context.Context, msg *ttnpb.UplinkMessage) {
+	nsMetrics.deviceRegistryConflicts.WithLabelValues(ctx, uplinkMTypeLabel(msg)).Inc()
+}
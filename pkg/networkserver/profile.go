@@ -0,0 +1,104 @@
+// Copyright © 2026 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkserver
+
+import (
+	"context"
+
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/log"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/unique"
+)
+
+var (
+	errEndDeviceProfilesNotConfigured = errors.DefineFailedPrecondition("end_device_profiles_not_configured", "end device profiles are not configured")
+	errProfileApplicationMismatch     = errors.DefineInvalidArgument("profile_application_mismatch", "profile of application `{profile_application_uid}` can not be used by end devices of application `{application_uid}`")
+)
+
+// mergeMACSettings returns the defaults with the settings that are set in overrides.
+func mergeMACSettings(defaults ttnpb.MACSettings, overrides *ttnpb.MACSettings) (ttnpb.MACSettings, error) {
+	if overrides == nil {
+		return defaults, nil
+	}
+	zero := ttnpb.MACSettings{}
+	for _, p := range ttnpb.MACSettingsFieldPathsTopLevel {
+		var v ttnpb.MACSettings
+		if err := v.SetFields(overrides, p); err != nil {
+			return ttnpb.MACSettings{}, err
+		}
+		if v.Equal(&zero) {
+			continue
+		}
+		if err := defaults.SetFields(&v, p); err != nil {
+			return ttnpb.MACSettings{}, err
+		}
+	}
+	return defaults, nil
+}
+
+// endDeviceDefaultMACSettings returns the MAC settings to fall back to if not specified by the device.
+// These are the MAC settings of the profile of the device merged over the default MAC settings of the Network Server.
+// The device must contain the profile_ids field.
+func (ns *NetworkServer) endDeviceDefaultMACSettings(ctx context.Context, dev *ttnpb.EndDevice) ttnpb.MACSettings {
+	if dev.ProfileIDs == nil || ns.endDeviceProfiles == nil {
+		return ns.defaultMACSettings
+	}
+	logger := log.FromContext(ctx).WithField("profile_id", dev.ProfileIDs.ProfileID)
+	profile, err := ns.endDeviceProfiles.Get(ctx, *dev.ProfileIDs, []string{"mac_settings"})
+	if err != nil {
+		logger.WithError(err).Warn("Failed to get end device profile, use default MAC settings")
+		return ns.defaultMACSettings
+	}
+	macSettings, err := mergeMACSettings(ns.defaultMACSettings, profile.MACSettings)
+	if err != nil {
+		logger.WithError(err).Warn("Failed to merge MAC settings of end device profile, use default MAC settings")
+		return ns.defaultMACSettings
+	}
+	return macSettings
+}
+
+// getEndDeviceProfile returns the end device profile, if it can be used by end devices of the application.
+func (ns *NetworkServer) getEndDeviceProfile(ctx context.Context, appIDs ttnpb.ApplicationIdentifiers, ids ttnpb.EndDeviceProfileIdentifiers, paths []string) (*ttnpb.EndDeviceProfile, error) {
+	if ns.endDeviceProfiles == nil {
+		return nil, errEndDeviceProfilesNotConfigured
+	}
+	if ids.ApplicationIDs != nil && ids.ApplicationIDs.ApplicationID != appIDs.ApplicationID {
+		return nil, errProfileApplicationMismatch.WithAttributes(
+			"profile_application_uid", unique.ID(ctx, *ids.ApplicationIDs),
+			"application_uid", unique.ID(ctx, appIDs),
+		)
+	}
+	return ns.endDeviceProfiles.Get(ctx, ids, paths)
+}
+
+// applyEndDeviceProfile sets the LoRaWAN versions and frequency plan of the profile in the end device, if these are
+// not set by sets and are set in the profile. It returns the paths that are set.
+// Unlike MAC settings, these fields are stored in the end device, as the MAC state of the end device depends on them.
+func applyEndDeviceProfile(dev *ttnpb.EndDevice, sets []string, profile *ttnpb.EndDeviceProfile) []string {
+	if !ttnpb.HasAnyField(sets, "lorawan_version") && profile.LoRaWANVersion != ttnpb.MAC_UNKNOWN {
+		dev.LoRaWANVersion = profile.LoRaWANVersion
+		sets = ttnpb.AddFields(sets, "lorawan_version")
+	}
+	if !ttnpb.HasAnyField(sets, "lorawan_phy_version") && profile.LoRaWANPHYVersion != ttnpb.PHY_UNKNOWN {
+		dev.LoRaWANPHYVersion = profile.LoRaWANPHYVersion
+		sets = ttnpb.AddFields(sets, "lorawan_phy_version")
+	}
+	if !ttnpb.HasAnyField(sets, "frequency_plan_id") && profile.FrequencyPlanID != "" {
+		dev.FrequencyPlanID = profile.FrequencyPlanID
+		sets = ttnpb.AddFields(sets, "frequency_plan_id")
+	}
+	return sets
+}
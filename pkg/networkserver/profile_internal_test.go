@@ -0,0 +1,146 @@
+// Copyright © 2026 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkserver
+
+import (
+	"testing"
+	"time"
+
+	pbtypes "github.com/gogo/protobuf/types"
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+func TestMergeMACSettings(t *testing.T) {
+	classBTimeout := time.Minute
+	classCTimeout := 10 * time.Second
+	defaults := ttnpb.MACSettings{
+		ClassBTimeout: &classBTimeout,
+		ClassCTimeout: &classCTimeout,
+		ADRMargin:     &pbtypes.FloatValue{Value: 15},
+	}
+
+	for _, tc := range []struct {
+		Name      string
+		Overrides *ttnpb.MACSettings
+		Expected  ttnpb.MACSettings
+	}{
+		{
+			Name:     "No overrides",
+			Expected: defaults,
+		},
+		{
+			Name:      "Empty overrides",
+			Overrides: &ttnpb.MACSettings{},
+			Expected:  defaults,
+		},
+		{
+			Name: "Overrides",
+			Overrides: &ttnpb.MACSettings{
+				ADRMargin:                &pbtypes.FloatValue{Value: 10},
+				UseADR:                   &pbtypes.BoolValue{Value: false},
+				FactoryPresetFrequencies: []uint64{868100000, 868300000},
+			},
+			Expected: ttnpb.MACSettings{
+				ClassBTimeout:            &classBTimeout,
+				ClassCTimeout:            &classCTimeout,
+				ADRMargin:                &pbtypes.FloatValue{Value: 10},
+				UseADR:                   &pbtypes.BoolValue{Value: false},
+				FactoryPresetFrequencies: []uint64{868100000, 868300000},
+			},
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+			merged, err := mergeMACSettings(defaults, tc.Overrides)
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			a.So(merged, should.Resemble, tc.Expected)
+		})
+	}
+	a := assertions.New(t)
+	a.So(defaults.ADRMargin.Value, should.Equal, 15)
+}
+
+func TestApplyEndDeviceProfile(t *testing.T) {
+	profile := &ttnpb.EndDeviceProfile{
+		LoRaWANVersion:    ttnpb.MAC_V1_0_3,
+		LoRaWANPHYVersion: ttnpb.PHY_V1_0_3_REV_A,
+		FrequencyPlanID:   "EU_863_870",
+	}
+
+	for _, tc := range []struct {
+		Name           string
+		Device         ttnpb.EndDevice
+		Sets           []string
+		Profile        *ttnpb.EndDeviceProfile
+		ExpectedDevice ttnpb.EndDevice
+		ExpectedSets   []string
+	}{
+		{
+			Name:    "Empty profile",
+			Sets:    []string{"supports_join"},
+			Profile: &ttnpb.EndDeviceProfile{},
+			ExpectedSets: []string{
+				"supports_join",
+			},
+		},
+		{
+			Name:    "No device values",
+			Sets:    []string{"supports_join"},
+			Profile: profile,
+			ExpectedDevice: ttnpb.EndDevice{
+				LoRaWANVersion:    ttnpb.MAC_V1_0_3,
+				LoRaWANPHYVersion: ttnpb.PHY_V1_0_3_REV_A,
+				FrequencyPlanID:   "EU_863_870",
+			},
+			ExpectedSets: []string{
+				"supports_join",
+				"lorawan_version",
+				"lorawan_phy_version",
+				"frequency_plan_id",
+			},
+		},
+		{
+			Name: "Device values",
+			Device: ttnpb.EndDevice{
+				LoRaWANVersion:  ttnpb.MAC_V1_1,
+				FrequencyPlanID: "EU_863_870_TTN",
+			},
+			Sets:    []string{"frequency_plan_id", "lorawan_version"},
+			Profile: profile,
+			ExpectedDevice: ttnpb.EndDevice{
+				LoRaWANVersion:    ttnpb.MAC_V1_1,
+				LoRaWANPHYVersion: ttnpb.PHY_V1_0_3_REV_A,
+				FrequencyPlanID:   "EU_863_870_TTN",
+			},
+			ExpectedSets: []string{
+				"frequency_plan_id",
+				"lorawan_version",
+				"lorawan_phy_version",
+			},
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+			dev := tc.Device
+			sets := applyEndDeviceProfile(&dev, tc.Sets, tc.Profile)
+			a.So(dev, should.Resemble, tc.ExpectedDevice)
+			a.So(sets, should.Resemble, tc.ExpectedSets)
+		})
+	}
+}
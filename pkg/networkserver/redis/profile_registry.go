@@ -0,0 +1,229 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis
+
+import (
+	"context"
+	"runtime/trace"
+	"time"
+
+	"github.com/go-redis/redis"
+	"github.com/gogo/protobuf/proto"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	ttnredis "go.thethings.network/lorawan-stack/pkg/redis"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/unique"
+)
+
+// appendImplicitEndDeviceProfileGetPaths appends implicit ttnpb.EndDeviceProfile get paths to paths.
+func appendImplicitEndDeviceProfileGetPaths(paths ...string) []string {
+	return append(append(make([]string, 0, 4+len(paths)),
+		"created_at",
+		"ids",
+		"updated_at",
+		"version",
+	), paths...)
+}
+
+func applyEndDeviceProfileFieldMask(dst, src *ttnpb.EndDeviceProfile, paths ...string) (*ttnpb.EndDeviceProfile, error) {
+	if dst == nil {
+		dst = &ttnpb.EndDeviceProfile{}
+	}
+	return dst, dst.SetFields(src, paths...)
+}
+
+// EndDeviceProfileRegistry is an implementation of networkserver.EndDeviceProfileRegistry.
+type EndDeviceProfileRegistry struct {
+	Redis *ttnredis.Client
+}
+
+// setKey returns the key of the set of profile IDs of the application, or of the global profiles if appIDs is nil.
+func (r *EndDeviceProfileRegistry) setKey(ctx context.Context, appIDs *ttnpb.ApplicationIdentifiers) string {
+	if appIDs == nil {
+		return r.Redis.Key("global")
+	}
+	return r.Redis.Key("uid", unique.ID(ctx, *appIDs))
+}
+
+func (r *EndDeviceProfileRegistry) makeIDKeyFunc(ctx context.Context, appIDs *ttnpb.ApplicationIdentifiers) func(id string) string {
+	setKey := r.setKey(ctx, appIDs)
+	return func(id string) string {
+		return ttnredis.Key(setKey, id)
+	}
+}
+
+func (r *EndDeviceProfileRegistry) idKey(ctx context.Context, ids ttnpb.EndDeviceProfileIdentifiers) string {
+	return r.makeIDKeyFunc(ctx, ids.ApplicationIDs)(ids.ProfileID)
+}
+
+// Get implements networkserver.EndDeviceProfileRegistry.
+func (r *EndDeviceProfileRegistry) Get(ctx context.Context, ids ttnpb.EndDeviceProfileIdentifiers, paths []string) (*ttnpb.EndDeviceProfile, error) {
+	defer trace.StartRegion(ctx, "get end device profile").End()
+
+	pb := &ttnpb.EndDeviceProfile{}
+	if err := ttnredis.GetProto(r.Redis, r.idKey(ctx, ids)).ScanProto(pb); err != nil {
+		return nil, err
+	}
+	return applyEndDeviceProfileFieldMask(nil, pb, appendImplicitEndDeviceProfileGetPaths(paths...)...)
+}
+
+// List implements networkserver.EndDeviceProfileRegistry.
+func (r *EndDeviceProfileRegistry) List(ctx context.Context, appIDs *ttnpb.ApplicationIdentifiers, paths []string) ([]*ttnpb.EndDeviceProfile, error) {
+	defer trace.StartRegion(ctx, "list end device profiles").End()
+
+	var pbs []*ttnpb.EndDeviceProfile
+	err := ttnredis.FindProtos(r.Redis, r.setKey(ctx, appIDs), r.makeIDKeyFunc(ctx, appIDs)).Range(func() (proto.Message, func() (bool, error)) {
+		pb := &ttnpb.EndDeviceProfile{}
+		return pb, func() (bool, error) {
+			pb, err := applyEndDeviceProfileFieldMask(nil, pb, appendImplicitEndDeviceProfileGetPaths(paths...)...)
+			if err != nil {
+				return false, err
+			}
+			pbs = append(pbs, pb)
+			return true, nil
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	return pbs, nil
+}
+
+// Set implements networkserver.EndDeviceProfileRegistry.
+// The version of the profile is incremented on every update.
+func (r *EndDeviceProfileRegistry) Set(ctx context.Context, ids ttnpb.EndDeviceProfileIdentifiers, gets []string, f func(*ttnpb.EndDeviceProfile) (*ttnpb.EndDeviceProfile, []string, error)) (*ttnpb.EndDeviceProfile, error) {
+	defer trace.StartRegion(ctx, "set end device profile").End()
+
+	sk := r.setKey(ctx, ids.ApplicationIDs)
+	ik := r.idKey(ctx, ids)
+
+	var pb *ttnpb.EndDeviceProfile
+	err := r.Redis.Watch(func(tx *redis.Tx) error {
+		cmd := ttnredis.GetProto(tx, ik)
+		stored := &ttnpb.EndDeviceProfile{}
+		if err := cmd.ScanProto(stored); errors.IsNotFound(err) {
+			stored = nil
+		} else if err != nil {
+			return err
+		}
+
+		gets = appendImplicitEndDeviceProfileGetPaths(gets...)
+
+		var err error
+		if stored != nil {
+			pb = &ttnpb.EndDeviceProfile{}
+			if err := cmd.ScanProto(pb); err != nil {
+				return err
+			}
+			pb, err = applyEndDeviceProfileFieldMask(nil, pb, gets...)
+			if err != nil {
+				return err
+			}
+		}
+
+		var sets []string
+		pb, sets, err = f(pb)
+		if err != nil {
+			return err
+		}
+		if stored == nil && pb == nil {
+			return nil
+		}
+		if pb != nil && len(sets) == 0 {
+			pb, err = applyEndDeviceProfileFieldMask(nil, stored, gets...)
+			return err
+		}
+
+		var pipelined func(redis.Pipeliner) error
+		if pb == nil && len(sets) == 0 {
+			pipelined = func(p redis.Pipeliner) error {
+				p.Del(ik)
+				p.SRem(sk, stored.ProfileID)
+				return nil
+			}
+		} else {
+			if pb == nil {
+				pb = &ttnpb.EndDeviceProfile{}
+			}
+
+			pb.UpdatedAt = time.Now().UTC()
+			sets = append(append(sets[:0:0], sets...),
+				"updated_at",
+				"version",
+			)
+
+			updated := &ttnpb.EndDeviceProfile{}
+			if stored == nil {
+				if err := ttnpb.RequireFields(sets,
+					"ids.profile_id",
+				); err != nil {
+					return errInvalidFieldmask.WithCause(err)
+				}
+
+				pb.CreatedAt = pb.UpdatedAt
+				pb.Version = 1
+				sets = append(sets, "created_at")
+
+				updated, err = applyEndDeviceProfileFieldMask(updated, pb, sets...)
+				if err != nil {
+					return err
+				}
+				if !updated.ApplicationIDs.Equal(ids.ApplicationIDs) || updated.ProfileID != ids.ProfileID {
+					return errInvalidIdentifiers
+				}
+			} else {
+				if ttnpb.HasAnyField(sets, "ids.application_ids") && !pb.ApplicationIDs.Equal(stored.ApplicationIDs) {
+					return errReadOnlyField.WithAttributes("field", "ids.application_ids")
+				}
+				if ttnpb.HasAnyField(sets, "ids.profile_id") && pb.ProfileID != stored.ProfileID {
+					return errReadOnlyField.WithAttributes("field", "ids.profile_id")
+				}
+				pb.Version = stored.Version + 1
+				if err := cmd.ScanProto(updated); err != nil {
+					return err
+				}
+				updated, err = applyEndDeviceProfileFieldMask(updated, pb, sets...)
+				if err != nil {
+					return err
+				}
+			}
+			if err := updated.ValidateFields(sets...); err != nil {
+				return err
+			}
+
+			pipelined = func(p redis.Pipeliner) error {
+				if _, err := ttnredis.SetProto(p, ik, updated, 0); err != nil {
+					return err
+				}
+				p.SAdd(sk, updated.ProfileID)
+				return nil
+			}
+
+			pb, err = applyEndDeviceProfileFieldMask(nil, updated, gets...)
+			if err != nil {
+				return err
+			}
+		}
+		_, err = tx.Pipelined(pipelined)
+		if err != nil {
+			return err
+		}
+		return nil
+	}, ik)
+	if err != nil {
+		return nil, err
+	}
+	return pb, nil
+}
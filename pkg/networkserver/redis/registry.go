@@ -52,6 +52,10 @@ func (r *DeviceRegistry) euiKey(joinEUI, devEUI types.EUI64) string {
 	return r.Redis.Key("eui", joinEUI.String(), devEUI.String())
 }
 
+func (r *DeviceRegistry) devEUIKey(devEUI types.EUI64) string {
+	return r.Redis.Key("dev_eui", devEUI.String())
+}
+
 // GetByID gets device by appID, devID.
 func (r *DeviceRegistry) GetByID(ctx context.Context, appID ttnpb.ApplicationIdentifiers, devID string, paths []string) (*ttnpb.EndDevice, context.Context, error) {
 	ids := ttnpb.EndDeviceIdentifiers{
@@ -108,6 +112,22 @@ func (r *DeviceRegistry) RangeByAddr(ctx context.Context, addr types.DevAddr, pa
 	})
 }
 
+// RangeByDevEUI ranges over devices by DevEUI.
+func (r *DeviceRegistry) RangeByDevEUI(ctx context.Context, devEUI types.EUI64, paths []string, f func(context.Context, *ttnpb.EndDevice) bool) error {
+	defer trace.StartRegion(ctx, "range end devices by dev_eui").End()
+
+	return ttnredis.FindProtos(r.Redis, r.devEUIKey(devEUI), r.uidKey).Range(func() (proto.Message, func() (bool, error)) {
+		pb := &ttnpb.EndDevice{}
+		return pb, func() (bool, error) {
+			pb, err := ttnpb.FilterGetEndDevice(pb, paths...)
+			if err != nil {
+				return false, err
+			}
+			return f(ctx, pb), nil
+		}
+	})
+}
+
 func getDevAddrs(pb *ttnpb.EndDevice) (addrs struct{ current, pending *types.DevAddr }) {
 	if pb == nil {
 		return
@@ -203,6 +223,9 @@ func (r *DeviceRegistry) SetByID(ctx context.Context, appID ttnpb.ApplicationIde
 				if stored.JoinEUI != nil && stored.DevEUI != nil {
 					p.Del(r.euiKey(*stored.JoinEUI, *stored.DevEUI))
 				}
+				if stored.DevEUI != nil {
+					p.SRem(r.devEUIKey(*stored.DevEUI), uid)
+				}
 				if stored.PendingSession != nil {
 					p.SRem(r.addrKey(stored.PendingSession.DevAddr), uid)
 				}
@@ -284,6 +307,10 @@ func (r *DeviceRegistry) SetByID(ctx context.Context, appID ttnpb.ApplicationIde
 				if err != nil {
 					return err
 				}
+				if updated.DevEUI != nil {
+					// NOTE: The DevEUI index is (re)populated on each update, since it was not maintained by earlier versions.
+					p.SAdd(r.devEUIKey(*updated.DevEUI), uid)
+				}
 
 				storedAddrs := getDevAddrs(stored)
 				updatedAddrs := getDevAddrs(updated)
@@ -314,6 +341,9 @@ func (r *DeviceRegistry) SetByID(ctx context.Context, appID ttnpb.ApplicationIde
 		}
 		return nil
 	}, uk)
+	if err == redis.TxFailedErr {
+		return nil, ctx, ttnredis.ConvertError(err)
+	}
 	if err != nil {
 		return nil, ctx, err
 	}
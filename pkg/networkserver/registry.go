@@ -16,15 +16,62 @@ package networkserver
 
 import (
 	"context"
+	"time"
 
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/log"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
 	"go.thethings.network/lorawan-stack/pkg/types"
 )
 
 // DeviceRegistry is a registry, containing devices.
+// SetByID returns an error with code Aborted if the transaction conflicted with a concurrent update of the device.
 type DeviceRegistry interface {
 	GetByEUI(ctx context.Context, joinEUI, devEUI types.EUI64, paths []string) (*ttnpb.EndDevice, context.Context, error)
 	GetByID(ctx context.Context, appID ttnpb.ApplicationIdentifiers, devID string, paths []string) (*ttnpb.EndDevice, context.Context, error)
 	RangeByAddr(ctx context.Context, devAddr types.DevAddr, paths []string, f func(context.Context, *ttnpb.EndDevice) bool) error
+	RangeByDevEUI(ctx context.Context, devEUI types.EUI64, paths []string, f func(context.Context, *ttnpb.EndDevice) bool) error
 	SetByID(ctx context.Context, appID ttnpb.ApplicationIdentifiers, devID string, paths []string, f func(context.Context, *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error)) (*ttnpb.EndDevice, context.Context, error)
 }
+
+var (
+	// deviceRegistryRetryAttempts is the maximum number of attempts of a device registry transaction in the uplink path.
+	deviceRegistryRetryAttempts = 4
+	// deviceRegistryRetryBackoff is the backoff before the first retry of a device registry transaction.
+	// The backoff doubles on every subsequent retry.
+	deviceRegistryRetryBackoff = 10 * time.Millisecond
+)
+
+// setDeviceByIDOnUplink calls r.SetByID and retries the transaction with exponential backoff if it conflicted with
+// a concurrent update of the device. f may be called multiple times.
+func setDeviceByIDOnUplink(ctx context.Context, r DeviceRegistry, up *ttnpb.UplinkMessage, appID ttnpb.ApplicationIdentifiers, devID string, paths []string, f func(context.Context, *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error)) (*ttnpb.EndDevice, context.Context, error) {
+	backoff := deviceRegistryRetryBackoff
+	for attempt := 1; ; attempt++ {
+		dev, retCtx, err := r.SetByID(ctx, appID, devID, paths, f)
+		if err == nil || !errors.IsAborted(err) {
+			return dev, retCtx, err
+		}
+		registerDeviceRegistryConflict(ctx, up)
+		if attempt >= deviceRegistryRetryAttempts {
+			return dev, retCtx, err
+		}
+		log.FromContext(ctx).WithError(err).WithFields(log.Fields(
+			"attempt", attempt,
+			"backoff", backoff,
+		)).Debug("Device registry transaction conflicted, retry")
+		select {
+		case <-ctx.Done():
+			return nil, ctx, ctx.Err()
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+	}
+}
+
+// EndDeviceProfileRegistry is a registry, containing end device profiles.
+// Profiles without application identifiers are global profiles.
+type EndDeviceProfileRegistry interface {
+	Get(ctx context.Context, ids ttnpb.EndDeviceProfileIdentifiers, paths []string) (*ttnpb.EndDeviceProfile, error)
+	List(ctx context.Context, appIDs *ttnpb.ApplicationIdentifiers, paths []string) ([]*ttnpb.EndDeviceProfile, error)
+	Set(ctx context.Context, ids ttnpb.EndDeviceProfileIdentifiers, paths []string, f func(*ttnpb.EndDeviceProfile) (*ttnpb.EndDeviceProfile, []string, error)) (*ttnpb.EndDeviceProfile, error)
+}
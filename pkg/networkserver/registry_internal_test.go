@@ -0,0 +1,114 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkserver
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+func TestSetDeviceByIDOnUplink(t *testing.T) {
+	defer func(backoff time.Duration) { deviceRegistryRetryBackoff = backoff }(deviceRegistryRetryBackoff)
+	deviceRegistryRetryBackoff = test.Delay
+
+	errConflict := errors.DefineAborted("test_registry_conflict", "conflict")
+	errOther := errors.DefineUnavailable("test_registry_other", "other")
+
+	up := &ttnpb.UplinkMessage{
+		Payload: &ttnpb.Message{
+			MHDR: ttnpb.MHDR{
+				MType: ttnpb.MType_UNCONFIRMED_UP,
+			},
+		},
+	}
+	appID := ttnpb.ApplicationIdentifiers{ApplicationID: "test-app-id"}
+
+	for _, tc := range []struct {
+		Name          string
+		Errors        []error
+		ExpectedError *errors.Definition
+		ExpectedCalls int
+	}{
+		{
+			Name:          "Success",
+			Errors:        []error{nil},
+			ExpectedCalls: 1,
+		},
+		{
+			Name:          "Conflict/Success",
+			Errors:        []error{errConflict, errConflict, nil},
+			ExpectedCalls: 3,
+		},
+		{
+			Name:          "Other error",
+			Errors:        []error{errOther},
+			ExpectedError: &errOther,
+			ExpectedCalls: 1,
+		},
+		{
+			Name:          "Conflict/Other error",
+			Errors:        []error{errConflict, errOther},
+			ExpectedError: &errOther,
+			ExpectedCalls: 2,
+		},
+		{
+			Name:          "Conflict/Retries exceeded",
+			Errors:        []error{errConflict, errConflict, errConflict, errConflict, nil},
+			ExpectedError: &errConflict,
+			ExpectedCalls: deviceRegistryRetryAttempts,
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+
+			var calls int
+			reg := MockDeviceRegistry{
+				SetByIDFunc: func(ctx context.Context, _ ttnpb.ApplicationIdentifiers, devID string, _ []string, f func(context.Context, *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error)) (*ttnpb.EndDevice, context.Context, error) {
+					err := tc.Errors[calls]
+					calls++
+					if err != nil {
+						return nil, ctx, err
+					}
+					dev, _, err := f(ctx, &ttnpb.EndDevice{})
+					return dev, ctx, err
+				},
+			}
+			var fCalls int
+			dev, _, err := setDeviceByIDOnUplink(test.Context(), reg, up, appID, "test-dev-id", nil,
+				func(ctx context.Context, dev *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error) {
+					fCalls++
+					return dev, nil, nil
+				},
+			)
+			a.So(calls, should.Equal, tc.ExpectedCalls)
+			if tc.ExpectedError != nil {
+				a.So(err, should.HaveSameErrorDefinitionAs, tc.ExpectedError)
+				a.So(dev, should.BeNil)
+				a.So(fCalls, should.Equal, 0)
+			} else {
+				a.So(err, should.BeNil)
+				a.So(dev, should.NotBeNil)
+				a.So(fCalls, should.Equal, 1)
+			}
+		})
+	}
+}
@@ -20,6 +20,7 @@ import (
 	"testing"
 	"time"
 
+	pbtypes "github.com/gogo/protobuf/types"
 	"github.com/smartystreets/assertions"
 	"go.thethings.network/lorawan-stack/pkg/errors"
 	. "go.thethings.network/lorawan-stack/pkg/networkserver"
@@ -273,6 +274,15 @@ func handleRegistryTest(t *testing.T, reg DeviceRegistry) {
 	a.So(err, should.BeNil)
 	a.So(rets, should.HaveSameElementsDiff, []*ttnpb.EndDevice{pb, pbOther})
 
+	rets = nil
+	err = reg.RangeByDevEUI(ctx, *pb.EndDeviceIdentifiers.DevEUI, ttnpb.EndDeviceFieldPathsTopLevel, func(devCtx context.Context, dev *ttnpb.EndDevice) bool {
+		a.So(devCtx, should.HaveParentContextOrEqual, ctx)
+		rets = append(rets, dev)
+		return true
+	})
+	a.So(err, should.BeNil)
+	a.So(rets, should.HaveSameElementsDiff, []*ttnpb.EndDevice{pb})
+
 	err = DeleteDevice(ctx, reg, pb.EndDeviceIdentifiers.ApplicationIdentifiers, pb.EndDeviceIdentifiers.DeviceID)
 	if !a.So(err, should.BeNil) {
 		t.FailNow()
@@ -310,6 +320,15 @@ func handleRegistryTest(t *testing.T, reg DeviceRegistry) {
 	a.So(err, should.BeNil)
 	a.So(rets, should.HaveSameElementsDiff, []*ttnpb.EndDevice{pbOther})
 
+	rets = nil
+	err = reg.RangeByDevEUI(ctx, *pb.EndDeviceIdentifiers.DevEUI, ttnpb.EndDeviceFieldPathsTopLevel, func(devCtx context.Context, dev *ttnpb.EndDevice) bool {
+		a.So(devCtx, should.HaveParentContextOrEqual, ctx)
+		rets = append(rets, dev)
+		return true
+	})
+	a.So(err, should.BeNil)
+	a.So(rets, should.BeNil)
+
 	err = DeleteDevice(ctx, reg, pbOther.EndDeviceIdentifiers.ApplicationIdentifiers, pbOther.EndDeviceIdentifiers.DeviceID)
 	if !a.So(err, should.BeNil) {
 		t.FailNow()
@@ -382,3 +401,109 @@ func TestRegistries(t *testing.T) {
 		}
 	}
 }
+
+func handleEndDeviceProfileRegistryTest(t *testing.T, reg EndDeviceProfileRegistry) {
+	a := assertions.New(t)
+
+	ctx := test.Context()
+
+	appIDs := &ttnpb.ApplicationIdentifiers{ApplicationID: "test-app"}
+	appProfileIDs := ttnpb.EndDeviceProfileIdentifiers{ApplicationIDs: appIDs, ProfileID: "test-profile"}
+	globalProfileIDs := ttnpb.EndDeviceProfileIdentifiers{ProfileID: "test-profile"}
+
+	ret, err := reg.Get(ctx, appProfileIDs, []string{"name"})
+	if !a.So(err, should.NotBeNil) || !a.So(errors.IsNotFound(err), should.BeTrue) {
+		t.Fatalf("Error received: %v", err)
+	}
+	a.So(ret, should.BeNil)
+
+	set := func(ids ttnpb.EndDeviceProfileIdentifiers, name string) *ttnpb.EndDeviceProfile {
+		ret, err := reg.Set(ctx, ids, []string{"mac_settings", "name"}, func(stored *ttnpb.EndDeviceProfile) (*ttnpb.EndDeviceProfile, []string, error) {
+			return &ttnpb.EndDeviceProfile{
+				EndDeviceProfileIdentifiers: ids,
+				Name:                        name,
+				MACSettings: &ttnpb.MACSettings{
+					UseADR: &pbtypes.BoolValue{Value: true},
+				},
+			}, []string{"ids", "mac_settings", "name"}, nil
+		})
+		if !a.So(err, should.BeNil) || !a.So(ret, should.NotBeNil) {
+			t.Fatalf("Failed to set profile: %v", err)
+		}
+		return ret
+	}
+
+	ret = set(appProfileIDs, "app-profile")
+	a.So(ret.Name, should.Equal, "app-profile")
+	a.So(ret.Version, should.Equal, 1)
+	a.So(ret.CreatedAt, should.Equal, ret.UpdatedAt)
+
+	ret = set(appProfileIDs, "app-profile-updated")
+	a.So(ret.Name, should.Equal, "app-profile-updated")
+	a.So(ret.Version, should.Equal, 2)
+	a.So(ret.UpdatedAt, should.HappenAfter, ret.CreatedAt)
+
+	ret = set(globalProfileIDs, "global-profile")
+	a.So(ret.Name, should.Equal, "global-profile")
+	a.So(ret.Version, should.Equal, 1)
+
+	ret, err = reg.Get(ctx, appProfileIDs, []string{"mac_settings", "name"})
+	a.So(err, should.BeNil)
+	a.So(ret.Name, should.Equal, "app-profile-updated")
+	a.So(ret.MACSettings, should.Resemble, &ttnpb.MACSettings{
+		UseADR: &pbtypes.BoolValue{Value: true},
+	})
+
+	rets, err := reg.List(ctx, appIDs, []string{"name"})
+	a.So(err, should.BeNil)
+	if a.So(rets, should.HaveLength, 1) {
+		a.So(rets[0].EndDeviceProfileIdentifiers, should.Resemble, appProfileIDs)
+	}
+
+	rets, err = reg.List(ctx, nil, []string{"name"})
+	a.So(err, should.BeNil)
+	if a.So(rets, should.HaveLength, 1) {
+		a.So(rets[0].Name, should.Equal, "global-profile")
+	}
+
+	for _, ids := range []ttnpb.EndDeviceProfileIdentifiers{appProfileIDs, globalProfileIDs} {
+		_, err = reg.Set(ctx, ids, nil, func(*ttnpb.EndDeviceProfile) (*ttnpb.EndDeviceProfile, []string, error) { return nil, nil, nil })
+		a.So(err, should.BeNil)
+		_, err = reg.Get(ctx, ids, nil)
+		a.So(errors.IsNotFound(err), should.BeTrue)
+	}
+
+	rets, err = reg.List(ctx, appIDs, nil)
+	a.So(err, should.BeNil)
+	a.So(rets, should.BeEmpty)
+}
+
+func TestEndDeviceProfileRegistries(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		Name string
+		New  func(t testing.TB) (reg EndDeviceProfileRegistry, closeFn func() error)
+	}{
+		{
+			Name: "Redis",
+			New:  NewRedisEndDeviceProfileRegistry,
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			reg, closeFn := tc.New(t)
+			if closeFn != nil {
+				defer func() {
+					if err := closeFn(); err != nil {
+						t.Errorf("Failed to close registry: %s", err)
+					}
+				}()
+			}
+			t.Run("1st run", func(t *testing.T) { handleEndDeviceProfileRegistryTest(t, reg) })
+			if t.Failed() {
+				t.Skip("Skipping 2nd run")
+			}
+			t.Run("2nd run", func(t *testing.T) { handleEndDeviceProfileRegistryTest(t, reg) })
+		})
+	}
+}
@@ -159,6 +159,7 @@ func deviceNeedsMACRequestsAt(ctx context.Context, dev *ttnpb.EndDevice, t time.
 		deviceNeedsDevStatusReq(dev, defaults, t),
 		deviceNeedsDLChannelReq(dev),
 		deviceNeedsDutyCycleReq(dev),
+		deviceNeedsForceRejoinReq(dev),
 		deviceNeedsLinkADRReq(dev),
 		deviceNeedsNewChannelReq(dev),
 		deviceNeedsPingSlotChannelReq(dev),
@@ -601,7 +602,6 @@ func newMACState(dev *ttnpb.EndDevice, fps *frequencyplans.Store, defaults ttnpb
 		macState.DesiredParameters.MaxDutyCycle = macState.CurrentParameters.MaxDutyCycle
 	}
 
-	// TODO: Support rejoins. (https://github.com/TheThingsNetwork/lorawan-stack/issues/8)
 	macState.CurrentParameters.RejoinTimePeriodicity = ttnpb.REJOIN_TIME_0
 	macState.DesiredParameters.RejoinTimePeriodicity = macState.CurrentParameters.RejoinTimePeriodicity
 
@@ -0,0 +1,462 @@
+// Copyright © 2026 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oauth
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	echo "github.com/labstack/echo/v4"
+	"github.com/openshift/osin"
+	"go.thethings.network/lorawan-stack/pkg/auth"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/events"
+	"go.thethings.network/lorawan-stack/pkg/jsonpb"
+	"go.thethings.network/lorawan-stack/pkg/random"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+)
+
+const (
+	// DeviceCodeGrantType is the grant type used to exchange a device code for an access token, as specified in RFC 8628.
+	DeviceCodeGrantType = "urn:ietf:params:oauth:grant-type:device_code"
+
+	deviceCodeExpiration   = 10 * time.Minute
+	deviceCodePollInterval = 5 * time.Second
+	// deviceCodeSlowDownIncrease is the increase of the poll interval after each slow_down error,
+	// as specified in RFC 8628, section 3.5.
+	deviceCodeSlowDownIncrease = 5 * time.Second
+
+	// userCodeCharset contains only consonants, so that user codes are easy to type and do not spell words.
+	userCodeCharset = "BCDFGHJKLMNPQRSTVWXZ"
+	userCodeLength  = 8
+
+	// userCodeAttemptLimit is the number of invalid user codes that can be entered per session and per IP address
+	// within the userCodeAttemptWindow, as recommended in RFC 8628, section 5.1.
+	userCodeAttemptLimit  = 5
+	userCodeAttemptWindow = 10 * time.Minute
+)
+
+var (
+	errAuthorizationPending = errors.DefineFailedPrecondition("authorization_pending", "device authorization is pending")
+	errExpiredToken         = errors.DefinePermissionDenied("expired_token", "device code expired")
+	errDeviceAccessDenied   = errors.DefinePermissionDenied("device_access_denied", "device authorization was denied")
+	errInvalidDeviceCode    = errors.DefinePermissionDenied("invalid_device_code", "invalid device code")
+	errInvalidUserCode      = errors.DefineNotFound("invalid_user_code", "invalid or expired user code")
+	errSlowDown             = errors.DefineResourceExhausted("slow_down", "device code polled too often, slow down")
+	errUserCodeAttempts     = errors.DefineResourceExhausted("user_code_attempts", "too many invalid user codes, try again later")
+)
+
+// generateUserCode generates a user code of the form XXXX-XXXX.
+func generateUserCode() string {
+	var b strings.Builder
+	for i := 0; i < userCodeLength; i++ {
+		if i == userCodeLength/2 {
+			b.WriteByte('-')
+		}
+		b.WriteByte(userCodeCharset[random.Intn(len(userCodeCharset))])
+	}
+	return b.String()
+}
+
+// normalizeUserCode normalizes the user code as entered by the user to the form XXXX-XXXX.
+// Characters that are not in the user code charset, such as dashes and spaces, are ignored.
+func normalizeUserCode(userCode string) string {
+	var b strings.Builder
+	for _, r := range strings.ToUpper(userCode) {
+		if !strings.ContainsRune(userCodeCharset, r) {
+			continue
+		}
+		if b.Len() == userCodeLength/2 {
+			b.WriteByte('-')
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+type userCodeAttempts struct {
+	count int
+	since time.Time
+}
+
+// userCodeAttemptLimiter limits the number of invalid user codes per key, so that user codes can not be brute forced.
+type userCodeAttemptLimiter struct {
+	mu       sync.Mutex
+	attempts map[string]*userCodeAttempts
+}
+
+func newUserCodeAttemptLimiter() *userCodeAttemptLimiter {
+	return &userCodeAttemptLimiter{
+		attempts: make(map[string]*userCodeAttempts),
+	}
+}
+
+// Allow returns whether all keys are below the attempt limit at the given time.
+func (l *userCodeAttemptLimiter) Allow(now time.Time, keys ...string) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for _, key := range keys {
+		if attempts, ok := l.attempts[key]; ok && now.Sub(attempts.since) < userCodeAttemptWindow && attempts.count >= userCodeAttemptLimit {
+			return false
+		}
+	}
+	return true
+}
+
+// Fail records an invalid attempt for the given keys at the given time.
+func (l *userCodeAttemptLimiter) Fail(now time.Time, keys ...string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for key, attempts := range l.attempts {
+		if now.Sub(attempts.since) >= userCodeAttemptWindow {
+			delete(l.attempts, key)
+		}
+	}
+	for _, key := range keys {
+		attempts, ok := l.attempts[key]
+		if !ok {
+			attempts = &userCodeAttempts{since: now}
+			l.attempts[key] = attempts
+		}
+		attempts.count++
+	}
+}
+
+type deviceAuthorizationRequest struct {
+	ClientID     string `json:"client_id" form:"client_id"`
+	ClientSecret string `json:"client_secret" form:"client_secret"`
+}
+
+type deviceAuthorizationResponse struct {
+	DeviceCode              string `json:"device_code"`
+	UserCode                string `json:"user_code"`
+	VerificationURI         string `json:"verification_uri"`
+	VerificationURIComplete string `json:"verification_uri_complete"`
+	ExpiresIn               int    `json:"expires_in"`
+	Interval                int    `json:"interval"`
+}
+
+// authenticateClient returns the client with the given ID if the secret matches.
+// If the ID is empty, the client credentials are taken from the basic authorization header.
+func (s *server) authenticateClient(req *http.Request, clientID, clientSecret string) (*ttnpb.Client, error) {
+	if clientID == "" {
+		clientID, clientSecret, _ = req.BasicAuth()
+	}
+	if clientID == "" {
+		return nil, errInvalidClient
+	}
+	client, err := s.store.GetClient(req.Context(), &ttnpb.ClientIdentifiers{ClientID: clientID}, nil)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil, errInvalidClient.WithCause(err)
+		}
+		return nil, err
+	}
+	if !osinClient(*client).ClientSecretMatches(clientSecret) {
+		return nil, errInvalidClient
+	}
+	return client, nil
+}
+
+// verificationURI returns the URI of the page where users enter the user code.
+func (s *server) verificationURI(c echo.Context) string {
+	config := s.configFromContext(c.Request().Context())
+	base := strings.TrimSuffix(config.UI.CanonicalURL, "/")
+	if base == "" {
+		base = fmt.Sprintf("%s://%s%s", c.Scheme(), c.Request().Host, strings.TrimSuffix(s.config.Mount, "/"))
+	}
+	return base + "/device"
+}
+
+// DeviceAuthorization handles device authorization requests, as specified in RFC 8628.
+func (s *server) DeviceAuthorization(c echo.Context) error {
+	req := c.Request()
+	var deviceRequest deviceAuthorizationRequest
+	if err := c.Bind(&deviceRequest); err != nil {
+		return err
+	}
+	client, err := s.authenticateClient(req, deviceRequest.ClientID, deviceRequest.ClientSecret)
+	if err != nil {
+		return err
+	}
+	if !clientHasGrant(client, ttnpb.GRANT_DEVICE_CODE) {
+		return errClientMissingGrant.WithAttributes("grant", "device_code")
+	}
+	switch client.State {
+	case ttnpb.STATE_REJECTED:
+		return errClientRejected
+	case ttnpb.STATE_SUSPENDED:
+		return errClientSuspended
+	}
+	deviceCode, err := auth.DeviceCode.Generate(req.Context(), "")
+	if err != nil {
+		return err
+	}
+	userCode := generateUserCode()
+	now := s.now()
+	if err := s.store.CreateDeviceAuthorization(req.Context(), &ttnpb.OAuthDeviceAuthorization{
+		ClientIDs:  client.ClientIdentifiers,
+		Rights:     client.Rights,
+		DeviceCode: deviceCode,
+		UserCode:   userCode,
+		CreatedAt:  now,
+		ExpiresAt:  now.Add(deviceCodeExpiration),
+	}); err != nil {
+		return err
+	}
+	verificationURI := s.verificationURI(c)
+	return c.JSON(http.StatusOK, deviceAuthorizationResponse{
+		DeviceCode:              deviceCode,
+		UserCode:                userCode,
+		VerificationURI:         verificationURI,
+		VerificationURIComplete: fmt.Sprintf("%s?user_code=%s", verificationURI, userCode),
+		ExpiresIn:               int(deviceCodeExpiration.Seconds()),
+		Interval:                int(deviceCodePollInterval.Seconds()),
+	})
+}
+
+// AuthorizeDevice handles the user verification of device authorizations.
+// On GET, the user code is looked up and the device page is rendered. On POST, the device is authorized or denied.
+func (s *server) AuthorizeDevice(devicePage echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		req := c.Request()
+		session, err := s.getSession(c)
+		if err != nil {
+			return err
+		}
+		var userCode string
+		switch req.Method {
+		case http.MethodGet:
+			userCode = c.QueryParam("user_code")
+		case http.MethodPost:
+			userCode = c.FormValue("user_code")
+		default:
+			return c.NoContent(http.StatusMethodNotAllowed)
+		}
+		userCode = normalizeUserCode(userCode)
+		if userCode == "" {
+			return devicePage(c)
+		}
+		now := s.now()
+		attemptKeys := []string{"session:" + session.SessionID, "ip:" + c.RealIP()}
+		if !s.userCodeAttempts.Allow(now, attemptKeys...) {
+			return errUserCodeAttempts
+		}
+		deviceAuthorization, err := s.store.GetDeviceAuthorizationByUserCode(req.Context(), userCode)
+		if err != nil {
+			if errors.IsNotFound(err) {
+				s.userCodeAttempts.Fail(now, attemptKeys...)
+				return errInvalidUserCode.WithCause(err)
+			}
+			return err
+		}
+		if deviceAuthorization.UserIDs.UserID != "" || deviceAuthorization.Denied || now.After(deviceAuthorization.ExpiresAt) {
+			s.userCodeAttempts.Fail(now, attemptKeys...)
+			return errInvalidUserCode
+		}
+		client, err := s.store.GetClient(req.Context(), &deviceAuthorization.ClientIDs, nil)
+		if err != nil {
+			return err
+		}
+		switch client.State {
+		case ttnpb.STATE_REJECTED:
+			return errClientRejected
+		case ttnpb.STATE_SUSPENDED:
+			return errClientSuspended
+		case ttnpb.STATE_REQUESTED:
+			collaboratorRights, err := s.collaboratorRights(req.Context(), session.UserIdentifiers, client)
+			if err != nil {
+				return err
+			}
+			if len(collaboratorRights.GetRights()) == 0 {
+				return errClientNotApproved
+			}
+			client.Rights = collaboratorRights.GetRights()
+		}
+
+		var authorized, done bool
+		if req.Method == http.MethodPost {
+			authorized, _ = strconv.ParseBool(c.FormValue("authorize"))
+			deviceAuthorization.UserIDs = session.UserIdentifiers
+			deviceAuthorization.Rights = client.Rights
+			deviceAuthorization.Denied = !authorized
+			// The device authorization is updated first, so that only one of concurrent approvals succeeds.
+			if err := s.store.UpdateDeviceAuthorization(req.Context(), deviceAuthorization); err != nil {
+				if errors.IsFailedPrecondition(err) {
+					return errInvalidUserCode.WithCause(err)
+				}
+				return err
+			}
+			if authorized {
+				if _, err := s.store.Authorize(req.Context(), &ttnpb.OAuthClientAuthorization{
+					ClientIDs: client.ClientIdentifiers,
+					UserIDs:   session.UserIdentifiers,
+					Rights:    client.Rights,
+				}); err != nil {
+					return err
+				}
+				events.Publish(evtAuthorize(req.Context(), ttnpb.CombineIdentifiers(session.UserIdentifiers, client.ClientIdentifiers), nil))
+			}
+			done = true
+		}
+
+		clientJSON, _ := jsonpb.TTN().Marshal(client.PublicSafe())
+		user, err := s.getUser(c)
+		if err != nil {
+			return err
+		}
+		userJSON, _ := jsonpb.TTN().Marshal(user.PublicSafe())
+		c.Set("page_data", struct {
+			Client     json.RawMessage `json:"client"`
+			User       json.RawMessage `json:"user"`
+			UserCode   string          `json:"user_code"`
+			Done       bool            `json:"done"`
+			Authorized bool            `json:"authorized"`
+		}{
+			Client:     clientJSON,
+			User:       userJSON,
+			UserCode:   userCode,
+			Done:       done,
+			Authorized: authorized,
+		})
+		return devicePage(c)
+	}
+}
+
+type deviceTokenError struct {
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description,omitempty"`
+}
+
+// deviceTokenErrorCodes maps errors to the error codes specified in RFC 6749 and RFC 8628.
+var deviceTokenErrorCodes = []struct {
+	definition errors.Definition
+	code       string
+}{
+	{errAuthorizationPending, "authorization_pending"},
+	{errSlowDown, "slow_down"},
+	{errExpiredToken, "expired_token"},
+	{errDeviceAccessDenied, osin.E_ACCESS_DENIED},
+	{errInvalidDeviceCode, osin.E_INVALID_GRANT},
+	{errInvalidClient, osin.E_INVALID_CLIENT},
+	{errClientMissingGrant, osin.E_UNAUTHORIZED_CLIENT},
+	{errClientRejected, osin.E_UNAUTHORIZED_CLIENT},
+	{errClientSuspended, osin.E_UNAUTHORIZED_CLIENT},
+}
+
+// deviceToken exchanges a device code for an access token.
+// Errors are returned in the format specified in RFC 8628, so that clients can tell pending authorizations apart.
+func (s *server) deviceToken(c echo.Context, tokenRequest tokenRequest) error {
+	err := s.exchangeDeviceCode(c, tokenRequest)
+	if err == nil {
+		return nil
+	}
+	for _, errorCode := range deviceTokenErrorCodes {
+		if errors.Resemble(err, errorCode.definition) {
+			status := http.StatusBadRequest
+			if errorCode.code == osin.E_INVALID_CLIENT {
+				status = http.StatusUnauthorized
+			}
+			return c.JSON(status, deviceTokenError{
+				Error:            errorCode.code,
+				ErrorDescription: err.Error(),
+			})
+		}
+	}
+	return err
+}
+
+func (s *server) exchangeDeviceCode(c echo.Context, tokenRequest tokenRequest) error {
+	req := c.Request()
+	client, err := s.authenticateClient(req, tokenRequest.ClientID, tokenRequest.ClientSecret)
+	if err != nil {
+		return err
+	}
+	if !clientHasGrant(client, ttnpb.GRANT_DEVICE_CODE) {
+		return errClientMissingGrant.WithAttributes("grant", "device_code")
+	}
+	switch client.State {
+	case ttnpb.STATE_REJECTED:
+		return errClientRejected
+	case ttnpb.STATE_SUSPENDED:
+		return errClientSuspended
+	}
+	// Polling records the poll time, so that clients that poll faster than the interval get a slow_down error.
+	// Each slow_down error increases the interval for subsequent polls.
+	deviceAuthorization, err := s.store.PollDeviceAuthorization(req.Context(), tokenRequest.DeviceCode, deviceCodePollInterval, deviceCodeSlowDownIncrease, s.now())
+	if err != nil {
+		switch {
+		case errors.IsNotFound(err):
+			return errInvalidDeviceCode.WithCause(err)
+		case errors.IsResourceExhausted(err):
+			return errSlowDown.WithCause(err)
+		}
+		return err
+	}
+	if deviceAuthorization.ClientIDs.ClientID != client.ClientID {
+		return errInvalidDeviceCode
+	}
+	switch {
+	case s.now().After(deviceAuthorization.ExpiresAt):
+		if err := s.store.DeleteDeviceAuthorization(req.Context(), deviceAuthorization.DeviceCode); err != nil {
+			return err
+		}
+		return errExpiredToken
+	case deviceAuthorization.Denied:
+		if err := s.store.DeleteDeviceAuthorization(req.Context(), deviceAuthorization.DeviceCode); err != nil {
+			return err
+		}
+		return errDeviceAccessDenied
+	case deviceAuthorization.UserIDs.UserID == "":
+		return errAuthorizationPending
+	}
+	// The device code can only be exchanged once, so concurrent exchanges of the same device code fail.
+	deviceAuthorization, err = s.store.RedeemDeviceAuthorization(req.Context(), deviceAuthorization.DeviceCode)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return errInvalidDeviceCode.WithCause(err)
+		}
+		return err
+	}
+
+	oauth2 := s.oauth2(req.Context())
+	resp := oauth2.NewResponse()
+	defer resp.Close()
+	ar := &osin.AccessRequest{
+		Type:            osin.AccessRequestType(DeviceCodeGrantType),
+		Client:          osinClient(*client),
+		Scope:           rightsToScope(deviceAuthorization.Rights...),
+		UserData:        userData{UserIdentifiers: deviceAuthorization.UserIDs},
+		Authorized:      true,
+		Expiration:      s.osinConfig.AccessExpiration,
+		GenerateRefresh: clientHasGrant(client, ttnpb.GRANT_REFRESH_TOKEN),
+	}
+	if client.State == ttnpb.STATE_REQUESTED {
+		if err := s.limitCollaboratorScope(req.Context(), deviceAuthorization.UserIDs, client, ar); err != nil {
+			return err
+		}
+	}
+	if ar.Authorized {
+		events.Publish(evtTokenExchange(req.Context(), ttnpb.CombineIdentifiers(deviceAuthorization.UserIDs, client.ClientIdentifiers), nil))
+	}
+	oauth2.FinishAccessRequest(resp, req, ar)
+	delete(resp.Output, "scope")
+	return s.output(c, resp)
+}
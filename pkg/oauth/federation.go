@@ -0,0 +1,512 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oauth
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"path"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+	echo "github.com/labstack/echo/v4"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/events"
+	"go.thethings.network/lorawan-stack/pkg/identityserver/store"
+	"go.thethings.network/lorawan-stack/pkg/log"
+	"go.thethings.network/lorawan-stack/pkg/random"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/web/cookie"
+	"golang.org/x/oauth2"
+)
+
+// ProviderConfig is the configuration of an upstream OpenID Connect provider
+// that users can login with.
+type ProviderConfig struct {
+	ID           string   `name:"id" description:"ID of the provider"`
+	Name         string   `name:"name" description:"Name of the provider that is shown on the login page"`
+	IssuerURL    string   `name:"issuer-url" description:"Issuer URL of the provider, used to discover its endpoints"`
+	ClientID     string   `name:"client-id" description:"OAuth client ID at the provider"`
+	ClientSecret string   `name:"client-secret" description:"OAuth client secret at the provider"`
+	Scopes       []string `name:"scopes" description:"Scopes to request in addition to openid, email and profile"`
+
+	AllowRegistration    bool     `name:"allow-registration" description:"Create users that login with the provider for the first time"`
+	RegistrationState    string   `name:"registration-state" description:"State of created users (requested, approved)"`
+	Domains              []string `name:"domains" description:"Email domains of the users of the provider"`
+	DisablePasswordLogin bool     `name:"disable-password-login" description:"Disable password login for users with email addresses in the domains of the provider"`
+
+	GroupsClaim        string            `name:"groups-claim" description:"Claim of the ID token that contains the groups of the user"`
+	Organizations      map[string]string `name:"organizations" description:"Organizations that members of groups become member of (group=organization-id)"`
+	OrganizationRights []string          `name:"organization-rights" description:"Rights of members of the organizations"`
+}
+
+// ProviderInfo is the information about an upstream provider that is shown on the login page.
+type ProviderInfo struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+func (c *Config) provider(id string) (ProviderConfig, bool) {
+	for _, provider := range c.Providers {
+		if provider.ID == id {
+			return provider, true
+		}
+	}
+	return ProviderConfig{}, false
+}
+
+func (c *Config) providerInfo() []ProviderInfo {
+	if len(c.Providers) == 0 {
+		return nil
+	}
+	res := make([]ProviderInfo, len(c.Providers))
+	for i, provider := range c.Providers {
+		res[i] = ProviderInfo{ID: provider.ID, Name: provider.Name}
+		if res[i].Name == "" {
+			res[i].Name = provider.ID
+		}
+	}
+	return res
+}
+
+// passwordLoginProvider returns the provider that users with the given email
+// address need to login with, if that provider disables password login.
+func (c *Config) passwordLoginProvider(email string) (ProviderConfig, bool) {
+	for _, provider := range c.Providers {
+		if provider.DisablePasswordLogin && len(provider.Domains) > 0 && provider.hasDomain(email) {
+			return provider, true
+		}
+	}
+	return ProviderConfig{}, false
+}
+
+// hasDomain returns whether the email address is in the domains of the provider.
+// If the provider has no domains, all email addresses are accepted.
+func (p ProviderConfig) hasDomain(email string) bool {
+	if len(p.Domains) == 0 {
+		return true
+	}
+	at := strings.LastIndex(email, "@")
+	if at == -1 {
+		return false
+	}
+	for _, domain := range p.Domains {
+		if strings.EqualFold(email[at+1:], domain) {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	errRegistrationState = errors.DefineInvalidArgument("registration_state", "invalid registration state `{state}`")
+	errOrganizationRight = errors.DefineInvalidArgument("organization_right", "invalid organization right `{right}`")
+)
+
+func (p ProviderConfig) registrationState() (ttnpb.State, error) {
+	if p.RegistrationState == "" {
+		return ttnpb.STATE_REQUESTED, nil
+	}
+	name := strings.ToUpper(p.RegistrationState)
+	if !strings.HasPrefix(name, "STATE_") {
+		name = "STATE_" + name
+	}
+	state, ok := ttnpb.State_value[name]
+	if !ok {
+		return 0, errRegistrationState.WithAttributes("state", p.RegistrationState)
+	}
+	return ttnpb.State(state), nil
+}
+
+func (p ProviderConfig) organizationRights() (*ttnpb.Rights, error) {
+	if len(p.OrganizationRights) == 0 {
+		return ttnpb.RightsFrom(ttnpb.RIGHT_ORGANIZATION_INFO), nil
+	}
+	rights := make([]ttnpb.Right, 0, len(p.OrganizationRights))
+	for _, name := range p.OrganizationRights {
+		name = strings.ToUpper(name)
+		if !strings.HasPrefix(name, "RIGHT_") {
+			name = "RIGHT_" + name
+		}
+		right, ok := ttnpb.Right_value[name]
+		if !ok {
+			return nil, errOrganizationRight.WithAttributes("right", name)
+		}
+		rights = append(rights, ttnpb.Right(right))
+	}
+	return ttnpb.RightsFrom(rights...), nil
+}
+
+func (p ProviderConfig) groupsClaim() string {
+	if p.GroupsClaim == "" {
+		return "groups"
+	}
+	return p.GroupsClaim
+}
+
+const federationCookieName = "_federation"
+
+func (s *server) federationCookie() *cookie.Cookie {
+	return &cookie.Cookie{
+		Name:     federationCookieName,
+		Path:     s.config.UI.MountPath(),
+		MaxAge:   10 * time.Minute,
+		HTTPOnly: true,
+	}
+}
+
+// federationState is the state of a login with an upstream provider.
+type federationState struct {
+	ProviderID string `json:"provider_id"`
+	State      string `json:"state"`
+	Nonce      string `json:"nonce"`
+	Next       string `json:"next"`
+}
+
+const federationPendingCookieName = "_federation_pending"
+
+func (s *server) federationPendingCookie() *cookie.Cookie {
+	return &cookie.Cookie{
+		Name:     federationPendingCookieName,
+		Path:     s.config.UI.MountPath(),
+		MaxAge:   10 * time.Minute,
+		HTTPOnly: true,
+	}
+}
+
+// federationPending is a login with an upstream provider that the user still
+// needs to complete on the login page. If Link is set, the user confirms linking
+// the account in the provider by logging in with password (and two-factor
+// authentication code). Otherwise, the user only enters the two-factor
+// authentication code.
+type federationPending struct {
+	ProviderID string `json:"provider_id"`
+	ExternalID string `json:"external_id"`
+	UserID     string `json:"user_id"`
+	Link       bool   `json:"link,omitempty"`
+}
+
+// redirectToPendingLogin sets the pending login cookie and redirects the user
+// to the login page to complete the login.
+func (s *server) redirectToPendingLogin(c echo.Context, pending federationPending, next string) error {
+	if err := s.federationPendingCookie().Set(c, pending); err != nil {
+		return err
+	}
+	values := make(url.Values)
+	if next != "" {
+		values.Set(nextKey, next)
+	}
+	values.Set("user_id", pending.UserID)
+	values.Set("provider", pending.ProviderID)
+	return c.Redirect(http.StatusFound, fmt.Sprintf("%s?%s", path.Join(s.config.UI.MountPath(), "login"), values.Encode()))
+}
+
+func (s *server) providerOAuth2(config *Config, provider ProviderConfig, metadata *oidcMetadata) *oauth2.Config {
+	return &oauth2.Config{
+		ClientID:     provider.ClientID,
+		ClientSecret: provider.ClientSecret,
+		RedirectURL:  fmt.Sprintf("%s/login/%s/callback", strings.TrimSuffix(config.UI.CanonicalURL, "/"), provider.ID),
+		Endpoint: oauth2.Endpoint{
+			AuthURL:  metadata.AuthorizationEndpoint,
+			TokenURL: metadata.TokenEndpoint,
+		},
+		Scopes: append([]string{"openid", "email", "profile"}, provider.Scopes...),
+	}
+}
+
+var (
+	errProviderNotFound      = errors.DefineNotFound("provider_not_found", "provider `{provider_id}` not found")
+	errFederationState       = errors.DefineInvalidArgument("federation_state", "invalid or expired login state")
+	errProviderAuthorization = errors.DefinePermissionDenied("provider_authorization", "authorization by provider failed with `{error}`", "description")
+	errProviderTokenExchange = errors.DefineUnauthenticated("provider_token_exchange", "exchange authorization code with provider")
+	errNoIDToken             = errors.DefineUnauthenticated("no_id_token", "provider did not return an ID token")
+)
+
+// FederatedLogin redirects the user to the upstream provider to login.
+func (s *server) FederatedLogin(c echo.Context) error {
+	ctx := c.Request().Context()
+	config := s.configFromContext(ctx)
+	provider, ok := config.provider(c.Param("provider"))
+	if !ok {
+		return errProviderNotFound.WithAttributes("provider_id", c.Param("provider"))
+	}
+	metadata, err := s.oidc.get(provider.IssuerURL).discover(ctx)
+	if err != nil {
+		return err
+	}
+	state := federationState{
+		ProviderID: provider.ID,
+		State:      random.String(32),
+		Nonce:      random.String(32),
+		Next:       c.QueryParam(nextKey),
+	}
+	if err = s.federationCookie().Set(c, state); err != nil {
+		return err
+	}
+	authURL := s.providerOAuth2(config, provider, metadata).AuthCodeURL(
+		state.State,
+		oauth2.SetAuthURLParam("nonce", state.Nonce),
+	)
+	return c.Redirect(http.StatusFound, authURL)
+}
+
+// FederatedCallback handles the callback of the upstream provider, and logs in
+// the user that is linked to the account in the provider.
+func (s *server) FederatedCallback(c echo.Context) error {
+	ctx := c.Request().Context()
+	config := s.configFromContext(ctx)
+	provider, ok := config.provider(c.Param("provider"))
+	if !ok {
+		return errProviderNotFound.WithAttributes("provider_id", c.Param("provider"))
+	}
+	var state federationState
+	ok, err := s.federationCookie().Get(c, &state)
+	if err != nil {
+		return err
+	}
+	s.federationCookie().Remove(c)
+	if !ok || state.ProviderID != provider.ID || state.State == "" || c.QueryParam("state") != state.State {
+		return errFederationState
+	}
+	if providerErr := c.QueryParam("error"); providerErr != "" {
+		return errProviderAuthorization.WithAttributes(
+			"error", providerErr,
+			"description", c.QueryParam("error_description"),
+		)
+	}
+	oidc := s.oidc.get(provider.IssuerURL)
+	metadata, err := oidc.discover(ctx)
+	if err != nil {
+		return err
+	}
+	token, err := s.providerOAuth2(config, provider, metadata).Exchange(ctx, c.QueryParam("code"))
+	if err != nil {
+		return errProviderTokenExchange.WithCause(err)
+	}
+	rawIDToken, _ := token.Extra("id_token").(string)
+	if rawIDToken == "" {
+		return errNoIDToken
+	}
+	claims, err := oidc.verifyIDToken(ctx, rawIDToken, provider.ClientID, state.Nonce, s.now())
+	if err != nil {
+		return err
+	}
+	userIDs, link, err := s.federatedUser(ctx, provider, claims)
+	if err != nil {
+		return err
+	}
+	pending := federationPending{
+		ProviderID: provider.ID,
+		ExternalID: claims.Subject,
+		UserID:     userIDs.UserID,
+	}
+	if link {
+		// The user confirms the link by logging in with password.
+		pending.Link = true
+		return s.redirectToPendingLogin(c, pending, state.Next)
+	}
+	if err = s.updateFederatedMemberships(ctx, provider, userIDs, claims); err != nil {
+		log.FromContext(ctx).WithError(err).Warn("Failed to update organization memberships from provider")
+	}
+	twoFactor, err := s.twoFactorEnabled(ctx, userIDs)
+	if err != nil {
+		return err
+	}
+	if twoFactor {
+		return s.redirectToPendingLogin(c, pending, state.Next)
+	}
+	if err = s.createSession(c, *userIDs); err != nil {
+		return err
+	}
+	next := state.Next
+	if next == "" {
+		next = s.config.UI.MountPath()
+	}
+	nextURL, err := url.Parse(next)
+	if err != nil {
+		return err
+	}
+	return c.Redirect(http.StatusFound, fmt.Sprintf("%s?%s", nextURL.Path, nextURL.RawQuery))
+}
+
+// twoFactorEnabled returns whether the user enabled two-factor authentication.
+func (s *server) twoFactorEnabled(ctx context.Context, userIDs *ttnpb.UserIdentifiers) (bool, error) {
+	state, err := s.store.GetTOTP(ctx, userIDs)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	return state.EnabledAt != nil, nil
+}
+
+var (
+	errEmailNotVerified  = errors.DefinePermissionDenied("email_not_verified", "email address is not verified by provider `{provider_id}`")
+	errEmailDomain       = errors.DefinePermissionDenied("email_domain", "email address `{email}` is not in the domains of provider `{provider_id}`")
+	errUserNotRegistered = errors.DefinePermissionDenied("user_not_registered", "no user registered with email address `{email}`")
+	errNoUserID          = errors.DefineAlreadyExists("no_user_id", "no user ID available for `{email}`")
+)
+
+// federatedUser returns the user that is linked to the account in the provider.
+// Accounts are matched to users by verified email address. If the account is
+// not linked yet, link is true and the user needs to confirm the link by
+// logging in with password, unless the provider is the only way to login for
+// the email address. If no user is registered with the email address, a new
+// user is created if the provider allows registration.
+func (s *server) federatedUser(ctx context.Context, provider ProviderConfig, claims *idTokenClaims) (userIDs *ttnpb.UserIdentifiers, link bool, err error) {
+	userIDs, err = s.store.GetExternalUser(ctx, provider.ID, claims.Subject)
+	if err == nil {
+		return userIDs, false, nil
+	}
+	if !errors.IsNotFound(err) {
+		return nil, false, err
+	}
+	if claims.Email == "" || !claims.emailVerified() {
+		return nil, false, errEmailNotVerified.WithAttributes("provider_id", provider.ID)
+	}
+	if !provider.hasDomain(claims.Email) {
+		return nil, false, errEmailDomain.WithAttributes("email", claims.Email, "provider_id", provider.ID)
+	}
+	usr, err := s.store.GetUser(ctx, &ttnpb.UserIdentifiers{Email: claims.Email}, &types.FieldMask{Paths: []string{"name"}})
+	switch {
+	case err == nil:
+		if authoritative, ok := s.configFromContext(ctx).passwordLoginProvider(claims.Email); !ok || authoritative.ID != provider.ID {
+			return &usr.UserIdentifiers, true, nil
+		}
+		if err = s.store.CreateExternalUser(ctx, &usr.UserIdentifiers, provider.ID, claims.Subject); err != nil {
+			return nil, false, err
+		}
+		events.Publish(evtLinkExternalUser(ctx, usr.UserIdentifiers, provider.ID))
+		return &usr.UserIdentifiers, false, nil
+	case !errors.IsNotFound(err):
+		return nil, false, err
+	case !provider.AllowRegistration:
+		return nil, false, errUserNotRegistered.WithAttributes("email", claims.Email)
+	}
+	state, err := provider.registrationState()
+	if err != nil {
+		return nil, false, err
+	}
+	for _, userID := range federatedUserIDCandidates(claims) {
+		ids := ttnpb.UserIdentifiers{UserID: userID}
+		if err := ids.ValidateContext(ctx); err != nil {
+			continue
+		}
+		_, err := s.store.GetUser(ctx, &ids, &types.FieldMask{Paths: []string{"name"}})
+		if err == nil {
+			continue
+		}
+		if !errors.IsNotFound(err) {
+			return nil, false, err
+		}
+		now := s.now()
+		usr, err = s.store.CreateUser(ctx, &ttnpb.User{
+			UserIdentifiers:                ids,
+			Name:                           claims.Name,
+			PrimaryEmailAddress:            claims.Email,
+			PrimaryEmailAddressValidatedAt: &now,
+			State:                          state,
+		})
+		if err != nil {
+			return nil, false, err
+		}
+		if err = s.store.CreateExternalUser(ctx, &usr.UserIdentifiers, provider.ID, claims.Subject); err != nil {
+			return nil, false, err
+		}
+		events.Publish(evtCreateExternalUser(ctx, usr.UserIdentifiers, provider.ID))
+		return &usr.UserIdentifiers, false, nil
+	}
+	return nil, false, errNoUserID.WithAttributes("email", claims.Email)
+}
+
+var invalidUserIDCharacters = regexp.MustCompile("[^a-z0-9]+")
+
+// federatedUserIDCandidates returns the user IDs to try for a new user, based
+// on the preferred username or the email address of the user.
+func federatedUserIDCandidates(claims *idTokenClaims) []string {
+	base := claims.PreferredUsername
+	if base == "" || strings.Contains(base, "@") {
+		base = claims.Email
+		if at := strings.LastIndex(base, "@"); at != -1 {
+			base = base[:at]
+		}
+	}
+	base = strings.Trim(invalidUserIDCharacters.ReplaceAllString(strings.ToLower(base), "-"), "-")
+	if len(base) < 3 {
+		base = "user"
+	}
+	if len(base) > 31 {
+		base = strings.TrimRight(base[:31], "-")
+	}
+	candidates := []string{base}
+	for i := 0; i < 5; i++ {
+		candidates = append(candidates, fmt.Sprintf("%s-%04d", base, random.Intn(10000)))
+	}
+	return candidates
+}
+
+// updateFederatedMemberships updates the memberships of the user in the
+// organizations of the provider, based on the groups of the user.
+// Memberships that would leave an organization without a member with all
+// rights are not changed.
+func (s *server) updateFederatedMemberships(ctx context.Context, provider ProviderConfig, userIDs *ttnpb.UserIdentifiers, claims *idTokenClaims) error {
+	if len(provider.Organizations) == 0 {
+		return nil
+	}
+	rights, err := provider.organizationRights()
+	if err != nil {
+		return err
+	}
+	// Groups are compared case-insensitively, as the keys in the configuration are case-insensitive.
+	groups := make(map[string]bool)
+	for _, group := range claims.stringsClaim(provider.groupsClaim()) {
+		groups[strings.ToLower(group)] = true
+	}
+	member := make(map[string]bool)
+	for group, organizationID := range provider.Organizations {
+		member[organizationID] = member[organizationID] || groups[strings.ToLower(group)]
+	}
+	logger := log.FromContext(ctx)
+	accountIDs := userIDs.OrganizationOrUserIdentifiers()
+	for organizationID, isMember := range member {
+		organizationIDs := ttnpb.OrganizationIdentifiers{OrganizationID: organizationID}
+		newRights := rights
+		if !isMember {
+			if _, err := s.store.GetMember(ctx, accountIDs, organizationIDs); err != nil {
+				if errors.IsNotFound(err) {
+					continue
+				}
+				return err
+			}
+			newRights = ttnpb.RightsFrom()
+		}
+		if err := store.CheckLastOwner(ctx, s.store, accountIDs, organizationIDs, newRights); err != nil {
+			if errors.IsFailedPrecondition(err) {
+				logger.WithError(err).WithField("organization_id", organizationID).Warn("Keep organization membership of last owner")
+				continue
+			}
+			return err
+		}
+		if err := s.store.SetMember(ctx, accountIDs, organizationIDs, newRights); err != nil {
+			return err
+		}
+	}
+	return nil
+}
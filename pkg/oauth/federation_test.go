@@ -0,0 +1,557 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oauth_test
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/cookiejar"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"github.com/smartystreets/assertions/should"
+	"go.thethings.network/lorawan-stack/pkg/component"
+	componenttest "go.thethings.network/lorawan-stack/pkg/component/test"
+	"go.thethings.network/lorawan-stack/pkg/config"
+	"go.thethings.network/lorawan-stack/pkg/oauth"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+	"go.thethings.network/lorawan-stack/pkg/webui"
+	"golang.org/x/net/publicsuffix"
+	jose "gopkg.in/square/go-jose.v2"
+	"gopkg.in/square/go-jose.v2/jwt"
+)
+
+// mockProvider is an upstream OpenID Connect provider that issues ID tokens
+// with the claims that are set by the test.
+type mockProvider struct {
+	*httptest.Server
+	key    *rsa.PrivateKey
+	nonce  string
+	claims map[string]interface{}
+}
+
+func newMockProvider() *mockProvider {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		panic(err)
+	}
+	p := &mockProvider{key: key}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]string{
+			"issuer":                 p.URL,
+			"authorization_endpoint": p.URL + "/authorize",
+			"token_endpoint":         p.URL + "/token",
+			"jwks_uri":               p.URL + "/jwks",
+		})
+	})
+	mux.HandleFunc("/jwks", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(jose.JSONWebKeySet{
+			Keys: []jose.JSONWebKey{{Key: &key.PublicKey, KeyID: "key", Algorithm: string(jose.RS256), Use: "sig"}},
+		})
+	})
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		if r.PostFormValue("code") != "code" {
+			w.WriteHeader(http.StatusBadRequest)
+			json.NewEncoder(w).Encode(map[string]string{"error": "invalid_grant"})
+			return
+		}
+		signer, err := jose.NewSigner(
+			jose.SigningKey{Algorithm: jose.RS256, Key: key},
+			(&jose.SignerOptions{}).WithType("JWT").WithHeader("kid", "key"),
+		)
+		if err != nil {
+			panic(err)
+		}
+		now := time.Now()
+		idToken, err := jwt.Signed(signer).Claims(jwt.Claims{
+			Issuer:   p.URL,
+			Audience: jwt.Audience{"client"},
+			IssuedAt: jwt.NewNumericDate(now),
+			Expiry:   jwt.NewNumericDate(now.Add(time.Minute)),
+		}).Claims(map[string]interface{}{
+			"nonce": p.nonce,
+		}).Claims(p.claims).CompactSerialize()
+		if err != nil {
+			panic(err)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"access_token": "access-token",
+			"token_type":   "bearer",
+			"expires_in":   3600,
+			"id_token":     idToken,
+		})
+	})
+	p.Server = httptest.NewServer(mux)
+	return p
+}
+
+func TestFederatedLogin(t *testing.T) {
+	ctx := test.Context()
+	provider := newMockProvider()
+	defer provider.Close()
+
+	store := &mockStore{}
+	c := componenttest.NewComponent(t, &component.Config{
+		ServiceBase: config.ServiceBase{
+			HTTP: config.HTTP{
+				Cookie: config.Cookie{
+					HashKey:  []byte("12345678123456781234567812345678"),
+					BlockKey: []byte("12345678123456781234567812345678"),
+				},
+			},
+		},
+	})
+	s := oauth.NewServer(ctx, store, nil, oauth.Config{
+		Mount: "/oauth",
+		UI: oauth.UIConfig{
+			TemplateData: webui.TemplateData{
+				SiteName:     "The Things Network",
+				Title:        "OAuth",
+				CanonicalURL: "https://example.com/oauth",
+			},
+		},
+		Providers: []oauth.ProviderConfig{
+			{
+				ID:                   "provider",
+				Name:                 "Provider",
+				IssuerURL:            provider.URL,
+				ClientID:             "client",
+				ClientSecret:         "secret",
+				AllowRegistration:    true,
+				Domains:              []string{"example.com"},
+				DisablePasswordLogin: true,
+				Organizations:        map[string]string{"admins": "admins"},
+				OrganizationRights:   []string{"RIGHT_ORGANIZATION_ALL"},
+			},
+			{
+				ID:           "other",
+				Name:         "Other",
+				IssuerURL:    provider.URL,
+				ClientID:     "client",
+				ClientSecret: "secret",
+			},
+		},
+	})
+	c.RegisterWeb(s)
+	componenttest.StartComponent(t, c)
+
+	do := func(jar http.CookieJar, method, path string, body interface{}) *httptest.ResponseRecorder {
+		var reqBody io.Reader
+		if body != nil {
+			b, _ := json.Marshal(body)
+			reqBody = bytes.NewBuffer(b)
+		}
+		req := httptest.NewRequest(method, path, reqBody)
+		req.URL.Scheme, req.URL.Host = "http", req.Host
+		if body != nil {
+			req.Header.Set("Content-Type", "application/json")
+		}
+		for _, c := range jar.Cookies(req.URL) {
+			req.AddCookie(c)
+			if c.Name == "_csrf" {
+				req.Header.Set("X-CSRF-Token", c.Value)
+			}
+		}
+		res := httptest.NewRecorder()
+		c.ServeHTTP(res, req)
+		if cookies := res.Result().Cookies(); len(cookies) > 0 {
+			jar.SetCookies(req.URL, cookies)
+		}
+		return res
+	}
+
+	for _, tt := range []struct {
+		Name             string
+		Provider         string
+		StoreSetup       func(*mockStore)
+		Claims           map[string]interface{}
+		State            string
+		ExpectedCode     int
+		ExpectedRedirect string
+		StoreCheck       func(*testing.T, *mockStore)
+	}{
+		{
+			Name: "Register",
+			StoreSetup: func(s *mockStore) {
+				s.err.getExternalUser = mockErrNotFound
+				s.err.getUser = mockErrNotFound
+			},
+			Claims: map[string]interface{}{
+				"sub":                "new-subject",
+				"email":              "New.User@example.com",
+				"email_verified":     true,
+				"name":               "New User",
+				"preferred_username": "New.User",
+				"groups":             []string{"admins", "users"},
+			},
+			ExpectedCode:     http.StatusFound,
+			ExpectedRedirect: "/oauth/authorize",
+			StoreCheck: func(t *testing.T, s *mockStore) {
+				a := assertions.New(t)
+				a.So(s.calls, should.Contain, "CreateUser")
+				if a.So(s.req.user, should.NotBeNil) {
+					a.So(s.req.user.UserID, should.Equal, "new-user")
+					a.So(s.req.user.Name, should.Equal, "New User")
+					a.So(s.req.user.PrimaryEmailAddress, should.Equal, "New.User@example.com")
+					a.So(s.req.user.PrimaryEmailAddressValidatedAt, should.NotBeNil)
+					a.So(s.req.user.State, should.Equal, ttnpb.STATE_REQUESTED)
+				}
+				a.So(s.calls, should.Contain, "CreateExternalUser")
+				a.So(s.req.providerID, should.Equal, "provider")
+				a.So(s.req.externalID, should.Equal, "new-subject")
+				a.So(s.calls, should.Contain, "SetMember")
+				a.So(s.req.entityIDs.IDString(), should.Equal, "admins")
+				a.So(s.req.rights.Rights, should.Contain, ttnpb.RIGHT_ORGANIZATION_ALL)
+				a.So(s.calls, should.Contain, "CreateSession")
+				if a.So(s.req.session, should.NotBeNil) {
+					a.So(s.req.session.UserID, should.Equal, "new-user")
+				}
+			},
+		},
+		{
+			Name: "Link",
+			StoreSetup: func(s *mockStore) {
+				s.err.getExternalUser = mockErrNotFound
+				s.err.getMember = mockErrNotFound
+				s.res.user = &ttnpb.User{
+					UserIdentifiers:     ttnpb.UserIdentifiers{UserID: "user"},
+					PrimaryEmailAddress: "user@example.com",
+				}
+			},
+			Claims: map[string]interface{}{
+				"sub":            "subject",
+				"email":          "user@example.com",
+				"email_verified": "true",
+			},
+			ExpectedCode:     http.StatusFound,
+			ExpectedRedirect: "/oauth/authorize",
+			StoreCheck: func(t *testing.T, s *mockStore) {
+				a := assertions.New(t)
+				a.So(s.calls, should.NotContain, "CreateUser")
+				a.So(s.calls, should.Contain, "CreateExternalUser")
+				a.So(s.req.userIDs.GetUserID(), should.Equal, "user")
+				a.So(s.req.externalID, should.Equal, "subject")
+				a.So(s.calls, should.Contain, "GetMember")
+				a.So(s.calls, should.NotContain, "SetMember")
+				a.So(s.calls, should.Contain, "CreateSession")
+			},
+		},
+		{
+			Name:     "Link Requires Password",
+			Provider: "other",
+			StoreSetup: func(s *mockStore) {
+				s.err.getExternalUser = mockErrNotFound
+				s.res.user = mockUser
+			},
+			Claims: map[string]interface{}{
+				"sub":            "subject",
+				"email":          "user@example.org",
+				"email_verified": true,
+			},
+			ExpectedCode:     http.StatusFound,
+			ExpectedRedirect: "/oauth/login?",
+			StoreCheck: func(t *testing.T, s *mockStore) {
+				a := assertions.New(t)
+				a.So(s.calls, should.NotContain, "CreateExternalUser")
+				a.So(s.calls, should.NotContain, "SetMember")
+				a.So(s.calls, should.NotContain, "CreateSession")
+			},
+		},
+		{
+			Name: "Two-Factor Required",
+			StoreSetup: func(s *mockStore) {
+				s.err.getMember = mockErrNotFound
+				s.res.user = mockUser
+				s.res.totpSecret, s.res.totpEnabledAt = mockTOTPSecret, &mockTOTPEnabledAt
+			},
+			Claims: map[string]interface{}{
+				"sub": "subject",
+			},
+			ExpectedCode:     http.StatusFound,
+			ExpectedRedirect: "/oauth/login?",
+			StoreCheck: func(t *testing.T, s *mockStore) {
+				a := assertions.New(t)
+				a.So(s.calls, should.Contain, "GetTOTP")
+				a.So(s.calls, should.NotContain, "CreateSession")
+			},
+		},
+		{
+			Name: "Last Owner",
+			StoreSetup: func(s *mockStore) {
+				s.res.user = mockUser
+				s.res.memberRights = ttnpb.RightsFrom(ttnpb.RIGHT_ORGANIZATION_ALL)
+				s.res.members = map[*ttnpb.OrganizationOrUserIdentifiers]*ttnpb.Rights{
+					mockUser.OrganizationOrUserIdentifiers(): ttnpb.RightsFrom(ttnpb.RIGHT_ORGANIZATION_ALL),
+				}
+			},
+			Claims: map[string]interface{}{
+				"sub":    "subject",
+				"groups": []string{"users"},
+			},
+			ExpectedCode:     http.StatusFound,
+			ExpectedRedirect: "/oauth/authorize",
+			StoreCheck: func(t *testing.T, s *mockStore) {
+				a := assertions.New(t)
+				a.So(s.calls, should.Contain, "FindMembers")
+				a.So(s.calls, should.NotContain, "SetMember")
+				a.So(s.calls, should.Contain, "CreateSession")
+			},
+		},
+		{
+			Name: "Linked",
+			StoreSetup: func(s *mockStore) {
+				s.err.getMember = mockErrNotFound
+				s.res.user = mockUser
+			},
+			Claims: map[string]interface{}{
+				"sub": "subject",
+			},
+			ExpectedCode:     http.StatusFound,
+			ExpectedRedirect: "/oauth/authorize",
+			StoreCheck: func(t *testing.T, s *mockStore) {
+				a := assertions.New(t)
+				a.So(s.calls, should.NotContain, "CreateExternalUser")
+				a.So(s.calls, should.Contain, "CreateSession")
+				if a.So(s.req.session, should.NotBeNil) {
+					a.So(s.req.session.UserID, should.Equal, "user")
+				}
+			},
+		},
+		{
+			Name: "Email Not Verified",
+			StoreSetup: func(s *mockStore) {
+				s.err.getExternalUser = mockErrNotFound
+			},
+			Claims: map[string]interface{}{
+				"sub":            "subject",
+				"email":          "user@example.com",
+				"email_verified": false,
+			},
+			ExpectedCode: http.StatusForbidden,
+			StoreCheck: func(t *testing.T, s *mockStore) {
+				a := assertions.New(t)
+				a.So(s.calls, should.NotContain, "CreateExternalUser")
+				a.So(s.calls, should.NotContain, "CreateSession")
+			},
+		},
+		{
+			Name: "Other Domain",
+			StoreSetup: func(s *mockStore) {
+				s.err.getExternalUser = mockErrNotFound
+			},
+			Claims: map[string]interface{}{
+				"sub":            "subject",
+				"email":          "user@example.org",
+				"email_verified": true,
+			},
+			ExpectedCode: http.StatusForbidden,
+			StoreCheck: func(t *testing.T, s *mockStore) {
+				a := assertions.New(t)
+				a.So(s.calls, should.NotContain, "CreateSession")
+			},
+		},
+		{
+			Name:         "Invalid State",
+			State:        "invalid",
+			ExpectedCode: http.StatusBadRequest,
+			StoreCheck: func(t *testing.T, s *mockStore) {
+				a := assertions.New(t)
+				a.So(s.calls, should.NotContain, "CreateSession")
+			},
+		},
+	} {
+		t.Run(tt.Name, func(t *testing.T) {
+			a := assertions.New(t)
+
+			jar, err := cookiejar.New(&cookiejar.Options{PublicSuffixList: publicsuffix.List})
+			if err != nil {
+				panic(err)
+			}
+
+			providerID := tt.Provider
+			if providerID == "" {
+				providerID = "provider"
+			}
+
+			store.reset()
+			res := do(jar, "GET", "/oauth/login/"+providerID+"?n=%2Foauth%2Fauthorize%3Fclient_id%3Dclient", nil)
+			if !a.So(res.Code, should.Equal, http.StatusFound) {
+				t.FailNow()
+			}
+			authURL, err := url.Parse(res.Header().Get("location"))
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			a.So(authURL.Path, should.Equal, "/authorize")
+			query := authURL.Query()
+			a.So(query.Get("client_id"), should.Equal, "client")
+			a.So(query.Get("redirect_uri"), should.Equal, "https://example.com/oauth/login/"+providerID+"/callback")
+			a.So(query.Get("scope"), should.ContainSubstring, "openid")
+
+			provider.nonce, provider.claims = query.Get("nonce"), tt.Claims
+			state := query.Get("state")
+			if tt.State != "" {
+				state = tt.State
+			}
+
+			if tt.StoreSetup != nil {
+				tt.StoreSetup(store)
+			}
+			res = do(jar, "GET", "/oauth/login/"+providerID+"/callback?"+url.Values{
+				"code":  []string{"code"},
+				"state": []string{state},
+			}.Encode(), nil)
+			a.So(res.Code, should.Equal, tt.ExpectedCode)
+			a.So(res.Header().Get("location"), should.ContainSubstring, tt.ExpectedRedirect)
+
+			if tt.StoreCheck != nil {
+				tt.StoreCheck(t, store)
+			}
+		})
+	}
+
+	// callback logs in with the provider and returns the response of the callback.
+	callback := func(t *testing.T, jar http.CookieJar, providerID string, claims map[string]interface{}) *httptest.ResponseRecorder {
+		a := assertions.New(t)
+		res := do(jar, "GET", "/oauth/login/"+providerID, nil)
+		if !a.So(res.Code, should.Equal, http.StatusFound) {
+			t.FailNow()
+		}
+		authURL, err := url.Parse(res.Header().Get("location"))
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		query := authURL.Query()
+		provider.nonce, provider.claims = query.Get("nonce"), claims
+		return do(jar, "GET", "/oauth/login/"+providerID+"/callback?"+url.Values{
+			"code":  []string{"code"},
+			"state": []string{query.Get("state")},
+		}.Encode(), nil)
+	}
+
+	t.Run("Link With Password", func(t *testing.T) {
+		a := assertions.New(t)
+
+		jar, err := cookiejar.New(&cookiejar.Options{PublicSuffixList: publicsuffix.List})
+		if err != nil {
+			panic(err)
+		}
+
+		store.reset()
+		store.err.getExternalUser = mockErrNotFound
+		store.res.user = mockUser
+		res := callback(t, jar, "other", map[string]interface{}{
+			"sub":            "subject",
+			"email":          "user@example.org",
+			"email_verified": true,
+		})
+		a.So(res.Code, should.Equal, http.StatusFound)
+		a.So(res.Header().Get("location"), should.ContainSubstring, "/oauth/login?")
+		a.So(store.calls, should.NotContain, "CreateExternalUser")
+
+		res = do(jar, "GET", "/oauth/login", nil)
+		a.So(res.Code, should.Equal, http.StatusOK)
+
+		store.reset()
+		store.res.user = mockUser
+		res = do(jar, "POST", "/oauth/api/auth/login", loginFormData{UserID: "user", Password: "wrong"})
+		a.So(res.Code, should.Equal, http.StatusBadRequest)
+		a.So(store.calls, should.NotContain, "CreateExternalUser")
+		a.So(store.calls, should.NotContain, "CreateSession")
+
+		store.reset()
+		store.res.user = mockUser
+		res = do(jar, "POST", "/oauth/api/auth/login", loginFormData{UserID: "user", Password: "pass"})
+		a.So(res.Code, should.Equal, http.StatusNoContent)
+		a.So(store.calls, should.Contain, "CreateExternalUser")
+		a.So(store.req.providerID, should.Equal, "other")
+		a.So(store.req.externalID, should.Equal, "subject")
+		a.So(store.calls, should.Contain, "CreateSession")
+		if a.So(store.req.session, should.NotBeNil) {
+			a.So(store.req.session.UserID, should.Equal, "user")
+		}
+	})
+
+	t.Run("Two-Factor Login", func(t *testing.T) {
+		a := assertions.New(t)
+
+		jar, err := cookiejar.New(&cookiejar.Options{PublicSuffixList: publicsuffix.List})
+		if err != nil {
+			panic(err)
+		}
+
+		store.reset()
+		store.err.getMember = mockErrNotFound
+		store.res.user = mockUser
+		store.res.totpSecret, store.res.totpEnabledAt = mockTOTPSecret, &mockTOTPEnabledAt
+		res := callback(t, jar, "provider", map[string]interface{}{
+			"sub": "subject",
+		})
+		a.So(res.Code, should.Equal, http.StatusFound)
+		a.So(res.Header().Get("location"), should.ContainSubstring, "/oauth/login?")
+		a.So(store.calls, should.NotContain, "CreateSession")
+
+		res = do(jar, "GET", "/oauth/login", nil)
+		a.So(res.Code, should.Equal, http.StatusOK)
+
+		store.reset()
+		store.res.totpSecret, store.res.totpEnabledAt = mockTOTPSecret, &mockTOTPEnabledAt
+		res = do(jar, "POST", "/oauth/api/auth/login", loginFormData{TwoFactorCode: "000000"})
+		a.So(res.Code, should.Equal, http.StatusBadRequest)
+		a.So(store.calls, should.NotContain, "CreateSession")
+
+		store.reset()
+		store.res.totpSecret, store.res.totpEnabledAt = mockTOTPSecret, &mockTOTPEnabledAt
+		res = do(jar, "POST", "/oauth/api/auth/login", loginFormData{TwoFactorCode: mockTOTPCode})
+		a.So(res.Code, should.Equal, http.StatusNoContent)
+		a.So(store.calls, should.Contain, "CreateSession")
+		if a.So(store.req.session, should.NotBeNil) {
+			a.So(store.req.session.UserID, should.Equal, "user")
+		}
+	})
+
+	t.Run("Password Login Disabled", func(t *testing.T) {
+		a := assertions.New(t)
+
+		jar, err := cookiejar.New(&cookiejar.Options{PublicSuffixList: publicsuffix.List})
+		if err != nil {
+			panic(err)
+		}
+
+		store.reset()
+		res := do(jar, "GET", "/oauth/login", nil)
+		a.So(res.Code, should.Equal, http.StatusOK)
+
+		store.res.user = &ttnpb.User{
+			UserIdentifiers:     ttnpb.UserIdentifiers{UserID: "user"},
+			PrimaryEmailAddress: "user@example.com",
+			Password:            mockUser.Password,
+		}
+		res = do(jar, "POST", "/oauth/api/auth/login", loginFormData{UserID: "user", Password: "pass"})
+		a.So(res.Code, should.Equal, http.StatusForbidden)
+		a.So(res.Body.String(), should.ContainSubstring, "password_login_disabled")
+		a.So(store.calls, should.NotContain, "CreateSession")
+	})
+}
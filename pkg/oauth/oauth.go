@@ -15,6 +15,7 @@
 package oauth
 
 import (
+	"context"
 	"encoding/json"
 	"net/http"
 	"net/url"
@@ -97,11 +98,18 @@ func (s *server) Authorize(authorizePage echo.HandlerFunc) echo.HandlerFunc {
 			oauth2.FinishAuthorizeRequest(resp, req, ar)
 			return s.output(c, resp)
 		case ttnpb.STATE_REQUESTED:
-			// TODO: Allow if user is collaborator (https://github.com/TheThingsNetwork/lorawan-stack/issues/49).
-			resp.InternalError = errClientNotApproved
-			resp.SetError(osin.E_INVALID_CLIENT, resp.InternalError.Error())
-			oauth2.FinishAuthorizeRequest(resp, req, ar)
-			return s.output(c, resp)
+			// Clients that are not yet approved can only be authorized by their collaborators.
+			collaboratorRights, err := s.collaboratorRights(req.Context(), session.UserIdentifiers, &client)
+			if err != nil {
+				return err
+			}
+			if len(collaboratorRights.GetRights()) == 0 {
+				resp.InternalError = errClientNotApproved
+				resp.SetError(osin.E_INVALID_CLIENT, resp.InternalError.Error())
+				oauth2.FinishAuthorizeRequest(resp, req, ar)
+				return s.output(c, resp)
+			}
+			client.Rights = collaboratorRights.GetRights()
 		}
 		ar.Authorized = client.SkipAuthorization
 		ar.Scope = rightsToScope(client.Rights...)
@@ -149,6 +157,55 @@ func (s *server) Authorize(authorizePage echo.HandlerFunc) echo.HandlerFunc {
 	}
 }
 
+// collaboratorRights returns the rights that the user can authorize the client for as its collaborator.
+// Direct collaborators can authorize all rights of the client. Members of organizations that are collaborators
+// of the client can authorize the intersection of the client rights and their rights on the organization, if the
+// intersection of their rights on the organization and the rights of the organization on the client is not empty.
+// If the user is not a collaborator of the client, no rights are returned.
+func (s *server) collaboratorRights(ctx context.Context, userIDs ttnpb.UserIdentifiers, client *ttnpb.Client) (*ttnpb.Rights, error) {
+	clientRights := ttnpb.RightsFrom(client.Rights...)
+	_, err := s.store.GetMember(ctx, userIDs.OrganizationOrUserIdentifiers(), client.ClientIdentifiers)
+	if err == nil {
+		return clientRights, nil
+	}
+	if !errors.IsNotFound(err) {
+		return nil, err
+	}
+	memberships, err := s.store.FindIndirectMemberships(ctx, &userIDs, client.ClientIdentifiers)
+	if err != nil {
+		return nil, err
+	}
+	// Pseudo rights, such as RIGHT_APPLICATION_ALL, are expanded on both sides so that they intersect.
+	clientRights = clientRights.Implied()
+	rights := &ttnpb.Rights{}
+	for _, membership := range memberships {
+		rightsOnOrganization := membership.RightsOnOrganization.Implied()
+		// The rights of the user on the client through the organization, as determined by the Identity Server.
+		if len(rightsOnOrganization.Intersect(membership.OrganizationRights.Implied()).GetRights()) == 0 {
+			continue
+		}
+		rights = rights.Union(clientRights.Intersect(rightsOnOrganization))
+	}
+	return rights.Sorted(), nil
+}
+
+// limitCollaboratorScope limits the scope of the access request of a client that is not yet approved to the rights
+// that the user currently has as collaborator of the client. This revokes access of users that are no longer
+// collaborator, or no longer member of an organization that is collaborator of the client.
+func (s *server) limitCollaboratorScope(ctx context.Context, userIDs ttnpb.UserIdentifiers, client *ttnpb.Client, ar *osin.AccessRequest) error {
+	collaboratorRights, err := s.collaboratorRights(ctx, userIDs, client)
+	if err != nil {
+		return err
+	}
+	rights := ttnpb.RightsFrom(rightsFromScope(ar.Scope)...).Implied().Intersect(collaboratorRights)
+	if len(rights.GetRights()) == 0 {
+		ar.Authorized = false
+		return nil
+	}
+	ar.Scope = rightsToScope(rights.GetRights()...)
+	return nil
+}
+
 type tokenRequest struct {
 	GrantType    string `json:"grant_type" form:"grant_type"`
 	Code         string `json:"code" form:"code"`
@@ -156,6 +213,7 @@ type tokenRequest struct {
 	RedirectURI  string `json:"redirect_uri" form:"redirect_uri"`
 	ClientID     string `json:"client_id" form:"client_id"`
 	ClientSecret string `json:"client_secret" form:"client_secret"`
+	DeviceCode   string `json:"device_code" form:"device_code"`
 }
 
 func (r tokenRequest) Values() (values url.Values) {
@@ -185,6 +243,9 @@ func (s *server) Token(c echo.Context) error {
 	if err := c.Bind(&tokenRequest); err != nil {
 		return err
 	}
+	if tokenRequest.GrantType == DeviceCodeGrantType {
+		return s.deviceToken(c, tokenRequest)
+	}
 	req.Form = tokenRequest.Values()
 	req.PostForm = req.Form
 
@@ -206,12 +267,17 @@ func (s *server) Token(c echo.Context) error {
 		ar.Authorized = clientHasGrant(&client, ttnpb.GRANT_REFRESH_TOKEN)
 	case osin.PASSWORD:
 		if clientHasGrant(&client, ttnpb.GRANT_PASSWORD) {
-			if err := s.doLogin(req.Context(), ar.Username, ar.Password); err != nil {
+			if err := s.doLogin(req.Context(), ar.Username, ar.Password, ""); err != nil {
 				return err
 			}
 			ar.Authorized = true
 		}
 	}
+	if ar.Authorized && client.State == ttnpb.STATE_REQUESTED {
+		if err := s.limitCollaboratorScope(req.Context(), userIDs, &client, ar); err != nil {
+			return err
+		}
+	}
 	if ar.Authorized {
 		events.Publish(evtTokenExchange(req.Context(), ttnpb.CombineIdentifiers(userIDs, client.ClientIdentifiers), nil))
 	}
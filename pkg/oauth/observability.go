@@ -28,6 +28,14 @@ var (
 		"oauth.user.login_failed", "login user failure",
 		ttnpb.RIGHT_USER_ALL,
 	)
+	evtCreateExternalUser = events.Define(
+		"oauth.user.external.create", "create user from upstream provider",
+		ttnpb.RIGHT_USER_ALL,
+	)
+	evtLinkExternalUser = events.Define(
+		"oauth.user.external.link", "link user to upstream provider",
+		ttnpb.RIGHT_USER_ALL,
+	)
 	evtUserLogout = events.Define(
 		"oauth.user.logout", "logout user",
 		ttnpb.RIGHT_USER_ALL,
@@ -0,0 +1,240 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oauth
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	jose "gopkg.in/square/go-jose.v2"
+	"gopkg.in/square/go-jose.v2/jwt"
+)
+
+// oidcMetadata is the OpenID Connect discovery metadata of a provider.
+type oidcMetadata struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	JWKSURI               string `json:"jwks_uri"`
+}
+
+// idTokenClaims are the claims of an ID token that are used by the OAuth server.
+type idTokenClaims struct {
+	jwt.Claims
+	Nonce             string      `json:"nonce"`
+	Email             string      `json:"email"`
+	EmailVerified     interface{} `json:"email_verified"` // Some providers send a string.
+	Name              string      `json:"name"`
+	PreferredUsername string      `json:"preferred_username"`
+
+	// Raw contains all claims of the ID token.
+	Raw map[string]interface{} `json:"-"`
+}
+
+// emailVerified returns whether the provider verified the email address.
+func (c idTokenClaims) emailVerified() bool {
+	switch verified := c.EmailVerified.(type) {
+	case bool:
+		return verified
+	case string:
+		return verified == "true"
+	default:
+		return false
+	}
+}
+
+// stringsClaim returns the claim as a slice of strings.
+func (c idTokenClaims) stringsClaim(name string) []string {
+	switch claim := c.Raw[name].(type) {
+	case string:
+		return []string{claim}
+	case []interface{}:
+		res := make([]string, 0, len(claim))
+		for _, v := range claim {
+			if s, ok := v.(string); ok {
+				res = append(res, s)
+			}
+		}
+		return res
+	default:
+		return nil
+	}
+}
+
+// oidcSigningAlgorithms are the supported signing algorithms of ID tokens.
+// Symmetric algorithms are not supported, as the client secret is not suitable as key.
+var oidcSigningAlgorithms = map[string]struct{}{
+	string(jose.RS256): {}, string(jose.RS384): {}, string(jose.RS512): {},
+	string(jose.PS256): {}, string(jose.PS384): {}, string(jose.PS512): {},
+	string(jose.ES256): {}, string(jose.ES384): {}, string(jose.ES512): {},
+}
+
+// oidcClockSkew is the allowed clock skew when validating the time claims of ID tokens.
+const oidcClockSkew = time.Minute
+
+var (
+	errProviderDiscovery      = errors.DefineUnavailable("provider_discovery", "discover OpenID Connect provider `{issuer_url}`")
+	errProviderIssuer         = errors.DefineFailedPrecondition("provider_issuer", "issuer `{issuer}` does not match issuer URL `{issuer_url}`")
+	errProviderKeys           = errors.DefineUnavailable("provider_keys", "get keys of OpenID Connect provider `{issuer_url}`")
+	errInvalidIDToken         = errors.DefineUnauthenticated("invalid_id_token", "invalid ID token")
+	errIDTokenAlgorithm       = errors.DefineUnauthenticated("id_token_algorithm", "unsupported ID token signing algorithm `{algorithm}`")
+	errIDTokenKeyNotFound     = errors.DefineUnauthenticated("id_token_key_not_found", "ID token key `{key_id}` not found")
+	errIDTokenAudience        = errors.DefineUnauthenticated("id_token_audience", "ID token is not issued for client `{client_id}`")
+	errIDTokenNonce           = errors.DefineUnauthenticated("id_token_nonce", "ID token nonce mismatch")
+	errUnexpectedHTTPResponse = errors.DefineUnavailable("unexpected_http_response", "unexpected HTTP response from `{url}`", "status")
+)
+
+func getJSON(ctx context.Context, url string, v interface{}) error {
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+	res, err := http.DefaultClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return errUnexpectedHTTPResponse.WithAttributes("url", url, "status", res.StatusCode)
+	}
+	return json.NewDecoder(res.Body).Decode(v)
+}
+
+// oidcProvider is an upstream OpenID Connect provider. The discovery metadata
+// and keys of the provider are cached.
+type oidcProvider struct {
+	issuerURL string
+
+	mu       sync.Mutex
+	metadata *oidcMetadata
+	keys     *jose.JSONWebKeySet
+}
+
+// oidcProviders caches the upstream OpenID Connect providers by issuer URL.
+type oidcProviders struct {
+	mu        sync.Mutex
+	providers map[string]*oidcProvider
+}
+
+func (p *oidcProviders) get(issuerURL string) *oidcProvider {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.providers == nil {
+		p.providers = make(map[string]*oidcProvider)
+	}
+	provider, ok := p.providers[issuerURL]
+	if !ok {
+		provider = &oidcProvider{issuerURL: issuerURL}
+		p.providers[issuerURL] = provider
+	}
+	return provider
+}
+
+// discover returns the discovery metadata of the provider.
+func (p *oidcProvider) discover(ctx context.Context) (*oidcMetadata, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.metadata != nil {
+		return p.metadata, nil
+	}
+	issuerURL := strings.TrimSuffix(p.issuerURL, "/")
+	metadata := &oidcMetadata{}
+	if err := getJSON(ctx, issuerURL+"/.well-known/openid-configuration", metadata); err != nil {
+		return nil, errProviderDiscovery.WithCause(err).WithAttributes("issuer_url", p.issuerURL)
+	}
+	if strings.TrimSuffix(metadata.Issuer, "/") != issuerURL {
+		return nil, errProviderIssuer.WithAttributes("issuer", metadata.Issuer, "issuer_url", p.issuerURL)
+	}
+	p.metadata = metadata
+	return metadata, nil
+}
+
+// key returns the key of the provider with the given ID. The keys of the
+// provider are fetched again if the key is unknown, as providers rotate keys.
+func (p *oidcProvider) key(ctx context.Context, keyID string) (*jose.JSONWebKey, error) {
+	metadata, err := p.discover(ctx)
+	if err != nil {
+		return nil, err
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.keys != nil {
+		if keys := p.keys.Key(keyID); len(keys) > 0 {
+			return &keys[0], nil
+		}
+	}
+	keySet := &jose.JSONWebKeySet{}
+	if err := getJSON(ctx, metadata.JWKSURI, keySet); err != nil {
+		return nil, errProviderKeys.WithCause(err).WithAttributes("issuer_url", p.issuerURL)
+	}
+	p.keys = keySet
+	if keys := keySet.Key(keyID); len(keys) > 0 {
+		return &keys[0], nil
+	}
+	if keyID == "" && len(keySet.Keys) == 1 {
+		return &keySet.Keys[0], nil
+	}
+	return nil, errIDTokenKeyNotFound.WithAttributes("key_id", keyID)
+}
+
+// verifyIDToken verifies the signature and the claims of the ID token.
+func (p *oidcProvider) verifyIDToken(ctx context.Context, rawIDToken, clientID, nonce string, now time.Time) (*idTokenClaims, error) {
+	metadata, err := p.discover(ctx)
+	if err != nil {
+		return nil, err
+	}
+	token, err := jwt.ParseSigned(rawIDToken)
+	if err != nil {
+		return nil, errInvalidIDToken.WithCause(err)
+	}
+	if len(token.Headers) != 1 {
+		return nil, errInvalidIDToken
+	}
+	header := token.Headers[0]
+	if _, ok := oidcSigningAlgorithms[header.Algorithm]; !ok {
+		return nil, errIDTokenAlgorithm.WithAttributes("algorithm", header.Algorithm)
+	}
+	key, err := p.key(ctx, header.KeyID)
+	if err != nil {
+		return nil, err
+	}
+	claims := &idTokenClaims{}
+	if err = token.Claims(key, claims, &claims.Raw); err != nil {
+		return nil, errInvalidIDToken.WithCause(err)
+	}
+	err = claims.ValidateWithLeeway(jwt.Expected{
+		Issuer: metadata.Issuer,
+		Time:   now,
+	}, oidcClockSkew)
+	if err != nil {
+		return nil, errInvalidIDToken.WithCause(err)
+	}
+	if !claims.Audience.Contains(clientID) {
+		return nil, errIDTokenAudience.WithAttributes("client_id", clientID)
+	}
+	if claims.Subject == "" {
+		return nil, errInvalidIDToken
+	}
+	if claims.Nonce != nonce {
+		return nil, errIDTokenNonce
+	}
+	return claims, nil
+}
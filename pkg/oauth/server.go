@@ -23,6 +23,7 @@ import (
 	echo "github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
 	"github.com/openshift/osin"
+	"go.thethings.network/lorawan-stack/pkg/crypto"
 	"go.thethings.network/lorawan-stack/pkg/errors"
 	web_errors "go.thethings.network/lorawan-stack/pkg/errors/web"
 	"go.thethings.network/lorawan-stack/pkg/identityserver/store"
@@ -40,6 +41,10 @@ type Server interface {
 	Logout(c echo.Context) error
 	Authorize(authorizePage echo.HandlerFunc) echo.HandlerFunc
 	Token(c echo.Context) error
+	DeviceAuthorization(c echo.Context) error
+	AuthorizeDevice(devicePage echo.HandlerFunc) echo.HandlerFunc
+	FederatedLogin(c echo.Context) error
+	FederatedCallback(c echo.Context) error
 }
 
 type server struct {
@@ -47,6 +52,10 @@ type server struct {
 	config     Config
 	osinConfig *osin.ServerConfig
 	store      Store
+	keyVault   crypto.KeyVault
+	oidc       oidcProviders
+
+	userCodeAttempts *userCodeAttemptLimiter
 }
 
 // Store used by the OAuth server.
@@ -54,10 +63,16 @@ type Store interface {
 	// UserStore and UserSessionStore are needed for user login/logout.
 	store.UserStore
 	store.UserSessionStore
+	// UserTOTPStore is needed for two-factor authentication during login.
+	store.UserTOTPStore
 	// ClientStore is needed for getting the OAuth client.
 	store.ClientStore
 	// OAuth is needed for OAuth authorizations.
 	store.OAuthStore
+	// MembershipStore is needed for authorizing clients that are not yet approved.
+	store.MembershipStore
+	// ExternalUserStore is needed for login with upstream providers.
+	store.ExternalUserStore
 }
 
 // UIConfig is the combined configuration for the OAuth UI.
@@ -73,7 +88,8 @@ type StackConfig struct {
 
 // FrontendConfig is the configuration for the OAuth frontend.
 type FrontendConfig struct {
-	Language    string `json:"language" name:"-"`
+	Language    string         `json:"language" name:"-"`
+	Providers   []ProviderInfo `json:"providers,omitempty" name:"-"`
 	StackConfig `json:"stack_config" name:",squash"`
 }
 
@@ -81,14 +97,20 @@ type FrontendConfig struct {
 type Config struct {
 	Mount string   `name:"mount" description:"Path on the server where the OAuth server will be served"`
 	UI    UIConfig `name:"ui"`
+	// Providers are the upstream OpenID Connect providers that users can login with.
+	Providers []ProviderConfig `name:"providers" file-only:"true" description:"Upstream OpenID Connect providers"`
 }
 
 // NewServer returns a new OAuth server on top of the given store.
-func NewServer(ctx context.Context, store Store, config Config) Server {
+// The key vault is used to decrypt the TOTP secrets of users.
+func NewServer(ctx context.Context, store Store, keyVault crypto.KeyVault, config Config) Server {
 	s := &server{
-		ctx:    ctx,
-		config: config,
-		store:  store,
+		ctx:      ctx,
+		config:   config,
+		store:    store,
+		keyVault: keyVault,
+
+		userCodeAttempts: newUserCodeAttemptLimiter(),
 	}
 
 	if s.config.Mount == "" {
@@ -230,6 +252,7 @@ func (s *server) RegisterRoutes(server *web.Server) {
 				c.Set("template_data", config.UI.TemplateData)
 				frontendConfig := config.UI.FrontendConfig
 				frontendConfig.Language = config.UI.TemplateData.Language
+				frontendConfig.Providers = config.providerInfo()
 				c.Set("app_config", struct {
 					FrontendConfig
 				}{
@@ -252,8 +275,11 @@ func (s *server) RegisterRoutes(server *web.Server) {
 		TokenLookup: "form:csrf",
 	}))
 	page.GET("/login", webui.Template.Handler, s.redirectToNext)
+	page.GET("/login/:provider", s.FederatedLogin, s.redirectToNext)
 	page.GET("/authorize", s.Authorize(webui.Template.Handler), s.redirectToLogin)
 	page.POST("/authorize", s.Authorize(webui.Template.Handler), s.redirectToLogin)
+	page.GET("/device", s.AuthorizeDevice(webui.Template.Handler), s.redirectToLogin)
+	page.POST("/device", s.AuthorizeDevice(webui.Template.Handler), s.redirectToLogin)
 
 	if s.config.Mount != "" && s.config.Mount != "/" {
 		group.GET("", webui.Template.Handler, middleware.CSRF())
@@ -263,5 +289,7 @@ func (s *server) RegisterRoutes(server *web.Server) {
 	// No CSRF here:
 	group.GET("/code", webui.Template.Handler)
 	group.GET("/local-callback", s.redirectToLocal)
+	group.GET("/login/:provider/callback", s.FederatedCallback)
 	group.POST("/token", s.Token)
+	group.POST("/device_authorization", s.DeviceAuthorization)
 }
@@ -31,9 +31,11 @@ import (
 	"github.com/smartystreets/assertions/should"
 	"go.thethings.network/lorawan-stack/pkg/auth"
 	"go.thethings.network/lorawan-stack/pkg/auth/pbkdf2"
+	"go.thethings.network/lorawan-stack/pkg/auth/totp"
 	"go.thethings.network/lorawan-stack/pkg/component"
 	componenttest "go.thethings.network/lorawan-stack/pkg/component/test"
 	"go.thethings.network/lorawan-stack/pkg/config"
+	"go.thethings.network/lorawan-stack/pkg/identityserver/store"
 	"go.thethings.network/lorawan-stack/pkg/oauth"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
 	"go.thethings.network/lorawan-stack/pkg/util/test"
@@ -42,9 +44,10 @@ import (
 )
 
 type loginFormData struct {
-	encoding string
-	UserID   string `json:"user_id"`
-	Password string `json:"password"`
+	encoding      string
+	UserID        string `json:"user_id"`
+	Password      string `json:"password"`
+	TwoFactorCode string `json:"two_factor_code,omitempty"`
 }
 
 type authorizeFormData struct {
@@ -52,12 +55,38 @@ type authorizeFormData struct {
 	Authorize bool `json:"authorize"`
 }
 
+type deviceFormData struct {
+	UserCode  string
+	Authorize bool
+}
+
 var (
 	mockSession = &ttnpb.UserSession{
 		UserIdentifiers: ttnpb.UserIdentifiers{UserID: "user"},
 		SessionID:       "session_id",
 		CreatedAt:       time.Now().Truncate(time.Second),
 	}
+	mockMemberships = []store.IndirectMembership{
+		{
+			RightsOnOrganization:    ttnpb.RightsFrom(ttnpb.RIGHT_APPLICATION_ALL, ttnpb.RIGHT_CLIENT_ALL),
+			OrganizationIdentifiers: &ttnpb.OrganizationIdentifiers{OrganizationID: "organization"},
+			OrganizationRights:      ttnpb.RightsFrom(ttnpb.RIGHT_CLIENT_ALL),
+		},
+	}
+	mockApplicationInfoMemberships = []store.IndirectMembership{
+		{
+			RightsOnOrganization:    ttnpb.RightsFrom(ttnpb.RIGHT_APPLICATION_INFO, ttnpb.RIGHT_CLIENT_ALL),
+			OrganizationIdentifiers: &ttnpb.OrganizationIdentifiers{OrganizationID: "organization"},
+			OrganizationRights:      ttnpb.RightsFrom(ttnpb.RIGHT_CLIENT_ALL),
+		},
+	}
+	mockNoClientRightsMemberships = []store.IndirectMembership{
+		{
+			RightsOnOrganization:    ttnpb.RightsFrom(ttnpb.RIGHT_APPLICATION_ALL),
+			OrganizationIdentifiers: &ttnpb.OrganizationIdentifiers{OrganizationID: "organization"},
+			OrganizationRights:      ttnpb.RightsFrom(ttnpb.RIGHT_CLIENT_ALL),
+		},
+	}
 	mockUser = &ttnpb.User{
 		UserIdentifiers: ttnpb.UserIdentifiers{UserID: "user"},
 	}
@@ -68,6 +97,35 @@ var (
 		RedirectURIs:      []string{"https://uri/callback", "http://uri/callback"},
 		Rights:            []ttnpb.Right{ttnpb.RIGHT_USER_INFO},
 	}
+	mockRequestedClient = &ttnpb.Client{
+		ClientIdentifiers: ttnpb.ClientIdentifiers{ClientID: "client"},
+		State:             ttnpb.STATE_REQUESTED,
+		Grants:            []ttnpb.GrantType{ttnpb.GRANT_AUTHORIZATION_CODE, ttnpb.GRANT_REFRESH_TOKEN},
+		RedirectURIs:      []string{"https://uri/callback", "http://uri/callback"},
+		Rights:            []ttnpb.Right{ttnpb.RIGHT_USER_INFO, ttnpb.RIGHT_APPLICATION_ALL},
+	}
+	mockDeviceClient = &ttnpb.Client{
+		ClientIdentifiers: ttnpb.ClientIdentifiers{ClientID: "device-client"},
+		State:             ttnpb.STATE_APPROVED,
+		Grants:            []ttnpb.GrantType{ttnpb.GRANT_DEVICE_CODE, ttnpb.GRANT_REFRESH_TOKEN},
+		Rights:            []ttnpb.Right{ttnpb.RIGHT_USER_INFO},
+	}
+	mockRequestedDeviceClient = &ttnpb.Client{
+		ClientIdentifiers: ttnpb.ClientIdentifiers{ClientID: "device-client"},
+		State:             ttnpb.STATE_REQUESTED,
+		Grants:            []ttnpb.GrantType{ttnpb.GRANT_DEVICE_CODE, ttnpb.GRANT_REFRESH_TOKEN},
+		Rights:            []ttnpb.Right{ttnpb.RIGHT_USER_INFO, ttnpb.RIGHT_APPLICATION_INFO},
+	}
+	mockSuspendedDeviceClient = &ttnpb.Client{
+		ClientIdentifiers: ttnpb.ClientIdentifiers{ClientID: "device-client"},
+		State:             ttnpb.STATE_SUSPENDED,
+		Grants:            []ttnpb.GrantType{ttnpb.GRANT_DEVICE_CODE, ttnpb.GRANT_REFRESH_TOKEN},
+		Rights:            []ttnpb.Right{ttnpb.RIGHT_USER_INFO},
+	}
+	mockTOTPSecret    = "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+	mockTOTPEnabledAt = time.Now().Add(-time.Hour)
+	mockTOTPCode      string
+	mockRecoveryCode  string
 )
 
 func init() {
@@ -88,6 +146,16 @@ func init() {
 		panic(err)
 	}
 	mockClient.Secret = secret
+	mockRequestedClient.Secret = secret
+
+	mockTOTPCode, err = totp.Code(mockTOTPSecret, time.Now())
+	if err != nil {
+		panic(err)
+	}
+	mockRecoveryCode, err = auth.Hash(ctx, "abcde-fghij")
+	if err != nil {
+		panic(err)
+	}
 }
 
 func TestOAuthFlow(t *testing.T) {
@@ -107,7 +175,7 @@ func TestOAuthFlow(t *testing.T) {
 			},
 		},
 	})
-	s := oauth.NewServer(ctx, store, oauth.Config{
+	s := oauth.NewServer(ctx, store, nil, oauth.Config{
 		Mount: "/oauth",
 		UI: oauth.UIConfig{
 			TemplateData: webui.TemplateData{
@@ -167,7 +235,7 @@ func TestOAuthFlow(t *testing.T) {
 			},
 			Method:       "POST",
 			Path:         "/oauth/api/auth/login",
-			Body:         loginFormData{"json", "user", "pass"},
+			Body:         loginFormData{"json", "user", "pass", ""},
 			ExpectedCode: http.StatusUnauthorized,
 			StoreCheck: func(t *testing.T, s *mockStore) {
 				a := assertions.New(t)
@@ -184,7 +252,7 @@ func TestOAuthFlow(t *testing.T) {
 			},
 			Method:       "POST",
 			Path:         "/oauth/api/auth/login",
-			Body:         loginFormData{"form", "user", "pass"},
+			Body:         loginFormData{"form", "user", "pass", ""},
 			ExpectedCode: http.StatusUnauthorized,
 			StoreCheck: func(t *testing.T, s *mockStore) {
 				a := assertions.New(t)
@@ -201,8 +269,110 @@ func TestOAuthFlow(t *testing.T) {
 			},
 			Method:       "POST",
 			Path:         "/oauth/api/auth/login",
-			Body:         loginFormData{"json", "user", "wrong_pass"},
+			Body:         loginFormData{"json", "user", "wrong_pass", ""},
+			ExpectedCode: http.StatusBadRequest,
+		},
+		{
+			Name: "login two-factor code required",
+			StoreSetup: func(s *mockStore) {
+				s.res.user = mockUser
+				s.res.totpSecret, s.res.totpEnabledAt = mockTOTPSecret, &mockTOTPEnabledAt
+			},
+			Method:       "POST",
+			Path:         "/oauth/api/auth/login",
+			Body:         loginFormData{"json", "user", "pass", ""},
+			ExpectedCode: http.StatusUnauthorized,
+			ExpectedBody: "two_factor_required",
+		},
+		{
+			Name: "login wrong two-factor code",
+			StoreSetup: func(s *mockStore) {
+				s.res.user = mockUser
+				s.res.totpSecret, s.res.totpEnabledAt = mockTOTPSecret, &mockTOTPEnabledAt
+				s.res.recoveryCodes = []string{mockRecoveryCode}
+			},
+			Method:       "POST",
+			Path:         "/oauth/api/auth/login",
+			Body:         loginFormData{"json", "user", "pass", "wrong-code"},
+			ExpectedCode: http.StatusBadRequest,
+			StoreCheck: func(t *testing.T, s *mockStore) {
+				a := assertions.New(t)
+				a.So(s.calls, should.NotContain, "CreateSession")
+				a.So(s.calls, should.NotContain, "DeleteRecoveryCode")
+			},
+		},
+		{
+			Name: "login with TOTP code",
+			StoreSetup: func(s *mockStore) {
+				s.res.user = mockUser
+				s.res.session = mockSession
+				s.res.totpSecret, s.res.totpEnabledAt = mockTOTPSecret, &mockTOTPEnabledAt
+			},
+			Method:       "POST",
+			Path:         "/oauth/api/auth/login",
+			Body:         loginFormData{"form", "user", "pass", mockTOTPCode},
+			ExpectedCode: http.StatusNoContent,
+			StoreCheck: func(t *testing.T, s *mockStore) {
+				a := assertions.New(t)
+				a.So(s.calls, should.Contain, "UseTOTPStep")
+				a.So(s.calls, should.Contain, "ResetTOTPAttempts")
+				a.So(s.calls, should.Contain, "CreateSession")
+			},
+		},
+		{
+			Name: "login with used TOTP code",
+			StoreSetup: func(s *mockStore) {
+				s.res.user = mockUser
+				s.res.session = mockSession
+				s.res.totpSecret, s.res.totpEnabledAt = mockTOTPSecret, &mockTOTPEnabledAt
+				s.err.useTOTPStep = mockErrAlreadyExists
+			},
+			Method:       "POST",
+			Path:         "/oauth/api/auth/login",
+			Body:         loginFormData{"form", "user", "pass", mockTOTPCode},
 			ExpectedCode: http.StatusBadRequest,
+			StoreCheck: func(t *testing.T, s *mockStore) {
+				a := assertions.New(t)
+				a.So(s.calls, should.Contain, "UseTOTPStep")
+				a.So(s.calls, should.NotContain, "CreateSession")
+			},
+		},
+		{
+			Name: "login too many two-factor attempts",
+			StoreSetup: func(s *mockStore) {
+				s.res.user = mockUser
+				s.res.session = mockSession
+				s.res.totpSecret, s.res.totpEnabledAt = mockTOTPSecret, &mockTOTPEnabledAt
+				s.err.countTOTPAttempt = mockErrTooManyAttempts
+			},
+			Method:       "POST",
+			Path:         "/oauth/api/auth/login",
+			Body:         loginFormData{"form", "user", "pass", mockTOTPCode},
+			ExpectedCode: http.StatusTooManyRequests,
+			StoreCheck: func(t *testing.T, s *mockStore) {
+				a := assertions.New(t)
+				a.So(s.calls, should.NotContain, "UseTOTPStep")
+				a.So(s.calls, should.NotContain, "CreateSession")
+			},
+		},
+		{
+			Name: "login with recovery code",
+			StoreSetup: func(s *mockStore) {
+				s.res.user = mockUser
+				s.res.session = mockSession
+				s.res.totpSecret, s.res.totpEnabledAt = mockTOTPSecret, &mockTOTPEnabledAt
+				s.res.recoveryCodes = []string{mockRecoveryCode}
+			},
+			Method:       "POST",
+			Path:         "/oauth/api/auth/login",
+			Body:         loginFormData{"json", "user", "pass", " ABCDE-FGHIJ "},
+			ExpectedCode: http.StatusNoContent,
+			StoreCheck: func(t *testing.T, s *mockStore) {
+				a := assertions.New(t)
+				a.So(s.calls, should.Contain, "DeleteRecoveryCode")
+				a.So(s.req.code, should.Equal, mockRecoveryCode)
+				a.So(s.calls, should.Contain, "CreateSession")
+			},
 		},
 		{
 			Name: "login",
@@ -212,7 +382,7 @@ func TestOAuthFlow(t *testing.T) {
 			},
 			Method:       "POST",
 			Path:         "/oauth/api/auth/login",
-			Body:         loginFormData{"json", "user", "pass"},
+			Body:         loginFormData{"json", "user", "pass", ""},
 			ExpectedCode: http.StatusNoContent,
 		},
 		{
@@ -305,11 +475,100 @@ func TestOAuthFlow(t *testing.T) {
 					RedirectURIs:      []string{"https://uri/callback", "http://uri/callback"},
 					Rights:            []ttnpb.Right{ttnpb.RIGHT_USER_INFO},
 				}
+				s.err.getMember = mockErrNotFound
 			},
 			Method:           "GET",
 			Path:             "/oauth/authorize?client_id=client&redirect_uri=http://uri/callback&response_type=code&state=foo",
 			ExpectedCode:     http.StatusFound,
 			ExpectedRedirect: "http://uri/callback?error=invalid_client",
+			StoreCheck: func(t *testing.T, s *mockStore) {
+				a := assertions.New(t)
+				a.So(s.calls, should.Contain, "GetMember")
+				a.So(s.calls, should.Contain, "FindIndirectMemberships")
+				a.So(s.calls, should.NotContain, "Authorize")
+			},
+		},
+		{
+			Name: "client not approved authorized by collaborator",
+			StoreSetup: func(s *mockStore) {
+				s.res.session = mockSession
+				s.res.user = mockUser
+				s.res.client = &ttnpb.Client{
+					ClientIdentifiers: ttnpb.ClientIdentifiers{ClientID: "client"},
+					State:             ttnpb.STATE_REQUESTED,
+					Grants:            []ttnpb.GrantType{ttnpb.GRANT_AUTHORIZATION_CODE, ttnpb.GRANT_REFRESH_TOKEN},
+					RedirectURIs:      []string{"https://uri/callback", "http://uri/callback"},
+					Rights:            []ttnpb.Right{ttnpb.RIGHT_USER_INFO, ttnpb.RIGHT_APPLICATION_INFO},
+				}
+				s.res.memberRights = ttnpb.RightsFrom(ttnpb.RIGHT_CLIENT_ALL)
+				s.err.getAuthorization = mockErrNotFound
+			},
+			Method:           "POST",
+			Path:             "/oauth/authorize?client_id=client&redirect_uri=http://uri/callback&response_type=code&state=foo",
+			Body:             authorizeFormData{encoding: "form", Authorize: true},
+			ExpectedCode:     http.StatusFound,
+			ExpectedRedirect: "http://uri/callback?code=",
+			StoreCheck: func(t *testing.T, s *mockStore) {
+				a := assertions.New(t)
+				a.So(s.calls, should.Contain, "GetMember")
+				a.So(s.calls, should.NotContain, "FindIndirectMemberships")
+				a.So(s.calls, should.Contain, "CreateAuthorizationCode")
+				a.So(s.req.authorizationCode.Rights, should.Resemble, []ttnpb.Right{ttnpb.RIGHT_USER_INFO, ttnpb.RIGHT_APPLICATION_INFO})
+			},
+		},
+		{
+			Name: "client not approved authorized by organization member",
+			StoreSetup: func(s *mockStore) {
+				s.res.session = mockSession
+				s.res.user = mockUser
+				s.res.client = &ttnpb.Client{
+					ClientIdentifiers: ttnpb.ClientIdentifiers{ClientID: "client"},
+					State:             ttnpb.STATE_REQUESTED,
+					Grants:            []ttnpb.GrantType{ttnpb.GRANT_AUTHORIZATION_CODE, ttnpb.GRANT_REFRESH_TOKEN},
+					RedirectURIs:      []string{"https://uri/callback", "http://uri/callback"},
+					Rights:            []ttnpb.Right{ttnpb.RIGHT_USER_INFO, ttnpb.RIGHT_APPLICATION_INFO},
+				}
+				s.err.getMember = mockErrNotFound
+				s.res.memberships = mockMemberships
+				s.err.getAuthorization = mockErrNotFound
+			},
+			Method:           "POST",
+			Path:             "/oauth/authorize?client_id=client&redirect_uri=http://uri/callback&response_type=code&state=foo",
+			Body:             authorizeFormData{encoding: "form", Authorize: true},
+			ExpectedCode:     http.StatusFound,
+			ExpectedRedirect: "http://uri/callback?code=",
+			StoreCheck: func(t *testing.T, s *mockStore) {
+				a := assertions.New(t)
+				a.So(s.calls, should.Contain, "FindIndirectMemberships")
+				a.So(s.calls, should.Contain, "CreateAuthorizationCode")
+				a.So(s.req.authorizationCode.Rights, should.Resemble, []ttnpb.Right{ttnpb.RIGHT_APPLICATION_INFO})
+			},
+		},
+		{
+			Name: "client not approved authorized by organization member without client rights",
+			StoreSetup: func(s *mockStore) {
+				s.res.session = mockSession
+				s.res.user = mockUser
+				s.res.client = &ttnpb.Client{
+					ClientIdentifiers: ttnpb.ClientIdentifiers{ClientID: "client"},
+					State:             ttnpb.STATE_REQUESTED,
+					Grants:            []ttnpb.GrantType{ttnpb.GRANT_AUTHORIZATION_CODE, ttnpb.GRANT_REFRESH_TOKEN},
+					RedirectURIs:      []string{"https://uri/callback", "http://uri/callback"},
+					Rights:            []ttnpb.Right{ttnpb.RIGHT_USER_INFO, ttnpb.RIGHT_APPLICATION_INFO},
+				}
+				s.err.getMember = mockErrNotFound
+				s.res.memberships = mockNoClientRightsMemberships
+			},
+			Method:           "POST",
+			Path:             "/oauth/authorize?client_id=client&redirect_uri=http://uri/callback&response_type=code&state=foo",
+			Body:             authorizeFormData{encoding: "form", Authorize: true},
+			ExpectedCode:     http.StatusFound,
+			ExpectedRedirect: "http://uri/callback?error=invalid_client",
+			StoreCheck: func(t *testing.T, s *mockStore) {
+				a := assertions.New(t)
+				a.So(s.calls, should.Contain, "FindIndirectMemberships")
+				a.So(s.calls, should.NotContain, "CreateAuthorizationCode")
+			},
 		},
 		{
 			Name: "client suspended",
@@ -392,6 +651,113 @@ func TestOAuthFlow(t *testing.T) {
 				a.So(s.req.authorizationCode.State, should.Equal, "foo")
 			},
 		},
+		{
+			Name: "device page",
+			StoreSetup: func(s *mockStore) {
+				s.res.session = mockSession
+				s.res.user = mockUser
+			},
+			Method:       "GET",
+			Path:         "/oauth/device",
+			ExpectedCode: http.StatusOK,
+			ExpectedBody: "The Things Network OAuth",
+		},
+		{
+			Name: "device invalid user code",
+			StoreSetup: func(s *mockStore) {
+				s.res.session = mockSession
+				s.res.user = mockUser
+				s.err.getDeviceAuthorization = mockErrNotFound
+			},
+			Method:       "GET",
+			Path:         "/oauth/device?user_code=bcdf-ghjk",
+			ExpectedCode: http.StatusNotFound,
+			StoreCheck: func(t *testing.T, s *mockStore) {
+				assertions.New(t).So(s.req.userCode, should.Equal, "BCDF-GHJK")
+			},
+		},
+		{
+			Name: "authorize device",
+			StoreSetup: func(s *mockStore) {
+				s.res.session = mockSession
+				s.res.user = mockUser
+				s.res.client = mockDeviceClient
+				s.res.deviceAuthorization = &ttnpb.OAuthDeviceAuthorization{
+					ClientIDs:  mockDeviceClient.ClientIdentifiers,
+					Rights:     mockDeviceClient.Rights,
+					DeviceCode: "device-code",
+					UserCode:   "BCDF-GHJK",
+					CreatedAt:  time.Now(),
+					ExpiresAt:  time.Now().Add(time.Minute),
+				}
+			},
+			Method:       "POST",
+			Path:         "/oauth/device",
+			Body:         deviceFormData{UserCode: "BCDFGHJK", Authorize: true},
+			ExpectedCode: http.StatusOK,
+			StoreCheck: func(t *testing.T, s *mockStore) {
+				a := assertions.New(t)
+				a.So(s.calls, should.Contain, "Authorize")
+				a.So(s.req.authorization.UserIDs, should.Resemble, mockUser.UserIdentifiers)
+				a.So(s.req.authorization.ClientIDs, should.Resemble, mockDeviceClient.ClientIdentifiers)
+				a.So(s.calls, should.Contain, "UpdateDeviceAuthorization")
+				a.So(s.req.deviceAuthorization.UserIDs, should.Resemble, mockUser.UserIdentifiers)
+				a.So(s.req.deviceAuthorization.Rights, should.Resemble, mockDeviceClient.Rights)
+				a.So(s.req.deviceAuthorization.Denied, should.BeFalse)
+			},
+		},
+		{
+			Name: "authorize device concurrently",
+			StoreSetup: func(s *mockStore) {
+				s.res.session = mockSession
+				s.res.user = mockUser
+				s.res.client = mockDeviceClient
+				s.res.deviceAuthorization = &ttnpb.OAuthDeviceAuthorization{
+					ClientIDs:  mockDeviceClient.ClientIdentifiers,
+					Rights:     mockDeviceClient.Rights,
+					DeviceCode: "device-code",
+					UserCode:   "BCDF-GHJK",
+					CreatedAt:  time.Now(),
+					ExpiresAt:  time.Now().Add(time.Minute),
+				}
+				s.err.updateDeviceAuthorization = mockErrFailedPrecondition
+			},
+			Method:       "POST",
+			Path:         "/oauth/device",
+			Body:         deviceFormData{UserCode: "BCDFGHJK", Authorize: true},
+			ExpectedCode: http.StatusNotFound,
+			StoreCheck: func(t *testing.T, s *mockStore) {
+				a := assertions.New(t)
+				a.So(s.calls, should.Contain, "UpdateDeviceAuthorization")
+				a.So(s.calls, should.NotContain, "Authorize")
+			},
+		},
+		{
+			Name: "deny device",
+			StoreSetup: func(s *mockStore) {
+				s.res.session = mockSession
+				s.res.user = mockUser
+				s.res.client = mockDeviceClient
+				s.res.deviceAuthorization = &ttnpb.OAuthDeviceAuthorization{
+					ClientIDs:  mockDeviceClient.ClientIdentifiers,
+					Rights:     mockDeviceClient.Rights,
+					DeviceCode: "device-code",
+					UserCode:   "BCDF-GHJK",
+					CreatedAt:  time.Now(),
+					ExpiresAt:  time.Now().Add(time.Minute),
+				}
+			},
+			Method:       "POST",
+			Path:         "/oauth/device",
+			Body:         deviceFormData{UserCode: "BCDF-GHJK", Authorize: false},
+			ExpectedCode: http.StatusOK,
+			StoreCheck: func(t *testing.T, s *mockStore) {
+				a := assertions.New(t)
+				a.So(s.calls, should.NotContain, "Authorize")
+				a.So(s.calls, should.Contain, "UpdateDeviceAuthorization")
+				a.So(s.req.deviceAuthorization.Denied, should.BeTrue)
+			},
+		},
 		{
 			Name: "logout",
 			StoreSetup: func(s *mockStore) {
@@ -444,8 +810,9 @@ func TestOAuthFlow(t *testing.T) {
 				}
 				if b.encoding == "form" {
 					body = bytes.NewBuffer([]byte(url.Values{
-						"user_id":  []string{b.UserID},
-						"password": []string{b.Password},
+						"user_id":         []string{b.UserID},
+						"password":        []string{b.Password},
+						"two_factor_code": []string{b.TwoFactorCode},
 					}.Encode()))
 					contentType = "application/x-www-form-urlencoded"
 				}
@@ -465,6 +832,16 @@ func TestOAuthFlow(t *testing.T) {
 					body = bytes.NewBuffer([]byte(values.Encode()))
 					contentType = "application/x-www-form-urlencoded"
 				}
+			case deviceFormData:
+				values := url.Values{
+					"user_code": []string{b.UserCode},
+					"authorize": []string{strconv.FormatBool(b.Authorize)},
+				}
+				if csrfToken != "" {
+					values.Set("csrf", csrfToken)
+				}
+				body = bytes.NewBuffer([]byte(values.Encode()))
+				contentType = "application/x-www-form-urlencoded"
 			}
 
 			if contentType != "" {
@@ -498,6 +875,99 @@ func TestOAuthFlow(t *testing.T) {
 	}
 }
 
+func TestDeviceUserCodeAttempts(t *testing.T) {
+	ctx := test.Context()
+	store := &mockStore{}
+	jar, err := cookiejar.New(&cookiejar.Options{PublicSuffixList: publicsuffix.List})
+	if err != nil {
+		panic(err)
+	}
+	c := componenttest.NewComponent(t, &component.Config{
+		ServiceBase: config.ServiceBase{
+			HTTP: config.HTTP{
+				Cookie: config.Cookie{
+					HashKey:  []byte("12345678123456781234567812345678"),
+					BlockKey: []byte("12345678123456781234567812345678"),
+				},
+			},
+		},
+	})
+	s := oauth.NewServer(ctx, store, nil, oauth.Config{
+		Mount: "/oauth",
+		UI: oauth.UIConfig{
+			TemplateData: webui.TemplateData{
+				SiteName:     "The Things Network",
+				Title:        "OAuth",
+				CanonicalURL: "https://example.com/oauth",
+			},
+		},
+	})
+	c.RegisterWeb(s)
+	componenttest.StartComponent(t, c)
+
+	do := func(method, path string, body url.Values) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, path, nil)
+		req.URL.Scheme, req.URL.Host = "http", req.Host
+		for _, c := range jar.Cookies(req.URL) {
+			req.AddCookie(c)
+			if c.Name == "_csrf" {
+				req.Header.Set("X-CSRF-Token", c.Value)
+			}
+		}
+		if body != nil {
+			encoded := body.Encode()
+			req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+			req.Body = ioutil.NopCloser(bytes.NewBufferString(encoded))
+			req.ContentLength = int64(len(encoded))
+		}
+		res := httptest.NewRecorder()
+		c.ServeHTTP(res, req)
+		if cookies := res.Result().Cookies(); len(cookies) > 0 {
+			jar.SetCookies(req.URL, cookies)
+		}
+		return res
+	}
+
+	a := assertions.New(t)
+
+	store.reset()
+	a.So(do("GET", "/oauth/login", nil).Code, should.Equal, http.StatusOK)
+
+	store.reset()
+	store.res.user = mockUser
+	store.res.session = mockSession
+	a.So(do("POST", "/oauth/api/auth/login", url.Values{
+		"user_id":  []string{"user"},
+		"password": []string{"pass"},
+	}).Code, should.Equal, http.StatusNoContent)
+
+	for i := 0; i < 5; i++ {
+		store.reset()
+		store.res.session = mockSession
+		store.res.user = mockUser
+		store.err.getDeviceAuthorization = mockErrNotFound
+		a.So(do("GET", "/oauth/device?user_code=BCDF-GHJK", nil).Code, should.Equal, http.StatusNotFound)
+	}
+
+	store.reset()
+	store.res.session = mockSession
+	store.res.user = mockUser
+	store.res.client = mockDeviceClient
+	store.res.deviceAuthorization = &ttnpb.OAuthDeviceAuthorization{
+		ClientIDs:  mockDeviceClient.ClientIdentifiers,
+		Rights:     mockDeviceClient.Rights,
+		DeviceCode: "device-code",
+		UserCode:   "BCDF-GHJK",
+		CreatedAt:  time.Now(),
+		ExpiresAt:  time.Now().Add(time.Minute),
+	}
+	a.So(do("GET", "/oauth/device?user_code=BCDF-GHJK", nil).Code, should.Equal, http.StatusTooManyRequests)
+	a.So(store.calls, should.NotContain, "GetDeviceAuthorizationByUserCode")
+
+	// Entering the device page without a user code is not limited.
+	a.So(do("GET", "/oauth/device", nil).Code, should.Equal, http.StatusOK)
+}
+
 func TestTokenExchange(t *testing.T) {
 	ctx := test.Context()
 	store := &mockStore{}
@@ -511,7 +981,7 @@ func TestTokenExchange(t *testing.T) {
 			},
 		},
 	})
-	s := oauth.NewServer(ctx, store, oauth.Config{
+	s := oauth.NewServer(ctx, store, nil, oauth.Config{
 		Mount: "/oauth",
 		UI: oauth.UIConfig{
 			TemplateData: webui.TemplateData{
@@ -608,6 +1078,308 @@ func TestTokenExchange(t *testing.T) {
 				a.So(s.req.previousID, should.Equal, "IBTFXELDVVT64Y26IZZFFNSL7GWZY2Y3ALQQI3A")
 			},
 		},
+		{
+			Name: "Exchange Refresh Token of Client Not Approved by Organization Member",
+			StoreSetup: func(s *mockStore) {
+				s.res.client = mockRequestedClient
+				s.res.accessToken = &ttnpb.OAuthAccessToken{
+					UserIDs:      mockUser.UserIdentifiers,
+					ClientIDs:    mockRequestedClient.ClientIdentifiers,
+					ID:           "SFUBFRKYTGULGPAXXM4SHIBYMKCPTIMQBM63ZGQ",
+					RefreshToken: "PBKDF2$sha256$20000$IGAiKs46xX_M64E5$4xpyqnQT8SOa_Vf4xhEPk6WOZnhmAjG2mqGQiYBhm2s",
+					Rights:       []ttnpb.Right{ttnpb.RIGHT_APPLICATION_INFO, ttnpb.RIGHT_APPLICATION_LINK},
+					CreatedAt:    time.Now().Truncate(time.Second),
+					ExpiresAt:    time.Now().Truncate(time.Second).Add(time.Hour),
+				}
+				s.err.getMember = mockErrNotFound
+				s.res.memberships = mockApplicationInfoMemberships
+			},
+			Method: "POST",
+			Path:   "/oauth/token",
+			Body: map[string]string{
+				"grant_type":    "refresh_token",
+				"refresh_token": "OJSWM.IBTFXELDVVT64Y26IZZFFNSL7GWZY2Y3ALQQI3A.GCPIASDUP7UZJ6YL5OP2ESZB7CKRFV4JJQYTMDOSDIOE7O75IAMQ",
+				"client_id":     "client",
+				"client_secret": "secret",
+			},
+			ExpectedCode: http.StatusOK,
+			StoreCheck: func(t *testing.T, s *mockStore) {
+				a := assertions.New(t)
+				a.So(s.calls, should.Contain, "FindIndirectMemberships")
+				a.So(s.calls, should.Contain, "CreateAccessToken")
+				a.So(s.req.token.Rights, should.Resemble, []ttnpb.Right{ttnpb.RIGHT_APPLICATION_INFO})
+			},
+		},
+		{
+			Name: "Exchange Refresh Token of Client Not Approved by Former Collaborator",
+			StoreSetup: func(s *mockStore) {
+				s.res.client = mockRequestedClient
+				s.res.accessToken = &ttnpb.OAuthAccessToken{
+					UserIDs:      mockUser.UserIdentifiers,
+					ClientIDs:    mockRequestedClient.ClientIdentifiers,
+					ID:           "SFUBFRKYTGULGPAXXM4SHIBYMKCPTIMQBM63ZGQ",
+					RefreshToken: "PBKDF2$sha256$20000$IGAiKs46xX_M64E5$4xpyqnQT8SOa_Vf4xhEPk6WOZnhmAjG2mqGQiYBhm2s",
+					Rights:       []ttnpb.Right{ttnpb.RIGHT_APPLICATION_INFO},
+					CreatedAt:    time.Now().Truncate(time.Second),
+					ExpiresAt:    time.Now().Truncate(time.Second).Add(time.Hour),
+				}
+				s.err.getMember = mockErrNotFound
+			},
+			Method: "POST",
+			Path:   "/oauth/token",
+			Body: map[string]string{
+				"grant_type":    "refresh_token",
+				"refresh_token": "OJSWM.IBTFXELDVVT64Y26IZZFFNSL7GWZY2Y3ALQQI3A.GCPIASDUP7UZJ6YL5OP2ESZB7CKRFV4JJQYTMDOSDIOE7O75IAMQ",
+				"client_id":     "client",
+				"client_secret": "secret",
+			},
+			ExpectedCode: http.StatusForbidden,
+			StoreCheck: func(t *testing.T, s *mockStore) {
+				a := assertions.New(t)
+				a.So(s.calls, should.Contain, "GetMember")
+				a.So(s.calls, should.Contain, "FindIndirectMemberships")
+				a.So(s.calls, should.NotContain, "CreateAccessToken")
+			},
+		},
+		{
+			Name: "Device Authorization",
+			StoreSetup: func(s *mockStore) {
+				s.res.client = mockDeviceClient
+			},
+			Method: "POST",
+			Path:   "/oauth/device_authorization",
+			Body: url.Values{
+				"client_id": []string{"device-client"},
+			},
+			ExpectedCode: http.StatusOK,
+			ExpectedBody: `"verification_uri":"http://example.com/oauth/device"`,
+			StoreCheck: func(t *testing.T, s *mockStore) {
+				a := assertions.New(t)
+				a.So(s.calls, should.Contain, "CreateDeviceAuthorization")
+				a.So(s.req.deviceAuthorization.ClientIDs, should.Resemble, mockDeviceClient.ClientIdentifiers)
+				a.So(s.req.deviceAuthorization.Rights, should.Resemble, mockDeviceClient.Rights)
+				a.So(s.req.deviceAuthorization.DeviceCode, should.NotBeEmpty)
+				a.So(s.req.deviceAuthorization.UserCode, should.HaveLength, 9)
+			},
+		},
+		{
+			Name: "Device Authorization Without Grant",
+			StoreSetup: func(s *mockStore) {
+				s.res.client = mockClient
+			},
+			Method: "POST",
+			Path:   "/oauth/device_authorization",
+			Body: url.Values{
+				"client_id":     []string{"client"},
+				"client_secret": []string{"secret"},
+			},
+			ExpectedCode: http.StatusForbidden,
+		},
+		{
+			Name: "Exchange Pending Device Code",
+			StoreSetup: func(s *mockStore) {
+				s.res.client = mockDeviceClient
+				s.res.deviceAuthorization = &ttnpb.OAuthDeviceAuthorization{
+					ClientIDs:  mockDeviceClient.ClientIdentifiers,
+					Rights:     mockDeviceClient.Rights,
+					DeviceCode: "device-code",
+					UserCode:   "BCDF-GHJK",
+					CreatedAt:  time.Now(),
+					ExpiresAt:  time.Now().Add(time.Minute),
+				}
+			},
+			Method: "POST",
+			Path:   "/oauth/token",
+			Body: url.Values{
+				"grant_type":  []string{oauth.DeviceCodeGrantType},
+				"device_code": []string{"device-code"},
+				"client_id":   []string{"device-client"},
+			},
+			ExpectedCode: http.StatusBadRequest,
+			ExpectedBody: `"error":"authorization_pending"`,
+			StoreCheck: func(t *testing.T, s *mockStore) {
+				a := assertions.New(t)
+				a.So(s.req.deviceCode, should.Equal, "device-code")
+				a.So(s.calls, should.NotContain, "DeleteDeviceAuthorization")
+				a.So(s.calls, should.NotContain, "CreateAccessToken")
+			},
+		},
+		{
+			Name: "Exchange Device Code Too Often",
+			StoreSetup: func(s *mockStore) {
+				s.res.client = mockDeviceClient
+				s.err.pollDeviceAuthorization = mockErrTooManyAttempts
+			},
+			Method: "POST",
+			Path:   "/oauth/token",
+			Body: url.Values{
+				"grant_type":  []string{oauth.DeviceCodeGrantType},
+				"device_code": []string{"device-code"},
+				"client_id":   []string{"device-client"},
+			},
+			ExpectedCode: http.StatusBadRequest,
+			ExpectedBody: `"error":"slow_down"`,
+			StoreCheck: func(t *testing.T, s *mockStore) {
+				a := assertions.New(t)
+				a.So(s.calls, should.NotContain, "RedeemDeviceAuthorization")
+				a.So(s.calls, should.NotContain, "CreateAccessToken")
+			},
+		},
+		{
+			Name: "Exchange Denied Device Code",
+			StoreSetup: func(s *mockStore) {
+				s.res.client = mockDeviceClient
+				s.res.deviceAuthorization = &ttnpb.OAuthDeviceAuthorization{
+					ClientIDs:  mockDeviceClient.ClientIdentifiers,
+					UserIDs:    mockUser.UserIdentifiers,
+					DeviceCode: "device-code",
+					UserCode:   "BCDF-GHJK",
+					Denied:     true,
+					CreatedAt:  time.Now(),
+					ExpiresAt:  time.Now().Add(time.Minute),
+				}
+			},
+			Method: "POST",
+			Path:   "/oauth/token",
+			Body: url.Values{
+				"grant_type":  []string{oauth.DeviceCodeGrantType},
+				"device_code": []string{"device-code"},
+				"client_id":   []string{"device-client"},
+			},
+			ExpectedCode: http.StatusBadRequest,
+			ExpectedBody: `"error":"access_denied"`,
+			StoreCheck: func(t *testing.T, s *mockStore) {
+				a := assertions.New(t)
+				a.So(s.calls, should.Contain, "DeleteDeviceAuthorization")
+				a.So(s.calls, should.NotContain, "CreateAccessToken")
+			},
+		},
+		{
+			Name: "Exchange Device Code",
+			StoreSetup: func(s *mockStore) {
+				s.res.client = mockDeviceClient
+				s.res.deviceAuthorization = &ttnpb.OAuthDeviceAuthorization{
+					ClientIDs:  mockDeviceClient.ClientIdentifiers,
+					UserIDs:    mockUser.UserIdentifiers,
+					Rights:     mockDeviceClient.Rights,
+					DeviceCode: "device-code",
+					UserCode:   "BCDF-GHJK",
+					CreatedAt:  time.Now(),
+					ExpiresAt:  time.Now().Add(time.Minute),
+				}
+			},
+			Method: "POST",
+			Path:   "/oauth/token",
+			Body: url.Values{
+				"grant_type":  []string{oauth.DeviceCodeGrantType},
+				"device_code": []string{"device-code"},
+				"client_id":   []string{"device-client"},
+			},
+			ExpectedCode: http.StatusOK,
+			ExpectedBody: `"access_token"`,
+			StoreCheck: func(t *testing.T, s *mockStore) {
+				a := assertions.New(t)
+				a.So(s.calls, should.Contain, "RedeemDeviceAuthorization")
+				a.So(s.calls, should.NotContain, "DeleteDeviceAuthorization")
+				a.So(s.calls, should.Contain, "CreateAccessToken")
+				a.So(s.req.token.UserIDs, should.Resemble, mockUser.UserIdentifiers)
+				a.So(s.req.token.ClientIDs, should.Resemble, mockDeviceClient.ClientIdentifiers)
+				a.So(s.req.token.Rights, should.Resemble, mockDeviceClient.Rights)
+				a.So(s.req.token.AccessToken, should.NotBeEmpty)
+				a.So(s.req.token.RefreshToken, should.NotBeEmpty)
+			},
+		},
+		{
+			Name: "Exchange Device Code of Suspended Client",
+			StoreSetup: func(s *mockStore) {
+				s.res.client = mockSuspendedDeviceClient
+				s.res.deviceAuthorization = &ttnpb.OAuthDeviceAuthorization{
+					ClientIDs:  mockSuspendedDeviceClient.ClientIdentifiers,
+					UserIDs:    mockUser.UserIdentifiers,
+					Rights:     mockSuspendedDeviceClient.Rights,
+					DeviceCode: "device-code",
+					UserCode:   "BCDF-GHJK",
+					CreatedAt:  time.Now(),
+					ExpiresAt:  time.Now().Add(time.Minute),
+				}
+			},
+			Method: "POST",
+			Path:   "/oauth/token",
+			Body: url.Values{
+				"grant_type":  []string{oauth.DeviceCodeGrantType},
+				"device_code": []string{"device-code"},
+				"client_id":   []string{"device-client"},
+			},
+			ExpectedCode: http.StatusBadRequest,
+			ExpectedBody: `"error":"unauthorized_client"`,
+			StoreCheck: func(t *testing.T, s *mockStore) {
+				a := assertions.New(t)
+				a.So(s.calls, should.NotContain, "RedeemDeviceAuthorization")
+				a.So(s.calls, should.NotContain, "CreateAccessToken")
+			},
+		},
+		{
+			Name: "Exchange Device Code of Client Not Approved by Organization Member",
+			StoreSetup: func(s *mockStore) {
+				s.res.client = mockRequestedDeviceClient
+				s.res.deviceAuthorization = &ttnpb.OAuthDeviceAuthorization{
+					ClientIDs:  mockRequestedDeviceClient.ClientIdentifiers,
+					UserIDs:    mockUser.UserIdentifiers,
+					Rights:     []ttnpb.Right{ttnpb.RIGHT_APPLICATION_INFO, ttnpb.RIGHT_APPLICATION_LINK},
+					DeviceCode: "device-code",
+					UserCode:   "BCDF-GHJK",
+					CreatedAt:  time.Now(),
+					ExpiresAt:  time.Now().Add(time.Minute),
+				}
+				s.err.getMember = mockErrNotFound
+				s.res.memberships = mockApplicationInfoMemberships
+			},
+			Method: "POST",
+			Path:   "/oauth/token",
+			Body: url.Values{
+				"grant_type":  []string{oauth.DeviceCodeGrantType},
+				"device_code": []string{"device-code"},
+				"client_id":   []string{"device-client"},
+			},
+			ExpectedCode: http.StatusOK,
+			ExpectedBody: `"access_token"`,
+			StoreCheck: func(t *testing.T, s *mockStore) {
+				a := assertions.New(t)
+				a.So(s.calls, should.Contain, "FindIndirectMemberships")
+				a.So(s.calls, should.Contain, "CreateAccessToken")
+				a.So(s.req.token.Rights, should.Resemble, []ttnpb.Right{ttnpb.RIGHT_APPLICATION_INFO})
+			},
+		},
+		{
+			Name: "Exchange Device Code of Client Not Approved by Former Collaborator",
+			StoreSetup: func(s *mockStore) {
+				s.res.client = mockRequestedDeviceClient
+				s.res.deviceAuthorization = &ttnpb.OAuthDeviceAuthorization{
+					ClientIDs:  mockRequestedDeviceClient.ClientIdentifiers,
+					UserIDs:    mockUser.UserIdentifiers,
+					Rights:     []ttnpb.Right{ttnpb.RIGHT_APPLICATION_INFO},
+					DeviceCode: "device-code",
+					UserCode:   "BCDF-GHJK",
+					CreatedAt:  time.Now(),
+					ExpiresAt:  time.Now().Add(time.Minute),
+				}
+				s.err.getMember = mockErrNotFound
+			},
+			Method: "POST",
+			Path:   "/oauth/token",
+			Body: url.Values{
+				"grant_type":  []string{oauth.DeviceCodeGrantType},
+				"device_code": []string{"device-code"},
+				"client_id":   []string{"device-client"},
+			},
+			ExpectedCode: http.StatusForbidden,
+			StoreCheck: func(t *testing.T, s *mockStore) {
+				a := assertions.New(t)
+				a.So(s.calls, should.Contain, "GetMember")
+				a.So(s.calls, should.Contain, "FindIndirectMemberships")
+				a.So(s.calls, should.NotContain, "CreateAccessToken")
+			},
+		},
 	} {
 		name := tt.Name
 		if name == "" {
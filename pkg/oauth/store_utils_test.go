@@ -16,6 +16,7 @@ package oauth_test
 
 import (
 	"context"
+	"time"
 
 	"github.com/gogo/protobuf/types"
 	"go.thethings.network/lorawan-stack/pkg/identityserver/store"
@@ -27,49 +28,78 @@ import (
 type mockStoreContents struct {
 	calls []string
 	req   struct {
-		ctx               context.Context
-		fieldMask         *types.FieldMask
-		session           *ttnpb.UserSession
-		sessionID         string
-		userIDs           *ttnpb.UserIdentifiers
-		clientIDs         *ttnpb.ClientIdentifiers
-		authorization     *ttnpb.OAuthClientAuthorization
-		authorizationCode *ttnpb.OAuthAuthorizationCode
-		code              string
-		token             *ttnpb.OAuthAccessToken
-		previousID        string
-		tokenID           string
+		ctx                 context.Context
+		fieldMask           *types.FieldMask
+		session             *ttnpb.UserSession
+		sessionID           string
+		userIDs             *ttnpb.UserIdentifiers
+		clientIDs           *ttnpb.ClientIdentifiers
+		authorization       *ttnpb.OAuthClientAuthorization
+		authorizationCode   *ttnpb.OAuthAuthorizationCode
+		code                string
+		token               *ttnpb.OAuthAccessToken
+		previousID          string
+		tokenID             string
+		memberIDs           *ttnpb.OrganizationOrUserIdentifiers
+		entityIDs           ttnpb.Identifiers
+		deviceAuthorization *ttnpb.OAuthDeviceAuthorization
+		deviceCode          string
+		userCode            string
+		user                *ttnpb.User
+		providerID          string
+		externalID          string
+		rights              *ttnpb.Rights
 	}
 	res struct {
-		session           *ttnpb.UserSession
-		user              *ttnpb.User
-		client            *ttnpb.Client
-		authorization     *ttnpb.OAuthClientAuthorization
-		authorizationCode *ttnpb.OAuthAuthorizationCode
-		accessToken       *ttnpb.OAuthAccessToken
+		session             *ttnpb.UserSession
+		user                *ttnpb.User
+		client              *ttnpb.Client
+		authorization       *ttnpb.OAuthClientAuthorization
+		authorizationCode   *ttnpb.OAuthAuthorizationCode
+		accessToken         *ttnpb.OAuthAccessToken
+		memberRights        *ttnpb.Rights
+		members             map[*ttnpb.OrganizationOrUserIdentifiers]*ttnpb.Rights
+		memberships         []store.IndirectMembership
+		deviceAuthorization *ttnpb.OAuthDeviceAuthorization
+		totpSecret          string
+		totpEnabledAt       *time.Time
+		totpLastUsedStep    int64
+		recoveryCodes       []string
 	}
 	err struct {
-		getUser                 error
-		createSession           error
-		getSession              error
-		deleteSession           error
-		getClient               error
-		getAuthorization        error
-		authorize               error
-		createAuthorizationCode error
-		getAuthorizationCode    error
-		deleteAuthorizationCode error
-		createAccessToken       error
-		getAccessToken          error
-		deleteAccessToken       error
+		getUser                   error
+		createSession             error
+		getSession                error
+		deleteSession             error
+		getClient                 error
+		getAuthorization          error
+		authorize                 error
+		createAuthorizationCode   error
+		getAuthorizationCode      error
+		deleteAuthorizationCode   error
+		createAccessToken         error
+		getAccessToken            error
+		deleteAccessToken         error
+		getMember                 error
+		findMemberships           error
+		getDeviceAuthorization    error
+		pollDeviceAuthorization   error
+		updateDeviceAuthorization error
+		getTOTP                   error
+		useTOTPStep               error
+		countTOTPAttempt          error
+		getExternalUser           error
 	}
 }
 
 type mockStore struct {
 	store.UserStore
 	store.UserSessionStore
+	store.UserTOTPStore
 	store.ClientStore
 	store.OAuthStore
+	store.MembershipStore
+	store.ExternalUserStore
 
 	mockStoreContents
 }
@@ -79,8 +109,11 @@ func (s *mockStore) reset() {
 }
 
 var (
-	mockErrUnauthenticated = grpc.Errorf(codes.Unauthenticated, "Unauthenticated")
-	mockErrNotFound        = grpc.Errorf(codes.NotFound, "NotFound")
+	mockErrUnauthenticated    = grpc.Errorf(codes.Unauthenticated, "Unauthenticated")
+	mockErrNotFound           = grpc.Errorf(codes.NotFound, "NotFound")
+	mockErrAlreadyExists      = grpc.Errorf(codes.AlreadyExists, "AlreadyExists")
+	mockErrTooManyAttempts    = grpc.Errorf(codes.ResourceExhausted, "too_many_attempts")
+	mockErrFailedPrecondition = grpc.Errorf(codes.FailedPrecondition, "FailedPrecondition")
 )
 
 func (s *mockStore) GetUser(ctx context.Context, id *ttnpb.UserIdentifiers, fieldMask *types.FieldMask) (*ttnpb.User, error) {
@@ -89,6 +122,12 @@ func (s *mockStore) GetUser(ctx context.Context, id *ttnpb.UserIdentifiers, fiel
 	return s.res.user, s.err.getUser
 }
 
+func (s *mockStore) CreateUser(ctx context.Context, usr *ttnpb.User) (*ttnpb.User, error) {
+	s.req.ctx, s.req.user = ctx, usr
+	s.calls = append(s.calls, "CreateUser")
+	return usr, nil
+}
+
 func (s *mockStore) CreateSession(ctx context.Context, sess *ttnpb.UserSession) (*ttnpb.UserSession, error) {
 	s.req.ctx, s.req.session = ctx, sess
 	s.calls = append(s.calls, "CreateSession")
@@ -107,6 +146,49 @@ func (s *mockStore) DeleteSession(ctx context.Context, userIDs *ttnpb.UserIdenti
 	return s.err.deleteSession
 }
 
+func (s *mockStore) GetTOTP(ctx context.Context, userIDs *ttnpb.UserIdentifiers) (*store.TOTP, error) {
+	s.req.ctx, s.req.userIDs = ctx, userIDs
+	s.calls = append(s.calls, "GetTOTP")
+	if s.err.getTOTP != nil {
+		return nil, s.err.getTOTP
+	}
+	return &store.TOTP{
+		Secret:       ttnpb.KeyEnvelope{EncryptedKey: []byte(s.res.totpSecret)},
+		EnabledAt:    s.res.totpEnabledAt,
+		LastUsedStep: s.res.totpLastUsedStep,
+	}, nil
+}
+
+func (s *mockStore) UseTOTPStep(ctx context.Context, userIDs *ttnpb.UserIdentifiers, step int64) error {
+	s.req.ctx, s.req.userIDs = ctx, userIDs
+	s.calls = append(s.calls, "UseTOTPStep")
+	return s.err.useTOTPStep
+}
+
+func (s *mockStore) CountTOTPAttempt(ctx context.Context, userIDs *ttnpb.UserIdentifiers, limit int, since, at time.Time) error {
+	s.req.ctx, s.req.userIDs = ctx, userIDs
+	s.calls = append(s.calls, "CountTOTPAttempt")
+	return s.err.countTOTPAttempt
+}
+
+func (s *mockStore) ResetTOTPAttempts(ctx context.Context, userIDs *ttnpb.UserIdentifiers) error {
+	s.req.ctx, s.req.userIDs = ctx, userIDs
+	s.calls = append(s.calls, "ResetTOTPAttempts")
+	return nil
+}
+
+func (s *mockStore) FindRecoveryCodes(ctx context.Context, userIDs *ttnpb.UserIdentifiers) ([]string, error) {
+	s.req.ctx, s.req.userIDs = ctx, userIDs
+	s.calls = append(s.calls, "FindRecoveryCodes")
+	return s.res.recoveryCodes, nil
+}
+
+func (s *mockStore) DeleteRecoveryCode(ctx context.Context, userIDs *ttnpb.UserIdentifiers, code string) error {
+	s.req.ctx, s.req.userIDs, s.req.code = ctx, userIDs, code
+	s.calls = append(s.calls, "DeleteRecoveryCode")
+	return nil
+}
+
 func (s *mockStore) GetClient(ctx context.Context, id *ttnpb.ClientIdentifiers, fieldMask *types.FieldMask) (*ttnpb.Client, error) {
 	s.req.ctx, s.req.clientIDs, s.req.fieldMask = ctx, id, fieldMask
 	s.calls = append(s.calls, "GetClient")
@@ -163,3 +245,87 @@ func (s *mockStore) DeleteAccessToken(ctx context.Context, tokenID string) error
 	s.calls = append(s.calls, "DeleteAccessToken")
 	return s.err.deleteAccessToken
 }
+
+func (s *mockStore) GetMember(ctx context.Context, id *ttnpb.OrganizationOrUserIdentifiers, entityID ttnpb.Identifiers) (*ttnpb.Rights, error) {
+	s.req.ctx, s.req.memberIDs, s.req.entityIDs = ctx, id, entityID
+	s.calls = append(s.calls, "GetMember")
+	return s.res.memberRights, s.err.getMember
+}
+
+func (s *mockStore) SetMember(ctx context.Context, id *ttnpb.OrganizationOrUserIdentifiers, entityID ttnpb.Identifiers, rights *ttnpb.Rights) error {
+	s.req.ctx, s.req.memberIDs, s.req.entityIDs, s.req.rights = ctx, id, entityID, rights
+	s.calls = append(s.calls, "SetMember")
+	return nil
+}
+
+func (s *mockStore) FindMembers(ctx context.Context, entityID ttnpb.Identifiers) (map[*ttnpb.OrganizationOrUserIdentifiers]*ttnpb.Rights, error) {
+	s.req.ctx, s.req.entityIDs = ctx, entityID
+	s.calls = append(s.calls, "FindMembers")
+	return s.res.members, nil
+}
+
+func (s *mockStore) FindIndirectMemberships(ctx context.Context, userIDs *ttnpb.UserIdentifiers, entityID ttnpb.Identifiers) ([]store.IndirectMembership, error) {
+	s.req.ctx, s.req.userIDs, s.req.entityIDs = ctx, userIDs, entityID
+	s.calls = append(s.calls, "FindIndirectMemberships")
+	return s.res.memberships, s.err.findMemberships
+}
+
+func (s *mockStore) CreateDeviceAuthorization(ctx context.Context, authorization *ttnpb.OAuthDeviceAuthorization) error {
+	s.req.ctx, s.req.deviceAuthorization = ctx, authorization
+	s.calls = append(s.calls, "CreateDeviceAuthorization")
+	return nil
+}
+
+func (s *mockStore) GetDeviceAuthorization(ctx context.Context, deviceCode string) (*ttnpb.OAuthDeviceAuthorization, error) {
+	s.req.ctx, s.req.deviceCode = ctx, deviceCode
+	s.calls = append(s.calls, "GetDeviceAuthorization")
+	return s.res.deviceAuthorization, s.err.getDeviceAuthorization
+}
+
+func (s *mockStore) GetDeviceAuthorizationByUserCode(ctx context.Context, userCode string) (*ttnpb.OAuthDeviceAuthorization, error) {
+	s.req.ctx, s.req.userCode = ctx, userCode
+	s.calls = append(s.calls, "GetDeviceAuthorizationByUserCode")
+	return s.res.deviceAuthorization, s.err.getDeviceAuthorization
+}
+
+func (s *mockStore) UpdateDeviceAuthorization(ctx context.Context, authorization *ttnpb.OAuthDeviceAuthorization) error {
+	s.req.ctx, s.req.deviceAuthorization = ctx, authorization
+	s.calls = append(s.calls, "UpdateDeviceAuthorization")
+	return s.err.updateDeviceAuthorization
+}
+
+func (s *mockStore) DeleteDeviceAuthorization(ctx context.Context, deviceCode string) error {
+	s.req.ctx, s.req.deviceCode = ctx, deviceCode
+	s.calls = append(s.calls, "DeleteDeviceAuthorization")
+	return nil
+}
+
+func (s *mockStore) PollDeviceAuthorization(ctx context.Context, deviceCode string, interval, increase time.Duration, at time.Time) (*ttnpb.OAuthDeviceAuthorization, error) {
+	s.req.ctx, s.req.deviceCode = ctx, deviceCode
+	s.calls = append(s.calls, "PollDeviceAuthorization")
+	if s.err.pollDeviceAuthorization != nil {
+		return nil, s.err.pollDeviceAuthorization
+	}
+	return s.res.deviceAuthorization, s.err.getDeviceAuthorization
+}
+
+func (s *mockStore) RedeemDeviceAuthorization(ctx context.Context, deviceCode string) (*ttnpb.OAuthDeviceAuthorization, error) {
+	s.req.ctx, s.req.deviceCode = ctx, deviceCode
+	s.calls = append(s.calls, "RedeemDeviceAuthorization")
+	return s.res.deviceAuthorization, s.err.getDeviceAuthorization
+}
+
+func (s *mockStore) GetExternalUser(ctx context.Context, providerID, externalID string) (*ttnpb.UserIdentifiers, error) {
+	s.req.ctx, s.req.providerID, s.req.externalID = ctx, providerID, externalID
+	s.calls = append(s.calls, "GetExternalUser")
+	if s.err.getExternalUser != nil {
+		return nil, s.err.getExternalUser
+	}
+	return &s.res.user.UserIdentifiers, nil
+}
+
+func (s *mockStore) CreateExternalUser(ctx context.Context, userIDs *ttnpb.UserIdentifiers, providerID, externalID string) error {
+	s.req.ctx, s.req.userIDs, s.req.providerID, s.req.externalID = ctx, userIDs, providerID, externalID
+	s.calls = append(s.calls, "CreateExternalUser")
+	return nil
+}
@@ -19,6 +19,7 @@ import (
 	"encoding/json"
 	"net/http"
 	"runtime/trace"
+	"strings"
 	"time"
 
 	"github.com/gogo/protobuf/types"
@@ -26,6 +27,7 @@ import (
 	"go.thethings.network/lorawan-stack/pkg/auth"
 	"go.thethings.network/lorawan-stack/pkg/errors"
 	"go.thethings.network/lorawan-stack/pkg/events"
+	"go.thethings.network/lorawan-stack/pkg/identityserver/twofactor"
 	"go.thethings.network/lorawan-stack/pkg/jsonpb"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
 	"go.thethings.network/lorawan-stack/pkg/web/cookie"
@@ -149,11 +151,37 @@ func (s *server) CurrentUser(c echo.Context) error {
 type loginRequest struct {
 	UserID   string `json:"user_id" form:"user_id"`
 	Password string `json:"password" form:"password"`
+	// TwoFactorCode is the TOTP code or a recovery code of users that enabled two-factor authentication.
+	TwoFactorCode string `json:"two_factor_code" form:"two_factor_code"`
 }
 
-var errIncorrectPasswordOrUserID = errors.DefineInvalidArgument("no_user_id_password_match", "incorrect password or user ID")
+var (
+	errIncorrectPasswordOrUserID = errors.DefineInvalidArgument("no_user_id_password_match", "incorrect password or user ID")
+	errTwoFactorRequired         = errors.DefineUnauthenticated("two_factor_required", "two-factor authentication code required")
+	errIncorrectTwoFactorCode    = errors.DefineInvalidArgument("incorrect_two_factor_code", "incorrect two-factor authentication code")
+	errPasswordLoginDisabled     = errors.DefinePermissionDenied("password_login_disabled", "password login is disabled, login with provider `{provider_id}`")
+)
+
+// validateTwoFactorCode validates the TOTP code or recovery code of users that
+// enabled two-factor authentication.
+func (s *server) validateTwoFactorCode(ctx context.Context, userIDs *ttnpb.UserIdentifiers, code string) (bool, error) {
+	state, err := s.store.GetTOTP(ctx, userIDs)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return true, nil
+		}
+		return false, err
+	}
+	if state.EnabledAt == nil {
+		return true, nil
+	}
+	if strings.TrimSpace(code) == "" {
+		return false, errTwoFactorRequired
+	}
+	return twofactor.Validate(ctx, s.store, s.keyVault, userIDs, state, code, s.now())
+}
 
-func (s *server) doLogin(ctx context.Context, userID, password string) error {
+func (s *server) doLogin(ctx context.Context, userID, password, twoFactorCode string) error {
 	ids := &ttnpb.UserIdentifiers{UserID: userID}
 	if err := ids.ValidateContext(ctx); err != nil {
 		return err
@@ -161,7 +189,7 @@ func (s *server) doLogin(ctx context.Context, userID, password string) error {
 	user, err := s.store.GetUser(
 		ctx,
 		ids,
-		&types.FieldMask{Paths: []string{"password"}},
+		&types.FieldMask{Paths: []string{"password", "primary_email_address"}},
 	)
 	if err != nil {
 		if errors.IsNotFound(err) {
@@ -176,6 +204,17 @@ func (s *server) doLogin(ctx context.Context, userID, password string) error {
 		events.Publish(evtUserLoginFailed(ctx, user.UserIdentifiers, nil))
 		return errIncorrectPasswordOrUserID
 	}
+	if provider, ok := s.configFromContext(ctx).passwordLoginProvider(user.PrimaryEmailAddress); ok {
+		return errPasswordLoginDisabled.WithAttributes("provider_id", provider.ID)
+	}
+	ok, err = s.validateTwoFactorCode(ctx, ids, twoFactorCode)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		events.Publish(evtUserLoginFailed(ctx, user.UserIdentifiers, nil))
+		return errIncorrectTwoFactorCode
+	}
 	return nil
 }
 
@@ -185,10 +224,50 @@ func (s *server) Login(c echo.Context) error {
 	if err := c.Bind(req); err != nil {
 		return err
 	}
-	if err := s.doLogin(ctx, req.UserID, req.Password); err != nil {
+	var pending federationPending
+	hasPending, err := s.federationPendingCookie().Get(c, &pending)
+	if err != nil {
+		return err
+	}
+	if hasPending && !pending.Link && req.Password == "" {
+		// The user logged in with an upstream provider and completes the login
+		// with the two-factor authentication code.
+		userIDs := ttnpb.UserIdentifiers{UserID: pending.UserID}
+		ok, err := s.validateTwoFactorCode(ctx, &userIDs, req.TwoFactorCode)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			events.Publish(evtUserLoginFailed(ctx, userIDs, nil))
+			return errIncorrectTwoFactorCode
+		}
+		s.federationPendingCookie().Remove(c)
+		if err := s.createSession(c, userIDs); err != nil {
+			return err
+		}
+		return c.NoContent(http.StatusNoContent)
+	}
+	if err := s.doLogin(ctx, req.UserID, req.Password, req.TwoFactorCode); err != nil {
 		return err
 	}
 	userIDs := ttnpb.UserIdentifiers{UserID: req.UserID}
+	if hasPending && pending.Link && pending.UserID == req.UserID {
+		// The user confirmed linking the account in the upstream provider.
+		if err := s.store.CreateExternalUser(ctx, &userIDs, pending.ProviderID, pending.ExternalID); err != nil {
+			return err
+		}
+		events.Publish(evtLinkExternalUser(ctx, userIDs, pending.ProviderID))
+		s.federationPendingCookie().Remove(c)
+	}
+	if err := s.createSession(c, userIDs); err != nil {
+		return err
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+// createSession creates a new session for the user and sets the auth cookie.
+func (s *server) createSession(c echo.Context, userIDs ttnpb.UserIdentifiers) error {
+	ctx := c.Request().Context()
 	session, err := s.store.CreateSession(ctx, &ttnpb.UserSession{
 		UserIdentifiers: userIDs,
 	})
@@ -196,15 +275,11 @@ func (s *server) Login(c echo.Context) error {
 		return err
 	}
 	events.Publish(evtUserLogin(ctx, userIDs, nil))
-	err = s.updateAuthCookie(c, func(cookie *authCookie) error {
+	return s.updateAuthCookie(c, func(cookie *authCookie) error {
 		cookie.UserID = session.UserID
 		cookie.SessionID = session.SessionID
 		return nil
 	})
-	if err != nil {
-		return err
-	}
-	return c.NoContent(http.StatusNoContent)
 }
 
 func (s *server) Logout(c echo.Context) error {
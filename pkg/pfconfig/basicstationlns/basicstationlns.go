@@ -32,7 +32,10 @@ const (
 	configHardwareSpecPrefix = "sx1301"
 )
 
-var errFrequencyPlan = errors.DefineInvalidArgument("frequency_plan", "invalid frequency plan `{name}`")
+var (
+	errFrequencyPlan    = errors.DefineInvalidArgument("frequency_plan", "invalid frequency plan `{name}`")
+	errIncompatibleBand = errors.DefineInvalidArgument("incompatible_band", "data rates of band `{band_id}` are incompatible with band `{primary_band_id}`")
+)
 
 // DataRates encodes the available datarates of the channel plan for the Station in the format below:
 // [0] -> SF (Spreading Factor; Range: 7...12 for LoRa, 0 for FSK)
@@ -72,7 +75,9 @@ func (conf RouterConfig) MarshalJSON() ([]byte, error) {
 }
 
 // GetRouterConfig returns the routerconfig message to be sent to the gateway.
-// Currently as per the basic station docs, all frequency plans have to be from the same region (band) https://doc.sm.tc/station/tcproto.html#router-config-message.
+// As per the basic station docs, the router configuration has a single region and data rate table https://doc.sm.tc/station/tcproto.html#router-config-message.
+// The region is the region of the given band. Frequency plans may be from other bands, as long as the data rates of
+// these bands are compatible with the data rates of the given band. See getDataRatesFromFrequencyPlans.
 func GetRouterConfig(bandID string, fps map[string]*frequencyplans.FrequencyPlan, isProd bool, dlTime time.Time) (RouterConfig, error) {
 	for _, fp := range fps {
 		if err := fp.Validate(); err != nil {
@@ -101,9 +106,9 @@ func GetRouterConfig(bandID string, fps map[string]*frequencyplans.FrequencyPlan
 
 	conf.HardwareSpec = fmt.Sprintf("%s/%d", configHardwareSpecPrefix, len(fps))
 
-	drs, err := getDataRatesFromBandID(bandID)
+	drs, err := getDataRatesFromFrequencyPlans(bandID, fps)
 	if err != nil {
-		return RouterConfig{}, errFrequencyPlan
+		return RouterConfig{}, err
 	}
 	conf.DataRates = drs
 
@@ -160,6 +165,44 @@ func getDataRatesFromBandID(id string) (DataRates, error) {
 	return drs, nil
 }
 
+// getDataRatesFromFrequencyPlans returns the data rates of the given band, completed with the data rates of the bands
+// of the frequency plans. As the Station has a single data rate table, data rates with the same index must be equal in
+// all bands. Data rates that are not defined in the given band are taken from the other bands.
+func getDataRatesFromFrequencyPlans(bandID string, fps map[string]*frequencyplans.FrequencyPlan) (DataRates, error) {
+	primary, err := band.GetByID(bandID)
+	if err != nil {
+		return DataRates{}, errFrequencyPlan.WithCause(err)
+	}
+	drs, err := getDataRatesFromBandID(bandID)
+	if err != nil {
+		return DataRates{}, errFrequencyPlan.WithCause(err)
+	}
+	for _, fp := range fps {
+		if fp.BandID == bandID {
+			continue
+		}
+		phy, err := band.GetByID(fp.BandID)
+		if err != nil {
+			return DataRates{}, errFrequencyPlan.WithCause(err)
+		}
+		bandDRs, err := getDataRatesFromBandID(fp.BandID)
+		if err != nil {
+			return DataRates{}, errFrequencyPlan.WithCause(err)
+		}
+		for i := range phy.DataRates {
+			if _, ok := primary.DataRates[i]; !ok {
+				drs[i] = bandDRs[i]
+			} else if drs[i] != bandDRs[i] {
+				return DataRates{}, errIncompatibleBand.WithAttributes(
+					"band_id", fp.BandID,
+					"primary_band_id", bandID,
+				)
+			}
+		}
+	}
+	return drs, nil
+}
+
 // getMinMaxFrequencies extract the minimum and maximum frequencies between all the bands.
 func getMinMaxFrequencies(fps map[string]*frequencyplans.FrequencyPlan) (uint64, uint64, error) {
 	var min, max uint64
@@ -444,3 +444,84 @@ func TestGetDataRatesFromFrequencyPlan(t *testing.T) {
 		})
 	}
 }
+
+func TestGetDataRatesFromFrequencyPlans(t *testing.T) {
+	for _, tc := range []struct {
+		Name           string
+		BandID         string
+		FrequencyPlans map[string]*frequencyplans.FrequencyPlan
+		DataRates      DataRates
+		ErrorAssertion func(error) bool
+	}{
+		{
+			Name:   "SingleBand",
+			BandID: "EU_863_870",
+			FrequencyPlans: map[string]*frequencyplans.FrequencyPlan{
+				test.EUFrequencyPlanID: {BandID: "EU_863_870"},
+			},
+			DataRates: DataRates{
+				[3]int{12, 125, 0},
+				[3]int{11, 125, 0},
+				[3]int{10, 125, 0},
+				[3]int{9, 125, 0},
+				[3]int{8, 125, 0},
+				[3]int{7, 125, 0},
+				[3]int{7, 250, 0},
+				[3]int{0, 0, 0},
+			},
+		},
+		{
+			Name:   "CompatibleBands",
+			BandID: "KR_920_923",
+			FrequencyPlans: map[string]*frequencyplans.FrequencyPlan{
+				test.KRFrequencyPlanID: {BandID: "KR_920_923"},
+				test.EUFrequencyPlanID: {BandID: "EU_863_870"},
+			},
+			DataRates: DataRates{
+				[3]int{12, 125, 0},
+				[3]int{11, 125, 0},
+				[3]int{10, 125, 0},
+				[3]int{9, 125, 0},
+				[3]int{8, 125, 0},
+				[3]int{7, 125, 0},
+				[3]int{7, 250, 0},
+				[3]int{0, 0, 0},
+			},
+		},
+		{
+			Name:   "IncompatibleBands",
+			BandID: "EU_863_870",
+			FrequencyPlans: map[string]*frequencyplans.FrequencyPlan{
+				test.EUFrequencyPlanID: {BandID: "EU_863_870"},
+				test.USFrequencyPlanID: {BandID: "US_902_928"},
+			},
+			ErrorAssertion: func(err error) bool {
+				return errors.Resemble(err, errIncompatibleBand)
+			},
+		},
+		{
+			Name:   "InvalidBand",
+			BandID: "EU_863_870",
+			FrequencyPlans: map[string]*frequencyplans.FrequencyPlan{
+				test.EUFrequencyPlanID: {BandID: "EU_863_870"},
+				"dummy":                {BandID: "PinkFloyd"},
+			},
+			ErrorAssertion: func(err error) bool {
+				return errors.Resemble(err, errFrequencyPlan)
+			},
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+			drs, err := getDataRatesFromFrequencyPlans(tc.BandID, tc.FrequencyPlans)
+			if tc.ErrorAssertion != nil {
+				a.So(tc.ErrorAssertion(err), should.BeTrue)
+				return
+			}
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			a.So(drs, should.Resemble, tc.DataRates)
+		})
+	}
+}
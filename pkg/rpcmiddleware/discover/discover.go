@@ -19,9 +19,12 @@ import (
 	"context"
 	"fmt"
 	"net"
+	"strconv"
 	"strings"
 
+	"go.thethings.network/lorawan-stack/pkg/dns"
 	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/log"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
 )
@@ -54,9 +57,62 @@ var defaultPorts = map[bool]int{
 	true:  8884,
 }
 
+// SRV record services that are used to discover gRPC endpoints. For example, the TLS endpoint of host example.com
+// is discovered through the SRV records of _ttn-v3-grpcs._tcp.example.com.
+const (
+	GRPCService    = "ttn-v3-grpc"
+	GRPCTLSService = "ttn-v3-grpcs"
+)
+
+var srvServices = map[bool]string{
+	false: GRPCService,
+	true:  GRPCTLSService,
+}
+
+// srvResolver is the resolver used to discover targets through SRV records.
+var srvResolver dns.SRVResolver = dns.DefaultResolver
+
+// srvHost returns the host name of which the SRV records should be looked up for the given target.
+// Only host names without port are discovered through SRV records.
+func srvHost(target string) (string, bool) {
+	if target == "" || strings.IndexByte(target, ':') >= 0 || net.ParseIP(target) != nil {
+		return "", false
+	}
+	return target, true
+}
+
+// lookupSRV looks up the SRV records of the given target and returns whether any are found.
+func lookupSRV(ctx context.Context, target string, tls bool) ([]*net.SRV, bool) {
+	host, ok := srvHost(target)
+	if !ok {
+		return nil, false
+	}
+	srvs, err := srvResolver.LookupSRV(ctx, srvServices[tls], "tcp", host)
+	if err != nil {
+		if !dns.IsNotFound(err) {
+			log.FromContext(ctx).WithError(err).WithField("target", target).Debug("Failed to look up SRV records")
+		}
+		return nil, false
+	}
+	return srvs, true
+}
+
+// dialSRV dials the targets of the given SRV records in order, and returns the first successful connection.
+func dialSRV(ctx context.Context, srvs []*net.SRV) (conn net.Conn, err error) {
+	for _, srv := range srvs {
+		conn, err = new(net.Dialer).DialContext(ctx, "tcp", net.JoinHostPort(srv.Target, strconv.Itoa(int(srv.Port))))
+		if err == nil {
+			return conn, nil
+		}
+	}
+	return nil, err
+}
+
 func resolver(tls bool) func(ctx context.Context, target string) (net.Conn, error) {
 	return func(ctx context.Context, target string) (net.Conn, error) {
-		// TODO: If no port is specified, discover through SRV records (https://github.com/TheThingsNetwork/lorawan-stack/issues/138)
+		if srvs, ok := lookupSRV(ctx, target, tls); ok {
+			return dialSRV(ctx, srvs)
+		}
 		target, err := defaultPort(target, defaultPorts[tls])
 		if err != nil {
 			return nil, err
@@ -66,7 +122,8 @@ func resolver(tls bool) func(ctx context.Context, target string) (net.Conn, erro
 }
 
 // WithTransportCredentials returns gRPC dial options which configures connection level security credentials (e.g.,
-// TLS/SSL) and discover the TLS/SSL listen port if not specified in the dial target.
+// TLS/SSL) and discover the TLS/SSL listen address through SRV records or the default port if no port is specified in
+// the dial target.
 func WithTransportCredentials(creds credentials.TransportCredentials) []grpc.DialOption {
 	return []grpc.DialOption{
 		grpc.WithTransportCredentials(creds),
@@ -74,8 +131,8 @@ func WithTransportCredentials(creds credentials.TransportCredentials) []grpc.Dia
 	}
 }
 
-// WithInsecure returns gRPC dial options which disable transport security and discover the default insecure listen
-// port if not specified in the dial target.
+// WithInsecure returns gRPC dial options which disable transport security and discover the insecure listen address
+// through SRV records or the default port if no port is specified in the dial target.
 func WithInsecure() []grpc.DialOption {
 	return []grpc.DialOption{
 		grpc.WithInsecure(),
@@ -95,8 +152,15 @@ func WithTLSFallback(parent context.Context, tls bool) context.Context {
 
 // DialOptions discovers gRPC dial options based on the given target. This includes whether or not transport level
 // security is enabled and service port discovery.
+// If the target is a host name without port, the SRV records of GRPCTLSService and GRPCService are looked up to
+// discover whether transport level security is enabled. The SRV records are cached by the resolver.
 func DialOptions(ctx context.Context, target string, creds credentials.TransportCredentials) ([]grpc.DialOption, error) {
-	// TODO: Discover through SRV records and cache result (https://github.com/TheThingsNetwork/lorawan-stack/issues/138)
+	if _, ok := lookupSRV(ctx, target, true); ok {
+		return WithTransportCredentials(creds), nil
+	}
+	if _, ok := lookupSRV(ctx, target, false); ok {
+		return WithInsecure(), nil
+	}
 	if val, ok := ctx.Value(tlsFallbackKey).(bool); ok && !val {
 		return WithInsecure(), nil
 	}
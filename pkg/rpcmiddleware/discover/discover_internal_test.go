@@ -15,9 +15,15 @@
 package discover
 
 import (
+	"context"
+	"fmt"
+	"net"
 	"testing"
 
 	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/dns"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
 	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
 )
 
@@ -46,3 +52,78 @@ func TestDefaultPort(t *testing.T) {
 		})
 	}
 }
+
+type mockSRVResolver map[string][]*net.SRV
+
+var errMockNotFound = errors.DefineNotFound("mock_not_found", "not found")
+
+func (r mockSRVResolver) LookupSRV(ctx context.Context, service, proto, name string) ([]*net.SRV, error) {
+	srvs, ok := r[fmt.Sprintf("_%s._%s.%s", service, proto, name)]
+	if !ok {
+		return nil, errMockNotFound
+	}
+	return srvs, nil
+}
+
+func TestSRVHost(t *testing.T) {
+	for input, expected := range map[string]string{
+		"localhost":      "localhost",
+		"example.com":    "example.com",
+		"localhost:80":   "",
+		"192.168.1.1":    "",
+		"192.168.1.1:80": "",
+		"::1":            "",
+		"[::1]:80":       "",
+		"":               "",
+	} {
+		t.Run(input, func(t *testing.T) {
+			host, ok := srvHost(input)
+			a := assertions.New(t)
+			a.So(host, should.Equal, expected)
+			a.So(ok, should.Equal, expected != "")
+		})
+	}
+}
+
+func TestSRVDiscovery(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	defer lis.Close()
+	go func() {
+		for {
+			conn, err := lis.Accept()
+			if err != nil {
+				return
+			}
+			conn.Close()
+		}
+	}()
+	port := uint16(lis.Addr().(*net.TCPAddr).Port)
+
+	defer func(r dns.SRVResolver) { srvResolver = r }(srvResolver)
+	srvResolver = mockSRVResolver{
+		"_ttn-v3-grpcs._tcp.tls.example.com": {
+			{Target: "127.0.0.1", Port: 1, Priority: 10},
+			{Target: "127.0.0.1", Port: port, Priority: 20},
+		},
+		"_ttn-v3-grpc._tcp.insecure.example.com": {
+			{Target: "127.0.0.1", Port: port, Priority: 10},
+		},
+	}
+
+	conn, err := resolver(true)(ctx, "tls.example.com")
+	if a.So(err, should.BeNil) {
+		a.So(conn.RemoteAddr().String(), should.Equal, lis.Addr().String())
+		conn.Close()
+	}
+	conn, err = resolver(false)(ctx, "insecure.example.com")
+	if a.So(err, should.BeNil) {
+		a.So(conn.RemoteAddr().String(), should.Equal, lis.Addr().String())
+		conn.Close()
+	}
+}
@@ -110,6 +110,12 @@ func validateMessage(ctx context.Context, fullMethod string, msg interface{}) er
 	}
 }
 
+// ValidateMessage validates the message as if it were the request of a call to the RPC with the given full method name.
+// This is used by batch RPCs to validate the requests that are handled as separate calls to the single RPC.
+func ValidateMessage(ctx context.Context, fullMethod string, msg interface{}) error {
+	return validateMessage(ctx, fullMethod, msg)
+}
+
 // UnaryServerInterceptor returns a new unary server interceptor that validates
 // incoming messages if those incoming messages implement:
 //   (A) ValidateContext(ctx context.Context) error
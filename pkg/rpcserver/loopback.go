@@ -17,11 +17,14 @@ package rpcserver
 import (
 	"context"
 	"net"
+	"strings"
 	"time"
 
 	"go.thethings.network/lorawan-stack/pkg/version"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
 )
 
 const inProcess = "in-process"
@@ -50,6 +53,41 @@ func (c inProcessCredentials) Info() credentials.ProtocolInfo {
 	}
 }
 
+// IsInProcess returns whether the request in the context came in over an in-process
+// connection, such as the connection of the HTTP gateway.
+func IsInProcess(ctx context.Context) bool {
+	p, ok := peer.FromContext(ctx)
+	return ok && p.AuthInfo != nil && p.AuthInfo.AuthType() == inProcess
+}
+
+// SourceAddress returns the IP address of the client of the original request.
+// Requests through the HTTP gateway come in over an in-process connection, so
+// for those, the address that the HTTP gateway adds to the x-forwarded-for
+// header is used. For other requests, the address of the gRPC peer is used.
+// Requests that are forwarded by other components of the cluster are not
+// attributed to the original client, so for those, the address of the
+// forwarding component is returned.
+// SourceAddress returns nil if the address is unknown.
+func SourceAddress(ctx context.Context) net.IP {
+	if IsInProcess(ctx) {
+		md, _ := metadata.FromIncomingContext(ctx)
+		if forwarded := md.Get("x-forwarded-for"); len(forwarded) > 0 {
+			addrs := strings.Split(forwarded[len(forwarded)-1], ",")
+			return net.ParseIP(strings.TrimSpace(addrs[len(addrs)-1]))
+		}
+		return nil
+	}
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return nil
+	}
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		return nil
+	}
+	return net.ParseIP(host)
+}
+
 func (c *inProcessCredentials) Clone() credentials.TransportCredentials { return c }
 
 func (c *inProcessCredentials) OverrideServerName(serverName string) error {
@@ -17,10 +17,14 @@ package javascript
 
 import (
 	"context"
+	"regexp"
 	"runtime/trace"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/robertkrimen/otto"
+	"github.com/robertkrimen/otto/parser"
 	"go.thethings.network/lorawan-stack/pkg/errors"
 	"go.thethings.network/lorawan-stack/pkg/scripting"
 )
@@ -34,7 +38,46 @@ func New(options scripting.Options) scripting.Engine {
 	return &js{options}
 }
 
-var errRuntime = errors.Define("runtime", "runtime error")
+var (
+	errRuntime = errors.Define("runtime", "runtime error")
+	errSyntax  = errors.DefineInvalidArgument("syntax", "syntax error on line `{line}` column `{column}`: {message}")
+	errScript  = errors.Define("script", "error on line `{line}` column `{column}`: {message}")
+)
+
+// framePosition matches the position of a stack frame of a runtime error, i.e. `at <anonymous>:3:9`.
+var framePosition = regexp.MustCompile(`^\s*at .*:(\d+):(\d+)\)?$`)
+
+// convertError converts the error returned by the virtual machine to an error with the line and column in the
+// script, if available.
+func convertError(err error) error {
+	switch err := err.(type) {
+	case parser.ErrorList:
+		if len(err) > 0 {
+			return convertError(err[0])
+		}
+	case *parser.Error:
+		return errSyntax.WithAttributes(
+			"line", err.Position.Line,
+			"column", err.Position.Column,
+			"message", err.Message,
+		)
+	case *otto.Error:
+		for _, frame := range strings.Split(err.String(), "\n")[1:] {
+			match := framePosition.FindStringSubmatch(frame)
+			if match == nil {
+				continue
+			}
+			line, _ := strconv.Atoi(match[1])
+			column, _ := strconv.Atoi(match[2])
+			return errScript.WithAttributes(
+				"line", line,
+				"column", column,
+				"message", err.Error(),
+			)
+		}
+	}
+	return errRuntime.WithCause(err)
+}
 
 // Run executes the Javascript script in the environment env and returns the output.
 func (j *js) Run(ctx context.Context, script string, env map[string]interface{}) (val interface{}, err error) {
@@ -82,7 +125,7 @@ func (j *js) Run(ctx context.Context, script string, env map[string]interface{})
 
 	output, err := vm.Run(script)
 	if err != nil {
-		return nil, errRuntime.WithCause(err)
+		return nil, convertError(err)
 	}
 
 	return output.Export()
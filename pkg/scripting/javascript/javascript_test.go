@@ -96,3 +96,45 @@ func TestRunTimeout(t *testing.T) {
 	a.So(err, should.NotBeNil)
 	a.So(errors.IsDeadlineExceeded(errors.Cause(err)), should.BeTrue)
 }
+
+func TestRunErrorLocation(t *testing.T) {
+	for _, tc := range []struct {
+		Name   string
+		Script string
+		Line   int
+		Column int
+	}{
+		{
+			Name: "Syntax",
+			Script: `(function () {
+	return {
+		x: 42
+	}
+)()`,
+			Line:   5,
+			Column: 1,
+		},
+		{
+			Name: "Runtime",
+			Script: `(function () {
+	var obj = null;
+	return obj.foo;
+})()`,
+			Line:   3,
+			Column: 9,
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+
+			ctx := test.Context()
+
+			e := New(scripting.DefaultOptions)
+			_, err := e.Run(ctx, tc.Script, nil)
+			a.So(err, should.NotBeNil)
+			attributes := errors.Attributes(err)
+			a.So(attributes["line"], should.Equal, tc.Line)
+			a.So(attributes["column"], should.Equal, tc.Column)
+		})
+	}
+}
@@ -222,7 +222,9 @@ type ListApplicationsRequest struct {
 	// Limit the number of results per page.
 	Limit uint32 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
 	// Page number for pagination. 0 is interpreted as 1.
-	Page                 uint32   `protobuf:"varint,5,opt,name=page,proto3" json:"page,omitempty"`
+	Page uint32 `protobuf:"varint,5,opt,name=page,proto3" json:"page,omitempty"`
+	// Only return recently deleted applications. This is only allowed for admins.
+	Deleted              bool     `protobuf:"varint,6,opt,name=deleted,proto3" json:"deleted,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
@@ -294,6 +296,13 @@ func (m *ListApplicationsRequest) GetPage() uint32 {
 	return 0
 }
 
+func (m *ListApplicationsRequest) GetDeleted() bool {
+	if m != nil {
+		return m.Deleted
+	}
+	return false
+}
+
 type CreateApplicationRequest struct {
 	Application `protobuf:"bytes,1,opt,name=application,proto3,embedded=application" json:"application"`
 	// Collaborator to grant all rights on the newly created application.
@@ -492,10 +501,13 @@ func (m *GetApplicationAPIKeyRequest) GetKeyID() string {
 
 type CreateApplicationAPIKeyRequest struct {
 	ApplicationIdentifiers `protobuf:"bytes,1,opt,name=application_ids,json=applicationIds,proto3,embedded=application_ids" json:"application_ids"`
-	Name                   string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Rights                 []Right  `protobuf:"varint,3,rep,packed,name=rights,proto3,enum=ttn.lorawan.v3.Right" json:"rights,omitempty"`
-	XXX_NoUnkeyedLiteral   struct{} `json:"-"`
-	XXX_sizecache          int32    `json:"-"`
+	Name                   string  `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Rights                 []Right `protobuf:"varint,3,rep,packed,name=rights,proto3,enum=ttn.lorawan.v3.Right" json:"rights,omitempty"`
+	// Time after which the API key is no longer valid. If not set, the API key does not expire.
+	ExpiresAt            *time.Time          `protobuf:"bytes,4,opt,name=expires_at,json=expiresAt,proto3,stdtime" json:"expires_at,omitempty"`
+	Restrictions         *APIKeyRestrictions `protobuf:"bytes,5,opt,name=restrictions,proto3" json:"restrictions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
 }
 
 func (m *CreateApplicationAPIKeyRequest) Reset()      { *m = CreateApplicationAPIKeyRequest{} }
@@ -544,11 +556,28 @@ func (m *CreateApplicationAPIKeyRequest) GetRights() []Right {
 	return nil
 }
 
+func (m *CreateApplicationAPIKeyRequest) GetExpiresAt() *time.Time {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return nil
+}
+
+func (m *CreateApplicationAPIKeyRequest) GetRestrictions() *APIKeyRestrictions {
+	if m != nil {
+		return m.Restrictions
+	}
+	return nil
+}
+
 type UpdateApplicationAPIKeyRequest struct {
 	ApplicationIdentifiers `protobuf:"bytes,1,opt,name=application_ids,json=applicationIds,proto3,embedded=application_ids" json:"application_ids"`
 	APIKey                 `protobuf:"bytes,2,opt,name=api_key,json=apiKey,proto3,embedded=api_key" json:"api_key"`
-	XXX_NoUnkeyedLiteral   struct{} `json:"-"`
-	XXX_sizecache          int32    `json:"-"`
+	// The names of the API key fields that should be updated.
+	// If empty, only the name and rights are updated.
+	FieldMask            types.FieldMask `protobuf:"bytes,3,opt,name=field_mask,json=fieldMask,proto3" json:"field_mask"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
 
 func (m *UpdateApplicationAPIKeyRequest) Reset()      { *m = UpdateApplicationAPIKeyRequest{} }
@@ -583,6 +612,13 @@ func (m *UpdateApplicationAPIKeyRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_UpdateApplicationAPIKeyRequest proto.InternalMessageInfo
 
+func (m *UpdateApplicationAPIKeyRequest) GetFieldMask() types.FieldMask {
+	if m != nil {
+		return m.FieldMask
+	}
+	return types.FieldMask{}
+}
+
 type ListApplicationCollaboratorsRequest struct {
 	ApplicationIdentifiers `protobuf:"bytes,1,opt,name=application_ids,json=applicationIds,proto3,embedded=application_ids" json:"application_ids"`
 	// Limit the number of results per page.
@@ -763,78 +799,81 @@ func init() {
 }
 
 var fileDescriptor_57d90136b1f4f7b1 = []byte{
-	// 1121 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0x4d, 0x6c, 0x1b, 0x45,
-	0x14, 0xde, 0xf1, 0x4f, 0x52, 0x8f, 0xf3, 0xa7, 0x15, 0x85, 0x55, 0x52, 0x26, 0xee, 0x36, 0xaa,
-	0xd2, 0x92, 0x5d, 0x23, 0xf7, 0x02, 0x15, 0x10, 0x79, 0xc3, 0x8f, 0x4c, 0xa0, 0x81, 0x85, 0x5e,
-	0xa8, 0x8a, 0x35, 0xf6, 0x8e, 0x37, 0x23, 0xdb, 0xbb, 0xcb, 0xee, 0x38, 0xc5, 0x45, 0x48, 0x15,
-	0xa7, 0x8a, 0x53, 0xc5, 0x09, 0x71, 0x42, 0x3d, 0xa0, 0x1e, 0x38, 0xf4, 0x84, 0x2a, 0xc1, 0xa1,
-	0x27, 0xc8, 0x81, 0x43, 0x4e, 0xa8, 0xa7, 0x50, 0xaf, 0x2f, 0x91, 0xb8, 0xf4, 0x58, 0xf9, 0x84,
-	0x76, 0x76, 0x9d, 0xac, 0x7f, 0x88, 0x44, 0x5b, 0x59, 0x3d, 0x79, 0x7e, 0xbe, 0xf7, 0xbd, 0xef,
-	0xcd, 0x7b, 0x6f, 0x66, 0x0d, 0xcf, 0x34, 0x6c, 0x17, 0x5f, 0xc3, 0x96, 0xe2, 0x31, 0x5c, 0xad,
-	0xe7, 0xb1, 0x43, 0xf3, 0xd8, 0x71, 0x1a, 0xb4, 0x8a, 0x19, 0xb5, 0x2d, 0xd5, 0x71, 0x6d, 0x66,
-	0x8b, 0x73, 0x8c, 0x59, 0x6a, 0x04, 0x54, 0x77, 0x2e, 0x2c, 0x16, 0x4d, 0xca, 0xb6, 0x5b, 0x15,
-	0xb5, 0x6a, 0x37, 0xf3, 0xc4, 0xda, 0xb1, 0xdb, 0x8e, 0x6b, 0x7f, 0xd9, 0xce, 0x73, 0x70, 0x55,
-	0x31, 0x89, 0xa5, 0xec, 0xe0, 0x06, 0x35, 0x30, 0x23, 0xf9, 0x91, 0x41, 0x48, 0xb9, 0xa8, 0xc4,
-	0x28, 0x4c, 0xdb, 0xb4, 0x43, 0xe3, 0x4a, 0xab, 0xc6, 0x67, 0x7c, 0xc2, 0x47, 0x11, 0xfc, 0x94,
-	0x69, 0xdb, 0x66, 0x83, 0x84, 0xfa, 0x2c, 0xcb, 0x66, 0x5c, 0x9e, 0x17, 0xed, 0xe6, 0xa2, 0xdd,
-	0x43, 0x8e, 0x1a, 0x25, 0x0d, 0xa3, 0xdc, 0xc4, 0x5e, 0x3d, 0x42, 0x2c, 0x0f, 0x23, 0x18, 0x6d,
-	0x12, 0x8f, 0xe1, 0xa6, 0x13, 0x01, 0x56, 0x46, 0xcf, 0xa1, 0x6a, 0x5b, 0x0c, 0x57, 0x59, 0x99,
-	0x5a, 0xb5, 0xbe, 0x8c, 0x31, 0xa7, 0x45, 0x0d, 0x62, 0x31, 0x5a, 0xa3, 0xc4, 0xed, 0xab, 0x41,
-	0xa3, 0x20, 0x97, 0x9a, 0xdb, 0x2c, 0xda, 0x97, 0x7f, 0x4a, 0xc1, 0x6c, 0xf1, 0xe8, 0x8c, 0xc5,
-	0xf7, 0x61, 0x92, 0x1a, 0x9e, 0x04, 0x72, 0x60, 0x35, 0x5b, 0x38, 0xab, 0x0e, 0x9e, 0xb5, 0x1a,
-	0x43, 0x96, 0x8e, 0x5c, 0x69, 0x0b, 0x3d, 0x2d, 0xfd, 0x2d, 0x48, 0x2c, 0x80, 0xdd, 0xfd, 0x65,
-	0x61, 0x6f, 0x7f, 0x19, 0xe8, 0x01, 0x89, 0xb8, 0x01, 0x61, 0xd5, 0x25, 0x98, 0x11, 0xa3, 0x8c,
-	0x99, 0x94, 0xe0, 0x94, 0x8b, 0x6a, 0x18, 0xbc, 0xda, 0x0f, 0x5e, 0xfd, 0xb4, 0x1f, 0xbc, 0x76,
-	0x22, 0x30, 0xbf, 0xf5, 0xf7, 0x32, 0xd0, 0x33, 0x91, 0x5d, 0x91, 0x05, 0x24, 0x2d, 0xc7, 0xe8,
-	0x93, 0x24, 0xff, 0x0f, 0x49, 0x64, 0x57, 0x64, 0xe2, 0x12, 0x4c, 0x59, 0xb8, 0x49, 0xa4, 0x54,
-	0x0e, 0xac, 0x66, 0xb4, 0xe9, 0x9e, 0x96, 0x72, 0x13, 0x52, 0x41, 0xe7, 0x8b, 0xe2, 0x79, 0x98,
-	0x35, 0x88, 0x57, 0x75, 0xa9, 0x13, 0xc4, 0x25, 0xa5, 0x39, 0xe6, 0x44, 0x4f, 0x4b, 0xbb, 0x49,
-	0x69, 0x6f, 0x5e, 0x8f, 0x6f, 0x8a, 0x6d, 0x08, 0x31, 0x63, 0x2e, 0xad, 0xb4, 0x18, 0xf1, 0xa4,
-	0xa9, 0x5c, 0x72, 0x35, 0x5b, 0x78, 0xe5, 0x98, 0x53, 0x52, 0x8b, 0x87, 0xe8, 0x77, 0x2c, 0xe6,
-	0xb6, 0xb5, 0xb5, 0x9e, 0x76, 0xee, 0x07, 0x70, 0x56, 0x5e, 0x71, 0x65, 0x69, 0xa5, 0x80, 0x3e,
-	0xbf, 0x82, 0x95, 0xeb, 0xaf, 0x2a, 0xaf, 0x5f, 0x5d, 0x5d, 0xbf, 0x78, 0x45, 0xb9, 0xba, 0xde,
-	0x9f, 0x9e, 0xfb, 0xaa, 0xb0, 0xf6, 0xf5, 0x8a, 0x1e, 0x73, 0x26, 0xbe, 0x05, 0x67, 0xe2, 0x45,
-	0x20, 0x4d, 0x73, 0xe7, 0x4b, 0xc3, 0xce, 0x37, 0x42, 0x4c, 0xc9, 0xaa, 0xd9, 0x7a, 0xb6, 0x7a,
-	0x34, 0x59, 0x7c, 0x13, 0xce, 0x0f, 0x89, 0x11, 0x17, 0x60, 0xb2, 0x4e, 0xda, 0x3c, 0xd9, 0x19,
-	0x3d, 0x18, 0x8a, 0x2f, 0xc0, 0xf4, 0x0e, 0x6e, 0xb4, 0x08, 0xcf, 0x56, 0x46, 0x0f, 0x27, 0x17,
-	0x13, 0xaf, 0x01, 0x79, 0x0b, 0xce, 0xc4, 0xe2, 0xf2, 0xc4, 0x75, 0x38, 0x13, 0xeb, 0xcd, 0xa0,
-	0x62, 0xc6, 0xca, 0x89, 0xd9, 0xe8, 0x03, 0x06, 0xf2, 0xaf, 0x00, 0x9e, 0x7c, 0x8f, 0xb0, 0x38,
-	0x80, 0x7c, 0xd1, 0x22, 0x1e, 0x13, 0x31, 0x9c, 0x8f, 0x21, 0xcb, 0xcf, 0xa2, 0x1e, 0xe7, 0x70,
-	0x1c, 0x19, 0xa8, 0x87, 0x47, 0x6d, 0xf9, 0x9f, 0xa5, 0xf9, 0x6e, 0x00, 0xf9, 0x10, 0x7b, 0x75,
-	0x2d, 0x15, 0x30, 0xe9, 0x99, 0x5a, 0x7f, 0x41, 0xfe, 0x23, 0x01, 0x5f, 0xfa, 0x80, 0x7a, 0x71,
-	0xf9, 0x5e, 0x5f, 0xff, 0xc7, 0x41, 0xa6, 0x1a, 0x0d, 0x5c, 0xb1, 0x5d, 0xcc, 0x6c, 0x37, 0x12,
-	0xaf, 0x0c, 0x8b, 0xdf, 0x72, 0x4d, 0x6c, 0xd1, 0xeb, 0xdc, 0x76, 0xcb, 0xbd, 0xec, 0x11, 0x37,
-	0x16, 0x83, 0x3e, 0x40, 0xf1, 0xd4, 0x7a, 0x45, 0x03, 0xa6, 0x6d, 0xd7, 0x20, 0x2e, 0xef, 0xa0,
-	0x8c, 0x76, 0xa9, 0xa7, 0x6d, 0xba, 0x25, 0x5d, 0x18, 0x38, 0x98, 0x32, 0x35, 0xf4, 0x79, 0x65,
-	0x68, 0x81, 0xf7, 0x88, 0x9e, 0x56, 0xf8, 0x4f, 0xac, 0x9f, 0xf5, 0xac, 0x12, 0x9b, 0x84, 0xe4,
-	0x22, 0x82, 0xe9, 0x06, 0x6d, 0x52, 0xc6, 0x1b, 0x6d, 0x96, 0x37, 0xd1, 0xf9, 0xa4, 0x74, 0x30,
-	0xad, 0x87, 0xcb, 0xa2, 0x08, 0x53, 0x0e, 0x36, 0x09, 0xef, 0xb1, 0x59, 0x9d, 0x8f, 0xe5, 0x3f,
-	0x01, 0x94, 0x36, 0x38, 0xd3, 0x98, 0x52, 0xd8, 0x82, 0xd9, 0x98, 0x9e, 0xe8, 0x24, 0x8f, 0x2b,
-	0xb2, 0x31, 0xb9, 0x8f, 0x33, 0x88, 0xe5, 0xa1, 0xdc, 0x24, 0x9e, 0x20, 0x37, 0xda, 0x4c, 0xdc,
-	0xc7, 0x60, 0xa6, 0xe4, 0x9f, 0x01, 0x94, 0x2e, 0xf3, 0x8b, 0x67, 0x12, 0xe1, 0x3c, 0x75, 0x1d,
-	0xff, 0x02, 0xe0, 0xcb, 0x43, 0x75, 0x5c, 0xfc, 0xa8, 0xb4, 0x49, 0xda, 0xde, 0x04, 0xbb, 0xf1,
-	0xb0, 0x6c, 0x12, 0xc7, 0x97, 0x4d, 0x32, 0x56, 0x36, 0xb7, 0x01, 0x5c, 0x1a, 0xbc, 0x3e, 0x42,
-	0xdd, 0x13, 0x94, 0x9d, 0x83, 0x53, 0x75, 0xd2, 0x2e, 0x53, 0x23, 0xbc, 0x2d, 0xb5, 0x8c, 0xbf,
-	0xbf, 0x9c, 0xde, 0x24, 0xed, 0xd2, 0xdb, 0x7a, 0xba, 0x4e, 0xda, 0x25, 0x43, 0xde, 0x07, 0x10,
-	0x8d, 0xd4, 0xf6, 0xc4, 0x75, 0xf6, 0x5f, 0xbf, 0xc4, 0xb8, 0xd7, 0xef, 0x0d, 0x38, 0x15, 0x7e,
-	0x10, 0x48, 0xc9, 0x5c, 0x72, 0x75, 0xae, 0x70, 0x72, 0xd8, 0xad, 0x1e, 0xec, 0x6a, 0xb3, 0x3d,
-	0x0d, 0x7e, 0x07, 0xa6, 0xe5, 0xf4, 0x37, 0x81, 0x2b, 0x3d, 0xb2, 0x91, 0x7f, 0x07, 0x10, 0x8d,
-	0x54, 0xfb, 0xc4, 0x03, 0x2c, 0xc2, 0x69, 0xec, 0xd0, 0x72, 0xf0, 0x96, 0x85, 0x2d, 0xf0, 0xe2,
-	0x08, 0x35, 0x97, 0x34, 0x86, 0x6a, 0x0a, 0x3b, 0x74, 0x93, 0xb4, 0xe5, 0xdf, 0x00, 0x3c, 0x33,
-	0xd4, 0x07, 0x1b, 0xb1, 0xb6, 0x7e, 0xde, 0xbb, 0xe1, 0x1f, 0x00, 0x4f, 0x0f, 0x76, 0x43, 0x5c,
-	0xfd, 0x04, 0xc5, 0x57, 0x9f, 0xc5, 0xfd, 0x3a, 0xea, 0x66, 0xf0, 0x8e, 0xfd, 0x0b, 0xc0, 0xd3,
-	0x9f, 0x3c, 0x0f, 0xd1, 0x5e, 0x1a, 0x1b, 0xed, 0xa9, 0xd1, 0x6f, 0xb2, 0x23, 0xcc, 0x71, 0x8f,
-	0x87, 0x76, 0x1b, 0xec, 0x76, 0x10, 0xd8, 0xeb, 0x20, 0xf0, 0xa0, 0x83, 0x84, 0x87, 0x1d, 0x24,
-	0x1c, 0x74, 0x90, 0xf0, 0xa8, 0x83, 0x84, 0xc7, 0x1d, 0x04, 0x6e, 0xf8, 0x08, 0xdc, 0xf4, 0x91,
-	0x70, 0xc7, 0x47, 0xe0, 0xae, 0x8f, 0x84, 0x7b, 0x3e, 0x12, 0xee, 0xfb, 0x48, 0xd8, 0xf5, 0x11,
-	0xd8, 0xf3, 0x11, 0x78, 0xe0, 0x23, 0xe1, 0xa1, 0x8f, 0xc0, 0x81, 0x8f, 0x84, 0x47, 0x3e, 0x02,
-	0x8f, 0x7d, 0x24, 0xdc, 0xe8, 0x22, 0xe1, 0x66, 0x17, 0x81, 0x5b, 0x5d, 0x24, 0x7c, 0xdf, 0x45,
-	0xe0, 0xc7, 0x2e, 0x12, 0xee, 0x74, 0x91, 0x70, 0xb7, 0x8b, 0xc0, 0xbd, 0x2e, 0x02, 0xf7, 0xbb,
-	0x08, 0x7c, 0xb6, 0x66, 0xda, 0x2a, 0xdb, 0x26, 0x6c, 0x9b, 0x5a, 0xa6, 0xa7, 0x5a, 0x84, 0x5d,
-	0xb3, 0xdd, 0x7a, 0x7e, 0xf0, 0x9f, 0x83, 0x53, 0x37, 0xf3, 0x8c, 0x59, 0x4e, 0xa5, 0x32, 0xc5,
-	0xdf, 0x95, 0x0b, 0xff, 0x06, 0x00, 0x00, 0xff, 0xff, 0x44, 0x1f, 0x5a, 0x5a, 0xae, 0x0d, 0x00,
-	0x00,
+	// 1182 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0x3d, 0x8c, 0x1b, 0x45,
+	0x14, 0xde, 0xf1, 0x6f, 0x3c, 0xbe, 0xe4, 0xa2, 0x15, 0x81, 0xd5, 0x25, 0xcc, 0x39, 0x9b, 0x53,
+	0xe4, 0x84, 0x78, 0x8d, 0x9c, 0x06, 0x22, 0x20, 0xf2, 0x1e, 0x24, 0x32, 0x07, 0x39, 0x58, 0x48,
+	0x43, 0x14, 0xac, 0xb1, 0x77, 0xbc, 0x37, 0xb2, 0xbd, 0xbb, 0xcc, 0x8e, 0x2f, 0x71, 0x10, 0x52,
+	0x44, 0x43, 0x44, 0x15, 0x51, 0x21, 0x2a, 0x94, 0x02, 0xa5, 0xa0, 0x48, 0x85, 0x22, 0x41, 0x91,
+	0xf2, 0x0a, 0x8a, 0xab, 0x50, 0xaa, 0x23, 0x5e, 0x17, 0x9c, 0x44, 0x93, 0x32, 0x72, 0x85, 0xf6,
+	0xc7, 0xe7, 0xf5, 0x4f, 0x4e, 0x0a, 0x89, 0xac, 0x54, 0xb7, 0x33, 0xf3, 0xbd, 0xf7, 0xbe, 0xf7,
+	0xe6, 0x7d, 0xf3, 0x7c, 0xf0, 0x44, 0xcb, 0x62, 0xf8, 0x1a, 0x36, 0x0b, 0x0e, 0xc7, 0xf5, 0x66,
+	0x11, 0xdb, 0xb4, 0x88, 0x6d, 0xbb, 0x45, 0xeb, 0x98, 0x53, 0xcb, 0x54, 0x6c, 0x66, 0x71, 0x4b,
+	0x3c, 0xc4, 0xb9, 0xa9, 0x84, 0x40, 0x65, 0xf3, 0xec, 0x52, 0xd9, 0xa0, 0x7c, 0xa3, 0x53, 0x53,
+	0xea, 0x56, 0xbb, 0x48, 0xcc, 0x4d, 0xab, 0x6b, 0x33, 0xeb, 0x7a, 0xb7, 0xe8, 0x83, 0xeb, 0x05,
+	0x83, 0x98, 0x85, 0x4d, 0xdc, 0xa2, 0x3a, 0xe6, 0xa4, 0x38, 0xf5, 0x11, 0xb8, 0x5c, 0x2a, 0x44,
+	0x5c, 0x18, 0x96, 0x61, 0x05, 0xc6, 0xb5, 0x4e, 0xc3, 0x5f, 0xf9, 0x0b, 0xff, 0x2b, 0x84, 0x1f,
+	0x33, 0x2c, 0xcb, 0x68, 0x91, 0x80, 0x9f, 0x69, 0x5a, 0xdc, 0xa7, 0xe7, 0x84, 0xa7, 0xb9, 0xf0,
+	0x74, 0xcf, 0x47, 0x83, 0x92, 0x96, 0x5e, 0x6d, 0x63, 0xa7, 0x19, 0x22, 0x96, 0x27, 0x11, 0x9c,
+	0xb6, 0x89, 0xc3, 0x71, 0xdb, 0x0e, 0x01, 0x2b, 0xd3, 0x75, 0xa8, 0x5b, 0x26, 0xc7, 0x75, 0x5e,
+	0xa5, 0x66, 0x63, 0x48, 0x63, 0x46, 0xb5, 0xa8, 0x4e, 0x4c, 0x4e, 0x1b, 0x94, 0xb0, 0x21, 0x1b,
+	0x34, 0x0d, 0x62, 0xd4, 0xd8, 0xe0, 0xe1, 0xb9, 0xfc, 0x4b, 0x02, 0x66, 0xcb, 0xa3, 0x1a, 0x8b,
+	0x1f, 0xc2, 0x38, 0xd5, 0x1d, 0x09, 0xe4, 0x40, 0x3e, 0x5b, 0x3a, 0xa9, 0x8c, 0xd7, 0x5a, 0x89,
+	0x20, 0x2b, 0xa3, 0x50, 0xea, 0xe1, 0x81, 0x9a, 0xfc, 0x1e, 0xc4, 0x0e, 0x83, 0xad, 0x9d, 0x65,
+	0x61, 0x7b, 0x67, 0x19, 0x68, 0x9e, 0x13, 0x71, 0x15, 0xc2, 0x3a, 0x23, 0x98, 0x13, 0xbd, 0x8a,
+	0xb9, 0x14, 0xf3, 0x5d, 0x2e, 0x29, 0x41, 0xf2, 0xca, 0x30, 0x79, 0xe5, 0xf3, 0x61, 0xf2, 0xea,
+	0x01, 0xcf, 0xfc, 0xf6, 0xdf, 0xcb, 0x40, 0xcb, 0x84, 0x76, 0x65, 0xee, 0x39, 0xe9, 0xd8, 0xfa,
+	0xd0, 0x49, 0xfc, 0x59, 0x9c, 0x84, 0x76, 0x65, 0x2e, 0x1e, 0x85, 0x09, 0x13, 0xb7, 0x89, 0x94,
+	0xc8, 0x81, 0x7c, 0x46, 0x4d, 0x0f, 0xd4, 0x04, 0x8b, 0x49, 0x25, 0xcd, 0xdf, 0x14, 0x4f, 0xc3,
+	0xac, 0x4e, 0x9c, 0x3a, 0xa3, 0xb6, 0x97, 0x97, 0x94, 0xf4, 0x31, 0x07, 0x06, 0x6a, 0x92, 0xc5,
+	0xa5, 0xed, 0x45, 0x2d, 0x7a, 0x28, 0x76, 0x21, 0xc4, 0x9c, 0x33, 0x5a, 0xeb, 0x70, 0xe2, 0x48,
+	0xa9, 0x5c, 0x3c, 0x9f, 0x2d, 0xbd, 0xb1, 0x4f, 0x95, 0x94, 0xf2, 0x1e, 0xfa, 0x03, 0x93, 0xb3,
+	0xae, 0x7a, 0x66, 0xa0, 0x9e, 0xfa, 0x09, 0x9c, 0x94, 0x57, 0x98, 0x2c, 0xad, 0x94, 0xd0, 0x97,
+	0x57, 0x70, 0xe1, 0xc6, 0x9b, 0x85, 0xb7, 0xaf, 0xe6, 0xcf, 0x9f, 0xbb, 0x52, 0xb8, 0x7a, 0x7e,
+	0xb8, 0x3c, 0xf5, 0x75, 0xe9, 0xcc, 0x37, 0x2b, 0x5a, 0x24, 0x98, 0xf8, 0x1e, 0x5c, 0x88, 0x36,
+	0x81, 0x94, 0xf6, 0x83, 0x1f, 0x9d, 0x0c, 0xbe, 0x1a, 0x60, 0x2a, 0x66, 0xc3, 0xd2, 0xb2, 0xf5,
+	0xd1, 0x62, 0xe9, 0x5d, 0xb8, 0x38, 0x41, 0x46, 0x3c, 0x0c, 0xe3, 0x4d, 0xd2, 0xf5, 0x2f, 0x3b,
+	0xa3, 0x79, 0x9f, 0xe2, 0x2b, 0x30, 0xb9, 0x89, 0x5b, 0x1d, 0xe2, 0xdf, 0x56, 0x46, 0x0b, 0x16,
+	0xe7, 0x62, 0x6f, 0x01, 0x79, 0x1d, 0x2e, 0x44, 0xf2, 0x72, 0xc4, 0xf3, 0x70, 0x21, 0xa2, 0x4d,
+	0xaf, 0x63, 0x66, 0xd2, 0x89, 0xd8, 0x68, 0x63, 0x06, 0xf2, 0xef, 0x00, 0x1e, 0xb9, 0x48, 0x78,
+	0x14, 0x40, 0xbe, 0xea, 0x10, 0x87, 0x8b, 0x18, 0x2e, 0x46, 0x90, 0xd5, 0x17, 0xd1, 0x8f, 0x87,
+	0x70, 0x14, 0xe9, 0xb1, 0x87, 0x23, 0x59, 0x3e, 0xb5, 0x35, 0x2f, 0x78, 0x90, 0x8f, 0xb1, 0xd3,
+	0x54, 0x13, 0x9e, 0x27, 0x2d, 0xd3, 0x18, 0x6e, 0xc8, 0xbd, 0x18, 0x7c, 0xed, 0x23, 0xea, 0x44,
+	0xe9, 0x3b, 0x43, 0xfe, 0x9f, 0x7a, 0x37, 0xd5, 0x6a, 0xe1, 0x9a, 0xc5, 0x30, 0xb7, 0x58, 0x48,
+	0xbe, 0x30, 0x49, 0x7e, 0x9d, 0x19, 0xd8, 0xa4, 0x37, 0x7c, 0xdb, 0x75, 0x76, 0xd9, 0x21, 0x2c,
+	0x92, 0x83, 0x36, 0xe6, 0xe2, 0xb9, 0xf9, 0x8a, 0x3a, 0x4c, 0x5a, 0x4c, 0x27, 0xcc, 0x57, 0x50,
+	0x46, 0xbd, 0x34, 0x50, 0xd7, 0x58, 0x45, 0x13, 0xc6, 0x0a, 0x53, 0xa5, 0xba, 0xb6, 0x58, 0x98,
+	0xd8, 0xf0, 0x35, 0xa2, 0x25, 0x0b, 0xfe, 0x9f, 0x88, 0x9e, 0xb5, 0x6c, 0x21, 0xb2, 0x08, 0x9c,
+	0x8b, 0x08, 0x26, 0x5b, 0xb4, 0x4d, 0xb9, 0x2f, 0xb4, 0x83, 0xbe, 0x88, 0x4e, 0xc7, 0xa5, 0xdd,
+	0xb4, 0x16, 0x6c, 0x8b, 0x22, 0x4c, 0xd8, 0xd8, 0x20, 0xbe, 0xc6, 0x0e, 0x6a, 0xfe, 0xb7, 0x28,
+	0xc1, 0xb4, 0x4e, 0x5a, 0x84, 0x13, 0x5d, 0x4a, 0xe5, 0x40, 0xfe, 0x80, 0x36, 0x5c, 0xca, 0x7f,
+	0x02, 0x28, 0xad, 0xfa, 0x31, 0x66, 0x34, 0xc9, 0x3a, 0xcc, 0x46, 0x98, 0x86, 0x35, 0xde, 0xaf,
+	0xfd, 0x66, 0x74, 0x45, 0xd4, 0x83, 0x58, 0x9d, 0xb8, 0xb5, 0xd8, 0xff, 0xb8, 0x35, 0x75, 0x21,
+	0x1a, 0x63, 0xfc, 0x0e, 0xe5, 0x5f, 0x01, 0x94, 0x2e, 0xfb, 0x4f, 0xd2, 0x3c, 0xd2, 0x79, 0xee,
+	0x0e, 0xff, 0x0d, 0xc0, 0xd7, 0x27, 0x3a, 0xbc, 0xfc, 0x49, 0x65, 0x8d, 0x74, 0x9d, 0x39, 0xea,
+	0x74, 0xaf, 0xa1, 0x62, 0xfb, 0x37, 0x54, 0x7c, 0xd4, 0x50, 0xf2, 0x1d, 0x00, 0x8f, 0x5e, 0x24,
+	0xd3, 0xbc, 0xe7, 0x48, 0x3b, 0x07, 0x53, 0x4d, 0xd2, 0xad, 0x52, 0x3d, 0x78, 0x47, 0xd5, 0x8c,
+	0xbb, 0xb3, 0x9c, 0x5c, 0x23, 0xdd, 0xca, 0xfb, 0x5a, 0xb2, 0x49, 0xba, 0x15, 0x5d, 0xfe, 0x27,
+	0x06, 0xd1, 0x54, 0x6f, 0xcf, 0x9d, 0xe7, 0x70, 0x2e, 0xc6, 0x66, 0xcd, 0xc5, 0x77, 0x60, 0x2a,
+	0xf8, 0xa9, 0x20, 0xc5, 0x73, 0xf1, 0xfc, 0xa1, 0xd2, 0x91, 0xc9, 0xb0, 0x9a, 0x77, 0xaa, 0x1e,
+	0x1c, 0xa8, 0xf0, 0x07, 0x90, 0x96, 0x93, 0xdf, 0x7a, 0xa1, 0xb4, 0xd0, 0xc6, 0xeb, 0x3f, 0x72,
+	0xdd, 0xa6, 0x8c, 0x38, 0x55, 0x1c, 0xbc, 0x07, 0xfb, 0xcf, 0xed, 0x44, 0x30, 0xb3, 0x43, 0x9b,
+	0x32, 0x17, 0x2f, 0xc0, 0x05, 0x46, 0x1c, 0xce, 0x68, 0x3d, 0x18, 0x30, 0x49, 0xdf, 0x85, 0x3c,
+	0x95, 0x7b, 0x58, 0xb3, 0x11, 0x52, 0x1b, 0xb3, 0x93, 0xbf, 0x8b, 0x41, 0x34, 0x25, 0xbb, 0xb9,
+	0x57, 0xba, 0x0c, 0xd3, 0xd8, 0xa6, 0x55, 0x6f, 0xdc, 0x06, 0x5a, 0x7c, 0x75, 0x76, 0x22, 0x33,
+	0x5c, 0xa5, 0xb0, 0x4d, 0xd7, 0x48, 0x77, 0x42, 0xd1, 0xf1, 0x67, 0x57, 0xf4, 0x1f, 0x00, 0x9e,
+	0x98, 0x50, 0xf4, 0x6a, 0xe4, 0x81, 0x7a, 0xd9, 0x75, 0xfd, 0x2f, 0x80, 0xc7, 0x2f, 0x92, 0xa7,
+	0xb1, 0x9f, 0x23, 0xf9, 0xfa, 0x8b, 0x98, 0x14, 0xd3, 0x61, 0xc6, 0xa7, 0xc5, 0x5f, 0x00, 0x1e,
+	0xff, 0xec, 0x65, 0xc8, 0xf6, 0xd2, 0xcc, 0x6c, 0x8f, 0x4d, 0xff, 0xee, 0x1c, 0x61, 0xf6, 0x1b,
+	0x83, 0xea, 0x1d, 0xb0, 0xd5, 0x43, 0x60, 0xbb, 0x87, 0xc0, 0xc3, 0x1e, 0x12, 0x1e, 0xf5, 0x90,
+	0xb0, 0xdb, 0x43, 0xc2, 0xe3, 0x1e, 0x12, 0x9e, 0xf4, 0x10, 0xb8, 0xe9, 0x22, 0x70, 0xcb, 0x45,
+	0xc2, 0x5d, 0x17, 0x81, 0x7b, 0x2e, 0x12, 0xee, 0xbb, 0x48, 0x78, 0xe0, 0x22, 0x61, 0xcb, 0x45,
+	0x60, 0xdb, 0x45, 0xe0, 0xa1, 0x8b, 0x84, 0x47, 0x2e, 0x02, 0xbb, 0x2e, 0x12, 0x1e, 0xbb, 0x08,
+	0x3c, 0x71, 0x91, 0x70, 0xb3, 0x8f, 0x84, 0x5b, 0x7d, 0x04, 0x6e, 0xf7, 0x91, 0xf0, 0x63, 0x1f,
+	0x81, 0x9f, 0xfb, 0x48, 0xb8, 0xdb, 0x47, 0xc2, 0xbd, 0x3e, 0x02, 0xf7, 0xfb, 0x08, 0x3c, 0xe8,
+	0x23, 0xf0, 0xc5, 0x19, 0xc3, 0x52, 0xf8, 0x06, 0xe1, 0x1b, 0xd4, 0x34, 0x1c, 0xc5, 0x24, 0xfc,
+	0x9a, 0xc5, 0x9a, 0xc5, 0xf1, 0xff, 0x8e, 0xec, 0xa6, 0x51, 0xe4, 0xdc, 0xb4, 0x6b, 0xb5, 0x94,
+	0xaf, 0xa7, 0xb3, 0xff, 0x0d, 0x00, 0xf6, 0x05, 0x85, 0x11, 0x92, 0x0e, 0x00, 0x00,
 }
 
 func (this *Application) Equal(that interface{}) bool {
@@ -979,6 +1018,9 @@ func (this *ListApplicationsRequest) Equal(that interface{}) bool {
 	if this.Page != that1.Page {
 		return false
 	}
+	if this.Deleted != that1.Deleted {
+		return false
+	}
 	return true
 }
 func (this *CreateApplicationRequest) Equal(that interface{}) bool {
@@ -1125,6 +1167,16 @@ func (this *CreateApplicationAPIKeyRequest) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if that1.ExpiresAt == nil {
+		if this.ExpiresAt != nil {
+			return false
+		}
+	} else if !this.ExpiresAt.Equal(*that1.ExpiresAt) {
+		return false
+	}
+	if !this.Restrictions.Equal(that1.Restrictions) {
+		return false
+	}
 	return true
 }
 func (this *UpdateApplicationAPIKeyRequest) Equal(that interface{}) bool {
@@ -1152,6 +1204,9 @@ func (this *UpdateApplicationAPIKeyRequest) Equal(that interface{}) bool {
 	if !this.APIKey.Equal(&that1.APIKey) {
 		return false
 	}
+	if !this.FieldMask.Equal(&that1.FieldMask) {
+		return false
+	}
 	return true
 }
 func (this *ListApplicationCollaboratorsRequest) Equal(that interface{}) bool {
@@ -1434,6 +1489,16 @@ func (m *ListApplicationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if m.Deleted {
+		i--
+		if m.Deleted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
 	if m.Page != 0 {
 		i = encodeVarintApplication(dAtA, i, uint64(m.Page))
 		i--
@@ -1665,21 +1730,43 @@ func (m *CreateApplicationAPIKeyRequest) MarshalToSizedBuffer(dAtA []byte) (int,
 	_ = i
 	var l int
 	_ = l
+	if m.Restrictions != nil {
+		{
+			size, err := m.Restrictions.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApplication(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.ExpiresAt != nil {
+		n15, err15 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ExpiresAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpiresAt):])
+		if err15 != nil {
+			return 0, err15
+		}
+		i -= n15
+		i = encodeVarintApplication(dAtA, i, uint64(n15))
+		i--
+		dAtA[i] = 0x22
+	}
 	if len(m.Rights) > 0 {
-		dAtA15 := make([]byte, len(m.Rights)*10)
-		var j14 int
+		dAtA17 := make([]byte, len(m.Rights)*10)
+		var j16 int
 		for _, num := range m.Rights {
 			for num >= 1<<7 {
-				dAtA15[j14] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA17[j16] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j14++
+				j16++
 			}
-			dAtA15[j14] = uint8(num)
-			j14++
+			dAtA17[j16] = uint8(num)
+			j16++
 		}
-		i -= j14
-		copy(dAtA[i:], dAtA15[:j14])
-		i = encodeVarintApplication(dAtA, i, uint64(j14))
+		i -= j16
+		copy(dAtA[i:], dAtA17[:j16])
+		i = encodeVarintApplication(dAtA, i, uint64(j16))
 		i--
 		dAtA[i] = 0x1a
 	}
@@ -1723,6 +1810,16 @@ func (m *UpdateApplicationAPIKeyRequest) MarshalToSizedBuffer(dAtA []byte) (int,
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.FieldMask.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintApplication(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
 	{
 		size, err := m.APIKey.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -1950,6 +2047,7 @@ func NewPopulatedListApplicationsRequest(r randyApplication, easy bool) *ListApp
 	this.Order = randStringApplication(r)
 	this.Limit = r.Uint32()
 	this.Page = r.Uint32()
+	this.Deleted = bool(r.Intn(2) == 0)
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -2008,6 +2106,12 @@ func NewPopulatedCreateApplicationAPIKeyRequest(r randyApplication, easy bool) *
 	for i := 0; i < v17; i++ {
 		this.Rights[i] = Right([]int32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 56, 19, 20, 21, 22, 23, 24, 25, 26, 27, 28, 29, 30, 31, 32, 33, 34, 35, 36, 37, 38, 39, 40, 41, 42, 43, 44, 45, 46, 47, 48, 49, 50, 51, 52, 53, 54, 55}[r.Intn(57)])
 	}
+	if r.Intn(5) != 0 {
+		this.ExpiresAt = github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	}
+	if r.Intn(5) != 0 {
+		this.Restrictions = NewPopulatedAPIKeyRestrictions(r, easy)
+	}
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -2019,6 +2123,8 @@ func NewPopulatedUpdateApplicationAPIKeyRequest(r randyApplication, easy bool) *
 	this.ApplicationIdentifiers = *v18
 	v19 := NewPopulatedAPIKey(r, easy)
 	this.APIKey = *v19
+	v20 := types.NewPopulatedFieldMask(r, easy)
+	this.FieldMask = *v20
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -2026,8 +2132,8 @@ func NewPopulatedUpdateApplicationAPIKeyRequest(r randyApplication, easy bool) *
 
 func NewPopulatedListApplicationCollaboratorsRequest(r randyApplication, easy bool) *ListApplicationCollaboratorsRequest {
 	this := &ListApplicationCollaboratorsRequest{}
-	v20 := NewPopulatedApplicationIdentifiers(r, easy)
-	this.ApplicationIdentifiers = *v20
+	v21 := NewPopulatedApplicationIdentifiers(r, easy)
+	this.ApplicationIdentifiers = *v21
 	this.Limit = r.Uint32()
 	this.Page = r.Uint32()
 	if !easy && r.Intn(10) != 0 {
@@ -2037,10 +2143,10 @@ func NewPopulatedListApplicationCollaboratorsRequest(r randyApplication, easy bo
 
 func NewPopulatedGetApplicationCollaboratorRequest(r randyApplication, easy bool) *GetApplicationCollaboratorRequest {
 	this := &GetApplicationCollaboratorRequest{}
-	v21 := NewPopulatedApplicationIdentifiers(r, easy)
-	this.ApplicationIdentifiers = *v21
-	v22 := NewPopulatedOrganizationOrUserIdentifiers(r, easy)
-	this.OrganizationOrUserIdentifiers = *v22
+	v22 := NewPopulatedApplicationIdentifiers(r, easy)
+	this.ApplicationIdentifiers = *v22
+	v23 := NewPopulatedOrganizationOrUserIdentifiers(r, easy)
+	this.OrganizationOrUserIdentifiers = *v23
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -2048,10 +2154,10 @@ func NewPopulatedGetApplicationCollaboratorRequest(r randyApplication, easy bool
 
 func NewPopulatedSetApplicationCollaboratorRequest(r randyApplication, easy bool) *SetApplicationCollaboratorRequest {
 	this := &SetApplicationCollaboratorRequest{}
-	v23 := NewPopulatedApplicationIdentifiers(r, easy)
-	this.ApplicationIdentifiers = *v23
-	v24 := NewPopulatedCollaborator(r, easy)
-	this.Collaborator = *v24
+	v24 := NewPopulatedApplicationIdentifiers(r, easy)
+	this.ApplicationIdentifiers = *v24
+	v25 := NewPopulatedCollaborator(r, easy)
+	this.Collaborator = *v25
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -2076,9 +2182,9 @@ func randUTF8RuneApplication(r randyApplication) rune {
 	return rune(ru + 61)
 }
 func randStringApplication(r randyApplication) string {
-	v25 := r.Intn(100)
-	tmps := make([]rune, v25)
-	for i := 0; i < v25; i++ {
+	v26 := r.Intn(100)
+	tmps := make([]rune, v26)
+	for i := 0; i < v26; i++ {
 		tmps[i] = randUTF8RuneApplication(r)
 	}
 	return string(tmps)
@@ -2100,11 +2206,11 @@ func randFieldApplication(dAtA []byte, r randyApplication, fieldNumber int, wire
 	switch wire {
 	case 0:
 		dAtA = encodeVarintPopulateApplication(dAtA, uint64(key))
-		v26 := r.Int63()
+		v27 := r.Int63()
 		if r.Intn(2) == 0 {
-			v26 *= -1
+			v27 *= -1
 		}
-		dAtA = encodeVarintPopulateApplication(dAtA, uint64(v26))
+		dAtA = encodeVarintPopulateApplication(dAtA, uint64(v27))
 	case 1:
 		dAtA = encodeVarintPopulateApplication(dAtA, uint64(key))
 		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
@@ -2216,6 +2322,9 @@ func (m *ListApplicationsRequest) Size() (n int) {
 	if m.Page != 0 {
 		n += 1 + sovApplication(uint64(m.Page))
 	}
+	if m.Deleted {
+		n += 2
+	}
 	return n
 }
 
@@ -2296,6 +2405,14 @@ func (m *CreateApplicationAPIKeyRequest) Size() (n int) {
 		}
 		n += 1 + sovApplication(uint64(l)) + l
 	}
+	if m.ExpiresAt != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpiresAt)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.Restrictions != nil {
+		l = m.Restrictions.Size()
+		n += 1 + l + sovApplication(uint64(l))
+	}
 	return n
 }
 
@@ -2309,6 +2426,8 @@ func (m *UpdateApplicationAPIKeyRequest) Size() (n int) {
 	n += 1 + l + sovApplication(uint64(l))
 	l = m.APIKey.Size()
 	n += 1 + l + sovApplication(uint64(l))
+	l = m.FieldMask.Size()
+	n += 1 + l + sovApplication(uint64(l))
 	return n
 }
 
@@ -2428,6 +2547,7 @@ func (this *ListApplicationsRequest) String() string {
 		`Order:` + fmt.Sprintf("%v", this.Order) + `,`,
 		`Limit:` + fmt.Sprintf("%v", this.Limit) + `,`,
 		`Page:` + fmt.Sprintf("%v", this.Page) + `,`,
+		`Deleted:` + fmt.Sprintf("%v", this.Deleted) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -2485,6 +2605,8 @@ func (this *CreateApplicationAPIKeyRequest) String() string {
 		`ApplicationIdentifiers:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ApplicationIdentifiers), "ApplicationIdentifiers", "ApplicationIdentifiers", 1), `&`, ``, 1) + `,`,
 		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
 		`Rights:` + fmt.Sprintf("%v", this.Rights) + `,`,
+		`ExpiresAt:` + strings.Replace(fmt.Sprintf("%v", this.ExpiresAt), "Timestamp", "types.Timestamp", 1) + `,`,
+		`Restrictions:` + strings.Replace(fmt.Sprintf("%v", this.Restrictions), "APIKeyRestrictions", "APIKeyRestrictions", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -2496,6 +2618,7 @@ func (this *UpdateApplicationAPIKeyRequest) String() string {
 	s := strings.Join([]string{`&UpdateApplicationAPIKeyRequest{`,
 		`ApplicationIdentifiers:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ApplicationIdentifiers), "ApplicationIdentifiers", "ApplicationIdentifiers", 1), `&`, ``, 1) + `,`,
 		`APIKey:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.APIKey), "APIKey", "APIKey", 1), `&`, ``, 1) + `,`,
+		`FieldMask:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.FieldMask), "FieldMask", "types.FieldMask", 1), `&`, ``, 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -3293,6 +3416,26 @@ func (m *ListApplicationsRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deleted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Deleted = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApplication(dAtA[iNdEx:])
@@ -3960,6 +4103,78 @@ func (m *CreateApplicationAPIKeyRequest) Unmarshal(dAtA []byte) error {
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field Rights", wireType)
 			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpiresAt == nil {
+				m.ExpiresAt = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.ExpiresAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Restrictions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Restrictions == nil {
+				m.Restrictions = &APIKeyRestrictions{}
+			}
+			if err := m.Restrictions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApplication(dAtA[iNdEx:])
@@ -4079,6 +4294,39 @@ func (m *UpdateApplicationAPIKeyRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FieldMask", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FieldMask.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApplication(dAtA[iNdEx:])
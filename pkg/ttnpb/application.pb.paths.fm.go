@@ -47,6 +47,7 @@ var ListApplicationsRequestFieldPathsNested = []string{
 	"collaborator.ids.user_ids",
 	"collaborator.ids.user_ids.email",
 	"collaborator.ids.user_ids.user_id",
+	"deleted",
 	"field_mask",
 	"limit",
 	"order",
@@ -55,6 +56,7 @@ var ListApplicationsRequestFieldPathsNested = []string{
 
 var ListApplicationsRequestFieldPathsTopLevel = []string{
 	"collaborator",
+	"deleted",
 	"field_mask",
 	"limit",
 	"order",
@@ -125,28 +127,41 @@ var GetApplicationAPIKeyRequestFieldPathsTopLevel = []string{
 var CreateApplicationAPIKeyRequestFieldPathsNested = []string{
 	"application_ids",
 	"application_ids.application_id",
+	"expires_at",
 	"name",
+	"restrictions",
+	"restrictions.end_device_ids",
+	"restrictions.source_cidrs",
 	"rights",
 }
 
 var CreateApplicationAPIKeyRequestFieldPathsTopLevel = []string{
 	"application_ids",
+	"expires_at",
 	"name",
+	"restrictions",
 	"rights",
 }
 var UpdateApplicationAPIKeyRequestFieldPathsNested = []string{
 	"api_key",
+	"api_key.expires_at",
 	"api_key.id",
 	"api_key.key",
+	"api_key.last_used_at",
 	"api_key.name",
+	"api_key.restrictions",
+	"api_key.restrictions.end_device_ids",
+	"api_key.restrictions.source_cidrs",
 	"api_key.rights",
 	"application_ids",
 	"application_ids.application_id",
+	"field_mask",
 }
 
 var UpdateApplicationAPIKeyRequestFieldPathsTopLevel = []string{
 	"api_key",
 	"application_ids",
+	"field_mask",
 }
 var ListApplicationCollaboratorsRequestFieldPathsNested = []string{
 	"application_ids",
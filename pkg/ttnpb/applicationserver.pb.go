@@ -4,6 +4,7 @@
 package ttnpb
 
 import (
+	bytes "bytes"
 	context "context"
 	fmt "fmt"
 	io "io"
@@ -297,6 +298,160 @@ func (m *ApplicationLinkStats) GetDownlinkCount() uint64 {
 	return 0
 }
 
+type TestUplinkFormatterRequest struct {
+	ApplicationIdentifiers `protobuf:"bytes,1,opt,name=application_ids,json=applicationIds,proto3,embedded=application_ids" json:"application_ids"`
+	// The end device version to pass to the formatter.
+	// This is required for the FORMATTER_REPOSITORY formatter.
+	VersionIDs *EndDeviceVersionIdentifiers `protobuf:"bytes,2,opt,name=version_ids,json=versionIds,proto3" json:"version_ids,omitempty"`
+	Formatter  PayloadFormatter             `protobuf:"varint,3,opt,name=formatter,proto3,enum=ttn.lorawan.v3.PayloadFormatter" json:"formatter,omitempty"`
+	// The formatter parameter, i.e. the script of the FORMATTER_JAVASCRIPT formatter.
+	FormatterParameter   string   `protobuf:"bytes,4,opt,name=formatter_parameter,json=formatterParameter,proto3" json:"formatter_parameter,omitempty"`
+	FPort                uint32   `protobuf:"varint,5,opt,name=f_port,json=fPort,proto3" json:"f_port,omitempty"`
+	FRMPayload           []byte   `protobuf:"bytes,6,opt,name=frm_payload,json=frmPayload,proto3" json:"frm_payload,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TestUplinkFormatterRequest) Reset()      { *m = TestUplinkFormatterRequest{} }
+func (*TestUplinkFormatterRequest) ProtoMessage() {}
+func (*TestUplinkFormatterRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df9d75a19dc066e1, []int{4}
+}
+func (m *TestUplinkFormatterRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TestUplinkFormatterRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TestUplinkFormatterRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TestUplinkFormatterRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TestUplinkFormatterRequest.Merge(m, src)
+}
+func (m *TestUplinkFormatterRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *TestUplinkFormatterRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TestUplinkFormatterRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TestUplinkFormatterRequest proto.InternalMessageInfo
+
+func (m *TestUplinkFormatterRequest) GetVersionIDs() *EndDeviceVersionIdentifiers {
+	if m != nil {
+		return m.VersionIDs
+	}
+	return nil
+}
+
+func (m *TestUplinkFormatterRequest) GetFormatter() PayloadFormatter {
+	if m != nil {
+		return m.Formatter
+	}
+	return PayloadFormatter_FORMATTER_NONE
+}
+
+func (m *TestUplinkFormatterRequest) GetFormatterParameter() string {
+	if m != nil {
+		return m.FormatterParameter
+	}
+	return ""
+}
+
+func (m *TestUplinkFormatterRequest) GetFPort() uint32 {
+	if m != nil {
+		return m.FPort
+	}
+	return 0
+}
+
+func (m *TestUplinkFormatterRequest) GetFRMPayload() []byte {
+	if m != nil {
+		return m.FRMPayload
+	}
+	return nil
+}
+
+type TestUplinkFormatterResponse struct {
+	DecodedPayload *types.Struct `protobuf:"bytes,1,opt,name=decoded_payload,json=decodedPayload,proto3" json:"decoded_payload,omitempty"`
+	// Warnings returned by the formatter.
+	Warnings []string `protobuf:"bytes,2,rep,name=warnings,proto3" json:"warnings,omitempty"`
+	// Errors returned by the formatter or that occurred while running the formatter.
+	// Errors in scripts contain the line and column in their attributes.
+	Errors []*ErrorDetails `protobuf:"bytes,3,rep,name=errors,proto3" json:"errors,omitempty"`
+	// Time it took to run the formatter.
+	Duration             time.Duration `protobuf:"bytes,4,opt,name=duration,proto3,stdduration" json:"duration"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *TestUplinkFormatterResponse) Reset()      { *m = TestUplinkFormatterResponse{} }
+func (*TestUplinkFormatterResponse) ProtoMessage() {}
+func (*TestUplinkFormatterResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df9d75a19dc066e1, []int{5}
+}
+func (m *TestUplinkFormatterResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TestUplinkFormatterResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TestUplinkFormatterResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TestUplinkFormatterResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TestUplinkFormatterResponse.Merge(m, src)
+}
+func (m *TestUplinkFormatterResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *TestUplinkFormatterResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TestUplinkFormatterResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TestUplinkFormatterResponse proto.InternalMessageInfo
+
+func (m *TestUplinkFormatterResponse) GetDecodedPayload() *types.Struct {
+	if m != nil {
+		return m.DecodedPayload
+	}
+	return nil
+}
+
+func (m *TestUplinkFormatterResponse) GetWarnings() []string {
+	if m != nil {
+		return m.Warnings
+	}
+	return nil
+}
+
+func (m *TestUplinkFormatterResponse) GetErrors() []*ErrorDetails {
+	if m != nil {
+		return m.Errors
+	}
+	return nil
+}
+
+func (m *TestUplinkFormatterResponse) GetDuration() time.Duration {
+	if m != nil {
+		return m.Duration
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*ApplicationLink)(nil), "ttn.lorawan.v3.ApplicationLink")
 	golang_proto.RegisterType((*ApplicationLink)(nil), "ttn.lorawan.v3.ApplicationLink")
@@ -306,6 +461,10 @@ func init() {
 	golang_proto.RegisterType((*SetApplicationLinkRequest)(nil), "ttn.lorawan.v3.SetApplicationLinkRequest")
 	proto.RegisterType((*ApplicationLinkStats)(nil), "ttn.lorawan.v3.ApplicationLinkStats")
 	golang_proto.RegisterType((*ApplicationLinkStats)(nil), "ttn.lorawan.v3.ApplicationLinkStats")
+	proto.RegisterType((*TestUplinkFormatterRequest)(nil), "ttn.lorawan.v3.TestUplinkFormatterRequest")
+	golang_proto.RegisterType((*TestUplinkFormatterRequest)(nil), "ttn.lorawan.v3.TestUplinkFormatterRequest")
+	proto.RegisterType((*TestUplinkFormatterResponse)(nil), "ttn.lorawan.v3.TestUplinkFormatterResponse")
+	golang_proto.RegisterType((*TestUplinkFormatterResponse)(nil), "ttn.lorawan.v3.TestUplinkFormatterResponse")
 }
 
 func init() {
@@ -316,92 +475,118 @@ func init() {
 }
 
 var fileDescriptor_df9d75a19dc066e1 = []byte{
-	// 1347 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x57, 0x4f, 0x6c, 0x13, 0xc7,
-	0x1a, 0xdf, 0xb1, 0x4d, 0x42, 0x86, 0xf7, 0x20, 0x2c, 0x3c, 0x5e, 0xe2, 0xc7, 0x1b, 0x47, 0x86,
-	0x22, 0xc7, 0xc2, 0xbb, 0xd4, 0xb4, 0x55, 0x9b, 0xaa, 0x8d, 0xd6, 0x10, 0x52, 0x4a, 0xa2, 0x82,
-	0x1d, 0x54, 0x29, 0xfc, 0xb1, 0x26, 0xde, 0xb1, 0xb3, 0xb2, 0xbd, 0xbb, 0xec, 0xcc, 0x26, 0x18,
-	0x88, 0x84, 0xaa, 0x8a, 0x22, 0x0e, 0x2d, 0x6a, 0x85, 0xc4, 0xb1, 0x6a, 0x2f, 0x1c, 0x51, 0x7b,
-	0x28, 0xa7, 0x96, 0x4b, 0x25, 0xd4, 0x5e, 0xa8, 0x7a, 0x41, 0xaa, 0x94, 0xe2, 0x75, 0x0f, 0x48,
-	0xbd, 0x70, 0xa4, 0x9c, 0xaa, 0x9d, 0x5d, 0xff, 0x89, 0x37, 0x09, 0x86, 0x22, 0xaa, 0xde, 0x66,
-	0x77, 0x7e, 0xdf, 0xef, 0xfb, 0x7d, 0xdf, 0xfc, 0xbe, 0xf1, 0x1a, 0x8e, 0x56, 0x0c, 0x0b, 0x2f,
-	0x62, 0x3d, 0x45, 0x19, 0x2e, 0x94, 0x65, 0x6c, 0x6a, 0x32, 0x36, 0xcd, 0x8a, 0x56, 0xc0, 0x4c,
-	0x33, 0x74, 0x4a, 0xac, 0x05, 0x62, 0x49, 0xa6, 0x65, 0x30, 0x43, 0xdc, 0xcc, 0x98, 0x2e, 0xf9,
-	0x70, 0x69, 0x61, 0x7f, 0x54, 0x29, 0x69, 0x6c, 0xde, 0x9e, 0x93, 0x0a, 0x46, 0x55, 0x26, 0xfa,
-	0x82, 0x51, 0x33, 0x2d, 0xe3, 0x6c, 0x4d, 0xe6, 0xe0, 0x42, 0xaa, 0x44, 0xf4, 0xd4, 0x02, 0xae,
-	0x68, 0x2a, 0x66, 0x44, 0x0e, 0x2c, 0x3c, 0xca, 0x68, 0xaa, 0x83, 0xa2, 0x64, 0x94, 0x0c, 0x2f,
-	0x78, 0xce, 0x2e, 0xf2, 0x27, 0xfe, 0xc0, 0x57, 0x3e, 0x7c, 0x67, 0xc9, 0x30, 0x4a, 0x15, 0xe2,
-	0xa9, 0xd4, 0x75, 0x83, 0x79, 0x22, 0xfd, 0xdd, 0xff, 0xf9, 0xbb, 0x2d, 0x0e, 0x52, 0x35, 0x59,
-	0xcd, 0xdf, 0x1c, 0xe9, 0xde, 0x2c, 0x6a, 0xa4, 0xa2, 0xe6, 0xab, 0x98, 0x96, 0x7d, 0x44, 0xac,
-	0x1b, 0xc1, 0xb4, 0x2a, 0xa1, 0x0c, 0x57, 0x4d, 0x1f, 0x10, 0x0f, 0xb6, 0x8a, 0xe8, 0x6a, 0x5e,
-	0x25, 0x0b, 0x5a, 0xa1, 0x59, 0xd0, 0xae, 0x20, 0x46, 0x53, 0x89, 0xce, 0xb4, 0xa2, 0x46, 0xac,
-	0xa6, 0xd0, 0x91, 0x20, 0xa8, 0x4a, 0x28, 0xc5, 0x25, 0xd2, 0x44, 0xec, 0x5c, 0x05, 0x71, 0x86,
-	0x31, 0x6f, 0x37, 0xfe, 0x47, 0x08, 0x6e, 0x51, 0xda, 0x87, 0x34, 0xa5, 0xe9, 0x65, 0xf1, 0x7b,
-	0x00, 0x77, 0xe8, 0x84, 0x2d, 0x1a, 0x56, 0x39, 0xef, 0x9d, 0x5a, 0x1e, 0xab, 0xaa, 0x45, 0x28,
-	0x1d, 0x02, 0x23, 0x20, 0x31, 0x90, 0xf9, 0x18, 0x3c, 0xce, 0x5c, 0x01, 0xd6, 0x47, 0x20, 0xfd,
-	0x21, 0x38, 0x9d, 0x18, 0x1f, 0x4b, 0x8c, 0x8f, 0x9d, 0xc0, 0xa9, 0x73, 0x4a, 0x6a, 0x76, 0x5f,
-	0xea, 0x8d, 0x53, 0x17, 0x3a, 0xd6, 0xed, 0xe5, 0xc9, 0xd4, 0xa9, 0x64, 0xc7, 0xc6, 0xe8, 0x49,
-	0x69, 0x34, 0xe9, 0xc6, 0x29, 0xa9, 0x59, 0x9c, 0x3a, 0xe7, 0xc5, 0xb5, 0xd7, 0xed, 0x25, 0x8f,
-	0x6b, 0x6f, 0x8c, 0x26, 0xc6, 0xc7, 0xc6, 0x4e, 0xb8, 0xab, 0xf3, 0x2f, 0xef, 0x7d, 0x75, 0x69,
-	0x74, 0x7c, 0xf7, 0x85, 0xd3, 0xbb, 0xb3, 0xdb, 0x7d, 0xb9, 0x39, 0xae, 0x56, 0xf1, 0xc4, 0x8a,
-	0x49, 0xd8, 0x8f, 0x4d, 0x2d, 0x5f, 0x26, 0xb5, 0xa1, 0x10, 0xd7, 0xbd, 0xf5, 0x71, 0x26, 0x62,
-	0x85, 0x06, 0x81, 0xb3, 0x1c, 0xeb, 0x53, 0x8e, 0x1e, 0x3e, 0x42, 0x6a, 0xd9, 0x3e, 0x6c, 0x6a,
-	0x47, 0x48, 0x4d, 0x7c, 0x1f, 0x8a, 0x2a, 0x29, 0x62, 0xbb, 0xc2, 0xf2, 0x45, 0xc3, 0xaa, 0x62,
-	0xc6, 0x88, 0x45, 0x87, 0xc2, 0x23, 0x20, 0xb1, 0x29, 0x9d, 0x90, 0x56, 0xba, 0x55, 0x9a, 0xf6,
-	0x3a, 0x7c, 0x14, 0xd7, 0x2a, 0x06, 0x56, 0x0f, 0xb5, 0xf0, 0xd9, 0xad, 0x3e, 0x47, 0xfb, 0x95,
-	0x38, 0x0c, 0xc3, 0xac, 0x42, 0x87, 0x22, 0x23, 0x20, 0xb1, 0x31, 0xd3, 0xef, 0x2c, 0xc7, 0xc2,
-	0x33, 0x53, 0xb9, 0xac, 0xfb, 0x2e, 0xfe, 0x1d, 0x80, 0xc3, 0x93, 0x84, 0x75, 0xb5, 0x3f, 0x4b,
-	0xce, 0xd8, 0x84, 0x32, 0x11, 0xc3, 0x2d, 0x1d, 0xd3, 0x93, 0xd7, 0x54, 0xaf, 0xfb, 0x9b, 0xd2,
-	0x7b, 0xba, 0xe5, 0x74, 0x10, 0x1c, 0x6e, 0x1b, 0x24, 0x33, 0xf8, 0x38, 0xb3, 0xe1, 0x0a, 0x08,
-	0x0d, 0x82, 0x3b, 0xcb, 0x31, 0xe1, 0xee, 0x72, 0x0c, 0x64, 0x37, 0xe3, 0x4e, 0x24, 0x15, 0xc7,
-	0x21, 0x6c, 0x5b, 0x97, 0xf7, 0x68, 0x53, 0x3a, 0x2a, 0x79, 0xde, 0x95, 0x9a, 0xde, 0x95, 0x0e,
-	0xb9, 0x90, 0x69, 0x4c, 0xcb, 0x99, 0x88, 0xcb, 0x94, 0x1d, 0x28, 0x36, 0x5f, 0xc4, 0x2f, 0x85,
-	0xe0, 0x70, 0xee, 0xef, 0xac, 0x60, 0x02, 0x46, 0x2a, 0x9a, 0xde, 0xd4, 0x1e, 0x5b, 0x87, 0xd7,
-	0x15, 0xb6, 0x0a, 0x21, 0x0f, 0xef, 0x6a, 0x44, 0xf8, 0xe9, 0x1b, 0xf1, 0x49, 0x04, 0x6e, 0xef,
-	0x4a, 0x96, 0x63, 0x98, 0x51, 0xf1, 0x2d, 0x38, 0xe0, 0x66, 0x20, 0x6a, 0x1e, 0x33, 0xbf, 0xfa,
-	0x20, 0xf1, 0x4c, 0xf3, 0x76, 0xc8, 0x44, 0xae, 0xfe, 0x1a, 0x03, 0xd9, 0x8d, 0x5e, 0x88, 0xc2,
-	0xd6, 0x1b, 0xc5, 0xd0, 0x3f, 0x69, 0x14, 0xdf, 0x83, 0xdb, 0x2a, 0x98, 0xb2, 0xbc, 0x6d, 0xe6,
-	0x2d, 0x52, 0x20, 0xda, 0x82, 0xd7, 0x90, 0x70, 0x8f, 0x0d, 0x19, 0x74, 0x83, 0x8f, 0x9b, 0x59,
-	0x3f, 0x54, 0x61, 0xe2, 0x30, 0xdc, 0x68, 0x9b, 0xf9, 0x82, 0x61, 0xeb, 0x8c, 0xcf, 0x56, 0x24,
-	0xdb, 0x6f, 0x9b, 0x07, 0xdc, 0x47, 0xf1, 0x14, 0x8c, 0xf2, 0x5c, 0xaa, 0xb1, 0xa8, 0xbb, 0x8d,
-	0x74, 0x07, 0x7a, 0x11, 0x5b, 0xaa, 0x97, 0x72, 0x43, 0x8f, 0x29, 0xff, 0xeb, 0x72, 0x1c, 0xf4,
-	0x29, 0x0e, 0x35, 0x19, 0x14, 0x26, 0xbe, 0x04, 0x37, 0xb7, 0x98, 0xbd, 0xfc, 0x7d, 0x3c, 0xff,
-	0xbf, 0x9b, 0x6f, 0xb9, 0x8a, 0xf4, 0x0f, 0x11, 0x18, 0x52, 0xa8, 0x78, 0x0d, 0xc0, 0xfe, 0x49,
-	0xc2, 0xf8, 0xbd, 0x3a, 0xda, 0x6d, 0xcf, 0x35, 0x87, 0x3f, 0xfa, 0x24, 0x27, 0xc7, 0xdf, 0xfe,
-	0xe0, 0xe7, 0xdf, 0x3e, 0x0b, 0xbd, 0x2e, 0xbe, 0x26, 0x63, 0xba, 0xe2, 0x57, 0x56, 0x3e, 0xdf,
-	0x35, 0x73, 0xd2, 0xca, 0xe7, 0x25, 0x99, 0x3b, 0xfe, 0x3a, 0x80, 0xfd, 0xb9, 0xb5, 0x74, 0xe5,
-	0x9e, 0x5d, 0x97, 0xc2, 0x75, 0xbd, 0x19, 0x7d, 0x46, 0x5d, 0x63, 0x20, 0x29, 0x5e, 0x80, 0xf0,
-	0x20, 0xa9, 0x10, 0x46, 0xb8, 0xb8, 0x1e, 0xef, 0x8a, 0xe8, 0x8e, 0xc0, 0x89, 0x4e, 0xb8, 0x3f,
-	0xd9, 0x71, 0x89, 0x0b, 0x4a, 0x24, 0xf7, 0x3c, 0x49, 0x90, 0xdf, 0x98, 0x4f, 0x01, 0xfc, 0x97,
-	0x7f, 0x60, 0xde, 0x04, 0xf7, 0x2a, 0x60, 0xf7, 0x13, 0x5a, 0xc3, 0xd9, 0xe2, 0xaf, 0x70, 0x39,
-	0x92, 0xb8, 0xb7, 0x37, 0x39, 0x32, 0x75, 0xa3, 0xd2, 0xbf, 0xf4, 0xc1, 0x0d, 0x8a, 0x69, 0x2a,
-	0x54, 0x9c, 0x81, 0x03, 0x39, 0x7b, 0x8e, 0x16, 0x2c, 0x6d, 0x8e, 0xf4, 0x2c, 0xed, 0xff, 0xeb,
-	0xe0, 0x8e, 0x9b, 0xfb, 0x80, 0xf8, 0x23, 0x80, 0x5b, 0x9b, 0x5e, 0x3f, 0x66, 0x13, 0x9b, 0x1c,
-	0xb5, 0xe9, 0xbc, 0x18, 0xa8, 0x68, 0x05, 0xa4, 0x69, 0x89, 0xb5, 0x1a, 0x7f, 0x96, 0x57, 0x6a,
-	0xc5, 0xab, 0xc1, 0x4a, 0xdb, 0x9f, 0x3a, 0xab, 0x18, 0x21, 0x68, 0x0c, 0x0f, 0x1a, 0x8c, 0x6b,
-	0x2d, 0x97, 0x64, 0x77, 0xf6, 0x64, 0xd3, 0xa6, 0xf3, 0xae, 0x81, 0x7e, 0x02, 0x70, 0x7b, 0x97,
-	0x54, 0xb3, 0x82, 0x0b, 0xe4, 0x2f, 0x16, 0x74, 0x9e, 0x17, 0x64, 0xc7, 0xcd, 0x17, 0x56, 0x90,
-	0xe5, 0xe9, 0x76, 0x6b, 0xfa, 0xba, 0xfb, 0x84, 0xa6, 0x34, 0xca, 0x82, 0x05, 0x4d, 0xe8, 0xea,
-	0x41, 0x4e, 0xd2, 0xab, 0x33, 0x9b, 0x9c, 0x34, 0x9e, 0xe5, 0xe5, 0x4d, 0x89, 0xef, 0x3e, 0xfd,
-	0xe4, 0xb6, 0xea, 0xe9, 0x2a, 0x40, 0xfc, 0x12, 0xc0, 0xff, 0x4c, 0x12, 0x36, 0x7d, 0x6c, 0x66,
-	0xe6, 0x80, 0xa1, 0xeb, 0xa4, 0xc0, 0x9d, 0xa9, 0x17, 0x8d, 0x9e, 0xad, 0x1b, 0x0f, 0x7c, 0x7b,
-	0x05, 0xb8, 0x7a, 0xbf, 0x0b, 0x97, 0xf8, 0x97, 0x6f, 0xaa, 0xd0, 0x0a, 0x4f, 0x69, 0x7a, 0xd1,
-	0x48, 0xff, 0x1e, 0x81, 0xdb, 0x14, 0xda, 0x6a, 0x5d, 0x96, 0x94, 0x34, 0xca, 0xac, 0x9a, 0xf8,
-	0x15, 0x80, 0xe1, 0x49, 0xc2, 0xc4, 0x5d, 0xab, 0xdc, 0xdb, 0x1d, 0x68, 0xcf, 0x35, 0xc3, 0x6b,
-	0x1e, 0x45, 0xbc, 0xcc, 0xf5, 0x11, 0xb1, 0xf0, 0x02, 0x8c, 0x23, 0x5e, 0x0a, 0xc1, 0x70, 0x6e,
-	0x35, 0xd1, 0xb9, 0xa7, 0x13, 0xfd, 0x2d, 0xe0, 0xaa, 0xbf, 0x01, 0xd1, 0x75, 0x65, 0x4b, 0xcf,
-	0x28, 0x5b, 0x5a, 0x29, 0x7b, 0x0c, 0x24, 0x67, 0xa7, 0xe3, 0xef, 0x3c, 0xaf, 0x4c, 0xee, 0xc4,
-	0x5c, 0x03, 0xb0, 0xcf, 0xfb, 0x1d, 0xe9, 0x71, 0x4c, 0xd6, 0x9a, 0xfb, 0x69, 0xde, 0x88, 0xc9,
-	0xe4, 0xc4, 0x73, 0x19, 0x8c, 0xcc, 0x17, 0xe0, 0x4e, 0x1d, 0x81, 0xbb, 0x75, 0x04, 0xee, 0xd5,
-	0x91, 0x70, 0xbf, 0x8e, 0x84, 0x07, 0x75, 0x24, 0x3c, 0xac, 0x23, 0xe1, 0x51, 0x1d, 0x81, 0x8b,
-	0x0e, 0x02, 0x97, 0x1d, 0x24, 0xdc, 0x70, 0x10, 0xb8, 0xe9, 0x20, 0xe1, 0x96, 0x83, 0x84, 0xdb,
-	0x0e, 0x12, 0xee, 0x38, 0x08, 0xdc, 0x75, 0x10, 0xb8, 0xe7, 0x20, 0xe1, 0xbe, 0x83, 0xc0, 0x03,
-	0x07, 0x09, 0x0f, 0x1d, 0x04, 0x1e, 0x39, 0x48, 0xb8, 0xd8, 0x40, 0xc2, 0xe5, 0x06, 0x02, 0x57,
-	0x1b, 0x48, 0xb8, 0xde, 0x40, 0xe0, 0xf3, 0x06, 0x12, 0x6e, 0x34, 0x90, 0x70, 0xb3, 0x81, 0xc0,
-	0xad, 0x06, 0x02, 0xb7, 0x1b, 0x08, 0xcc, 0xee, 0x2d, 0x19, 0x12, 0x9b, 0x27, 0x6c, 0x5e, 0xd3,
-	0x4b, 0x54, 0xf2, 0x3f, 0xd2, 0xe4, 0x95, 0xff, 0x0d, 0xcd, 0x72, 0x49, 0x66, 0x4c, 0x37, 0xe7,
-	0xe6, 0xfa, 0x78, 0x0f, 0xf6, 0xff, 0x19, 0x00, 0x00, 0xff, 0xff, 0x64, 0x22, 0xcb, 0xfb, 0xd3,
-	0x0f, 0x00, 0x00,
+	// 1771 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x58, 0x4b, 0x6c, 0x1b, 0xc7,
+	0x19, 0xe6, 0x90, 0x12, 0x25, 0x8d, 0x12, 0xd9, 0x1e, 0xb9, 0x8e, 0xc4, 0x38, 0x2b, 0x61, 0xe3,
+	0xa6, 0x14, 0x63, 0xee, 0xa6, 0x4c, 0xfa, 0x52, 0xd1, 0xaa, 0x5c, 0xcb, 0x92, 0x53, 0x5b, 0xad,
+	0xbc, 0x54, 0x5a, 0xc0, 0xb1, 0x43, 0x8c, 0xb8, 0x43, 0x6a, 0x21, 0x72, 0x77, 0xb3, 0x33, 0x2b,
+	0x45, 0x7e, 0x00, 0x41, 0x50, 0x24, 0x41, 0x0e, 0xad, 0xd1, 0x22, 0x80, 0x8f, 0x45, 0x7b, 0xc9,
+	0xa9, 0x08, 0xda, 0x43, 0x72, 0x69, 0x1b, 0xa0, 0x28, 0x60, 0xa0, 0x17, 0x17, 0xbd, 0x04, 0x28,
+	0xa0, 0x46, 0xcb, 0x1e, 0x72, 0x0c, 0x7a, 0x4a, 0x75, 0x69, 0x31, 0xb3, 0xbb, 0x24, 0xb5, 0x24,
+	0x6d, 0x4a, 0x2e, 0xdc, 0xf6, 0xa4, 0x99, 0xfd, 0xbf, 0xf9, 0xfe, 0x6f, 0xfe, 0xc7, 0xcc, 0x50,
+	0x70, 0xae, 0x6e, 0xbb, 0x78, 0x1b, 0x5b, 0x79, 0xca, 0x70, 0x65, 0x53, 0xc5, 0x8e, 0xa9, 0x62,
+	0xc7, 0xa9, 0x9b, 0x15, 0xcc, 0x4c, 0xdb, 0xa2, 0xc4, 0xdd, 0x22, 0xae, 0xe2, 0xb8, 0x36, 0xb3,
+	0xd1, 0x04, 0x63, 0x96, 0x12, 0xc2, 0x95, 0xad, 0xe7, 0x33, 0xc5, 0x9a, 0xc9, 0x36, 0xbc, 0x75,
+	0xa5, 0x62, 0x37, 0x54, 0x62, 0x6d, 0xd9, 0x3b, 0x8e, 0x6b, 0xbf, 0xb6, 0xa3, 0x0a, 0x70, 0x25,
+	0x5f, 0x23, 0x56, 0x7e, 0x0b, 0xd7, 0x4d, 0x03, 0x33, 0xa2, 0x76, 0x0d, 0x02, 0xca, 0x4c, 0xbe,
+	0x83, 0xa2, 0x66, 0xd7, 0xec, 0x60, 0xf1, 0xba, 0x57, 0x15, 0x33, 0x31, 0x11, 0xa3, 0x10, 0x7e,
+	0xba, 0x66, 0xdb, 0xb5, 0x3a, 0x09, 0x54, 0x5a, 0x96, 0xcd, 0x02, 0x91, 0xa1, 0x55, 0x0a, 0xad,
+	0x2d, 0x0e, 0xc3, 0x73, 0x05, 0x20, 0xb4, 0x3f, 0x19, 0xb7, 0x93, 0x86, 0xc3, 0x76, 0x42, 0xe3,
+	0x6c, 0xdc, 0x58, 0x35, 0x49, 0xdd, 0x28, 0x37, 0x30, 0xdd, 0x8c, 0x39, 0x6f, 0x21, 0x28, 0x73,
+	0xbd, 0x0a, 0x0b, 0xad, 0x33, 0x71, 0x2b, 0x33, 0x1b, 0x84, 0x32, 0xdc, 0x70, 0x42, 0x80, 0xdc,
+	0x1d, 0x68, 0x62, 0x19, 0x65, 0x83, 0x6c, 0x99, 0x95, 0x28, 0x1c, 0x4f, 0xf5, 0xc0, 0xb8, 0xae,
+	0x1d, 0x26, 0x20, 0xf3, 0x74, 0xb7, 0xd9, 0x34, 0x88, 0xc5, 0xcc, 0xaa, 0x49, 0xdc, 0x28, 0x0a,
+	0xb3, 0xdd, 0xa0, 0x06, 0xa1, 0x14, 0xd7, 0x48, 0x84, 0x38, 0xdd, 0x03, 0xf1, 0x2a, 0x0b, 0x37,
+	0x22, 0xff, 0x33, 0x09, 0x8f, 0x15, 0xdb, 0x15, 0x70, 0xc9, 0xb4, 0x36, 0xd1, 0x1f, 0x01, 0x3c,
+	0x65, 0x11, 0xb6, 0x6d, 0xbb, 0x9b, 0xe5, 0xa0, 0x24, 0xca, 0xd8, 0x30, 0x5c, 0x42, 0xe9, 0x14,
+	0x98, 0x05, 0xd9, 0x31, 0xed, 0xc7, 0x60, 0x5f, 0x7b, 0x07, 0xb8, 0x6f, 0x81, 0xc2, 0x8f, 0xc0,
+	0x2b, 0xd9, 0x85, 0xf9, 0xec, 0xc2, 0xfc, 0xcb, 0x38, 0x7f, 0xbd, 0x98, 0xbf, 0xf2, 0x5c, 0xfe,
+	0x1b, 0xd7, 0x6e, 0x76, 0x8c, 0xdb, 0xc3, 0xab, 0xf9, 0x6b, 0xb9, 0x0e, 0xc3, 0xdc, 0x55, 0x65,
+	0x2e, 0xc7, 0xd7, 0x15, 0xf3, 0x57, 0x70, 0xfe, 0x7a, 0xb0, 0xae, 0x3d, 0x6e, 0x0f, 0xc5, 0xba,
+	0xb6, 0x61, 0x2e, 0xbb, 0x30, 0x3f, 0xff, 0x32, 0x1f, 0xdd, 0xf8, 0xf2, 0xd9, 0xaf, 0xdc, 0x9a,
+	0x5b, 0x38, 0x73, 0xf3, 0x95, 0x33, 0xfa, 0xc9, 0x50, 0x6e, 0x49, 0xa8, 0x2d, 0x06, 0x62, 0x51,
+	0x0e, 0x8e, 0x60, 0xc7, 0x2c, 0x6f, 0x92, 0x9d, 0xa9, 0xa4, 0xd0, 0x7d, 0x62, 0x5f, 0x1b, 0x72,
+	0x93, 0xc7, 0x81, 0xbf, 0x3b, 0x93, 0x2e, 0xae, 0xbe, 0x78, 0x91, 0xec, 0xe8, 0x69, 0xec, 0x98,
+	0x17, 0xc9, 0x0e, 0xfa, 0x21, 0x44, 0x06, 0xa9, 0x62, 0xaf, 0xce, 0xca, 0x55, 0xdb, 0x6d, 0x60,
+	0xc6, 0x88, 0x4b, 0xa7, 0x52, 0xb3, 0x20, 0x3b, 0x5e, 0xc8, 0x2a, 0x07, 0x5b, 0x41, 0x59, 0x09,
+	0x22, 0xbc, 0x8a, 0x77, 0xea, 0x36, 0x36, 0x96, 0x5a, 0x78, 0xfd, 0x44, 0xc8, 0xd1, 0xfe, 0x84,
+	0xa6, 0x61, 0x8a, 0xd5, 0xe9, 0xd4, 0xd0, 0x2c, 0xc8, 0x8e, 0x6a, 0x23, 0xfe, 0xee, 0x4c, 0x6a,
+	0xed, 0x52, 0x49, 0xe7, 0xdf, 0xe4, 0xdf, 0x03, 0x38, 0xbd, 0x4c, 0x58, 0x2c, 0xfc, 0x3a, 0x79,
+	0xd5, 0x23, 0x94, 0x21, 0x0c, 0x8f, 0x75, 0xb4, 0x66, 0xd9, 0x34, 0x82, 0xe8, 0x8f, 0x17, 0x9e,
+	0x89, 0xcb, 0xe9, 0x20, 0x78, 0xb1, 0x5d, 0x20, 0xda, 0xf1, 0x7d, 0x6d, 0xf8, 0x1d, 0x90, 0x3c,
+	0x0e, 0xee, 0xee, 0xce, 0x24, 0xee, 0xed, 0xce, 0x00, 0x7d, 0x02, 0x77, 0x22, 0x29, 0x5a, 0x80,
+	0xb0, 0x5d, 0xf7, 0x22, 0x46, 0xe3, 0x85, 0x8c, 0x12, 0x94, 0xb6, 0x12, 0x95, 0xb6, 0xb2, 0xc4,
+	0x21, 0x2b, 0x98, 0x6e, 0x6a, 0x43, 0x9c, 0x49, 0x1f, 0xab, 0x46, 0x1f, 0xe4, 0x37, 0x93, 0x70,
+	0xba, 0xf4, 0xdf, 0xdc, 0xc1, 0x79, 0x38, 0x54, 0x37, 0xad, 0x48, 0xfb, 0xcc, 0x7d, 0x78, 0xb9,
+	0xb0, 0x1e, 0x84, 0x62, 0x79, 0x2c, 0x10, 0xa9, 0xc3, 0x07, 0xe2, 0x27, 0x43, 0xf0, 0x64, 0xcc,
+	0x59, 0x89, 0x61, 0x46, 0xd1, 0xb7, 0xe0, 0x18, 0xf7, 0x40, 0x8c, 0x32, 0x66, 0x53, 0xa0, 0x0f,
+	0xf1, 0x5a, 0x74, 0x78, 0x68, 0x43, 0xb7, 0xff, 0x36, 0x03, 0xf4, 0xd1, 0x60, 0x49, 0x91, 0xdd,
+	0xaf, 0x15, 0x93, 0xff, 0x4f, 0xad, 0xf8, 0x7d, 0x38, 0x59, 0xc7, 0x94, 0x95, 0x3d, 0xa7, 0xec,
+	0x92, 0x0a, 0x31, 0xb7, 0x82, 0x80, 0xa4, 0x06, 0x0c, 0xc8, 0x71, 0xbe, 0xf8, 0x25, 0x47, 0x0f,
+	0x97, 0x16, 0x19, 0x9a, 0x86, 0xa3, 0x9e, 0x53, 0xae, 0xd8, 0x9e, 0xc5, 0x44, 0x6f, 0x0d, 0xe9,
+	0x23, 0x9e, 0x73, 0x8e, 0x4f, 0xd1, 0x35, 0x98, 0x11, 0xbe, 0x0c, 0x7b, 0xdb, 0xe2, 0x81, 0xe4,
+	0x0d, 0xbd, 0x8d, 0x5d, 0x23, 0x70, 0x39, 0x3c, 0xa0, 0xcb, 0x27, 0x38, 0xc7, 0x62, 0x48, 0xb1,
+	0x14, 0x31, 0x14, 0x19, 0xfa, 0x22, 0x9c, 0x68, 0x31, 0x07, 0xfe, 0xd3, 0xc2, 0xff, 0xe3, 0xd1,
+	0x57, 0xa1, 0x42, 0xfe, 0x6d, 0x0a, 0x66, 0xd6, 0x08, 0x57, 0x1d, 0x12, 0x04, 0x07, 0xc2, 0x23,
+	0xec, 0x8d, 0xab, 0x70, 0x7c, 0x8b, 0xb8, 0x34, 0xa2, 0x0f, 0x5a, 0xe4, 0xd9, 0x38, 0xfd, 0x79,
+	0xcb, 0x58, 0x14, 0x97, 0xd2, 0x0f, 0x02, 0x6c, 0xa7, 0x8f, 0x09, 0x7f, 0x77, 0x06, 0x46, 0xdf,
+	0x17, 0xa9, 0x0e, 0xb7, 0x22, 0x0c, 0x45, 0x17, 0xe0, 0x58, 0xeb, 0xa0, 0x14, 0x79, 0x9c, 0x28,
+	0xcc, 0xc6, 0xb9, 0xe3, 0x07, 0xa4, 0x36, 0xba, 0xaf, 0x0d, 0xbf, 0xc1, 0x45, 0xeb, 0xed, 0xc5,
+	0x48, 0x85, 0x93, 0xad, 0x49, 0xd9, 0xc1, 0x2e, 0x6e, 0x10, 0xce, 0xc9, 0xb3, 0x3a, 0xa6, 0xa3,
+	0x96, 0x69, 0x35, 0xb2, 0xa0, 0x1c, 0x4c, 0x57, 0xcb, 0x8e, 0xed, 0x06, 0xc9, 0x7c, 0x5c, 0x9b,
+	0xdc, 0xd7, 0x46, 0x72, 0xc3, 0x53, 0xff, 0x02, 0x59, 0x7e, 0xb2, 0x0f, 0x2f, 0xad, 0xda, 0x2e,
+	0xd3, 0x87, 0xab, 0xfc, 0x0f, 0xfa, 0x1a, 0x1c, 0xaf, 0xba, 0x8d, 0xb2, 0x13, 0x28, 0x11, 0xa9,
+	0x7a, 0x4c, 0x3b, 0xb5, 0xaf, 0x0d, 0x5f, 0x4f, 0x4d, 0xed, 0x73, 0x38, 0x5c, 0xd2, 0x57, 0x42,
+	0x9d, 0x3a, 0xac, 0xba, 0x8d, 0x70, 0x2c, 0xff, 0x03, 0xc0, 0x27, 0x7b, 0xe6, 0x8f, 0x3a, 0xb6,
+	0x45, 0x09, 0xfa, 0x0e, 0x3c, 0x66, 0x90, 0x8a, 0xcd, 0xab, 0x2a, 0x22, 0x0f, 0x12, 0xf8, 0x44,
+	0x57, 0x69, 0x95, 0xc4, 0xcb, 0x41, 0x9f, 0x08, 0xf1, 0xa1, 0x07, 0x94, 0x81, 0xa3, 0xdb, 0xd8,
+	0xb5, 0x4c, 0xab, 0xc6, 0x93, 0x93, 0xca, 0x8e, 0xe9, 0xad, 0x39, 0x7a, 0x01, 0xa6, 0xc5, 0x53,
+	0x80, 0x5f, 0x41, 0xa9, 0xec, 0x78, 0xe1, 0x74, 0x57, 0xda, 0xb8, 0x75, 0x91, 0x30, 0x6c, 0xd6,
+	0xa9, 0x1e, 0x62, 0xd1, 0x02, 0x1c, 0x8d, 0x1e, 0x41, 0x22, 0x7c, 0xe3, 0x85, 0xe9, 0x2e, 0x31,
+	0x8b, 0x21, 0x40, 0x1b, 0xe5, 0x85, 0x73, 0x47, 0x1c, 0x37, 0xd1, 0xa2, 0xc2, 0xed, 0x34, 0x4c,
+	0x16, 0x29, 0x7a, 0x17, 0xc0, 0x91, 0x65, 0xc2, 0xc4, 0x63, 0x60, 0x2e, 0xee, 0xb9, 0xef, 0x8d,
+	0x95, 0x79, 0xd0, 0xf1, 0x2b, 0x7f, 0xfb, 0x8d, 0xbf, 0xfc, 0xfd, 0x67, 0xc9, 0xaf, 0xa3, 0xaf,
+	0xaa, 0x98, 0x1e, 0x78, 0x77, 0xaa, 0x37, 0x62, 0xcd, 0xa0, 0x1c, 0x9c, 0xdf, 0x52, 0xc5, 0x31,
+	0x7d, 0x07, 0xc0, 0x91, 0x52, 0x3f, 0x5d, 0xa5, 0xa3, 0xeb, 0x2a, 0x0a, 0x5d, 0xdf, 0xcc, 0x1c,
+	0x51, 0xd7, 0x3c, 0xc8, 0xa1, 0x9b, 0x10, 0x2e, 0x92, 0x3a, 0x61, 0x44, 0x88, 0x1b, 0xb0, 0x89,
+	0x33, 0xa7, 0xba, 0xd2, 0x73, 0x9e, 0x3f, 0x52, 0x65, 0x45, 0x08, 0xca, 0xe6, 0x9e, 0x79, 0x90,
+	0xa0, 0x30, 0x30, 0x3f, 0x05, 0xf0, 0xb1, 0x30, 0x61, 0xc1, 0xb5, 0x33, 0xa8, 0x80, 0x33, 0x0f,
+	0x08, 0x8d, 0x60, 0x93, 0x5f, 0x10, 0x72, 0x14, 0x74, 0x76, 0x30, 0x39, 0x2a, 0x15, 0x1a, 0xfe,
+	0x00, 0xe0, 0x64, 0x8f, 0x0e, 0x42, 0xb9, 0xb8, 0xcf, 0xfe, 0xc7, 0x64, 0xe6, 0xd9, 0x81, 0xb0,
+	0x41, 0x4b, 0xca, 0x25, 0x21, 0x73, 0x45, 0xbe, 0x70, 0xf8, 0x34, 0xb6, 0xdf, 0x7c, 0xaa, 0x27,
+	0xd8, 0x55, 0x46, 0x28, 0x9b, 0x07, 0xb9, 0xc2, 0x5f, 0xd3, 0x70, 0xb8, 0xe8, 0x38, 0x45, 0x8a,
+	0xd6, 0xe0, 0x58, 0xc9, 0x5b, 0xa7, 0x15, 0xd7, 0x5c, 0x27, 0x03, 0x07, 0xf8, 0xa9, 0xfb, 0xe0,
+	0x5e, 0x72, 0x9e, 0x03, 0xe8, 0x4f, 0x00, 0x9e, 0x88, 0xae, 0x99, 0xcb, 0x1e, 0xf1, 0xc8, 0xaa,
+	0x47, 0x37, 0x50, 0x57, 0x5e, 0x0e, 0x40, 0xa2, 0xe8, 0xf4, 0x2b, 0x9f, 0xd7, 0x44, 0x20, 0x5c,
+	0xb9, 0xd1, 0x1d, 0x88, 0xf6, 0x8f, 0x90, 0x1e, 0x71, 0xe8, 0x8e, 0x4b, 0x00, 0xed, 0x5e, 0xd7,
+	0x1a, 0xde, 0x52, 0xf9, 0xb5, 0xa7, 0x3a, 0x1e, 0xdd, 0xe0, 0x6d, 0xf0, 0x67, 0x00, 0x4f, 0xc6,
+	0xa4, 0x3a, 0x75, 0x5c, 0x21, 0x0f, 0xb9, 0xa1, 0x1b, 0x62, 0x43, 0x9e, 0xec, 0x3c, 0xb2, 0x0d,
+	0xb9, 0x81, 0x6e, 0xbe, 0xa7, 0xdf, 0xc4, 0x33, 0x74, 0xc9, 0xa4, 0x0c, 0x9d, 0xe9, 0x7b, 0x91,
+	0x0e, 0xda, 0x5f, 0x11, 0x27, 0x95, 0x75, 0xb1, 0xbd, 0x4b, 0xe8, 0xbb, 0x87, 0x2f, 0xdc, 0xd6,
+	0x7e, 0x62, 0x1b, 0x40, 0xbf, 0x04, 0xf0, 0x0b, 0xcb, 0x84, 0xad, 0x5c, 0x5e, 0x5b, 0x3b, 0x67,
+	0x5b, 0x16, 0xa9, 0x88, 0xca, 0xb4, 0xaa, 0xf6, 0xc0, 0xa5, 0x2b, 0x77, 0xfd, 0xec, 0xe9, 0xe2,
+	0x1a, 0xfc, 0x44, 0xbf, 0x25, 0x7e, 0x74, 0xe6, 0x2b, 0xad, 0xe5, 0x79, 0xd3, 0xaa, 0xda, 0x85,
+	0xb7, 0xc6, 0xe0, 0x64, 0x91, 0xb6, 0x42, 0xa7, 0x93, 0x9a, 0x49, 0x99, 0xbb, 0x83, 0x7e, 0x0d,
+	0x60, 0x6a, 0x99, 0x30, 0xf4, 0x74, 0x8f, 0xdb, 0xa7, 0x03, 0x1d, 0x54, 0xcd, 0x74, 0xdf, 0x54,
+	0xc8, 0x9b, 0x42, 0x1f, 0x41, 0x95, 0x47, 0x50, 0x38, 0xe8, 0xcd, 0x24, 0x4c, 0x95, 0x7a, 0x89,
+	0x2e, 0x1d, 0x4e, 0xf4, 0xef, 0x80, 0x50, 0xfd, 0x01, 0xc8, 0xdc, 0x57, 0xb6, 0x72, 0x44, 0xd9,
+	0xca, 0x41, 0xd9, 0xf3, 0x20, 0x77, 0xa5, 0xe7, 0x99, 0x79, 0x34, 0x4f, 0xbc, 0x63, 0xde, 0x05,
+	0x30, 0x1d, 0xdc, 0x86, 0x03, 0xb6, 0x49, 0xbf, 0xbe, 0x5f, 0x11, 0x81, 0x58, 0xce, 0x9d, 0xff,
+	0x8f, 0x34, 0x06, 0xfa, 0x15, 0x80, 0xa3, 0x1a, 0x66, 0x95, 0x0d, 0x5e, 0x5a, 0x5d, 0x0f, 0x88,
+	0xc8, 0xd2, 0x52, 0x48, 0xa3, 0x5c, 0x7d, 0xa9, 0x27, 0xb4, 0x13, 0x17, 0xde, 0x40, 0xdf, 0x13,
+	0x7a, 0x2f, 0xc8, 0xe7, 0x8e, 0xae, 0x77, 0x9d, 0x53, 0xab, 0x35, 0xc2, 0x2f, 0x9f, 0xb6, 0xe0,
+	0x52, 0x5f, 0xc1, 0xa5, 0xff, 0x0d, 0xc1, 0x34, 0x10, 0xfc, 0x01, 0x80, 0xe3, 0xc2, 0x57, 0x98,
+	0xfe, 0xb3, 0x3d, 0x85, 0x04, 0xc6, 0x87, 0x90, 0x7d, 0x59, 0xc8, 0xbe, 0x28, 0x2f, 0x3d, 0xac,
+	0x6c, 0x43, 0x28, 0x99, 0x07, 0x39, 0xed, 0x17, 0xe0, 0xee, 0x9e, 0x04, 0xee, 0xed, 0x49, 0xe0,
+	0xe3, 0x3d, 0x29, 0xf1, 0xc9, 0x9e, 0x94, 0xf8, 0x74, 0x4f, 0x4a, 0x7c, 0xb6, 0x27, 0x25, 0x3e,
+	0xdf, 0x93, 0xc0, 0xeb, 0xbe, 0x04, 0xde, 0xf6, 0xa5, 0xc4, 0x7b, 0xbe, 0x04, 0xde, 0xf7, 0xa5,
+	0xc4, 0x87, 0xbe, 0x94, 0xf8, 0xc8, 0x97, 0x12, 0x77, 0x7d, 0x09, 0xdc, 0xf3, 0x25, 0xf0, 0xb1,
+	0x2f, 0x25, 0x3e, 0xf1, 0x25, 0xf0, 0xa9, 0x2f, 0x25, 0x3e, 0xf3, 0x25, 0xf0, 0xb9, 0x2f, 0x25,
+	0x5e, 0x6f, 0x4a, 0x89, 0xb7, 0x9b, 0x12, 0xb8, 0xdd, 0x94, 0x12, 0x77, 0x9a, 0x12, 0xf8, 0x79,
+	0x53, 0x4a, 0xbc, 0xd7, 0x94, 0x12, 0xef, 0x37, 0x25, 0xf0, 0x61, 0x53, 0x02, 0x1f, 0x35, 0x25,
+	0x70, 0xe5, 0x6c, 0xcd, 0x56, 0xd8, 0x06, 0x61, 0x1b, 0xfc, 0xd9, 0xaf, 0x84, 0xbf, 0x9d, 0xd5,
+	0x83, 0xff, 0xb2, 0x73, 0x36, 0x6b, 0x2a, 0x63, 0x96, 0xb3, 0xbe, 0x9e, 0x16, 0xfd, 0xf1, 0xfc,
+	0xbf, 0x07, 0x00, 0xdd, 0xcf, 0xcb, 0x13, 0xc7, 0x15, 0x00, 0x00,
 }
 
 func (this *ApplicationLink) Equal(that interface{}) bool {
@@ -545,6 +730,88 @@ func (this *ApplicationLinkStats) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *TestUplinkFormatterRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TestUplinkFormatterRequest)
+	if !ok {
+		that2, ok := that.(TestUplinkFormatterRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.ApplicationIdentifiers.Equal(&that1.ApplicationIdentifiers) {
+		return false
+	}
+	if !this.VersionIDs.Equal(that1.VersionIDs) {
+		return false
+	}
+	if this.Formatter != that1.Formatter {
+		return false
+	}
+	if this.FormatterParameter != that1.FormatterParameter {
+		return false
+	}
+	if this.FPort != that1.FPort {
+		return false
+	}
+	if !bytes.Equal(this.FRMPayload, that1.FRMPayload) {
+		return false
+	}
+	return true
+}
+func (this *TestUplinkFormatterResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TestUplinkFormatterResponse)
+	if !ok {
+		that2, ok := that.(TestUplinkFormatterResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.DecodedPayload.Equal(that1.DecodedPayload) {
+		return false
+	}
+	if len(this.Warnings) != len(that1.Warnings) {
+		return false
+	}
+	for i := range this.Warnings {
+		if this.Warnings[i] != that1.Warnings[i] {
+			return false
+		}
+	}
+	if len(this.Errors) != len(that1.Errors) {
+		return false
+	}
+	for i := range this.Errors {
+		if !this.Errors[i].Equal(that1.Errors[i]) {
+			return false
+		}
+	}
+	if this.Duration != that1.Duration {
+		return false
+	}
+	return true
+}
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ context.Context
@@ -568,6 +835,9 @@ type AsClient interface {
 	// This call returns a NotFound error code if there is no link for the given application identifiers.
 	// This call returns the error code of the link error if linking to a Network Server failed.
 	GetLinkStats(ctx context.Context, in *ApplicationIdentifiers, opts ...grpc.CallOption) (*ApplicationLinkStats, error)
+	// TestUplinkFormatter runs the given payload formatter on the given uplink payload.
+	// This call does not return an error if the formatter fails; the errors are part of the response.
+	TestUplinkFormatter(ctx context.Context, in *TestUplinkFormatterRequest, opts ...grpc.CallOption) (*TestUplinkFormatterResponse, error)
 }
 
 type asClient struct {
@@ -614,6 +884,15 @@ func (c *asClient) GetLinkStats(ctx context.Context, in *ApplicationIdentifiers,
 	return out, nil
 }
 
+func (c *asClient) TestUplinkFormatter(ctx context.Context, in *TestUplinkFormatterRequest, opts ...grpc.CallOption) (*TestUplinkFormatterResponse, error) {
+	out := new(TestUplinkFormatterResponse)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.As/TestUplinkFormatter", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AsServer is the server API for As service.
 type AsServer interface {
 	GetLink(context.Context, *GetApplicationLinkRequest) (*ApplicationLink, error)
@@ -626,6 +905,9 @@ type AsServer interface {
 	// This call returns a NotFound error code if there is no link for the given application identifiers.
 	// This call returns the error code of the link error if linking to a Network Server failed.
 	GetLinkStats(context.Context, *ApplicationIdentifiers) (*ApplicationLinkStats, error)
+	// TestUplinkFormatter runs the given payload formatter on the given uplink payload.
+	// This call does not return an error if the formatter fails; the errors are part of the response.
+	TestUplinkFormatter(context.Context, *TestUplinkFormatterRequest) (*TestUplinkFormatterResponse, error)
 }
 
 // UnimplementedAsServer can be embedded to have forward compatible implementations.
@@ -644,6 +926,9 @@ func (*UnimplementedAsServer) DeleteLink(ctx context.Context, req *ApplicationId
 func (*UnimplementedAsServer) GetLinkStats(ctx context.Context, req *ApplicationIdentifiers) (*ApplicationLinkStats, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetLinkStats not implemented")
 }
+func (*UnimplementedAsServer) TestUplinkFormatter(ctx context.Context, req *TestUplinkFormatterRequest) (*TestUplinkFormatterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TestUplinkFormatter not implemented")
+}
 
 func RegisterAsServer(s *grpc.Server, srv AsServer) {
 	s.RegisterService(&_As_serviceDesc, srv)
@@ -721,6 +1006,24 @@ func _As_GetLinkStats_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _As_TestUplinkFormatter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TestUplinkFormatterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AsServer).TestUplinkFormatter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.As/TestUplinkFormatter",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AsServer).TestUplinkFormatter(ctx, req.(*TestUplinkFormatterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _As_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ttn.lorawan.v3.As",
 	HandlerType: (*AsServer)(nil),
@@ -741,6 +1044,10 @@ var _As_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetLinkStats",
 			Handler:    _As_GetLinkStats_Handler,
 		},
+		{
+			MethodName: "TestUplinkFormatter",
+			Handler:    _As_TestUplinkFormatter_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "lorawan-stack/api/applicationserver.proto",
@@ -1002,6 +1309,15 @@ type AsEndDeviceRegistryClient interface {
 	// Delete deletes the device that matches the given identifiers.
 	// If there are multiple matches, an error will be returned.
 	Delete(ctx context.Context, in *EndDeviceIdentifiers, opts ...grpc.CallOption) (*types.Empty, error)
+	// BatchGet returns up to 100 devices of an application.
+	// The results contain the device or the error for each request.
+	BatchGet(ctx context.Context, in *BatchGetEndDevicesRequest, opts ...grpc.CallOption) (*BatchEndDevicesResponse, error)
+	// BatchSet creates or updates up to 100 devices of an application.
+	// The results contain the device or the error for each request.
+	BatchSet(ctx context.Context, in *BatchSetEndDevicesRequest, opts ...grpc.CallOption) (*BatchEndDevicesResponse, error)
+	// BatchDelete deletes up to 100 devices of an application.
+	// The results contain the error for each request that failed.
+	BatchDelete(ctx context.Context, in *BatchDeleteEndDevicesRequest, opts ...grpc.CallOption) (*BatchEndDevicesResponse, error)
 }
 
 type asEndDeviceRegistryClient struct {
@@ -1039,6 +1355,33 @@ func (c *asEndDeviceRegistryClient) Delete(ctx context.Context, in *EndDeviceIde
 	return out, nil
 }
 
+func (c *asEndDeviceRegistryClient) BatchGet(ctx context.Context, in *BatchGetEndDevicesRequest, opts ...grpc.CallOption) (*BatchEndDevicesResponse, error) {
+	out := new(BatchEndDevicesResponse)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.AsEndDeviceRegistry/BatchGet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *asEndDeviceRegistryClient) BatchSet(ctx context.Context, in *BatchSetEndDevicesRequest, opts ...grpc.CallOption) (*BatchEndDevicesResponse, error) {
+	out := new(BatchEndDevicesResponse)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.AsEndDeviceRegistry/BatchSet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *asEndDeviceRegistryClient) BatchDelete(ctx context.Context, in *BatchDeleteEndDevicesRequest, opts ...grpc.CallOption) (*BatchEndDevicesResponse, error) {
+	out := new(BatchEndDevicesResponse)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.AsEndDeviceRegistry/BatchDelete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AsEndDeviceRegistryServer is the server API for AsEndDeviceRegistry service.
 type AsEndDeviceRegistryServer interface {
 	// Get returns the device that matches the given identifiers.
@@ -1049,6 +1392,15 @@ type AsEndDeviceRegistryServer interface {
 	// Delete deletes the device that matches the given identifiers.
 	// If there are multiple matches, an error will be returned.
 	Delete(context.Context, *EndDeviceIdentifiers) (*types.Empty, error)
+	// BatchGet returns up to 100 devices of an application.
+	// The results contain the device or the error for each request.
+	BatchGet(context.Context, *BatchGetEndDevicesRequest) (*BatchEndDevicesResponse, error)
+	// BatchSet creates or updates up to 100 devices of an application.
+	// The results contain the device or the error for each request.
+	BatchSet(context.Context, *BatchSetEndDevicesRequest) (*BatchEndDevicesResponse, error)
+	// BatchDelete deletes up to 100 devices of an application.
+	// The results contain the error for each request that failed.
+	BatchDelete(context.Context, *BatchDeleteEndDevicesRequest) (*BatchEndDevicesResponse, error)
 }
 
 // UnimplementedAsEndDeviceRegistryServer can be embedded to have forward compatible implementations.
@@ -1064,6 +1416,15 @@ func (*UnimplementedAsEndDeviceRegistryServer) Set(ctx context.Context, req *Set
 func (*UnimplementedAsEndDeviceRegistryServer) Delete(ctx context.Context, req *EndDeviceIdentifiers) (*types.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
 }
+func (*UnimplementedAsEndDeviceRegistryServer) BatchGet(ctx context.Context, req *BatchGetEndDevicesRequest) (*BatchEndDevicesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchGet not implemented")
+}
+func (*UnimplementedAsEndDeviceRegistryServer) BatchSet(ctx context.Context, req *BatchSetEndDevicesRequest) (*BatchEndDevicesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchSet not implemented")
+}
+func (*UnimplementedAsEndDeviceRegistryServer) BatchDelete(ctx context.Context, req *BatchDeleteEndDevicesRequest) (*BatchEndDevicesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchDelete not implemented")
+}
 
 func RegisterAsEndDeviceRegistryServer(s *grpc.Server, srv AsEndDeviceRegistryServer) {
 	s.RegisterService(&_AsEndDeviceRegistry_serviceDesc, srv)
@@ -1123,6 +1484,60 @@ func _AsEndDeviceRegistry_Delete_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AsEndDeviceRegistry_BatchGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchGetEndDevicesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AsEndDeviceRegistryServer).BatchGet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.AsEndDeviceRegistry/BatchGet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AsEndDeviceRegistryServer).BatchGet(ctx, req.(*BatchGetEndDevicesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AsEndDeviceRegistry_BatchSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchSetEndDevicesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AsEndDeviceRegistryServer).BatchSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.AsEndDeviceRegistry/BatchSet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AsEndDeviceRegistryServer).BatchSet(ctx, req.(*BatchSetEndDevicesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AsEndDeviceRegistry_BatchDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchDeleteEndDevicesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AsEndDeviceRegistryServer).BatchDelete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.AsEndDeviceRegistry/BatchDelete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AsEndDeviceRegistryServer).BatchDelete(ctx, req.(*BatchDeleteEndDevicesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _AsEndDeviceRegistry_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ttn.lorawan.v3.AsEndDeviceRegistry",
 	HandlerType: (*AsEndDeviceRegistryServer)(nil),
@@ -1139,6 +1554,18 @@ var _AsEndDeviceRegistry_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Delete",
 			Handler:    _AsEndDeviceRegistry_Delete_Handler,
 		},
+		{
+			MethodName: "BatchGet",
+			Handler:    _AsEndDeviceRegistry_BatchGet_Handler,
+		},
+		{
+			MethodName: "BatchSet",
+			Handler:    _AsEndDeviceRegistry_BatchSet_Handler,
+		},
+		{
+			MethodName: "BatchDelete",
+			Handler:    _AsEndDeviceRegistry_BatchDelete_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "lorawan-stack/api/applicationserver.proto",
@@ -1369,18 +1796,153 @@ func (m *ApplicationLinkStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintApplicationserver(dAtA []byte, offset int, v uint64) int {
-	offset -= sovApplicationserver(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *TestUplinkFormatterRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func NewPopulatedApplicationLink(r randyApplicationserver, easy bool) *ApplicationLink {
+
+func (m *TestUplinkFormatterRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TestUplinkFormatterRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FRMPayload) > 0 {
+		i -= len(m.FRMPayload)
+		copy(dAtA[i:], m.FRMPayload)
+		i = encodeVarintApplicationserver(dAtA, i, uint64(len(m.FRMPayload)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.FPort != 0 {
+		i = encodeVarintApplicationserver(dAtA, i, uint64(m.FPort))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.FormatterParameter) > 0 {
+		i -= len(m.FormatterParameter)
+		copy(dAtA[i:], m.FormatterParameter)
+		i = encodeVarintApplicationserver(dAtA, i, uint64(len(m.FormatterParameter)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Formatter != 0 {
+		i = encodeVarintApplicationserver(dAtA, i, uint64(m.Formatter))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.VersionIDs != nil {
+		{
+			size, err := m.VersionIDs.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApplicationserver(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.ApplicationIdentifiers.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintApplicationserver(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *TestUplinkFormatterResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TestUplinkFormatterResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TestUplinkFormatterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n12, err12 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration):])
+	if err12 != nil {
+		return 0, err12
+	}
+	i -= n12
+	i = encodeVarintApplicationserver(dAtA, i, uint64(n12))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Errors) > 0 {
+		for iNdEx := len(m.Errors) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Errors[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintApplicationserver(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Warnings) > 0 {
+		for iNdEx := len(m.Warnings) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Warnings[iNdEx])
+			copy(dAtA[i:], m.Warnings[iNdEx])
+			i = encodeVarintApplicationserver(dAtA, i, uint64(len(m.Warnings[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.DecodedPayload != nil {
+		{
+			size, err := m.DecodedPayload.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApplicationserver(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintApplicationserver(dAtA []byte, offset int, v uint64) int {
+	offset -= sovApplicationserver(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func NewPopulatedApplicationLink(r randyApplicationserver, easy bool) *ApplicationLink {
 	this := &ApplicationLink{}
 	this.NetworkServerAddress = randStringApplicationserver(r)
 	this.APIKey = randStringApplicationserver(r)
@@ -1436,6 +1998,50 @@ func NewPopulatedApplicationLinkStats(r randyApplicationserver, easy bool) *Appl
 	return this
 }
 
+func NewPopulatedTestUplinkFormatterRequest(r randyApplicationserver, easy bool) *TestUplinkFormatterRequest {
+	this := &TestUplinkFormatterRequest{}
+	v6 := NewPopulatedApplicationIdentifiers(r, easy)
+	this.ApplicationIdentifiers = *v6
+	if r.Intn(5) != 0 {
+		this.VersionIDs = NewPopulatedEndDeviceVersionIdentifiers(r, easy)
+	}
+	this.Formatter = PayloadFormatter([]int32{0, 1, 2, 3, 4, 5}[r.Intn(6)])
+	this.FormatterParameter = randStringApplicationserver(r)
+	this.FPort = r.Uint32()
+	v7 := r.Intn(100)
+	this.FRMPayload = make([]byte, v7)
+	for i := 0; i < v7; i++ {
+		this.FRMPayload[i] = byte(r.Intn(256))
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedTestUplinkFormatterResponse(r randyApplicationserver, easy bool) *TestUplinkFormatterResponse {
+	this := &TestUplinkFormatterResponse{}
+	if r.Intn(5) != 0 {
+		this.DecodedPayload = types.NewPopulatedStruct(r, easy)
+	}
+	v8 := r.Intn(10)
+	this.Warnings = make([]string, v8)
+	for i := 0; i < v8; i++ {
+		this.Warnings[i] = randStringApplicationserver(r)
+	}
+	if r.Intn(5) == 0 {
+		v9 := r.Intn(5)
+		this.Errors = make([]*ErrorDetails, v9)
+		for i := 0; i < v9; i++ {
+			this.Errors[i] = NewPopulatedErrorDetails(r, easy)
+		}
+	}
+	v10 := github_com_gogo_protobuf_types.NewPopulatedStdDuration(r, easy)
+	this.Duration = *v10
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
 type randyApplicationserver interface {
 	Float32() float32
 	Float64() float64
@@ -1455,9 +2061,9 @@ func randUTF8RuneApplicationserver(r randyApplicationserver) rune {
 	return rune(ru + 61)
 }
 func randStringApplicationserver(r randyApplicationserver) string {
-	v6 := r.Intn(100)
-	tmps := make([]rune, v6)
-	for i := 0; i < v6; i++ {
+	v11 := r.Intn(100)
+	tmps := make([]rune, v11)
+	for i := 0; i < v11; i++ {
 		tmps[i] = randUTF8RuneApplicationserver(r)
 	}
 	return string(tmps)
@@ -1479,11 +2085,11 @@ func randFieldApplicationserver(dAtA []byte, r randyApplicationserver, fieldNumb
 	switch wire {
 	case 0:
 		dAtA = encodeVarintPopulateApplicationserver(dAtA, uint64(key))
-		v7 := r.Int63()
+		v12 := r.Int63()
 		if r.Intn(2) == 0 {
-			v7 *= -1
+			v12 *= -1
 		}
-		dAtA = encodeVarintPopulateApplicationserver(dAtA, uint64(v7))
+		dAtA = encodeVarintPopulateApplicationserver(dAtA, uint64(v12))
 	case 1:
 		dAtA = encodeVarintPopulateApplicationserver(dAtA, uint64(key))
 		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
@@ -1591,6 +2197,62 @@ func (m *ApplicationLinkStats) Size() (n int) {
 	return n
 }
 
+func (m *TestUplinkFormatterRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ApplicationIdentifiers.Size()
+	n += 1 + l + sovApplicationserver(uint64(l))
+	if m.VersionIDs != nil {
+		l = m.VersionIDs.Size()
+		n += 1 + l + sovApplicationserver(uint64(l))
+	}
+	if m.Formatter != 0 {
+		n += 1 + sovApplicationserver(uint64(m.Formatter))
+	}
+	l = len(m.FormatterParameter)
+	if l > 0 {
+		n += 1 + l + sovApplicationserver(uint64(l))
+	}
+	if m.FPort != 0 {
+		n += 1 + sovApplicationserver(uint64(m.FPort))
+	}
+	l = len(m.FRMPayload)
+	if l > 0 {
+		n += 1 + l + sovApplicationserver(uint64(l))
+	}
+	return n
+}
+
+func (m *TestUplinkFormatterResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DecodedPayload != nil {
+		l = m.DecodedPayload.Size()
+		n += 1 + l + sovApplicationserver(uint64(l))
+	}
+	if len(m.Warnings) > 0 {
+		for _, s := range m.Warnings {
+			l = len(s)
+			n += 1 + l + sovApplicationserver(uint64(l))
+		}
+	}
+	if len(m.Errors) > 0 {
+		for _, e := range m.Errors {
+			l = e.Size()
+			n += 1 + l + sovApplicationserver(uint64(l))
+		}
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration)
+	n += 1 + l + sovApplicationserver(uint64(l))
+	return n
+}
+
 func sovApplicationserver(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1648,6 +2310,39 @@ func (this *ApplicationLinkStats) String() string {
 	}, "")
 	return s
 }
+func (this *TestUplinkFormatterRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TestUplinkFormatterRequest{`,
+		`ApplicationIdentifiers:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ApplicationIdentifiers), "ApplicationIdentifiers", "ApplicationIdentifiers", 1), `&`, ``, 1) + `,`,
+		`VersionIDs:` + strings.Replace(fmt.Sprintf("%v", this.VersionIDs), "EndDeviceVersionIdentifiers", "EndDeviceVersionIdentifiers", 1) + `,`,
+		`Formatter:` + fmt.Sprintf("%v", this.Formatter) + `,`,
+		`FormatterParameter:` + fmt.Sprintf("%v", this.FormatterParameter) + `,`,
+		`FPort:` + fmt.Sprintf("%v", this.FPort) + `,`,
+		`FRMPayload:` + fmt.Sprintf("%v", this.FRMPayload) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *TestUplinkFormatterResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForErrors := "[]*ErrorDetails{"
+	for _, f := range this.Errors {
+		repeatedStringForErrors += strings.Replace(fmt.Sprintf("%v", f), "ErrorDetails", "ErrorDetails", 1) + ","
+	}
+	repeatedStringForErrors += "}"
+	s := strings.Join([]string{`&TestUplinkFormatterResponse{`,
+		`DecodedPayload:` + strings.Replace(fmt.Sprintf("%v", this.DecodedPayload), "Struct", "types.Struct", 1) + `,`,
+		`Warnings:` + fmt.Sprintf("%v", this.Warnings) + `,`,
+		`Errors:` + repeatedStringForErrors + `,`,
+		`Duration:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Duration), "Duration", "types.Duration", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func valueToStringApplicationserver(v interface{}) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
@@ -2331,6 +3026,420 @@ func (m *ApplicationLinkStats) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *TestUplinkFormatterRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplicationserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TestUplinkFormatterRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TestUplinkFormatterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationIdentifiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ApplicationIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VersionIDs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.VersionIDs == nil {
+				m.VersionIDs = &EndDeviceVersionIdentifiers{}
+			}
+			if err := m.VersionIDs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Formatter", wireType)
+			}
+			m.Formatter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Formatter |= PayloadFormatter(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FormatterParameter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FormatterParameter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FPort", wireType)
+			}
+			m.FPort = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FPort |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FRMPayload", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FRMPayload = append(m.FRMPayload[:0], dAtA[iNdEx:postIndex]...)
+			if m.FRMPayload == nil {
+				m.FRMPayload = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplicationserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TestUplinkFormatterResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplicationserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TestUplinkFormatterResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TestUplinkFormatterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecodedPayload", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DecodedPayload == nil {
+				m.DecodedPayload = &types.Struct{}
+			}
+			if err := m.DecodedPayload.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Warnings", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Warnings = append(m.Warnings, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Errors", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Errors = append(m.Errors, &ErrorDetails{})
+			if err := m.Errors[len(m.Errors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Duration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplicationserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipApplicationserver(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
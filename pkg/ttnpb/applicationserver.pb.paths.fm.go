@@ -66,3 +66,38 @@ var ApplicationLinkStatsFieldPathsTopLevel = []string{
 	"network_server_address",
 	"up_count",
 }
+var TestUplinkFormatterRequestFieldPathsNested = []string{
+	"application_ids",
+	"application_ids.application_id",
+	"f_port",
+	"formatter",
+	"formatter_parameter",
+	"frm_payload",
+	"version_ids",
+	"version_ids.brand_id",
+	"version_ids.firmware_version",
+	"version_ids.hardware_version",
+	"version_ids.model_id",
+}
+
+var TestUplinkFormatterRequestFieldPathsTopLevel = []string{
+	"application_ids",
+	"f_port",
+	"formatter",
+	"formatter_parameter",
+	"frm_payload",
+	"version_ids",
+}
+var TestUplinkFormatterResponseFieldPathsNested = []string{
+	"decoded_payload",
+	"duration",
+	"errors",
+	"warnings",
+}
+
+var TestUplinkFormatterResponseFieldPathsTopLevel = []string{
+	"decoded_payload",
+	"duration",
+	"errors",
+	"warnings",
+}
@@ -4,6 +4,7 @@ package ttnpb
 
 import (
 	fmt "fmt"
+	time "time"
 
 	types "github.com/gogo/protobuf/types"
 )
@@ -237,3 +238,144 @@ func (dst *ApplicationLinkStats) SetFields(src *ApplicationLinkStats, paths ...s
 	}
 	return nil
 }
+
+func (dst *TestUplinkFormatterRequest) SetFields(src *TestUplinkFormatterRequest, paths ...string) error {
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		switch name {
+		case "application_ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *ApplicationIdentifiers
+				if src != nil {
+					newSrc = &src.ApplicationIdentifiers
+				}
+				newDst = &dst.ApplicationIdentifiers
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.ApplicationIdentifiers = src.ApplicationIdentifiers
+				} else {
+					var zero ApplicationIdentifiers
+					dst.ApplicationIdentifiers = zero
+				}
+			}
+		case "version_ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *EndDeviceVersionIdentifiers
+				if (src == nil || src.VersionIDs == nil) && dst.VersionIDs == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.VersionIDs
+				}
+				if dst.VersionIDs != nil {
+					newDst = dst.VersionIDs
+				} else {
+					newDst = &EndDeviceVersionIdentifiers{}
+					dst.VersionIDs = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.VersionIDs = src.VersionIDs
+				} else {
+					dst.VersionIDs = nil
+				}
+			}
+		case "formatter":
+			if len(subs) > 0 {
+				return fmt.Errorf("'formatter' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Formatter = src.Formatter
+			} else {
+				var zero PayloadFormatter
+				dst.Formatter = zero
+			}
+		case "formatter_parameter":
+			if len(subs) > 0 {
+				return fmt.Errorf("'formatter_parameter' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.FormatterParameter = src.FormatterParameter
+			} else {
+				var zero string
+				dst.FormatterParameter = zero
+			}
+		case "f_port":
+			if len(subs) > 0 {
+				return fmt.Errorf("'f_port' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.FPort = src.FPort
+			} else {
+				var zero uint32
+				dst.FPort = zero
+			}
+		case "frm_payload":
+			if len(subs) > 0 {
+				return fmt.Errorf("'frm_payload' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.FRMPayload = src.FRMPayload
+			} else {
+				dst.FRMPayload = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *TestUplinkFormatterResponse) SetFields(src *TestUplinkFormatterResponse, paths ...string) error {
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		switch name {
+		case "decoded_payload":
+			if len(subs) > 0 {
+				return fmt.Errorf("'decoded_payload' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.DecodedPayload = src.DecodedPayload
+			} else {
+				dst.DecodedPayload = nil
+			}
+		case "warnings":
+			if len(subs) > 0 {
+				return fmt.Errorf("'warnings' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Warnings = src.Warnings
+			} else {
+				dst.Warnings = nil
+			}
+		case "errors":
+			if len(subs) > 0 {
+				return fmt.Errorf("'errors' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Errors = src.Errors
+			} else {
+				dst.Errors = nil
+			}
+		case "duration":
+			if len(subs) > 0 {
+				return fmt.Errorf("'duration' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Duration = src.Duration
+			} else {
+				var zero time.Duration
+				dst.Duration = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
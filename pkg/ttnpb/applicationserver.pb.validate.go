@@ -503,3 +503,263 @@ var _ interface {
 } = ApplicationLinkStatsValidationError{}
 
 var _ApplicationLinkStats_NetworkServerAddress_Pattern = regexp.MustCompile("^(?:(?:[a-zA-Z0-9]|[a-zA-Z0-9][a-zA-Z0-9\\-]*[a-zA-Z0-9])\\.)*(?:[A-Za-z0-9]|[A-Za-z0-9][A-Za-z0-9\\-]*[A-Za-z0-9])(?::[0-9]{1,5})?$|^$")
+
+// ValidateFields checks the field values on TestUplinkFormatterRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, an error is returned.
+func (m *TestUplinkFormatterRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = TestUplinkFormatterRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "application_ids":
+
+			if v, ok := interface{}(&m.ApplicationIdentifiers).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return TestUplinkFormatterRequestValidationError{
+						field:  "application_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "version_ids":
+
+			if v, ok := interface{}(m.GetVersionIDs()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return TestUplinkFormatterRequestValidationError{
+						field:  "version_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "formatter":
+
+			if _, ok := PayloadFormatter_name[int32(m.GetFormatter())]; !ok {
+				return TestUplinkFormatterRequestValidationError{
+					field:  "formatter",
+					reason: "value must be one of the defined enum values",
+				}
+			}
+
+		case "formatter_parameter":
+			// no validation rules for FormatterParameter
+		case "f_port":
+
+			if val := m.GetFPort(); val < 1 || val > 255 {
+				return TestUplinkFormatterRequestValidationError{
+					field:  "f_port",
+					reason: "value must be inside range [1, 255]",
+				}
+			}
+
+		case "frm_payload":
+
+			if len(m.GetFRMPayload()) > 250 {
+				return TestUplinkFormatterRequestValidationError{
+					field:  "frm_payload",
+					reason: "value length must be at most 250 bytes",
+				}
+			}
+
+		default:
+			return TestUplinkFormatterRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// TestUplinkFormatterRequestValidationError is the validation error returned
+// by TestUplinkFormatterRequest.ValidateFields if the designated constraints
+// aren't met.
+type TestUplinkFormatterRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TestUplinkFormatterRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TestUplinkFormatterRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TestUplinkFormatterRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TestUplinkFormatterRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TestUplinkFormatterRequestValidationError) ErrorName() string {
+	return "TestUplinkFormatterRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TestUplinkFormatterRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTestUplinkFormatterRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TestUplinkFormatterRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TestUplinkFormatterRequestValidationError{}
+
+// ValidateFields checks the field values on TestUplinkFormatterResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, an error is returned.
+func (m *TestUplinkFormatterResponse) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = TestUplinkFormatterResponseFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "decoded_payload":
+
+			if v, ok := interface{}(m.GetDecodedPayload()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return TestUplinkFormatterResponseValidationError{
+						field:  "decoded_payload",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "warnings":
+
+		case "errors":
+
+			for idx, item := range m.GetErrors() {
+				_, _ = idx, item
+
+				if v, ok := interface{}(item).(interface{ ValidateFields(...string) error }); ok {
+					if err := v.ValidateFields(subs...); err != nil {
+						return TestUplinkFormatterResponseValidationError{
+							field:  fmt.Sprintf("errors[%v]", idx),
+							reason: "embedded message failed validation",
+							cause:  err,
+						}
+					}
+				}
+
+			}
+
+		case "duration":
+
+			if v, ok := interface{}(&m.Duration).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return TestUplinkFormatterResponseValidationError{
+						field:  "duration",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		default:
+			return TestUplinkFormatterResponseValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// TestUplinkFormatterResponseValidationError is the validation error returned
+// by TestUplinkFormatterResponse.ValidateFields if the designated constraints
+// aren't met.
+type TestUplinkFormatterResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TestUplinkFormatterResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TestUplinkFormatterResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TestUplinkFormatterResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TestUplinkFormatterResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TestUplinkFormatterResponseValidationError) ErrorName() string {
+	return "TestUplinkFormatterResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TestUplinkFormatterResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTestUplinkFormatterResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TestUplinkFormatterResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TestUplinkFormatterResponseValidationError{}
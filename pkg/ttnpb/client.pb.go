@@ -45,18 +45,22 @@ const (
 	GRANT_PASSWORD GrantType = 1
 	// Grant type used to exchange a refresh token for an access token.
 	GRANT_REFRESH_TOKEN GrantType = 2
+	// Grant type used to exchange a device code for an access token, as specified in RFC 8628.
+	GRANT_DEVICE_CODE GrantType = 3
 )
 
 var GrantType_name = map[int32]string{
 	0: "GRANT_AUTHORIZATION_CODE",
 	1: "GRANT_PASSWORD",
 	2: "GRANT_REFRESH_TOKEN",
+	3: "GRANT_DEVICE_CODE",
 }
 
 var GrantType_value = map[string]int32{
 	"GRANT_AUTHORIZATION_CODE": 0,
 	"GRANT_PASSWORD":           1,
 	"GRANT_REFRESH_TOKEN":      2,
+	"GRANT_DEVICE_CODE":        3,
 }
 
 func (GrantType) EnumDescriptor() ([]byte, []int) {
@@ -321,7 +325,9 @@ type ListClientsRequest struct {
 	// Limit the number of results per page.
 	Limit uint32 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
 	// Page number for pagination. 0 is interpreted as 1.
-	Page                 uint32   `protobuf:"varint,5,opt,name=page,proto3" json:"page,omitempty"`
+	Page uint32 `protobuf:"varint,5,opt,name=page,proto3" json:"page,omitempty"`
+	// Only return recently deleted clients. This is only allowed for admins.
+	Deleted              bool     `protobuf:"varint,6,opt,name=deleted,proto3" json:"deleted,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
@@ -393,6 +399,13 @@ func (m *ListClientsRequest) GetPage() uint32 {
 	return 0
 }
 
+func (m *ListClientsRequest) GetDeleted() bool {
+	if m != nil {
+		return m.Deleted
+	}
+	return false
+}
+
 type CreateClientRequest struct {
 	Client `protobuf:"bytes,1,opt,name=client,proto3,embedded=client" json:"client"`
 	// Collaborator to grant all rights on the newly created client.
@@ -658,84 +671,85 @@ func init() {
 }
 
 var fileDescriptor_c5f33a3b812bf10c = []byte{
-	// 1219 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0x4f, 0x6c, 0x13, 0xc7,
-	0x17, 0xde, 0xb1, 0x63, 0x27, 0x9e, 0xfc, 0xc1, 0xbf, 0xe1, 0x57, 0xba, 0x0d, 0xe9, 0xc4, 0x75,
-	0x23, 0x64, 0x10, 0xb6, 0x91, 0x11, 0x52, 0x4b, 0xff, 0x04, 0x6f, 0x08, 0x10, 0xb5, 0x8d, 0xdb,
-	0x49, 0xa2, 0x4a, 0x50, 0x6a, 0x4d, 0xec, 0xc9, 0x66, 0x64, 0x7b, 0xd7, 0x9d, 0x19, 0x87, 0x86,
-	0xaa, 0x12, 0xea, 0x09, 0xf5, 0x84, 0x7a, 0x42, 0x3d, 0x55, 0xed, 0xa1, 0x1c, 0x39, 0xe6, 0x88,
-	0x7a, 0xe2, 0x98, 0x5b, 0x39, 0x51, 0xbc, 0xbe, 0xe4, 0xc8, 0x11, 0xe5, 0x54, 0xed, 0xec, 0x3a,
-	0x76, 0xe2, 0x80, 0xd4, 0x02, 0x3d, 0x79, 0xde, 0xbc, 0xef, 0xbd, 0xf9, 0xe6, 0xcd, 0xf7, 0xde,
-	0x1a, 0xe2, 0xba, 0x2b, 0xe8, 0x0d, 0xea, 0x64, 0xa5, 0xa2, 0x95, 0x5a, 0x9e, 0x36, 0x79, 0xbe,
-	0x52, 0xe7, 0xcc, 0x51, 0xb9, 0xa6, 0x70, 0x95, 0x8b, 0x26, 0x94, 0x72, 0x72, 0x21, 0x26, 0xb7,
-	0x71, 0x76, 0xb2, 0x68, 0x73, 0xb5, 0xde, 0x5a, 0xcd, 0x55, 0xdc, 0x46, 0x9e, 0x39, 0x1b, 0xee,
-	0x66, 0x53, 0xb8, 0xdf, 0x6e, 0xe6, 0x35, 0xb8, 0x92, 0xb5, 0x99, 0x93, 0xdd, 0xa0, 0x75, 0x5e,
-	0xa5, 0x8a, 0xe5, 0x07, 0x16, 0x41, 0xca, 0xc9, 0x6c, 0x5f, 0x0a, 0xdb, 0xb5, 0xdd, 0x20, 0x78,
-	0xb5, 0xb5, 0xa6, 0x2d, 0x6d, 0xe8, 0x55, 0x08, 0x4f, 0xd9, 0xae, 0x6b, 0xd7, 0x59, 0x0f, 0xb5,
-	0xc6, 0x59, 0xbd, 0x5a, 0x6e, 0x50, 0x59, 0x0b, 0x11, 0xd3, 0x07, 0x11, 0x8a, 0x37, 0x98, 0x54,
-	0xb4, 0xd1, 0x0c, 0x01, 0x33, 0x87, 0x5c, 0xd2, 0x75, 0x14, 0xad, 0xa8, 0x32, 0x77, 0xd6, 0xba,
-	0x07, 0xbd, 0x3d, 0x88, 0x62, 0x4e, 0xab, 0x21, 0x43, 0xf7, 0xbb, 0x83, 0x6e, 0x5e, 0x65, 0x8e,
-	0xe2, 0x6b, 0x9c, 0x89, 0x2e, 0xe8, 0x90, 0x72, 0x0a, 0x6e, 0xaf, 0xab, 0xd0, 0x9f, 0xde, 0x89,
-	0xc3, 0xf8, 0x9c, 0xae, 0x2f, 0x9a, 0x87, 0x51, 0x5e, 0x95, 0x26, 0x48, 0x81, 0xcc, 0x68, 0xe1,
-	0x9d, 0xdc, 0xfe, 0x3a, 0xe7, 0x02, 0xd0, 0x42, 0xef, 0x00, 0x2b, 0xb9, 0x6b, 0xc5, 0x7e, 0x04,
-	0x91, 0x24, 0x78, 0xf8, 0x78, 0xda, 0xd8, 0x7e, 0x3c, 0x0d, 0x88, 0x1f, 0x8f, 0xe6, 0x20, 0xac,
-	0x08, 0x46, 0x15, 0xab, 0x96, 0xa9, 0x32, 0x23, 0x3a, 0xdb, 0x64, 0x2e, 0xa8, 0x48, 0xae, 0x5b,
-	0x91, 0xdc, 0x72, 0xb7, 0x22, 0xd6, 0x88, 0x1f, 0x7e, 0xe7, 0xaf, 0x69, 0x40, 0x12, 0x61, 0x5c,
-	0x51, 0xf9, 0x49, 0x5a, 0xcd, 0x6a, 0x37, 0x49, 0xf4, 0x9f, 0x24, 0x09, 0xe3, 0x8a, 0x0a, 0x1d,
-	0x87, 0x43, 0x0e, 0x6d, 0x30, 0x73, 0x28, 0x05, 0x32, 0x09, 0x6b, 0x78, 0xd7, 0x1a, 0x12, 0x11,
-	0xb3, 0x40, 0xf4, 0x26, 0x3a, 0x05, 0x47, 0xab, 0x4c, 0x56, 0x04, 0x6f, 0x2a, 0xee, 0x3a, 0x66,
-	0x4c, 0x63, 0x46, 0x76, 0xad, 0x98, 0x88, 0x9a, 0xdb, 0x47, 0x48, 0xbf, 0x13, 0x29, 0x08, 0xa9,
-	0x52, 0x82, 0xaf, 0xb6, 0x14, 0x93, 0x66, 0x3c, 0x15, 0xcd, 0x8c, 0x16, 0x4e, 0x1c, 0x5e, 0xa0,
-	0x5c, 0x71, 0x0f, 0x38, 0xef, 0x28, 0xb1, 0x69, 0x9d, 0xde, 0xb5, 0x4e, 0xfe, 0x0c, 0x4e, 0xa4,
-	0x67, 0x44, 0xda, 0x9c, 0x29, 0xe0, 0xaf, 0xaf, 0xd1, 0xec, 0xcd, 0x33, 0xd9, 0xf7, 0xaf, 0x67,
-	0x66, 0xcf, 0x5f, 0xcb, 0x5e, 0x9f, 0xed, 0x9a, 0x27, 0xbf, 0x2b, 0x9c, 0xfe, 0x7e, 0x86, 0xf4,
-	0x9d, 0x83, 0x3e, 0x86, 0x63, 0xfd, 0xa2, 0x30, 0x87, 0xf5, 0xb9, 0xc7, 0x07, 0xce, 0x0d, 0x30,
-	0x0b, 0xce, 0x9a, 0x4b, 0x46, 0x2b, 0x3d, 0x03, 0x1d, 0x83, 0x71, 0xc9, 0x2a, 0x82, 0x29, 0x73,
-	0xc4, 0xbf, 0x1c, 0x09, 0x2d, 0x74, 0x0e, 0x8e, 0x0b, 0x56, 0xe5, 0x82, 0x55, 0x54, 0xb9, 0x25,
-	0xb8, 0x34, 0x13, 0xa9, 0x68, 0x26, 0x61, 0x25, 0xbd, 0xc7, 0xd3, 0x63, 0x24, 0x74, 0xac, 0x90,
-	0x05, 0x49, 0xc6, 0xba, 0xb0, 0x15, 0xc1, 0x25, 0x3a, 0x07, 0x63, 0x52, 0x51, 0xc5, 0x4c, 0x98,
-	0x02, 0x99, 0x89, 0xc2, 0x1b, 0x07, 0x79, 0x2c, 0xf9, 0x4e, 0x5d, 0xc1, 0x1f, 0x7c, 0x51, 0x90,
-	0x00, 0x8d, 0xb2, 0x10, 0xc9, 0x1a, 0x6f, 0x96, 0x69, 0x4b, 0xad, 0xbb, 0x82, 0xdf, 0xa4, 0xba,
-	0xdc, 0xa3, 0x29, 0x90, 0x19, 0x21, 0xff, 0xf3, 0x3d, 0xc5, 0x7e, 0x07, 0x9a, 0x84, 0x23, 0xcc,
-	0xa9, 0xba, 0x42, 0xb2, 0xaa, 0x39, 0xa6, 0x41, 0x7b, 0x36, 0xba, 0x00, 0xe3, 0xb6, 0xa0, 0x8e,
-	0x92, 0xe6, 0x78, 0x2a, 0x9a, 0x99, 0x28, 0xbc, 0x75, 0x90, 0xc2, 0x65, 0xdf, 0xbb, 0xbc, 0xd9,
-	0x64, 0xd6, 0xf8, 0xae, 0x05, 0x7f, 0x02, 0xc3, 0xe9, 0x90, 0x4b, 0x18, 0x87, 0x3e, 0x84, 0xf1,
-	0x40, 0xfd, 0xe6, 0x84, 0xce, 0x30, 0x70, 0x09, 0xe2, 0x7b, 0x07, 0xa2, 0x83, 0x98, 0xc9, 0x8f,
-	0xe0, 0x91, 0x03, 0xaf, 0x8b, 0x92, 0x30, 0x5a, 0x63, 0x9b, 0xba, 0x67, 0x12, 0xc4, 0x5f, 0xa2,
-	0xff, 0xc3, 0xd8, 0x06, 0xad, 0xb7, 0x98, 0x56, 0x7e, 0x82, 0x04, 0xc6, 0xf9, 0xc8, 0x7b, 0x20,
-	0xfd, 0x01, 0x1c, 0x0e, 0x34, 0x22, 0xd1, 0x19, 0x38, 0x1c, 0x0c, 0x35, 0xbf, 0xdd, 0xfc, 0x57,
-	0x3d, 0x76, 0xb8, 0x9a, 0x48, 0x17, 0x96, 0xfe, 0x1d, 0xc0, 0xe4, 0x65, 0xa6, 0xc2, 0x6d, 0xf6,
-	0x4d, 0x8b, 0x49, 0x85, 0x08, 0x84, 0x81, 0xbf, 0xfc, 0x92, 0x8d, 0x9b, 0xa8, 0x84, 0x20, 0x89,
-	0x66, 0x21, 0xec, 0xcd, 0xb3, 0xe7, 0xb6, 0xef, 0x25, 0x1f, 0xf2, 0x19, 0x95, 0x35, 0x6b, 0xc8,
-	0x4f, 0x42, 0x12, 0x6b, 0xdd, 0x8d, 0xf4, 0x56, 0x04, 0xa2, 0x4f, 0xb9, 0x0c, 0xa9, 0xca, 0x2e,
-	0xd7, 0x2f, 0x7c, 0x35, 0xd7, 0xeb, 0x74, 0xd5, 0x15, 0x54, 0xb9, 0x22, 0x64, 0x9b, 0x3d, 0xc8,
-	0xb6, 0x24, 0x6c, 0xea, 0x84, 0x62, 0x28, 0x89, 0x15, 0xc9, 0x44, 0x1f, 0x73, 0xb2, 0x2f, 0xc5,
-	0x4b, 0x53, 0x45, 0x57, 0x61, 0xcc, 0x15, 0x55, 0x26, 0xf4, 0x80, 0x49, 0x58, 0x17, 0x77, 0xad,
-	0xa2, 0x98, 0x25, 0x46, 0xb7, 0x1c, 0x65, 0x5e, 0x25, 0x30, 0xdb, 0x5b, 0xeb, 0xc1, 0x41, 0x62,
-	0x59, 0xfd, 0xd3, 0x37, 0xe4, 0xc8, 0x68, 0xb6, 0xcf, 0x08, 0x52, 0x22, 0x0c, 0x63, 0x75, 0xde,
-	0xe0, 0x4a, 0x4f, 0x9f, 0x71, 0xdd, 0x17, 0xa7, 0xa2, 0xe6, 0xce, 0x30, 0x09, 0xb6, 0x11, 0x82,
-	0x43, 0x4d, 0x6a, 0x33, 0x3d, 0x78, 0xc6, 0x89, 0x5e, 0xa7, 0xb7, 0x00, 0x3c, 0x3a, 0xa7, 0x33,
-	0xed, 0x7f, 0xe7, 0x0b, 0x30, 0x1e, 0xb0, 0x08, 0xab, 0xf6, 0x1c, 0xb5, 0x1c, 0xf2, 0xb0, 0x61,
-	0x1c, 0x2a, 0x1f, 0xa8, 0x7e, 0xe4, 0x5f, 0x54, 0xdf, 0x1a, 0xeb, 0x4f, 0xbf, 0xff, 0x2d, 0xd2,
-	0x77, 0x01, 0x3c, 0xba, 0xa2, 0x27, 0xef, 0xab, 0xa6, 0xfe, 0xd2, 0x82, 0xbc, 0x07, 0x20, 0xee,
-	0x09, 0x72, 0xae, 0x8f, 0xb5, 0x7c, 0x9d, 0x8d, 0xb4, 0x27, 0x80, 0xc8, 0x8b, 0x05, 0x10, 0xed,
-	0x13, 0xc0, 0x9f, 0x00, 0x4e, 0xed, 0x75, 0x79, 0x3f, 0xd3, 0xd7, 0x49, 0xb4, 0xf2, 0x2a, 0xb4,
-	0x31, 0x78, 0xc2, 0x7e, 0x7d, 0xfc, 0x01, 0xe0, 0xd4, 0xd2, 0x7f, 0x7d, 0xb3, 0xc5, 0x43, 0x6f,
-	0x36, 0x35, 0xf8, 0x05, 0xed, 0x61, 0x5e, 0x24, 0xf2, 0x53, 0x5f, 0xc1, 0xc4, 0xde, 0x27, 0x06,
-	0x4d, 0x41, 0xf3, 0x32, 0x29, 0x2e, 0x2e, 0x97, 0x8b, 0x2b, 0xcb, 0x57, 0x4a, 0x64, 0xe1, 0x6a,
-	0x71, 0x79, 0xa1, 0xb4, 0x58, 0x9e, 0x2b, 0x5d, 0x9c, 0x4f, 0x1a, 0x08, 0xc1, 0x89, 0xc0, 0xfb,
-	0x79, 0x71, 0x69, 0xe9, 0xcb, 0x12, 0xb9, 0x98, 0x04, 0xe8, 0x4d, 0x78, 0x34, 0xd8, 0x23, 0xf3,
-	0x97, 0xc8, 0xfc, 0xd2, 0x95, 0xf2, 0x72, 0xe9, 0x93, 0xf9, 0xc5, 0x64, 0x64, 0x72, 0xe8, 0xf6,
-	0x6f, 0xd8, 0xb0, 0x7e, 0x05, 0x0f, 0xdb, 0x18, 0x6c, 0xb7, 0x31, 0x78, 0xd4, 0xc6, 0xc6, 0x93,
-	0x36, 0x36, 0x76, 0xda, 0xd8, 0x78, 0xda, 0xc6, 0xc6, 0xb3, 0x36, 0x06, 0xb7, 0x3c, 0x0c, 0x6e,
-	0x7b, 0xd8, 0xb8, 0xe7, 0x61, 0x70, 0xdf, 0xc3, 0xc6, 0x96, 0x87, 0x8d, 0x07, 0x1e, 0x36, 0x1e,
-	0x7a, 0x18, 0x6c, 0x7b, 0x18, 0x3c, 0xf2, 0xb0, 0xf1, 0xc4, 0xc3, 0x60, 0xc7, 0xc3, 0xc6, 0x53,
-	0x0f, 0x83, 0x67, 0x1e, 0x36, 0x6e, 0x75, 0xb0, 0x71, 0xbb, 0x83, 0xc1, 0x9d, 0x0e, 0x36, 0xee,
-	0x76, 0x30, 0xf8, 0xa5, 0x83, 0x8d, 0x7b, 0x1d, 0x6c, 0xdc, 0xef, 0x60, 0xb0, 0xd5, 0xc1, 0xe0,
-	0x41, 0x07, 0x83, 0xab, 0xa7, 0x6d, 0x37, 0xa7, 0xd6, 0x99, 0x5a, 0xe7, 0x8e, 0x2d, 0x73, 0x0e,
-	0x53, 0x37, 0x5c, 0x51, 0xcb, 0xef, 0xff, 0xdb, 0xd8, 0xac, 0xd9, 0x79, 0xa5, 0x9c, 0xe6, 0xea,
-	0x6a, 0x5c, 0x77, 0xdc, 0xd9, 0xbf, 0x03, 0x00, 0x00, 0xff, 0xff, 0xcb, 0xed, 0x5c, 0x4b, 0xa7,
-	0x0b, 0x00, 0x00,
+	// 1242 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0x4d, 0x6c, 0x13, 0x47,
+	0x14, 0xde, 0xb1, 0x63, 0x3b, 0x9e, 0xfc, 0x60, 0x86, 0x42, 0xb7, 0x21, 0x9d, 0xb8, 0x6e, 0x84,
+	0x0c, 0xc2, 0x36, 0x32, 0x42, 0x6a, 0xe9, 0x4f, 0xf0, 0x26, 0x26, 0x44, 0x6d, 0xe3, 0x76, 0x92,
+	0xb4, 0x12, 0x88, 0x5a, 0x1b, 0xef, 0x64, 0xb3, 0xb2, 0xbd, 0xeb, 0xce, 0x8c, 0x43, 0x43, 0x55,
+	0x09, 0xf5, 0x84, 0x7a, 0x42, 0x3d, 0xa1, 0x9e, 0xaa, 0xf6, 0x50, 0x8e, 0x1c, 0x39, 0xa2, 0x9e,
+	0x38, 0x46, 0xbd, 0x94, 0x13, 0xc5, 0xeb, 0x4b, 0x8e, 0x1c, 0x51, 0x4e, 0xd5, 0xce, 0xae, 0x63,
+	0xc7, 0x36, 0x48, 0x6d, 0xa0, 0x27, 0xcf, 0x9b, 0xf7, 0xbd, 0x37, 0xdf, 0xbc, 0xf9, 0xde, 0xf3,
+	0x42, 0x5c, 0x73, 0x98, 0x7e, 0x43, 0xb7, 0x33, 0x5c, 0xe8, 0x95, 0x6a, 0x4e, 0x6f, 0x58, 0xb9,
+	0x4a, 0xcd, 0xa2, 0xb6, 0xc8, 0x36, 0x98, 0x23, 0x1c, 0x34, 0x29, 0x84, 0x9d, 0x0d, 0x30, 0xd9,
+	0xad, 0xf3, 0x53, 0x05, 0xd3, 0x12, 0x9b, 0xcd, 0xf5, 0x6c, 0xc5, 0xa9, 0xe7, 0xa8, 0xbd, 0xe5,
+	0x6c, 0x37, 0x98, 0xf3, 0xed, 0x76, 0x4e, 0x82, 0x2b, 0x19, 0x93, 0xda, 0x99, 0x2d, 0xbd, 0x66,
+	0x19, 0xba, 0xa0, 0xb9, 0x81, 0x85, 0x9f, 0x72, 0x2a, 0xd3, 0x93, 0xc2, 0x74, 0x4c, 0xc7, 0x0f,
+	0x5e, 0x6f, 0x6e, 0x48, 0x4b, 0x1a, 0x72, 0x15, 0xc0, 0x93, 0xa6, 0xe3, 0x98, 0x35, 0xda, 0x45,
+	0x6d, 0x58, 0xb4, 0x66, 0x94, 0xeb, 0x3a, 0xaf, 0x06, 0x88, 0x99, 0x7e, 0x84, 0xb0, 0xea, 0x94,
+	0x0b, 0xbd, 0xde, 0x08, 0x00, 0xb3, 0x43, 0x2e, 0xe9, 0xd8, 0x42, 0xaf, 0x88, 0xb2, 0x65, 0x6f,
+	0x74, 0x0e, 0x7a, 0x7b, 0x10, 0x45, 0xed, 0x66, 0x9d, 0x07, 0xee, 0x77, 0x07, 0xdd, 0x96, 0x41,
+	0x6d, 0x61, 0x6d, 0x58, 0x94, 0x75, 0x40, 0x43, 0xca, 0xc9, 0x2c, 0x73, 0x53, 0x04, 0xfe, 0xd4,
+	0x6e, 0x14, 0x46, 0xe7, 0x65, 0x7d, 0x51, 0x11, 0x86, 0x2d, 0x83, 0xab, 0x20, 0x09, 0xd2, 0x63,
+	0xf9, 0x77, 0xb2, 0x07, 0xeb, 0x9c, 0xf5, 0x41, 0x4b, 0xdd, 0x03, 0xb4, 0xc4, 0x9e, 0x16, 0xf9,
+	0x11, 0x84, 0x12, 0xe0, 0xd1, 0x93, 0x19, 0x65, 0xe7, 0xc9, 0x0c, 0x20, 0x5e, 0x3c, 0x9a, 0x87,
+	0xb0, 0xc2, 0xa8, 0x2e, 0xa8, 0x51, 0xd6, 0x85, 0x1a, 0x92, 0xd9, 0xa6, 0xb2, 0x7e, 0x45, 0xb2,
+	0x9d, 0x8a, 0x64, 0x57, 0x3b, 0x15, 0xd1, 0x46, 0xbd, 0xf0, 0x3b, 0x7f, 0xcf, 0x00, 0x12, 0x0f,
+	0xe2, 0x0a, 0xc2, 0x4b, 0xd2, 0x6c, 0x18, 0x9d, 0x24, 0xe1, 0x7f, 0x93, 0x24, 0x88, 0x2b, 0x08,
+	0x74, 0x12, 0x8e, 0xd8, 0x7a, 0x9d, 0xaa, 0x23, 0x49, 0x90, 0x8e, 0x6b, 0xb1, 0x3d, 0x6d, 0x84,
+	0x85, 0xd4, 0x3c, 0x91, 0x9b, 0xe8, 0x0c, 0x1c, 0x33, 0x28, 0xaf, 0x30, 0xab, 0x21, 0x2c, 0xc7,
+	0x56, 0x23, 0x12, 0x33, 0xba, 0xa7, 0x45, 0x58, 0x58, 0xdd, 0x39, 0x42, 0x7a, 0x9d, 0x48, 0x40,
+	0xa8, 0x0b, 0xc1, 0xac, 0xf5, 0xa6, 0xa0, 0x5c, 0x8d, 0x26, 0xc3, 0xe9, 0xb1, 0xfc, 0xa9, 0xe1,
+	0x05, 0xca, 0x16, 0xf6, 0x81, 0x45, 0x5b, 0xb0, 0x6d, 0xed, 0xec, 0x9e, 0x76, 0xfa, 0x67, 0x70,
+	0x2a, 0x35, 0xcb, 0x52, 0xea, 0x6c, 0x1e, 0x7f, 0x7d, 0x4d, 0xcf, 0xdc, 0x3c, 0x97, 0x79, 0xff,
+	0x7a, 0x7a, 0xee, 0xe2, 0xb5, 0xcc, 0xf5, 0xb9, 0x8e, 0x79, 0xfa, 0xbb, 0xfc, 0xd9, 0xef, 0x67,
+	0x49, 0xcf, 0x39, 0xe8, 0x63, 0x38, 0xde, 0x2b, 0x0a, 0x35, 0x26, 0xcf, 0x3d, 0x39, 0x70, 0xae,
+	0x8f, 0x59, 0xb2, 0x37, 0x1c, 0x32, 0x56, 0xe9, 0x1a, 0xe8, 0x04, 0x8c, 0x72, 0x5a, 0x61, 0x54,
+	0xa8, 0xa3, 0xde, 0xe5, 0x48, 0x60, 0xa1, 0x0b, 0x70, 0x82, 0x51, 0xc3, 0x62, 0xb4, 0x22, 0xca,
+	0x4d, 0x66, 0x71, 0x35, 0x9e, 0x0c, 0xa7, 0xe3, 0x5a, 0xc2, 0x7d, 0x32, 0x33, 0x4e, 0x02, 0xc7,
+	0x1a, 0x59, 0xe2, 0x64, 0xbc, 0x03, 0x5b, 0x63, 0x16, 0x47, 0x17, 0x60, 0x84, 0x0b, 0x5d, 0x50,
+	0x15, 0x26, 0x41, 0x7a, 0x32, 0x7f, 0xbc, 0x9f, 0xc7, 0x8a, 0xe7, 0x94, 0x15, 0xfc, 0xc1, 0x13,
+	0x05, 0xf1, 0xd1, 0x28, 0x03, 0x11, 0xaf, 0x5a, 0x8d, 0xb2, 0xde, 0x14, 0x9b, 0x0e, 0xb3, 0x6e,
+	0xea, 0xb2, 0xdc, 0x63, 0x49, 0x90, 0x1e, 0x25, 0x47, 0x3d, 0x4f, 0xa1, 0xd7, 0x81, 0xa6, 0xe0,
+	0x28, 0xb5, 0x0d, 0x87, 0x71, 0x6a, 0xa8, 0xe3, 0x12, 0xb4, 0x6f, 0xa3, 0x4b, 0x30, 0x6a, 0x32,
+	0xdd, 0x16, 0x5c, 0x9d, 0x48, 0x86, 0xd3, 0x93, 0xf9, 0xb7, 0xfa, 0x29, 0x2c, 0x7a, 0xde, 0xd5,
+	0xed, 0x06, 0xd5, 0x26, 0xf6, 0x34, 0xf8, 0x13, 0x88, 0xa5, 0x02, 0x2e, 0x41, 0x1c, 0xfa, 0x10,
+	0x46, 0x7d, 0xf5, 0xab, 0x93, 0xc9, 0xf0, 0xb0, 0x4b, 0x10, 0xcf, 0x3b, 0x10, 0xed, 0xc7, 0x4c,
+	0x7d, 0x04, 0x8f, 0xf4, 0xbd, 0x2e, 0x4a, 0xc0, 0x70, 0x95, 0x6e, 0xcb, 0x9e, 0x89, 0x13, 0x6f,
+	0x89, 0xde, 0x80, 0x91, 0x2d, 0xbd, 0xd6, 0xa4, 0x52, 0xf9, 0x71, 0xe2, 0x1b, 0x17, 0x43, 0xef,
+	0x81, 0xd4, 0x07, 0x30, 0xe6, 0x6b, 0x84, 0xa3, 0x73, 0x30, 0xe6, 0x0f, 0x35, 0xaf, 0xdd, 0xbc,
+	0x57, 0x3d, 0x31, 0x5c, 0x4d, 0xa4, 0x03, 0x4b, 0xfd, 0x0e, 0x60, 0x62, 0x91, 0x8a, 0x60, 0x9b,
+	0x7e, 0xd3, 0xa4, 0x5c, 0x20, 0x02, 0xa1, 0xef, 0x2f, 0x1f, 0xb2, 0x71, 0xe3, 0x95, 0x00, 0xc4,
+	0xd1, 0x1c, 0x84, 0xdd, 0x79, 0xf6, 0xc2, 0xf6, 0xbd, 0xec, 0x41, 0x3e, 0xd3, 0x79, 0x55, 0x1b,
+	0xf1, 0x92, 0x90, 0xf8, 0x46, 0x67, 0x23, 0xf5, 0x67, 0x08, 0xa2, 0x4f, 0x2d, 0x1e, 0x50, 0xe5,
+	0x1d, 0xae, 0x5f, 0x78, 0x6a, 0xae, 0xd5, 0xf4, 0x75, 0x87, 0xe9, 0xc2, 0x61, 0x01, 0xdb, 0x4c,
+	0x3f, 0xdb, 0x12, 0x33, 0x75, 0x3b, 0x10, 0x43, 0x89, 0xad, 0x71, 0xca, 0x7a, 0x98, 0x93, 0x03,
+	0x29, 0x0e, 0x4d, 0x15, 0x5d, 0x85, 0x11, 0x87, 0x19, 0x94, 0xc9, 0x01, 0x13, 0xd7, 0x16, 0xf6,
+	0xb4, 0x02, 0x9b, 0x23, 0x4a, 0xa7, 0x1c, 0x65, 0xcb, 0x20, 0x30, 0xd3, 0x5d, 0xcb, 0xc1, 0x41,
+	0x22, 0x19, 0xf9, 0xd3, 0x33, 0xe4, 0xc8, 0x58, 0xa6, 0xc7, 0xf0, 0x53, 0x22, 0x0c, 0x23, 0x35,
+	0xab, 0x6e, 0x09, 0x39, 0x7d, 0x26, 0x64, 0x5f, 0x9c, 0x09, 0xab, 0xbb, 0x31, 0xe2, 0x6f, 0x23,
+	0x04, 0x47, 0x1a, 0xba, 0x49, 0xe5, 0xe0, 0x99, 0x20, 0x72, 0x8d, 0x54, 0x18, 0x33, 0x68, 0x8d,
+	0x0a, 0x6a, 0xa8, 0x51, 0xa9, 0xfd, 0x8e, 0x99, 0x7a, 0x00, 0xe0, 0xb1, 0x79, 0x79, 0xc6, 0x41,
+	0x05, 0x5c, 0x82, 0x51, 0x9f, 0x5f, 0x50, 0xcf, 0x17, 0xe8, 0x68, 0xc8, 0x93, 0x07, 0x71, 0xa8,
+	0xdc, 0xf7, 0x2e, 0xa1, 0xff, 0xf0, 0x2e, 0xda, 0x78, 0x6f, 0xfa, 0x83, 0xaf, 0x94, 0xba, 0x0b,
+	0xe0, 0xb1, 0x35, 0x39, 0x93, 0x5f, 0x35, 0xf5, 0x43, 0x4b, 0xf5, 0x1e, 0x80, 0xb8, 0x2b, 0xd5,
+	0xf9, 0x1e, 0xd6, 0xfc, 0x75, 0xb6, 0xd8, 0xbe, 0x34, 0x42, 0x2f, 0x97, 0x46, 0xb8, 0x2b, 0x8d,
+	0xd4, 0x5f, 0x00, 0x4e, 0x2f, 0xd2, 0x21, 0x4c, 0x5f, 0x27, 0xd1, 0xca, 0xab, 0xd0, 0xc6, 0xe0,
+	0x09, 0x07, 0xf5, 0xf1, 0x07, 0x80, 0xd3, 0x2b, 0xff, 0xf7, 0xcd, 0x96, 0x87, 0xde, 0x6c, 0x7a,
+	0x20, 0x6b, 0x0f, 0xe6, 0x65, 0x22, 0x3f, 0xc3, 0x61, 0x7c, 0xff, 0xcf, 0x07, 0x4d, 0x43, 0x75,
+	0x91, 0x14, 0x96, 0x57, 0xcb, 0x85, 0xb5, 0xd5, 0x2b, 0x25, 0xb2, 0x74, 0xb5, 0xb0, 0xba, 0x54,
+	0x5a, 0x2e, 0xcf, 0x97, 0x16, 0x8a, 0x09, 0x05, 0x21, 0x38, 0xe9, 0x7b, 0x3f, 0x2f, 0xac, 0xac,
+	0x7c, 0x55, 0x22, 0x0b, 0x09, 0x80, 0xde, 0x84, 0xc7, 0xfc, 0x3d, 0x52, 0xbc, 0x4c, 0x8a, 0x2b,
+	0x57, 0xca, 0xab, 0xa5, 0x4f, 0x8a, 0xcb, 0x89, 0x10, 0x3a, 0x0e, 0x8f, 0xfa, 0x8e, 0x85, 0xe2,
+	0x97, 0x4b, 0xf3, 0x45, 0x3f, 0x47, 0x78, 0x6a, 0xe4, 0xf6, 0x6f, 0x58, 0xd1, 0x7e, 0x05, 0x8f,
+	0x5a, 0x18, 0xec, 0xb4, 0x30, 0x78, 0xdc, 0xc2, 0xca, 0xd3, 0x16, 0x56, 0x76, 0x5b, 0x58, 0x79,
+	0xd6, 0xc2, 0xca, 0xf3, 0x16, 0x06, 0xb7, 0x5c, 0x0c, 0x6e, 0xbb, 0x58, 0xb9, 0xe7, 0x62, 0x70,
+	0xdf, 0xc5, 0xca, 0x03, 0x17, 0x2b, 0x0f, 0x5d, 0xac, 0x3c, 0x72, 0x31, 0xd8, 0x71, 0x31, 0x78,
+	0xec, 0x62, 0xe5, 0xa9, 0x8b, 0xc1, 0xae, 0x8b, 0x95, 0x67, 0x2e, 0x06, 0xcf, 0x5d, 0xac, 0xdc,
+	0x6a, 0x63, 0xe5, 0x76, 0x1b, 0x83, 0x3b, 0x6d, 0xac, 0xdc, 0x6d, 0x63, 0xf0, 0x4b, 0x1b, 0x2b,
+	0xf7, 0xda, 0x58, 0xb9, 0xdf, 0xc6, 0xe0, 0x41, 0x1b, 0x83, 0x87, 0x6d, 0x0c, 0xae, 0x9e, 0x35,
+	0x9d, 0xac, 0xd8, 0xa4, 0x62, 0xd3, 0xb2, 0x4d, 0x9e, 0xb5, 0xa9, 0xb8, 0xe1, 0xb0, 0x6a, 0xee,
+	0xe0, 0x77, 0x66, 0xa3, 0x6a, 0xe6, 0x84, 0xb0, 0x1b, 0xeb, 0xeb, 0x51, 0xd9, 0x88, 0xe7, 0xff,
+	0x19, 0x00, 0xf6, 0xeb, 0x0f, 0xfe, 0xd8, 0x0b, 0x00, 0x00,
 }
 
 func (x GrantType) String() string {
@@ -923,6 +937,9 @@ func (this *ListClientsRequest) Equal(that interface{}) bool {
 	if this.Page != that1.Page {
 		return false
 	}
+	if this.Deleted != that1.Deleted {
+		return false
+	}
 	return true
 }
 func (this *CreateClientRequest) Equal(that interface{}) bool {
@@ -1336,6 +1353,16 @@ func (m *ListClientsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Deleted {
+		i--
+		if m.Deleted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
 	if m.Page != 0 {
 		i = encodeVarintClient(dAtA, i, uint64(m.Page))
 		i--
@@ -1640,7 +1667,7 @@ func NewPopulatedClient(r randyClient, easy bool) *Client {
 	v7 := r.Intn(10)
 	this.Grants = make([]GrantType, v7)
 	for i := 0; i < v7; i++ {
-		this.Grants[i] = GrantType([]int32{0, 1, 2}[r.Intn(3)])
+		this.Grants[i] = GrantType([]int32{0, 1, 2, 3}[r.Intn(4)])
 	}
 	v8 := r.Intn(10)
 	this.Rights = make([]Right, v8)
@@ -1687,6 +1714,7 @@ func NewPopulatedListClientsRequest(r randyClient, easy bool) *ListClientsReques
 	this.Order = randStringClient(r)
 	this.Limit = r.Uint32()
 	this.Page = r.Uint32()
+	this.Deleted = bool(r.Intn(2) == 0)
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -1939,6 +1967,9 @@ func (m *ListClientsRequest) Size() (n int) {
 	if m.Page != 0 {
 		n += 1 + sovClient(uint64(m.Page))
 	}
+	if m.Deleted {
+		n += 2
+	}
 	return n
 }
 
@@ -2091,6 +2122,7 @@ func (this *ListClientsRequest) String() string {
 		`Order:` + fmt.Sprintf("%v", this.Order) + `,`,
 		`Limit:` + fmt.Sprintf("%v", this.Limit) + `,`,
 		`Page:` + fmt.Sprintf("%v", this.Page) + `,`,
+		`Deleted:` + fmt.Sprintf("%v", this.Deleted) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -3171,6 +3203,26 @@ func (m *ListClientsRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deleted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Deleted = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipClient(dAtA[iNdEx:])
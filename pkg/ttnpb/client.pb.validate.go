@@ -512,6 +512,8 @@ func (m *ListClientsRequest) ValidateFields(paths ...string) error {
 
 		case "page":
 			// no validation rules for Page
+		case "deleted":
+			// no validation rules for Deleted
 		default:
 			return ListClientsRequestValidationError{
 				field:  name,
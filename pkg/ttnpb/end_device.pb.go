@@ -705,9 +705,11 @@ type EndDeviceVersion struct {
 	// Whether the device resets the join and dev nonces (not LoRaWAN compliant).
 	ResetsJoinNonces bool `protobuf:"varint,12,opt,name=resets_join_nonces,json=resetsJoinNonces,proto3" json:"resets_join_nonces,omitempty"`
 	// Default formatters defining the payload formats for this end device.
-	DefaultFormatters    MessagePayloadFormatters `protobuf:"bytes,13,opt,name=default_formatters,json=defaultFormatters,proto3" json:"default_formatters"`
-	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
-	XXX_sizecache        int32                    `json:"-"`
+	DefaultFormatters MessagePayloadFormatters `protobuf:"bytes,13,opt,name=default_formatters,json=defaultFormatters,proto3" json:"default_formatters"`
+	// Identifiers of the end device profiles by band ID.
+	ProfileIDs           map[string]string `protobuf:"bytes,14,rep,name=profile_ids,json=profileIds,proto3" json:"profile_ids,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
 func (m *EndDeviceVersion) Reset()      { *m = EndDeviceVersion{} }
@@ -826,6 +828,13 @@ func (m *EndDeviceVersion) GetDefaultFormatters() MessagePayloadFormatters {
 	return MessagePayloadFormatters{}
 }
 
+func (m *EndDeviceVersion) GetProfileIDs() map[string]string {
+	if m != nil {
+		return m.ProfileIDs
+	}
+	return nil
+}
+
 type MACSettings struct {
 	// Maximum delay for the device to answer a MAC request or a confirmed downlink frame.
 	// If unset, the default value from Network Server configuration will be used.
@@ -1190,8 +1199,14 @@ type MACState struct {
 	RecentDownlinks []*DownlinkMessage `protobuf:"bytes,15,rep,name=recent_downlinks,json=recentDownlinks,proto3" json:"recent_downlinks,omitempty"`
 	// Time when the last network-initiated downlink message was scheduled.
 	LastNetworkInitiatedDownlinkAt *time.Time `protobuf:"bytes,16,opt,name=last_network_initiated_downlink_at,json=lastNetworkInitiatedDownlinkAt,proto3,stdtime" json:"last_network_initiated_downlink_at,omitempty"`
-	XXX_NoUnkeyedLiteral           struct{}   `json:"-"`
-	XXX_sizecache                  int32      `json:"-"`
+	// Queued ForceRejoinReq.
+	// Set via the Network Server end device registry and removed each time the request is scheduled.
+	QueuedForceRejoinReq *MACCommand_ForceRejoinReq `protobuf:"bytes,17,opt,name=queued_force_rejoin_req,json=queuedForceRejoinReq,proto3" json:"queued_force_rejoin_req,omitempty"`
+	// Last RJcount0 of the rejoin-requests of type 0 and 2 that were sent by the end device in the current session.
+	// The end device resets RJcount0 each time it processes a join-accept, so this value is reset with the MAC state.
+	LastRJCount0         uint32   `protobuf:"varint,18,opt,name=last_rj_count_0,json=lastRjCount0,proto3" json:"last_rj_count_0,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *MACState) Reset()      { *m = MACState{} }
@@ -1338,6 +1353,20 @@ func (m *MACState) GetLastNetworkInitiatedDownlinkAt() *time.Time {
 	return nil
 }
 
+func (m *MACState) GetQueuedForceRejoinReq() *MACCommand_ForceRejoinReq {
+	if m != nil {
+		return m.QueuedForceRejoinReq
+	}
+	return nil
+}
+
+func (m *MACState) GetLastRJCount0() uint32 {
+	if m != nil {
+		return m.LastRJCount0
+	}
+	return 0
+}
+
 type MACState_JoinAccept struct {
 	// Payload of the join-accept received from Join Server.
 	Payload []byte `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
@@ -1613,9 +1642,12 @@ type EndDevice struct {
 	// Authentication code to claim ownership of the end device. Stored in Join Server.
 	ClaimAuthenticationCode *EndDeviceAuthenticationCode `protobuf:"bytes,46,opt,name=claim_authentication_code,json=claimAuthenticationCode,proto3" json:"claim_authentication_code,omitempty"`
 	// Skip decryption of uplink payloads and encryption of downlink payloads.
-	SkipPayloadCrypto    bool     `protobuf:"varint,51,opt,name=skip_payload_crypto,json=skipPayloadCrypto,proto3" json:"skip_payload_crypto,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	SkipPayloadCrypto bool `protobuf:"varint,51,opt,name=skip_payload_crypto,json=skipPayloadCrypto,proto3" json:"skip_payload_crypto,omitempty"`
+	// Identifiers of the end device profile of the device. Stored in Network Server.
+	// The MAC settings of the profile are used for settings that are not set in mac_settings of the device.
+	ProfileIDs           *EndDeviceProfileIdentifiers `protobuf:"bytes,52,opt,name=profile_ids,json=profileIds,proto3" json:"profile_ids,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                     `json:"-"`
+	XXX_sizecache        int32                        `json:"-"`
 }
 
 func (m *EndDevice) Reset()      { *m = EndDevice{} }
@@ -1993,6 +2025,13 @@ func (m *EndDevice) GetSkipPayloadCrypto() bool {
 	return false
 }
 
+func (m *EndDevice) GetProfileIDs() *EndDeviceProfileIdentifiers {
+	if m != nil {
+		return m.ProfileIDs
+	}
+	return nil
+}
+
 type EndDevices struct {
 	EndDevices           []*EndDevice `protobuf:"bytes,1,rep,name=end_devices,json=endDevices,proto3" json:"end_devices,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
@@ -2334,25 +2373,24 @@ func (m *SetEndDeviceRequest) GetFieldMask() types.FieldMask {
 	return types.FieldMask{}
 }
 
-type EndDeviceTemplate struct {
-	EndDevice            EndDevice       `protobuf:"bytes,1,opt,name=end_device,json=endDevice,proto3" json:"end_device"`
-	FieldMask            types.FieldMask `protobuf:"bytes,2,opt,name=field_mask,json=fieldMask,proto3" json:"field_mask"`
-	MappingKey           string          `protobuf:"bytes,3,opt,name=mapping_key,json=mappingKey,proto3" json:"mapping_key,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
-	XXX_sizecache        int32           `json:"-"`
+type BatchCreateEndDevicesRequest struct {
+	ApplicationIdentifiers `protobuf:"bytes,1,opt,name=application_ids,json=applicationIds,proto3,embedded=application_ids" json:"application_ids"`
+	Requests               []*CreateEndDeviceRequest `protobuf:"bytes,2,rep,name=requests,proto3" json:"requests,omitempty"`
+	XXX_NoUnkeyedLiteral   struct{}                  `json:"-"`
+	XXX_sizecache          int32                     `json:"-"`
 }
 
-func (m *EndDeviceTemplate) Reset()      { *m = EndDeviceTemplate{} }
-func (*EndDeviceTemplate) ProtoMessage() {}
-func (*EndDeviceTemplate) Descriptor() ([]byte, []int) {
+func (m *BatchCreateEndDevicesRequest) Reset()      { *m = BatchCreateEndDevicesRequest{} }
+func (*BatchCreateEndDevicesRequest) ProtoMessage() {}
+func (*BatchCreateEndDevicesRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_a656ee0551c94a80, []int{17}
 }
-func (m *EndDeviceTemplate) XXX_Unmarshal(b []byte) error {
+func (m *BatchCreateEndDevicesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EndDeviceTemplate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *BatchCreateEndDevicesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EndDeviceTemplate.Marshal(b, m, deterministic)
+		return xxx_messageInfo_BatchCreateEndDevicesRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2362,58 +2400,89 @@ func (m *EndDeviceTemplate) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (m *EndDeviceTemplate) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EndDeviceTemplate.Merge(m, src)
+func (m *BatchCreateEndDevicesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchCreateEndDevicesRequest.Merge(m, src)
 }
-func (m *EndDeviceTemplate) XXX_Size() int {
+func (m *BatchCreateEndDevicesRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *EndDeviceTemplate) XXX_DiscardUnknown() {
-	xxx_messageInfo_EndDeviceTemplate.DiscardUnknown(m)
+func (m *BatchCreateEndDevicesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchCreateEndDevicesRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EndDeviceTemplate proto.InternalMessageInfo
+var xxx_messageInfo_BatchCreateEndDevicesRequest proto.InternalMessageInfo
 
-func (m *EndDeviceTemplate) GetEndDevice() EndDevice {
+func (m *BatchCreateEndDevicesRequest) GetRequests() []*CreateEndDeviceRequest {
 	if m != nil {
-		return m.EndDevice
+		return m.Requests
 	}
-	return EndDevice{}
+	return nil
 }
 
-func (m *EndDeviceTemplate) GetFieldMask() types.FieldMask {
-	if m != nil {
-		return m.FieldMask
+type BatchGetEndDevicesRequest struct {
+	ApplicationIdentifiers `protobuf:"bytes,1,opt,name=application_ids,json=applicationIds,proto3,embedded=application_ids" json:"application_ids"`
+	Requests               []*GetEndDeviceRequest `protobuf:"bytes,2,rep,name=requests,proto3" json:"requests,omitempty"`
+	XXX_NoUnkeyedLiteral   struct{}               `json:"-"`
+	XXX_sizecache          int32                  `json:"-"`
+}
+
+func (m *BatchGetEndDevicesRequest) Reset()      { *m = BatchGetEndDevicesRequest{} }
+func (*BatchGetEndDevicesRequest) ProtoMessage() {}
+func (*BatchGetEndDevicesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a656ee0551c94a80, []int{18}
+}
+func (m *BatchGetEndDevicesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BatchGetEndDevicesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BatchGetEndDevicesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return types.FieldMask{}
+}
+func (m *BatchGetEndDevicesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchGetEndDevicesRequest.Merge(m, src)
+}
+func (m *BatchGetEndDevicesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *BatchGetEndDevicesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchGetEndDevicesRequest.DiscardUnknown(m)
 }
 
-func (m *EndDeviceTemplate) GetMappingKey() string {
+var xxx_messageInfo_BatchGetEndDevicesRequest proto.InternalMessageInfo
+
+func (m *BatchGetEndDevicesRequest) GetRequests() []*GetEndDeviceRequest {
 	if m != nil {
-		return m.MappingKey
+		return m.Requests
 	}
-	return ""
+	return nil
 }
 
-type EndDeviceTemplateFormat struct {
-	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Description          string   `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
-	FileExtensions       []string `protobuf:"bytes,3,rep,name=file_extensions,json=fileExtensions,proto3" json:"file_extensions,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type BatchUpdateEndDevicesRequest struct {
+	ApplicationIdentifiers `protobuf:"bytes,1,opt,name=application_ids,json=applicationIds,proto3,embedded=application_ids" json:"application_ids"`
+	Requests               []*UpdateEndDeviceRequest `protobuf:"bytes,2,rep,name=requests,proto3" json:"requests,omitempty"`
+	XXX_NoUnkeyedLiteral   struct{}                  `json:"-"`
+	XXX_sizecache          int32                     `json:"-"`
 }
 
-func (m *EndDeviceTemplateFormat) Reset()      { *m = EndDeviceTemplateFormat{} }
-func (*EndDeviceTemplateFormat) ProtoMessage() {}
-func (*EndDeviceTemplateFormat) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a656ee0551c94a80, []int{18}
+func (m *BatchUpdateEndDevicesRequest) Reset()      { *m = BatchUpdateEndDevicesRequest{} }
+func (*BatchUpdateEndDevicesRequest) ProtoMessage() {}
+func (*BatchUpdateEndDevicesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a656ee0551c94a80, []int{19}
 }
-func (m *EndDeviceTemplateFormat) XXX_Unmarshal(b []byte) error {
+func (m *BatchUpdateEndDevicesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EndDeviceTemplateFormat) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *BatchUpdateEndDevicesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EndDeviceTemplateFormat.Marshal(b, m, deterministic)
+		return xxx_messageInfo_BatchUpdateEndDevicesRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2423,56 +2492,137 @@ func (m *EndDeviceTemplateFormat) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *EndDeviceTemplateFormat) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EndDeviceTemplateFormat.Merge(m, src)
+func (m *BatchUpdateEndDevicesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchUpdateEndDevicesRequest.Merge(m, src)
 }
-func (m *EndDeviceTemplateFormat) XXX_Size() int {
+func (m *BatchUpdateEndDevicesRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *EndDeviceTemplateFormat) XXX_DiscardUnknown() {
-	xxx_messageInfo_EndDeviceTemplateFormat.DiscardUnknown(m)
+func (m *BatchUpdateEndDevicesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchUpdateEndDevicesRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EndDeviceTemplateFormat proto.InternalMessageInfo
+var xxx_messageInfo_BatchUpdateEndDevicesRequest proto.InternalMessageInfo
 
-func (m *EndDeviceTemplateFormat) GetName() string {
+func (m *BatchUpdateEndDevicesRequest) GetRequests() []*UpdateEndDeviceRequest {
 	if m != nil {
-		return m.Name
+		return m.Requests
 	}
-	return ""
+	return nil
 }
 
-func (m *EndDeviceTemplateFormat) GetDescription() string {
+type BatchSetEndDevicesRequest struct {
+	ApplicationIdentifiers `protobuf:"bytes,1,opt,name=application_ids,json=applicationIds,proto3,embedded=application_ids" json:"application_ids"`
+	Requests               []*SetEndDeviceRequest `protobuf:"bytes,2,rep,name=requests,proto3" json:"requests,omitempty"`
+	XXX_NoUnkeyedLiteral   struct{}               `json:"-"`
+	XXX_sizecache          int32                  `json:"-"`
+}
+
+func (m *BatchSetEndDevicesRequest) Reset()      { *m = BatchSetEndDevicesRequest{} }
+func (*BatchSetEndDevicesRequest) ProtoMessage() {}
+func (*BatchSetEndDevicesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a656ee0551c94a80, []int{20}
+}
+func (m *BatchSetEndDevicesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BatchSetEndDevicesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BatchSetEndDevicesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BatchSetEndDevicesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchSetEndDevicesRequest.Merge(m, src)
+}
+func (m *BatchSetEndDevicesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *BatchSetEndDevicesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchSetEndDevicesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchSetEndDevicesRequest proto.InternalMessageInfo
+
+func (m *BatchSetEndDevicesRequest) GetRequests() []*SetEndDeviceRequest {
 	if m != nil {
-		return m.Description
+		return m.Requests
 	}
-	return ""
+	return nil
 }
 
-func (m *EndDeviceTemplateFormat) GetFileExtensions() []string {
+type BatchDeleteEndDevicesRequest struct {
+	ApplicationIdentifiers `protobuf:"bytes,1,opt,name=application_ids,json=applicationIds,proto3,embedded=application_ids" json:"application_ids"`
+	EndDeviceIDs           []*EndDeviceIdentifiers `protobuf:"bytes,2,rep,name=end_device_ids,json=endDeviceIds,proto3" json:"end_device_ids,omitempty"`
+	XXX_NoUnkeyedLiteral   struct{}                `json:"-"`
+	XXX_sizecache          int32                   `json:"-"`
+}
+
+func (m *BatchDeleteEndDevicesRequest) Reset()      { *m = BatchDeleteEndDevicesRequest{} }
+func (*BatchDeleteEndDevicesRequest) ProtoMessage() {}
+func (*BatchDeleteEndDevicesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a656ee0551c94a80, []int{21}
+}
+func (m *BatchDeleteEndDevicesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BatchDeleteEndDevicesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BatchDeleteEndDevicesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BatchDeleteEndDevicesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchDeleteEndDevicesRequest.Merge(m, src)
+}
+func (m *BatchDeleteEndDevicesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *BatchDeleteEndDevicesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchDeleteEndDevicesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchDeleteEndDevicesRequest proto.InternalMessageInfo
+
+func (m *BatchDeleteEndDevicesRequest) GetEndDeviceIDs() []*EndDeviceIdentifiers {
 	if m != nil {
-		return m.FileExtensions
+		return m.EndDeviceIDs
 	}
 	return nil
 }
 
-type EndDeviceTemplateFormats struct {
-	Formats              map[string]*EndDeviceTemplateFormat `protobuf:"bytes,1,rep,name=formats,proto3" json:"formats,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	XXX_NoUnkeyedLiteral struct{}                            `json:"-"`
-	XXX_sizecache        int32                               `json:"-"`
+// The requests of a batch are handled concurrently as if they were separate calls to the single RPC.
+// All requests of a batch must be for end devices of the application of the batch.
+type BatchEndDevicesResponse struct {
+	// The results, in the order of the requests.
+	Results              []BatchEndDevicesResponse_Result `protobuf:"bytes,1,rep,name=results,proto3" json:"results"`
+	XXX_NoUnkeyedLiteral struct{}                         `json:"-"`
+	XXX_sizecache        int32                            `json:"-"`
 }
 
-func (m *EndDeviceTemplateFormats) Reset()      { *m = EndDeviceTemplateFormats{} }
-func (*EndDeviceTemplateFormats) ProtoMessage() {}
-func (*EndDeviceTemplateFormats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a656ee0551c94a80, []int{19}
+func (m *BatchEndDevicesResponse) Reset()      { *m = BatchEndDevicesResponse{} }
+func (*BatchEndDevicesResponse) ProtoMessage() {}
+func (*BatchEndDevicesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a656ee0551c94a80, []int{22}
 }
-func (m *EndDeviceTemplateFormats) XXX_Unmarshal(b []byte) error {
+func (m *BatchEndDevicesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EndDeviceTemplateFormats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *BatchEndDevicesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EndDeviceTemplateFormats.Marshal(b, m, deterministic)
+		return xxx_messageInfo_BatchEndDevicesResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2482,45 +2632,47 @@ func (m *EndDeviceTemplateFormats) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *EndDeviceTemplateFormats) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EndDeviceTemplateFormats.Merge(m, src)
+func (m *BatchEndDevicesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchEndDevicesResponse.Merge(m, src)
 }
-func (m *EndDeviceTemplateFormats) XXX_Size() int {
+func (m *BatchEndDevicesResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *EndDeviceTemplateFormats) XXX_DiscardUnknown() {
-	xxx_messageInfo_EndDeviceTemplateFormats.DiscardUnknown(m)
+func (m *BatchEndDevicesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchEndDevicesResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EndDeviceTemplateFormats proto.InternalMessageInfo
+var xxx_messageInfo_BatchEndDevicesResponse proto.InternalMessageInfo
 
-func (m *EndDeviceTemplateFormats) GetFormats() map[string]*EndDeviceTemplateFormat {
+func (m *BatchEndDevicesResponse) GetResults() []BatchEndDevicesResponse_Result {
 	if m != nil {
-		return m.Formats
+		return m.Results
 	}
 	return nil
 }
 
-type ConvertEndDeviceTemplateRequest struct {
-	// ID of the format.
-	FormatID string `protobuf:"bytes,1,opt,name=format_id,json=formatId,proto3" json:"format_id,omitempty"`
-	// Data to convert.
-	Data                 []byte   `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type BatchEndDevicesResponse_Result struct {
+	IDs EndDeviceIdentifiers `protobuf:"bytes,1,opt,name=ids,proto3" json:"ids"`
+	// The end device that is returned by the single RPC.
+	// This is not set for deleted end devices and failed requests.
+	EndDevice *EndDevice `protobuf:"bytes,2,opt,name=end_device,json=endDevice,proto3" json:"end_device,omitempty"`
+	// The error of the request, if it failed.
+	Error                *ErrorDetails `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
-func (m *ConvertEndDeviceTemplateRequest) Reset()      { *m = ConvertEndDeviceTemplateRequest{} }
-func (*ConvertEndDeviceTemplateRequest) ProtoMessage() {}
-func (*ConvertEndDeviceTemplateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a656ee0551c94a80, []int{20}
+func (m *BatchEndDevicesResponse_Result) Reset()      { *m = BatchEndDevicesResponse_Result{} }
+func (*BatchEndDevicesResponse_Result) ProtoMessage() {}
+func (*BatchEndDevicesResponse_Result) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a656ee0551c94a80, []int{22, 0}
 }
-func (m *ConvertEndDeviceTemplateRequest) XXX_Unmarshal(b []byte) error {
+func (m *BatchEndDevicesResponse_Result) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ConvertEndDeviceTemplateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *BatchEndDevicesResponse_Result) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ConvertEndDeviceTemplateRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_BatchEndDevicesResponse_Result.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2530,656 +2682,1174 @@ func (m *ConvertEndDeviceTemplateRequest) XXX_Marshal(b []byte, deterministic bo
 		return b[:n], nil
 	}
 }
-func (m *ConvertEndDeviceTemplateRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ConvertEndDeviceTemplateRequest.Merge(m, src)
+func (m *BatchEndDevicesResponse_Result) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchEndDevicesResponse_Result.Merge(m, src)
 }
-func (m *ConvertEndDeviceTemplateRequest) XXX_Size() int {
+func (m *BatchEndDevicesResponse_Result) XXX_Size() int {
 	return m.Size()
 }
-func (m *ConvertEndDeviceTemplateRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ConvertEndDeviceTemplateRequest.DiscardUnknown(m)
+func (m *BatchEndDevicesResponse_Result) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchEndDevicesResponse_Result.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ConvertEndDeviceTemplateRequest proto.InternalMessageInfo
+var xxx_messageInfo_BatchEndDevicesResponse_Result proto.InternalMessageInfo
 
-func (m *ConvertEndDeviceTemplateRequest) GetFormatID() string {
+func (m *BatchEndDevicesResponse_Result) GetIDs() EndDeviceIdentifiers {
 	if m != nil {
-		return m.FormatID
+		return m.IDs
 	}
-	return ""
+	return EndDeviceIdentifiers{}
 }
 
-func (m *ConvertEndDeviceTemplateRequest) GetData() []byte {
+func (m *BatchEndDevicesResponse_Result) GetEndDevice() *EndDevice {
 	if m != nil {
-		return m.Data
+		return m.EndDevice
 	}
 	return nil
 }
 
-func init() {
-	proto.RegisterEnum("ttn.lorawan.v3.PowerState", PowerState_name, PowerState_value)
-	golang_proto.RegisterEnum("ttn.lorawan.v3.PowerState", PowerState_name, PowerState_value)
-	proto.RegisterType((*Session)(nil), "ttn.lorawan.v3.Session")
-	golang_proto.RegisterType((*Session)(nil), "ttn.lorawan.v3.Session")
-	proto.RegisterType((*MACParameters)(nil), "ttn.lorawan.v3.MACParameters")
-	golang_proto.RegisterType((*MACParameters)(nil), "ttn.lorawan.v3.MACParameters")
-	proto.RegisterType((*MACParameters_Channel)(nil), "ttn.lorawan.v3.MACParameters.Channel")
-	golang_proto.RegisterType((*MACParameters_Channel)(nil), "ttn.lorawan.v3.MACParameters.Channel")
-	proto.RegisterType((*EndDeviceBrand)(nil), "ttn.lorawan.v3.EndDeviceBrand")
-	golang_proto.RegisterType((*EndDeviceBrand)(nil), "ttn.lorawan.v3.EndDeviceBrand")
-	proto.RegisterType((*EndDeviceModel)(nil), "ttn.lorawan.v3.EndDeviceModel")
-	golang_proto.RegisterType((*EndDeviceModel)(nil), "ttn.lorawan.v3.EndDeviceModel")
-	proto.RegisterType((*EndDeviceVersionIdentifiers)(nil), "ttn.lorawan.v3.EndDeviceVersionIdentifiers")
-	golang_proto.RegisterType((*EndDeviceVersionIdentifiers)(nil), "ttn.lorawan.v3.EndDeviceVersionIdentifiers")
-	proto.RegisterType((*EndDeviceVersion)(nil), "ttn.lorawan.v3.EndDeviceVersion")
-	golang_proto.RegisterType((*EndDeviceVersion)(nil), "ttn.lorawan.v3.EndDeviceVersion")
-	proto.RegisterType((*MACSettings)(nil), "ttn.lorawan.v3.MACSettings")
-	golang_proto.RegisterType((*MACSettings)(nil), "ttn.lorawan.v3.MACSettings")
-	proto.RegisterType((*MACState)(nil), "ttn.lorawan.v3.MACState")
-	golang_proto.RegisterType((*MACState)(nil), "ttn.lorawan.v3.MACState")
-	proto.RegisterType((*MACState_JoinAccept)(nil), "ttn.lorawan.v3.MACState.JoinAccept")
-	golang_proto.RegisterType((*MACState_JoinAccept)(nil), "ttn.lorawan.v3.MACState.JoinAccept")
-	proto.RegisterType((*EndDeviceAuthenticationCode)(nil), "ttn.lorawan.v3.EndDeviceAuthenticationCode")
-	golang_proto.RegisterType((*EndDeviceAuthenticationCode)(nil), "ttn.lorawan.v3.EndDeviceAuthenticationCode")
-	proto.RegisterType((*EndDevice)(nil), "ttn.lorawan.v3.EndDevice")
-	golang_proto.RegisterType((*EndDevice)(nil), "ttn.lorawan.v3.EndDevice")
-	proto.RegisterMapType((map[string]string)(nil), "ttn.lorawan.v3.EndDevice.AttributesEntry")
-	golang_proto.RegisterMapType((map[string]string)(nil), "ttn.lorawan.v3.EndDevice.AttributesEntry")
-	proto.RegisterMapType((map[string]*Location)(nil), "ttn.lorawan.v3.EndDevice.LocationsEntry")
-	golang_proto.RegisterMapType((map[string]*Location)(nil), "ttn.lorawan.v3.EndDevice.LocationsEntry")
-	proto.RegisterType((*EndDevices)(nil), "ttn.lorawan.v3.EndDevices")
-	golang_proto.RegisterType((*EndDevices)(nil), "ttn.lorawan.v3.EndDevices")
-	proto.RegisterType((*CreateEndDeviceRequest)(nil), "ttn.lorawan.v3.CreateEndDeviceRequest")
-	golang_proto.RegisterType((*CreateEndDeviceRequest)(nil), "ttn.lorawan.v3.CreateEndDeviceRequest")
-	proto.RegisterType((*UpdateEndDeviceRequest)(nil), "ttn.lorawan.v3.UpdateEndDeviceRequest")
-	golang_proto.RegisterType((*UpdateEndDeviceRequest)(nil), "ttn.lorawan.v3.UpdateEndDeviceRequest")
-	proto.RegisterType((*GetEndDeviceRequest)(nil), "ttn.lorawan.v3.GetEndDeviceRequest")
-	golang_proto.RegisterType((*GetEndDeviceRequest)(nil), "ttn.lorawan.v3.GetEndDeviceRequest")
-	proto.RegisterType((*GetEndDeviceIdentifiersForEUIsRequest)(nil), "ttn.lorawan.v3.GetEndDeviceIdentifiersForEUIsRequest")
-	golang_proto.RegisterType((*GetEndDeviceIdentifiersForEUIsRequest)(nil), "ttn.lorawan.v3.GetEndDeviceIdentifiersForEUIsRequest")
-	proto.RegisterType((*ListEndDevicesRequest)(nil), "ttn.lorawan.v3.ListEndDevicesRequest")
-	golang_proto.RegisterType((*ListEndDevicesRequest)(nil), "ttn.lorawan.v3.ListEndDevicesRequest")
-	proto.RegisterType((*SetEndDeviceRequest)(nil), "ttn.lorawan.v3.SetEndDeviceRequest")
-	golang_proto.RegisterType((*SetEndDeviceRequest)(nil), "ttn.lorawan.v3.SetEndDeviceRequest")
-	proto.RegisterType((*EndDeviceTemplate)(nil), "ttn.lorawan.v3.EndDeviceTemplate")
-	golang_proto.RegisterType((*EndDeviceTemplate)(nil), "ttn.lorawan.v3.EndDeviceTemplate")
-	proto.RegisterType((*EndDeviceTemplateFormat)(nil), "ttn.lorawan.v3.EndDeviceTemplateFormat")
-	golang_proto.RegisterType((*EndDeviceTemplateFormat)(nil), "ttn.lorawan.v3.EndDeviceTemplateFormat")
-	proto.RegisterType((*EndDeviceTemplateFormats)(nil), "ttn.lorawan.v3.EndDeviceTemplateFormats")
-	golang_proto.RegisterType((*EndDeviceTemplateFormats)(nil), "ttn.lorawan.v3.EndDeviceTemplateFormats")
-	proto.RegisterMapType((map[string]*EndDeviceTemplateFormat)(nil), "ttn.lorawan.v3.EndDeviceTemplateFormats.FormatsEntry")
-	golang_proto.RegisterMapType((map[string]*EndDeviceTemplateFormat)(nil), "ttn.lorawan.v3.EndDeviceTemplateFormats.FormatsEntry")
-	proto.RegisterType((*ConvertEndDeviceTemplateRequest)(nil), "ttn.lorawan.v3.ConvertEndDeviceTemplateRequest")
-	golang_proto.RegisterType((*ConvertEndDeviceTemplateRequest)(nil), "ttn.lorawan.v3.ConvertEndDeviceTemplateRequest")
+func (m *BatchEndDevicesResponse_Result) GetError() *ErrorDetails {
+	if m != nil {
+		return m.Error
+	}
+	return nil
 }
 
-func init() { proto.RegisterFile("lorawan-stack/api/end_device.proto", fileDescriptor_a656ee0551c94a80) }
-func init() {
-	golang_proto.RegisterFile("lorawan-stack/api/end_device.proto", fileDescriptor_a656ee0551c94a80)
+type EndDeviceProfileIdentifiers struct {
+	// Identifiers of the application of the profile.
+	// Profiles without application identifiers are global profiles, which can be used by end devices of all applications.
+	ApplicationIDs       *ApplicationIdentifiers `protobuf:"bytes,1,opt,name=application_ids,json=applicationIds,proto3" json:"application_ids,omitempty"`
+	ProfileID            string                  `protobuf:"bytes,2,opt,name=profile_id,json=profileId,proto3" json:"profile_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
 }
 
-var fileDescriptor_a656ee0551c94a80 = []byte{
-	// 4879 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5b, 0x4d, 0x6c, 0x1c, 0x47,
-	0x76, 0x9e, 0xe6, 0xdf, 0xcc, 0x3c, 0xfe, 0xcc, 0xb0, 0x48, 0x8a, 0x2d, 0x4a, 0x9a, 0xa1, 0x46,
-	0x94, 0x4d, 0xc9, 0x22, 0x25, 0x8e, 0x6c, 0xaf, 0x2d, 0xdb, 0xd1, 0x4e, 0x73, 0x48, 0x7b, 0x24,
-	0x51, 0xe6, 0x96, 0xfe, 0x62, 0xfd, 0xf5, 0x16, 0xa7, 0x8b, 0x54, 0x9b, 0x33, 0xdd, 0xb3, 0xdd,
-	0x3d, 0x14, 0x69, 0x5b, 0x80, 0xb1, 0x48, 0xb0, 0x9b, 0x45, 0x12, 0x6c, 0x7c, 0x89, 0x91, 0x43,
-	0x60, 0x04, 0x08, 0xb0, 0xa7, 0x60, 0x11, 0x24, 0x80, 0x6f, 0xd9, 0x1c, 0x12, 0xf8, 0x12, 0xc0,
-	0x87, 0x3d, 0x2c, 0x16, 0x08, 0xb3, 0x1a, 0x5d, 0x7c, 0xdc, 0xe3, 0x82, 0x87, 0x20, 0xa8, 0x9f,
-	0xfe, 0x99, 0x1f, 0x52, 0x43, 0xdb, 0x59, 0xf8, 0x42, 0x76, 0x57, 0xbd, 0xf7, 0xbd, 0x57, 0xaf,
-	0xaa, 0x5e, 0xbd, 0xf7, 0xaa, 0x07, 0x72, 0x15, 0xdb, 0x21, 0x8f, 0x89, 0x35, 0xe7, 0x7a, 0xa4,
-	0xbc, 0x79, 0x9e, 0xd4, 0xcc, 0xf3, 0xd4, 0x32, 0x74, 0x83, 0x6e, 0x99, 0x65, 0x3a, 0x5f, 0x73,
-	0x6c, 0xcf, 0x46, 0x23, 0x9e, 0x67, 0xcd, 0x4b, 0xba, 0xf9, 0xad, 0x8b, 0x53, 0x85, 0x0d, 0xd3,
-	0x7b, 0x54, 0x5f, 0x9b, 0x2f, 0xdb, 0xd5, 0xf3, 0xd4, 0xda, 0xb2, 0x77, 0x6a, 0x8e, 0xbd, 0xbd,
-	0x73, 0x9e, 0x13, 0x97, 0xe7, 0x36, 0xa8, 0x35, 0xb7, 0x45, 0x2a, 0xa6, 0x41, 0x3c, 0x7a, 0xbe,
-	0xed, 0x41, 0x40, 0x4e, 0xcd, 0x45, 0x20, 0x36, 0xec, 0x0d, 0x5b, 0x30, 0xaf, 0xd5, 0xd7, 0xf9,
-	0x1b, 0x7f, 0xe1, 0x4f, 0x92, 0xfc, 0xf8, 0x86, 0x6d, 0x6f, 0x54, 0x28, 0x57, 0x8f, 0x58, 0x96,
-	0xed, 0x11, 0xcf, 0xb4, 0x2d, 0x57, 0xf6, 0x66, 0x64, 0x6f, 0x80, 0x61, 0xd4, 0x1d, 0x4e, 0x20,
-	0xfb, 0x8f, 0xb5, 0xf6, 0xd3, 0x6a, 0xcd, 0xdb, 0x91, 0x9d, 0xd3, 0xad, 0x9d, 0xeb, 0x26, 0xad,
-	0x18, 0x7a, 0x95, 0xb8, 0x9b, 0x2d, 0xc2, 0x03, 0x0a, 0xd7, 0x73, 0xea, 0x65, 0x4f, 0xf6, 0x66,
-	0x5b, 0x7b, 0x3d, 0xb3, 0x4a, 0x5d, 0x8f, 0x54, 0x6b, 0xfb, 0x69, 0xf7, 0xd8, 0x21, 0xb5, 0x1a,
-	0x75, 0x7c, 0xed, 0x4f, 0xb5, 0xcf, 0x80, 0x69, 0x50, 0xcb, 0x33, 0xd7, 0xcd, 0x90, 0xe8, 0x78,
-	0x3b, 0xd1, 0xfb, 0xb6, 0x69, 0xed, 0xdf, 0xbb, 0x49, 0x77, 0x7c, 0xde, 0x6c, 0x7b, 0xaf, 0x3f,
-	0x99, 0xd2, 0x04, 0xed, 0x04, 0x55, 0xea, 0xba, 0x64, 0x83, 0x1e, 0x00, 0x51, 0x33, 0xcb, 0x5e,
-	0xdd, 0xa1, 0x07, 0x41, 0x78, 0xc4, 0x20, 0x1e, 0x11, 0x14, 0xb9, 0xbf, 0xed, 0x85, 0xf8, 0x0d,
-	0xea, 0xba, 0xa6, 0x6d, 0xa1, 0x3b, 0x90, 0x30, 0xe8, 0x96, 0x4e, 0x0c, 0xc3, 0x51, 0x7b, 0xa6,
-	0x95, 0xd9, 0x21, 0xed, 0xcd, 0x2f, 0x76, 0xb3, 0xb1, 0xdf, 0xee, 0x66, 0x5f, 0xde, 0xb0, 0xe7,
-	0xbd, 0x47, 0xd4, 0x7b, 0x64, 0x5a, 0x1b, 0xee, 0xbc, 0x45, 0xbd, 0xc7, 0xb6, 0xb3, 0x79, 0xbe,
-	0x19, 0xbc, 0xb6, 0xb9, 0x71, 0xde, 0xdb, 0xa9, 0x51, 0x77, 0xbe, 0x48, 0xb7, 0x0a, 0x86, 0xe1,
-	0xe0, 0xb8, 0x21, 0x1e, 0x50, 0x01, 0xfa, 0xd8, 0xc0, 0xd5, 0xde, 0x69, 0x65, 0x76, 0x30, 0x7f,
-	0x6c, 0xbe, 0x79, 0xe1, 0xce, 0x4b, 0xf9, 0x57, 0xe9, 0x8e, 0xab, 0xa5, 0xf7, 0xb4, 0xfe, 0x9f,
-	0x29, 0x3d, 0x69, 0x85, 0x49, 0xfe, 0x72, 0x37, 0xab, 0x60, 0xce, 0x8a, 0x4e, 0xc2, 0x70, 0x85,
-	0xb8, 0x9e, 0xbe, 0xae, 0x97, 0x2d, 0x4f, 0xaf, 0xd7, 0xd4, 0xbe, 0x69, 0x65, 0x76, 0x18, 0x03,
-	0x6b, 0x5c, 0x5e, 0xb4, 0xbc, 0x5b, 0x35, 0x34, 0x0b, 0xa3, 0x9c, 0xc4, 0x92, 0x44, 0x86, 0xfd,
-	0xd8, 0x52, 0xfb, 0x39, 0x19, 0xe7, 0xbd, 0xce, 0xe8, 0x8a, 0xf6, 0x63, 0x2b, 0xa0, 0x24, 0x51,
-	0xca, 0x81, 0x90, 0xb2, 0x10, 0x50, 0xce, 0xc3, 0x38, 0xa7, 0x2c, 0xdb, 0xd6, 0x7a, 0x94, 0x38,
-	0xce, 0x89, 0xd3, 0xac, 0x6f, 0xd1, 0xb6, 0xd6, 0x03, 0xfa, 0x45, 0x00, 0xd7, 0x23, 0x8e, 0x47,
-	0x0d, 0x9d, 0x78, 0x6a, 0x82, 0x8f, 0x77, 0x6a, 0x5e, 0x2c, 0xb5, 0x79, 0x7f, 0xa9, 0xcd, 0xdf,
-	0xf4, 0xd7, 0xa2, 0x96, 0x60, 0xc3, 0xfc, 0xf9, 0xff, 0x64, 0x15, 0x9c, 0x94, 0x7c, 0x05, 0xef,
-	0x4a, 0x5f, 0x42, 0x49, 0xf7, 0xe4, 0xfe, 0x29, 0x0d, 0xc3, 0x2b, 0x85, 0xc5, 0x55, 0xe2, 0x90,
-	0x2a, 0xf5, 0xa8, 0xe3, 0xa2, 0x17, 0x20, 0x51, 0x25, 0xdb, 0x3a, 0x35, 0x9d, 0x9a, 0xaa, 0x4c,
-	0x2b, 0xb3, 0x3d, 0xda, 0x60, 0x63, 0x37, 0x1b, 0x5f, 0x21, 0xdb, 0x4b, 0x25, 0xbc, 0x8a, 0xe3,
-	0x55, 0xb2, 0xbd, 0x64, 0x3a, 0x35, 0xf4, 0x3e, 0x8c, 0x11, 0xc3, 0xd1, 0xd9, 0x2c, 0xeb, 0x0e,
-	0xf1, 0xa8, 0x6e, 0x5a, 0x06, 0xdd, 0xe6, 0x16, 0x1b, 0xc9, 0x9f, 0x68, 0xb5, 0x7e, 0x91, 0x78,
-	0x04, 0x13, 0x8f, 0x96, 0x18, 0x91, 0x76, 0x7c, 0x4f, 0xeb, 0xff, 0x31, 0xb3, 0x7f, 0x63, 0x37,
-	0x9b, 0x2e, 0x14, 0x71, 0x53, 0x2f, 0x4e, 0x13, 0xc3, 0x69, 0x6a, 0x41, 0x6f, 0x03, 0x62, 0xb2,
-	0xbc, 0x6d, 0xbd, 0x66, 0x3f, 0xa6, 0x8e, 0x14, 0xc5, 0xad, 0xae, 0x4d, 0xed, 0x69, 0x7d, 0x67,
-	0x7b, 0xd4, 0x54, 0x63, 0x37, 0x9b, 0x2a, 0x14, 0xf1, 0xcd, 0xed, 0x55, 0x46, 0x22, 0x90, 0x52,
-	0xc4, 0x70, 0xa2, 0x0d, 0xe8, 0x7b, 0x30, 0xc4, 0x80, 0xac, 0x35, 0xdd, 0x73, 0x88, 0xe5, 0x8a,
-	0xe9, 0xd0, 0x26, 0x42, 0x08, 0x28, 0x14, 0xf1, 0xf5, 0xb5, 0x9b, 0xac, 0x13, 0x03, 0x31, 0x1c,
-	0xf9, 0x8c, 0x5e, 0x81, 0x61, 0xc6, 0x48, 0xca, 0x9b, 0x7a, 0xc5, 0xac, 0x9a, 0x9e, 0x98, 0x1b,
-	0x6d, 0xb4, 0xb1, 0x9b, 0x1d, 0x2c, 0x14, 0x71, 0xa1, 0xbc, 0x79, 0x8d, 0x37, 0x2b, 0x78, 0x90,
-	0x18, 0x8e, 0xff, 0x1a, 0x65, 0x33, 0x68, 0x85, 0xec, 0xf0, 0xc9, 0x6a, 0x62, 0x2b, 0xf2, 0xe6,
-	0x80, 0x8d, 0xbf, 0xa2, 0x3f, 0x81, 0xa4, 0xb3, 0xbd, 0x20, 0x59, 0x92, 0xdc, 0xa2, 0x93, 0xad,
-	0x16, 0xc5, 0xdb, 0x9c, 0x56, 0x4b, 0xf8, 0xb6, 0xc4, 0x09, 0x67, 0x7b, 0x41, 0xf0, 0xbf, 0x06,
-	0xe3, 0x9c, 0x3f, 0x98, 0x1b, 0x7b, 0x7d, 0xdd, 0xa5, 0x9e, 0x0a, 0x5c, 0x7a, 0x5c, 0x0c, 0x37,
-	0x8e, 0x47, 0x19, 0x83, 0x34, 0xf4, 0xbb, 0x9c, 0x02, 0xdd, 0x86, 0x31, 0x67, 0x3b, 0xdf, 0x36,
-	0xab, 0x83, 0xdd, 0xcc, 0x6a, 0xa8, 0x49, 0xda, 0xd9, 0xce, 0x37, 0xcf, 0xe0, 0x3c, 0x0c, 0x33,
-	0xdc, 0x75, 0x87, 0xfe, 0xa8, 0x4e, 0xad, 0xf2, 0x8e, 0x3a, 0x34, 0xad, 0xcc, 0xf6, 0x69, 0xc9,
-	0x3d, 0x6d, 0x20, 0xdf, 0x37, 0xfb, 0xd9, 0x5f, 0x0d, 0xe0, 0x21, 0x67, 0x3b, 0xbf, 0xec, 0x77,
-	0xa3, 0x1b, 0x30, 0xc2, 0x56, 0xa1, 0x51, 0xf7, 0x76, 0xf4, 0xf2, 0x4e, 0xb9, 0x42, 0xd5, 0x61,
-	0xae, 0xc2, 0xa9, 0x56, 0x15, 0x0a, 0x1b, 0x1b, 0x0e, 0xdd, 0x20, 0x1e, 0x35, 0x8a, 0x75, 0x6f,
-	0x67, 0x91, 0x91, 0x46, 0x14, 0x19, 0xaa, 0x92, 0xed, 0xa0, 0x1d, 0x19, 0x30, 0xe9, 0x50, 0xe6,
-	0x3a, 0x75, 0xe6, 0xa7, 0xf5, 0x1a, 0x75, 0x4c, 0xdb, 0x30, 0xcb, 0xa6, 0xb7, 0xa3, 0x8e, 0x70,
-	0xf4, 0x5c, 0x9b, 0x91, 0x39, 0x39, 0xdb, 0x49, 0x4b, 0xdb, 0x35, 0xdb, 0xa2, 0x96, 0x17, 0x01,
-	0x9f, 0x70, 0x82, 0xde, 0xd5, 0x10, 0x0a, 0x6d, 0x80, 0x2a, 0xa5, 0x94, 0xed, 0xba, 0xe5, 0x35,
-	0x89, 0x49, 0x75, 0x1e, 0x84, 0x10, 0xb3, 0xc8, 0xc8, 0x3b, 0xc8, 0x39, 0xe2, 0x84, 0xdd, 0x51,
-	0x41, 0x6f, 0xc0, 0x58, 0xcd, 0xb4, 0x36, 0x74, 0xb7, 0x62, 0x7b, 0x11, 0xcb, 0xa6, 0xb9, 0x65,
-	0x07, 0xf7, 0xb4, 0x44, 0x7e, 0x40, 0x8d, 0x71, 0xdb, 0x8e, 0x32, 0xba, 0x1b, 0x15, 0xdb, 0x0b,
-	0x0d, 0x7c, 0x0f, 0x8e, 0x86, 0xcc, 0xad, 0xd3, 0x3d, 0xda, 0xcd, 0x74, 0xf7, 0xa8, 0x0a, 0x9e,
-	0xf0, 0x81, 0x9b, 0x67, 0xfb, 0x55, 0x48, 0xaf, 0x51, 0x52, 0xb6, 0xad, 0x88, 0x5a, 0xa8, 0x5d,
-	0xad, 0x94, 0x20, 0x0a, 0x95, 0xba, 0x0a, 0x89, 0xf2, 0x23, 0x62, 0x59, 0xb4, 0xe2, 0xaa, 0x63,
-	0xd3, 0xbd, 0xb3, 0x83, 0xf9, 0xd3, 0xad, 0x3a, 0x34, 0x39, 0xab, 0xf9, 0x45, 0x41, 0xcd, 0x8d,
-	0xf5, 0x89, 0xd2, 0x93, 0x50, 0x70, 0x00, 0x80, 0x96, 0x61, 0xb4, 0x5e, 0xab, 0x98, 0xd6, 0xa6,
-	0x6e, 0x3c, 0xa6, 0x95, 0x0a, 0x9f, 0x73, 0x75, 0x7c, 0x1f, 0x67, 0xa9, 0xd9, 0x76, 0xe5, 0x36,
-	0xa9, 0xd4, 0x29, 0x4e, 0x09, 0xa6, 0x22, 0xe3, 0x61, 0x53, 0x8b, 0xae, 0xc0, 0x18, 0xf3, 0xc6,
-	0xad, 0x48, 0x13, 0xcf, 0x45, 0x1a, 0xf5, 0xd9, 0x42, 0xac, 0x2d, 0x38, 0xd2, 0xe4, 0x46, 0x74,
-	0x2a, 0xa7, 0x5b, 0x3d, 0xc2, 0xe1, 0x66, 0xdb, 0x96, 0x77, 0xe8, 0x5b, 0xfc, 0x95, 0xc1, 0xc1,
-	0xb5, 0xc9, 0xc6, 0x6e, 0x76, 0xac, 0x43, 0x2f, 0x1e, 0x8b, 0xf8, 0x1f, 0xbf, 0x31, 0x2a, 0x97,
-	0x3b, 0x95, 0x50, 0xee, 0xe4, 0x41, 0x72, 0xb9, 0x37, 0xd9, 0x57, 0x6e, 0x53, 0xaf, 0x2f, 0xb7,
-	0xa9, 0x11, 0x6d, 0x40, 0x76, 0xdf, 0x55, 0xa6, 0x6f, 0x31, 0x40, 0x55, 0xe5, 0x0a, 0xe4, 0x0e,
-	0x5c, 0x6b, 0xc2, 0x9e, 0x53, 0x1d, 0x17, 0x1b, 0xef, 0x9b, 0xfa, 0x75, 0x0f, 0xc4, 0xe5, 0x62,
-	0x40, 0x2f, 0x43, 0x5a, 0x4e, 0x7c, 0xb8, 0xfa, 0x94, 0x56, 0x77, 0x23, 0xa7, 0x39, 0x5c, 0x7b,
-	0xaf, 0x01, 0x0a, 0xa6, 0x39, 0xe4, 0xeb, 0x69, 0xe5, 0x0b, 0x26, 0x35, 0xe4, 0xbc, 0x0d, 0x63,
-	0x55, 0xd3, 0x6a, 0xdb, 0x44, 0xbd, 0x87, 0xf4, 0x99, 0x55, 0xd3, 0x6a, 0xde, 0x45, 0x0c, 0x97,
-	0xf9, 0xc0, 0xaf, 0x73, 0xc2, 0x46, 0x71, 0xc9, 0x76, 0x33, 0xee, 0x29, 0x18, 0xa6, 0x16, 0x59,
-	0xab, 0x50, 0x5d, 0xd8, 0x80, 0x1f, 0xa4, 0x09, 0x3c, 0x24, 0x1a, 0x6f, 0xf1, 0xb6, 0x4b, 0x7d,
-	0x9f, 0x7f, 0x96, 0x8d, 0x89, 0xbf, 0x57, 0xfa, 0x12, 0x3d, 0xe9, 0xde, 0x2b, 0x7d, 0x89, 0xde,
-	0x74, 0x5f, 0xae, 0x0a, 0x23, 0x4b, 0x96, 0x51, 0xe4, 0x29, 0x82, 0xe6, 0x10, 0xcb, 0x40, 0x47,
-	0xa0, 0xc7, 0x34, 0xb8, 0x81, 0x93, 0xda, 0x40, 0x63, 0x37, 0xdb, 0x53, 0x2a, 0xe2, 0x1e, 0xd3,
-	0x40, 0x08, 0xfa, 0x2c, 0x52, 0xa5, 0xdc, 0x84, 0x49, 0xcc, 0x9f, 0xd1, 0x51, 0xe8, 0xad, 0x3b,
-	0x15, 0x6e, 0x9a, 0xa4, 0x16, 0x6f, 0xec, 0x66, 0x7b, 0x6f, 0xe1, 0x6b, 0x98, 0xb5, 0xa1, 0x71,
-	0xe8, 0xaf, 0xd8, 0x1b, 0xb6, 0xab, 0xf6, 0x4d, 0xf7, 0xce, 0x26, 0xb1, 0x78, 0xc9, 0xfd, 0xb3,
-	0x12, 0x91, 0xb7, 0x62, 0x1b, 0xb4, 0x82, 0x56, 0x20, 0xb1, 0xc6, 0x04, 0xeb, 0x81, 0xd4, 0xfc,
-	0x9e, 0x36, 0xe3, 0xe4, 0xd4, 0x99, 0x7c, 0xe6, 0xe1, 0x3d, 0x32, 0xf7, 0xc1, 0x85, 0xb9, 0xd7,
-	0x1f, 0xcc, 0x5e, 0xbe, 0x74, 0x6f, 0xee, 0xc1, 0x65, 0xff, 0xf5, 0xcc, 0x87, 0xf9, 0x73, 0x4f,
-	0x66, 0x58, 0x1c, 0xc3, 0x75, 0x2e, 0x15, 0x71, 0x9c, 0x63, 0x94, 0x0c, 0xf4, 0x16, 0x57, 0x9f,
-	0x2b, 0xa9, 0xcd, 0x75, 0x0f, 0xd4, 0x3a, 0xca, 0xde, 0x70, 0x94, 0xb9, 0xbf, 0xe9, 0x81, 0x63,
-	0x81, 0xd2, 0xb7, 0xa9, 0xc3, 0xe2, 0xce, 0x52, 0x18, 0xd6, 0x7f, 0xdb, 0x23, 0x58, 0x81, 0x44,
-	0x95, 0x59, 0x46, 0x0f, 0xc6, 0x71, 0x18, 0x38, 0x6e, 0x54, 0x06, 0xc7, 0x31, 0x4a, 0x06, 0x3a,
-	0x03, 0xe9, 0x47, 0xc4, 0x31, 0x1e, 0x13, 0x87, 0xea, 0x5b, 0x42, 0x79, 0x39, 0xba, 0x94, 0xdf,
-	0x2e, 0xc7, 0xc4, 0x48, 0xd7, 0x4d, 0xa7, 0xda, 0x44, 0xda, 0x27, 0x48, 0xfd, 0x76, 0x49, 0x9a,
-	0xfb, 0xf5, 0x00, 0xa4, 0x5b, 0x6d, 0x82, 0xde, 0x85, 0x5e, 0xd3, 0x70, 0xb9, 0x0d, 0x06, 0xf3,
-	0x2f, 0xb5, 0xae, 0xe8, 0x03, 0x4c, 0xd8, 0x21, 0x82, 0x67, 0x48, 0x48, 0x87, 0x94, 0x04, 0x08,
-	0xf4, 0xe9, 0xe1, 0xdb, 0x65, 0xaa, 0xc3, 0x39, 0x22, 0x61, 0x59, 0x04, 0x19, 0x44, 0xa3, 0x23,
-	0xd7, 0x6c, 0x4c, 0xee, 0x14, 0xae, 0xcb, 0x3e, 0x3c, 0x22, 0x59, 0x7c, 0x8d, 0x4d, 0x18, 0xf3,
-	0x05, 0xd4, 0x1e, 0xed, 0x34, 0xd9, 0xa7, 0x83, 0x90, 0xd5, 0x77, 0xde, 0xf3, 0x85, 0x9c, 0x88,
-	0x08, 0x19, 0x95, 0x42, 0xc2, 0x6e, 0x3c, 0x2a, 0xb9, 0x56, 0x1f, 0xed, 0xf8, 0xa2, 0x96, 0x61,
-	0x34, 0xf0, 0x43, 0x7a, 0xad, 0x42, 0x2c, 0x36, 0xbf, 0xdc, 0xba, 0x3c, 0xe6, 0x75, 0x7a, 0xd4,
-	0xef, 0xb3, 0x98, 0x37, 0xf0, 0x43, 0xab, 0x15, 0x62, 0x95, 0x8a, 0x38, 0xb5, 0xde, 0xd4, 0xc0,
-	0xf6, 0xe7, 0x40, 0xed, 0x91, 0xed, 0xd9, 0xae, 0xda, 0xcf, 0x77, 0x96, 0x7c, 0x43, 0xb3, 0x90,
-	0x76, 0xeb, 0xb5, 0x9a, 0xed, 0x78, 0xae, 0x5e, 0xae, 0x10, 0xd7, 0xd5, 0xd7, 0x78, 0x3c, 0x9c,
-	0xc0, 0x23, 0x7e, 0xfb, 0x22, 0x6b, 0xd6, 0x3a, 0x50, 0x96, 0x79, 0xfc, 0xdb, 0x4a, 0xb9, 0x88,
-	0x28, 0x8c, 0x1b, 0x74, 0x9d, 0xd4, 0x2b, 0x9e, 0x5e, 0x25, 0x65, 0xdd, 0xa5, 0x9e, 0xc7, 0x92,
-	0x39, 0x99, 0xa3, 0x1c, 0xeb, 0x30, 0x09, 0x37, 0x24, 0x89, 0x76, 0xa4, 0xb1, 0x9b, 0x45, 0x45,
-	0xc1, 0x1c, 0x69, 0xc7, 0x48, 0x02, 0xae, 0x90, 0xb2, 0xdf, 0xc6, 0x3c, 0x18, 0xf3, 0xb8, 0xa1,
-	0x9b, 0x66, 0x31, 0x72, 0x1f, 0x1e, 0xaa, 0x9a, 0x91, 0x60, 0x82, 0x11, 0x91, 0xed, 0x08, 0x11,
-	0x48, 0x22, 0xb2, 0xdd, 0x44, 0x14, 0x0c, 0x8d, 0x05, 0x59, 0x3c, 0xd2, 0x4d, 0xe0, 0x21, 0xbf,
-	0xf1, 0x8a, 0x6d, 0x5a, 0xe8, 0x1c, 0x20, 0x87, 0xba, 0x54, 0x92, 0xe8, 0x96, 0x6d, 0x95, 0xa9,
-	0xcb, 0x23, 0xd8, 0x04, 0x4e, 0x8b, 0x1e, 0x46, 0x77, 0x9d, 0xb7, 0x23, 0x0a, 0xbe, 0xca, 0xfa,
-	0xba, 0xed, 0x54, 0x89, 0xc7, 0x22, 0x15, 0x1e, 0xbe, 0x76, 0x38, 0x67, 0x57, 0x44, 0xae, 0xbd,
-	0x4a, 0x76, 0x2a, 0x36, 0x31, 0x96, 0x03, 0x7a, 0x6d, 0x28, 0xba, 0xc0, 0xf1, 0xa8, 0x44, 0x0c,
-	0x09, 0x84, 0x6b, 0xce, 0xfd, 0xfb, 0x18, 0x0c, 0x46, 0xac, 0x85, 0xde, 0x86, 0x94, 0x9c, 0x4b,
-	0x1e, 0xa5, 0xd8, 0x75, 0x4f, 0xee, 0xae, 0xa3, 0x6d, 0x81, 0x4a, 0x51, 0x16, 0x4a, 0xb4, 0xbe,
-	0x4f, 0x59, 0x6a, 0x38, 0xcc, 0xf9, 0xb4, 0x9b, 0x82, 0x0b, 0xdd, 0x81, 0x89, 0xf0, 0xe4, 0x8e,
-	0x86, 0xb0, 0x3d, 0x1c, 0xae, 0x2d, 0x84, 0x5d, 0x95, 0x67, 0xb3, 0x08, 0x50, 0xc5, 0x81, 0x3d,
-	0x56, 0x6b, 0x6a, 0x14, 0x51, 0xeb, 0xfd, 0x83, 0x02, 0xcf, 0xde, 0xae, 0x83, 0x81, 0x7d, 0x22,
-	0xcf, 0x3b, 0x9d, 0x63, 0xe2, 0x3e, 0x8e, 0x7b, 0xbc, 0xcd, 0x06, 0xb7, 0x4a, 0x96, 0xf7, 0xea,
-	0xcb, 0x22, 0xb2, 0x89, 0x1e, 0xf2, 0xed, 0xf1, 0x32, 0xee, 0x10, 0xd2, 0x1e, 0x3d, 0x1c, 0x6a,
-	0x5b, 0xb8, 0x1b, 0x4c, 0x56, 0x39, 0x98, 0xac, 0xfe, 0xc3, 0x4c, 0xd6, 0xa2, 0x3f, 0x59, 0xaf,
-	0x47, 0xf3, 0xc5, 0x01, 0xa9, 0x55, 0xe7, 0x7c, 0x51, 0x58, 0x2f, 0x4c, 0x15, 0x6f, 0xef, 0x93,
-	0x2a, 0xc6, 0x0f, 0x18, 0xdb, 0xc5, 0xbc, 0x18, 0xdb, 0x41, 0x89, 0xe4, 0x0f, 0x3a, 0x27, 0x92,
-	0x89, 0xae, 0x27, 0xb8, 0x3d, 0x87, 0xbc, 0xd6, 0x9a, 0x43, 0x26, 0x0f, 0x67, 0xff, 0xe6, 0x0c,
-	0xf3, 0x4d, 0x98, 0x5a, 0x27, 0x65, 0xcf, 0x76, 0x76, 0xf4, 0x1a, 0xdf, 0xc3, 0x01, 0xb0, 0x49,
-	0x5d, 0x15, 0xa6, 0x7b, 0x67, 0xfb, 0xb0, 0x2a, 0x29, 0x56, 0x39, 0xc1, 0x72, 0xd8, 0x8f, 0xae,
-	0xb7, 0xe5, 0xa7, 0x83, 0xfb, 0x04, 0xd2, 0xed, 0xf9, 0xa9, 0x18, 0x5f, 0x73, 0x6a, 0x5a, 0x86,
-	0x89, 0xc0, 0x0f, 0x5d, 0xcc, 0xeb, 0x6b, 0xa6, 0x2c, 0x42, 0x71, 0x2f, 0x73, 0x60, 0x9a, 0xa1,
-	0x4d, 0xb0, 0x13, 0xe5, 0x86, 0x64, 0xbe, 0x98, 0xd7, 0x4c, 0x5e, 0xaa, 0xc2, 0xa3, 0x6e, 0x6b,
-	0x13, 0xba, 0x0c, 0xf1, 0xba, 0x4b, 0x75, 0x62, 0x38, 0xd2, 0x1d, 0x1d, 0x04, 0x0b, 0x8d, 0xdd,
-	0xec, 0xc0, 0x2d, 0x97, 0x16, 0x8a, 0x18, 0x0f, 0xd4, 0x5d, 0x5a, 0x30, 0x1c, 0x54, 0x02, 0x60,
-	0x69, 0x44, 0x95, 0x38, 0x1b, 0xa6, 0xc5, 0x73, 0x66, 0xe6, 0xd4, 0x5b, 0x31, 0x96, 0x2b, 0x36,
-	0x91, 0xd9, 0xc2, 0x70, 0x63, 0x37, 0x9b, 0x2c, 0x14, 0xf1, 0x0a, 0xe7, 0xc0, 0x49, 0x62, 0x38,
-	0xe2, 0x11, 0xbd, 0x09, 0x43, 0xd2, 0xa7, 0x8a, 0x71, 0xa6, 0x9e, 0x9b, 0x4e, 0x81, 0xa0, 0xe7,
-	0x23, 0xb9, 0x03, 0x93, 0xae, 0x47, 0xbc, 0xba, 0xdb, 0x9e, 0xc9, 0xa7, 0xbb, 0xdb, 0x41, 0x13,
-	0x82, 0xbf, 0x35, 0x79, 0xbf, 0x0d, 0xaa, 0x04, 0x6e, 0x4f, 0xde, 0x47, 0x9f, 0xbf, 0x25, 0xf0,
-	0x11, 0xc1, 0xdd, 0x96, 0xab, 0xbf, 0x03, 0xa3, 0x06, 0x75, 0x4d, 0x87, 0x1a, 0x7a, 0xb8, 0x53,
-	0x51, 0x17, 0x3b, 0x35, 0x25, 0xd9, 0xb0, 0xbf, 0x61, 0xef, 0xc3, 0xf1, 0x26, 0xa4, 0xd6, 0x8d,
-	0x3b, 0xd6, 0x85, 0x96, 0x6a, 0x04, 0xb4, 0x79, 0xdb, 0xfe, 0x10, 0x8e, 0x85, 0xe8, 0xed, 0xdb,
-	0x77, 0xbc, 0xeb, 0xed, 0x3b, 0x19, 0x88, 0x68, 0xd9, 0xc5, 0xf7, 0x60, 0x22, 0x2a, 0x21, 0xdc,
-	0xcd, 0x13, 0x87, 0xdb, 0xcd, 0x63, 0xa1, 0x80, 0x70, 0x53, 0x3f, 0x80, 0x23, 0x3e, 0x78, 0xcb,
-	0xf6, 0x3c, 0x72, 0xc8, 0xed, 0xe9, 0xc3, 0xaf, 0x44, 0x77, 0xe9, 0x5f, 0x2a, 0x90, 0xf1, 0xf1,
-	0xf7, 0xc9, 0xe3, 0x27, 0x0f, 0x99, 0xc7, 0x67, 0x1a, 0xbb, 0xd9, 0xa9, 0xa2, 0xc0, 0xec, 0x94,
-	0xce, 0x4f, 0x49, 0x79, 0x85, 0x0e, 0x59, 0x7d, 0x27, 0x75, 0x5a, 0xd2, 0x7b, 0xf5, 0x90, 0xe9,
-	0x7d, 0xbb, 0x3a, 0xcd, 0x59, 0x7e, 0xb3, 0x3a, 0xcd, 0xc9, 0xfe, 0x26, 0x9c, 0xf4, 0xb5, 0xd9,
-	0xff, 0x84, 0x3f, 0xd6, 0xf5, 0x0a, 0xf2, 0x97, 0xf9, 0x6a, 0xc7, 0x83, 0x7e, 0x3d, 0x5c, 0xa8,
-	0x9d, 0x0e, 0xfc, 0xe3, 0x87, 0x5b, 0x4c, 0x6a, 0x8b, 0xac, 0x70, 0x45, 0x11, 0xf0, 0xfb, 0xf4,
-	0xb6, 0xf3, 0xff, 0xc4, 0xe1, 0x84, 0xf8, 0x4b, 0x53, 0x6b, 0x0e, 0x03, 0x72, 0xff, 0x3d, 0x08,
-	0x09, 0x16, 0xc3, 0x79, 0xc4, 0xa3, 0xe8, 0x2e, 0xa0, 0x72, 0xdd, 0x71, 0x28, 0xf3, 0x3d, 0x41,
-	0x9d, 0x4b, 0xc6, 0x70, 0x27, 0x0e, 0x2c, 0x86, 0xb5, 0x86, 0x8c, 0x12, 0x26, 0x52, 0xda, 0xbf,
-	0xcb, 0x22, 0x53, 0x69, 0xb3, 0x10, 0xbb, 0xe7, 0x6b, 0x60, 0xfb, 0xe6, 0x0a, 0xb1, 0x35, 0x18,
-	0x12, 0xf7, 0x86, 0x22, 0x43, 0x90, 0x19, 0xd1, 0x44, 0x2b, 0xaa, 0xc8, 0x28, 0xc2, 0xea, 0xc4,
-	0xa0, 0x60, 0xe2, 0xcd, 0x9d, 0xb2, 0xb7, 0xbe, 0x6f, 0x35, 0x7b, 0x7b, 0x00, 0x53, 0xc1, 0x45,
-	0x8b, 0xe9, 0x54, 0xa9, 0xa1, 0x07, 0x25, 0x1f, 0xe2, 0xc7, 0x5e, 0x07, 0x5d, 0xa4, 0xf4, 0xf1,
-	0x4b, 0x94, 0x49, 0xff, 0x42, 0x86, 0x43, 0x14, 0x25, 0x42, 0xc1, 0x43, 0xaf, 0x80, 0xca, 0xe1,
-	0x0d, 0xba, 0xa5, 0xcb, 0x53, 0x24, 0xb8, 0x49, 0x12, 0x17, 0x3f, 0x63, 0xac, 0xbf, 0x48, 0xb7,
-	0x6e, 0xf0, 0x5e, 0x79, 0xa5, 0xb4, 0x6f, 0xa8, 0x1d, 0xff, 0x86, 0xa1, 0x36, 0x85, 0xe3, 0x35,
-	0x6a, 0x19, 0x0c, 0x9b, 0xd4, 0x6a, 0x15, 0xb3, 0xcc, 0x0f, 0xc0, 0x60, 0xcc, 0x32, 0x18, 0x6b,
-	0x2f, 0xa9, 0x87, 0xb4, 0xfe, 0xe0, 0xf0, 0x94, 0x04, 0xea, 0xd0, 0x87, 0x96, 0x20, 0xfd, 0xa3,
-	0x3a, 0xad, 0x33, 0x87, 0x4e, 0xdd, 0x9a, 0x6d, 0xb9, 0xd4, 0x55, 0x93, 0xbc, 0x7a, 0xdb, 0x69,
-	0xde, 0x16, 0xed, 0x6a, 0x95, 0x58, 0x06, 0x4e, 0x09, 0x1e, 0xec, 0xb3, 0x30, 0x18, 0x5f, 0x5b,
-	0xbe, 0x35, 0x5c, 0x4f, 0x84, 0x61, 0xcf, 0x81, 0x91, 0x3c, 0x58, 0xb2, 0xa0, 0x1f, 0x00, 0x92,
-	0xda, 0xf0, 0x64, 0x8d, 0x94, 0xcb, 0xb4, 0xe6, 0xc9, 0xe8, 0xec, 0x54, 0xa7, 0x04, 0x94, 0x6d,
-	0xbb, 0x79, 0x96, 0xbf, 0x15, 0x38, 0x29, 0x96, 0x83, 0x09, 0x5b, 0xd0, 0x0a, 0x8c, 0xfb, 0x9a,
-	0x71, 0x4c, 0xa9, 0x9e, 0x8c, 0xcd, 0xda, 0xb2, 0x5a, 0xc6, 0x29, 0xd5, 0xc1, 0x48, 0x32, 0x46,
-	0xda, 0xd0, 0x05, 0x16, 0x72, 0xeb, 0x8f, 0x4d, 0xcb, 0xb0, 0x1f, 0xbb, 0x3a, 0xd9, 0x22, 0x66,
-	0x85, 0xac, 0xc9, 0x1b, 0x8e, 0x04, 0x46, 0xce, 0xf6, 0x1d, 0xd1, 0x55, 0xf0, 0x7b, 0x50, 0x11,
-	0x46, 0x1c, 0x5a, 0xa6, 0x7c, 0x25, 0x31, 0x93, 0xbb, 0xea, 0x08, 0x37, 0x4c, 0xdb, 0xa6, 0x15,
-	0xc5, 0x3b, 0x99, 0x54, 0xe2, 0x61, 0xc1, 0x24, 0x1a, 0x5d, 0x74, 0x05, 0xd2, 0x12, 0xc5, 0x5f,
-	0x01, 0xae, 0x9a, 0xe2, 0x38, 0xd9, 0x36, 0x77, 0x2c, 0x09, 0x7c, 0xa4, 0x94, 0x60, 0xf4, 0x9b,
-	0x5d, 0x54, 0x81, 0x9c, 0xb8, 0x06, 0x15, 0xb7, 0xb4, 0xba, 0x69, 0x99, 0x9e, 0xc9, 0x8e, 0xd1,
-	0xa6, 0x1d, 0x95, 0xee, 0x72, 0x47, 0x65, 0xf8, 0xcd, 0xa9, 0x80, 0x2a, 0xf9, 0x48, 0xe1, 0xc6,
-	0x9a, 0xfa, 0x57, 0x05, 0x20, 0x32, 0x1f, 0xa7, 0x20, 0x5e, 0x13, 0x09, 0x33, 0x77, 0x8c, 0x43,
-	0xdc, 0xc9, 0x7e, 0xd0, 0x97, 0x1e, 0x55, 0x4f, 0x62, 0xbf, 0x07, 0x2d, 0x42, 0xdc, 0x9f, 0xa7,
-	0x9e, 0xe7, 0xce, 0x53, 0x8b, 0x7f, 0xf3, 0x39, 0xd1, 0x5b, 0xdd, 0xdf, 0x29, 0x37, 0x23, 0x70,
-	0x36, 0x99, 0xa3, 0x7f, 0xa9, 0x44, 0xca, 0x81, 0x85, 0xba, 0xf7, 0x88, 0x5a, 0x9e, 0xdc, 0x43,
-	0x8b, 0xb6, 0x41, 0xd1, 0x1c, 0xf4, 0x8b, 0x52, 0xb8, 0xa8, 0x05, 0x4e, 0xee, 0x69, 0xe3, 0x0e,
-	0xca, 0xa7, 0x1f, 0xde, 0x2b, 0xcc, 0xdd, 0xbd, 0x30, 0xf7, 0xfa, 0x83, 0x0f, 0x17, 0xce, 0x5d,
-	0xcc, 0x3f, 0x99, 0xc1, 0x82, 0x0a, 0x5d, 0x06, 0xe0, 0x1f, 0x54, 0xe8, 0xeb, 0x8e, 0x5d, 0x95,
-	0x63, 0x7b, 0xbe, 0x89, 0x93, 0x9c, 0x67, 0xd9, 0xb1, 0xab, 0xe8, 0x0d, 0x48, 0x08, 0x00, 0xcf,
-	0x96, 0x03, 0x7b, 0x3e, 0x7b, 0x9c, 0x73, 0xdc, 0xb4, 0xe5, 0x90, 0x3e, 0x9d, 0x86, 0x64, 0x30,
-	0x24, 0xf4, 0x4e, 0xb4, 0x8c, 0x37, 0xb3, 0x6f, 0x19, 0xaf, 0x8b, 0xfa, 0xdd, 0x22, 0x40, 0xd9,
-	0xa1, 0x44, 0xde, 0x6c, 0xf7, 0x1c, 0xe6, 0x66, 0x5b, 0xf2, 0x15, 0x3c, 0x06, 0x52, 0xaf, 0x19,
-	0x3e, 0x48, 0xef, 0x61, 0x40, 0x24, 0x5f, 0xc1, 0x43, 0xc7, 0x64, 0x5d, 0x57, 0x14, 0xdc, 0xe2,
-	0xa2, 0xe0, 0x96, 0x97, 0x65, 0xec, 0xb3, 0x30, 0x68, 0x50, 0xb7, 0xec, 0x98, 0x35, 0x36, 0x89,
-	0xfc, 0xe0, 0x48, 0xf2, 0x43, 0xcd, 0xe9, 0x55, 0xbf, 0x4c, 0xe1, 0x68, 0x27, 0x7a, 0x0c, 0x40,
-	0x3c, 0xcf, 0x31, 0xd7, 0xea, 0x1e, 0x75, 0xd5, 0x01, 0xbe, 0xdf, 0xce, 0xec, 0x6b, 0xa3, 0xf9,
-	0x42, 0x40, 0xbb, 0x64, 0x79, 0xce, 0x8e, 0x76, 0x6e, 0x4f, 0x3b, 0xf3, 0x77, 0xca, 0x0b, 0xb9,
-	0xae, 0xea, 0xb9, 0x38, 0x22, 0x0a, 0xdd, 0x87, 0x41, 0x79, 0x8a, 0xea, 0x6c, 0x76, 0xe2, 0x87,
-	0x2f, 0xb2, 0x8e, 0x34, 0x76, 0xb3, 0xe0, 0xb7, 0x17, 0x5d, 0x0c, 0x5b, 0x3e, 0x8d, 0x8b, 0x4a,
-	0x80, 0x5c, 0xea, 0xf0, 0x03, 0xbf, 0xe6, 0xd8, 0xeb, 0x66, 0x85, 0xea, 0xa6, 0xc1, 0x4f, 0x94,
-	0xa4, 0x76, 0x2c, 0x2c, 0x4f, 0xa6, 0x6f, 0x08, 0xa2, 0x55, 0x41, 0x53, 0x2a, 0xe2, 0xb4, 0xdb,
-	0xdc, 0x62, 0xa0, 0xff, 0x50, 0xe0, 0x88, 0xef, 0x47, 0x58, 0x27, 0x75, 0xf8, 0xd7, 0x21, 0xd4,
-	0x75, 0x79, 0x86, 0x9f, 0xd4, 0xfe, 0x5a, 0xd9, 0xd3, 0x7e, 0xa6, 0x38, 0x3f, 0x51, 0xf2, 0x7f,
-	0xa6, 0x3c, 0x9c, 0xbd, 0x7c, 0x89, 0x8d, 0x9d, 0xcc, 0x7d, 0x20, 0xb7, 0xc7, 0x47, 0x91, 0xe7,
-	0xf0, 0xf1, 0xfe, 0xdc, 0x83, 0xb3, 0x91, 0x8e, 0x33, 0xf7, 0xe7, 0xcf, 0x9c, 0x65, 0x7c, 0x85,
-	0xb9, 0xbb, 0xd2, 0x64, 0x1f, 0x45, 0x9e, 0xc3, 0x47, 0xce, 0x17, 0x76, 0x9c, 0x99, 0xbd, 0x7c,
-	0xe9, 0xd2, 0x3d, 0xb9, 0x0b, 0x5f, 0x79, 0x72, 0xe6, 0xf2, 0xcc, 0x47, 0x0f, 0x67, 0xf0, 0xb8,
-	0x54, 0xf7, 0x06, 0xd7, 0xb6, 0x20, 0x94, 0x45, 0x77, 0x41, 0x6d, 0x19, 0xc6, 0x26, 0xdd, 0xd4,
-	0x2b, 0x64, 0x8d, 0x56, 0xd4, 0xf3, 0x7c, 0x20, 0x27, 0xc5, 0x12, 0xf9, 0x38, 0xdd, 0xd8, 0xcd,
-	0x4e, 0x5c, 0x8f, 0x62, 0x5c, 0x5d, 0xba, 0x7a, 0x8d, 0x11, 0xe2, 0x89, 0x26, 0xe8, 0xab, 0x74,
-	0x93, 0x37, 0xa3, 0xff, 0x52, 0x60, 0x2a, 0x7a, 0x86, 0xb7, 0xd8, 0x09, 0xbe, 0x9b, 0x76, 0x52,
-	0x23, 0x2a, 0x37, 0xdb, 0x6a, 0x1d, 0x8e, 0x77, 0x18, 0x4e, 0x68, 0xaf, 0x0b, 0x7c, 0x40, 0xa7,
-	0x23, 0xf6, 0x3a, 0x5a, 0x68, 0xc5, 0x0a, 0x6c, 0x76, 0xb4, 0x4d, 0x4c, 0x60, 0x37, 0x0c, 0x13,
-	0x1d, 0xe4, 0x98, 0x86, 0xba, 0xc0, 0x05, 0x64, 0xc4, 0x4a, 0x35, 0xf8, 0xa5, 0x66, 0x2b, 0x48,
-	0xa9, 0x88, 0xc7, 0xda, 0x90, 0x4b, 0x06, 0xfa, 0x37, 0x05, 0xc6, 0x78, 0x1c, 0xd0, 0x32, 0x09,
-	0x83, 0xdf, 0xcd, 0x49, 0x18, 0x65, 0xba, 0x36, 0x5b, 0xdf, 0x83, 0x64, 0xc5, 0x16, 0xa3, 0x72,
-	0xd5, 0x21, 0xee, 0x92, 0x66, 0xf7, 0x77, 0x49, 0xd7, 0x7c, 0xd2, 0xaf, 0xe3, 0x91, 0x42, 0x41,
-	0x68, 0x01, 0xe2, 0xf2, 0xc3, 0x31, 0x35, 0xcf, 0x9d, 0xd1, 0x64, 0x7b, 0x64, 0xcb, 0xbb, 0xb1,
-	0x4f, 0xd7, 0xf1, 0x8e, 0x62, 0xb8, 0xeb, 0x3b, 0x8a, 0x91, 0x8e, 0x77, 0x14, 0x1d, 0xb2, 0x8c,
-	0xd4, 0x1f, 0xe3, 0x8e, 0x28, 0xfd, 0xc7, 0xba, 0x23, 0x1a, 0x3d, 0xfc, 0x1d, 0x51, 0xdb, 0x85,
-	0x0a, 0xea, 0xe6, 0x42, 0x65, 0xac, 0x9b, 0x0b, 0x95, 0xf1, 0xae, 0x2f, 0x54, 0x26, 0xf6, 0xb9,
-	0x50, 0x79, 0x05, 0x92, 0x8e, 0x6d, 0x7b, 0x3a, 0x8f, 0xc4, 0x44, 0x1d, 0x47, 0x6d, 0xab, 0x99,
-	0xd9, 0xb6, 0xc7, 0xc2, 0x30, 0x9c, 0x70, 0xe4, 0x13, 0xba, 0x0d, 0x03, 0x16, 0xf5, 0x98, 0x41,
-	0x26, 0x79, 0x90, 0x78, 0xf9, 0xb7, 0xbb, 0xd9, 0xfc, 0xa1, 0x3e, 0x31, 0xbc, 0x4e, 0xbd, 0x52,
-	0xb1, 0xb1, 0x9b, 0xed, 0xe7, 0x0f, 0xb8, 0xdf, 0xa2, 0x5e, 0xc9, 0x40, 0xef, 0xc2, 0x50, 0xd3,
-	0xdd, 0x96, 0xfa, 0xfc, 0xbb, 0xad, 0x54, 0x63, 0x37, 0x1b, 0xbd, 0xa6, 0xc1, 0x83, 0xd5, 0xc8,
-	0x6d, 0xd6, 0x22, 0x24, 0x39, 0x20, 0x4b, 0x44, 0xe4, 0x9d, 0x82, 0xba, 0x5f, 0xa2, 0xa2, 0x0d,
-	0x35, 0x76, 0xb3, 0x41, 0xb5, 0x00, 0x27, 0x18, 0x0e, 0xaf, 0x1b, 0xbc, 0x07, 0xa3, 0x7e, 0x8e,
-	0x12, 0x82, 0x9d, 0x7b, 0x0e, 0xd8, 0x18, 0x5b, 0x1c, 0xab, 0x82, 0x2d, 0xc0, 0xf4, 0x33, 0xaa,
-	0x15, 0x1f, 0x7a, 0x01, 0xe2, 0xae, 0x08, 0x74, 0xd5, 0xa9, 0xce, 0xfb, 0x56, 0xc6, 0xc1, 0xd8,
-	0xa7, 0x43, 0xdf, 0x07, 0x1f, 0x45, 0xf7, 0x59, 0x8f, 0x1d, 0xcc, 0x3a, 0x22, 0xe9, 0xfd, 0xcf,
-	0x44, 0x67, 0x60, 0x24, 0xc8, 0xa5, 0xf9, 0xfa, 0xe0, 0x25, 0x9d, 0x61, 0x3c, 0x24, 0x33, 0x68,
-	0xbe, 0x36, 0xd0, 0x0b, 0x90, 0xaa, 0xbb, 0xd4, 0x08, 0xa9, 0x5c, 0xf5, 0xc4, 0x74, 0xef, 0xec,
-	0x30, 0x1e, 0x66, 0xcd, 0x3e, 0x99, 0xcb, 0xe8, 0x38, 0x5a, 0xb8, 0xdc, 0xd4, 0x4c, 0xf8, 0x25,
-	0x66, 0xb0, 0xd6, 0xd0, 0xf7, 0x24, 0x9d, 0xf3, 0xbe, 0xac, 0xff, 0x5e, 0x50, 0xb3, 0xfc, 0x9b,
-	0x39, 0x76, 0x02, 0x0d, 0x5d, 0x23, 0xae, 0x87, 0xaf, 0xf0, 0xda, 0xee, 0x05, 0xa1, 0x08, 0x7e,
-	0x5f, 0xbc, 0xb5, 0x33, 0x2e, 0xa8, 0xd3, 0x1d, 0x19, 0x17, 0x9a, 0x18, 0x17, 0xd0, 0x43, 0x38,
-	0xd6, 0x5a, 0x33, 0x60, 0xb9, 0x96, 0xb9, 0x25, 0xa2, 0xd7, 0x93, 0x87, 0xa9, 0x49, 0x04, 0x85,
-	0x05, 0x2c, 0x11, 0x0a, 0x1e, 0x5a, 0x82, 0x41, 0xf1, 0xcd, 0xa4, 0x58, 0x11, 0xb9, 0x7d, 0x9c,
-	0x10, 0x23, 0x11, 0x6b, 0x22, 0xac, 0xcd, 0x40, 0x2d, 0x68, 0x45, 0xf7, 0x00, 0xad, 0xf1, 0x8b,
-	0xc7, 0x1d, 0xbd, 0x46, 0x1d, 0x96, 0x0b, 0x92, 0x0d, 0xaa, 0x9e, 0x7a, 0xfe, 0x0d, 0x40, 0x6a,
-	0x4f, 0x1b, 0x02, 0x38, 0x11, 0x8b, 0x7d, 0x7c, 0x79, 0x2e, 0x16, 0x8b, 0xc5, 0xf0, 0xa8, 0xc4,
-	0x59, 0x0d, 0x60, 0xd0, 0x8b, 0x90, 0x0a, 0xb2, 0x46, 0x79, 0xb7, 0x30, 0x33, 0xad, 0xcc, 0xf6,
-	0xe3, 0x11, 0xbf, 0x59, 0x5e, 0x1a, 0x10, 0xe6, 0x37, 0x78, 0x06, 0x4b, 0x0c, 0x27, 0xc8, 0x85,
-	0x4f, 0x77, 0x91, 0x0b, 0x6b, 0xe3, 0x2c, 0x18, 0xc5, 0x9c, 0xb9, 0x50, 0xc4, 0x32, 0x25, 0xc6,
-	0x32, 0x21, 0x2e, 0x18, 0x8e, 0x9f, 0x24, 0xb7, 0xa7, 0xda, 0x2f, 0x7c, 0x4b, 0xa9, 0xf6, 0x8b,
-	0x5f, 0x33, 0xd5, 0xa6, 0x70, 0x5c, 0x16, 0x34, 0x3a, 0x15, 0x71, 0x5c, 0x75, 0x96, 0xe3, 0x76,
-	0x57, 0xc5, 0x11, 0x40, 0x1d, 0xba, 0x5c, 0xf4, 0x0e, 0x40, 0xe4, 0xba, 0xfa, 0xcc, 0xe1, 0xae,
-	0xab, 0x71, 0x84, 0x17, 0xad, 0xc1, 0x48, 0xcd, 0xb1, 0xb7, 0x4c, 0xb6, 0x8f, 0x45, 0xb0, 0x75,
-	0x96, 0x9f, 0x48, 0x6f, 0xec, 0x69, 0x2f, 0x3a, 0xa7, 0xd5, 0x99, 0xfc, 0xc9, 0x83, 0x63, 0x86,
-	0x8f, 0x1e, 0xce, 0x34, 0x76, 0xb3, 0xc3, 0xab, 0x21, 0x46, 0xa9, 0x88, 0x87, 0x23, 0x90, 0x25,
-	0x03, 0x15, 0x61, 0x34, 0x68, 0x60, 0x5e, 0xc6, 0x20, 0x1e, 0x51, 0x5f, 0x92, 0x2e, 0xa6, 0x75,
-	0x39, 0xde, 0xe0, 0xdf, 0xec, 0xe3, 0x74, 0x94, 0xa3, 0x48, 0x3c, 0x82, 0x8e, 0x43, 0xb2, 0x5a,
-	0xaf, 0xb0, 0x64, 0xdc, 0xf5, 0xd4, 0x39, 0x7e, 0xfc, 0x84, 0x0d, 0x68, 0x03, 0x8e, 0x96, 0x2b,
-	0xc4, 0xac, 0xea, 0xa4, 0x29, 0x67, 0xd7, 0xcb, 0xb6, 0x41, 0xd5, 0xf9, 0xe7, 0xa4, 0x53, 0xed,
-	0x79, 0x3e, 0x9e, 0xe4, 0x68, 0x1d, 0x0a, 0x00, 0xf3, 0x30, 0xe6, 0x6e, 0x9a, 0x35, 0x5d, 0x96,
-	0x2e, 0xf4, 0xb2, 0xb3, 0x53, 0xf3, 0x6c, 0xf5, 0x22, 0x57, 0x68, 0x94, 0x75, 0x49, 0x83, 0x2f,
-	0xf2, 0x8e, 0xa9, 0xb7, 0x20, 0xd5, 0x92, 0x26, 0xa2, 0x34, 0xf4, 0x6e, 0x52, 0xf1, 0x99, 0x5b,
-	0x12, 0xb3, 0x47, 0x34, 0xee, 0x57, 0x15, 0xc4, 0xf7, 0x57, 0xe2, 0xe5, 0x52, 0xcf, 0x6b, 0xca,
-	0xd4, 0x6d, 0x18, 0x69, 0x0e, 0xe9, 0x3a, 0x70, 0xcf, 0x47, 0xb9, 0x3b, 0x1c, 0x21, 0x3e, 0x40,
-	0x04, 0x57, 0x96, 0x06, 0xde, 0x01, 0x08, 0x8c, 0xe0, 0xa2, 0x4b, 0x30, 0x18, 0xfe, 0xa4, 0xc4,
-	0x55, 0x15, 0xbe, 0x56, 0x8f, 0xee, 0x6b, 0x35, 0x0c, 0x34, 0xe0, 0xcd, 0x19, 0x70, 0x64, 0x91,
-	0x27, 0xf5, 0x61, 0xb7, 0x2c, 0xcb, 0x5c, 0x01, 0x08, 0x51, 0x83, 0x0f, 0x1c, 0xf6, 0x03, 0xed,
-	0x50, 0x6c, 0x48, 0x06, 0x62, 0x72, 0xff, 0xa8, 0xc0, 0x91, 0x5b, 0x3c, 0xed, 0xff, 0xff, 0x14,
-	0x83, 0x2e, 0x03, 0x84, 0x3f, 0x2e, 0xd9, 0xb7, 0xb2, 0xb1, 0xcc, 0x48, 0x56, 0x88, 0xbb, 0xa9,
-	0xf5, 0xf1, 0x32, 0x52, 0x72, 0xdd, 0x6f, 0xc8, 0xfd, 0x8b, 0x02, 0x63, 0x6f, 0x53, 0xaf, 0x4d,
-	0xc9, 0xfb, 0x30, 0x12, 0x2a, 0xa9, 0x7f, 0xf3, 0x3a, 0xcc, 0x10, 0x0d, 0xe9, 0xdc, 0x6f, 0xae,
-	0xf6, 0x57, 0x0a, 0x9c, 0x8e, 0xaa, 0x1d, 0x11, 0xbe, 0x6c, 0x3b, 0x4b, 0xb7, 0x4a, 0xae, 0x3f,
-	0x90, 0x1f, 0x42, 0x82, 0x1f, 0xcf, 0xb4, 0x6e, 0xca, 0xb2, 0xde, 0x92, 0xfc, 0x61, 0xc8, 0xe1,
-	0xa2, 0xb6, 0xa5, 0x5b, 0xa5, 0x57, 0x5f, 0x6e, 0xec, 0x66, 0xe3, 0xec, 0x58, 0x5f, 0xba, 0x55,
-	0xc2, 0x71, 0x06, 0xbb, 0x54, 0x37, 0xd1, 0x03, 0x88, 0xb3, 0x63, 0x96, 0x09, 0x10, 0xbf, 0x3c,
-	0x29, 0x7e, 0x23, 0x01, 0x03, 0x45, 0xba, 0xc5, 0xf0, 0x07, 0x0c, 0xba, 0xb5, 0x54, 0x37, 0x73,
-	0x9f, 0xf4, 0xc2, 0xc4, 0x35, 0xd3, 0x0d, 0xc7, 0x1a, 0x0c, 0x8d, 0x40, 0x2a, 0xea, 0xbb, 0xc3,
-	0x49, 0x7a, 0xe1, 0x00, 0xaf, 0x7d, 0xf0, 0x34, 0x8d, 0x90, 0x28, 0xe5, 0x37, 0x9f, 0x28, 0xf4,
-	0x99, 0x02, 0xfd, 0xb6, 0x63, 0x50, 0x47, 0x7e, 0x9d, 0xf9, 0x17, 0xca, 0x9e, 0xf6, 0xe7, 0x8a,
-	0xf3, 0x63, 0x05, 0xc7, 0x70, 0x32, 0x58, 0x5d, 0x18, 0xe6, 0xc2, 0xe7, 0x60, 0xbe, 0x70, 0x72,
-	0x2e, 0x78, 0xf4, 0x4d, 0x8c, 0x13, 0x73, 0xfe, 0x13, 0x2f, 0x9a, 0xe1, 0xfe, 0x39, 0xfe, 0x2f,
-	0x5a, 0x1c, 0xc3, 0x43, 0x73, 0xd1, 0xb7, 0x48, 0xed, 0x0f, 0x0f, 0xce, 0x45, 0x5e, 0x84, 0x62,
-	0x28, 0x03, 0xfd, 0xe2, 0xc7, 0x17, 0xfc, 0x67, 0x39, 0x3c, 0x52, 0x39, 0xdb, 0xab, 0x7e, 0x15,
-	0xc7, 0xa2, 0x19, 0x21, 0xe8, 0xab, 0xb1, 0xb0, 0x44, 0xfc, 0x1c, 0x87, 0x3f, 0xe7, 0xfe, 0x5e,
-	0x81, 0xb1, 0x1b, 0x1d, 0xb6, 0xcd, 0xf2, 0xe1, 0xf6, 0x76, 0x73, 0x75, 0xf7, 0xdb, 0xdc, 0xd7,
-	0xff, 0xa9, 0xc0, 0x68, 0x20, 0xe7, 0x26, 0xad, 0xd6, 0x2a, 0x2c, 0xde, 0xfa, 0xae, 0xa8, 0x87,
-	0x66, 0x61, 0xb0, 0x4a, 0x6a, 0xfc, 0x7e, 0x8a, 0x1d, 0x11, 0xbd, 0xd1, 0x72, 0xa8, 0x81, 0x41,
-	0xf6, 0x5d, 0xa5, 0x3b, 0xb9, 0xcf, 0x15, 0x98, 0x6c, 0x1b, 0x88, 0x08, 0x11, 0x82, 0x6a, 0xaa,
-	0xd2, 0xcc, 0xde, 0xb1, 0x9a, 0xda, 0x13, 0xad, 0xa6, 0x7e, 0xa1, 0x34, 0x57, 0x53, 0x6f, 0x42,
-	0x8a, 0xd7, 0x1a, 0xe9, 0xb6, 0x47, 0x2d, 0x97, 0xd7, 0x2f, 0x7a, 0xa7, 0x7b, 0x67, 0x93, 0xda,
-	0x4b, 0x7b, 0xda, 0xec, 0x27, 0xca, 0xe9, 0xb4, 0xa1, 0x2a, 0xb9, 0xac, 0x73, 0x22, 0x7f, 0xec,
-	0xe1, 0xec, 0xe5, 0x4b, 0xf7, 0xe7, 0xfd, 0xc8, 0xe2, 0xc3, 0x85, 0x73, 0x0b, 0xaf, 0x3e, 0x39,
-	0xf3, 0xe1, 0xc2, 0xb9, 0xfc, 0x93, 0x19, 0x3c, 0xc2, 0x30, 0x96, 0x02, 0x88, 0xdc, 0xff, 0x2a,
-	0xa0, 0xee, 0xa3, 0xba, 0x8b, 0x9e, 0x40, 0x5c, 0x04, 0x37, 0xfe, 0xf1, 0xf5, 0xca, 0xbe, 0xf3,
-	0xd0, 0xc2, 0x3a, 0x2f, 0xff, 0x7f, 0x9d, 0xba, 0x89, 0x2f, 0x73, 0xaa, 0x0c, 0x43, 0x51, 0x98,
-	0x0e, 0x67, 0xf5, 0x5b, 0xcd, 0x67, 0xf5, 0x8b, 0x5d, 0xaa, 0x17, 0x39, 0xba, 0x73, 0x3f, 0x51,
-	0x20, 0xbb, 0x68, 0x5b, 0x5b, 0xd4, 0xf1, 0xda, 0xa8, 0xfd, 0x1d, 0xb3, 0x0a, 0x49, 0xa1, 0x53,
-	0xf8, 0xd9, 0xf2, 0xc5, 0xee, 0xbf, 0x33, 0x4e, 0x08, 0xa1, 0xa5, 0x22, 0x4e, 0x08, 0x94, 0x12,
-	0xff, 0x76, 0x9a, 0xc7, 0x6d, 0xdc, 0x19, 0x63, 0xfe, 0x7c, 0x76, 0x19, 0x20, 0x4c, 0x46, 0xd0,
-	0x28, 0x0c, 0xaf, 0xbe, 0x7b, 0x67, 0x09, 0xeb, 0xb7, 0xae, 0x5f, 0xbd, 0xfe, 0xee, 0x9d, 0xeb,
-	0xe9, 0x58, 0xd8, 0xa4, 0x15, 0x6e, 0xde, 0x5c, 0xc2, 0xef, 0xa5, 0x15, 0x84, 0x60, 0x44, 0x34,
-	0x2d, 0xfd, 0xe9, 0xcd, 0x25, 0x7c, 0xbd, 0x70, 0x2d, 0xdd, 0xa3, 0xfd, 0x83, 0xf2, 0xc5, 0xd3,
-	0x8c, 0xf2, 0xe5, 0xd3, 0x8c, 0xf2, 0x9b, 0xa7, 0x99, 0xd8, 0xef, 0x9e, 0x66, 0x62, 0x5f, 0x3d,
-	0xcd, 0xc4, 0x7e, 0xff, 0x34, 0x13, 0xfb, 0xc3, 0xd3, 0x8c, 0xf2, 0x71, 0x23, 0xa3, 0xfc, 0xb4,
-	0x91, 0x89, 0xfd, 0xa2, 0x91, 0x51, 0x7e, 0xd9, 0xc8, 0xc4, 0x3e, 0x6f, 0x64, 0x62, 0xbf, 0x6a,
-	0x64, 0x62, 0x5f, 0x34, 0x32, 0xca, 0x97, 0x8d, 0x8c, 0xf2, 0x9b, 0x46, 0x26, 0xf6, 0xbb, 0x46,
-	0x46, 0xf9, 0xaa, 0x91, 0x89, 0xfd, 0xbe, 0x91, 0x51, 0xfe, 0xd0, 0xc8, 0xc4, 0x3e, 0x7e, 0x96,
-	0x89, 0xfd, 0xf4, 0x59, 0x46, 0xf9, 0xf9, 0xb3, 0x4c, 0xec, 0xd3, 0x67, 0x19, 0xe5, 0xb3, 0x67,
-	0x99, 0xd8, 0x2f, 0x9e, 0x65, 0x62, 0xbf, 0x7c, 0x96, 0x51, 0x3e, 0x7f, 0x96, 0x51, 0x7e, 0xf5,
-	0x2c, 0xa3, 0xdc, 0x3d, 0xd7, 0xed, 0x49, 0xe2, 0x59, 0xb5, 0xb5, 0xb5, 0x01, 0xbe, 0x03, 0x2f,
-	0xfe, 0x5f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xa1, 0x95, 0x8e, 0x42, 0x89, 0x3b, 0x00, 0x00,
+func (m *EndDeviceProfileIdentifiers) Reset()      { *m = EndDeviceProfileIdentifiers{} }
+func (*EndDeviceProfileIdentifiers) ProtoMessage() {}
+func (*EndDeviceProfileIdentifiers) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a656ee0551c94a80, []int{23}
+}
+func (m *EndDeviceProfileIdentifiers) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EndDeviceProfileIdentifiers) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EndDeviceProfileIdentifiers.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EndDeviceProfileIdentifiers) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EndDeviceProfileIdentifiers.Merge(m, src)
+}
+func (m *EndDeviceProfileIdentifiers) XXX_Size() int {
+	return m.Size()
+}
+func (m *EndDeviceProfileIdentifiers) XXX_DiscardUnknown() {
+	xxx_messageInfo_EndDeviceProfileIdentifiers.DiscardUnknown(m)
 }
 
-func (x PowerState) String() string {
-	s, ok := PowerState_name[int32(x)]
-	if ok {
-		return s
+var xxx_messageInfo_EndDeviceProfileIdentifiers proto.InternalMessageInfo
+
+func (m *EndDeviceProfileIdentifiers) GetApplicationIDs() *ApplicationIdentifiers {
+	if m != nil {
+		return m.ApplicationIDs
 	}
-	return strconv.Itoa(int(x))
+	return nil
 }
-func (this *Session) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *EndDeviceProfileIdentifiers) GetProfileID() string {
+	if m != nil {
+		return m.ProfileID
 	}
+	return ""
+}
 
-	that1, ok := that.(*Session)
-	if !ok {
-		that2, ok := that.(Session)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+// EndDeviceProfile is a named set of LoRaWAN versions, frequency plan and MAC settings that can be referenced by many end devices.
+// Profiles are stored in the Network Server per application, or globally by administrators.
+type EndDeviceProfile struct {
+	EndDeviceProfileIdentifiers `protobuf:"bytes,1,opt,name=ids,proto3,embedded=ids" json:"ids"`
+	CreatedAt                   time.Time `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3,stdtime" json:"created_at"`
+	UpdatedAt                   time.Time `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3,stdtime" json:"updated_at"`
+	// Version of the profile. The version is incremented by the Network Server on every update of the profile.
+	Version     uint32 `protobuf:"varint,4,opt,name=version,proto3" json:"version,omitempty"`
+	Name        string `protobuf:"bytes,5,opt,name=name,proto3" json:"name,omitempty"`
+	Description string `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
+	// MAC settings of the end devices that use the profile.
+	// Settings that are set in the MAC settings of an end device take precedence over the settings of the profile.
+	// Updates of the settings take effect for all end devices that use the profile.
+	MACSettings *MACSettings `protobuf:"bytes,7,opt,name=mac_settings,json=macSettings,proto3" json:"mac_settings,omitempty"`
+	// LoRaWAN MAC version of the end devices that are created with the profile.
+	// The LoRaWAN version of an end device takes precedence over the version of the profile.
+	// Updates of the version only take effect for end devices that are created after the update.
+	LoRaWANVersion MACVersion `protobuf:"varint,8,opt,name=lorawan_version,json=lorawanVersion,proto3,enum=ttn.lorawan.v3.MACVersion" json:"lorawan_version,omitempty"`
+	// LoRaWAN PHY version of the end devices that are created with the profile.
+	// The LoRaWAN PHY version of an end device takes precedence over the version of the profile.
+	// Updates of the version only take effect for end devices that are created after the update.
+	LoRaWANPHYVersion PHYVersion `protobuf:"varint,9,opt,name=lorawan_phy_version,json=lorawanPhyVersion,proto3,enum=ttn.lorawan.v3.PHYVersion" json:"lorawan_phy_version,omitempty"`
+	// ID of the frequency plan of the end devices that are created with the profile.
+	// The frequency plan of an end device takes precedence over the frequency plan of the profile.
+	// Updates of the frequency plan only take effect for end devices that are created after the update.
+	FrequencyPlanID      string   `protobuf:"bytes,10,opt,name=frequency_plan_id,json=frequencyPlanId,proto3" json:"frequency_plan_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EndDeviceProfile) Reset()      { *m = EndDeviceProfile{} }
+func (*EndDeviceProfile) ProtoMessage() {}
+func (*EndDeviceProfile) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a656ee0551c94a80, []int{24}
+}
+func (m *EndDeviceProfile) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EndDeviceProfile) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EndDeviceProfile.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+}
+func (m *EndDeviceProfile) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EndDeviceProfile.Merge(m, src)
+}
+func (m *EndDeviceProfile) XXX_Size() int {
+	return m.Size()
+}
+func (m *EndDeviceProfile) XXX_DiscardUnknown() {
+	xxx_messageInfo_EndDeviceProfile.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EndDeviceProfile proto.InternalMessageInfo
+
+func (m *EndDeviceProfile) GetCreatedAt() time.Time {
+	if m != nil {
+		return m.CreatedAt
 	}
-	if !this.DevAddr.Equal(that1.DevAddr) {
-		return false
+	return time.Time{}
+}
+
+func (m *EndDeviceProfile) GetUpdatedAt() time.Time {
+	if m != nil {
+		return m.UpdatedAt
 	}
-	if !this.SessionKeys.Equal(&that1.SessionKeys) {
-		return false
+	return time.Time{}
+}
+
+func (m *EndDeviceProfile) GetVersion() uint32 {
+	if m != nil {
+		return m.Version
 	}
-	if this.LastFCntUp != that1.LastFCntUp {
-		return false
+	return 0
+}
+
+func (m *EndDeviceProfile) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	if this.LastNFCntDown != that1.LastNFCntDown {
-		return false
+	return ""
+}
+
+func (m *EndDeviceProfile) GetDescription() string {
+	if m != nil {
+		return m.Description
 	}
-	if this.LastAFCntDown != that1.LastAFCntDown {
-		return false
+	return ""
+}
+
+func (m *EndDeviceProfile) GetMACSettings() *MACSettings {
+	if m != nil {
+		return m.MACSettings
 	}
-	if this.LastConfFCntDown != that1.LastConfFCntDown {
-		return false
+	return nil
+}
+
+func (m *EndDeviceProfile) GetLoRaWANVersion() MACVersion {
+	if m != nil {
+		return m.LoRaWANVersion
 	}
-	if !this.StartedAt.Equal(that1.StartedAt) {
-		return false
+	return MAC_UNKNOWN
+}
+
+func (m *EndDeviceProfile) GetLoRaWANPHYVersion() PHYVersion {
+	if m != nil {
+		return m.LoRaWANPHYVersion
 	}
-	return true
+	return PHY_UNKNOWN
 }
-func (this *MACParameters) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *EndDeviceProfile) GetFrequencyPlanID() string {
+	if m != nil {
+		return m.FrequencyPlanID
 	}
+	return ""
+}
 
-	that1, ok := that.(*MACParameters)
-	if !ok {
-		that2, ok := that.(MACParameters)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type EndDeviceProfiles struct {
+	Profiles             []*EndDeviceProfile `protobuf:"bytes,1,rep,name=profiles,proto3" json:"profiles,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *EndDeviceProfiles) Reset()      { *m = EndDeviceProfiles{} }
+func (*EndDeviceProfiles) ProtoMessage() {}
+func (*EndDeviceProfiles) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a656ee0551c94a80, []int{25}
+}
+func (m *EndDeviceProfiles) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EndDeviceProfiles) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EndDeviceProfiles.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+}
+func (m *EndDeviceProfiles) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EndDeviceProfiles.Merge(m, src)
+}
+func (m *EndDeviceProfiles) XXX_Size() int {
+	return m.Size()
+}
+func (m *EndDeviceProfiles) XXX_DiscardUnknown() {
+	xxx_messageInfo_EndDeviceProfiles.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EndDeviceProfiles proto.InternalMessageInfo
+
+func (m *EndDeviceProfiles) GetProfiles() []*EndDeviceProfile {
+	if m != nil {
+		return m.Profiles
 	}
-	if this.MaxEIRP != that1.MaxEIRP {
-		return false
+	return nil
+}
+
+type GetEndDeviceProfileRequest struct {
+	EndDeviceProfileIdentifiers `protobuf:"bytes,1,opt,name=profile_ids,json=profileIds,proto3,embedded=profile_ids" json:"profile_ids"`
+	FieldMask                   types.FieldMask `protobuf:"bytes,2,opt,name=field_mask,json=fieldMask,proto3" json:"field_mask"`
+	XXX_NoUnkeyedLiteral        struct{}        `json:"-"`
+	XXX_sizecache               int32           `json:"-"`
+}
+
+func (m *GetEndDeviceProfileRequest) Reset()      { *m = GetEndDeviceProfileRequest{} }
+func (*GetEndDeviceProfileRequest) ProtoMessage() {}
+func (*GetEndDeviceProfileRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a656ee0551c94a80, []int{26}
+}
+func (m *GetEndDeviceProfileRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetEndDeviceProfileRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetEndDeviceProfileRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if this.ADRDataRateIndex != that1.ADRDataRateIndex {
-		return false
+}
+func (m *GetEndDeviceProfileRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetEndDeviceProfileRequest.Merge(m, src)
+}
+func (m *GetEndDeviceProfileRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetEndDeviceProfileRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetEndDeviceProfileRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetEndDeviceProfileRequest proto.InternalMessageInfo
+
+func (m *GetEndDeviceProfileRequest) GetFieldMask() types.FieldMask {
+	if m != nil {
+		return m.FieldMask
 	}
-	if this.ADRTxPowerIndex != that1.ADRTxPowerIndex {
-		return false
+	return types.FieldMask{}
+}
+
+type ListEndDeviceProfilesRequest struct {
+	// List the profiles of the application. If not set, the global profiles are listed.
+	ApplicationIDs *ApplicationIdentifiers `protobuf:"bytes,1,opt,name=application_ids,json=applicationIds,proto3" json:"application_ids,omitempty"`
+	FieldMask      types.FieldMask         `protobuf:"bytes,2,opt,name=field_mask,json=fieldMask,proto3" json:"field_mask"`
+	// Limit the number of results per page.
+	Limit uint32 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	// Page number for pagination. 0 is interpreted as 1.
+	Page                 uint32   `protobuf:"varint,4,opt,name=page,proto3" json:"page,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListEndDeviceProfilesRequest) Reset()      { *m = ListEndDeviceProfilesRequest{} }
+func (*ListEndDeviceProfilesRequest) ProtoMessage() {}
+func (*ListEndDeviceProfilesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a656ee0551c94a80, []int{27}
+}
+func (m *ListEndDeviceProfilesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListEndDeviceProfilesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListEndDeviceProfilesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if this.ADRNbTrans != that1.ADRNbTrans {
-		return false
+}
+func (m *ListEndDeviceProfilesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListEndDeviceProfilesRequest.Merge(m, src)
+}
+func (m *ListEndDeviceProfilesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListEndDeviceProfilesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListEndDeviceProfilesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListEndDeviceProfilesRequest proto.InternalMessageInfo
+
+func (m *ListEndDeviceProfilesRequest) GetApplicationIDs() *ApplicationIdentifiers {
+	if m != nil {
+		return m.ApplicationIDs
 	}
-	if this.ADRAckLimit != that1.ADRAckLimit {
-		return false
+	return nil
+}
+
+func (m *ListEndDeviceProfilesRequest) GetFieldMask() types.FieldMask {
+	if m != nil {
+		return m.FieldMask
 	}
-	if this.ADRAckDelay != that1.ADRAckDelay {
-		return false
+	return types.FieldMask{}
+}
+
+func (m *ListEndDeviceProfilesRequest) GetLimit() uint32 {
+	if m != nil {
+		return m.Limit
 	}
-	if this.Rx1Delay != that1.Rx1Delay {
-		return false
+	return 0
+}
+
+func (m *ListEndDeviceProfilesRequest) GetPage() uint32 {
+	if m != nil {
+		return m.Page
 	}
-	if this.Rx1DataRateOffset != that1.Rx1DataRateOffset {
-		return false
+	return 0
+}
+
+type SetEndDeviceProfileRequest struct {
+	Profile              EndDeviceProfile `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile"`
+	FieldMask            types.FieldMask  `protobuf:"bytes,2,opt,name=field_mask,json=fieldMask,proto3" json:"field_mask"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *SetEndDeviceProfileRequest) Reset()      { *m = SetEndDeviceProfileRequest{} }
+func (*SetEndDeviceProfileRequest) ProtoMessage() {}
+func (*SetEndDeviceProfileRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a656ee0551c94a80, []int{28}
+}
+func (m *SetEndDeviceProfileRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetEndDeviceProfileRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetEndDeviceProfileRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if this.Rx2DataRateIndex != that1.Rx2DataRateIndex {
-		return false
-	}
-	if this.Rx2Frequency != that1.Rx2Frequency {
-		return false
-	}
-	if this.MaxDutyCycle != that1.MaxDutyCycle {
-		return false
-	}
-	if this.RejoinTimePeriodicity != that1.RejoinTimePeriodicity {
-		return false
-	}
-	if this.RejoinCountPeriodicity != that1.RejoinCountPeriodicity {
-		return false
-	}
-	if this.PingSlotFrequency != that1.PingSlotFrequency {
-		return false
-	}
-	if this.PingSlotDataRateIndex != that1.PingSlotDataRateIndex {
-		return false
-	}
-	if this.BeaconFrequency != that1.BeaconFrequency {
-		return false
-	}
-	if len(this.Channels) != len(that1.Channels) {
-		return false
-	}
-	for i := range this.Channels {
-		if !this.Channels[i].Equal(that1.Channels[i]) {
-			return false
-		}
-	}
-	if !this.UplinkDwellTime.Equal(that1.UplinkDwellTime) {
-		return false
-	}
-	if !this.DownlinkDwellTime.Equal(that1.DownlinkDwellTime) {
-		return false
-	}
-	if !this.ADRAckLimitExponent.Equal(that1.ADRAckLimitExponent) {
-		return false
-	}
-	if !this.ADRAckDelayExponent.Equal(that1.ADRAckDelayExponent) {
-		return false
-	}
-	if !this.PingSlotDataRateIndexValue.Equal(that1.PingSlotDataRateIndexValue) {
-		return false
-	}
-	return true
 }
-func (this *MACParameters_Channel) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *SetEndDeviceProfileRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetEndDeviceProfileRequest.Merge(m, src)
+}
+func (m *SetEndDeviceProfileRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetEndDeviceProfileRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetEndDeviceProfileRequest.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*MACParameters_Channel)
-	if !ok {
-		that2, ok := that.(MACParameters_Channel)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.UplinkFrequency != that1.UplinkFrequency {
-		return false
-	}
-	if this.DownlinkFrequency != that1.DownlinkFrequency {
-		return false
-	}
-	if this.MinDataRateIndex != that1.MinDataRateIndex {
-		return false
-	}
-	if this.MaxDataRateIndex != that1.MaxDataRateIndex {
-		return false
-	}
-	if this.EnableUplink != that1.EnableUplink {
-		return false
+var xxx_messageInfo_SetEndDeviceProfileRequest proto.InternalMessageInfo
+
+func (m *SetEndDeviceProfileRequest) GetProfile() EndDeviceProfile {
+	if m != nil {
+		return m.Profile
 	}
-	return true
+	return EndDeviceProfile{}
 }
-func (this *EndDeviceBrand) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *SetEndDeviceProfileRequest) GetFieldMask() types.FieldMask {
+	if m != nil {
+		return m.FieldMask
 	}
+	return types.FieldMask{}
+}
 
-	that1, ok := that.(*EndDeviceBrand)
-	if !ok {
-		that2, ok := that.(EndDeviceBrand)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type EndDeviceTemplate struct {
+	EndDevice            EndDevice       `protobuf:"bytes,1,opt,name=end_device,json=endDevice,proto3" json:"end_device"`
+	FieldMask            types.FieldMask `protobuf:"bytes,2,opt,name=field_mask,json=fieldMask,proto3" json:"field_mask"`
+	MappingKey           string          `protobuf:"bytes,3,opt,name=mapping_key,json=mappingKey,proto3" json:"mapping_key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *EndDeviceTemplate) Reset()      { *m = EndDeviceTemplate{} }
+func (*EndDeviceTemplate) ProtoMessage() {}
+func (*EndDeviceTemplate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a656ee0551c94a80, []int{29}
+}
+func (m *EndDeviceTemplate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EndDeviceTemplate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EndDeviceTemplate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.ID != that1.ID {
-		return false
-	}
-	if this.Name != that1.Name {
-		return false
-	}
-	if this.URL != that1.URL {
-		return false
-	}
-	if len(this.Logos) != len(that1.Logos) {
-		return false
+}
+func (m *EndDeviceTemplate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EndDeviceTemplate.Merge(m, src)
+}
+func (m *EndDeviceTemplate) XXX_Size() int {
+	return m.Size()
+}
+func (m *EndDeviceTemplate) XXX_DiscardUnknown() {
+	xxx_messageInfo_EndDeviceTemplate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EndDeviceTemplate proto.InternalMessageInfo
+
+func (m *EndDeviceTemplate) GetEndDevice() EndDevice {
+	if m != nil {
+		return m.EndDevice
 	}
-	for i := range this.Logos {
-		if this.Logos[i] != that1.Logos[i] {
-			return false
-		}
+	return EndDevice{}
+}
+
+func (m *EndDeviceTemplate) GetFieldMask() types.FieldMask {
+	if m != nil {
+		return m.FieldMask
 	}
-	return true
+	return types.FieldMask{}
 }
-func (this *EndDeviceModel) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *EndDeviceTemplate) GetMappingKey() string {
+	if m != nil {
+		return m.MappingKey
 	}
+	return ""
+}
 
-	that1, ok := that.(*EndDeviceModel)
-	if !ok {
-		that2, ok := that.(EndDeviceModel)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type EndDeviceTemplateFormat struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description          string   `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	FileExtensions       []string `protobuf:"bytes,3,rep,name=file_extensions,json=fileExtensions,proto3" json:"file_extensions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EndDeviceTemplateFormat) Reset()      { *m = EndDeviceTemplateFormat{} }
+func (*EndDeviceTemplateFormat) ProtoMessage() {}
+func (*EndDeviceTemplateFormat) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a656ee0551c94a80, []int{30}
+}
+func (m *EndDeviceTemplateFormat) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EndDeviceTemplateFormat) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EndDeviceTemplateFormat.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.BrandID != that1.BrandID {
-		return false
-	}
-	if this.ID != that1.ID {
-		return false
+}
+func (m *EndDeviceTemplateFormat) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EndDeviceTemplateFormat.Merge(m, src)
+}
+func (m *EndDeviceTemplateFormat) XXX_Size() int {
+	return m.Size()
+}
+func (m *EndDeviceTemplateFormat) XXX_DiscardUnknown() {
+	xxx_messageInfo_EndDeviceTemplateFormat.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EndDeviceTemplateFormat proto.InternalMessageInfo
+
+func (m *EndDeviceTemplateFormat) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	if this.Name != that1.Name {
-		return false
+	return ""
+}
+
+func (m *EndDeviceTemplateFormat) GetDescription() string {
+	if m != nil {
+		return m.Description
 	}
-	return true
+	return ""
 }
-func (this *EndDeviceVersionIdentifiers) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *EndDeviceTemplateFormat) GetFileExtensions() []string {
+	if m != nil {
+		return m.FileExtensions
 	}
+	return nil
+}
 
-	that1, ok := that.(*EndDeviceVersionIdentifiers)
+type EndDeviceTemplateFormats struct {
+	Formats              map[string]*EndDeviceTemplateFormat `protobuf:"bytes,1,rep,name=formats,proto3" json:"formats,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}                            `json:"-"`
+	XXX_sizecache        int32                               `json:"-"`
+}
+
+func (m *EndDeviceTemplateFormats) Reset()      { *m = EndDeviceTemplateFormats{} }
+func (*EndDeviceTemplateFormats) ProtoMessage() {}
+func (*EndDeviceTemplateFormats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a656ee0551c94a80, []int{31}
+}
+func (m *EndDeviceTemplateFormats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EndDeviceTemplateFormats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EndDeviceTemplateFormats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EndDeviceTemplateFormats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EndDeviceTemplateFormats.Merge(m, src)
+}
+func (m *EndDeviceTemplateFormats) XXX_Size() int {
+	return m.Size()
+}
+func (m *EndDeviceTemplateFormats) XXX_DiscardUnknown() {
+	xxx_messageInfo_EndDeviceTemplateFormats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EndDeviceTemplateFormats proto.InternalMessageInfo
+
+func (m *EndDeviceTemplateFormats) GetFormats() map[string]*EndDeviceTemplateFormat {
+	if m != nil {
+		return m.Formats
+	}
+	return nil
+}
+
+type ConvertEndDeviceTemplateRequest struct {
+	// ID of the format.
+	FormatID string `protobuf:"bytes,1,opt,name=format_id,json=formatId,proto3" json:"format_id,omitempty"`
+	// Data to convert.
+	Data []byte `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	// Options of the CSV format, which override the configured options of the converter.
+	CSVOptions           *ConvertEndDeviceTemplateRequest_CSVOptions `protobuf:"bytes,3,opt,name=csv_options,json=csvOptions,proto3" json:"csv_options,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                    `json:"-"`
+	XXX_sizecache        int32                                       `json:"-"`
+}
+
+func (m *ConvertEndDeviceTemplateRequest) Reset()      { *m = ConvertEndDeviceTemplateRequest{} }
+func (*ConvertEndDeviceTemplateRequest) ProtoMessage() {}
+func (*ConvertEndDeviceTemplateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a656ee0551c94a80, []int{32}
+}
+func (m *ConvertEndDeviceTemplateRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConvertEndDeviceTemplateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConvertEndDeviceTemplateRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConvertEndDeviceTemplateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConvertEndDeviceTemplateRequest.Merge(m, src)
+}
+func (m *ConvertEndDeviceTemplateRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConvertEndDeviceTemplateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConvertEndDeviceTemplateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConvertEndDeviceTemplateRequest proto.InternalMessageInfo
+
+func (m *ConvertEndDeviceTemplateRequest) GetFormatID() string {
+	if m != nil {
+		return m.FormatID
+	}
+	return ""
+}
+
+func (m *ConvertEndDeviceTemplateRequest) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *ConvertEndDeviceTemplateRequest) GetCSVOptions() *ConvertEndDeviceTemplateRequest_CSVOptions {
+	if m != nil {
+		return m.CSVOptions
+	}
+	return nil
+}
+
+type ConvertEndDeviceTemplateRequest_CSVOptions struct {
+	// Separator of the columns. The default is a comma.
+	Separator string `protobuf:"bytes,1,opt,name=separator,proto3" json:"separator,omitempty"`
+	// EUIs, addresses and keys are base64 encoded instead of hex encoded.
+	Base64               bool     `protobuf:"varint,2,opt,name=base64,proto3" json:"base64,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ConvertEndDeviceTemplateRequest_CSVOptions) Reset() {
+	*m = ConvertEndDeviceTemplateRequest_CSVOptions{}
+}
+func (*ConvertEndDeviceTemplateRequest_CSVOptions) ProtoMessage() {}
+func (*ConvertEndDeviceTemplateRequest_CSVOptions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a656ee0551c94a80, []int{32, 0}
+}
+func (m *ConvertEndDeviceTemplateRequest_CSVOptions) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConvertEndDeviceTemplateRequest_CSVOptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConvertEndDeviceTemplateRequest_CSVOptions.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConvertEndDeviceTemplateRequest_CSVOptions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConvertEndDeviceTemplateRequest_CSVOptions.Merge(m, src)
+}
+func (m *ConvertEndDeviceTemplateRequest_CSVOptions) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConvertEndDeviceTemplateRequest_CSVOptions) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConvertEndDeviceTemplateRequest_CSVOptions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConvertEndDeviceTemplateRequest_CSVOptions proto.InternalMessageInfo
+
+func (m *ConvertEndDeviceTemplateRequest_CSVOptions) GetSeparator() string {
+	if m != nil {
+		return m.Separator
+	}
+	return ""
+}
+
+func (m *ConvertEndDeviceTemplateRequest_CSVOptions) GetBase64() bool {
+	if m != nil {
+		return m.Base64
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterEnum("ttn.lorawan.v3.PowerState", PowerState_name, PowerState_value)
+	golang_proto.RegisterEnum("ttn.lorawan.v3.PowerState", PowerState_name, PowerState_value)
+	proto.RegisterType((*Session)(nil), "ttn.lorawan.v3.Session")
+	golang_proto.RegisterType((*Session)(nil), "ttn.lorawan.v3.Session")
+	proto.RegisterType((*MACParameters)(nil), "ttn.lorawan.v3.MACParameters")
+	golang_proto.RegisterType((*MACParameters)(nil), "ttn.lorawan.v3.MACParameters")
+	proto.RegisterType((*MACParameters_Channel)(nil), "ttn.lorawan.v3.MACParameters.Channel")
+	golang_proto.RegisterType((*MACParameters_Channel)(nil), "ttn.lorawan.v3.MACParameters.Channel")
+	proto.RegisterType((*EndDeviceBrand)(nil), "ttn.lorawan.v3.EndDeviceBrand")
+	golang_proto.RegisterType((*EndDeviceBrand)(nil), "ttn.lorawan.v3.EndDeviceBrand")
+	proto.RegisterType((*EndDeviceModel)(nil), "ttn.lorawan.v3.EndDeviceModel")
+	golang_proto.RegisterType((*EndDeviceModel)(nil), "ttn.lorawan.v3.EndDeviceModel")
+	proto.RegisterType((*EndDeviceVersionIdentifiers)(nil), "ttn.lorawan.v3.EndDeviceVersionIdentifiers")
+	golang_proto.RegisterType((*EndDeviceVersionIdentifiers)(nil), "ttn.lorawan.v3.EndDeviceVersionIdentifiers")
+	proto.RegisterType((*EndDeviceVersion)(nil), "ttn.lorawan.v3.EndDeviceVersion")
+	golang_proto.RegisterType((*EndDeviceVersion)(nil), "ttn.lorawan.v3.EndDeviceVersion")
+	proto.RegisterMapType((map[string]string)(nil), "ttn.lorawan.v3.EndDeviceVersion.ProfileIdsEntry")
+	golang_proto.RegisterMapType((map[string]string)(nil), "ttn.lorawan.v3.EndDeviceVersion.ProfileIdsEntry")
+	proto.RegisterType((*MACSettings)(nil), "ttn.lorawan.v3.MACSettings")
+	golang_proto.RegisterType((*MACSettings)(nil), "ttn.lorawan.v3.MACSettings")
+	proto.RegisterType((*MACState)(nil), "ttn.lorawan.v3.MACState")
+	golang_proto.RegisterType((*MACState)(nil), "ttn.lorawan.v3.MACState")
+	proto.RegisterType((*MACState_JoinAccept)(nil), "ttn.lorawan.v3.MACState.JoinAccept")
+	golang_proto.RegisterType((*MACState_JoinAccept)(nil), "ttn.lorawan.v3.MACState.JoinAccept")
+	proto.RegisterType((*EndDeviceAuthenticationCode)(nil), "ttn.lorawan.v3.EndDeviceAuthenticationCode")
+	golang_proto.RegisterType((*EndDeviceAuthenticationCode)(nil), "ttn.lorawan.v3.EndDeviceAuthenticationCode")
+	proto.RegisterType((*EndDevice)(nil), "ttn.lorawan.v3.EndDevice")
+	golang_proto.RegisterType((*EndDevice)(nil), "ttn.lorawan.v3.EndDevice")
+	proto.RegisterMapType((map[string]string)(nil), "ttn.lorawan.v3.EndDevice.AttributesEntry")
+	golang_proto.RegisterMapType((map[string]string)(nil), "ttn.lorawan.v3.EndDevice.AttributesEntry")
+	proto.RegisterMapType((map[string]*Location)(nil), "ttn.lorawan.v3.EndDevice.LocationsEntry")
+	golang_proto.RegisterMapType((map[string]*Location)(nil), "ttn.lorawan.v3.EndDevice.LocationsEntry")
+	proto.RegisterType((*EndDevices)(nil), "ttn.lorawan.v3.EndDevices")
+	golang_proto.RegisterType((*EndDevices)(nil), "ttn.lorawan.v3.EndDevices")
+	proto.RegisterType((*CreateEndDeviceRequest)(nil), "ttn.lorawan.v3.CreateEndDeviceRequest")
+	golang_proto.RegisterType((*CreateEndDeviceRequest)(nil), "ttn.lorawan.v3.CreateEndDeviceRequest")
+	proto.RegisterType((*UpdateEndDeviceRequest)(nil), "ttn.lorawan.v3.UpdateEndDeviceRequest")
+	golang_proto.RegisterType((*UpdateEndDeviceRequest)(nil), "ttn.lorawan.v3.UpdateEndDeviceRequest")
+	proto.RegisterType((*GetEndDeviceRequest)(nil), "ttn.lorawan.v3.GetEndDeviceRequest")
+	golang_proto.RegisterType((*GetEndDeviceRequest)(nil), "ttn.lorawan.v3.GetEndDeviceRequest")
+	proto.RegisterType((*GetEndDeviceIdentifiersForEUIsRequest)(nil), "ttn.lorawan.v3.GetEndDeviceIdentifiersForEUIsRequest")
+	golang_proto.RegisterType((*GetEndDeviceIdentifiersForEUIsRequest)(nil), "ttn.lorawan.v3.GetEndDeviceIdentifiersForEUIsRequest")
+	proto.RegisterType((*ListEndDevicesRequest)(nil), "ttn.lorawan.v3.ListEndDevicesRequest")
+	golang_proto.RegisterType((*ListEndDevicesRequest)(nil), "ttn.lorawan.v3.ListEndDevicesRequest")
+	proto.RegisterType((*SetEndDeviceRequest)(nil), "ttn.lorawan.v3.SetEndDeviceRequest")
+	golang_proto.RegisterType((*SetEndDeviceRequest)(nil), "ttn.lorawan.v3.SetEndDeviceRequest")
+	proto.RegisterType((*BatchCreateEndDevicesRequest)(nil), "ttn.lorawan.v3.BatchCreateEndDevicesRequest")
+	golang_proto.RegisterType((*BatchCreateEndDevicesRequest)(nil), "ttn.lorawan.v3.BatchCreateEndDevicesRequest")
+	proto.RegisterType((*BatchGetEndDevicesRequest)(nil), "ttn.lorawan.v3.BatchGetEndDevicesRequest")
+	golang_proto.RegisterType((*BatchGetEndDevicesRequest)(nil), "ttn.lorawan.v3.BatchGetEndDevicesRequest")
+	proto.RegisterType((*BatchUpdateEndDevicesRequest)(nil), "ttn.lorawan.v3.BatchUpdateEndDevicesRequest")
+	golang_proto.RegisterType((*BatchUpdateEndDevicesRequest)(nil), "ttn.lorawan.v3.BatchUpdateEndDevicesRequest")
+	proto.RegisterType((*BatchSetEndDevicesRequest)(nil), "ttn.lorawan.v3.BatchSetEndDevicesRequest")
+	golang_proto.RegisterType((*BatchSetEndDevicesRequest)(nil), "ttn.lorawan.v3.BatchSetEndDevicesRequest")
+	proto.RegisterType((*BatchDeleteEndDevicesRequest)(nil), "ttn.lorawan.v3.BatchDeleteEndDevicesRequest")
+	golang_proto.RegisterType((*BatchDeleteEndDevicesRequest)(nil), "ttn.lorawan.v3.BatchDeleteEndDevicesRequest")
+	proto.RegisterType((*BatchEndDevicesResponse)(nil), "ttn.lorawan.v3.BatchEndDevicesResponse")
+	golang_proto.RegisterType((*BatchEndDevicesResponse)(nil), "ttn.lorawan.v3.BatchEndDevicesResponse")
+	proto.RegisterType((*BatchEndDevicesResponse_Result)(nil), "ttn.lorawan.v3.BatchEndDevicesResponse.Result")
+	golang_proto.RegisterType((*BatchEndDevicesResponse_Result)(nil), "ttn.lorawan.v3.BatchEndDevicesResponse.Result")
+	proto.RegisterType((*EndDeviceProfileIdentifiers)(nil), "ttn.lorawan.v3.EndDeviceProfileIdentifiers")
+	golang_proto.RegisterType((*EndDeviceProfileIdentifiers)(nil), "ttn.lorawan.v3.EndDeviceProfileIdentifiers")
+	proto.RegisterType((*EndDeviceProfile)(nil), "ttn.lorawan.v3.EndDeviceProfile")
+	golang_proto.RegisterType((*EndDeviceProfile)(nil), "ttn.lorawan.v3.EndDeviceProfile")
+	proto.RegisterType((*EndDeviceProfiles)(nil), "ttn.lorawan.v3.EndDeviceProfiles")
+	golang_proto.RegisterType((*EndDeviceProfiles)(nil), "ttn.lorawan.v3.EndDeviceProfiles")
+	proto.RegisterType((*GetEndDeviceProfileRequest)(nil), "ttn.lorawan.v3.GetEndDeviceProfileRequest")
+	golang_proto.RegisterType((*GetEndDeviceProfileRequest)(nil), "ttn.lorawan.v3.GetEndDeviceProfileRequest")
+	proto.RegisterType((*ListEndDeviceProfilesRequest)(nil), "ttn.lorawan.v3.ListEndDeviceProfilesRequest")
+	golang_proto.RegisterType((*ListEndDeviceProfilesRequest)(nil), "ttn.lorawan.v3.ListEndDeviceProfilesRequest")
+	proto.RegisterType((*SetEndDeviceProfileRequest)(nil), "ttn.lorawan.v3.SetEndDeviceProfileRequest")
+	golang_proto.RegisterType((*SetEndDeviceProfileRequest)(nil), "ttn.lorawan.v3.SetEndDeviceProfileRequest")
+	proto.RegisterType((*EndDeviceTemplate)(nil), "ttn.lorawan.v3.EndDeviceTemplate")
+	golang_proto.RegisterType((*EndDeviceTemplate)(nil), "ttn.lorawan.v3.EndDeviceTemplate")
+	proto.RegisterType((*EndDeviceTemplateFormat)(nil), "ttn.lorawan.v3.EndDeviceTemplateFormat")
+	golang_proto.RegisterType((*EndDeviceTemplateFormat)(nil), "ttn.lorawan.v3.EndDeviceTemplateFormat")
+	proto.RegisterType((*EndDeviceTemplateFormats)(nil), "ttn.lorawan.v3.EndDeviceTemplateFormats")
+	golang_proto.RegisterType((*EndDeviceTemplateFormats)(nil), "ttn.lorawan.v3.EndDeviceTemplateFormats")
+	proto.RegisterMapType((map[string]*EndDeviceTemplateFormat)(nil), "ttn.lorawan.v3.EndDeviceTemplateFormats.FormatsEntry")
+	golang_proto.RegisterMapType((map[string]*EndDeviceTemplateFormat)(nil), "ttn.lorawan.v3.EndDeviceTemplateFormats.FormatsEntry")
+	proto.RegisterType((*ConvertEndDeviceTemplateRequest)(nil), "ttn.lorawan.v3.ConvertEndDeviceTemplateRequest")
+	golang_proto.RegisterType((*ConvertEndDeviceTemplateRequest)(nil), "ttn.lorawan.v3.ConvertEndDeviceTemplateRequest")
+	proto.RegisterType((*ConvertEndDeviceTemplateRequest_CSVOptions)(nil), "ttn.lorawan.v3.ConvertEndDeviceTemplateRequest.CSVOptions")
+	golang_proto.RegisterType((*ConvertEndDeviceTemplateRequest_CSVOptions)(nil), "ttn.lorawan.v3.ConvertEndDeviceTemplateRequest.CSVOptions")
+}
+
+func init() { proto.RegisterFile("lorawan-stack/api/end_device.proto", fileDescriptor_a656ee0551c94a80) }
+func init() {
+	golang_proto.RegisterFile("lorawan-stack/api/end_device.proto", fileDescriptor_a656ee0551c94a80)
+}
+
+var fileDescriptor_a656ee0551c94a80 = []byte{
+	// 5440 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5b, 0x5b, 0x6c, 0x1c, 0xd7,
+	0x79, 0xde, 0xd9, 0x25, 0xb9, 0xbb, 0x3f, 0x6f, 0xcb, 0x43, 0x51, 0x1c, 0x51, 0xd2, 0x2e, 0xbd,
+	0x96, 0x6c, 0x4a, 0x11, 0x57, 0x16, 0x25, 0x3b, 0x8e, 0x62, 0x57, 0xd9, 0xe1, 0x52, 0x31, 0x75,
+	0x33, 0x73, 0xa8, 0x4b, 0x63, 0xc9, 0x9a, 0x1c, 0xee, 0x1c, 0x52, 0x63, 0xee, 0xce, 0xac, 0x67,
+	0x66, 0x29, 0xd2, 0x17, 0xc0, 0x08, 0x5a, 0x34, 0x0d, 0xda, 0x22, 0xf5, 0x4b, 0x83, 0x3e, 0x14,
+	0x46, 0x81, 0x22, 0x79, 0x2a, 0x82, 0xa2, 0x05, 0x0c, 0x04, 0x45, 0x93, 0x87, 0x16, 0x06, 0x8a,
+	0x02, 0x2e, 0xd0, 0x87, 0x20, 0x40, 0xd9, 0x68, 0xf5, 0xe2, 0xbe, 0xe5, 0x29, 0x30, 0xf8, 0x50,
+	0x14, 0xe7, 0x32, 0x97, 0xdd, 0x1d, 0x92, 0x4b, 0xcb, 0x55, 0xfd, 0x42, 0xce, 0x9e, 0xff, 0xff,
+	0xbf, 0x73, 0xfb, 0xcf, 0x7f, 0xfe, 0xcb, 0x0c, 0x14, 0x6b, 0xb6, 0x43, 0x1e, 0x12, 0x6b, 0xd6,
+	0xf5, 0x48, 0x75, 0xfd, 0x2c, 0x69, 0x98, 0x67, 0xa9, 0x65, 0xe8, 0x06, 0xdd, 0x30, 0xab, 0xb4,
+	0xd4, 0x70, 0x6c, 0xcf, 0x46, 0x23, 0x9e, 0x67, 0x95, 0x24, 0x5f, 0x69, 0xe3, 0xfc, 0x54, 0x79,
+	0xcd, 0xf4, 0x1e, 0x34, 0x57, 0x4a, 0x55, 0xbb, 0x7e, 0x96, 0x5a, 0x1b, 0xf6, 0x56, 0xc3, 0xb1,
+	0x37, 0xb7, 0xce, 0x72, 0xe6, 0xea, 0xec, 0x1a, 0xb5, 0x66, 0x37, 0x48, 0xcd, 0x34, 0x88, 0x47,
+	0xcf, 0x76, 0x3d, 0x08, 0xc8, 0xa9, 0xd9, 0x08, 0xc4, 0x9a, 0xbd, 0x66, 0x0b, 0xe1, 0x95, 0xe6,
+	0x2a, 0xff, 0xc5, 0x7f, 0xf0, 0x27, 0xc9, 0x7e, 0x6c, 0xcd, 0xb6, 0xd7, 0x6a, 0x94, 0x0f, 0x8f,
+	0x58, 0x96, 0xed, 0x11, 0xcf, 0xb4, 0x2d, 0x57, 0x52, 0xf3, 0x92, 0x1a, 0x60, 0x18, 0x4d, 0x87,
+	0x33, 0x48, 0xfa, 0xd1, 0x4e, 0x3a, 0xad, 0x37, 0xbc, 0x2d, 0x49, 0x9c, 0xee, 0x24, 0xae, 0x9a,
+	0xb4, 0x66, 0xe8, 0x75, 0xe2, 0xae, 0x77, 0x74, 0x1e, 0x70, 0xb8, 0x9e, 0xd3, 0xac, 0x7a, 0x92,
+	0x5a, 0xe8, 0xa4, 0x7a, 0x66, 0x9d, 0xba, 0x1e, 0xa9, 0x37, 0x76, 0x1b, 0xdd, 0x43, 0x87, 0x34,
+	0x1a, 0xd4, 0xf1, 0x47, 0x7f, 0x3c, 0x66, 0x07, 0x1c, 0xc7, 0x76, 0x24, 0xf9, 0xd9, 0x6e, 0xb2,
+	0x69, 0x50, 0xcb, 0x33, 0x57, 0xcd, 0x10, 0xe3, 0x58, 0x37, 0xd3, 0x5b, 0xb6, 0x69, 0xed, 0x4e,
+	0x5d, 0xa7, 0x5b, 0xbe, 0x6c, 0xa1, 0x9b, 0xea, 0xef, 0xb5, 0x5c, 0xa1, 0x6e, 0x86, 0x3a, 0x75,
+	0x5d, 0xb2, 0x46, 0xf7, 0x80, 0x68, 0x98, 0x55, 0xaf, 0xe9, 0xd0, 0xbd, 0x20, 0x3c, 0x62, 0x10,
+	0x8f, 0x08, 0x8e, 0xe2, 0x5f, 0xa4, 0x20, 0xbd, 0x4c, 0x5d, 0xd7, 0xb4, 0x2d, 0x74, 0x07, 0x32,
+	0x06, 0xdd, 0xd0, 0x89, 0x61, 0x38, 0x6a, 0x72, 0x5a, 0x99, 0x19, 0xd2, 0x5e, 0xf9, 0x64, 0xbb,
+	0x90, 0xf8, 0xf5, 0x76, 0xe1, 0xc2, 0x9a, 0x5d, 0xf2, 0x1e, 0x50, 0xef, 0x81, 0x69, 0xad, 0xb9,
+	0x25, 0x8b, 0x7a, 0x0f, 0x6d, 0x67, 0xfd, 0x6c, 0x3b, 0x78, 0x63, 0x7d, 0xed, 0xac, 0xb7, 0xd5,
+	0xa0, 0x6e, 0xa9, 0x42, 0x37, 0xca, 0x86, 0xe1, 0xe0, 0xb4, 0x21, 0x1e, 0x50, 0x19, 0xfa, 0xd8,
+	0xc4, 0xd5, 0xd4, 0xb4, 0x32, 0x33, 0x38, 0x77, 0xb4, 0xd4, 0xae, 0xd7, 0x25, 0xd9, 0xff, 0x55,
+	0xba, 0xe5, 0x6a, 0xb9, 0x1d, 0xad, 0xff, 0x87, 0x4a, 0x32, 0xa7, 0xb0, 0x9e, 0x3f, 0xdd, 0x2e,
+	0x28, 0x98, 0x8b, 0xa2, 0x67, 0x60, 0xb8, 0x46, 0x5c, 0x4f, 0x5f, 0xd5, 0xab, 0x96, 0xa7, 0x37,
+	0x1b, 0x6a, 0xdf, 0xb4, 0x32, 0x33, 0x8c, 0x81, 0x35, 0x5e, 0x9e, 0xb7, 0xbc, 0x5b, 0x0d, 0x34,
+	0x03, 0x63, 0x9c, 0xc5, 0x92, 0x4c, 0x86, 0xfd, 0xd0, 0x52, 0xfb, 0x39, 0x1b, 0x97, 0xbd, 0xc1,
+	0xf8, 0x2a, 0xf6, 0x43, 0x2b, 0xe0, 0x24, 0x51, 0xce, 0x81, 0x90, 0xb3, 0x1c, 0x70, 0x96, 0xe0,
+	0x10, 0xe7, 0xac, 0xda, 0xd6, 0x6a, 0x94, 0x39, 0xcd, 0x99, 0x73, 0x8c, 0x36, 0x6f, 0x5b, 0xab,
+	0x01, 0xff, 0x3c, 0x80, 0xeb, 0x11, 0xc7, 0xa3, 0x86, 0x4e, 0x3c, 0x35, 0xc3, 0xe7, 0x3b, 0x55,
+	0x12, 0x9a, 0x58, 0xf2, 0x35, 0xb1, 0x74, 0xd3, 0x57, 0x55, 0x2d, 0xc3, 0xa6, 0xf9, 0xa3, 0xff,
+	0x2a, 0x28, 0x38, 0x2b, 0xe5, 0xca, 0xde, 0x95, 0xbe, 0x8c, 0x92, 0x4b, 0x16, 0xff, 0x36, 0x07,
+	0xc3, 0xd7, 0xcb, 0xf3, 0x4b, 0xc4, 0x21, 0x75, 0xea, 0x51, 0xc7, 0x45, 0xcf, 0x41, 0xa6, 0x4e,
+	0x36, 0x75, 0x6a, 0x3a, 0x0d, 0x55, 0x99, 0x56, 0x66, 0x92, 0xda, 0x60, 0x6b, 0xbb, 0x90, 0xbe,
+	0x4e, 0x36, 0x17, 0x16, 0xf1, 0x12, 0x4e, 0xd7, 0xc9, 0xe6, 0x82, 0xe9, 0x34, 0xd0, 0x5b, 0x30,
+	0x4e, 0x0c, 0x47, 0x67, 0xbb, 0xac, 0x3b, 0xc4, 0xa3, 0xba, 0x69, 0x19, 0x74, 0x93, 0xaf, 0xd8,
+	0xc8, 0xdc, 0xf1, 0xce, 0xd5, 0xaf, 0x10, 0x8f, 0x60, 0xe2, 0xd1, 0x45, 0xc6, 0xa4, 0x1d, 0xdb,
+	0xd1, 0xfa, 0xbf, 0xcf, 0xd6, 0xbf, 0xb5, 0x5d, 0xc8, 0x95, 0x2b, 0xb8, 0x8d, 0x8a, 0x73, 0xc4,
+	0x70, 0xda, 0x5a, 0xd0, 0xb7, 0x01, 0xb1, 0xbe, 0xbc, 0x4d, 0xbd, 0x61, 0x3f, 0xa4, 0x8e, 0xec,
+	0x8a, 0xaf, 0xba, 0x36, 0xb5, 0xa3, 0xf5, 0x9d, 0x4e, 0xaa, 0xa3, 0xad, 0xed, 0xc2, 0x68, 0xb9,
+	0x82, 0x6f, 0x6e, 0x2e, 0x31, 0x16, 0x81, 0x34, 0x4a, 0x0c, 0x27, 0xda, 0x80, 0xbe, 0x0e, 0x43,
+	0x0c, 0xc8, 0x5a, 0xd1, 0x3d, 0x87, 0x58, 0xae, 0xd8, 0x0e, 0x6d, 0x22, 0x84, 0x80, 0x72, 0x05,
+	0xdf, 0x58, 0xb9, 0xc9, 0x88, 0x18, 0x88, 0xe1, 0xc8, 0x67, 0xf4, 0x22, 0x0c, 0x33, 0x41, 0x52,
+	0x5d, 0xd7, 0x6b, 0x66, 0xdd, 0xf4, 0xc4, 0xde, 0x68, 0x63, 0xad, 0xed, 0xc2, 0x60, 0xb9, 0x82,
+	0xcb, 0xd5, 0xf5, 0x6b, 0xbc, 0x59, 0xc1, 0x83, 0xc4, 0x70, 0xfc, 0x9f, 0x51, 0x31, 0x83, 0xd6,
+	0xc8, 0x96, 0x9a, 0xe9, 0x14, 0xab, 0xf0, 0xe6, 0x40, 0x8c, 0xff, 0x44, 0xbf, 0x07, 0x59, 0x67,
+	0xf3, 0x9c, 0x14, 0xc9, 0xf2, 0x15, 0x9d, 0xec, 0x5c, 0x51, 0xbc, 0xc9, 0x79, 0xb5, 0x8c, 0xbf,
+	0x96, 0x38, 0xe3, 0x6c, 0x9e, 0x13, 0xf2, 0x2f, 0xc3, 0x21, 0x2e, 0x1f, 0xec, 0x8d, 0xbd, 0xba,
+	0xea, 0x52, 0x4f, 0x05, 0xde, 0x7b, 0x5a, 0x4c, 0x37, 0x8d, 0xc7, 0x98, 0x80, 0x5c, 0xe8, 0xd7,
+	0x39, 0x07, 0xba, 0x0d, 0xe3, 0xce, 0xe6, 0x5c, 0xd7, 0xae, 0x0e, 0xf6, 0xb2, 0xab, 0xe1, 0x48,
+	0x72, 0xce, 0xe6, 0x5c, 0xfb, 0x0e, 0x96, 0x60, 0x98, 0xe1, 0xae, 0x3a, 0xf4, 0xed, 0x26, 0xb5,
+	0xaa, 0x5b, 0xea, 0xd0, 0xb4, 0x32, 0xd3, 0xa7, 0x65, 0x77, 0xb4, 0x81, 0xb9, 0xbe, 0x99, 0x8f,
+	0xfe, 0x74, 0x00, 0x0f, 0x39, 0x9b, 0x73, 0x97, 0x7d, 0x32, 0x5a, 0x86, 0x11, 0xa6, 0x85, 0x46,
+	0xd3, 0xdb, 0xd2, 0xab, 0x5b, 0xd5, 0x1a, 0x55, 0x87, 0xf9, 0x10, 0x9e, 0xed, 0x1c, 0x42, 0x79,
+	0x6d, 0xcd, 0xa1, 0x6b, 0xc4, 0xa3, 0x46, 0xa5, 0xe9, 0x6d, 0xcd, 0x33, 0xd6, 0xc8, 0x40, 0x86,
+	0xea, 0x64, 0x33, 0x68, 0x47, 0x06, 0x4c, 0x3a, 0x94, 0x99, 0x4e, 0x9d, 0x99, 0x71, 0xbd, 0x41,
+	0x1d, 0xd3, 0x36, 0xcc, 0xaa, 0xe9, 0x6d, 0xa9, 0x23, 0x1c, 0xbd, 0xd8, 0xb5, 0xc8, 0x9c, 0x9d,
+	0x9d, 0xa4, 0x85, 0xcd, 0x86, 0x6d, 0x51, 0xcb, 0x8b, 0x80, 0x4f, 0x38, 0x01, 0x75, 0x29, 0x84,
+	0x42, 0x6b, 0xa0, 0xca, 0x5e, 0xaa, 0x76, 0xd3, 0xf2, 0xda, 0xba, 0x19, 0x8d, 0x9f, 0x84, 0xe8,
+	0x66, 0x9e, 0xb1, 0xc7, 0xf4, 0x73, 0xd8, 0x09, 0xc9, 0xd1, 0x8e, 0xbe, 0x09, 0xe3, 0x0d, 0xd3,
+	0x5a, 0xd3, 0xdd, 0x9a, 0xed, 0x45, 0x56, 0x36, 0xc7, 0x57, 0x76, 0x70, 0x47, 0xcb, 0xcc, 0x0d,
+	0xa8, 0x09, 0xbe, 0xb6, 0x63, 0x8c, 0x6f, 0xb9, 0x66, 0x7b, 0xe1, 0x02, 0xdf, 0x85, 0x23, 0xa1,
+	0x70, 0xe7, 0x76, 0x8f, 0xf5, 0xb2, 0xdd, 0x49, 0x55, 0xc1, 0x13, 0x3e, 0x70, 0xfb, 0x6e, 0xbf,
+	0x04, 0xb9, 0x15, 0x4a, 0xaa, 0xb6, 0x15, 0x19, 0x16, 0xea, 0x1e, 0xd6, 0xa8, 0x60, 0x0a, 0x07,
+	0x75, 0x15, 0x32, 0xd5, 0x07, 0xc4, 0xb2, 0x68, 0xcd, 0x55, 0xc7, 0xa7, 0x53, 0x33, 0x83, 0x73,
+	0x27, 0x3b, 0xc7, 0xd0, 0x66, 0xac, 0x4a, 0xf3, 0x82, 0x9b, 0x2f, 0xd6, 0x87, 0x4a, 0x32, 0xa3,
+	0xe0, 0x00, 0x00, 0x5d, 0x86, 0xb1, 0x66, 0xa3, 0x66, 0x5a, 0xeb, 0xba, 0xf1, 0x90, 0xd6, 0x6a,
+	0x7c, 0xcf, 0xd5, 0x43, 0xbb, 0x18, 0x4b, 0xcd, 0xb6, 0x6b, 0xb7, 0x49, 0xad, 0x49, 0xf1, 0xa8,
+	0x10, 0xaa, 0x30, 0x19, 0xb6, 0xb5, 0xe8, 0x0a, 0x8c, 0x33, 0x6b, 0xdc, 0x89, 0x34, 0xb1, 0x2f,
+	0xd2, 0x98, 0x2f, 0x16, 0x62, 0x6d, 0xc0, 0xe1, 0x36, 0x33, 0xa2, 0x53, 0xb9, 0xdd, 0xea, 0x61,
+	0x0e, 0x37, 0xd3, 0xa5, 0xde, 0xa1, 0x6d, 0xf1, 0x35, 0x83, 0x83, 0x6b, 0x93, 0xad, 0xed, 0xc2,
+	0x78, 0x0c, 0x15, 0x8f, 0x47, 0xec, 0x8f, 0xdf, 0x18, 0xed, 0x97, 0x1b, 0x95, 0xb0, 0xdf, 0xc9,
+	0xbd, 0xfa, 0xe5, 0xd6, 0x64, 0xd7, 0x7e, 0xdb, 0xa8, 0x7e, 0xbf, 0x6d, 0x8d, 0x68, 0x0d, 0x0a,
+	0xbb, 0x6a, 0x99, 0xbe, 0xc1, 0x00, 0x55, 0x95, 0x0f, 0xa0, 0xb8, 0xa7, 0xae, 0x89, 0xf5, 0x9c,
+	0x8a, 0x55, 0x36, 0x4e, 0x9b, 0xfa, 0x8f, 0x24, 0xa4, 0xa5, 0x32, 0xa0, 0x0b, 0x90, 0x93, 0x1b,
+	0x1f, 0x6a, 0x9f, 0xd2, 0x69, 0x6e, 0xe4, 0x36, 0x87, 0xba, 0xf7, 0x32, 0xa0, 0x60, 0x9b, 0x43,
+	0xb9, 0x64, 0xa7, 0x5c, 0xb0, 0xa9, 0xa1, 0xe4, 0x6d, 0x18, 0xaf, 0x9b, 0x56, 0xd7, 0x21, 0x4a,
+	0x1d, 0xd0, 0x66, 0xd6, 0x4d, 0xab, 0xfd, 0x14, 0x31, 0x5c, 0xb2, 0xd9, 0x85, 0xdb, 0x77, 0x50,
+	0x5c, 0xb2, 0xd9, 0x8e, 0xfb, 0x2c, 0x0c, 0x53, 0x8b, 0xac, 0xd4, 0xa8, 0x2e, 0xd6, 0x80, 0x5f,
+	0xa4, 0x19, 0x3c, 0x24, 0x1a, 0x6f, 0xf1, 0xb6, 0x8b, 0x7d, 0x1f, 0x7f, 0x54, 0x48, 0x88, 0xbf,
+	0x57, 0xfa, 0x32, 0xc9, 0x5c, 0xea, 0x4a, 0x5f, 0x26, 0x95, 0xeb, 0x2b, 0xd6, 0x61, 0x64, 0xc1,
+	0x32, 0x2a, 0x3c, 0x82, 0xd0, 0x1c, 0x62, 0x19, 0xe8, 0x30, 0x24, 0x4d, 0x83, 0x2f, 0x70, 0x56,
+	0x1b, 0x68, 0x6d, 0x17, 0x92, 0x8b, 0x15, 0x9c, 0x34, 0x0d, 0x84, 0xa0, 0xcf, 0x22, 0x75, 0xca,
+	0x97, 0x30, 0x8b, 0xf9, 0x33, 0x3a, 0x02, 0xa9, 0xa6, 0x53, 0xe3, 0x4b, 0x93, 0xd5, 0xd2, 0xad,
+	0xed, 0x42, 0xea, 0x16, 0xbe, 0x86, 0x59, 0x1b, 0x3a, 0x04, 0xfd, 0x35, 0x7b, 0xcd, 0x76, 0xd5,
+	0xbe, 0xe9, 0xd4, 0x4c, 0x16, 0x8b, 0x1f, 0xc5, 0xbf, 0x53, 0x22, 0xfd, 0x5d, 0xb7, 0x0d, 0x5a,
+	0x43, 0xd7, 0x21, 0xb3, 0xc2, 0x3a, 0xd6, 0x83, 0x5e, 0xe7, 0x76, 0xb4, 0x13, 0x4e, 0x51, 0x3d,
+	0x31, 0x97, 0xbf, 0x7f, 0x97, 0xcc, 0xbe, 0xf3, 0xc2, 0xec, 0x37, 0xde, 0x9c, 0xb9, 0x74, 0xf1,
+	0xee, 0xec, 0x9b, 0x97, 0xfc, 0x9f, 0xa7, 0xde, 0x9d, 0x3b, 0xf3, 0xfe, 0x09, 0xe6, 0xc7, 0xf0,
+	0x31, 0x2f, 0x56, 0x70, 0x9a, 0x63, 0x2c, 0x1a, 0xe8, 0x55, 0x3e, 0x7c, 0x3e, 0x48, 0x6d, 0xb6,
+	0x77, 0xa0, 0xce, 0x59, 0xa6, 0xc2, 0x59, 0x16, 0xff, 0x3c, 0x09, 0x47, 0x83, 0x41, 0xdf, 0xa6,
+	0x0e, 0xf3, 0x3b, 0x17, 0x43, 0xb7, 0xfe, 0xcb, 0x9e, 0xc1, 0x75, 0xc8, 0xd4, 0xd9, 0xca, 0xe8,
+	0xc1, 0x3c, 0x0e, 0x02, 0xc7, 0x17, 0x95, 0xc1, 0x71, 0x8c, 0x45, 0x03, 0x9d, 0x82, 0xdc, 0x03,
+	0xe2, 0x18, 0x0f, 0x89, 0x43, 0xf5, 0x0d, 0x31, 0x78, 0x39, 0xbb, 0x51, 0xbf, 0x5d, 0xce, 0x89,
+	0xb1, 0xae, 0x9a, 0x4e, 0xbd, 0x8d, 0xb5, 0x4f, 0xb0, 0xfa, 0xed, 0x92, 0xb5, 0xf8, 0xbb, 0x34,
+	0xe4, 0x3a, 0xd7, 0x04, 0xbd, 0x0e, 0x29, 0xd3, 0x70, 0xf9, 0x1a, 0x0c, 0xce, 0x7d, 0xad, 0x53,
+	0xa3, 0xf7, 0x58, 0xc2, 0x18, 0x0f, 0x9e, 0x21, 0x21, 0x1d, 0x46, 0x25, 0x40, 0x30, 0x9e, 0x24,
+	0x3f, 0x2e, 0x53, 0x31, 0xf7, 0x88, 0x84, 0xd5, 0xa6, 0xfc, 0xb3, 0xd2, 0xda, 0x2e, 0x8c, 0x5c,
+	0xb3, 0x31, 0xb9, 0x53, 0xbe, 0x21, 0x69, 0x78, 0x44, 0x8a, 0xf8, 0x23, 0x36, 0x61, 0xdc, 0xef,
+	0xa0, 0xf1, 0x60, 0xab, 0x6d, 0x7d, 0x62, 0x3a, 0x59, 0x7a, 0xed, 0xbb, 0x7e, 0x27, 0xc7, 0x23,
+	0x9d, 0x8c, 0xc9, 0x4e, 0x42, 0x32, 0x1e, 0x93, 0x52, 0x4b, 0x0f, 0xb6, 0xfc, 0xae, 0x2e, 0xc3,
+	0x58, 0x60, 0x87, 0xf4, 0x46, 0x8d, 0x58, 0x6c, 0x7f, 0xf9, 0xea, 0x72, 0x9f, 0xd7, 0x49, 0xaa,
+	0xdf, 0x62, 0x3e, 0x6f, 0x60, 0x87, 0x96, 0x6a, 0xc4, 0x5a, 0xac, 0xe0, 0xd1, 0xd5, 0xb6, 0x06,
+	0x76, 0x3e, 0x07, 0x1a, 0x0f, 0x6c, 0xcf, 0x76, 0xd5, 0x7e, 0x7e, 0xb2, 0xe4, 0x2f, 0x34, 0x03,
+	0x39, 0xb7, 0xd9, 0x68, 0xd8, 0x8e, 0xe7, 0xea, 0xd5, 0x1a, 0x71, 0x5d, 0x7d, 0x85, 0xfb, 0xc3,
+	0x19, 0x3c, 0xe2, 0xb7, 0xcf, 0xb3, 0x66, 0x2d, 0x86, 0xb3, 0xaa, 0xa6, 0x63, 0x38, 0xe7, 0x11,
+	0x85, 0x43, 0x06, 0x5d, 0x25, 0xcd, 0x9a, 0xa7, 0xd7, 0x49, 0x55, 0x77, 0xa9, 0xe7, 0xb1, 0x60,
+	0x4e, 0xcd, 0xc4, 0xc7, 0x64, 0xd7, 0xcb, 0xf3, 0xcb, 0x92, 0x45, 0x3b, 0xdc, 0xda, 0x2e, 0xa0,
+	0x8a, 0x10, 0x8e, 0xb4, 0x63, 0x24, 0x01, 0xaf, 0x93, 0xaa, 0xdf, 0xc6, 0x2c, 0x18, 0xb3, 0xb8,
+	0xa1, 0x99, 0x66, 0x3e, 0x72, 0x1f, 0x1e, 0xaa, 0x9b, 0x11, 0x67, 0x82, 0x31, 0x91, 0xcd, 0x08,
+	0x13, 0x48, 0x26, 0xb2, 0xd9, 0xc6, 0x14, 0x4c, 0x8d, 0x39, 0x59, 0xdc, 0xd3, 0xcd, 0xe0, 0x21,
+	0xbf, 0xf1, 0x8a, 0x6d, 0x5a, 0xe8, 0x0c, 0x20, 0x87, 0xba, 0x54, 0xb2, 0xe8, 0x96, 0x6d, 0x55,
+	0xa9, 0xcb, 0x3d, 0xd8, 0x0c, 0xce, 0x09, 0x0a, 0xe3, 0xbb, 0xc1, 0xdb, 0x11, 0x05, 0x7f, 0xc8,
+	0xfa, 0xaa, 0xed, 0xd4, 0x89, 0xc7, 0x3c, 0x15, 0x75, 0x38, 0xfe, 0x9e, 0xbd, 0x2e, 0x62, 0xed,
+	0x25, 0xb2, 0x55, 0xb3, 0x89, 0x71, 0x39, 0xe0, 0xd7, 0x86, 0xa2, 0x0a, 0x8e, 0xc7, 0x24, 0x62,
+	0xc8, 0x80, 0x08, 0x0c, 0x36, 0x1c, 0x7b, 0xd5, 0xac, 0x51, 0x9d, 0x9d, 0xa1, 0x11, 0xee, 0x2e,
+	0xbd, 0xb0, 0xdf, 0x19, 0x2a, 0x2d, 0x09, 0x99, 0x45, 0xc3, 0x5d, 0xb0, 0x3c, 0x67, 0x4b, 0x1b,
+	0x61, 0x41, 0x8f, 0xdf, 0x58, 0x71, 0x31, 0x34, 0x02, 0x86, 0xa9, 0x57, 0x61, 0xb4, 0x83, 0x1d,
+	0xe5, 0x20, 0xb5, 0x4e, 0xc5, 0x75, 0x9a, 0xc5, 0xec, 0x91, 0xd9, 0x6d, 0x71, 0x91, 0x0b, 0x3b,
+	0x2f, 0x7e, 0x5c, 0x4c, 0xbe, 0xac, 0x88, 0xcb, 0xa3, 0xf8, 0xcb, 0x71, 0x18, 0x8c, 0xec, 0x27,
+	0xfa, 0x36, 0x8c, 0x4a, 0x6d, 0xe3, 0x7e, 0x94, 0xdd, 0xf4, 0xe4, 0xf9, 0x3f, 0xd2, 0xe5, 0x4a,
+	0x55, 0x64, 0xa6, 0x47, 0xeb, 0xfb, 0x31, 0x0b, 0x5e, 0x87, 0xb9, 0x9c, 0x76, 0x53, 0x48, 0xa1,
+	0x3b, 0x30, 0x11, 0xfa, 0x16, 0x51, 0x27, 0x3b, 0xc9, 0xe1, 0xba, 0x9c, 0xec, 0x25, 0xe9, 0x3d,
+	0x08, 0x17, 0x5a, 0xb8, 0x14, 0xe3, 0x8d, 0xb6, 0x46, 0xe1, 0x57, 0xdf, 0xdb, 0xcb, 0x35, 0x4e,
+	0xf5, 0xec, 0xae, 0xec, 0xe2, 0x1b, 0xdf, 0x89, 0xf7, 0xda, 0xfb, 0x38, 0xee, 0xb1, 0xae, 0x35,
+	0xb8, 0xb5, 0x68, 0x79, 0x2f, 0x5d, 0x10, 0xbe, 0x57, 0xd4, 0x0d, 0xe9, 0xf6, 0xe8, 0x71, 0x8c,
+	0xd3, 0x7d, 0xe4, 0x60, 0xa8, 0x5d, 0x0e, 0x79, 0xb0, 0x59, 0xd5, 0x60, 0xb3, 0xfa, 0x0f, 0xb2,
+	0x59, 0xf3, 0xfe, 0x66, 0x7d, 0x23, 0x1a, 0xd1, 0x0e, 0xc8, 0x51, 0xc5, 0x47, 0xb4, 0x62, 0xf5,
+	0xc2, 0x60, 0xf6, 0xf6, 0x2e, 0xc1, 0x6c, 0x7a, 0x8f, 0xb9, 0x9d, 0x9f, 0x13, 0x73, 0xdb, 0x2b,
+	0xd4, 0xfd, 0x4e, 0x7c, 0xa8, 0x9b, 0xe9, 0x79, 0x83, 0xbb, 0xa3, 0xdc, 0x6b, 0x9d, 0x51, 0x6e,
+	0xf6, 0x60, 0xeb, 0xdf, 0x1e, 0x03, 0xbf, 0x02, 0x53, 0xab, 0xa4, 0xea, 0xd9, 0xce, 0x96, 0xde,
+	0xe0, 0x56, 0x26, 0x00, 0x36, 0xa9, 0xab, 0xc2, 0x74, 0x6a, 0xa6, 0x0f, 0xab, 0x92, 0x63, 0x89,
+	0x33, 0x5c, 0x0e, 0xe9, 0xe8, 0x46, 0x57, 0x04, 0x3d, 0xb8, 0x8b, 0xab, 0xdf, 0x1d, 0x41, 0x8b,
+	0xf9, 0xb5, 0x07, 0xcf, 0x55, 0x98, 0x08, 0x2c, 0xe5, 0xf9, 0x39, 0x7d, 0xc5, 0x94, 0x69, 0x32,
+	0x75, 0x68, 0xbf, 0x40, 0x48, 0x9b, 0x60, 0x77, 0xde, 0xb2, 0x14, 0x3e, 0x3f, 0xa7, 0x99, 0x3c,
+	0x99, 0x86, 0xc7, 0xdc, 0xce, 0x26, 0x74, 0x09, 0xd2, 0x4d, 0x97, 0xea, 0xc4, 0x70, 0xd4, 0xe1,
+	0x7d, 0x61, 0xa1, 0xb5, 0x5d, 0x18, 0xb8, 0xe5, 0xd2, 0x72, 0x05, 0xe3, 0x81, 0xa6, 0x4b, 0xcb,
+	0x86, 0x83, 0x16, 0x81, 0x65, 0x6d, 0xf4, 0x3a, 0x71, 0xd6, 0x4c, 0x4b, 0x1d, 0x91, 0xd7, 0x4e,
+	0x27, 0xc6, 0xe5, 0x9a, 0x4d, 0x64, 0x3c, 0x33, 0xdc, 0xda, 0x2e, 0x64, 0xcb, 0x15, 0x7c, 0x9d,
+	0x4b, 0xe0, 0x2c, 0x31, 0x1c, 0xf1, 0x88, 0x5e, 0x81, 0x21, 0x69, 0xf5, 0xc5, 0x3c, 0x47, 0xf7,
+	0x0d, 0xf8, 0x40, 0xf0, 0xf3, 0x99, 0xdc, 0x81, 0x49, 0xd7, 0x23, 0x5e, 0xd3, 0xed, 0xce, 0x35,
+	0xe4, 0x7a, 0x3b, 0x41, 0x13, 0x42, 0xbe, 0x33, 0xbd, 0x70, 0x1b, 0x54, 0x09, 0xdc, 0x9d, 0x5e,
+	0x18, 0xdb, 0xff, 0x48, 0xe0, 0xc3, 0x42, 0xba, 0x2b, 0x9b, 0xf0, 0x1a, 0x8c, 0x19, 0xd4, 0x35,
+	0x1d, 0x6a, 0xe8, 0xe1, 0x49, 0x45, 0x3d, 0x9c, 0xd4, 0x51, 0x29, 0x86, 0xfd, 0x03, 0x7b, 0x0f,
+	0x8e, 0xb5, 0x21, 0x75, 0x1e, 0xdc, 0xf1, 0x1e, 0x46, 0xa9, 0x46, 0x40, 0xdb, 0x8f, 0xed, 0xf7,
+	0xe0, 0x68, 0x88, 0xde, 0x7d, 0x7c, 0x0f, 0xf5, 0x7c, 0x7c, 0x27, 0x83, 0x2e, 0x3a, 0x4e, 0xf1,
+	0x5d, 0x98, 0x88, 0xf6, 0x10, 0x9e, 0xe6, 0x89, 0x83, 0x9d, 0xe6, 0xf1, 0xb0, 0x83, 0xf0, 0x50,
+	0xbf, 0x09, 0x87, 0x7d, 0xf0, 0x8e, 0xe3, 0x79, 0xf8, 0x80, 0xc7, 0xd3, 0x87, 0xbf, 0x1e, 0x3d,
+	0xa5, 0x7f, 0xa2, 0x40, 0xde, 0xc7, 0xdf, 0x25, 0xd3, 0x30, 0x79, 0xc0, 0x4c, 0x43, 0xbe, 0xb5,
+	0x5d, 0x98, 0xaa, 0x08, 0xcc, 0x18, 0x26, 0x3c, 0x25, 0xfb, 0x2b, 0xc7, 0xe4, 0x1d, 0xe2, 0x86,
+	0xd3, 0x91, 0x80, 0x50, 0x0f, 0x98, 0x80, 0xe8, 0x1e, 0x4e, 0x1b, 0x53, 0xc7, 0x70, 0xda, 0x68,
+	0x68, 0x1d, 0x9e, 0xf1, 0x47, 0xb3, 0xfb, 0x0d, 0x7f, 0xb4, 0x67, 0x0d, 0xf2, 0xd5, 0x7c, 0x29,
+	0xf6, 0xa2, 0x5f, 0x85, 0xa3, 0xdd, 0x9d, 0x85, 0xca, 0x74, 0xec, 0x60, 0xca, 0xa4, 0x76, 0xf4,
+	0x15, 0x6a, 0x14, 0x01, 0x9f, 0xa6, 0x77, 0xdd, 0xff, 0xc7, 0x0f, 0xd6, 0x89, 0xaf, 0x9a, 0x5a,
+	0xbb, 0x1b, 0x50, 0xfc, 0x7c, 0x08, 0x32, 0xcc, 0x87, 0xf3, 0x88, 0x47, 0xd1, 0x1b, 0x80, 0xaa,
+	0x4d, 0xc7, 0xa1, 0xcc, 0xf6, 0x04, 0x99, 0x38, 0xe9, 0xc3, 0x1d, 0xdf, 0x33, 0x5d, 0xd7, 0xe9,
+	0xd4, 0x4a, 0x98, 0x90, 0x81, 0x61, 0x07, 0x6b, 0x16, 0x62, 0x27, 0xbf, 0x00, 0xb6, 0xbf, 0x5c,
+	0x21, 0xb6, 0x06, 0x43, 0xa2, 0xf0, 0x29, 0x62, 0x18, 0x19, 0xb3, 0x4d, 0x74, 0xa2, 0x8a, 0x98,
+	0x27, 0xcc, 0x9f, 0x0c, 0x0a, 0x21, 0xde, 0x1c, 0x17, 0x5f, 0xf6, 0x7d, 0xa9, 0xf1, 0xe5, 0x9b,
+	0x30, 0x15, 0x94, 0x82, 0x4c, 0xa7, 0x4e, 0x0d, 0x3d, 0x48, 0x4a, 0x11, 0xdf, 0xf7, 0xda, 0xab,
+	0xd4, 0xd3, 0xc7, 0xcb, 0x3c, 0x93, 0x7e, 0xc9, 0x88, 0x43, 0x54, 0x24, 0x42, 0x99, 0xd5, 0x23,
+	0x54, 0x0e, 0xcf, 0x2a, 0x70, 0xf2, 0x16, 0x09, 0x6a, 0x5d, 0xa2, 0x34, 0x35, 0xce, 0xe8, 0x15,
+	0xba, 0xb1, 0xcc, 0xa9, 0xb2, 0xe8, 0xb5, 0xab, 0xab, 0x9d, 0x7e, 0x42, 0x57, 0x9b, 0xc2, 0xb1,
+	0x06, 0xb5, 0x0c, 0x86, 0x4d, 0x1a, 0x8d, 0x9a, 0x59, 0xe5, 0x17, 0x60, 0x30, 0x67, 0x35, 0x13,
+	0x8f, 0x5f, 0x0e, 0x79, 0xfd, 0xc9, 0xe1, 0x29, 0x09, 0x14, 0x43, 0x43, 0x0b, 0x90, 0x7b, 0xbb,
+	0x49, 0x9b, 0xcc, 0xa0, 0x53, 0xb7, 0x61, 0x5b, 0x2e, 0x75, 0xd5, 0x2c, 0x0f, 0x98, 0xe2, 0xf6,
+	0x6d, 0xde, 0xae, 0xd7, 0x89, 0x65, 0xe0, 0x51, 0x21, 0x83, 0x7d, 0x11, 0x06, 0xe3, 0x8f, 0x96,
+	0x1f, 0x0d, 0xd7, 0x13, 0x6e, 0xd8, 0x3e, 0x30, 0x52, 0x06, 0x4b, 0x11, 0xf4, 0x1d, 0x40, 0x72,
+	0x34, 0x3c, 0x9c, 0x24, 0xd5, 0x2a, 0x6d, 0x78, 0xea, 0x60, 0xfc, 0x54, 0xfd, 0x63, 0x57, 0x62,
+	0x11, 0x66, 0x99, 0xb3, 0x62, 0x39, 0x99, 0xb0, 0x05, 0x5d, 0x87, 0x43, 0xfe, 0xc8, 0x38, 0xa6,
+	0x1c, 0x9e, 0x3a, 0x14, 0x1f, 0x77, 0x33, 0x49, 0x39, 0x1c, 0x8c, 0xa4, 0x60, 0xa4, 0x0d, 0xbd,
+	0xc0, 0x5c, 0x6e, 0xfd, 0xa1, 0x69, 0x19, 0xf6, 0x43, 0x57, 0x27, 0x1b, 0xc4, 0xac, 0xb1, 0xd4,
+	0x20, 0xf7, 0xc9, 0x32, 0x18, 0x39, 0x9b, 0x77, 0x04, 0xa9, 0xec, 0x53, 0x50, 0x05, 0x46, 0x1c,
+	0x5a, 0xa5, 0x5c, 0x93, 0xd8, 0x92, 0xfb, 0x01, 0x69, 0xd7, 0xa1, 0x15, 0xe9, 0x45, 0x19, 0xf6,
+	0xe2, 0x61, 0x21, 0x24, 0x1a, 0x5d, 0x74, 0x05, 0x72, 0x12, 0xc5, 0xd7, 0x00, 0x57, 0x1d, 0xe5,
+	0x38, 0x85, 0x2e, 0x73, 0x2c, 0x19, 0x7c, 0xa4, 0x51, 0x21, 0xe8, 0x37, 0xbb, 0xa8, 0x06, 0x45,
+	0x51, 0xa8, 0x15, 0x75, 0x64, 0xdd, 0xb4, 0x4c, 0xcf, 0x64, 0xd7, 0x68, 0xdb, 0x89, 0xca, 0xf5,
+	0x78, 0xa2, 0xf2, 0xbc, 0xb6, 0x2b, 0xa0, 0x16, 0x7d, 0xa4, 0xc8, 0xc1, 0xfa, 0x1e, 0x4c, 0xca,
+	0x3d, 0x5d, 0xb5, 0x9d, 0x2a, 0xd5, 0x65, 0x05, 0xc8, 0xa1, 0x6f, 0x4b, 0xa7, 0xec, 0xd4, 0xee,
+	0x1a, 0x52, 0xba, 0xcc, 0x44, 0x44, 0x0d, 0x08, 0xd3, 0xb7, 0xf1, 0x21, 0x81, 0xd4, 0xde, 0x8a,
+	0xbe, 0x0e, 0xa3, 0x7c, 0x3e, 0xce, 0x5b, 0xd2, 0xf1, 0x7b, 0x81, 0x7b, 0x67, 0xc3, 0x5a, 0xae,
+	0xb5, 0x5d, 0x18, 0xba, 0x46, 0x5c, 0x0f, 0x5f, 0xe1, 0x4e, 0xdd, 0x0b, 0x78, 0x88, 0x31, 0xe2,
+	0xb7, 0xc4, 0xaf, 0xa9, 0x7f, 0x50, 0x00, 0x22, 0xaa, 0xf2, 0x2c, 0xa4, 0x1b, 0x22, 0xdb, 0xc0,
+	0x6d, 0xf6, 0x10, 0xb7, 0xff, 0xef, 0xf4, 0xe5, 0xc6, 0xd4, 0x67, 0xb0, 0x4f, 0x41, 0xf3, 0x90,
+	0xf6, 0x55, 0x28, 0xb9, 0xaf, 0x0a, 0x75, 0x98, 0x5e, 0x5f, 0x12, 0xbd, 0xda, 0x7b, 0x41, 0xbe,
+	0x1d, 0x81, 0x8b, 0xc9, 0xf4, 0xc1, 0xa7, 0x4a, 0x24, 0x97, 0x5a, 0x6e, 0x7a, 0x0f, 0xa8, 0xe5,
+	0xc9, 0xe3, 0x3d, 0x6f, 0x1b, 0x14, 0xcd, 0xfa, 0xe9, 0x07, 0x91, 0x48, 0x9d, 0xdc, 0xd1, 0x0e,
+	0x39, 0x68, 0x2e, 0x77, 0xff, 0x6e, 0x79, 0xf6, 0x0d, 0x96, 0xe8, 0x7c, 0xf7, 0xdc, 0x99, 0xf3,
+	0x73, 0xef, 0x9f, 0x90, 0x79, 0x09, 0x74, 0x09, 0x80, 0xbf, 0xac, 0xa2, 0xaf, 0x3a, 0x76, 0x5d,
+	0x4d, 0xf6, 0xb8, 0xfb, 0x59, 0x2e, 0x73, 0xd9, 0xb1, 0xeb, 0xe8, 0x9b, 0x90, 0x11, 0x00, 0x9e,
+	0xad, 0xa6, 0x7a, 0x14, 0x4f, 0x73, 0x89, 0x9b, 0xb6, 0x9c, 0xd2, 0xef, 0xa6, 0x21, 0x1b, 0x4c,
+	0x09, 0xbd, 0x16, 0xcd, 0x81, 0x9e, 0xd8, 0x35, 0x7f, 0xd3, 0x43, 0xf2, 0x73, 0x1e, 0xa0, 0xea,
+	0x50, 0x22, 0x5f, 0x0b, 0x48, 0x1e, 0xe4, 0xb5, 0x00, 0x29, 0x57, 0xf6, 0x18, 0x48, 0xb3, 0x61,
+	0xf8, 0x20, 0xa9, 0x83, 0x80, 0x48, 0xb9, 0xb2, 0x87, 0x8e, 0xca, 0xa4, 0xb8, 0xc8, 0x56, 0xa6,
+	0x45, 0xb6, 0x72, 0x4e, 0xd6, 0x00, 0x4e, 0xc3, 0xa0, 0x41, 0xdd, 0xaa, 0x63, 0x36, 0xd8, 0x26,
+	0xf2, 0x3b, 0x2d, 0xcb, 0xef, 0x5b, 0x27, 0xa5, 0x7e, 0x3a, 0x8a, 0xa3, 0x44, 0xf4, 0x10, 0x80,
+	0x78, 0x9e, 0x63, 0xae, 0x34, 0x3d, 0xca, 0xaa, 0xf5, 0xa9, 0xb8, 0x93, 0x14, 0xac, 0x51, 0xa9,
+	0x1c, 0xf0, 0x8a, 0xe4, 0xd6, 0x99, 0x1d, 0xed, 0xd4, 0x5f, 0x2a, 0xcf, 0x15, 0x7b, 0x4a, 0x86,
+	0xe3, 0x48, 0x57, 0xe8, 0x1e, 0x0c, 0xca, 0x0b, 0x9e, 0x67, 0xd7, 0xd2, 0x07, 0xcf, 0x50, 0xf3,
+	0xc4, 0x9a, 0xdf, 0xce, 0x12, 0x6b, 0x1b, 0x3e, 0x8f, 0x8b, 0x16, 0x01, 0xb9, 0xd4, 0x61, 0x82,
+	0x7a, 0x98, 0xc3, 0xe3, 0x97, 0x5d, 0x56, 0x3b, 0x1a, 0xe6, 0x76, 0x73, 0xcb, 0x82, 0x29, 0x48,
+	0xcf, 0xe1, 0x9c, 0xdb, 0xde, 0x62, 0xa0, 0x7f, 0x56, 0xe0, 0xb0, 0x6f, 0xe2, 0x18, 0x91, 0x3a,
+	0xfc, 0xd5, 0x1a, 0xea, 0xba, 0x3c, 0xf9, 0x90, 0xd5, 0xfe, 0x4c, 0xd9, 0xd1, 0x7e, 0xa8, 0x38,
+	0x7f, 0xa4, 0xcc, 0xfd, 0x81, 0x72, 0x7f, 0xe6, 0xd2, 0x45, 0x36, 0x77, 0x32, 0xfb, 0x8e, 0x3c,
+	0x1e, 0xef, 0x45, 0x9e, 0xc3, 0xc7, 0x7b, 0xb3, 0x6f, 0x9e, 0x8e, 0x10, 0x4e, 0xdd, 0x2b, 0x9d,
+	0x3a, 0xcd, 0xe4, 0xca, 0xb3, 0x6f, 0xc8, 0x25, 0x7b, 0x2f, 0xf2, 0x1c, 0x3e, 0x72, 0xb9, 0x90,
+	0x70, 0x6a, 0xe6, 0xd2, 0xc5, 0x8b, 0x77, 0xe5, 0x29, 0x7c, 0xf1, 0xfd, 0x53, 0x97, 0x4e, 0xbc,
+	0x77, 0xff, 0x04, 0x3e, 0x24, 0x87, 0xbb, 0xcc, 0x47, 0x5b, 0x16, 0x83, 0x45, 0x6f, 0x80, 0xda,
+	0x31, 0x8d, 0x75, 0xba, 0xae, 0xd7, 0xc8, 0x0a, 0xad, 0xa9, 0x67, 0xf9, 0x44, 0x9e, 0x11, 0x2a,
+	0xf2, 0x01, 0xb3, 0x77, 0x13, 0x37, 0xa2, 0x18, 0x57, 0x17, 0xae, 0x5e, 0x63, 0x8c, 0x78, 0xa2,
+	0x0d, 0xfa, 0x2a, 0x5d, 0xe7, 0xcd, 0xe8, 0xdf, 0x14, 0x98, 0x8a, 0xba, 0x17, 0x1d, 0xeb, 0x04,
+	0x5f, 0xcd, 0x75, 0x52, 0x23, 0x43, 0x6e, 0x5f, 0xab, 0x55, 0x38, 0x16, 0x33, 0x9d, 0x70, 0xbd,
+	0x5e, 0xe0, 0x13, 0x3a, 0x19, 0x59, 0xaf, 0x23, 0xe5, 0x4e, 0xac, 0x60, 0xcd, 0x8e, 0x74, 0x75,
+	0x13, 0xac, 0x1b, 0x86, 0x89, 0x98, 0x7e, 0x4c, 0x43, 0x3d, 0xc7, 0x3b, 0xc8, 0x0b, 0x4d, 0x35,
+	0x78, 0x45, 0xb8, 0x13, 0x64, 0xb1, 0x82, 0xc7, 0xbb, 0x90, 0x17, 0x0d, 0xf4, 0x4f, 0x0a, 0x8c,
+	0xf3, 0xab, 0xb1, 0x63, 0x13, 0x06, 0xbf, 0x9a, 0x9b, 0x30, 0xc6, 0xc6, 0xda, 0xbe, 0xfa, 0x1e,
+	0x64, 0x6b, 0xb6, 0x98, 0x15, 0x2b, 0x02, 0xa4, 0xe2, 0xa2, 0xd7, 0xd0, 0x24, 0x5d, 0xf3, 0x59,
+	0xbf, 0x88, 0x45, 0x0a, 0x3b, 0x42, 0xe7, 0x20, 0x2d, 0xdf, 0xba, 0x53, 0xe7, 0xb8, 0x31, 0x9a,
+	0xec, 0x76, 0xba, 0x39, 0x19, 0xfb, 0x7c, 0xb1, 0x05, 0x9e, 0xe1, 0x9e, 0x0b, 0x3c, 0x23, 0xb1,
+	0x05, 0x9e, 0x98, 0x00, 0x68, 0xf4, 0x69, 0x14, 0xd8, 0x72, 0x4f, 0xab, 0xc0, 0x36, 0x76, 0xf0,
+	0x02, 0x5b, 0x57, 0x35, 0x0a, 0xf5, 0x52, 0x8d, 0x1a, 0xef, 0xa5, 0x1a, 0x75, 0xa8, 0xe7, 0x6a,
+	0xd4, 0xc4, 0x2e, 0xd5, 0xa8, 0x17, 0x21, 0xeb, 0xd8, 0xb6, 0xa7, 0x73, 0x4f, 0x4c, 0xa4, 0x98,
+	0xd4, 0xae, 0x74, 0x9e, 0x6d, 0x7b, 0xcc, 0x0d, 0xc3, 0x19, 0x47, 0x3e, 0xa1, 0xdb, 0x30, 0x60,
+	0x51, 0x8f, 0x2d, 0xc8, 0x24, 0x77, 0x12, 0x2f, 0xfd, 0x7a, 0xbb, 0x30, 0x77, 0xa0, 0xf7, 0x33,
+	0x6f, 0x50, 0x6f, 0xb1, 0xd2, 0xda, 0x2e, 0xf4, 0xf3, 0x07, 0xdc, 0x6f, 0x51, 0x6f, 0xd1, 0x40,
+	0xaf, 0xc3, 0x50, 0x5b, 0x61, 0x50, 0xdd, 0xbf, 0x30, 0xc8, 0x5e, 0xcb, 0x8b, 0x56, 0x90, 0xf0,
+	0x60, 0x3d, 0x52, 0x0a, 0x9c, 0x87, 0x2c, 0x07, 0xf4, 0x88, 0x47, 0xd5, 0x23, 0xf1, 0xf3, 0xf3,
+	0x63, 0x28, 0x6d, 0xa8, 0xb5, 0x5d, 0x08, 0x12, 0x19, 0x38, 0xc3, 0x70, 0xd8, 0x13, 0xfa, 0x2e,
+	0x8c, 0xf9, 0xe1, 0x53, 0x08, 0x76, 0x66, 0x1f, 0xb0, 0x71, 0xa6, 0x1c, 0x4b, 0x42, 0x2c, 0xc0,
+	0xf4, 0x83, 0xbd, 0xeb, 0x3e, 0xf4, 0x39, 0x48, 0xbb, 0xc2, 0xd1, 0x55, 0xa7, 0xe2, 0xcf, 0xad,
+	0xf4, 0x83, 0xb1, 0xcf, 0x87, 0xbe, 0x05, 0x3e, 0x8a, 0xee, 0x8b, 0x1e, 0xdd, 0x5b, 0x74, 0x44,
+	0xf2, 0xcb, 0xdf, 0xe8, 0x04, 0x8c, 0x04, 0x61, 0x3e, 0xd7, 0x0f, 0x9e, 0x6d, 0x1a, 0x16, 0x81,
+	0x41, 0x85, 0x6e, 0x70, 0xdd, 0x40, 0xcf, 0xc1, 0x68, 0xd3, 0xa5, 0x46, 0xc8, 0xe5, 0xaa, 0xc7,
+	0xa7, 0x53, 0xec, 0xf5, 0x54, 0xd6, 0xec, 0xb3, 0xb1, 0x37, 0x42, 0x45, 0xe4, 0x11, 0xaa, 0x9b,
+	0x9a, 0x0f, 0x5f, 0x63, 0x0d, 0x74, 0x2d, 0x2e, 0x42, 0x29, 0xf4, 0x12, 0xa1, 0x74, 0x0b, 0x9e,
+	0x53, 0xa7, 0x63, 0x05, 0xcf, 0xb5, 0x09, 0x9e, 0x43, 0xf7, 0xe1, 0x68, 0x67, 0x3a, 0x83, 0x85,
+	0x81, 0xe6, 0x86, 0xf0, 0x5e, 0x9f, 0x39, 0x48, 0xba, 0x24, 0xc8, 0x79, 0x60, 0x89, 0x50, 0xf6,
+	0xd0, 0x02, 0x0c, 0x8a, 0x17, 0x4e, 0x85, 0x46, 0x14, 0x77, 0x31, 0x42, 0x8c, 0x45, 0xe8, 0x44,
+	0x98, 0x36, 0x82, 0x46, 0xd0, 0x8a, 0xee, 0x02, 0x5a, 0xe1, 0x55, 0xdb, 0x2d, 0x96, 0x3c, 0x61,
+	0x61, 0x2a, 0x59, 0xa3, 0xea, 0xb3, 0xfb, 0x17, 0x27, 0x46, 0x77, 0xb4, 0x21, 0x80, 0xe3, 0x89,
+	0xc4, 0x07, 0x97, 0x66, 0x13, 0x89, 0x44, 0x02, 0x8f, 0x49, 0x9c, 0xa5, 0x00, 0x06, 0x3d, 0x0f,
+	0xa3, 0x41, 0x40, 0x2b, 0xcb, 0x1e, 0x27, 0xa6, 0x95, 0x99, 0x7e, 0x3c, 0xe2, 0x37, 0xcb, 0x7a,
+	0x06, 0x61, 0x76, 0x83, 0x49, 0xf1, 0x4c, 0xac, 0x1f, 0xa6, 0x9f, 0xec, 0x21, 0x4c, 0xd7, 0x0e,
+	0x31, 0x67, 0x14, 0x73, 0xe1, 0x72, 0x05, 0x0b, 0x9a, 0x8b, 0x65, 0xac, 0x5e, 0x36, 0x1c, 0xd9,
+	0x12, 0x93, 0x05, 0x78, 0xee, 0x4b, 0xca, 0x02, 0x3c, 0xff, 0x05, 0xb3, 0x00, 0x14, 0x8e, 0xc9,
+	0xb8, 0x3c, 0x2e, 0xbf, 0xe4, 0xaa, 0x33, 0xd3, 0xa9, 0xb8, 0xac, 0x4b, 0x6c, 0x82, 0x49, 0x00,
+	0xc5, 0x90, 0x5c, 0xf4, 0x1a, 0x40, 0xa4, 0xd6, 0x7f, 0xea, 0x60, 0xb5, 0x7e, 0x1c, 0x91, 0x45,
+	0x2b, 0x30, 0xd2, 0x70, 0xec, 0x0d, 0x93, 0x9d, 0x63, 0xe1, 0x6c, 0x9d, 0xe6, 0x37, 0xd2, 0x37,
+	0x77, 0xb4, 0xe7, 0x9d, 0x93, 0xea, 0x89, 0xb9, 0x67, 0xf6, 0xf6, 0x19, 0xde, 0xbb, 0xcf, 0xde,
+	0xea, 0x19, 0x5e, 0x0a, 0x31, 0x16, 0x2b, 0x78, 0x38, 0x02, 0xb9, 0x68, 0xa0, 0x0a, 0x8c, 0x05,
+	0x0d, 0xcc, 0xca, 0x18, 0xc4, 0x23, 0xea, 0xd7, 0xa4, 0x89, 0xe9, 0x54, 0xc7, 0x65, 0xfe, 0x3d,
+	0x04, 0xce, 0x45, 0x25, 0x58, 0xa2, 0x1b, 0x1d, 0x83, 0x6c, 0xbd, 0x59, 0x63, 0xc1, 0xb8, 0xeb,
+	0xa9, 0xb3, 0xfc, 0xfa, 0x09, 0x1b, 0xd0, 0x1a, 0x1c, 0xa9, 0xd6, 0x88, 0x59, 0xd7, 0x49, 0x5b,
+	0xcc, 0xae, 0x57, 0x6d, 0x83, 0xaa, 0xa5, 0x7d, 0xc2, 0xa9, 0xee, 0x38, 0x1f, 0x4f, 0x72, 0xb4,
+	0x6e, 0x02, 0x2a, 0xc1, 0xb8, 0xbb, 0x6e, 0x36, 0x74, 0x99, 0xba, 0xd0, 0xab, 0xce, 0x56, 0xc3,
+	0xb3, 0xd5, 0xf3, 0x7c, 0x40, 0x63, 0x8c, 0x24, 0x17, 0x7c, 0x9e, 0x13, 0x58, 0x64, 0x17, 0x7d,
+	0x6f, 0xe2, 0xc2, 0x3e, 0x43, 0x09, 0x22, 0xad, 0x8e, 0xc8, 0x6e, 0xf7, 0x57, 0x26, 0x3a, 0x82,
+	0xd0, 0x83, 0xbc, 0x32, 0x31, 0x75, 0x1b, 0x46, 0xda, 0x1d, 0xc6, 0x18, 0xe9, 0x52, 0x54, 0x3a,
+	0xe6, 0x82, 0xf2, 0x01, 0xba, 0x5f, 0xc5, 0x78, 0x0d, 0x20, 0x98, 0x97, 0x8b, 0x2e, 0xc2, 0x60,
+	0xf8, 0x31, 0x10, 0x4b, 0x40, 0xa4, 0x78, 0x55, 0x72, 0xb7, 0x85, 0xc0, 0x40, 0x03, 0xd9, 0xa2,
+	0x01, 0x87, 0xe7, 0x79, 0xca, 0x20, 0x24, 0xcb, 0xa4, 0xcf, 0x15, 0x80, 0x10, 0x35, 0x78, 0xb3,
+	0x63, 0x37, 0xd0, 0x98, 0x54, 0x46, 0x36, 0xe8, 0xa6, 0xf8, 0x37, 0x0a, 0x1c, 0xbe, 0xc5, 0x93,
+	0x0a, 0xff, 0x97, 0xdd, 0xb0, 0x9c, 0x50, 0xf8, 0x59, 0xd0, 0xae, 0x79, 0x93, 0xcb, 0x8c, 0xe5,
+	0x3a, 0x71, 0xd7, 0xb5, 0x3e, 0x06, 0x82, 0xb3, 0xab, 0x7e, 0x43, 0xf1, 0xef, 0x15, 0x18, 0xff,
+	0x36, 0xf5, 0xba, 0x06, 0x79, 0x0f, 0x46, 0xc2, 0x41, 0xea, 0x4f, 0x9e, 0xe5, 0x19, 0xa2, 0x21,
+	0x9f, 0xfb, 0xe4, 0xc3, 0xfe, 0x4c, 0x81, 0x93, 0xd1, 0x61, 0x47, 0x3a, 0xbf, 0x6c, 0x3b, 0x0b,
+	0xb7, 0x16, 0x5d, 0x7f, 0x22, 0xdf, 0x83, 0x0c, 0xbf, 0xfc, 0x69, 0xd3, 0x94, 0x49, 0xc3, 0x05,
+	0xf9, 0xcd, 0xce, 0xc1, 0x7c, 0xc2, 0x85, 0x5b, 0x8b, 0x2f, 0x5d, 0x60, 0x2f, 0x1d, 0x32, 0xa7,
+	0x61, 0xe1, 0xd6, 0x22, 0x4e, 0x33, 0xd8, 0x85, 0xa6, 0x89, 0xde, 0x04, 0xf6, 0x1d, 0x0f, 0xef,
+	0x40, 0x7c, 0x14, 0x54, 0x79, 0xa2, 0x0e, 0x06, 0x2a, 0x74, 0x83, 0xe1, 0x0f, 0x18, 0x74, 0x63,
+	0xa1, 0x69, 0x16, 0x3f, 0x4c, 0xc1, 0xc4, 0x35, 0xd3, 0x0d, 0xe7, 0x1a, 0x4c, 0x8d, 0xc0, 0x68,
+	0xf4, 0x66, 0x08, 0x37, 0xe9, 0xb9, 0x3d, 0xee, 0x84, 0xbd, 0xb7, 0x69, 0x84, 0x44, 0x39, 0x9f,
+	0x7c, 0xa3, 0xd0, 0x47, 0x0a, 0xf4, 0xdb, 0x8e, 0x41, 0x1d, 0xf9, 0xe2, 0xec, 0x1f, 0x2b, 0x3b,
+	0xda, 0x1f, 0x2a, 0xce, 0xf7, 0x15, 0x9c, 0xc0, 0xd9, 0x40, 0xbb, 0x30, 0xcc, 0x86, 0xcf, 0xc1,
+	0x7e, 0xe1, 0xec, 0x6c, 0xf0, 0xe8, 0x2f, 0x31, 0xce, 0xcc, 0xfa, 0x4f, 0x3c, 0x25, 0x87, 0xfb,
+	0x67, 0xf9, 0xbf, 0x68, 0xea, 0x0d, 0x0f, 0xcd, 0x46, 0x7f, 0x45, 0x32, 0x8b, 0x78, 0x70, 0x36,
+	0xf2, 0x43, 0x0c, 0x0c, 0xe5, 0xa1, 0x5f, 0x7c, 0x17, 0xc3, 0xbf, 0x98, 0xe2, 0x7e, 0xd0, 0xe9,
+	0x94, 0xfa, 0x59, 0x1a, 0x8b, 0x66, 0xf6, 0x9a, 0x6c, 0x83, 0x39, 0x3d, 0xe2, 0x4b, 0x29, 0xfe,
+	0x5c, 0xfc, 0x2b, 0x05, 0xc6, 0x97, 0x63, 0x8e, 0xcd, 0xe5, 0x83, 0x9d, 0xed, 0xf6, 0xdc, 0xf1,
+	0x97, 0x79, 0xae, 0xff, 0x5d, 0x81, 0x63, 0x1a, 0xf1, 0xaa, 0x0f, 0x3a, 0x6c, 0xdd, 0xd3, 0x54,
+	0x9e, 0x1b, 0x90, 0x09, 0x6a, 0x4d, 0xc9, 0xe9, 0x54, 0x1c, 0x76, 0xbc, 0x25, 0xd6, 0x60, 0x47,
+	0x4b, 0x7f, 0xa8, 0xb0, 0xcf, 0xbc, 0x0c, 0x1c, 0x60, 0x14, 0xff, 0x55, 0x81, 0x23, 0x7c, 0x4e,
+	0xd1, 0x93, 0xff, 0x34, 0x27, 0x74, 0xb5, 0x6b, 0x42, 0x5d, 0xde, 0x57, 0x8c, 0x2d, 0xdd, 0x65,
+	0x36, 0xc1, 0x0e, 0x75, 0x5c, 0x13, 0x5f, 0xb1, 0x1d, 0x8a, 0xbf, 0xc4, 0xf6, 0xdb, 0xa1, 0xe5,
+	0xaf, 0xee, 0x0e, 0x2d, 0xf7, 0xbc, 0x43, 0xff, 0xed, 0xef, 0x50, 0x85, 0xd6, 0xe8, 0xff, 0xd3,
+	0x0e, 0xad, 0x76, 0xdd, 0xc3, 0x62, 0x5a, 0xbd, 0xdd, 0xc3, 0x53, 0xe1, 0xbc, 0x58, 0x38, 0x1a,
+	0xf2, 0x54, 0xdc, 0xf6, 0x1b, 0xb9, 0xf8, 0x93, 0x24, 0x4c, 0xf2, 0xb9, 0x46, 0x67, 0x29, 0x8a,
+	0xc7, 0xe8, 0x06, 0xab, 0xa8, 0xb9, 0xcd, 0x9a, 0xe7, 0x7b, 0x5a, 0xa5, 0xce, 0xce, 0x77, 0x91,
+	0x2c, 0x61, 0x2e, 0x26, 0xad, 0x93, 0x0f, 0x32, 0xf5, 0x73, 0x05, 0x06, 0x04, 0x05, 0xcd, 0x1f,
+	0xbc, 0x82, 0x34, 0xc8, 0xc0, 0xd8, 0xe7, 0x17, 0x6c, 0x12, 0x4c, 0x1a, 0xbd, 0xdc, 0x66, 0x74,
+	0x93, 0xfb, 0x18, 0xdd, 0xa8, 0x99, 0x9d, 0x83, 0x7e, 0xfe, 0x49, 0xb3, 0x9a, 0x8a, 0x7f, 0x59,
+	0x6c, 0x81, 0x11, 0x2b, 0xd4, 0x23, 0x66, 0xcd, 0xc5, 0x82, 0xb5, 0xf8, 0x9f, 0xd1, 0xaa, 0x5e,
+	0xb7, 0x8b, 0x8d, 0xaa, 0x4f, 0xaa, 0x14, 0x88, 0xa5, 0x1a, 0xa3, 0xb4, 0x8a, 0xdb, 0xa5, 0x16,
+	0xcb, 0x00, 0x91, 0xea, 0x8b, 0xf8, 0x72, 0xe2, 0x42, 0xef, 0x5f, 0x4e, 0x64, 0xc3, 0xb2, 0x4c,
+	0x36, 0x88, 0x00, 0xa4, 0xa7, 0xfd, 0xf3, 0xfe, 0xc8, 0xd7, 0x0e, 0x92, 0xaf, 0xd7, 0xaf, 0x1d,
+	0x62, 0x22, 0x8e, 0xaf, 0x7c, 0xc1, 0x4f, 0x85, 0x74, 0xf4, 0x7d, 0x98, 0x61, 0xec, 0xff, 0x0c,
+	0x4a, 0x81, 0xfd, 0x3d, 0x94, 0x02, 0x07, 0xf6, 0x2a, 0x05, 0x76, 0x66, 0x0e, 0xd3, 0x4f, 0x9a,
+	0x39, 0x8c, 0x49, 0x65, 0x67, 0x9e, 0x46, 0x2a, 0x3b, 0xfb, 0xb4, 0x52, 0xd9, 0x70, 0xe0, 0x54,
+	0xb6, 0xd4, 0xde, 0xef, 0xc0, 0x58, 0xa7, 0x36, 0xba, 0xe8, 0x15, 0xc8, 0x48, 0x2d, 0xf7, 0x2d,
+	0xd8, 0xf4, 0x7e, 0x2a, 0x8c, 0x03, 0x89, 0xe2, 0x3f, 0x2a, 0x30, 0x15, 0xbd, 0xd6, 0x7d, 0x0e,
+	0x79, 0x09, 0xdc, 0x6f, 0x0f, 0xca, 0xbf, 0x94, 0x23, 0x12, 0x09, 0xcb, 0x9f, 0xdc, 0x15, 0xfc,
+	0x5c, 0x81, 0x63, 0x6d, 0x01, 0x84, 0xbf, 0x2e, 0xfe, 0x0c, 0x9e, 0x8a, 0xc5, 0x7a, 0xe2, 0x48,
+	0x22, 0x70, 0xd3, 0x53, 0x7b, 0xbb, 0xe9, 0x7d, 0x11, 0x37, 0xfd, 0x27, 0x0a, 0x4c, 0x2d, 0xef,
+	0xbe, 0x75, 0xaf, 0x41, 0x5a, 0x2e, 0xb4, 0x9c, 0xf0, 0xbe, 0x6a, 0xd1, 0xf9, 0xbe, 0x88, 0x14,
+	0x7f, 0xf2, 0x4d, 0xfa, 0x17, 0x25, 0xa2, 0xb8, 0x37, 0x69, 0xbd, 0x51, 0x23, 0x1e, 0xfd, 0xca,
+	0x84, 0x13, 0x68, 0x06, 0x06, 0xeb, 0xa4, 0xc1, 0x5f, 0xa4, 0x63, 0x29, 0x9d, 0x54, 0xd4, 0x22,
+	0x1a, 0x18, 0x24, 0xed, 0x2a, 0xdd, 0x2a, 0x7e, 0xac, 0xc0, 0x64, 0xd7, 0x44, 0x44, 0xc2, 0x30,
+	0x30, 0xa8, 0x4a, 0xbb, 0x78, 0xac, 0x41, 0x4d, 0x46, 0x0d, 0xea, 0x27, 0x4a, 0xbb, 0x41, 0xbd,
+	0x09, 0xa3, 0xfc, 0xc0, 0xd1, 0x4d, 0x8f, 0x5a, 0x2e, 0xaf, 0x66, 0xa6, 0xd8, 0x07, 0x62, 0xda,
+	0xd7, 0x76, 0xb4, 0x99, 0x0f, 0x95, 0x93, 0x39, 0x43, 0x55, 0x8a, 0x05, 0xe7, 0xf8, 0xdc, 0x51,
+	0x56, 0x89, 0xbd, 0x57, 0xf2, 0x2f, 0xc0, 0x77, 0xcf, 0x9d, 0x39, 0xf7, 0xd2, 0xfb, 0xa7, 0xde,
+	0x3d, 0x77, 0x86, 0xbd, 0x57, 0x33, 0xc2, 0x30, 0x16, 0x02, 0x88, 0xe2, 0xff, 0x28, 0xa0, 0xee,
+	0x32, 0x74, 0x17, 0xbd, 0x0f, 0x69, 0x91, 0xea, 0xf4, 0x4d, 0xc8, 0x8b, 0xbb, 0xee, 0x43, 0x87,
+	0x68, 0x49, 0xfe, 0xff, 0x22, 0x55, 0x54, 0xbf, 0xcf, 0xa9, 0x2a, 0x0c, 0x45, 0x61, 0x62, 0x72,
+	0x6b, 0xaf, 0xb6, 0xe7, 0xd6, 0x9e, 0xef, 0x71, 0x78, 0x91, 0x54, 0x5b, 0xf1, 0x97, 0x49, 0x28,
+	0xcc, 0xdb, 0xd6, 0x06, 0x75, 0xbc, 0x2e, 0x6e, 0xff, 0xcc, 0x2c, 0x41, 0x56, 0x8c, 0x29, 0xfc,
+	0x02, 0xf4, 0x7c, 0xef, 0x8e, 0x47, 0x46, 0x74, 0xba, 0x58, 0xc1, 0x19, 0x81, 0xb2, 0xc8, 0x3f,
+	0x43, 0xe5, 0x59, 0x5c, 0x9e, 0x3c, 0xc1, 0xfc, 0x19, 0xad, 0xc3, 0x60, 0xd5, 0xdd, 0xd0, 0xed,
+	0x86, 0x27, 0x37, 0x97, 0x4d, 0xe9, 0x62, 0x57, 0xf4, 0xb8, 0xf7, 0x58, 0x4b, 0xf3, 0xcb, 0xb7,
+	0x5f, 0x17, 0x08, 0x22, 0xf1, 0x19, 0xfe, 0xc6, 0x50, 0x75, 0x37, 0xe4, 0xf3, 0xd4, 0x55, 0x88,
+	0x50, 0xd0, 0x49, 0xc8, 0xba, 0xb4, 0x41, 0x1c, 0xe2, 0xd9, 0x4e, 0xbb, 0xa6, 0xf6, 0xe1, 0x90,
+	0xc2, 0x3e, 0x4d, 0x5c, 0x21, 0x2e, 0x7d, 0xe9, 0x02, 0x1f, 0x77, 0x06, 0xcb, 0x5f, 0xa7, 0x2f,
+	0x03, 0x84, 0x45, 0x15, 0x34, 0x06, 0xc3, 0x4b, 0xaf, 0xdf, 0x59, 0xc0, 0xfa, 0xad, 0x1b, 0x57,
+	0x6f, 0xbc, 0x7e, 0xe7, 0x46, 0x2e, 0x11, 0x36, 0x69, 0xe5, 0x9b, 0x37, 0x17, 0xf0, 0x77, 0x73,
+	0x0a, 0x42, 0x30, 0x22, 0x9a, 0x16, 0x7e, 0xff, 0xe6, 0x02, 0xbe, 0x51, 0xbe, 0x96, 0x4b, 0x6a,
+	0x7f, 0xad, 0x7c, 0xf2, 0x28, 0xaf, 0x7c, 0xfa, 0x28, 0xaf, 0xfc, 0xea, 0x51, 0x3e, 0xf1, 0x9b,
+	0x47, 0xf9, 0xc4, 0x67, 0x8f, 0xf2, 0x89, 0xdf, 0x3e, 0xca, 0x27, 0x3e, 0x7f, 0x94, 0x57, 0x3e,
+	0x68, 0xe5, 0x95, 0x1f, 0xb4, 0xf2, 0x89, 0x9f, 0xb6, 0xf2, 0xca, 0xcf, 0x5a, 0xf9, 0xc4, 0xc7,
+	0xad, 0x7c, 0xe2, 0x17, 0xad, 0x7c, 0xe2, 0x93, 0x56, 0x5e, 0xf9, 0xb4, 0x95, 0x57, 0x7e, 0xd5,
+	0xca, 0x27, 0x7e, 0xd3, 0xca, 0x2b, 0x9f, 0xb5, 0xf2, 0x89, 0xdf, 0xb6, 0xf2, 0xca, 0xe7, 0xad,
+	0x7c, 0xe2, 0x83, 0xc7, 0xf9, 0xc4, 0x0f, 0x1e, 0xe7, 0x95, 0x1f, 0x3d, 0xce, 0x27, 0x7e, 0xfc,
+	0x38, 0xaf, 0x7c, 0xf4, 0x38, 0x9f, 0xf8, 0xe9, 0xe3, 0x7c, 0xe2, 0x67, 0x8f, 0xf3, 0xca, 0xc7,
+	0x8f, 0xf3, 0xca, 0x2f, 0x1e, 0xe7, 0x95, 0x37, 0xce, 0xf4, 0x9a, 0xb3, 0xf2, 0xac, 0xc6, 0xca,
+	0xca, 0x00, 0xb7, 0x1d, 0xe7, 0xff, 0x77, 0x00, 0x54, 0x2a, 0xc7, 0x71, 0xad, 0x49, 0x00, 0x00,
+}
+
+func (x PowerState) String() string {
+	s, ok := PowerState_name[int32(x)]
+	if ok {
+		return s
+	}
+	return strconv.Itoa(int(x))
+}
+func (this *Session) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Session)
 	if !ok {
-		that2, ok := that.(EndDeviceVersionIdentifiers)
+		that2, ok := that.(Session)
 		if ok {
 			that1 = &that2
 		} else {
@@ -3191,28 +3861,37 @@ func (this *EndDeviceVersionIdentifiers) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.BrandID != that1.BrandID {
+	if !this.DevAddr.Equal(that1.DevAddr) {
 		return false
 	}
-	if this.ModelID != that1.ModelID {
+	if !this.SessionKeys.Equal(&that1.SessionKeys) {
 		return false
 	}
-	if this.HardwareVersion != that1.HardwareVersion {
+	if this.LastFCntUp != that1.LastFCntUp {
 		return false
 	}
-	if this.FirmwareVersion != that1.FirmwareVersion {
+	if this.LastNFCntDown != that1.LastNFCntDown {
+		return false
+	}
+	if this.LastAFCntDown != that1.LastAFCntDown {
+		return false
+	}
+	if this.LastConfFCntDown != that1.LastConfFCntDown {
+		return false
+	}
+	if !this.StartedAt.Equal(that1.StartedAt) {
 		return false
 	}
 	return true
 }
-func (this *EndDeviceVersion) Equal(that interface{}) bool {
+func (this *MACParameters) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*EndDeviceVersion)
+	that1, ok := that.(*MACParameters)
 	if !ok {
-		that2, ok := that.(EndDeviceVersion)
+		that2, ok := that.(MACParameters)
 		if ok {
 			that1 = &that2
 		} else {
@@ -3224,30 +3903,259 @@ func (this *EndDeviceVersion) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.EndDeviceVersionIdentifiers.Equal(&that1.EndDeviceVersionIdentifiers) {
+	if this.MaxEIRP != that1.MaxEIRP {
 		return false
 	}
-	if this.LoRaWANVersion != that1.LoRaWANVersion {
+	if this.ADRDataRateIndex != that1.ADRDataRateIndex {
 		return false
 	}
-	if this.LoRaWANPHYVersion != that1.LoRaWANPHYVersion {
+	if this.ADRTxPowerIndex != that1.ADRTxPowerIndex {
 		return false
 	}
-	if this.FrequencyPlanID != that1.FrequencyPlanID {
+	if this.ADRNbTrans != that1.ADRNbTrans {
 		return false
 	}
-	if len(this.Photos) != len(that1.Photos) {
+	if this.ADRAckLimit != that1.ADRAckLimit {
 		return false
 	}
-	for i := range this.Photos {
-		if this.Photos[i] != that1.Photos[i] {
-			return false
-		}
-	}
-	if this.SupportsClassB != that1.SupportsClassB {
+	if this.ADRAckDelay != that1.ADRAckDelay {
 		return false
 	}
-	if this.SupportsClassC != that1.SupportsClassC {
+	if this.Rx1Delay != that1.Rx1Delay {
+		return false
+	}
+	if this.Rx1DataRateOffset != that1.Rx1DataRateOffset {
+		return false
+	}
+	if this.Rx2DataRateIndex != that1.Rx2DataRateIndex {
+		return false
+	}
+	if this.Rx2Frequency != that1.Rx2Frequency {
+		return false
+	}
+	if this.MaxDutyCycle != that1.MaxDutyCycle {
+		return false
+	}
+	if this.RejoinTimePeriodicity != that1.RejoinTimePeriodicity {
+		return false
+	}
+	if this.RejoinCountPeriodicity != that1.RejoinCountPeriodicity {
+		return false
+	}
+	if this.PingSlotFrequency != that1.PingSlotFrequency {
+		return false
+	}
+	if this.PingSlotDataRateIndex != that1.PingSlotDataRateIndex {
+		return false
+	}
+	if this.BeaconFrequency != that1.BeaconFrequency {
+		return false
+	}
+	if len(this.Channels) != len(that1.Channels) {
+		return false
+	}
+	for i := range this.Channels {
+		if !this.Channels[i].Equal(that1.Channels[i]) {
+			return false
+		}
+	}
+	if !this.UplinkDwellTime.Equal(that1.UplinkDwellTime) {
+		return false
+	}
+	if !this.DownlinkDwellTime.Equal(that1.DownlinkDwellTime) {
+		return false
+	}
+	if !this.ADRAckLimitExponent.Equal(that1.ADRAckLimitExponent) {
+		return false
+	}
+	if !this.ADRAckDelayExponent.Equal(that1.ADRAckDelayExponent) {
+		return false
+	}
+	if !this.PingSlotDataRateIndexValue.Equal(that1.PingSlotDataRateIndexValue) {
+		return false
+	}
+	return true
+}
+func (this *MACParameters_Channel) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MACParameters_Channel)
+	if !ok {
+		that2, ok := that.(MACParameters_Channel)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.UplinkFrequency != that1.UplinkFrequency {
+		return false
+	}
+	if this.DownlinkFrequency != that1.DownlinkFrequency {
+		return false
+	}
+	if this.MinDataRateIndex != that1.MinDataRateIndex {
+		return false
+	}
+	if this.MaxDataRateIndex != that1.MaxDataRateIndex {
+		return false
+	}
+	if this.EnableUplink != that1.EnableUplink {
+		return false
+	}
+	return true
+}
+func (this *EndDeviceBrand) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*EndDeviceBrand)
+	if !ok {
+		that2, ok := that.(EndDeviceBrand)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ID != that1.ID {
+		return false
+	}
+	if this.Name != that1.Name {
+		return false
+	}
+	if this.URL != that1.URL {
+		return false
+	}
+	if len(this.Logos) != len(that1.Logos) {
+		return false
+	}
+	for i := range this.Logos {
+		if this.Logos[i] != that1.Logos[i] {
+			return false
+		}
+	}
+	return true
+}
+func (this *EndDeviceModel) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*EndDeviceModel)
+	if !ok {
+		that2, ok := that.(EndDeviceModel)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.BrandID != that1.BrandID {
+		return false
+	}
+	if this.ID != that1.ID {
+		return false
+	}
+	if this.Name != that1.Name {
+		return false
+	}
+	return true
+}
+func (this *EndDeviceVersionIdentifiers) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*EndDeviceVersionIdentifiers)
+	if !ok {
+		that2, ok := that.(EndDeviceVersionIdentifiers)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.BrandID != that1.BrandID {
+		return false
+	}
+	if this.ModelID != that1.ModelID {
+		return false
+	}
+	if this.HardwareVersion != that1.HardwareVersion {
+		return false
+	}
+	if this.FirmwareVersion != that1.FirmwareVersion {
+		return false
+	}
+	return true
+}
+func (this *EndDeviceVersion) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*EndDeviceVersion)
+	if !ok {
+		that2, ok := that.(EndDeviceVersion)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.EndDeviceVersionIdentifiers.Equal(&that1.EndDeviceVersionIdentifiers) {
+		return false
+	}
+	if this.LoRaWANVersion != that1.LoRaWANVersion {
+		return false
+	}
+	if this.LoRaWANPHYVersion != that1.LoRaWANPHYVersion {
+		return false
+	}
+	if this.FrequencyPlanID != that1.FrequencyPlanID {
+		return false
+	}
+	if len(this.Photos) != len(that1.Photos) {
+		return false
+	}
+	for i := range this.Photos {
+		if this.Photos[i] != that1.Photos[i] {
+			return false
+		}
+	}
+	if this.SupportsClassB != that1.SupportsClassB {
+		return false
+	}
+	if this.SupportsClassC != that1.SupportsClassC {
 		return false
 	}
 	if !this.DefaultMACSettings.Equal(that1.DefaultMACSettings) {
@@ -3268,6 +4176,14 @@ func (this *EndDeviceVersion) Equal(that interface{}) bool {
 	if !this.DefaultFormatters.Equal(&that1.DefaultFormatters) {
 		return false
 	}
+	if len(this.ProfileIDs) != len(that1.ProfileIDs) {
+		return false
+	}
+	for i := range this.ProfileIDs {
+		if this.ProfileIDs[i] != that1.ProfileIDs[i] {
+			return false
+		}
+	}
 	return true
 }
 func (this *MACSettings) Equal(that interface{}) bool {
@@ -3493,6 +4409,12 @@ func (this *MACState) Equal(that interface{}) bool {
 	} else if !this.LastNetworkInitiatedDownlinkAt.Equal(*that1.LastNetworkInitiatedDownlinkAt) {
 		return false
 	}
+	if !this.QueuedForceRejoinReq.Equal(that1.QueuedForceRejoinReq) {
+		return false
+	}
+	if this.LastRJCount0 != that1.LastRJCount0 {
+		return false
+	}
 	return true
 }
 func (this *MACState_JoinAccept) Equal(that interface{}) bool {
@@ -3778,6 +4700,9 @@ func (this *EndDevice) Equal(that interface{}) bool {
 	if this.SkipPayloadCrypto != that1.SkipPayloadCrypto {
 		return false
 	}
+	if !this.ProfileIDs.Equal(that1.ProfileIDs) {
+		return false
+	}
 	return true
 }
 func (this *EndDevices) Equal(that interface{}) bool {
@@ -3977,14 +4902,14 @@ func (this *SetEndDeviceRequest) Equal(that interface{}) bool {
 	}
 	return true
 }
-func (this *EndDeviceTemplate) Equal(that interface{}) bool {
+func (this *BatchCreateEndDevicesRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*EndDeviceTemplate)
+	that1, ok := that.(*BatchCreateEndDevicesRequest)
 	if !ok {
-		that2, ok := that.(EndDeviceTemplate)
+		that2, ok := that.(BatchCreateEndDevicesRequest)
 		if ok {
 			that1 = &that2
 		} else {
@@ -3996,25 +4921,27 @@ func (this *EndDeviceTemplate) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.EndDevice.Equal(&that1.EndDevice) {
+	if !this.ApplicationIdentifiers.Equal(&that1.ApplicationIdentifiers) {
 		return false
 	}
-	if !this.FieldMask.Equal(&that1.FieldMask) {
+	if len(this.Requests) != len(that1.Requests) {
 		return false
 	}
-	if this.MappingKey != that1.MappingKey {
-		return false
+	for i := range this.Requests {
+		if !this.Requests[i].Equal(that1.Requests[i]) {
+			return false
+		}
 	}
 	return true
 }
-func (this *EndDeviceTemplateFormat) Equal(that interface{}) bool {
+func (this *BatchGetEndDevicesRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*EndDeviceTemplateFormat)
+	that1, ok := that.(*BatchGetEndDevicesRequest)
 	if !ok {
-		that2, ok := that.(EndDeviceTemplateFormat)
+		that2, ok := that.(BatchGetEndDevicesRequest)
 		if ok {
 			that1 = &that2
 		} else {
@@ -4026,30 +4953,27 @@ func (this *EndDeviceTemplateFormat) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.Name != that1.Name {
-		return false
-	}
-	if this.Description != that1.Description {
+	if !this.ApplicationIdentifiers.Equal(&that1.ApplicationIdentifiers) {
 		return false
 	}
-	if len(this.FileExtensions) != len(that1.FileExtensions) {
+	if len(this.Requests) != len(that1.Requests) {
 		return false
 	}
-	for i := range this.FileExtensions {
-		if this.FileExtensions[i] != that1.FileExtensions[i] {
+	for i := range this.Requests {
+		if !this.Requests[i].Equal(that1.Requests[i]) {
 			return false
 		}
 	}
 	return true
 }
-func (this *EndDeviceTemplateFormats) Equal(that interface{}) bool {
+func (this *BatchUpdateEndDevicesRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*EndDeviceTemplateFormats)
+	that1, ok := that.(*BatchUpdateEndDevicesRequest)
 	if !ok {
-		that2, ok := that.(EndDeviceTemplateFormats)
+		that2, ok := that.(BatchUpdateEndDevicesRequest)
 		if ok {
 			that1 = &that2
 		} else {
@@ -4061,24 +4985,27 @@ func (this *EndDeviceTemplateFormats) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if len(this.Formats) != len(that1.Formats) {
+	if !this.ApplicationIdentifiers.Equal(&that1.ApplicationIdentifiers) {
 		return false
 	}
-	for i := range this.Formats {
-		if !this.Formats[i].Equal(that1.Formats[i]) {
+	if len(this.Requests) != len(that1.Requests) {
+		return false
+	}
+	for i := range this.Requests {
+		if !this.Requests[i].Equal(that1.Requests[i]) {
 			return false
 		}
 	}
 	return true
 }
-func (this *ConvertEndDeviceTemplateRequest) Equal(that interface{}) bool {
+func (this *BatchSetEndDevicesRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*ConvertEndDeviceTemplateRequest)
+	that1, ok := that.(*BatchSetEndDevicesRequest)
 	if !ok {
-		that2, ok := that.(ConvertEndDeviceTemplateRequest)
+		that2, ok := that.(BatchSetEndDevicesRequest)
 		if ok {
 			that1 = &that2
 		} else {
@@ -4090,432 +5017,456 @@ func (this *ConvertEndDeviceTemplateRequest) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.FormatID != that1.FormatID {
+	if !this.ApplicationIdentifiers.Equal(&that1.ApplicationIdentifiers) {
 		return false
 	}
-	if !bytes.Equal(this.Data, that1.Data) {
+	if len(this.Requests) != len(that1.Requests) {
 		return false
 	}
+	for i := range this.Requests {
+		if !this.Requests[i].Equal(that1.Requests[i]) {
+			return false
+		}
+	}
 	return true
 }
-func (m *Session) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *BatchDeleteEndDevicesRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
-
-func (m *Session) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
 
-func (m *Session) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartedAt):])
-	if err1 != nil {
-		return 0, err1
-	}
-	i -= n1
-	i = encodeVarintEndDevice(dAtA, i, uint64(n1))
-	i--
-	dAtA[i] = 0x42
-	if m.LastConfFCntDown != 0 {
-		i = encodeVarintEndDevice(dAtA, i, uint64(m.LastConfFCntDown))
-		i--
-		dAtA[i] = 0x38
-	}
-	if m.LastAFCntDown != 0 {
-		i = encodeVarintEndDevice(dAtA, i, uint64(m.LastAFCntDown))
-		i--
-		dAtA[i] = 0x30
+	that1, ok := that.(*BatchDeleteEndDevicesRequest)
+	if !ok {
+		that2, ok := that.(BatchDeleteEndDevicesRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if m.LastNFCntDown != 0 {
-		i = encodeVarintEndDevice(dAtA, i, uint64(m.LastNFCntDown))
-		i--
-		dAtA[i] = 0x28
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.LastFCntUp != 0 {
-		i = encodeVarintEndDevice(dAtA, i, uint64(m.LastFCntUp))
-		i--
-		dAtA[i] = 0x20
+	if !this.ApplicationIdentifiers.Equal(&that1.ApplicationIdentifiers) {
+		return false
 	}
-	{
-		size, err := m.SessionKeys.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintEndDevice(dAtA, i, uint64(size))
+	if len(this.EndDeviceIDs) != len(that1.EndDeviceIDs) {
+		return false
 	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size := m.DevAddr.Size()
-		i -= size
-		if _, err := m.DevAddr.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	for i := range this.EndDeviceIDs {
+		if !this.EndDeviceIDs[i].Equal(that1.EndDeviceIDs[i]) {
+			return false
 		}
-		i = encodeVarintEndDevice(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x12
-	return len(dAtA) - i, nil
+	return true
 }
-
-func (m *MACParameters) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *BatchEndDevicesResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MACParameters) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
 
-func (m *MACParameters) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.PingSlotDataRateIndexValue != nil {
-		{
-			size, err := m.PingSlotDataRateIndexValue.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEndDevice(dAtA, i, uint64(size))
+	that1, ok := that.(*BatchEndDevicesResponse)
+	if !ok {
+		that2, ok := that.(BatchEndDevicesResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xc2
 	}
-	if m.ADRAckDelayExponent != nil {
-		{
-			size, err := m.ADRAckDelayExponent.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEndDevice(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xba
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.ADRAckLimitExponent != nil {
-		{
-			size, err := m.ADRAckLimitExponent.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEndDevice(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xb2
+	if len(this.Results) != len(that1.Results) {
+		return false
 	}
-	if m.DownlinkDwellTime != nil {
-		{
-			size, err := m.DownlinkDwellTime.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEndDevice(dAtA, i, uint64(size))
+	for i := range this.Results {
+		if !this.Results[i].Equal(&that1.Results[i]) {
+			return false
 		}
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xaa
 	}
-	if m.UplinkDwellTime != nil {
-		{
-			size, err := m.UplinkDwellTime.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEndDevice(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xa2
+	return true
+}
+func (this *BatchEndDevicesResponse_Result) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	if len(m.Channels) > 0 {
-		for iNdEx := len(m.Channels) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Channels[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintEndDevice(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1
-			i--
-			dAtA[i] = 0x9a
+
+	that1, ok := that.(*BatchEndDevicesResponse_Result)
+	if !ok {
+		that2, ok := that.(BatchEndDevicesResponse_Result)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	if m.BeaconFrequency != 0 {
-		i = encodeVarintEndDevice(dAtA, i, m.BeaconFrequency)
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x90
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.PingSlotDataRateIndex != 0 {
-		i = encodeVarintEndDevice(dAtA, i, uint64(m.PingSlotDataRateIndex))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x88
+	if !this.IDs.Equal(&that1.IDs) {
+		return false
 	}
-	if m.PingSlotFrequency != 0 {
-		i = encodeVarintEndDevice(dAtA, i, m.PingSlotFrequency)
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x80
+	if !this.EndDevice.Equal(that1.EndDevice) {
+		return false
 	}
-	if m.RejoinCountPeriodicity != 0 {
-		i = encodeVarintEndDevice(dAtA, i, uint64(m.RejoinCountPeriodicity))
-		i--
-		dAtA[i] = 0x78
+	if !this.Error.Equal(that1.Error) {
+		return false
 	}
-	if m.RejoinTimePeriodicity != 0 {
-		i = encodeVarintEndDevice(dAtA, i, uint64(m.RejoinTimePeriodicity))
-		i--
-		dAtA[i] = 0x70
+	return true
+}
+func (this *EndDeviceProfileIdentifiers) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	if m.MaxDutyCycle != 0 {
-		i = encodeVarintEndDevice(dAtA, i, uint64(m.MaxDutyCycle))
-		i--
-		dAtA[i] = 0x68
+
+	that1, ok := that.(*EndDeviceProfileIdentifiers)
+	if !ok {
+		that2, ok := that.(EndDeviceProfileIdentifiers)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if m.Rx2Frequency != 0 {
-		i = encodeVarintEndDevice(dAtA, i, m.Rx2Frequency)
-		i--
-		dAtA[i] = 0x60
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.Rx2DataRateIndex != 0 {
-		i = encodeVarintEndDevice(dAtA, i, uint64(m.Rx2DataRateIndex))
-		i--
-		dAtA[i] = 0x58
+	if !this.ApplicationIDs.Equal(that1.ApplicationIDs) {
+		return false
 	}
-	if m.Rx1DataRateOffset != 0 {
-		i = encodeVarintEndDevice(dAtA, i, uint64(m.Rx1DataRateOffset))
-		i--
-		dAtA[i] = 0x50
+	if this.ProfileID != that1.ProfileID {
+		return false
 	}
-	if m.Rx1Delay != 0 {
-		i = encodeVarintEndDevice(dAtA, i, uint64(m.Rx1Delay))
-		i--
-		dAtA[i] = 0x48
+	return true
+}
+func (this *EndDeviceProfile) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	if m.ADRAckDelay != 0 {
-		i = encodeVarintEndDevice(dAtA, i, uint64(m.ADRAckDelay))
-		i--
-		dAtA[i] = 0x40
+
+	that1, ok := that.(*EndDeviceProfile)
+	if !ok {
+		that2, ok := that.(EndDeviceProfile)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if m.ADRAckLimit != 0 {
-		i = encodeVarintEndDevice(dAtA, i, uint64(m.ADRAckLimit))
-		i--
-		dAtA[i] = 0x38
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.ADRNbTrans != 0 {
-		i = encodeVarintEndDevice(dAtA, i, uint64(m.ADRNbTrans))
-		i--
-		dAtA[i] = 0x30
+	if !this.EndDeviceProfileIdentifiers.Equal(&that1.EndDeviceProfileIdentifiers) {
+		return false
 	}
-	if m.ADRTxPowerIndex != 0 {
-		i = encodeVarintEndDevice(dAtA, i, uint64(m.ADRTxPowerIndex))
-		i--
-		dAtA[i] = 0x28
+	if !this.CreatedAt.Equal(that1.CreatedAt) {
+		return false
 	}
-	if m.ADRDataRateIndex != 0 {
-		i = encodeVarintEndDevice(dAtA, i, uint64(m.ADRDataRateIndex))
-		i--
-		dAtA[i] = 0x20
+	if !this.UpdatedAt.Equal(that1.UpdatedAt) {
+		return false
 	}
-	if m.MaxEIRP != 0 {
-		i -= 4
-		encoding_binary.LittleEndian.PutUint32(dAtA[i:], math.Float32bits(float32(m.MaxEIRP)))
-		i--
-		dAtA[i] = 0xd
+	if this.Version != that1.Version {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *MACParameters_Channel) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.Name != that1.Name {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MACParameters_Channel) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if this.Description != that1.Description {
+		return false
+	}
+	if !this.MACSettings.Equal(that1.MACSettings) {
+		return false
+	}
+	if this.LoRaWANVersion != that1.LoRaWANVersion {
+		return false
+	}
+	if this.LoRaWANPHYVersion != that1.LoRaWANPHYVersion {
+		return false
+	}
+	if this.FrequencyPlanID != that1.FrequencyPlanID {
+		return false
+	}
+	return true
 }
+func (this *EndDeviceProfiles) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *MACParameters_Channel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.EnableUplink {
-		i--
-		if m.EnableUplink {
-			dAtA[i] = 1
+	that1, ok := that.(*EndDeviceProfiles)
+	if !ok {
+		that2, ok := that.(EndDeviceProfiles)
+		if ok {
+			that1 = &that2
 		} else {
-			dAtA[i] = 0
+			return false
 		}
-		i--
-		dAtA[i] = 0x28
-	}
-	if m.MaxDataRateIndex != 0 {
-		i = encodeVarintEndDevice(dAtA, i, uint64(m.MaxDataRateIndex))
-		i--
-		dAtA[i] = 0x20
 	}
-	if m.MinDataRateIndex != 0 {
-		i = encodeVarintEndDevice(dAtA, i, uint64(m.MinDataRateIndex))
-		i--
-		dAtA[i] = 0x18
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.DownlinkFrequency != 0 {
-		i = encodeVarintEndDevice(dAtA, i, m.DownlinkFrequency)
-		i--
-		dAtA[i] = 0x10
+	if len(this.Profiles) != len(that1.Profiles) {
+		return false
 	}
-	if m.UplinkFrequency != 0 {
-		i = encodeVarintEndDevice(dAtA, i, m.UplinkFrequency)
-		i--
-		dAtA[i] = 0x8
+	for i := range this.Profiles {
+		if !this.Profiles[i].Equal(that1.Profiles[i]) {
+			return false
+		}
 	}
-	return len(dAtA) - i, nil
+	return true
 }
-
-func (m *EndDeviceBrand) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *GetEndDeviceProfileRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
 
-func (m *EndDeviceBrand) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	that1, ok := that.(*GetEndDeviceProfileRequest)
+	if !ok {
+		that2, ok := that.(GetEndDeviceProfileRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.EndDeviceProfileIdentifiers.Equal(&that1.EndDeviceProfileIdentifiers) {
+		return false
+	}
+	if !this.FieldMask.Equal(&that1.FieldMask) {
+		return false
+	}
+	return true
 }
+func (this *ListEndDeviceProfilesRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *EndDeviceBrand) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Logos) > 0 {
-		for iNdEx := len(m.Logos) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Logos[iNdEx])
-			copy(dAtA[i:], m.Logos[iNdEx])
-			i = encodeVarintEndDevice(dAtA, i, uint64(len(m.Logos[iNdEx])))
-			i--
-			dAtA[i] = 0x22
+	that1, ok := that.(*ListEndDeviceProfilesRequest)
+	if !ok {
+		that2, ok := that.(ListEndDeviceProfilesRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	if len(m.URL) > 0 {
-		i -= len(m.URL)
-		copy(dAtA[i:], m.URL)
-		i = encodeVarintEndDevice(dAtA, i, uint64(len(m.URL)))
-		i--
-		dAtA[i] = 0x1a
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintEndDevice(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0x12
+	if !this.ApplicationIDs.Equal(that1.ApplicationIDs) {
+		return false
 	}
-	if len(m.ID) > 0 {
-		i -= len(m.ID)
-		copy(dAtA[i:], m.ID)
-		i = encodeVarintEndDevice(dAtA, i, uint64(len(m.ID)))
-		i--
-		dAtA[i] = 0xa
+	if !this.FieldMask.Equal(&that1.FieldMask) {
+		return false
 	}
-	return len(dAtA) - i, nil
+	if this.Limit != that1.Limit {
+		return false
+	}
+	if this.Page != that1.Page {
+		return false
+	}
+	return true
 }
+func (this *SetEndDeviceProfileRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *EndDeviceModel) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	that1, ok := that.(*SetEndDeviceProfileRequest)
+	if !ok {
+		that2, ok := that.(SetEndDeviceProfileRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return dAtA[:n], nil
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Profile.Equal(&that1.Profile) {
+		return false
+	}
+	if !this.FieldMask.Equal(&that1.FieldMask) {
+		return false
+	}
+	return true
 }
+func (this *EndDeviceTemplate) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *EndDeviceModel) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	that1, ok := that.(*EndDeviceTemplate)
+	if !ok {
+		that2, ok := that.(EndDeviceTemplate)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.EndDevice.Equal(&that1.EndDevice) {
+		return false
+	}
+	if !this.FieldMask.Equal(&that1.FieldMask) {
+		return false
+	}
+	if this.MappingKey != that1.MappingKey {
+		return false
+	}
+	return true
 }
+func (this *EndDeviceTemplateFormat) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *EndDeviceModel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintEndDevice(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0x1a
+	that1, ok := that.(*EndDeviceTemplateFormat)
+	if !ok {
+		that2, ok := that.(EndDeviceTemplateFormat)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if len(m.ID) > 0 {
-		i -= len(m.ID)
-		copy(dAtA[i:], m.ID)
-		i = encodeVarintEndDevice(dAtA, i, uint64(len(m.ID)))
-		i--
-		dAtA[i] = 0x12
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if len(m.BrandID) > 0 {
-		i -= len(m.BrandID)
-		copy(dAtA[i:], m.BrandID)
-		i = encodeVarintEndDevice(dAtA, i, uint64(len(m.BrandID)))
-		i--
-		dAtA[i] = 0xa
+	if this.Name != that1.Name {
+		return false
 	}
-	return len(dAtA) - i, nil
+	if this.Description != that1.Description {
+		return false
+	}
+	if len(this.FileExtensions) != len(that1.FileExtensions) {
+		return false
+	}
+	for i := range this.FileExtensions {
+		if this.FileExtensions[i] != that1.FileExtensions[i] {
+			return false
+		}
+	}
+	return true
 }
+func (this *EndDeviceTemplateFormats) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *EndDeviceVersionIdentifiers) Marshal() (dAtA []byte, err error) {
+	that1, ok := that.(*EndDeviceTemplateFormats)
+	if !ok {
+		that2, ok := that.(EndDeviceTemplateFormats)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Formats) != len(that1.Formats) {
+		return false
+	}
+	for i := range this.Formats {
+		if !this.Formats[i].Equal(that1.Formats[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *ConvertEndDeviceTemplateRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ConvertEndDeviceTemplateRequest)
+	if !ok {
+		that2, ok := that.(ConvertEndDeviceTemplateRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.FormatID != that1.FormatID {
+		return false
+	}
+	if !bytes.Equal(this.Data, that1.Data) {
+		return false
+	}
+	if !this.CSVOptions.Equal(that1.CSVOptions) {
+		return false
+	}
+	return true
+}
+func (this *ConvertEndDeviceTemplateRequest_CSVOptions) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ConvertEndDeviceTemplateRequest_CSVOptions)
+	if !ok {
+		that2, ok := that.(ConvertEndDeviceTemplateRequest_CSVOptions)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Separator != that1.Separator {
+		return false
+	}
+	if this.Base64 != that1.Base64 {
+		return false
+	}
+	return true
+}
+func (m *Session) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4525,48 +5476,68 @@ func (m *EndDeviceVersionIdentifiers) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EndDeviceVersionIdentifiers) MarshalTo(dAtA []byte) (int, error) {
+func (m *Session) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EndDeviceVersionIdentifiers) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Session) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.FirmwareVersion) > 0 {
-		i -= len(m.FirmwareVersion)
-		copy(dAtA[i:], m.FirmwareVersion)
-		i = encodeVarintEndDevice(dAtA, i, uint64(len(m.FirmwareVersion)))
+	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartedAt):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintEndDevice(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x42
+	if m.LastConfFCntDown != 0 {
+		i = encodeVarintEndDevice(dAtA, i, uint64(m.LastConfFCntDown))
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0x38
 	}
-	if len(m.HardwareVersion) > 0 {
-		i -= len(m.HardwareVersion)
-		copy(dAtA[i:], m.HardwareVersion)
-		i = encodeVarintEndDevice(dAtA, i, uint64(len(m.HardwareVersion)))
+	if m.LastAFCntDown != 0 {
+		i = encodeVarintEndDevice(dAtA, i, uint64(m.LastAFCntDown))
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x30
 	}
-	if len(m.ModelID) > 0 {
-		i -= len(m.ModelID)
-		copy(dAtA[i:], m.ModelID)
-		i = encodeVarintEndDevice(dAtA, i, uint64(len(m.ModelID)))
+	if m.LastNFCntDown != 0 {
+		i = encodeVarintEndDevice(dAtA, i, uint64(m.LastNFCntDown))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x28
 	}
-	if len(m.BrandID) > 0 {
-		i -= len(m.BrandID)
-		copy(dAtA[i:], m.BrandID)
-		i = encodeVarintEndDevice(dAtA, i, uint64(len(m.BrandID)))
+	if m.LastFCntUp != 0 {
+		i = encodeVarintEndDevice(dAtA, i, uint64(m.LastFCntUp))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x20
+	}
+	{
+		size, err := m.SessionKeys.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEndDevice(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.DevAddr.Size()
+		i -= size
+		if _, err := m.DevAddr.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEndDevice(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0x12
 	return len(dAtA) - i, nil
 }
 
-func (m *EndDeviceVersion) Marshal() (dAtA []byte, err error) {
+func (m *MACParameters) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4576,59 +5547,19 @@ func (m *EndDeviceVersion) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EndDeviceVersion) MarshalTo(dAtA []byte) (int, error) {
+func (m *MACParameters) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EndDeviceVersion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MACParameters) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.DefaultFormatters.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintEndDevice(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x6a
-	if m.ResetsJoinNonces {
-		i--
-		if m.ResetsJoinNonces {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x60
-	}
-	if m.SupportsJoin {
-		i--
-		if m.SupportsJoin {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x58
-	}
-	if m.MaxFrequency != 0 {
-		i = encodeVarintEndDevice(dAtA, i, m.MaxFrequency)
-		i--
-		dAtA[i] = 0x50
-	}
-	if m.MinFrequency != 0 {
-		i = encodeVarintEndDevice(dAtA, i, m.MinFrequency)
-		i--
-		dAtA[i] = 0x48
-	}
-	if m.DefaultMACSettings != nil {
+	if m.PingSlotDataRateIndexValue != nil {
 		{
-			size, err := m.DefaultMACSettings.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.PingSlotDataRateIndexValue.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -4636,90 +5567,13 @@ func (m *EndDeviceVersion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintEndDevice(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x42
-	}
-	if m.SupportsClassC {
-		i--
-		if m.SupportsClassC {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x38
-	}
-	if m.SupportsClassB {
-		i--
-		if m.SupportsClassB {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x30
-	}
-	if len(m.Photos) > 0 {
-		for iNdEx := len(m.Photos) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Photos[iNdEx])
-			copy(dAtA[i:], m.Photos[iNdEx])
-			i = encodeVarintEndDevice(dAtA, i, uint64(len(m.Photos[iNdEx])))
-			i--
-			dAtA[i] = 0x2a
-		}
-	}
-	if len(m.FrequencyPlanID) > 0 {
-		i -= len(m.FrequencyPlanID)
-		copy(dAtA[i:], m.FrequencyPlanID)
-		i = encodeVarintEndDevice(dAtA, i, uint64(len(m.FrequencyPlanID)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.LoRaWANPHYVersion != 0 {
-		i = encodeVarintEndDevice(dAtA, i, uint64(m.LoRaWANPHYVersion))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.LoRaWANVersion != 0 {
-		i = encodeVarintEndDevice(dAtA, i, uint64(m.LoRaWANVersion))
+		dAtA[i] = 0x1
 		i--
-		dAtA[i] = 0x10
-	}
-	{
-		size, err := m.EndDeviceVersionIdentifiers.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintEndDevice(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func (m *MACSettings) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+		dAtA[i] = 0xc2
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MACSettings) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MACSettings) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.DesiredBeaconFrequency != nil {
+	if m.ADRAckDelayExponent != nil {
 		{
-			size, err := m.DesiredBeaconFrequency.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.ADRAckDelayExponent.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -4729,11 +5583,11 @@ func (m *MACSettings) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x1
 		i--
-		dAtA[i] = 0xea
+		dAtA[i] = 0xba
 	}
-	if m.DesiredPingSlotFrequency != nil {
+	if m.ADRAckLimitExponent != nil {
 		{
-			size, err := m.DesiredPingSlotFrequency.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.ADRAckLimitExponent.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -4743,11 +5597,11 @@ func (m *MACSettings) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x1
 		i--
-		dAtA[i] = 0xe2
+		dAtA[i] = 0xb2
 	}
-	if m.DesiredPingSlotDataRateIndex != nil {
+	if m.DownlinkDwellTime != nil {
 		{
-			size, err := m.DesiredPingSlotDataRateIndex.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.DownlinkDwellTime.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -4757,11 +5611,11 @@ func (m *MACSettings) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x1
 		i--
-		dAtA[i] = 0xda
+		dAtA[i] = 0xaa
 	}
-	if m.BeaconFrequency != nil {
+	if m.UplinkDwellTime != nil {
 		{
-			size, err := m.BeaconFrequency.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.UplinkDwellTime.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -4771,318 +5625,115 @@ func (m *MACSettings) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x1
 		i--
-		dAtA[i] = 0xca
+		dAtA[i] = 0xa2
 	}
-	if m.DesiredADRAckDelayExponent != nil {
-		{
-			size, err := m.DesiredADRAckDelayExponent.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+	if len(m.Channels) > 0 {
+		for iNdEx := len(m.Channels) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Channels[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEndDevice(dAtA, i, uint64(size))
 			}
-			i -= size
-			i = encodeVarintEndDevice(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
 		}
+	}
+	if m.BeaconFrequency != 0 {
+		i = encodeVarintEndDevice(dAtA, i, m.BeaconFrequency)
 		i--
 		dAtA[i] = 0x1
 		i--
-		dAtA[i] = 0xc2
+		dAtA[i] = 0x90
 	}
-	if m.DesiredADRAckLimitExponent != nil {
-		{
-			size, err := m.DesiredADRAckLimitExponent.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEndDevice(dAtA, i, uint64(size))
-		}
+	if m.PingSlotDataRateIndex != 0 {
+		i = encodeVarintEndDevice(dAtA, i, uint64(m.PingSlotDataRateIndex))
 		i--
 		dAtA[i] = 0x1
 		i--
-		dAtA[i] = 0xba
+		dAtA[i] = 0x88
 	}
-	if m.DesiredMaxDutyCycle != nil {
-		{
-			size, err := m.DesiredMaxDutyCycle.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEndDevice(dAtA, i, uint64(size))
-		}
+	if m.PingSlotFrequency != 0 {
+		i = encodeVarintEndDevice(dAtA, i, m.PingSlotFrequency)
 		i--
 		dAtA[i] = 0x1
 		i--
-		dAtA[i] = 0xb2
+		dAtA[i] = 0x80
 	}
-	if m.DesiredRx2Frequency != nil {
-		{
-			size, err := m.DesiredRx2Frequency.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEndDevice(dAtA, i, uint64(size))
-		}
+	if m.RejoinCountPeriodicity != 0 {
+		i = encodeVarintEndDevice(dAtA, i, uint64(m.RejoinCountPeriodicity))
 		i--
-		dAtA[i] = 0x1
+		dAtA[i] = 0x78
+	}
+	if m.RejoinTimePeriodicity != 0 {
+		i = encodeVarintEndDevice(dAtA, i, uint64(m.RejoinTimePeriodicity))
 		i--
-		dAtA[i] = 0xaa
+		dAtA[i] = 0x70
 	}
-	if m.DesiredRx2DataRateIndex != nil {
-		{
-			size, err := m.DesiredRx2DataRateIndex.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEndDevice(dAtA, i, uint64(size))
-		}
+	if m.MaxDutyCycle != 0 {
+		i = encodeVarintEndDevice(dAtA, i, uint64(m.MaxDutyCycle))
 		i--
-		dAtA[i] = 0x1
+		dAtA[i] = 0x68
+	}
+	if m.Rx2Frequency != 0 {
+		i = encodeVarintEndDevice(dAtA, i, m.Rx2Frequency)
 		i--
-		dAtA[i] = 0xa2
+		dAtA[i] = 0x60
 	}
-	if m.DesiredRx1DataRateOffset != nil {
-		{
-			size, err := m.DesiredRx1DataRateOffset.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEndDevice(dAtA, i, uint64(size))
-		}
+	if m.Rx2DataRateIndex != 0 {
+		i = encodeVarintEndDevice(dAtA, i, uint64(m.Rx2DataRateIndex))
 		i--
-		dAtA[i] = 0x1
+		dAtA[i] = 0x58
+	}
+	if m.Rx1DataRateOffset != 0 {
+		i = encodeVarintEndDevice(dAtA, i, uint64(m.Rx1DataRateOffset))
 		i--
-		dAtA[i] = 0x9a
+		dAtA[i] = 0x50
 	}
-	if m.DesiredRx1Delay != nil {
-		{
-			size, err := m.DesiredRx1Delay.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEndDevice(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x92
-	}
-	if m.StatusCountPeriodicity != nil {
-		{
-			size, err := m.StatusCountPeriodicity.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEndDevice(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x8a
-	}
-	if m.StatusTimePeriodicity != nil {
-		n23, err23 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.StatusTimePeriodicity, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.StatusTimePeriodicity):])
-		if err23 != nil {
-			return 0, err23
-		}
-		i -= n23
-		i = encodeVarintEndDevice(dAtA, i, uint64(n23))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x82
-	}
-	if m.ResetsFCnt != nil {
-		{
-			size, err := m.ResetsFCnt.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEndDevice(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x7a
-	}
-	if m.ADRMargin != nil {
-		{
-			size, err := m.ADRMargin.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEndDevice(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x72
-	}
-	if m.UseADR != nil {
-		{
-			size, err := m.UseADR.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEndDevice(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x6a
-	}
-	if m.Supports32BitFCnt != nil {
-		{
-			size, err := m.Supports32BitFCnt.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEndDevice(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x62
-	}
-	if m.MaxDutyCycle != nil {
-		{
-			size, err := m.MaxDutyCycle.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEndDevice(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x5a
-	}
-	if len(m.FactoryPresetFrequencies) > 0 {
-		dAtA30 := make([]byte, len(m.FactoryPresetFrequencies)*10)
-		var j29 int
-		for _, num := range m.FactoryPresetFrequencies {
-			for num >= 1<<7 {
-				dAtA30[j29] = uint8(num&0x7f | 0x80)
-				num >>= 7
-				j29++
-			}
-			dAtA30[j29] = uint8(num)
-			j29++
-		}
-		i -= j29
-		copy(dAtA[i:], dAtA30[:j29])
-		i = encodeVarintEndDevice(dAtA, i, uint64(j29))
-		i--
-		dAtA[i] = 0x52
-	}
-	if m.Rx2Frequency != nil {
-		{
-			size, err := m.Rx2Frequency.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEndDevice(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x4a
-	}
-	if m.Rx2DataRateIndex != nil {
-		{
-			size, err := m.Rx2DataRateIndex.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEndDevice(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x42
-	}
-	if m.Rx1DataRateOffset != nil {
-		{
-			size, err := m.Rx1DataRateOffset.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEndDevice(dAtA, i, uint64(size))
-		}
+	if m.Rx1Delay != 0 {
+		i = encodeVarintEndDevice(dAtA, i, uint64(m.Rx1Delay))
 		i--
-		dAtA[i] = 0x3a
+		dAtA[i] = 0x48
 	}
-	if m.Rx1Delay != nil {
-		{
-			size, err := m.Rx1Delay.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEndDevice(dAtA, i, uint64(size))
-		}
+	if m.ADRAckDelay != 0 {
+		i = encodeVarintEndDevice(dAtA, i, uint64(m.ADRAckDelay))
 		i--
-		dAtA[i] = 0x32
+		dAtA[i] = 0x40
 	}
-	if m.ClassCTimeout != nil {
-		n35, err35 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.ClassCTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.ClassCTimeout):])
-		if err35 != nil {
-			return 0, err35
-		}
-		i -= n35
-		i = encodeVarintEndDevice(dAtA, i, uint64(n35))
+	if m.ADRAckLimit != 0 {
+		i = encodeVarintEndDevice(dAtA, i, uint64(m.ADRAckLimit))
 		i--
-		dAtA[i] = 0x2a
+		dAtA[i] = 0x38
 	}
-	if m.PingSlotFrequency != nil {
-		{
-			size, err := m.PingSlotFrequency.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEndDevice(dAtA, i, uint64(size))
-		}
+	if m.ADRNbTrans != 0 {
+		i = encodeVarintEndDevice(dAtA, i, uint64(m.ADRNbTrans))
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0x30
 	}
-	if m.PingSlotDataRateIndex != nil {
-		{
-			size, err := m.PingSlotDataRateIndex.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEndDevice(dAtA, i, uint64(size))
-		}
+	if m.ADRTxPowerIndex != 0 {
+		i = encodeVarintEndDevice(dAtA, i, uint64(m.ADRTxPowerIndex))
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x28
 	}
-	if m.PingSlotPeriodicity != nil {
-		{
-			size, err := m.PingSlotPeriodicity.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEndDevice(dAtA, i, uint64(size))
-		}
+	if m.ADRDataRateIndex != 0 {
+		i = encodeVarintEndDevice(dAtA, i, uint64(m.ADRDataRateIndex))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x20
 	}
-	if m.ClassBTimeout != nil {
-		n39, err39 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.ClassBTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.ClassBTimeout):])
-		if err39 != nil {
-			return 0, err39
-		}
-		i -= n39
-		i = encodeVarintEndDevice(dAtA, i, uint64(n39))
+	if m.MaxEIRP != 0 {
+		i -= 4
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], math.Float32bits(float32(m.MaxEIRP)))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0xd
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MACState) Marshal() (dAtA []byte, err error) {
+func (m *MACParameters_Channel) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5092,191 +5743,50 @@ func (m *MACState) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MACState) MarshalTo(dAtA []byte) (int, error) {
+func (m *MACParameters_Channel) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MACState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MACParameters_Channel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.LastNetworkInitiatedDownlinkAt != nil {
-		n40, err40 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastNetworkInitiatedDownlinkAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastNetworkInitiatedDownlinkAt):])
-		if err40 != nil {
-			return 0, err40
+	if m.EnableUplink {
+		i--
+		if m.EnableUplink {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
-		i -= n40
-		i = encodeVarintEndDevice(dAtA, i, uint64(n40))
 		i--
-		dAtA[i] = 0x1
+		dAtA[i] = 0x28
+	}
+	if m.MaxDataRateIndex != 0 {
+		i = encodeVarintEndDevice(dAtA, i, uint64(m.MaxDataRateIndex))
 		i--
-		dAtA[i] = 0x82
+		dAtA[i] = 0x20
 	}
-	if len(m.RecentDownlinks) > 0 {
-		for iNdEx := len(m.RecentDownlinks) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.RecentDownlinks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintEndDevice(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x7a
-		}
-	}
-	if len(m.RecentUplinks) > 0 {
-		for iNdEx := len(m.RecentUplinks) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.RecentUplinks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintEndDevice(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x72
-		}
-	}
-	if m.RxWindowsAvailable {
-		i--
-		if m.RxWindowsAvailable {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x68
-	}
-	if m.PendingJoinRequest != nil {
-		{
-			size, err := m.PendingJoinRequest.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEndDevice(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x62
-	}
-	if m.QueuedJoinAccept != nil {
-		{
-			size, err := m.QueuedJoinAccept.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEndDevice(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x5a
-	}
-	if len(m.PendingRequests) > 0 {
-		for iNdEx := len(m.PendingRequests) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.PendingRequests[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintEndDevice(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x52
-		}
-	}
-	if len(m.QueuedResponses) > 0 {
-		for iNdEx := len(m.QueuedResponses) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.QueuedResponses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintEndDevice(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x4a
-		}
-	}
-	if m.PendingApplicationDownlink != nil {
-		{
-			size, err := m.PendingApplicationDownlink.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEndDevice(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x42
-	}
-	if m.PingSlotPeriodicity != nil {
-		{
-			size, err := m.PingSlotPeriodicity.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEndDevice(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x3a
-	}
-	if m.LastDevStatusFCntUp != 0 {
-		i = encodeVarintEndDevice(dAtA, i, uint64(m.LastDevStatusFCntUp))
-		i--
-		dAtA[i] = 0x30
-	}
-	if m.LastConfirmedDownlinkAt != nil {
-		n45, err45 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastConfirmedDownlinkAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastConfirmedDownlinkAt):])
-		if err45 != nil {
-			return 0, err45
-		}
-		i -= n45
-		i = encodeVarintEndDevice(dAtA, i, uint64(n45))
+	if m.MinDataRateIndex != 0 {
+		i = encodeVarintEndDevice(dAtA, i, uint64(m.MinDataRateIndex))
 		i--
-		dAtA[i] = 0x2a
+		dAtA[i] = 0x18
 	}
-	if m.LoRaWANVersion != 0 {
-		i = encodeVarintEndDevice(dAtA, i, uint64(m.LoRaWANVersion))
+	if m.DownlinkFrequency != 0 {
+		i = encodeVarintEndDevice(dAtA, i, m.DownlinkFrequency)
 		i--
-		dAtA[i] = 0x20
+		dAtA[i] = 0x10
 	}
-	if m.DeviceClass != 0 {
-		i = encodeVarintEndDevice(dAtA, i, uint64(m.DeviceClass))
+	if m.UplinkFrequency != 0 {
+		i = encodeVarintEndDevice(dAtA, i, m.UplinkFrequency)
 		i--
-		dAtA[i] = 0x18
-	}
-	{
-		size, err := m.DesiredParameters.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintEndDevice(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size, err := m.CurrentParameters.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintEndDevice(dAtA, i, uint64(size))
+		dAtA[i] = 0x8
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *MACState_JoinAccept) Marshal() (dAtA []byte, err error) {
+func (m *EndDeviceBrand) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5286,47 +5796,50 @@ func (m *MACState_JoinAccept) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MACState_JoinAccept) MarshalTo(dAtA []byte) (int, error) {
+func (m *EndDeviceBrand) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MACState_JoinAccept) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EndDeviceBrand) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Keys.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if len(m.Logos) > 0 {
+		for iNdEx := len(m.Logos) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Logos[iNdEx])
+			copy(dAtA[i:], m.Logos[iNdEx])
+			i = encodeVarintEndDevice(dAtA, i, uint64(len(m.Logos[iNdEx])))
+			i--
+			dAtA[i] = 0x22
 		}
-		i -= size
-		i = encodeVarintEndDevice(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintEndDevice(dAtA, i, uint64(size))
+	if len(m.URL) > 0 {
+		i -= len(m.URL)
+		copy(dAtA[i:], m.URL)
+		i = encodeVarintEndDevice(dAtA, i, uint64(len(m.URL)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Payload) > 0 {
-		i -= len(m.Payload)
-		copy(dAtA[i:], m.Payload)
-		i = encodeVarintEndDevice(dAtA, i, uint64(len(m.Payload)))
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintEndDevice(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ID) > 0 {
+		i -= len(m.ID)
+		copy(dAtA[i:], m.ID)
+		i = encodeVarintEndDevice(dAtA, i, uint64(len(m.ID)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *EndDeviceAuthenticationCode) Marshal() (dAtA []byte, err error) {
+func (m *EndDeviceModel) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5336,47 +5849,41 @@ func (m *EndDeviceAuthenticationCode) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EndDeviceAuthenticationCode) MarshalTo(dAtA []byte) (int, error) {
+func (m *EndDeviceModel) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EndDeviceAuthenticationCode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EndDeviceModel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.ValidTo != nil {
-		n50, err50 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ValidTo, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ValidTo):])
-		if err50 != nil {
-			return 0, err50
-		}
-		i -= n50
-		i = encodeVarintEndDevice(dAtA, i, uint64(n50))
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintEndDevice(dAtA, i, uint64(len(m.Name)))
 		i--
 		dAtA[i] = 0x1a
 	}
-	if m.ValidFrom != nil {
-		n51, err51 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ValidFrom, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ValidFrom):])
-		if err51 != nil {
-			return 0, err51
-		}
-		i -= n51
-		i = encodeVarintEndDevice(dAtA, i, uint64(n51))
+	if len(m.ID) > 0 {
+		i -= len(m.ID)
+		copy(dAtA[i:], m.ID)
+		i = encodeVarintEndDevice(dAtA, i, uint64(len(m.ID)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Value) > 0 {
-		i -= len(m.Value)
-		copy(dAtA[i:], m.Value)
-		i = encodeVarintEndDevice(dAtA, i, uint64(len(m.Value)))
+	if len(m.BrandID) > 0 {
+		i -= len(m.BrandID)
+		copy(dAtA[i:], m.BrandID)
+		i = encodeVarintEndDevice(dAtA, i, uint64(len(m.BrandID)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *EndDevice) Marshal() (dAtA []byte, err error) {
+func (m *EndDeviceVersionIdentifiers) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5386,72 +5893,129 @@ func (m *EndDevice) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EndDevice) MarshalTo(dAtA []byte) (int, error) {
+func (m *EndDeviceVersionIdentifiers) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EndDevice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EndDeviceVersionIdentifiers) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.SkipPayloadCrypto {
-		i--
-		if m.SkipPayloadCrypto {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
+	if len(m.FirmwareVersion) > 0 {
+		i -= len(m.FirmwareVersion)
+		copy(dAtA[i:], m.FirmwareVersion)
+		i = encodeVarintEndDevice(dAtA, i, uint64(len(m.FirmwareVersion)))
 		i--
-		dAtA[i] = 0x3
+		dAtA[i] = 0x22
+	}
+	if len(m.HardwareVersion) > 0 {
+		i -= len(m.HardwareVersion)
+		copy(dAtA[i:], m.HardwareVersion)
+		i = encodeVarintEndDevice(dAtA, i, uint64(len(m.HardwareVersion)))
 		i--
-		dAtA[i] = 0x98
+		dAtA[i] = 0x1a
 	}
-	if m.Picture != nil {
-		{
-			size, err := m.Picture.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEndDevice(dAtA, i, uint64(size))
-		}
+	if len(m.ModelID) > 0 {
+		i -= len(m.ModelID)
+		copy(dAtA[i:], m.ModelID)
+		i = encodeVarintEndDevice(dAtA, i, uint64(len(m.ModelID)))
 		i--
-		dAtA[i] = 0x3
+		dAtA[i] = 0x12
+	}
+	if len(m.BrandID) > 0 {
+		i -= len(m.BrandID)
+		copy(dAtA[i:], m.BrandID)
+		i = encodeVarintEndDevice(dAtA, i, uint64(len(m.BrandID)))
 		i--
-		dAtA[i] = 0x92
+		dAtA[i] = 0xa
 	}
-	if len(m.ApplicationServerID) > 0 {
-		i -= len(m.ApplicationServerID)
-		copy(dAtA[i:], m.ApplicationServerID)
-		i = encodeVarintEndDevice(dAtA, i, uint64(len(m.ApplicationServerID)))
+	return len(dAtA) - i, nil
+}
+
+func (m *EndDeviceVersion) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EndDeviceVersion) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EndDeviceVersion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ProfileIDs) > 0 {
+		for k := range m.ProfileIDs {
+			v := m.ProfileIDs[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintEndDevice(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintEndDevice(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintEndDevice(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	{
+		size, err := m.DefaultFormatters.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEndDevice(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x6a
+	if m.ResetsJoinNonces {
 		i--
-		dAtA[i] = 0x3
+		if m.ResetsJoinNonces {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
 		i--
-		dAtA[i] = 0x8a
+		dAtA[i] = 0x60
 	}
-	if len(m.ApplicationServerKEKLabel) > 0 {
-		i -= len(m.ApplicationServerKEKLabel)
-		copy(dAtA[i:], m.ApplicationServerKEKLabel)
-		i = encodeVarintEndDevice(dAtA, i, uint64(len(m.ApplicationServerKEKLabel)))
+	if m.SupportsJoin {
 		i--
-		dAtA[i] = 0x3
+		if m.SupportsJoin {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
 		i--
-		dAtA[i] = 0x82
+		dAtA[i] = 0x58
 	}
-	if len(m.NetworkServerKEKLabel) > 0 {
-		i -= len(m.NetworkServerKEKLabel)
-		copy(dAtA[i:], m.NetworkServerKEKLabel)
-		i = encodeVarintEndDevice(dAtA, i, uint64(len(m.NetworkServerKEKLabel)))
+	if m.MaxFrequency != 0 {
+		i = encodeVarintEndDevice(dAtA, i, m.MaxFrequency)
 		i--
-		dAtA[i] = 0x2
+		dAtA[i] = 0x50
+	}
+	if m.MinFrequency != 0 {
+		i = encodeVarintEndDevice(dAtA, i, m.MinFrequency)
 		i--
-		dAtA[i] = 0xfa
+		dAtA[i] = 0x48
 	}
-	if m.ClaimAuthenticationCode != nil {
+	if m.DefaultMACSettings != nil {
 		{
-			size, err := m.ClaimAuthenticationCode.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.DefaultMACSettings.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5459,25 +6023,90 @@ func (m *EndDevice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintEndDevice(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x2
+		dAtA[i] = 0x42
+	}
+	if m.SupportsClassC {
 		i--
-		dAtA[i] = 0xf2
+		if m.SupportsClassC {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
 	}
-	if m.Multicast {
+	if m.SupportsClassB {
 		i--
-		if m.Multicast {
+		if m.SupportsClassB {
 			dAtA[i] = 1
 		} else {
 			dAtA[i] = 0
 		}
 		i--
-		dAtA[i] = 0x2
+		dAtA[i] = 0x30
+	}
+	if len(m.Photos) > 0 {
+		for iNdEx := len(m.Photos) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Photos[iNdEx])
+			copy(dAtA[i:], m.Photos[iNdEx])
+			i = encodeVarintEndDevice(dAtA, i, uint64(len(m.Photos[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.FrequencyPlanID) > 0 {
+		i -= len(m.FrequencyPlanID)
+		copy(dAtA[i:], m.FrequencyPlanID)
+		i = encodeVarintEndDevice(dAtA, i, uint64(len(m.FrequencyPlanID)))
 		i--
-		dAtA[i] = 0xe8
+		dAtA[i] = 0x22
 	}
-	if m.PendingMACState != nil {
+	if m.LoRaWANPHYVersion != 0 {
+		i = encodeVarintEndDevice(dAtA, i, uint64(m.LoRaWANPHYVersion))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.LoRaWANVersion != 0 {
+		i = encodeVarintEndDevice(dAtA, i, uint64(m.LoRaWANVersion))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.EndDeviceVersionIdentifiers.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEndDevice(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MACSettings) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MACSettings) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MACSettings) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DesiredBeaconFrequency != nil {
 		{
-			size, err := m.PendingMACState.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.DesiredBeaconFrequency.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5485,13 +6114,13 @@ func (m *EndDevice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintEndDevice(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x2
+		dAtA[i] = 0x1
 		i--
-		dAtA[i] = 0xe2
+		dAtA[i] = 0xea
 	}
-	if m.ProvisioningData != nil {
+	if m.DesiredPingSlotFrequency != nil {
 		{
-			size, err := m.ProvisioningData.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.DesiredPingSlotFrequency.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5499,22 +6128,27 @@ func (m *EndDevice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintEndDevice(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x2
+		dAtA[i] = 0x1
 		i--
-		dAtA[i] = 0xda
+		dAtA[i] = 0xe2
 	}
-	if len(m.ProvisionerID) > 0 {
-		i -= len(m.ProvisionerID)
-		copy(dAtA[i:], m.ProvisionerID)
-		i = encodeVarintEndDevice(dAtA, i, uint64(len(m.ProvisionerID)))
+	if m.DesiredPingSlotDataRateIndex != nil {
+		{
+			size, err := m.DesiredPingSlotDataRateIndex.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEndDevice(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x2
+		dAtA[i] = 0x1
 		i--
-		dAtA[i] = 0xd2
+		dAtA[i] = 0xda
 	}
-	if m.Formatters != nil {
+	if m.BeaconFrequency != nil {
 		{
-			size, err := m.Formatters.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.BeaconFrequency.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5522,84 +6156,41 @@ func (m *EndDevice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintEndDevice(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x2
+		dAtA[i] = 0x1
 		i--
 		dAtA[i] = 0xca
 	}
-	if len(m.QueuedApplicationDownlinks) > 0 {
-		for iNdEx := len(m.QueuedApplicationDownlinks) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.QueuedApplicationDownlinks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintEndDevice(dAtA, i, uint64(size))
+	if m.DesiredADRAckDelayExponent != nil {
+		{
+			size, err := m.DesiredADRAckDelayExponent.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
-			i--
-			dAtA[i] = 0x2
-			i--
-			dAtA[i] = 0xc2
+			i -= size
+			i = encodeVarintEndDevice(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc2
 	}
-	if len(m.RecentDownlinks) > 0 {
-		for iNdEx := len(m.RecentDownlinks) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.RecentDownlinks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintEndDevice(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x2
-			i--
-			dAtA[i] = 0xba
-		}
-	}
-	if len(m.RecentUplinks) > 0 {
-		for iNdEx := len(m.RecentUplinks) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.RecentUplinks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintEndDevice(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x2
-			i--
-			dAtA[i] = 0xb2
-		}
-	}
-	if len(m.RecentADRUplinks) > 0 {
-		for iNdEx := len(m.RecentADRUplinks) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.RecentADRUplinks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintEndDevice(dAtA, i, uint64(size))
+	if m.DesiredADRAckLimitExponent != nil {
+		{
+			size, err := m.DesiredADRAckLimitExponent.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
-			i--
-			dAtA[i] = 0x2
-			i--
-			dAtA[i] = 0xaa
+			i -= size
+			i = encodeVarintEndDevice(dAtA, i, uint64(size))
 		}
-	}
-	if m.DownlinkMargin != 0 {
-		i = encodeVarintEndDevice(dAtA, i, uint64(m.DownlinkMargin))
 		i--
-		dAtA[i] = 0x2
+		dAtA[i] = 0x1
 		i--
-		dAtA[i] = 0xa0
+		dAtA[i] = 0xba
 	}
-	if m.BatteryPercentage != nil {
+	if m.DesiredMaxDutyCycle != nil {
 		{
-			size, err := m.BatteryPercentage.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.DesiredMaxDutyCycle.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5607,80 +6198,95 @@ func (m *EndDevice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintEndDevice(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x2
-		i--
-		dAtA[i] = 0x9a
-	}
-	if m.PowerState != 0 {
-		i = encodeVarintEndDevice(dAtA, i, uint64(m.PowerState))
-		i--
-		dAtA[i] = 0x2
+		dAtA[i] = 0x1
 		i--
-		dAtA[i] = 0x90
+		dAtA[i] = 0xb2
 	}
-	if m.LastDevStatusReceivedAt != nil {
-		n58, err58 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastDevStatusReceivedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastDevStatusReceivedAt):])
-		if err58 != nil {
-			return 0, err58
+	if m.DesiredRx2Frequency != nil {
+		{
+			size, err := m.DesiredRx2Frequency.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEndDevice(dAtA, i, uint64(size))
 		}
-		i -= n58
-		i = encodeVarintEndDevice(dAtA, i, uint64(n58))
 		i--
-		dAtA[i] = 0x2
+		dAtA[i] = 0x1
 		i--
-		dAtA[i] = 0x8a
+		dAtA[i] = 0xaa
 	}
-	if m.LastRJCount1 != 0 {
-		i = encodeVarintEndDevice(dAtA, i, uint64(m.LastRJCount1))
+	if m.DesiredRx2DataRateIndex != nil {
+		{
+			size, err := m.DesiredRx2DataRateIndex.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEndDevice(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x2
+		dAtA[i] = 0x1
 		i--
-		dAtA[i] = 0x80
+		dAtA[i] = 0xa2
 	}
-	if m.LastRJCount0 != 0 {
-		i = encodeVarintEndDevice(dAtA, i, uint64(m.LastRJCount0))
+	if m.DesiredRx1DataRateOffset != nil {
+		{
+			size, err := m.DesiredRx1DataRateOffset.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEndDevice(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0x1
 		i--
-		dAtA[i] = 0xf8
+		dAtA[i] = 0x9a
 	}
-	if m.LastJoinNonce != 0 {
-		i = encodeVarintEndDevice(dAtA, i, uint64(m.LastJoinNonce))
+	if m.DesiredRx1Delay != nil {
+		{
+			size, err := m.DesiredRx1Delay.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEndDevice(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0x1
 		i--
-		dAtA[i] = 0xf0
+		dAtA[i] = 0x92
 	}
-	if len(m.UsedDevNonces) > 0 {
-		dAtA60 := make([]byte, len(m.UsedDevNonces)*10)
-		var j59 int
-		for _, num := range m.UsedDevNonces {
-			for num >= 1<<7 {
-				dAtA60[j59] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j59++
+	if m.StatusCountPeriodicity != nil {
+		{
+			size, err := m.StatusCountPeriodicity.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
-			dAtA60[j59] = uint8(num)
-			j59++
+			i -= size
+			i = encodeVarintEndDevice(dAtA, i, uint64(size))
 		}
-		i -= j59
-		copy(dAtA[i:], dAtA60[:j59])
-		i = encodeVarintEndDevice(dAtA, i, uint64(j59))
 		i--
 		dAtA[i] = 0x1
 		i--
-		dAtA[i] = 0xea
+		dAtA[i] = 0x8a
 	}
-	if m.LastDevNonce != 0 {
-		i = encodeVarintEndDevice(dAtA, i, uint64(m.LastDevNonce))
+	if m.StatusTimePeriodicity != nil {
+		n23, err23 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.StatusTimePeriodicity, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.StatusTimePeriodicity):])
+		if err23 != nil {
+			return 0, err23
+		}
+		i -= n23
+		i = encodeVarintEndDevice(dAtA, i, uint64(n23))
 		i--
 		dAtA[i] = 0x1
 		i--
-		dAtA[i] = 0xe0
+		dAtA[i] = 0x82
 	}
-	if m.PendingSession != nil {
+	if m.ResetsFCnt != nil {
 		{
-			size, err := m.PendingSession.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.ResetsFCnt.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5688,13 +6294,11 @@ func (m *EndDevice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintEndDevice(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xda
+		dAtA[i] = 0x7a
 	}
-	if m.Session != nil {
+	if m.ADRMargin != nil {
 		{
-			size, err := m.Session.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.ADRMargin.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5702,13 +6306,11 @@ func (m *EndDevice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintEndDevice(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xd2
+		dAtA[i] = 0x72
 	}
-	if m.MACState != nil {
+	if m.UseADR != nil {
 		{
-			size, err := m.MACState.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.UseADR.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5716,13 +6318,11 @@ func (m *EndDevice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintEndDevice(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xca
+		dAtA[i] = 0x6a
 	}
-	if m.MACSettings != nil {
+	if m.Supports32BitFCnt != nil {
 		{
-			size, err := m.MACSettings.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Supports32BitFCnt.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5730,27 +6330,41 @@ func (m *EndDevice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintEndDevice(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xc2
+		dAtA[i] = 0x62
 	}
-	if m.NetID != nil {
+	if m.MaxDutyCycle != nil {
 		{
-			size := m.NetID.Size()
-			i -= size
-			if _, err := m.NetID.MarshalTo(dAtA[i:]); err != nil {
+			size, err := m.MaxDutyCycle.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
 				return 0, err
 			}
+			i -= size
 			i = encodeVarintEndDevice(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x1
+		dAtA[i] = 0x5a
+	}
+	if len(m.FactoryPresetFrequencies) > 0 {
+		dAtA30 := make([]byte, len(m.FactoryPresetFrequencies)*10)
+		var j29 int
+		for _, num := range m.FactoryPresetFrequencies {
+			for num >= 1<<7 {
+				dAtA30[j29] = uint8(num&0x7f | 0x80)
+				num >>= 7
+				j29++
+			}
+			dAtA30[j29] = uint8(num)
+			j29++
+		}
+		i -= j29
+		copy(dAtA[i:], dAtA30[:j29])
+		i = encodeVarintEndDevice(dAtA, i, uint64(j29))
 		i--
-		dAtA[i] = 0xba
+		dAtA[i] = 0x52
 	}
-	if m.RootKeys != nil {
+	if m.Rx2Frequency != nil {
 		{
-			size, err := m.RootKeys.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Rx2Frequency.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5758,146 +6372,69 @@ func (m *EndDevice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintEndDevice(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xb2
+		dAtA[i] = 0x4a
 	}
-	if m.ResetsJoinNonces {
-		i--
-		if m.ResetsJoinNonces {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	if m.Rx2DataRateIndex != nil {
+		{
+			size, err := m.Rx2DataRateIndex.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEndDevice(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xa8
+		dAtA[i] = 0x42
 	}
-	if m.SupportsJoin {
-		i--
-		if m.SupportsJoin {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	if m.Rx1DataRateOffset != nil {
+		{
+			size, err := m.Rx1DataRateOffset.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEndDevice(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xa0
+		dAtA[i] = 0x3a
 	}
-	if m.MaxFrequency != 0 {
-		i = encodeVarintEndDevice(dAtA, i, m.MaxFrequency)
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x98
-	}
-	if m.MinFrequency != 0 {
-		i = encodeVarintEndDevice(dAtA, i, m.MinFrequency)
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x90
-	}
-	if len(m.FrequencyPlanID) > 0 {
-		i -= len(m.FrequencyPlanID)
-		copy(dAtA[i:], m.FrequencyPlanID)
-		i = encodeVarintEndDevice(dAtA, i, uint64(len(m.FrequencyPlanID)))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x8a
-	}
-	if m.LoRaWANPHYVersion != 0 {
-		i = encodeVarintEndDevice(dAtA, i, uint64(m.LoRaWANPHYVersion))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x80
-	}
-	if m.LoRaWANVersion != 0 {
-		i = encodeVarintEndDevice(dAtA, i, uint64(m.LoRaWANVersion))
-		i--
-		dAtA[i] = 0x78
-	}
-	if m.SupportsClassC {
-		i--
-		if m.SupportsClassC {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	if m.Rx1Delay != nil {
+		{
+			size, err := m.Rx1Delay.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEndDevice(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x70
+		dAtA[i] = 0x32
 	}
-	if m.SupportsClassB {
-		i--
-		if m.SupportsClassB {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	if m.ClassCTimeout != nil {
+		n35, err35 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.ClassCTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.ClassCTimeout):])
+		if err35 != nil {
+			return 0, err35
 		}
+		i -= n35
+		i = encodeVarintEndDevice(dAtA, i, uint64(n35))
 		i--
-		dAtA[i] = 0x68
+		dAtA[i] = 0x2a
 	}
-	if len(m.Locations) > 0 {
-		for k := range m.Locations {
-			v := m.Locations[k]
-			baseI := i
-			if v != nil {
-				{
-					size, err := v.MarshalToSizedBuffer(dAtA[:i])
-					if err != nil {
-						return 0, err
-					}
-					i -= size
-					i = encodeVarintEndDevice(dAtA, i, uint64(size))
-				}
-				i--
-				dAtA[i] = 0x12
+	if m.PingSlotFrequency != nil {
+		{
+			size, err := m.PingSlotFrequency.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintEndDevice(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintEndDevice(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x62
+			i -= size
+			i = encodeVarintEndDevice(dAtA, i, uint64(size))
 		}
-	}
-	if len(m.JoinServerAddress) > 0 {
-		i -= len(m.JoinServerAddress)
-		copy(dAtA[i:], m.JoinServerAddress)
-		i = encodeVarintEndDevice(dAtA, i, uint64(len(m.JoinServerAddress)))
-		i--
-		dAtA[i] = 0x5a
-	}
-	if len(m.ApplicationServerAddress) > 0 {
-		i -= len(m.ApplicationServerAddress)
-		copy(dAtA[i:], m.ApplicationServerAddress)
-		i = encodeVarintEndDevice(dAtA, i, uint64(len(m.ApplicationServerAddress)))
-		i--
-		dAtA[i] = 0x52
-	}
-	if len(m.NetworkServerAddress) > 0 {
-		i -= len(m.NetworkServerAddress)
-		copy(dAtA[i:], m.NetworkServerAddress)
-		i = encodeVarintEndDevice(dAtA, i, uint64(len(m.NetworkServerAddress)))
 		i--
-		dAtA[i] = 0x4a
-	}
-	if len(m.ServiceProfileID) > 0 {
-		i -= len(m.ServiceProfileID)
-		copy(dAtA[i:], m.ServiceProfileID)
-		i = encodeVarintEndDevice(dAtA, i, uint64(len(m.ServiceProfileID)))
-		i--
-		dAtA[i] = 0x42
+		dAtA[i] = 0x22
 	}
-	if m.VersionIDs != nil {
+	if m.PingSlotDataRateIndex != nil {
 		{
-			size, err := m.VersionIDs.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.PingSlotDataRateIndex.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5905,71 +6442,34 @@ func (m *EndDevice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintEndDevice(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x3a
+		dAtA[i] = 0x1a
 	}
-	if len(m.Attributes) > 0 {
-		for k := range m.Attributes {
-			v := m.Attributes[k]
-			baseI := i
-			i -= len(v)
-			copy(dAtA[i:], v)
-			i = encodeVarintEndDevice(dAtA, i, uint64(len(v)))
-			i--
-			dAtA[i] = 0x12
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintEndDevice(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintEndDevice(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x32
+	if m.PingSlotPeriodicity != nil {
+		{
+			size, err := m.PingSlotPeriodicity.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEndDevice(dAtA, i, uint64(size))
 		}
-	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintEndDevice(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintEndDevice(dAtA, i, uint64(len(m.Name)))
 		i--
-		dAtA[i] = 0x22
-	}
-	n68, err68 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.UpdatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.UpdatedAt):])
-	if err68 != nil {
-		return 0, err68
-	}
-	i -= n68
-	i = encodeVarintEndDevice(dAtA, i, uint64(n68))
-	i--
-	dAtA[i] = 0x1a
-	n69, err69 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CreatedAt):])
-	if err69 != nil {
-		return 0, err69
+		dAtA[i] = 0x12
 	}
-	i -= n69
-	i = encodeVarintEndDevice(dAtA, i, uint64(n69))
-	i--
-	dAtA[i] = 0x12
-	{
-		size, err := m.EndDeviceIdentifiers.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if m.ClassBTimeout != nil {
+		n39, err39 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.ClassBTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.ClassBTimeout):])
+		if err39 != nil {
+			return 0, err39
 		}
-		i -= size
-		i = encodeVarintEndDevice(dAtA, i, uint64(size))
+		i -= n39
+		i = encodeVarintEndDevice(dAtA, i, uint64(n39))
+		i--
+		dAtA[i] = 0xa
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *EndDevices) Marshal() (dAtA []byte, err error) {
+func (m *MACState) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5979,20 +6479,53 @@ func (m *EndDevices) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EndDevices) MarshalTo(dAtA []byte) (int, error) {
+func (m *MACState) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EndDevices) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MACState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.EndDevices) > 0 {
-		for iNdEx := len(m.EndDevices) - 1; iNdEx >= 0; iNdEx-- {
+	if m.LastRJCount0 != 0 {
+		i = encodeVarintEndDevice(dAtA, i, uint64(m.LastRJCount0))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if m.QueuedForceRejoinReq != nil {
+		{
+			size, err := m.QueuedForceRejoinReq.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEndDevice(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if m.LastNetworkInitiatedDownlinkAt != nil {
+		n41, err41 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastNetworkInitiatedDownlinkAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastNetworkInitiatedDownlinkAt):])
+		if err41 != nil {
+			return 0, err41
+		}
+		i -= n41
+		i = encodeVarintEndDevice(dAtA, i, uint64(n41))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if len(m.RecentDownlinks) > 0 {
+		for iNdEx := len(m.RecentDownlinks) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.EndDevices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.RecentDownlinks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -6000,67 +6533,136 @@ func (m *EndDevices) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintEndDevice(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x7a
 		}
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *CreateEndDeviceRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if len(m.RecentUplinks) > 0 {
+		for iNdEx := len(m.RecentUplinks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RecentUplinks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEndDevice(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
 	}
-	return dAtA[:n], nil
-}
-
-func (m *CreateEndDeviceRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *CreateEndDeviceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.EndDevice.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if m.RxWindowsAvailable {
+		i--
+		if m.RxWindowsAvailable {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
-		i -= size
-		i = encodeVarintEndDevice(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x68
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func (m *UpdateEndDeviceRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if m.PendingJoinRequest != nil {
+		{
+			size, err := m.PendingJoinRequest.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEndDevice(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.QueuedJoinAccept != nil {
+		{
+			size, err := m.QueuedJoinAccept.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEndDevice(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.PendingRequests) > 0 {
+		for iNdEx := len(m.PendingRequests) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingRequests[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEndDevice(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.QueuedResponses) > 0 {
+		for iNdEx := len(m.QueuedResponses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.QueuedResponses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEndDevice(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if m.PendingApplicationDownlink != nil {
+		{
+			size, err := m.PendingApplicationDownlink.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEndDevice(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.PingSlotPeriodicity != nil {
+		{
+			size, err := m.PingSlotPeriodicity.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEndDevice(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.LastDevStatusFCntUp != 0 {
+		i = encodeVarintEndDevice(dAtA, i, uint64(m.LastDevStatusFCntUp))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.LastConfirmedDownlinkAt != nil {
+		n46, err46 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastConfirmedDownlinkAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastConfirmedDownlinkAt):])
+		if err46 != nil {
+			return 0, err46
+		}
+		i -= n46
+		i = encodeVarintEndDevice(dAtA, i, uint64(n46))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.LoRaWANVersion != 0 {
+		i = encodeVarintEndDevice(dAtA, i, uint64(m.LoRaWANVersion))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.DeviceClass != 0 {
+		i = encodeVarintEndDevice(dAtA, i, uint64(m.DeviceClass))
+		i--
+		dAtA[i] = 0x18
 	}
-	return dAtA[:n], nil
-}
-
-func (m *UpdateEndDeviceRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *UpdateEndDeviceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
 	{
-		size, err := m.FieldMask.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.DesiredParameters.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -6070,7 +6672,7 @@ func (m *UpdateEndDeviceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	i--
 	dAtA[i] = 0x12
 	{
-		size, err := m.EndDevice.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.CurrentParameters.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -6082,7 +6684,7 @@ func (m *UpdateEndDeviceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *GetEndDeviceRequest) Marshal() (dAtA []byte, err error) {
+func (m *MACState_JoinAccept) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6092,18 +6694,18 @@ func (m *GetEndDeviceRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetEndDeviceRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *MACState_JoinAccept) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GetEndDeviceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MACState_JoinAccept) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size, err := m.FieldMask.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.Keys.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -6111,9 +6713,9 @@ func (m *GetEndDeviceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i = encodeVarintEndDevice(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x12
+	dAtA[i] = 0x1a
 	{
-		size, err := m.EndDeviceIdentifiers.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -6121,11 +6723,18 @@ func (m *GetEndDeviceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i = encodeVarintEndDevice(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0xa
+	dAtA[i] = 0x12
+	if len(m.Payload) > 0 {
+		i -= len(m.Payload)
+		copy(dAtA[i:], m.Payload)
+		i = encodeVarintEndDevice(dAtA, i, uint64(len(m.Payload)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *GetEndDeviceIdentifiersForEUIsRequest) Marshal() (dAtA []byte, err error) {
+func (m *EndDeviceAuthenticationCode) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6135,40 +6744,47 @@ func (m *GetEndDeviceIdentifiersForEUIsRequest) Marshal() (dAtA []byte, err erro
 	return dAtA[:n], nil
 }
 
-func (m *GetEndDeviceIdentifiersForEUIsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *EndDeviceAuthenticationCode) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GetEndDeviceIdentifiersForEUIsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EndDeviceAuthenticationCode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size := m.DevEUI.Size()
-		i -= size
-		if _, err := m.DevEUI.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	if m.ValidTo != nil {
+		n51, err51 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ValidTo, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ValidTo):])
+		if err51 != nil {
+			return 0, err51
 		}
-		i = encodeVarintEndDevice(dAtA, i, uint64(size))
+		i -= n51
+		i = encodeVarintEndDevice(dAtA, i, uint64(n51))
+		i--
+		dAtA[i] = 0x1a
 	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size := m.JoinEUI.Size()
-		i -= size
-		if _, err := m.JoinEUI.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	if m.ValidFrom != nil {
+		n52, err52 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ValidFrom, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ValidFrom):])
+		if err52 != nil {
+			return 0, err52
 		}
-		i = encodeVarintEndDevice(dAtA, i, uint64(size))
+		i -= n52
+		i = encodeVarintEndDevice(dAtA, i, uint64(n52))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintEndDevice(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0xa
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *ListEndDevicesRequest) Marshal() (dAtA []byte, err error) {
+func (m *EndDevice) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6178,2033 +6794,5650 @@ func (m *ListEndDevicesRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ListEndDevicesRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *EndDevice) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ListEndDevicesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EndDevice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Page != 0 {
-		i = encodeVarintEndDevice(dAtA, i, uint64(m.Page))
+	if m.ProfileIDs != nil {
+		{
+			size, err := m.ProfileIDs.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEndDevice(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x28
-	}
-	if m.Limit != 0 {
-		i = encodeVarintEndDevice(dAtA, i, uint64(m.Limit))
+		dAtA[i] = 0x3
 		i--
-		dAtA[i] = 0x20
+		dAtA[i] = 0xa2
 	}
-	if len(m.Order) > 0 {
-		i -= len(m.Order)
-		copy(dAtA[i:], m.Order)
-		i = encodeVarintEndDevice(dAtA, i, uint64(len(m.Order)))
+	if m.SkipPayloadCrypto {
 		i--
-		dAtA[i] = 0x1a
-	}
-	{
-		size, err := m.FieldMask.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+		if m.SkipPayloadCrypto {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
-		i -= size
-		i = encodeVarintEndDevice(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0x98
 	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size, err := m.ApplicationIdentifiers.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintEndDevice(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func (m *SetEndDeviceRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *SetEndDeviceRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *SetEndDeviceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.FieldMask.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if m.Picture != nil {
+		{
+			size, err := m.Picture.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEndDevice(dAtA, i, uint64(size))
 		}
-		i -= size
-		i = encodeVarintEndDevice(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0x92
 	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size, err := m.EndDevice.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintEndDevice(dAtA, i, uint64(size))
+	if len(m.ApplicationServerID) > 0 {
+		i -= len(m.ApplicationServerID)
+		copy(dAtA[i:], m.ApplicationServerID)
+		i = encodeVarintEndDevice(dAtA, i, uint64(len(m.ApplicationServerID)))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0x8a
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func (m *EndDeviceTemplate) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if len(m.ApplicationServerKEKLabel) > 0 {
+		i -= len(m.ApplicationServerKEKLabel)
+		copy(dAtA[i:], m.ApplicationServerKEKLabel)
+		i = encodeVarintEndDevice(dAtA, i, uint64(len(m.ApplicationServerKEKLabel)))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0x82
 	}
-	return dAtA[:n], nil
-}
-
-func (m *EndDeviceTemplate) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *EndDeviceTemplate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.MappingKey) > 0 {
-		i -= len(m.MappingKey)
-		copy(dAtA[i:], m.MappingKey)
-		i = encodeVarintEndDevice(dAtA, i, uint64(len(m.MappingKey)))
+	if len(m.NetworkServerKEKLabel) > 0 {
+		i -= len(m.NetworkServerKEKLabel)
+		copy(dAtA[i:], m.NetworkServerKEKLabel)
+		i = encodeVarintEndDevice(dAtA, i, uint64(len(m.NetworkServerKEKLabel)))
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xfa
 	}
-	{
-		size, err := m.FieldMask.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if m.ClaimAuthenticationCode != nil {
+		{
+			size, err := m.ClaimAuthenticationCode.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEndDevice(dAtA, i, uint64(size))
 		}
-		i -= size
-		i = encodeVarintEndDevice(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xf2
 	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size, err := m.EndDevice.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if m.Multicast {
+		i--
+		if m.Multicast {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
-		i -= size
-		i = encodeVarintEndDevice(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xe8
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func (m *EndDeviceTemplateFormat) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if m.PendingMACState != nil {
+		{
+			size, err := m.PendingMACState.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEndDevice(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xe2
 	}
-	return dAtA[:n], nil
-}
-
-func (m *EndDeviceTemplateFormat) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *EndDeviceTemplateFormat) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.FileExtensions) > 0 {
-		for iNdEx := len(m.FileExtensions) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.FileExtensions[iNdEx])
-			copy(dAtA[i:], m.FileExtensions[iNdEx])
-			i = encodeVarintEndDevice(dAtA, i, uint64(len(m.FileExtensions[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
+	if m.ProvisioningData != nil {
+		{
+			size, err := m.ProvisioningData.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEndDevice(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xda
 	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintEndDevice(dAtA, i, uint64(len(m.Description)))
+	if len(m.ProvisionerID) > 0 {
+		i -= len(m.ProvisionerID)
+		copy(dAtA[i:], m.ProvisionerID)
+		i = encodeVarintEndDevice(dAtA, i, uint64(len(m.ProvisionerID)))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xd2
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintEndDevice(dAtA, i, uint64(len(m.Name)))
+	if m.Formatters != nil {
+		{
+			size, err := m.Formatters.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEndDevice(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xca
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *EndDeviceTemplateFormats) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if len(m.QueuedApplicationDownlinks) > 0 {
+		for iNdEx := len(m.QueuedApplicationDownlinks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.QueuedApplicationDownlinks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEndDevice(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xc2
+		}
 	}
-	return dAtA[:n], nil
-}
-
-func (m *EndDeviceTemplateFormats) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *EndDeviceTemplateFormats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Formats) > 0 {
-		for k := range m.Formats {
-			v := m.Formats[k]
-			baseI := i
-			if v != nil {
-				{
-					size, err := v.MarshalToSizedBuffer(dAtA[:i])
-					if err != nil {
-						return 0, err
-					}
-					i -= size
-					i = encodeVarintEndDevice(dAtA, i, uint64(size))
+	if len(m.RecentDownlinks) > 0 {
+		for iNdEx := len(m.RecentDownlinks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RecentDownlinks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
 				}
-				i--
-				dAtA[i] = 0x12
+				i -= size
+				i = encodeVarintEndDevice(dAtA, i, uint64(size))
 			}
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintEndDevice(dAtA, i, uint64(len(k)))
 			i--
-			dAtA[i] = 0xa
-			i = encodeVarintEndDevice(dAtA, i, uint64(baseI-i))
+			dAtA[i] = 0x2
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0xba
 		}
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *ConvertEndDeviceTemplateRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if len(m.RecentUplinks) > 0 {
+		for iNdEx := len(m.RecentUplinks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RecentUplinks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEndDevice(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xb2
+		}
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ConvertEndDeviceTemplateRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *ConvertEndDeviceTemplateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Data) > 0 {
-		i -= len(m.Data)
-		copy(dAtA[i:], m.Data)
-		i = encodeVarintEndDevice(dAtA, i, uint64(len(m.Data)))
-		i--
-		dAtA[i] = 0x12
+	if len(m.RecentADRUplinks) > 0 {
+		for iNdEx := len(m.RecentADRUplinks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RecentADRUplinks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEndDevice(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xaa
+		}
 	}
-	if len(m.FormatID) > 0 {
-		i -= len(m.FormatID)
-		copy(dAtA[i:], m.FormatID)
-		i = encodeVarintEndDevice(dAtA, i, uint64(len(m.FormatID)))
+	if m.DownlinkMargin != 0 {
+		i = encodeVarintEndDevice(dAtA, i, uint64(m.DownlinkMargin))
 		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintEndDevice(dAtA []byte, offset int, v uint64) int {
-	offset -= sovEndDevice(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func NewPopulatedSession(r randyEndDevice, easy bool) *Session {
-	this := &Session{}
-	v1 := go_thethings_network_lorawan_stack_pkg_types.NewPopulatedDevAddr(r)
-	this.DevAddr = *v1
-	v2 := NewPopulatedSessionKeys(r, easy)
-	this.SessionKeys = *v2
-	this.LastFCntUp = r.Uint32()
-	this.LastNFCntDown = r.Uint32()
-	this.LastAFCntDown = r.Uint32()
-	this.LastConfFCntDown = r.Uint32()
-	v3 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
-	this.StartedAt = *v3
-	if !easy && r.Intn(10) != 0 {
-	}
-	return this
-}
-
-func NewPopulatedEndDeviceBrand(r randyEndDevice, easy bool) *EndDeviceBrand {
-	this := &EndDeviceBrand{}
-	this.ID = randStringEndDevice(r)
-	this.Name = randStringEndDevice(r)
-	this.URL = randStringEndDevice(r)
-	v4 := r.Intn(10)
-	this.Logos = make([]string, v4)
-	for i := 0; i < v4; i++ {
-		this.Logos[i] = randStringEndDevice(r)
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xa0
 	}
-	if !easy && r.Intn(10) != 0 {
+	if m.BatteryPercentage != nil {
+		{
+			size, err := m.BatteryPercentage.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEndDevice(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x9a
 	}
-	return this
-}
-
-func NewPopulatedEndDeviceModel(r randyEndDevice, easy bool) *EndDeviceModel {
-	this := &EndDeviceModel{}
-	this.BrandID = randStringEndDevice(r)
-	this.ID = randStringEndDevice(r)
-	this.Name = randStringEndDevice(r)
-	if !easy && r.Intn(10) != 0 {
+	if m.PowerState != 0 {
+		i = encodeVarintEndDevice(dAtA, i, uint64(m.PowerState))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x90
 	}
-	return this
-}
-
-func NewPopulatedEndDeviceVersionIdentifiers(r randyEndDevice, easy bool) *EndDeviceVersionIdentifiers {
-	this := &EndDeviceVersionIdentifiers{}
-	this.BrandID = randStringEndDevice(r)
-	this.ModelID = randStringEndDevice(r)
-	this.HardwareVersion = randStringEndDevice(r)
-	this.FirmwareVersion = randStringEndDevice(r)
-	if !easy && r.Intn(10) != 0 {
+	if m.LastDevStatusReceivedAt != nil {
+		n60, err60 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastDevStatusReceivedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastDevStatusReceivedAt):])
+		if err60 != nil {
+			return 0, err60
+		}
+		i -= n60
+		i = encodeVarintEndDevice(dAtA, i, uint64(n60))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x8a
 	}
-	return this
-}
-
-func NewPopulatedMACSettings(r randyEndDevice, easy bool) *MACSettings {
-	this := &MACSettings{}
-	if r.Intn(5) != 0 {
-		this.ClassBTimeout = github_com_gogo_protobuf_types.NewPopulatedStdDuration(r, easy)
+	if m.LastRJCount1 != 0 {
+		i = encodeVarintEndDevice(dAtA, i, uint64(m.LastRJCount1))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x80
 	}
-	if r.Intn(5) != 0 {
-		this.PingSlotPeriodicity = NewPopulatedPingSlotPeriodValue(r, easy)
+	if m.LastRJCount0 != 0 {
+		i = encodeVarintEndDevice(dAtA, i, uint64(m.LastRJCount0))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xf8
 	}
-	if r.Intn(5) != 0 {
-		this.PingSlotDataRateIndex = NewPopulatedDataRateIndexValue(r, easy)
+	if m.LastJoinNonce != 0 {
+		i = encodeVarintEndDevice(dAtA, i, uint64(m.LastJoinNonce))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xf0
 	}
-	if r.Intn(5) != 0 {
-		this.PingSlotFrequency = types.NewPopulatedUInt64Value(r, easy)
+	if len(m.UsedDevNonces) > 0 {
+		dAtA62 := make([]byte, len(m.UsedDevNonces)*10)
+		var j61 int
+		for _, num := range m.UsedDevNonces {
+			for num >= 1<<7 {
+				dAtA62[j61] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j61++
+			}
+			dAtA62[j61] = uint8(num)
+			j61++
+		}
+		i -= j61
+		copy(dAtA[i:], dAtA62[:j61])
+		i = encodeVarintEndDevice(dAtA, i, uint64(j61))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xea
 	}
-	if r.Intn(5) != 0 {
-		this.ClassCTimeout = github_com_gogo_protobuf_types.NewPopulatedStdDuration(r, easy)
+	if m.LastDevNonce != 0 {
+		i = encodeVarintEndDevice(dAtA, i, uint64(m.LastDevNonce))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xe0
 	}
-	if r.Intn(5) != 0 {
-		this.Rx1Delay = NewPopulatedRxDelayValue(r, easy)
+	if m.PendingSession != nil {
+		{
+			size, err := m.PendingSession.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEndDevice(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xda
 	}
-	if r.Intn(5) != 0 {
-		this.Rx1DataRateOffset = types.NewPopulatedUInt32Value(r, easy)
+	if m.Session != nil {
+		{
+			size, err := m.Session.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEndDevice(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd2
 	}
-	if r.Intn(5) != 0 {
-		this.Rx2DataRateIndex = NewPopulatedDataRateIndexValue(r, easy)
+	if m.MACState != nil {
+		{
+			size, err := m.MACState.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEndDevice(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xca
 	}
-	if r.Intn(5) != 0 {
-		this.Rx2Frequency = types.NewPopulatedUInt64Value(r, easy)
+	if m.MACSettings != nil {
+		{
+			size, err := m.MACSettings.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEndDevice(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc2
 	}
-	v5 := r.Intn(10)
-	this.FactoryPresetFrequencies = make([]uint64, v5)
-	for i := 0; i < v5; i++ {
-		this.FactoryPresetFrequencies[i] = uint64(r.Uint32())
+	if m.NetID != nil {
+		{
+			size := m.NetID.Size()
+			i -= size
+			if _, err := m.NetID.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintEndDevice(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xba
 	}
-	if r.Intn(5) != 0 {
-		this.MaxDutyCycle = NewPopulatedAggregatedDutyCycleValue(r, easy)
+	if m.RootKeys != nil {
+		{
+			size, err := m.RootKeys.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEndDevice(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb2
 	}
-	if r.Intn(5) != 0 {
-		this.Supports32BitFCnt = types.NewPopulatedBoolValue(r, easy)
+	if m.ResetsJoinNonces {
+		i--
+		if m.ResetsJoinNonces {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa8
 	}
-	if r.Intn(5) != 0 {
-		this.UseADR = types.NewPopulatedBoolValue(r, easy)
+	if m.SupportsJoin {
+		i--
+		if m.SupportsJoin {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
 	}
-	if r.Intn(5) != 0 {
-		this.ADRMargin = types.NewPopulatedFloatValue(r, easy)
+	if m.MaxFrequency != 0 {
+		i = encodeVarintEndDevice(dAtA, i, m.MaxFrequency)
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
 	}
-	if r.Intn(5) != 0 {
-		this.ResetsFCnt = types.NewPopulatedBoolValue(r, easy)
+	if m.MinFrequency != 0 {
+		i = encodeVarintEndDevice(dAtA, i, m.MinFrequency)
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
 	}
-	if r.Intn(5) != 0 {
-		this.StatusTimePeriodicity = github_com_gogo_protobuf_types.NewPopulatedStdDuration(r, easy)
+	if len(m.FrequencyPlanID) > 0 {
+		i -= len(m.FrequencyPlanID)
+		copy(dAtA[i:], m.FrequencyPlanID)
+		i = encodeVarintEndDevice(dAtA, i, uint64(len(m.FrequencyPlanID)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
 	}
-	if r.Intn(5) != 0 {
-		this.StatusCountPeriodicity = types.NewPopulatedUInt32Value(r, easy)
+	if m.LoRaWANPHYVersion != 0 {
+		i = encodeVarintEndDevice(dAtA, i, uint64(m.LoRaWANPHYVersion))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
 	}
-	if r.Intn(5) != 0 {
-		this.DesiredRx1Delay = NewPopulatedRxDelayValue(r, easy)
+	if m.LoRaWANVersion != 0 {
+		i = encodeVarintEndDevice(dAtA, i, uint64(m.LoRaWANVersion))
+		i--
+		dAtA[i] = 0x78
 	}
-	if r.Intn(5) != 0 {
-		this.DesiredRx1DataRateOffset = types.NewPopulatedUInt32Value(r, easy)
+	if m.SupportsClassC {
+		i--
+		if m.SupportsClassC {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x70
 	}
-	if r.Intn(5) != 0 {
-		this.DesiredRx2DataRateIndex = NewPopulatedDataRateIndexValue(r, easy)
+	if m.SupportsClassB {
+		i--
+		if m.SupportsClassB {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x68
 	}
-	if r.Intn(5) != 0 {
-		this.DesiredRx2Frequency = types.NewPopulatedUInt64Value(r, easy)
+	if len(m.Locations) > 0 {
+		for k := range m.Locations {
+			v := m.Locations[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintEndDevice(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintEndDevice(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintEndDevice(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x62
+		}
 	}
-	if r.Intn(5) != 0 {
-		this.DesiredMaxDutyCycle = NewPopulatedAggregatedDutyCycleValue(r, easy)
+	if len(m.JoinServerAddress) > 0 {
+		i -= len(m.JoinServerAddress)
+		copy(dAtA[i:], m.JoinServerAddress)
+		i = encodeVarintEndDevice(dAtA, i, uint64(len(m.JoinServerAddress)))
+		i--
+		dAtA[i] = 0x5a
 	}
-	if r.Intn(5) != 0 {
-		this.DesiredADRAckLimitExponent = NewPopulatedADRAckLimitExponentValue(r, easy)
+	if len(m.ApplicationServerAddress) > 0 {
+		i -= len(m.ApplicationServerAddress)
+		copy(dAtA[i:], m.ApplicationServerAddress)
+		i = encodeVarintEndDevice(dAtA, i, uint64(len(m.ApplicationServerAddress)))
+		i--
+		dAtA[i] = 0x52
 	}
-	if r.Intn(5) != 0 {
-		this.DesiredADRAckDelayExponent = NewPopulatedADRAckDelayExponentValue(r, easy)
+	if len(m.NetworkServerAddress) > 0 {
+		i -= len(m.NetworkServerAddress)
+		copy(dAtA[i:], m.NetworkServerAddress)
+		i = encodeVarintEndDevice(dAtA, i, uint64(len(m.NetworkServerAddress)))
+		i--
+		dAtA[i] = 0x4a
 	}
-	if r.Intn(5) != 0 {
-		this.BeaconFrequency = types.NewPopulatedUInt64Value(r, easy)
+	if len(m.ServiceProfileID) > 0 {
+		i -= len(m.ServiceProfileID)
+		copy(dAtA[i:], m.ServiceProfileID)
+		i = encodeVarintEndDevice(dAtA, i, uint64(len(m.ServiceProfileID)))
+		i--
+		dAtA[i] = 0x42
 	}
-	if r.Intn(5) != 0 {
-		this.DesiredPingSlotDataRateIndex = NewPopulatedDataRateIndexValue(r, easy)
+	if m.VersionIDs != nil {
+		{
+			size, err := m.VersionIDs.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEndDevice(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
 	}
-	if r.Intn(5) != 0 {
-		this.DesiredPingSlotFrequency = types.NewPopulatedUInt64Value(r, easy)
+	if len(m.Attributes) > 0 {
+		for k := range m.Attributes {
+			v := m.Attributes[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintEndDevice(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintEndDevice(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintEndDevice(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x32
+		}
 	}
-	if r.Intn(5) != 0 {
-		this.DesiredBeaconFrequency = types.NewPopulatedUInt64Value(r, easy)
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintEndDevice(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	if !easy && r.Intn(10) != 0 {
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintEndDevice(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x22
 	}
-	return this
-}
-
-func NewPopulatedMACState_JoinAccept(r randyEndDevice, easy bool) *MACState_JoinAccept {
-	this := &MACState_JoinAccept{}
-	v6 := r.Intn(100)
-	this.Payload = make([]byte, v6)
-	for i := 0; i < v6; i++ {
-		this.Payload[i] = byte(r.Intn(256))
+	n70, err70 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.UpdatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.UpdatedAt):])
+	if err70 != nil {
+		return 0, err70
 	}
-	v7 := NewPopulatedJoinRequest(r, easy)
-	this.Request = *v7
-	v8 := NewPopulatedSessionKeys(r, easy)
-	this.Keys = *v8
-	if !easy && r.Intn(10) != 0 {
+	i -= n70
+	i = encodeVarintEndDevice(dAtA, i, uint64(n70))
+	i--
+	dAtA[i] = 0x1a
+	n71, err71 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CreatedAt):])
+	if err71 != nil {
+		return 0, err71
 	}
-	return this
-}
-
-func NewPopulatedEndDevices(r randyEndDevice, easy bool) *EndDevices {
-	this := &EndDevices{}
-	if r.Intn(5) != 0 {
-		v9 := r.Intn(5)
-		this.EndDevices = make([]*EndDevice, v9)
-		for i := 0; i < v9; i++ {
-			this.EndDevices[i] = NewPopulatedEndDevice(r, easy)
+	i -= n71
+	i = encodeVarintEndDevice(dAtA, i, uint64(n71))
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.EndDeviceIdentifiers.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintEndDevice(dAtA, i, uint64(size))
 	}
-	if !easy && r.Intn(10) != 0 {
-	}
-	return this
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func NewPopulatedCreateEndDeviceRequest(r randyEndDevice, easy bool) *CreateEndDeviceRequest {
-	this := &CreateEndDeviceRequest{}
-	v10 := NewPopulatedEndDevice(r, easy)
-	this.EndDevice = *v10
-	if !easy && r.Intn(10) != 0 {
+func (m *EndDevices) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return this
+	return dAtA[:n], nil
 }
 
-func NewPopulatedUpdateEndDeviceRequest(r randyEndDevice, easy bool) *UpdateEndDeviceRequest {
-	this := &UpdateEndDeviceRequest{}
-	v11 := NewPopulatedEndDevice(r, easy)
-	this.EndDevice = *v11
-	v12 := types.NewPopulatedFieldMask(r, easy)
-	this.FieldMask = *v12
-	if !easy && r.Intn(10) != 0 {
-	}
-	return this
+func (m *EndDevices) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func NewPopulatedGetEndDeviceRequest(r randyEndDevice, easy bool) *GetEndDeviceRequest {
-	this := &GetEndDeviceRequest{}
-	v13 := NewPopulatedEndDeviceIdentifiers(r, easy)
-	this.EndDeviceIdentifiers = *v13
-	v14 := types.NewPopulatedFieldMask(r, easy)
-	this.FieldMask = *v14
-	if !easy && r.Intn(10) != 0 {
+func (m *EndDevices) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EndDevices) > 0 {
+		for iNdEx := len(m.EndDevices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EndDevices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEndDevice(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return this
+	return len(dAtA) - i, nil
 }
 
-func NewPopulatedGetEndDeviceIdentifiersForEUIsRequest(r randyEndDevice, easy bool) *GetEndDeviceIdentifiersForEUIsRequest {
-	this := &GetEndDeviceIdentifiersForEUIsRequest{}
-	v15 := go_thethings_network_lorawan_stack_pkg_types.NewPopulatedEUI64(r)
-	this.JoinEUI = *v15
-	v16 := go_thethings_network_lorawan_stack_pkg_types.NewPopulatedEUI64(r)
-	this.DevEUI = *v16
-	if !easy && r.Intn(10) != 0 {
+func (m *CreateEndDeviceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return this
+	return dAtA[:n], nil
 }
 
-func NewPopulatedListEndDevicesRequest(r randyEndDevice, easy bool) *ListEndDevicesRequest {
-	this := &ListEndDevicesRequest{}
-	v17 := NewPopulatedApplicationIdentifiers(r, easy)
-	this.ApplicationIdentifiers = *v17
-	v18 := types.NewPopulatedFieldMask(r, easy)
-	this.FieldMask = *v18
-	this.Order = randStringEndDevice(r)
-	this.Limit = r.Uint32()
-	this.Page = r.Uint32()
-	if !easy && r.Intn(10) != 0 {
-	}
-	return this
+func (m *CreateEndDeviceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func NewPopulatedSetEndDeviceRequest(r randyEndDevice, easy bool) *SetEndDeviceRequest {
-	this := &SetEndDeviceRequest{}
-	v19 := NewPopulatedEndDevice(r, easy)
-	this.EndDevice = *v19
-	v20 := types.NewPopulatedFieldMask(r, easy)
-	this.FieldMask = *v20
-	if !easy && r.Intn(10) != 0 {
+func (m *CreateEndDeviceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.EndDevice.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEndDevice(dAtA, i, uint64(size))
 	}
-	return this
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func NewPopulatedEndDeviceTemplate(r randyEndDevice, easy bool) *EndDeviceTemplate {
-	this := &EndDeviceTemplate{}
-	v21 := NewPopulatedEndDevice(r, easy)
-	this.EndDevice = *v21
-	v22 := types.NewPopulatedFieldMask(r, easy)
-	this.FieldMask = *v22
-	this.MappingKey = randStringEndDevice(r)
-	if !easy && r.Intn(10) != 0 {
+func (m *UpdateEndDeviceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return this
+	return dAtA[:n], nil
 }
 
-func NewPopulatedEndDeviceTemplateFormat(r randyEndDevice, easy bool) *EndDeviceTemplateFormat {
-	this := &EndDeviceTemplateFormat{}
-	this.Name = randStringEndDevice(r)
-	this.Description = randStringEndDevice(r)
-	v23 := r.Intn(10)
-	this.FileExtensions = make([]string, v23)
-	for i := 0; i < v23; i++ {
-		this.FileExtensions[i] = randStringEndDevice(r)
-	}
-	if !easy && r.Intn(10) != 0 {
-	}
-	return this
+func (m *UpdateEndDeviceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func NewPopulatedEndDeviceTemplateFormats(r randyEndDevice, easy bool) *EndDeviceTemplateFormats {
-	this := &EndDeviceTemplateFormats{}
-	if r.Intn(5) != 0 {
-		v24 := r.Intn(10)
-		this.Formats = make(map[string]*EndDeviceTemplateFormat)
-		for i := 0; i < v24; i++ {
-			this.Formats[randStringEndDevice(r)] = NewPopulatedEndDeviceTemplateFormat(r, easy)
+func (m *UpdateEndDeviceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.FieldMask.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintEndDevice(dAtA, i, uint64(size))
 	}
-	if !easy && r.Intn(10) != 0 {
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.EndDevice.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEndDevice(dAtA, i, uint64(size))
 	}
-	return this
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func NewPopulatedConvertEndDeviceTemplateRequest(r randyEndDevice, easy bool) *ConvertEndDeviceTemplateRequest {
-	this := &ConvertEndDeviceTemplateRequest{}
-	this.FormatID = randStringEndDevice(r)
-	v25 := r.Intn(100)
-	this.Data = make([]byte, v25)
-	for i := 0; i < v25; i++ {
-		this.Data[i] = byte(r.Intn(256))
-	}
-	if !easy && r.Intn(10) != 0 {
+func (m *GetEndDeviceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return this
+	return dAtA[:n], nil
 }
 
-type randyEndDevice interface {
-	Float32() float32
-	Float64() float64
-	Int63() int64
-	Int31() int32
-	Uint32() uint32
-	Intn(n int) int
+func (m *GetEndDeviceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func randUTF8RuneEndDevice(r randyEndDevice) rune {
-	ru := r.Intn(62)
-	if ru < 10 {
-		return rune(ru + 48)
-	} else if ru < 36 {
-		return rune(ru + 55)
-	}
-	return rune(ru + 61)
-}
-func randStringEndDevice(r randyEndDevice) string {
-	v26 := r.Intn(100)
-	tmps := make([]rune, v26)
-	for i := 0; i < v26; i++ {
-		tmps[i] = randUTF8RuneEndDevice(r)
-	}
-	return string(tmps)
-}
-func randUnrecognizedEndDevice(r randyEndDevice, maxFieldNumber int) (dAtA []byte) {
-	l := r.Intn(5)
-	for i := 0; i < l; i++ {
-		wire := r.Intn(4)
-		if wire == 3 {
-			wire = 5
+func (m *GetEndDeviceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.FieldMask.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
-		fieldNumber := maxFieldNumber + r.Intn(100)
-		dAtA = randFieldEndDevice(dAtA, r, fieldNumber, wire)
+		i -= size
+		i = encodeVarintEndDevice(dAtA, i, uint64(size))
 	}
-	return dAtA
-}
-func randFieldEndDevice(dAtA []byte, r randyEndDevice, fieldNumber int, wire int) []byte {
-	key := uint32(fieldNumber)<<3 | uint32(wire)
-	switch wire {
-	case 0:
-		dAtA = encodeVarintPopulateEndDevice(dAtA, uint64(key))
-		v27 := r.Int63()
-		if r.Intn(2) == 0 {
-			v27 *= -1
-		}
-		dAtA = encodeVarintPopulateEndDevice(dAtA, uint64(v27))
-	case 1:
-		dAtA = encodeVarintPopulateEndDevice(dAtA, uint64(key))
-		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
-	case 2:
-		dAtA = encodeVarintPopulateEndDevice(dAtA, uint64(key))
-		ll := r.Intn(100)
-		dAtA = encodeVarintPopulateEndDevice(dAtA, uint64(ll))
-		for j := 0; j < ll; j++ {
-			dAtA = append(dAtA, byte(r.Intn(256)))
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.EndDeviceIdentifiers.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
-	default:
-		dAtA = encodeVarintPopulateEndDevice(dAtA, uint64(key))
-		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
+		i -= size
+		i = encodeVarintEndDevice(dAtA, i, uint64(size))
 	}
-	return dAtA
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
-func encodeVarintPopulateEndDevice(dAtA []byte, v uint64) []byte {
-	for v >= 1<<7 {
-		dAtA = append(dAtA, uint8(v&0x7f|0x80))
-		v >>= 7
+
+func (m *GetEndDeviceIdentifiersForEUIsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA = append(dAtA, uint8(v))
-	return dAtA
+	return dAtA[:n], nil
 }
-func (m *Session) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *GetEndDeviceIdentifiersForEUIsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetEndDeviceIdentifiersForEUIsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.DevAddr.Size()
-	n += 1 + l + sovEndDevice(uint64(l))
-	l = m.SessionKeys.Size()
-	n += 1 + l + sovEndDevice(uint64(l))
-	if m.LastFCntUp != 0 {
-		n += 1 + sovEndDevice(uint64(m.LastFCntUp))
-	}
-	if m.LastNFCntDown != 0 {
-		n += 1 + sovEndDevice(uint64(m.LastNFCntDown))
-	}
-	if m.LastAFCntDown != 0 {
-		n += 1 + sovEndDevice(uint64(m.LastAFCntDown))
+	{
+		size := m.DevEUI.Size()
+		i -= size
+		if _, err := m.DevEUI.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEndDevice(dAtA, i, uint64(size))
 	}
-	if m.LastConfFCntDown != 0 {
-		n += 1 + sovEndDevice(uint64(m.LastConfFCntDown))
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.JoinEUI.Size()
+		i -= size
+		if _, err := m.JoinEUI.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEndDevice(dAtA, i, uint64(size))
 	}
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartedAt)
-	n += 1 + l + sovEndDevice(uint64(l))
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *MACParameters) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ListEndDevicesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ListEndDevicesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListEndDevicesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.MaxEIRP != 0 {
-		n += 5
-	}
-	if m.ADRDataRateIndex != 0 {
-		n += 1 + sovEndDevice(uint64(m.ADRDataRateIndex))
-	}
-	if m.ADRTxPowerIndex != 0 {
-		n += 1 + sovEndDevice(uint64(m.ADRTxPowerIndex))
-	}
-	if m.ADRNbTrans != 0 {
-		n += 1 + sovEndDevice(uint64(m.ADRNbTrans))
-	}
-	if m.ADRAckLimit != 0 {
-		n += 1 + sovEndDevice(uint64(m.ADRAckLimit))
-	}
-	if m.ADRAckDelay != 0 {
-		n += 1 + sovEndDevice(uint64(m.ADRAckDelay))
-	}
-	if m.Rx1Delay != 0 {
-		n += 1 + sovEndDevice(uint64(m.Rx1Delay))
-	}
-	if m.Rx1DataRateOffset != 0 {
-		n += 1 + sovEndDevice(uint64(m.Rx1DataRateOffset))
-	}
-	if m.Rx2DataRateIndex != 0 {
-		n += 1 + sovEndDevice(uint64(m.Rx2DataRateIndex))
-	}
-	if m.Rx2Frequency != 0 {
-		n += 1 + sovEndDevice(m.Rx2Frequency)
-	}
-	if m.MaxDutyCycle != 0 {
-		n += 1 + sovEndDevice(uint64(m.MaxDutyCycle))
-	}
-	if m.RejoinTimePeriodicity != 0 {
-		n += 1 + sovEndDevice(uint64(m.RejoinTimePeriodicity))
-	}
-	if m.RejoinCountPeriodicity != 0 {
-		n += 1 + sovEndDevice(uint64(m.RejoinCountPeriodicity))
-	}
-	if m.PingSlotFrequency != 0 {
-		n += 2 + sovEndDevice(m.PingSlotFrequency)
+	if m.Page != 0 {
+		i = encodeVarintEndDevice(dAtA, i, uint64(m.Page))
+		i--
+		dAtA[i] = 0x28
 	}
-	if m.PingSlotDataRateIndex != 0 {
-		n += 2 + sovEndDevice(uint64(m.PingSlotDataRateIndex))
+	if m.Limit != 0 {
+		i = encodeVarintEndDevice(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x20
 	}
-	if m.BeaconFrequency != 0 {
-		n += 2 + sovEndDevice(m.BeaconFrequency)
+	if len(m.Order) > 0 {
+		i -= len(m.Order)
+		copy(dAtA[i:], m.Order)
+		i = encodeVarintEndDevice(dAtA, i, uint64(len(m.Order)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if len(m.Channels) > 0 {
-		for _, e := range m.Channels {
-			l = e.Size()
-			n += 2 + l + sovEndDevice(uint64(l))
+	{
+		size, err := m.FieldMask.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintEndDevice(dAtA, i, uint64(size))
 	}
-	if m.UplinkDwellTime != nil {
-		l = m.UplinkDwellTime.Size()
-		n += 2 + l + sovEndDevice(uint64(l))
-	}
-	if m.DownlinkDwellTime != nil {
-		l = m.DownlinkDwellTime.Size()
-		n += 2 + l + sovEndDevice(uint64(l))
-	}
-	if m.ADRAckLimitExponent != nil {
-		l = m.ADRAckLimitExponent.Size()
-		n += 2 + l + sovEndDevice(uint64(l))
-	}
-	if m.ADRAckDelayExponent != nil {
-		l = m.ADRAckDelayExponent.Size()
-		n += 2 + l + sovEndDevice(uint64(l))
-	}
-	if m.PingSlotDataRateIndexValue != nil {
-		l = m.PingSlotDataRateIndexValue.Size()
-		n += 2 + l + sovEndDevice(uint64(l))
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.ApplicationIdentifiers.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEndDevice(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *MACParameters_Channel) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SetEndDeviceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *SetEndDeviceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetEndDeviceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.UplinkFrequency != 0 {
-		n += 1 + sovEndDevice(m.UplinkFrequency)
-	}
-	if m.DownlinkFrequency != 0 {
-		n += 1 + sovEndDevice(m.DownlinkFrequency)
-	}
-	if m.MinDataRateIndex != 0 {
-		n += 1 + sovEndDevice(uint64(m.MinDataRateIndex))
-	}
-	if m.MaxDataRateIndex != 0 {
-		n += 1 + sovEndDevice(uint64(m.MaxDataRateIndex))
+	{
+		size, err := m.FieldMask.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEndDevice(dAtA, i, uint64(size))
 	}
-	if m.EnableUplink {
-		n += 2
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.EndDevice.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEndDevice(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *EndDeviceBrand) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *BatchCreateEndDevicesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchCreateEndDevicesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchCreateEndDevicesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ID)
-	if l > 0 {
-		n += 1 + l + sovEndDevice(uint64(l))
-	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovEndDevice(uint64(l))
-	}
-	l = len(m.URL)
-	if l > 0 {
-		n += 1 + l + sovEndDevice(uint64(l))
+	if len(m.Requests) > 0 {
+		for iNdEx := len(m.Requests) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Requests[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEndDevice(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	if len(m.Logos) > 0 {
-		for _, s := range m.Logos {
-			l = len(s)
-			n += 1 + l + sovEndDevice(uint64(l))
+	{
+		size, err := m.ApplicationIdentifiers.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintEndDevice(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *EndDeviceModel) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *BatchGetEndDevicesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchGetEndDevicesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchGetEndDevicesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.BrandID)
-	if l > 0 {
-		n += 1 + l + sovEndDevice(uint64(l))
-	}
-	l = len(m.ID)
-	if l > 0 {
-		n += 1 + l + sovEndDevice(uint64(l))
+	if len(m.Requests) > 0 {
+		for iNdEx := len(m.Requests) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Requests[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEndDevice(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovEndDevice(uint64(l))
+	{
+		size, err := m.ApplicationIdentifiers.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEndDevice(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *EndDeviceVersionIdentifiers) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *BatchUpdateEndDevicesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchUpdateEndDevicesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchUpdateEndDevicesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.BrandID)
-	if l > 0 {
-		n += 1 + l + sovEndDevice(uint64(l))
-	}
-	l = len(m.ModelID)
-	if l > 0 {
-		n += 1 + l + sovEndDevice(uint64(l))
-	}
-	l = len(m.HardwareVersion)
-	if l > 0 {
-		n += 1 + l + sovEndDevice(uint64(l))
+	if len(m.Requests) > 0 {
+		for iNdEx := len(m.Requests) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Requests[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEndDevice(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	l = len(m.FirmwareVersion)
-	if l > 0 {
-		n += 1 + l + sovEndDevice(uint64(l))
+	{
+		size, err := m.ApplicationIdentifiers.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEndDevice(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *EndDeviceVersion) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *BatchSetEndDevicesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchSetEndDevicesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchSetEndDevicesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.EndDeviceVersionIdentifiers.Size()
-	n += 1 + l + sovEndDevice(uint64(l))
-	if m.LoRaWANVersion != 0 {
-		n += 1 + sovEndDevice(uint64(m.LoRaWANVersion))
-	}
-	if m.LoRaWANPHYVersion != 0 {
-		n += 1 + sovEndDevice(uint64(m.LoRaWANPHYVersion))
-	}
-	l = len(m.FrequencyPlanID)
-	if l > 0 {
-		n += 1 + l + sovEndDevice(uint64(l))
-	}
-	if len(m.Photos) > 0 {
-		for _, s := range m.Photos {
-			l = len(s)
-			n += 1 + l + sovEndDevice(uint64(l))
+	if len(m.Requests) > 0 {
+		for iNdEx := len(m.Requests) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Requests[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEndDevice(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	if m.SupportsClassB {
-		n += 2
-	}
-	if m.SupportsClassC {
-		n += 2
-	}
-	if m.DefaultMACSettings != nil {
-		l = m.DefaultMACSettings.Size()
-		n += 1 + l + sovEndDevice(uint64(l))
-	}
-	if m.MinFrequency != 0 {
-		n += 1 + sovEndDevice(m.MinFrequency)
+	{
+		size, err := m.ApplicationIdentifiers.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEndDevice(dAtA, i, uint64(size))
 	}
-	if m.MaxFrequency != 0 {
-		n += 1 + sovEndDevice(m.MaxFrequency)
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *BatchDeleteEndDevicesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.SupportsJoin {
-		n += 2
+	return dAtA[:n], nil
+}
+
+func (m *BatchDeleteEndDevicesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchDeleteEndDevicesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EndDeviceIDs) > 0 {
+		for iNdEx := len(m.EndDeviceIDs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EndDeviceIDs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEndDevice(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	if m.ResetsJoinNonces {
-		n += 2
+	{
+		size, err := m.ApplicationIdentifiers.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEndDevice(dAtA, i, uint64(size))
 	}
-	l = m.DefaultFormatters.Size()
-	n += 1 + l + sovEndDevice(uint64(l))
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *MACSettings) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *BatchEndDevicesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchEndDevicesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchEndDevicesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ClassBTimeout != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.ClassBTimeout)
-		n += 1 + l + sovEndDevice(uint64(l))
+	if len(m.Results) > 0 {
+		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEndDevice(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	if m.PingSlotPeriodicity != nil {
-		l = m.PingSlotPeriodicity.Size()
-		n += 1 + l + sovEndDevice(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *BatchEndDevicesResponse_Result) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.PingSlotDataRateIndex != nil {
-		l = m.PingSlotDataRateIndex.Size()
-		n += 1 + l + sovEndDevice(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *BatchEndDevicesResponse_Result) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchEndDevicesResponse_Result) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Error != nil {
+		{
+			size, err := m.Error.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEndDevice(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.PingSlotFrequency != nil {
-		l = m.PingSlotFrequency.Size()
-		n += 1 + l + sovEndDevice(uint64(l))
+	if m.EndDevice != nil {
+		{
+			size, err := m.EndDevice.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEndDevice(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.ClassCTimeout != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.ClassCTimeout)
-		n += 1 + l + sovEndDevice(uint64(l))
+	{
+		size, err := m.IDs.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEndDevice(dAtA, i, uint64(size))
 	}
-	if m.Rx1Delay != nil {
-		l = m.Rx1Delay.Size()
-		n += 1 + l + sovEndDevice(uint64(l))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *EndDeviceProfileIdentifiers) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.Rx1DataRateOffset != nil {
-		l = m.Rx1DataRateOffset.Size()
-		n += 1 + l + sovEndDevice(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *EndDeviceProfileIdentifiers) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EndDeviceProfileIdentifiers) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ProfileID) > 0 {
+		i -= len(m.ProfileID)
+		copy(dAtA[i:], m.ProfileID)
+		i = encodeVarintEndDevice(dAtA, i, uint64(len(m.ProfileID)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Rx2DataRateIndex != nil {
-		l = m.Rx2DataRateIndex.Size()
-		n += 1 + l + sovEndDevice(uint64(l))
+	if m.ApplicationIDs != nil {
+		{
+			size, err := m.ApplicationIDs.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEndDevice(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	if m.Rx2Frequency != nil {
-		l = m.Rx2Frequency.Size()
-		n += 1 + l + sovEndDevice(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *EndDeviceProfile) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if len(m.FactoryPresetFrequencies) > 0 {
-		l = 0
-		for _, e := range m.FactoryPresetFrequencies {
-			l += sovEndDevice(e)
-		}
-		n += 1 + sovEndDevice(uint64(l)) + l
+	return dAtA[:n], nil
+}
+
+func (m *EndDeviceProfile) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EndDeviceProfile) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FrequencyPlanID) > 0 {
+		i -= len(m.FrequencyPlanID)
+		copy(dAtA[i:], m.FrequencyPlanID)
+		i = encodeVarintEndDevice(dAtA, i, uint64(len(m.FrequencyPlanID)))
+		i--
+		dAtA[i] = 0x52
 	}
-	if m.MaxDutyCycle != nil {
-		l = m.MaxDutyCycle.Size()
-		n += 1 + l + sovEndDevice(uint64(l))
+	if m.LoRaWANPHYVersion != 0 {
+		i = encodeVarintEndDevice(dAtA, i, uint64(m.LoRaWANPHYVersion))
+		i--
+		dAtA[i] = 0x48
 	}
-	if m.Supports32BitFCnt != nil {
-		l = m.Supports32BitFCnt.Size()
-		n += 1 + l + sovEndDevice(uint64(l))
+	if m.LoRaWANVersion != 0 {
+		i = encodeVarintEndDevice(dAtA, i, uint64(m.LoRaWANVersion))
+		i--
+		dAtA[i] = 0x40
 	}
-	if m.UseADR != nil {
-		l = m.UseADR.Size()
-		n += 1 + l + sovEndDevice(uint64(l))
+	if m.MACSettings != nil {
+		{
+			size, err := m.MACSettings.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEndDevice(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
 	}
-	if m.ADRMargin != nil {
-		l = m.ADRMargin.Size()
-		n += 1 + l + sovEndDevice(uint64(l))
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintEndDevice(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x32
 	}
-	if m.ResetsFCnt != nil {
-		l = m.ResetsFCnt.Size()
-		n += 1 + l + sovEndDevice(uint64(l))
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintEndDevice(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	if m.StatusTimePeriodicity != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.StatusTimePeriodicity)
-		n += 2 + l + sovEndDevice(uint64(l))
+	if m.Version != 0 {
+		i = encodeVarintEndDevice(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x20
 	}
-	if m.StatusCountPeriodicity != nil {
-		l = m.StatusCountPeriodicity.Size()
-		n += 2 + l + sovEndDevice(uint64(l))
+	n92, err92 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.UpdatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.UpdatedAt):])
+	if err92 != nil {
+		return 0, err92
 	}
-	if m.DesiredRx1Delay != nil {
-		l = m.DesiredRx1Delay.Size()
-		n += 2 + l + sovEndDevice(uint64(l))
+	i -= n92
+	i = encodeVarintEndDevice(dAtA, i, uint64(n92))
+	i--
+	dAtA[i] = 0x1a
+	n93, err93 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CreatedAt):])
+	if err93 != nil {
+		return 0, err93
 	}
-	if m.DesiredRx1DataRateOffset != nil {
-		l = m.DesiredRx1DataRateOffset.Size()
-		n += 2 + l + sovEndDevice(uint64(l))
-	}
-	if m.DesiredRx2DataRateIndex != nil {
-		l = m.DesiredRx2DataRateIndex.Size()
-		n += 2 + l + sovEndDevice(uint64(l))
-	}
-	if m.DesiredRx2Frequency != nil {
-		l = m.DesiredRx2Frequency.Size()
-		n += 2 + l + sovEndDevice(uint64(l))
-	}
-	if m.DesiredMaxDutyCycle != nil {
-		l = m.DesiredMaxDutyCycle.Size()
-		n += 2 + l + sovEndDevice(uint64(l))
-	}
-	if m.DesiredADRAckLimitExponent != nil {
-		l = m.DesiredADRAckLimitExponent.Size()
-		n += 2 + l + sovEndDevice(uint64(l))
-	}
-	if m.DesiredADRAckDelayExponent != nil {
-		l = m.DesiredADRAckDelayExponent.Size()
-		n += 2 + l + sovEndDevice(uint64(l))
-	}
-	if m.BeaconFrequency != nil {
-		l = m.BeaconFrequency.Size()
-		n += 2 + l + sovEndDevice(uint64(l))
-	}
-	if m.DesiredPingSlotDataRateIndex != nil {
-		l = m.DesiredPingSlotDataRateIndex.Size()
-		n += 2 + l + sovEndDevice(uint64(l))
-	}
-	if m.DesiredPingSlotFrequency != nil {
-		l = m.DesiredPingSlotFrequency.Size()
-		n += 2 + l + sovEndDevice(uint64(l))
-	}
-	if m.DesiredBeaconFrequency != nil {
-		l = m.DesiredBeaconFrequency.Size()
-		n += 2 + l + sovEndDevice(uint64(l))
+	i -= n93
+	i = encodeVarintEndDevice(dAtA, i, uint64(n93))
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.EndDeviceProfileIdentifiers.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEndDevice(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *MACState) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EndDeviceProfiles) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EndDeviceProfiles) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EndDeviceProfiles) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.CurrentParameters.Size()
-	n += 1 + l + sovEndDevice(uint64(l))
-	l = m.DesiredParameters.Size()
-	n += 1 + l + sovEndDevice(uint64(l))
-	if m.DeviceClass != 0 {
-		n += 1 + sovEndDevice(uint64(m.DeviceClass))
-	}
-	if m.LoRaWANVersion != 0 {
-		n += 1 + sovEndDevice(uint64(m.LoRaWANVersion))
-	}
-	if m.LastConfirmedDownlinkAt != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastConfirmedDownlinkAt)
-		n += 1 + l + sovEndDevice(uint64(l))
-	}
-	if m.LastDevStatusFCntUp != 0 {
-		n += 1 + sovEndDevice(uint64(m.LastDevStatusFCntUp))
-	}
-	if m.PingSlotPeriodicity != nil {
-		l = m.PingSlotPeriodicity.Size()
-		n += 1 + l + sovEndDevice(uint64(l))
-	}
-	if m.PendingApplicationDownlink != nil {
-		l = m.PendingApplicationDownlink.Size()
-		n += 1 + l + sovEndDevice(uint64(l))
-	}
-	if len(m.QueuedResponses) > 0 {
-		for _, e := range m.QueuedResponses {
-			l = e.Size()
-			n += 1 + l + sovEndDevice(uint64(l))
-		}
-	}
-	if len(m.PendingRequests) > 0 {
-		for _, e := range m.PendingRequests {
-			l = e.Size()
-			n += 1 + l + sovEndDevice(uint64(l))
+	if len(m.Profiles) > 0 {
+		for iNdEx := len(m.Profiles) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Profiles[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEndDevice(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	if m.QueuedJoinAccept != nil {
-		l = m.QueuedJoinAccept.Size()
-		n += 1 + l + sovEndDevice(uint64(l))
-	}
-	if m.PendingJoinRequest != nil {
-		l = m.PendingJoinRequest.Size()
-		n += 1 + l + sovEndDevice(uint64(l))
-	}
-	if m.RxWindowsAvailable {
-		n += 2
+	return len(dAtA) - i, nil
+}
+
+func (m *GetEndDeviceProfileRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if len(m.RecentUplinks) > 0 {
-		for _, e := range m.RecentUplinks {
-			l = e.Size()
-			n += 1 + l + sovEndDevice(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *GetEndDeviceProfileRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetEndDeviceProfileRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.FieldMask.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintEndDevice(dAtA, i, uint64(size))
 	}
-	if len(m.RecentDownlinks) > 0 {
-		for _, e := range m.RecentDownlinks {
-			l = e.Size()
-			n += 1 + l + sovEndDevice(uint64(l))
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.EndDeviceProfileIdentifiers.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintEndDevice(dAtA, i, uint64(size))
 	}
-	if m.LastNetworkInitiatedDownlinkAt != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastNetworkInitiatedDownlinkAt)
-		n += 2 + l + sovEndDevice(uint64(l))
-	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *MACState_JoinAccept) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Payload)
-	if l > 0 {
-		n += 1 + l + sovEndDevice(uint64(l))
+func (m *ListEndDeviceProfilesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = m.Request.Size()
-	n += 1 + l + sovEndDevice(uint64(l))
-	l = m.Keys.Size()
-	n += 1 + l + sovEndDevice(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *EndDeviceAuthenticationCode) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *ListEndDeviceProfilesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListEndDeviceProfilesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Value)
-	if l > 0 {
-		n += 1 + l + sovEndDevice(uint64(l))
+	if m.Page != 0 {
+		i = encodeVarintEndDevice(dAtA, i, uint64(m.Page))
+		i--
+		dAtA[i] = 0x20
 	}
-	if m.ValidFrom != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.ValidFrom)
-		n += 1 + l + sovEndDevice(uint64(l))
+	if m.Limit != 0 {
+		i = encodeVarintEndDevice(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.ValidTo != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.ValidTo)
-		n += 1 + l + sovEndDevice(uint64(l))
+	{
+		size, err := m.FieldMask.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEndDevice(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0x12
+	if m.ApplicationIDs != nil {
+		{
+			size, err := m.ApplicationIDs.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEndDevice(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *EndDevice) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SetEndDeviceProfileRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *SetEndDeviceProfileRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetEndDeviceProfileRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.EndDeviceIdentifiers.Size()
-	n += 1 + l + sovEndDevice(uint64(l))
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.CreatedAt)
-	n += 1 + l + sovEndDevice(uint64(l))
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.UpdatedAt)
-	n += 1 + l + sovEndDevice(uint64(l))
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovEndDevice(uint64(l))
-	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovEndDevice(uint64(l))
+	{
+		size, err := m.FieldMask.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEndDevice(dAtA, i, uint64(size))
 	}
-	if len(m.Attributes) > 0 {
-		for k, v := range m.Attributes {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovEndDevice(uint64(len(k))) + 1 + len(v) + sovEndDevice(uint64(len(v)))
-			n += mapEntrySize + 1 + sovEndDevice(uint64(mapEntrySize))
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Profile.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintEndDevice(dAtA, i, uint64(size))
 	}
-	if m.VersionIDs != nil {
-		l = m.VersionIDs.Size()
-		n += 1 + l + sovEndDevice(uint64(l))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *EndDeviceTemplate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.ServiceProfileID)
-	if l > 0 {
-		n += 1 + l + sovEndDevice(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *EndDeviceTemplate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EndDeviceTemplate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MappingKey) > 0 {
+		i -= len(m.MappingKey)
+		copy(dAtA[i:], m.MappingKey)
+		i = encodeVarintEndDevice(dAtA, i, uint64(len(m.MappingKey)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.NetworkServerAddress)
-	if l > 0 {
-		n += 1 + l + sovEndDevice(uint64(l))
+	{
+		size, err := m.FieldMask.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEndDevice(dAtA, i, uint64(size))
 	}
-	l = len(m.ApplicationServerAddress)
-	if l > 0 {
-		n += 1 + l + sovEndDevice(uint64(l))
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.EndDevice.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEndDevice(dAtA, i, uint64(size))
 	}
-	l = len(m.JoinServerAddress)
-	if l > 0 {
-		n += 1 + l + sovEndDevice(uint64(l))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *EndDeviceTemplateFormat) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Locations) > 0 {
-		for k, v := range m.Locations {
-			_ = k
-			_ = v
-			l = 0
-			if v != nil {
-				l = v.Size()
-				l += 1 + sovEndDevice(uint64(l))
-			}
-			mapEntrySize := 1 + len(k) + sovEndDevice(uint64(len(k))) + l
-			n += mapEntrySize + 1 + sovEndDevice(uint64(mapEntrySize))
+	return dAtA[:n], nil
+}
+
+func (m *EndDeviceTemplateFormat) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EndDeviceTemplateFormat) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FileExtensions) > 0 {
+		for iNdEx := len(m.FileExtensions) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.FileExtensions[iNdEx])
+			copy(dAtA[i:], m.FileExtensions[iNdEx])
+			i = encodeVarintEndDevice(dAtA, i, uint64(len(m.FileExtensions[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
 		}
 	}
-	if m.SupportsClassB {
-		n += 2
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintEndDevice(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.SupportsClassC {
-		n += 2
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintEndDevice(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
 	}
-	if m.LoRaWANVersion != 0 {
-		n += 1 + sovEndDevice(uint64(m.LoRaWANVersion))
+	return len(dAtA) - i, nil
+}
+
+func (m *EndDeviceTemplateFormats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.LoRaWANPHYVersion != 0 {
-		n += 2 + sovEndDevice(uint64(m.LoRaWANPHYVersion))
+	return dAtA[:n], nil
+}
+
+func (m *EndDeviceTemplateFormats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EndDeviceTemplateFormats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Formats) > 0 {
+		for k := range m.Formats {
+			v := m.Formats[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintEndDevice(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintEndDevice(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintEndDevice(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	l = len(m.FrequencyPlanID)
-	if l > 0 {
-		n += 2 + l + sovEndDevice(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *ConvertEndDeviceTemplateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.MinFrequency != 0 {
-		n += 2 + sovEndDevice(m.MinFrequency)
+	return dAtA[:n], nil
+}
+
+func (m *ConvertEndDeviceTemplateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConvertEndDeviceTemplateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CSVOptions != nil {
+		{
+			size, err := m.CSVOptions.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEndDevice(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.MaxFrequency != 0 {
-		n += 2 + sovEndDevice(m.MaxFrequency)
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintEndDevice(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.SupportsJoin {
-		n += 3
+	if len(m.FormatID) > 0 {
+		i -= len(m.FormatID)
+		copy(dAtA[i:], m.FormatID)
+		i = encodeVarintEndDevice(dAtA, i, uint64(len(m.FormatID)))
+		i--
+		dAtA[i] = 0xa
 	}
-	if m.ResetsJoinNonces {
-		n += 3
+	return len(dAtA) - i, nil
+}
+
+func (m *ConvertEndDeviceTemplateRequest_CSVOptions) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.RootKeys != nil {
-		l = m.RootKeys.Size()
-		n += 2 + l + sovEndDevice(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *ConvertEndDeviceTemplateRequest_CSVOptions) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConvertEndDeviceTemplateRequest_CSVOptions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Base64 {
+		i--
+		if m.Base64 {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.NetID != nil {
-		l = m.NetID.Size()
-		n += 2 + l + sovEndDevice(uint64(l))
+	if len(m.Separator) > 0 {
+		i -= len(m.Separator)
+		copy(dAtA[i:], m.Separator)
+		i = encodeVarintEndDevice(dAtA, i, uint64(len(m.Separator)))
+		i--
+		dAtA[i] = 0xa
 	}
-	if m.MACSettings != nil {
-		l = m.MACSettings.Size()
-		n += 2 + l + sovEndDevice(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEndDevice(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEndDevice(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
 	}
-	if m.MACState != nil {
-		l = m.MACState.Size()
-		n += 2 + l + sovEndDevice(uint64(l))
+	dAtA[offset] = uint8(v)
+	return base
+}
+func NewPopulatedSession(r randyEndDevice, easy bool) *Session {
+	this := &Session{}
+	v1 := go_thethings_network_lorawan_stack_pkg_types.NewPopulatedDevAddr(r)
+	this.DevAddr = *v1
+	v2 := NewPopulatedSessionKeys(r, easy)
+	this.SessionKeys = *v2
+	this.LastFCntUp = r.Uint32()
+	this.LastNFCntDown = r.Uint32()
+	this.LastAFCntDown = r.Uint32()
+	this.LastConfFCntDown = r.Uint32()
+	v3 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	this.StartedAt = *v3
+	if !easy && r.Intn(10) != 0 {
 	}
-	if m.Session != nil {
-		l = m.Session.Size()
-		n += 2 + l + sovEndDevice(uint64(l))
+	return this
+}
+
+func NewPopulatedEndDeviceBrand(r randyEndDevice, easy bool) *EndDeviceBrand {
+	this := &EndDeviceBrand{}
+	this.ID = randStringEndDevice(r)
+	this.Name = randStringEndDevice(r)
+	this.URL = randStringEndDevice(r)
+	v4 := r.Intn(10)
+	this.Logos = make([]string, v4)
+	for i := 0; i < v4; i++ {
+		this.Logos[i] = randStringEndDevice(r)
 	}
-	if m.PendingSession != nil {
-		l = m.PendingSession.Size()
-		n += 2 + l + sovEndDevice(uint64(l))
+	if !easy && r.Intn(10) != 0 {
 	}
-	if m.LastDevNonce != 0 {
-		n += 2 + sovEndDevice(uint64(m.LastDevNonce))
+	return this
+}
+
+func NewPopulatedEndDeviceModel(r randyEndDevice, easy bool) *EndDeviceModel {
+	this := &EndDeviceModel{}
+	this.BrandID = randStringEndDevice(r)
+	this.ID = randStringEndDevice(r)
+	this.Name = randStringEndDevice(r)
+	if !easy && r.Intn(10) != 0 {
 	}
-	if len(m.UsedDevNonces) > 0 {
-		l = 0
-		for _, e := range m.UsedDevNonces {
-			l += sovEndDevice(uint64(e))
-		}
-		n += 2 + sovEndDevice(uint64(l)) + l
+	return this
+}
+
+func NewPopulatedEndDeviceVersionIdentifiers(r randyEndDevice, easy bool) *EndDeviceVersionIdentifiers {
+	this := &EndDeviceVersionIdentifiers{}
+	this.BrandID = randStringEndDevice(r)
+	this.ModelID = randStringEndDevice(r)
+	this.HardwareVersion = randStringEndDevice(r)
+	this.FirmwareVersion = randStringEndDevice(r)
+	if !easy && r.Intn(10) != 0 {
 	}
-	if m.LastJoinNonce != 0 {
-		n += 2 + sovEndDevice(uint64(m.LastJoinNonce))
+	return this
+}
+
+func NewPopulatedMACSettings(r randyEndDevice, easy bool) *MACSettings {
+	this := &MACSettings{}
+	if r.Intn(5) != 0 {
+		this.ClassBTimeout = github_com_gogo_protobuf_types.NewPopulatedStdDuration(r, easy)
 	}
-	if m.LastRJCount0 != 0 {
-		n += 2 + sovEndDevice(uint64(m.LastRJCount0))
+	if r.Intn(5) != 0 {
+		this.PingSlotPeriodicity = NewPopulatedPingSlotPeriodValue(r, easy)
 	}
-	if m.LastRJCount1 != 0 {
-		n += 2 + sovEndDevice(uint64(m.LastRJCount1))
+	if r.Intn(5) != 0 {
+		this.PingSlotDataRateIndex = NewPopulatedDataRateIndexValue(r, easy)
 	}
-	if m.LastDevStatusReceivedAt != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastDevStatusReceivedAt)
-		n += 2 + l + sovEndDevice(uint64(l))
+	if r.Intn(5) != 0 {
+		this.PingSlotFrequency = types.NewPopulatedUInt64Value(r, easy)
 	}
-	if m.PowerState != 0 {
-		n += 2 + sovEndDevice(uint64(m.PowerState))
+	if r.Intn(5) != 0 {
+		this.ClassCTimeout = github_com_gogo_protobuf_types.NewPopulatedStdDuration(r, easy)
 	}
-	if m.BatteryPercentage != nil {
-		l = m.BatteryPercentage.Size()
-		n += 2 + l + sovEndDevice(uint64(l))
+	if r.Intn(5) != 0 {
+		this.Rx1Delay = NewPopulatedRxDelayValue(r, easy)
 	}
-	if m.DownlinkMargin != 0 {
-		n += 2 + sovEndDevice(uint64(m.DownlinkMargin))
+	if r.Intn(5) != 0 {
+		this.Rx1DataRateOffset = types.NewPopulatedUInt32Value(r, easy)
 	}
-	if len(m.RecentADRUplinks) > 0 {
-		for _, e := range m.RecentADRUplinks {
-			l = e.Size()
-			n += 2 + l + sovEndDevice(uint64(l))
-		}
+	if r.Intn(5) != 0 {
+		this.Rx2DataRateIndex = NewPopulatedDataRateIndexValue(r, easy)
 	}
-	if len(m.RecentUplinks) > 0 {
-		for _, e := range m.RecentUplinks {
-			l = e.Size()
-			n += 2 + l + sovEndDevice(uint64(l))
-		}
+	if r.Intn(5) != 0 {
+		this.Rx2Frequency = types.NewPopulatedUInt64Value(r, easy)
 	}
-	if len(m.RecentDownlinks) > 0 {
-		for _, e := range m.RecentDownlinks {
-			l = e.Size()
-			n += 2 + l + sovEndDevice(uint64(l))
-		}
+	v5 := r.Intn(10)
+	this.FactoryPresetFrequencies = make([]uint64, v5)
+	for i := 0; i < v5; i++ {
+		this.FactoryPresetFrequencies[i] = uint64(r.Uint32())
 	}
-	if len(m.QueuedApplicationDownlinks) > 0 {
-		for _, e := range m.QueuedApplicationDownlinks {
-			l = e.Size()
-			n += 2 + l + sovEndDevice(uint64(l))
-		}
+	if r.Intn(5) != 0 {
+		this.MaxDutyCycle = NewPopulatedAggregatedDutyCycleValue(r, easy)
 	}
-	if m.Formatters != nil {
-		l = m.Formatters.Size()
-		n += 2 + l + sovEndDevice(uint64(l))
+	if r.Intn(5) != 0 {
+		this.Supports32BitFCnt = types.NewPopulatedBoolValue(r, easy)
 	}
-	l = len(m.ProvisionerID)
-	if l > 0 {
-		n += 2 + l + sovEndDevice(uint64(l))
+	if r.Intn(5) != 0 {
+		this.UseADR = types.NewPopulatedBoolValue(r, easy)
 	}
-	if m.ProvisioningData != nil {
-		l = m.ProvisioningData.Size()
-		n += 2 + l + sovEndDevice(uint64(l))
+	if r.Intn(5) != 0 {
+		this.ADRMargin = types.NewPopulatedFloatValue(r, easy)
 	}
-	if m.PendingMACState != nil {
-		l = m.PendingMACState.Size()
-		n += 2 + l + sovEndDevice(uint64(l))
+	if r.Intn(5) != 0 {
+		this.ResetsFCnt = types.NewPopulatedBoolValue(r, easy)
 	}
-	if m.Multicast {
-		n += 3
+	if r.Intn(5) != 0 {
+		this.StatusTimePeriodicity = github_com_gogo_protobuf_types.NewPopulatedStdDuration(r, easy)
 	}
-	if m.ClaimAuthenticationCode != nil {
-		l = m.ClaimAuthenticationCode.Size()
-		n += 2 + l + sovEndDevice(uint64(l))
+	if r.Intn(5) != 0 {
+		this.StatusCountPeriodicity = types.NewPopulatedUInt32Value(r, easy)
 	}
-	l = len(m.NetworkServerKEKLabel)
-	if l > 0 {
-		n += 2 + l + sovEndDevice(uint64(l))
+	if r.Intn(5) != 0 {
+		this.DesiredRx1Delay = NewPopulatedRxDelayValue(r, easy)
 	}
-	l = len(m.ApplicationServerKEKLabel)
-	if l > 0 {
-		n += 2 + l + sovEndDevice(uint64(l))
+	if r.Intn(5) != 0 {
+		this.DesiredRx1DataRateOffset = types.NewPopulatedUInt32Value(r, easy)
 	}
-	l = len(m.ApplicationServerID)
-	if l > 0 {
-		n += 2 + l + sovEndDevice(uint64(l))
+	if r.Intn(5) != 0 {
+		this.DesiredRx2DataRateIndex = NewPopulatedDataRateIndexValue(r, easy)
 	}
-	if m.Picture != nil {
-		l = m.Picture.Size()
-		n += 2 + l + sovEndDevice(uint64(l))
+	if r.Intn(5) != 0 {
+		this.DesiredRx2Frequency = types.NewPopulatedUInt64Value(r, easy)
 	}
-	if m.SkipPayloadCrypto {
-		n += 3
+	if r.Intn(5) != 0 {
+		this.DesiredMaxDutyCycle = NewPopulatedAggregatedDutyCycleValue(r, easy)
 	}
-	return n
-}
-
-func (m *EndDevices) Size() (n int) {
-	if m == nil {
-		return 0
+	if r.Intn(5) != 0 {
+		this.DesiredADRAckLimitExponent = NewPopulatedADRAckLimitExponentValue(r, easy)
 	}
-	var l int
-	_ = l
-	if len(m.EndDevices) > 0 {
-		for _, e := range m.EndDevices {
-			l = e.Size()
-			n += 1 + l + sovEndDevice(uint64(l))
-		}
+	if r.Intn(5) != 0 {
+		this.DesiredADRAckDelayExponent = NewPopulatedADRAckDelayExponentValue(r, easy)
 	}
-	return n
-}
-
-func (m *CreateEndDeviceRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if r.Intn(5) != 0 {
+		this.BeaconFrequency = types.NewPopulatedUInt64Value(r, easy)
 	}
-	var l int
-	_ = l
-	l = m.EndDevice.Size()
-	n += 1 + l + sovEndDevice(uint64(l))
-	return n
+	if r.Intn(5) != 0 {
+		this.DesiredPingSlotDataRateIndex = NewPopulatedDataRateIndexValue(r, easy)
+	}
+	if r.Intn(5) != 0 {
+		this.DesiredPingSlotFrequency = types.NewPopulatedUInt64Value(r, easy)
+	}
+	if r.Intn(5) != 0 {
+		this.DesiredBeaconFrequency = types.NewPopulatedUInt64Value(r, easy)
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
 }
 
-func (m *UpdateEndDeviceRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func NewPopulatedMACState_JoinAccept(r randyEndDevice, easy bool) *MACState_JoinAccept {
+	this := &MACState_JoinAccept{}
+	v6 := r.Intn(100)
+	this.Payload = make([]byte, v6)
+	for i := 0; i < v6; i++ {
+		this.Payload[i] = byte(r.Intn(256))
 	}
-	var l int
-	_ = l
-	l = m.EndDevice.Size()
-	n += 1 + l + sovEndDevice(uint64(l))
-	l = m.FieldMask.Size()
-	n += 1 + l + sovEndDevice(uint64(l))
-	return n
+	v7 := NewPopulatedJoinRequest(r, easy)
+	this.Request = *v7
+	v8 := NewPopulatedSessionKeys(r, easy)
+	this.Keys = *v8
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
 }
 
-func (m *GetEndDeviceRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func NewPopulatedEndDevices(r randyEndDevice, easy bool) *EndDevices {
+	this := &EndDevices{}
+	if r.Intn(5) != 0 {
+		v9 := r.Intn(5)
+		this.EndDevices = make([]*EndDevice, v9)
+		for i := 0; i < v9; i++ {
+			this.EndDevices[i] = NewPopulatedEndDevice(r, easy)
+		}
 	}
-	var l int
-	_ = l
-	l = m.EndDeviceIdentifiers.Size()
-	n += 1 + l + sovEndDevice(uint64(l))
-	l = m.FieldMask.Size()
-	n += 1 + l + sovEndDevice(uint64(l))
-	return n
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
 }
 
-func (m *GetEndDeviceIdentifiersForEUIsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func NewPopulatedCreateEndDeviceRequest(r randyEndDevice, easy bool) *CreateEndDeviceRequest {
+	this := &CreateEndDeviceRequest{}
+	v10 := NewPopulatedEndDevice(r, easy)
+	this.EndDevice = *v10
+	if !easy && r.Intn(10) != 0 {
 	}
-	var l int
-	_ = l
-	l = m.JoinEUI.Size()
-	n += 1 + l + sovEndDevice(uint64(l))
-	l = m.DevEUI.Size()
-	n += 1 + l + sovEndDevice(uint64(l))
-	return n
+	return this
 }
 
-func (m *ListEndDevicesRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func NewPopulatedUpdateEndDeviceRequest(r randyEndDevice, easy bool) *UpdateEndDeviceRequest {
+	this := &UpdateEndDeviceRequest{}
+	v11 := NewPopulatedEndDevice(r, easy)
+	this.EndDevice = *v11
+	v12 := types.NewPopulatedFieldMask(r, easy)
+	this.FieldMask = *v12
+	if !easy && r.Intn(10) != 0 {
 	}
-	var l int
-	_ = l
-	l = m.ApplicationIdentifiers.Size()
-	n += 1 + l + sovEndDevice(uint64(l))
-	l = m.FieldMask.Size()
-	n += 1 + l + sovEndDevice(uint64(l))
-	l = len(m.Order)
-	if l > 0 {
-		n += 1 + l + sovEndDevice(uint64(l))
+	return this
+}
+
+func NewPopulatedGetEndDeviceRequest(r randyEndDevice, easy bool) *GetEndDeviceRequest {
+	this := &GetEndDeviceRequest{}
+	v13 := NewPopulatedEndDeviceIdentifiers(r, easy)
+	this.EndDeviceIdentifiers = *v13
+	v14 := types.NewPopulatedFieldMask(r, easy)
+	this.FieldMask = *v14
+	if !easy && r.Intn(10) != 0 {
 	}
-	if m.Limit != 0 {
-		n += 1 + sovEndDevice(uint64(m.Limit))
+	return this
+}
+
+func NewPopulatedGetEndDeviceIdentifiersForEUIsRequest(r randyEndDevice, easy bool) *GetEndDeviceIdentifiersForEUIsRequest {
+	this := &GetEndDeviceIdentifiersForEUIsRequest{}
+	v15 := go_thethings_network_lorawan_stack_pkg_types.NewPopulatedEUI64(r)
+	this.JoinEUI = *v15
+	v16 := go_thethings_network_lorawan_stack_pkg_types.NewPopulatedEUI64(r)
+	this.DevEUI = *v16
+	if !easy && r.Intn(10) != 0 {
 	}
-	if m.Page != 0 {
-		n += 1 + sovEndDevice(uint64(m.Page))
+	return this
+}
+
+func NewPopulatedListEndDevicesRequest(r randyEndDevice, easy bool) *ListEndDevicesRequest {
+	this := &ListEndDevicesRequest{}
+	v17 := NewPopulatedApplicationIdentifiers(r, easy)
+	this.ApplicationIdentifiers = *v17
+	v18 := types.NewPopulatedFieldMask(r, easy)
+	this.FieldMask = *v18
+	this.Order = randStringEndDevice(r)
+	this.Limit = r.Uint32()
+	this.Page = r.Uint32()
+	if !easy && r.Intn(10) != 0 {
 	}
-	return n
+	return this
 }
 
-func (m *SetEndDeviceRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func NewPopulatedSetEndDeviceRequest(r randyEndDevice, easy bool) *SetEndDeviceRequest {
+	this := &SetEndDeviceRequest{}
+	v19 := NewPopulatedEndDevice(r, easy)
+	this.EndDevice = *v19
+	v20 := types.NewPopulatedFieldMask(r, easy)
+	this.FieldMask = *v20
+	if !easy && r.Intn(10) != 0 {
 	}
-	var l int
-	_ = l
-	l = m.EndDevice.Size()
-	n += 1 + l + sovEndDevice(uint64(l))
-	l = m.FieldMask.Size()
-	n += 1 + l + sovEndDevice(uint64(l))
-	return n
+	return this
 }
 
-func (m *EndDeviceTemplate) Size() (n int) {
-	if m == nil {
-		return 0
+func NewPopulatedBatchCreateEndDevicesRequest(r randyEndDevice, easy bool) *BatchCreateEndDevicesRequest {
+	this := &BatchCreateEndDevicesRequest{}
+	v21 := NewPopulatedApplicationIdentifiers(r, easy)
+	this.ApplicationIdentifiers = *v21
+	if r.Intn(5) != 0 {
+		v22 := r.Intn(5)
+		this.Requests = make([]*CreateEndDeviceRequest, v22)
+		for i := 0; i < v22; i++ {
+			this.Requests[i] = NewPopulatedCreateEndDeviceRequest(r, easy)
+		}
 	}
-	var l int
-	_ = l
-	l = m.EndDevice.Size()
-	n += 1 + l + sovEndDevice(uint64(l))
-	l = m.FieldMask.Size()
-	n += 1 + l + sovEndDevice(uint64(l))
-	l = len(m.MappingKey)
-	if l > 0 {
-		n += 1 + l + sovEndDevice(uint64(l))
+	if !easy && r.Intn(10) != 0 {
 	}
-	return n
+	return this
 }
 
-func (m *EndDeviceTemplateFormat) Size() (n int) {
-	if m == nil {
-		return 0
+func NewPopulatedBatchGetEndDevicesRequest(r randyEndDevice, easy bool) *BatchGetEndDevicesRequest {
+	this := &BatchGetEndDevicesRequest{}
+	v23 := NewPopulatedApplicationIdentifiers(r, easy)
+	this.ApplicationIdentifiers = *v23
+	if r.Intn(5) != 0 {
+		v24 := r.Intn(5)
+		this.Requests = make([]*GetEndDeviceRequest, v24)
+		for i := 0; i < v24; i++ {
+			this.Requests[i] = NewPopulatedGetEndDeviceRequest(r, easy)
+		}
 	}
-	var l int
-	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovEndDevice(uint64(l))
+	if !easy && r.Intn(10) != 0 {
 	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovEndDevice(uint64(l))
+	return this
+}
+
+func NewPopulatedBatchUpdateEndDevicesRequest(r randyEndDevice, easy bool) *BatchUpdateEndDevicesRequest {
+	this := &BatchUpdateEndDevicesRequest{}
+	v25 := NewPopulatedApplicationIdentifiers(r, easy)
+	this.ApplicationIdentifiers = *v25
+	if r.Intn(5) != 0 {
+		v26 := r.Intn(5)
+		this.Requests = make([]*UpdateEndDeviceRequest, v26)
+		for i := 0; i < v26; i++ {
+			this.Requests[i] = NewPopulatedUpdateEndDeviceRequest(r, easy)
+		}
 	}
-	if len(m.FileExtensions) > 0 {
-		for _, s := range m.FileExtensions {
-			l = len(s)
-			n += 1 + l + sovEndDevice(uint64(l))
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedBatchSetEndDevicesRequest(r randyEndDevice, easy bool) *BatchSetEndDevicesRequest {
+	this := &BatchSetEndDevicesRequest{}
+	v27 := NewPopulatedApplicationIdentifiers(r, easy)
+	this.ApplicationIdentifiers = *v27
+	if r.Intn(5) != 0 {
+		v28 := r.Intn(5)
+		this.Requests = make([]*SetEndDeviceRequest, v28)
+		for i := 0; i < v28; i++ {
+			this.Requests[i] = NewPopulatedSetEndDeviceRequest(r, easy)
 		}
 	}
-	return n
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
 }
 
-func (m *EndDeviceTemplateFormats) Size() (n int) {
-	if m == nil {
-		return 0
+func NewPopulatedBatchDeleteEndDevicesRequest(r randyEndDevice, easy bool) *BatchDeleteEndDevicesRequest {
+	this := &BatchDeleteEndDevicesRequest{}
+	v29 := NewPopulatedApplicationIdentifiers(r, easy)
+	this.ApplicationIdentifiers = *v29
+	if r.Intn(5) != 0 {
+		v30 := r.Intn(5)
+		this.EndDeviceIDs = make([]*EndDeviceIdentifiers, v30)
+		for i := 0; i < v30; i++ {
+			this.EndDeviceIDs[i] = NewPopulatedEndDeviceIdentifiers(r, easy)
+		}
 	}
-	var l int
-	_ = l
-	if len(m.Formats) > 0 {
-		for k, v := range m.Formats {
-			_ = k
-			_ = v
-			l = 0
-			if v != nil {
-				l = v.Size()
-				l += 1 + sovEndDevice(uint64(l))
-			}
-			mapEntrySize := 1 + len(k) + sovEndDevice(uint64(len(k))) + l
-			n += mapEntrySize + 1 + sovEndDevice(uint64(mapEntrySize))
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedBatchEndDevicesResponse(r randyEndDevice, easy bool) *BatchEndDevicesResponse {
+	this := &BatchEndDevicesResponse{}
+	if r.Intn(5) == 0 {
+		v31 := r.Intn(5)
+		this.Results = make([]BatchEndDevicesResponse_Result, v31)
+		for i := 0; i < v31; i++ {
+			v32 := NewPopulatedBatchEndDevicesResponse_Result(r, easy)
+			this.Results[i] = *v32
 		}
 	}
-	return n
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
 }
 
-func (m *ConvertEndDeviceTemplateRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func NewPopulatedBatchEndDevicesResponse_Result(r randyEndDevice, easy bool) *BatchEndDevicesResponse_Result {
+	this := &BatchEndDevicesResponse_Result{}
+	v33 := NewPopulatedEndDeviceIdentifiers(r, easy)
+	this.IDs = *v33
+	if r.Intn(5) != 0 {
+		this.EndDevice = NewPopulatedEndDevice(r, easy)
 	}
-	var l int
-	_ = l
-	l = len(m.FormatID)
-	if l > 0 {
-		n += 1 + l + sovEndDevice(uint64(l))
+	if r.Intn(5) == 0 {
+		this.Error = NewPopulatedErrorDetails(r, easy)
 	}
-	l = len(m.Data)
-	if l > 0 {
-		n += 1 + l + sovEndDevice(uint64(l))
+	if !easy && r.Intn(10) != 0 {
 	}
-	return n
+	return this
 }
 
-func sovEndDevice(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozEndDevice(x uint64) (n int) {
-	return sovEndDevice((x << 1) ^ uint64((int64(x) >> 63)))
-}
-func (this *Session) String() string {
-	if this == nil {
-		return "nil"
+func NewPopulatedEndDeviceProfiles(r randyEndDevice, easy bool) *EndDeviceProfiles {
+	this := &EndDeviceProfiles{}
+	if r.Intn(5) != 0 {
+		v34 := r.Intn(5)
+		this.Profiles = make([]*EndDeviceProfile, v34)
+		for i := 0; i < v34; i++ {
+			this.Profiles[i] = NewPopulatedEndDeviceProfile(r, easy)
+		}
 	}
-	s := strings.Join([]string{`&Session{`,
-		`DevAddr:` + fmt.Sprintf("%v", this.DevAddr) + `,`,
-		`SessionKeys:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.SessionKeys), "SessionKeys", "SessionKeys", 1), `&`, ``, 1) + `,`,
-		`LastFCntUp:` + fmt.Sprintf("%v", this.LastFCntUp) + `,`,
-		`LastNFCntDown:` + fmt.Sprintf("%v", this.LastNFCntDown) + `,`,
-		`LastAFCntDown:` + fmt.Sprintf("%v", this.LastAFCntDown) + `,`,
-		`LastConfFCntDown:` + fmt.Sprintf("%v", this.LastConfFCntDown) + `,`,
-		`StartedAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.StartedAt), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *MACParameters) String() string {
-	if this == nil {
-		return "nil"
-	}
-	repeatedStringForChannels := "[]*MACParameters_Channel{"
-	for _, f := range this.Channels {
-		repeatedStringForChannels += strings.Replace(fmt.Sprintf("%v", f), "MACParameters_Channel", "MACParameters_Channel", 1) + ","
+	if !easy && r.Intn(10) != 0 {
 	}
-	repeatedStringForChannels += "}"
-	s := strings.Join([]string{`&MACParameters{`,
-		`MaxEIRP:` + fmt.Sprintf("%v", this.MaxEIRP) + `,`,
-		`ADRDataRateIndex:` + fmt.Sprintf("%v", this.ADRDataRateIndex) + `,`,
-		`ADRTxPowerIndex:` + fmt.Sprintf("%v", this.ADRTxPowerIndex) + `,`,
-		`ADRNbTrans:` + fmt.Sprintf("%v", this.ADRNbTrans) + `,`,
-		`ADRAckLimit:` + fmt.Sprintf("%v", this.ADRAckLimit) + `,`,
-		`ADRAckDelay:` + fmt.Sprintf("%v", this.ADRAckDelay) + `,`,
-		`Rx1Delay:` + fmt.Sprintf("%v", this.Rx1Delay) + `,`,
-		`Rx1DataRateOffset:` + fmt.Sprintf("%v", this.Rx1DataRateOffset) + `,`,
-		`Rx2DataRateIndex:` + fmt.Sprintf("%v", this.Rx2DataRateIndex) + `,`,
-		`Rx2Frequency:` + fmt.Sprintf("%v", this.Rx2Frequency) + `,`,
-		`MaxDutyCycle:` + fmt.Sprintf("%v", this.MaxDutyCycle) + `,`,
-		`RejoinTimePeriodicity:` + fmt.Sprintf("%v", this.RejoinTimePeriodicity) + `,`,
-		`RejoinCountPeriodicity:` + fmt.Sprintf("%v", this.RejoinCountPeriodicity) + `,`,
-		`PingSlotFrequency:` + fmt.Sprintf("%v", this.PingSlotFrequency) + `,`,
-		`PingSlotDataRateIndex:` + fmt.Sprintf("%v", this.PingSlotDataRateIndex) + `,`,
-		`BeaconFrequency:` + fmt.Sprintf("%v", this.BeaconFrequency) + `,`,
-		`Channels:` + repeatedStringForChannels + `,`,
-		`UplinkDwellTime:` + strings.Replace(fmt.Sprintf("%v", this.UplinkDwellTime), "BoolValue", "types.BoolValue", 1) + `,`,
-		`DownlinkDwellTime:` + strings.Replace(fmt.Sprintf("%v", this.DownlinkDwellTime), "BoolValue", "types.BoolValue", 1) + `,`,
-		`ADRAckLimitExponent:` + strings.Replace(fmt.Sprintf("%v", this.ADRAckLimitExponent), "ADRAckLimitExponentValue", "ADRAckLimitExponentValue", 1) + `,`,
-		`ADRAckDelayExponent:` + strings.Replace(fmt.Sprintf("%v", this.ADRAckDelayExponent), "ADRAckDelayExponentValue", "ADRAckDelayExponentValue", 1) + `,`,
-		`PingSlotDataRateIndexValue:` + strings.Replace(fmt.Sprintf("%v", this.PingSlotDataRateIndexValue), "DataRateIndexValue", "DataRateIndexValue", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return this
 }
-func (this *MACParameters_Channel) String() string {
-	if this == nil {
-		return "nil"
+
+func NewPopulatedGetEndDeviceProfileRequest(r randyEndDevice, easy bool) *GetEndDeviceProfileRequest {
+	this := &GetEndDeviceProfileRequest{}
+	v35 := NewPopulatedEndDeviceProfileIdentifiers(r, easy)
+	this.EndDeviceProfileIdentifiers = *v35
+	v36 := types.NewPopulatedFieldMask(r, easy)
+	this.FieldMask = *v36
+	if !easy && r.Intn(10) != 0 {
 	}
-	s := strings.Join([]string{`&MACParameters_Channel{`,
-		`UplinkFrequency:` + fmt.Sprintf("%v", this.UplinkFrequency) + `,`,
-		`DownlinkFrequency:` + fmt.Sprintf("%v", this.DownlinkFrequency) + `,`,
-		`MinDataRateIndex:` + fmt.Sprintf("%v", this.MinDataRateIndex) + `,`,
-		`MaxDataRateIndex:` + fmt.Sprintf("%v", this.MaxDataRateIndex) + `,`,
-		`EnableUplink:` + fmt.Sprintf("%v", this.EnableUplink) + `,`,
-		`}`,
-	}, "")
-	return s
+	return this
 }
-func (this *EndDeviceBrand) String() string {
-	if this == nil {
-		return "nil"
+
+func NewPopulatedListEndDeviceProfilesRequest(r randyEndDevice, easy bool) *ListEndDeviceProfilesRequest {
+	this := &ListEndDeviceProfilesRequest{}
+	if r.Intn(5) != 0 {
+		this.ApplicationIDs = NewPopulatedApplicationIdentifiers(r, easy)
 	}
-	s := strings.Join([]string{`&EndDeviceBrand{`,
-		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
-		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
-		`URL:` + fmt.Sprintf("%v", this.URL) + `,`,
-		`Logos:` + fmt.Sprintf("%v", this.Logos) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *EndDeviceModel) String() string {
-	if this == nil {
-		return "nil"
+	v37 := types.NewPopulatedFieldMask(r, easy)
+	this.FieldMask = *v37
+	this.Limit = r.Uint32()
+	this.Page = r.Uint32()
+	if !easy && r.Intn(10) != 0 {
 	}
-	s := strings.Join([]string{`&EndDeviceModel{`,
-		`BrandID:` + fmt.Sprintf("%v", this.BrandID) + `,`,
-		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
-		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
-		`}`,
-	}, "")
-	return s
+	return this
 }
-func (this *EndDeviceVersionIdentifiers) String() string {
-	if this == nil {
-		return "nil"
+
+func NewPopulatedSetEndDeviceProfileRequest(r randyEndDevice, easy bool) *SetEndDeviceProfileRequest {
+	this := &SetEndDeviceProfileRequest{}
+	v38 := NewPopulatedEndDeviceProfile(r, easy)
+	this.Profile = *v38
+	v39 := types.NewPopulatedFieldMask(r, easy)
+	this.FieldMask = *v39
+	if !easy && r.Intn(10) != 0 {
 	}
-	s := strings.Join([]string{`&EndDeviceVersionIdentifiers{`,
-		`BrandID:` + fmt.Sprintf("%v", this.BrandID) + `,`,
-		`ModelID:` + fmt.Sprintf("%v", this.ModelID) + `,`,
-		`HardwareVersion:` + fmt.Sprintf("%v", this.HardwareVersion) + `,`,
-		`FirmwareVersion:` + fmt.Sprintf("%v", this.FirmwareVersion) + `,`,
-		`}`,
-	}, "")
-	return s
+	return this
 }
-func (this *EndDeviceVersion) String() string {
-	if this == nil {
-		return "nil"
+
+func NewPopulatedEndDeviceTemplate(r randyEndDevice, easy bool) *EndDeviceTemplate {
+	this := &EndDeviceTemplate{}
+	v40 := NewPopulatedEndDevice(r, easy)
+	this.EndDevice = *v40
+	v41 := types.NewPopulatedFieldMask(r, easy)
+	this.FieldMask = *v41
+	this.MappingKey = randStringEndDevice(r)
+	if !easy && r.Intn(10) != 0 {
 	}
-	s := strings.Join([]string{`&EndDeviceVersion{`,
-		`EndDeviceVersionIdentifiers:` + strings.Replace(strings.Replace(this.EndDeviceVersionIdentifiers.String(), "EndDeviceVersionIdentifiers", "EndDeviceVersionIdentifiers", 1), `&`, ``, 1) + `,`,
-		`LoRaWANVersion:` + fmt.Sprintf("%v", this.LoRaWANVersion) + `,`,
-		`LoRaWANPHYVersion:` + fmt.Sprintf("%v", this.LoRaWANPHYVersion) + `,`,
-		`FrequencyPlanID:` + fmt.Sprintf("%v", this.FrequencyPlanID) + `,`,
-		`Photos:` + fmt.Sprintf("%v", this.Photos) + `,`,
-		`SupportsClassB:` + fmt.Sprintf("%v", this.SupportsClassB) + `,`,
-		`SupportsClassC:` + fmt.Sprintf("%v", this.SupportsClassC) + `,`,
-		`DefaultMACSettings:` + strings.Replace(this.DefaultMACSettings.String(), "MACSettings", "MACSettings", 1) + `,`,
-		`MinFrequency:` + fmt.Sprintf("%v", this.MinFrequency) + `,`,
-		`MaxFrequency:` + fmt.Sprintf("%v", this.MaxFrequency) + `,`,
-		`SupportsJoin:` + fmt.Sprintf("%v", this.SupportsJoin) + `,`,
-		`ResetsJoinNonces:` + fmt.Sprintf("%v", this.ResetsJoinNonces) + `,`,
-		`DefaultFormatters:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.DefaultFormatters), "MessagePayloadFormatters", "MessagePayloadFormatters", 1), `&`, ``, 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return this
 }
-func (this *MACSettings) String() string {
-	if this == nil {
-		return "nil"
+
+func NewPopulatedEndDeviceTemplateFormat(r randyEndDevice, easy bool) *EndDeviceTemplateFormat {
+	this := &EndDeviceTemplateFormat{}
+	this.Name = randStringEndDevice(r)
+	this.Description = randStringEndDevice(r)
+	v42 := r.Intn(10)
+	this.FileExtensions = make([]string, v42)
+	for i := 0; i < v42; i++ {
+		this.FileExtensions[i] = randStringEndDevice(r)
 	}
-	s := strings.Join([]string{`&MACSettings{`,
-		`ClassBTimeout:` + strings.Replace(fmt.Sprintf("%v", this.ClassBTimeout), "Duration", "types.Duration", 1) + `,`,
-		`PingSlotPeriodicity:` + strings.Replace(fmt.Sprintf("%v", this.PingSlotPeriodicity), "PingSlotPeriodValue", "PingSlotPeriodValue", 1) + `,`,
-		`PingSlotDataRateIndex:` + strings.Replace(fmt.Sprintf("%v", this.PingSlotDataRateIndex), "DataRateIndexValue", "DataRateIndexValue", 1) + `,`,
-		`PingSlotFrequency:` + strings.Replace(fmt.Sprintf("%v", this.PingSlotFrequency), "UInt64Value", "types.UInt64Value", 1) + `,`,
-		`ClassCTimeout:` + strings.Replace(fmt.Sprintf("%v", this.ClassCTimeout), "Duration", "types.Duration", 1) + `,`,
-		`Rx1Delay:` + strings.Replace(fmt.Sprintf("%v", this.Rx1Delay), "RxDelayValue", "RxDelayValue", 1) + `,`,
-		`Rx1DataRateOffset:` + strings.Replace(fmt.Sprintf("%v", this.Rx1DataRateOffset), "UInt32Value", "types.UInt32Value", 1) + `,`,
-		`Rx2DataRateIndex:` + strings.Replace(fmt.Sprintf("%v", this.Rx2DataRateIndex), "DataRateIndexValue", "DataRateIndexValue", 1) + `,`,
-		`Rx2Frequency:` + strings.Replace(fmt.Sprintf("%v", this.Rx2Frequency), "UInt64Value", "types.UInt64Value", 1) + `,`,
-		`FactoryPresetFrequencies:` + fmt.Sprintf("%v", this.FactoryPresetFrequencies) + `,`,
-		`MaxDutyCycle:` + strings.Replace(fmt.Sprintf("%v", this.MaxDutyCycle), "AggregatedDutyCycleValue", "AggregatedDutyCycleValue", 1) + `,`,
-		`Supports32BitFCnt:` + strings.Replace(fmt.Sprintf("%v", this.Supports32BitFCnt), "BoolValue", "types.BoolValue", 1) + `,`,
-		`UseADR:` + strings.Replace(fmt.Sprintf("%v", this.UseADR), "BoolValue", "types.BoolValue", 1) + `,`,
-		`ADRMargin:` + strings.Replace(fmt.Sprintf("%v", this.ADRMargin), "FloatValue", "types.FloatValue", 1) + `,`,
-		`ResetsFCnt:` + strings.Replace(fmt.Sprintf("%v", this.ResetsFCnt), "BoolValue", "types.BoolValue", 1) + `,`,
-		`StatusTimePeriodicity:` + strings.Replace(fmt.Sprintf("%v", this.StatusTimePeriodicity), "Duration", "types.Duration", 1) + `,`,
-		`StatusCountPeriodicity:` + strings.Replace(fmt.Sprintf("%v", this.StatusCountPeriodicity), "UInt32Value", "types.UInt32Value", 1) + `,`,
-		`DesiredRx1Delay:` + strings.Replace(fmt.Sprintf("%v", this.DesiredRx1Delay), "RxDelayValue", "RxDelayValue", 1) + `,`,
-		`DesiredRx1DataRateOffset:` + strings.Replace(fmt.Sprintf("%v", this.DesiredRx1DataRateOffset), "UInt32Value", "types.UInt32Value", 1) + `,`,
-		`DesiredRx2DataRateIndex:` + strings.Replace(fmt.Sprintf("%v", this.DesiredRx2DataRateIndex), "DataRateIndexValue", "DataRateIndexValue", 1) + `,`,
-		`DesiredRx2Frequency:` + strings.Replace(fmt.Sprintf("%v", this.DesiredRx2Frequency), "UInt64Value", "types.UInt64Value", 1) + `,`,
-		`DesiredMaxDutyCycle:` + strings.Replace(fmt.Sprintf("%v", this.DesiredMaxDutyCycle), "AggregatedDutyCycleValue", "AggregatedDutyCycleValue", 1) + `,`,
-		`DesiredADRAckLimitExponent:` + strings.Replace(fmt.Sprintf("%v", this.DesiredADRAckLimitExponent), "ADRAckLimitExponentValue", "ADRAckLimitExponentValue", 1) + `,`,
-		`DesiredADRAckDelayExponent:` + strings.Replace(fmt.Sprintf("%v", this.DesiredADRAckDelayExponent), "ADRAckDelayExponentValue", "ADRAckDelayExponentValue", 1) + `,`,
-		`BeaconFrequency:` + strings.Replace(fmt.Sprintf("%v", this.BeaconFrequency), "UInt64Value", "types.UInt64Value", 1) + `,`,
-		`DesiredPingSlotDataRateIndex:` + strings.Replace(fmt.Sprintf("%v", this.DesiredPingSlotDataRateIndex), "DataRateIndexValue", "DataRateIndexValue", 1) + `,`,
-		`DesiredPingSlotFrequency:` + strings.Replace(fmt.Sprintf("%v", this.DesiredPingSlotFrequency), "UInt64Value", "types.UInt64Value", 1) + `,`,
-		`DesiredBeaconFrequency:` + strings.Replace(fmt.Sprintf("%v", this.DesiredBeaconFrequency), "UInt64Value", "types.UInt64Value", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
 }
-func (this *MACState) String() string {
-	if this == nil {
-		return "nil"
+
+func NewPopulatedEndDeviceTemplateFormats(r randyEndDevice, easy bool) *EndDeviceTemplateFormats {
+	this := &EndDeviceTemplateFormats{}
+	if r.Intn(5) != 0 {
+		v43 := r.Intn(10)
+		this.Formats = make(map[string]*EndDeviceTemplateFormat)
+		for i := 0; i < v43; i++ {
+			this.Formats[randStringEndDevice(r)] = NewPopulatedEndDeviceTemplateFormat(r, easy)
+		}
 	}
-	repeatedStringForQueuedResponses := "[]*MACCommand{"
-	for _, f := range this.QueuedResponses {
-		repeatedStringForQueuedResponses += strings.Replace(fmt.Sprintf("%v", f), "MACCommand", "MACCommand", 1) + ","
+	if !easy && r.Intn(10) != 0 {
 	}
-	repeatedStringForQueuedResponses += "}"
-	repeatedStringForPendingRequests := "[]*MACCommand{"
-	for _, f := range this.PendingRequests {
-		repeatedStringForPendingRequests += strings.Replace(fmt.Sprintf("%v", f), "MACCommand", "MACCommand", 1) + ","
+	return this
+}
+
+func NewPopulatedConvertEndDeviceTemplateRequest(r randyEndDevice, easy bool) *ConvertEndDeviceTemplateRequest {
+	this := &ConvertEndDeviceTemplateRequest{}
+	this.FormatID = randStringEndDevice(r)
+	v44 := r.Intn(100)
+	this.Data = make([]byte, v44)
+	for i := 0; i < v44; i++ {
+		this.Data[i] = byte(r.Intn(256))
 	}
-	repeatedStringForPendingRequests += "}"
-	repeatedStringForRecentUplinks := "[]*UplinkMessage{"
-	for _, f := range this.RecentUplinks {
-		repeatedStringForRecentUplinks += strings.Replace(fmt.Sprintf("%v", f), "UplinkMessage", "UplinkMessage", 1) + ","
+	if r.Intn(5) != 0 {
+		this.CSVOptions = NewPopulatedConvertEndDeviceTemplateRequest_CSVOptions(r, easy)
 	}
-	repeatedStringForRecentUplinks += "}"
-	repeatedStringForRecentDownlinks := "[]*DownlinkMessage{"
-	for _, f := range this.RecentDownlinks {
-		repeatedStringForRecentDownlinks += strings.Replace(fmt.Sprintf("%v", f), "DownlinkMessage", "DownlinkMessage", 1) + ","
+	if !easy && r.Intn(10) != 0 {
 	}
-	repeatedStringForRecentDownlinks += "}"
-	s := strings.Join([]string{`&MACState{`,
-		`CurrentParameters:` + strings.Replace(strings.Replace(this.CurrentParameters.String(), "MACParameters", "MACParameters", 1), `&`, ``, 1) + `,`,
-		`DesiredParameters:` + strings.Replace(strings.Replace(this.DesiredParameters.String(), "MACParameters", "MACParameters", 1), `&`, ``, 1) + `,`,
-		`DeviceClass:` + fmt.Sprintf("%v", this.DeviceClass) + `,`,
-		`LoRaWANVersion:` + fmt.Sprintf("%v", this.LoRaWANVersion) + `,`,
-		`LastConfirmedDownlinkAt:` + strings.Replace(fmt.Sprintf("%v", this.LastConfirmedDownlinkAt), "Timestamp", "types.Timestamp", 1) + `,`,
-		`LastDevStatusFCntUp:` + fmt.Sprintf("%v", this.LastDevStatusFCntUp) + `,`,
-		`PingSlotPeriodicity:` + strings.Replace(fmt.Sprintf("%v", this.PingSlotPeriodicity), "PingSlotPeriodValue", "PingSlotPeriodValue", 1) + `,`,
-		`PendingApplicationDownlink:` + strings.Replace(fmt.Sprintf("%v", this.PendingApplicationDownlink), "ApplicationDownlink", "ApplicationDownlink", 1) + `,`,
-		`QueuedResponses:` + repeatedStringForQueuedResponses + `,`,
-		`PendingRequests:` + repeatedStringForPendingRequests + `,`,
-		`QueuedJoinAccept:` + strings.Replace(fmt.Sprintf("%v", this.QueuedJoinAccept), "MACState_JoinAccept", "MACState_JoinAccept", 1) + `,`,
-		`PendingJoinRequest:` + strings.Replace(fmt.Sprintf("%v", this.PendingJoinRequest), "JoinRequest", "JoinRequest", 1) + `,`,
-		`RxWindowsAvailable:` + fmt.Sprintf("%v", this.RxWindowsAvailable) + `,`,
-		`RecentUplinks:` + repeatedStringForRecentUplinks + `,`,
-		`RecentDownlinks:` + repeatedStringForRecentDownlinks + `,`,
-		`LastNetworkInitiatedDownlinkAt:` + strings.Replace(fmt.Sprintf("%v", this.LastNetworkInitiatedDownlinkAt), "Timestamp", "types.Timestamp", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return this
 }
-func (this *MACState_JoinAccept) String() string {
-	if this == nil {
-		return "nil"
+
+func NewPopulatedConvertEndDeviceTemplateRequest_CSVOptions(r randyEndDevice, easy bool) *ConvertEndDeviceTemplateRequest_CSVOptions {
+	this := &ConvertEndDeviceTemplateRequest_CSVOptions{}
+	this.Separator = randStringEndDevice(r)
+	this.Base64 = bool(r.Intn(2) == 0)
+	if !easy && r.Intn(10) != 0 {
 	}
-	s := strings.Join([]string{`&MACState_JoinAccept{`,
-		`Payload:` + fmt.Sprintf("%v", this.Payload) + `,`,
-		`Request:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Request), "JoinRequest", "JoinRequest", 1), `&`, ``, 1) + `,`,
-		`Keys:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Keys), "SessionKeys", "SessionKeys", 1), `&`, ``, 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return this
 }
-func (this *EndDeviceAuthenticationCode) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&EndDeviceAuthenticationCode{`,
-		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
-		`ValidFrom:` + strings.Replace(fmt.Sprintf("%v", this.ValidFrom), "Timestamp", "types.Timestamp", 1) + `,`,
-		`ValidTo:` + strings.Replace(fmt.Sprintf("%v", this.ValidTo), "Timestamp", "types.Timestamp", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+
+type randyEndDevice interface {
+	Float32() float32
+	Float64() float64
+	Int63() int64
+	Int31() int32
+	Uint32() uint32
+	Intn(n int) int
 }
-func (this *EndDevice) String() string {
-	if this == nil {
-		return "nil"
-	}
-	repeatedStringForRecentADRUplinks := "[]*UplinkMessage{"
-	for _, f := range this.RecentADRUplinks {
-		repeatedStringForRecentADRUplinks += strings.Replace(fmt.Sprintf("%v", f), "UplinkMessage", "UplinkMessage", 1) + ","
+
+func randUTF8RuneEndDevice(r randyEndDevice) rune {
+	ru := r.Intn(62)
+	if ru < 10 {
+		return rune(ru + 48)
+	} else if ru < 36 {
+		return rune(ru + 55)
 	}
-	repeatedStringForRecentADRUplinks += "}"
-	repeatedStringForRecentUplinks := "[]*UplinkMessage{"
-	for _, f := range this.RecentUplinks {
-		repeatedStringForRecentUplinks += strings.Replace(fmt.Sprintf("%v", f), "UplinkMessage", "UplinkMessage", 1) + ","
+	return rune(ru + 61)
+}
+func randStringEndDevice(r randyEndDevice) string {
+	v45 := r.Intn(100)
+	tmps := make([]rune, v45)
+	for i := 0; i < v45; i++ {
+		tmps[i] = randUTF8RuneEndDevice(r)
 	}
-	repeatedStringForRecentUplinks += "}"
-	repeatedStringForRecentDownlinks := "[]*DownlinkMessage{"
-	for _, f := range this.RecentDownlinks {
-		repeatedStringForRecentDownlinks += strings.Replace(fmt.Sprintf("%v", f), "DownlinkMessage", "DownlinkMessage", 1) + ","
+	return string(tmps)
+}
+func randUnrecognizedEndDevice(r randyEndDevice, maxFieldNumber int) (dAtA []byte) {
+	l := r.Intn(5)
+	for i := 0; i < l; i++ {
+		wire := r.Intn(4)
+		if wire == 3 {
+			wire = 5
+		}
+		fieldNumber := maxFieldNumber + r.Intn(100)
+		dAtA = randFieldEndDevice(dAtA, r, fieldNumber, wire)
 	}
-	repeatedStringForRecentDownlinks += "}"
-	repeatedStringForQueuedApplicationDownlinks := "[]*ApplicationDownlink{"
-	for _, f := range this.QueuedApplicationDownlinks {
-		repeatedStringForQueuedApplicationDownlinks += strings.Replace(fmt.Sprintf("%v", f), "ApplicationDownlink", "ApplicationDownlink", 1) + ","
+	return dAtA
+}
+func randFieldEndDevice(dAtA []byte, r randyEndDevice, fieldNumber int, wire int) []byte {
+	key := uint32(fieldNumber)<<3 | uint32(wire)
+	switch wire {
+	case 0:
+		dAtA = encodeVarintPopulateEndDevice(dAtA, uint64(key))
+		v46 := r.Int63()
+		if r.Intn(2) == 0 {
+			v46 *= -1
+		}
+		dAtA = encodeVarintPopulateEndDevice(dAtA, uint64(v46))
+	case 1:
+		dAtA = encodeVarintPopulateEndDevice(dAtA, uint64(key))
+		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
+	case 2:
+		dAtA = encodeVarintPopulateEndDevice(dAtA, uint64(key))
+		ll := r.Intn(100)
+		dAtA = encodeVarintPopulateEndDevice(dAtA, uint64(ll))
+		for j := 0; j < ll; j++ {
+			dAtA = append(dAtA, byte(r.Intn(256)))
+		}
+	default:
+		dAtA = encodeVarintPopulateEndDevice(dAtA, uint64(key))
+		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
 	}
-	repeatedStringForQueuedApplicationDownlinks += "}"
-	keysForAttributes := make([]string, 0, len(this.Attributes))
-	for k := range this.Attributes {
-		keysForAttributes = append(keysForAttributes, k)
+	return dAtA
+}
+func encodeVarintPopulateEndDevice(dAtA []byte, v uint64) []byte {
+	for v >= 1<<7 {
+		dAtA = append(dAtA, uint8(v&0x7f|0x80))
+		v >>= 7
 	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForAttributes)
-	mapStringForAttributes := "map[string]string{"
-	for _, k := range keysForAttributes {
-		mapStringForAttributes += fmt.Sprintf("%v: %v,", k, this.Attributes[k])
+	dAtA = append(dAtA, uint8(v))
+	return dAtA
+}
+func (m *Session) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	mapStringForAttributes += "}"
-	keysForLocations := make([]string, 0, len(this.Locations))
-	for k := range this.Locations {
-		keysForLocations = append(keysForLocations, k)
+	var l int
+	_ = l
+	l = m.DevAddr.Size()
+	n += 1 + l + sovEndDevice(uint64(l))
+	l = m.SessionKeys.Size()
+	n += 1 + l + sovEndDevice(uint64(l))
+	if m.LastFCntUp != 0 {
+		n += 1 + sovEndDevice(uint64(m.LastFCntUp))
 	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForLocations)
-	mapStringForLocations := "map[string]*Location{"
-	for _, k := range keysForLocations {
-		mapStringForLocations += fmt.Sprintf("%v: %v,", k, this.Locations[k])
+	if m.LastNFCntDown != 0 {
+		n += 1 + sovEndDevice(uint64(m.LastNFCntDown))
 	}
-	mapStringForLocations += "}"
-	s := strings.Join([]string{`&EndDevice{`,
-		`EndDeviceIdentifiers:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.EndDeviceIdentifiers), "EndDeviceIdentifiers", "EndDeviceIdentifiers", 1), `&`, ``, 1) + `,`,
-		`CreatedAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.CreatedAt), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
-		`UpdatedAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.UpdatedAt), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
-		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
-		`Description:` + fmt.Sprintf("%v", this.Description) + `,`,
-		`Attributes:` + mapStringForAttributes + `,`,
-		`VersionIDs:` + strings.Replace(this.VersionIDs.String(), "EndDeviceVersionIdentifiers", "EndDeviceVersionIdentifiers", 1) + `,`,
-		`ServiceProfileID:` + fmt.Sprintf("%v", this.ServiceProfileID) + `,`,
-		`NetworkServerAddress:` + fmt.Sprintf("%v", this.NetworkServerAddress) + `,`,
-		`ApplicationServerAddress:` + fmt.Sprintf("%v", this.ApplicationServerAddress) + `,`,
-		`JoinServerAddress:` + fmt.Sprintf("%v", this.JoinServerAddress) + `,`,
-		`Locations:` + mapStringForLocations + `,`,
-		`SupportsClassB:` + fmt.Sprintf("%v", this.SupportsClassB) + `,`,
-		`SupportsClassC:` + fmt.Sprintf("%v", this.SupportsClassC) + `,`,
-		`LoRaWANVersion:` + fmt.Sprintf("%v", this.LoRaWANVersion) + `,`,
-		`LoRaWANPHYVersion:` + fmt.Sprintf("%v", this.LoRaWANPHYVersion) + `,`,
-		`FrequencyPlanID:` + fmt.Sprintf("%v", this.FrequencyPlanID) + `,`,
-		`MinFrequency:` + fmt.Sprintf("%v", this.MinFrequency) + `,`,
-		`MaxFrequency:` + fmt.Sprintf("%v", this.MaxFrequency) + `,`,
-		`SupportsJoin:` + fmt.Sprintf("%v", this.SupportsJoin) + `,`,
-		`ResetsJoinNonces:` + fmt.Sprintf("%v", this.ResetsJoinNonces) + `,`,
-		`RootKeys:` + strings.Replace(fmt.Sprintf("%v", this.RootKeys), "RootKeys", "RootKeys", 1) + `,`,
-		`NetID:` + fmt.Sprintf("%v", this.NetID) + `,`,
-		`MACSettings:` + strings.Replace(this.MACSettings.String(), "MACSettings", "MACSettings", 1) + `,`,
-		`MACState:` + strings.Replace(this.MACState.String(), "MACState", "MACState", 1) + `,`,
-		`Session:` + strings.Replace(this.Session.String(), "Session", "Session", 1) + `,`,
-		`PendingSession:` + strings.Replace(this.PendingSession.String(), "Session", "Session", 1) + `,`,
-		`LastDevNonce:` + fmt.Sprintf("%v", this.LastDevNonce) + `,`,
-		`UsedDevNonces:` + fmt.Sprintf("%v", this.UsedDevNonces) + `,`,
-		`LastJoinNonce:` + fmt.Sprintf("%v", this.LastJoinNonce) + `,`,
-		`LastRJCount0:` + fmt.Sprintf("%v", this.LastRJCount0) + `,`,
-		`LastRJCount1:` + fmt.Sprintf("%v", this.LastRJCount1) + `,`,
-		`LastDevStatusReceivedAt:` + strings.Replace(fmt.Sprintf("%v", this.LastDevStatusReceivedAt), "Timestamp", "types.Timestamp", 1) + `,`,
-		`PowerState:` + fmt.Sprintf("%v", this.PowerState) + `,`,
-		`BatteryPercentage:` + strings.Replace(fmt.Sprintf("%v", this.BatteryPercentage), "FloatValue", "types.FloatValue", 1) + `,`,
-		`DownlinkMargin:` + fmt.Sprintf("%v", this.DownlinkMargin) + `,`,
-		`RecentADRUplinks:` + repeatedStringForRecentADRUplinks + `,`,
-		`RecentUplinks:` + repeatedStringForRecentUplinks + `,`,
-		`RecentDownlinks:` + repeatedStringForRecentDownlinks + `,`,
-		`QueuedApplicationDownlinks:` + repeatedStringForQueuedApplicationDownlinks + `,`,
-		`Formatters:` + strings.Replace(fmt.Sprintf("%v", this.Formatters), "MessagePayloadFormatters", "MessagePayloadFormatters", 1) + `,`,
-		`ProvisionerID:` + fmt.Sprintf("%v", this.ProvisionerID) + `,`,
-		`ProvisioningData:` + strings.Replace(fmt.Sprintf("%v", this.ProvisioningData), "Struct", "types.Struct", 1) + `,`,
-		`PendingMACState:` + strings.Replace(this.PendingMACState.String(), "MACState", "MACState", 1) + `,`,
-		`Multicast:` + fmt.Sprintf("%v", this.Multicast) + `,`,
-		`ClaimAuthenticationCode:` + strings.Replace(this.ClaimAuthenticationCode.String(), "EndDeviceAuthenticationCode", "EndDeviceAuthenticationCode", 1) + `,`,
-		`NetworkServerKEKLabel:` + fmt.Sprintf("%v", this.NetworkServerKEKLabel) + `,`,
-		`ApplicationServerKEKLabel:` + fmt.Sprintf("%v", this.ApplicationServerKEKLabel) + `,`,
-		`ApplicationServerID:` + fmt.Sprintf("%v", this.ApplicationServerID) + `,`,
-		`Picture:` + strings.Replace(fmt.Sprintf("%v", this.Picture), "Picture", "Picture", 1) + `,`,
-		`SkipPayloadCrypto:` + fmt.Sprintf("%v", this.SkipPayloadCrypto) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *EndDevices) String() string {
-	if this == nil {
-		return "nil"
+	if m.LastAFCntDown != 0 {
+		n += 1 + sovEndDevice(uint64(m.LastAFCntDown))
 	}
-	repeatedStringForEndDevices := "[]*EndDevice{"
-	for _, f := range this.EndDevices {
-		repeatedStringForEndDevices += strings.Replace(f.String(), "EndDevice", "EndDevice", 1) + ","
+	if m.LastConfFCntDown != 0 {
+		n += 1 + sovEndDevice(uint64(m.LastConfFCntDown))
 	}
-	repeatedStringForEndDevices += "}"
-	s := strings.Join([]string{`&EndDevices{`,
-		`EndDevices:` + repeatedStringForEndDevices + `,`,
-		`}`,
-	}, "")
-	return s
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartedAt)
+	n += 1 + l + sovEndDevice(uint64(l))
+	return n
 }
-func (this *CreateEndDeviceRequest) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *MACParameters) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&CreateEndDeviceRequest{`,
-		`EndDevice:` + strings.Replace(strings.Replace(this.EndDevice.String(), "EndDevice", "EndDevice", 1), `&`, ``, 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *UpdateEndDeviceRequest) String() string {
-	if this == nil {
-		return "nil"
+	var l int
+	_ = l
+	if m.MaxEIRP != 0 {
+		n += 5
 	}
-	s := strings.Join([]string{`&UpdateEndDeviceRequest{`,
-		`EndDevice:` + strings.Replace(strings.Replace(this.EndDevice.String(), "EndDevice", "EndDevice", 1), `&`, ``, 1) + `,`,
-		`FieldMask:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.FieldMask), "FieldMask", "types.FieldMask", 1), `&`, ``, 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *GetEndDeviceRequest) String() string {
-	if this == nil {
-		return "nil"
+	if m.ADRDataRateIndex != 0 {
+		n += 1 + sovEndDevice(uint64(m.ADRDataRateIndex))
 	}
-	s := strings.Join([]string{`&GetEndDeviceRequest{`,
-		`EndDeviceIdentifiers:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.EndDeviceIdentifiers), "EndDeviceIdentifiers", "EndDeviceIdentifiers", 1), `&`, ``, 1) + `,`,
-		`FieldMask:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.FieldMask), "FieldMask", "types.FieldMask", 1), `&`, ``, 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *GetEndDeviceIdentifiersForEUIsRequest) String() string {
-	if this == nil {
-		return "nil"
+	if m.ADRTxPowerIndex != 0 {
+		n += 1 + sovEndDevice(uint64(m.ADRTxPowerIndex))
 	}
-	s := strings.Join([]string{`&GetEndDeviceIdentifiersForEUIsRequest{`,
-		`JoinEUI:` + fmt.Sprintf("%v", this.JoinEUI) + `,`,
-		`DevEUI:` + fmt.Sprintf("%v", this.DevEUI) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *ListEndDevicesRequest) String() string {
-	if this == nil {
-		return "nil"
+	if m.ADRNbTrans != 0 {
+		n += 1 + sovEndDevice(uint64(m.ADRNbTrans))
 	}
-	s := strings.Join([]string{`&ListEndDevicesRequest{`,
-		`ApplicationIdentifiers:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ApplicationIdentifiers), "ApplicationIdentifiers", "ApplicationIdentifiers", 1), `&`, ``, 1) + `,`,
-		`FieldMask:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.FieldMask), "FieldMask", "types.FieldMask", 1), `&`, ``, 1) + `,`,
-		`Order:` + fmt.Sprintf("%v", this.Order) + `,`,
-		`Limit:` + fmt.Sprintf("%v", this.Limit) + `,`,
-		`Page:` + fmt.Sprintf("%v", this.Page) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *SetEndDeviceRequest) String() string {
-	if this == nil {
-		return "nil"
+	if m.ADRAckLimit != 0 {
+		n += 1 + sovEndDevice(uint64(m.ADRAckLimit))
 	}
-	s := strings.Join([]string{`&SetEndDeviceRequest{`,
-		`EndDevice:` + strings.Replace(strings.Replace(this.EndDevice.String(), "EndDevice", "EndDevice", 1), `&`, ``, 1) + `,`,
-		`FieldMask:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.FieldMask), "FieldMask", "types.FieldMask", 1), `&`, ``, 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	if m.ADRAckDelay != 0 {
+		n += 1 + sovEndDevice(uint64(m.ADRAckDelay))
+	}
+	if m.Rx1Delay != 0 {
+		n += 1 + sovEndDevice(uint64(m.Rx1Delay))
+	}
+	if m.Rx1DataRateOffset != 0 {
+		n += 1 + sovEndDevice(uint64(m.Rx1DataRateOffset))
+	}
+	if m.Rx2DataRateIndex != 0 {
+		n += 1 + sovEndDevice(uint64(m.Rx2DataRateIndex))
+	}
+	if m.Rx2Frequency != 0 {
+		n += 1 + sovEndDevice(m.Rx2Frequency)
+	}
+	if m.MaxDutyCycle != 0 {
+		n += 1 + sovEndDevice(uint64(m.MaxDutyCycle))
+	}
+	if m.RejoinTimePeriodicity != 0 {
+		n += 1 + sovEndDevice(uint64(m.RejoinTimePeriodicity))
+	}
+	if m.RejoinCountPeriodicity != 0 {
+		n += 1 + sovEndDevice(uint64(m.RejoinCountPeriodicity))
+	}
+	if m.PingSlotFrequency != 0 {
+		n += 2 + sovEndDevice(m.PingSlotFrequency)
+	}
+	if m.PingSlotDataRateIndex != 0 {
+		n += 2 + sovEndDevice(uint64(m.PingSlotDataRateIndex))
+	}
+	if m.BeaconFrequency != 0 {
+		n += 2 + sovEndDevice(m.BeaconFrequency)
+	}
+	if len(m.Channels) > 0 {
+		for _, e := range m.Channels {
+			l = e.Size()
+			n += 2 + l + sovEndDevice(uint64(l))
+		}
+	}
+	if m.UplinkDwellTime != nil {
+		l = m.UplinkDwellTime.Size()
+		n += 2 + l + sovEndDevice(uint64(l))
+	}
+	if m.DownlinkDwellTime != nil {
+		l = m.DownlinkDwellTime.Size()
+		n += 2 + l + sovEndDevice(uint64(l))
+	}
+	if m.ADRAckLimitExponent != nil {
+		l = m.ADRAckLimitExponent.Size()
+		n += 2 + l + sovEndDevice(uint64(l))
+	}
+	if m.ADRAckDelayExponent != nil {
+		l = m.ADRAckDelayExponent.Size()
+		n += 2 + l + sovEndDevice(uint64(l))
+	}
+	if m.PingSlotDataRateIndexValue != nil {
+		l = m.PingSlotDataRateIndexValue.Size()
+		n += 2 + l + sovEndDevice(uint64(l))
+	}
+	return n
 }
-func (this *EndDeviceTemplate) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *MACParameters_Channel) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&EndDeviceTemplate{`,
-		`EndDevice:` + strings.Replace(strings.Replace(this.EndDevice.String(), "EndDevice", "EndDevice", 1), `&`, ``, 1) + `,`,
-		`FieldMask:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.FieldMask), "FieldMask", "types.FieldMask", 1), `&`, ``, 1) + `,`,
-		`MappingKey:` + fmt.Sprintf("%v", this.MappingKey) + `,`,
-		`}`,
-	}, "")
-	return s
+	var l int
+	_ = l
+	if m.UplinkFrequency != 0 {
+		n += 1 + sovEndDevice(m.UplinkFrequency)
+	}
+	if m.DownlinkFrequency != 0 {
+		n += 1 + sovEndDevice(m.DownlinkFrequency)
+	}
+	if m.MinDataRateIndex != 0 {
+		n += 1 + sovEndDevice(uint64(m.MinDataRateIndex))
+	}
+	if m.MaxDataRateIndex != 0 {
+		n += 1 + sovEndDevice(uint64(m.MaxDataRateIndex))
+	}
+	if m.EnableUplink {
+		n += 2
+	}
+	return n
 }
-func (this *EndDeviceTemplateFormat) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *EndDeviceBrand) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&EndDeviceTemplateFormat{`,
-		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
-		`Description:` + fmt.Sprintf("%v", this.Description) + `,`,
-		`FileExtensions:` + fmt.Sprintf("%v", this.FileExtensions) + `,`,
-		`}`,
-	}, "")
-	return s
+	var l int
+	_ = l
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovEndDevice(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovEndDevice(uint64(l))
+	}
+	l = len(m.URL)
+	if l > 0 {
+		n += 1 + l + sovEndDevice(uint64(l))
+	}
+	if len(m.Logos) > 0 {
+		for _, s := range m.Logos {
+			l = len(s)
+			n += 1 + l + sovEndDevice(uint64(l))
+		}
+	}
+	return n
 }
-func (this *EndDeviceTemplateFormats) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *EndDeviceModel) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	keysForFormats := make([]string, 0, len(this.Formats))
-	for k := range this.Formats {
-		keysForFormats = append(keysForFormats, k)
+	var l int
+	_ = l
+	l = len(m.BrandID)
+	if l > 0 {
+		n += 1 + l + sovEndDevice(uint64(l))
 	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForFormats)
-	mapStringForFormats := "map[string]*EndDeviceTemplateFormat{"
-	for _, k := range keysForFormats {
-		mapStringForFormats += fmt.Sprintf("%v: %v,", k, this.Formats[k])
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovEndDevice(uint64(l))
 	}
-	mapStringForFormats += "}"
-	s := strings.Join([]string{`&EndDeviceTemplateFormats{`,
-		`Formats:` + mapStringForFormats + `,`,
-		`}`,
-	}, "")
-	return s
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovEndDevice(uint64(l))
+	}
+	return n
 }
-func (this *ConvertEndDeviceTemplateRequest) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *EndDeviceVersionIdentifiers) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BrandID)
+	if l > 0 {
+		n += 1 + l + sovEndDevice(uint64(l))
+	}
+	l = len(m.ModelID)
+	if l > 0 {
+		n += 1 + l + sovEndDevice(uint64(l))
+	}
+	l = len(m.HardwareVersion)
+	if l > 0 {
+		n += 1 + l + sovEndDevice(uint64(l))
+	}
+	l = len(m.FirmwareVersion)
+	if l > 0 {
+		n += 1 + l + sovEndDevice(uint64(l))
+	}
+	return n
+}
+
+func (m *EndDeviceVersion) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.EndDeviceVersionIdentifiers.Size()
+	n += 1 + l + sovEndDevice(uint64(l))
+	if m.LoRaWANVersion != 0 {
+		n += 1 + sovEndDevice(uint64(m.LoRaWANVersion))
+	}
+	if m.LoRaWANPHYVersion != 0 {
+		n += 1 + sovEndDevice(uint64(m.LoRaWANPHYVersion))
+	}
+	l = len(m.FrequencyPlanID)
+	if l > 0 {
+		n += 1 + l + sovEndDevice(uint64(l))
+	}
+	if len(m.Photos) > 0 {
+		for _, s := range m.Photos {
+			l = len(s)
+			n += 1 + l + sovEndDevice(uint64(l))
+		}
+	}
+	if m.SupportsClassB {
+		n += 2
+	}
+	if m.SupportsClassC {
+		n += 2
+	}
+	if m.DefaultMACSettings != nil {
+		l = m.DefaultMACSettings.Size()
+		n += 1 + l + sovEndDevice(uint64(l))
+	}
+	if m.MinFrequency != 0 {
+		n += 1 + sovEndDevice(m.MinFrequency)
+	}
+	if m.MaxFrequency != 0 {
+		n += 1 + sovEndDevice(m.MaxFrequency)
+	}
+	if m.SupportsJoin {
+		n += 2
+	}
+	if m.ResetsJoinNonces {
+		n += 2
+	}
+	l = m.DefaultFormatters.Size()
+	n += 1 + l + sovEndDevice(uint64(l))
+	if len(m.ProfileIDs) > 0 {
+		for k, v := range m.ProfileIDs {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovEndDevice(uint64(len(k))) + 1 + len(v) + sovEndDevice(uint64(len(v)))
+			n += mapEntrySize + 1 + sovEndDevice(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *MACSettings) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ClassBTimeout != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.ClassBTimeout)
+		n += 1 + l + sovEndDevice(uint64(l))
+	}
+	if m.PingSlotPeriodicity != nil {
+		l = m.PingSlotPeriodicity.Size()
+		n += 1 + l + sovEndDevice(uint64(l))
+	}
+	if m.PingSlotDataRateIndex != nil {
+		l = m.PingSlotDataRateIndex.Size()
+		n += 1 + l + sovEndDevice(uint64(l))
+	}
+	if m.PingSlotFrequency != nil {
+		l = m.PingSlotFrequency.Size()
+		n += 1 + l + sovEndDevice(uint64(l))
+	}
+	if m.ClassCTimeout != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.ClassCTimeout)
+		n += 1 + l + sovEndDevice(uint64(l))
+	}
+	if m.Rx1Delay != nil {
+		l = m.Rx1Delay.Size()
+		n += 1 + l + sovEndDevice(uint64(l))
+	}
+	if m.Rx1DataRateOffset != nil {
+		l = m.Rx1DataRateOffset.Size()
+		n += 1 + l + sovEndDevice(uint64(l))
+	}
+	if m.Rx2DataRateIndex != nil {
+		l = m.Rx2DataRateIndex.Size()
+		n += 1 + l + sovEndDevice(uint64(l))
+	}
+	if m.Rx2Frequency != nil {
+		l = m.Rx2Frequency.Size()
+		n += 1 + l + sovEndDevice(uint64(l))
+	}
+	if len(m.FactoryPresetFrequencies) > 0 {
+		l = 0
+		for _, e := range m.FactoryPresetFrequencies {
+			l += sovEndDevice(e)
+		}
+		n += 1 + sovEndDevice(uint64(l)) + l
+	}
+	if m.MaxDutyCycle != nil {
+		l = m.MaxDutyCycle.Size()
+		n += 1 + l + sovEndDevice(uint64(l))
+	}
+	if m.Supports32BitFCnt != nil {
+		l = m.Supports32BitFCnt.Size()
+		n += 1 + l + sovEndDevice(uint64(l))
+	}
+	if m.UseADR != nil {
+		l = m.UseADR.Size()
+		n += 1 + l + sovEndDevice(uint64(l))
+	}
+	if m.ADRMargin != nil {
+		l = m.ADRMargin.Size()
+		n += 1 + l + sovEndDevice(uint64(l))
+	}
+	if m.ResetsFCnt != nil {
+		l = m.ResetsFCnt.Size()
+		n += 1 + l + sovEndDevice(uint64(l))
+	}
+	if m.StatusTimePeriodicity != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.StatusTimePeriodicity)
+		n += 2 + l + sovEndDevice(uint64(l))
+	}
+	if m.StatusCountPeriodicity != nil {
+		l = m.StatusCountPeriodicity.Size()
+		n += 2 + l + sovEndDevice(uint64(l))
+	}
+	if m.DesiredRx1Delay != nil {
+		l = m.DesiredRx1Delay.Size()
+		n += 2 + l + sovEndDevice(uint64(l))
+	}
+	if m.DesiredRx1DataRateOffset != nil {
+		l = m.DesiredRx1DataRateOffset.Size()
+		n += 2 + l + sovEndDevice(uint64(l))
+	}
+	if m.DesiredRx2DataRateIndex != nil {
+		l = m.DesiredRx2DataRateIndex.Size()
+		n += 2 + l + sovEndDevice(uint64(l))
+	}
+	if m.DesiredRx2Frequency != nil {
+		l = m.DesiredRx2Frequency.Size()
+		n += 2 + l + sovEndDevice(uint64(l))
+	}
+	if m.DesiredMaxDutyCycle != nil {
+		l = m.DesiredMaxDutyCycle.Size()
+		n += 2 + l + sovEndDevice(uint64(l))
+	}
+	if m.DesiredADRAckLimitExponent != nil {
+		l = m.DesiredADRAckLimitExponent.Size()
+		n += 2 + l + sovEndDevice(uint64(l))
+	}
+	if m.DesiredADRAckDelayExponent != nil {
+		l = m.DesiredADRAckDelayExponent.Size()
+		n += 2 + l + sovEndDevice(uint64(l))
+	}
+	if m.BeaconFrequency != nil {
+		l = m.BeaconFrequency.Size()
+		n += 2 + l + sovEndDevice(uint64(l))
+	}
+	if m.DesiredPingSlotDataRateIndex != nil {
+		l = m.DesiredPingSlotDataRateIndex.Size()
+		n += 2 + l + sovEndDevice(uint64(l))
+	}
+	if m.DesiredPingSlotFrequency != nil {
+		l = m.DesiredPingSlotFrequency.Size()
+		n += 2 + l + sovEndDevice(uint64(l))
+	}
+	if m.DesiredBeaconFrequency != nil {
+		l = m.DesiredBeaconFrequency.Size()
+		n += 2 + l + sovEndDevice(uint64(l))
+	}
+	return n
+}
+
+func (m *MACState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CurrentParameters.Size()
+	n += 1 + l + sovEndDevice(uint64(l))
+	l = m.DesiredParameters.Size()
+	n += 1 + l + sovEndDevice(uint64(l))
+	if m.DeviceClass != 0 {
+		n += 1 + sovEndDevice(uint64(m.DeviceClass))
+	}
+	if m.LoRaWANVersion != 0 {
+		n += 1 + sovEndDevice(uint64(m.LoRaWANVersion))
+	}
+	if m.LastConfirmedDownlinkAt != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastConfirmedDownlinkAt)
+		n += 1 + l + sovEndDevice(uint64(l))
+	}
+	if m.LastDevStatusFCntUp != 0 {
+		n += 1 + sovEndDevice(uint64(m.LastDevStatusFCntUp))
+	}
+	if m.PingSlotPeriodicity != nil {
+		l = m.PingSlotPeriodicity.Size()
+		n += 1 + l + sovEndDevice(uint64(l))
+	}
+	if m.PendingApplicationDownlink != nil {
+		l = m.PendingApplicationDownlink.Size()
+		n += 1 + l + sovEndDevice(uint64(l))
+	}
+	if len(m.QueuedResponses) > 0 {
+		for _, e := range m.QueuedResponses {
+			l = e.Size()
+			n += 1 + l + sovEndDevice(uint64(l))
+		}
+	}
+	if len(m.PendingRequests) > 0 {
+		for _, e := range m.PendingRequests {
+			l = e.Size()
+			n += 1 + l + sovEndDevice(uint64(l))
+		}
+	}
+	if m.QueuedJoinAccept != nil {
+		l = m.QueuedJoinAccept.Size()
+		n += 1 + l + sovEndDevice(uint64(l))
+	}
+	if m.PendingJoinRequest != nil {
+		l = m.PendingJoinRequest.Size()
+		n += 1 + l + sovEndDevice(uint64(l))
+	}
+	if m.RxWindowsAvailable {
+		n += 2
+	}
+	if len(m.RecentUplinks) > 0 {
+		for _, e := range m.RecentUplinks {
+			l = e.Size()
+			n += 1 + l + sovEndDevice(uint64(l))
+		}
+	}
+	if len(m.RecentDownlinks) > 0 {
+		for _, e := range m.RecentDownlinks {
+			l = e.Size()
+			n += 1 + l + sovEndDevice(uint64(l))
+		}
+	}
+	if m.LastNetworkInitiatedDownlinkAt != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastNetworkInitiatedDownlinkAt)
+		n += 2 + l + sovEndDevice(uint64(l))
+	}
+	if m.QueuedForceRejoinReq != nil {
+		l = m.QueuedForceRejoinReq.Size()
+		n += 2 + l + sovEndDevice(uint64(l))
+	}
+	if m.LastRJCount0 != 0 {
+		n += 2 + sovEndDevice(uint64(m.LastRJCount0))
+	}
+	return n
+}
+
+func (m *MACState_JoinAccept) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Payload)
+	if l > 0 {
+		n += 1 + l + sovEndDevice(uint64(l))
+	}
+	l = m.Request.Size()
+	n += 1 + l + sovEndDevice(uint64(l))
+	l = m.Keys.Size()
+	n += 1 + l + sovEndDevice(uint64(l))
+	return n
+}
+
+func (m *EndDeviceAuthenticationCode) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovEndDevice(uint64(l))
+	}
+	if m.ValidFrom != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.ValidFrom)
+		n += 1 + l + sovEndDevice(uint64(l))
+	}
+	if m.ValidTo != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.ValidTo)
+		n += 1 + l + sovEndDevice(uint64(l))
+	}
+	return n
+}
+
+func (m *EndDevice) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.EndDeviceIdentifiers.Size()
+	n += 1 + l + sovEndDevice(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.CreatedAt)
+	n += 1 + l + sovEndDevice(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.UpdatedAt)
+	n += 1 + l + sovEndDevice(uint64(l))
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovEndDevice(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovEndDevice(uint64(l))
+	}
+	if len(m.Attributes) > 0 {
+		for k, v := range m.Attributes {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovEndDevice(uint64(len(k))) + 1 + len(v) + sovEndDevice(uint64(len(v)))
+			n += mapEntrySize + 1 + sovEndDevice(uint64(mapEntrySize))
+		}
+	}
+	if m.VersionIDs != nil {
+		l = m.VersionIDs.Size()
+		n += 1 + l + sovEndDevice(uint64(l))
+	}
+	l = len(m.ServiceProfileID)
+	if l > 0 {
+		n += 1 + l + sovEndDevice(uint64(l))
+	}
+	l = len(m.NetworkServerAddress)
+	if l > 0 {
+		n += 1 + l + sovEndDevice(uint64(l))
+	}
+	l = len(m.ApplicationServerAddress)
+	if l > 0 {
+		n += 1 + l + sovEndDevice(uint64(l))
+	}
+	l = len(m.JoinServerAddress)
+	if l > 0 {
+		n += 1 + l + sovEndDevice(uint64(l))
+	}
+	if len(m.Locations) > 0 {
+		for k, v := range m.Locations {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovEndDevice(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovEndDevice(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovEndDevice(uint64(mapEntrySize))
+		}
+	}
+	if m.SupportsClassB {
+		n += 2
+	}
+	if m.SupportsClassC {
+		n += 2
+	}
+	if m.LoRaWANVersion != 0 {
+		n += 1 + sovEndDevice(uint64(m.LoRaWANVersion))
+	}
+	if m.LoRaWANPHYVersion != 0 {
+		n += 2 + sovEndDevice(uint64(m.LoRaWANPHYVersion))
+	}
+	l = len(m.FrequencyPlanID)
+	if l > 0 {
+		n += 2 + l + sovEndDevice(uint64(l))
+	}
+	if m.MinFrequency != 0 {
+		n += 2 + sovEndDevice(m.MinFrequency)
+	}
+	if m.MaxFrequency != 0 {
+		n += 2 + sovEndDevice(m.MaxFrequency)
+	}
+	if m.SupportsJoin {
+		n += 3
+	}
+	if m.ResetsJoinNonces {
+		n += 3
+	}
+	if m.RootKeys != nil {
+		l = m.RootKeys.Size()
+		n += 2 + l + sovEndDevice(uint64(l))
+	}
+	if m.NetID != nil {
+		l = m.NetID.Size()
+		n += 2 + l + sovEndDevice(uint64(l))
+	}
+	if m.MACSettings != nil {
+		l = m.MACSettings.Size()
+		n += 2 + l + sovEndDevice(uint64(l))
+	}
+	if m.MACState != nil {
+		l = m.MACState.Size()
+		n += 2 + l + sovEndDevice(uint64(l))
+	}
+	if m.Session != nil {
+		l = m.Session.Size()
+		n += 2 + l + sovEndDevice(uint64(l))
+	}
+	if m.PendingSession != nil {
+		l = m.PendingSession.Size()
+		n += 2 + l + sovEndDevice(uint64(l))
+	}
+	if m.LastDevNonce != 0 {
+		n += 2 + sovEndDevice(uint64(m.LastDevNonce))
+	}
+	if len(m.UsedDevNonces) > 0 {
+		l = 0
+		for _, e := range m.UsedDevNonces {
+			l += sovEndDevice(uint64(e))
+		}
+		n += 2 + sovEndDevice(uint64(l)) + l
+	}
+	if m.LastJoinNonce != 0 {
+		n += 2 + sovEndDevice(uint64(m.LastJoinNonce))
+	}
+	if m.LastRJCount0 != 0 {
+		n += 2 + sovEndDevice(uint64(m.LastRJCount0))
+	}
+	if m.LastRJCount1 != 0 {
+		n += 2 + sovEndDevice(uint64(m.LastRJCount1))
+	}
+	if m.LastDevStatusReceivedAt != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastDevStatusReceivedAt)
+		n += 2 + l + sovEndDevice(uint64(l))
+	}
+	if m.PowerState != 0 {
+		n += 2 + sovEndDevice(uint64(m.PowerState))
+	}
+	if m.BatteryPercentage != nil {
+		l = m.BatteryPercentage.Size()
+		n += 2 + l + sovEndDevice(uint64(l))
+	}
+	if m.DownlinkMargin != 0 {
+		n += 2 + sovEndDevice(uint64(m.DownlinkMargin))
+	}
+	if len(m.RecentADRUplinks) > 0 {
+		for _, e := range m.RecentADRUplinks {
+			l = e.Size()
+			n += 2 + l + sovEndDevice(uint64(l))
+		}
+	}
+	if len(m.RecentUplinks) > 0 {
+		for _, e := range m.RecentUplinks {
+			l = e.Size()
+			n += 2 + l + sovEndDevice(uint64(l))
+		}
+	}
+	if len(m.RecentDownlinks) > 0 {
+		for _, e := range m.RecentDownlinks {
+			l = e.Size()
+			n += 2 + l + sovEndDevice(uint64(l))
+		}
+	}
+	if len(m.QueuedApplicationDownlinks) > 0 {
+		for _, e := range m.QueuedApplicationDownlinks {
+			l = e.Size()
+			n += 2 + l + sovEndDevice(uint64(l))
+		}
+	}
+	if m.Formatters != nil {
+		l = m.Formatters.Size()
+		n += 2 + l + sovEndDevice(uint64(l))
+	}
+	l = len(m.ProvisionerID)
+	if l > 0 {
+		n += 2 + l + sovEndDevice(uint64(l))
+	}
+	if m.ProvisioningData != nil {
+		l = m.ProvisioningData.Size()
+		n += 2 + l + sovEndDevice(uint64(l))
+	}
+	if m.PendingMACState != nil {
+		l = m.PendingMACState.Size()
+		n += 2 + l + sovEndDevice(uint64(l))
+	}
+	if m.Multicast {
+		n += 3
+	}
+	if m.ClaimAuthenticationCode != nil {
+		l = m.ClaimAuthenticationCode.Size()
+		n += 2 + l + sovEndDevice(uint64(l))
+	}
+	l = len(m.NetworkServerKEKLabel)
+	if l > 0 {
+		n += 2 + l + sovEndDevice(uint64(l))
+	}
+	l = len(m.ApplicationServerKEKLabel)
+	if l > 0 {
+		n += 2 + l + sovEndDevice(uint64(l))
+	}
+	l = len(m.ApplicationServerID)
+	if l > 0 {
+		n += 2 + l + sovEndDevice(uint64(l))
+	}
+	if m.Picture != nil {
+		l = m.Picture.Size()
+		n += 2 + l + sovEndDevice(uint64(l))
+	}
+	if m.SkipPayloadCrypto {
+		n += 3
+	}
+	if m.ProfileIDs != nil {
+		l = m.ProfileIDs.Size()
+		n += 2 + l + sovEndDevice(uint64(l))
+	}
+	return n
+}
+
+func (m *EndDevices) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.EndDevices) > 0 {
+		for _, e := range m.EndDevices {
+			l = e.Size()
+			n += 1 + l + sovEndDevice(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CreateEndDeviceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.EndDevice.Size()
+	n += 1 + l + sovEndDevice(uint64(l))
+	return n
+}
+
+func (m *UpdateEndDeviceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.EndDevice.Size()
+	n += 1 + l + sovEndDevice(uint64(l))
+	l = m.FieldMask.Size()
+	n += 1 + l + sovEndDevice(uint64(l))
+	return n
+}
+
+func (m *GetEndDeviceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.EndDeviceIdentifiers.Size()
+	n += 1 + l + sovEndDevice(uint64(l))
+	l = m.FieldMask.Size()
+	n += 1 + l + sovEndDevice(uint64(l))
+	return n
+}
+
+func (m *GetEndDeviceIdentifiersForEUIsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.JoinEUI.Size()
+	n += 1 + l + sovEndDevice(uint64(l))
+	l = m.DevEUI.Size()
+	n += 1 + l + sovEndDevice(uint64(l))
+	return n
+}
+
+func (m *ListEndDevicesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ApplicationIdentifiers.Size()
+	n += 1 + l + sovEndDevice(uint64(l))
+	l = m.FieldMask.Size()
+	n += 1 + l + sovEndDevice(uint64(l))
+	l = len(m.Order)
+	if l > 0 {
+		n += 1 + l + sovEndDevice(uint64(l))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovEndDevice(uint64(m.Limit))
+	}
+	if m.Page != 0 {
+		n += 1 + sovEndDevice(uint64(m.Page))
+	}
+	return n
+}
+
+func (m *SetEndDeviceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.EndDevice.Size()
+	n += 1 + l + sovEndDevice(uint64(l))
+	l = m.FieldMask.Size()
+	n += 1 + l + sovEndDevice(uint64(l))
+	return n
+}
+
+func (m *BatchCreateEndDevicesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ApplicationIdentifiers.Size()
+	n += 1 + l + sovEndDevice(uint64(l))
+	if len(m.Requests) > 0 {
+		for _, e := range m.Requests {
+			l = e.Size()
+			n += 1 + l + sovEndDevice(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BatchGetEndDevicesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ApplicationIdentifiers.Size()
+	n += 1 + l + sovEndDevice(uint64(l))
+	if len(m.Requests) > 0 {
+		for _, e := range m.Requests {
+			l = e.Size()
+			n += 1 + l + sovEndDevice(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BatchUpdateEndDevicesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ApplicationIdentifiers.Size()
+	n += 1 + l + sovEndDevice(uint64(l))
+	if len(m.Requests) > 0 {
+		for _, e := range m.Requests {
+			l = e.Size()
+			n += 1 + l + sovEndDevice(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BatchSetEndDevicesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ApplicationIdentifiers.Size()
+	n += 1 + l + sovEndDevice(uint64(l))
+	if len(m.Requests) > 0 {
+		for _, e := range m.Requests {
+			l = e.Size()
+			n += 1 + l + sovEndDevice(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BatchDeleteEndDevicesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ApplicationIdentifiers.Size()
+	n += 1 + l + sovEndDevice(uint64(l))
+	if len(m.EndDeviceIDs) > 0 {
+		for _, e := range m.EndDeviceIDs {
+			l = e.Size()
+			n += 1 + l + sovEndDevice(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BatchEndDevicesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.Size()
+			n += 1 + l + sovEndDevice(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BatchEndDevicesResponse_Result) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.IDs.Size()
+	n += 1 + l + sovEndDevice(uint64(l))
+	if m.EndDevice != nil {
+		l = m.EndDevice.Size()
+		n += 1 + l + sovEndDevice(uint64(l))
+	}
+	if m.Error != nil {
+		l = m.Error.Size()
+		n += 1 + l + sovEndDevice(uint64(l))
+	}
+	return n
+}
+
+func (m *EndDeviceProfileIdentifiers) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ApplicationIDs != nil {
+		l = m.ApplicationIDs.Size()
+		n += 1 + l + sovEndDevice(uint64(l))
+	}
+	l = len(m.ProfileID)
+	if l > 0 {
+		n += 1 + l + sovEndDevice(uint64(l))
+	}
+	return n
+}
+
+func (m *EndDeviceProfile) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.EndDeviceProfileIdentifiers.Size()
+	n += 1 + l + sovEndDevice(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.CreatedAt)
+	n += 1 + l + sovEndDevice(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.UpdatedAt)
+	n += 1 + l + sovEndDevice(uint64(l))
+	if m.Version != 0 {
+		n += 1 + sovEndDevice(uint64(m.Version))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovEndDevice(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovEndDevice(uint64(l))
+	}
+	if m.MACSettings != nil {
+		l = m.MACSettings.Size()
+		n += 1 + l + sovEndDevice(uint64(l))
+	}
+	if m.LoRaWANVersion != 0 {
+		n += 1 + sovEndDevice(uint64(m.LoRaWANVersion))
+	}
+	if m.LoRaWANPHYVersion != 0 {
+		n += 1 + sovEndDevice(uint64(m.LoRaWANPHYVersion))
+	}
+	l = len(m.FrequencyPlanID)
+	if l > 0 {
+		n += 1 + l + sovEndDevice(uint64(l))
+	}
+	return n
+}
+
+func (m *EndDeviceProfiles) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Profiles) > 0 {
+		for _, e := range m.Profiles {
+			l = e.Size()
+			n += 1 + l + sovEndDevice(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GetEndDeviceProfileRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.EndDeviceProfileIdentifiers.Size()
+	n += 1 + l + sovEndDevice(uint64(l))
+	l = m.FieldMask.Size()
+	n += 1 + l + sovEndDevice(uint64(l))
+	return n
+}
+
+func (m *ListEndDeviceProfilesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ApplicationIDs != nil {
+		l = m.ApplicationIDs.Size()
+		n += 1 + l + sovEndDevice(uint64(l))
+	}
+	l = m.FieldMask.Size()
+	n += 1 + l + sovEndDevice(uint64(l))
+	if m.Limit != 0 {
+		n += 1 + sovEndDevice(uint64(m.Limit))
+	}
+	if m.Page != 0 {
+		n += 1 + sovEndDevice(uint64(m.Page))
+	}
+	return n
+}
+
+func (m *SetEndDeviceProfileRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Profile.Size()
+	n += 1 + l + sovEndDevice(uint64(l))
+	l = m.FieldMask.Size()
+	n += 1 + l + sovEndDevice(uint64(l))
+	return n
+}
+
+func (m *EndDeviceTemplate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.EndDevice.Size()
+	n += 1 + l + sovEndDevice(uint64(l))
+	l = m.FieldMask.Size()
+	n += 1 + l + sovEndDevice(uint64(l))
+	l = len(m.MappingKey)
+	if l > 0 {
+		n += 1 + l + sovEndDevice(uint64(l))
+	}
+	return n
+}
+
+func (m *EndDeviceTemplateFormat) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovEndDevice(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovEndDevice(uint64(l))
+	}
+	if len(m.FileExtensions) > 0 {
+		for _, s := range m.FileExtensions {
+			l = len(s)
+			n += 1 + l + sovEndDevice(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EndDeviceTemplateFormats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Formats) > 0 {
+		for k, v := range m.Formats {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovEndDevice(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovEndDevice(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovEndDevice(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *ConvertEndDeviceTemplateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FormatID)
+	if l > 0 {
+		n += 1 + l + sovEndDevice(uint64(l))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovEndDevice(uint64(l))
+	}
+	if m.CSVOptions != nil {
+		l = m.CSVOptions.Size()
+		n += 1 + l + sovEndDevice(uint64(l))
+	}
+	return n
+}
+
+func (m *ConvertEndDeviceTemplateRequest_CSVOptions) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Separator)
+	if l > 0 {
+		n += 1 + l + sovEndDevice(uint64(l))
+	}
+	if m.Base64 {
+		n += 2
+	}
+	return n
+}
+
+func sovEndDevice(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEndDevice(x uint64) (n int) {
+	return sovEndDevice((x << 1) ^ uint64((int64(x) >> 63)))
+}
+func (this *Session) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Session{`,
+		`DevAddr:` + fmt.Sprintf("%v", this.DevAddr) + `,`,
+		`SessionKeys:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.SessionKeys), "SessionKeys", "SessionKeys", 1), `&`, ``, 1) + `,`,
+		`LastFCntUp:` + fmt.Sprintf("%v", this.LastFCntUp) + `,`,
+		`LastNFCntDown:` + fmt.Sprintf("%v", this.LastNFCntDown) + `,`,
+		`LastAFCntDown:` + fmt.Sprintf("%v", this.LastAFCntDown) + `,`,
+		`LastConfFCntDown:` + fmt.Sprintf("%v", this.LastConfFCntDown) + `,`,
+		`StartedAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.StartedAt), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACParameters) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForChannels := "[]*MACParameters_Channel{"
+	for _, f := range this.Channels {
+		repeatedStringForChannels += strings.Replace(fmt.Sprintf("%v", f), "MACParameters_Channel", "MACParameters_Channel", 1) + ","
+	}
+	repeatedStringForChannels += "}"
+	s := strings.Join([]string{`&MACParameters{`,
+		`MaxEIRP:` + fmt.Sprintf("%v", this.MaxEIRP) + `,`,
+		`ADRDataRateIndex:` + fmt.Sprintf("%v", this.ADRDataRateIndex) + `,`,
+		`ADRTxPowerIndex:` + fmt.Sprintf("%v", this.ADRTxPowerIndex) + `,`,
+		`ADRNbTrans:` + fmt.Sprintf("%v", this.ADRNbTrans) + `,`,
+		`ADRAckLimit:` + fmt.Sprintf("%v", this.ADRAckLimit) + `,`,
+		`ADRAckDelay:` + fmt.Sprintf("%v", this.ADRAckDelay) + `,`,
+		`Rx1Delay:` + fmt.Sprintf("%v", this.Rx1Delay) + `,`,
+		`Rx1DataRateOffset:` + fmt.Sprintf("%v", this.Rx1DataRateOffset) + `,`,
+		`Rx2DataRateIndex:` + fmt.Sprintf("%v", this.Rx2DataRateIndex) + `,`,
+		`Rx2Frequency:` + fmt.Sprintf("%v", this.Rx2Frequency) + `,`,
+		`MaxDutyCycle:` + fmt.Sprintf("%v", this.MaxDutyCycle) + `,`,
+		`RejoinTimePeriodicity:` + fmt.Sprintf("%v", this.RejoinTimePeriodicity) + `,`,
+		`RejoinCountPeriodicity:` + fmt.Sprintf("%v", this.RejoinCountPeriodicity) + `,`,
+		`PingSlotFrequency:` + fmt.Sprintf("%v", this.PingSlotFrequency) + `,`,
+		`PingSlotDataRateIndex:` + fmt.Sprintf("%v", this.PingSlotDataRateIndex) + `,`,
+		`BeaconFrequency:` + fmt.Sprintf("%v", this.BeaconFrequency) + `,`,
+		`Channels:` + repeatedStringForChannels + `,`,
+		`UplinkDwellTime:` + strings.Replace(fmt.Sprintf("%v", this.UplinkDwellTime), "BoolValue", "types.BoolValue", 1) + `,`,
+		`DownlinkDwellTime:` + strings.Replace(fmt.Sprintf("%v", this.DownlinkDwellTime), "BoolValue", "types.BoolValue", 1) + `,`,
+		`ADRAckLimitExponent:` + strings.Replace(fmt.Sprintf("%v", this.ADRAckLimitExponent), "ADRAckLimitExponentValue", "ADRAckLimitExponentValue", 1) + `,`,
+		`ADRAckDelayExponent:` + strings.Replace(fmt.Sprintf("%v", this.ADRAckDelayExponent), "ADRAckDelayExponentValue", "ADRAckDelayExponentValue", 1) + `,`,
+		`PingSlotDataRateIndexValue:` + strings.Replace(fmt.Sprintf("%v", this.PingSlotDataRateIndexValue), "DataRateIndexValue", "DataRateIndexValue", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACParameters_Channel) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACParameters_Channel{`,
+		`UplinkFrequency:` + fmt.Sprintf("%v", this.UplinkFrequency) + `,`,
+		`DownlinkFrequency:` + fmt.Sprintf("%v", this.DownlinkFrequency) + `,`,
+		`MinDataRateIndex:` + fmt.Sprintf("%v", this.MinDataRateIndex) + `,`,
+		`MaxDataRateIndex:` + fmt.Sprintf("%v", this.MaxDataRateIndex) + `,`,
+		`EnableUplink:` + fmt.Sprintf("%v", this.EnableUplink) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EndDeviceBrand) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EndDeviceBrand{`,
+		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`URL:` + fmt.Sprintf("%v", this.URL) + `,`,
+		`Logos:` + fmt.Sprintf("%v", this.Logos) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EndDeviceModel) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EndDeviceModel{`,
+		`BrandID:` + fmt.Sprintf("%v", this.BrandID) + `,`,
+		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EndDeviceVersionIdentifiers) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EndDeviceVersionIdentifiers{`,
+		`BrandID:` + fmt.Sprintf("%v", this.BrandID) + `,`,
+		`ModelID:` + fmt.Sprintf("%v", this.ModelID) + `,`,
+		`HardwareVersion:` + fmt.Sprintf("%v", this.HardwareVersion) + `,`,
+		`FirmwareVersion:` + fmt.Sprintf("%v", this.FirmwareVersion) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EndDeviceVersion) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForProfileIDs := make([]string, 0, len(this.ProfileIDs))
+	for k := range this.ProfileIDs {
+		keysForProfileIDs = append(keysForProfileIDs, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForProfileIDs)
+	mapStringForProfileIDs := "map[string]string{"
+	for _, k := range keysForProfileIDs {
+		mapStringForProfileIDs += fmt.Sprintf("%v: %v,", k, this.ProfileIDs[k])
+	}
+	mapStringForProfileIDs += "}"
+	s := strings.Join([]string{`&EndDeviceVersion{`,
+		`EndDeviceVersionIdentifiers:` + strings.Replace(strings.Replace(this.EndDeviceVersionIdentifiers.String(), "EndDeviceVersionIdentifiers", "EndDeviceVersionIdentifiers", 1), `&`, ``, 1) + `,`,
+		`LoRaWANVersion:` + fmt.Sprintf("%v", this.LoRaWANVersion) + `,`,
+		`LoRaWANPHYVersion:` + fmt.Sprintf("%v", this.LoRaWANPHYVersion) + `,`,
+		`FrequencyPlanID:` + fmt.Sprintf("%v", this.FrequencyPlanID) + `,`,
+		`Photos:` + fmt.Sprintf("%v", this.Photos) + `,`,
+		`SupportsClassB:` + fmt.Sprintf("%v", this.SupportsClassB) + `,`,
+		`SupportsClassC:` + fmt.Sprintf("%v", this.SupportsClassC) + `,`,
+		`DefaultMACSettings:` + strings.Replace(this.DefaultMACSettings.String(), "MACSettings", "MACSettings", 1) + `,`,
+		`MinFrequency:` + fmt.Sprintf("%v", this.MinFrequency) + `,`,
+		`MaxFrequency:` + fmt.Sprintf("%v", this.MaxFrequency) + `,`,
+		`SupportsJoin:` + fmt.Sprintf("%v", this.SupportsJoin) + `,`,
+		`ResetsJoinNonces:` + fmt.Sprintf("%v", this.ResetsJoinNonces) + `,`,
+		`DefaultFormatters:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.DefaultFormatters), "MessagePayloadFormatters", "MessagePayloadFormatters", 1), `&`, ``, 1) + `,`,
+		`ProfileIDs:` + mapStringForProfileIDs + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACSettings) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACSettings{`,
+		`ClassBTimeout:` + strings.Replace(fmt.Sprintf("%v", this.ClassBTimeout), "Duration", "types.Duration", 1) + `,`,
+		`PingSlotPeriodicity:` + strings.Replace(fmt.Sprintf("%v", this.PingSlotPeriodicity), "PingSlotPeriodValue", "PingSlotPeriodValue", 1) + `,`,
+		`PingSlotDataRateIndex:` + strings.Replace(fmt.Sprintf("%v", this.PingSlotDataRateIndex), "DataRateIndexValue", "DataRateIndexValue", 1) + `,`,
+		`PingSlotFrequency:` + strings.Replace(fmt.Sprintf("%v", this.PingSlotFrequency), "UInt64Value", "types.UInt64Value", 1) + `,`,
+		`ClassCTimeout:` + strings.Replace(fmt.Sprintf("%v", this.ClassCTimeout), "Duration", "types.Duration", 1) + `,`,
+		`Rx1Delay:` + strings.Replace(fmt.Sprintf("%v", this.Rx1Delay), "RxDelayValue", "RxDelayValue", 1) + `,`,
+		`Rx1DataRateOffset:` + strings.Replace(fmt.Sprintf("%v", this.Rx1DataRateOffset), "UInt32Value", "types.UInt32Value", 1) + `,`,
+		`Rx2DataRateIndex:` + strings.Replace(fmt.Sprintf("%v", this.Rx2DataRateIndex), "DataRateIndexValue", "DataRateIndexValue", 1) + `,`,
+		`Rx2Frequency:` + strings.Replace(fmt.Sprintf("%v", this.Rx2Frequency), "UInt64Value", "types.UInt64Value", 1) + `,`,
+		`FactoryPresetFrequencies:` + fmt.Sprintf("%v", this.FactoryPresetFrequencies) + `,`,
+		`MaxDutyCycle:` + strings.Replace(fmt.Sprintf("%v", this.MaxDutyCycle), "AggregatedDutyCycleValue", "AggregatedDutyCycleValue", 1) + `,`,
+		`Supports32BitFCnt:` + strings.Replace(fmt.Sprintf("%v", this.Supports32BitFCnt), "BoolValue", "types.BoolValue", 1) + `,`,
+		`UseADR:` + strings.Replace(fmt.Sprintf("%v", this.UseADR), "BoolValue", "types.BoolValue", 1) + `,`,
+		`ADRMargin:` + strings.Replace(fmt.Sprintf("%v", this.ADRMargin), "FloatValue", "types.FloatValue", 1) + `,`,
+		`ResetsFCnt:` + strings.Replace(fmt.Sprintf("%v", this.ResetsFCnt), "BoolValue", "types.BoolValue", 1) + `,`,
+		`StatusTimePeriodicity:` + strings.Replace(fmt.Sprintf("%v", this.StatusTimePeriodicity), "Duration", "types.Duration", 1) + `,`,
+		`StatusCountPeriodicity:` + strings.Replace(fmt.Sprintf("%v", this.StatusCountPeriodicity), "UInt32Value", "types.UInt32Value", 1) + `,`,
+		`DesiredRx1Delay:` + strings.Replace(fmt.Sprintf("%v", this.DesiredRx1Delay), "RxDelayValue", "RxDelayValue", 1) + `,`,
+		`DesiredRx1DataRateOffset:` + strings.Replace(fmt.Sprintf("%v", this.DesiredRx1DataRateOffset), "UInt32Value", "types.UInt32Value", 1) + `,`,
+		`DesiredRx2DataRateIndex:` + strings.Replace(fmt.Sprintf("%v", this.DesiredRx2DataRateIndex), "DataRateIndexValue", "DataRateIndexValue", 1) + `,`,
+		`DesiredRx2Frequency:` + strings.Replace(fmt.Sprintf("%v", this.DesiredRx2Frequency), "UInt64Value", "types.UInt64Value", 1) + `,`,
+		`DesiredMaxDutyCycle:` + strings.Replace(fmt.Sprintf("%v", this.DesiredMaxDutyCycle), "AggregatedDutyCycleValue", "AggregatedDutyCycleValue", 1) + `,`,
+		`DesiredADRAckLimitExponent:` + strings.Replace(fmt.Sprintf("%v", this.DesiredADRAckLimitExponent), "ADRAckLimitExponentValue", "ADRAckLimitExponentValue", 1) + `,`,
+		`DesiredADRAckDelayExponent:` + strings.Replace(fmt.Sprintf("%v", this.DesiredADRAckDelayExponent), "ADRAckDelayExponentValue", "ADRAckDelayExponentValue", 1) + `,`,
+		`BeaconFrequency:` + strings.Replace(fmt.Sprintf("%v", this.BeaconFrequency), "UInt64Value", "types.UInt64Value", 1) + `,`,
+		`DesiredPingSlotDataRateIndex:` + strings.Replace(fmt.Sprintf("%v", this.DesiredPingSlotDataRateIndex), "DataRateIndexValue", "DataRateIndexValue", 1) + `,`,
+		`DesiredPingSlotFrequency:` + strings.Replace(fmt.Sprintf("%v", this.DesiredPingSlotFrequency), "UInt64Value", "types.UInt64Value", 1) + `,`,
+		`DesiredBeaconFrequency:` + strings.Replace(fmt.Sprintf("%v", this.DesiredBeaconFrequency), "UInt64Value", "types.UInt64Value", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACState) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForQueuedResponses := "[]*MACCommand{"
+	for _, f := range this.QueuedResponses {
+		repeatedStringForQueuedResponses += strings.Replace(fmt.Sprintf("%v", f), "MACCommand", "MACCommand", 1) + ","
+	}
+	repeatedStringForQueuedResponses += "}"
+	repeatedStringForPendingRequests := "[]*MACCommand{"
+	for _, f := range this.PendingRequests {
+		repeatedStringForPendingRequests += strings.Replace(fmt.Sprintf("%v", f), "MACCommand", "MACCommand", 1) + ","
+	}
+	repeatedStringForPendingRequests += "}"
+	repeatedStringForRecentUplinks := "[]*UplinkMessage{"
+	for _, f := range this.RecentUplinks {
+		repeatedStringForRecentUplinks += strings.Replace(fmt.Sprintf("%v", f), "UplinkMessage", "UplinkMessage", 1) + ","
+	}
+	repeatedStringForRecentUplinks += "}"
+	repeatedStringForRecentDownlinks := "[]*DownlinkMessage{"
+	for _, f := range this.RecentDownlinks {
+		repeatedStringForRecentDownlinks += strings.Replace(fmt.Sprintf("%v", f), "DownlinkMessage", "DownlinkMessage", 1) + ","
+	}
+	repeatedStringForRecentDownlinks += "}"
+	s := strings.Join([]string{`&MACState{`,
+		`CurrentParameters:` + strings.Replace(strings.Replace(this.CurrentParameters.String(), "MACParameters", "MACParameters", 1), `&`, ``, 1) + `,`,
+		`DesiredParameters:` + strings.Replace(strings.Replace(this.DesiredParameters.String(), "MACParameters", "MACParameters", 1), `&`, ``, 1) + `,`,
+		`DeviceClass:` + fmt.Sprintf("%v", this.DeviceClass) + `,`,
+		`LoRaWANVersion:` + fmt.Sprintf("%v", this.LoRaWANVersion) + `,`,
+		`LastConfirmedDownlinkAt:` + strings.Replace(fmt.Sprintf("%v", this.LastConfirmedDownlinkAt), "Timestamp", "types.Timestamp", 1) + `,`,
+		`LastDevStatusFCntUp:` + fmt.Sprintf("%v", this.LastDevStatusFCntUp) + `,`,
+		`PingSlotPeriodicity:` + strings.Replace(fmt.Sprintf("%v", this.PingSlotPeriodicity), "PingSlotPeriodValue", "PingSlotPeriodValue", 1) + `,`,
+		`PendingApplicationDownlink:` + strings.Replace(fmt.Sprintf("%v", this.PendingApplicationDownlink), "ApplicationDownlink", "ApplicationDownlink", 1) + `,`,
+		`QueuedResponses:` + repeatedStringForQueuedResponses + `,`,
+		`PendingRequests:` + repeatedStringForPendingRequests + `,`,
+		`QueuedJoinAccept:` + strings.Replace(fmt.Sprintf("%v", this.QueuedJoinAccept), "MACState_JoinAccept", "MACState_JoinAccept", 1) + `,`,
+		`PendingJoinRequest:` + strings.Replace(fmt.Sprintf("%v", this.PendingJoinRequest), "JoinRequest", "JoinRequest", 1) + `,`,
+		`RxWindowsAvailable:` + fmt.Sprintf("%v", this.RxWindowsAvailable) + `,`,
+		`RecentUplinks:` + repeatedStringForRecentUplinks + `,`,
+		`RecentDownlinks:` + repeatedStringForRecentDownlinks + `,`,
+		`LastNetworkInitiatedDownlinkAt:` + strings.Replace(fmt.Sprintf("%v", this.LastNetworkInitiatedDownlinkAt), "Timestamp", "types.Timestamp", 1) + `,`,
+		`QueuedForceRejoinReq:` + strings.Replace(fmt.Sprintf("%v", this.QueuedForceRejoinReq), "MACCommand_ForceRejoinReq", "MACCommand_ForceRejoinReq", 1) + `,`,
+		`LastRJCount0:` + fmt.Sprintf("%v", this.LastRJCount0) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACState_JoinAccept) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACState_JoinAccept{`,
+		`Payload:` + fmt.Sprintf("%v", this.Payload) + `,`,
+		`Request:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Request), "JoinRequest", "JoinRequest", 1), `&`, ``, 1) + `,`,
+		`Keys:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Keys), "SessionKeys", "SessionKeys", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EndDeviceAuthenticationCode) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EndDeviceAuthenticationCode{`,
+		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
+		`ValidFrom:` + strings.Replace(fmt.Sprintf("%v", this.ValidFrom), "Timestamp", "types.Timestamp", 1) + `,`,
+		`ValidTo:` + strings.Replace(fmt.Sprintf("%v", this.ValidTo), "Timestamp", "types.Timestamp", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EndDevice) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForRecentADRUplinks := "[]*UplinkMessage{"
+	for _, f := range this.RecentADRUplinks {
+		repeatedStringForRecentADRUplinks += strings.Replace(fmt.Sprintf("%v", f), "UplinkMessage", "UplinkMessage", 1) + ","
+	}
+	repeatedStringForRecentADRUplinks += "}"
+	repeatedStringForRecentUplinks := "[]*UplinkMessage{"
+	for _, f := range this.RecentUplinks {
+		repeatedStringForRecentUplinks += strings.Replace(fmt.Sprintf("%v", f), "UplinkMessage", "UplinkMessage", 1) + ","
+	}
+	repeatedStringForRecentUplinks += "}"
+	repeatedStringForRecentDownlinks := "[]*DownlinkMessage{"
+	for _, f := range this.RecentDownlinks {
+		repeatedStringForRecentDownlinks += strings.Replace(fmt.Sprintf("%v", f), "DownlinkMessage", "DownlinkMessage", 1) + ","
+	}
+	repeatedStringForRecentDownlinks += "}"
+	repeatedStringForQueuedApplicationDownlinks := "[]*ApplicationDownlink{"
+	for _, f := range this.QueuedApplicationDownlinks {
+		repeatedStringForQueuedApplicationDownlinks += strings.Replace(fmt.Sprintf("%v", f), "ApplicationDownlink", "ApplicationDownlink", 1) + ","
+	}
+	repeatedStringForQueuedApplicationDownlinks += "}"
+	keysForAttributes := make([]string, 0, len(this.Attributes))
+	for k := range this.Attributes {
+		keysForAttributes = append(keysForAttributes, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForAttributes)
+	mapStringForAttributes := "map[string]string{"
+	for _, k := range keysForAttributes {
+		mapStringForAttributes += fmt.Sprintf("%v: %v,", k, this.Attributes[k])
+	}
+	mapStringForAttributes += "}"
+	keysForLocations := make([]string, 0, len(this.Locations))
+	for k := range this.Locations {
+		keysForLocations = append(keysForLocations, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForLocations)
+	mapStringForLocations := "map[string]*Location{"
+	for _, k := range keysForLocations {
+		mapStringForLocations += fmt.Sprintf("%v: %v,", k, this.Locations[k])
+	}
+	mapStringForLocations += "}"
+	s := strings.Join([]string{`&EndDevice{`,
+		`EndDeviceIdentifiers:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.EndDeviceIdentifiers), "EndDeviceIdentifiers", "EndDeviceIdentifiers", 1), `&`, ``, 1) + `,`,
+		`CreatedAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.CreatedAt), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
+		`UpdatedAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.UpdatedAt), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Description:` + fmt.Sprintf("%v", this.Description) + `,`,
+		`Attributes:` + mapStringForAttributes + `,`,
+		`VersionIDs:` + strings.Replace(this.VersionIDs.String(), "EndDeviceVersionIdentifiers", "EndDeviceVersionIdentifiers", 1) + `,`,
+		`ServiceProfileID:` + fmt.Sprintf("%v", this.ServiceProfileID) + `,`,
+		`NetworkServerAddress:` + fmt.Sprintf("%v", this.NetworkServerAddress) + `,`,
+		`ApplicationServerAddress:` + fmt.Sprintf("%v", this.ApplicationServerAddress) + `,`,
+		`JoinServerAddress:` + fmt.Sprintf("%v", this.JoinServerAddress) + `,`,
+		`Locations:` + mapStringForLocations + `,`,
+		`SupportsClassB:` + fmt.Sprintf("%v", this.SupportsClassB) + `,`,
+		`SupportsClassC:` + fmt.Sprintf("%v", this.SupportsClassC) + `,`,
+		`LoRaWANVersion:` + fmt.Sprintf("%v", this.LoRaWANVersion) + `,`,
+		`LoRaWANPHYVersion:` + fmt.Sprintf("%v", this.LoRaWANPHYVersion) + `,`,
+		`FrequencyPlanID:` + fmt.Sprintf("%v", this.FrequencyPlanID) + `,`,
+		`MinFrequency:` + fmt.Sprintf("%v", this.MinFrequency) + `,`,
+		`MaxFrequency:` + fmt.Sprintf("%v", this.MaxFrequency) + `,`,
+		`SupportsJoin:` + fmt.Sprintf("%v", this.SupportsJoin) + `,`,
+		`ResetsJoinNonces:` + fmt.Sprintf("%v", this.ResetsJoinNonces) + `,`,
+		`RootKeys:` + strings.Replace(fmt.Sprintf("%v", this.RootKeys), "RootKeys", "RootKeys", 1) + `,`,
+		`NetID:` + fmt.Sprintf("%v", this.NetID) + `,`,
+		`MACSettings:` + strings.Replace(this.MACSettings.String(), "MACSettings", "MACSettings", 1) + `,`,
+		`MACState:` + strings.Replace(this.MACState.String(), "MACState", "MACState", 1) + `,`,
+		`Session:` + strings.Replace(this.Session.String(), "Session", "Session", 1) + `,`,
+		`PendingSession:` + strings.Replace(this.PendingSession.String(), "Session", "Session", 1) + `,`,
+		`LastDevNonce:` + fmt.Sprintf("%v", this.LastDevNonce) + `,`,
+		`UsedDevNonces:` + fmt.Sprintf("%v", this.UsedDevNonces) + `,`,
+		`LastJoinNonce:` + fmt.Sprintf("%v", this.LastJoinNonce) + `,`,
+		`LastRJCount0:` + fmt.Sprintf("%v", this.LastRJCount0) + `,`,
+		`LastRJCount1:` + fmt.Sprintf("%v", this.LastRJCount1) + `,`,
+		`LastDevStatusReceivedAt:` + strings.Replace(fmt.Sprintf("%v", this.LastDevStatusReceivedAt), "Timestamp", "types.Timestamp", 1) + `,`,
+		`PowerState:` + fmt.Sprintf("%v", this.PowerState) + `,`,
+		`BatteryPercentage:` + strings.Replace(fmt.Sprintf("%v", this.BatteryPercentage), "FloatValue", "types.FloatValue", 1) + `,`,
+		`DownlinkMargin:` + fmt.Sprintf("%v", this.DownlinkMargin) + `,`,
+		`RecentADRUplinks:` + repeatedStringForRecentADRUplinks + `,`,
+		`RecentUplinks:` + repeatedStringForRecentUplinks + `,`,
+		`RecentDownlinks:` + repeatedStringForRecentDownlinks + `,`,
+		`QueuedApplicationDownlinks:` + repeatedStringForQueuedApplicationDownlinks + `,`,
+		`Formatters:` + strings.Replace(fmt.Sprintf("%v", this.Formatters), "MessagePayloadFormatters", "MessagePayloadFormatters", 1) + `,`,
+		`ProvisionerID:` + fmt.Sprintf("%v", this.ProvisionerID) + `,`,
+		`ProvisioningData:` + strings.Replace(fmt.Sprintf("%v", this.ProvisioningData), "Struct", "types.Struct", 1) + `,`,
+		`PendingMACState:` + strings.Replace(this.PendingMACState.String(), "MACState", "MACState", 1) + `,`,
+		`Multicast:` + fmt.Sprintf("%v", this.Multicast) + `,`,
+		`ClaimAuthenticationCode:` + strings.Replace(this.ClaimAuthenticationCode.String(), "EndDeviceAuthenticationCode", "EndDeviceAuthenticationCode", 1) + `,`,
+		`NetworkServerKEKLabel:` + fmt.Sprintf("%v", this.NetworkServerKEKLabel) + `,`,
+		`ApplicationServerKEKLabel:` + fmt.Sprintf("%v", this.ApplicationServerKEKLabel) + `,`,
+		`ApplicationServerID:` + fmt.Sprintf("%v", this.ApplicationServerID) + `,`,
+		`Picture:` + strings.Replace(fmt.Sprintf("%v", this.Picture), "Picture", "Picture", 1) + `,`,
+		`SkipPayloadCrypto:` + fmt.Sprintf("%v", this.SkipPayloadCrypto) + `,`,
+		`ProfileIDs:` + strings.Replace(this.ProfileIDs.String(), "EndDeviceProfileIdentifiers", "EndDeviceProfileIdentifiers", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EndDevices) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForEndDevices := "[]*EndDevice{"
+	for _, f := range this.EndDevices {
+		repeatedStringForEndDevices += strings.Replace(f.String(), "EndDevice", "EndDevice", 1) + ","
+	}
+	repeatedStringForEndDevices += "}"
+	s := strings.Join([]string{`&EndDevices{`,
+		`EndDevices:` + repeatedStringForEndDevices + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CreateEndDeviceRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CreateEndDeviceRequest{`,
+		`EndDevice:` + strings.Replace(strings.Replace(this.EndDevice.String(), "EndDevice", "EndDevice", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *UpdateEndDeviceRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&UpdateEndDeviceRequest{`,
+		`EndDevice:` + strings.Replace(strings.Replace(this.EndDevice.String(), "EndDevice", "EndDevice", 1), `&`, ``, 1) + `,`,
+		`FieldMask:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.FieldMask), "FieldMask", "types.FieldMask", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetEndDeviceRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetEndDeviceRequest{`,
+		`EndDeviceIdentifiers:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.EndDeviceIdentifiers), "EndDeviceIdentifiers", "EndDeviceIdentifiers", 1), `&`, ``, 1) + `,`,
+		`FieldMask:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.FieldMask), "FieldMask", "types.FieldMask", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetEndDeviceIdentifiersForEUIsRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetEndDeviceIdentifiersForEUIsRequest{`,
+		`JoinEUI:` + fmt.Sprintf("%v", this.JoinEUI) + `,`,
+		`DevEUI:` + fmt.Sprintf("%v", this.DevEUI) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ListEndDevicesRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ListEndDevicesRequest{`,
+		`ApplicationIdentifiers:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ApplicationIdentifiers), "ApplicationIdentifiers", "ApplicationIdentifiers", 1), `&`, ``, 1) + `,`,
+		`FieldMask:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.FieldMask), "FieldMask", "types.FieldMask", 1), `&`, ``, 1) + `,`,
+		`Order:` + fmt.Sprintf("%v", this.Order) + `,`,
+		`Limit:` + fmt.Sprintf("%v", this.Limit) + `,`,
+		`Page:` + fmt.Sprintf("%v", this.Page) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SetEndDeviceRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SetEndDeviceRequest{`,
+		`EndDevice:` + strings.Replace(strings.Replace(this.EndDevice.String(), "EndDevice", "EndDevice", 1), `&`, ``, 1) + `,`,
+		`FieldMask:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.FieldMask), "FieldMask", "types.FieldMask", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *BatchCreateEndDevicesRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForRequests := "[]*CreateEndDeviceRequest{"
+	for _, f := range this.Requests {
+		repeatedStringForRequests += strings.Replace(f.String(), "CreateEndDeviceRequest", "CreateEndDeviceRequest", 1) + ","
+	}
+	repeatedStringForRequests += "}"
+	s := strings.Join([]string{`&BatchCreateEndDevicesRequest{`,
+		`ApplicationIdentifiers:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ApplicationIdentifiers), "ApplicationIdentifiers", "ApplicationIdentifiers", 1), `&`, ``, 1) + `,`,
+		`Requests:` + repeatedStringForRequests + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *BatchGetEndDevicesRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForRequests := "[]*GetEndDeviceRequest{"
+	for _, f := range this.Requests {
+		repeatedStringForRequests += strings.Replace(f.String(), "GetEndDeviceRequest", "GetEndDeviceRequest", 1) + ","
+	}
+	repeatedStringForRequests += "}"
+	s := strings.Join([]string{`&BatchGetEndDevicesRequest{`,
+		`ApplicationIdentifiers:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ApplicationIdentifiers), "ApplicationIdentifiers", "ApplicationIdentifiers", 1), `&`, ``, 1) + `,`,
+		`Requests:` + repeatedStringForRequests + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *BatchUpdateEndDevicesRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForRequests := "[]*UpdateEndDeviceRequest{"
+	for _, f := range this.Requests {
+		repeatedStringForRequests += strings.Replace(f.String(), "UpdateEndDeviceRequest", "UpdateEndDeviceRequest", 1) + ","
+	}
+	repeatedStringForRequests += "}"
+	s := strings.Join([]string{`&BatchUpdateEndDevicesRequest{`,
+		`ApplicationIdentifiers:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ApplicationIdentifiers), "ApplicationIdentifiers", "ApplicationIdentifiers", 1), `&`, ``, 1) + `,`,
+		`Requests:` + repeatedStringForRequests + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *BatchSetEndDevicesRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForRequests := "[]*SetEndDeviceRequest{"
+	for _, f := range this.Requests {
+		repeatedStringForRequests += strings.Replace(f.String(), "SetEndDeviceRequest", "SetEndDeviceRequest", 1) + ","
+	}
+	repeatedStringForRequests += "}"
+	s := strings.Join([]string{`&BatchSetEndDevicesRequest{`,
+		`ApplicationIdentifiers:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ApplicationIdentifiers), "ApplicationIdentifiers", "ApplicationIdentifiers", 1), `&`, ``, 1) + `,`,
+		`Requests:` + repeatedStringForRequests + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *BatchDeleteEndDevicesRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForEndDeviceIDs := "[]*EndDeviceIdentifiers{"
+	for _, f := range this.EndDeviceIDs {
+		repeatedStringForEndDeviceIDs += strings.Replace(fmt.Sprintf("%v", f), "EndDeviceIdentifiers", "EndDeviceIdentifiers", 1) + ","
+	}
+	repeatedStringForEndDeviceIDs += "}"
+	s := strings.Join([]string{`&BatchDeleteEndDevicesRequest{`,
+		`ApplicationIdentifiers:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ApplicationIdentifiers), "ApplicationIdentifiers", "ApplicationIdentifiers", 1), `&`, ``, 1) + `,`,
+		`EndDeviceIDs:` + repeatedStringForEndDeviceIDs + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *BatchEndDevicesResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForResults := "[]BatchEndDevicesResponse_Result{"
+	for _, f := range this.Results {
+		repeatedStringForResults += fmt.Sprintf("%v", f) + ","
+	}
+	repeatedStringForResults += "}"
+	s := strings.Join([]string{`&BatchEndDevicesResponse{`,
+		`Results:` + repeatedStringForResults + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *BatchEndDevicesResponse_Result) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&BatchEndDevicesResponse_Result{`,
+		`IDs:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.IDs), "EndDeviceIdentifiers", "EndDeviceIdentifiers", 1), `&`, ``, 1) + `,`,
+		`EndDevice:` + strings.Replace(this.EndDevice.String(), "EndDevice", "EndDevice", 1) + `,`,
+		`Error:` + strings.Replace(fmt.Sprintf("%v", this.Error), "ErrorDetails", "ErrorDetails", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EndDeviceProfileIdentifiers) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EndDeviceProfileIdentifiers{`,
+		`ApplicationIDs:` + strings.Replace(fmt.Sprintf("%v", this.ApplicationIDs), "ApplicationIdentifiers", "ApplicationIdentifiers", 1) + `,`,
+		`ProfileID:` + fmt.Sprintf("%v", this.ProfileID) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EndDeviceProfile) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EndDeviceProfile{`,
+		`EndDeviceProfileIdentifiers:` + strings.Replace(strings.Replace(this.EndDeviceProfileIdentifiers.String(), "EndDeviceProfileIdentifiers", "EndDeviceProfileIdentifiers", 1), `&`, ``, 1) + `,`,
+		`CreatedAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.CreatedAt), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
+		`UpdatedAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.UpdatedAt), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
+		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Description:` + fmt.Sprintf("%v", this.Description) + `,`,
+		`MACSettings:` + strings.Replace(this.MACSettings.String(), "MACSettings", "MACSettings", 1) + `,`,
+		`LoRaWANVersion:` + fmt.Sprintf("%v", this.LoRaWANVersion) + `,`,
+		`LoRaWANPHYVersion:` + fmt.Sprintf("%v", this.LoRaWANPHYVersion) + `,`,
+		`FrequencyPlanID:` + fmt.Sprintf("%v", this.FrequencyPlanID) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EndDeviceProfiles) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForProfiles := "[]*EndDeviceProfile{"
+	for _, f := range this.Profiles {
+		repeatedStringForProfiles += strings.Replace(f.String(), "EndDeviceProfile", "EndDeviceProfile", 1) + ","
+	}
+	repeatedStringForProfiles += "}"
+	s := strings.Join([]string{`&EndDeviceProfiles{`,
+		`Profiles:` + repeatedStringForProfiles + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetEndDeviceProfileRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetEndDeviceProfileRequest{`,
+		`EndDeviceProfileIdentifiers:` + strings.Replace(strings.Replace(this.EndDeviceProfileIdentifiers.String(), "EndDeviceProfileIdentifiers", "EndDeviceProfileIdentifiers", 1), `&`, ``, 1) + `,`,
+		`FieldMask:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.FieldMask), "FieldMask", "types.FieldMask", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ListEndDeviceProfilesRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ListEndDeviceProfilesRequest{`,
+		`ApplicationIDs:` + strings.Replace(fmt.Sprintf("%v", this.ApplicationIDs), "ApplicationIdentifiers", "ApplicationIdentifiers", 1) + `,`,
+		`FieldMask:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.FieldMask), "FieldMask", "types.FieldMask", 1), `&`, ``, 1) + `,`,
+		`Limit:` + fmt.Sprintf("%v", this.Limit) + `,`,
+		`Page:` + fmt.Sprintf("%v", this.Page) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SetEndDeviceProfileRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SetEndDeviceProfileRequest{`,
+		`Profile:` + strings.Replace(strings.Replace(this.Profile.String(), "EndDeviceProfile", "EndDeviceProfile", 1), `&`, ``, 1) + `,`,
+		`FieldMask:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.FieldMask), "FieldMask", "types.FieldMask", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EndDeviceTemplate) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EndDeviceTemplate{`,
+		`EndDevice:` + strings.Replace(strings.Replace(this.EndDevice.String(), "EndDevice", "EndDevice", 1), `&`, ``, 1) + `,`,
+		`FieldMask:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.FieldMask), "FieldMask", "types.FieldMask", 1), `&`, ``, 1) + `,`,
+		`MappingKey:` + fmt.Sprintf("%v", this.MappingKey) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EndDeviceTemplateFormat) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EndDeviceTemplateFormat{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Description:` + fmt.Sprintf("%v", this.Description) + `,`,
+		`FileExtensions:` + fmt.Sprintf("%v", this.FileExtensions) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EndDeviceTemplateFormats) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForFormats := make([]string, 0, len(this.Formats))
+	for k := range this.Formats {
+		keysForFormats = append(keysForFormats, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForFormats)
+	mapStringForFormats := "map[string]*EndDeviceTemplateFormat{"
+	for _, k := range keysForFormats {
+		mapStringForFormats += fmt.Sprintf("%v: %v,", k, this.Formats[k])
+	}
+	mapStringForFormats += "}"
+	s := strings.Join([]string{`&EndDeviceTemplateFormats{`,
+		`Formats:` + mapStringForFormats + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ConvertEndDeviceTemplateRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ConvertEndDeviceTemplateRequest{`,
+		`FormatID:` + fmt.Sprintf("%v", this.FormatID) + `,`,
+		`Data:` + fmt.Sprintf("%v", this.Data) + `,`,
+		`CSVOptions:` + strings.Replace(fmt.Sprintf("%v", this.CSVOptions), "ConvertEndDeviceTemplateRequest_CSVOptions", "ConvertEndDeviceTemplateRequest_CSVOptions", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ConvertEndDeviceTemplateRequest_CSVOptions) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ConvertEndDeviceTemplateRequest_CSVOptions{`,
+		`Separator:` + fmt.Sprintf("%v", this.Separator) + `,`,
+		`Base64:` + fmt.Sprintf("%v", this.Base64) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringEndDevice(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *Session) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEndDevice
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Session: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Session: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DevAddr", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DevAddr.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionKeys", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SessionKeys.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastFCntUp", wireType)
+			}
+			m.LastFCntUp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastFCntUp |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastNFCntDown", wireType)
+			}
+			m.LastNFCntDown = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastNFCntDown |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastAFCntDown", wireType)
+			}
+			m.LastAFCntDown = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastAFCntDown |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastConfFCntDown", wireType)
+			}
+			m.LastConfFCntDown = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastConfFCntDown |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEndDevice(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MACParameters) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEndDevice
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MACParameters: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MACParameters: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxEIRP", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:])
+			iNdEx += 4
+			m.MaxEIRP = float32(math.Float32frombits(v))
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ADRDataRateIndex", wireType)
+			}
+			m.ADRDataRateIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ADRDataRateIndex |= DataRateIndex(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ADRTxPowerIndex", wireType)
+			}
+			m.ADRTxPowerIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ADRTxPowerIndex |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ADRNbTrans", wireType)
+			}
+			m.ADRNbTrans = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ADRNbTrans |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ADRAckLimit", wireType)
+			}
+			m.ADRAckLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ADRAckLimit |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ADRAckDelay", wireType)
+			}
+			m.ADRAckDelay = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ADRAckDelay |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rx1Delay", wireType)
+			}
+			m.Rx1Delay = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Rx1Delay |= RxDelay(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rx1DataRateOffset", wireType)
+			}
+			m.Rx1DataRateOffset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Rx1DataRateOffset |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rx2DataRateIndex", wireType)
+			}
+			m.Rx2DataRateIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Rx2DataRateIndex |= DataRateIndex(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rx2Frequency", wireType)
+			}
+			m.Rx2Frequency = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Rx2Frequency |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDutyCycle", wireType)
+			}
+			m.MaxDutyCycle = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxDutyCycle |= AggregatedDutyCycle(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RejoinTimePeriodicity", wireType)
+			}
+			m.RejoinTimePeriodicity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RejoinTimePeriodicity |= RejoinTimeExponent(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RejoinCountPeriodicity", wireType)
+			}
+			m.RejoinCountPeriodicity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RejoinCountPeriodicity |= RejoinCountExponent(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PingSlotFrequency", wireType)
+			}
+			m.PingSlotFrequency = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PingSlotFrequency |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PingSlotDataRateIndex", wireType)
+			}
+			m.PingSlotDataRateIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PingSlotDataRateIndex |= DataRateIndex(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BeaconFrequency", wireType)
+			}
+			m.BeaconFrequency = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BeaconFrequency |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Channels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Channels = append(m.Channels, &MACParameters_Channel{})
+			if err := m.Channels[len(m.Channels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UplinkDwellTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.UplinkDwellTime == nil {
+				m.UplinkDwellTime = &types.BoolValue{}
+			}
+			if err := m.UplinkDwellTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DownlinkDwellTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DownlinkDwellTime == nil {
+				m.DownlinkDwellTime = &types.BoolValue{}
+			}
+			if err := m.DownlinkDwellTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ADRAckLimitExponent", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ADRAckLimitExponent == nil {
+				m.ADRAckLimitExponent = &ADRAckLimitExponentValue{}
+			}
+			if err := m.ADRAckLimitExponent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ADRAckDelayExponent", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ADRAckDelayExponent == nil {
+				m.ADRAckDelayExponent = &ADRAckDelayExponentValue{}
+			}
+			if err := m.ADRAckDelayExponent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PingSlotDataRateIndexValue", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PingSlotDataRateIndexValue == nil {
+				m.PingSlotDataRateIndexValue = &DataRateIndexValue{}
+			}
+			if err := m.PingSlotDataRateIndexValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEndDevice(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MACParameters_Channel) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEndDevice
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Channel: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Channel: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UplinkFrequency", wireType)
+			}
+			m.UplinkFrequency = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UplinkFrequency |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DownlinkFrequency", wireType)
+			}
+			m.DownlinkFrequency = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DownlinkFrequency |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinDataRateIndex", wireType)
+			}
+			m.MinDataRateIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinDataRateIndex |= DataRateIndex(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDataRateIndex", wireType)
+			}
+			m.MaxDataRateIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxDataRateIndex |= DataRateIndex(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableUplink", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EnableUplink = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEndDevice(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EndDeviceBrand) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEndDevice
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EndDeviceBrand: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EndDeviceBrand: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.URL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Logos", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Logos = append(m.Logos, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEndDevice(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EndDeviceModel) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEndDevice
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EndDeviceModel: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EndDeviceModel: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BrandID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BrandID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEndDevice(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EndDeviceVersionIdentifiers) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEndDevice
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EndDeviceVersionIdentifiers: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EndDeviceVersionIdentifiers: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BrandID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BrandID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModelID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ModelID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HardwareVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HardwareVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FirmwareVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FirmwareVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEndDevice(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	s := strings.Join([]string{`&ConvertEndDeviceTemplateRequest{`,
-		`FormatID:` + fmt.Sprintf("%v", this.FormatID) + `,`,
-		`Data:` + fmt.Sprintf("%v", this.Data) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func valueToStringEndDevice(v interface{}) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
-		return "nil"
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("*%v", pv)
+	return nil
 }
-func (m *Session) Unmarshal(dAtA []byte) error {
+func (m *EndDeviceVersion) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8227,17 +12460,17 @@ func (m *Session) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Session: wiretype end group for non-group")
+			return fmt.Errorf("proto: EndDeviceVersion: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Session: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EndDeviceVersion: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 2:
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DevAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EndDeviceVersionIdentifiers", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEndDevice
@@ -8247,28 +12480,170 @@ func (m *Session) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEndDevice
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEndDevice
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.DevAddr.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.EndDeviceVersionIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LoRaWANVersion", wireType)
+			}
+			m.LoRaWANVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LoRaWANVersion |= MACVersion(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LoRaWANPHYVersion", wireType)
+			}
+			m.LoRaWANPHYVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LoRaWANPHYVersion |= PHYVersion(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FrequencyPlanID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FrequencyPlanID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Photos", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Photos = append(m.Photos, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SupportsClassB", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SupportsClassB = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SupportsClassC", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SupportsClassC = bool(v != 0)
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SessionKeys", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultMACSettings", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8295,15 +12670,18 @@ func (m *Session) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SessionKeys.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.DefaultMACSettings == nil {
+				m.DefaultMACSettings = &MACSettings{}
+			}
+			if err := m.DefaultMACSettings.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 9:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastFCntUp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinFrequency", wireType)
 			}
-			m.LastFCntUp = 0
+			m.MinFrequency = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEndDevice
@@ -8313,16 +12691,16 @@ func (m *Session) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LastFCntUp |= uint32(b&0x7F) << shift
+				m.MinFrequency |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+		case 10:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastNFCntDown", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxFrequency", wireType)
 			}
-			m.LastNFCntDown = 0
+			m.MaxFrequency = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEndDevice
@@ -8332,16 +12710,16 @@ func (m *Session) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LastNFCntDown |= uint32(b&0x7F) << shift
+				m.MaxFrequency |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
+		case 11:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastAFCntDown", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SupportsJoin", wireType)
 			}
-			m.LastAFCntDown = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEndDevice
@@ -8351,16 +12729,17 @@ func (m *Session) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LastAFCntDown |= uint32(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
+			m.SupportsJoin = bool(v != 0)
+		case 12:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastConfFCntDown", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ResetsJoinNonces", wireType)
 			}
-			m.LastConfFCntDown = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEndDevice
@@ -8370,14 +12749,15 @@ func (m *Session) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LastConfFCntDown |= uint32(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 8:
+			m.ResetsJoinNonces = bool(v != 0)
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultFormatters", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8404,10 +12784,137 @@ func (m *Session) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartedAt, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.DefaultFormatters.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProfileIDs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ProfileIDs == nil {
+				m.ProfileIDs = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEndDevice
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowEndDevice
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthEndDevice
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthEndDevice
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowEndDevice
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthEndDevice
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthEndDevice
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipEndDevice(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthEndDevice
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.ProfileIDs[mapkey] = mapvalue
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEndDevice(dAtA[iNdEx:])
@@ -8432,7 +12939,7 @@ func (m *Session) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MACParameters) Unmarshal(dAtA []byte) error {
+func (m *MACSettings) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8449,91 +12956,23 @@ func (m *MACParameters) Unmarshal(dAtA []byte) error {
 			iNdEx++
 			wire |= uint64(b&0x7F) << shift
 			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MACParameters: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MACParameters: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 5 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxEIRP", wireType)
-			}
-			var v uint32
-			if (iNdEx + 4) > l {
-				return io.ErrUnexpectedEOF
-			}
-			v = encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:])
-			iNdEx += 4
-			m.MaxEIRP = float32(math.Float32frombits(v))
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ADRDataRateIndex", wireType)
-			}
-			m.ADRDataRateIndex = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEndDevice
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ADRDataRateIndex |= DataRateIndex(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ADRTxPowerIndex", wireType)
-			}
-			m.ADRTxPowerIndex = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEndDevice
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ADRTxPowerIndex |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ADRNbTrans", wireType)
-			}
-			m.ADRNbTrans = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEndDevice
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ADRNbTrans |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ADRAckLimit", wireType)
+				break
 			}
-			m.ADRAckLimit = 0
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MACSettings: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MACSettings: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassBTimeout", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEndDevice
@@ -8543,54 +12982,33 @@ func (m *MACParameters) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ADRAckLimit |= uint32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ADRAckDelay", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthEndDevice
 			}
-			m.ADRAckDelay = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEndDevice
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ADRAckDelay |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEndDevice
 			}
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rx1Delay", wireType)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.Rx1Delay = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEndDevice
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Rx1Delay |= RxDelay(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if m.ClassBTimeout == nil {
+				m.ClassBTimeout = new(time.Duration)
 			}
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rx1DataRateOffset", wireType)
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.ClassBTimeout, dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Rx1DataRateOffset = 0
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PingSlotPeriodicity", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEndDevice
@@ -8600,54 +13018,33 @@ func (m *MACParameters) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Rx1DataRateOffset |= uint32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rx2DataRateIndex", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthEndDevice
 			}
-			m.Rx2DataRateIndex = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEndDevice
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Rx2DataRateIndex |= DataRateIndex(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEndDevice
 			}
-		case 12:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rx2Frequency", wireType)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.Rx2Frequency = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEndDevice
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Rx2Frequency |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if m.PingSlotPeriodicity == nil {
+				m.PingSlotPeriodicity = &PingSlotPeriodValue{}
 			}
-		case 13:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxDutyCycle", wireType)
+			if err := m.PingSlotPeriodicity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.MaxDutyCycle = 0
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PingSlotDataRateIndex", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEndDevice
@@ -8657,54 +13054,33 @@ func (m *MACParameters) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxDutyCycle |= AggregatedDutyCycle(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 14:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RejoinTimePeriodicity", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthEndDevice
 			}
-			m.RejoinTimePeriodicity = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEndDevice
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.RejoinTimePeriodicity |= RejoinTimeExponent(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEndDevice
 			}
-		case 15:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RejoinCountPeriodicity", wireType)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.RejoinCountPeriodicity = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEndDevice
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.RejoinCountPeriodicity |= RejoinCountExponent(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if m.PingSlotDataRateIndex == nil {
+				m.PingSlotDataRateIndex = &DataRateIndexValue{}
 			}
-		case 16:
-			if wireType != 0 {
+			if err := m.PingSlotDataRateIndex.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field PingSlotFrequency", wireType)
 			}
-			m.PingSlotFrequency = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEndDevice
@@ -8714,16 +13090,33 @@ func (m *MACParameters) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PingSlotFrequency |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 17:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PingSlotDataRateIndex", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthEndDevice
 			}
-			m.PingSlotDataRateIndex = 0
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PingSlotFrequency == nil {
+				m.PingSlotFrequency = &types.UInt64Value{}
+			}
+			if err := m.PingSlotFrequency.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassCTimeout", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEndDevice
@@ -8733,16 +13126,33 @@ func (m *MACParameters) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PingSlotDataRateIndex |= DataRateIndex(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 18:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BeaconFrequency", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthEndDevice
 			}
-			m.BeaconFrequency = 0
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ClassCTimeout == nil {
+				m.ClassCTimeout = new(time.Duration)
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.ClassCTimeout, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rx1Delay", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEndDevice
@@ -8752,14 +13162,31 @@ func (m *MACParameters) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BeaconFrequency |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 19:
+			if msglen < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Rx1Delay == nil {
+				m.Rx1Delay = &RxDelayValue{}
+			}
+			if err := m.Rx1Delay.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Channels", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rx1DataRateOffset", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8786,14 +13213,16 @@ func (m *MACParameters) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Channels = append(m.Channels, &MACParameters_Channel{})
-			if err := m.Channels[len(m.Channels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Rx1DataRateOffset == nil {
+				m.Rx1DataRateOffset = &types.UInt32Value{}
+			}
+			if err := m.Rx1DataRateOffset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 20:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UplinkDwellTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rx2DataRateIndex", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8820,16 +13249,16 @@ func (m *MACParameters) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.UplinkDwellTime == nil {
-				m.UplinkDwellTime = &types.BoolValue{}
+			if m.Rx2DataRateIndex == nil {
+				m.Rx2DataRateIndex = &DataRateIndexValue{}
 			}
-			if err := m.UplinkDwellTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Rx2DataRateIndex.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 21:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DownlinkDwellTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rx2Frequency", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8856,16 +13285,92 @@ func (m *MACParameters) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DownlinkDwellTime == nil {
-				m.DownlinkDwellTime = &types.BoolValue{}
+			if m.Rx2Frequency == nil {
+				m.Rx2Frequency = &types.UInt64Value{}
 			}
-			if err := m.DownlinkDwellTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Rx2Frequency.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 22:
+		case 10:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEndDevice
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.FactoryPresetFrequencies = append(m.FactoryPresetFrequencies, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEndDevice
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthEndDevice
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthEndDevice
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.FactoryPresetFrequencies) == 0 {
+					m.FactoryPresetFrequencies = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowEndDevice
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.FactoryPresetFrequencies = append(m.FactoryPresetFrequencies, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field FactoryPresetFrequencies", wireType)
+			}
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ADRAckLimitExponent", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDutyCycle", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8892,16 +13397,16 @@ func (m *MACParameters) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ADRAckLimitExponent == nil {
-				m.ADRAckLimitExponent = &ADRAckLimitExponentValue{}
+			if m.MaxDutyCycle == nil {
+				m.MaxDutyCycle = &AggregatedDutyCycleValue{}
 			}
-			if err := m.ADRAckLimitExponent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MaxDutyCycle.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 23:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ADRAckDelayExponent", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Supports32BitFCnt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8928,16 +13433,16 @@ func (m *MACParameters) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ADRAckDelayExponent == nil {
-				m.ADRAckDelayExponent = &ADRAckDelayExponentValue{}
+			if m.Supports32BitFCnt == nil {
+				m.Supports32BitFCnt = &types.BoolValue{}
 			}
-			if err := m.ADRAckDelayExponent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Supports32BitFCnt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 24:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PingSlotDataRateIndexValue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UseADR", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8964,71 +13469,18 @@ func (m *MACParameters) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PingSlotDataRateIndexValue == nil {
-				m.PingSlotDataRateIndexValue = &DataRateIndexValue{}
+			if m.UseADR == nil {
+				m.UseADR = &types.BoolValue{}
 			}
-			if err := m.PingSlotDataRateIndexValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.UseADR.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEndDevice(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthEndDevice
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthEndDevice
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MACParameters_Channel) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowEndDevice
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Channel: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Channel: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UplinkFrequency", wireType)
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ADRMargin", wireType)
 			}
-			m.UplinkFrequency = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEndDevice
@@ -9038,54 +13490,33 @@ func (m *MACParameters_Channel) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.UplinkFrequency |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DownlinkFrequency", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthEndDevice
 			}
-			m.DownlinkFrequency = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEndDevice
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.DownlinkFrequency |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEndDevice
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinDataRateIndex", wireType)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.MinDataRateIndex = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEndDevice
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MinDataRateIndex |= DataRateIndex(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if m.ADRMargin == nil {
+				m.ADRMargin = &types.FloatValue{}
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxDataRateIndex", wireType)
+			if err := m.ADRMargin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.MaxDataRateIndex = 0
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResetsFCnt", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEndDevice
@@ -9095,16 +13526,33 @@ func (m *MACParameters_Channel) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxDataRateIndex |= DataRateIndex(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EnableUplink", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthEndDevice
 			}
-			var v int
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ResetsFCnt == nil {
+				m.ResetsFCnt = &types.BoolValue{}
+			}
+			if err := m.ResetsFCnt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StatusTimePeriodicity", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEndDevice
@@ -9114,70 +13562,33 @@ func (m *MACParameters_Channel) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.EnableUplink = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEndDevice(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEndDevice
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthEndDevice
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *EndDeviceBrand) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowEndDevice
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.StatusTimePeriodicity == nil {
+				m.StatusTimePeriodicity = new(time.Duration)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.StatusTimePeriodicity, dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EndDeviceBrand: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EndDeviceBrand: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 17:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StatusCountPeriodicity", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEndDevice
@@ -9187,29 +13598,33 @@ func (m *EndDeviceBrand) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEndDevice
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEndDevice
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ID = string(dAtA[iNdEx:postIndex])
+			if m.StatusCountPeriodicity == nil {
+				m.StatusCountPeriodicity = &types.UInt32Value{}
+			}
+			if err := m.StatusCountPeriodicity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 18:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DesiredRx1Delay", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEndDevice
@@ -9219,29 +13634,33 @@ func (m *EndDeviceBrand) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEndDevice
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEndDevice
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			if m.DesiredRx1Delay == nil {
+				m.DesiredRx1Delay = &RxDelayValue{}
+			}
+			if err := m.DesiredRx1Delay.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 19:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DesiredRx1DataRateOffset", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEndDevice
@@ -9251,29 +13670,33 @@ func (m *EndDeviceBrand) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEndDevice
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEndDevice
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.URL = string(dAtA[iNdEx:postIndex])
+			if m.DesiredRx1DataRateOffset == nil {
+				m.DesiredRx1DataRateOffset = &types.UInt32Value{}
+			}
+			if err := m.DesiredRx1DataRateOffset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 20:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Logos", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DesiredRx2DataRateIndex", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEndDevice
@@ -9283,82 +13706,69 @@ func (m *EndDeviceBrand) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEndDevice
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEndDevice
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Logos = append(m.Logos, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEndDevice(dAtA[iNdEx:])
-			if err != nil {
+			if m.DesiredRx2DataRateIndex == nil {
+				m.DesiredRx2DataRateIndex = &DataRateIndexValue{}
+			}
+			if err := m.DesiredRx2DataRateIndex.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if skippy < 0 {
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DesiredRx2Frequency", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthEndDevice
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthEndDevice
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *EndDeviceModel) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowEndDevice
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.DesiredRx2Frequency == nil {
+				m.DesiredRx2Frequency = &types.UInt64Value{}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.DesiredRx2Frequency.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EndDeviceModel: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EndDeviceModel: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 22:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BrandID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DesiredMaxDutyCycle", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEndDevice
@@ -9368,29 +13778,33 @@ func (m *EndDeviceModel) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEndDevice
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEndDevice
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BrandID = string(dAtA[iNdEx:postIndex])
+			if m.DesiredMaxDutyCycle == nil {
+				m.DesiredMaxDutyCycle = &AggregatedDutyCycleValue{}
+			}
+			if err := m.DesiredMaxDutyCycle.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 23:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DesiredADRAckLimitExponent", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEndDevice
@@ -9400,29 +13814,33 @@ func (m *EndDeviceModel) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEndDevice
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEndDevice
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ID = string(dAtA[iNdEx:postIndex])
+			if m.DesiredADRAckLimitExponent == nil {
+				m.DesiredADRAckLimitExponent = &ADRAckLimitExponentValue{}
+			}
+			if err := m.DesiredADRAckLimitExponent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 24:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DesiredADRAckDelayExponent", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEndDevice
@@ -9432,82 +13850,33 @@ func (m *EndDeviceModel) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEndDevice
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEndDevice
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEndDevice(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthEndDevice
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthEndDevice
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *EndDeviceVersionIdentifiers) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowEndDevice
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.DesiredADRAckDelayExponent == nil {
+				m.DesiredADRAckDelayExponent = &ADRAckDelayExponentValue{}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.DesiredADRAckDelayExponent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EndDeviceVersionIdentifiers: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EndDeviceVersionIdentifiers: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 25:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BrandID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BeaconFrequency", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEndDevice
@@ -9517,29 +13886,33 @@ func (m *EndDeviceVersionIdentifiers) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEndDevice
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEndDevice
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BrandID = string(dAtA[iNdEx:postIndex])
+			if m.BeaconFrequency == nil {
+				m.BeaconFrequency = &types.UInt64Value{}
+			}
+			if err := m.BeaconFrequency.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 27:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ModelID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DesiredPingSlotDataRateIndex", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEndDevice
@@ -9549,29 +13922,33 @@ func (m *EndDeviceVersionIdentifiers) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEndDevice
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEndDevice
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ModelID = string(dAtA[iNdEx:postIndex])
+			if m.DesiredPingSlotDataRateIndex == nil {
+				m.DesiredPingSlotDataRateIndex = &DataRateIndexValue{}
+			}
+			if err := m.DesiredPingSlotDataRateIndex.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 28:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HardwareVersion", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DesiredPingSlotFrequency", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEndDevice
@@ -9581,29 +13958,33 @@ func (m *EndDeviceVersionIdentifiers) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEndDevice
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEndDevice
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HardwareVersion = string(dAtA[iNdEx:postIndex])
+			if m.DesiredPingSlotFrequency == nil {
+				m.DesiredPingSlotFrequency = &types.UInt64Value{}
+			}
+			if err := m.DesiredPingSlotFrequency.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 29:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FirmwareVersion", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DesiredBeaconFrequency", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEndDevice
@@ -9613,23 +13994,27 @@ func (m *EndDeviceVersionIdentifiers) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEndDevice
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEndDevice
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FirmwareVersion = string(dAtA[iNdEx:postIndex])
+			if m.DesiredBeaconFrequency == nil {
+				m.DesiredBeaconFrequency = &types.UInt64Value{}
+			}
+			if err := m.DesiredBeaconFrequency.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9655,7 +14040,7 @@ func (m *EndDeviceVersionIdentifiers) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EndDeviceVersion) Unmarshal(dAtA []byte) error {
+func (m *MACState) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9678,15 +14063,15 @@ func (m *EndDeviceVersion) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EndDeviceVersion: wiretype end group for non-group")
+			return fmt.Errorf("proto: MACState: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EndDeviceVersion: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MACState: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndDeviceVersionIdentifiers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentParameters", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9713,85 +14098,15 @@ func (m *EndDeviceVersion) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.EndDeviceVersionIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CurrentParameters.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LoRaWANVersion", wireType)
-			}
-			m.LoRaWANVersion = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEndDevice
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.LoRaWANVersion |= MACVersion(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LoRaWANPHYVersion", wireType)
-			}
-			m.LoRaWANPHYVersion = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEndDevice
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.LoRaWANPHYVersion |= PHYVersion(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FrequencyPlanID", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEndDevice
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEndDevice
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEndDevice
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.FrequencyPlanID = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Photos", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DesiredParameters", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEndDevice
@@ -9801,29 +14116,30 @@ func (m *EndDeviceVersion) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEndDevice
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEndDevice
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Photos = append(m.Photos, string(dAtA[iNdEx:postIndex]))
+			if err := m.DesiredParameters.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 6:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SupportsClassB", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DeviceClass", wireType)
 			}
-			var v int
+			m.DeviceClass = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEndDevice
@@ -9833,17 +14149,16 @@ func (m *EndDeviceVersion) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.DeviceClass |= Class(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.SupportsClassB = bool(v != 0)
-		case 7:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SupportsClassC", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LoRaWANVersion", wireType)
 			}
-			var v int
+			m.LoRaWANVersion = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEndDevice
@@ -9853,15 +14168,14 @@ func (m *EndDeviceVersion) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.LoRaWANVersion |= MACVersion(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.SupportsClassC = bool(v != 0)
-		case 8:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DefaultMACSettings", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastConfirmedDownlinkAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9888,18 +14202,18 @@ func (m *EndDeviceVersion) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DefaultMACSettings == nil {
-				m.DefaultMACSettings = &MACSettings{}
+			if m.LastConfirmedDownlinkAt == nil {
+				m.LastConfirmedDownlinkAt = new(time.Time)
 			}
-			if err := m.DefaultMACSettings.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.LastConfirmedDownlinkAt, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinFrequency", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastDevStatusFCntUp", wireType)
 			}
-			m.MinFrequency = 0
+			m.LastDevStatusFCntUp = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEndDevice
@@ -9909,16 +14223,16 @@ func (m *EndDeviceVersion) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MinFrequency |= uint64(b&0x7F) << shift
+				m.LastDevStatusFCntUp |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxFrequency", wireType)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PingSlotPeriodicity", wireType)
 			}
-			m.MaxFrequency = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEndDevice
@@ -9928,16 +14242,33 @@ func (m *EndDeviceVersion) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxFrequency |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SupportsJoin", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthEndDevice
 			}
-			var v int
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PingSlotPeriodicity == nil {
+				m.PingSlotPeriodicity = &PingSlotPeriodValue{}
+			}
+			if err := m.PingSlotPeriodicity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingApplicationDownlink", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEndDevice
@@ -9947,17 +14278,33 @@ func (m *EndDeviceVersion) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.SupportsJoin = bool(v != 0)
-		case 12:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResetsJoinNonces", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthEndDevice
 			}
-			var v int
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PendingApplicationDownlink == nil {
+				m.PendingApplicationDownlink = &ApplicationDownlink{}
+			}
+			if err := m.PendingApplicationDownlink.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueuedResponses", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEndDevice
@@ -9967,15 +14314,29 @@ func (m *EndDeviceVersion) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.ResetsJoinNonces = bool(v != 0)
-		case 13:
+			if msglen < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QueuedResponses = append(m.QueuedResponses, &MACCommand{})
+			if err := m.QueuedResponses[len(m.QueuedResponses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DefaultFormatters", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingRequests", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10002,66 +14363,50 @@ func (m *EndDeviceVersion) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.DefaultFormatters.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.PendingRequests = append(m.PendingRequests, &MACCommand{})
+			if err := m.PendingRequests[len(m.PendingRequests)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEndDevice(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueuedJoinAccept", wireType)
 			}
-			if skippy < 0 {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthEndDevice
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthEndDevice
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MACSettings) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowEndDevice
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.QueuedJoinAccept == nil {
+				m.QueuedJoinAccept = &MACState_JoinAccept{}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.QueuedJoinAccept.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MACSettings: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MACSettings: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClassBTimeout", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingJoinRequest", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10088,16 +14433,36 @@ func (m *MACSettings) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ClassBTimeout == nil {
-				m.ClassBTimeout = new(time.Duration)
+			if m.PendingJoinRequest == nil {
+				m.PendingJoinRequest = &JoinRequest{}
 			}
-			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.ClassBTimeout, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PendingJoinRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RxWindowsAvailable", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RxWindowsAvailable = bool(v != 0)
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PingSlotPeriodicity", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RecentUplinks", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10124,16 +14489,14 @@ func (m *MACSettings) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PingSlotPeriodicity == nil {
-				m.PingSlotPeriodicity = &PingSlotPeriodValue{}
-			}
-			if err := m.PingSlotPeriodicity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.RecentUplinks = append(m.RecentUplinks, &UplinkMessage{})
+			if err := m.RecentUplinks[len(m.RecentUplinks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PingSlotDataRateIndex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RecentDownlinks", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10160,16 +14523,14 @@ func (m *MACSettings) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PingSlotDataRateIndex == nil {
-				m.PingSlotDataRateIndex = &DataRateIndexValue{}
-			}
-			if err := m.PingSlotDataRateIndex.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.RecentDownlinks = append(m.RecentDownlinks, &DownlinkMessage{})
+			if err := m.RecentDownlinks[len(m.RecentDownlinks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 16:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PingSlotFrequency", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastNetworkInitiatedDownlinkAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10196,16 +14557,16 @@ func (m *MACSettings) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PingSlotFrequency == nil {
-				m.PingSlotFrequency = &types.UInt64Value{}
+			if m.LastNetworkInitiatedDownlinkAt == nil {
+				m.LastNetworkInitiatedDownlinkAt = new(time.Time)
 			}
-			if err := m.PingSlotFrequency.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.LastNetworkInitiatedDownlinkAt, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 17:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClassCTimeout", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field QueuedForceRejoinReq", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10232,18 +14593,18 @@ func (m *MACSettings) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ClassCTimeout == nil {
-				m.ClassCTimeout = new(time.Duration)
+			if m.QueuedForceRejoinReq == nil {
+				m.QueuedForceRejoinReq = &MACCommand_ForceRejoinReq{}
 			}
-			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.ClassCTimeout, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.QueuedForceRejoinReq.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rx1Delay", wireType)
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastRJCount0", wireType)
 			}
-			var msglen int
+			m.LastRJCount0 = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEndDevice
@@ -10253,33 +14614,69 @@ func (m *MACSettings) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.LastRJCount0 |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEndDevice(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthEndDevice
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthEndDevice
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Rx1Delay == nil {
-				m.Rx1Delay = &RxDelayValue{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MACState_JoinAccept) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEndDevice
 			}
-			if err := m.Rx1Delay.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 7:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JoinAccept: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JoinAccept: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rx1DataRateOffset", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEndDevice
@@ -10289,31 +14686,29 @@ func (m *MACSettings) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthEndDevice
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEndDevice
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Rx1DataRateOffset == nil {
-				m.Rx1DataRateOffset = &types.UInt32Value{}
-			}
-			if err := m.Rx1DataRateOffset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Payload = append(m.Payload[:0], dAtA[iNdEx:postIndex]...)
+			if m.Payload == nil {
+				m.Payload = []byte{}
 			}
 			iNdEx = postIndex
-		case 8:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rx2DataRateIndex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10340,16 +14735,13 @@ func (m *MACSettings) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Rx2DataRateIndex == nil {
-				m.Rx2DataRateIndex = &DataRateIndexValue{}
-			}
-			if err := m.Rx2DataRateIndex.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rx2Frequency", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Keys", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10376,92 +14768,98 @@ func (m *MACSettings) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Rx2Frequency == nil {
-				m.Rx2Frequency = &types.UInt64Value{}
-			}
-			if err := m.Rx2Frequency.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Keys.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 10:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowEndDevice
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.FactoryPresetFrequencies = append(m.FactoryPresetFrequencies, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowEndDevice
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthEndDevice
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthEndDevice
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEndDevice(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EndDeviceAuthenticationCode) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEndDevice
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EndDeviceAuthenticationCode: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EndDeviceAuthenticationCode: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.FactoryPresetFrequencies) == 0 {
-					m.FactoryPresetFrequencies = make([]uint64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowEndDevice
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.FactoryPresetFrequencies = append(m.FactoryPresetFrequencies, v)
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field FactoryPresetFrequencies", wireType)
 			}
-		case 11:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxDutyCycle", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidFrom", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10488,16 +14886,16 @@ func (m *MACSettings) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.MaxDutyCycle == nil {
-				m.MaxDutyCycle = &AggregatedDutyCycleValue{}
+			if m.ValidFrom == nil {
+				m.ValidFrom = new(time.Time)
 			}
-			if err := m.MaxDutyCycle.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.ValidFrom, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 12:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Supports32BitFCnt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidTo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10524,16 +14922,69 @@ func (m *MACSettings) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Supports32BitFCnt == nil {
-				m.Supports32BitFCnt = &types.BoolValue{}
+			if m.ValidTo == nil {
+				m.ValidTo = new(time.Time)
 			}
-			if err := m.Supports32BitFCnt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.ValidTo, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 13:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEndDevice(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EndDevice) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEndDevice
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EndDevice: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EndDevice: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UseADR", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EndDeviceIdentifiers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10560,16 +15011,13 @@ func (m *MACSettings) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.UseADR == nil {
-				m.UseADR = &types.BoolValue{}
-			}
-			if err := m.UseADR.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.EndDeviceIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 14:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ADRMargin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10596,16 +15044,13 @@ func (m *MACSettings) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ADRMargin == nil {
-				m.ADRMargin = &types.FloatValue{}
-			}
-			if err := m.ADRMargin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CreatedAt, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 15:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResetsFCnt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10632,18 +15077,15 @@ func (m *MACSettings) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ResetsFCnt == nil {
-				m.ResetsFCnt = &types.BoolValue{}
-			}
-			if err := m.ResetsFCnt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.UpdatedAt, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 16:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StatusTimePeriodicity", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEndDevice
@@ -10653,33 +15095,29 @@ func (m *MACSettings) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEndDevice
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEndDevice
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.StatusTimePeriodicity == nil {
-				m.StatusTimePeriodicity = new(time.Duration)
-			}
-			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.StatusTimePeriodicity, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 17:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StatusCountPeriodicity", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEndDevice
@@ -10689,31 +15127,27 @@ func (m *MACSettings) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEndDevice
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEndDevice
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.StatusCountPeriodicity == nil {
-				m.StatusCountPeriodicity = &types.UInt32Value{}
-			}
-			if err := m.StatusCountPeriodicity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 18:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DesiredRx1Delay", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Attributes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10730,26 +15164,117 @@ func (m *MACSettings) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthEndDevice
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthEndDevice
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.DesiredRx1Delay == nil {
-				m.DesiredRx1Delay = &RxDelayValue{}
-			}
-			if err := m.DesiredRx1Delay.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			if msglen < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Attributes == nil {
+				m.Attributes = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEndDevice
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowEndDevice
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthEndDevice
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthEndDevice
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowEndDevice
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthEndDevice
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthEndDevice
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipEndDevice(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthEndDevice
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Attributes[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 19:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DesiredRx1DataRateOffset", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VersionIDs", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10776,18 +15301,18 @@ func (m *MACSettings) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DesiredRx1DataRateOffset == nil {
-				m.DesiredRx1DataRateOffset = &types.UInt32Value{}
+			if m.VersionIDs == nil {
+				m.VersionIDs = &EndDeviceVersionIdentifiers{}
 			}
-			if err := m.DesiredRx1DataRateOffset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.VersionIDs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 20:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DesiredRx2DataRateIndex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceProfileID", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEndDevice
@@ -10797,33 +15322,29 @@ func (m *MACSettings) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEndDevice
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEndDevice
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DesiredRx2DataRateIndex == nil {
-				m.DesiredRx2DataRateIndex = &DataRateIndexValue{}
-			}
-			if err := m.DesiredRx2DataRateIndex.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ServiceProfileID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 21:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DesiredRx2Frequency", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NetworkServerAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEndDevice
@@ -10833,33 +15354,29 @@ func (m *MACSettings) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEndDevice
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEndDevice
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DesiredRx2Frequency == nil {
-				m.DesiredRx2Frequency = &types.UInt64Value{}
-			}
-			if err := m.DesiredRx2Frequency.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.NetworkServerAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 22:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DesiredMaxDutyCycle", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationServerAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEndDevice
@@ -10869,33 +15386,29 @@ func (m *MACSettings) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEndDevice
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEndDevice
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DesiredMaxDutyCycle == nil {
-				m.DesiredMaxDutyCycle = &AggregatedDutyCycleValue{}
-			}
-			if err := m.DesiredMaxDutyCycle.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ApplicationServerAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 23:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DesiredADRAckLimitExponent", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JoinServerAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEndDevice
@@ -10905,31 +15418,27 @@ func (m *MACSettings) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEndDevice
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEndDevice
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DesiredADRAckLimitExponent == nil {
-				m.DesiredADRAckLimitExponent = &ADRAckLimitExponentValue{}
-			}
-			if err := m.DesiredADRAckLimitExponent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.JoinServerAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 24:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DesiredADRAckDelayExponent", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Locations", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10956,54 +15465,111 @@ func (m *MACSettings) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DesiredADRAckDelayExponent == nil {
-				m.DesiredADRAckDelayExponent = &ADRAckDelayExponentValue{}
-			}
-			if err := m.DesiredADRAckDelayExponent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 25:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BeaconFrequency", wireType)
+			if m.Locations == nil {
+				m.Locations = make(map[string]*Location)
 			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEndDevice
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+			var mapkey string
+			var mapvalue *Location
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEndDevice
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowEndDevice
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthEndDevice
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthEndDevice
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowEndDevice
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthEndDevice
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthEndDevice
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &Location{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipEndDevice(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthEndDevice
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthEndDevice
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthEndDevice
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.BeaconFrequency == nil {
-				m.BeaconFrequency = &types.UInt64Value{}
-			}
-			if err := m.BeaconFrequency.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Locations[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 27:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DesiredPingSlotDataRateIndex", wireType)
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SupportsClassB", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEndDevice
@@ -11013,33 +15579,17 @@ func (m *MACSettings) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthEndDevice
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthEndDevice
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.DesiredPingSlotDataRateIndex == nil {
-				m.DesiredPingSlotDataRateIndex = &DataRateIndexValue{}
-			}
-			if err := m.DesiredPingSlotDataRateIndex.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 28:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DesiredPingSlotFrequency", wireType)
+			m.SupportsClassB = bool(v != 0)
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SupportsClassC", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEndDevice
@@ -11049,33 +15599,17 @@ func (m *MACSettings) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthEndDevice
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthEndDevice
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.DesiredPingSlotFrequency == nil {
-				m.DesiredPingSlotFrequency = &types.UInt64Value{}
-			}
-			if err := m.DesiredPingSlotFrequency.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 29:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DesiredBeaconFrequency", wireType)
+			m.SupportsClassC = bool(v != 0)
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LoRaWANVersion", wireType)
 			}
-			var msglen int
+			m.LoRaWANVersion = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEndDevice
@@ -11085,86 +15619,16 @@ func (m *MACSettings) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.LoRaWANVersion |= MACVersion(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthEndDevice
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthEndDevice
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.DesiredBeaconFrequency == nil {
-				m.DesiredBeaconFrequency = &types.UInt64Value{}
-			}
-			if err := m.DesiredBeaconFrequency.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEndDevice(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthEndDevice
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthEndDevice
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MACState) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowEndDevice
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MACState: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MACState: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentParameters", wireType)
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LoRaWANPHYVersion", wireType)
 			}
-			var msglen int
+			m.LoRaWANPHYVersion = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEndDevice
@@ -11174,30 +15638,16 @@ func (m *MACState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.LoRaWANPHYVersion |= PHYVersion(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthEndDevice
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthEndDevice
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.CurrentParameters.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
+		case 17:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DesiredParameters", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FrequencyPlanID", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEndDevice
@@ -11207,30 +15657,29 @@ func (m *MACState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEndDevice
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEndDevice
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.DesiredParameters.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.FrequencyPlanID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 18:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeviceClass", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinFrequency", wireType)
 			}
-			m.DeviceClass = 0
+			m.MinFrequency = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEndDevice
@@ -11240,16 +15689,16 @@ func (m *MACState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DeviceClass |= Class(b&0x7F) << shift
+				m.MinFrequency |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+		case 19:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LoRaWANVersion", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxFrequency", wireType)
 			}
-			m.LoRaWANVersion = 0
+			m.MaxFrequency = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEndDevice
@@ -11259,16 +15708,16 @@ func (m *MACState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LoRaWANVersion |= MACVersion(b&0x7F) << shift
+				m.MaxFrequency |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastConfirmedDownlinkAt", wireType)
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SupportsJoin", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEndDevice
@@ -11278,33 +15727,17 @@ func (m *MACState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthEndDevice
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthEndDevice
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.LastConfirmedDownlinkAt == nil {
-				m.LastConfirmedDownlinkAt = new(time.Time)
-			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.LastConfirmedDownlinkAt, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 6:
+			m.SupportsJoin = bool(v != 0)
+		case 21:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastDevStatusFCntUp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ResetsJoinNonces", wireType)
 			}
-			m.LastDevStatusFCntUp = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEndDevice
@@ -11314,14 +15747,15 @@ func (m *MACState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LastDevStatusFCntUp |= uint32(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
+			m.ResetsJoinNonces = bool(v != 0)
+		case 22:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PingSlotPeriodicity", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RootKeys", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11348,18 +15782,18 @@ func (m *MACState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PingSlotPeriodicity == nil {
-				m.PingSlotPeriodicity = &PingSlotPeriodValue{}
+			if m.RootKeys == nil {
+				m.RootKeys = &RootKeys{}
 			}
-			if err := m.PingSlotPeriodicity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RootKeys.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 23:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PendingApplicationDownlink", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NetID", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEndDevice
@@ -11369,31 +15803,30 @@ func (m *MACState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthEndDevice
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEndDevice
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PendingApplicationDownlink == nil {
-				m.PendingApplicationDownlink = &ApplicationDownlink{}
-			}
-			if err := m.PendingApplicationDownlink.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			var v go_thethings_network_lorawan_stack_pkg_types.NetID
+			m.NetID = &v
+			if err := m.NetID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
+		case 24:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QueuedResponses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MACSettings", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11420,14 +15853,16 @@ func (m *MACState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.QueuedResponses = append(m.QueuedResponses, &MACCommand{})
-			if err := m.QueuedResponses[len(m.QueuedResponses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.MACSettings == nil {
+				m.MACSettings = &MACSettings{}
+			}
+			if err := m.MACSettings.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 10:
+		case 25:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PendingRequests", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MACState", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11454,14 +15889,16 @@ func (m *MACState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PendingRequests = append(m.PendingRequests, &MACCommand{})
-			if err := m.PendingRequests[len(m.PendingRequests)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.MACState == nil {
+				m.MACState = &MACState{}
+			}
+			if err := m.MACState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 11:
+		case 26:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QueuedJoinAccept", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Session", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11488,16 +15925,16 @@ func (m *MACState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.QueuedJoinAccept == nil {
-				m.QueuedJoinAccept = &MACState_JoinAccept{}
+			if m.Session == nil {
+				m.Session = &Session{}
 			}
-			if err := m.QueuedJoinAccept.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Session.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 12:
+		case 27:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PendingJoinRequest", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingSession", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11524,18 +15961,18 @@ func (m *MACState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PendingJoinRequest == nil {
-				m.PendingJoinRequest = &JoinRequest{}
+			if m.PendingSession == nil {
+				m.PendingSession = &Session{}
 			}
-			if err := m.PendingJoinRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PendingSession.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 13:
+		case 28:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RxWindowsAvailable", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastDevNonce", wireType)
 			}
-			var v int
+			m.LastDevNonce = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEndDevice
@@ -11545,17 +15982,92 @@ func (m *MACState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.LastDevNonce |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.RxWindowsAvailable = bool(v != 0)
-		case 14:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecentUplinks", wireType)
+		case 29:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEndDevice
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.UsedDevNonces = append(m.UsedDevNonces, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEndDevice
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthEndDevice
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthEndDevice
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.UsedDevNonces) == 0 {
+					m.UsedDevNonces = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowEndDevice
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.UsedDevNonces = append(m.UsedDevNonces, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field UsedDevNonces", wireType)
 			}
-			var msglen int
+		case 30:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastJoinNonce", wireType)
+			}
+			m.LastJoinNonce = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEndDevice
@@ -11565,31 +16077,16 @@ func (m *MACState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.LastJoinNonce |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthEndDevice
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthEndDevice
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.RecentUplinks = append(m.RecentUplinks, &UplinkMessage{})
-			if err := m.RecentUplinks[len(m.RecentUplinks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 15:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecentDownlinks", wireType)
+		case 31:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastRJCount0", wireType)
 			}
-			var msglen int
+			m.LastRJCount0 = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEndDevice
@@ -11599,31 +16096,16 @@ func (m *MACState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.LastRJCount0 |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthEndDevice
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthEndDevice
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.RecentDownlinks = append(m.RecentDownlinks, &DownlinkMessage{})
-			if err := m.RecentDownlinks[len(m.RecentDownlinks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 16:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastNetworkInitiatedDownlinkAt", wireType)
+		case 32:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastRJCount1", wireType)
 			}
-			var msglen int
+			m.LastRJCount1 = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEndDevice
@@ -11633,86 +16115,16 @@ func (m *MACState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.LastRJCount1 |= uint32(b&0x7F) << shift
 				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthEndDevice
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthEndDevice
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.LastNetworkInitiatedDownlinkAt == nil {
-				m.LastNetworkInitiatedDownlinkAt = new(time.Time)
-			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.LastNetworkInitiatedDownlinkAt, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEndDevice(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthEndDevice
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthEndDevice
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MACState_JoinAccept) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowEndDevice
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: JoinAccept: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JoinAccept: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+					break
+				}
+			}
+		case 33:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastDevStatusReceivedAt", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEndDevice
@@ -11722,29 +16134,50 @@ func (m *MACState_JoinAccept) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEndDevice
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEndDevice
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Payload = append(m.Payload[:0], dAtA[iNdEx:postIndex]...)
-			if m.Payload == nil {
-				m.Payload = []byte{}
+			if m.LastDevStatusReceivedAt == nil {
+				m.LastDevStatusReceivedAt = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.LastDevStatusReceivedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 34:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PowerState", wireType)
+			}
+			m.PowerState = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PowerState |= PowerState(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 35:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BatteryPercentage", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11771,13 +16204,35 @@ func (m *MACState_JoinAccept) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.BatteryPercentage == nil {
+				m.BatteryPercentage = &types.FloatValue{}
+			}
+			if err := m.BatteryPercentage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 36:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DownlinkMargin", wireType)
+			}
+			m.DownlinkMargin = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DownlinkMargin |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 37:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Keys", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RecentADRUplinks", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11804,68 +16259,50 @@ func (m *MACState_JoinAccept) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Keys.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.RecentADRUplinks = append(m.RecentADRUplinks, &UplinkMessage{})
+			if err := m.RecentADRUplinks[len(m.RecentADRUplinks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEndDevice(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 38:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecentUplinks", wireType)
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthEndDevice
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEndDevice
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *EndDeviceAuthenticationCode) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowEndDevice
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEndDevice
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.RecentUplinks = append(m.RecentUplinks, &UplinkMessage{})
+			if err := m.RecentUplinks[len(m.RecentUplinks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EndDeviceAuthenticationCode: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EndDeviceAuthenticationCode: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 39:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RecentDownlinks", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEndDevice
@@ -11875,27 +16312,29 @@ func (m *EndDeviceAuthenticationCode) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEndDevice
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEndDevice
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			m.RecentDownlinks = append(m.RecentDownlinks, &DownlinkMessage{})
+			if err := m.RecentDownlinks[len(m.RecentDownlinks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 40:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidFrom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field QueuedApplicationDownlinks", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11922,16 +16361,14 @@ func (m *EndDeviceAuthenticationCode) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ValidFrom == nil {
-				m.ValidFrom = new(time.Time)
-			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.ValidFrom, dAtA[iNdEx:postIndex]); err != nil {
+			m.QueuedApplicationDownlinks = append(m.QueuedApplicationDownlinks, &ApplicationDownlink{})
+			if err := m.QueuedApplicationDownlinks[len(m.QueuedApplicationDownlinks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 41:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidTo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Formatters", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11958,69 +16395,48 @@ func (m *EndDeviceAuthenticationCode) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ValidTo == nil {
-				m.ValidTo = new(time.Time)
+			if m.Formatters == nil {
+				m.Formatters = &MessagePayloadFormatters{}
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.ValidTo, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Formatters.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEndDevice(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 42:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProvisionerID", wireType)
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthEndDevice
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEndDevice
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *EndDevice) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowEndDevice
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEndDevice
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EndDevice: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EndDevice: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProvisionerID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 43:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndDeviceIdentifiers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProvisioningData", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12047,13 +16463,16 @@ func (m *EndDevice) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.EndDeviceIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.ProvisioningData == nil {
+				m.ProvisioningData = &types.Struct{}
+			}
+			if err := m.ProvisioningData.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 44:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingMACState", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12080,13 +16499,36 @@ func (m *EndDevice) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CreatedAt, dAtA[iNdEx:postIndex]); err != nil {
+			if m.PendingMACState == nil {
+				m.PendingMACState = &MACState{}
+			}
+			if err := m.PendingMACState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 45:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Multicast", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Multicast = bool(v != 0)
+		case 46:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimAuthenticationCode", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12113,13 +16555,16 @@ func (m *EndDevice) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.UpdatedAt, dAtA[iNdEx:postIndex]); err != nil {
+			if m.ClaimAuthenticationCode == nil {
+				m.ClaimAuthenticationCode = &EndDeviceAuthenticationCode{}
+			}
+			if err := m.ClaimAuthenticationCode.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 47:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NetworkServerKEKLabel", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12147,11 +16592,11 @@ func (m *EndDevice) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.NetworkServerKEKLabel = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 48:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationServerKEKLabel", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12179,11 +16624,43 @@ func (m *EndDevice) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			m.ApplicationServerKEKLabel = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 49:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Attributes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationServerID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApplicationServerID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 50:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Picture", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12210,107 +16687,125 @@ func (m *EndDevice) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Attributes == nil {
-				m.Attributes = make(map[string]string)
+			if m.Picture == nil {
+				m.Picture = &Picture{}
 			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowEndDevice
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			if err := m.Picture.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 51:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SkipPayloadCrypto", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
 				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowEndDevice
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthEndDevice
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthEndDevice
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowEndDevice
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthEndDevice
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthEndDevice
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipEndDevice(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if skippy < 0 {
-						return ErrInvalidLengthEndDevice
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SkipPayloadCrypto = bool(v != 0)
+		case 52:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProfileIDs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.Attributes[mapkey] = mapvalue
-			iNdEx = postIndex
-		case 7:
+			if msglen < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ProfileIDs == nil {
+				m.ProfileIDs = &EndDeviceProfileIdentifiers{}
+			}
+			if err := m.ProfileIDs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEndDevice(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EndDevices) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEndDevice
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EndDevices: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EndDevices: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VersionIDs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EndDevices", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12337,18 +16832,69 @@ func (m *EndDevice) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.VersionIDs == nil {
-				m.VersionIDs = &EndDeviceVersionIdentifiers{}
-			}
-			if err := m.VersionIDs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.EndDevices = append(m.EndDevices, &EndDevice{})
+			if err := m.EndDevices[len(m.EndDevices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEndDevice(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CreateEndDeviceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEndDevice
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreateEndDeviceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreateEndDeviceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServiceProfileID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EndDevice", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEndDevice
@@ -12358,29 +16904,83 @@ func (m *EndDevice) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEndDevice
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEndDevice
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ServiceProfileID = string(dAtA[iNdEx:postIndex])
+			if err := m.EndDevice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 9:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEndDevice(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateEndDeviceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEndDevice
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateEndDeviceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateEndDeviceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NetworkServerAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EndDevice", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEndDevice
@@ -12390,29 +16990,30 @@ func (m *EndDevice) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEndDevice
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEndDevice
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NetworkServerAddress = string(dAtA[iNdEx:postIndex])
+			if err := m.EndDevice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 10:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationServerAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FieldMask", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEndDevice
@@ -12422,29 +17023,83 @@ func (m *EndDevice) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEndDevice
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEndDevice
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ApplicationServerAddress = string(dAtA[iNdEx:postIndex])
+			if err := m.FieldMask.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 11:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEndDevice(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetEndDeviceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEndDevice
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetEndDeviceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetEndDeviceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JoinServerAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EndDeviceIdentifiers", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEndDevice
@@ -12454,27 +17109,28 @@ func (m *EndDevice) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEndDevice
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEndDevice
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.JoinServerAddress = string(dAtA[iNdEx:postIndex])
+			if err := m.EndDeviceIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 12:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Locations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FieldMask", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12501,170 +17157,68 @@ func (m *EndDevice) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Locations == nil {
-				m.Locations = make(map[string]*Location)
-			}
-			var mapkey string
-			var mapvalue *Location
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowEndDevice
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowEndDevice
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthEndDevice
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthEndDevice
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowEndDevice
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthEndDevice
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthEndDevice
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &Location{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipEndDevice(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if skippy < 0 {
-						return ErrInvalidLengthEndDevice
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			if err := m.FieldMask.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Locations[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 13:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SupportsClassB", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEndDevice(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEndDevice
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if skippy < 0 {
+				return ErrInvalidLengthEndDevice
 			}
-			m.SupportsClassB = bool(v != 0)
-		case 14:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SupportsClassC", wireType)
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthEndDevice
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEndDevice
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.SupportsClassC = bool(v != 0)
-		case 15:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LoRaWANVersion", wireType)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetEndDeviceIdentifiersForEUIsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEndDevice
 			}
-			m.LoRaWANVersion = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEndDevice
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.LoRaWANVersion |= MACVersion(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-		case 16:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LoRaWANPHYVersion", wireType)
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			m.LoRaWANPHYVersion = 0
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetEndDeviceIdentifiersForEUIsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetEndDeviceIdentifiersForEUIsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JoinEUI", wireType)
+			}
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEndDevice
@@ -12674,16 +17228,30 @@ func (m *EndDevice) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LoRaWANPHYVersion |= PHYVersion(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 17:
+			if byteLen < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.JoinEUI.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FrequencyPlanID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DevEUI", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEndDevice
@@ -12693,29 +17261,83 @@ func (m *EndDevice) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthEndDevice
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEndDevice
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FrequencyPlanID = string(dAtA[iNdEx:postIndex])
+			if err := m.DevEUI.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 18:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinFrequency", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEndDevice(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.MinFrequency = 0
+			if skippy < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListEndDevicesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEndDevice
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListEndDevicesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListEndDevicesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationIdentifiers", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEndDevice
@@ -12725,16 +17347,30 @@ func (m *EndDevice) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MinFrequency |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 19:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxFrequency", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthEndDevice
 			}
-			m.MaxFrequency = 0
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ApplicationIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FieldMask", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEndDevice
@@ -12744,16 +17380,30 @@ func (m *EndDevice) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxFrequency |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 20:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SupportsJoin", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthEndDevice
 			}
-			var v int
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FieldMask.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Order", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEndDevice
@@ -12763,17 +17413,29 @@ func (m *EndDevice) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.SupportsJoin = bool(v != 0)
-		case 21:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Order = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResetsJoinNonces", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
 			}
-			var v int
+			m.Limit = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEndDevice
@@ -12783,17 +17445,16 @@ func (m *EndDevice) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.Limit |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.ResetsJoinNonces = bool(v != 0)
-		case 22:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RootKeys", wireType)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Page", wireType)
 			}
-			var msglen int
+			m.Page = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEndDevice
@@ -12803,33 +17464,69 @@ func (m *EndDevice) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Page |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEndDevice(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthEndDevice
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthEndDevice
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.RootKeys == nil {
-				m.RootKeys = &RootKeys{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetEndDeviceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEndDevice
 			}
-			if err := m.RootKeys.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 23:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetEndDeviceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetEndDeviceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NetID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EndDevice", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEndDevice
@@ -12839,30 +17536,28 @@ func (m *EndDevice) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEndDevice
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEndDevice
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v go_thethings_network_lorawan_stack_pkg_types.NetID
-			m.NetID = &v
-			if err := m.NetID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.EndDevice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 24:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MACSettings", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FieldMask", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12889,52 +17584,66 @@ func (m *EndDevice) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.MACSettings == nil {
-				m.MACSettings = &MACSettings{}
-			}
-			if err := m.MACSettings.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.FieldMask.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 25:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MACState", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEndDevice
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEndDevice(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthEndDevice
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthEndDevice
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.MACState == nil {
-				m.MACState = &MACState{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BatchCreateEndDevicesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEndDevice
 			}
-			if err := m.MACState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 26:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BatchCreateEndDevicesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BatchCreateEndDevicesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Session", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationIdentifiers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12961,16 +17670,13 @@ func (m *EndDevice) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Session == nil {
-				m.Session = &Session{}
-			}
-			if err := m.Session.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ApplicationIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 27:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PendingSession", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Requests", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12997,168 +17703,67 @@ func (m *EndDevice) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PendingSession == nil {
-				m.PendingSession = &Session{}
-			}
-			if err := m.PendingSession.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Requests = append(m.Requests, &CreateEndDeviceRequest{})
+			if err := m.Requests[len(m.Requests)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 28:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastDevNonce", wireType)
-			}
-			m.LastDevNonce = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEndDevice
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.LastDevNonce |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 29:
-			if wireType == 0 {
-				var v uint32
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowEndDevice
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint32(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.UsedDevNonces = append(m.UsedDevNonces, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowEndDevice
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthEndDevice
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthEndDevice
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.UsedDevNonces) == 0 {
-					m.UsedDevNonces = make([]uint32, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint32
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowEndDevice
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint32(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.UsedDevNonces = append(m.UsedDevNonces, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field UsedDevNonces", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEndDevice(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-		case 30:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastJoinNonce", wireType)
+			if skippy < 0 {
+				return ErrInvalidLengthEndDevice
 			}
-			m.LastJoinNonce = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEndDevice
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.LastJoinNonce |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthEndDevice
 			}
-		case 31:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastRJCount0", wireType)
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.LastRJCount0 = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEndDevice
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.LastRJCount0 |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BatchGetEndDevicesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEndDevice
 			}
-		case 32:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastRJCount1", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			m.LastRJCount1 = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEndDevice
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.LastRJCount1 |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-		case 33:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BatchGetEndDevicesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BatchGetEndDevicesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastDevStatusReceivedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationIdentifiers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13185,35 +17790,13 @@ func (m *EndDevice) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LastDevStatusReceivedAt == nil {
-				m.LastDevStatusReceivedAt = new(time.Time)
-			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.LastDevStatusReceivedAt, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ApplicationIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 34:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PowerState", wireType)
-			}
-			m.PowerState = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEndDevice
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PowerState |= PowerState(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 35:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BatteryPercentage", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Requests", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13240,35 +17823,67 @@ func (m *EndDevice) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.BatteryPercentage == nil {
-				m.BatteryPercentage = &types.FloatValue{}
-			}
-			if err := m.BatteryPercentage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Requests = append(m.Requests, &GetEndDeviceRequest{})
+			if err := m.Requests[len(m.Requests)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 36:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DownlinkMargin", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEndDevice(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.DownlinkMargin = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEndDevice
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.DownlinkMargin |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if skippy < 0 {
+				return ErrInvalidLengthEndDevice
 			}
-		case 37:
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BatchUpdateEndDevicesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEndDevice
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BatchUpdateEndDevicesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BatchUpdateEndDevicesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecentADRUplinks", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationIdentifiers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13295,14 +17910,13 @@ func (m *EndDevice) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RecentADRUplinks = append(m.RecentADRUplinks, &UplinkMessage{})
-			if err := m.RecentADRUplinks[len(m.RecentADRUplinks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ApplicationIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 38:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecentUplinks", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Requests", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13329,48 +17943,67 @@ func (m *EndDevice) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RecentUplinks = append(m.RecentUplinks, &UplinkMessage{})
-			if err := m.RecentUplinks[len(m.RecentUplinks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Requests = append(m.Requests, &UpdateEndDeviceRequest{})
+			if err := m.Requests[len(m.Requests)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 39:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecentDownlinks", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEndDevice
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEndDevice(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthEndDevice
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthEndDevice
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RecentDownlinks = append(m.RecentDownlinks, &DownlinkMessage{})
-			if err := m.RecentDownlinks[len(m.RecentDownlinks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BatchSetEndDevicesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEndDevice
 			}
-			iNdEx = postIndex
-		case 40:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BatchSetEndDevicesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BatchSetEndDevicesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QueuedApplicationDownlinks", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationIdentifiers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13397,14 +18030,13 @@ func (m *EndDevice) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.QueuedApplicationDownlinks = append(m.QueuedApplicationDownlinks, &ApplicationDownlink{})
-			if err := m.QueuedApplicationDownlinks[len(m.QueuedApplicationDownlinks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ApplicationIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 41:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Formatters", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Requests", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13431,48 +18063,67 @@ func (m *EndDevice) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Formatters == nil {
-				m.Formatters = &MessagePayloadFormatters{}
-			}
-			if err := m.Formatters.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Requests = append(m.Requests, &SetEndDeviceRequest{})
+			if err := m.Requests[len(m.Requests)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 42:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProvisionerID", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEndDevice
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEndDevice(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthEndDevice
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthEndDevice
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProvisionerID = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 43:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BatchDeleteEndDevicesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEndDevice
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BatchDeleteEndDevicesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BatchDeleteEndDevicesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProvisioningData", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationIdentifiers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13499,16 +18150,13 @@ func (m *EndDevice) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ProvisioningData == nil {
-				m.ProvisioningData = &types.Struct{}
-			}
-			if err := m.ProvisioningData.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ApplicationIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 44:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PendingMACState", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EndDeviceIDs", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13535,36 +18183,67 @@ func (m *EndDevice) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PendingMACState == nil {
-				m.PendingMACState = &MACState{}
-			}
-			if err := m.PendingMACState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.EndDeviceIDs = append(m.EndDeviceIDs, &EndDeviceIdentifiers{})
+			if err := m.EndDeviceIDs[len(m.EndDeviceIDs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 45:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Multicast", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEndDevice(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEndDevice
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if skippy < 0 {
+				return ErrInvalidLengthEndDevice
 			}
-			m.Multicast = bool(v != 0)
-		case 46:
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BatchEndDevicesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEndDevice
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BatchEndDevicesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BatchEndDevicesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClaimAuthenticationCode", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13591,18 +18270,69 @@ func (m *EndDevice) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ClaimAuthenticationCode == nil {
-				m.ClaimAuthenticationCode = &EndDeviceAuthenticationCode{}
-			}
-			if err := m.ClaimAuthenticationCode.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Results = append(m.Results, BatchEndDevicesResponse_Result{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 47:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEndDevice(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BatchEndDevicesResponse_Result) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEndDevice
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Result: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Result: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NetworkServerKEKLabel", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IDs", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEndDevice
@@ -13612,29 +18342,30 @@ func (m *EndDevice) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEndDevice
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEndDevice
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NetworkServerKEKLabel = string(dAtA[iNdEx:postIndex])
+			if err := m.IDs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 48:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationServerKEKLabel", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EndDevice", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEndDevice
@@ -13644,29 +18375,33 @@ func (m *EndDevice) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEndDevice
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEndDevice
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ApplicationServerKEKLabel = string(dAtA[iNdEx:postIndex])
+			if m.EndDevice == nil {
+				m.EndDevice = &EndDevice{}
+			}
+			if err := m.EndDevice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 49:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationServerID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEndDevice
@@ -13676,27 +18411,84 @@ func (m *EndDevice) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEndDevice
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEndDevice
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ApplicationServerID = string(dAtA[iNdEx:postIndex])
+			if m.Error == nil {
+				m.Error = &ErrorDetails{}
+			}
+			if err := m.Error.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 50:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEndDevice(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EndDeviceProfileIdentifiers) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEndDevice
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EndDeviceProfileIdentifiers: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EndDeviceProfileIdentifiers: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Picture", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationIDs", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13723,18 +18515,18 @@ func (m *EndDevice) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Picture == nil {
-				m.Picture = &Picture{}
+			if m.ApplicationIDs == nil {
+				m.ApplicationIDs = &ApplicationIdentifiers{}
 			}
-			if err := m.Picture.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ApplicationIDs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 51:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SkipPayloadCrypto", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProfileID", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEndDevice
@@ -13744,12 +18536,24 @@ func (m *EndDevice) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.SkipPayloadCrypto = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProfileID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEndDevice(dAtA[iNdEx:])
@@ -13774,7 +18578,7 @@ func (m *EndDevice) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EndDevices) Unmarshal(dAtA []byte) error {
+func (m *EndDeviceProfile) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13797,15 +18601,15 @@ func (m *EndDevices) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EndDevices: wiretype end group for non-group")
+			return fmt.Errorf("proto: EndDeviceProfile: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EndDevices: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EndDeviceProfile: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndDevices", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EndDeviceProfileIdentifiers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13832,67 +18636,46 @@ func (m *EndDevices) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EndDevices = append(m.EndDevices, &EndDevice{})
-			if err := m.EndDevices[len(m.EndDevices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.EndDeviceProfileIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEndDevice(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthEndDevice
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEndDevice
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *CreateEndDeviceRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowEndDevice
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEndDevice
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CreatedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: CreateEndDeviceRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CreateEndDeviceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndDevice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13919,66 +18702,96 @@ func (m *CreateEndDeviceRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.EndDevice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.UpdatedAt, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEndDevice(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
-			if skippy < 0 {
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEndDevice
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthEndDevice
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *UpdateEndDeviceRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowEndDevice
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEndDevice
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: UpdateEndDeviceRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpdateEndDeviceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndDevice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MACSettings", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14005,15 +18818,56 @@ func (m *UpdateEndDeviceRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.EndDevice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.MACSettings == nil {
+				m.MACSettings = &MACSettings{}
+			}
+			if err := m.MACSettings.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 2:
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LoRaWANVersion", wireType)
+			}
+			m.LoRaWANVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LoRaWANVersion |= MACVersion(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LoRaWANPHYVersion", wireType)
+			}
+			m.LoRaWANPHYVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LoRaWANPHYVersion |= PHYVersion(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FieldMask", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FrequencyPlanID", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEndDevice
@@ -14023,24 +18877,23 @@ func (m *UpdateEndDeviceRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEndDevice
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEndDevice
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.FieldMask.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.FrequencyPlanID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -14066,7 +18919,7 @@ func (m *UpdateEndDeviceRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetEndDeviceRequest) Unmarshal(dAtA []byte) error {
+func (m *EndDeviceProfiles) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14089,48 +18942,15 @@ func (m *GetEndDeviceRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetEndDeviceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: EndDeviceProfiles: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetEndDeviceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EndDeviceProfiles: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndDeviceIdentifiers", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEndDevice
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthEndDevice
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthEndDevice
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.EndDeviceIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FieldMask", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Profiles", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14157,7 +18977,8 @@ func (m *GetEndDeviceRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.FieldMask.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Profiles = append(m.Profiles, &EndDeviceProfile{})
+			if err := m.Profiles[len(m.Profiles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -14185,7 +19006,7 @@ func (m *GetEndDeviceRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetEndDeviceIdentifiersForEUIsRequest) Unmarshal(dAtA []byte) error {
+func (m *GetEndDeviceProfileRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14208,17 +19029,17 @@ func (m *GetEndDeviceIdentifiersForEUIsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetEndDeviceIdentifiersForEUIsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetEndDeviceProfileRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetEndDeviceIdentifiersForEUIsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetEndDeviceProfileRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JoinEUI", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EndDeviceProfileIdentifiers", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEndDevice
@@ -14228,30 +19049,30 @@ func (m *GetEndDeviceIdentifiersForEUIsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEndDevice
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEndDevice
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.JoinEUI.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.EndDeviceProfileIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DevEUI", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FieldMask", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEndDevice
@@ -14261,22 +19082,22 @@ func (m *GetEndDeviceIdentifiersForEUIsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEndDevice
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEndDevice
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.DevEUI.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.FieldMask.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -14304,7 +19125,7 @@ func (m *GetEndDeviceIdentifiersForEUIsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListEndDevicesRequest) Unmarshal(dAtA []byte) error {
+func (m *ListEndDeviceProfilesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14327,15 +19148,15 @@ func (m *ListEndDevicesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListEndDevicesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListEndDeviceProfilesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListEndDevicesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListEndDeviceProfilesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationIdentifiers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationIDs", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14362,7 +19183,10 @@ func (m *ListEndDevicesRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ApplicationIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.ApplicationIDs == nil {
+				m.ApplicationIDs = &ApplicationIdentifiers{}
+			}
+			if err := m.ApplicationIDs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -14400,38 +19224,6 @@ func (m *ListEndDevicesRequest) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Order", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEndDevice
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEndDevice
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEndDevice
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Order = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
 			}
@@ -14450,7 +19242,7 @@ func (m *ListEndDevicesRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 5:
+		case 4:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Page", wireType)
 			}
@@ -14493,7 +19285,7 @@ func (m *ListEndDevicesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SetEndDeviceRequest) Unmarshal(dAtA []byte) error {
+func (m *SetEndDeviceProfileRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14516,15 +19308,15 @@ func (m *SetEndDeviceRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SetEndDeviceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SetEndDeviceProfileRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SetEndDeviceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SetEndDeviceProfileRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndDevice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Profile", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14551,7 +19343,7 @@ func (m *SetEndDeviceRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.EndDevice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Profile.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -15189,6 +19981,147 @@ func (m *ConvertEndDeviceTemplateRequest) Unmarshal(dAtA []byte) error {
 				m.Data = []byte{}
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CSVOptions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CSVOptions == nil {
+				m.CSVOptions = &ConvertEndDeviceTemplateRequest_CSVOptions{}
+			}
+			if err := m.CSVOptions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEndDevice(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConvertEndDeviceTemplateRequest_CSVOptions) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEndDevice
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CSVOptions: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CSVOptions: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Separator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Separator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Base64", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Base64 = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEndDevice(dAtA[iNdEx:])
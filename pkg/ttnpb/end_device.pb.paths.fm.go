@@ -184,6 +184,7 @@ var EndDeviceVersionFieldPathsNested = []string{
 	"max_frequency",
 	"min_frequency",
 	"photos",
+	"profile_ids",
 	"resets_join_nonces",
 	"supports_class_b",
 	"supports_class_c",
@@ -200,6 +201,7 @@ var EndDeviceVersionFieldPathsTopLevel = []string{
 	"max_frequency",
 	"min_frequency",
 	"photos",
+	"profile_ids",
 	"resets_join_nonces",
 	"supports_class_b",
 	"supports_class_c",
@@ -334,6 +336,7 @@ var MACStateFieldPathsNested = []string{
 	"last_confirmed_downlink_at",
 	"last_dev_status_f_cnt_up",
 	"last_network_initiated_downlink_at",
+	"last_rj_count_0",
 	"lorawan_version",
 	"pending_application_downlink",
 	"pending_application_downlink.class_b_c",
@@ -342,6 +345,7 @@ var MACStateFieldPathsNested = []string{
 	"pending_application_downlink.confirmed",
 	"pending_application_downlink.correlation_ids",
 	"pending_application_downlink.decoded_payload",
+	"pending_application_downlink.decoded_payload_warnings",
 	"pending_application_downlink.f_cnt",
 	"pending_application_downlink.f_port",
 	"pending_application_downlink.frm_payload",
@@ -358,6 +362,7 @@ var MACStateFieldPathsNested = []string{
 	"pending_join_request.downlink_settings.opt_neg",
 	"pending_join_request.downlink_settings.rx1_dr_offset",
 	"pending_join_request.downlink_settings.rx2_dr",
+	"pending_join_request.join_eui",
 	"pending_join_request.net_id",
 	"pending_join_request.payload",
 	"pending_join_request.payload.Payload",
@@ -409,6 +414,11 @@ var MACStateFieldPathsNested = []string{
 	"pending_requests",
 	"ping_slot_periodicity",
 	"ping_slot_periodicity.value",
+	"queued_force_rejoin_req",
+	"queued_force_rejoin_req.data_rate_index",
+	"queued_force_rejoin_req.max_retries",
+	"queued_force_rejoin_req.period_exponent",
+	"queued_force_rejoin_req.rejoin_type",
 	"queued_join_accept",
 	"queued_join_accept.keys",
 	"queued_join_accept.keys.app_s_key",
@@ -440,6 +450,7 @@ var MACStateFieldPathsNested = []string{
 	"queued_join_accept.request.downlink_settings.opt_neg",
 	"queued_join_accept.request.downlink_settings.rx1_dr_offset",
 	"queued_join_accept.request.downlink_settings.rx2_dr",
+	"queued_join_accept.request.join_eui",
 	"queued_join_accept.request.net_id",
 	"queued_join_accept.request.payload",
 	"queued_join_accept.request.payload.Payload",
@@ -501,11 +512,13 @@ var MACStateFieldPathsTopLevel = []string{
 	"last_confirmed_downlink_at",
 	"last_dev_status_f_cnt_up",
 	"last_network_initiated_downlink_at",
+	"last_rj_count_0",
 	"lorawan_version",
 	"pending_application_downlink",
 	"pending_join_request",
 	"pending_requests",
 	"ping_slot_periodicity",
+	"queued_force_rejoin_req",
 	"queued_join_accept",
 	"queued_responses",
 	"recent_downlinks",
@@ -655,6 +668,7 @@ var EndDeviceFieldPathsNested = []string{
 	"mac_state.last_confirmed_downlink_at",
 	"mac_state.last_dev_status_f_cnt_up",
 	"mac_state.last_network_initiated_downlink_at",
+	"mac_state.last_rj_count_0",
 	"mac_state.lorawan_version",
 	"mac_state.pending_application_downlink",
 	"mac_state.pending_application_downlink.class_b_c",
@@ -663,6 +677,7 @@ var EndDeviceFieldPathsNested = []string{
 	"mac_state.pending_application_downlink.confirmed",
 	"mac_state.pending_application_downlink.correlation_ids",
 	"mac_state.pending_application_downlink.decoded_payload",
+	"mac_state.pending_application_downlink.decoded_payload_warnings",
 	"mac_state.pending_application_downlink.f_cnt",
 	"mac_state.pending_application_downlink.f_port",
 	"mac_state.pending_application_downlink.frm_payload",
@@ -679,6 +694,7 @@ var EndDeviceFieldPathsNested = []string{
 	"mac_state.pending_join_request.downlink_settings.opt_neg",
 	"mac_state.pending_join_request.downlink_settings.rx1_dr_offset",
 	"mac_state.pending_join_request.downlink_settings.rx2_dr",
+	"mac_state.pending_join_request.join_eui",
 	"mac_state.pending_join_request.net_id",
 	"mac_state.pending_join_request.payload",
 	"mac_state.pending_join_request.payload.Payload",
@@ -730,6 +746,11 @@ var EndDeviceFieldPathsNested = []string{
 	"mac_state.pending_requests",
 	"mac_state.ping_slot_periodicity",
 	"mac_state.ping_slot_periodicity.value",
+	"mac_state.queued_force_rejoin_req",
+	"mac_state.queued_force_rejoin_req.data_rate_index",
+	"mac_state.queued_force_rejoin_req.max_retries",
+	"mac_state.queued_force_rejoin_req.period_exponent",
+	"mac_state.queued_force_rejoin_req.rejoin_type",
 	"mac_state.queued_join_accept",
 	"mac_state.queued_join_accept.keys",
 	"mac_state.queued_join_accept.keys.app_s_key",
@@ -761,6 +782,7 @@ var EndDeviceFieldPathsNested = []string{
 	"mac_state.queued_join_accept.request.downlink_settings.opt_neg",
 	"mac_state.queued_join_accept.request.downlink_settings.rx1_dr_offset",
 	"mac_state.queued_join_accept.request.downlink_settings.rx2_dr",
+	"mac_state.queued_join_accept.request.join_eui",
 	"mac_state.queued_join_accept.request.net_id",
 	"mac_state.queued_join_accept.request.payload",
 	"mac_state.queued_join_accept.request.payload.Payload",
@@ -877,6 +899,7 @@ var EndDeviceFieldPathsNested = []string{
 	"pending_mac_state.last_confirmed_downlink_at",
 	"pending_mac_state.last_dev_status_f_cnt_up",
 	"pending_mac_state.last_network_initiated_downlink_at",
+	"pending_mac_state.last_rj_count_0",
 	"pending_mac_state.lorawan_version",
 	"pending_mac_state.pending_application_downlink",
 	"pending_mac_state.pending_application_downlink.class_b_c",
@@ -885,6 +908,7 @@ var EndDeviceFieldPathsNested = []string{
 	"pending_mac_state.pending_application_downlink.confirmed",
 	"pending_mac_state.pending_application_downlink.correlation_ids",
 	"pending_mac_state.pending_application_downlink.decoded_payload",
+	"pending_mac_state.pending_application_downlink.decoded_payload_warnings",
 	"pending_mac_state.pending_application_downlink.f_cnt",
 	"pending_mac_state.pending_application_downlink.f_port",
 	"pending_mac_state.pending_application_downlink.frm_payload",
@@ -901,6 +925,7 @@ var EndDeviceFieldPathsNested = []string{
 	"pending_mac_state.pending_join_request.downlink_settings.opt_neg",
 	"pending_mac_state.pending_join_request.downlink_settings.rx1_dr_offset",
 	"pending_mac_state.pending_join_request.downlink_settings.rx2_dr",
+	"pending_mac_state.pending_join_request.join_eui",
 	"pending_mac_state.pending_join_request.net_id",
 	"pending_mac_state.pending_join_request.payload",
 	"pending_mac_state.pending_join_request.payload.Payload",
@@ -952,6 +977,11 @@ var EndDeviceFieldPathsNested = []string{
 	"pending_mac_state.pending_requests",
 	"pending_mac_state.ping_slot_periodicity",
 	"pending_mac_state.ping_slot_periodicity.value",
+	"pending_mac_state.queued_force_rejoin_req",
+	"pending_mac_state.queued_force_rejoin_req.data_rate_index",
+	"pending_mac_state.queued_force_rejoin_req.max_retries",
+	"pending_mac_state.queued_force_rejoin_req.period_exponent",
+	"pending_mac_state.queued_force_rejoin_req.rejoin_type",
 	"pending_mac_state.queued_join_accept",
 	"pending_mac_state.queued_join_accept.keys",
 	"pending_mac_state.queued_join_accept.keys.app_s_key",
@@ -983,6 +1013,7 @@ var EndDeviceFieldPathsNested = []string{
 	"pending_mac_state.queued_join_accept.request.downlink_settings.opt_neg",
 	"pending_mac_state.queued_join_accept.request.downlink_settings.rx1_dr_offset",
 	"pending_mac_state.queued_join_accept.request.downlink_settings.rx2_dr",
+	"pending_mac_state.queued_join_accept.request.join_eui",
 	"pending_mac_state.queued_join_accept.request.net_id",
 	"pending_mac_state.queued_join_accept.request.payload",
 	"pending_mac_state.queued_join_accept.request.payload.Payload",
@@ -1066,6 +1097,10 @@ var EndDeviceFieldPathsNested = []string{
 	"picture.embedded.mime_type",
 	"picture.sizes",
 	"power_state",
+	"profile_ids",
+	"profile_ids.application_ids",
+	"profile_ids.application_ids.application_id",
+	"profile_ids.profile_id",
 	"provisioner_id",
 	"provisioning_data",
 	"queued_application_downlinks",
@@ -1157,6 +1192,7 @@ var EndDeviceFieldPathsTopLevel = []string{
 	"pending_session",
 	"picture",
 	"power_state",
+	"profile_ids",
 	"provisioner_id",
 	"provisioning_data",
 	"queued_application_downlinks",
@@ -1315,6 +1351,7 @@ var CreateEndDeviceRequestFieldPathsNested = []string{
 	"end_device.mac_state.last_confirmed_downlink_at",
 	"end_device.mac_state.last_dev_status_f_cnt_up",
 	"end_device.mac_state.last_network_initiated_downlink_at",
+	"end_device.mac_state.last_rj_count_0",
 	"end_device.mac_state.lorawan_version",
 	"end_device.mac_state.pending_application_downlink",
 	"end_device.mac_state.pending_application_downlink.class_b_c",
@@ -1323,6 +1360,7 @@ var CreateEndDeviceRequestFieldPathsNested = []string{
 	"end_device.mac_state.pending_application_downlink.confirmed",
 	"end_device.mac_state.pending_application_downlink.correlation_ids",
 	"end_device.mac_state.pending_application_downlink.decoded_payload",
+	"end_device.mac_state.pending_application_downlink.decoded_payload_warnings",
 	"end_device.mac_state.pending_application_downlink.f_cnt",
 	"end_device.mac_state.pending_application_downlink.f_port",
 	"end_device.mac_state.pending_application_downlink.frm_payload",
@@ -1339,6 +1377,7 @@ var CreateEndDeviceRequestFieldPathsNested = []string{
 	"end_device.mac_state.pending_join_request.downlink_settings.opt_neg",
 	"end_device.mac_state.pending_join_request.downlink_settings.rx1_dr_offset",
 	"end_device.mac_state.pending_join_request.downlink_settings.rx2_dr",
+	"end_device.mac_state.pending_join_request.join_eui",
 	"end_device.mac_state.pending_join_request.net_id",
 	"end_device.mac_state.pending_join_request.payload",
 	"end_device.mac_state.pending_join_request.payload.Payload",
@@ -1390,6 +1429,11 @@ var CreateEndDeviceRequestFieldPathsNested = []string{
 	"end_device.mac_state.pending_requests",
 	"end_device.mac_state.ping_slot_periodicity",
 	"end_device.mac_state.ping_slot_periodicity.value",
+	"end_device.mac_state.queued_force_rejoin_req",
+	"end_device.mac_state.queued_force_rejoin_req.data_rate_index",
+	"end_device.mac_state.queued_force_rejoin_req.max_retries",
+	"end_device.mac_state.queued_force_rejoin_req.period_exponent",
+	"end_device.mac_state.queued_force_rejoin_req.rejoin_type",
 	"end_device.mac_state.queued_join_accept",
 	"end_device.mac_state.queued_join_accept.keys",
 	"end_device.mac_state.queued_join_accept.keys.app_s_key",
@@ -1421,6 +1465,7 @@ var CreateEndDeviceRequestFieldPathsNested = []string{
 	"end_device.mac_state.queued_join_accept.request.downlink_settings.opt_neg",
 	"end_device.mac_state.queued_join_accept.request.downlink_settings.rx1_dr_offset",
 	"end_device.mac_state.queued_join_accept.request.downlink_settings.rx2_dr",
+	"end_device.mac_state.queued_join_accept.request.join_eui",
 	"end_device.mac_state.queued_join_accept.request.net_id",
 	"end_device.mac_state.queued_join_accept.request.payload",
 	"end_device.mac_state.queued_join_accept.request.payload.Payload",
@@ -1537,6 +1582,7 @@ var CreateEndDeviceRequestFieldPathsNested = []string{
 	"end_device.pending_mac_state.last_confirmed_downlink_at",
 	"end_device.pending_mac_state.last_dev_status_f_cnt_up",
 	"end_device.pending_mac_state.last_network_initiated_downlink_at",
+	"end_device.pending_mac_state.last_rj_count_0",
 	"end_device.pending_mac_state.lorawan_version",
 	"end_device.pending_mac_state.pending_application_downlink",
 	"end_device.pending_mac_state.pending_application_downlink.class_b_c",
@@ -1545,6 +1591,7 @@ var CreateEndDeviceRequestFieldPathsNested = []string{
 	"end_device.pending_mac_state.pending_application_downlink.confirmed",
 	"end_device.pending_mac_state.pending_application_downlink.correlation_ids",
 	"end_device.pending_mac_state.pending_application_downlink.decoded_payload",
+	"end_device.pending_mac_state.pending_application_downlink.decoded_payload_warnings",
 	"end_device.pending_mac_state.pending_application_downlink.f_cnt",
 	"end_device.pending_mac_state.pending_application_downlink.f_port",
 	"end_device.pending_mac_state.pending_application_downlink.frm_payload",
@@ -1561,6 +1608,7 @@ var CreateEndDeviceRequestFieldPathsNested = []string{
 	"end_device.pending_mac_state.pending_join_request.downlink_settings.opt_neg",
 	"end_device.pending_mac_state.pending_join_request.downlink_settings.rx1_dr_offset",
 	"end_device.pending_mac_state.pending_join_request.downlink_settings.rx2_dr",
+	"end_device.pending_mac_state.pending_join_request.join_eui",
 	"end_device.pending_mac_state.pending_join_request.net_id",
 	"end_device.pending_mac_state.pending_join_request.payload",
 	"end_device.pending_mac_state.pending_join_request.payload.Payload",
@@ -1612,6 +1660,11 @@ var CreateEndDeviceRequestFieldPathsNested = []string{
 	"end_device.pending_mac_state.pending_requests",
 	"end_device.pending_mac_state.ping_slot_periodicity",
 	"end_device.pending_mac_state.ping_slot_periodicity.value",
+	"end_device.pending_mac_state.queued_force_rejoin_req",
+	"end_device.pending_mac_state.queued_force_rejoin_req.data_rate_index",
+	"end_device.pending_mac_state.queued_force_rejoin_req.max_retries",
+	"end_device.pending_mac_state.queued_force_rejoin_req.period_exponent",
+	"end_device.pending_mac_state.queued_force_rejoin_req.rejoin_type",
 	"end_device.pending_mac_state.queued_join_accept",
 	"end_device.pending_mac_state.queued_join_accept.keys",
 	"end_device.pending_mac_state.queued_join_accept.keys.app_s_key",
@@ -1643,6 +1696,7 @@ var CreateEndDeviceRequestFieldPathsNested = []string{
 	"end_device.pending_mac_state.queued_join_accept.request.downlink_settings.opt_neg",
 	"end_device.pending_mac_state.queued_join_accept.request.downlink_settings.rx1_dr_offset",
 	"end_device.pending_mac_state.queued_join_accept.request.downlink_settings.rx2_dr",
+	"end_device.pending_mac_state.queued_join_accept.request.join_eui",
 	"end_device.pending_mac_state.queued_join_accept.request.net_id",
 	"end_device.pending_mac_state.queued_join_accept.request.payload",
 	"end_device.pending_mac_state.queued_join_accept.request.payload.Payload",
@@ -1726,6 +1780,10 @@ var CreateEndDeviceRequestFieldPathsNested = []string{
 	"end_device.picture.embedded.mime_type",
 	"end_device.picture.sizes",
 	"end_device.power_state",
+	"end_device.profile_ids",
+	"end_device.profile_ids.application_ids",
+	"end_device.profile_ids.application_ids.application_id",
+	"end_device.profile_ids.profile_id",
 	"end_device.provisioner_id",
 	"end_device.provisioning_data",
 	"end_device.queued_application_downlinks",
@@ -1918,6 +1976,7 @@ var UpdateEndDeviceRequestFieldPathsNested = []string{
 	"end_device.mac_state.last_confirmed_downlink_at",
 	"end_device.mac_state.last_dev_status_f_cnt_up",
 	"end_device.mac_state.last_network_initiated_downlink_at",
+	"end_device.mac_state.last_rj_count_0",
 	"end_device.mac_state.lorawan_version",
 	"end_device.mac_state.pending_application_downlink",
 	"end_device.mac_state.pending_application_downlink.class_b_c",
@@ -1926,6 +1985,7 @@ var UpdateEndDeviceRequestFieldPathsNested = []string{
 	"end_device.mac_state.pending_application_downlink.confirmed",
 	"end_device.mac_state.pending_application_downlink.correlation_ids",
 	"end_device.mac_state.pending_application_downlink.decoded_payload",
+	"end_device.mac_state.pending_application_downlink.decoded_payload_warnings",
 	"end_device.mac_state.pending_application_downlink.f_cnt",
 	"end_device.mac_state.pending_application_downlink.f_port",
 	"end_device.mac_state.pending_application_downlink.frm_payload",
@@ -1942,6 +2002,7 @@ var UpdateEndDeviceRequestFieldPathsNested = []string{
 	"end_device.mac_state.pending_join_request.downlink_settings.opt_neg",
 	"end_device.mac_state.pending_join_request.downlink_settings.rx1_dr_offset",
 	"end_device.mac_state.pending_join_request.downlink_settings.rx2_dr",
+	"end_device.mac_state.pending_join_request.join_eui",
 	"end_device.mac_state.pending_join_request.net_id",
 	"end_device.mac_state.pending_join_request.payload",
 	"end_device.mac_state.pending_join_request.payload.Payload",
@@ -1993,6 +2054,11 @@ var UpdateEndDeviceRequestFieldPathsNested = []string{
 	"end_device.mac_state.pending_requests",
 	"end_device.mac_state.ping_slot_periodicity",
 	"end_device.mac_state.ping_slot_periodicity.value",
+	"end_device.mac_state.queued_force_rejoin_req",
+	"end_device.mac_state.queued_force_rejoin_req.data_rate_index",
+	"end_device.mac_state.queued_force_rejoin_req.max_retries",
+	"end_device.mac_state.queued_force_rejoin_req.period_exponent",
+	"end_device.mac_state.queued_force_rejoin_req.rejoin_type",
 	"end_device.mac_state.queued_join_accept",
 	"end_device.mac_state.queued_join_accept.keys",
 	"end_device.mac_state.queued_join_accept.keys.app_s_key",
@@ -2024,6 +2090,7 @@ var UpdateEndDeviceRequestFieldPathsNested = []string{
 	"end_device.mac_state.queued_join_accept.request.downlink_settings.opt_neg",
 	"end_device.mac_state.queued_join_accept.request.downlink_settings.rx1_dr_offset",
 	"end_device.mac_state.queued_join_accept.request.downlink_settings.rx2_dr",
+	"end_device.mac_state.queued_join_accept.request.join_eui",
 	"end_device.mac_state.queued_join_accept.request.net_id",
 	"end_device.mac_state.queued_join_accept.request.payload",
 	"end_device.mac_state.queued_join_accept.request.payload.Payload",
@@ -2140,6 +2207,7 @@ var UpdateEndDeviceRequestFieldPathsNested = []string{
 	"end_device.pending_mac_state.last_confirmed_downlink_at",
 	"end_device.pending_mac_state.last_dev_status_f_cnt_up",
 	"end_device.pending_mac_state.last_network_initiated_downlink_at",
+	"end_device.pending_mac_state.last_rj_count_0",
 	"end_device.pending_mac_state.lorawan_version",
 	"end_device.pending_mac_state.pending_application_downlink",
 	"end_device.pending_mac_state.pending_application_downlink.class_b_c",
@@ -2148,6 +2216,7 @@ var UpdateEndDeviceRequestFieldPathsNested = []string{
 	"end_device.pending_mac_state.pending_application_downlink.confirmed",
 	"end_device.pending_mac_state.pending_application_downlink.correlation_ids",
 	"end_device.pending_mac_state.pending_application_downlink.decoded_payload",
+	"end_device.pending_mac_state.pending_application_downlink.decoded_payload_warnings",
 	"end_device.pending_mac_state.pending_application_downlink.f_cnt",
 	"end_device.pending_mac_state.pending_application_downlink.f_port",
 	"end_device.pending_mac_state.pending_application_downlink.frm_payload",
@@ -2164,6 +2233,7 @@ var UpdateEndDeviceRequestFieldPathsNested = []string{
 	"end_device.pending_mac_state.pending_join_request.downlink_settings.opt_neg",
 	"end_device.pending_mac_state.pending_join_request.downlink_settings.rx1_dr_offset",
 	"end_device.pending_mac_state.pending_join_request.downlink_settings.rx2_dr",
+	"end_device.pending_mac_state.pending_join_request.join_eui",
 	"end_device.pending_mac_state.pending_join_request.net_id",
 	"end_device.pending_mac_state.pending_join_request.payload",
 	"end_device.pending_mac_state.pending_join_request.payload.Payload",
@@ -2215,6 +2285,11 @@ var UpdateEndDeviceRequestFieldPathsNested = []string{
 	"end_device.pending_mac_state.pending_requests",
 	"end_device.pending_mac_state.ping_slot_periodicity",
 	"end_device.pending_mac_state.ping_slot_periodicity.value",
+	"end_device.pending_mac_state.queued_force_rejoin_req",
+	"end_device.pending_mac_state.queued_force_rejoin_req.data_rate_index",
+	"end_device.pending_mac_state.queued_force_rejoin_req.max_retries",
+	"end_device.pending_mac_state.queued_force_rejoin_req.period_exponent",
+	"end_device.pending_mac_state.queued_force_rejoin_req.rejoin_type",
 	"end_device.pending_mac_state.queued_join_accept",
 	"end_device.pending_mac_state.queued_join_accept.keys",
 	"end_device.pending_mac_state.queued_join_accept.keys.app_s_key",
@@ -2246,6 +2321,7 @@ var UpdateEndDeviceRequestFieldPathsNested = []string{
 	"end_device.pending_mac_state.queued_join_accept.request.downlink_settings.opt_neg",
 	"end_device.pending_mac_state.queued_join_accept.request.downlink_settings.rx1_dr_offset",
 	"end_device.pending_mac_state.queued_join_accept.request.downlink_settings.rx2_dr",
+	"end_device.pending_mac_state.queued_join_accept.request.join_eui",
 	"end_device.pending_mac_state.queued_join_accept.request.net_id",
 	"end_device.pending_mac_state.queued_join_accept.request.payload",
 	"end_device.pending_mac_state.queued_join_accept.request.payload.Payload",
@@ -2329,6 +2405,10 @@ var UpdateEndDeviceRequestFieldPathsNested = []string{
 	"end_device.picture.embedded.mime_type",
 	"end_device.picture.sizes",
 	"end_device.power_state",
+	"end_device.profile_ids",
+	"end_device.profile_ids.application_ids",
+	"end_device.profile_ids.application_ids.application_id",
+	"end_device.profile_ids.profile_id",
 	"end_device.provisioner_id",
 	"end_device.provisioning_data",
 	"end_device.queued_application_downlinks",
@@ -2563,6 +2643,7 @@ var SetEndDeviceRequestFieldPathsNested = []string{
 	"end_device.mac_state.last_confirmed_downlink_at",
 	"end_device.mac_state.last_dev_status_f_cnt_up",
 	"end_device.mac_state.last_network_initiated_downlink_at",
+	"end_device.mac_state.last_rj_count_0",
 	"end_device.mac_state.lorawan_version",
 	"end_device.mac_state.pending_application_downlink",
 	"end_device.mac_state.pending_application_downlink.class_b_c",
@@ -2571,6 +2652,7 @@ var SetEndDeviceRequestFieldPathsNested = []string{
 	"end_device.mac_state.pending_application_downlink.confirmed",
 	"end_device.mac_state.pending_application_downlink.correlation_ids",
 	"end_device.mac_state.pending_application_downlink.decoded_payload",
+	"end_device.mac_state.pending_application_downlink.decoded_payload_warnings",
 	"end_device.mac_state.pending_application_downlink.f_cnt",
 	"end_device.mac_state.pending_application_downlink.f_port",
 	"end_device.mac_state.pending_application_downlink.frm_payload",
@@ -2587,6 +2669,7 @@ var SetEndDeviceRequestFieldPathsNested = []string{
 	"end_device.mac_state.pending_join_request.downlink_settings.opt_neg",
 	"end_device.mac_state.pending_join_request.downlink_settings.rx1_dr_offset",
 	"end_device.mac_state.pending_join_request.downlink_settings.rx2_dr",
+	"end_device.mac_state.pending_join_request.join_eui",
 	"end_device.mac_state.pending_join_request.net_id",
 	"end_device.mac_state.pending_join_request.payload",
 	"end_device.mac_state.pending_join_request.payload.Payload",
@@ -2638,6 +2721,11 @@ var SetEndDeviceRequestFieldPathsNested = []string{
 	"end_device.mac_state.pending_requests",
 	"end_device.mac_state.ping_slot_periodicity",
 	"end_device.mac_state.ping_slot_periodicity.value",
+	"end_device.mac_state.queued_force_rejoin_req",
+	"end_device.mac_state.queued_force_rejoin_req.data_rate_index",
+	"end_device.mac_state.queued_force_rejoin_req.max_retries",
+	"end_device.mac_state.queued_force_rejoin_req.period_exponent",
+	"end_device.mac_state.queued_force_rejoin_req.rejoin_type",
 	"end_device.mac_state.queued_join_accept",
 	"end_device.mac_state.queued_join_accept.keys",
 	"end_device.mac_state.queued_join_accept.keys.app_s_key",
@@ -2669,6 +2757,7 @@ var SetEndDeviceRequestFieldPathsNested = []string{
 	"end_device.mac_state.queued_join_accept.request.downlink_settings.opt_neg",
 	"end_device.mac_state.queued_join_accept.request.downlink_settings.rx1_dr_offset",
 	"end_device.mac_state.queued_join_accept.request.downlink_settings.rx2_dr",
+	"end_device.mac_state.queued_join_accept.request.join_eui",
 	"end_device.mac_state.queued_join_accept.request.net_id",
 	"end_device.mac_state.queued_join_accept.request.payload",
 	"end_device.mac_state.queued_join_accept.request.payload.Payload",
@@ -2785,6 +2874,7 @@ var SetEndDeviceRequestFieldPathsNested = []string{
 	"end_device.pending_mac_state.last_confirmed_downlink_at",
 	"end_device.pending_mac_state.last_dev_status_f_cnt_up",
 	"end_device.pending_mac_state.last_network_initiated_downlink_at",
+	"end_device.pending_mac_state.last_rj_count_0",
 	"end_device.pending_mac_state.lorawan_version",
 	"end_device.pending_mac_state.pending_application_downlink",
 	"end_device.pending_mac_state.pending_application_downlink.class_b_c",
@@ -2793,6 +2883,7 @@ var SetEndDeviceRequestFieldPathsNested = []string{
 	"end_device.pending_mac_state.pending_application_downlink.confirmed",
 	"end_device.pending_mac_state.pending_application_downlink.correlation_ids",
 	"end_device.pending_mac_state.pending_application_downlink.decoded_payload",
+	"end_device.pending_mac_state.pending_application_downlink.decoded_payload_warnings",
 	"end_device.pending_mac_state.pending_application_downlink.f_cnt",
 	"end_device.pending_mac_state.pending_application_downlink.f_port",
 	"end_device.pending_mac_state.pending_application_downlink.frm_payload",
@@ -2809,6 +2900,7 @@ var SetEndDeviceRequestFieldPathsNested = []string{
 	"end_device.pending_mac_state.pending_join_request.downlink_settings.opt_neg",
 	"end_device.pending_mac_state.pending_join_request.downlink_settings.rx1_dr_offset",
 	"end_device.pending_mac_state.pending_join_request.downlink_settings.rx2_dr",
+	"end_device.pending_mac_state.pending_join_request.join_eui",
 	"end_device.pending_mac_state.pending_join_request.net_id",
 	"end_device.pending_mac_state.pending_join_request.payload",
 	"end_device.pending_mac_state.pending_join_request.payload.Payload",
@@ -2860,6 +2952,11 @@ var SetEndDeviceRequestFieldPathsNested = []string{
 	"end_device.pending_mac_state.pending_requests",
 	"end_device.pending_mac_state.ping_slot_periodicity",
 	"end_device.pending_mac_state.ping_slot_periodicity.value",
+	"end_device.pending_mac_state.queued_force_rejoin_req",
+	"end_device.pending_mac_state.queued_force_rejoin_req.data_rate_index",
+	"end_device.pending_mac_state.queued_force_rejoin_req.max_retries",
+	"end_device.pending_mac_state.queued_force_rejoin_req.period_exponent",
+	"end_device.pending_mac_state.queued_force_rejoin_req.rejoin_type",
 	"end_device.pending_mac_state.queued_join_accept",
 	"end_device.pending_mac_state.queued_join_accept.keys",
 	"end_device.pending_mac_state.queued_join_accept.keys.app_s_key",
@@ -2891,6 +2988,7 @@ var SetEndDeviceRequestFieldPathsNested = []string{
 	"end_device.pending_mac_state.queued_join_accept.request.downlink_settings.opt_neg",
 	"end_device.pending_mac_state.queued_join_accept.request.downlink_settings.rx1_dr_offset",
 	"end_device.pending_mac_state.queued_join_accept.request.downlink_settings.rx2_dr",
+	"end_device.pending_mac_state.queued_join_accept.request.join_eui",
 	"end_device.pending_mac_state.queued_join_accept.request.net_id",
 	"end_device.pending_mac_state.queued_join_accept.request.payload",
 	"end_device.pending_mac_state.queued_join_accept.request.payload.Payload",
@@ -2974,6 +3072,10 @@ var SetEndDeviceRequestFieldPathsNested = []string{
 	"end_device.picture.embedded.mime_type",
 	"end_device.picture.sizes",
 	"end_device.power_state",
+	"end_device.profile_ids",
+	"end_device.profile_ids.application_ids",
+	"end_device.profile_ids.application_ids.application_id",
+	"end_device.profile_ids.profile_id",
 	"end_device.provisioner_id",
 	"end_device.provisioning_data",
 	"end_device.queued_application_downlinks",
@@ -3035,6 +3137,234 @@ var SetEndDeviceRequestFieldPathsTopLevel = []string{
 	"end_device",
 	"field_mask",
 }
+var BatchCreateEndDevicesRequestFieldPathsNested = []string{
+	"application_ids",
+	"application_ids.application_id",
+	"requests",
+}
+
+var BatchCreateEndDevicesRequestFieldPathsTopLevel = []string{
+	"application_ids",
+	"requests",
+}
+var BatchGetEndDevicesRequestFieldPathsNested = []string{
+	"application_ids",
+	"application_ids.application_id",
+	"requests",
+}
+
+var BatchGetEndDevicesRequestFieldPathsTopLevel = []string{
+	"application_ids",
+	"requests",
+}
+var BatchUpdateEndDevicesRequestFieldPathsNested = []string{
+	"application_ids",
+	"application_ids.application_id",
+	"requests",
+}
+
+var BatchUpdateEndDevicesRequestFieldPathsTopLevel = []string{
+	"application_ids",
+	"requests",
+}
+var BatchSetEndDevicesRequestFieldPathsNested = []string{
+	"application_ids",
+	"application_ids.application_id",
+	"requests",
+}
+
+var BatchSetEndDevicesRequestFieldPathsTopLevel = []string{
+	"application_ids",
+	"requests",
+}
+var BatchDeleteEndDevicesRequestFieldPathsNested = []string{
+	"application_ids",
+	"application_ids.application_id",
+	"end_device_ids",
+}
+
+var BatchDeleteEndDevicesRequestFieldPathsTopLevel = []string{
+	"application_ids",
+	"end_device_ids",
+}
+var BatchEndDevicesResponseFieldPathsNested = []string{
+	"results",
+}
+
+var BatchEndDevicesResponseFieldPathsTopLevel = []string{
+	"results",
+}
+var EndDeviceProfileIdentifiersFieldPathsNested = []string{
+	"application_ids",
+	"application_ids.application_id",
+	"profile_id",
+}
+
+var EndDeviceProfileIdentifiersFieldPathsTopLevel = []string{
+	"application_ids",
+	"profile_id",
+}
+var EndDeviceProfileFieldPathsNested = []string{
+	"created_at",
+	"description",
+	"frequency_plan_id",
+	"ids",
+	"ids.application_ids",
+	"ids.application_ids.application_id",
+	"ids.profile_id",
+	"lorawan_phy_version",
+	"lorawan_version",
+	"mac_settings",
+	"mac_settings.adr_margin",
+	"mac_settings.beacon_frequency",
+	"mac_settings.class_b_timeout",
+	"mac_settings.class_c_timeout",
+	"mac_settings.desired_adr_ack_delay_exponent",
+	"mac_settings.desired_adr_ack_delay_exponent.value",
+	"mac_settings.desired_adr_ack_limit_exponent",
+	"mac_settings.desired_adr_ack_limit_exponent.value",
+	"mac_settings.desired_beacon_frequency",
+	"mac_settings.desired_max_duty_cycle",
+	"mac_settings.desired_max_duty_cycle.value",
+	"mac_settings.desired_ping_slot_data_rate_index",
+	"mac_settings.desired_ping_slot_data_rate_index.value",
+	"mac_settings.desired_ping_slot_frequency",
+	"mac_settings.desired_rx1_data_rate_offset",
+	"mac_settings.desired_rx1_delay",
+	"mac_settings.desired_rx1_delay.value",
+	"mac_settings.desired_rx2_data_rate_index",
+	"mac_settings.desired_rx2_data_rate_index.value",
+	"mac_settings.desired_rx2_frequency",
+	"mac_settings.factory_preset_frequencies",
+	"mac_settings.max_duty_cycle",
+	"mac_settings.max_duty_cycle.value",
+	"mac_settings.ping_slot_data_rate_index",
+	"mac_settings.ping_slot_data_rate_index.value",
+	"mac_settings.ping_slot_frequency",
+	"mac_settings.ping_slot_periodicity",
+	"mac_settings.ping_slot_periodicity.value",
+	"mac_settings.resets_f_cnt",
+	"mac_settings.rx1_data_rate_offset",
+	"mac_settings.rx1_delay",
+	"mac_settings.rx1_delay.value",
+	"mac_settings.rx2_data_rate_index",
+	"mac_settings.rx2_data_rate_index.value",
+	"mac_settings.rx2_frequency",
+	"mac_settings.status_count_periodicity",
+	"mac_settings.status_time_periodicity",
+	"mac_settings.supports_32_bit_f_cnt",
+	"mac_settings.use_adr",
+	"name",
+	"updated_at",
+	"version",
+}
+
+var EndDeviceProfileFieldPathsTopLevel = []string{
+	"created_at",
+	"description",
+	"frequency_plan_id",
+	"ids",
+	"lorawan_phy_version",
+	"lorawan_version",
+	"mac_settings",
+	"name",
+	"updated_at",
+	"version",
+}
+var EndDeviceProfilesFieldPathsNested = []string{
+	"profiles",
+}
+
+var EndDeviceProfilesFieldPathsTopLevel = []string{
+	"profiles",
+}
+var GetEndDeviceProfileRequestFieldPathsNested = []string{
+	"field_mask",
+	"profile_ids",
+	"profile_ids.application_ids",
+	"profile_ids.application_ids.application_id",
+	"profile_ids.profile_id",
+}
+
+var GetEndDeviceProfileRequestFieldPathsTopLevel = []string{
+	"field_mask",
+	"profile_ids",
+}
+var ListEndDeviceProfilesRequestFieldPathsNested = []string{
+	"application_ids",
+	"application_ids.application_id",
+	"field_mask",
+	"limit",
+	"page",
+}
+
+var ListEndDeviceProfilesRequestFieldPathsTopLevel = []string{
+	"application_ids",
+	"field_mask",
+	"limit",
+	"page",
+}
+var SetEndDeviceProfileRequestFieldPathsNested = []string{
+	"field_mask",
+	"profile",
+	"profile.created_at",
+	"profile.description",
+	"profile.frequency_plan_id",
+	"profile.ids",
+	"profile.ids.application_ids",
+	"profile.ids.application_ids.application_id",
+	"profile.ids.profile_id",
+	"profile.lorawan_phy_version",
+	"profile.lorawan_version",
+	"profile.mac_settings",
+	"profile.mac_settings.adr_margin",
+	"profile.mac_settings.beacon_frequency",
+	"profile.mac_settings.class_b_timeout",
+	"profile.mac_settings.class_c_timeout",
+	"profile.mac_settings.desired_adr_ack_delay_exponent",
+	"profile.mac_settings.desired_adr_ack_delay_exponent.value",
+	"profile.mac_settings.desired_adr_ack_limit_exponent",
+	"profile.mac_settings.desired_adr_ack_limit_exponent.value",
+	"profile.mac_settings.desired_beacon_frequency",
+	"profile.mac_settings.desired_max_duty_cycle",
+	"profile.mac_settings.desired_max_duty_cycle.value",
+	"profile.mac_settings.desired_ping_slot_data_rate_index",
+	"profile.mac_settings.desired_ping_slot_data_rate_index.value",
+	"profile.mac_settings.desired_ping_slot_frequency",
+	"profile.mac_settings.desired_rx1_data_rate_offset",
+	"profile.mac_settings.desired_rx1_delay",
+	"profile.mac_settings.desired_rx1_delay.value",
+	"profile.mac_settings.desired_rx2_data_rate_index",
+	"profile.mac_settings.desired_rx2_data_rate_index.value",
+	"profile.mac_settings.desired_rx2_frequency",
+	"profile.mac_settings.factory_preset_frequencies",
+	"profile.mac_settings.max_duty_cycle",
+	"profile.mac_settings.max_duty_cycle.value",
+	"profile.mac_settings.ping_slot_data_rate_index",
+	"profile.mac_settings.ping_slot_data_rate_index.value",
+	"profile.mac_settings.ping_slot_frequency",
+	"profile.mac_settings.ping_slot_periodicity",
+	"profile.mac_settings.ping_slot_periodicity.value",
+	"profile.mac_settings.resets_f_cnt",
+	"profile.mac_settings.rx1_data_rate_offset",
+	"profile.mac_settings.rx1_delay",
+	"profile.mac_settings.rx1_delay.value",
+	"profile.mac_settings.rx2_data_rate_index",
+	"profile.mac_settings.rx2_data_rate_index.value",
+	"profile.mac_settings.rx2_frequency",
+	"profile.mac_settings.status_count_periodicity",
+	"profile.mac_settings.status_time_periodicity",
+	"profile.mac_settings.supports_32_bit_f_cnt",
+	"profile.mac_settings.use_adr",
+	"profile.name",
+	"profile.updated_at",
+	"profile.version",
+}
+
+var SetEndDeviceProfileRequestFieldPathsTopLevel = []string{
+	"field_mask",
+	"profile",
+}
 var EndDeviceTemplateFieldPathsNested = []string{
 	"end_device",
 	"end_device.application_server_address",
@@ -3168,6 +3498,7 @@ var EndDeviceTemplateFieldPathsNested = []string{
 	"end_device.mac_state.last_confirmed_downlink_at",
 	"end_device.mac_state.last_dev_status_f_cnt_up",
 	"end_device.mac_state.last_network_initiated_downlink_at",
+	"end_device.mac_state.last_rj_count_0",
 	"end_device.mac_state.lorawan_version",
 	"end_device.mac_state.pending_application_downlink",
 	"end_device.mac_state.pending_application_downlink.class_b_c",
@@ -3176,6 +3507,7 @@ var EndDeviceTemplateFieldPathsNested = []string{
 	"end_device.mac_state.pending_application_downlink.confirmed",
 	"end_device.mac_state.pending_application_downlink.correlation_ids",
 	"end_device.mac_state.pending_application_downlink.decoded_payload",
+	"end_device.mac_state.pending_application_downlink.decoded_payload_warnings",
 	"end_device.mac_state.pending_application_downlink.f_cnt",
 	"end_device.mac_state.pending_application_downlink.f_port",
 	"end_device.mac_state.pending_application_downlink.frm_payload",
@@ -3192,6 +3524,7 @@ var EndDeviceTemplateFieldPathsNested = []string{
 	"end_device.mac_state.pending_join_request.downlink_settings.opt_neg",
 	"end_device.mac_state.pending_join_request.downlink_settings.rx1_dr_offset",
 	"end_device.mac_state.pending_join_request.downlink_settings.rx2_dr",
+	"end_device.mac_state.pending_join_request.join_eui",
 	"end_device.mac_state.pending_join_request.net_id",
 	"end_device.mac_state.pending_join_request.payload",
 	"end_device.mac_state.pending_join_request.payload.Payload",
@@ -3243,6 +3576,11 @@ var EndDeviceTemplateFieldPathsNested = []string{
 	"end_device.mac_state.pending_requests",
 	"end_device.mac_state.ping_slot_periodicity",
 	"end_device.mac_state.ping_slot_periodicity.value",
+	"end_device.mac_state.queued_force_rejoin_req",
+	"end_device.mac_state.queued_force_rejoin_req.data_rate_index",
+	"end_device.mac_state.queued_force_rejoin_req.max_retries",
+	"end_device.mac_state.queued_force_rejoin_req.period_exponent",
+	"end_device.mac_state.queued_force_rejoin_req.rejoin_type",
 	"end_device.mac_state.queued_join_accept",
 	"end_device.mac_state.queued_join_accept.keys",
 	"end_device.mac_state.queued_join_accept.keys.app_s_key",
@@ -3274,6 +3612,7 @@ var EndDeviceTemplateFieldPathsNested = []string{
 	"end_device.mac_state.queued_join_accept.request.downlink_settings.opt_neg",
 	"end_device.mac_state.queued_join_accept.request.downlink_settings.rx1_dr_offset",
 	"end_device.mac_state.queued_join_accept.request.downlink_settings.rx2_dr",
+	"end_device.mac_state.queued_join_accept.request.join_eui",
 	"end_device.mac_state.queued_join_accept.request.net_id",
 	"end_device.mac_state.queued_join_accept.request.payload",
 	"end_device.mac_state.queued_join_accept.request.payload.Payload",
@@ -3390,6 +3729,7 @@ var EndDeviceTemplateFieldPathsNested = []string{
 	"end_device.pending_mac_state.last_confirmed_downlink_at",
 	"end_device.pending_mac_state.last_dev_status_f_cnt_up",
 	"end_device.pending_mac_state.last_network_initiated_downlink_at",
+	"end_device.pending_mac_state.last_rj_count_0",
 	"end_device.pending_mac_state.lorawan_version",
 	"end_device.pending_mac_state.pending_application_downlink",
 	"end_device.pending_mac_state.pending_application_downlink.class_b_c",
@@ -3398,6 +3738,7 @@ var EndDeviceTemplateFieldPathsNested = []string{
 	"end_device.pending_mac_state.pending_application_downlink.confirmed",
 	"end_device.pending_mac_state.pending_application_downlink.correlation_ids",
 	"end_device.pending_mac_state.pending_application_downlink.decoded_payload",
+	"end_device.pending_mac_state.pending_application_downlink.decoded_payload_warnings",
 	"end_device.pending_mac_state.pending_application_downlink.f_cnt",
 	"end_device.pending_mac_state.pending_application_downlink.f_port",
 	"end_device.pending_mac_state.pending_application_downlink.frm_payload",
@@ -3414,6 +3755,7 @@ var EndDeviceTemplateFieldPathsNested = []string{
 	"end_device.pending_mac_state.pending_join_request.downlink_settings.opt_neg",
 	"end_device.pending_mac_state.pending_join_request.downlink_settings.rx1_dr_offset",
 	"end_device.pending_mac_state.pending_join_request.downlink_settings.rx2_dr",
+	"end_device.pending_mac_state.pending_join_request.join_eui",
 	"end_device.pending_mac_state.pending_join_request.net_id",
 	"end_device.pending_mac_state.pending_join_request.payload",
 	"end_device.pending_mac_state.pending_join_request.payload.Payload",
@@ -3465,6 +3807,11 @@ var EndDeviceTemplateFieldPathsNested = []string{
 	"end_device.pending_mac_state.pending_requests",
 	"end_device.pending_mac_state.ping_slot_periodicity",
 	"end_device.pending_mac_state.ping_slot_periodicity.value",
+	"end_device.pending_mac_state.queued_force_rejoin_req",
+	"end_device.pending_mac_state.queued_force_rejoin_req.data_rate_index",
+	"end_device.pending_mac_state.queued_force_rejoin_req.max_retries",
+	"end_device.pending_mac_state.queued_force_rejoin_req.period_exponent",
+	"end_device.pending_mac_state.queued_force_rejoin_req.rejoin_type",
 	"end_device.pending_mac_state.queued_join_accept",
 	"end_device.pending_mac_state.queued_join_accept.keys",
 	"end_device.pending_mac_state.queued_join_accept.keys.app_s_key",
@@ -3496,6 +3843,7 @@ var EndDeviceTemplateFieldPathsNested = []string{
 	"end_device.pending_mac_state.queued_join_accept.request.downlink_settings.opt_neg",
 	"end_device.pending_mac_state.queued_join_accept.request.downlink_settings.rx1_dr_offset",
 	"end_device.pending_mac_state.queued_join_accept.request.downlink_settings.rx2_dr",
+	"end_device.pending_mac_state.queued_join_accept.request.join_eui",
 	"end_device.pending_mac_state.queued_join_accept.request.net_id",
 	"end_device.pending_mac_state.queued_join_accept.request.payload",
 	"end_device.pending_mac_state.queued_join_accept.request.payload.Payload",
@@ -3579,6 +3927,10 @@ var EndDeviceTemplateFieldPathsNested = []string{
 	"end_device.picture.embedded.mime_type",
 	"end_device.picture.sizes",
 	"end_device.power_state",
+	"end_device.profile_ids",
+	"end_device.profile_ids.application_ids",
+	"end_device.profile_ids.application_ids.application_id",
+	"end_device.profile_ids.profile_id",
 	"end_device.provisioner_id",
 	"end_device.provisioning_data",
 	"end_device.queued_application_downlinks",
@@ -3661,11 +4013,15 @@ var EndDeviceTemplateFormatsFieldPathsTopLevel = []string{
 	"formats",
 }
 var ConvertEndDeviceTemplateRequestFieldPathsNested = []string{
+	"csv_options",
+	"csv_options.base64",
+	"csv_options.separator",
 	"data",
 	"format_id",
 }
 
 var ConvertEndDeviceTemplateRequestFieldPathsTopLevel = []string{
+	"csv_options",
 	"data",
 	"format_id",
 }
@@ -3715,6 +4071,7 @@ var MACState_JoinAcceptFieldPathsNested = []string{
 	"request.downlink_settings.opt_neg",
 	"request.downlink_settings.rx1_dr_offset",
 	"request.downlink_settings.rx2_dr",
+	"request.join_eui",
 	"request.net_id",
 	"request.payload",
 	"request.payload.Payload",
@@ -3770,3 +4127,660 @@ var MACState_JoinAcceptFieldPathsTopLevel = []string{
 	"payload",
 	"request",
 }
+var BatchEndDevicesResponse_ResultFieldPathsNested = []string{
+	"end_device",
+	"end_device.application_server_address",
+	"end_device.application_server_id",
+	"end_device.application_server_kek_label",
+	"end_device.attributes",
+	"end_device.battery_percentage",
+	"end_device.claim_authentication_code",
+	"end_device.claim_authentication_code.valid_from",
+	"end_device.claim_authentication_code.valid_to",
+	"end_device.claim_authentication_code.value",
+	"end_device.created_at",
+	"end_device.description",
+	"end_device.downlink_margin",
+	"end_device.formatters",
+	"end_device.formatters.down_formatter",
+	"end_device.formatters.down_formatter_parameter",
+	"end_device.formatters.up_formatter",
+	"end_device.formatters.up_formatter_parameter",
+	"end_device.frequency_plan_id",
+	"end_device.ids",
+	"end_device.ids.application_ids",
+	"end_device.ids.application_ids.application_id",
+	"end_device.ids.dev_addr",
+	"end_device.ids.dev_eui",
+	"end_device.ids.device_id",
+	"end_device.ids.join_eui",
+	"end_device.join_server_address",
+	"end_device.last_dev_nonce",
+	"end_device.last_dev_status_received_at",
+	"end_device.last_join_nonce",
+	"end_device.last_rj_count_0",
+	"end_device.last_rj_count_1",
+	"end_device.locations",
+	"end_device.lorawan_phy_version",
+	"end_device.lorawan_version",
+	"end_device.mac_settings",
+	"end_device.mac_settings.adr_margin",
+	"end_device.mac_settings.beacon_frequency",
+	"end_device.mac_settings.class_b_timeout",
+	"end_device.mac_settings.class_c_timeout",
+	"end_device.mac_settings.desired_adr_ack_delay_exponent",
+	"end_device.mac_settings.desired_adr_ack_delay_exponent.value",
+	"end_device.mac_settings.desired_adr_ack_limit_exponent",
+	"end_device.mac_settings.desired_adr_ack_limit_exponent.value",
+	"end_device.mac_settings.desired_beacon_frequency",
+	"end_device.mac_settings.desired_max_duty_cycle",
+	"end_device.mac_settings.desired_max_duty_cycle.value",
+	"end_device.mac_settings.desired_ping_slot_data_rate_index",
+	"end_device.mac_settings.desired_ping_slot_data_rate_index.value",
+	"end_device.mac_settings.desired_ping_slot_frequency",
+	"end_device.mac_settings.desired_rx1_data_rate_offset",
+	"end_device.mac_settings.desired_rx1_delay",
+	"end_device.mac_settings.desired_rx1_delay.value",
+	"end_device.mac_settings.desired_rx2_data_rate_index",
+	"end_device.mac_settings.desired_rx2_data_rate_index.value",
+	"end_device.mac_settings.desired_rx2_frequency",
+	"end_device.mac_settings.factory_preset_frequencies",
+	"end_device.mac_settings.max_duty_cycle",
+	"end_device.mac_settings.max_duty_cycle.value",
+	"end_device.mac_settings.ping_slot_data_rate_index",
+	"end_device.mac_settings.ping_slot_data_rate_index.value",
+	"end_device.mac_settings.ping_slot_frequency",
+	"end_device.mac_settings.ping_slot_periodicity",
+	"end_device.mac_settings.ping_slot_periodicity.value",
+	"end_device.mac_settings.resets_f_cnt",
+	"end_device.mac_settings.rx1_data_rate_offset",
+	"end_device.mac_settings.rx1_delay",
+	"end_device.mac_settings.rx1_delay.value",
+	"end_device.mac_settings.rx2_data_rate_index",
+	"end_device.mac_settings.rx2_data_rate_index.value",
+	"end_device.mac_settings.rx2_frequency",
+	"end_device.mac_settings.status_count_periodicity",
+	"end_device.mac_settings.status_time_periodicity",
+	"end_device.mac_settings.supports_32_bit_f_cnt",
+	"end_device.mac_settings.use_adr",
+	"end_device.mac_state",
+	"end_device.mac_state.current_parameters",
+	"end_device.mac_state.current_parameters.adr_ack_delay",
+	"end_device.mac_state.current_parameters.adr_ack_delay_exponent",
+	"end_device.mac_state.current_parameters.adr_ack_delay_exponent.value",
+	"end_device.mac_state.current_parameters.adr_ack_limit",
+	"end_device.mac_state.current_parameters.adr_ack_limit_exponent",
+	"end_device.mac_state.current_parameters.adr_ack_limit_exponent.value",
+	"end_device.mac_state.current_parameters.adr_data_rate_index",
+	"end_device.mac_state.current_parameters.adr_nb_trans",
+	"end_device.mac_state.current_parameters.adr_tx_power_index",
+	"end_device.mac_state.current_parameters.beacon_frequency",
+	"end_device.mac_state.current_parameters.channels",
+	"end_device.mac_state.current_parameters.downlink_dwell_time",
+	"end_device.mac_state.current_parameters.max_duty_cycle",
+	"end_device.mac_state.current_parameters.max_eirp",
+	"end_device.mac_state.current_parameters.ping_slot_data_rate_index",
+	"end_device.mac_state.current_parameters.ping_slot_data_rate_index_value",
+	"end_device.mac_state.current_parameters.ping_slot_data_rate_index_value.value",
+	"end_device.mac_state.current_parameters.ping_slot_frequency",
+	"end_device.mac_state.current_parameters.rejoin_count_periodicity",
+	"end_device.mac_state.current_parameters.rejoin_time_periodicity",
+	"end_device.mac_state.current_parameters.rx1_data_rate_offset",
+	"end_device.mac_state.current_parameters.rx1_delay",
+	"end_device.mac_state.current_parameters.rx2_data_rate_index",
+	"end_device.mac_state.current_parameters.rx2_frequency",
+	"end_device.mac_state.current_parameters.uplink_dwell_time",
+	"end_device.mac_state.desired_parameters",
+	"end_device.mac_state.desired_parameters.adr_ack_delay",
+	"end_device.mac_state.desired_parameters.adr_ack_delay_exponent",
+	"end_device.mac_state.desired_parameters.adr_ack_delay_exponent.value",
+	"end_device.mac_state.desired_parameters.adr_ack_limit",
+	"end_device.mac_state.desired_parameters.adr_ack_limit_exponent",
+	"end_device.mac_state.desired_parameters.adr_ack_limit_exponent.value",
+	"end_device.mac_state.desired_parameters.adr_data_rate_index",
+	"end_device.mac_state.desired_parameters.adr_nb_trans",
+	"end_device.mac_state.desired_parameters.adr_tx_power_index",
+	"end_device.mac_state.desired_parameters.beacon_frequency",
+	"end_device.mac_state.desired_parameters.channels",
+	"end_device.mac_state.desired_parameters.downlink_dwell_time",
+	"end_device.mac_state.desired_parameters.max_duty_cycle",
+	"end_device.mac_state.desired_parameters.max_eirp",
+	"end_device.mac_state.desired_parameters.ping_slot_data_rate_index",
+	"end_device.mac_state.desired_parameters.ping_slot_data_rate_index_value",
+	"end_device.mac_state.desired_parameters.ping_slot_data_rate_index_value.value",
+	"end_device.mac_state.desired_parameters.ping_slot_frequency",
+	"end_device.mac_state.desired_parameters.rejoin_count_periodicity",
+	"end_device.mac_state.desired_parameters.rejoin_time_periodicity",
+	"end_device.mac_state.desired_parameters.rx1_data_rate_offset",
+	"end_device.mac_state.desired_parameters.rx1_delay",
+	"end_device.mac_state.desired_parameters.rx2_data_rate_index",
+	"end_device.mac_state.desired_parameters.rx2_frequency",
+	"end_device.mac_state.desired_parameters.uplink_dwell_time",
+	"end_device.mac_state.device_class",
+	"end_device.mac_state.last_confirmed_downlink_at",
+	"end_device.mac_state.last_dev_status_f_cnt_up",
+	"end_device.mac_state.last_network_initiated_downlink_at",
+	"end_device.mac_state.last_rj_count_0",
+	"end_device.mac_state.lorawan_version",
+	"end_device.mac_state.pending_application_downlink",
+	"end_device.mac_state.pending_application_downlink.class_b_c",
+	"end_device.mac_state.pending_application_downlink.class_b_c.absolute_time",
+	"end_device.mac_state.pending_application_downlink.class_b_c.gateways",
+	"end_device.mac_state.pending_application_downlink.confirmed",
+	"end_device.mac_state.pending_application_downlink.correlation_ids",
+	"end_device.mac_state.pending_application_downlink.decoded_payload",
+	"end_device.mac_state.pending_application_downlink.decoded_payload_warnings",
+	"end_device.mac_state.pending_application_downlink.f_cnt",
+	"end_device.mac_state.pending_application_downlink.f_port",
+	"end_device.mac_state.pending_application_downlink.frm_payload",
+	"end_device.mac_state.pending_application_downlink.priority",
+	"end_device.mac_state.pending_application_downlink.session_key_id",
+	"end_device.mac_state.pending_join_request",
+	"end_device.mac_state.pending_join_request.cf_list",
+	"end_device.mac_state.pending_join_request.cf_list.ch_masks",
+	"end_device.mac_state.pending_join_request.cf_list.freq",
+	"end_device.mac_state.pending_join_request.cf_list.type",
+	"end_device.mac_state.pending_join_request.correlation_ids",
+	"end_device.mac_state.pending_join_request.dev_addr",
+	"end_device.mac_state.pending_join_request.downlink_settings",
+	"end_device.mac_state.pending_join_request.downlink_settings.opt_neg",
+	"end_device.mac_state.pending_join_request.downlink_settings.rx1_dr_offset",
+	"end_device.mac_state.pending_join_request.downlink_settings.rx2_dr",
+	"end_device.mac_state.pending_join_request.join_eui",
+	"end_device.mac_state.pending_join_request.net_id",
+	"end_device.mac_state.pending_join_request.payload",
+	"end_device.mac_state.pending_join_request.payload.Payload",
+	"end_device.mac_state.pending_join_request.payload.Payload.join_accept_payload",
+	"end_device.mac_state.pending_join_request.payload.Payload.join_accept_payload.cf_list",
+	"end_device.mac_state.pending_join_request.payload.Payload.join_accept_payload.cf_list.ch_masks",
+	"end_device.mac_state.pending_join_request.payload.Payload.join_accept_payload.cf_list.freq",
+	"end_device.mac_state.pending_join_request.payload.Payload.join_accept_payload.cf_list.type",
+	"end_device.mac_state.pending_join_request.payload.Payload.join_accept_payload.dev_addr",
+	"end_device.mac_state.pending_join_request.payload.Payload.join_accept_payload.dl_settings",
+	"end_device.mac_state.pending_join_request.payload.Payload.join_accept_payload.dl_settings.opt_neg",
+	"end_device.mac_state.pending_join_request.payload.Payload.join_accept_payload.dl_settings.rx1_dr_offset",
+	"end_device.mac_state.pending_join_request.payload.Payload.join_accept_payload.dl_settings.rx2_dr",
+	"end_device.mac_state.pending_join_request.payload.Payload.join_accept_payload.encrypted",
+	"end_device.mac_state.pending_join_request.payload.Payload.join_accept_payload.join_nonce",
+	"end_device.mac_state.pending_join_request.payload.Payload.join_accept_payload.net_id",
+	"end_device.mac_state.pending_join_request.payload.Payload.join_accept_payload.rx_delay",
+	"end_device.mac_state.pending_join_request.payload.Payload.join_request_payload",
+	"end_device.mac_state.pending_join_request.payload.Payload.join_request_payload.dev_eui",
+	"end_device.mac_state.pending_join_request.payload.Payload.join_request_payload.dev_nonce",
+	"end_device.mac_state.pending_join_request.payload.Payload.join_request_payload.join_eui",
+	"end_device.mac_state.pending_join_request.payload.Payload.mac_payload",
+	"end_device.mac_state.pending_join_request.payload.Payload.mac_payload.decoded_payload",
+	"end_device.mac_state.pending_join_request.payload.Payload.mac_payload.f_hdr",
+	"end_device.mac_state.pending_join_request.payload.Payload.mac_payload.f_hdr.dev_addr",
+	"end_device.mac_state.pending_join_request.payload.Payload.mac_payload.f_hdr.f_cnt",
+	"end_device.mac_state.pending_join_request.payload.Payload.mac_payload.f_hdr.f_ctrl",
+	"end_device.mac_state.pending_join_request.payload.Payload.mac_payload.f_hdr.f_ctrl.ack",
+	"end_device.mac_state.pending_join_request.payload.Payload.mac_payload.f_hdr.f_ctrl.adr",
+	"end_device.mac_state.pending_join_request.payload.Payload.mac_payload.f_hdr.f_ctrl.adr_ack_req",
+	"end_device.mac_state.pending_join_request.payload.Payload.mac_payload.f_hdr.f_ctrl.class_b",
+	"end_device.mac_state.pending_join_request.payload.Payload.mac_payload.f_hdr.f_ctrl.f_pending",
+	"end_device.mac_state.pending_join_request.payload.Payload.mac_payload.f_hdr.f_opts",
+	"end_device.mac_state.pending_join_request.payload.Payload.mac_payload.f_port",
+	"end_device.mac_state.pending_join_request.payload.Payload.mac_payload.frm_payload",
+	"end_device.mac_state.pending_join_request.payload.Payload.rejoin_request_payload",
+	"end_device.mac_state.pending_join_request.payload.Payload.rejoin_request_payload.dev_eui",
+	"end_device.mac_state.pending_join_request.payload.Payload.rejoin_request_payload.join_eui",
+	"end_device.mac_state.pending_join_request.payload.Payload.rejoin_request_payload.net_id",
+	"end_device.mac_state.pending_join_request.payload.Payload.rejoin_request_payload.rejoin_cnt",
+	"end_device.mac_state.pending_join_request.payload.Payload.rejoin_request_payload.rejoin_type",
+	"end_device.mac_state.pending_join_request.payload.m_hdr",
+	"end_device.mac_state.pending_join_request.payload.m_hdr.m_type",
+	"end_device.mac_state.pending_join_request.payload.m_hdr.major",
+	"end_device.mac_state.pending_join_request.payload.mic",
+	"end_device.mac_state.pending_join_request.raw_payload",
+	"end_device.mac_state.pending_join_request.rx_delay",
+	"end_device.mac_state.pending_join_request.selected_mac_version",
+	"end_device.mac_state.pending_requests",
+	"end_device.mac_state.ping_slot_periodicity",
+	"end_device.mac_state.ping_slot_periodicity.value",
+	"end_device.mac_state.queued_force_rejoin_req",
+	"end_device.mac_state.queued_force_rejoin_req.data_rate_index",
+	"end_device.mac_state.queued_force_rejoin_req.max_retries",
+	"end_device.mac_state.queued_force_rejoin_req.period_exponent",
+	"end_device.mac_state.queued_force_rejoin_req.rejoin_type",
+	"end_device.mac_state.queued_join_accept",
+	"end_device.mac_state.queued_join_accept.keys",
+	"end_device.mac_state.queued_join_accept.keys.app_s_key",
+	"end_device.mac_state.queued_join_accept.keys.app_s_key.encrypted_key",
+	"end_device.mac_state.queued_join_accept.keys.app_s_key.kek_label",
+	"end_device.mac_state.queued_join_accept.keys.app_s_key.key",
+	"end_device.mac_state.queued_join_accept.keys.f_nwk_s_int_key",
+	"end_device.mac_state.queued_join_accept.keys.f_nwk_s_int_key.encrypted_key",
+	"end_device.mac_state.queued_join_accept.keys.f_nwk_s_int_key.kek_label",
+	"end_device.mac_state.queued_join_accept.keys.f_nwk_s_int_key.key",
+	"end_device.mac_state.queued_join_accept.keys.nwk_s_enc_key",
+	"end_device.mac_state.queued_join_accept.keys.nwk_s_enc_key.encrypted_key",
+	"end_device.mac_state.queued_join_accept.keys.nwk_s_enc_key.kek_label",
+	"end_device.mac_state.queued_join_accept.keys.nwk_s_enc_key.key",
+	"end_device.mac_state.queued_join_accept.keys.s_nwk_s_int_key",
+	"end_device.mac_state.queued_join_accept.keys.s_nwk_s_int_key.encrypted_key",
+	"end_device.mac_state.queued_join_accept.keys.s_nwk_s_int_key.kek_label",
+	"end_device.mac_state.queued_join_accept.keys.s_nwk_s_int_key.key",
+	"end_device.mac_state.queued_join_accept.keys.session_key_id",
+	"end_device.mac_state.queued_join_accept.payload",
+	"end_device.mac_state.queued_join_accept.request",
+	"end_device.mac_state.queued_join_accept.request.cf_list",
+	"end_device.mac_state.queued_join_accept.request.cf_list.ch_masks",
+	"end_device.mac_state.queued_join_accept.request.cf_list.freq",
+	"end_device.mac_state.queued_join_accept.request.cf_list.type",
+	"end_device.mac_state.queued_join_accept.request.correlation_ids",
+	"end_device.mac_state.queued_join_accept.request.dev_addr",
+	"end_device.mac_state.queued_join_accept.request.downlink_settings",
+	"end_device.mac_state.queued_join_accept.request.downlink_settings.opt_neg",
+	"end_device.mac_state.queued_join_accept.request.downlink_settings.rx1_dr_offset",
+	"end_device.mac_state.queued_join_accept.request.downlink_settings.rx2_dr",
+	"end_device.mac_state.queued_join_accept.request.join_eui",
+	"end_device.mac_state.queued_join_accept.request.net_id",
+	"end_device.mac_state.queued_join_accept.request.payload",
+	"end_device.mac_state.queued_join_accept.request.payload.Payload",
+	"end_device.mac_state.queued_join_accept.request.payload.Payload.join_accept_payload",
+	"end_device.mac_state.queued_join_accept.request.payload.Payload.join_accept_payload.cf_list",
+	"end_device.mac_state.queued_join_accept.request.payload.Payload.join_accept_payload.cf_list.ch_masks",
+	"end_device.mac_state.queued_join_accept.request.payload.Payload.join_accept_payload.cf_list.freq",
+	"end_device.mac_state.queued_join_accept.request.payload.Payload.join_accept_payload.cf_list.type",
+	"end_device.mac_state.queued_join_accept.request.payload.Payload.join_accept_payload.dev_addr",
+	"end_device.mac_state.queued_join_accept.request.payload.Payload.join_accept_payload.dl_settings",
+	"end_device.mac_state.queued_join_accept.request.payload.Payload.join_accept_payload.dl_settings.opt_neg",
+	"end_device.mac_state.queued_join_accept.request.payload.Payload.join_accept_payload.dl_settings.rx1_dr_offset",
+	"end_device.mac_state.queued_join_accept.request.payload.Payload.join_accept_payload.dl_settings.rx2_dr",
+	"end_device.mac_state.queued_join_accept.request.payload.Payload.join_accept_payload.encrypted",
+	"end_device.mac_state.queued_join_accept.request.payload.Payload.join_accept_payload.join_nonce",
+	"end_device.mac_state.queued_join_accept.request.payload.Payload.join_accept_payload.net_id",
+	"end_device.mac_state.queued_join_accept.request.payload.Payload.join_accept_payload.rx_delay",
+	"end_device.mac_state.queued_join_accept.request.payload.Payload.join_request_payload",
+	"end_device.mac_state.queued_join_accept.request.payload.Payload.join_request_payload.dev_eui",
+	"end_device.mac_state.queued_join_accept.request.payload.Payload.join_request_payload.dev_nonce",
+	"end_device.mac_state.queued_join_accept.request.payload.Payload.join_request_payload.join_eui",
+	"end_device.mac_state.queued_join_accept.request.payload.Payload.mac_payload",
+	"end_device.mac_state.queued_join_accept.request.payload.Payload.mac_payload.decoded_payload",
+	"end_device.mac_state.queued_join_accept.request.payload.Payload.mac_payload.f_hdr",
+	"end_device.mac_state.queued_join_accept.request.payload.Payload.mac_payload.f_hdr.dev_addr",
+	"end_device.mac_state.queued_join_accept.request.payload.Payload.mac_payload.f_hdr.f_cnt",
+	"end_device.mac_state.queued_join_accept.request.payload.Payload.mac_payload.f_hdr.f_ctrl",
+	"end_device.mac_state.queued_join_accept.request.payload.Payload.mac_payload.f_hdr.f_ctrl.ack",
+	"end_device.mac_state.queued_join_accept.request.payload.Payload.mac_payload.f_hdr.f_ctrl.adr",
+	"end_device.mac_state.queued_join_accept.request.payload.Payload.mac_payload.f_hdr.f_ctrl.adr_ack_req",
+	"end_device.mac_state.queued_join_accept.request.payload.Payload.mac_payload.f_hdr.f_ctrl.class_b",
+	"end_device.mac_state.queued_join_accept.request.payload.Payload.mac_payload.f_hdr.f_ctrl.f_pending",
+	"end_device.mac_state.queued_join_accept.request.payload.Payload.mac_payload.f_hdr.f_opts",
+	"end_device.mac_state.queued_join_accept.request.payload.Payload.mac_payload.f_port",
+	"end_device.mac_state.queued_join_accept.request.payload.Payload.mac_payload.frm_payload",
+	"end_device.mac_state.queued_join_accept.request.payload.Payload.rejoin_request_payload",
+	"end_device.mac_state.queued_join_accept.request.payload.Payload.rejoin_request_payload.dev_eui",
+	"end_device.mac_state.queued_join_accept.request.payload.Payload.rejoin_request_payload.join_eui",
+	"end_device.mac_state.queued_join_accept.request.payload.Payload.rejoin_request_payload.net_id",
+	"end_device.mac_state.queued_join_accept.request.payload.Payload.rejoin_request_payload.rejoin_cnt",
+	"end_device.mac_state.queued_join_accept.request.payload.Payload.rejoin_request_payload.rejoin_type",
+	"end_device.mac_state.queued_join_accept.request.payload.m_hdr",
+	"end_device.mac_state.queued_join_accept.request.payload.m_hdr.m_type",
+	"end_device.mac_state.queued_join_accept.request.payload.m_hdr.major",
+	"end_device.mac_state.queued_join_accept.request.payload.mic",
+	"end_device.mac_state.queued_join_accept.request.raw_payload",
+	"end_device.mac_state.queued_join_accept.request.rx_delay",
+	"end_device.mac_state.queued_join_accept.request.selected_mac_version",
+	"end_device.mac_state.queued_responses",
+	"end_device.mac_state.recent_downlinks",
+	"end_device.mac_state.recent_uplinks",
+	"end_device.mac_state.rx_windows_available",
+	"end_device.max_frequency",
+	"end_device.min_frequency",
+	"end_device.multicast",
+	"end_device.name",
+	"end_device.net_id",
+	"end_device.network_server_address",
+	"end_device.network_server_kek_label",
+	"end_device.pending_mac_state",
+	"end_device.pending_mac_state.current_parameters",
+	"end_device.pending_mac_state.current_parameters.adr_ack_delay",
+	"end_device.pending_mac_state.current_parameters.adr_ack_delay_exponent",
+	"end_device.pending_mac_state.current_parameters.adr_ack_delay_exponent.value",
+	"end_device.pending_mac_state.current_parameters.adr_ack_limit",
+	"end_device.pending_mac_state.current_parameters.adr_ack_limit_exponent",
+	"end_device.pending_mac_state.current_parameters.adr_ack_limit_exponent.value",
+	"end_device.pending_mac_state.current_parameters.adr_data_rate_index",
+	"end_device.pending_mac_state.current_parameters.adr_nb_trans",
+	"end_device.pending_mac_state.current_parameters.adr_tx_power_index",
+	"end_device.pending_mac_state.current_parameters.beacon_frequency",
+	"end_device.pending_mac_state.current_parameters.channels",
+	"end_device.pending_mac_state.current_parameters.downlink_dwell_time",
+	"end_device.pending_mac_state.current_parameters.max_duty_cycle",
+	"end_device.pending_mac_state.current_parameters.max_eirp",
+	"end_device.pending_mac_state.current_parameters.ping_slot_data_rate_index",
+	"end_device.pending_mac_state.current_parameters.ping_slot_data_rate_index_value",
+	"end_device.pending_mac_state.current_parameters.ping_slot_data_rate_index_value.value",
+	"end_device.pending_mac_state.current_parameters.ping_slot_frequency",
+	"end_device.pending_mac_state.current_parameters.rejoin_count_periodicity",
+	"end_device.pending_mac_state.current_parameters.rejoin_time_periodicity",
+	"end_device.pending_mac_state.current_parameters.rx1_data_rate_offset",
+	"end_device.pending_mac_state.current_parameters.rx1_delay",
+	"end_device.pending_mac_state.current_parameters.rx2_data_rate_index",
+	"end_device.pending_mac_state.current_parameters.rx2_frequency",
+	"end_device.pending_mac_state.current_parameters.uplink_dwell_time",
+	"end_device.pending_mac_state.desired_parameters",
+	"end_device.pending_mac_state.desired_parameters.adr_ack_delay",
+	"end_device.pending_mac_state.desired_parameters.adr_ack_delay_exponent",
+	"end_device.pending_mac_state.desired_parameters.adr_ack_delay_exponent.value",
+	"end_device.pending_mac_state.desired_parameters.adr_ack_limit",
+	"end_device.pending_mac_state.desired_parameters.adr_ack_limit_exponent",
+	"end_device.pending_mac_state.desired_parameters.adr_ack_limit_exponent.value",
+	"end_device.pending_mac_state.desired_parameters.adr_data_rate_index",
+	"end_device.pending_mac_state.desired_parameters.adr_nb_trans",
+	"end_device.pending_mac_state.desired_parameters.adr_tx_power_index",
+	"end_device.pending_mac_state.desired_parameters.beacon_frequency",
+	"end_device.pending_mac_state.desired_parameters.channels",
+	"end_device.pending_mac_state.desired_parameters.downlink_dwell_time",
+	"end_device.pending_mac_state.desired_parameters.max_duty_cycle",
+	"end_device.pending_mac_state.desired_parameters.max_eirp",
+	"end_device.pending_mac_state.desired_parameters.ping_slot_data_rate_index",
+	"end_device.pending_mac_state.desired_parameters.ping_slot_data_rate_index_value",
+	"end_device.pending_mac_state.desired_parameters.ping_slot_data_rate_index_value.value",
+	"end_device.pending_mac_state.desired_parameters.ping_slot_frequency",
+	"end_device.pending_mac_state.desired_parameters.rejoin_count_periodicity",
+	"end_device.pending_mac_state.desired_parameters.rejoin_time_periodicity",
+	"end_device.pending_mac_state.desired_parameters.rx1_data_rate_offset",
+	"end_device.pending_mac_state.desired_parameters.rx1_delay",
+	"end_device.pending_mac_state.desired_parameters.rx2_data_rate_index",
+	"end_device.pending_mac_state.desired_parameters.rx2_frequency",
+	"end_device.pending_mac_state.desired_parameters.uplink_dwell_time",
+	"end_device.pending_mac_state.device_class",
+	"end_device.pending_mac_state.last_confirmed_downlink_at",
+	"end_device.pending_mac_state.last_dev_status_f_cnt_up",
+	"end_device.pending_mac_state.last_network_initiated_downlink_at",
+	"end_device.pending_mac_state.last_rj_count_0",
+	"end_device.pending_mac_state.lorawan_version",
+	"end_device.pending_mac_state.pending_application_downlink",
+	"end_device.pending_mac_state.pending_application_downlink.class_b_c",
+	"end_device.pending_mac_state.pending_application_downlink.class_b_c.absolute_time",
+	"end_device.pending_mac_state.pending_application_downlink.class_b_c.gateways",
+	"end_device.pending_mac_state.pending_application_downlink.confirmed",
+	"end_device.pending_mac_state.pending_application_downlink.correlation_ids",
+	"end_device.pending_mac_state.pending_application_downlink.decoded_payload",
+	"end_device.pending_mac_state.pending_application_downlink.decoded_payload_warnings",
+	"end_device.pending_mac_state.pending_application_downlink.f_cnt",
+	"end_device.pending_mac_state.pending_application_downlink.f_port",
+	"end_device.pending_mac_state.pending_application_downlink.frm_payload",
+	"end_device.pending_mac_state.pending_application_downlink.priority",
+	"end_device.pending_mac_state.pending_application_downlink.session_key_id",
+	"end_device.pending_mac_state.pending_join_request",
+	"end_device.pending_mac_state.pending_join_request.cf_list",
+	"end_device.pending_mac_state.pending_join_request.cf_list.ch_masks",
+	"end_device.pending_mac_state.pending_join_request.cf_list.freq",
+	"end_device.pending_mac_state.pending_join_request.cf_list.type",
+	"end_device.pending_mac_state.pending_join_request.correlation_ids",
+	"end_device.pending_mac_state.pending_join_request.dev_addr",
+	"end_device.pending_mac_state.pending_join_request.downlink_settings",
+	"end_device.pending_mac_state.pending_join_request.downlink_settings.opt_neg",
+	"end_device.pending_mac_state.pending_join_request.downlink_settings.rx1_dr_offset",
+	"end_device.pending_mac_state.pending_join_request.downlink_settings.rx2_dr",
+	"end_device.pending_mac_state.pending_join_request.join_eui",
+	"end_device.pending_mac_state.pending_join_request.net_id",
+	"end_device.pending_mac_state.pending_join_request.payload",
+	"end_device.pending_mac_state.pending_join_request.payload.Payload",
+	"end_device.pending_mac_state.pending_join_request.payload.Payload.join_accept_payload",
+	"end_device.pending_mac_state.pending_join_request.payload.Payload.join_accept_payload.cf_list",
+	"end_device.pending_mac_state.pending_join_request.payload.Payload.join_accept_payload.cf_list.ch_masks",
+	"end_device.pending_mac_state.pending_join_request.payload.Payload.join_accept_payload.cf_list.freq",
+	"end_device.pending_mac_state.pending_join_request.payload.Payload.join_accept_payload.cf_list.type",
+	"end_device.pending_mac_state.pending_join_request.payload.Payload.join_accept_payload.dev_addr",
+	"end_device.pending_mac_state.pending_join_request.payload.Payload.join_accept_payload.dl_settings",
+	"end_device.pending_mac_state.pending_join_request.payload.Payload.join_accept_payload.dl_settings.opt_neg",
+	"end_device.pending_mac_state.pending_join_request.payload.Payload.join_accept_payload.dl_settings.rx1_dr_offset",
+	"end_device.pending_mac_state.pending_join_request.payload.Payload.join_accept_payload.dl_settings.rx2_dr",
+	"end_device.pending_mac_state.pending_join_request.payload.Payload.join_accept_payload.encrypted",
+	"end_device.pending_mac_state.pending_join_request.payload.Payload.join_accept_payload.join_nonce",
+	"end_device.pending_mac_state.pending_join_request.payload.Payload.join_accept_payload.net_id",
+	"end_device.pending_mac_state.pending_join_request.payload.Payload.join_accept_payload.rx_delay",
+	"end_device.pending_mac_state.pending_join_request.payload.Payload.join_request_payload",
+	"end_device.pending_mac_state.pending_join_request.payload.Payload.join_request_payload.dev_eui",
+	"end_device.pending_mac_state.pending_join_request.payload.Payload.join_request_payload.dev_nonce",
+	"end_device.pending_mac_state.pending_join_request.payload.Payload.join_request_payload.join_eui",
+	"end_device.pending_mac_state.pending_join_request.payload.Payload.mac_payload",
+	"end_device.pending_mac_state.pending_join_request.payload.Payload.mac_payload.decoded_payload",
+	"end_device.pending_mac_state.pending_join_request.payload.Payload.mac_payload.f_hdr",
+	"end_device.pending_mac_state.pending_join_request.payload.Payload.mac_payload.f_hdr.dev_addr",
+	"end_device.pending_mac_state.pending_join_request.payload.Payload.mac_payload.f_hdr.f_cnt",
+	"end_device.pending_mac_state.pending_join_request.payload.Payload.mac_payload.f_hdr.f_ctrl",
+	"end_device.pending_mac_state.pending_join_request.payload.Payload.mac_payload.f_hdr.f_ctrl.ack",
+	"end_device.pending_mac_state.pending_join_request.payload.Payload.mac_payload.f_hdr.f_ctrl.adr",
+	"end_device.pending_mac_state.pending_join_request.payload.Payload.mac_payload.f_hdr.f_ctrl.adr_ack_req",
+	"end_device.pending_mac_state.pending_join_request.payload.Payload.mac_payload.f_hdr.f_ctrl.class_b",
+	"end_device.pending_mac_state.pending_join_request.payload.Payload.mac_payload.f_hdr.f_ctrl.f_pending",
+	"end_device.pending_mac_state.pending_join_request.payload.Payload.mac_payload.f_hdr.f_opts",
+	"end_device.pending_mac_state.pending_join_request.payload.Payload.mac_payload.f_port",
+	"end_device.pending_mac_state.pending_join_request.payload.Payload.mac_payload.frm_payload",
+	"end_device.pending_mac_state.pending_join_request.payload.Payload.rejoin_request_payload",
+	"end_device.pending_mac_state.pending_join_request.payload.Payload.rejoin_request_payload.dev_eui",
+	"end_device.pending_mac_state.pending_join_request.payload.Payload.rejoin_request_payload.join_eui",
+	"end_device.pending_mac_state.pending_join_request.payload.Payload.rejoin_request_payload.net_id",
+	"end_device.pending_mac_state.pending_join_request.payload.Payload.rejoin_request_payload.rejoin_cnt",
+	"end_device.pending_mac_state.pending_join_request.payload.Payload.rejoin_request_payload.rejoin_type",
+	"end_device.pending_mac_state.pending_join_request.payload.m_hdr",
+	"end_device.pending_mac_state.pending_join_request.payload.m_hdr.m_type",
+	"end_device.pending_mac_state.pending_join_request.payload.m_hdr.major",
+	"end_device.pending_mac_state.pending_join_request.payload.mic",
+	"end_device.pending_mac_state.pending_join_request.raw_payload",
+	"end_device.pending_mac_state.pending_join_request.rx_delay",
+	"end_device.pending_mac_state.pending_join_request.selected_mac_version",
+	"end_device.pending_mac_state.pending_requests",
+	"end_device.pending_mac_state.ping_slot_periodicity",
+	"end_device.pending_mac_state.ping_slot_periodicity.value",
+	"end_device.pending_mac_state.queued_force_rejoin_req",
+	"end_device.pending_mac_state.queued_force_rejoin_req.data_rate_index",
+	"end_device.pending_mac_state.queued_force_rejoin_req.max_retries",
+	"end_device.pending_mac_state.queued_force_rejoin_req.period_exponent",
+	"end_device.pending_mac_state.queued_force_rejoin_req.rejoin_type",
+	"end_device.pending_mac_state.queued_join_accept",
+	"end_device.pending_mac_state.queued_join_accept.keys",
+	"end_device.pending_mac_state.queued_join_accept.keys.app_s_key",
+	"end_device.pending_mac_state.queued_join_accept.keys.app_s_key.encrypted_key",
+	"end_device.pending_mac_state.queued_join_accept.keys.app_s_key.kek_label",
+	"end_device.pending_mac_state.queued_join_accept.keys.app_s_key.key",
+	"end_device.pending_mac_state.queued_join_accept.keys.f_nwk_s_int_key",
+	"end_device.pending_mac_state.queued_join_accept.keys.f_nwk_s_int_key.encrypted_key",
+	"end_device.pending_mac_state.queued_join_accept.keys.f_nwk_s_int_key.kek_label",
+	"end_device.pending_mac_state.queued_join_accept.keys.f_nwk_s_int_key.key",
+	"end_device.pending_mac_state.queued_join_accept.keys.nwk_s_enc_key",
+	"end_device.pending_mac_state.queued_join_accept.keys.nwk_s_enc_key.encrypted_key",
+	"end_device.pending_mac_state.queued_join_accept.keys.nwk_s_enc_key.kek_label",
+	"end_device.pending_mac_state.queued_join_accept.keys.nwk_s_enc_key.key",
+	"end_device.pending_mac_state.queued_join_accept.keys.s_nwk_s_int_key",
+	"end_device.pending_mac_state.queued_join_accept.keys.s_nwk_s_int_key.encrypted_key",
+	"end_device.pending_mac_state.queued_join_accept.keys.s_nwk_s_int_key.kek_label",
+	"end_device.pending_mac_state.queued_join_accept.keys.s_nwk_s_int_key.key",
+	"end_device.pending_mac_state.queued_join_accept.keys.session_key_id",
+	"end_device.pending_mac_state.queued_join_accept.payload",
+	"end_device.pending_mac_state.queued_join_accept.request",
+	"end_device.pending_mac_state.queued_join_accept.request.cf_list",
+	"end_device.pending_mac_state.queued_join_accept.request.cf_list.ch_masks",
+	"end_device.pending_mac_state.queued_join_accept.request.cf_list.freq",
+	"end_device.pending_mac_state.queued_join_accept.request.cf_list.type",
+	"end_device.pending_mac_state.queued_join_accept.request.correlation_ids",
+	"end_device.pending_mac_state.queued_join_accept.request.dev_addr",
+	"end_device.pending_mac_state.queued_join_accept.request.downlink_settings",
+	"end_device.pending_mac_state.queued_join_accept.request.downlink_settings.opt_neg",
+	"end_device.pending_mac_state.queued_join_accept.request.downlink_settings.rx1_dr_offset",
+	"end_device.pending_mac_state.queued_join_accept.request.downlink_settings.rx2_dr",
+	"end_device.pending_mac_state.queued_join_accept.request.join_eui",
+	"end_device.pending_mac_state.queued_join_accept.request.net_id",
+	"end_device.pending_mac_state.queued_join_accept.request.payload",
+	"end_device.pending_mac_state.queued_join_accept.request.payload.Payload",
+	"end_device.pending_mac_state.queued_join_accept.request.payload.Payload.join_accept_payload",
+	"end_device.pending_mac_state.queued_join_accept.request.payload.Payload.join_accept_payload.cf_list",
+	"end_device.pending_mac_state.queued_join_accept.request.payload.Payload.join_accept_payload.cf_list.ch_masks",
+	"end_device.pending_mac_state.queued_join_accept.request.payload.Payload.join_accept_payload.cf_list.freq",
+	"end_device.pending_mac_state.queued_join_accept.request.payload.Payload.join_accept_payload.cf_list.type",
+	"end_device.pending_mac_state.queued_join_accept.request.payload.Payload.join_accept_payload.dev_addr",
+	"end_device.pending_mac_state.queued_join_accept.request.payload.Payload.join_accept_payload.dl_settings",
+	"end_device.pending_mac_state.queued_join_accept.request.payload.Payload.join_accept_payload.dl_settings.opt_neg",
+	"end_device.pending_mac_state.queued_join_accept.request.payload.Payload.join_accept_payload.dl_settings.rx1_dr_offset",
+	"end_device.pending_mac_state.queued_join_accept.request.payload.Payload.join_accept_payload.dl_settings.rx2_dr",
+	"end_device.pending_mac_state.queued_join_accept.request.payload.Payload.join_accept_payload.encrypted",
+	"end_device.pending_mac_state.queued_join_accept.request.payload.Payload.join_accept_payload.join_nonce",
+	"end_device.pending_mac_state.queued_join_accept.request.payload.Payload.join_accept_payload.net_id",
+	"end_device.pending_mac_state.queued_join_accept.request.payload.Payload.join_accept_payload.rx_delay",
+	"end_device.pending_mac_state.queued_join_accept.request.payload.Payload.join_request_payload",
+	"end_device.pending_mac_state.queued_join_accept.request.payload.Payload.join_request_payload.dev_eui",
+	"end_device.pending_mac_state.queued_join_accept.request.payload.Payload.join_request_payload.dev_nonce",
+	"end_device.pending_mac_state.queued_join_accept.request.payload.Payload.join_request_payload.join_eui",
+	"end_device.pending_mac_state.queued_join_accept.request.payload.Payload.mac_payload",
+	"end_device.pending_mac_state.queued_join_accept.request.payload.Payload.mac_payload.decoded_payload",
+	"end_device.pending_mac_state.queued_join_accept.request.payload.Payload.mac_payload.f_hdr",
+	"end_device.pending_mac_state.queued_join_accept.request.payload.Payload.mac_payload.f_hdr.dev_addr",
+	"end_device.pending_mac_state.queued_join_accept.request.payload.Payload.mac_payload.f_hdr.f_cnt",
+	"end_device.pending_mac_state.queued_join_accept.request.payload.Payload.mac_payload.f_hdr.f_ctrl",
+	"end_device.pending_mac_state.queued_join_accept.request.payload.Payload.mac_payload.f_hdr.f_ctrl.ack",
+	"end_device.pending_mac_state.queued_join_accept.request.payload.Payload.mac_payload.f_hdr.f_ctrl.adr",
+	"end_device.pending_mac_state.queued_join_accept.request.payload.Payload.mac_payload.f_hdr.f_ctrl.adr_ack_req",
+	"end_device.pending_mac_state.queued_join_accept.request.payload.Payload.mac_payload.f_hdr.f_ctrl.class_b",
+	"end_device.pending_mac_state.queued_join_accept.request.payload.Payload.mac_payload.f_hdr.f_ctrl.f_pending",
+	"end_device.pending_mac_state.queued_join_accept.request.payload.Payload.mac_payload.f_hdr.f_opts",
+	"end_device.pending_mac_state.queued_join_accept.request.payload.Payload.mac_payload.f_port",
+	"end_device.pending_mac_state.queued_join_accept.request.payload.Payload.mac_payload.frm_payload",
+	"end_device.pending_mac_state.queued_join_accept.request.payload.Payload.rejoin_request_payload",
+	"end_device.pending_mac_state.queued_join_accept.request.payload.Payload.rejoin_request_payload.dev_eui",
+	"end_device.pending_mac_state.queued_join_accept.request.payload.Payload.rejoin_request_payload.join_eui",
+	"end_device.pending_mac_state.queued_join_accept.request.payload.Payload.rejoin_request_payload.net_id",
+	"end_device.pending_mac_state.queued_join_accept.request.payload.Payload.rejoin_request_payload.rejoin_cnt",
+	"end_device.pending_mac_state.queued_join_accept.request.payload.Payload.rejoin_request_payload.rejoin_type",
+	"end_device.pending_mac_state.queued_join_accept.request.payload.m_hdr",
+	"end_device.pending_mac_state.queued_join_accept.request.payload.m_hdr.m_type",
+	"end_device.pending_mac_state.queued_join_accept.request.payload.m_hdr.major",
+	"end_device.pending_mac_state.queued_join_accept.request.payload.mic",
+	"end_device.pending_mac_state.queued_join_accept.request.raw_payload",
+	"end_device.pending_mac_state.queued_join_accept.request.rx_delay",
+	"end_device.pending_mac_state.queued_join_accept.request.selected_mac_version",
+	"end_device.pending_mac_state.queued_responses",
+	"end_device.pending_mac_state.recent_downlinks",
+	"end_device.pending_mac_state.recent_uplinks",
+	"end_device.pending_mac_state.rx_windows_available",
+	"end_device.pending_session",
+	"end_device.pending_session.dev_addr",
+	"end_device.pending_session.keys",
+	"end_device.pending_session.keys.app_s_key",
+	"end_device.pending_session.keys.app_s_key.encrypted_key",
+	"end_device.pending_session.keys.app_s_key.kek_label",
+	"end_device.pending_session.keys.app_s_key.key",
+	"end_device.pending_session.keys.f_nwk_s_int_key",
+	"end_device.pending_session.keys.f_nwk_s_int_key.encrypted_key",
+	"end_device.pending_session.keys.f_nwk_s_int_key.kek_label",
+	"end_device.pending_session.keys.f_nwk_s_int_key.key",
+	"end_device.pending_session.keys.nwk_s_enc_key",
+	"end_device.pending_session.keys.nwk_s_enc_key.encrypted_key",
+	"end_device.pending_session.keys.nwk_s_enc_key.kek_label",
+	"end_device.pending_session.keys.nwk_s_enc_key.key",
+	"end_device.pending_session.keys.s_nwk_s_int_key",
+	"end_device.pending_session.keys.s_nwk_s_int_key.encrypted_key",
+	"end_device.pending_session.keys.s_nwk_s_int_key.kek_label",
+	"end_device.pending_session.keys.s_nwk_s_int_key.key",
+	"end_device.pending_session.keys.session_key_id",
+	"end_device.pending_session.last_a_f_cnt_down",
+	"end_device.pending_session.last_conf_f_cnt_down",
+	"end_device.pending_session.last_f_cnt_up",
+	"end_device.pending_session.last_n_f_cnt_down",
+	"end_device.pending_session.started_at",
+	"end_device.picture",
+	"end_device.picture.embedded",
+	"end_device.picture.embedded.data",
+	"end_device.picture.embedded.mime_type",
+	"end_device.picture.sizes",
+	"end_device.power_state",
+	"end_device.profile_ids",
+	"end_device.profile_ids.application_ids",
+	"end_device.profile_ids.application_ids.application_id",
+	"end_device.profile_ids.profile_id",
+	"end_device.provisioner_id",
+	"end_device.provisioning_data",
+	"end_device.queued_application_downlinks",
+	"end_device.recent_adr_uplinks",
+	"end_device.recent_downlinks",
+	"end_device.recent_uplinks",
+	"end_device.resets_join_nonces",
+	"end_device.root_keys",
+	"end_device.root_keys.app_key",
+	"end_device.root_keys.app_key.encrypted_key",
+	"end_device.root_keys.app_key.kek_label",
+	"end_device.root_keys.app_key.key",
+	"end_device.root_keys.nwk_key",
+	"end_device.root_keys.nwk_key.encrypted_key",
+	"end_device.root_keys.nwk_key.kek_label",
+	"end_device.root_keys.nwk_key.key",
+	"end_device.root_keys.root_key_id",
+	"end_device.service_profile_id",
+	"end_device.session",
+	"end_device.session.dev_addr",
+	"end_device.session.keys",
+	"end_device.session.keys.app_s_key",
+	"end_device.session.keys.app_s_key.encrypted_key",
+	"end_device.session.keys.app_s_key.kek_label",
+	"end_device.session.keys.app_s_key.key",
+	"end_device.session.keys.f_nwk_s_int_key",
+	"end_device.session.keys.f_nwk_s_int_key.encrypted_key",
+	"end_device.session.keys.f_nwk_s_int_key.kek_label",
+	"end_device.session.keys.f_nwk_s_int_key.key",
+	"end_device.session.keys.nwk_s_enc_key",
+	"end_device.session.keys.nwk_s_enc_key.encrypted_key",
+	"end_device.session.keys.nwk_s_enc_key.kek_label",
+	"end_device.session.keys.nwk_s_enc_key.key",
+	"end_device.session.keys.s_nwk_s_int_key",
+	"end_device.session.keys.s_nwk_s_int_key.encrypted_key",
+	"end_device.session.keys.s_nwk_s_int_key.kek_label",
+	"end_device.session.keys.s_nwk_s_int_key.key",
+	"end_device.session.keys.session_key_id",
+	"end_device.session.last_a_f_cnt_down",
+	"end_device.session.last_conf_f_cnt_down",
+	"end_device.session.last_f_cnt_up",
+	"end_device.session.last_n_f_cnt_down",
+	"end_device.session.started_at",
+	"end_device.skip_payload_crypto",
+	"end_device.supports_class_b",
+	"end_device.supports_class_c",
+	"end_device.supports_join",
+	"end_device.updated_at",
+	"end_device.used_dev_nonces",
+	"end_device.version_ids",
+	"end_device.version_ids.brand_id",
+	"end_device.version_ids.firmware_version",
+	"end_device.version_ids.hardware_version",
+	"end_device.version_ids.model_id",
+	"error",
+	"error.attributes",
+	"error.cause",
+	"error.cause.attributes",
+	"error.cause.correlation_id",
+	"error.cause.message_format",
+	"error.cause.name",
+	"error.cause.namespace",
+	"error.code",
+	"error.correlation_id",
+	"error.details",
+	"error.message_format",
+	"error.name",
+	"error.namespace",
+	"ids",
+	"ids.application_ids",
+	"ids.application_ids.application_id",
+	"ids.dev_addr",
+	"ids.dev_eui",
+	"ids.device_id",
+	"ids.join_eui",
+}
+
+var BatchEndDevicesResponse_ResultFieldPathsTopLevel = []string{
+	"end_device",
+	"error",
+	"ids",
+}
+var ConvertEndDeviceTemplateRequest_CSVOptionsFieldPathsNested = []string{
+	"base64",
+	"separator",
+}
+
+var ConvertEndDeviceTemplateRequest_CSVOptionsFieldPathsTopLevel = []string{
+	"base64",
+	"separator",
+}
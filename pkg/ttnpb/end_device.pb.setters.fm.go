@@ -677,6 +677,15 @@ func (dst *EndDeviceVersion) SetFields(src *EndDeviceVersion, paths ...string) e
 					dst.DefaultFormatters = zero
 				}
 			}
+		case "profile_ids":
+			if len(subs) > 0 {
+				return fmt.Errorf("'profile_ids' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.ProfileIDs = src.ProfileIDs
+			} else {
+				dst.ProfileIDs = nil
+			}
 
 		default:
 			return fmt.Errorf("invalid field: '%s'", name)
@@ -1357,6 +1366,41 @@ func (dst *MACState) SetFields(src *MACState, paths ...string) error {
 			} else {
 				dst.LastNetworkInitiatedDownlinkAt = nil
 			}
+		case "queued_force_rejoin_req":
+			if len(subs) > 0 {
+				var newDst, newSrc *MACCommand_ForceRejoinReq
+				if (src == nil || src.QueuedForceRejoinReq == nil) && dst.QueuedForceRejoinReq == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.QueuedForceRejoinReq
+				}
+				if dst.QueuedForceRejoinReq != nil {
+					newDst = dst.QueuedForceRejoinReq
+				} else {
+					newDst = &MACCommand_ForceRejoinReq{}
+					dst.QueuedForceRejoinReq = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.QueuedForceRejoinReq = src.QueuedForceRejoinReq
+				} else {
+					dst.QueuedForceRejoinReq = nil
+				}
+			}
+		case "last_rj_count_0":
+			if len(subs) > 0 {
+				return fmt.Errorf("'last_rj_count_0' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.LastRJCount0 = src.LastRJCount0
+			} else {
+				var zero uint32
+				dst.LastRJCount0 = zero
+			}
 
 		default:
 			return fmt.Errorf("invalid field: '%s'", name)
@@ -2064,6 +2108,31 @@ func (dst *EndDevice) SetFields(src *EndDevice, paths ...string) error {
 				var zero bool
 				dst.SkipPayloadCrypto = zero
 			}
+		case "profile_ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *EndDeviceProfileIdentifiers
+				if (src == nil || src.ProfileIDs == nil) && dst.ProfileIDs == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.ProfileIDs
+				}
+				if dst.ProfileIDs != nil {
+					newDst = dst.ProfileIDs
+				} else {
+					newDst = &EndDeviceProfileIdentifiers{}
+					dst.ProfileIDs = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.ProfileIDs = src.ProfileIDs
+				} else {
+					dst.ProfileIDs = nil
+				}
+			}
 
 		default:
 			return fmt.Errorf("invalid field: '%s'", name)
@@ -2338,46 +2407,35 @@ func (dst *SetEndDeviceRequest) SetFields(src *SetEndDeviceRequest, paths ...str
 	return nil
 }
 
-func (dst *EndDeviceTemplate) SetFields(src *EndDeviceTemplate, paths ...string) error {
+func (dst *BatchCreateEndDevicesRequest) SetFields(src *BatchCreateEndDevicesRequest, paths ...string) error {
 	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
 		switch name {
-		case "end_device":
+		case "application_ids":
 			if len(subs) > 0 {
-				var newDst, newSrc *EndDevice
+				var newDst, newSrc *ApplicationIdentifiers
 				if src != nil {
-					newSrc = &src.EndDevice
+					newSrc = &src.ApplicationIdentifiers
 				}
-				newDst = &dst.EndDevice
+				newDst = &dst.ApplicationIdentifiers
 				if err := newDst.SetFields(newSrc, subs...); err != nil {
 					return err
 				}
 			} else {
 				if src != nil {
-					dst.EndDevice = src.EndDevice
+					dst.ApplicationIdentifiers = src.ApplicationIdentifiers
 				} else {
-					var zero EndDevice
-					dst.EndDevice = zero
+					var zero ApplicationIdentifiers
+					dst.ApplicationIdentifiers = zero
 				}
 			}
-		case "field_mask":
-			if len(subs) > 0 {
-				return fmt.Errorf("'field_mask' has no subfields, but %s were specified", subs)
-			}
-			if src != nil {
-				dst.FieldMask = src.FieldMask
-			} else {
-				var zero types.FieldMask
-				dst.FieldMask = zero
-			}
-		case "mapping_key":
+		case "requests":
 			if len(subs) > 0 {
-				return fmt.Errorf("'mapping_key' has no subfields, but %s were specified", subs)
+				return fmt.Errorf("'requests' has no subfields, but %s were specified", subs)
 			}
 			if src != nil {
-				dst.MappingKey = src.MappingKey
+				dst.Requests = src.Requests
 			} else {
-				var zero string
-				dst.MappingKey = zero
+				dst.Requests = nil
 			}
 
 		default:
@@ -2387,37 +2445,35 @@ func (dst *EndDeviceTemplate) SetFields(src *EndDeviceTemplate, paths ...string)
 	return nil
 }
 
-func (dst *EndDeviceTemplateFormat) SetFields(src *EndDeviceTemplateFormat, paths ...string) error {
+func (dst *BatchGetEndDevicesRequest) SetFields(src *BatchGetEndDevicesRequest, paths ...string) error {
 	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
 		switch name {
-		case "name":
-			if len(subs) > 0 {
-				return fmt.Errorf("'name' has no subfields, but %s were specified", subs)
-			}
-			if src != nil {
-				dst.Name = src.Name
-			} else {
-				var zero string
-				dst.Name = zero
-			}
-		case "description":
+		case "application_ids":
 			if len(subs) > 0 {
-				return fmt.Errorf("'description' has no subfields, but %s were specified", subs)
-			}
-			if src != nil {
-				dst.Description = src.Description
+				var newDst, newSrc *ApplicationIdentifiers
+				if src != nil {
+					newSrc = &src.ApplicationIdentifiers
+				}
+				newDst = &dst.ApplicationIdentifiers
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
 			} else {
-				var zero string
-				dst.Description = zero
+				if src != nil {
+					dst.ApplicationIdentifiers = src.ApplicationIdentifiers
+				} else {
+					var zero ApplicationIdentifiers
+					dst.ApplicationIdentifiers = zero
+				}
 			}
-		case "file_extensions":
+		case "requests":
 			if len(subs) > 0 {
-				return fmt.Errorf("'file_extensions' has no subfields, but %s were specified", subs)
+				return fmt.Errorf("'requests' has no subfields, but %s were specified", subs)
 			}
 			if src != nil {
-				dst.FileExtensions = src.FileExtensions
+				dst.Requests = src.Requests
 			} else {
-				dst.FileExtensions = nil
+				dst.Requests = nil
 			}
 
 		default:
@@ -2427,17 +2483,35 @@ func (dst *EndDeviceTemplateFormat) SetFields(src *EndDeviceTemplateFormat, path
 	return nil
 }
 
-func (dst *EndDeviceTemplateFormats) SetFields(src *EndDeviceTemplateFormats, paths ...string) error {
+func (dst *BatchUpdateEndDevicesRequest) SetFields(src *BatchUpdateEndDevicesRequest, paths ...string) error {
 	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
 		switch name {
-		case "formats":
+		case "application_ids":
 			if len(subs) > 0 {
-				return fmt.Errorf("'formats' has no subfields, but %s were specified", subs)
+				var newDst, newSrc *ApplicationIdentifiers
+				if src != nil {
+					newSrc = &src.ApplicationIdentifiers
+				}
+				newDst = &dst.ApplicationIdentifiers
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.ApplicationIdentifiers = src.ApplicationIdentifiers
+				} else {
+					var zero ApplicationIdentifiers
+					dst.ApplicationIdentifiers = zero
+				}
+			}
+		case "requests":
+			if len(subs) > 0 {
+				return fmt.Errorf("'requests' has no subfields, but %s were specified", subs)
 			}
 			if src != nil {
-				dst.Formats = src.Formats
+				dst.Requests = src.Requests
 			} else {
-				dst.Formats = nil
+				dst.Requests = nil
 			}
 
 		default:
@@ -2447,27 +2521,35 @@ func (dst *EndDeviceTemplateFormats) SetFields(src *EndDeviceTemplateFormats, pa
 	return nil
 }
 
-func (dst *ConvertEndDeviceTemplateRequest) SetFields(src *ConvertEndDeviceTemplateRequest, paths ...string) error {
+func (dst *BatchSetEndDevicesRequest) SetFields(src *BatchSetEndDevicesRequest, paths ...string) error {
 	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
 		switch name {
-		case "format_id":
+		case "application_ids":
 			if len(subs) > 0 {
-				return fmt.Errorf("'format_id' has no subfields, but %s were specified", subs)
-			}
-			if src != nil {
-				dst.FormatID = src.FormatID
+				var newDst, newSrc *ApplicationIdentifiers
+				if src != nil {
+					newSrc = &src.ApplicationIdentifiers
+				}
+				newDst = &dst.ApplicationIdentifiers
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
 			} else {
-				var zero string
-				dst.FormatID = zero
+				if src != nil {
+					dst.ApplicationIdentifiers = src.ApplicationIdentifiers
+				} else {
+					var zero ApplicationIdentifiers
+					dst.ApplicationIdentifiers = zero
+				}
 			}
-		case "data":
+		case "requests":
 			if len(subs) > 0 {
-				return fmt.Errorf("'data' has no subfields, but %s were specified", subs)
+				return fmt.Errorf("'requests' has no subfields, but %s were specified", subs)
 			}
 			if src != nil {
-				dst.Data = src.Data
+				dst.Requests = src.Requests
 			} else {
-				dst.Data = nil
+				dst.Requests = nil
 			}
 
 		default:
@@ -2477,58 +2559,35 @@ func (dst *ConvertEndDeviceTemplateRequest) SetFields(src *ConvertEndDeviceTempl
 	return nil
 }
 
-func (dst *MACParameters_Channel) SetFields(src *MACParameters_Channel, paths ...string) error {
+func (dst *BatchDeleteEndDevicesRequest) SetFields(src *BatchDeleteEndDevicesRequest, paths ...string) error {
 	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
 		switch name {
-		case "uplink_frequency":
-			if len(subs) > 0 {
-				return fmt.Errorf("'uplink_frequency' has no subfields, but %s were specified", subs)
-			}
-			if src != nil {
-				dst.UplinkFrequency = src.UplinkFrequency
-			} else {
-				var zero uint64
-				dst.UplinkFrequency = zero
-			}
-		case "downlink_frequency":
-			if len(subs) > 0 {
-				return fmt.Errorf("'downlink_frequency' has no subfields, but %s were specified", subs)
-			}
-			if src != nil {
-				dst.DownlinkFrequency = src.DownlinkFrequency
-			} else {
-				var zero uint64
-				dst.DownlinkFrequency = zero
-			}
-		case "min_data_rate_index":
-			if len(subs) > 0 {
-				return fmt.Errorf("'min_data_rate_index' has no subfields, but %s were specified", subs)
-			}
-			if src != nil {
-				dst.MinDataRateIndex = src.MinDataRateIndex
-			} else {
-				var zero DataRateIndex
-				dst.MinDataRateIndex = zero
-			}
-		case "max_data_rate_index":
+		case "application_ids":
 			if len(subs) > 0 {
-				return fmt.Errorf("'max_data_rate_index' has no subfields, but %s were specified", subs)
-			}
-			if src != nil {
-				dst.MaxDataRateIndex = src.MaxDataRateIndex
+				var newDst, newSrc *ApplicationIdentifiers
+				if src != nil {
+					newSrc = &src.ApplicationIdentifiers
+				}
+				newDst = &dst.ApplicationIdentifiers
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
 			} else {
-				var zero DataRateIndex
-				dst.MaxDataRateIndex = zero
+				if src != nil {
+					dst.ApplicationIdentifiers = src.ApplicationIdentifiers
+				} else {
+					var zero ApplicationIdentifiers
+					dst.ApplicationIdentifiers = zero
+				}
 			}
-		case "enable_uplink":
+		case "end_device_ids":
 			if len(subs) > 0 {
-				return fmt.Errorf("'enable_uplink' has no subfields, but %s were specified", subs)
+				return fmt.Errorf("'end_device_ids' has no subfields, but %s were specified", subs)
 			}
 			if src != nil {
-				dst.EnableUplink = src.EnableUplink
+				dst.EndDeviceIDs = src.EndDeviceIDs
 			} else {
-				var zero bool
-				dst.EnableUplink = zero
+				dst.EndDeviceIDs = nil
 			}
 
 		default:
@@ -2538,44 +2597,633 @@ func (dst *MACParameters_Channel) SetFields(src *MACParameters_Channel, paths ..
 	return nil
 }
 
-func (dst *MACState_JoinAccept) SetFields(src *MACState_JoinAccept, paths ...string) error {
+func (dst *BatchEndDevicesResponse) SetFields(src *BatchEndDevicesResponse, paths ...string) error {
 	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
 		switch name {
-		case "payload":
+		case "results":
 			if len(subs) > 0 {
-				return fmt.Errorf("'payload' has no subfields, but %s were specified", subs)
+				return fmt.Errorf("'results' has no subfields, but %s were specified", subs)
 			}
 			if src != nil {
-				dst.Payload = src.Payload
+				dst.Results = src.Results
 			} else {
-				dst.Payload = nil
+				dst.Results = nil
 			}
-		case "request":
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *EndDeviceProfileIdentifiers) SetFields(src *EndDeviceProfileIdentifiers, paths ...string) error {
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		switch name {
+		case "application_ids":
 			if len(subs) > 0 {
-				var newDst, newSrc *JoinRequest
+				var newDst, newSrc *ApplicationIdentifiers
+				if (src == nil || src.ApplicationIDs == nil) && dst.ApplicationIDs == nil {
+					continue
+				}
 				if src != nil {
-					newSrc = &src.Request
+					newSrc = src.ApplicationIDs
+				}
+				if dst.ApplicationIDs != nil {
+					newDst = dst.ApplicationIDs
+				} else {
+					newDst = &ApplicationIdentifiers{}
+					dst.ApplicationIDs = newDst
 				}
-				newDst = &dst.Request
 				if err := newDst.SetFields(newSrc, subs...); err != nil {
 					return err
 				}
 			} else {
 				if src != nil {
-					dst.Request = src.Request
+					dst.ApplicationIDs = src.ApplicationIDs
 				} else {
-					var zero JoinRequest
-					dst.Request = zero
+					dst.ApplicationIDs = nil
 				}
 			}
-		case "keys":
+		case "profile_id":
 			if len(subs) > 0 {
-				var newDst, newSrc *SessionKeys
-				if src != nil {
-					newSrc = &src.Keys
-				}
-				newDst = &dst.Keys
-				if err := newDst.SetFields(newSrc, subs...); err != nil {
+				return fmt.Errorf("'profile_id' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.ProfileID = src.ProfileID
+			} else {
+				var zero string
+				dst.ProfileID = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *EndDeviceProfile) SetFields(src *EndDeviceProfile, paths ...string) error {
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		switch name {
+		case "ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *EndDeviceProfileIdentifiers
+				if src != nil {
+					newSrc = &src.EndDeviceProfileIdentifiers
+				}
+				newDst = &dst.EndDeviceProfileIdentifiers
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.EndDeviceProfileIdentifiers = src.EndDeviceProfileIdentifiers
+				} else {
+					var zero EndDeviceProfileIdentifiers
+					dst.EndDeviceProfileIdentifiers = zero
+				}
+			}
+		case "created_at":
+			if len(subs) > 0 {
+				return fmt.Errorf("'created_at' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.CreatedAt = src.CreatedAt
+			} else {
+				var zero time.Time
+				dst.CreatedAt = zero
+			}
+		case "updated_at":
+			if len(subs) > 0 {
+				return fmt.Errorf("'updated_at' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.UpdatedAt = src.UpdatedAt
+			} else {
+				var zero time.Time
+				dst.UpdatedAt = zero
+			}
+		case "version":
+			if len(subs) > 0 {
+				return fmt.Errorf("'version' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Version = src.Version
+			} else {
+				var zero uint32
+				dst.Version = zero
+			}
+		case "name":
+			if len(subs) > 0 {
+				return fmt.Errorf("'name' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Name = src.Name
+			} else {
+				var zero string
+				dst.Name = zero
+			}
+		case "description":
+			if len(subs) > 0 {
+				return fmt.Errorf("'description' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Description = src.Description
+			} else {
+				var zero string
+				dst.Description = zero
+			}
+		case "mac_settings":
+			if len(subs) > 0 {
+				var newDst, newSrc *MACSettings
+				if (src == nil || src.MACSettings == nil) && dst.MACSettings == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.MACSettings
+				}
+				if dst.MACSettings != nil {
+					newDst = dst.MACSettings
+				} else {
+					newDst = &MACSettings{}
+					dst.MACSettings = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.MACSettings = src.MACSettings
+				} else {
+					dst.MACSettings = nil
+				}
+			}
+		case "lorawan_version":
+			if len(subs) > 0 {
+				return fmt.Errorf("'lorawan_version' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.LoRaWANVersion = src.LoRaWANVersion
+			} else {
+				var zero MACVersion
+				dst.LoRaWANVersion = zero
+			}
+		case "lorawan_phy_version":
+			if len(subs) > 0 {
+				return fmt.Errorf("'lorawan_phy_version' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.LoRaWANPHYVersion = src.LoRaWANPHYVersion
+			} else {
+				var zero PHYVersion
+				dst.LoRaWANPHYVersion = zero
+			}
+		case "frequency_plan_id":
+			if len(subs) > 0 {
+				return fmt.Errorf("'frequency_plan_id' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.FrequencyPlanID = src.FrequencyPlanID
+			} else {
+				var zero string
+				dst.FrequencyPlanID = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *EndDeviceProfiles) SetFields(src *EndDeviceProfiles, paths ...string) error {
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		switch name {
+		case "profiles":
+			if len(subs) > 0 {
+				return fmt.Errorf("'profiles' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Profiles = src.Profiles
+			} else {
+				dst.Profiles = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *GetEndDeviceProfileRequest) SetFields(src *GetEndDeviceProfileRequest, paths ...string) error {
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		switch name {
+		case "profile_ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *EndDeviceProfileIdentifiers
+				if src != nil {
+					newSrc = &src.EndDeviceProfileIdentifiers
+				}
+				newDst = &dst.EndDeviceProfileIdentifiers
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.EndDeviceProfileIdentifiers = src.EndDeviceProfileIdentifiers
+				} else {
+					var zero EndDeviceProfileIdentifiers
+					dst.EndDeviceProfileIdentifiers = zero
+				}
+			}
+		case "field_mask":
+			if len(subs) > 0 {
+				return fmt.Errorf("'field_mask' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.FieldMask = src.FieldMask
+			} else {
+				var zero types.FieldMask
+				dst.FieldMask = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *ListEndDeviceProfilesRequest) SetFields(src *ListEndDeviceProfilesRequest, paths ...string) error {
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		switch name {
+		case "application_ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *ApplicationIdentifiers
+				if (src == nil || src.ApplicationIDs == nil) && dst.ApplicationIDs == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.ApplicationIDs
+				}
+				if dst.ApplicationIDs != nil {
+					newDst = dst.ApplicationIDs
+				} else {
+					newDst = &ApplicationIdentifiers{}
+					dst.ApplicationIDs = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.ApplicationIDs = src.ApplicationIDs
+				} else {
+					dst.ApplicationIDs = nil
+				}
+			}
+		case "field_mask":
+			if len(subs) > 0 {
+				return fmt.Errorf("'field_mask' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.FieldMask = src.FieldMask
+			} else {
+				var zero types.FieldMask
+				dst.FieldMask = zero
+			}
+		case "limit":
+			if len(subs) > 0 {
+				return fmt.Errorf("'limit' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Limit = src.Limit
+			} else {
+				var zero uint32
+				dst.Limit = zero
+			}
+		case "page":
+			if len(subs) > 0 {
+				return fmt.Errorf("'page' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Page = src.Page
+			} else {
+				var zero uint32
+				dst.Page = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *SetEndDeviceProfileRequest) SetFields(src *SetEndDeviceProfileRequest, paths ...string) error {
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		switch name {
+		case "profile":
+			if len(subs) > 0 {
+				var newDst, newSrc *EndDeviceProfile
+				if src != nil {
+					newSrc = &src.Profile
+				}
+				newDst = &dst.Profile
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.Profile = src.Profile
+				} else {
+					var zero EndDeviceProfile
+					dst.Profile = zero
+				}
+			}
+		case "field_mask":
+			if len(subs) > 0 {
+				return fmt.Errorf("'field_mask' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.FieldMask = src.FieldMask
+			} else {
+				var zero types.FieldMask
+				dst.FieldMask = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *EndDeviceTemplate) SetFields(src *EndDeviceTemplate, paths ...string) error {
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		switch name {
+		case "end_device":
+			if len(subs) > 0 {
+				var newDst, newSrc *EndDevice
+				if src != nil {
+					newSrc = &src.EndDevice
+				}
+				newDst = &dst.EndDevice
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.EndDevice = src.EndDevice
+				} else {
+					var zero EndDevice
+					dst.EndDevice = zero
+				}
+			}
+		case "field_mask":
+			if len(subs) > 0 {
+				return fmt.Errorf("'field_mask' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.FieldMask = src.FieldMask
+			} else {
+				var zero types.FieldMask
+				dst.FieldMask = zero
+			}
+		case "mapping_key":
+			if len(subs) > 0 {
+				return fmt.Errorf("'mapping_key' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.MappingKey = src.MappingKey
+			} else {
+				var zero string
+				dst.MappingKey = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *EndDeviceTemplateFormat) SetFields(src *EndDeviceTemplateFormat, paths ...string) error {
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		switch name {
+		case "name":
+			if len(subs) > 0 {
+				return fmt.Errorf("'name' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Name = src.Name
+			} else {
+				var zero string
+				dst.Name = zero
+			}
+		case "description":
+			if len(subs) > 0 {
+				return fmt.Errorf("'description' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Description = src.Description
+			} else {
+				var zero string
+				dst.Description = zero
+			}
+		case "file_extensions":
+			if len(subs) > 0 {
+				return fmt.Errorf("'file_extensions' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.FileExtensions = src.FileExtensions
+			} else {
+				dst.FileExtensions = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *EndDeviceTemplateFormats) SetFields(src *EndDeviceTemplateFormats, paths ...string) error {
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		switch name {
+		case "formats":
+			if len(subs) > 0 {
+				return fmt.Errorf("'formats' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Formats = src.Formats
+			} else {
+				dst.Formats = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *ConvertEndDeviceTemplateRequest) SetFields(src *ConvertEndDeviceTemplateRequest, paths ...string) error {
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		switch name {
+		case "format_id":
+			if len(subs) > 0 {
+				return fmt.Errorf("'format_id' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.FormatID = src.FormatID
+			} else {
+				var zero string
+				dst.FormatID = zero
+			}
+		case "data":
+			if len(subs) > 0 {
+				return fmt.Errorf("'data' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Data = src.Data
+			} else {
+				dst.Data = nil
+			}
+		case "csv_options":
+			if len(subs) > 0 {
+				var newDst, newSrc *ConvertEndDeviceTemplateRequest_CSVOptions
+				if (src == nil || src.CSVOptions == nil) && dst.CSVOptions == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.CSVOptions
+				}
+				if dst.CSVOptions != nil {
+					newDst = dst.CSVOptions
+				} else {
+					newDst = &ConvertEndDeviceTemplateRequest_CSVOptions{}
+					dst.CSVOptions = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.CSVOptions = src.CSVOptions
+				} else {
+					dst.CSVOptions = nil
+				}
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *MACParameters_Channel) SetFields(src *MACParameters_Channel, paths ...string) error {
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		switch name {
+		case "uplink_frequency":
+			if len(subs) > 0 {
+				return fmt.Errorf("'uplink_frequency' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.UplinkFrequency = src.UplinkFrequency
+			} else {
+				var zero uint64
+				dst.UplinkFrequency = zero
+			}
+		case "downlink_frequency":
+			if len(subs) > 0 {
+				return fmt.Errorf("'downlink_frequency' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.DownlinkFrequency = src.DownlinkFrequency
+			} else {
+				var zero uint64
+				dst.DownlinkFrequency = zero
+			}
+		case "min_data_rate_index":
+			if len(subs) > 0 {
+				return fmt.Errorf("'min_data_rate_index' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.MinDataRateIndex = src.MinDataRateIndex
+			} else {
+				var zero DataRateIndex
+				dst.MinDataRateIndex = zero
+			}
+		case "max_data_rate_index":
+			if len(subs) > 0 {
+				return fmt.Errorf("'max_data_rate_index' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.MaxDataRateIndex = src.MaxDataRateIndex
+			} else {
+				var zero DataRateIndex
+				dst.MaxDataRateIndex = zero
+			}
+		case "enable_uplink":
+			if len(subs) > 0 {
+				return fmt.Errorf("'enable_uplink' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.EnableUplink = src.EnableUplink
+			} else {
+				var zero bool
+				dst.EnableUplink = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *MACState_JoinAccept) SetFields(src *MACState_JoinAccept, paths ...string) error {
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		switch name {
+		case "payload":
+			if len(subs) > 0 {
+				return fmt.Errorf("'payload' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Payload = src.Payload
+			} else {
+				dst.Payload = nil
+			}
+		case "request":
+			if len(subs) > 0 {
+				var newDst, newSrc *JoinRequest
+				if src != nil {
+					newSrc = &src.Request
+				}
+				newDst = &dst.Request
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.Request = src.Request
+				} else {
+					var zero JoinRequest
+					dst.Request = zero
+				}
+			}
+		case "keys":
+			if len(subs) > 0 {
+				var newDst, newSrc *SessionKeys
+				if src != nil {
+					newSrc = &src.Keys
+				}
+				newDst = &dst.Keys
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
 					return err
 				}
 			} else {
@@ -2593,3 +3241,113 @@ func (dst *MACState_JoinAccept) SetFields(src *MACState_JoinAccept, paths ...str
 	}
 	return nil
 }
+
+func (dst *BatchEndDevicesResponse_Result) SetFields(src *BatchEndDevicesResponse_Result, paths ...string) error {
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		switch name {
+		case "ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *EndDeviceIdentifiers
+				if src != nil {
+					newSrc = &src.IDs
+				}
+				newDst = &dst.IDs
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.IDs = src.IDs
+				} else {
+					var zero EndDeviceIdentifiers
+					dst.IDs = zero
+				}
+			}
+		case "end_device":
+			if len(subs) > 0 {
+				var newDst, newSrc *EndDevice
+				if (src == nil || src.EndDevice == nil) && dst.EndDevice == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.EndDevice
+				}
+				if dst.EndDevice != nil {
+					newDst = dst.EndDevice
+				} else {
+					newDst = &EndDevice{}
+					dst.EndDevice = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.EndDevice = src.EndDevice
+				} else {
+					dst.EndDevice = nil
+				}
+			}
+		case "error":
+			if len(subs) > 0 {
+				var newDst, newSrc *ErrorDetails
+				if (src == nil || src.Error == nil) && dst.Error == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.Error
+				}
+				if dst.Error != nil {
+					newDst = dst.Error
+				} else {
+					newDst = &ErrorDetails{}
+					dst.Error = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.Error = src.Error
+				} else {
+					dst.Error = nil
+				}
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *ConvertEndDeviceTemplateRequest_CSVOptions) SetFields(src *ConvertEndDeviceTemplateRequest_CSVOptions, paths ...string) error {
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		switch name {
+		case "separator":
+			if len(subs) > 0 {
+				return fmt.Errorf("'separator' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Separator = src.Separator
+			} else {
+				var zero string
+				dst.Separator = zero
+			}
+		case "base64":
+			if len(subs) > 0 {
+				return fmt.Errorf("'base64' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Base64 = src.Base64
+			} else {
+				var zero bool
+				dst.Base64 = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
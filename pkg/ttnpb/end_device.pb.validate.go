@@ -845,6 +845,8 @@ func (m *EndDeviceVersion) ValidateFields(paths ...string) error {
 				}
 			}
 
+		case "profile_ids":
+			// no validation rules for ProfileIDs
 		default:
 			return EndDeviceVersionValidationError{
 				field:  name,
@@ -1522,6 +1524,20 @@ func (m *MACState) ValidateFields(paths ...string) error {
 				}
 			}
 
+		case "queued_force_rejoin_req":
+
+			if v, ok := interface{}(m.GetQueuedForceRejoinReq()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return MACStateValidationError{
+						field:  "queued_force_rejoin_req",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "last_rj_count_0":
+			// no validation rules for LastRJCount0
 		default:
 			return MACStateValidationError{
 				field:  name,
@@ -2195,6 +2211,18 @@ func (m *EndDevice) ValidateFields(paths ...string) error {
 
 		case "skip_payload_crypto":
 			// no validation rules for SkipPayloadCrypto
+		case "profile_ids":
+
+			if v, ok := interface{}(m.GetProfileIDs()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return EndDeviceValidationError{
+						field:  "profile_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
 		default:
 			return EndDeviceValidationError{
 				field:  name,
@@ -3002,56 +3030,59 @@ var _ interface {
 	ErrorName() string
 } = SetEndDeviceRequestValidationError{}
 
-// ValidateFields checks the field values on EndDeviceTemplate with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, an error is returned.
-func (m *EndDeviceTemplate) ValidateFields(paths ...string) error {
+// ValidateFields checks the field values on BatchCreateEndDevicesRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, an error is returned.
+func (m *BatchCreateEndDevicesRequest) ValidateFields(paths ...string) error {
 	if m == nil {
 		return nil
 	}
 
 	if len(paths) == 0 {
-		paths = EndDeviceTemplateFieldPathsNested
+		paths = BatchCreateEndDevicesRequestFieldPathsNested
 	}
 
 	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
 		_ = subs
 		switch name {
-		case "end_device":
+		case "application_ids":
 
-			if v, ok := interface{}(&m.EndDevice).(interface{ ValidateFields(...string) error }); ok {
+			if v, ok := interface{}(&m.ApplicationIdentifiers).(interface{ ValidateFields(...string) error }); ok {
 				if err := v.ValidateFields(subs...); err != nil {
-					return EndDeviceTemplateValidationError{
-						field:  "end_device",
+					return BatchCreateEndDevicesRequestValidationError{
+						field:  "application_ids",
 						reason: "embedded message failed validation",
 						cause:  err,
 					}
 				}
 			}
 
-		case "field_mask":
+		case "requests":
 
-			if v, ok := interface{}(&m.FieldMask).(interface{ ValidateFields(...string) error }); ok {
-				if err := v.ValidateFields(subs...); err != nil {
-					return EndDeviceTemplateValidationError{
-						field:  "field_mask",
-						reason: "embedded message failed validation",
-						cause:  err,
-					}
+			if l := len(m.GetRequests()); l < 1 || l > 100 {
+				return BatchCreateEndDevicesRequestValidationError{
+					field:  "requests",
+					reason: "value must contain between 1 and 100 items, inclusive",
 				}
 			}
 
-		case "mapping_key":
+			for idx, item := range m.GetRequests() {
+				_, _ = idx, item
 
-			if utf8.RuneCountInString(m.GetMappingKey()) > 100 {
-				return EndDeviceTemplateValidationError{
-					field:  "mapping_key",
-					reason: "value length must be at most 100 runes",
+				if v, ok := interface{}(item).(interface{ ValidateFields(...string) error }); ok {
+					if err := v.ValidateFields(subs...); err != nil {
+						return BatchCreateEndDevicesRequestValidationError{
+							field:  fmt.Sprintf("requests[%v]", idx),
+							reason: "embedded message failed validation",
+							cause:  err,
+						}
+					}
 				}
+
 			}
 
 		default:
-			return EndDeviceTemplateValidationError{
+			return BatchCreateEndDevicesRequestValidationError{
 				field:  name,
 				reason: "invalid field path",
 			}
@@ -3060,9 +3091,10 @@ func (m *EndDeviceTemplate) ValidateFields(paths ...string) error {
 	return nil
 }
 
-// EndDeviceTemplateValidationError is the validation error returned by
-// EndDeviceTemplate.ValidateFields if the designated constraints aren't met.
-type EndDeviceTemplateValidationError struct {
+// BatchCreateEndDevicesRequestValidationError is the validation error returned
+// by BatchCreateEndDevicesRequest.ValidateFields if the designated
+// constraints aren't met.
+type BatchCreateEndDevicesRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3070,24 +3102,24 @@ type EndDeviceTemplateValidationError struct {
 }
 
 // Field function returns field value.
-func (e EndDeviceTemplateValidationError) Field() string { return e.field }
+func (e BatchCreateEndDevicesRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e EndDeviceTemplateValidationError) Reason() string { return e.reason }
+func (e BatchCreateEndDevicesRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e EndDeviceTemplateValidationError) Cause() error { return e.cause }
+func (e BatchCreateEndDevicesRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e EndDeviceTemplateValidationError) Key() bool { return e.key }
+func (e BatchCreateEndDevicesRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e EndDeviceTemplateValidationError) ErrorName() string {
-	return "EndDeviceTemplateValidationError"
+func (e BatchCreateEndDevicesRequestValidationError) ErrorName() string {
+	return "BatchCreateEndDevicesRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e EndDeviceTemplateValidationError) Error() string {
+func (e BatchCreateEndDevicesRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3099,14 +3131,14 @@ func (e EndDeviceTemplateValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sEndDeviceTemplate.%s: %s%s",
+		"invalid %sBatchCreateEndDevicesRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = EndDeviceTemplateValidationError{}
+var _ error = BatchCreateEndDevicesRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -3114,75 +3146,61 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = EndDeviceTemplateValidationError{}
+} = BatchCreateEndDevicesRequestValidationError{}
 
-// ValidateFields checks the field values on EndDeviceTemplateFormat with the
+// ValidateFields checks the field values on BatchGetEndDevicesRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
-func (m *EndDeviceTemplateFormat) ValidateFields(paths ...string) error {
+func (m *BatchGetEndDevicesRequest) ValidateFields(paths ...string) error {
 	if m == nil {
 		return nil
 	}
 
 	if len(paths) == 0 {
-		paths = EndDeviceTemplateFormatFieldPathsNested
+		paths = BatchGetEndDevicesRequestFieldPathsNested
 	}
 
 	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
 		_ = subs
 		switch name {
-		case "name":
-
-			if utf8.RuneCountInString(m.GetName()) > 100 {
-				return EndDeviceTemplateFormatValidationError{
-					field:  "name",
-					reason: "value length must be at most 100 runes",
-				}
-			}
-
-		case "description":
+		case "application_ids":
 
-			if utf8.RuneCountInString(m.GetDescription()) > 200 {
-				return EndDeviceTemplateFormatValidationError{
-					field:  "description",
-					reason: "value length must be at most 200 runes",
+			if v, ok := interface{}(&m.ApplicationIdentifiers).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return BatchGetEndDevicesRequestValidationError{
+						field:  "application_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
 				}
 			}
 
-		case "file_extensions":
+		case "requests":
 
-			if len(m.GetFileExtensions()) > 100 {
-				return EndDeviceTemplateFormatValidationError{
-					field:  "file_extensions",
-					reason: "value must contain no more than 100 item(s)",
+			if l := len(m.GetRequests()); l < 1 || l > 100 {
+				return BatchGetEndDevicesRequestValidationError{
+					field:  "requests",
+					reason: "value must contain between 1 and 100 items, inclusive",
 				}
 			}
 
-			_EndDeviceTemplateFormat_FileExtensions_Unique := make(map[string]struct{}, len(m.GetFileExtensions()))
-
-			for idx, item := range m.GetFileExtensions() {
+			for idx, item := range m.GetRequests() {
 				_, _ = idx, item
 
-				if _, exists := _EndDeviceTemplateFormat_FileExtensions_Unique[item]; exists {
-					return EndDeviceTemplateFormatValidationError{
-						field:  fmt.Sprintf("file_extensions[%v]", idx),
-						reason: "repeated value must contain unique items",
-					}
-				} else {
-					_EndDeviceTemplateFormat_FileExtensions_Unique[item] = struct{}{}
-				}
-
-				if !_EndDeviceTemplateFormat_FileExtensions_Pattern.MatchString(item) {
-					return EndDeviceTemplateFormatValidationError{
-						field:  fmt.Sprintf("file_extensions[%v]", idx),
-						reason: "value does not match regex pattern \"^(?:\\\\.[a-z0-9]{1,16}){1,2}$\"",
+				if v, ok := interface{}(item).(interface{ ValidateFields(...string) error }); ok {
+					if err := v.ValidateFields(subs...); err != nil {
+						return BatchGetEndDevicesRequestValidationError{
+							field:  fmt.Sprintf("requests[%v]", idx),
+							reason: "embedded message failed validation",
+							cause:  err,
+						}
 					}
 				}
 
 			}
 
 		default:
-			return EndDeviceTemplateFormatValidationError{
+			return BatchGetEndDevicesRequestValidationError{
 				field:  name,
 				reason: "invalid field path",
 			}
@@ -3191,9 +3209,10 @@ func (m *EndDeviceTemplateFormat) ValidateFields(paths ...string) error {
 	return nil
 }
 
-// EndDeviceTemplateFormatValidationError is the validation error returned by
-// EndDeviceTemplateFormat.ValidateFields if the designated constraints aren't met.
-type EndDeviceTemplateFormatValidationError struct {
+// BatchGetEndDevicesRequestValidationError is the validation error returned by
+// BatchGetEndDevicesRequest.ValidateFields if the designated constraints
+// aren't met.
+type BatchGetEndDevicesRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3201,24 +3220,24 @@ type EndDeviceTemplateFormatValidationError struct {
 }
 
 // Field function returns field value.
-func (e EndDeviceTemplateFormatValidationError) Field() string { return e.field }
+func (e BatchGetEndDevicesRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e EndDeviceTemplateFormatValidationError) Reason() string { return e.reason }
+func (e BatchGetEndDevicesRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e EndDeviceTemplateFormatValidationError) Cause() error { return e.cause }
+func (e BatchGetEndDevicesRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e EndDeviceTemplateFormatValidationError) Key() bool { return e.key }
+func (e BatchGetEndDevicesRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e EndDeviceTemplateFormatValidationError) ErrorName() string {
-	return "EndDeviceTemplateFormatValidationError"
+func (e BatchGetEndDevicesRequestValidationError) ErrorName() string {
+	return "BatchGetEndDevicesRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e EndDeviceTemplateFormatValidationError) Error() string {
+func (e BatchGetEndDevicesRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3230,14 +3249,14 @@ func (e EndDeviceTemplateFormatValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sEndDeviceTemplateFormat.%s: %s%s",
+		"invalid %sBatchGetEndDevicesRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = EndDeviceTemplateFormatValidationError{}
+var _ error = BatchGetEndDevicesRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -3245,48 +3264,51 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = EndDeviceTemplateFormatValidationError{}
-
-var _EndDeviceTemplateFormat_FileExtensions_Pattern = regexp.MustCompile("^(?:\\.[a-z0-9]{1,16}){1,2}$")
+} = BatchGetEndDevicesRequestValidationError{}
 
-// ValidateFields checks the field values on EndDeviceTemplateFormats with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, an error is returned.
-func (m *EndDeviceTemplateFormats) ValidateFields(paths ...string) error {
+// ValidateFields checks the field values on BatchUpdateEndDevicesRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, an error is returned.
+func (m *BatchUpdateEndDevicesRequest) ValidateFields(paths ...string) error {
 	if m == nil {
 		return nil
 	}
 
 	if len(paths) == 0 {
-		paths = EndDeviceTemplateFormatsFieldPathsNested
+		paths = BatchUpdateEndDevicesRequestFieldPathsNested
 	}
 
 	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
 		_ = subs
 		switch name {
-		case "formats":
-
-			for key, val := range m.GetFormats() {
-				_ = val
+		case "application_ids":
 
-				if utf8.RuneCountInString(key) > 36 {
-					return EndDeviceTemplateFormatsValidationError{
-						field:  fmt.Sprintf("formats[%v]", key),
-						reason: "value length must be at most 36 runes",
+			if v, ok := interface{}(&m.ApplicationIdentifiers).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return BatchUpdateEndDevicesRequestValidationError{
+						field:  "application_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
 					}
 				}
+			}
 
-				if !_EndDeviceTemplateFormats_Formats_Pattern.MatchString(key) {
-					return EndDeviceTemplateFormatsValidationError{
-						field:  fmt.Sprintf("formats[%v]", key),
-						reason: "value does not match regex pattern \"^[a-z0-9](?:[-]?[a-z0-9]){2,}$\"",
-					}
+		case "requests":
+
+			if l := len(m.GetRequests()); l < 1 || l > 100 {
+				return BatchUpdateEndDevicesRequestValidationError{
+					field:  "requests",
+					reason: "value must contain between 1 and 100 items, inclusive",
 				}
+			}
 
-				if v, ok := interface{}(val).(interface{ ValidateFields(...string) error }); ok {
+			for idx, item := range m.GetRequests() {
+				_, _ = idx, item
+
+				if v, ok := interface{}(item).(interface{ ValidateFields(...string) error }); ok {
 					if err := v.ValidateFields(subs...); err != nil {
-						return EndDeviceTemplateFormatsValidationError{
-							field:  fmt.Sprintf("formats[%v]", key),
+						return BatchUpdateEndDevicesRequestValidationError{
+							field:  fmt.Sprintf("requests[%v]", idx),
 							reason: "embedded message failed validation",
 							cause:  err,
 						}
@@ -3296,7 +3318,7 @@ func (m *EndDeviceTemplateFormats) ValidateFields(paths ...string) error {
 			}
 
 		default:
-			return EndDeviceTemplateFormatsValidationError{
+			return BatchUpdateEndDevicesRequestValidationError{
 				field:  name,
 				reason: "invalid field path",
 			}
@@ -3305,10 +3327,10 @@ func (m *EndDeviceTemplateFormats) ValidateFields(paths ...string) error {
 	return nil
 }
 
-// EndDeviceTemplateFormatsValidationError is the validation error returned by
-// EndDeviceTemplateFormats.ValidateFields if the designated constraints
-// aren't met.
-type EndDeviceTemplateFormatsValidationError struct {
+// BatchUpdateEndDevicesRequestValidationError is the validation error returned
+// by BatchUpdateEndDevicesRequest.ValidateFields if the designated
+// constraints aren't met.
+type BatchUpdateEndDevicesRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3316,24 +3338,24 @@ type EndDeviceTemplateFormatsValidationError struct {
 }
 
 // Field function returns field value.
-func (e EndDeviceTemplateFormatsValidationError) Field() string { return e.field }
+func (e BatchUpdateEndDevicesRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e EndDeviceTemplateFormatsValidationError) Reason() string { return e.reason }
+func (e BatchUpdateEndDevicesRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e EndDeviceTemplateFormatsValidationError) Cause() error { return e.cause }
+func (e BatchUpdateEndDevicesRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e EndDeviceTemplateFormatsValidationError) Key() bool { return e.key }
+func (e BatchUpdateEndDevicesRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e EndDeviceTemplateFormatsValidationError) ErrorName() string {
-	return "EndDeviceTemplateFormatsValidationError"
+func (e BatchUpdateEndDevicesRequestValidationError) ErrorName() string {
+	return "BatchUpdateEndDevicesRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e EndDeviceTemplateFormatsValidationError) Error() string {
+func (e BatchUpdateEndDevicesRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3345,14 +3367,14 @@ func (e EndDeviceTemplateFormatsValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sEndDeviceTemplateFormats.%s: %s%s",
+		"invalid %sBatchUpdateEndDevicesRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = EndDeviceTemplateFormatsValidationError{}
+var _ error = BatchUpdateEndDevicesRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -3360,45 +3382,61 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = EndDeviceTemplateFormatsValidationError{}
-
-var _EndDeviceTemplateFormats_Formats_Pattern = regexp.MustCompile("^[a-z0-9](?:[-]?[a-z0-9]){2,}$")
+} = BatchUpdateEndDevicesRequestValidationError{}
 
-// ValidateFields checks the field values on ConvertEndDeviceTemplateRequest
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, an error is returned.
-func (m *ConvertEndDeviceTemplateRequest) ValidateFields(paths ...string) error {
+// ValidateFields checks the field values on BatchSetEndDevicesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *BatchSetEndDevicesRequest) ValidateFields(paths ...string) error {
 	if m == nil {
 		return nil
 	}
 
 	if len(paths) == 0 {
-		paths = ConvertEndDeviceTemplateRequestFieldPathsNested
+		paths = BatchSetEndDevicesRequestFieldPathsNested
 	}
 
 	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
 		_ = subs
 		switch name {
-		case "format_id":
+		case "application_ids":
 
-			if utf8.RuneCountInString(m.GetFormatID()) > 36 {
-				return ConvertEndDeviceTemplateRequestValidationError{
-					field:  "format_id",
-					reason: "value length must be at most 36 runes",
+			if v, ok := interface{}(&m.ApplicationIdentifiers).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return BatchSetEndDevicesRequestValidationError{
+						field:  "application_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
 				}
 			}
 
-			if !_ConvertEndDeviceTemplateRequest_FormatID_Pattern.MatchString(m.GetFormatID()) {
-				return ConvertEndDeviceTemplateRequestValidationError{
-					field:  "format_id",
-					reason: "value does not match regex pattern \"^[a-z0-9](?:[-]?[a-z0-9]){2,}$\"",
+		case "requests":
+
+			if l := len(m.GetRequests()); l < 1 || l > 100 {
+				return BatchSetEndDevicesRequestValidationError{
+					field:  "requests",
+					reason: "value must contain between 1 and 100 items, inclusive",
 				}
 			}
 
-		case "data":
-			// no validation rules for Data
+			for idx, item := range m.GetRequests() {
+				_, _ = idx, item
+
+				if v, ok := interface{}(item).(interface{ ValidateFields(...string) error }); ok {
+					if err := v.ValidateFields(subs...); err != nil {
+						return BatchSetEndDevicesRequestValidationError{
+							field:  fmt.Sprintf("requests[%v]", idx),
+							reason: "embedded message failed validation",
+							cause:  err,
+						}
+					}
+				}
+
+			}
+
 		default:
-			return ConvertEndDeviceTemplateRequestValidationError{
+			return BatchSetEndDevicesRequestValidationError{
 				field:  name,
 				reason: "invalid field path",
 			}
@@ -3407,10 +3445,10 @@ func (m *ConvertEndDeviceTemplateRequest) ValidateFields(paths ...string) error
 	return nil
 }
 
-// ConvertEndDeviceTemplateRequestValidationError is the validation error
-// returned by ConvertEndDeviceTemplateRequest.ValidateFields if the
-// designated constraints aren't met.
-type ConvertEndDeviceTemplateRequestValidationError struct {
+// BatchSetEndDevicesRequestValidationError is the validation error returned by
+// BatchSetEndDevicesRequest.ValidateFields if the designated constraints
+// aren't met.
+type BatchSetEndDevicesRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3418,24 +3456,24 @@ type ConvertEndDeviceTemplateRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ConvertEndDeviceTemplateRequestValidationError) Field() string { return e.field }
+func (e BatchSetEndDevicesRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ConvertEndDeviceTemplateRequestValidationError) Reason() string { return e.reason }
+func (e BatchSetEndDevicesRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ConvertEndDeviceTemplateRequestValidationError) Cause() error { return e.cause }
+func (e BatchSetEndDevicesRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ConvertEndDeviceTemplateRequestValidationError) Key() bool { return e.key }
+func (e BatchSetEndDevicesRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ConvertEndDeviceTemplateRequestValidationError) ErrorName() string {
-	return "ConvertEndDeviceTemplateRequestValidationError"
+func (e BatchSetEndDevicesRequestValidationError) ErrorName() string {
+	return "BatchSetEndDevicesRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ConvertEndDeviceTemplateRequestValidationError) Error() string {
+func (e BatchSetEndDevicesRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3447,14 +3485,14 @@ func (e ConvertEndDeviceTemplateRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sConvertEndDeviceTemplateRequest.%s: %s%s",
+		"invalid %sBatchSetEndDevicesRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ConvertEndDeviceTemplateRequestValidationError{}
+var _ error = BatchSetEndDevicesRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -3462,30 +3500,1433 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ConvertEndDeviceTemplateRequestValidationError{}
-
-var _ConvertEndDeviceTemplateRequest_FormatID_Pattern = regexp.MustCompile("^[a-z0-9](?:[-]?[a-z0-9]){2,}$")
+} = BatchSetEndDevicesRequestValidationError{}
 
-// ValidateFields checks the field values on MACParameters_Channel with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, an error is returned.
-func (m *MACParameters_Channel) ValidateFields(paths ...string) error {
+// ValidateFields checks the field values on BatchDeleteEndDevicesRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, an error is returned.
+func (m *BatchDeleteEndDevicesRequest) ValidateFields(paths ...string) error {
 	if m == nil {
 		return nil
 	}
 
 	if len(paths) == 0 {
-		paths = MACParameters_ChannelFieldPathsNested
+		paths = BatchDeleteEndDevicesRequestFieldPathsNested
 	}
 
 	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
 		_ = subs
 		switch name {
-		case "uplink_frequency":
+		case "application_ids":
 
-			if m.GetUplinkFrequency() < 100000 {
-				return MACParameters_ChannelValidationError{
-					field:  "uplink_frequency",
+			if v, ok := interface{}(&m.ApplicationIdentifiers).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return BatchDeleteEndDevicesRequestValidationError{
+						field:  "application_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "end_device_ids":
+
+			if l := len(m.GetEndDeviceIDs()); l < 1 || l > 100 {
+				return BatchDeleteEndDevicesRequestValidationError{
+					field:  "end_device_ids",
+					reason: "value must contain between 1 and 100 items, inclusive",
+				}
+			}
+
+			for idx, item := range m.GetEndDeviceIDs() {
+				_, _ = idx, item
+
+				if v, ok := interface{}(item).(interface{ ValidateFields(...string) error }); ok {
+					if err := v.ValidateFields(subs...); err != nil {
+						return BatchDeleteEndDevicesRequestValidationError{
+							field:  fmt.Sprintf("end_device_ids[%v]", idx),
+							reason: "embedded message failed validation",
+							cause:  err,
+						}
+					}
+				}
+
+			}
+
+		default:
+			return BatchDeleteEndDevicesRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// BatchDeleteEndDevicesRequestValidationError is the validation error returned
+// by BatchDeleteEndDevicesRequest.ValidateFields if the designated
+// constraints aren't met.
+type BatchDeleteEndDevicesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BatchDeleteEndDevicesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BatchDeleteEndDevicesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BatchDeleteEndDevicesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BatchDeleteEndDevicesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BatchDeleteEndDevicesRequestValidationError) ErrorName() string {
+	return "BatchDeleteEndDevicesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BatchDeleteEndDevicesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBatchDeleteEndDevicesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BatchDeleteEndDevicesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BatchDeleteEndDevicesRequestValidationError{}
+
+// ValidateFields checks the field values on BatchEndDevicesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *BatchEndDevicesResponse) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = BatchEndDevicesResponseFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "results":
+
+			for idx, item := range m.Results {
+				_, _ = idx, item
+
+				if v, ok := interface{}(item).(interface{ ValidateFields(...string) error }); ok {
+					if err := v.ValidateFields(subs...); err != nil {
+						return BatchEndDevicesResponseValidationError{
+							field:  fmt.Sprintf("results[%v]", idx),
+							reason: "embedded message failed validation",
+							cause:  err,
+						}
+					}
+				}
+
+			}
+
+		default:
+			return BatchEndDevicesResponseValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// BatchEndDevicesResponseValidationError is the validation error returned by
+// BatchEndDevicesResponse.ValidateFields if the designated constraints aren't met.
+type BatchEndDevicesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BatchEndDevicesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BatchEndDevicesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BatchEndDevicesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BatchEndDevicesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BatchEndDevicesResponseValidationError) ErrorName() string {
+	return "BatchEndDevicesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BatchEndDevicesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBatchEndDevicesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BatchEndDevicesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BatchEndDevicesResponseValidationError{}
+
+// ValidateFields checks the field values on EndDeviceProfileIdentifiers with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, an error is returned.
+func (m *EndDeviceProfileIdentifiers) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = EndDeviceProfileIdentifiersFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "application_ids":
+
+			if v, ok := interface{}(m.GetApplicationIDs()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return EndDeviceProfileIdentifiersValidationError{
+						field:  "application_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "profile_id":
+
+			if utf8.RuneCountInString(m.GetProfileID()) > 36 {
+				return EndDeviceProfileIdentifiersValidationError{
+					field:  "profile_id",
+					reason: "value length must be at most 36 runes",
+				}
+			}
+
+			if !_EndDeviceProfileIdentifiers_ProfileID_Pattern.MatchString(m.GetProfileID()) {
+				return EndDeviceProfileIdentifiersValidationError{
+					field:  "profile_id",
+					reason: "value does not match regex pattern \"^[a-z0-9](?:[-]?[a-z0-9]){2,}$\"",
+				}
+			}
+
+		default:
+			return EndDeviceProfileIdentifiersValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// EndDeviceProfileIdentifiersValidationError is the validation error returned
+// by EndDeviceProfileIdentifiers.ValidateFields if the designated constraints
+// aren't met.
+type EndDeviceProfileIdentifiersValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EndDeviceProfileIdentifiersValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EndDeviceProfileIdentifiersValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EndDeviceProfileIdentifiersValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EndDeviceProfileIdentifiersValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EndDeviceProfileIdentifiersValidationError) ErrorName() string {
+	return "EndDeviceProfileIdentifiersValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e EndDeviceProfileIdentifiersValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEndDeviceProfileIdentifiers.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EndDeviceProfileIdentifiersValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EndDeviceProfileIdentifiersValidationError{}
+
+var _EndDeviceProfileIdentifiers_ProfileID_Pattern = regexp.MustCompile("^[a-z0-9](?:[-]?[a-z0-9]){2,}$")
+
+// ValidateFields checks the field values on EndDeviceProfile with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *EndDeviceProfile) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = EndDeviceProfileFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "ids":
+
+			if v, ok := interface{}(&m.EndDeviceProfileIdentifiers).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return EndDeviceProfileValidationError{
+						field:  "ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "created_at":
+
+			if v, ok := interface{}(&m.CreatedAt).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return EndDeviceProfileValidationError{
+						field:  "created_at",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "updated_at":
+
+			if v, ok := interface{}(&m.UpdatedAt).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return EndDeviceProfileValidationError{
+						field:  "updated_at",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "version":
+			// no validation rules for Version
+		case "name":
+
+			if utf8.RuneCountInString(m.GetName()) > 50 {
+				return EndDeviceProfileValidationError{
+					field:  "name",
+					reason: "value length must be at most 50 runes",
+				}
+			}
+
+		case "description":
+
+			if utf8.RuneCountInString(m.GetDescription()) > 2000 {
+				return EndDeviceProfileValidationError{
+					field:  "description",
+					reason: "value length must be at most 2000 runes",
+				}
+			}
+
+		case "mac_settings":
+
+			if v, ok := interface{}(m.GetMACSettings()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return EndDeviceProfileValidationError{
+						field:  "mac_settings",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "lorawan_version":
+
+			if _, ok := MACVersion_name[int32(m.GetLoRaWANVersion())]; !ok {
+				return EndDeviceProfileValidationError{
+					field:  "lorawan_version",
+					reason: "value must be one of the defined enum values",
+				}
+			}
+
+		case "lorawan_phy_version":
+
+			if _, ok := PHYVersion_name[int32(m.GetLoRaWANPHYVersion())]; !ok {
+				return EndDeviceProfileValidationError{
+					field:  "lorawan_phy_version",
+					reason: "value must be one of the defined enum values",
+				}
+			}
+
+		case "frequency_plan_id":
+
+			if utf8.RuneCountInString(m.GetFrequencyPlanID()) > 64 {
+				return EndDeviceProfileValidationError{
+					field:  "frequency_plan_id",
+					reason: "value length must be at most 64 runes",
+				}
+			}
+
+		default:
+			return EndDeviceProfileValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// EndDeviceProfileValidationError is the validation error returned by
+// EndDeviceProfile.ValidateFields if the designated constraints aren't met.
+type EndDeviceProfileValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EndDeviceProfileValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EndDeviceProfileValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EndDeviceProfileValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EndDeviceProfileValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EndDeviceProfileValidationError) ErrorName() string { return "EndDeviceProfileValidationError" }
+
+// Error satisfies the builtin error interface
+func (e EndDeviceProfileValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEndDeviceProfile.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EndDeviceProfileValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EndDeviceProfileValidationError{}
+
+// ValidateFields checks the field values on EndDeviceProfiles with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *EndDeviceProfiles) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = EndDeviceProfilesFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "profiles":
+
+			for idx, item := range m.GetProfiles() {
+				_, _ = idx, item
+
+				if v, ok := interface{}(item).(interface{ ValidateFields(...string) error }); ok {
+					if err := v.ValidateFields(subs...); err != nil {
+						return EndDeviceProfilesValidationError{
+							field:  fmt.Sprintf("profiles[%v]", idx),
+							reason: "embedded message failed validation",
+							cause:  err,
+						}
+					}
+				}
+
+			}
+
+		default:
+			return EndDeviceProfilesValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// EndDeviceProfilesValidationError is the validation error returned by
+// EndDeviceProfiles.ValidateFields if the designated constraints aren't met.
+type EndDeviceProfilesValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EndDeviceProfilesValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EndDeviceProfilesValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EndDeviceProfilesValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EndDeviceProfilesValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EndDeviceProfilesValidationError) ErrorName() string {
+	return "EndDeviceProfilesValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e EndDeviceProfilesValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEndDeviceProfiles.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EndDeviceProfilesValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EndDeviceProfilesValidationError{}
+
+// ValidateFields checks the field values on GetEndDeviceProfileRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, an error is returned.
+func (m *GetEndDeviceProfileRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = GetEndDeviceProfileRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "profile_ids":
+
+			if v, ok := interface{}(&m.EndDeviceProfileIdentifiers).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GetEndDeviceProfileRequestValidationError{
+						field:  "profile_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "field_mask":
+
+			if v, ok := interface{}(&m.FieldMask).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GetEndDeviceProfileRequestValidationError{
+						field:  "field_mask",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		default:
+			return GetEndDeviceProfileRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// GetEndDeviceProfileRequestValidationError is the validation error returned
+// by GetEndDeviceProfileRequest.ValidateFields if the designated constraints
+// aren't met.
+type GetEndDeviceProfileRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetEndDeviceProfileRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetEndDeviceProfileRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetEndDeviceProfileRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetEndDeviceProfileRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetEndDeviceProfileRequestValidationError) ErrorName() string {
+	return "GetEndDeviceProfileRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetEndDeviceProfileRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetEndDeviceProfileRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetEndDeviceProfileRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetEndDeviceProfileRequestValidationError{}
+
+// ValidateFields checks the field values on ListEndDeviceProfilesRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, an error is returned.
+func (m *ListEndDeviceProfilesRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = ListEndDeviceProfilesRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "application_ids":
+
+			if v, ok := interface{}(m.GetApplicationIDs()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ListEndDeviceProfilesRequestValidationError{
+						field:  "application_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "field_mask":
+
+			if v, ok := interface{}(&m.FieldMask).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ListEndDeviceProfilesRequestValidationError{
+						field:  "field_mask",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "limit":
+
+			if m.GetLimit() > 1000 {
+				return ListEndDeviceProfilesRequestValidationError{
+					field:  "limit",
+					reason: "value must be less than or equal to 1000",
+				}
+			}
+
+		case "page":
+			// no validation rules for Page
+		default:
+			return ListEndDeviceProfilesRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// ListEndDeviceProfilesRequestValidationError is the validation error returned
+// by ListEndDeviceProfilesRequest.ValidateFields if the designated
+// constraints aren't met.
+type ListEndDeviceProfilesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListEndDeviceProfilesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListEndDeviceProfilesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListEndDeviceProfilesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListEndDeviceProfilesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListEndDeviceProfilesRequestValidationError) ErrorName() string {
+	return "ListEndDeviceProfilesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListEndDeviceProfilesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListEndDeviceProfilesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListEndDeviceProfilesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListEndDeviceProfilesRequestValidationError{}
+
+// ValidateFields checks the field values on SetEndDeviceProfileRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, an error is returned.
+func (m *SetEndDeviceProfileRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = SetEndDeviceProfileRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "profile":
+
+			if v, ok := interface{}(&m.Profile).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return SetEndDeviceProfileRequestValidationError{
+						field:  "profile",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "field_mask":
+
+			if v, ok := interface{}(&m.FieldMask).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return SetEndDeviceProfileRequestValidationError{
+						field:  "field_mask",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		default:
+			return SetEndDeviceProfileRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// SetEndDeviceProfileRequestValidationError is the validation error returned
+// by SetEndDeviceProfileRequest.ValidateFields if the designated constraints
+// aren't met.
+type SetEndDeviceProfileRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetEndDeviceProfileRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetEndDeviceProfileRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetEndDeviceProfileRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetEndDeviceProfileRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetEndDeviceProfileRequestValidationError) ErrorName() string {
+	return "SetEndDeviceProfileRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetEndDeviceProfileRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetEndDeviceProfileRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetEndDeviceProfileRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetEndDeviceProfileRequestValidationError{}
+
+// ValidateFields checks the field values on EndDeviceTemplate with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *EndDeviceTemplate) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = EndDeviceTemplateFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "end_device":
+
+			if v, ok := interface{}(&m.EndDevice).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return EndDeviceTemplateValidationError{
+						field:  "end_device",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "field_mask":
+
+			if v, ok := interface{}(&m.FieldMask).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return EndDeviceTemplateValidationError{
+						field:  "field_mask",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "mapping_key":
+
+			if utf8.RuneCountInString(m.GetMappingKey()) > 100 {
+				return EndDeviceTemplateValidationError{
+					field:  "mapping_key",
+					reason: "value length must be at most 100 runes",
+				}
+			}
+
+		default:
+			return EndDeviceTemplateValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// EndDeviceTemplateValidationError is the validation error returned by
+// EndDeviceTemplate.ValidateFields if the designated constraints aren't met.
+type EndDeviceTemplateValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EndDeviceTemplateValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EndDeviceTemplateValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EndDeviceTemplateValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EndDeviceTemplateValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EndDeviceTemplateValidationError) ErrorName() string {
+	return "EndDeviceTemplateValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e EndDeviceTemplateValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEndDeviceTemplate.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EndDeviceTemplateValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EndDeviceTemplateValidationError{}
+
+// ValidateFields checks the field values on EndDeviceTemplateFormat with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *EndDeviceTemplateFormat) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = EndDeviceTemplateFormatFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "name":
+
+			if utf8.RuneCountInString(m.GetName()) > 100 {
+				return EndDeviceTemplateFormatValidationError{
+					field:  "name",
+					reason: "value length must be at most 100 runes",
+				}
+			}
+
+		case "description":
+
+			if utf8.RuneCountInString(m.GetDescription()) > 200 {
+				return EndDeviceTemplateFormatValidationError{
+					field:  "description",
+					reason: "value length must be at most 200 runes",
+				}
+			}
+
+		case "file_extensions":
+
+			if len(m.GetFileExtensions()) > 100 {
+				return EndDeviceTemplateFormatValidationError{
+					field:  "file_extensions",
+					reason: "value must contain no more than 100 item(s)",
+				}
+			}
+
+			_EndDeviceTemplateFormat_FileExtensions_Unique := make(map[string]struct{}, len(m.GetFileExtensions()))
+
+			for idx, item := range m.GetFileExtensions() {
+				_, _ = idx, item
+
+				if _, exists := _EndDeviceTemplateFormat_FileExtensions_Unique[item]; exists {
+					return EndDeviceTemplateFormatValidationError{
+						field:  fmt.Sprintf("file_extensions[%v]", idx),
+						reason: "repeated value must contain unique items",
+					}
+				} else {
+					_EndDeviceTemplateFormat_FileExtensions_Unique[item] = struct{}{}
+				}
+
+				if !_EndDeviceTemplateFormat_FileExtensions_Pattern.MatchString(item) {
+					return EndDeviceTemplateFormatValidationError{
+						field:  fmt.Sprintf("file_extensions[%v]", idx),
+						reason: "value does not match regex pattern \"^(?:\\\\.[a-z0-9]{1,16}){1,2}$\"",
+					}
+				}
+
+			}
+
+		default:
+			return EndDeviceTemplateFormatValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// EndDeviceTemplateFormatValidationError is the validation error returned by
+// EndDeviceTemplateFormat.ValidateFields if the designated constraints aren't met.
+type EndDeviceTemplateFormatValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EndDeviceTemplateFormatValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EndDeviceTemplateFormatValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EndDeviceTemplateFormatValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EndDeviceTemplateFormatValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EndDeviceTemplateFormatValidationError) ErrorName() string {
+	return "EndDeviceTemplateFormatValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e EndDeviceTemplateFormatValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEndDeviceTemplateFormat.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EndDeviceTemplateFormatValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EndDeviceTemplateFormatValidationError{}
+
+var _EndDeviceTemplateFormat_FileExtensions_Pattern = regexp.MustCompile("^(?:\\.[a-z0-9]{1,16}){1,2}$")
+
+// ValidateFields checks the field values on EndDeviceTemplateFormats with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *EndDeviceTemplateFormats) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = EndDeviceTemplateFormatsFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "formats":
+
+			for key, val := range m.GetFormats() {
+				_ = val
+
+				if utf8.RuneCountInString(key) > 36 {
+					return EndDeviceTemplateFormatsValidationError{
+						field:  fmt.Sprintf("formats[%v]", key),
+						reason: "value length must be at most 36 runes",
+					}
+				}
+
+				if !_EndDeviceTemplateFormats_Formats_Pattern.MatchString(key) {
+					return EndDeviceTemplateFormatsValidationError{
+						field:  fmt.Sprintf("formats[%v]", key),
+						reason: "value does not match regex pattern \"^[a-z0-9](?:[-]?[a-z0-9]){2,}$\"",
+					}
+				}
+
+				if v, ok := interface{}(val).(interface{ ValidateFields(...string) error }); ok {
+					if err := v.ValidateFields(subs...); err != nil {
+						return EndDeviceTemplateFormatsValidationError{
+							field:  fmt.Sprintf("formats[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						}
+					}
+				}
+
+			}
+
+		default:
+			return EndDeviceTemplateFormatsValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// EndDeviceTemplateFormatsValidationError is the validation error returned by
+// EndDeviceTemplateFormats.ValidateFields if the designated constraints
+// aren't met.
+type EndDeviceTemplateFormatsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EndDeviceTemplateFormatsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EndDeviceTemplateFormatsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EndDeviceTemplateFormatsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EndDeviceTemplateFormatsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EndDeviceTemplateFormatsValidationError) ErrorName() string {
+	return "EndDeviceTemplateFormatsValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e EndDeviceTemplateFormatsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEndDeviceTemplateFormats.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EndDeviceTemplateFormatsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EndDeviceTemplateFormatsValidationError{}
+
+var _EndDeviceTemplateFormats_Formats_Pattern = regexp.MustCompile("^[a-z0-9](?:[-]?[a-z0-9]){2,}$")
+
+// ValidateFields checks the field values on ConvertEndDeviceTemplateRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, an error is returned.
+func (m *ConvertEndDeviceTemplateRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = ConvertEndDeviceTemplateRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "format_id":
+
+			if utf8.RuneCountInString(m.GetFormatID()) > 36 {
+				return ConvertEndDeviceTemplateRequestValidationError{
+					field:  "format_id",
+					reason: "value length must be at most 36 runes",
+				}
+			}
+
+			if !_ConvertEndDeviceTemplateRequest_FormatID_Pattern.MatchString(m.GetFormatID()) {
+				return ConvertEndDeviceTemplateRequestValidationError{
+					field:  "format_id",
+					reason: "value does not match regex pattern \"^[a-z0-9](?:[-]?[a-z0-9]){2,}$\"",
+				}
+			}
+
+		case "data":
+			// no validation rules for Data
+		case "csv_options":
+
+			if v, ok := interface{}(m.GetCSVOptions()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ConvertEndDeviceTemplateRequestValidationError{
+						field:  "csv_options",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		default:
+			return ConvertEndDeviceTemplateRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// ConvertEndDeviceTemplateRequestValidationError is the validation error
+// returned by ConvertEndDeviceTemplateRequest.ValidateFields if the
+// designated constraints aren't met.
+type ConvertEndDeviceTemplateRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ConvertEndDeviceTemplateRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ConvertEndDeviceTemplateRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ConvertEndDeviceTemplateRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ConvertEndDeviceTemplateRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ConvertEndDeviceTemplateRequestValidationError) ErrorName() string {
+	return "ConvertEndDeviceTemplateRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ConvertEndDeviceTemplateRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sConvertEndDeviceTemplateRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ConvertEndDeviceTemplateRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ConvertEndDeviceTemplateRequestValidationError{}
+
+var _ConvertEndDeviceTemplateRequest_FormatID_Pattern = regexp.MustCompile("^[a-z0-9](?:[-]?[a-z0-9]){2,}$")
+
+// ValidateFields checks the field values on MACParameters_Channel with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *MACParameters_Channel) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = MACParameters_ChannelFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "uplink_frequency":
+
+			if m.GetUplinkFrequency() < 100000 {
+				return MACParameters_ChannelValidationError{
+					field:  "uplink_frequency",
 					reason: "value must be greater than or equal to 100000",
 				}
 			}
@@ -3698,3 +5139,214 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = MACState_JoinAcceptValidationError{}
+
+// ValidateFields checks the field values on BatchEndDevicesResponse_Result
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, an error is returned.
+func (m *BatchEndDevicesResponse_Result) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = BatchEndDevicesResponse_ResultFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "ids":
+
+			if v, ok := interface{}(&m.IDs).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return BatchEndDevicesResponse_ResultValidationError{
+						field:  "ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "end_device":
+
+			if v, ok := interface{}(m.GetEndDevice()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return BatchEndDevicesResponse_ResultValidationError{
+						field:  "end_device",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "error":
+
+			if v, ok := interface{}(m.GetError()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return BatchEndDevicesResponse_ResultValidationError{
+						field:  "error",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		default:
+			return BatchEndDevicesResponse_ResultValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// BatchEndDevicesResponse_ResultValidationError is the validation error
+// returned by BatchEndDevicesResponse_Result.ValidateFields if the designated
+// constraints aren't met.
+type BatchEndDevicesResponse_ResultValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BatchEndDevicesResponse_ResultValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BatchEndDevicesResponse_ResultValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BatchEndDevicesResponse_ResultValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BatchEndDevicesResponse_ResultValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BatchEndDevicesResponse_ResultValidationError) ErrorName() string {
+	return "BatchEndDevicesResponse_ResultValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BatchEndDevicesResponse_ResultValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBatchEndDevicesResponse_Result.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BatchEndDevicesResponse_ResultValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BatchEndDevicesResponse_ResultValidationError{}
+
+// ValidateFields checks the field values on
+// ConvertEndDeviceTemplateRequest_CSVOptions with the rules defined in the
+// proto definition for this message. If any rules are violated, an error is returned.
+func (m *ConvertEndDeviceTemplateRequest_CSVOptions) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = ConvertEndDeviceTemplateRequest_CSVOptionsFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "separator":
+
+			if utf8.RuneCountInString(m.GetSeparator()) > 4 {
+				return ConvertEndDeviceTemplateRequest_CSVOptionsValidationError{
+					field:  "separator",
+					reason: "value length must be at most 4 runes",
+				}
+			}
+
+		case "base64":
+			// no validation rules for Base64
+		default:
+			return ConvertEndDeviceTemplateRequest_CSVOptionsValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// ConvertEndDeviceTemplateRequest_CSVOptionsValidationError is the validation
+// error returned by ConvertEndDeviceTemplateRequest_CSVOptions.ValidateFields
+// if the designated constraints aren't met.
+type ConvertEndDeviceTemplateRequest_CSVOptionsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ConvertEndDeviceTemplateRequest_CSVOptionsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ConvertEndDeviceTemplateRequest_CSVOptionsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ConvertEndDeviceTemplateRequest_CSVOptionsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ConvertEndDeviceTemplateRequest_CSVOptionsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ConvertEndDeviceTemplateRequest_CSVOptionsValidationError) ErrorName() string {
+	return "ConvertEndDeviceTemplateRequest_CSVOptionsValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ConvertEndDeviceTemplateRequest_CSVOptionsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sConvertEndDeviceTemplateRequest_CSVOptions.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ConvertEndDeviceTemplateRequest_CSVOptionsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ConvertEndDeviceTemplateRequest_CSVOptionsValidationError{}
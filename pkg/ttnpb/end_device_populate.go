@@ -66,6 +66,27 @@ func NewPopulatedMACState(r randyEndDevice, easy bool) *MACState {
 	return out
 }
 
+func NewPopulatedEndDeviceProfileIdentifiers(r randyEndDevice, easy bool) *EndDeviceProfileIdentifiers {
+	out := &EndDeviceProfileIdentifiers{}
+	if r.Intn(2) == 0 {
+		out.ApplicationIDs = NewPopulatedApplicationIdentifiers(r, easy)
+	}
+	out.ProfileID = NewPopulatedID(r)
+	return out
+}
+
+func NewPopulatedEndDeviceProfile(r randyEndDevice, easy bool) *EndDeviceProfile {
+	out := &EndDeviceProfile{}
+	out.EndDeviceProfileIdentifiers = *NewPopulatedEndDeviceProfileIdentifiers(r, easy)
+	out.CreatedAt = *pbtypes.NewPopulatedStdTime(r, easy)
+	out.UpdatedAt = *pbtypes.NewPopulatedStdTime(r, easy)
+	out.Version = r.Uint32()
+	out.Name = randStringEndDevice(r)
+	out.Description = randStringEndDevice(r)
+	out.MACSettings = NewPopulatedMACSettings(r, easy)
+	return out
+}
+
 func NewPopulatedEndDevice(r randyEndDevice, easy bool) *EndDevice {
 	out := &EndDevice{}
 	out.EndDeviceIdentifiers = *NewPopulatedEndDeviceIdentifiers(r, easy)
@@ -38,49 +38,56 @@ func init() {
 }
 
 var fileDescriptor_36b7c5a531ab03ac = []byte{
-	// 659 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0x3f, 0x4c, 0xd4, 0x50,
-	0x18, 0x7f, 0x4f, 0xcd, 0x91, 0xd4, 0xa8, 0xf1, 0x25, 0x18, 0xad, 0xe6, 0x4b, 0x2c, 0x6a, 0x0c,
-	0x4a, 0x6b, 0x20, 0x1a, 0xc3, 0xe0, 0x00, 0x1c, 0x04, 0xe2, 0x60, 0x8c, 0x2c, 0x2e, 0x47, 0xef,
-	0xee, 0xbb, 0x5e, 0x73, 0x77, 0x7d, 0xb5, 0xfd, 0x80, 0x10, 0x42, 0x42, 0x9c, 0x70, 0x33, 0xba,
-	0xb8, 0x98, 0x18, 0x27, 0x36, 0xd9, 0x64, 0x64, 0x64, 0x24, 0x71, 0x61, 0xa4, 0xad, 0x03, 0x23,
-	0x23, 0x9b, 0xe6, 0xda, 0xde, 0x71, 0x5c, 0x3d, 0x04, 0xe3, 0xd4, 0xbe, 0xf7, 0x7e, 0xff, 0xbe,
-	0xef, 0xfd, 0x51, 0x1e, 0xd4, 0xa5, 0x67, 0x2e, 0x9a, 0xce, 0x90, 0x4f, 0x66, 0xa9, 0x66, 0x98,
-	0xae, 0x6d, 0xa0, 0x53, 0x2e, 0x94, 0x71, 0xc1, 0x2e, 0x61, 0xc1, 0x47, 0xaf, 0xf9, 0xf5, 0x75,
-	0xd7, 0x93, 0x24, 0xc5, 0x65, 0x22, 0x47, 0x4f, 0x09, 0xfa, 0xc2, 0x88, 0x3a, 0x64, 0xd9, 0x54,
-	0x9d, 0x2f, 0xea, 0x25, 0xd9, 0x30, 0x2c, 0x69, 0x49, 0x23, 0x86, 0x15, 0xe7, 0x2b, 0xf1, 0x28,
-	0x1e, 0xc4, 0x7f, 0x09, 0x5d, 0xbd, 0x65, 0x49, 0x69, 0xd5, 0x31, 0x36, 0x31, 0x1d, 0x47, 0x92,
-	0x49, 0xb6, 0x74, 0x52, 0x71, 0xf5, 0x66, 0xba, 0xda, 0xd6, 0xc0, 0x86, 0x4b, 0x4b, 0xe9, 0xa2,
-	0x76, 0x52, 0xcc, 0x14, 0x33, 0x90, 0xc5, 0xd8, 0x65, 0x74, 0xc8, 0xae, 0xd8, 0xe8, 0xa5, 0x2e,
-	0xc3, 0xdf, 0xfb, 0x94, 0xab, 0x79, 0xa7, 0x3c, 0x11, 0x13, 0x5f, 0xa2, 0x65, 0xfb, 0xe4, 0x2d,
-	0x89, 0xcf, 0x5c, 0xc9, 0x8d, 0x7b, 0x68, 0x12, 0x8a, 0x7b, 0xfa, 0xf1, 0x22, 0xf5, 0x64, 0xbe,
-	0x83, 0xf3, 0x66, 0x1e, 0x7d, 0x52, 0x6f, 0x74, 0xe3, 0xda, 0x08, 0xed, 0xc5, 0xdb, 0x1f, 0x3f,
-	0x3f, 0x9e, 0x9b, 0xd1, 0xf2, 0x86, 0xe9, 0xba, 0x75, 0xbb, 0x94, 0x94, 0x69, 0x2c, 0x77, 0x24,
-	0xb6, 0xcb, 0xbe, 0xde, 0xb1, 0x58, 0xc8, 0x8e, 0x57, 0x8c, 0x04, 0xea, 0x8f, 0xf2, 0x41, 0xf1,
-	0x8d, 0x2b, 0xe7, 0xa7, 0x90, 0xc4, 0x40, 0xb7, 0xe9, 0x14, 0xd2, 0x59, 0x92, 0x55, 0xe3, 0x64,
-	0x45, 0x31, 0xd7, 0x33, 0x59, 0xe1, 0x0c, 0xc9, 0x32, 0xbc, 0xf6, 0xef, 0x8a, 0x20, 0xa5, 0x7f,
-	0x0a, 0x69, 0xfa, 0xa8, 0xff, 0x93, 0xd2, 0xcb, 0xcf, 0x4e, 0xfb, 0xe2, 0xf1, 0x49, 0x25, 0x64,
-	0xf1, 0xad, 0xa2, 0xee, 0xf4, 0x2c, 0xaa, 0x83, 0x23, 0xde, 0x71, 0xe5, 0xc2, 0x73, 0xdb, 0x27,
-	0x71, 0xb7, 0x1b, 0xde, 0x9c, 0x6d, 0x53, 0xda, 0xaa, 0x6a, 0x4f, 0x55, 0x5f, 0x7b, 0x16, 0xf7,
-	0xea, 0xa9, 0x78, 0xd2, 0xd5, 0xab, 0x53, 0x36, 0x47, 0x6c, 0x72, 0x25, 0x37, 0xeb, 0x96, 0xff,
-	0x78, 0xa6, 0x92, 0xf9, 0xb3, 0xec, 0x5c, 0x2d, 0x4e, 0x83, 0xea, 0xdc, 0x7f, 0x39, 0x53, 0x19,
-	0xde, 0xd1, 0xce, 0x35, 0x8f, 0xdb, 0x07, 0xae, 0xe4, 0x26, 0xb0, 0x8e, 0x84, 0xe2, 0x54, 0x7d,
-	0x57, 0xaf, 0xe9, 0xc9, 0xe5, 0xd5, 0x5b, 0x97, 0x57, 0xcf, 0x37, 0x2f, 0xaf, 0x36, 0x13, 0xa7,
-	0x9e, 0x18, 0x1c, 0xfb, 0xb7, 0x1e, 0x1a, 0xcb, 0x47, 0xb9, 0x86, 0x7f, 0x71, 0x45, 0x6d, 0x9b,
-	0xbf, 0xc2, 0x86, 0x5b, 0x37, 0x09, 0xc7, 0xa5, 0xb3, 0x80, 0x1e, 0xa1, 0x27, 0x2a, 0xca, 0xc5,
-	0xe6, 0x1e, 0x4f, 0x4a, 0xaf, 0x61, 0x92, 0x2f, 0x7a, 0x24, 0x52, 0xef, 0xf7, 0xac, 0xa7, 0x25,
-	0x99, 0x2a, 0x68, 0xfd, 0x71, 0xf6, 0x2b, 0xe2, 0x92, 0x81, 0x65, 0x2a, 0x19, 0x95, 0x54, 0x78,
-	0x49, 0xe9, 0x4b, 0x4d, 0x85, 0x91, 0x79, 0x2a, 0x92, 0x85, 0x8c, 0x64, 0x6b, 0x7f, 0x6f, 0xff,
-	0xd5, 0x5c, 0xbb, 0x1e, 0xbb, 0x0a, 0x2d, 0x75, 0x2d, 0x25, 0x8a, 0xa3, 0x7c, 0xf0, 0x11, 0x1f,
-	0xfb, 0xca, 0xb7, 0x03, 0xe0, 0x3b, 0x01, 0xf0, 0xdd, 0x00, 0xd8, 0x5e, 0x00, 0x6c, 0x3f, 0x00,
-	0x76, 0x10, 0x00, 0x3b, 0x0c, 0x80, 0xaf, 0x86, 0xc0, 0xd7, 0x42, 0x60, 0xeb, 0x21, 0xf0, 0x8d,
-	0x10, 0xd8, 0x66, 0x08, 0x6c, 0x2b, 0x04, 0xb6, 0x1d, 0x02, 0xdf, 0x09, 0x81, 0xef, 0x86, 0xc0,
-	0xf6, 0x42, 0xe0, 0xfb, 0x21, 0xb0, 0x83, 0x10, 0xf8, 0x61, 0x08, 0x6c, 0x35, 0x02, 0xb6, 0x16,
-	0x01, 0x7f, 0x1f, 0x01, 0xfb, 0x14, 0x01, 0xff, 0x12, 0x01, 0x5b, 0x8f, 0x80, 0x6d, 0x44, 0xc0,
-	0x37, 0x23, 0xe0, 0x5b, 0x11, 0xf0, 0xd7, 0x0f, 0x2d, 0xa9, 0x53, 0x15, 0xa9, 0x6a, 0x3b, 0x96,
-	0xaf, 0x3b, 0x48, 0x8b, 0xd2, 0xab, 0x19, 0xc7, 0x1f, 0x5b, 0xb7, 0x66, 0x19, 0x44, 0x8e, 0x5b,
-	0x2c, 0xe6, 0xe2, 0x86, 0x8f, 0xfc, 0x0e, 0x00, 0x00, 0xff, 0xff, 0x6a, 0xe6, 0x95, 0x1f, 0x59,
-	0x06, 0x00, 0x00,
+	// 770 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x96, 0x3f, 0x4c, 0xd4, 0x50,
+	0x1c, 0xc7, 0xdf, 0x53, 0x73, 0x9a, 0x12, 0x34, 0xbe, 0x04, 0xa3, 0xd5, 0xbc, 0xc4, 0xe2, 0xdf,
+	0x13, 0x5a, 0x03, 0xd1, 0x18, 0x06, 0x63, 0x80, 0x03, 0x41, 0x63, 0x8c, 0x91, 0xc5, 0xe5, 0xe8,
+	0xb5, 0xef, 0x7a, 0xcd, 0xdd, 0xf5, 0xd5, 0xf6, 0x1d, 0x84, 0x10, 0x12, 0xe2, 0x84, 0x4e, 0x46,
+	0x17, 0x17, 0x13, 0xe3, 0x44, 0xe2, 0x20, 0x83, 0x03, 0x23, 0x23, 0x23, 0x89, 0x0b, 0x23, 0xd7,
+	0x3a, 0x30, 0x32, 0xb2, 0x69, 0xfa, 0xda, 0x2b, 0xe5, 0x7a, 0x87, 0x1c, 0x9a, 0x30, 0x5d, 0xfb,
+	0x7e, 0xff, 0x3e, 0xbf, 0xef, 0xfb, 0xfd, 0xee, 0x4e, 0xb8, 0x53, 0xa1, 0x8e, 0x3a, 0xab, 0x5a,
+	0xfd, 0x2e, 0x53, 0xb5, 0xb2, 0xa2, 0xda, 0xa6, 0x42, 0x2c, 0x3d, 0xaf, 0x93, 0x19, 0x53, 0x23,
+	0x79, 0x97, 0x38, 0xc1, 0xa7, 0x2b, 0xdb, 0x0e, 0x65, 0x14, 0x9d, 0x65, 0xcc, 0x92, 0xa3, 0x00,
+	0x79, 0x66, 0x50, 0xec, 0x37, 0x4c, 0x56, 0xaa, 0x15, 0x64, 0x8d, 0x56, 0x15, 0x83, 0x1a, 0x54,
+	0xe1, 0x6e, 0x85, 0x5a, 0x91, 0xbf, 0xf1, 0x17, 0xfe, 0x14, 0x86, 0x8b, 0x57, 0x0c, 0x4a, 0x8d,
+	0x0a, 0xe1, 0x45, 0x54, 0xcb, 0xa2, 0x4c, 0x65, 0x26, 0xb5, 0xa2, 0xe4, 0xe2, 0xe5, 0xc8, 0x1a,
+	0xe7, 0x20, 0x55, 0x9b, 0xcd, 0x45, 0x46, 0xe9, 0x20, 0xcc, 0xc8, 0xa7, 0x37, 0xed, 0x63, 0xea,
+	0xc4, 0x62, 0x66, 0xd1, 0x24, 0x4e, 0x54, 0x65, 0xe0, 0x5d, 0xb7, 0x70, 0x3e, 0x67, 0xe9, 0xa3,
+	0x3c, 0xf0, 0x05, 0x31, 0x4c, 0x97, 0x39, 0x73, 0xe8, 0x33, 0x14, 0x32, 0x23, 0x0e, 0x51, 0x19,
+	0x41, 0x37, 0xe4, 0xfd, 0x4d, 0xca, 0xe1, 0x79, 0x22, 0xe6, 0x75, 0x8d, 0xb8, 0x4c, 0xbc, 0xd4,
+	0xec, 0x17, 0x7b, 0x48, 0xcf, 0xdf, 0xfc, 0xfc, 0xf5, 0xf1, 0xc4, 0xa4, 0x94, 0x53, 0x54, 0xdb,
+	0xae, 0x98, 0x5a, 0xd8, 0xa6, 0x32, 0x9f, 0x20, 0x36, 0x75, 0x57, 0x4e, 0x18, 0xf3, 0xe9, 0xf7,
+	0x05, 0x25, 0x74, 0x75, 0x87, 0x60, 0x16, 0x7d, 0x87, 0xc2, 0xc9, 0x71, 0xc2, 0x50, 0x6f, 0x73,
+	0xd1, 0x71, 0xc2, 0x3a, 0x21, 0x2b, 0x71, 0xb2, 0x02, 0x9a, 0x6e, 0x4b, 0x96, 0xef, 0x80, 0x2c,
+	0x15, 0x17, 0x3f, 0x2e, 0x20, 0x26, 0xf4, 0x8c, 0x13, 0x36, 0xb1, 0xa7, 0xff, 0x18, 0x75, 0x72,
+	0x53, 0x13, 0x2e, 0xba, 0x77, 0x50, 0x0b, 0x69, 0xff, 0x46, 0x53, 0xd7, 0xda, 0x36, 0x95, 0x88,
+	0x41, 0x6f, 0xa1, 0x70, 0xea, 0xa9, 0xe9, 0x32, 0x74, 0xbd, 0xd9, 0x3d, 0x38, 0x8d, 0x43, 0xe2,
+	0xac, 0x62, 0xdb, 0xac, 0xae, 0xf4, 0x90, 0x6b, 0xf5, 0x00, 0xdd, 0x6f, 0xd2, 0xea, 0x90, 0xe2,
+	0xa0, 0x55, 0x28, 0x64, 0xa6, 0x6c, 0xbd, 0xe5, 0x4c, 0x85, 0xe7, 0x9d, 0xdc, 0x5c, 0x99, 0xd3,
+	0x10, 0x71, 0xfa, 0xbf, 0xcc, 0x54, 0x2a, 0x6e, 0xef, 0xe6, 0x82, 0x71, 0xfb, 0x00, 0x85, 0xcc,
+	0x28, 0xa9, 0x10, 0x46, 0xd0, 0xa1, 0x74, 0x17, 0x2f, 0xc8, 0xe1, 0xf2, 0xca, 0x8d, 0xe5, 0x95,
+	0x73, 0xc1, 0xf2, 0x4a, 0x93, 0x9c, 0x7a, 0x34, 0x3b, 0x7c, 0x34, 0x0d, 0x95, 0xf9, 0xc4, 0x44,
+	0xfd, 0x80, 0x42, 0xd7, 0xb0, 0xca, 0xb4, 0x52, 0xb4, 0xa8, 0x7d, 0xcd, 0x64, 0x09, 0x63, 0xfa,
+	0xa6, 0x6f, 0xb6, 0xf4, 0x4e, 0xfa, 0xb9, 0x36, 0xb5, 0x5c, 0x22, 0x3d, 0xe3, 0xc8, 0x8f, 0xa5,
+	0x91, 0x23, 0x22, 0x17, 0x82, 0xbc, 0x8a, 0xc6, 0x31, 0x02, 0x2d, 0xbf, 0x41, 0xe1, 0x0c, 0xaf,
+	0x15, 0xec, 0xef, 0xed, 0x96, 0x14, 0xc9, 0x0d, 0xe8, 0x1c, 0xf8, 0x09, 0x07, 0xce, 0x49, 0x8f,
+	0xfe, 0x09, 0xd8, 0x20, 0x2c, 0xa0, 0x8d, 0x45, 0x8e, 0x26, 0xb7, 0xb5, 0xc8, 0x4d, 0xe3, 0x7b,
+	0x5c, 0x22, 0xd7, 0x38, 0xc6, 0x3e, 0xec, 0x68, 0x6a, 0x5b, 0x63, 0x87, 0xc6, 0x63, 0xc7, 0xd6,
+	0x39, 0xc6, 0x10, 0xcc, 0x0e, 0xfc, 0x86, 0x82, 0x18, 0x97, 0x79, 0x49, 0xaa, 0x76, 0x45, 0x65,
+	0x64, 0x84, 0x5a, 0x33, 0xc4, 0x61, 0xc4, 0x41, 0x45, 0xa1, 0x2b, 0xf8, 0xda, 0x1a, 0xa3, 0x4e,
+	0x55, 0x65, 0x2e, 0x6a, 0xb3, 0x64, 0xe2, 0xad, 0xb6, 0x2b, 0xda, 0x48, 0x19, 0x65, 0x90, 0x7a,
+	0x38, 0xff, 0x39, 0xd4, 0xad, 0x10, 0x9d, 0x69, 0x4a, 0x31, 0x4a, 0x3c, 0x27, 0x9c, 0x8e, 0x8a,
+	0x22, 0x25, 0xf5, 0xeb, 0x17, 0x1a, 0x52, 0x29, 0x1b, 0xda, 0x5d, 0xfd, 0x6b, 0x71, 0xe9, 0x22,
+	0xaf, 0x8a, 0xa4, 0xa8, 0xaa, 0x16, 0x66, 0x1c, 0x82, 0xd9, 0xbb, 0x70, 0xf8, 0x2b, 0x5c, 0xaf,
+	0x63, 0xb8, 0x51, 0xc7, 0x70, 0xb3, 0x8e, 0xc1, 0x56, 0x1d, 0x83, 0xed, 0x3a, 0x06, 0x3b, 0x75,
+	0x0c, 0x76, 0xeb, 0x18, 0x2e, 0x7a, 0x18, 0x2e, 0x79, 0x18, 0x2c, 0x7b, 0x18, 0xae, 0x78, 0x18,
+	0xac, 0x7a, 0x18, 0xac, 0x79, 0x18, 0xac, 0x7b, 0x18, 0x6e, 0x78, 0x18, 0x6e, 0x7a, 0x18, 0x6c,
+	0x79, 0x18, 0x6e, 0x7b, 0x18, 0xec, 0x78, 0x18, 0xee, 0x7a, 0x18, 0x2c, 0xfa, 0x18, 0x2c, 0xf9,
+	0x18, 0xbe, 0xf7, 0x31, 0xf8, 0xe4, 0x63, 0xf8, 0xc5, 0xc7, 0x60, 0xd9, 0xc7, 0x60, 0xc5, 0xc7,
+	0x70, 0xd5, 0xc7, 0x70, 0xcd, 0xc7, 0xf0, 0x55, 0x9f, 0x41, 0x65, 0x56, 0x22, 0xac, 0x64, 0x5a,
+	0x86, 0x2b, 0x5b, 0x84, 0xcd, 0x52, 0xa7, 0xac, 0xec, 0xff, 0xff, 0x60, 0x97, 0x0d, 0x85, 0x31,
+	0xcb, 0x2e, 0x14, 0x32, 0x5c, 0xf0, 0xc1, 0x3f, 0x03, 0x00, 0x6e, 0xb8, 0xe6, 0x5d, 0x2c, 0x09,
+	0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -105,6 +112,18 @@ type EndDeviceRegistryClient interface {
 	List(ctx context.Context, in *ListEndDevicesRequest, opts ...grpc.CallOption) (*EndDevices, error)
 	Update(ctx context.Context, in *UpdateEndDeviceRequest, opts ...grpc.CallOption) (*EndDevice, error)
 	Delete(ctx context.Context, in *EndDeviceIdentifiers, opts ...grpc.CallOption) (*types.Empty, error)
+	// Create up to 100 end devices of an application.
+	// The results contain the created end device or the error for each request.
+	BatchCreate(ctx context.Context, in *BatchCreateEndDevicesRequest, opts ...grpc.CallOption) (*BatchEndDevicesResponse, error)
+	// Get up to 100 end devices of an application.
+	// The results contain the end device or the error for each request.
+	BatchGet(ctx context.Context, in *BatchGetEndDevicesRequest, opts ...grpc.CallOption) (*BatchEndDevicesResponse, error)
+	// Update up to 100 end devices of an application.
+	// The results contain the updated end device or the error for each request.
+	BatchUpdate(ctx context.Context, in *BatchUpdateEndDevicesRequest, opts ...grpc.CallOption) (*BatchEndDevicesResponse, error)
+	// Delete up to 100 end devices of an application.
+	// The results contain the error for each request that failed.
+	BatchDelete(ctx context.Context, in *BatchDeleteEndDevicesRequest, opts ...grpc.CallOption) (*BatchEndDevicesResponse, error)
 }
 
 type endDeviceRegistryClient struct {
@@ -169,6 +188,42 @@ func (c *endDeviceRegistryClient) Delete(ctx context.Context, in *EndDeviceIdent
 	return out, nil
 }
 
+func (c *endDeviceRegistryClient) BatchCreate(ctx context.Context, in *BatchCreateEndDevicesRequest, opts ...grpc.CallOption) (*BatchEndDevicesResponse, error) {
+	out := new(BatchEndDevicesResponse)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.EndDeviceRegistry/BatchCreate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *endDeviceRegistryClient) BatchGet(ctx context.Context, in *BatchGetEndDevicesRequest, opts ...grpc.CallOption) (*BatchEndDevicesResponse, error) {
+	out := new(BatchEndDevicesResponse)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.EndDeviceRegistry/BatchGet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *endDeviceRegistryClient) BatchUpdate(ctx context.Context, in *BatchUpdateEndDevicesRequest, opts ...grpc.CallOption) (*BatchEndDevicesResponse, error) {
+	out := new(BatchEndDevicesResponse)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.EndDeviceRegistry/BatchUpdate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *endDeviceRegistryClient) BatchDelete(ctx context.Context, in *BatchDeleteEndDevicesRequest, opts ...grpc.CallOption) (*BatchEndDevicesResponse, error) {
+	out := new(BatchEndDevicesResponse)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.EndDeviceRegistry/BatchDelete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // EndDeviceRegistryServer is the server API for EndDeviceRegistry service.
 type EndDeviceRegistryServer interface {
 	// Create a new end device within an application.
@@ -181,6 +236,18 @@ type EndDeviceRegistryServer interface {
 	List(context.Context, *ListEndDevicesRequest) (*EndDevices, error)
 	Update(context.Context, *UpdateEndDeviceRequest) (*EndDevice, error)
 	Delete(context.Context, *EndDeviceIdentifiers) (*types.Empty, error)
+	// Create up to 100 end devices of an application.
+	// The results contain the created end device or the error for each request.
+	BatchCreate(context.Context, *BatchCreateEndDevicesRequest) (*BatchEndDevicesResponse, error)
+	// Get up to 100 end devices of an application.
+	// The results contain the end device or the error for each request.
+	BatchGet(context.Context, *BatchGetEndDevicesRequest) (*BatchEndDevicesResponse, error)
+	// Update up to 100 end devices of an application.
+	// The results contain the updated end device or the error for each request.
+	BatchUpdate(context.Context, *BatchUpdateEndDevicesRequest) (*BatchEndDevicesResponse, error)
+	// Delete up to 100 end devices of an application.
+	// The results contain the error for each request that failed.
+	BatchDelete(context.Context, *BatchDeleteEndDevicesRequest) (*BatchEndDevicesResponse, error)
 }
 
 // UnimplementedEndDeviceRegistryServer can be embedded to have forward compatible implementations.
@@ -205,6 +272,18 @@ func (*UnimplementedEndDeviceRegistryServer) Update(ctx context.Context, req *Up
 func (*UnimplementedEndDeviceRegistryServer) Delete(ctx context.Context, req *EndDeviceIdentifiers) (*types.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
 }
+func (*UnimplementedEndDeviceRegistryServer) BatchCreate(ctx context.Context, req *BatchCreateEndDevicesRequest) (*BatchEndDevicesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchCreate not implemented")
+}
+func (*UnimplementedEndDeviceRegistryServer) BatchGet(ctx context.Context, req *BatchGetEndDevicesRequest) (*BatchEndDevicesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchGet not implemented")
+}
+func (*UnimplementedEndDeviceRegistryServer) BatchUpdate(ctx context.Context, req *BatchUpdateEndDevicesRequest) (*BatchEndDevicesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchUpdate not implemented")
+}
+func (*UnimplementedEndDeviceRegistryServer) BatchDelete(ctx context.Context, req *BatchDeleteEndDevicesRequest) (*BatchEndDevicesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchDelete not implemented")
+}
 
 func RegisterEndDeviceRegistryServer(s *grpc.Server, srv EndDeviceRegistryServer) {
 	s.RegisterService(&_EndDeviceRegistry_serviceDesc, srv)
@@ -318,6 +397,78 @@ func _EndDeviceRegistry_Delete_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _EndDeviceRegistry_BatchCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchCreateEndDevicesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EndDeviceRegistryServer).BatchCreate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.EndDeviceRegistry/BatchCreate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EndDeviceRegistryServer).BatchCreate(ctx, req.(*BatchCreateEndDevicesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EndDeviceRegistry_BatchGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchGetEndDevicesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EndDeviceRegistryServer).BatchGet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.EndDeviceRegistry/BatchGet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EndDeviceRegistryServer).BatchGet(ctx, req.(*BatchGetEndDevicesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EndDeviceRegistry_BatchUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchUpdateEndDevicesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EndDeviceRegistryServer).BatchUpdate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.EndDeviceRegistry/BatchUpdate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EndDeviceRegistryServer).BatchUpdate(ctx, req.(*BatchUpdateEndDevicesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EndDeviceRegistry_BatchDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchDeleteEndDevicesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EndDeviceRegistryServer).BatchDelete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.EndDeviceRegistry/BatchDelete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EndDeviceRegistryServer).BatchDelete(ctx, req.(*BatchDeleteEndDevicesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _EndDeviceRegistry_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ttn.lorawan.v3.EndDeviceRegistry",
 	HandlerType: (*EndDeviceRegistryServer)(nil),
@@ -346,6 +497,22 @@ var _EndDeviceRegistry_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Delete",
 			Handler:    _EndDeviceRegistry_Delete_Handler,
 		},
+		{
+			MethodName: "BatchCreate",
+			Handler:    _EndDeviceRegistry_BatchCreate_Handler,
+		},
+		{
+			MethodName: "BatchGet",
+			Handler:    _EndDeviceRegistry_BatchGet_Handler,
+		},
+		{
+			MethodName: "BatchUpdate",
+			Handler:    _EndDeviceRegistry_BatchUpdate_Handler,
+		},
+		{
+			MethodName: "BatchDelete",
+			Handler:    _EndDeviceRegistry_BatchDelete_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "lorawan-stack/api/end_device_services.proto",
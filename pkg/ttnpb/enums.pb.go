@@ -104,6 +104,7 @@ const (
 	ClusterRole_GATEWAY_CONFIGURATION_SERVER ClusterRole = 10
 	ClusterRole_QR_CODE_GENERATOR            ClusterRole = 11
 	ClusterRole_PACKET_BROKER_AGENT          ClusterRole = 12
+	ClusterRole_DEVICE_REPOSITORY            ClusterRole = 13
 )
 
 var ClusterRole_name = map[int32]string{
@@ -120,6 +121,7 @@ var ClusterRole_name = map[int32]string{
 	10: "GATEWAY_CONFIGURATION_SERVER",
 	11: "QR_CODE_GENERATOR",
 	12: "PACKET_BROKER_AGENT",
+	13: "DEVICE_REPOSITORY",
 }
 
 var ClusterRole_value = map[string]int32{
@@ -136,6 +138,7 @@ var ClusterRole_value = map[string]int32{
 	"GATEWAY_CONFIGURATION_SERVER": 10,
 	"QR_CODE_GENERATOR":            11,
 	"PACKET_BROKER_AGENT":          12,
+	"DEVICE_REPOSITORY":            13,
 }
 
 func (ClusterRole) EnumDescriptor() ([]byte, []int) {
@@ -157,46 +160,46 @@ func init() {
 }
 
 var fileDescriptor_e36318a1e2f407cb = []byte{
-	// 615 bytes of a gzipped FileDescriptorProto
+	// 624 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x93, 0xb1, 0x4f, 0x1b, 0x49,
-	0x14, 0xc6, 0x67, 0x8c, 0xe1, 0xb8, 0xe1, 0x0e, 0x96, 0x41, 0xc7, 0xe9, 0xd0, 0xf1, 0x94, 0x44,
-	0x4a, 0x11, 0x14, 0xec, 0x82, 0xbf, 0x60, 0xd9, 0x7d, 0x2c, 0x8b, 0xcd, 0xec, 0x32, 0x3b, 0x18,
-	0x39, 0xcd, 0xca, 0x46, 0x8e, 0x6d, 0xd9, 0xec, 0x5a, 0xf6, 0x38, 0xb4, 0x94, 0x94, 0x94, 0x29,
-	0xa3, 0xa4, 0x21, 0x1d, 0x25, 0x25, 0x25, 0x25, 0x25, 0x25, 0xde, 0x6d, 0x28, 0x29, 0x29, 0x23,
-	0x1b, 0x3b, 0x88, 0x82, 0x6e, 0xde, 0x6f, 0xbe, 0xf7, 0xde, 0xf7, 0x49, 0x33, 0x6c, 0xb5, 0x1d,
-	0x77, 0x2b, 0xc7, 0x95, 0x68, 0xbd, 0xa7, 0x2b, 0x87, 0xad, 0x7c, 0xa5, 0xd3, 0xcc, 0xd7, 0xa2,
-	0xfe, 0x51, 0x2f, 0xd7, 0xe9, 0xc6, 0x3a, 0xe6, 0xf3, 0x5a, 0x47, 0xb9, 0xb1, 0x24, 0xf7, 0x65,
-	0x63, 0x65, 0xbd, 0xde, 0xd4, 0x8d, 0x7e, 0x35, 0x77, 0x18, 0x1f, 0xe5, 0xeb, 0x71, 0x3d, 0xce,
-	0x8f, 0x64, 0xd5, 0xfe, 0xe7, 0x51, 0x35, 0x2a, 0x46, 0xa7, 0xa7, 0xf6, 0xb5, 0x33, 0xca, 0x96,
-	0xed, 0xf8, 0x38, 0x6a, 0x37, 0xa3, 0x96, 0x5f, 0xd1, 0x0d, 0x2b, 0x8e, 0x7a, 0xba, 0x5b, 0x69,
-	0x46, 0x9a, 0xbf, 0x65, 0xab, 0xb6, 0x77, 0x20, 0x8a, 0xae, 0x28, 0x84, 0xbe, 0xa9, 0xb6, 0x43,
-	0xcb, 0x13, 0x81, 0x92, 0xa6, 0x2b, 0x54, 0x28, 0x3c, 0x81, 0x06, 0xe1, 0x1f, 0xd8, 0xfb, 0x57,
-	0x25, 0xbe, 0xc4, 0x2d, 0x94, 0xa1, 0xa7, 0xb6, 0x51, 0x1a, 0x94, 0xbf, 0x63, 0xf0, 0xfa, 0x34,
-	0x2c, 0xa1, 0x34, 0x32, 0x2b, 0xd9, 0xd3, 0x1f, 0x40, 0xd6, 0xba, 0x6c, 0x3a, 0xd0, 0x15, 0x5d,
-	0xe3, 0x4b, 0x6c, 0x21, 0x50, 0xa6, 0xc2, 0x50, 0xe2, 0xde, 0x3e, 0x06, 0x0a, 0x6d, 0x83, 0x70,
-	0xce, 0xe6, 0x9f, 0xa0, 0xe9, 0xfb, 0xd2, 0x2b, 0xa1, 0x6d, 0xd0, 0x67, 0x26, 0x71, 0x07, 0xad,
-	0xa1, 0x2e, 0xc3, 0x17, 0xd9, 0xdf, 0x4f, 0x6c, 0xab, 0x68, 0x3a, 0x0e, 0xda, 0xc6, 0xd4, 0xf3,
-	0xbc, 0x60, 0x3f, 0xf0, 0x51, 0xd8, 0x68, 0x1b, 0xd9, 0xf1, 0xce, 0x9f, 0x19, 0x36, 0x67, 0xb5,
-	0xfb, 0x3d, 0x5d, 0xeb, 0xca, 0xb8, 0x5d, 0xe3, 0xb3, 0x2c, 0x3b, 0x8e, 0xb8, 0xc4, 0x16, 0x50,
-	0x28, 0x57, 0x95, 0x43, 0x89, 0x8e, 0x1b, 0x28, 0x59, 0x36, 0x28, 0x67, 0x6c, 0xc6, 0xb4, 0x2c,
-	0x0c, 0x02, 0x23, 0x33, 0x5c, 0xee, 0x98, 0x0a, 0x0f, 0xcc, 0x72, 0x18, 0xa0, 0x1c, 0x06, 0x99,
-	0x1a, 0x32, 0x81, 0xea, 0xc0, 0x93, 0x85, 0x09, 0xcb, 0xf2, 0x65, 0xc6, 0x4d, 0xdf, 0x2f, 0xba,
-	0x96, 0xa9, 0x5c, 0x4f, 0x4c, 0xf8, 0x34, 0x5f, 0x60, 0x73, 0x3b, 0x9e, 0xfb, 0x1b, 0xcc, 0x0c,
-	0x9d, 0x5b, 0xb2, 0xec, 0x2b, 0x6f, 0x82, 0xfe, 0xe0, 0xab, 0xec, 0x3f, 0x1b, 0x4b, 0xae, 0x85,
-	0xa1, 0xc2, 0x5d, 0xbf, 0x38, 0xcc, 0x60, 0x79, 0xa2, 0x84, 0x52, 0xa1, 0x34, 0x66, 0xf9, 0x0a,
-	0x5b, 0x1e, 0x5f, 0x5b, 0x45, 0xd3, 0xdd, 0x75, 0x85, 0x33, 0x69, 0xfd, 0x93, 0xbf, 0x61, 0xff,
-	0x4f, 0xec, 0x59, 0x9e, 0xd8, 0x72, 0x9d, 0x7d, 0xf9, 0xc2, 0x00, 0xe3, 0xff, 0xb0, 0xc5, 0x3d,
-	0x19, 0x5a, 0x9e, 0x8d, 0xa1, 0x83, 0x02, 0xa5, 0xa9, 0x3c, 0x69, 0xcc, 0xf1, 0x7f, 0xd9, 0x92,
-	0x6f, 0x5a, 0x05, 0x54, 0xe1, 0xa6, 0xf4, 0x0a, 0x28, 0x43, 0xd3, 0x41, 0xa1, 0x8c, 0xbf, 0x36,
-	0xbf, 0xd3, 0xeb, 0x01, 0xd0, 0x9b, 0x01, 0xd0, 0xdb, 0x01, 0x90, 0xbb, 0x01, 0x90, 0xfb, 0x01,
-	0x90, 0x87, 0x01, 0x90, 0xc7, 0x01, 0xd0, 0x93, 0x04, 0xe8, 0x69, 0x02, 0xe4, 0x3c, 0x01, 0x7a,
-	0x91, 0x00, 0xb9, 0x4c, 0x80, 0x5c, 0x25, 0x40, 0xae, 0x13, 0xa0, 0x37, 0x09, 0xd0, 0xdb, 0x04,
-	0xc8, 0x5d, 0x02, 0xf4, 0x3e, 0x01, 0xf2, 0x90, 0x00, 0x7d, 0x4c, 0x80, 0x9c, 0xa4, 0x40, 0x4e,
-	0x53, 0xa0, 0x67, 0x29, 0x90, 0xaf, 0x29, 0xd0, 0x6f, 0x29, 0x90, 0xf3, 0x14, 0xc8, 0x45, 0x0a,
-	0xf4, 0x32, 0x05, 0x7a, 0x95, 0x02, 0xfd, 0xf4, 0xb1, 0x1e, 0xe7, 0x74, 0xa3, 0xa6, 0x1b, 0xcd,
-	0xa8, 0xde, 0xcb, 0x45, 0x35, 0x7d, 0x1c, 0x77, 0x5b, 0xf9, 0x97, 0x9f, 0xa3, 0xd3, 0xaa, 0xe7,
-	0xb5, 0x8e, 0x3a, 0xd5, 0xea, 0xcc, 0xe8, 0x79, 0x6f, 0xfc, 0x0a, 0x00, 0x00, 0xff, 0xff, 0xc1,
-	0x9e, 0xe3, 0x11, 0x3e, 0x03, 0x00, 0x00,
+	0x14, 0xc6, 0x67, 0x8c, 0xe1, 0xb8, 0xe1, 0x80, 0x65, 0xd0, 0x71, 0x3a, 0x74, 0x3c, 0x5d, 0x22,
+	0xa5, 0x08, 0x0a, 0x76, 0xc1, 0x5f, 0xb0, 0xec, 0x3e, 0x96, 0xc5, 0x66, 0x67, 0x99, 0x1d, 0x8c,
+	0x9c, 0x66, 0x65, 0x23, 0xc7, 0xb6, 0x6c, 0x76, 0x2d, 0x7b, 0x1c, 0x5a, 0x4a, 0x4a, 0xca, 0x94,
+	0x51, 0xd2, 0x50, 0x52, 0x52, 0x52, 0x52, 0x52, 0x52, 0xe2, 0xdd, 0x86, 0x92, 0x92, 0x32, 0xb2,
+	0xb1, 0x83, 0x28, 0xe8, 0xe6, 0xfd, 0xe6, 0x7b, 0xef, 0x7d, 0x9f, 0x34, 0xc3, 0xd6, 0xda, 0x71,
+	0xb7, 0x72, 0x52, 0x89, 0x36, 0x7a, 0xba, 0x72, 0xd4, 0xca, 0x57, 0x3a, 0xcd, 0x7c, 0x2d, 0xea,
+	0x1f, 0xf7, 0x72, 0x9d, 0x6e, 0xac, 0x63, 0xbe, 0xa0, 0x75, 0x94, 0x1b, 0x4b, 0x72, 0x5f, 0x37,
+	0x57, 0x37, 0xea, 0x4d, 0xdd, 0xe8, 0x57, 0x73, 0x47, 0xf1, 0x71, 0xbe, 0x1e, 0xd7, 0xe3, 0xfc,
+	0x48, 0x56, 0xed, 0x7f, 0x19, 0x55, 0xa3, 0x62, 0x74, 0x7a, 0x6e, 0x5f, 0x3f, 0xa7, 0x6c, 0xc5,
+	0x8e, 0x4f, 0xa2, 0x76, 0x33, 0x6a, 0xf9, 0x15, 0xdd, 0xb0, 0xe2, 0xa8, 0xa7, 0xbb, 0x95, 0x66,
+	0xa4, 0xf9, 0x3b, 0xb6, 0x66, 0x8b, 0x43, 0xaf, 0xe8, 0x7a, 0x85, 0xd0, 0x37, 0xd5, 0x4e, 0x68,
+	0x09, 0x2f, 0x50, 0xd2, 0x74, 0x3d, 0x15, 0x7a, 0xc2, 0x43, 0x83, 0xf0, 0x8f, 0xec, 0xc3, 0x9b,
+	0x12, 0x5f, 0xe2, 0x36, 0xca, 0x50, 0xa8, 0x1d, 0x94, 0x06, 0xe5, 0xef, 0x19, 0xbc, 0x3d, 0x0d,
+	0x4b, 0x28, 0x8d, 0xcc, 0x6a, 0xf6, 0xec, 0x27, 0x90, 0xf5, 0x2e, 0x9b, 0x0e, 0x74, 0x45, 0xd7,
+	0xf8, 0x32, 0x5b, 0x0c, 0x94, 0xa9, 0x30, 0x94, 0xb8, 0x7f, 0x80, 0x81, 0x42, 0xdb, 0x20, 0x9c,
+	0xb3, 0x85, 0x67, 0x68, 0xfa, 0xbe, 0x14, 0x25, 0xb4, 0x0d, 0xfa, 0xc2, 0x24, 0xee, 0xa2, 0x35,
+	0xd4, 0x65, 0xf8, 0x12, 0x9b, 0x7f, 0x66, 0xdb, 0x45, 0xd3, 0x71, 0xd0, 0x36, 0xa6, 0x5e, 0xe6,
+	0x05, 0x07, 0x81, 0x8f, 0x9e, 0x8d, 0xb6, 0x91, 0x1d, 0xef, 0xbc, 0xce, 0xb0, 0x39, 0xab, 0xdd,
+	0xef, 0xe9, 0x5a, 0x57, 0xc6, 0xed, 0x1a, 0x9f, 0x65, 0xd9, 0x71, 0xc4, 0x65, 0xb6, 0x88, 0x9e,
+	0x72, 0x55, 0x39, 0x94, 0xe8, 0xb8, 0x81, 0x92, 0x65, 0x83, 0x72, 0xc6, 0x66, 0x4c, 0xcb, 0xc2,
+	0x20, 0x30, 0x32, 0xc3, 0xe5, 0x8e, 0xa9, 0xf0, 0xd0, 0x2c, 0x87, 0x01, 0xca, 0x61, 0x90, 0xa9,
+	0x21, 0xf3, 0x50, 0x1d, 0x0a, 0x59, 0x98, 0xb0, 0x2c, 0x5f, 0x61, 0xdc, 0xf4, 0xfd, 0xa2, 0x6b,
+	0x99, 0xca, 0x15, 0xde, 0x84, 0x4f, 0xf3, 0x45, 0x36, 0xb7, 0x2b, 0xdc, 0xdf, 0x60, 0x66, 0xe8,
+	0xdc, 0x92, 0x65, 0x5f, 0x89, 0x09, 0xfa, 0x83, 0xaf, 0xb1, 0x7f, 0x6d, 0x2c, 0xb9, 0x16, 0x86,
+	0x0a, 0xf7, 0xfc, 0xe2, 0x30, 0x83, 0x25, 0xbc, 0x12, 0x4a, 0x85, 0xd2, 0x98, 0xe5, 0xab, 0x6c,
+	0x65, 0x7c, 0x6d, 0x15, 0x4d, 0x77, 0xcf, 0xf5, 0x9c, 0x49, 0xeb, 0x9f, 0xfc, 0x7f, 0xf6, 0xdf,
+	0xc4, 0x9e, 0x25, 0xbc, 0x6d, 0xd7, 0x39, 0x90, 0xaf, 0x0c, 0x30, 0xfe, 0x37, 0x5b, 0xda, 0x97,
+	0xa1, 0x25, 0x6c, 0x0c, 0x1d, 0xf4, 0x50, 0x9a, 0x4a, 0x48, 0x63, 0x8e, 0xff, 0xc3, 0x96, 0x7d,
+	0xd3, 0x2a, 0xa0, 0x0a, 0xb7, 0xa4, 0x28, 0xa0, 0x0c, 0x4d, 0x07, 0x3d, 0x65, 0xfc, 0x35, 0xd4,
+	0x8f, 0xb7, 0x49, 0xf4, 0x45, 0xe0, 0x2a, 0x21, 0xcb, 0xc6, 0xfc, 0xd6, 0x0f, 0x7a, 0x33, 0x00,
+	0x7a, 0x3b, 0x00, 0x7a, 0x37, 0x00, 0x72, 0x3f, 0x00, 0xf2, 0x30, 0x00, 0xf2, 0x38, 0x00, 0xf2,
+	0x34, 0x00, 0x7a, 0x9a, 0x00, 0x3d, 0x4b, 0x80, 0x5c, 0x24, 0x40, 0x2f, 0x13, 0x20, 0x57, 0x09,
+	0x90, 0xeb, 0x04, 0xc8, 0x4d, 0x02, 0xf4, 0x36, 0x01, 0x7a, 0x97, 0x00, 0xb9, 0x4f, 0x80, 0x3e,
+	0x24, 0x40, 0x1e, 0x13, 0xa0, 0x4f, 0x09, 0x90, 0xd3, 0x14, 0xc8, 0x59, 0x0a, 0xf4, 0x3c, 0x05,
+	0xf2, 0x2d, 0x05, 0xfa, 0x3d, 0x05, 0x72, 0x91, 0x02, 0xb9, 0x4c, 0x81, 0x5e, 0xa5, 0x40, 0xaf,
+	0x53, 0xa0, 0x9f, 0x3f, 0xd5, 0xe3, 0x9c, 0x6e, 0xd4, 0x74, 0xa3, 0x19, 0xd5, 0x7b, 0xb9, 0xa8,
+	0xa6, 0x4f, 0xe2, 0x6e, 0x2b, 0xff, 0xfa, 0xcf, 0x74, 0x5a, 0xf5, 0xbc, 0xd6, 0x51, 0xa7, 0x5a,
+	0x9d, 0x19, 0xbd, 0xfa, 0xcd, 0x5f, 0x03, 0x00, 0xf7, 0x9e, 0xe8, 0x4e, 0x55, 0x03, 0x00, 0x00,
 }
 
 func (x DownlinkPathConstraint) String() string {
@@ -354,6 +354,7 @@ var AllowedFieldMaskPathsForRPC = map[string][]string{
 		"mac_state.pending_join_request.downlink_settings.opt_neg",
 		"mac_state.pending_join_request.downlink_settings.rx1_dr_offset",
 		"mac_state.pending_join_request.downlink_settings.rx2_dr",
+		"mac_state.pending_join_request.join_eui",
 		"mac_state.pending_join_request.net_id",
 		"mac_state.pending_join_request.payload",
 		"mac_state.pending_join_request.payload.Payload",
@@ -404,6 +405,11 @@ var AllowedFieldMaskPathsForRPC = map[string][]string{
 		"mac_state.pending_join_request.selected_mac_version",
 		"mac_state.pending_requests",
 		"mac_state.ping_slot_periodicity",
+		"mac_state.queued_force_rejoin_req",
+		"mac_state.queued_force_rejoin_req.data_rate_index",
+		"mac_state.queued_force_rejoin_req.max_retries",
+		"mac_state.queued_force_rejoin_req.period_exponent",
+		"mac_state.queued_force_rejoin_req.rejoin_type",
 		"mac_state.queued_join_accept",
 		"mac_state.queued_join_accept.keys",
 		"mac_state.queued_join_accept.keys.app_s_key",
@@ -427,6 +433,7 @@ var AllowedFieldMaskPathsForRPC = map[string][]string{
 		"mac_state.queued_join_accept.request.downlink_settings.opt_neg",
 		"mac_state.queued_join_accept.request.downlink_settings.rx1_dr_offset",
 		"mac_state.queued_join_accept.request.downlink_settings.rx2_dr",
+		"mac_state.queued_join_accept.request.join_eui",
 		"mac_state.queued_join_accept.request.net_id",
 		"mac_state.queued_join_accept.request.payload",
 		"mac_state.queued_join_accept.request.payload.Payload",
@@ -494,6 +501,10 @@ var AllowedFieldMaskPathsForRPC = map[string][]string{
 		"pending_session.last_f_cnt_up",
 		"pending_session.last_n_f_cnt_down",
 		"power_state",
+		"profile_ids",
+		"profile_ids.application_ids",
+		"profile_ids.application_ids.application_id",
+		"profile_ids.profile_id",
 		"queued_application_downlinks",
 		"recent_adr_uplinks",
 		"recent_downlinks",
@@ -594,9 +605,15 @@ var AllowedFieldMaskPathsForRPC = map[string][]string{
 		"mac_state.device_class",
 		"mac_state.lorawan_version",
 		"mac_state.ping_slot_periodicity",
+		"mac_state.queued_force_rejoin_req",
+		"mac_state.queued_force_rejoin_req.data_rate_index",
+		"mac_state.queued_force_rejoin_req.max_retries",
+		"mac_state.queued_force_rejoin_req.period_exponent",
+		"mac_state.queued_force_rejoin_req.rejoin_type",
 		"max_frequency",
 		"min_frequency",
 		"multicast",
+		"profile_ids",
 		"session.dev_addr",
 		"session.keys.f_nwk_s_int_key",
 		"session.keys.f_nwk_s_int_key.key",
@@ -619,6 +636,11 @@ var AllowedFieldMaskPathsForRPC = map[string][]string{
 		"version_ids.model_id",
 	},
 
+	// End device profiles:
+	"/ttn.lorawan.v3.NsEndDeviceProfileRegistry/Get":  EndDeviceProfileFieldPathsNested,
+	"/ttn.lorawan.v3.NsEndDeviceProfileRegistry/List": EndDeviceProfileFieldPathsNested,
+	"/ttn.lorawan.v3.NsEndDeviceProfileRegistry/Set":  EndDeviceProfileFieldPathsNested,
+
 	// Gateways:
 	"/ttn.lorawan.v3.EntityRegistrySearch/SearchGateways": GatewayFieldPathsNested,
 	"/ttn.lorawan.v3.GatewayRegistry/Get":                 GatewayFieldPathsNested,
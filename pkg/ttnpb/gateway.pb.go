@@ -780,7 +780,9 @@ type ListGatewaysRequest struct {
 	// Limit the number of results per page.
 	Limit uint32 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
 	// Page number for pagination. 0 is interpreted as 1.
-	Page                 uint32   `protobuf:"varint,5,opt,name=page,proto3" json:"page,omitempty"`
+	Page uint32 `protobuf:"varint,5,opt,name=page,proto3" json:"page,omitempty"`
+	// Only return recently deleted gateways. This is only allowed for admins.
+	Deleted              bool     `protobuf:"varint,6,opt,name=deleted,proto3" json:"deleted,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
@@ -852,6 +854,13 @@ func (m *ListGatewaysRequest) GetPage() uint32 {
 	return 0
 }
 
+func (m *ListGatewaysRequest) GetDeleted() bool {
+	if m != nil {
+		return m.Deleted
+	}
+	return false
+}
+
 type CreateGatewayRequest struct {
 	Gateway `protobuf:"bytes,1,opt,name=gateway,proto3,embedded=gateway" json:"gateway"`
 	// Collaborator to grant all rights on the newly created gateway.
@@ -1049,11 +1058,14 @@ func (m *GetGatewayAPIKeyRequest) GetKeyID() string {
 }
 
 type CreateGatewayAPIKeyRequest struct {
-	GatewayIdentifiers   `protobuf:"bytes,1,opt,name=gateway_ids,json=gatewayIds,proto3,embedded=gateway_ids" json:"gateway_ids"`
-	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Rights               []Right  `protobuf:"varint,3,rep,packed,name=rights,proto3,enum=ttn.lorawan.v3.Right" json:"rights,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	GatewayIdentifiers `protobuf:"bytes,1,opt,name=gateway_ids,json=gatewayIds,proto3,embedded=gateway_ids" json:"gateway_ids"`
+	Name               string  `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Rights             []Right `protobuf:"varint,3,rep,packed,name=rights,proto3,enum=ttn.lorawan.v3.Right" json:"rights,omitempty"`
+	// Time after which the API key is no longer valid. If not set, the API key does not expire.
+	ExpiresAt            *time.Time          `protobuf:"bytes,4,opt,name=expires_at,json=expiresAt,proto3,stdtime" json:"expires_at,omitempty"`
+	Restrictions         *APIKeyRestrictions `protobuf:"bytes,5,opt,name=restrictions,proto3" json:"restrictions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
 }
 
 func (m *CreateGatewayAPIKeyRequest) Reset()      { *m = CreateGatewayAPIKeyRequest{} }
@@ -1102,11 +1114,28 @@ func (m *CreateGatewayAPIKeyRequest) GetRights() []Right {
 	return nil
 }
 
+func (m *CreateGatewayAPIKeyRequest) GetExpiresAt() *time.Time {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return nil
+}
+
+func (m *CreateGatewayAPIKeyRequest) GetRestrictions() *APIKeyRestrictions {
+	if m != nil {
+		return m.Restrictions
+	}
+	return nil
+}
+
 type UpdateGatewayAPIKeyRequest struct {
-	GatewayIdentifiers   `protobuf:"bytes,1,opt,name=gateway_ids,json=gatewayIds,proto3,embedded=gateway_ids" json:"gateway_ids"`
-	APIKey               `protobuf:"bytes,2,opt,name=api_key,json=apiKey,proto3,embedded=api_key" json:"api_key"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	GatewayIdentifiers `protobuf:"bytes,1,opt,name=gateway_ids,json=gatewayIds,proto3,embedded=gateway_ids" json:"gateway_ids"`
+	APIKey             `protobuf:"bytes,2,opt,name=api_key,json=apiKey,proto3,embedded=api_key" json:"api_key"`
+	// The names of the API key fields that should be updated.
+	// If empty, only the name and rights are updated.
+	FieldMask            types.FieldMask `protobuf:"bytes,3,opt,name=field_mask,json=fieldMask,proto3" json:"field_mask"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
 
 func (m *UpdateGatewayAPIKeyRequest) Reset()      { *m = UpdateGatewayAPIKeyRequest{} }
@@ -1141,6 +1170,13 @@ func (m *UpdateGatewayAPIKeyRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_UpdateGatewayAPIKeyRequest proto.InternalMessageInfo
 
+func (m *UpdateGatewayAPIKeyRequest) GetFieldMask() types.FieldMask {
+	if m != nil {
+		return m.FieldMask
+	}
+	return types.FieldMask{}
+}
+
 type ListGatewayCollaboratorsRequest struct {
 	GatewayIdentifiers `protobuf:"bytes,1,opt,name=gateway_ids,json=gatewayIds,proto3,embedded=gateway_ids" json:"gateway_ids"`
 	// Limit the number of results per page.
@@ -1705,167 +1741,170 @@ func init() {
 }
 
 var fileDescriptor_1df6bae1ac946b39 = []byte{
-	// 2558 bytes of a gzipped FileDescriptorProto
+	// 2608 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x59, 0x4d, 0x6c, 0x1b, 0xc7,
-	0x15, 0xe6, 0x90, 0xa2, 0x44, 0x0d, 0xf5, 0x43, 0x4f, 0x54, 0x65, 0x4d, 0xdb, 0x4b, 0x85, 0x71,
-	0x12, 0xc9, 0x35, 0xa9, 0x96, 0x49, 0x8a, 0x56, 0x6d, 0xa2, 0x70, 0x29, 0x3b, 0x20, 0x62, 0x37,
-	0xee, 0xca, 0x4a, 0xd0, 0xd8, 0xf1, 0x62, 0xb4, 0x3b, 0x24, 0xb7, 0x5a, 0xee, 0xb2, 0xbb, 0xb3,
-	0xb2, 0x98, 0x38, 0x40, 0x50, 0x04, 0x68, 0x10, 0x14, 0x6d, 0xe0, 0x53, 0x50, 0xf4, 0x10, 0x14,
-	0x68, 0x11, 0xb4, 0x3d, 0x04, 0x3d, 0x14, 0x39, 0xf4, 0x90, 0x4b, 0x8b, 0x9c, 0x0a, 0x9f, 0x82,
-	0xa0, 0x05, 0x94, 0x88, 0xba, 0xa4, 0xb7, 0xa0, 0xa7, 0x40, 0xa7, 0x62, 0x66, 0x67, 0x97, 0x4b,
-	0xca, 0x52, 0xac, 0x38, 0x4e, 0x7b, 0xe2, 0xcc, 0x9b, 0xef, 0xfd, 0xcc, 0x7b, 0x6f, 0xdf, 0xcc,
-	0x1b, 0xc2, 0x82, 0xe5, 0xb8, 0xf8, 0x3a, 0xb6, 0x4b, 0x1e, 0xc5, 0xfa, 0xc6, 0x22, 0xee, 0x98,
-	0x8b, 0x4d, 0x4c, 0xc9, 0x75, 0xdc, 0x2d, 0x77, 0x5c, 0x87, 0x3a, 0x68, 0x8a, 0x52, 0xbb, 0x2c,
-	0x40, 0xe5, 0xcd, 0x47, 0xf3, 0xd5, 0xa6, 0x49, 0x5b, 0xfe, 0x7a, 0x59, 0x77, 0xda, 0x8b, 0xc4,
-	0xde, 0x74, 0xba, 0x1d, 0xd7, 0xd9, 0xea, 0x2e, 0x72, 0xb0, 0x5e, 0x6a, 0x12, 0xbb, 0xb4, 0x89,
-	0x2d, 0xd3, 0xc0, 0x94, 0x2c, 0xee, 0x1b, 0x04, 0x22, 0xf3, 0xa5, 0x98, 0x88, 0xa6, 0xd3, 0x74,
-	0x02, 0xe6, 0x75, 0xbf, 0xc1, 0x67, 0x7c, 0xc2, 0x47, 0x02, 0x2e, 0x37, 0x1d, 0xa7, 0x69, 0x91,
-	0x3e, 0xca, 0xf0, 0x5d, 0x4c, 0x4d, 0xc7, 0x16, 0xeb, 0x73, 0xc3, 0xeb, 0x0d, 0x93, 0x58, 0x86,
-	0xd6, 0xc6, 0xde, 0x86, 0x40, 0x9c, 0x1c, 0x46, 0x78, 0xd4, 0xf5, 0x75, 0x2a, 0x56, 0x0b, 0xc3,
-	0xab, 0xd4, 0x6c, 0x13, 0x8f, 0xe2, 0x76, 0x47, 0x00, 0x4e, 0xef, 0xf7, 0x91, 0xee, 0xd8, 0x14,
-	0xeb, 0x54, 0x33, 0xed, 0x46, 0x68, 0xe6, 0xa9, 0xfd, 0x28, 0x62, 0xfb, 0x6d, 0x4f, 0x2c, 0x3f,
-	0xb8, 0x7f, 0xd9, 0x34, 0x88, 0x4d, 0xcd, 0x86, 0x49, 0xdc, 0x10, 0x34, 0xb7, 0x1f, 0xd4, 0x26,
-	0x14, 0x1b, 0x98, 0xe2, 0xd0, 0x19, 0xfb, 0x11, 0xae, 0xd9, 0x6c, 0x51, 0x21, 0xa1, 0xb8, 0x01,
-	0x27, 0x9e, 0x0e, 0xe2, 0xa7, 0xb8, 0xd8, 0x36, 0xd0, 0x2c, 0x4c, 0x9a, 0x86, 0x04, 0xe6, 0xc0,
-	0xfc, 0xb8, 0x32, 0xda, 0xdb, 0x2e, 0x24, 0xeb, 0x2b, 0x6a, 0xd2, 0x34, 0x10, 0x82, 0x23, 0x36,
-	0x6e, 0x13, 0x29, 0xc9, 0x56, 0x54, 0x3e, 0x46, 0xc7, 0x61, 0xca, 0x77, 0x2d, 0x29, 0xc5, 0xc1,
-	0x63, 0xbd, 0xed, 0x42, 0x6a, 0x4d, 0xbd, 0xa0, 0x32, 0x1a, 0x9a, 0x81, 0x69, 0xcb, 0x69, 0x3a,
-	0x9e, 0x34, 0x32, 0x97, 0x9a, 0x1f, 0x57, 0x83, 0x49, 0xf1, 0x5d, 0x10, 0x69, 0xbb, 0xe8, 0x18,
-	0xc4, 0x42, 0x17, 0x61, 0x66, 0x9d, 0xa9, 0xd5, 0x22, 0x9d, 0x95, 0x3d, 0xe5, 0xb4, 0x5b, 0x94,
-	0x4e, 0x57, 0xe4, 0x6b, 0x57, 0x70, 0xe9, 0xa5, 0x6f, 0x95, 0xbe, 0xf7, 0xe2, 0xfc, 0xf2, 0xd2,
-	0x95, 0xd2, 0x8b, 0xcb, 0xe1, 0x74, 0xe1, 0xe5, 0xca, 0xd9, 0x57, 0x4e, 0xf7, 0xb6, 0x0b, 0x63,
-	0xdc, 0xe2, 0xfa, 0x8a, 0x3a, 0xc6, 0x65, 0xd4, 0x0d, 0xf4, 0x04, 0x37, 0x9e, 0x9b, 0xa8, 0x94,
-	0xee, 0x5c, 0xd0, 0xf0, 0x1e, 0x53, 0xfd, 0x3d, 0x16, 0x7f, 0x95, 0x84, 0xc7, 0x85, 0xc9, 0xcf,
-	0x11, 0xd7, 0x33, 0x1d, 0xbb, 0xde, 0x8f, 0xc2, 0x57, 0x6d, 0xff, 0x45, 0x98, 0x69, 0x33, 0xbf,
-	0x68, 0xd1, 0x2e, 0x8e, 0x22, 0x8e, 0xbb, 0x94, 0x89, 0xe3, 0x32, 0xea, 0x06, 0x5a, 0x80, 0xb9,
-	0x16, 0x76, 0x8d, 0xeb, 0xd8, 0x25, 0xda, 0x66, 0x60, 0xbc, 0xd8, 0xdb, 0x74, 0x48, 0x17, 0x7b,
-	0x62, 0xd0, 0x86, 0xe9, 0xb6, 0x07, 0xa0, 0x23, 0x01, 0x34, 0xa4, 0x0b, 0x68, 0xf1, 0x3f, 0xc9,
-	0x28, 0x88, 0x2a, 0x36, 0x4c, 0x07, 0xcd, 0xc2, 0x51, 0x62, 0xe3, 0x75, 0x8b, 0x70, 0x17, 0x64,
-	0x54, 0x31, 0x43, 0x27, 0xe0, 0xb8, 0xde, 0x32, 0x3b, 0x1a, 0xed, 0x76, 0xc2, 0xbc, 0xc9, 0x30,
-	0xc2, 0xe5, 0x6e, 0x87, 0xa0, 0x93, 0x70, 0xbc, 0xe1, 0x92, 0x9f, 0xfa, 0xc4, 0xd6, 0xbb, 0xdc,
-	0xa8, 0x11, 0xb5, 0x4f, 0x40, 0x8b, 0x30, 0xeb, 0x7a, 0x9e, 0xa9, 0x39, 0x8d, 0x86, 0x47, 0x28,
-	0xb7, 0x24, 0xa9, 0x4c, 0xf5, 0xb6, 0x0b, 0x50, 0x5d, 0x5d, 0xad, 0x3f, 0xcb, 0xa9, 0x2a, 0x64,
-	0x90, 0x60, 0x8c, 0x9e, 0x87, 0x39, 0xba, 0xa5, 0xe9, 0x8e, 0xdd, 0x30, 0x9b, 0xe2, 0x6b, 0x97,
-	0xd2, 0x73, 0x60, 0x3e, 0x5b, 0x39, 0x5b, 0x1e, 0x2c, 0x48, 0xe5, 0xb8, 0xed, 0xe5, 0xcb, 0x5b,
-	0xb5, 0x38, 0x8f, 0x3a, 0x4d, 0x07, 0x09, 0xf9, 0xd7, 0x00, 0x9c, 0x1e, 0x02, 0xa1, 0x07, 0xe1,
-	0x64, 0xdb, 0xb4, 0xb5, 0xbe, 0xfd, 0x80, 0xdb, 0x3f, 0xd1, 0x36, 0xed, 0xf3, 0xd1, 0x16, 0x18,
-	0x08, 0x6f, 0xc5, 0x40, 0x49, 0x01, 0xc2, 0x5b, 0x7d, 0xd0, 0x23, 0x70, 0xda, 0x76, 0xa8, 0xde,
-	0xd2, 0x86, 0x7d, 0x31, 0xc5, 0xc9, 0x11, 0xb0, 0xf8, 0x21, 0x80, 0x53, 0x83, 0x69, 0x88, 0x2e,
-	0xc2, 0x94, 0x69, 0x78, 0x5c, 0x77, 0xb6, 0xb2, 0x70, 0xc0, 0x2e, 0xf7, 0xe7, 0xac, 0x92, 0xdb,
-	0x53, 0xd2, 0x6f, 0x80, 0x64, 0x0e, 0x7c, 0xb0, 0x5d, 0x48, 0xdc, 0xda, 0x2e, 0x00, 0x95, 0xc9,
-	0x61, 0x51, 0xec, 0xb4, 0x1c, 0xea, 0x78, 0x52, 0x92, 0x7f, 0xb2, 0x62, 0x86, 0x1e, 0x83, 0xa3,
-	0x2e, 0x73, 0x95, 0x27, 0xa5, 0xe6, 0x52, 0xf3, 0xd9, 0xca, 0xc9, 0xc3, 0xfc, 0xa9, 0x0a, 0x2c,
-	0x7a, 0x00, 0x4e, 0xe8, 0x96, 0xa3, 0x6f, 0x68, 0x9e, 0xe3, 0xbb, 0x3a, 0x91, 0xc6, 0xe6, 0xc0,
-	0xfc, 0xa4, 0x9a, 0xe5, 0xb4, 0x55, 0x4e, 0x5a, 0x1a, 0x79, 0xef, 0xed, 0x42, 0xa2, 0xb8, 0x9b,
-	0x85, 0x63, 0x42, 0x02, 0x3a, 0x1f, 0xdf, 0x51, 0xf1, 0x00, 0x3d, 0x77, 0xb0, 0x95, 0x1a, 0x84,
-	0xba, 0x4b, 0x30, 0x25, 0x86, 0x86, 0x29, 0xf7, 0x7b, 0xb6, 0x92, 0x2f, 0x07, 0x55, 0xbb, 0x1c,
-	0x56, 0xed, 0xf2, 0xe5, 0xb0, 0x6a, 0x2b, 0x19, 0xc6, 0xfe, 0xe6, 0xc7, 0x05, 0xa0, 0x8e, 0x0b,
-	0xbe, 0x2a, 0x65, 0x42, 0xfc, 0x8e, 0x11, 0x0a, 0x49, 0x1d, 0x45, 0x88, 0xe0, 0xab, 0x52, 0x74,
-	0x42, 0x54, 0x94, 0x91, 0xa0, 0x44, 0xee, 0x29, 0x23, 0x6e, 0x52, 0xaa, 0x88, 0xf2, 0x79, 0x06,
-	0x66, 0x0d, 0xe2, 0xe9, 0xae, 0xd9, 0x89, 0xd2, 0x75, 0x5c, 0xc9, 0xec, 0x29, 0x69, 0x37, 0x25,
-	0xdd, 0x9a, 0x56, 0xe3, 0x8b, 0xc8, 0x87, 0x10, 0x53, 0xea, 0x9a, 0xeb, 0x3e, 0x25, 0x9e, 0x34,
-	0xca, 0x23, 0xf1, 0xc8, 0x01, 0x1e, 0x2a, 0x57, 0x23, 0xe4, 0x39, 0x9b, 0xba, 0x5d, 0xe5, 0xec,
-	0x9e, 0xb2, 0xf0, 0x6b, 0xf0, 0x70, 0xf1, 0x8e, 0x2a, 0x89, 0x1a, 0x53, 0x84, 0x9e, 0x84, 0x13,
-	0xf1, 0x93, 0x4b, 0x1a, 0xe3, 0x8a, 0x4f, 0x0c, 0x2b, 0xae, 0x05, 0x98, 0xba, 0xdd, 0x70, 0xd4,
-	0xac, 0xde, 0x9f, 0xa0, 0xab, 0x30, 0x2b, 0xaa, 0x89, 0xc6, 0x22, 0x9b, 0xb9, 0xfb, 0x5c, 0x85,
-	0x9b, 0x21, 0xca, 0x43, 0x7f, 0x03, 0x70, 0x56, 0x5c, 0x3e, 0x34, 0x8f, 0xb8, 0x9b, 0xc4, 0xd5,
-	0xb0, 0x61, 0xb8, 0xc4, 0xf3, 0xa4, 0x71, 0xee, 0xcc, 0x5f, 0x82, 0x3d, 0xe5, 0x0d, 0xe0, 0xfe,
-	0x1c, 0x54, 0x5e, 0x03, 0xd7, 0xe6, 0x97, 0x97, 0xd8, 0x86, 0x71, 0xe9, 0xa5, 0x6a, 0xe9, 0x05,
-	0xb6, 0xdf, 0x1b, 0xb1, 0x71, 0x7f, 0x78, 0xb5, 0xf4, 0xe2, 0x99, 0xd8, 0xc2, 0xc2, 0xd5, 0xf2,
-	0xc2, 0x19, 0xc6, 0x57, 0x2d, 0xbd, 0x20, 0xfc, 0x74, 0x23, 0x36, 0xee, 0x0f, 0x39, 0x5f, 0x7f,
-	0x61, 0x61, 0x7e, 0x79, 0x69, 0xe9, 0x0a, 0x1b, 0xbd, 0xfc, 0xed, 0xb3, 0x8f, 0xbf, 0xb2, 0xb0,
-	0x7c, 0xfa, 0xc6, 0xb5, 0xd3, 0xea, 0x8c, 0x30, 0x77, 0x95, 0x5b, 0x5b, 0x0d, 0x8c, 0x45, 0x05,
-	0x98, 0xc5, 0x3e, 0x75, 0xb4, 0x20, 0x6f, 0x24, 0xc8, 0xab, 0x28, 0x64, 0xa4, 0x35, 0x4e, 0x41,
-	0x0f, 0xc1, 0xa9, 0x60, 0x4d, 0xd3, 0x5b, 0xd8, 0xb6, 0x89, 0x25, 0x65, 0x79, 0x39, 0x9d, 0x0c,
-	0xa8, 0xb5, 0x80, 0x88, 0xce, 0xc3, 0x63, 0x51, 0x1d, 0xd1, 0x3a, 0x16, 0x66, 0x4e, 0x97, 0x26,
-	0xb8, 0x27, 0xf2, 0x41, 0xea, 0x3d, 0xd5, 0xdb, 0x2e, 0x4c, 0x47, 0x55, 0xe5, 0x92, 0x85, 0xed,
-	0xfa, 0x8a, 0x3a, 0xdd, 0x18, 0x20, 0x18, 0xe8, 0x39, 0x88, 0xf6, 0xc9, 0xf1, 0xa4, 0x19, 0x56,
-	0x16, 0x94, 0xf9, 0x3d, 0x25, 0x7d, 0x13, 0x24, 0x73, 0x99, 0x3d, 0x65, 0xfc, 0x26, 0x18, 0x2d,
-	0x86, 0x52, 0x73, 0x43, 0x52, 0x3d, 0x35, 0x37, 0x24, 0xd6, 0x43, 0x4f, 0xc1, 0x0c, 0xb6, 0x29,
-	0xb1, 0x6d, 0xec, 0x49, 0x93, 0x3c, 0x93, 0xe4, 0x03, 0x52, 0xa1, 0x1a, 0xc0, 0x94, 0x11, 0x16,
-	0x77, 0x35, 0xe2, 0x62, 0x45, 0xd5, 0xa3, 0x98, 0xfa, 0x9e, 0xd6, 0xf1, 0xd7, 0x2d, 0x53, 0x97,
-	0xa6, 0xb8, 0xaf, 0x26, 0x02, 0xe2, 0x25, 0x4e, 0x63, 0x45, 0xd5, 0x72, 0x74, 0x5e, 0xaa, 0x43,
-	0xd8, 0x34, 0x87, 0x4d, 0x85, 0x64, 0x01, 0x7c, 0x0c, 0xce, 0x7a, 0x7a, 0x8b, 0x18, 0xbe, 0x45,
-	0x34, 0xc3, 0xb9, 0x6e, 0x5b, 0xa6, 0xbd, 0xa1, 0x59, 0x2c, 0x04, 0x39, 0x8e, 0x9f, 0x09, 0x57,
-	0x57, 0xc4, 0xe2, 0x05, 0x16, 0x8c, 0xb3, 0x10, 0x11, 0xbb, 0xe1, 0xb8, 0x3a, 0xd1, 0x0c, 0x9f,
-	0x76, 0x35, 0xbd, 0xab, 0x5b, 0x44, 0x3a, 0xc6, 0x39, 0x72, 0x62, 0x65, 0xc5, 0xa7, 0xdd, 0x1a,
-	0xa3, 0xa3, 0x9f, 0x40, 0x29, 0x12, 0xdd, 0xc1, 0xb4, 0xc5, 0xce, 0x28, 0x8f, 0xba, 0xd8, 0xb4,
-	0xa9, 0x84, 0xe6, 0xc0, 0xfc, 0x54, 0xe5, 0xe1, 0x61, 0x1f, 0x84, 0xda, 0x2e, 0x61, 0xda, 0xaa,
-	0x45, 0x68, 0x5e, 0x19, 0x7e, 0xc6, 0xbe, 0x05, 0x75, 0xd6, 0xb8, 0x2d, 0x02, 0xfd, 0x38, 0xb6,
-	0x1f, 0x6c, 0x77, 0xd9, 0xb5, 0x54, 0x33, 0x88, 0x85, 0xbb, 0xd2, 0x7d, 0xfc, 0xc3, 0x3b, 0xbe,
-	0xaf, 0x7c, 0xad, 0x88, 0x23, 0x8d, 0x57, 0x2f, 0xf0, 0x16, 0xab, 0x5e, 0xd1, 0xa6, 0xab, 0x81,
-	0x84, 0x15, 0x26, 0x20, 0xff, 0x04, 0x9c, 0x1e, 0xaa, 0x2a, 0x28, 0x07, 0x53, 0x1b, 0x24, 0x38,
-	0xfb, 0xc6, 0x55, 0x36, 0x64, 0x97, 0xbe, 0x4d, 0x6c, 0xf9, 0xe1, 0x61, 0x1f, 0x4c, 0x96, 0x92,
-	0xdf, 0x05, 0xc5, 0x65, 0x98, 0x11, 0x91, 0xf5, 0xd0, 0xa3, 0x30, 0x23, 0xbe, 0x02, 0x56, 0xea,
-	0x59, 0x16, 0xdc, 0x7f, 0xd0, 0x91, 0x12, 0x01, 0x8b, 0x7f, 0x04, 0xf0, 0xd8, 0xd3, 0x84, 0x86,
-	0x0b, 0x2c, 0xb1, 0x3c, 0x8a, 0xd6, 0x60, 0x36, 0xfc, 0xfe, 0xef, 0xf6, 0xe0, 0x80, 0xcd, 0x10,
-	0xe5, 0xa1, 0x65, 0x08, 0xfb, 0x2d, 0xc1, 0x81, 0xe7, 0xc7, 0x79, 0x06, 0xb9, 0x88, 0xbd, 0x0d,
-	0x91, 0xa5, 0xe3, 0x8d, 0x90, 0x50, 0xec, 0xc2, 0x62, 0xdf, 0xd8, 0x98, 0xde, 0xf3, 0x8e, 0x7b,
-	0x6e, 0xad, 0x1e, 0x5a, 0xbf, 0x0a, 0x53, 0xc4, 0x37, 0xb9, 0xd5, 0x13, 0x4a, 0x95, 0xc9, 0xf8,
-	0xe7, 0x76, 0xa1, 0xd2, 0x74, 0xca, 0xb4, 0x45, 0x68, 0xcb, 0xb4, 0x9b, 0x5e, 0xd9, 0x26, 0xf4,
-	0xba, 0xe3, 0x6e, 0x2c, 0x0e, 0x5e, 0xe2, 0x3b, 0x1b, 0xcd, 0x45, 0x76, 0xa9, 0xf2, 0xca, 0xe7,
-	0xd6, 0xea, 0xdf, 0x79, 0x8c, 0x5d, 0xbc, 0x99, 0x58, 0x26, 0xad, 0xf8, 0x61, 0x12, 0xde, 0x77,
-	0xc1, 0xf4, 0x42, 0xe5, 0x5e, 0xa8, 0xec, 0x47, 0xac, 0x92, 0x5b, 0x16, 0x5e, 0x77, 0x5c, 0x4c,
-	0x1d, 0x57, 0xf8, 0xaa, 0x34, 0xec, 0xab, 0x67, 0xdd, 0x26, 0xb6, 0xcd, 0x97, 0x78, 0x52, 0x3c,
-	0xeb, 0xae, 0x79, 0xc4, 0x8d, 0x99, 0xaf, 0x0e, 0x88, 0xb8, 0x6b, 0x37, 0xa1, 0xeb, 0x30, 0xed,
-	0xb8, 0x06, 0x71, 0x45, 0x07, 0x81, 0xf7, 0x94, 0x6b, 0xee, 0x55, 0x35, 0x11, 0xc5, 0x42, 0x33,
-	0x0d, 0x35, 0x5b, 0x8a, 0x4f, 0xc2, 0x31, 0xf1, 0x4d, 0x75, 0xa2, 0x14, 0x9f, 0xf1, 0x23, 0x55,
-	0x4d, 0x97, 0xf8, 0x4f, 0xec, 0xf8, 0x57, 0xb3, 0xa5, 0xd8, 0x24, 0xd0, 0x87, 0x64, 0x98, 0xb6,
-	0xcc, 0xb6, 0x19, 0x5c, 0x2c, 0x27, 0xf9, 0x97, 0x75, 0x26, 0x25, 0x7d, 0x3a, 0xa6, 0x06, 0x64,
-	0xd6, 0x08, 0x74, 0x70, 0x93, 0xf0, 0x23, 0x79, 0x52, 0xe5, 0xe3, 0xe2, 0x5f, 0x01, 0x9c, 0xa9,
-	0x71, 0x49, 0x43, 0x49, 0x58, 0x83, 0x63, 0xc2, 0x10, 0xe1, 0xd4, 0x83, 0xd2, 0xf9, 0x36, 0x59,
-	0x17, 0x72, 0x22, 0x6d, 0x28, 0x3c, 0xc9, 0x2f, 0x11, 0x1e, 0x65, 0x22, 0x2e, 0x7f, 0x30, 0x58,
-	0xc5, 0xdf, 0x00, 0x38, 0x13, 0x9c, 0x26, 0xf7, 0xc2, 0xfc, 0xbb, 0xfe, 0x62, 0x7e, 0x0f, 0xe0,
-	0xf1, 0x58, 0xda, 0x56, 0x2f, 0xd5, 0x9f, 0x21, 0xfd, 0xe4, 0xbd, 0x47, 0xdf, 0x79, 0x94, 0x06,
-	0xc9, 0xc3, 0xd3, 0x20, 0x15, 0x4b, 0x83, 0x9b, 0x00, 0xde, 0xdf, 0xff, 0xb6, 0x03, 0x3b, 0xef,
-	0xb1, 0x99, 0x73, 0x70, 0x74, 0x83, 0x74, 0xfb, 0x3d, 0xe1, 0x78, 0x6f, 0xbb, 0x90, 0x7e, 0x86,
-	0x74, 0xeb, 0x2b, 0x6a, 0x7a, 0x83, 0x74, 0xeb, 0x46, 0xf1, 0x1f, 0x00, 0xe6, 0x07, 0x72, 0xf3,
-	0x6b, 0xb1, 0xeb, 0x44, 0xfc, 0x49, 0x60, 0xf8, 0x72, 0xfb, 0x03, 0x38, 0x1a, 0xbc, 0x33, 0xf0,
-	0xb6, 0x61, 0xaa, 0xf2, 0x8d, 0x61, 0x75, 0x2a, 0x5b, 0x55, 0x26, 0xf7, 0x14, 0x78, 0x13, 0x8c,
-	0x15, 0xc5, 0xc9, 0x26, 0x78, 0x8a, 0x7f, 0x01, 0x30, 0x3f, 0x90, 0xad, 0x5f, 0xcb, 0x86, 0xaa,
-	0x70, 0x0c, 0x77, 0x4c, 0x8d, 0x9d, 0x6a, 0x41, 0x0a, 0xcf, 0x0e, 0x8b, 0x0c, 0xcc, 0xb8, 0x8d,
-	0x98, 0x51, 0xdc, 0x31, 0x9f, 0x21, 0xdd, 0xe2, 0x9f, 0x00, 0x2c, 0xc4, 0xf2, 0xb8, 0x16, 0xfb,
-	0x04, 0xff, 0x1f, 0xb3, 0xf9, 0x5f, 0x00, 0x9e, 0xea, 0x67, 0x73, 0xdc, 0xda, 0x7b, 0x6c, 0xac,
-	0xfe, 0x55, 0xd4, 0xbb, 0xfd, 0x2a, 0x06, 0x6b, 0xde, 0xdf, 0x01, 0x3c, 0xb5, 0xfa, 0xbf, 0xd8,
-	0xdd, 0x0f, 0x6f, 0xbb, 0xbb, 0x93, 0xfb, 0xdb, 0xa6, 0x3e, 0xe6, 0xd0, 0xe2, 0xfd, 0xbb, 0x64,
-	0xd4, 0xfd, 0x8b, 0x9b, 0x31, 0x8b, 0x66, 0x13, 0x9b, 0x36, 0x37, 0x39, 0xa9, 0xf2, 0x31, 0x52,
-	0x60, 0x26, 0xbc, 0xe1, 0x0a, 0x95, 0xd2, 0xb0, 0xca, 0x0b, 0x62, 0x7d, 0x48, 0x5d, 0xc4, 0x87,
-	0x6e, 0x0c, 0x34, 0x9a, 0x41, 0xcb, 0x5f, 0x3e, 0xfc, 0x96, 0xfe, 0xd5, 0xf5, 0x9b, 0x77, 0x7b,
-	0xcd, 0xfc, 0x45, 0x1a, 0x4e, 0x0a, 0xdb, 0x56, 0x79, 0x47, 0x80, 0x9e, 0x82, 0x23, 0xec, 0x12,
-	0x2b, 0x22, 0x7b, 0x58, 0xff, 0xce, 0x22, 0xfa, 0x67, 0x90, 0xcc, 0x80, 0xa8, 0x8f, 0xe7, 0x9c,
-	0xa8, 0x0a, 0xc7, 0xd7, 0x1d, 0x87, 0x6a, 0x5c, 0xcc, 0x51, 0xde, 0x12, 0x32, 0x8c, 0x8d, 0x2d,
-	0x20, 0x1f, 0x66, 0x44, 0xd7, 0x1a, 0x7a, 0xf4, 0x9b, 0x07, 0x78, 0x34, 0xb0, 0xba, 0x2c, 0x3a,
-	0xe1, 0x2f, 0xe5, 0xce, 0x48, 0x15, 0x3a, 0x07, 0x8f, 0x89, 0xc6, 0x49, 0x0b, 0xc3, 0x1b, 0xbc,
-	0xc7, 0x1e, 0x92, 0x17, 0x6a, 0x4e, 0xb0, 0x84, 0x04, 0x8f, 0xbf, 0x08, 0x77, 0xa4, 0x34, 0xef,
-	0xfe, 0x82, 0x17, 0xe1, 0x4b, 0x6a, 0xd2, 0xec, 0x20, 0x17, 0x8e, 0xb5, 0x09, 0x75, 0x4d, 0x3d,
-	0x7c, 0x8f, 0x38, 0x73, 0xf8, 0xa6, 0x2e, 0x06, 0xe0, 0x2f, 0xb3, 0xa7, 0x50, 0x11, 0xeb, 0x1d,
-	0xb0, 0xb1, 0x89, 0x6d, 0x9d, 0x18, 0x92, 0x2e, 0x6e, 0x2b, 0xc3, 0xb1, 0x58, 0xe5, 0x6f, 0xf5,
-	0x6a, 0x04, 0xcc, 0x7f, 0x1f, 0x4e, 0x0e, 0x38, 0xf4, 0x28, 0x29, 0x95, 0x5f, 0x82, 0x13, 0x71,
-	0xc3, 0xbf, 0x88, 0x37, 0x19, 0x4f, 0xc7, 0x8f, 0x47, 0xe1, 0x6c, 0x54, 0x7c, 0x6c, 0x9b, 0xe8,
-	0xcc, 0xa1, 0xcc, 0x1b, 0x1e, 0xaa, 0xf1, 0x87, 0x15, 0x46, 0x0a, 0xde, 0x97, 0xbe, 0x38, 0x3f,
-	0x47, 0x78, 0x52, 0x65, 0x23, 0xae, 0x2a, 0x45, 0x79, 0x98, 0x09, 0xfe, 0x46, 0x71, 0xac, 0xf0,
-	0x7d, 0x35, 0x9c, 0xa3, 0xe7, 0xe1, 0xfd, 0x16, 0xf6, 0xa8, 0x26, 0xda, 0x65, 0x97, 0xe8, 0xc4,
-	0xdc, 0xbc, 0xd3, 0xb7, 0xac, 0x40, 0xd7, 0x0c, 0x13, 0x10, 0x04, 0x4f, 0x15, 0xec, 0x55, 0x8a,
-	0x9e, 0x84, 0xd9, 0x98, 0x60, 0x7e, 0x83, 0xce, 0x56, 0x4e, 0x1d, 0x1a, 0x7a, 0x15, 0xf6, 0x25,
-	0x45, 0x86, 0xf9, 0x1d, 0xde, 0x13, 0xc7, 0x0d, 0x4b, 0x1f, 0xc5, 0xb0, 0x35, 0xce, 0x1f, 0x33,
-	0xec, 0x01, 0x38, 0x21, 0x64, 0xea, 0x8e, 0x6f, 0x53, 0x69, 0x94, 0x3f, 0xa4, 0x66, 0x03, 0x5a,
-	0x8d, 0x91, 0xd0, 0x15, 0x78, 0x9c, 0xeb, 0x8e, 0x3a, 0xf2, 0xb8, 0xf6, 0xb1, 0x3b, 0xd4, 0x3e,
-	0xcb, 0x44, 0x84, 0x3d, 0x7a, 0x4c, 0xff, 0x43, 0x70, 0x2a, 0x92, 0x1b, 0x58, 0x90, 0xe1, 0x16,
-	0x4c, 0x86, 0xd4, 0xc0, 0x06, 0x0d, 0xe6, 0x5c, 0xc7, 0xb7, 0x0d, 0x8d, 0xba, 0x66, 0x87, 0x57,
-	0x95, 0xe0, 0xb5, 0x2a, 0x5b, 0x79, 0xfc, 0x00, 0x27, 0x0e, 0xe5, 0x4e, 0x59, 0x65, 0xec, 0x97,
-	0x5d, 0xb3, 0xc3, 0x2d, 0x53, 0xa7, 0xdc, 0x81, 0x79, 0xfe, 0xdf, 0x00, 0x4e, 0x0d, 0x42, 0xd0,
-	0x13, 0x30, 0xd5, 0x16, 0x67, 0xc5, 0xa1, 0xaf, 0x00, 0xac, 0x06, 0xfe, 0x21, 0xac, 0x81, 0xfc,
-	0x35, 0x80, 0xf1, 0x71, 0x76, 0xbc, 0x25, 0x8a, 0xdf, 0x11, 0xd9, 0xf1, 0x16, 0xaa, 0xc1, 0xd1,
-	0x36, 0x31, 0x4c, 0x6c, 0x8b, 0xcc, 0x3b, 0x92, 0x04, 0xc1, 0xca, 0xbe, 0xb2, 0xc0, 0xa9, 0xbc,
-	0x65, 0x53, 0x83, 0x89, 0xf2, 0x5b, 0xf0, 0xc1, 0x8e, 0x0c, 0x6e, 0xed, 0xc8, 0xe0, 0xa3, 0x1d,
-	0x39, 0xf1, 0xc9, 0x8e, 0x9c, 0xf8, 0x74, 0x47, 0x4e, 0x7c, 0xb6, 0x23, 0x27, 0x3e, 0xdf, 0x91,
-	0xc1, 0xab, 0x3d, 0x19, 0xbc, 0xde, 0x93, 0x13, 0xef, 0xf4, 0x64, 0xf0, 0x6e, 0x4f, 0x4e, 0xbc,
-	0xd7, 0x93, 0x13, 0xef, 0xf7, 0xe4, 0xc4, 0x07, 0x3d, 0x19, 0xdc, 0xea, 0xc9, 0xe0, 0xa3, 0x9e,
-	0x9c, 0xf8, 0xa4, 0x27, 0x83, 0x4f, 0x7b, 0x72, 0xe2, 0xb3, 0x9e, 0x0c, 0x3e, 0xef, 0xc9, 0x89,
-	0x57, 0x77, 0xe5, 0xc4, 0xeb, 0xbb, 0x32, 0x78, 0x73, 0x57, 0x4e, 0xbc, 0xb5, 0x2b, 0x83, 0xb7,
-	0x77, 0xe5, 0xc4, 0x3b, 0xbb, 0x72, 0xe2, 0xdd, 0x5d, 0x19, 0xbc, 0xb7, 0x2b, 0x83, 0xf7, 0x77,
-	0x65, 0xf0, 0xc2, 0xd9, 0x3b, 0x6d, 0xd2, 0xa9, 0xdd, 0x59, 0x5f, 0x1f, 0xe5, 0xfb, 0x7c, 0xf4,
-	0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x6d, 0xd7, 0xc7, 0x63, 0x3b, 0x1d, 0x00, 0x00,
+	0x15, 0xe6, 0x90, 0xa2, 0x48, 0x0d, 0x29, 0x4a, 0x9e, 0xa8, 0xca, 0x5a, 0xb6, 0x97, 0x0a, 0xe3,
+	0x24, 0x92, 0x6b, 0x52, 0x2d, 0x93, 0x14, 0xad, 0xda, 0x44, 0xe1, 0x4a, 0xb6, 0x41, 0xc4, 0x6e,
+	0xdc, 0x95, 0x95, 0xa0, 0xb1, 0xe3, 0xc5, 0x68, 0x77, 0x48, 0x6e, 0xb5, 0xdc, 0x65, 0x77, 0x87,
+	0x92, 0x98, 0x38, 0x40, 0x50, 0x04, 0x68, 0x10, 0x14, 0x6d, 0xe0, 0x53, 0x50, 0xf4, 0x10, 0x14,
+	0x68, 0x11, 0xb4, 0x3d, 0x04, 0x3d, 0xe5, 0xd0, 0x43, 0x2e, 0x2d, 0x72, 0xf4, 0xa9, 0x0d, 0x5a,
+	0x40, 0x89, 0xa8, 0x4b, 0x7a, 0x0b, 0x7a, 0x69, 0xa0, 0x53, 0x31, 0xb3, 0xb3, 0xcb, 0x25, 0xf5,
+	0x53, 0xc9, 0x3f, 0x69, 0x4f, 0xdc, 0x79, 0xf3, 0xbd, 0x9f, 0x79, 0xef, 0xcd, 0x9b, 0x99, 0x47,
+	0x98, 0xb7, 0x1c, 0x17, 0x6f, 0x60, 0xbb, 0xe8, 0x51, 0xac, 0xaf, 0xcd, 0xe1, 0x96, 0x39, 0x57,
+	0xc7, 0x94, 0x6c, 0xe0, 0x4e, 0xa9, 0xe5, 0x3a, 0xd4, 0x41, 0x39, 0x4a, 0xed, 0x92, 0x00, 0x95,
+	0xd6, 0x9f, 0x9c, 0xaa, 0xd4, 0x4d, 0xda, 0x68, 0xaf, 0x96, 0x74, 0xa7, 0x39, 0x47, 0xec, 0x75,
+	0xa7, 0xd3, 0x72, 0x9d, 0xcd, 0xce, 0x1c, 0x07, 0xeb, 0xc5, 0x3a, 0xb1, 0x8b, 0xeb, 0xd8, 0x32,
+	0x0d, 0x4c, 0xc9, 0xdc, 0x9e, 0x0f, 0x5f, 0xe4, 0x54, 0x31, 0x22, 0xa2, 0xee, 0xd4, 0x1d, 0x9f,
+	0x79, 0xb5, 0x5d, 0xe3, 0x23, 0x3e, 0xe0, 0x5f, 0x02, 0x2e, 0xd7, 0x1d, 0xa7, 0x6e, 0x91, 0x1e,
+	0xca, 0x68, 0xbb, 0x98, 0x9a, 0x8e, 0x2d, 0xe6, 0xa7, 0x07, 0xe7, 0x6b, 0x26, 0xb1, 0x0c, 0xad,
+	0x89, 0xbd, 0x35, 0x81, 0x38, 0x3d, 0x88, 0xf0, 0xa8, 0xdb, 0xd6, 0xa9, 0x98, 0xcd, 0x0f, 0xce,
+	0x52, 0xb3, 0x49, 0x3c, 0x8a, 0x9b, 0x2d, 0x01, 0x38, 0xbb, 0xd7, 0x47, 0xba, 0x63, 0x53, 0xac,
+	0x53, 0xcd, 0xb4, 0x6b, 0x81, 0x99, 0x67, 0xf6, 0xa2, 0x88, 0xdd, 0x6e, 0x7a, 0x62, 0xfa, 0xd1,
+	0xbd, 0xd3, 0xa6, 0x41, 0x6c, 0x6a, 0xd6, 0x4c, 0xe2, 0x06, 0xa0, 0xe9, 0xbd, 0xa0, 0x26, 0xa1,
+	0xd8, 0xc0, 0x14, 0x07, 0xce, 0xd8, 0x8b, 0x70, 0xcd, 0x7a, 0x83, 0x0a, 0x09, 0x85, 0x35, 0x98,
+	0xbd, 0xe4, 0xc7, 0x4f, 0x71, 0xb1, 0x6d, 0xa0, 0x49, 0x18, 0x37, 0x0d, 0x09, 0x4c, 0x83, 0x99,
+	0x11, 0x65, 0xb8, 0xbb, 0x95, 0x8f, 0x57, 0x97, 0xd4, 0xb8, 0x69, 0x20, 0x04, 0x87, 0x6c, 0xdc,
+	0x24, 0x52, 0x9c, 0xcd, 0xa8, 0xfc, 0x1b, 0x9d, 0x84, 0x89, 0xb6, 0x6b, 0x49, 0x09, 0x0e, 0x4e,
+	0x75, 0xb7, 0xf2, 0x89, 0x15, 0xf5, 0xb2, 0xca, 0x68, 0x68, 0x02, 0x26, 0x2d, 0xa7, 0xee, 0x78,
+	0xd2, 0xd0, 0x74, 0x62, 0x66, 0x44, 0xf5, 0x07, 0x85, 0x0f, 0x40, 0xa8, 0xed, 0x8a, 0x63, 0x10,
+	0x0b, 0x5d, 0x81, 0xe9, 0x55, 0xa6, 0x56, 0x0b, 0x75, 0x96, 0x77, 0x95, 0xb3, 0x6e, 0x41, 0x3a,
+	0x5b, 0x96, 0x6f, 0x5e, 0xc7, 0xc5, 0x57, 0xbf, 0x51, 0xfc, 0xce, 0x2b, 0x33, 0x0b, 0xf3, 0xd7,
+	0x8b, 0xaf, 0x2c, 0x04, 0xc3, 0xd9, 0xd7, 0xca, 0xe7, 0x5f, 0x3f, 0xdb, 0xdd, 0xca, 0xa7, 0xb8,
+	0xc5, 0xd5, 0x25, 0x35, 0xc5, 0x65, 0x54, 0x0d, 0xf4, 0x0c, 0x37, 0x9e, 0x9b, 0xa8, 0x14, 0x8f,
+	0x2e, 0x68, 0x70, 0x8d, 0x89, 0xde, 0x1a, 0x0b, 0xbf, 0x88, 0xc3, 0x93, 0xc2, 0xe4, 0x17, 0x89,
+	0xeb, 0x99, 0x8e, 0x5d, 0xed, 0x45, 0xe1, 0x7e, 0xdb, 0x7f, 0x05, 0xa6, 0x9b, 0xcc, 0x2f, 0x5a,
+	0xb8, 0x8a, 0xe3, 0x88, 0xe3, 0x2e, 0x65, 0xe2, 0xb8, 0x8c, 0xaa, 0x81, 0x66, 0xe1, 0x78, 0x03,
+	0xbb, 0xc6, 0x06, 0x76, 0x89, 0xb6, 0xee, 0x1b, 0x2f, 0xd6, 0x36, 0x16, 0xd0, 0xc5, 0x9a, 0x18,
+	0xb4, 0x66, 0xba, 0xcd, 0x3e, 0xe8, 0x90, 0x0f, 0x0d, 0xe8, 0x02, 0x5a, 0xf8, 0x57, 0x3c, 0x0c,
+	0xa2, 0x8a, 0x0d, 0xd3, 0x41, 0x93, 0x70, 0x98, 0xd8, 0x78, 0xd5, 0x22, 0xdc, 0x05, 0x69, 0x55,
+	0x8c, 0xd0, 0x29, 0x38, 0xa2, 0x37, 0xcc, 0x96, 0x46, 0x3b, 0xad, 0x20, 0x6f, 0xd2, 0x8c, 0x70,
+	0xad, 0xd3, 0x22, 0xe8, 0x34, 0x1c, 0xa9, 0xb9, 0xe4, 0xc7, 0x6d, 0x62, 0xeb, 0x1d, 0x6e, 0xd4,
+	0x90, 0xda, 0x23, 0xa0, 0x39, 0x98, 0x71, 0x3d, 0xcf, 0xd4, 0x9c, 0x5a, 0xcd, 0x23, 0x94, 0x5b,
+	0x12, 0x57, 0x72, 0xdd, 0xad, 0x3c, 0x54, 0x97, 0x97, 0xab, 0x2f, 0x70, 0xaa, 0x0a, 0x19, 0xc4,
+	0xff, 0x46, 0x2f, 0xc1, 0x71, 0xba, 0xa9, 0xe9, 0x8e, 0x5d, 0x33, 0xeb, 0x62, 0xb7, 0x4b, 0xc9,
+	0x69, 0x30, 0x93, 0x29, 0x9f, 0x2f, 0xf5, 0x17, 0xa4, 0x52, 0xd4, 0xf6, 0xd2, 0xb5, 0xcd, 0xc5,
+	0x28, 0x8f, 0x3a, 0x46, 0xfb, 0x09, 0x53, 0x6f, 0x02, 0x38, 0x36, 0x00, 0x42, 0x8f, 0xc2, 0xd1,
+	0xa6, 0x69, 0x6b, 0x3d, 0xfb, 0x01, 0xb7, 0x3f, 0xdb, 0x34, 0xed, 0x8b, 0xe1, 0x12, 0x18, 0x08,
+	0x6f, 0x46, 0x40, 0x71, 0x01, 0xc2, 0x9b, 0x3d, 0xd0, 0x13, 0x70, 0xcc, 0x76, 0xa8, 0xde, 0xd0,
+	0x06, 0x7d, 0x91, 0xe3, 0xe4, 0x10, 0x58, 0xf8, 0x2b, 0x80, 0xb9, 0xfe, 0x34, 0x44, 0x57, 0x60,
+	0xc2, 0x34, 0x3c, 0xae, 0x3b, 0x53, 0x9e, 0x3d, 0x60, 0x95, 0x7b, 0x73, 0x56, 0x19, 0xdf, 0x55,
+	0x92, 0x6f, 0x83, 0xf8, 0x38, 0xf8, 0x78, 0x2b, 0x1f, 0xbb, 0xb3, 0x95, 0x07, 0x2a, 0x93, 0xc3,
+	0xa2, 0xd8, 0x6a, 0x38, 0xd4, 0xf1, 0xa4, 0x38, 0xdf, 0xb2, 0x62, 0x84, 0x9e, 0x82, 0xc3, 0x2e,
+	0x73, 0x95, 0x27, 0x25, 0xa6, 0x13, 0x33, 0x99, 0xf2, 0xe9, 0xc3, 0xfc, 0xa9, 0x0a, 0x2c, 0x7a,
+	0x04, 0x66, 0x75, 0xcb, 0xd1, 0xd7, 0x34, 0xcf, 0x69, 0xbb, 0x3a, 0x91, 0x52, 0xd3, 0x60, 0x66,
+	0x54, 0xcd, 0x70, 0xda, 0x32, 0x27, 0xcd, 0x0f, 0x7d, 0xf8, 0x5e, 0x3e, 0x56, 0xd8, 0xc9, 0xc0,
+	0x94, 0x90, 0x80, 0x2e, 0x46, 0x57, 0x54, 0x38, 0x40, 0xcf, 0x11, 0x96, 0xb2, 0x08, 0xa1, 0xee,
+	0x12, 0x4c, 0x89, 0xa1, 0x61, 0xca, 0xfd, 0x9e, 0x29, 0x4f, 0x95, 0xfc, 0xaa, 0x5d, 0x0a, 0xaa,
+	0x76, 0xe9, 0x5a, 0x50, 0xb5, 0x95, 0x34, 0x63, 0x7f, 0xe7, 0xd3, 0x3c, 0x50, 0x47, 0x04, 0x5f,
+	0x85, 0x32, 0x21, 0xed, 0x96, 0x11, 0x08, 0x49, 0x1c, 0x47, 0x88, 0xe0, 0xab, 0x50, 0x74, 0x4a,
+	0x54, 0x94, 0x21, 0xbf, 0x44, 0xee, 0x2a, 0x43, 0x6e, 0x5c, 0x2a, 0x8b, 0xf2, 0x79, 0x0e, 0x66,
+	0x0c, 0xe2, 0xe9, 0xae, 0xd9, 0x0a, 0xd3, 0x75, 0x44, 0x49, 0xef, 0x2a, 0x49, 0x37, 0x21, 0xdd,
+	0x19, 0x53, 0xa3, 0x93, 0xa8, 0x0d, 0x21, 0xa6, 0xd4, 0x35, 0x57, 0xdb, 0x94, 0x78, 0xd2, 0x30,
+	0x8f, 0xc4, 0x13, 0x07, 0x78, 0xa8, 0x54, 0x09, 0x91, 0x17, 0x6c, 0xea, 0x76, 0x94, 0xf3, 0xbb,
+	0xca, 0xec, 0x2f, 0xc1, 0xe3, 0x85, 0x23, 0x55, 0x12, 0x35, 0xa2, 0x08, 0x3d, 0x0b, 0xb3, 0xd1,
+	0x93, 0x4b, 0x4a, 0x71, 0xc5, 0xa7, 0x06, 0x15, 0x2f, 0xfa, 0x98, 0xaa, 0x5d, 0x73, 0xd4, 0x8c,
+	0xde, 0x1b, 0xa0, 0x1b, 0x30, 0x23, 0xaa, 0x89, 0xc6, 0x22, 0x9b, 0xbe, 0xf7, 0x5c, 0x85, 0xeb,
+	0x01, 0xca, 0x43, 0x7f, 0x06, 0x70, 0x52, 0x5c, 0x3e, 0x34, 0x8f, 0xb8, 0xeb, 0xc4, 0xd5, 0xb0,
+	0x61, 0xb8, 0xc4, 0xf3, 0xa4, 0x11, 0xee, 0xcc, 0x9f, 0x83, 0x5d, 0xe5, 0x6d, 0xe0, 0xfe, 0x14,
+	0x94, 0xdf, 0x04, 0x37, 0x67, 0x16, 0xe6, 0xd9, 0x82, 0x71, 0xf1, 0xd5, 0x4a, 0xf1, 0x65, 0xb6,
+	0xde, 0x5b, 0x91, 0xef, 0xde, 0xe7, 0x8d, 0xe2, 0x2b, 0xe7, 0x22, 0x13, 0xb3, 0x37, 0x4a, 0xb3,
+	0xe7, 0x18, 0x5f, 0xa5, 0xf8, 0xb2, 0xf0, 0xd3, 0xad, 0xc8, 0x77, 0xef, 0x93, 0xf3, 0xf5, 0x26,
+	0x66, 0x67, 0x16, 0xe6, 0xe7, 0xaf, 0xb3, 0xaf, 0xd7, 0xbe, 0x79, 0xfe, 0xe9, 0xd7, 0x67, 0x17,
+	0xce, 0xde, 0xba, 0x79, 0x56, 0x9d, 0x10, 0xe6, 0x2e, 0x73, 0x6b, 0x2b, 0xbe, 0xb1, 0x28, 0x0f,
+	0x33, 0xb8, 0x4d, 0x1d, 0xcd, 0xcf, 0x1b, 0x09, 0xf2, 0x2a, 0x0a, 0x19, 0x69, 0x85, 0x53, 0xd0,
+	0x63, 0x30, 0xe7, 0xcf, 0x69, 0x7a, 0x03, 0xdb, 0x36, 0xb1, 0xa4, 0x0c, 0x2f, 0xa7, 0xa3, 0x3e,
+	0x75, 0xd1, 0x27, 0xa2, 0x8b, 0xf0, 0x44, 0x58, 0x47, 0xb4, 0x96, 0x85, 0x99, 0xd3, 0xa5, 0x2c,
+	0xf7, 0xc4, 0x94, 0x9f, 0x7a, 0xcf, 0x75, 0xb7, 0xf2, 0x63, 0x61, 0x55, 0xb9, 0x6a, 0x61, 0xbb,
+	0xba, 0xa4, 0x8e, 0xd5, 0xfa, 0x08, 0x06, 0x7a, 0x11, 0xa2, 0x3d, 0x72, 0x3c, 0x69, 0x82, 0x95,
+	0x05, 0x65, 0x66, 0x57, 0x49, 0xde, 0x06, 0xf1, 0xf1, 0xf4, 0xae, 0x32, 0x72, 0x1b, 0x0c, 0x17,
+	0x02, 0xa9, 0xe3, 0x03, 0x52, 0x3d, 0x75, 0x7c, 0x40, 0xac, 0x87, 0x9e, 0x83, 0x69, 0x6c, 0x53,
+	0x62, 0xdb, 0xd8, 0x93, 0x46, 0x79, 0x26, 0xc9, 0x07, 0xa4, 0x42, 0xc5, 0x87, 0x29, 0x43, 0x2c,
+	0xee, 0x6a, 0xc8, 0xc5, 0x8a, 0xaa, 0x47, 0x31, 0x6d, 0x7b, 0x5a, 0xab, 0xbd, 0x6a, 0x99, 0xba,
+	0x94, 0xe3, 0xbe, 0xca, 0xfa, 0xc4, 0xab, 0x9c, 0xc6, 0x8a, 0xaa, 0xe5, 0xe8, 0xbc, 0x54, 0x07,
+	0xb0, 0x31, 0x0e, 0xcb, 0x05, 0x64, 0x01, 0x7c, 0x0a, 0x4e, 0x7a, 0x7a, 0x83, 0x18, 0x6d, 0x8b,
+	0x68, 0x86, 0xb3, 0x61, 0x5b, 0xa6, 0xbd, 0xa6, 0x59, 0x2c, 0x04, 0xe3, 0x1c, 0x3f, 0x11, 0xcc,
+	0x2e, 0x89, 0xc9, 0xcb, 0x2c, 0x18, 0xe7, 0x21, 0x22, 0x76, 0xcd, 0x71, 0x75, 0xa2, 0x19, 0x6d,
+	0xda, 0xd1, 0xf4, 0x8e, 0x6e, 0x11, 0xe9, 0x04, 0xe7, 0x18, 0x17, 0x33, 0x4b, 0x6d, 0xda, 0x59,
+	0x64, 0x74, 0xf4, 0x23, 0x28, 0x85, 0xa2, 0x5b, 0x98, 0x36, 0xd8, 0x19, 0xe5, 0x51, 0x17, 0x9b,
+	0x36, 0x95, 0xd0, 0x34, 0x98, 0xc9, 0x95, 0x1f, 0x1f, 0xf4, 0x41, 0xa0, 0xed, 0x2a, 0xa6, 0x8d,
+	0xc5, 0x10, 0xcd, 0x2b, 0xc3, 0x4f, 0xd8, 0x5e, 0x50, 0x27, 0x8d, 0x7d, 0x11, 0xe8, 0x87, 0x91,
+	0xf5, 0x60, 0xbb, 0xc3, 0xae, 0xa5, 0x9a, 0x41, 0x2c, 0xdc, 0x91, 0x1e, 0xe2, 0x1b, 0xef, 0xe4,
+	0x9e, 0xf2, 0xb5, 0x24, 0x8e, 0x34, 0x5e, 0xbd, 0xc0, 0xbb, 0xac, 0x7a, 0x85, 0x8b, 0xae, 0xf8,
+	0x12, 0x96, 0x98, 0x80, 0xa9, 0x67, 0xe0, 0xd8, 0x40, 0x55, 0x41, 0xe3, 0x30, 0xb1, 0x46, 0xfc,
+	0xb3, 0x6f, 0x44, 0x65, 0x9f, 0xec, 0xd2, 0xb7, 0x8e, 0xad, 0x76, 0x70, 0xd8, 0xfb, 0x83, 0xf9,
+	0xf8, 0xb7, 0x41, 0x61, 0x01, 0xa6, 0x45, 0x64, 0x3d, 0xf4, 0x24, 0x4c, 0x8b, 0x5d, 0xc0, 0x4a,
+	0x3d, 0xcb, 0x82, 0x87, 0x0f, 0x3a, 0x52, 0x42, 0x60, 0xe1, 0xf7, 0x00, 0x9e, 0xb8, 0x44, 0x68,
+	0x30, 0xc1, 0x12, 0xcb, 0xa3, 0x68, 0x05, 0x66, 0x82, 0xfd, 0x7f, 0xaf, 0x07, 0x07, 0xac, 0x07,
+	0x28, 0x0f, 0x2d, 0x40, 0xd8, 0x7b, 0x12, 0x1c, 0x78, 0x7e, 0x5c, 0x64, 0x90, 0x2b, 0xd8, 0x5b,
+	0x13, 0x59, 0x3a, 0x52, 0x0b, 0x08, 0x85, 0x0e, 0x2c, 0xf4, 0x8c, 0x8d, 0xe8, 0xbd, 0xe8, 0xb8,
+	0x17, 0x56, 0xaa, 0x81, 0xf5, 0xcb, 0x30, 0x41, 0xda, 0x26, 0xb7, 0x3a, 0xab, 0x54, 0x98, 0x8c,
+	0xbf, 0x6f, 0xe5, 0xcb, 0x75, 0xa7, 0x44, 0x1b, 0x84, 0x36, 0x4c, 0xbb, 0xee, 0x95, 0x6c, 0x42,
+	0x37, 0x1c, 0x77, 0x6d, 0xae, 0xff, 0x12, 0xdf, 0x5a, 0xab, 0xcf, 0xb1, 0x4b, 0x95, 0x57, 0xba,
+	0xb0, 0x52, 0xfd, 0xd6, 0x53, 0xec, 0xe2, 0xcd, 0xc4, 0x32, 0x69, 0x85, 0x2f, 0xe2, 0xf0, 0xa1,
+	0xcb, 0xa6, 0x17, 0x28, 0xf7, 0x02, 0x65, 0x3f, 0x60, 0x95, 0xdc, 0xb2, 0xf0, 0xaa, 0xe3, 0x62,
+	0xea, 0xb8, 0xc2, 0x57, 0xc5, 0x41, 0x5f, 0xbd, 0xe0, 0xd6, 0xb1, 0x6d, 0xbe, 0xca, 0x93, 0xe2,
+	0x05, 0x77, 0xc5, 0x23, 0x6e, 0xc4, 0x7c, 0xb5, 0x4f, 0xc4, 0x3d, 0xbb, 0x09, 0x6d, 0xc0, 0xa4,
+	0xe3, 0x1a, 0xc4, 0x15, 0x2f, 0x08, 0xbc, 0xab, 0xdc, 0x74, 0x6f, 0xa8, 0xb1, 0x30, 0x16, 0x9a,
+	0x69, 0xa8, 0x99, 0x62, 0x74, 0x10, 0x7c, 0x93, 0xb6, 0xa9, 0x66, 0x8b, 0xd1, 0x11, 0x3f, 0x52,
+	0xd5, 0x64, 0x91, 0xff, 0x44, 0x8e, 0x7f, 0x35, 0x53, 0x8c, 0x0c, 0x7c, 0x7d, 0x48, 0x86, 0x49,
+	0xcb, 0x6c, 0x9a, 0xfe, 0xc5, 0x72, 0x94, 0xef, 0xac, 0x73, 0x09, 0xe9, 0xf3, 0x94, 0xea, 0x93,
+	0xd9, 0x43, 0xa0, 0x85, 0xeb, 0x84, 0x1f, 0xc9, 0xa3, 0x2a, 0xff, 0x46, 0x12, 0x4c, 0x19, 0xc4,
+	0x22, 0x94, 0x18, 0xd2, 0x30, 0xdf, 0xeb, 0xc1, 0xb0, 0xf0, 0x27, 0x00, 0x27, 0x16, 0xb9, 0x8e,
+	0x81, 0xf4, 0x5c, 0x84, 0x29, 0x61, 0xa2, 0x70, 0xf7, 0x41, 0x89, 0xbe, 0x4f, 0x3e, 0x06, 0x9c,
+	0x48, 0x1b, 0x08, 0x5c, 0xfc, 0x2e, 0x02, 0xa7, 0x64, 0xa3, 0xf2, 0xfb, 0xc3, 0x58, 0xf8, 0x15,
+	0x80, 0x13, 0xfe, 0x39, 0xf3, 0x20, 0xcc, 0xbf, 0xe7, 0xbd, 0xf4, 0x5b, 0x00, 0x4f, 0x46, 0x12,
+	0xba, 0x72, 0xb5, 0xfa, 0x3c, 0xe9, 0x78, 0x0f, 0xb8, 0x02, 0x84, 0x09, 0x12, 0x3f, 0x3c, 0x41,
+	0x12, 0xbd, 0x04, 0x29, 0xdc, 0x06, 0xf0, 0xe1, 0x4b, 0xa4, 0xdf, 0xce, 0x07, 0x6c, 0xe6, 0x34,
+	0x1c, 0x5e, 0x23, 0x9d, 0xde, 0x6b, 0x71, 0xa4, 0xbb, 0x95, 0x4f, 0x3e, 0x4f, 0x3a, 0xd5, 0x25,
+	0x35, 0xb9, 0x46, 0x3a, 0x55, 0xa3, 0xf0, 0xb7, 0x38, 0x9c, 0xea, 0xcb, 0xcd, 0xaf, 0xc4, 0xae,
+	0x53, 0xd1, 0x66, 0xc1, 0xe0, 0xb5, 0xf7, 0x7b, 0x70, 0xd8, 0xef, 0x40, 0xf0, 0x07, 0x45, 0xae,
+	0xfc, 0xb5, 0x41, 0x75, 0x2a, 0x9b, 0x55, 0x46, 0x77, 0x15, 0x78, 0x1b, 0xa4, 0x0a, 0xe2, 0xcc,
+	0x13, 0x3c, 0x2c, 0x9f, 0xc8, 0x66, 0xcb, 0x74, 0x89, 0xa7, 0x61, 0x7f, 0xff, 0x1e, 0x7e, 0x2d,
+	0x1f, 0xf2, 0xaf, 0xe4, 0x82, 0xa7, 0x42, 0xd1, 0x45, 0x98, 0x75, 0x89, 0x47, 0x5d, 0x53, 0x67,
+	0x5b, 0xc5, 0x93, 0x92, 0xfb, 0xaf, 0x39, 0xf0, 0x53, 0x0f, 0xa9, 0xf6, 0xf1, 0x15, 0xfe, 0x0d,
+	0xe0, 0x54, 0xdf, 0xb6, 0xf9, 0x4a, 0x3c, 0x5b, 0x81, 0x29, 0xdc, 0x32, 0x35, 0x76, 0xf0, 0xfa,
+	0x7b, 0x69, 0x72, 0x7f, 0xc3, 0xf7, 0x11, 0x33, 0x8c, 0x5b, 0xe6, 0xf3, 0x64, 0x70, 0x47, 0x26,
+	0x8e, 0xbf, 0x23, 0xff, 0x00, 0x60, 0x3e, 0xb2, 0x23, 0x17, 0x23, 0xc5, 0xe4, 0xff, 0x71, 0x5f,
+	0xfe, 0x03, 0xc0, 0x33, 0x97, 0xc8, 0x7e, 0xd6, 0x3e, 0x60, 0x63, 0xf5, 0xfb, 0x51, 0xb9, 0xf7,
+	0xaa, 0xe8, 0xaf, 0xde, 0x7f, 0x01, 0xf0, 0xcc, 0xf2, 0xff, 0x62, 0x75, 0xdf, 0xdf, 0x77, 0x75,
+	0xa7, 0xf7, 0x3e, 0x0d, 0x7b, 0x98, 0x43, 0x8f, 0xa1, 0xdf, 0xc4, 0x61, 0xae, 0xff, 0xf6, 0xcf,
+	0xa2, 0x59, 0xc7, 0xa6, 0xcd, 0x4d, 0x8e, 0xab, 0xfc, 0x1b, 0x29, 0x30, 0x1d, 0xdc, 0xe2, 0x85,
+	0x4a, 0x69, 0x50, 0xe5, 0x65, 0x31, 0x3f, 0xa0, 0x2e, 0xe4, 0x43, 0xb7, 0xfa, 0x1e, 0xd3, 0x7e,
+	0x5b, 0xa3, 0x74, 0xf8, 0x4b, 0xe4, 0xfe, 0xbd, 0xa9, 0xef, 0xf5, 0x2a, 0xfd, 0xb3, 0x24, 0x1c,
+	0x15, 0xb6, 0x2d, 0xf3, 0x57, 0x0f, 0x7a, 0x0e, 0x0e, 0xb1, 0x8b, 0xba, 0x04, 0x0e, 0xd8, 0xca,
+	0xbd, 0x62, 0xc8, 0x22, 0xfa, 0x47, 0x10, 0x4f, 0x83, 0xb0, 0x57, 0xc1, 0x39, 0x51, 0x05, 0x8e,
+	0xac, 0x3a, 0x0e, 0xd5, 0xb8, 0x98, 0xe3, 0xf4, 0x4b, 0xd2, 0x8c, 0x8d, 0x4d, 0xa0, 0x36, 0x4c,
+	0x8b, 0x97, 0x79, 0xe0, 0xd1, 0xaf, 0x1f, 0xe0, 0x51, 0xdf, 0xea, 0x92, 0x78, 0xed, 0xdf, 0x95,
+	0x3b, 0x43, 0x55, 0xe8, 0x02, 0x3c, 0x21, 0x1e, 0x87, 0x5a, 0x10, 0x5e, 0xbf, 0xe7, 0x7c, 0x48,
+	0x5e, 0xa8, 0xe3, 0x82, 0x25, 0x20, 0x78, 0xbc, 0xeb, 0xdd, 0x92, 0x92, 0xd3, 0x89, 0xb0, 0xeb,
+	0x7d, 0x55, 0x8d, 0x9b, 0x2d, 0xe4, 0xc2, 0x54, 0x93, 0xb0, 0x9a, 0x1f, 0xf4, 0x5c, 0xce, 0x1d,
+	0xbe, 0xa8, 0x2b, 0x3e, 0xf8, 0x6e, 0xd6, 0x14, 0x28, 0x62, 0xef, 0x23, 0x6c, 0xac, 0x63, 0x5b,
+	0x27, 0x86, 0xa4, 0x8b, 0x7b, 0xd7, 0x60, 0x2c, 0x96, 0xf9, 0xff, 0x11, 0x6a, 0x08, 0x9c, 0xfa,
+	0x2e, 0x1c, 0xed, 0x73, 0xe8, 0x71, 0x52, 0x6a, 0x6a, 0x1e, 0x66, 0xa3, 0x86, 0xff, 0x37, 0xde,
+	0x78, 0x34, 0x1d, 0x3f, 0x1d, 0x86, 0x93, 0x61, 0xf1, 0xb1, 0x6d, 0xc2, 0x4f, 0x47, 0xe6, 0x0d,
+	0xd6, 0x86, 0xcb, 0xea, 0x3e, 0xc9, 0xef, 0xa1, 0x81, 0x23, 0x1e, 0xd6, 0x99, 0x90, 0xab, 0x42,
+	0xd1, 0x14, 0x4c, 0x73, 0xa0, 0xee, 0x58, 0x41, 0x0f, 0x39, 0x18, 0xa3, 0x97, 0xe0, 0xc3, 0x16,
+	0xf6, 0xa8, 0x26, 0x5a, 0x02, 0x2e, 0xd1, 0x89, 0xb9, 0x7e, 0xd4, 0x7e, 0x9d, 0xaf, 0x6b, 0x82,
+	0x09, 0xf0, 0x83, 0xa7, 0x0a, 0xf6, 0x0a, 0x45, 0xcf, 0xc2, 0x4c, 0x44, 0xb0, 0xb8, 0x65, 0x9c,
+	0x39, 0x34, 0xf4, 0x2a, 0xec, 0x49, 0x0a, 0x0d, 0x6b, 0xb7, 0xf8, 0xbb, 0x3f, 0x6a, 0x58, 0xf2,
+	0x38, 0x86, 0xad, 0x70, 0xfe, 0x88, 0x61, 0x8f, 0xc0, 0xac, 0x90, 0xa9, 0x3b, 0x6d, 0x9b, 0xf2,
+	0x97, 0xc8, 0x90, 0x9a, 0xf1, 0x69, 0x8b, 0x8c, 0x84, 0xae, 0xc3, 0x93, 0x5c, 0x77, 0xd8, 0x75,
+	0x88, 0x6a, 0x4f, 0x1d, 0x51, 0xfb, 0x24, 0x13, 0x11, 0xf4, 0x21, 0x22, 0xfa, 0x1f, 0x83, 0xb9,
+	0x50, 0xae, 0x6f, 0x41, 0x9a, 0x5b, 0x30, 0x1a, 0x50, 0x7d, 0x1b, 0x34, 0x38, 0xee, 0x3a, 0x6d,
+	0xdb, 0xd0, 0xa8, 0xcb, 0xfa, 0xff, 0x4c, 0x38, 0xef, 0xc8, 0x65, 0xca, 0x4f, 0x1f, 0xe0, 0xc4,
+	0x81, 0xdc, 0x29, 0xa9, 0x8c, 0xfd, 0x9a, 0x6b, 0xb6, 0xb8, 0x65, 0x6a, 0xce, 0xed, 0x1b, 0x4f,
+	0xfd, 0x13, 0xc0, 0x5c, 0x3f, 0x04, 0x3d, 0x03, 0x13, 0x4d, 0x71, 0x56, 0x1c, 0xda, 0xe9, 0x60,
+	0x35, 0xf0, 0x77, 0x41, 0x0d, 0xe4, 0x1d, 0x0f, 0xc6, 0xc7, 0xd9, 0xf1, 0xa6, 0x14, 0xbf, 0x1b,
+	0x76, 0xbc, 0x89, 0x16, 0xe1, 0x70, 0x93, 0x18, 0x26, 0xb6, 0xa5, 0xc4, 0xf1, 0x25, 0x08, 0x56,
+	0xb6, 0xcb, 0x7c, 0xa7, 0xf2, 0x67, 0xa9, 0xea, 0x0f, 0x94, 0x5f, 0x83, 0x8f, 0xb7, 0x65, 0x70,
+	0x67, 0x5b, 0x06, 0x9f, 0x6c, 0xcb, 0xb1, 0xcf, 0xb6, 0xe5, 0xd8, 0xe7, 0xdb, 0x72, 0xec, 0x8b,
+	0x6d, 0x39, 0xf6, 0xe5, 0xb6, 0x0c, 0xde, 0xe8, 0xca, 0xe0, 0xad, 0xae, 0x1c, 0x7b, 0xbf, 0x2b,
+	0x83, 0x0f, 0xba, 0x72, 0xec, 0xc3, 0xae, 0x1c, 0xfb, 0xa8, 0x2b, 0xc7, 0x3e, 0xee, 0xca, 0xe0,
+	0x4e, 0x57, 0x06, 0x9f, 0x74, 0xe5, 0xd8, 0x67, 0x5d, 0x19, 0x7c, 0xde, 0x95, 0x63, 0x5f, 0x74,
+	0x65, 0xf0, 0x65, 0x57, 0x8e, 0xbd, 0xb1, 0x23, 0xc7, 0xde, 0xda, 0x91, 0xc1, 0x3b, 0x3b, 0x72,
+	0xec, 0xdd, 0x1d, 0x19, 0xbc, 0xb7, 0x23, 0xc7, 0xde, 0xdf, 0x91, 0x63, 0x1f, 0xec, 0xc8, 0xe0,
+	0xc3, 0x1d, 0x19, 0x7c, 0xb4, 0x23, 0x83, 0x97, 0xcf, 0x1f, 0xb5, 0x11, 0x41, 0xed, 0xd6, 0xea,
+	0xea, 0x30, 0x5f, 0xe7, 0x93, 0xff, 0x19, 0x00, 0x8d, 0xa4, 0x53, 0x0e, 0x1f, 0x1e, 0x00, 0x00,
 }
 
 func (this *GatewayBrand) Equal(that interface{}) bool {
@@ -2299,6 +2338,9 @@ func (this *ListGatewaysRequest) Equal(that interface{}) bool {
 	if this.Page != that1.Page {
 		return false
 	}
+	if this.Deleted != that1.Deleted {
+		return false
+	}
 	return true
 }
 func (this *CreateGatewayRequest) Equal(that interface{}) bool {
@@ -2445,6 +2487,16 @@ func (this *CreateGatewayAPIKeyRequest) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if that1.ExpiresAt == nil {
+		if this.ExpiresAt != nil {
+			return false
+		}
+	} else if !this.ExpiresAt.Equal(*that1.ExpiresAt) {
+		return false
+	}
+	if !this.Restrictions.Equal(that1.Restrictions) {
+		return false
+	}
 	return true
 }
 func (this *UpdateGatewayAPIKeyRequest) Equal(that interface{}) bool {
@@ -2472,6 +2524,9 @@ func (this *UpdateGatewayAPIKeyRequest) Equal(that interface{}) bool {
 	if !this.APIKey.Equal(&that1.APIKey) {
 		return false
 	}
+	if !this.FieldMask.Equal(&that1.FieldMask) {
+		return false
+	}
 	return true
 }
 func (this *ListGatewayCollaboratorsRequest) Equal(that interface{}) bool {
@@ -3420,6 +3475,16 @@ func (m *ListGatewaysRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Deleted {
+		i--
+		if m.Deleted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
 	if m.Page != 0 {
 		i = encodeVarintGateway(dAtA, i, uint64(m.Page))
 		i--
@@ -3651,21 +3716,43 @@ func (m *CreateGatewayAPIKeyRequest) MarshalToSizedBuffer(dAtA []byte) (int, err
 	_ = i
 	var l int
 	_ = l
+	if m.Restrictions != nil {
+		{
+			size, err := m.Restrictions.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGateway(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.ExpiresAt != nil {
+		n19, err19 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ExpiresAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpiresAt):])
+		if err19 != nil {
+			return 0, err19
+		}
+		i -= n19
+		i = encodeVarintGateway(dAtA, i, uint64(n19))
+		i--
+		dAtA[i] = 0x22
+	}
 	if len(m.Rights) > 0 {
-		dAtA19 := make([]byte, len(m.Rights)*10)
-		var j18 int
+		dAtA21 := make([]byte, len(m.Rights)*10)
+		var j20 int
 		for _, num := range m.Rights {
 			for num >= 1<<7 {
-				dAtA19[j18] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA21[j20] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j18++
+				j20++
 			}
-			dAtA19[j18] = uint8(num)
-			j18++
+			dAtA21[j20] = uint8(num)
+			j20++
 		}
-		i -= j18
-		copy(dAtA[i:], dAtA19[:j18])
-		i = encodeVarintGateway(dAtA, i, uint64(j18))
+		i -= j20
+		copy(dAtA[i:], dAtA21[:j20])
+		i = encodeVarintGateway(dAtA, i, uint64(j20))
 		i--
 		dAtA[i] = 0x1a
 	}
@@ -3709,6 +3796,16 @@ func (m *UpdateGatewayAPIKeyRequest) MarshalToSizedBuffer(dAtA []byte) (int, err
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.FieldMask.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGateway(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
 	{
 		size, err := m.APIKey.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -4013,20 +4110,20 @@ func (m *GatewayStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			dAtA[i] = 0x1a
 		}
 	}
-	n30, err30 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.BootTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.BootTime):])
-	if err30 != nil {
-		return 0, err30
+	n33, err33 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.BootTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.BootTime):])
+	if err33 != nil {
+		return 0, err33
 	}
-	i -= n30
-	i = encodeVarintGateway(dAtA, i, uint64(n30))
+	i -= n33
+	i = encodeVarintGateway(dAtA, i, uint64(n33))
 	i--
 	dAtA[i] = 0x12
-	n31, err31 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
-	if err31 != nil {
-		return 0, err31
+	n34, err34 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err34 != nil {
+		return 0, err34
 	}
-	i -= n31
-	i = encodeVarintGateway(dAtA, i, uint64(n31))
+	i -= n34
+	i = encodeVarintGateway(dAtA, i, uint64(n34))
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
@@ -4070,12 +4167,12 @@ func (m *GatewayConnectionStats) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		dAtA[i] = 0x40
 	}
 	if m.LastDownlinkReceivedAt != nil {
-		n33, err33 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastDownlinkReceivedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastDownlinkReceivedAt):])
-		if err33 != nil {
-			return 0, err33
+		n36, err36 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastDownlinkReceivedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastDownlinkReceivedAt):])
+		if err36 != nil {
+			return 0, err36
 		}
-		i -= n33
-		i = encodeVarintGateway(dAtA, i, uint64(n33))
+		i -= n36
+		i = encodeVarintGateway(dAtA, i, uint64(n36))
 		i--
 		dAtA[i] = 0x3a
 	}
@@ -4085,12 +4182,12 @@ func (m *GatewayConnectionStats) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		dAtA[i] = 0x30
 	}
 	if m.LastUplinkReceivedAt != nil {
-		n34, err34 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastUplinkReceivedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastUplinkReceivedAt):])
-		if err34 != nil {
-			return 0, err34
+		n37, err37 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastUplinkReceivedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastUplinkReceivedAt):])
+		if err37 != nil {
+			return 0, err37
 		}
-		i -= n34
-		i = encodeVarintGateway(dAtA, i, uint64(n34))
+		i -= n37
+		i = encodeVarintGateway(dAtA, i, uint64(n37))
 		i--
 		dAtA[i] = 0x2a
 	}
@@ -4107,12 +4204,12 @@ func (m *GatewayConnectionStats) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		dAtA[i] = 0x22
 	}
 	if m.LastStatusReceivedAt != nil {
-		n36, err36 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastStatusReceivedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastStatusReceivedAt):])
-		if err36 != nil {
-			return 0, err36
+		n39, err39 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastStatusReceivedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastStatusReceivedAt):])
+		if err39 != nil {
+			return 0, err39
 		}
-		i -= n36
-		i = encodeVarintGateway(dAtA, i, uint64(n36))
+		i -= n39
+		i = encodeVarintGateway(dAtA, i, uint64(n39))
 		i--
 		dAtA[i] = 0x1a
 	}
@@ -4124,12 +4221,12 @@ func (m *GatewayConnectionStats) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		dAtA[i] = 0x12
 	}
 	if m.ConnectedAt != nil {
-		n37, err37 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ConnectedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ConnectedAt):])
-		if err37 != nil {
-			return 0, err37
+		n40, err40 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ConnectedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ConnectedAt):])
+		if err40 != nil {
+			return 0, err40
 		}
-		i -= n37
-		i = encodeVarintGateway(dAtA, i, uint64(n37))
+		i -= n40
+		i = encodeVarintGateway(dAtA, i, uint64(n40))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -4161,28 +4258,28 @@ func (m *GatewayConnectionStats_RoundTripTimes) MarshalToSizedBuffer(dAtA []byte
 		i--
 		dAtA[i] = 0x20
 	}
-	n38, err38 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Median, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Median):])
-	if err38 != nil {
-		return 0, err38
+	n41, err41 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Median, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Median):])
+	if err41 != nil {
+		return 0, err41
 	}
-	i -= n38
-	i = encodeVarintGateway(dAtA, i, uint64(n38))
+	i -= n41
+	i = encodeVarintGateway(dAtA, i, uint64(n41))
 	i--
 	dAtA[i] = 0x1a
-	n39, err39 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Max, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Max):])
-	if err39 != nil {
-		return 0, err39
+	n42, err42 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Max, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Max):])
+	if err42 != nil {
+		return 0, err42
 	}
-	i -= n39
-	i = encodeVarintGateway(dAtA, i, uint64(n39))
+	i -= n42
+	i = encodeVarintGateway(dAtA, i, uint64(n42))
 	i--
 	dAtA[i] = 0x12
-	n40, err40 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Min, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Min):])
-	if err40 != nil {
-		return 0, err40
+	n43, err43 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Min, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Min):])
+	if err43 != nil {
+		return 0, err43
 	}
-	i -= n40
-	i = encodeVarintGateway(dAtA, i, uint64(n40))
+	i -= n43
+	i = encodeVarintGateway(dAtA, i, uint64(n43))
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
@@ -4362,6 +4459,7 @@ func NewPopulatedListGatewaysRequest(r randyGateway, easy bool) *ListGatewaysReq
 	this.Order = randStringGateway(r)
 	this.Limit = r.Uint32()
 	this.Page = r.Uint32()
+	this.Deleted = bool(r.Intn(2) == 0)
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -4420,6 +4518,12 @@ func NewPopulatedCreateGatewayAPIKeyRequest(r randyGateway, easy bool) *CreateGa
 	for i := 0; i < v23; i++ {
 		this.Rights[i] = Right([]int32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 56, 19, 20, 21, 22, 23, 24, 25, 26, 27, 28, 29, 30, 31, 32, 33, 34, 35, 36, 37, 38, 39, 40, 41, 42, 43, 44, 45, 46, 47, 48, 49, 50, 51, 52, 53, 54, 55}[r.Intn(57)])
 	}
+	if r.Intn(5) != 0 {
+		this.ExpiresAt = github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	}
+	if r.Intn(5) != 0 {
+		this.Restrictions = NewPopulatedAPIKeyRestrictions(r, easy)
+	}
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -4431,6 +4535,8 @@ func NewPopulatedUpdateGatewayAPIKeyRequest(r randyGateway, easy bool) *UpdateGa
 	this.GatewayIdentifiers = *v24
 	v25 := NewPopulatedAPIKey(r, easy)
 	this.APIKey = *v25
+	v26 := types.NewPopulatedFieldMask(r, easy)
+	this.FieldMask = *v26
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -4438,8 +4544,8 @@ func NewPopulatedUpdateGatewayAPIKeyRequest(r randyGateway, easy bool) *UpdateGa
 
 func NewPopulatedListGatewayCollaboratorsRequest(r randyGateway, easy bool) *ListGatewayCollaboratorsRequest {
 	this := &ListGatewayCollaboratorsRequest{}
-	v26 := NewPopulatedGatewayIdentifiers(r, easy)
-	this.GatewayIdentifiers = *v26
+	v27 := NewPopulatedGatewayIdentifiers(r, easy)
+	this.GatewayIdentifiers = *v27
 	this.Limit = r.Uint32()
 	this.Page = r.Uint32()
 	if !easy && r.Intn(10) != 0 {
@@ -4449,10 +4555,10 @@ func NewPopulatedListGatewayCollaboratorsRequest(r randyGateway, easy bool) *Lis
 
 func NewPopulatedGetGatewayCollaboratorRequest(r randyGateway, easy bool) *GetGatewayCollaboratorRequest {
 	this := &GetGatewayCollaboratorRequest{}
-	v27 := NewPopulatedGatewayIdentifiers(r, easy)
-	this.GatewayIdentifiers = *v27
-	v28 := NewPopulatedOrganizationOrUserIdentifiers(r, easy)
-	this.OrganizationOrUserIdentifiers = *v28
+	v28 := NewPopulatedGatewayIdentifiers(r, easy)
+	this.GatewayIdentifiers = *v28
+	v29 := NewPopulatedOrganizationOrUserIdentifiers(r, easy)
+	this.OrganizationOrUserIdentifiers = *v29
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -4460,10 +4566,10 @@ func NewPopulatedGetGatewayCollaboratorRequest(r randyGateway, easy bool) *GetGa
 
 func NewPopulatedSetGatewayCollaboratorRequest(r randyGateway, easy bool) *SetGatewayCollaboratorRequest {
 	this := &SetGatewayCollaboratorRequest{}
-	v29 := NewPopulatedGatewayIdentifiers(r, easy)
-	this.GatewayIdentifiers = *v29
-	v30 := NewPopulatedCollaborator(r, easy)
-	this.Collaborator = *v30
+	v30 := NewPopulatedGatewayIdentifiers(r, easy)
+	this.GatewayIdentifiers = *v30
+	v31 := NewPopulatedCollaborator(r, easy)
+	this.Collaborator = *v31
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -4475,12 +4581,12 @@ func NewPopulatedGatewayAntenna(r randyGateway, easy bool) *GatewayAntenna {
 	if r.Intn(2) == 0 {
 		this.Gain *= -1
 	}
-	v31 := NewPopulatedLocation(r, easy)
-	this.Location = *v31
+	v32 := NewPopulatedLocation(r, easy)
+	this.Location = *v32
 	if r.Intn(5) != 0 {
-		v32 := r.Intn(10)
+		v33 := r.Intn(10)
 		this.Attributes = make(map[string]string)
-		for i := 0; i < v32; i++ {
+		for i := 0; i < v33; i++ {
 			this.Attributes[randStringGateway(r)] = randStringGateway(r)
 		}
 	}
@@ -4491,37 +4597,37 @@ func NewPopulatedGatewayAntenna(r randyGateway, easy bool) *GatewayAntenna {
 
 func NewPopulatedGatewayStatus(r randyGateway, easy bool) *GatewayStatus {
 	this := &GatewayStatus{}
-	v33 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
-	this.Time = *v33
 	v34 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
-	this.BootTime = *v34
+	this.Time = *v34
+	v35 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	this.BootTime = *v35
 	if r.Intn(5) != 0 {
-		v35 := r.Intn(10)
+		v36 := r.Intn(10)
 		this.Versions = make(map[string]string)
-		for i := 0; i < v35; i++ {
+		for i := 0; i < v36; i++ {
 			this.Versions[randStringGateway(r)] = randStringGateway(r)
 		}
 	}
 	if r.Intn(5) != 0 {
-		v36 := r.Intn(5)
-		this.AntennaLocations = make([]*Location, v36)
-		for i := 0; i < v36; i++ {
+		v37 := r.Intn(5)
+		this.AntennaLocations = make([]*Location, v37)
+		for i := 0; i < v37; i++ {
 			this.AntennaLocations[i] = NewPopulatedLocation(r, easy)
 		}
 	}
-	v37 := r.Intn(10)
-	this.IP = make([]string, v37)
-	for i := 0; i < v37; i++ {
+	v38 := r.Intn(10)
+	this.IP = make([]string, v38)
+	for i := 0; i < v38; i++ {
 		this.IP[i] = randStringGateway(r)
 	}
 	if r.Intn(5) != 0 {
-		v38 := r.Intn(10)
+		v39 := r.Intn(10)
 		this.Metrics = make(map[string]float32)
-		for i := 0; i < v38; i++ {
-			v39 := randStringGateway(r)
-			this.Metrics[v39] = float32(r.Float32())
+		for i := 0; i < v39; i++ {
+			v40 := randStringGateway(r)
+			this.Metrics[v40] = float32(r.Float32())
 			if r.Intn(2) == 0 {
-				this.Metrics[v39] *= -1
+				this.Metrics[v40] *= -1
 			}
 		}
 	}
@@ -4563,12 +4669,12 @@ func NewPopulatedGatewayConnectionStats(r randyGateway, easy bool) *GatewayConne
 
 func NewPopulatedGatewayConnectionStats_RoundTripTimes(r randyGateway, easy bool) *GatewayConnectionStats_RoundTripTimes {
 	this := &GatewayConnectionStats_RoundTripTimes{}
-	v40 := github_com_gogo_protobuf_types.NewPopulatedStdDuration(r, easy)
-	this.Min = *v40
 	v41 := github_com_gogo_protobuf_types.NewPopulatedStdDuration(r, easy)
-	this.Max = *v41
+	this.Min = *v41
 	v42 := github_com_gogo_protobuf_types.NewPopulatedStdDuration(r, easy)
-	this.Median = *v42
+	this.Max = *v42
+	v43 := github_com_gogo_protobuf_types.NewPopulatedStdDuration(r, easy)
+	this.Median = *v43
 	this.Count = r.Uint32()
 	if !easy && r.Intn(10) != 0 {
 	}
@@ -4594,9 +4700,9 @@ func randUTF8RuneGateway(r randyGateway) rune {
 	return rune(ru + 61)
 }
 func randStringGateway(r randyGateway) string {
-	v43 := r.Intn(100)
-	tmps := make([]rune, v43)
-	for i := 0; i < v43; i++ {
+	v44 := r.Intn(100)
+	tmps := make([]rune, v44)
+	for i := 0; i < v44; i++ {
 		tmps[i] = randUTF8RuneGateway(r)
 	}
 	return string(tmps)
@@ -4618,11 +4724,11 @@ func randFieldGateway(dAtA []byte, r randyGateway, fieldNumber int, wire int) []
 	switch wire {
 	case 0:
 		dAtA = encodeVarintPopulateGateway(dAtA, uint64(key))
-		v44 := r.Int63()
+		v45 := r.Int63()
 		if r.Intn(2) == 0 {
-			v44 *= -1
+			v45 *= -1
 		}
-		dAtA = encodeVarintPopulateGateway(dAtA, uint64(v44))
+		dAtA = encodeVarintPopulateGateway(dAtA, uint64(v45))
 	case 1:
 		dAtA = encodeVarintPopulateGateway(dAtA, uint64(key))
 		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
@@ -4936,6 +5042,9 @@ func (m *ListGatewaysRequest) Size() (n int) {
 	if m.Page != 0 {
 		n += 1 + sovGateway(uint64(m.Page))
 	}
+	if m.Deleted {
+		n += 2
+	}
 	return n
 }
 
@@ -5016,6 +5125,14 @@ func (m *CreateGatewayAPIKeyRequest) Size() (n int) {
 		}
 		n += 1 + sovGateway(uint64(l)) + l
 	}
+	if m.ExpiresAt != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpiresAt)
+		n += 1 + l + sovGateway(uint64(l))
+	}
+	if m.Restrictions != nil {
+		l = m.Restrictions.Size()
+		n += 1 + l + sovGateway(uint64(l))
+	}
 	return n
 }
 
@@ -5029,6 +5146,8 @@ func (m *UpdateGatewayAPIKeyRequest) Size() (n int) {
 	n += 1 + l + sovGateway(uint64(l))
 	l = m.APIKey.Size()
 	n += 1 + l + sovGateway(uint64(l))
+	l = m.FieldMask.Size()
+	n += 1 + l + sovGateway(uint64(l))
 	return n
 }
 
@@ -5386,6 +5505,7 @@ func (this *ListGatewaysRequest) String() string {
 		`Order:` + fmt.Sprintf("%v", this.Order) + `,`,
 		`Limit:` + fmt.Sprintf("%v", this.Limit) + `,`,
 		`Page:` + fmt.Sprintf("%v", this.Page) + `,`,
+		`Deleted:` + fmt.Sprintf("%v", this.Deleted) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -5443,6 +5563,8 @@ func (this *CreateGatewayAPIKeyRequest) String() string {
 		`GatewayIdentifiers:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.GatewayIdentifiers), "GatewayIdentifiers", "GatewayIdentifiers", 1), `&`, ``, 1) + `,`,
 		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
 		`Rights:` + fmt.Sprintf("%v", this.Rights) + `,`,
+		`ExpiresAt:` + strings.Replace(fmt.Sprintf("%v", this.ExpiresAt), "Timestamp", "types.Timestamp", 1) + `,`,
+		`Restrictions:` + strings.Replace(fmt.Sprintf("%v", this.Restrictions), "APIKeyRestrictions", "APIKeyRestrictions", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -5454,6 +5576,7 @@ func (this *UpdateGatewayAPIKeyRequest) String() string {
 	s := strings.Join([]string{`&UpdateGatewayAPIKeyRequest{`,
 		`GatewayIdentifiers:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.GatewayIdentifiers), "GatewayIdentifiers", "GatewayIdentifiers", 1), `&`, ``, 1) + `,`,
 		`APIKey:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.APIKey), "APIKey", "APIKey", 1), `&`, ``, 1) + `,`,
+		`FieldMask:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.FieldMask), "FieldMask", "types.FieldMask", 1), `&`, ``, 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -7744,6 +7867,26 @@ func (m *ListGatewaysRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deleted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGateway
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Deleted = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGateway(dAtA[iNdEx:])
@@ -8411,6 +8554,78 @@ func (m *CreateGatewayAPIKeyRequest) Unmarshal(dAtA []byte) error {
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field Rights", wireType)
 			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGateway
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGateway
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGateway
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpiresAt == nil {
+				m.ExpiresAt = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.ExpiresAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Restrictions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGateway
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGateway
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGateway
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Restrictions == nil {
+				m.Restrictions = &APIKeyRestrictions{}
+			}
+			if err := m.Restrictions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGateway(dAtA[iNdEx:])
@@ -8530,6 +8745,39 @@ func (m *UpdateGatewayAPIKeyRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FieldMask", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGateway
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGateway
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGateway
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FieldMask.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGateway(dAtA[iNdEx:])
@@ -158,6 +158,7 @@ var ListGatewaysRequestFieldPathsNested = []string{
 	"collaborator.ids.user_ids",
 	"collaborator.ids.user_ids.email",
 	"collaborator.ids.user_ids.user_id",
+	"deleted",
 	"field_mask",
 	"limit",
 	"order",
@@ -166,6 +167,7 @@ var ListGatewaysRequestFieldPathsNested = []string{
 
 var ListGatewaysRequestFieldPathsTopLevel = []string{
 	"collaborator",
+	"deleted",
 	"field_mask",
 	"limit",
 	"order",
@@ -272,24 +274,36 @@ var GetGatewayAPIKeyRequestFieldPathsTopLevel = []string{
 	"key_id",
 }
 var CreateGatewayAPIKeyRequestFieldPathsNested = []string{
+	"expires_at",
 	"gateway_ids",
 	"gateway_ids.eui",
 	"gateway_ids.gateway_id",
 	"name",
+	"restrictions",
+	"restrictions.end_device_ids",
+	"restrictions.source_cidrs",
 	"rights",
 }
 
 var CreateGatewayAPIKeyRequestFieldPathsTopLevel = []string{
+	"expires_at",
 	"gateway_ids",
 	"name",
+	"restrictions",
 	"rights",
 }
 var UpdateGatewayAPIKeyRequestFieldPathsNested = []string{
 	"api_key",
+	"api_key.expires_at",
 	"api_key.id",
 	"api_key.key",
+	"api_key.last_used_at",
 	"api_key.name",
+	"api_key.restrictions",
+	"api_key.restrictions.end_device_ids",
+	"api_key.restrictions.source_cidrs",
 	"api_key.rights",
+	"field_mask",
 	"gateway_ids",
 	"gateway_ids.eui",
 	"gateway_ids.gateway_id",
@@ -297,6 +311,7 @@ var UpdateGatewayAPIKeyRequestFieldPathsNested = []string{
 
 var UpdateGatewayAPIKeyRequestFieldPathsTopLevel = []string{
 	"api_key",
+	"field_mask",
 	"gateway_ids",
 }
 var ListGatewayCollaboratorsRequestFieldPathsNested = []string{
@@ -93,71 +93,73 @@ func init() {
 }
 
 var fileDescriptor_72fc70018c9e7608 = []byte{
-	// 1016 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x4f, 0x6c, 0xdb, 0x54,
-	0x18, 0xf7, 0x2b, 0x53, 0x44, 0x5f, 0x07, 0xd5, 0x3e, 0xd0, 0xa8, 0x02, 0x7b, 0x19, 0xde, 0xd0,
-	0x46, 0x21, 0x76, 0x49, 0x61, 0x9a, 0xf8, 0x23, 0xb4, 0x96, 0x2d, 0xaa, 0xd8, 0xa4, 0x29, 0x63,
-	0x1c, 0x72, 0xa9, 0x9c, 0xe4, 0xc5, 0xb5, 0xe2, 0xfa, 0x05, 0xbf, 0x97, 0x56, 0xa5, 0xaa, 0x54,
-	0xed, 0x80, 0x36, 0x4e, 0x93, 0x10, 0x82, 0x0b, 0x12, 0xe2, 0xb4, 0xe3, 0xb8, 0xed, 0xb8, 0x63,
-	0x8f, 0x95, 0x76, 0xe9, 0x69, 0x5a, 0xec, 0x1d, 0x76, 0xe0, 0xb0, 0x63, 0x39, 0x81, 0xfc, 0x6c,
-	0xb7, 0x4e, 0x1c, 0xb7, 0xb1, 0xb4, 0x9b, 0xf3, 0xbe, 0xdf, 0xfb, 0x7d, 0xbf, 0xef, 0x6f, 0x1e,
-	0xbe, 0x68, 0x33, 0xd7, 0x58, 0x37, 0x9c, 0x32, 0x17, 0x46, 0xb3, 0xa3, 0x1b, 0x5d, 0x4b, 0x37,
-	0x0d, 0x41, 0xd7, 0x8d, 0x8d, 0x65, 0x4e, 0xdd, 0x35, 0xab, 0x49, 0xb9, 0xd6, 0x75, 0x99, 0x60,
-	0xf0, 0xa6, 0x10, 0x8e, 0x16, 0xa1, 0xb5, 0xb5, 0xf9, 0x62, 0xd9, 0xb4, 0xc4, 0x4a, 0xaf, 0xa1,
-	0x35, 0xd9, 0xaa, 0x6e, 0x32, 0x93, 0xe9, 0x12, 0xd6, 0xe8, 0xb5, 0xe5, 0x2f, 0xf9, 0x43, 0x7e,
-	0x85, 0xd7, 0x8b, 0xef, 0x99, 0x8c, 0x99, 0x36, 0x95, 0x1e, 0x0c, 0xc7, 0x61, 0xc2, 0x10, 0x16,
-	0x73, 0x22, 0xf2, 0xe2, 0xbb, 0x91, 0xf5, 0x80, 0x83, 0xae, 0x76, 0xc5, 0x46, 0x64, 0x3c, 0x3b,
-	0x6c, 0x6c, 0x5b, 0xd4, 0x6e, 0x2d, 0xaf, 0x1a, 0xbc, 0x13, 0x21, 0x4a, 0x99, 0x51, 0x44, 0x80,
-	0x73, 0x69, 0x80, 0xd5, 0xa2, 0x8e, 0xb0, 0xda, 0x16, 0x75, 0x63, 0x11, 0x24, 0x0d, 0x72, 0x2d,
-	0x73, 0x45, 0x44, 0x76, 0xf5, 0x6f, 0x84, 0x4b, 0x37, 0x7b, 0xb6, 0x5d, 0x0d, 0xa9, 0x17, 0x99,
-	0xd3, 0xb6, 0xcc, 0x9e, 0x2b, 0x03, 0xa9, 0xd1, 0x1f, 0x7a, 0x94, 0x0b, 0xb8, 0x81, 0xa7, 0xe2,
-	0xfc, 0x59, 0x2d, 0x3e, 0x83, 0xce, 0xa2, 0x8b, 0x53, 0x15, 0x55, 0x1b, 0xcc, 0x9d, 0x16, 0x31,
-	0x2c, 0x1d, 0x4a, 0x58, 0x78, 0x7d, 0xe7, 0x69, 0x49, 0xd9, 0x7d, 0x5a, 0x42, 0x35, 0x6c, 0xc6,
-	0x56, 0x0e, 0x5f, 0x63, 0x7c, 0x18, 0xec, 0xcc, 0x84, 0x64, 0x2b, 0x6a, 0x61, 0x3e, 0xb4, 0x38,
-	0x1f, 0xda, 0xb5, 0x00, 0x72, 0xc3, 0xe0, 0x9d, 0x85, 0x13, 0x01, 0x4b, 0x6d, 0xb2, 0x1d, 0x1f,
-	0x54, 0xfe, 0x2b, 0xe0, 0xe9, 0xc8, 0x5b, 0x8d, 0x9a, 0x16, 0x17, 0xee, 0x06, 0x3c, 0x41, 0xb8,
-	0xb0, 0xe8, 0x52, 0x43, 0x50, 0x38, 0x3f, 0xac, 0x2c, 0x3c, 0x3f, 0xb8, 0x21, 0x83, 0x2a, 0xbe,
-	0x93, 0xa1, 0x5f, 0xbd, 0x87, 0xee, 0x3c, 0x79, 0xfe, 0xcb, 0xc4, 0x1d, 0xa4, 0xea, 0x7a, 0x8f,
-	0x53, 0x97, 0xeb, 0x9b, 0x4d, 0x66, 0xdb, 0x46, 0x83, 0xb9, 0x86, 0x60, 0xae, 0x16, 0x9c, 0x05,
-	0x79, 0x88, 0x3f, 0xb6, 0xe2, 0xb2, 0xf0, 0xcf, 0xd1, 0x6c, 0xfd, 0xba, 0x5a, 0xd5, 0x99, 0x6b,
-	0x1a, 0x8e, 0xf5, 0x63, 0xd8, 0x0c, 0x43, 0xb7, 0x93, 0x36, 0xc9, 0x32, 0x74, 0x30, 0xc0, 0x06,
-	0xab, 0xf8, 0xb5, 0x2a, 0x15, 0xf0, 0x7e, 0x4a, 0x2b, 0x15, 0xe3, 0x86, 0x33, 0x2b, 0xa3, 0x39,
-	0x0f, 0xea, 0x01, 0xb1, 0xbe, 0x99, 0xa8, 0xa6, 0x76, 0xf8, 0xbd, 0x05, 0x0e, 0x7e, 0xbb, 0x4a,
-	0x45, 0xa2, 0x82, 0xd7, 0x98, 0x7b, 0xf5, 0xf6, 0x12, 0x54, 0xb2, 0xfd, 0xa7, 0xc0, 0xb1, 0xa0,
-	0x31, 0xfa, 0x03, 0xf6, 0x10, 0x3e, 0x71, 0xdd, 0xe2, 0x02, 0xce, 0x0d, 0x83, 0x83, 0xd3, 0xe8,
-	0x02, 0x8f, 0x19, 0x67, 0x32, 0x18, 0xb9, 0x7a, 0x3f, 0x2c, 0xd9, 0x3d, 0x04, 0x93, 0x07, 0x51,
-	0xd6, 0x3f, 0x81, 0xbc, 0xf5, 0xab, 0x2f, 0xc1, 0xab, 0x2a, 0x1e, 0xac, 0xe1, 0xc2, 0xed, 0x6e,
-	0x6b, 0x64, 0x3b, 0x86, 0xe7, 0xe3, 0xd6, 0xaf, 0x2c, 0x43, 0xbb, 0x50, 0x1c, 0x51, 0x3f, 0x6d,
-	0xa8, 0x7e, 0x41, 0xc7, 0xb4, 0x70, 0xe1, 0x1b, 0x6a, 0x53, 0x41, 0x61, 0x8c, 0x02, 0x14, 0x4f,
-	0xa7, 0xc6, 0xee, 0x6a, 0xb0, 0xa3, 0x54, 0x22, 0x9d, 0xce, 0xcc, 0x9e, 0x1e, 0xd9, 0x34, 0x5b,
-	0x95, 0xe7, 0x18, 0xbf, 0x11, 0xd1, 0x5d, 0x69, 0x36, 0x29, 0xe7, 0xc0, 0x30, 0x0e, 0x6a, 0x56,
-	0x93, 0xbb, 0x65, 0x4c, 0xdf, 0x43, 0x98, 0xf0, 0xae, 0xfa, 0x81, 0xf4, 0x5d, 0x82, 0x33, 0xa3,
-	0x7d, 0x47, 0xeb, 0x0b, 0x7e, 0x46, 0xf8, 0x64, 0x38, 0xd8, 0x57, 0x6e, 0x2e, 0x7d, 0x4b, 0x37,
-	0x60, 0xf6, 0xc8, 0xb1, 0x0f, 0x41, 0x71, 0xb6, 0x53, 0xbe, 0x43, 0xb3, 0x7a, 0x49, 0xfa, 0x9e,
-	0x53, 0x3f, 0x3a, 0x7e, 0x58, 0x82, 0x55, 0x5a, 0xee, 0xd0, 0x70, 0x4e, 0x7f, 0x42, 0x78, 0x2a,
-	0x08, 0x3f, 0xa4, 0xe1, 0xf0, 0xe1, 0x11, 0xfd, 0x1c, 0x61, 0x32, 0x0b, 0x1f, 0xd9, 0xd5, 0x79,
-	0xa9, 0xa5, 0x0c, 0x79, 0xb4, 0x04, 0x59, 0x99, 0xac, 0xd2, 0x48, 0x07, 0x5c, 0xc8, 0x9e, 0xdb,
-	0xf1, 0xf2, 0xf1, 0xa5, 0xd4, 0x70, 0x09, 0x3e, 0xcd, 0xa1, 0x41, 0xdf, 0xec, 0xd0, 0x70, 0x9d,
-	0xfc, 0x86, 0xf0, 0xc9, 0xb0, 0xd9, 0xb3, 0x4a, 0x34, 0x30, 0x0a, 0xe3, 0x49, 0x5a, 0x94, 0x92,
-	0xbe, 0x2a, 0x5e, 0xce, 0x25, 0xc9, 0xe8, 0x5a, 0xcb, 0x1d, 0x1a, 0x4c, 0x8c, 0x9c, 0x92, 0x7f,
-	0x26, 0xf0, 0x74, 0x95, 0x8a, 0xc5, 0xc4, 0x70, 0x43, 0x39, 0x3b, 0x59, 0x49, 0x5c, 0xac, 0x6f,
-	0x54, 0x6e, 0x07, 0x71, 0xbc, 0xcb, 0x1c, 0x4e, 0xd5, 0xed, 0x09, 0xa9, 0xf8, 0x5f, 0x04, 0xfa,
-	0x18, 0x92, 0x93, 0xfb, 0xa6, 0xfe, 0x3d, 0x7c, 0x97, 0xf3, 0x8a, 0xdc, 0x78, 0xc7, 0x2d, 0xbc,
-	0xba, 0x03, 0x76, 0x5e, 0xde, 0xe4, 0xa6, 0xcb, 0xbb, 0x15, 0xe1, 0x57, 0x84, 0xa7, 0x6f, 0x1d,
-	0x97, 0xee, 0x5b, 0x47, 0xa6, 0x3b, 0x6b, 0x53, 0x7d, 0x21, 0x93, 0xfb, 0x59, 0x71, 0x2e, 0x67,
-	0x40, 0x72, 0x6c, 0xff, 0x40, 0xf8, 0x54, 0x30, 0x99, 0x49, 0x87, 0x1c, 0xf4, 0x23, 0x86, 0x77,
-	0x00, 0x19, 0x6b, 0x3b, 0x93, 0xda, 0x3c, 0x49, 0x94, 0x7a, 0x59, 0x4a, 0xac, 0x40, 0x6e, 0x89,
-	0x95, 0x35, 0xfc, 0x56, 0xea, 0x5d, 0xc6, 0x5c, 0x58, 0xc6, 0xa7, 0x82, 0x27, 0xdb, 0xc0, 0x5b,
-	0x2d, 0xad, 0xfa, 0x98, 0x57, 0x5d, 0xe6, 0x3f, 0xce, 0x1c, 0x5a, 0xf8, 0x0b, 0xed, 0xf4, 0x09,
-	0xda, 0xed, 0x13, 0xb4, 0xd7, 0x27, 0xca, 0xb3, 0x3e, 0x51, 0x5e, 0xf4, 0x89, 0xf2, 0xb2, 0x4f,
-	0x94, 0xfd, 0x3e, 0x41, 0xdb, 0x1e, 0x41, 0x77, 0x3d, 0xa2, 0x3c, 0xf0, 0x08, 0x7a, 0xe8, 0x11,
-	0xe5, 0x91, 0x47, 0x94, 0xc7, 0x1e, 0x51, 0x76, 0x3c, 0x82, 0x76, 0x3d, 0x82, 0xf6, 0x3c, 0xa2,
-	0x3c, 0xf3, 0x08, 0x7a, 0xe1, 0x11, 0xe5, 0xa5, 0x47, 0xd0, 0xbe, 0x47, 0x94, 0x6d, 0x9f, 0x28,
-	0x77, 0x7d, 0x82, 0xee, 0xfb, 0x44, 0xf9, 0xdd, 0x27, 0xe8, 0x4f, 0x9f, 0x28, 0x0f, 0x7c, 0xa2,
-	0x3c, 0xf4, 0x09, 0x7a, 0xe4, 0x13, 0xf4, 0xd8, 0x27, 0xa8, 0xfe, 0xb1, 0xc9, 0x34, 0xb1, 0x42,
-	0xc5, 0x8a, 0xe5, 0x98, 0x5c, 0x73, 0xa8, 0x58, 0x67, 0x6e, 0x47, 0x1f, 0x7c, 0xc0, 0x76, 0x3b,
-	0xa6, 0x2e, 0x84, 0xd3, 0x6d, 0x34, 0x0a, 0xb2, 0x13, 0xe6, 0xff, 0x0f, 0x00, 0x00, 0xff, 0xff,
-	0xeb, 0x32, 0x4a, 0xec, 0xee, 0x0b, 0x00, 0x00,
+	// 1053 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x4d, 0x6c, 0x1b, 0x45,
+	0x14, 0xde, 0x09, 0x25, 0xb4, 0x93, 0x42, 0xd4, 0x01, 0x95, 0xc8, 0xb4, 0xe3, 0xb0, 0x0d, 0xb4,
+	0x18, 0xbc, 0x1b, 0x1c, 0xa8, 0x2a, 0x7e, 0x84, 0x9a, 0xd0, 0x5a, 0x11, 0xad, 0x14, 0xb9, 0x94,
+	0x83, 0x2f, 0xd1, 0xda, 0x1e, 0x6f, 0x56, 0xb6, 0x77, 0x96, 0x99, 0x71, 0x22, 0x13, 0x45, 0x8a,
+	0x7a, 0x40, 0x2d, 0xa7, 0x4a, 0x08, 0xc1, 0x05, 0x09, 0x71, 0xea, 0xb1, 0xdc, 0x7a, 0xec, 0x31,
+	0xc7, 0x48, 0xbd, 0x84, 0x4b, 0x55, 0xef, 0xf6, 0xd0, 0x03, 0x87, 0x1e, 0xcb, 0x0d, 0xed, 0xec,
+	0x6e, 0xb2, 0xf6, 0x7a, 0x13, 0xaf, 0xca, 0x6d, 0x3d, 0xef, 0x9b, 0xf7, 0x7d, 0xef, 0xd7, 0x03,
+	0x2f, 0xb4, 0x29, 0x33, 0x36, 0x0c, 0xbb, 0xc8, 0x85, 0x51, 0x6f, 0xe9, 0x86, 0x63, 0xe9, 0xa6,
+	0x21, 0xc8, 0x86, 0xd1, 0x5b, 0xe5, 0x84, 0xad, 0x5b, 0x75, 0xc2, 0x35, 0x87, 0x51, 0x41, 0xd1,
+	0x1b, 0x42, 0xd8, 0x5a, 0x88, 0xd6, 0xd6, 0x17, 0x72, 0x45, 0xd3, 0x12, 0x6b, 0xdd, 0x9a, 0x56,
+	0xa7, 0x1d, 0xdd, 0xa4, 0x26, 0xd5, 0x25, 0xac, 0xd6, 0x6d, 0xca, 0x5f, 0xf2, 0x87, 0xfc, 0x0a,
+	0xae, 0xe7, 0xce, 0x98, 0x94, 0x9a, 0x6d, 0x22, 0x19, 0x0c, 0xdb, 0xa6, 0xc2, 0x10, 0x16, 0xb5,
+	0x43, 0xe7, 0xb9, 0x77, 0x42, 0xeb, 0xbe, 0x0f, 0xd2, 0x71, 0x44, 0x2f, 0x34, 0xce, 0x0e, 0x1b,
+	0x9b, 0x16, 0x69, 0x37, 0x56, 0x3b, 0x06, 0x6f, 0x85, 0x88, 0x7c, 0x6a, 0x14, 0x21, 0xe0, 0x5c,
+	0x12, 0x60, 0x35, 0x88, 0x2d, 0xac, 0xa6, 0x45, 0x58, 0x24, 0x02, 0x27, 0x41, 0xcc, 0x32, 0xd7,
+	0x44, 0x68, 0x57, 0xff, 0x02, 0x30, 0xbf, 0xd2, 0x6d, 0xb7, 0xcb, 0x81, 0xeb, 0x25, 0x6a, 0x37,
+	0x2d, 0xb3, 0xcb, 0x64, 0x20, 0x15, 0xf2, 0x7d, 0x97, 0x70, 0x81, 0xae, 0xc3, 0xa9, 0x28, 0x7f,
+	0x56, 0x83, 0xcf, 0x80, 0x59, 0x70, 0x61, 0xaa, 0xa4, 0x6a, 0x83, 0xb9, 0xd3, 0x42, 0x0f, 0xcb,
+	0x07, 0x12, 0x16, 0x8f, 0xef, 0x3c, 0xce, 0x2b, 0xbb, 0x8f, 0xf3, 0xa0, 0x02, 0xcd, 0xc8, 0xca,
+	0xd1, 0x57, 0x10, 0x1e, 0x04, 0x3b, 0x33, 0x21, 0xbd, 0xe5, 0xb4, 0x20, 0x1f, 0x5a, 0x94, 0x0f,
+	0xed, 0xaa, 0x0f, 0xb9, 0x6e, 0xf0, 0xd6, 0xe2, 0x31, 0xdf, 0x4b, 0xe5, 0x44, 0x33, 0x3a, 0x28,
+	0xfd, 0x7d, 0x1c, 0x4e, 0x87, 0x6c, 0x15, 0x62, 0x5a, 0x5c, 0xb0, 0x1e, 0x7a, 0x04, 0xe0, 0xe4,
+	0x12, 0x23, 0x86, 0x20, 0x68, 0x6e, 0x58, 0x59, 0x70, 0xbe, 0x7f, 0x43, 0x06, 0x95, 0x7b, 0x3b,
+	0x45, 0xbf, 0x7a, 0x07, 0xdc, 0x7a, 0xf4, 0xf4, 0xe7, 0x89, 0x5b, 0x40, 0xd5, 0xf5, 0x2e, 0x27,
+	0x8c, 0xeb, 0x9b, 0x75, 0xda, 0x6e, 0x1b, 0x35, 0xca, 0x0c, 0x41, 0x99, 0xe6, 0x9f, 0xf9, 0x79,
+	0x88, 0x3e, 0xb6, 0xa2, 0xb2, 0xf0, 0xcf, 0x40, 0xa1, 0x7a, 0x4d, 0x2d, 0xeb, 0x94, 0x99, 0x86,
+	0x6d, 0xfd, 0x10, 0x34, 0xc3, 0xd0, 0xed, 0xb8, 0x4d, 0x7a, 0x19, 0x3a, 0x18, 0xf0, 0x86, 0x3a,
+	0xf0, 0x95, 0x32, 0x11, 0xe8, 0xdd, 0x84, 0x56, 0x22, 0xc6, 0x0d, 0xa7, 0x20, 0xa3, 0x99, 0x43,
+	0xea, 0xbe, 0x63, 0x7d, 0x33, 0x56, 0x4d, 0xed, 0xe0, 0x7b, 0x0b, 0xd9, 0xf0, 0xad, 0x32, 0x11,
+	0xb1, 0x0a, 0x5e, 0xa5, 0xec, 0xca, 0xcd, 0x65, 0x54, 0x4a, 0xe7, 0x4f, 0x80, 0x23, 0x41, 0x63,
+	0xf4, 0x07, 0xda, 0x03, 0xf0, 0xd8, 0x35, 0x8b, 0x0b, 0x74, 0x6e, 0x18, 0xec, 0x9f, 0x86, 0x17,
+	0x78, 0xe4, 0x71, 0x26, 0xc5, 0x23, 0x57, 0xef, 0x06, 0x25, 0xbb, 0x03, 0xd0, 0x89, 0xfd, 0x28,
+	0xab, 0x1f, 0xa3, 0xac, 0xf5, 0xab, 0x2e, 0xa3, 0xff, 0xab, 0x78, 0x68, 0x1d, 0x4e, 0xde, 0x74,
+	0x1a, 0x23, 0xdb, 0x31, 0x38, 0x1f, 0xb7, 0x7e, 0x45, 0x19, 0xda, 0xf9, 0xdc, 0x88, 0xfa, 0x69,
+	0x43, 0xf5, 0xf3, 0x3b, 0xa6, 0x01, 0x27, 0xbf, 0x26, 0x6d, 0x22, 0x08, 0x1a, 0xa3, 0x00, 0xb9,
+	0xd3, 0x89, 0xb1, 0xbb, 0xe2, 0xef, 0x28, 0x15, 0x4b, 0xd2, 0x99, 0xc2, 0xe9, 0x91, 0x4d, 0xb3,
+	0x85, 0x3a, 0xf0, 0xb5, 0x0a, 0xe1, 0x82, 0xb2, 0x97, 0xa3, 0x79, 0x5f, 0xd2, 0xcc, 0xaa, 0x78,
+	0x34, 0x8d, 0xce, 0x42, 0x0e, 0x0b, 0xbe, 0xba, 0xd2, 0x65, 0xe6, 0xcb, 0x91, 0xcd, 0x49, 0x32,
+	0x5c, 0x38, 0x93, 0x42, 0xe6, 0xf8, 0x0c, 0xa5, 0xa7, 0x10, 0xbe, 0x1e, 0x3a, 0xbd, 0x5c, 0xaf,
+	0x13, 0xce, 0x11, 0x85, 0xd0, 0xef, 0xc6, 0x8a, 0xdc, 0x9a, 0x63, 0x2a, 0x18, 0xc2, 0x04, 0x77,
+	0xd5, 0xf7, 0xa4, 0x82, 0x3c, 0x3a, 0x9b, 0x16, 0x6e, 0x40, 0xf1, 0x13, 0x80, 0x27, 0x83, 0x95,
+	0x75, 0x79, 0x65, 0xf9, 0x1b, 0xd2, 0x43, 0x85, 0x43, 0x17, 0x5a, 0x00, 0x8a, 0xfa, 0x28, 0xc1,
+	0x1d, 0x98, 0xd5, 0x8b, 0x92, 0x7b, 0x5e, 0xfd, 0xf0, 0xe8, 0x35, 0xe0, 0xff, 0x49, 0x14, 0x5b,
+	0x24, 0xd8, 0x40, 0x3f, 0x02, 0x38, 0xe5, 0x87, 0x1f, 0xb8, 0xe1, 0xe8, 0x83, 0x43, 0x26, 0x35,
+	0xc4, 0xa4, 0xb6, 0x74, 0x68, 0x57, 0x17, 0xa4, 0x96, 0x22, 0xca, 0xa2, 0xc5, 0xcf, 0xca, 0x89,
+	0x32, 0x09, 0x75, 0xa0, 0xf3, 0xe9, 0x1b, 0x69, 0xbc, 0x7c, 0x7c, 0x21, 0x35, 0x5c, 0x44, 0x9f,
+	0x64, 0xd0, 0xa0, 0x6f, 0xb6, 0x48, 0xd0, 0xff, 0xbf, 0x02, 0x78, 0x32, 0x18, 0xe3, 0xb4, 0x12,
+	0x0d, 0x0c, 0xf9, 0x78, 0x92, 0x96, 0xa4, 0xa4, 0x2f, 0x73, 0x97, 0x32, 0x49, 0x32, 0x1c, 0x6b,
+	0xb5, 0x45, 0x7a, 0x5a, 0x38, 0xff, 0xff, 0x4c, 0xc0, 0xe9, 0x32, 0x11, 0x4b, 0xb1, 0xb5, 0x85,
+	0x8a, 0xe9, 0xc9, 0x8a, 0xe3, 0x22, 0x7d, 0xa3, 0x72, 0x3b, 0x88, 0xe3, 0x0e, 0xb5, 0x39, 0x51,
+	0xb7, 0x27, 0xa4, 0xe2, 0x7f, 0x01, 0xd2, 0xc7, 0x90, 0x1c, 0xdf, 0xa4, 0xd5, 0xef, 0xd0, 0xb7,
+	0x19, 0xaf, 0xc8, 0x5d, 0x7e, 0xd4, 0x2a, 0xaf, 0xda, 0xa8, 0x9d, 0xd5, 0x6f, 0x7c, 0x87, 0x67,
+	0xdd, 0xf7, 0xe8, 0x17, 0x00, 0xa7, 0x6f, 0x1c, 0x95, 0xee, 0x1b, 0x87, 0xa6, 0x3b, 0x6d, 0x5f,
+	0x7d, 0x2e, 0x93, 0xfb, 0x69, 0x6e, 0x3e, 0x63, 0x40, 0x72, 0x6c, 0x7f, 0x07, 0xf0, 0x94, 0x3f,
+	0x99, 0x71, 0x42, 0x8e, 0xf4, 0x43, 0x86, 0x77, 0x00, 0x19, 0x69, 0x3b, 0x9b, 0xd8, 0x3c, 0x71,
+	0x94, 0x7a, 0x49, 0x4a, 0x2c, 0xa1, 0xcc, 0x12, 0x4b, 0xeb, 0xf0, 0xcd, 0xc4, 0x8b, 0x93, 0x32,
+	0xb4, 0x0a, 0x4f, 0xf9, 0x8f, 0xd1, 0x81, 0x57, 0x68, 0x52, 0xf5, 0x11, 0xef, 0xd5, 0xd4, 0xff,
+	0xd2, 0x79, 0xb0, 0xf8, 0x27, 0xd8, 0xe9, 0x63, 0xb0, 0xdb, 0xc7, 0x60, 0xaf, 0x8f, 0x95, 0x27,
+	0x7d, 0xac, 0x3c, 0xeb, 0x63, 0xe5, 0x79, 0x1f, 0x2b, 0x2f, 0xfa, 0x18, 0x6c, 0xbb, 0x18, 0xdc,
+	0x76, 0xb1, 0x72, 0xcf, 0xc5, 0xe0, 0xbe, 0x8b, 0x95, 0x07, 0x2e, 0x56, 0x1e, 0xba, 0x58, 0xd9,
+	0x71, 0x31, 0xd8, 0x75, 0x31, 0xd8, 0x73, 0xb1, 0xf2, 0xc4, 0xc5, 0xe0, 0x99, 0x8b, 0x95, 0xe7,
+	0x2e, 0x06, 0x2f, 0x5c, 0xac, 0x6c, 0x7b, 0x58, 0xb9, 0xed, 0x61, 0x70, 0xd7, 0xc3, 0xca, 0x6f,
+	0x1e, 0x06, 0x7f, 0x78, 0x58, 0xb9, 0xe7, 0x61, 0xe5, 0xbe, 0x87, 0xc1, 0x03, 0x0f, 0x83, 0x87,
+	0x1e, 0x06, 0xd5, 0x8f, 0x4c, 0xaa, 0x89, 0x35, 0x22, 0xd6, 0x2c, 0xdb, 0xe4, 0x9a, 0x4d, 0xc4,
+	0x06, 0x65, 0x2d, 0x7d, 0xf0, 0x69, 0xee, 0xb4, 0x4c, 0x5d, 0x08, 0xdb, 0xa9, 0xd5, 0x26, 0x65,
+	0x27, 0x2c, 0xfc, 0x37, 0x00, 0x0d, 0x1e, 0xd2, 0x05, 0xc8, 0x0c, 0x00, 0x00,
 }
 
 func (this *PullGatewayConfigurationRequest) Equal(that interface{}) bool {
@@ -212,6 +214,11 @@ type GatewayRegistryClient interface {
 	List(ctx context.Context, in *ListGatewaysRequest, opts ...grpc.CallOption) (*Gateways, error)
 	Update(ctx context.Context, in *UpdateGatewayRequest, opts ...grpc.CallOption) (*Gateway, error)
 	Delete(ctx context.Context, in *GatewayIdentifiers, opts ...grpc.CallOption) (*types.Empty, error)
+	// Restore a recently deleted gateway.
+	Restore(ctx context.Context, in *GatewayIdentifiers, opts ...grpc.CallOption) (*types.Empty, error)
+	// Purge the gateway. This permanently deletes the gateway and its API keys, memberships,
+	// attributes and contact info, and releases the ID. This is only allowed for admins.
+	Purge(ctx context.Context, in *GatewayIdentifiers, opts ...grpc.CallOption) (*types.Empty, error)
 }
 
 type gatewayRegistryClient struct {
@@ -276,6 +283,24 @@ func (c *gatewayRegistryClient) Delete(ctx context.Context, in *GatewayIdentifie
 	return out, nil
 }
 
+func (c *gatewayRegistryClient) Restore(ctx context.Context, in *GatewayIdentifiers, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.GatewayRegistry/Restore", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gatewayRegistryClient) Purge(ctx context.Context, in *GatewayIdentifiers, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.GatewayRegistry/Purge", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // GatewayRegistryServer is the server API for GatewayRegistry service.
 type GatewayRegistryServer interface {
 	// Create a new gateway. This also sets the given organization or user as
@@ -290,6 +315,11 @@ type GatewayRegistryServer interface {
 	List(context.Context, *ListGatewaysRequest) (*Gateways, error)
 	Update(context.Context, *UpdateGatewayRequest) (*Gateway, error)
 	Delete(context.Context, *GatewayIdentifiers) (*types.Empty, error)
+	// Restore a recently deleted gateway.
+	Restore(context.Context, *GatewayIdentifiers) (*types.Empty, error)
+	// Purge the gateway. This permanently deletes the gateway and its API keys, memberships,
+	// attributes and contact info, and releases the ID. This is only allowed for admins.
+	Purge(context.Context, *GatewayIdentifiers) (*types.Empty, error)
 }
 
 // UnimplementedGatewayRegistryServer can be embedded to have forward compatible implementations.
@@ -314,6 +344,12 @@ func (*UnimplementedGatewayRegistryServer) Update(ctx context.Context, req *Upda
 func (*UnimplementedGatewayRegistryServer) Delete(ctx context.Context, req *GatewayIdentifiers) (*types.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
 }
+func (*UnimplementedGatewayRegistryServer) Restore(ctx context.Context, req *GatewayIdentifiers) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Restore not implemented")
+}
+func (*UnimplementedGatewayRegistryServer) Purge(ctx context.Context, req *GatewayIdentifiers) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Purge not implemented")
+}
 
 func RegisterGatewayRegistryServer(s *grpc.Server, srv GatewayRegistryServer) {
 	s.RegisterService(&_GatewayRegistry_serviceDesc, srv)
@@ -427,6 +463,42 @@ func _GatewayRegistry_Delete_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _GatewayRegistry_Restore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GatewayIdentifiers)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayRegistryServer).Restore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.GatewayRegistry/Restore",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayRegistryServer).Restore(ctx, req.(*GatewayIdentifiers))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GatewayRegistry_Purge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GatewayIdentifiers)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayRegistryServer).Purge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.GatewayRegistry/Purge",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayRegistryServer).Purge(ctx, req.(*GatewayIdentifiers))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _GatewayRegistry_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ttn.lorawan.v3.GatewayRegistry",
 	HandlerType: (*GatewayRegistryServer)(nil),
@@ -455,6 +527,14 @@ var _GatewayRegistry_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Delete",
 			Handler:    _GatewayRegistry_Delete_Handler,
 		},
+		{
+			MethodName: "Restore",
+			Handler:    _GatewayRegistry_Restore_Handler,
+		},
+		{
+			MethodName: "Purge",
+			Handler:    _GatewayRegistry_Purge_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "lorawan-stack/api/gateway_services.proto",
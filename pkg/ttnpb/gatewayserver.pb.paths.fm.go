@@ -13,6 +13,91 @@ var GatewayUpFieldPathsNested = []string{
 	"gateway_status.versions",
 	"tx_acknowledgment",
 	"tx_acknowledgment.correlation_ids",
+	"tx_acknowledgment.downlink_message",
+	"tx_acknowledgment.downlink_message.correlation_ids",
+	"tx_acknowledgment.downlink_message.end_device_ids",
+	"tx_acknowledgment.downlink_message.end_device_ids.application_ids",
+	"tx_acknowledgment.downlink_message.end_device_ids.application_ids.application_id",
+	"tx_acknowledgment.downlink_message.end_device_ids.dev_addr",
+	"tx_acknowledgment.downlink_message.end_device_ids.dev_eui",
+	"tx_acknowledgment.downlink_message.end_device_ids.device_id",
+	"tx_acknowledgment.downlink_message.end_device_ids.join_eui",
+	"tx_acknowledgment.downlink_message.payload",
+	"tx_acknowledgment.downlink_message.payload.Payload",
+	"tx_acknowledgment.downlink_message.payload.Payload.join_accept_payload",
+	"tx_acknowledgment.downlink_message.payload.Payload.join_accept_payload.cf_list",
+	"tx_acknowledgment.downlink_message.payload.Payload.join_accept_payload.cf_list.ch_masks",
+	"tx_acknowledgment.downlink_message.payload.Payload.join_accept_payload.cf_list.freq",
+	"tx_acknowledgment.downlink_message.payload.Payload.join_accept_payload.cf_list.type",
+	"tx_acknowledgment.downlink_message.payload.Payload.join_accept_payload.dev_addr",
+	"tx_acknowledgment.downlink_message.payload.Payload.join_accept_payload.dl_settings",
+	"tx_acknowledgment.downlink_message.payload.Payload.join_accept_payload.dl_settings.opt_neg",
+	"tx_acknowledgment.downlink_message.payload.Payload.join_accept_payload.dl_settings.rx1_dr_offset",
+	"tx_acknowledgment.downlink_message.payload.Payload.join_accept_payload.dl_settings.rx2_dr",
+	"tx_acknowledgment.downlink_message.payload.Payload.join_accept_payload.encrypted",
+	"tx_acknowledgment.downlink_message.payload.Payload.join_accept_payload.join_nonce",
+	"tx_acknowledgment.downlink_message.payload.Payload.join_accept_payload.net_id",
+	"tx_acknowledgment.downlink_message.payload.Payload.join_accept_payload.rx_delay",
+	"tx_acknowledgment.downlink_message.payload.Payload.join_request_payload",
+	"tx_acknowledgment.downlink_message.payload.Payload.join_request_payload.dev_eui",
+	"tx_acknowledgment.downlink_message.payload.Payload.join_request_payload.dev_nonce",
+	"tx_acknowledgment.downlink_message.payload.Payload.join_request_payload.join_eui",
+	"tx_acknowledgment.downlink_message.payload.Payload.mac_payload",
+	"tx_acknowledgment.downlink_message.payload.Payload.mac_payload.decoded_payload",
+	"tx_acknowledgment.downlink_message.payload.Payload.mac_payload.f_hdr",
+	"tx_acknowledgment.downlink_message.payload.Payload.mac_payload.f_hdr.dev_addr",
+	"tx_acknowledgment.downlink_message.payload.Payload.mac_payload.f_hdr.f_cnt",
+	"tx_acknowledgment.downlink_message.payload.Payload.mac_payload.f_hdr.f_ctrl",
+	"tx_acknowledgment.downlink_message.payload.Payload.mac_payload.f_hdr.f_ctrl.ack",
+	"tx_acknowledgment.downlink_message.payload.Payload.mac_payload.f_hdr.f_ctrl.adr",
+	"tx_acknowledgment.downlink_message.payload.Payload.mac_payload.f_hdr.f_ctrl.adr_ack_req",
+	"tx_acknowledgment.downlink_message.payload.Payload.mac_payload.f_hdr.f_ctrl.class_b",
+	"tx_acknowledgment.downlink_message.payload.Payload.mac_payload.f_hdr.f_ctrl.f_pending",
+	"tx_acknowledgment.downlink_message.payload.Payload.mac_payload.f_hdr.f_opts",
+	"tx_acknowledgment.downlink_message.payload.Payload.mac_payload.f_port",
+	"tx_acknowledgment.downlink_message.payload.Payload.mac_payload.frm_payload",
+	"tx_acknowledgment.downlink_message.payload.Payload.rejoin_request_payload",
+	"tx_acknowledgment.downlink_message.payload.Payload.rejoin_request_payload.dev_eui",
+	"tx_acknowledgment.downlink_message.payload.Payload.rejoin_request_payload.join_eui",
+	"tx_acknowledgment.downlink_message.payload.Payload.rejoin_request_payload.net_id",
+	"tx_acknowledgment.downlink_message.payload.Payload.rejoin_request_payload.rejoin_cnt",
+	"tx_acknowledgment.downlink_message.payload.Payload.rejoin_request_payload.rejoin_type",
+	"tx_acknowledgment.downlink_message.payload.m_hdr",
+	"tx_acknowledgment.downlink_message.payload.m_hdr.m_type",
+	"tx_acknowledgment.downlink_message.payload.m_hdr.major",
+	"tx_acknowledgment.downlink_message.payload.mic",
+	"tx_acknowledgment.downlink_message.raw_payload",
+	"tx_acknowledgment.downlink_message.settings",
+	"tx_acknowledgment.downlink_message.settings.request",
+	"tx_acknowledgment.downlink_message.settings.request.absolute_time",
+	"tx_acknowledgment.downlink_message.settings.request.advanced",
+	"tx_acknowledgment.downlink_message.settings.request.class",
+	"tx_acknowledgment.downlink_message.settings.request.downlink_paths",
+	"tx_acknowledgment.downlink_message.settings.request.frequency_plan_id",
+	"tx_acknowledgment.downlink_message.settings.request.priority",
+	"tx_acknowledgment.downlink_message.settings.request.rx1_data_rate_index",
+	"tx_acknowledgment.downlink_message.settings.request.rx1_delay",
+	"tx_acknowledgment.downlink_message.settings.request.rx1_frequency",
+	"tx_acknowledgment.downlink_message.settings.request.rx2_data_rate_index",
+	"tx_acknowledgment.downlink_message.settings.request.rx2_frequency",
+	"tx_acknowledgment.downlink_message.settings.scheduled",
+	"tx_acknowledgment.downlink_message.settings.scheduled.coding_rate",
+	"tx_acknowledgment.downlink_message.settings.scheduled.data_rate",
+	"tx_acknowledgment.downlink_message.settings.scheduled.data_rate.modulation",
+	"tx_acknowledgment.downlink_message.settings.scheduled.data_rate.modulation.fsk",
+	"tx_acknowledgment.downlink_message.settings.scheduled.data_rate.modulation.fsk.bit_rate",
+	"tx_acknowledgment.downlink_message.settings.scheduled.data_rate.modulation.lora",
+	"tx_acknowledgment.downlink_message.settings.scheduled.data_rate.modulation.lora.bandwidth",
+	"tx_acknowledgment.downlink_message.settings.scheduled.data_rate.modulation.lora.spreading_factor",
+	"tx_acknowledgment.downlink_message.settings.scheduled.data_rate_index",
+	"tx_acknowledgment.downlink_message.settings.scheduled.downlink",
+	"tx_acknowledgment.downlink_message.settings.scheduled.downlink.antenna_index",
+	"tx_acknowledgment.downlink_message.settings.scheduled.downlink.invert_polarization",
+	"tx_acknowledgment.downlink_message.settings.scheduled.downlink.tx_power",
+	"tx_acknowledgment.downlink_message.settings.scheduled.enable_crc",
+	"tx_acknowledgment.downlink_message.settings.scheduled.frequency",
+	"tx_acknowledgment.downlink_message.settings.scheduled.time",
+	"tx_acknowledgment.downlink_message.settings.scheduled.timestamp",
 	"tx_acknowledgment.result",
 	"uplink_messages",
 }
@@ -28,6 +28,7 @@ func init() {
 	defineEnum(GRANT_AUTHORIZATION_CODE, "authorization code")
 	defineEnum(GRANT_PASSWORD, "username and password")
 	defineEnum(GRANT_REFRESH_TOKEN, "refresh token")
+	defineEnum(GRANT_DEVICE_CODE, "device code")
 
 	defineEnum(STATE_REQUESTED, "requested and pending review")
 	defineEnum(STATE_APPROVED, "reviewed and approved")
@@ -97,6 +98,7 @@ func init() {
 	defineEnum(PayloadFormatter_FORMATTER_GRPC_SERVICE, "gRPC service")
 	defineEnum(PayloadFormatter_FORMATTER_JAVASCRIPT, "JavaScript")
 	defineEnum(PayloadFormatter_FORMATTER_CAYENNELPP, "Cayenne LPP")
+	defineEnum(PayloadFormatter_FORMATTER_WASM, "WebAssembly")
 
 	defineEnum(RIGHT_USER_INFO, "view user information")
 	defineEnum(RIGHT_USER_SETTINGS_BASIC, "edit basic user settings")
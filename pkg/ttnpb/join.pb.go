@@ -44,10 +44,13 @@ type JoinRequest struct {
 	DownlinkSettings   DLSettings                                           `protobuf:"bytes,6,opt,name=downlink_settings,json=downlinkSettings,proto3" json:"downlink_settings"`
 	RxDelay            RxDelay                                              `protobuf:"varint,7,opt,name=rx_delay,json=rxDelay,proto3,enum=ttn.lorawan.v3.RxDelay" json:"rx_delay,omitempty"`
 	// Optional CFList.
-	CFList               *CFList  `protobuf:"bytes,8,opt,name=cf_list,json=cfList,proto3" json:"cf_list,omitempty"`
-	CorrelationIDs       []string `protobuf:"bytes,10,rep,name=correlation_ids,json=correlationIds,proto3" json:"correlation_ids,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	CFList         *CFList  `protobuf:"bytes,8,opt,name=cf_list,json=cfList,proto3" json:"cf_list,omitempty"`
+	CorrelationIDs []string `protobuf:"bytes,10,rep,name=correlation_ids,json=correlationIds,proto3" json:"correlation_ids,omitempty"`
+	// JoinEUI of the end device.
+	// Only set for rejoin-requests of type 0 and 2, which do not contain the JoinEUI.
+	JoinEUI              *go_thethings_network_lorawan_stack_pkg_types.EUI64 `protobuf:"bytes,11,opt,name=join_eui,json=joinEui,proto3,customtype=go.thethings.network/lorawan-stack/pkg/types.EUI64" json:"join_eui,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                            `json:"-"`
+	XXX_sizecache        int32                                               `json:"-"`
 }
 
 func (m *JoinRequest) Reset()      { *m = JoinRequest{} }
@@ -206,57 +209,59 @@ func init() {
 }
 
 var fileDescriptor_dd69b88666e72e14 = []byte{
-	// 800 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x53, 0x3d, 0x6c, 0xdb, 0x46,
-	0x18, 0xbd, 0x53, 0xf4, 0xe7, 0x93, 0xe1, 0x2a, 0x44, 0x91, 0xb0, 0x6e, 0x71, 0x74, 0x3d, 0x09,
-	0x45, 0x4d, 0xa1, 0x4e, 0xa7, 0x36, 0x40, 0x61, 0x5a, 0x68, 0xe1, 0x34, 0x29, 0x02, 0x1a, 0x6d,
-	0x81, 0x2c, 0xc4, 0x89, 0x77, 0xa2, 0x58, 0xd1, 0x3c, 0x96, 0x77, 0x92, 0xac, 0x4c, 0x41, 0xa7,
-	0xa0, 0x53, 0xd1, 0xa1, 0xc8, 0x18, 0x74, 0xca, 0xd0, 0x21, 0xa3, 0xc7, 0x8c, 0x1e, 0x3d, 0x06,
-	0x1d, 0xd4, 0xe8, 0xb8, 0x64, 0xcc, 0x18, 0x68, 0x2a, 0xf8, 0xa3, 0xca, 0xb6, 0x3c, 0xb4, 0x9d,
-	0xf8, 0xf1, 0xbe, 0xf7, 0x3d, 0xbc, 0x7b, 0xf7, 0x3e, 0xf4, 0x41, 0xc0, 0x63, 0x32, 0x26, 0xe1,
-	0x8e, 0x90, 0xc4, 0x1d, 0xb4, 0x49, 0xe4, 0xb7, 0x7f, 0xe0, 0x7e, 0x68, 0x46, 0x31, 0x97, 0x5c,
-	0xdb, 0x90, 0x32, 0x34, 0x0b, 0x84, 0x39, 0xba, 0xb5, 0xb9, 0xe7, 0xf9, 0xb2, 0x3f, 0xec, 0x9a,
-	0x2e, 0x3f, 0x6a, 0xb3, 0x70, 0xc4, 0x27, 0x51, 0xcc, 0x8f, 0x27, 0xed, 0x0c, 0xec, 0xee, 0x78,
-	0x2c, 0xdc, 0x19, 0x91, 0xc0, 0xa7, 0x44, 0xb2, 0xf6, 0x4a, 0x91, 0x53, 0x6e, 0xee, 0x9c, 0xa3,
-	0xf0, 0xb8, 0xc7, 0xf3, 0xe1, 0xee, 0xb0, 0x97, 0xfd, 0x65, 0x3f, 0x59, 0x55, 0xc0, 0xb1, 0xc7,
-	0xb9, 0x17, 0xb0, 0x25, 0x8a, 0x0e, 0x63, 0x22, 0x7d, 0x5e, 0x28, 0xdc, 0xbc, 0x42, 0xff, 0x80,
-	0x4d, 0x44, 0xd1, 0x35, 0x56, 0xbb, 0x8b, 0xdb, 0x64, 0x80, 0xed, 0x3f, 0x2a, 0xa8, 0x71, 0x87,
-	0xfb, 0xa1, 0xcd, 0x7e, 0x1c, 0x32, 0x21, 0xb5, 0x16, 0x6a, 0xc4, 0x64, 0xec, 0x44, 0x64, 0x12,
-	0x70, 0x42, 0x75, 0xb8, 0x05, 0x5b, 0xeb, 0x56, 0x6d, 0x6e, 0x95, 0x1f, 0x96, 0xfa, 0x37, 0x6d,
-	0x14, 0x93, 0xf1, 0xfd, 0xbc, 0xa5, 0x7d, 0x82, 0x6a, 0x0b, 0x54, 0x69, 0x0b, 0xb6, 0x1a, 0xbb,
-	0x37, 0xcd, 0x8b, 0x66, 0x99, 0xf7, 0x98, 0x10, 0xc4, 0x63, 0xf6, 0x02, 0xa7, 0x7d, 0x8f, 0xea,
-	0x94, 0x8d, 0x1c, 0x42, 0x69, 0xac, 0x5f, 0xcb, 0x98, 0x6f, 0x9f, 0x4e, 0x0d, 0xf0, 0xe7, 0xd4,
-	0xf8, 0xd4, 0xe3, 0xa6, 0xec, 0x33, 0xd9, 0xf7, 0x43, 0x4f, 0x98, 0x21, 0x93, 0x63, 0x1e, 0x0f,
-	0xda, 0x17, 0xc5, 0x47, 0x03, 0xaf, 0x2d, 0x27, 0x11, 0x13, 0x66, 0x87, 0x8d, 0xf6, 0x28, 0x8d,
-	0xed, 0x1a, 0xcd, 0x0b, 0x8d, 0xa2, 0x77, 0x05, 0x0b, 0x98, 0x2b, 0x19, 0x75, 0x8e, 0x88, 0xeb,
-	0x8c, 0x58, 0x2c, 0x7c, 0x1e, 0xea, 0xe5, 0x2d, 0xd8, 0xda, 0xd8, 0xdd, 0x5c, 0x11, 0xb6, 0xb7,
-	0xff, 0x5d, 0x8e, 0xb0, 0x6e, 0xa8, 0xa9, 0xa1, 0x1d, 0x16, 0xb3, 0xcb, 0x73, 0x5b, 0x5b, 0xf0,
-	0xdd, 0x23, 0x6e, 0x71, 0xa6, 0x3d, 0x40, 0xd5, 0x90, 0x49, 0xc7, 0xa7, 0x7a, 0x25, 0x13, 0xbf,
-	0x5f, 0x88, 0xdf, 0xfd, 0x4f, 0xe2, 0xbf, 0x61, 0xf2, 0xa0, 0xa3, 0xa6, 0x46, 0x25, 0x2b, 0xec,
-	0x4a, 0xc8, 0xe4, 0x01, 0xd5, 0xbe, 0x45, 0xd7, 0x29, 0x1f, 0x87, 0x81, 0x1f, 0x0e, 0x1c, 0xc1,
-	0xa4, 0x4c, 0xa9, 0xf4, 0x6a, 0xe6, 0xeb, 0x8a, 0xfc, 0xce, 0xdd, 0xc3, 0x02, 0x61, 0xad, 0xcf,
-	0xad, 0xca, 0xcf, 0xb0, 0xd4, 0x84, 0xa9, 0x14, 0xbb, 0xb9, 0xa0, 0x58, 0xf4, 0xb5, 0xdb, 0xa8,
-	0x1e, 0x1f, 0x3b, 0x94, 0x05, 0x64, 0xa2, 0xd7, 0x32, 0x33, 0x56, 0x5e, 0xc9, 0x3e, 0xee, 0xa4,
-	0x6d, 0xab, 0x3e, 0xb7, 0x2a, 0x3f, 0xa5, 0x54, 0x76, 0x2d, 0xce, 0x8f, 0xb4, 0xcf, 0x51, 0xcd,
-	0xed, 0x39, 0x81, 0x2f, 0xa4, 0x5e, 0xcf, 0xa4, 0xdc, 0xb8, 0x3c, 0xbc, 0xff, 0xe5, 0x5d, 0x5f,
-	0x48, 0x0b, 0xa9, 0xa9, 0x51, 0xcd, 0x6b, 0xbb, 0xea, 0xf6, 0xd2, 0xaf, 0xf6, 0x15, 0x7a, 0xc7,
-	0xe5, 0x71, 0xcc, 0x82, 0x2c, 0xad, 0x8e, 0x4f, 0x85, 0x8e, 0xb6, 0xae, 0xb5, 0xd6, 0x2c, 0x3c,
-	0xb7, 0xd6, 0x7e, 0x85, 0xd5, 0xed, 0x72, 0x5c, 0xd2, 0xa9, 0x9a, 0x1a, 0x1b, 0xfb, 0x4b, 0xd8,
-	0x41, 0x47, 0xd8, 0x1b, 0xe7, 0xc6, 0x0e, 0xa8, 0xf8, 0xac, 0x7c, 0xf2, 0xd4, 0x00, 0x77, 0xca,
-	0xf5, 0xb5, 0x26, 0xda, 0xfe, 0xad, 0x84, 0xd6, 0xf3, 0xb8, 0x8a, 0x88, 0x87, 0x82, 0x69, 0x1f,
-	0x5d, 0x95, 0xd7, 0xb5, 0xb9, 0x55, 0x7d, 0x58, 0x6e, 0x5e, 0xd7, 0x3f, 0xbc, 0x90, 0xd8, 0xfb,
-	0x68, 0x5d, 0x30, 0x91, 0x3e, 0xa5, 0x93, 0xae, 0x48, 0x11, 0xdb, 0xf7, 0x2f, 0xdf, 0xe9, 0x30,
-	0xc7, 0x7c, 0xcd, 0x26, 0xc2, 0x6a, 0x9e, 0xf7, 0xf7, 0x6c, 0x6a, 0x40, 0xbb, 0x21, 0x96, 0x6d,
-	0xed, 0x0b, 0x54, 0x0f, 0xfc, 0x1e, 0x93, 0xfe, 0x11, 0xcb, 0x02, 0xdd, 0xd8, 0x7d, 0xcf, 0xcc,
-	0xf7, 0xd5, 0x5c, 0xec, 0xab, 0xd9, 0x29, 0xf6, 0xd5, 0xaa, 0xa7, 0x1c, 0x4f, 0xfe, 0x32, 0xa0,
-	0xfd, 0xcf, 0xd0, 0x55, 0x26, 0x95, 0xff, 0x8f, 0x49, 0xd6, 0xef, 0xf0, 0x74, 0x86, 0xe1, 0xd9,
-	0x0c, 0xc3, 0x97, 0x33, 0x0c, 0x5e, 0xcd, 0x30, 0x78, 0x3d, 0xc3, 0xe0, 0xcd, 0x0c, 0x83, 0xb7,
-	0x33, 0x0c, 0x1f, 0x29, 0x0c, 0x1f, 0x2b, 0x0c, 0x9e, 0x29, 0x0c, 0x9f, 0x2b, 0x0c, 0x4e, 0x14,
-	0x06, 0x2f, 0x14, 0x06, 0xa7, 0x0a, 0xc3, 0x33, 0x85, 0xe1, 0x4b, 0x85, 0xc1, 0x2b, 0x85, 0xe1,
-	0x6b, 0x85, 0xc1, 0x1b, 0x85, 0xe1, 0x5b, 0x85, 0xc1, 0xa3, 0x04, 0x83, 0xc7, 0x09, 0x86, 0xbf,
-	0x24, 0x18, 0x3c, 0x49, 0x30, 0x7c, 0x9a, 0x60, 0xf0, 0x2c, 0xc1, 0xe0, 0x79, 0x82, 0xe1, 0x49,
-	0x82, 0xe1, 0x8b, 0x04, 0xc3, 0x07, 0x1f, 0xff, 0xdb, 0xe8, 0xcb, 0x30, 0xea, 0x76, 0xab, 0x99,
-	0x29, 0xb7, 0xfe, 0x0e, 0x00, 0x00, 0xff, 0xff, 0x14, 0x03, 0x29, 0x70, 0x74, 0x05, 0x00, 0x00,
+	// 830 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0x3d, 0x6c, 0x1c, 0x45,
+	0x18, 0x9d, 0x71, 0xee, 0xcf, 0x73, 0x96, 0xb9, 0x2c, 0x28, 0x2c, 0x06, 0xcd, 0x1a, 0x57, 0x16,
+	0xc2, 0x7b, 0xc2, 0x89, 0x28, 0x20, 0x12, 0xf2, 0xfa, 0x0c, 0xba, 0x90, 0xa0, 0x68, 0x2d, 0x83,
+	0x14, 0x21, 0xad, 0xc6, 0x3b, 0xe3, 0xf5, 0x70, 0xeb, 0x9d, 0x63, 0x67, 0xee, 0xce, 0x47, 0x15,
+	0x51, 0x45, 0x88, 0x02, 0x51, 0xa0, 0x94, 0x11, 0x55, 0xca, 0x94, 0x2e, 0x53, 0xba, 0x74, 0x19,
+	0x51, 0x2c, 0xb9, 0xd9, 0x26, 0x65, 0xca, 0xc8, 0x15, 0xda, 0x9f, 0xc3, 0x76, 0x2e, 0x42, 0x24,
+	0xd5, 0x7d, 0x3b, 0xdf, 0xfb, 0x9e, 0xde, 0xbd, 0x6f, 0xde, 0xa0, 0x0f, 0x42, 0x11, 0x93, 0x11,
+	0x89, 0xd6, 0xa4, 0x22, 0x7e, 0xaf, 0x4d, 0xfa, 0xbc, 0xfd, 0x83, 0xe0, 0x91, 0xdd, 0x8f, 0x85,
+	0x12, 0xc6, 0xa2, 0x52, 0x91, 0x5d, 0x22, 0xec, 0xe1, 0xd5, 0xa5, 0x8d, 0x80, 0xab, 0xfd, 0xc1,
+	0xae, 0xed, 0x8b, 0x83, 0x36, 0x8b, 0x86, 0x62, 0xdc, 0x8f, 0xc5, 0xe1, 0xb8, 0x9d, 0x83, 0xfd,
+	0xb5, 0x80, 0x45, 0x6b, 0x43, 0x12, 0x72, 0x4a, 0x14, 0x6b, 0xcf, 0x14, 0x05, 0xe5, 0xd2, 0xda,
+	0x39, 0x8a, 0x40, 0x04, 0xa2, 0x18, 0xde, 0x1d, 0xec, 0xe5, 0x5f, 0xf9, 0x47, 0x5e, 0x95, 0x70,
+	0x1c, 0x08, 0x11, 0x84, 0xec, 0x0c, 0x45, 0x07, 0x31, 0x51, 0x5c, 0x94, 0x0a, 0x97, 0x5e, 0xa1,
+	0xbf, 0xc7, 0xc6, 0xb2, 0xec, 0x5a, 0xb3, 0xdd, 0xe9, 0xbf, 0xc9, 0x01, 0x2b, 0xbf, 0xd6, 0x50,
+	0xf3, 0x86, 0xe0, 0x91, 0xcb, 0x7e, 0x1c, 0x30, 0xa9, 0x8c, 0x8f, 0x50, 0x33, 0x26, 0x23, 0xaf,
+	0x4f, 0xc6, 0xa1, 0x20, 0xd4, 0x84, 0xcb, 0x70, 0x75, 0xc1, 0x99, 0x3f, 0x75, 0x6a, 0x3f, 0x55,
+	0x5a, 0x6f, 0x9b, 0xa6, 0x8b, 0x62, 0x32, 0xba, 0x5d, 0x34, 0x8d, 0x4f, 0x50, 0x7d, 0x8a, 0x9b,
+	0x5b, 0x86, 0xab, 0xcd, 0xf5, 0x77, 0xed, 0x8b, 0x76, 0xd9, 0xb7, 0x98, 0x94, 0x24, 0x60, 0xee,
+	0x14, 0x67, 0x7c, 0x87, 0x1a, 0x94, 0x0d, 0x3d, 0x42, 0x69, 0x6c, 0x5e, 0xca, 0xb9, 0xaf, 0x1f,
+	0x27, 0x16, 0xf8, 0x2b, 0xb1, 0xae, 0x05, 0xc2, 0x56, 0xfb, 0x4c, 0xed, 0xf3, 0x28, 0x90, 0x76,
+	0xc4, 0xd4, 0x48, 0xc4, 0xbd, 0xf6, 0x45, 0xf9, 0xfd, 0x5e, 0xd0, 0x56, 0xe3, 0x3e, 0x93, 0x76,
+	0x87, 0x0d, 0x37, 0x28, 0x8d, 0xdd, 0x3a, 0x2d, 0x0a, 0x83, 0xa2, 0x77, 0x24, 0x0b, 0x99, 0xaf,
+	0x18, 0xf5, 0x0e, 0x88, 0xef, 0x0d, 0x59, 0x2c, 0xb9, 0x88, 0xcc, 0xca, 0x32, 0x5c, 0x5d, 0x5c,
+	0x5f, 0x9a, 0x11, 0xb6, 0xb1, 0xf9, 0x6d, 0x81, 0x70, 0xae, 0xe8, 0xc4, 0x32, 0xb6, 0xcb, 0xd9,
+	0xb3, 0x73, 0xd7, 0x98, 0xf2, 0xdd, 0x22, 0x7e, 0x79, 0x66, 0xdc, 0x41, 0xb5, 0x88, 0x29, 0x8f,
+	0x53, 0xb3, 0x9a, 0x8b, 0xdf, 0x2c, 0xc5, 0xaf, 0xbf, 0x96, 0xf8, 0x6f, 0x98, 0xea, 0x76, 0x74,
+	0x62, 0x55, 0xf3, 0xc2, 0xad, 0x46, 0x4c, 0x75, 0xa9, 0xb1, 0x83, 0x2e, 0x53, 0x31, 0x8a, 0x42,
+	0x1e, 0xf5, 0x3c, 0xc9, 0x94, 0xca, 0xa8, 0xcc, 0x5a, 0xee, 0xeb, 0x8c, 0xfc, 0xce, 0xcd, 0xed,
+	0x12, 0xe1, 0x2c, 0x9c, 0x3a, 0xd5, 0x5f, 0xe0, 0x5c, 0x0b, 0x66, 0x52, 0xdc, 0xd6, 0x94, 0x62,
+	0xda, 0x37, 0xae, 0xa3, 0x46, 0x7c, 0xe8, 0x51, 0x16, 0x92, 0xb1, 0x59, 0xcf, 0xcd, 0x98, 0xd9,
+	0x92, 0x7b, 0xd8, 0xc9, 0xda, 0x4e, 0xe3, 0xd4, 0xa9, 0xfe, 0x9c, 0x51, 0xb9, 0xf5, 0xb8, 0x38,
+	0x32, 0x3e, 0x47, 0x75, 0x7f, 0xcf, 0x0b, 0xb9, 0x54, 0x66, 0x23, 0x97, 0x72, 0xe5, 0xe5, 0xe1,
+	0xcd, 0x2f, 0x6f, 0x72, 0xa9, 0x1c, 0xa4, 0x13, 0xab, 0x56, 0xd4, 0x6e, 0xcd, 0xdf, 0xcb, 0x7e,
+	0x8d, 0xaf, 0xd0, 0x5b, 0xbe, 0x88, 0x63, 0x16, 0xe6, 0xf7, 0xd5, 0xe3, 0x54, 0x9a, 0x68, 0xf9,
+	0xd2, 0xea, 0xbc, 0x83, 0x4f, 0x9d, 0xf9, 0xdf, 0x61, 0x6d, 0xa5, 0x12, 0xcf, 0x99, 0x54, 0x27,
+	0xd6, 0xe2, 0xe6, 0x19, 0xac, 0xdb, 0x91, 0xee, 0xe2, 0xb9, 0xb1, 0x2e, 0x95, 0xc6, 0xf7, 0xa8,
+	0x91, 0x65, 0xd2, 0x63, 0x03, 0x6e, 0x36, 0x73, 0xe3, 0x37, 0x5e, 0xdb, 0xf4, 0xad, 0x9d, 0xee,
+	0xa7, 0xd7, 0x74, 0x62, 0xd5, 0xb3, 0xdb, 0xbe, 0xb5, 0xd3, 0x75, 0xeb, 0x19, 0xe5, 0xd6, 0x80,
+	0x7f, 0x56, 0x39, 0x7a, 0x60, 0x81, 0x1b, 0x95, 0xc6, 0x7c, 0x0b, 0xad, 0xfc, 0x31, 0x87, 0x16,
+	0x8a, 0x38, 0xc8, 0xbe, 0x88, 0x24, 0xfb, 0xcf, 0x3c, 0x5c, 0x36, 0x3f, 0xbc, 0x90, 0x87, 0xdb,
+	0x68, 0x41, 0x32, 0x99, 0x5d, 0x14, 0x2f, 0x8b, 0x60, 0x19, 0x8a, 0xf7, 0x5f, 0x76, 0x6c, 0xbb,
+	0xc0, 0x7c, 0xcd, 0xc6, 0xd2, 0x69, 0x9d, 0xdf, 0xde, 0x49, 0x62, 0x41, 0xb7, 0x29, 0xcf, 0xda,
+	0xc6, 0x17, 0xa8, 0x11, 0xf2, 0x3d, 0xa6, 0xf8, 0x01, 0xcb, 0xe3, 0xd2, 0x5c, 0x7f, 0xcf, 0x2e,
+	0xde, 0x03, 0x7b, 0xfa, 0x1e, 0xd8, 0x9d, 0xf2, 0x3d, 0x70, 0x1a, 0x19, 0xc7, 0xfd, 0xbf, 0x2d,
+	0xe8, 0xfe, 0x3b, 0xf4, 0xaa, 0x15, 0x54, 0xde, 0x64, 0x05, 0xce, 0x9f, 0xf0, 0x78, 0x82, 0xe1,
+	0xc9, 0x04, 0xc3, 0x27, 0x13, 0x0c, 0x9e, 0x4e, 0x30, 0x78, 0x36, 0xc1, 0xe0, 0xf9, 0x04, 0x83,
+	0x17, 0x13, 0x0c, 0xef, 0x6a, 0x0c, 0xef, 0x69, 0x0c, 0x1e, 0x6a, 0x0c, 0x1f, 0x69, 0x0c, 0x8e,
+	0x34, 0x06, 0x8f, 0x35, 0x06, 0xc7, 0x1a, 0xc3, 0x13, 0x8d, 0xe1, 0x13, 0x8d, 0xc1, 0x53, 0x8d,
+	0xe1, 0x33, 0x8d, 0xc1, 0x73, 0x8d, 0xe1, 0x0b, 0x8d, 0xc1, 0xdd, 0x14, 0x83, 0x7b, 0x29, 0x86,
+	0xbf, 0xa5, 0x18, 0xdc, 0x4f, 0x31, 0x7c, 0x90, 0x62, 0xf0, 0x30, 0xc5, 0xe0, 0x51, 0x8a, 0xe1,
+	0x51, 0x8a, 0xe1, 0xe3, 0x14, 0xc3, 0x3b, 0x1f, 0xff, 0xdf, 0x1d, 0xab, 0xa8, 0xbf, 0xbb, 0x5b,
+	0xcb, 0x4d, 0xb9, 0xfa, 0xcf, 0x00, 0xe8, 0x84, 0x21, 0xa5, 0xd4, 0x05, 0x00, 0x00,
 }
 
 func (this *JoinRequest) Equal(that interface{}) bool {
@@ -310,6 +315,13 @@ func (this *JoinRequest) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if that1.JoinEUI == nil {
+		if this.JoinEUI != nil {
+			return false
+		}
+	} else if !this.JoinEUI.Equal(*that1.JoinEUI) {
+		return false
+	}
 	return true
 }
 func (this *JoinResponse) Equal(that interface{}) bool {
@@ -370,6 +382,18 @@ func (m *JoinRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.JoinEUI != nil {
+		{
+			size := m.JoinEUI.Size()
+			i -= size
+			if _, err := m.JoinEUI.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintJoin(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
 	if len(m.CorrelationIDs) > 0 {
 		for iNdEx := len(m.CorrelationIDs) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.CorrelationIDs[iNdEx])
@@ -650,6 +674,10 @@ func (m *JoinRequest) Size() (n int) {
 			n += 1 + l + sovJoin(uint64(l))
 		}
 	}
+	if m.JoinEUI != nil {
+		l = m.JoinEUI.Size()
+		n += 1 + l + sovJoin(uint64(l))
+	}
 	return n
 }
 
@@ -696,6 +724,7 @@ func (this *JoinRequest) String() string {
 		`RxDelay:` + fmt.Sprintf("%v", this.RxDelay) + `,`,
 		`CFList:` + strings.Replace(fmt.Sprintf("%v", this.CFList), "CFList", "CFList", 1) + `,`,
 		`CorrelationIDs:` + fmt.Sprintf("%v", this.CorrelationIDs) + `,`,
+		`JoinEUI:` + fmt.Sprintf("%v", this.JoinEUI) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -1025,6 +1054,41 @@ func (m *JoinRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.CorrelationIDs = append(m.CorrelationIDs, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JoinEUI", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJoin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthJoin
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthJoin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v go_thethings_network_lorawan_stack_pkg_types.EUI64
+			m.JoinEUI = &v
+			if err := m.JoinEUI.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipJoin(dAtA[iNdEx:])
@@ -13,6 +13,7 @@ var JoinRequestFieldPathsNested = []string{
 	"downlink_settings.opt_neg",
 	"downlink_settings.rx1_dr_offset",
 	"downlink_settings.rx2_dr",
+	"join_eui",
 	"net_id",
 	"payload",
 	"payload.Payload",
@@ -68,6 +69,7 @@ var JoinRequestFieldPathsTopLevel = []string{
 	"correlation_ids",
 	"dev_addr",
 	"downlink_settings",
+	"join_eui",
 	"net_id",
 	"payload",
 	"raw_payload",
@@ -138,6 +138,15 @@ func (dst *JoinRequest) SetFields(src *JoinRequest, paths ...string) error {
 			} else {
 				dst.CorrelationIDs = nil
 			}
+		case "join_eui":
+			if len(subs) > 0 {
+				return fmt.Errorf("'join_eui' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.JoinEUI = src.JoinEUI
+			} else {
+				dst.JoinEUI = nil
+			}
 
 		default:
 			return fmt.Errorf("invalid field: '%s'", name)
@@ -856,122 +856,127 @@ func init() {
 }
 
 var fileDescriptor_1b695d5f526759a7 = []byte{
-	// 1829 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x57, 0x41, 0x6c, 0x1b, 0xc7,
-	0x15, 0xdd, 0x21, 0x45, 0x4a, 0x1a, 0x49, 0x94, 0x3c, 0x76, 0x13, 0x96, 0x76, 0x96, 0x0e, 0xa3,
-	0xb6, 0xae, 0x63, 0x91, 0x01, 0xd3, 0x06, 0xa9, 0x82, 0xda, 0x20, 0x45, 0x56, 0xa2, 0x65, 0xa9,
-	0xea, 0xb2, 0x69, 0x53, 0x25, 0x0a, 0xb3, 0x22, 0x47, 0xf4, 0x9a, 0xd4, 0xec, 0x76, 0x67, 0x44,
-	0x85, 0x49, 0x0d, 0x18, 0x3e, 0x04, 0x6e, 0xd1, 0x43, 0x81, 0x36, 0x40, 0x8f, 0x45, 0x7b, 0x68,
-	0x0e, 0x3d, 0x04, 0xbd, 0x34, 0xa7, 0x22, 0x87, 0x1e, 0xdc, 0x9b, 0x8b, 0x5e, 0x82, 0x1e, 0xd4,
-	0x68, 0xd9, 0x43, 0x8e, 0x39, 0x15, 0x81, 0x4e, 0xc5, 0xcc, 0x0e, 0xc9, 0xe5, 0x92, 0x92, 0x45,
-	0x45, 0x32, 0xd0, 0xdb, 0xcc, 0xce, 0xff, 0x6f, 0xfe, 0x7f, 0xff, 0xff, 0xd9, 0xff, 0x61, 0xa2,
-	0x6e, 0xda, 0xfa, 0xae, 0x4e, 0xe6, 0x28, 0xd3, 0xcb, 0xb5, 0x94, 0x6e, 0x19, 0xa9, 0x3b, 0xa6,
-	0x41, 0x28, 0xb6, 0x1b, 0xd8, 0x4e, 0x5a, 0xb6, 0xc9, 0x4c, 0x14, 0x61, 0x8c, 0x24, 0xa5, 0x5c,
-	0xb2, 0xf1, 0x62, 0x2c, 0x53, 0x35, 0xd8, 0xed, 0x9d, 0xcd, 0x64, 0xd9, 0xdc, 0x4e, 0x61, 0xd2,
-	0x30, 0x9b, 0x96, 0x6d, 0xbe, 0xdd, 0x4c, 0x09, 0xe1, 0xf2, 0x5c, 0x15, 0x93, 0xb9, 0x86, 0x5e,
-	0x37, 0x2a, 0x3a, 0xc3, 0xa9, 0xbe, 0x85, 0x0b, 0x19, 0x9b, 0xf3, 0x40, 0x54, 0xcd, 0xaa, 0xe9,
-	0x2a, 0x6f, 0xee, 0x6c, 0x89, 0x9d, 0xd8, 0x88, 0x95, 0x14, 0xbf, 0x54, 0x35, 0xcd, 0x6a, 0x1d,
-	0x0b, 0xf3, 0x74, 0x42, 0x4c, 0xa6, 0x33, 0xc3, 0x24, 0x54, 0x9e, 0x5e, 0x94, 0xa7, 0x1d, 0x0c,
-	0xbc, 0x6d, 0xb1, 0xa6, 0x4f, 0xb5, 0x73, 0x48, 0x99, 0xbd, 0x53, 0x66, 0xf2, 0x74, 0x80, 0xfb,
-	0x98, 0x54, 0x4a, 0x15, 0xdc, 0x30, 0xca, 0x6d, 0x5b, 0x9f, 0xeb, 0x97, 0x31, 0x2a, 0x98, 0x30,
-	0x63, 0xcb, 0xc0, 0x76, 0xdb, 0x86, 0x4b, 0x83, 0x79, 0x3c, 0xfc, 0xb4, 0x86, 0x9b, 0x6d, 0xdd,
-	0x78, 0xff, 0x69, 0x9b, 0x6d, 0x21, 0x90, 0xf8, 0x4d, 0x00, 0x9e, 0x2b, 0x62, 0x4a, 0x0d, 0x93,
-	0x2c, 0xe3, 0xa6, 0x86, 0x7f, 0xba, 0x83, 0x29, 0x43, 0xd7, 0x61, 0x84, 0xba, 0x1f, 0x4b, 0x35,
-	0xdc, 0x2c, 0x19, 0x95, 0x28, 0xb8, 0x0c, 0xae, 0x4c, 0x66, 0xa3, 0x07, 0xd9, 0xd0, 0x3b, 0xc1,
-	0xe8, 0xbd, 0x19, 0x67, 0x2f, 0x3e, 0xd9, 0x55, 0x2b, 0xe4, 0xb4, 0x49, 0xda, 0xdd, 0x55, 0xd0,
-	0x06, 0x1c, 0xad, 0xe0, 0x46, 0x09, 0xef, 0x18, 0xd1, 0x80, 0x50, 0xcc, 0x3d, 0xdc, 0x8b, 0x2b,
-	0xff, 0xda, 0x8b, 0xa7, 0xab, 0x66, 0x92, 0xdd, 0xc6, 0xec, 0xb6, 0x41, 0xaa, 0x34, 0x49, 0x30,
-	0xdb, 0x35, 0xed, 0x5a, 0xaa, 0xd7, 0x48, 0xab, 0x56, 0x4d, 0xb1, 0xa6, 0x85, 0x69, 0x32, 0xff,
-	0x6a, 0xe1, 0xa5, 0x6f, 0x39, 0x7b, 0xf1, 0x70, 0x0e, 0x37, 0xf2, 0xaf, 0x16, 0xb4, 0x70, 0x05,
-	0x37, 0xf2, 0x3b, 0x06, 0x7a, 0x0b, 0x8e, 0x71, 0x06, 0x04, 0x7e, 0x50, 0xe0, 0xe7, 0xbf, 0x14,
-	0xfe, 0xe8, 0x4d, 0xd3, 0x20, 0xfc, 0x82, 0x51, 0x0e, 0x9b, 0xdf, 0x31, 0x12, 0xf7, 0x03, 0x70,
-	0x66, 0x75, 0xb7, 0x56, 0x5c, 0xc6, 0x4d, 0xaa, 0x61, 0x6a, 0x99, 0x84, 0x62, 0xf4, 0x7d, 0x38,
-	0xbd, 0x55, 0x22, 0xbb, 0xb5, 0x12, 0x2d, 0x19, 0x84, 0x71, 0x66, 0x04, 0x2d, 0x13, 0xe9, 0x8b,
-	0xc9, 0xde, 0x34, 0x4e, 0x2e, 0xe3, 0x66, 0x9e, 0x34, 0x70, 0xdd, 0xb4, 0x70, 0x76, 0xf2, 0x20,
-	0x1b, 0xfa, 0x05, 0x08, 0xcc, 0x00, 0x6e, 0xa2, 0x36, 0xb1, 0xc5, 0x61, 0x0b, 0x84, 0x2d, 0xe3,
-	0x26, 0x07, 0xa4, 0x3e, 0xc0, 0xc0, 0xd0, 0x80, 0xd4, 0x03, 0x78, 0x0b, 0x4e, 0xb9, 0x70, 0x98,
-	0x94, 0x05, 0x5c, 0x70, 0x58, 0x38, 0x48, 0x76, 0x6b, 0xc5, 0x3c, 0x29, 0x2f, 0xe3, 0x66, 0xe2,
-	0x35, 0x38, 0x9d, 0xb1, 0xac, 0xa2, 0xc8, 0x0b, 0x49, 0x41, 0x1e, 0x8e, 0xeb, 0x96, 0x55, 0xa2,
-	0x27, 0x73, 0x7e, 0x54, 0x77, 0xe1, 0x12, 0xbf, 0x0c, 0xc2, 0x8b, 0x0b, 0x76, 0xd3, 0x62, 0x66,
-	0x11, 0xdb, 0xbc, 0x1e, 0xd6, 0xf4, 0x66, 0xdd, 0xd4, 0x2b, 0xed, 0xfc, 0x5b, 0x82, 0x41, 0xa3,
-	0x42, 0xe5, 0x05, 0xb3, 0xfe, 0x0b, 0xf2, 0xa4, 0x92, 0x13, 0x55, 0x54, 0xe8, 0xd6, 0x4a, 0x76,
-	0xc6, 0x7b, 0xd3, 0xa3, 0xbd, 0x38, 0xd0, 0x38, 0x04, 0x2a, 0xc1, 0x69, 0xa9, 0x59, 0x6a, 0x60,
-	0x9b, 0x67, 0xa8, 0xa0, 0x38, 0x92, 0x8e, 0xf9, 0x51, 0x57, 0x32, 0x0b, 0x3f, 0x72, 0x25, 0xb2,
-	0xb1, 0x83, 0x6c, 0xe8, 0x3e, 0xc7, 0x72, 0xf6, 0xe2, 0x91, 0x5b, 0xa6, 0xa6, 0xff, 0x38, 0xb3,
-	0x2a, 0xcf, 0xb4, 0x88, 0x54, 0x91, 0x7b, 0x14, 0x85, 0xa3, 0x96, 0x6b, 0xbc, 0x9b, 0x8a, 0x5a,
-	0x7b, 0x8b, 0x36, 0x61, 0xc4, 0xb2, 0xcd, 0x86, 0xc1, 0xc5, 0xb0, 0xcd, 0x8b, 0x68, 0xe4, 0x32,
-	0xb8, 0x32, 0x9e, 0x7d, 0xe5, 0x20, 0xfb, 0x0d, 0xfb, 0x6b, 0xd1, 0xd9, 0xf4, 0xb3, 0x6f, 0xbe,
-	0xae, 0xcf, 0xbd, 0xf3, 0xc2, 0xdc, 0x77, 0x36, 0xae, 0xdc, 0x98, 0x7f, 0x7d, 0x6e, 0xe3, 0x46,
-	0x7b, 0xfb, 0xcd, 0x77, 0xd3, 0xd7, 0xee, 0xce, 0xfe, 0xec, 0xcd, 0x59, 0x67, 0x2f, 0x3e, 0xb5,
-	0xd6, 0xc5, 0x28, 0xe4, 0xb4, 0x29, 0x0f, 0x64, 0xa1, 0x82, 0x72, 0xf0, 0x5c, 0xe7, 0x83, 0x41,
-	0xaa, 0xa5, 0x8a, 0xce, 0xf4, 0x68, 0x48, 0xd0, 0xf6, 0x74, 0xd2, 0x7d, 0x9e, 0x92, 0xed, 0xe7,
-	0x29, 0x59, 0x14, 0xcf, 0x93, 0x36, 0xe3, 0xd5, 0xc8, 0xe9, 0x4c, 0x4f, 0xbc, 0x0c, 0x2f, 0x0d,
-	0x8e, 0x86, 0x8c, 0xba, 0xc7, 0x47, 0xd0, 0xe3, 0x63, 0xe2, 0x4f, 0x01, 0x78, 0x81, 0x17, 0x4f,
-	0xa6, 0x5c, 0xc6, 0x16, 0x5b, 0x29, 0x2c, 0xb4, 0x23, 0xb8, 0x05, 0xa7, 0xa5, 0x4c, 0xc9, 0x76,
-	0x3f, 0xc9, 0x68, 0x3e, 0xef, 0xe7, 0xfd, 0x88, 0x3c, 0x18, 0x10, 0xd4, 0x88, 0xd5, 0x9b, 0x29,
-	0x6b, 0xf0, 0x9c, 0x78, 0x0a, 0xe4, 0x25, 0x25, 0x5e, 0xd8, 0x87, 0x45, 0x58, 0xc3, 0x5c, 0xf4,
-	0x87, 0x4d, 0x0b, 0x67, 0xc7, 0xda, 0x11, 0xd6, 0xa6, 0xf9, 0x37, 0x89, 0xc6, 0x8f, 0xd0, 0x3a,
-	0x1c, 0xe7, 0x6f, 0x17, 0x31, 0x49, 0x19, 0xcb, 0xd7, 0xe5, 0xbb, 0xf2, 0x75, 0xf9, 0xf6, 0x50,
-	0xaf, 0x4b, 0x0e, 0x37, 0x56, 0x39, 0x88, 0x36, 0x56, 0x91, 0xab, 0xc4, 0x7b, 0x21, 0x18, 0xcd,
-	0x61, 0xdb, 0x68, 0xe0, 0xee, 0xe3, 0x49, 0xff, 0x0f, 0x93, 0x7e, 0x03, 0x42, 0xc1, 0xba, 0x97,
-	0xa4, 0xeb, 0x92, 0xa4, 0x97, 0x86, 0x22, 0x89, 0x27, 0x8f, 0xcb, 0xd2, 0xf8, 0x9d, 0xf6, 0xb2,
-	0x37, 0x04, 0x23, 0xa7, 0x1a, 0x02, 0xb4, 0x0e, 0xc3, 0x04, 0x33, 0x5e, 0x8d, 0x21, 0x01, 0xbc,
-	0x70, 0xa2, 0x3f, 0xc7, 0x2a, 0x66, 0x85, 0x9c, 0xb3, 0x17, 0x0f, 0x89, 0x85, 0x16, 0x22, 0x98,
-	0x15, 0x06, 0x55, 0x7c, 0xf8, 0xc9, 0x54, 0xfc, 0xe8, 0xb0, 0x15, 0xff, 0x20, 0x00, 0xd1, 0x22,
-	0x66, 0x9a, 0x69, 0xb2, 0xb3, 0x49, 0xc1, 0x7e, 0x2a, 0x02, 0x4f, 0x86, 0x8a, 0xe0, 0xb0, 0x54,
-	0xfc, 0x7d, 0x0c, 0xc6, 0x3a, 0xd7, 0x74, 0x5c, 0xec, 0x50, 0xf2, 0x13, 0x38, 0xad, 0x5b, 0x56,
-	0xdd, 0x28, 0x8b, 0xbe, 0xb0, 0xd4, 0xa5, 0xe7, 0xeb, 0x7e, 0x7a, 0x32, 0x5d, 0x31, 0x2f, 0x41,
-	0x63, 0xdd, 0xb7, 0x4b, 0xf7, 0x4a, 0xf0, 0x32, 0x1d, 0xcc, 0xd1, 0xcb, 0x07, 0xd9, 0x59, 0x3b,
-	0x11, 0x9d, 0x4d, 0xab, 0x47, 0x73, 0xf4, 0x58, 0x82, 0x9e, 0x3f, 0x8c, 0xa0, 0xc9, 0x7e, 0x1e,
-	0xd0, 0x1a, 0x1c, 0xa9, 0x1b, 0x94, 0x89, 0x7a, 0x9b, 0x48, 0xcf, 0xfb, 0xbd, 0x3b, 0x9c, 0xa2,
-	0xa4, 0xc7, 0xdb, 0x5b, 0x06, 0x65, 0x4b, 0x8a, 0x26, 0x90, 0x50, 0x11, 0x86, 0x6c, 0x9d, 0x54,
-	0xb1, 0xfc, 0x21, 0xbd, 0x72, 0x32, 0x48, 0x8d, 0x43, 0x2c, 0x29, 0x9a, 0x8b, 0x85, 0x36, 0xe0,
-	0xf8, 0x96, 0x6d, 0x6e, 0xbb, 0xbe, 0x84, 0x05, 0xf0, 0xf5, 0x93, 0x01, 0x7f, 0xcf, 0x36, 0xb7,
-	0xb9, 0xe7, 0x4b, 0x8a, 0x36, 0xb6, 0x25, 0xd7, 0xb1, 0x7f, 0x00, 0x38, 0xed, 0xf3, 0x07, 0xbd,
-	0xe1, 0x69, 0x37, 0xdd, 0x3e, 0x38, 0x73, 0x7a, 0xad, 0x26, 0x7a, 0x0b, 0x46, 0xba, 0x73, 0x81,
-	0xc8, 0xaf, 0xc0, 0xe5, 0xe0, 0xb1, 0xcb, 0xef, 0x02, 0xcf, 0x2e, 0xde, 0x8d, 0x77, 0x4f, 0x73,
-	0x54, 0x9b, 0xc4, 0x5d, 0x59, 0x1a, 0xfb, 0x37, 0x80, 0x33, 0x7e, 0x42, 0xcf, 0xd8, 0xa9, 0x6d,
-	0x38, 0x45, 0x99, 0x6e, 0xb3, 0x52, 0xef, 0x18, 0x50, 0xf8, 0x52, 0x6d, 0xfa, 0x44, 0x91, 0x43,
-	0xca, 0x59, 0x60, 0x82, 0xb6, 0x37, 0x3b, 0x46, 0x8c, 0xc2, 0xf3, 0x03, 0x02, 0x7b, 0xb6, 0x3e,
-	0xce, 0x07, 0xa2, 0x20, 0x3b, 0x05, 0x27, 0xba, 0xc1, 0xa3, 0x89, 0x9f, 0x03, 0x38, 0x25, 0xe5,
-	0xd6, 0x6c, 0xbc, 0x65, 0xbc, 0xdd, 0x33, 0xaa, 0x80, 0xb3, 0x18, 0x55, 0xd0, 0x53, 0x30, 0x5c,
-	0xc7, 0xa4, 0xca, 0x6e, 0x0b, 0x8e, 0xa7, 0x34, 0xb9, 0x4b, 0x68, 0x70, 0xba, 0xc7, 0x14, 0x4c,
-	0xd1, 0x0d, 0x38, 0x66, 0xc9, 0x75, 0x14, 0x88, 0x24, 0x7b, 0xc6, 0x9f, 0x64, 0x3d, 0x2a, 0xd9,
-	0x11, 0xd1, 0xb6, 0x77, 0x94, 0xd2, 0x7f, 0x00, 0x70, 0x64, 0x95, 0xde, 0xa4, 0x68, 0x11, 0xc2,
-	0x25, 0x9d, 0x54, 0xea, 0x98, 0xcb, 0xa3, 0x8b, 0x83, 0x50, 0x64, 0xc5, 0xc5, 0x2e, 0x0d, 0x3e,
-	0x94, 0xad, 0xa5, 0x06, 0x27, 0x16, 0x31, 0x6b, 0x8f, 0x5a, 0xe8, 0x59, 0xbf, 0x70, 0xdf, 0x6c,
-	0x1a, 0xbb, 0xec, 0x17, 0xf1, 0xcf, 0x69, 0xe9, 0xd7, 0xe0, 0x48, 0x86, 0x1b, 0xb9, 0x06, 0xe1,
-	0x22, 0x66, 0x72, 0x84, 0x39, 0x0e, 0x74, 0x7c, 0xc0, 0x93, 0xee, 0x1d, 0x7f, 0xd2, 0xff, 0x1d,
-	0x81, 0x17, 0x56, 0xdd, 0x48, 0xf5, 0xb4, 0xad, 0xa8, 0x06, 0x23, 0x1e, 0x9f, 0x57, 0x0a, 0x0b,
-	0x68, 0x98, 0x3e, 0x37, 0x76, 0xed, 0x78, 0xc2, 0x92, 0xb3, 0xb2, 0x9b, 0x64, 0x9d, 0x9e, 0x1b,
-	0xcd, 0x0e, 0xa2, 0xd8, 0xdf, 0x92, 0x0f, 0x79, 0x09, 0x81, 0xe7, 0xf2, 0xa4, 0xcc, 0x25, 0xba,
-	0x60, 0x67, 0xe9, 0x94, 0x05, 0xcf, 0xcb, 0xfb, 0xdc, 0x36, 0xfd, 0xec, 0x6f, 0x7c, 0x03, 0x46,
-	0xdc, 0x5e, 0xbc, 0x93, 0x7d, 0x57, 0xfc, 0xfa, 0x87, 0xf5, 0xea, 0x8f, 0x4f, 0x42, 0x74, 0x0b,
-	0x8e, 0xbb, 0x89, 0xcd, 0x73, 0x2f, 0xe1, 0x17, 0xef, 0xef, 0xbd, 0x62, 0x47, 0xcd, 0xd1, 0xe9,
-	0xbf, 0x01, 0x18, 0xf5, 0xf4, 0x17, 0xbd, 0xc9, 0xb7, 0x0e, 0xa7, 0x5c, 0x43, 0xdb, 0xa9, 0x7e,
-	0x7c, 0x3f, 0x1e, 0x97, 0xf1, 0xd2, 0x8d, 0x8c, 0x65, 0x9d, 0x8a, 0x1b, 0xef, 0x87, 0xe1, 0xf9,
-	0x9b, 0xb4, 0xf3, 0xab, 0xd2, 0x70, 0xd5, 0xa0, 0xcc, 0x6e, 0xa2, 0x3f, 0x03, 0x18, 0x5c, 0xc4,
-	0x0c, 0x3d, 0x37, 0xe0, 0x02, 0x8f, 0xb4, 0x7b, 0xc3, 0x57, 0x0f, 0xfd, 0x31, 0x26, 0x6a, 0xf7,
-	0xff, 0xf9, 0x9f, 0x5f, 0x07, 0x30, 0x2a, 0xa7, 0xee, 0xd0, 0x94, 0xa7, 0xdb, 0xa2, 0xa9, 0x77,
-	0x7b, 0xff, 0xb1, 0x49, 0x5f, 0x4f, 0xe7, 0xdb, 0xdf, 0x4d, 0xc9, 0x17, 0xbd, 0x4f, 0xaf, 0xb3,
-	0xbc, 0x8b, 0xde, 0x0b, 0xc0, 0x60, 0x71, 0x90, 0xd1, 0xc5, 0xe1, 0x8c, 0xfe, 0x2b, 0x10, 0x56,
-	0xff, 0x05, 0xc4, 0x8e, 0x34, 0x3b, 0x79, 0x42, 0xb3, 0x93, 0xbd, 0x66, 0xcf, 0x83, 0xab, 0xeb,
-	0x2b, 0x89, 0xa5, 0xd3, 0xba, 0x69, 0x1e, 0x5c, 0x45, 0x7f, 0x04, 0x70, 0xbc, 0xd3, 0x72, 0xa1,
-	0xab, 0xc7, 0xef, 0xc6, 0x8e, 0x62, 0xe5, 0x07, 0x82, 0x94, 0xa5, 0xd8, 0x42, 0xbf, 0xa5, 0x8f,
-	0x33, 0xad, 0xd3, 0xda, 0xce, 0x75, 0x8d, 0x7c, 0x10, 0x00, 0x2f, 0x00, 0xf4, 0x3e, 0x80, 0xe1,
-	0x1c, 0xae, 0x63, 0x86, 0xd1, 0xb1, 0xda, 0xab, 0xd8, 0x53, 0x7d, 0x73, 0x44, 0x7e, 0xdb, 0x62,
-	0xcd, 0xc4, 0x8a, 0xb0, 0x6e, 0xf1, 0x6a, 0x7e, 0x78, 0xeb, 0x3a, 0x21, 0xea, 0xc6, 0x24, 0x6d,
-	0xc3, 0xc0, 0x4d, 0x8a, 0xea, 0x62, 0x26, 0xf3, 0xff, 0xb4, 0x0f, 0x31, 0xa1, 0xbf, 0x74, 0x7d,
-	0x8a, 0x89, 0x67, 0x84, 0x8d, 0x4f, 0xa3, 0xaf, 0x70, 0x1b, 0xdb, 0x4d, 0x48, 0xa9, 0xfd, 0x2f,
-	0xcf, 0xfe, 0x1e, 0x3c, 0xdc, 0x57, 0xc1, 0xa3, 0x7d, 0x15, 0x7c, 0xb2, 0xaf, 0x2a, 0x9f, 0xee,
-	0xab, 0xca, 0x67, 0xfb, 0xaa, 0xf2, 0xf9, 0xbe, 0xaa, 0x7c, 0xb1, 0xaf, 0x82, 0x7b, 0x8e, 0x0a,
-	0x1e, 0x38, 0xaa, 0xf2, 0x81, 0xa3, 0x82, 0x0f, 0x1d, 0x55, 0xf9, 0xc8, 0x51, 0x95, 0x8f, 0x1d,
-	0x55, 0x79, 0xe8, 0xa8, 0xe0, 0x91, 0xa3, 0x82, 0x4f, 0x1c, 0x55, 0xf9, 0xd4, 0x51, 0xc1, 0x67,
-	0x8e, 0xaa, 0x7c, 0xee, 0xa8, 0xe0, 0x0b, 0x47, 0x55, 0xee, 0xb5, 0x54, 0xe5, 0x41, 0x4b, 0x05,
-	0xbf, 0x6a, 0xa9, 0xca, 0x6f, 0x5b, 0x2a, 0xf8, 0x5d, 0x4b, 0x55, 0x3e, 0x68, 0xa9, 0xca, 0x87,
-	0x2d, 0x15, 0x7c, 0xd4, 0x52, 0xc1, 0xc7, 0x2d, 0x15, 0xac, 0x5f, 0x3b, 0x6e, 0xdb, 0xc3, 0x88,
-	0xb5, 0xb9, 0x19, 0x16, 0x4e, 0xbf, 0xf8, 0xbf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x03, 0x0e, 0xb9,
-	0xea, 0x4c, 0x18, 0x00, 0x00,
+	// 1907 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x58, 0x4d, 0x6c, 0x1b, 0xc7,
+	0x15, 0xde, 0x21, 0x45, 0xfd, 0x8c, 0x44, 0x4a, 0x1e, 0xbb, 0x09, 0x4b, 0x3b, 0x4b, 0x67, 0xa3,
+	0x36, 0x8e, 0x62, 0x91, 0x01, 0xd3, 0x06, 0xa9, 0x82, 0xda, 0x20, 0x45, 0x46, 0xa2, 0x65, 0xa9,
+	0xea, 0xb2, 0x69, 0x53, 0x25, 0x0a, 0xbd, 0x22, 0x47, 0xd4, 0x9a, 0xd4, 0xec, 0x76, 0x67, 0x44,
+	0x85, 0x49, 0x0d, 0x18, 0x3e, 0x04, 0x6e, 0xd1, 0x43, 0x81, 0xb6, 0x40, 0x8f, 0x45, 0x7b, 0x68,
+	0x0e, 0x45, 0x91, 0xf6, 0x92, 0x9c, 0x8a, 0x1c, 0x7a, 0x70, 0x6f, 0x2e, 0x7a, 0x09, 0x7a, 0x50,
+	0xa3, 0x65, 0x0f, 0x39, 0xe6, 0x54, 0x04, 0x3a, 0x15, 0x33, 0xbb, 0x24, 0x97, 0x4b, 0x4a, 0x16,
+	0x65, 0x29, 0x68, 0x6f, 0x33, 0x9c, 0x37, 0xdf, 0x7c, 0xef, 0x7b, 0xef, 0xcd, 0xbe, 0x21, 0x54,
+	0x6a, 0x86, 0xa5, 0xed, 0x6a, 0x64, 0x96, 0x32, 0xad, 0x54, 0x4d, 0x6a, 0xa6, 0x9e, 0xbc, 0x6d,
+	0xe8, 0x84, 0x62, 0xab, 0x8e, 0xad, 0x84, 0x69, 0x19, 0xcc, 0x40, 0x11, 0xc6, 0x48, 0xc2, 0xb5,
+	0x4b, 0xd4, 0x5f, 0x8c, 0xa5, 0x2b, 0x3a, 0xdb, 0xda, 0xd9, 0x48, 0x94, 0x8c, 0xed, 0x24, 0x26,
+	0x75, 0xa3, 0x61, 0x5a, 0xc6, 0xdb, 0x8d, 0xa4, 0x30, 0x2e, 0xcd, 0x56, 0x30, 0x99, 0xad, 0x6b,
+	0x35, 0xbd, 0xac, 0x31, 0x9c, 0xec, 0x19, 0x38, 0x90, 0xb1, 0x59, 0x0f, 0x44, 0xc5, 0xa8, 0x18,
+	0xce, 0xe6, 0x8d, 0x9d, 0x4d, 0x31, 0x13, 0x13, 0x31, 0x72, 0xcd, 0x2f, 0x55, 0x0c, 0xa3, 0x52,
+	0xc3, 0x82, 0x9e, 0x46, 0x88, 0xc1, 0x34, 0xa6, 0x1b, 0x84, 0xba, 0xab, 0x17, 0xdd, 0xd5, 0x36,
+	0x06, 0xde, 0x36, 0x59, 0xc3, 0xb7, 0xb5, 0xbd, 0x48, 0x99, 0xb5, 0x53, 0x62, 0xee, 0x6a, 0x1f,
+	0xf7, 0x31, 0x29, 0x17, 0xcb, 0xb8, 0xae, 0x97, 0x5a, 0x5c, 0x9f, 0xe9, 0xb5, 0xd1, 0xcb, 0x98,
+	0x30, 0x7d, 0x53, 0xc7, 0x56, 0x8b, 0xc3, 0xa5, 0xfe, 0x3a, 0x1e, 0xbe, 0x5a, 0xc5, 0x8d, 0xd6,
+	0xde, 0x78, 0xef, 0x6a, 0x4b, 0x6d, 0x61, 0xa0, 0xfc, 0x32, 0x00, 0xcf, 0x15, 0x30, 0xa5, 0xba,
+	0x41, 0x96, 0x70, 0x43, 0xc5, 0x3f, 0xda, 0xc1, 0x94, 0xa1, 0x6b, 0x30, 0x42, 0x9d, 0x1f, 0x8b,
+	0x55, 0xdc, 0x28, 0xea, 0xe5, 0x28, 0xb8, 0x0c, 0xae, 0x4c, 0x64, 0xa2, 0x07, 0x99, 0xd0, 0x3b,
+	0xc1, 0xe8, 0xdd, 0x29, 0x7b, 0x2f, 0x3e, 0xd1, 0xd9, 0x96, 0xcf, 0xaa, 0x13, 0xb4, 0x33, 0x2b,
+	0xa3, 0x75, 0x38, 0x52, 0xc6, 0xf5, 0x22, 0xde, 0xd1, 0xa3, 0x01, 0xb1, 0x31, 0xfb, 0x60, 0x2f,
+	0x2e, 0xfd, 0x73, 0x2f, 0x9e, 0xaa, 0x18, 0x09, 0xb6, 0x85, 0xd9, 0x96, 0x4e, 0x2a, 0x34, 0x41,
+	0x30, 0xdb, 0x35, 0xac, 0x6a, 0xb2, 0x9b, 0xa4, 0x59, 0xad, 0x24, 0x59, 0xc3, 0xc4, 0x34, 0x91,
+	0x7b, 0x2d, 0xff, 0xd2, 0x37, 0xec, 0xbd, 0xf8, 0x70, 0x16, 0xd7, 0x73, 0xaf, 0xe5, 0xd5, 0xe1,
+	0x32, 0xae, 0xe7, 0x76, 0x74, 0x74, 0x0b, 0x8e, 0x72, 0x05, 0x04, 0x7e, 0x50, 0xe0, 0xe7, 0x1e,
+	0x0b, 0x7f, 0xe4, 0x86, 0xa1, 0x13, 0x7e, 0xc0, 0x08, 0x87, 0xcd, 0xed, 0xe8, 0xca, 0xbd, 0x00,
+	0x9c, 0x5a, 0xd9, 0xad, 0x16, 0x96, 0x70, 0x83, 0xaa, 0x98, 0x9a, 0x06, 0xa1, 0x18, 0x7d, 0x07,
+	0x4e, 0x6e, 0x16, 0xc9, 0x6e, 0xb5, 0x48, 0x8b, 0x3a, 0x61, 0x5c, 0x19, 0x21, 0xcb, 0x78, 0xea,
+	0x62, 0xa2, 0x3b, 0x8d, 0x13, 0x4b, 0xb8, 0x91, 0x23, 0x75, 0x5c, 0x33, 0x4c, 0x9c, 0x99, 0x38,
+	0xc8, 0x84, 0x7e, 0x0a, 0x02, 0x53, 0x80, 0x53, 0x54, 0xc7, 0x37, 0x39, 0x6c, 0x9e, 0xb0, 0x25,
+	0xdc, 0xe0, 0x80, 0xd4, 0x07, 0x18, 0x18, 0x18, 0x90, 0x7a, 0x00, 0x6f, 0xc2, 0xb0, 0x03, 0x87,
+	0x49, 0x49, 0xc0, 0x05, 0x07, 0x85, 0x83, 0x64, 0xb7, 0x5a, 0xc8, 0x91, 0xd2, 0x12, 0x6e, 0x28,
+	0xaf, 0xc3, 0xc9, 0xb4, 0x69, 0x16, 0x44, 0x5e, 0xb8, 0x12, 0xe4, 0xe0, 0x98, 0x66, 0x9a, 0x45,
+	0x7a, 0x32, 0xe7, 0x47, 0x34, 0x07, 0x4e, 0xf9, 0x59, 0x10, 0x5e, 0x9c, 0xb7, 0x1a, 0x26, 0x33,
+	0x0a, 0xd8, 0xe2, 0xf5, 0xb0, 0xaa, 0x35, 0x6a, 0x86, 0x56, 0x6e, 0xe5, 0xdf, 0x22, 0x0c, 0xea,
+	0x65, 0xea, 0x1e, 0x30, 0xed, 0x3f, 0x20, 0x47, 0xca, 0x59, 0x51, 0x45, 0xf9, 0x4e, 0xad, 0x64,
+	0xa6, 0xbc, 0x27, 0x3d, 0xdc, 0x8b, 0x03, 0x95, 0x43, 0xa0, 0x22, 0x9c, 0x74, 0x77, 0x16, 0xeb,
+	0xd8, 0xe2, 0x19, 0x2a, 0x24, 0x8e, 0xa4, 0x62, 0x7e, 0xd4, 0xe5, 0xf4, 0xfc, 0xf7, 0x1d, 0x8b,
+	0x4c, 0xec, 0x20, 0x13, 0xba, 0xc7, 0xb1, 0xec, 0xbd, 0x78, 0xe4, 0xa6, 0xa1, 0x6a, 0x3f, 0x48,
+	0xaf, 0xb8, 0x6b, 0x6a, 0xc4, 0xdd, 0xe2, 0xce, 0x51, 0x14, 0x8e, 0x98, 0x0e, 0x79, 0x27, 0x15,
+	0xd5, 0xd6, 0x14, 0x6d, 0xc0, 0x88, 0x69, 0x19, 0x75, 0x9d, 0x9b, 0x61, 0x8b, 0x17, 0xd1, 0xd0,
+	0x65, 0x70, 0x65, 0x2c, 0xf3, 0xca, 0x41, 0xe6, 0x59, 0xeb, 0x6b, 0xd1, 0xe9, 0xd4, 0xd3, 0x6f,
+	0xbd, 0xa1, 0xcd, 0xbe, 0xf3, 0xc2, 0xec, 0xb7, 0xd6, 0xaf, 0x5c, 0x9f, 0x7b, 0x63, 0x76, 0xfd,
+	0x7a, 0x6b, 0xfa, 0xdc, 0xbb, 0xa9, 0xab, 0x77, 0xa6, 0x7f, 0xfc, 0xd6, 0xb4, 0xbd, 0x17, 0x0f,
+	0xaf, 0x76, 0x30, 0xf2, 0x59, 0x35, 0xec, 0x81, 0xcc, 0x97, 0x51, 0x16, 0x9e, 0x6b, 0xff, 0xa0,
+	0x93, 0x4a, 0xb1, 0xac, 0x31, 0x2d, 0x1a, 0x12, 0xb2, 0x3d, 0x99, 0x70, 0xae, 0xa7, 0x44, 0xeb,
+	0x7a, 0x4a, 0x14, 0xc4, 0xf5, 0xa4, 0x4e, 0x79, 0x77, 0x64, 0x35, 0xa6, 0x29, 0x2f, 0xc3, 0x4b,
+	0xfd, 0xa3, 0xe1, 0x46, 0xdd, 0xe3, 0x23, 0xe8, 0xf2, 0x51, 0xf9, 0x43, 0x00, 0x5e, 0xe0, 0xc5,
+	0x93, 0x2e, 0x95, 0xb0, 0xc9, 0x96, 0xf3, 0xf3, 0xad, 0x08, 0x6e, 0xc2, 0x49, 0xd7, 0xa6, 0x68,
+	0x39, 0x3f, 0xb9, 0xd1, 0x7c, 0xde, 0xaf, 0xfb, 0x11, 0x79, 0xd0, 0x27, 0xa8, 0x11, 0xb3, 0x3b,
+	0x53, 0x56, 0xe1, 0x39, 0x71, 0x15, 0xb8, 0x87, 0x14, 0x79, 0x61, 0x1f, 0x16, 0x61, 0x15, 0x73,
+	0xd3, 0xef, 0x35, 0x4c, 0x9c, 0x19, 0x6d, 0x45, 0x58, 0x9d, 0xe4, 0xbf, 0xb9, 0x68, 0x7c, 0x09,
+	0xad, 0xc1, 0x31, 0x7e, 0x77, 0x11, 0x83, 0x94, 0xb0, 0x7b, 0xbb, 0x7c, 0xdb, 0xbd, 0x5d, 0xbe,
+	0x39, 0xd0, 0xed, 0x92, 0xc5, 0xf5, 0x15, 0x0e, 0xa2, 0x8e, 0x96, 0xdd, 0x91, 0xf2, 0x5e, 0x08,
+	0x46, 0xb3, 0xd8, 0xd2, 0xeb, 0xb8, 0x73, 0x79, 0xd2, 0xff, 0xc3, 0xa4, 0x5f, 0x87, 0x50, 0xa8,
+	0xee, 0x15, 0xe9, 0x9a, 0x2b, 0xd2, 0x4b, 0x03, 0x89, 0xc4, 0x93, 0xc7, 0x51, 0x69, 0xec, 0x76,
+	0x6b, 0xd8, 0x1d, 0x82, 0xa1, 0x53, 0x0d, 0x01, 0x5a, 0x83, 0xc3, 0x04, 0x33, 0x5e, 0x8d, 0x21,
+	0x01, 0x3c, 0x7f, 0xa2, 0x2f, 0xc7, 0x0a, 0x66, 0xf9, 0xac, 0xbd, 0x17, 0x0f, 0x89, 0x81, 0x1a,
+	0x22, 0x98, 0xe5, 0xfb, 0x55, 0xfc, 0xf0, 0x97, 0x53, 0xf1, 0x23, 0x83, 0x56, 0xfc, 0xfd, 0x00,
+	0x44, 0x0b, 0x98, 0xa9, 0x86, 0xc1, 0xce, 0x26, 0x05, 0x7b, 0xa5, 0x08, 0x7c, 0x39, 0x52, 0x04,
+	0x07, 0x95, 0xe2, 0x6f, 0xa3, 0x30, 0xd6, 0x3e, 0xa6, 0xed, 0x62, 0x5b, 0x92, 0x1f, 0xc2, 0x49,
+	0xcd, 0x34, 0x6b, 0x7a, 0x49, 0xf4, 0x85, 0xc5, 0x8e, 0x3c, 0x5f, 0xf7, 0xcb, 0x93, 0xee, 0x98,
+	0x79, 0x05, 0x1a, 0xed, 0xdc, 0x5d, 0x9a, 0xd7, 0x82, 0x97, 0x69, 0x7f, 0x8d, 0x5e, 0x3e, 0xc8,
+	0x4c, 0x5b, 0x4a, 0x74, 0x3a, 0x25, 0x1f, 0xad, 0xd1, 0x23, 0x05, 0x7a, 0xfe, 0x30, 0x81, 0x26,
+	0x7a, 0x75, 0x40, 0xab, 0x70, 0xa8, 0xa6, 0x53, 0x26, 0xea, 0x6d, 0x3c, 0x35, 0xe7, 0xf7, 0xee,
+	0x70, 0x89, 0x12, 0x1e, 0x6f, 0x6f, 0xea, 0x94, 0x2d, 0x4a, 0xaa, 0x40, 0x42, 0x05, 0x18, 0xb2,
+	0x34, 0x52, 0xc1, 0xee, 0x07, 0xe9, 0x95, 0x93, 0x41, 0xaa, 0x1c, 0x62, 0x51, 0x52, 0x1d, 0x2c,
+	0xb4, 0x0e, 0xc7, 0x36, 0x2d, 0x63, 0xdb, 0xf1, 0x65, 0x58, 0x00, 0x5f, 0x3b, 0x19, 0xf0, 0xab,
+	0x96, 0xb1, 0xcd, 0x3d, 0x5f, 0x94, 0xd4, 0xd1, 0x4d, 0x77, 0x1c, 0xfb, 0x3b, 0x80, 0x93, 0x3e,
+	0x7f, 0xd0, 0x9b, 0x9e, 0x76, 0xd3, 0xe9, 0x83, 0xd3, 0xa7, 0xd7, 0x6a, 0xa2, 0x5b, 0x30, 0xd2,
+	0x79, 0x17, 0x88, 0xfc, 0x0a, 0x5c, 0x0e, 0x1e, 0xbb, 0xfc, 0x2e, 0xf0, 0xec, 0xe2, 0xdd, 0x78,
+	0x67, 0x35, 0x4b, 0xd5, 0x09, 0xdc, 0xb1, 0xa5, 0xb1, 0x7f, 0x01, 0x38, 0xe5, 0x17, 0xf4, 0x8c,
+	0x9d, 0xda, 0x86, 0x61, 0xca, 0x34, 0x8b, 0x15, 0xbb, 0x9f, 0x01, 0xf9, 0xc7, 0x6a, 0xd3, 0xc7,
+	0x0b, 0x1c, 0xd2, 0x7d, 0x0b, 0x8c, 0xd3, 0xd6, 0x64, 0x47, 0x8f, 0x51, 0x78, 0xbe, 0x4f, 0x60,
+	0xcf, 0xd6, 0xc7, 0xb9, 0x40, 0x14, 0x64, 0xc2, 0x70, 0xbc, 0x13, 0x3c, 0xaa, 0xfc, 0x04, 0xc0,
+	0xb0, 0x6b, 0xb7, 0x6a, 0xe1, 0x4d, 0xfd, 0x6d, 0x74, 0xab, 0x87, 0xc2, 0x29, 0x3f, 0x55, 0xd0,
+	0x13, 0x70, 0xb8, 0x86, 0x49, 0x85, 0x6d, 0x09, 0x8d, 0xc3, 0xaa, 0x3b, 0x53, 0x54, 0x38, 0xd9,
+	0x45, 0x05, 0x53, 0x74, 0x1d, 0x8e, 0x9a, 0xee, 0x38, 0x0a, 0x44, 0x92, 0x3d, 0xe5, 0x4f, 0xb2,
+	0xae, 0x2d, 0x99, 0x21, 0xd1, 0xb6, 0xb7, 0x37, 0xa5, 0x7e, 0x07, 0xe0, 0xd0, 0x0a, 0xbd, 0x41,
+	0xd1, 0x02, 0x84, 0x8b, 0x1a, 0x29, 0xd7, 0x30, 0xb7, 0x47, 0x17, 0xfb, 0xa1, 0xb8, 0x15, 0x17,
+	0xbb, 0xd4, 0x7f, 0xd1, 0x6d, 0x2d, 0x55, 0x38, 0xbe, 0x80, 0x59, 0xeb, 0xa9, 0x85, 0x9e, 0xf6,
+	0x1b, 0xf7, 0xbc, 0x4d, 0x63, 0x97, 0xfd, 0x26, 0xfe, 0x77, 0x5a, 0xea, 0x75, 0x38, 0x94, 0xe6,
+	0x24, 0x57, 0x21, 0x5c, 0xc0, 0xcc, 0x7d, 0xc2, 0x1c, 0x07, 0x3a, 0xde, 0xe7, 0x4a, 0xf7, 0x3e,
+	0x7f, 0x52, 0xff, 0x19, 0x82, 0x17, 0x56, 0x9c, 0x48, 0x75, 0xb5, 0xad, 0xa8, 0x0a, 0x23, 0x1e,
+	0x9f, 0x97, 0xf3, 0xf3, 0x68, 0x90, 0x3e, 0x37, 0x76, 0xf5, 0x78, 0xc6, 0xae, 0x66, 0x25, 0x18,
+	0xee, 0xea, 0xb9, 0xd1, 0x74, 0x3f, 0x89, 0xfd, 0x2d, 0xf9, 0x80, 0x87, 0x10, 0x78, 0x2e, 0x47,
+	0x4a, 0xdc, 0xa2, 0x03, 0x76, 0x96, 0x4e, 0x99, 0xf0, 0xbc, 0x7b, 0x9e, 0xd3, 0xa6, 0x9f, 0xfd,
+	0x89, 0x6f, 0xc2, 0x88, 0xd3, 0x8b, 0xb7, 0xb3, 0xef, 0x8a, 0x7f, 0xff, 0x61, 0xbd, 0xfa, 0xa3,
+	0x93, 0x10, 0xdd, 0x84, 0x63, 0x4e, 0x62, 0xf3, 0xdc, 0x53, 0xfc, 0xe6, 0xbd, 0xbd, 0x57, 0xec,
+	0xa8, 0x77, 0x74, 0xea, 0xaf, 0x00, 0x46, 0x3d, 0xfd, 0x45, 0x77, 0xf2, 0xad, 0xc1, 0xb0, 0x43,
+	0xb4, 0x95, 0xea, 0xc7, 0xf7, 0xe3, 0x51, 0x19, 0xef, 0xba, 0x91, 0x36, 0xcd, 0x53, 0x71, 0xe3,
+	0x4f, 0x10, 0x9e, 0xbf, 0x41, 0xdb, 0x9f, 0x2a, 0x15, 0x57, 0x74, 0xca, 0xac, 0x06, 0xfa, 0x33,
+	0x80, 0xc1, 0x05, 0xcc, 0xd0, 0x33, 0x7d, 0x0e, 0xf0, 0x58, 0x3b, 0x27, 0x7c, 0xf5, 0xd0, 0x0f,
+	0xa3, 0x52, 0xbd, 0xf7, 0x8f, 0x7f, 0xff, 0x22, 0x80, 0x51, 0x29, 0x79, 0x9b, 0x26, 0x3d, 0xdd,
+	0x16, 0x4d, 0xbe, 0xdb, 0xfd, 0x8d, 0x4d, 0xf8, 0x7a, 0x3a, 0xdf, 0xfc, 0x4e, 0xd2, 0x31, 0xed,
+	0xdd, 0xd7, 0x1e, 0xde, 0x41, 0xef, 0x05, 0x60, 0xb0, 0xd0, 0x8f, 0x74, 0x61, 0x30, 0xd2, 0x7f,
+	0x01, 0x82, 0xf5, 0x87, 0x20, 0x76, 0x24, 0xed, 0xc4, 0x09, 0x69, 0x27, 0xba, 0x69, 0xcf, 0x81,
+	0x99, 0xb5, 0x65, 0x65, 0xf1, 0xb4, 0x4e, 0x9a, 0x03, 0x33, 0xe8, 0xf7, 0x00, 0x8e, 0xb5, 0x5b,
+	0x2e, 0x34, 0x73, 0xfc, 0x6e, 0xec, 0x28, 0x55, 0xbe, 0x2b, 0x44, 0x59, 0x8c, 0xcd, 0xf7, 0x32,
+	0x7d, 0x14, 0xb5, 0x76, 0x6b, 0x3b, 0xdb, 0x21, 0x79, 0x3f, 0x00, 0x5e, 0x00, 0xe8, 0x57, 0x00,
+	0x0e, 0x67, 0x71, 0x0d, 0x33, 0x8c, 0x8e, 0xd5, 0x5e, 0xc5, 0x9e, 0xe8, 0x79, 0x47, 0xe4, 0xf8,
+	0x1f, 0xc0, 0xca, 0xb2, 0x60, 0xb7, 0x30, 0x93, 0x1b, 0x9c, 0x5d, 0x3b, 0x44, 0x9e, 0x54, 0xfa,
+	0x23, 0x80, 0xa3, 0x19, 0x8d, 0x95, 0xb6, 0x78, 0x11, 0x3c, 0xe7, 0x67, 0xd6, 0x5a, 0xe9, 0xd5,
+	0xef, 0xd9, 0xbe, 0xa6, 0x5e, 0x3b, 0xa7, 0x8c, 0x95, 0x15, 0x47, 0x4d, 0x65, 0xfe, 0xe4, 0x7c,
+	0x37, 0x38, 0x74, 0xb2, 0x82, 0x19, 0x0f, 0x79, 0x9b, 0x70, 0xe1, 0x50, 0xc2, 0x85, 0xff, 0x0d,
+	0xc2, 0xd4, 0x21, 0xfc, 0x21, 0x80, 0xe3, 0xe2, 0x2c, 0x37, 0xfc, 0x57, 0xfb, 0x12, 0x71, 0x16,
+	0x1f, 0x83, 0xb6, 0x93, 0xb5, 0x4b, 0xca, 0xab, 0x8f, 0x4b, 0xbb, 0x2c, 0x98, 0xcc, 0x81, 0x99,
+	0x94, 0x05, 0x03, 0x37, 0x28, 0xaa, 0x89, 0xf7, 0xba, 0xbf, 0xa1, 0x3b, 0x24, 0x3d, 0x7b, 0xaf,
+	0x75, 0xdf, 0x46, 0xe5, 0x29, 0xc1, 0xf3, 0x49, 0xf4, 0x15, 0xce, 0xb3, 0xd5, 0xa0, 0x16, 0x5b,
+	0x7d, 0x5e, 0xe6, 0xb7, 0xe0, 0xc1, 0xbe, 0x0c, 0x1e, 0xee, 0xcb, 0xe0, 0x93, 0x7d, 0x59, 0xfa,
+	0x74, 0x5f, 0x96, 0x3e, 0xdb, 0x97, 0xa5, 0xcf, 0xf7, 0x65, 0xe9, 0x8b, 0x7d, 0x19, 0xdc, 0xb5,
+	0x65, 0x70, 0xdf, 0x96, 0xa5, 0xf7, 0x6d, 0x19, 0x7c, 0x60, 0xcb, 0xd2, 0x47, 0xb6, 0x2c, 0x7d,
+	0x6c, 0xcb, 0xd2, 0x03, 0x5b, 0x06, 0x0f, 0x6d, 0x19, 0x7c, 0x62, 0xcb, 0xd2, 0xa7, 0xb6, 0x0c,
+	0x3e, 0xb3, 0x65, 0xe9, 0x73, 0x5b, 0x06, 0x5f, 0xd8, 0xb2, 0x74, 0xb7, 0x29, 0x4b, 0xf7, 0x9b,
+	0x32, 0xf8, 0x79, 0x53, 0x96, 0x7e, 0xdd, 0x94, 0xc1, 0x6f, 0x9a, 0xb2, 0xf4, 0x7e, 0x53, 0x96,
+	0x3e, 0x68, 0xca, 0xe0, 0xa3, 0xa6, 0x0c, 0x3e, 0x6e, 0xca, 0x60, 0xed, 0xea, 0x71, 0x5b, 0x62,
+	0x46, 0xcc, 0x8d, 0x8d, 0x61, 0xe1, 0xf4, 0x8b, 0xff, 0x1d, 0x00, 0x99, 0x55, 0x23, 0xdc, 0x68,
+	0x1a, 0x00, 0x00,
 }
 
 func (this *SessionKeyRequest) Equal(that interface{}) bool {
@@ -2050,6 +2055,15 @@ type JsEndDeviceRegistryClient interface {
 	// Delete deletes the device that matches the given identifiers.
 	// If there are multiple matches, an error will be returned.
 	Delete(ctx context.Context, in *EndDeviceIdentifiers, opts ...grpc.CallOption) (*types.Empty, error)
+	// BatchGet returns up to 100 devices of an application.
+	// The results contain the device or the error for each request.
+	BatchGet(ctx context.Context, in *BatchGetEndDevicesRequest, opts ...grpc.CallOption) (*BatchEndDevicesResponse, error)
+	// BatchSet creates or updates up to 100 devices of an application.
+	// The results contain the device or the error for each request.
+	BatchSet(ctx context.Context, in *BatchSetEndDevicesRequest, opts ...grpc.CallOption) (*BatchEndDevicesResponse, error)
+	// BatchDelete deletes up to 100 devices of an application.
+	// The results contain the error for each request that failed.
+	BatchDelete(ctx context.Context, in *BatchDeleteEndDevicesRequest, opts ...grpc.CallOption) (*BatchEndDevicesResponse, error)
 }
 
 type jsEndDeviceRegistryClient struct {
@@ -2120,6 +2134,33 @@ func (c *jsEndDeviceRegistryClient) Delete(ctx context.Context, in *EndDeviceIde
 	return out, nil
 }
 
+func (c *jsEndDeviceRegistryClient) BatchGet(ctx context.Context, in *BatchGetEndDevicesRequest, opts ...grpc.CallOption) (*BatchEndDevicesResponse, error) {
+	out := new(BatchEndDevicesResponse)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.JsEndDeviceRegistry/BatchGet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jsEndDeviceRegistryClient) BatchSet(ctx context.Context, in *BatchSetEndDevicesRequest, opts ...grpc.CallOption) (*BatchEndDevicesResponse, error) {
+	out := new(BatchEndDevicesResponse)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.JsEndDeviceRegistry/BatchSet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jsEndDeviceRegistryClient) BatchDelete(ctx context.Context, in *BatchDeleteEndDevicesRequest, opts ...grpc.CallOption) (*BatchEndDevicesResponse, error) {
+	out := new(BatchEndDevicesResponse)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.JsEndDeviceRegistry/BatchDelete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // JsEndDeviceRegistryServer is the server API for JsEndDeviceRegistry service.
 type JsEndDeviceRegistryServer interface {
 	// Get returns the device that matches the given identifiers.
@@ -2133,6 +2174,15 @@ type JsEndDeviceRegistryServer interface {
 	// Delete deletes the device that matches the given identifiers.
 	// If there are multiple matches, an error will be returned.
 	Delete(context.Context, *EndDeviceIdentifiers) (*types.Empty, error)
+	// BatchGet returns up to 100 devices of an application.
+	// The results contain the device or the error for each request.
+	BatchGet(context.Context, *BatchGetEndDevicesRequest) (*BatchEndDevicesResponse, error)
+	// BatchSet creates or updates up to 100 devices of an application.
+	// The results contain the device or the error for each request.
+	BatchSet(context.Context, *BatchSetEndDevicesRequest) (*BatchEndDevicesResponse, error)
+	// BatchDelete deletes up to 100 devices of an application.
+	// The results contain the error for each request that failed.
+	BatchDelete(context.Context, *BatchDeleteEndDevicesRequest) (*BatchEndDevicesResponse, error)
 }
 
 // UnimplementedJsEndDeviceRegistryServer can be embedded to have forward compatible implementations.
@@ -2151,6 +2201,15 @@ func (*UnimplementedJsEndDeviceRegistryServer) Provision(req *ProvisionEndDevice
 func (*UnimplementedJsEndDeviceRegistryServer) Delete(ctx context.Context, req *EndDeviceIdentifiers) (*types.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
 }
+func (*UnimplementedJsEndDeviceRegistryServer) BatchGet(ctx context.Context, req *BatchGetEndDevicesRequest) (*BatchEndDevicesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchGet not implemented")
+}
+func (*UnimplementedJsEndDeviceRegistryServer) BatchSet(ctx context.Context, req *BatchSetEndDevicesRequest) (*BatchEndDevicesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchSet not implemented")
+}
+func (*UnimplementedJsEndDeviceRegistryServer) BatchDelete(ctx context.Context, req *BatchDeleteEndDevicesRequest) (*BatchEndDevicesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchDelete not implemented")
+}
 
 func RegisterJsEndDeviceRegistryServer(s *grpc.Server, srv JsEndDeviceRegistryServer) {
 	s.RegisterService(&_JsEndDeviceRegistry_serviceDesc, srv)
@@ -2231,6 +2290,60 @@ func _JsEndDeviceRegistry_Delete_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _JsEndDeviceRegistry_BatchGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchGetEndDevicesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JsEndDeviceRegistryServer).BatchGet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.JsEndDeviceRegistry/BatchGet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JsEndDeviceRegistryServer).BatchGet(ctx, req.(*BatchGetEndDevicesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JsEndDeviceRegistry_BatchSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchSetEndDevicesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JsEndDeviceRegistryServer).BatchSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.JsEndDeviceRegistry/BatchSet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JsEndDeviceRegistryServer).BatchSet(ctx, req.(*BatchSetEndDevicesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JsEndDeviceRegistry_BatchDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchDeleteEndDevicesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JsEndDeviceRegistryServer).BatchDelete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.JsEndDeviceRegistry/BatchDelete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JsEndDeviceRegistryServer).BatchDelete(ctx, req.(*BatchDeleteEndDevicesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _JsEndDeviceRegistry_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ttn.lorawan.v3.JsEndDeviceRegistry",
 	HandlerType: (*JsEndDeviceRegistryServer)(nil),
@@ -2247,6 +2360,18 @@ var _JsEndDeviceRegistry_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Delete",
 			Handler:    _JsEndDeviceRegistry_Delete_Handler,
 		},
+		{
+			MethodName: "BatchGet",
+			Handler:    _JsEndDeviceRegistry_BatchGet_Handler,
+		},
+		{
+			MethodName: "BatchSet",
+			Handler:    _JsEndDeviceRegistry_BatchSet_Handler,
+		},
+		{
+			MethodName: "BatchDelete",
+			Handler:    _JsEndDeviceRegistry_BatchDelete_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
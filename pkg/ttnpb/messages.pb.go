@@ -21,6 +21,7 @@ import (
 	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	types "github.com/gogo/protobuf/types"
 	golang_proto "github.com/golang/protobuf/proto"
+	go_thethings_network_lorawan_stack_pkg_types "go.thethings.network/lorawan-stack/pkg/types"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -49,6 +50,8 @@ const (
 	PayloadFormatter_FORMATTER_JAVASCRIPT PayloadFormatter = 3
 	// CayenneLPP payload formatter.
 	PayloadFormatter_FORMATTER_CAYENNELPP PayloadFormatter = 4
+	// Custom payload formatter that executes WebAssembly code. The parameter is a base64 encoded WebAssembly module.
+	PayloadFormatter_FORMATTER_WASM PayloadFormatter = 5
 )
 
 var PayloadFormatter_name = map[int32]string{
@@ -57,6 +60,7 @@ var PayloadFormatter_name = map[int32]string{
 	2: "FORMATTER_GRPC_SERVICE",
 	3: "FORMATTER_JAVASCRIPT",
 	4: "FORMATTER_CAYENNELPP",
+	5: "FORMATTER_WASM",
 }
 
 var PayloadFormatter_value = map[string]int32{
@@ -65,6 +69,7 @@ var PayloadFormatter_value = map[string]int32{
 	"FORMATTER_GRPC_SERVICE": 2,
 	"FORMATTER_JAVASCRIPT":   3,
 	"FORMATTER_CAYENNELPP":   4,
+	"FORMATTER_WASM":         5,
 }
 
 func (PayloadFormatter) EnumDescriptor() ([]byte, []int) {
@@ -332,10 +337,13 @@ func (*DownlinkMessage) XXX_OneofWrappers() []interface{} {
 }
 
 type TxAcknowledgment struct {
-	CorrelationIDs       []string                `protobuf:"bytes,1,rep,name=correlation_ids,json=correlationIds,proto3" json:"correlation_ids,omitempty"`
-	Result               TxAcknowledgment_Result `protobuf:"varint,2,opt,name=result,proto3,enum=ttn.lorawan.v3.TxAcknowledgment_Result" json:"result,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
-	XXX_sizecache        int32                   `json:"-"`
+	CorrelationIDs []string                `protobuf:"bytes,1,rep,name=correlation_ids,json=correlationIds,proto3" json:"correlation_ids,omitempty"`
+	Result         TxAcknowledgment_Result `protobuf:"varint,2,opt,name=result,proto3,enum=ttn.lorawan.v3.TxAcknowledgment_Result" json:"result,omitempty"`
+	// The acknowledged downlink message.
+	// Set by the Gateway Server.
+	DownlinkMessage      *DownlinkMessage `protobuf:"bytes,3,opt,name=downlink_message,json=downlinkMessage,proto3" json:"downlink_message,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
 
 func (m *TxAcknowledgment) Reset()      { *m = TxAcknowledgment{} }
@@ -384,6 +392,59 @@ func (m *TxAcknowledgment) GetResult() TxAcknowledgment_Result {
 	return TxAcknowledgment_SUCCESS
 }
 
+func (m *TxAcknowledgment) GetDownlinkMessage() *DownlinkMessage {
+	if m != nil {
+		return m.DownlinkMessage
+	}
+	return nil
+}
+
+type GatewayTxAcknowledgment struct {
+	GatewayIdentifiers   `protobuf:"bytes,1,opt,name=gateway_ids,json=gatewayIds,proto3,embedded=gateway_ids" json:"gateway_ids"`
+	TxAck                *TxAcknowledgment `protobuf:"bytes,2,opt,name=tx_ack,json=txAck,proto3" json:"tx_ack,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *GatewayTxAcknowledgment) Reset()      { *m = GatewayTxAcknowledgment{} }
+func (*GatewayTxAcknowledgment) ProtoMessage() {}
+func (*GatewayTxAcknowledgment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bbc6bff5780bdc9d, []int{3}
+}
+func (m *GatewayTxAcknowledgment) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GatewayTxAcknowledgment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GatewayTxAcknowledgment.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GatewayTxAcknowledgment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GatewayTxAcknowledgment.Merge(m, src)
+}
+func (m *GatewayTxAcknowledgment) XXX_Size() int {
+	return m.Size()
+}
+func (m *GatewayTxAcknowledgment) XXX_DiscardUnknown() {
+	xxx_messageInfo_GatewayTxAcknowledgment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GatewayTxAcknowledgment proto.InternalMessageInfo
+
+func (m *GatewayTxAcknowledgment) GetTxAck() *TxAcknowledgment {
+	if m != nil {
+		return m.TxAck
+	}
+	return nil
+}
+
 type GatewayUplinkMessage struct {
 	*UplinkMessage `protobuf:"bytes,1,opt,name=message,proto3,embedded=message" json:"message,omitempty"`
 	// LoRaWAN band ID of the gateway.
@@ -395,7 +456,7 @@ type GatewayUplinkMessage struct {
 func (m *GatewayUplinkMessage) Reset()      { *m = GatewayUplinkMessage{} }
 func (*GatewayUplinkMessage) ProtoMessage() {}
 func (*GatewayUplinkMessage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bbc6bff5780bdc9d, []int{3}
+	return fileDescriptor_bbc6bff5780bdc9d, []int{4}
 }
 func (m *GatewayUplinkMessage) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -456,15 +517,17 @@ type ApplicationUplink struct {
 	// This field is only present if the skip_payload_crypto field of the EndDevice
 	// is true.
 	// Can be used with app_s_key to encrypt downlink payloads.
-	LastAFCntDown        uint32   `protobuf:"varint,10,opt,name=last_a_f_cnt_down,json=lastAFCntDown,proto3" json:"last_a_f_cnt_down,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	LastAFCntDown uint32 `protobuf:"varint,10,opt,name=last_a_f_cnt_down,json=lastAFCntDown,proto3" json:"last_a_f_cnt_down,omitempty"`
+	// Warnings returned by the payload formatter when decoding the frame payload.
+	DecodedPayloadWarnings []string `protobuf:"bytes,11,rep,name=decoded_payload_warnings,json=decodedPayloadWarnings,proto3" json:"decoded_payload_warnings,omitempty"`
+	XXX_NoUnkeyedLiteral   struct{} `json:"-"`
+	XXX_sizecache          int32    `json:"-"`
 }
 
 func (m *ApplicationUplink) Reset()      { *m = ApplicationUplink{} }
 func (*ApplicationUplink) ProtoMessage() {}
 func (*ApplicationUplink) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bbc6bff5780bdc9d, []int{4}
+	return fileDescriptor_bbc6bff5780bdc9d, []int{5}
 }
 func (m *ApplicationUplink) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -563,6 +626,13 @@ func (m *ApplicationUplink) GetLastAFCntDown() uint32 {
 	return 0
 }
 
+func (m *ApplicationUplink) GetDecodedPayloadWarnings() []string {
+	if m != nil {
+		return m.DecodedPayloadWarnings
+	}
+	return nil
+}
+
 type ApplicationLocation struct {
 	Service              string `protobuf:"bytes,1,opt,name=service,proto3" json:"service,omitempty"`
 	Location             `protobuf:"bytes,2,opt,name=location,proto3,embedded=location" json:"location"`
@@ -574,7 +644,7 @@ type ApplicationLocation struct {
 func (m *ApplicationLocation) Reset()      { *m = ApplicationLocation{} }
 func (*ApplicationLocation) ProtoMessage() {}
 func (*ApplicationLocation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bbc6bff5780bdc9d, []int{5}
+	return fileDescriptor_bbc6bff5780bdc9d, []int{6}
 }
 func (m *ApplicationLocation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -636,7 +706,7 @@ type ApplicationJoinAccept struct {
 func (m *ApplicationJoinAccept) Reset()      { *m = ApplicationJoinAccept{} }
 func (*ApplicationJoinAccept) ProtoMessage() {}
 func (*ApplicationJoinAccept) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bbc6bff5780bdc9d, []int{6}
+	return fileDescriptor_bbc6bff5780bdc9d, []int{7}
 }
 func (m *ApplicationJoinAccept) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -716,16 +786,18 @@ type ApplicationDownlink struct {
 	// If not set, this downlink message may be transmitted in class A, B and C.
 	ClassBC *ApplicationDownlink_ClassBC `protobuf:"bytes,7,opt,name=class_b_c,json=classBC,proto3" json:"class_b_c,omitempty"`
 	// Priority for scheduling the downlink message.
-	Priority             TxSchedulePriority `protobuf:"varint,8,opt,name=priority,proto3,enum=ttn.lorawan.v3.TxSchedulePriority" json:"priority,omitempty"`
-	CorrelationIDs       []string           `protobuf:"bytes,9,rep,name=correlation_ids,json=correlationIds,proto3" json:"correlation_ids,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
-	XXX_sizecache        int32              `json:"-"`
+	Priority       TxSchedulePriority `protobuf:"varint,8,opt,name=priority,proto3,enum=ttn.lorawan.v3.TxSchedulePriority" json:"priority,omitempty"`
+	CorrelationIDs []string           `protobuf:"bytes,9,rep,name=correlation_ids,json=correlationIds,proto3" json:"correlation_ids,omitempty"`
+	// Warnings returned by the payload formatter when encoding or decoding the frame payload.
+	DecodedPayloadWarnings []string `protobuf:"bytes,10,rep,name=decoded_payload_warnings,json=decodedPayloadWarnings,proto3" json:"decoded_payload_warnings,omitempty"`
+	XXX_NoUnkeyedLiteral   struct{} `json:"-"`
+	XXX_sizecache          int32    `json:"-"`
 }
 
 func (m *ApplicationDownlink) Reset()      { *m = ApplicationDownlink{} }
 func (*ApplicationDownlink) ProtoMessage() {}
 func (*ApplicationDownlink) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bbc6bff5780bdc9d, []int{7}
+	return fileDescriptor_bbc6bff5780bdc9d, []int{8}
 }
 func (m *ApplicationDownlink) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -817,6 +889,13 @@ func (m *ApplicationDownlink) GetCorrelationIDs() []string {
 	return nil
 }
 
+func (m *ApplicationDownlink) GetDecodedPayloadWarnings() []string {
+	if m != nil {
+		return m.DecodedPayloadWarnings
+	}
+	return nil
+}
+
 type ApplicationDownlink_ClassBC struct {
 	// Possible gateway identifiers and antenna index to use for this downlink message.
 	// The Network Server selects one of these gateways for downlink, based on connectivity, signal quality, channel utilization and an available slot.
@@ -835,7 +914,7 @@ type ApplicationDownlink_ClassBC struct {
 func (m *ApplicationDownlink_ClassBC) Reset()      { *m = ApplicationDownlink_ClassBC{} }
 func (*ApplicationDownlink_ClassBC) ProtoMessage() {}
 func (*ApplicationDownlink_ClassBC) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bbc6bff5780bdc9d, []int{7, 0}
+	return fileDescriptor_bbc6bff5780bdc9d, []int{8, 0}
 }
 func (m *ApplicationDownlink_ClassBC) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -887,7 +966,7 @@ type ApplicationDownlinks struct {
 func (m *ApplicationDownlinks) Reset()      { *m = ApplicationDownlinks{} }
 func (*ApplicationDownlinks) ProtoMessage() {}
 func (*ApplicationDownlinks) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bbc6bff5780bdc9d, []int{8}
+	return fileDescriptor_bbc6bff5780bdc9d, []int{9}
 }
 func (m *ApplicationDownlinks) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -933,7 +1012,7 @@ type ApplicationDownlinkFailed struct {
 func (m *ApplicationDownlinkFailed) Reset()      { *m = ApplicationDownlinkFailed{} }
 func (*ApplicationDownlinkFailed) ProtoMessage() {}
 func (*ApplicationDownlinkFailed) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bbc6bff5780bdc9d, []int{9}
+	return fileDescriptor_bbc6bff5780bdc9d, []int{10}
 }
 func (m *ApplicationDownlinkFailed) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -979,7 +1058,7 @@ type ApplicationInvalidatedDownlinks struct {
 func (m *ApplicationInvalidatedDownlinks) Reset()      { *m = ApplicationInvalidatedDownlinks{} }
 func (*ApplicationInvalidatedDownlinks) ProtoMessage() {}
 func (*ApplicationInvalidatedDownlinks) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bbc6bff5780bdc9d, []int{10}
+	return fileDescriptor_bbc6bff5780bdc9d, []int{11}
 }
 func (m *ApplicationInvalidatedDownlinks) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1022,6 +1101,63 @@ func (m *ApplicationInvalidatedDownlinks) GetLastFCntDown() uint32 {
 	return 0
 }
 
+type ApplicationSessionSwitch struct {
+	// Join Server issued identifier for the session keys of the session the end device switched to.
+	SessionKeyID []byte `protobuf:"bytes,1,opt,name=session_key_id,json=sessionKeyId,proto3" json:"session_key_id,omitempty"`
+	// Device address of the session the end device switched to.
+	DevAddr go_thethings_network_lorawan_stack_pkg_types.DevAddr `protobuf:"bytes,2,opt,name=dev_addr,json=devAddr,proto3,customtype=go.thethings.network/lorawan-stack/pkg/types.DevAddr" json:"dev_addr"`
+	// Server time when the Network Server detected the session switch.
+	SwitchedAt           time.Time `protobuf:"bytes,3,opt,name=switched_at,json=switchedAt,proto3,stdtime" json:"switched_at"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *ApplicationSessionSwitch) Reset()      { *m = ApplicationSessionSwitch{} }
+func (*ApplicationSessionSwitch) ProtoMessage() {}
+func (*ApplicationSessionSwitch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bbc6bff5780bdc9d, []int{12}
+}
+func (m *ApplicationSessionSwitch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplicationSessionSwitch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ApplicationSessionSwitch.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ApplicationSessionSwitch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationSessionSwitch.Merge(m, src)
+}
+func (m *ApplicationSessionSwitch) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationSessionSwitch) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationSessionSwitch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationSessionSwitch proto.InternalMessageInfo
+
+func (m *ApplicationSessionSwitch) GetSessionKeyID() []byte {
+	if m != nil {
+		return m.SessionKeyID
+	}
+	return nil
+}
+
+func (m *ApplicationSessionSwitch) GetSwitchedAt() time.Time {
+	if m != nil {
+		return m.SwitchedAt
+	}
+	return time.Time{}
+}
+
 type ApplicationUp struct {
 	EndDeviceIdentifiers `protobuf:"bytes,1,opt,name=end_device_ids,json=endDeviceIds,proto3,embedded=end_device_ids" json:"end_device_ids"`
 	CorrelationIDs       []string `protobuf:"bytes,2,rep,name=correlation_ids,json=correlationIds,proto3" json:"correlation_ids,omitempty"`
@@ -1037,6 +1173,7 @@ type ApplicationUp struct {
 	//	*ApplicationUp_DownlinkQueued
 	//	*ApplicationUp_DownlinkQueueInvalidated
 	//	*ApplicationUp_LocationSolved
+	//	*ApplicationUp_SessionSwitch
 	Up                   isApplicationUp_Up `protobuf_oneof:"up"`
 	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
 	XXX_sizecache        int32              `json:"-"`
@@ -1045,7 +1182,7 @@ type ApplicationUp struct {
 func (m *ApplicationUp) Reset()      { *m = ApplicationUp{} }
 func (*ApplicationUp) ProtoMessage() {}
 func (*ApplicationUp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bbc6bff5780bdc9d, []int{11}
+	return fileDescriptor_bbc6bff5780bdc9d, []int{13}
 }
 func (m *ApplicationUp) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1108,6 +1245,9 @@ type ApplicationUp_DownlinkQueueInvalidated struct {
 type ApplicationUp_LocationSolved struct {
 	LocationSolved *ApplicationLocation `protobuf:"bytes,11,opt,name=location_solved,json=locationSolved,proto3,oneof" json:"location_solved,omitempty"`
 }
+type ApplicationUp_SessionSwitch struct {
+	SessionSwitch *ApplicationSessionSwitch `protobuf:"bytes,13,opt,name=session_switch,json=sessionSwitch,proto3,oneof" json:"session_switch,omitempty"`
+}
 
 func (*ApplicationUp_UplinkMessage) isApplicationUp_Up()            {}
 func (*ApplicationUp_JoinAccept) isApplicationUp_Up()               {}
@@ -1118,6 +1258,7 @@ func (*ApplicationUp_DownlinkFailed) isApplicationUp_Up()           {}
 func (*ApplicationUp_DownlinkQueued) isApplicationUp_Up()           {}
 func (*ApplicationUp_DownlinkQueueInvalidated) isApplicationUp_Up() {}
 func (*ApplicationUp_LocationSolved) isApplicationUp_Up()           {}
+func (*ApplicationUp_SessionSwitch) isApplicationUp_Up()            {}
 
 func (m *ApplicationUp) GetUp() isApplicationUp_Up {
 	if m != nil {
@@ -1203,6 +1344,13 @@ func (m *ApplicationUp) GetLocationSolved() *ApplicationLocation {
 	return nil
 }
 
+func (m *ApplicationUp) GetSessionSwitch() *ApplicationSessionSwitch {
+	if x, ok := m.GetUp().(*ApplicationUp_SessionSwitch); ok {
+		return x.SessionSwitch
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*ApplicationUp) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -1215,6 +1363,7 @@ func (*ApplicationUp) XXX_OneofWrappers() []interface{} {
 		(*ApplicationUp_DownlinkQueued)(nil),
 		(*ApplicationUp_DownlinkQueueInvalidated)(nil),
 		(*ApplicationUp_LocationSolved)(nil),
+		(*ApplicationUp_SessionSwitch)(nil),
 	}
 }
 
@@ -1234,7 +1383,7 @@ type MessagePayloadFormatters struct {
 func (m *MessagePayloadFormatters) Reset()      { *m = MessagePayloadFormatters{} }
 func (*MessagePayloadFormatters) ProtoMessage() {}
 func (*MessagePayloadFormatters) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bbc6bff5780bdc9d, []int{12}
+	return fileDescriptor_bbc6bff5780bdc9d, []int{14}
 }
 func (m *MessagePayloadFormatters) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1301,7 +1450,7 @@ type DownlinkQueueRequest struct {
 func (m *DownlinkQueueRequest) Reset()      { *m = DownlinkQueueRequest{} }
 func (*DownlinkQueueRequest) ProtoMessage() {}
 func (*DownlinkQueueRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bbc6bff5780bdc9d, []int{13}
+	return fileDescriptor_bbc6bff5780bdc9d, []int{15}
 }
 func (m *DownlinkQueueRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1348,6 +1497,8 @@ func init() {
 	golang_proto.RegisterType((*DownlinkMessage)(nil), "ttn.lorawan.v3.DownlinkMessage")
 	proto.RegisterType((*TxAcknowledgment)(nil), "ttn.lorawan.v3.TxAcknowledgment")
 	golang_proto.RegisterType((*TxAcknowledgment)(nil), "ttn.lorawan.v3.TxAcknowledgment")
+	proto.RegisterType((*GatewayTxAcknowledgment)(nil), "ttn.lorawan.v3.GatewayTxAcknowledgment")
+	golang_proto.RegisterType((*GatewayTxAcknowledgment)(nil), "ttn.lorawan.v3.GatewayTxAcknowledgment")
 	proto.RegisterType((*GatewayUplinkMessage)(nil), "ttn.lorawan.v3.GatewayUplinkMessage")
 	golang_proto.RegisterType((*GatewayUplinkMessage)(nil), "ttn.lorawan.v3.GatewayUplinkMessage")
 	proto.RegisterType((*ApplicationUplink)(nil), "ttn.lorawan.v3.ApplicationUplink")
@@ -1368,6 +1519,8 @@ func init() {
 	golang_proto.RegisterType((*ApplicationDownlinkFailed)(nil), "ttn.lorawan.v3.ApplicationDownlinkFailed")
 	proto.RegisterType((*ApplicationInvalidatedDownlinks)(nil), "ttn.lorawan.v3.ApplicationInvalidatedDownlinks")
 	golang_proto.RegisterType((*ApplicationInvalidatedDownlinks)(nil), "ttn.lorawan.v3.ApplicationInvalidatedDownlinks")
+	proto.RegisterType((*ApplicationSessionSwitch)(nil), "ttn.lorawan.v3.ApplicationSessionSwitch")
+	golang_proto.RegisterType((*ApplicationSessionSwitch)(nil), "ttn.lorawan.v3.ApplicationSessionSwitch")
 	proto.RegisterType((*ApplicationUp)(nil), "ttn.lorawan.v3.ApplicationUp")
 	golang_proto.RegisterType((*ApplicationUp)(nil), "ttn.lorawan.v3.ApplicationUp")
 	proto.RegisterType((*MessagePayloadFormatters)(nil), "ttn.lorawan.v3.MessagePayloadFormatters")
@@ -1382,142 +1535,154 @@ func init() {
 }
 
 var fileDescriptor_bbc6bff5780bdc9d = []byte{
-	// 2152 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x58, 0x4b, 0x6c, 0x1b, 0xc7,
-	0xf9, 0xdf, 0xe1, 0x9b, 0xc3, 0x87, 0x36, 0x13, 0x25, 0x7f, 0x46, 0x7f, 0x67, 0xa9, 0xd2, 0x4e,
-	0x23, 0xbb, 0x16, 0xd5, 0xca, 0x2d, 0xea, 0xba, 0x68, 0x13, 0x2e, 0xb5, 0xb2, 0x68, 0xc9, 0x24,
-	0x3d, 0xa4, 0x13, 0xbb, 0x69, 0xba, 0x58, 0x71, 0x47, 0xf4, 0x46, 0xd4, 0xee, 0x66, 0x77, 0xa8,
-	0x47, 0x8a, 0x02, 0x6e, 0x4e, 0x41, 0x4f, 0x46, 0x80, 0x16, 0x45, 0x81, 0x16, 0x41, 0x7b, 0xc9,
-	0xa1, 0x40, 0x7d, 0x34, 0x7a, 0x28, 0x72, 0xab, 0x8f, 0x3e, 0x06, 0x3d, 0xa8, 0x16, 0x75, 0xc9,
-	0x31, 0x47, 0x43, 0x97, 0x14, 0x3b, 0xbb, 0x4b, 0xee, 0x52, 0xac, 0x2d, 0x2b, 0xed, 0xa9, 0x27,
-	0x71, 0xe7, 0xfb, 0x7d, 0xbf, 0xf9, 0x66, 0xbe, 0xe7, 0x08, 0xce, 0xf6, 0x0c, 0x4b, 0xd9, 0x51,
-	0xf4, 0x79, 0x9b, 0x2a, 0x9d, 0xcd, 0x05, 0xc5, 0xd4, 0x16, 0xb6, 0x88, 0x6d, 0x2b, 0x5d, 0x62,
-	0x97, 0x4d, 0xcb, 0xa0, 0x06, 0xca, 0x53, 0xaa, 0x97, 0x3d, 0x54, 0x79, 0xfb, 0xd2, 0x4c, 0xa5,
-	0xab, 0xd1, 0x3b, 0xfd, 0xf5, 0x72, 0xc7, 0xd8, 0x5a, 0x20, 0xfa, 0xb6, 0xb1, 0x67, 0x5a, 0xc6,
-	0xee, 0xde, 0x02, 0x03, 0x77, 0xe6, 0xbb, 0x44, 0x9f, 0xdf, 0x56, 0x7a, 0x9a, 0xaa, 0x50, 0xb2,
-	0x70, 0xec, 0x87, 0x4b, 0x39, 0x33, 0x1f, 0xa0, 0xe8, 0x1a, 0x5d, 0xc3, 0x55, 0x5e, 0xef, 0x6f,
-	0xb0, 0x2f, 0xf6, 0xc1, 0x7e, 0x79, 0xf0, 0x33, 0x5d, 0xc3, 0xe8, 0xf6, 0xc8, 0x08, 0x65, 0x53,
-	0xab, 0xdf, 0xa1, 0x9e, 0xb4, 0x38, 0x2e, 0xa5, 0xda, 0x16, 0xb1, 0xa9, 0xb2, 0x65, 0x7a, 0x80,
-	0x57, 0x8f, 0x1f, 0x91, 0x58, 0x96, 0x61, 0x79, 0xe2, 0xb3, 0xc7, 0xc5, 0x9a, 0x4a, 0x74, 0xaa,
-	0x6d, 0x68, 0xc4, 0xb2, 0x7d, 0x13, 0x8e, 0x83, 0x36, 0xc9, 0x9e, 0x2f, 0x2d, 0x1e, 0x97, 0xfa,
-	0x17, 0xe6, 0x02, 0x26, 0xde, 0x32, 0x55, 0x54, 0x85, 0x2a, 0x2e, 0xa2, 0xf4, 0xb7, 0x28, 0xcc,
-	0xdd, 0x34, 0x7b, 0x9a, 0xbe, 0x79, 0xdd, 0xbd, 0x7e, 0x54, 0x84, 0x19, 0x4b, 0xd9, 0x91, 0x4d,
-	0x65, 0xaf, 0x67, 0x28, 0x6a, 0x01, 0xcc, 0x82, 0xb9, 0x2c, 0x86, 0x96, 0xb2, 0xd3, 0x74, 0x57,
-	0xd0, 0x77, 0x60, 0xd2, 0x17, 0x46, 0x66, 0xc1, 0x5c, 0x66, 0xf1, 0xff, 0xca, 0x61, 0x57, 0x95,
-	0x3d, 0x2a, 0xec, 0xe3, 0xd0, 0x12, 0x4c, 0xd9, 0x84, 0x52, 0x4d, 0xef, 0xda, 0x85, 0x18, 0xd3,
-	0x99, 0x19, 0xd7, 0x69, 0xef, 0xb6, 0x3c, 0x84, 0x98, 0x3d, 0x12, 0xe3, 0xbf, 0x02, 0x11, 0x1e,
-	0x3c, 0xdc, 0x2f, 0x72, 0x78, 0xa8, 0x89, 0x7e, 0x08, 0x33, 0xd6, 0xae, 0xec, 0x1f, 0xa0, 0x10,
-	0x9f, 0x8d, 0x4e, 0x22, 0xc2, 0xbb, 0xd7, 0x3d, 0x04, 0x86, 0xd6, 0xf0, 0x37, 0x92, 0x60, 0xc6,
-	0x22, 0x1d, 0xa2, 0x6d, 0x13, 0x55, 0x56, 0x68, 0x21, 0xe1, 0x59, 0xe1, 0x3a, 0xb1, 0xec, 0x3b,
-	0xb1, 0xdc, 0xf6, 0x9d, 0x28, 0xa6, 0x9c, 0xdd, 0xef, 0xfd, 0xb3, 0x08, 0x30, 0xf4, 0x15, 0x2b,
-	0x14, 0x5d, 0x85, 0x53, 0x1d, 0xc3, 0xb2, 0x48, 0x4f, 0xa1, 0x9a, 0xa1, 0xcb, 0x9a, 0x6a, 0x17,
-	0x92, 0xb3, 0xd1, 0xb9, 0xb4, 0x28, 0x1c, 0x89, 0xe9, 0x8f, 0x41, 0xa2, 0x14, 0xb3, 0x22, 0x05,
-	0x75, 0xb0, 0x5f, 0xcc, 0x57, 0x47, 0xb0, 0xda, 0x92, 0x8d, 0xf3, 0x01, 0xb5, 0x9a, 0x6a, 0xa3,
-	0x2b, 0x70, 0x5a, 0x25, 0xdb, 0x5a, 0x87, 0xc8, 0x9d, 0x3b, 0x8a, 0xae, 0x93, 0x9e, 0xac, 0xe9,
-	0x2a, 0xd9, 0x2d, 0xa4, 0x67, 0xc1, 0x5c, 0x4e, 0x4c, 0x1d, 0x89, 0xf1, 0x0b, 0xd1, 0xc2, 0x57,
-	0x00, 0x23, 0x17, 0x55, 0x75, 0x41, 0x35, 0x07, 0x73, 0x25, 0xf6, 0xe0, 0x93, 0x22, 0x77, 0x2d,
-	0x96, 0x4a, 0xf1, 0xe9, 0xd2, 0x6f, 0xa2, 0x70, 0x6a, 0xc9, 0xd8, 0xd1, 0xff, 0xdb, 0x2e, 0xfc,
-	0x29, 0xcc, 0x13, 0x5d, 0x95, 0x3d, 0x9b, 0x9d, 0x73, 0x47, 0x99, 0xe6, 0xb9, 0x71, 0x4d, 0x49,
-	0x57, 0x97, 0x18, 0xa8, 0x36, 0x8a, 0x66, 0x91, 0x1f, 0xec, 0x17, 0xb3, 0x23, 0xc9, 0x92, 0x8d,
-	0xb3, 0x64, 0x84, 0xb3, 0xd1, 0xf7, 0x60, 0xd2, 0x22, 0xef, 0xf7, 0x89, 0x4d, 0xbd, 0xf8, 0x78,
-	0xe5, 0x78, 0x7c, 0x60, 0x17, 0xb0, 0xc2, 0x61, 0x1f, 0x8b, 0xae, 0xc0, 0xb4, 0xdd, 0xb9, 0x43,
-	0xd4, 0x7e, 0x8f, 0xa8, 0x85, 0xf8, 0xb3, 0x02, 0x6b, 0x85, 0xc3, 0x23, 0xf8, 0x24, 0x4f, 0x26,
-	0x4e, 0xe3, 0x49, 0xd7, 0x1b, 0xe2, 0xd4, 0x28, 0xc4, 0x51, 0xf4, 0x89, 0x08, 0x4a, 0x7f, 0x8f,
-	0x40, 0xbe, 0xbd, 0x5b, 0xe9, 0x6c, 0xea, 0xc6, 0x4e, 0x8f, 0xa8, 0xdd, 0x2d, 0xa2, 0x4f, 0x0c,
-	0x1f, 0x70, 0xaa, 0xf0, 0xa9, 0xc1, 0x84, 0x45, 0xec, 0x7e, 0x8f, 0x32, 0x07, 0xe6, 0x17, 0x5f,
-	0x3f, 0x7e, 0xec, 0xf0, 0xd6, 0x65, 0xcc, 0xe0, 0x2c, 0xb2, 0x3e, 0x74, 0x92, 0x0b, 0x7b, 0x04,
-	0xa5, 0x3f, 0x00, 0x98, 0x70, 0x85, 0x28, 0x03, 0x93, 0xad, 0x9b, 0xd5, 0xaa, 0xd4, 0x6a, 0xf1,
-	0x1c, 0x7a, 0x01, 0xe6, 0x6e, 0xd6, 0x57, 0xeb, 0x8d, 0xb7, 0xeb, 0xb2, 0x84, 0x71, 0x03, 0xf3,
-	0x00, 0x65, 0x61, 0xaa, 0xdd, 0x68, 0xc8, 0x6b, 0x95, 0xb6, 0xc4, 0x47, 0x50, 0x0e, 0xa6, 0x9d,
-	0x2f, 0xa9, 0x82, 0xd7, 0x6e, 0xf3, 0x51, 0x34, 0x0d, 0xf9, 0x6a, 0x63, 0x6d, 0xad, 0xd6, 0xaa,
-	0x35, 0xea, 0x72, 0xb3, 0x52, 0x5d, 0x95, 0xda, 0x7c, 0x2c, 0xbc, 0x2a, 0x4a, 0x95, 0x6a, 0xa3,
-	0xce, 0xc7, 0x9d, 0x8d, 0xda, 0xb7, 0xe4, 0x65, 0x2c, 0xdd, 0xe0, 0x13, 0x8c, 0xf5, 0x96, 0xdc,
-	0x6c, 0xbc, 0x2d, 0x61, 0x3e, 0x89, 0x78, 0x98, 0xbd, 0xda, 0x6c, 0xc9, 0x37, 0xeb, 0x6b, 0x8d,
-	0xea, 0xaa, 0xb4, 0xc4, 0xa7, 0x4a, 0x1f, 0x02, 0x38, 0x7d, 0x55, 0xa1, 0x64, 0x47, 0xd9, 0x0b,
-	0x97, 0x2a, 0x09, 0x26, 0xbd, 0xa6, 0xc1, 0x62, 0x3c, 0xb3, 0xf8, 0xea, 0xf8, 0x2d, 0x84, 0xf0,
-	0xa3, 0xc2, 0xf2, 0x68, 0xbf, 0x08, 0xb0, 0xaf, 0x8b, 0xce, 0xc2, 0xe4, 0xba, 0xa2, 0xab, 0xb2,
-	0xe6, 0x66, 0x43, 0x5a, 0x84, 0x83, 0xfd, 0x62, 0x42, 0x54, 0x74, 0xb5, 0xb6, 0x84, 0x13, 0x8e,
-	0xa8, 0xa6, 0x96, 0x1e, 0xc4, 0xe0, 0x0b, 0x15, 0xd3, 0xec, 0x69, 0x1d, 0xe6, 0x03, 0x97, 0x18,
-	0xfd, 0x18, 0xe6, 0x6d, 0x62, 0xdb, 0x8e, 0x2f, 0x37, 0xc9, 0x9e, 0xc3, 0xc0, 0x92, 0x4d, 0x2c,
-	0x1c, 0x89, 0xf1, 0x0f, 0xa2, 0x85, 0xbb, 0x2c, 0xee, 0x5b, 0x2e, 0x62, 0x95, 0xec, 0xd5, 0x96,
-	0x70, 0xd6, 0x1e, 0x7d, 0xa9, 0xe8, 0x1c, 0x4c, 0x6c, 0xc8, 0xa6, 0x61, 0xb9, 0x6e, 0xcc, 0x89,
-	0xb9, 0x23, 0x11, 0x5e, 0x48, 0x15, 0xbe, 0x02, 0x73, 0xe0, 0xf2, 0x63, 0x80, 0xe3, 0x1b, 0x4d,
-	0xc3, 0xa2, 0xe8, 0x45, 0x18, 0xdf, 0x90, 0x3b, 0x3a, 0x65, 0x29, 0x97, 0xc3, 0xb1, 0x8d, 0xaa,
-	0x4e, 0xd1, 0x02, 0xcc, 0x6c, 0x58, 0x5b, 0xc3, 0x24, 0x8f, 0xb1, 0x7d, 0xf3, 0x83, 0xfd, 0x22,
-	0x5c, 0xc6, 0xd7, 0xbd, 0x44, 0xc7, 0x70, 0xc3, 0xda, 0xf2, 0x93, 0xfe, 0x4d, 0x38, 0xa5, 0x92,
-	0x8e, 0xa1, 0x12, 0x75, 0xa8, 0x14, 0xf7, 0x92, 0x7f, 0xbc, 0x0a, 0xb6, 0x58, 0xa3, 0xc3, 0x79,
-	0x0f, 0xef, 0x33, 0x48, 0xe1, 0x02, 0x9c, 0x78, 0x56, 0x01, 0x66, 0xc1, 0xf6, 0x31, 0x88, 0xa4,
-	0x40, 0xa8, 0x14, 0x07, 0xbb, 0x41, 0xf2, 0xd4, 0xdd, 0x60, 0xac, 0xa0, 0xa7, 0x4e, 0x59, 0xd0,
-	0xbf, 0x0f, 0xd3, 0x8a, 0x69, 0xca, 0xb6, 0xe3, 0x3f, 0x56, 0x7c, 0x33, 0x8b, 0xff, 0x3f, 0x6e,
-	0xcd, 0x2a, 0xd9, 0x93, 0xf4, 0x6d, 0xd2, 0x33, 0x4c, 0x82, 0x93, 0x8a, 0x69, 0xb6, 0x56, 0xc9,
-	0x1e, 0x9a, 0x83, 0x2f, 0xf4, 0x14, 0x9b, 0xca, 0x8a, 0xcc, 0x7c, 0x23, 0xab, 0xc6, 0x8e, 0x5e,
-	0x80, 0xcc, 0x41, 0x39, 0x47, 0x50, 0x59, 0xae, 0xea, 0xd4, 0xa9, 0xcc, 0xa5, 0xbf, 0x46, 0xe0,
-	0x8b, 0x81, 0xd0, 0x59, 0x33, 0xdc, 0xbf, 0xa8, 0x00, 0x93, 0x36, 0xb1, 0x9c, 0x12, 0xc8, 0xa2,
-	0x26, 0x8d, 0xfd, 0x4f, 0xb4, 0x0c, 0x53, 0x3d, 0x0f, 0xe5, 0x15, 0xe8, 0xc2, 0xb8, 0x4d, 0x3e,
-	0x8b, 0xc8, 0x07, 0xef, 0x87, 0x05, 0xf6, 0x50, 0x17, 0xfd, 0x12, 0x40, 0xa8, 0x50, 0x6a, 0x69,
-	0xeb, 0x7d, 0x4a, 0x9c, 0x8a, 0xed, 0x38, 0xec, 0xd2, 0x38, 0xd5, 0x04, 0xdb, 0xca, 0x95, 0xa1,
-	0x96, 0xa4, 0x53, 0x6b, 0x4f, 0xbc, 0x78, 0x24, 0x9e, 0xff, 0x1d, 0xf8, 0x66, 0xe9, 0x9c, 0x55,
-	0x2a, 0x9c, 0x5b, 0x14, 0x7e, 0xf6, 0x8e, 0x32, 0xff, 0xc1, 0xb7, 0xe7, 0x7f, 0xf0, 0xee, 0xdc,
-	0x1b, 0x57, 0xde, 0x99, 0x7f, 0xf7, 0x0d, 0xff, 0xf3, 0xfc, 0xcf, 0x17, 0x2f, 0xfe, 0xe2, 0x1c,
-	0x0e, 0x6c, 0x3a, 0xf3, 0x23, 0x38, 0x35, 0x46, 0x86, 0x78, 0x18, 0x75, 0x6e, 0xdb, 0x3d, 0xb4,
-	0xf3, 0x13, 0x4d, 0xc3, 0xf8, 0xb6, 0xd2, 0xeb, 0x13, 0x37, 0x01, 0xb1, 0xfb, 0x71, 0x25, 0x72,
-	0x19, 0x94, 0xfe, 0x11, 0x81, 0x2f, 0x05, 0x0c, 0xbc, 0x66, 0x68, 0x7a, 0xa5, 0xd3, 0x21, 0x26,
-	0xfd, 0xda, 0xb9, 0x17, 0xf2, 0x7c, 0xe4, 0x39, 0x3c, 0x7f, 0x0b, 0xbe, 0xa4, 0xe9, 0xfe, 0x68,
-	0xa9, 0x32, 0xc7, 0x3b, 0xc5, 0xc0, 0xbf, 0xdf, 0xb3, 0x4f, 0xb9, 0x5f, 0xbf, 0x53, 0xe3, 0xe9,
-	0x00, 0x83, 0xbf, 0x68, 0xa3, 0xd7, 0xe1, 0x94, 0x49, 0x74, 0x55, 0xd3, 0xbb, 0xb2, 0x67, 0x2a,
-	0xcb, 0xeb, 0x14, 0xce, 0x7b, 0xcb, 0xde, 0x71, 0xfe, 0x43, 0xc1, 0x5f, 0xfa, 0x53, 0x3c, 0x14,
-	0x99, 0xbe, 0x21, 0xff, 0x63, 0x65, 0xed, 0x0c, 0x4c, 0x77, 0x0c, 0x7d, 0x43, 0xb3, 0xb6, 0x88,
-	0xca, 0x06, 0xc3, 0x14, 0x1e, 0x2d, 0xa0, 0xab, 0x30, 0xdd, 0xe9, 0x29, 0xb6, 0x2d, 0xaf, 0xcb,
-	0x1d, 0xaf, 0x5c, 0x7d, 0xeb, 0x04, 0x1e, 0x2e, 0x57, 0x1d, 0x25, 0xb1, 0x8a, 0x93, 0x1d, 0xf7,
-	0x07, 0x5a, 0x81, 0x29, 0xd3, 0xd2, 0x0c, 0x4b, 0xa3, 0x7b, 0xcc, 0x61, 0xf9, 0xc5, 0xd2, 0x84,
-	0xb2, 0xe7, 0xcd, 0x27, 0x4d, 0x0f, 0x19, 0xe8, 0xd7, 0x43, 0xed, 0x49, 0x53, 0x44, 0xfa, 0x34,
-	0x53, 0xc4, 0xcc, 0xef, 0x01, 0x4c, 0x7a, 0x76, 0xa2, 0x55, 0x98, 0xea, 0xba, 0x4d, 0xd6, 0x1d,
-	0x69, 0x33, 0x8b, 0xe7, 0xc7, 0xcd, 0xf3, 0x9a, 0x70, 0x45, 0xa7, 0x44, 0xd7, 0x95, 0xe0, 0x7c,
-	0x17, 0x73, 0x8b, 0xb3, 0x4f, 0x80, 0x24, 0x98, 0x53, 0xd6, 0x6d, 0xa3, 0xd7, 0xa7, 0x44, 0x76,
-	0xde, 0x45, 0x27, 0x88, 0xd0, 0x18, 0x8b, 0xce, 0xac, 0xaf, 0xe6, 0x08, 0xdc, 0xd1, 0xaa, 0x74,
-	0x1b, 0x4e, 0x4f, 0xb8, 0x60, 0x1b, 0x55, 0x60, 0x7a, 0x94, 0x7b, 0xe0, 0xe4, 0xb9, 0x37, 0xd2,
-	0x2a, 0xdd, 0x07, 0xf0, 0x95, 0x09, 0x90, 0x65, 0x45, 0x73, 0x46, 0xc4, 0x1b, 0x30, 0xe5, 0x43,
-	0xbd, 0x01, 0xe3, 0x24, 0xfc, 0x93, 0x2a, 0xb2, 0x4f, 0x83, 0xde, 0x84, 0x71, 0xf6, 0x08, 0xf4,
-	0x0a, 0xce, 0x99, 0x63, 0xd3, 0xb3, 0x23, 0x5c, 0x22, 0x54, 0xd1, 0x7a, 0xe3, 0xad, 0xcf, 0x55,
-	0x2c, 0xfd, 0x1a, 0xc0, 0x62, 0x60, 0xd7, 0xda, 0xa4, 0x3a, 0xb2, 0x7a, 0xba, 0x9b, 0x09, 0xf4,
-	0xeb, 0x91, 0x3e, 0x7a, 0x0d, 0x4e, 0xb1, 0x46, 0x17, 0x68, 0x73, 0x2c, 0xab, 0x71, 0xd6, 0x59,
-	0x1e, 0x76, 0xb9, 0xc3, 0x24, 0xcc, 0x85, 0x06, 0xa4, 0x09, 0x4f, 0x06, 0xf0, 0x3c, 0x4f, 0x86,
-	0x63, 0xb7, 0x18, 0x7e, 0x32, 0x4c, 0x48, 0x82, 0xc8, 0xa9, 0x46, 0xe9, 0x4a, 0xb8, 0x96, 0x66,
-	0x4f, 0x18, 0xa9, 0xc1, 0x21, 0xe2, 0x1a, 0xcc, 0xf7, 0xd9, 0x40, 0x28, 0xfb, 0xf3, 0xa8, 0xfb,
-	0x38, 0xfa, 0xc6, 0x53, 0x2e, 0xdd, 0x9d, 0x20, 0x57, 0x38, 0x9c, 0xeb, 0x87, 0x86, 0xda, 0x15,
-	0x98, 0x79, 0xcf, 0xd0, 0x74, 0x59, 0x61, 0x5d, 0xce, 0x7b, 0x0e, 0xbd, 0xf6, 0x14, 0xa2, 0x51,
-	0x4b, 0x5c, 0xe1, 0x30, 0x7c, 0x6f, 0xd4, 0x20, 0x57, 0x60, 0xd6, 0xf7, 0xa2, 0xac, 0x74, 0x36,
-	0xbd, 0xb2, 0x78, 0x92, 0x40, 0x58, 0xe1, 0x70, 0xc6, 0x57, 0xad, 0x74, 0x36, 0xd1, 0x35, 0x98,
-	0x1b, 0x32, 0xe9, 0x0e, 0x55, 0xe2, 0x79, 0xa8, 0x86, 0x56, 0xd4, 0x95, 0x31, 0x2e, 0x9b, 0xe8,
-	0xd4, 0xab, 0xa9, 0xcf, 0xcb, 0xd5, 0x72, 0x9e, 0x53, 0x6d, 0x38, 0x35, 0xe4, 0xda, 0x60, 0x39,
-	0xeb, 0x15, 0x9a, 0xf3, 0x27, 0x60, 0x73, 0x93, 0x7c, 0x85, 0xc3, 0x79, 0x35, 0x9c, 0xf6, 0xf5,
-	0x00, 0xeb, 0xfb, 0x7d, 0xd2, 0x27, 0xaa, 0x37, 0x18, 0x9e, 0xd0, 0xc6, 0x21, 0xdf, 0x0d, 0xa6,
-	0x8c, 0x0c, 0x38, 0x13, 0xe6, 0x93, 0x03, 0xcd, 0x9f, 0x8d, 0x8c, 0x99, 0xc5, 0x85, 0xa7, 0x50,
-	0x4f, 0x4a, 0xf1, 0x15, 0x0e, 0x17, 0x42, 0xdb, 0x04, 0x40, 0xce, 0x01, 0xfc, 0x11, 0x50, 0xb6,
-	0x8d, 0xde, 0x36, 0x51, 0x0b, 0x99, 0x67, 0x1e, 0xc0, 0x1f, 0xfd, 0x9c, 0x03, 0xf8, 0xda, 0x2d,
-	0xa6, 0x2c, 0xa6, 0x61, 0xa4, 0x6f, 0xba, 0xaf, 0xda, 0x3f, 0x47, 0x60, 0xc1, 0x8b, 0x54, 0xaf,
-	0x7d, 0x2e, 0x1b, 0xd6, 0x96, 0x42, 0x29, 0xb1, 0x6c, 0x74, 0x1d, 0x66, 0xfb, 0xa6, 0xbc, 0xe1,
-	0x2f, 0xb0, 0x74, 0xcf, 0x2f, 0xce, 0x8e, 0x6f, 0x3a, 0xae, 0x18, 0xe8, 0x71, 0x99, 0xbe, 0x39,
-	0x5c, 0x46, 0xdf, 0x85, 0x2f, 0x07, 0xe9, 0x64, 0x53, 0xb1, 0x94, 0x2d, 0xe2, 0x10, 0xbb, 0x53,
-	0xe2, 0x74, 0x00, 0xdc, 0xf4, 0x65, 0xe8, 0x06, 0x64, 0xf7, 0x1f, 0x30, 0x23, 0xfa, 0xdc, 0x66,
-	0xb0, 0x08, 0x1d, 0x19, 0x72, 0x19, 0x16, 0xc2, 0x94, 0x01, 0x53, 0x62, 0xcc, 0x94, 0x97, 0x43,
-	0x0a, 0x43, 0x63, 0x4a, 0x7f, 0x01, 0x70, 0x7a, 0x29, 0xe8, 0x26, 0xef, 0x9f, 0x18, 0xa8, 0xfd,
-	0xb5, 0x6a, 0x63, 0xea, 0xdf, 0xd4, 0xc4, 0x50, 0x47, 0x8c, 0x9c, 0xa6, 0x23, 0x5e, 0xb8, 0x07,
-	0x20, 0x3f, 0x7e, 0x33, 0x08, 0xc1, 0xfc, 0x72, 0x03, 0x5f, 0xaf, 0xb4, 0xdb, 0x12, 0x96, 0xeb,
-	0x8d, 0xba, 0xc4, 0x73, 0xa8, 0x00, 0xa7, 0x47, 0x6b, 0x58, 0x6a, 0x36, 0x5a, 0xb5, 0x76, 0x03,
-	0xdf, 0xe6, 0x01, 0x9a, 0x81, 0x2f, 0x8f, 0x24, 0x57, 0x71, 0xb3, 0x2a, 0xb7, 0x24, 0xfc, 0x56,
-	0xad, 0x2a, 0xf1, 0x91, 0xb0, 0xd6, 0xb5, 0xca, 0x5b, 0x95, 0x56, 0x15, 0xd7, 0x9a, 0x6d, 0x3e,
-	0x1a, 0x96, 0x54, 0x2b, 0xb7, 0xa5, 0x7a, 0x5d, 0x5a, 0x6b, 0x36, 0xf9, 0x98, 0xf8, 0x47, 0xf0,
-	0xf0, 0x40, 0x00, 0x8f, 0x0e, 0x04, 0xf0, 0xf9, 0x81, 0xc0, 0x3d, 0x3e, 0x10, 0xb8, 0x2f, 0x0e,
-	0x04, 0xee, 0xcb, 0x03, 0x81, 0x7b, 0x72, 0x20, 0x80, 0xbb, 0x03, 0x01, 0x7c, 0x34, 0x10, 0xb8,
-	0x4f, 0x07, 0x02, 0xb8, 0x3f, 0x10, 0xb8, 0x07, 0x03, 0x81, 0xfb, 0x6c, 0x20, 0x70, 0x0f, 0x07,
-	0x02, 0x78, 0x34, 0x10, 0xc0, 0xe7, 0x03, 0x81, 0x7b, 0x3c, 0x10, 0xc0, 0x17, 0x03, 0x81, 0xfb,
-	0x72, 0x20, 0x80, 0x27, 0x03, 0x81, 0xbb, 0x7b, 0x28, 0x70, 0x1f, 0x1d, 0x0a, 0xe0, 0xde, 0xa1,
-	0xc0, 0xfd, 0xf6, 0x50, 0x00, 0x9f, 0x1c, 0x0a, 0xdc, 0xa7, 0x87, 0x02, 0x77, 0xff, 0x50, 0x00,
-	0x0f, 0x0e, 0x05, 0xf0, 0xd9, 0xa1, 0x00, 0x7e, 0x72, 0xb1, 0x6b, 0x94, 0xe9, 0x1d, 0x42, 0xef,
-	0x38, 0xcf, 0xcd, 0xb2, 0x4e, 0xe8, 0x8e, 0x61, 0x6d, 0x2e, 0x84, 0xff, 0xa3, 0x6a, 0x6e, 0x76,
-	0x17, 0x28, 0xd5, 0xcd, 0xf5, 0xf5, 0x04, 0x6b, 0x14, 0x97, 0xfe, 0x15, 0x00, 0x00, 0xff, 0xff,
-	0x0d, 0xbd, 0x72, 0x40, 0xd9, 0x16, 0x00, 0x00,
+	// 2339 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x58, 0x4d, 0x6c, 0x1b, 0xc7,
+	0x15, 0xde, 0xe1, 0x3f, 0x87, 0x3f, 0xda, 0x4c, 0x14, 0x67, 0xa3, 0x26, 0x4b, 0x95, 0x71, 0x1a,
+	0x39, 0x8d, 0xa8, 0x56, 0x49, 0x51, 0xd7, 0xfd, 0x49, 0xb8, 0x14, 0x65, 0x51, 0x3f, 0x24, 0x3d,
+	0xa4, 0x63, 0xbb, 0x69, 0xba, 0x58, 0x71, 0x47, 0xd4, 0x46, 0xd4, 0xee, 0x66, 0x77, 0xa8, 0x9f,
+	0x14, 0x05, 0xdc, 0x9c, 0x82, 0x9e, 0x82, 0x14, 0x2d, 0x8a, 0x02, 0x2d, 0x82, 0xa2, 0x87, 0x1c,
+	0x0a, 0xd4, 0x40, 0x81, 0xc2, 0xe8, 0xa1, 0xc8, 0xd1, 0x47, 0x1f, 0x83, 0x00, 0x55, 0x2d, 0xea,
+	0x92, 0x63, 0xd0, 0x93, 0xa1, 0x4b, 0x8a, 0xfd, 0x23, 0x77, 0x49, 0x46, 0x96, 0xe4, 0xf6, 0xd4,
+	0x13, 0x77, 0xe6, 0xbd, 0xf7, 0xcd, 0x9b, 0x79, 0x6f, 0xbe, 0xf7, 0x86, 0x70, 0xba, 0xa3, 0x19,
+	0xd2, 0xae, 0xa4, 0xce, 0x9a, 0x54, 0x6a, 0x6d, 0xcd, 0x49, 0xba, 0x32, 0xb7, 0x4d, 0x4c, 0x53,
+	0x6a, 0x13, 0xb3, 0xa0, 0x1b, 0x1a, 0xd5, 0x50, 0x96, 0x52, 0xb5, 0xe0, 0x6a, 0x15, 0x76, 0x5e,
+	0x99, 0x2a, 0xb6, 0x15, 0xba, 0xd9, 0x5d, 0x2f, 0xb4, 0xb4, 0xed, 0x39, 0xa2, 0xee, 0x68, 0xfb,
+	0xba, 0xa1, 0xed, 0xed, 0xcf, 0xd9, 0xca, 0xad, 0xd9, 0x36, 0x51, 0x67, 0x77, 0xa4, 0x8e, 0x22,
+	0x4b, 0x94, 0xcc, 0x8d, 0x7c, 0x38, 0x90, 0x53, 0xb3, 0x3e, 0x88, 0xb6, 0xd6, 0xd6, 0x1c, 0xe3,
+	0xf5, 0xee, 0x86, 0x3d, 0xb2, 0x07, 0xf6, 0x97, 0xab, 0xfe, 0x6c, 0x5b, 0xd3, 0xda, 0x1d, 0x32,
+	0xd0, 0x32, 0xa9, 0xd1, 0x6d, 0x51, 0x57, 0x9a, 0x1b, 0x96, 0x52, 0x65, 0x9b, 0x98, 0x54, 0xda,
+	0xd6, 0x5d, 0x85, 0xe7, 0x46, 0xb7, 0x48, 0x0c, 0x43, 0x33, 0x5c, 0xf1, 0xf3, 0xa3, 0x62, 0x45,
+	0x26, 0x2a, 0x55, 0x36, 0x14, 0x62, 0x98, 0x9e, 0x0b, 0xa3, 0x4a, 0x5b, 0x64, 0xdf, 0x93, 0xe6,
+	0x46, 0xa5, 0xde, 0x81, 0x39, 0x0a, 0x63, 0x4f, 0x99, 0x4a, 0xb2, 0x44, 0x25, 0x47, 0x23, 0xff,
+	0x8f, 0x30, 0xcc, 0x5c, 0xd7, 0x3b, 0x8a, 0xba, 0xb5, 0xe6, 0x1c, 0x3f, 0xca, 0xc1, 0x94, 0x21,
+	0xed, 0x8a, 0xba, 0xb4, 0xdf, 0xd1, 0x24, 0x99, 0x03, 0xd3, 0x60, 0x26, 0x8d, 0xa1, 0x21, 0xed,
+	0xd6, 0x9d, 0x19, 0xf4, 0x6d, 0x18, 0xf7, 0x84, 0xa1, 0x69, 0x30, 0x93, 0x9a, 0x7f, 0xba, 0x10,
+	0x0c, 0x55, 0xc1, 0x85, 0xc2, 0x9e, 0x1e, 0x5a, 0x80, 0x09, 0x93, 0x50, 0xaa, 0xa8, 0x6d, 0x93,
+	0x8b, 0xd8, 0x36, 0x53, 0xc3, 0x36, 0xcd, 0xbd, 0x86, 0xab, 0x21, 0xa4, 0x8f, 0x85, 0xe8, 0x2f,
+	0x41, 0x88, 0x05, 0xf7, 0x0e, 0x72, 0x0c, 0xee, 0x5b, 0xa2, 0xef, 0xc3, 0x94, 0xb1, 0x27, 0x7a,
+	0x1b, 0xe0, 0xa2, 0xd3, 0xe1, 0x71, 0x40, 0x78, 0x6f, 0xcd, 0xd5, 0xc0, 0xd0, 0xe8, 0x7f, 0xa3,
+	0x32, 0x4c, 0x19, 0xa4, 0x45, 0x94, 0x1d, 0x22, 0x8b, 0x12, 0xe5, 0x62, 0xae, 0x17, 0x4e, 0x10,
+	0x0b, 0x5e, 0x10, 0x0b, 0x4d, 0x2f, 0x88, 0x42, 0xc2, 0x5a, 0xfd, 0x83, 0x7f, 0xe5, 0x00, 0x86,
+	0x9e, 0x61, 0x91, 0xa2, 0xab, 0x70, 0xa2, 0xa5, 0x19, 0x06, 0xe9, 0x48, 0x54, 0xd1, 0x54, 0x51,
+	0x91, 0x4d, 0x2e, 0x3e, 0x1d, 0x9e, 0x49, 0x0a, 0xfc, 0xb1, 0x90, 0xfc, 0x10, 0xc4, 0xf2, 0x11,
+	0x23, 0xc4, 0xc9, 0xbd, 0x83, 0x5c, 0xb6, 0x34, 0x50, 0xab, 0x2c, 0x98, 0x38, 0xeb, 0x33, 0xab,
+	0xc8, 0x26, 0xba, 0x02, 0x27, 0x65, 0xb2, 0xa3, 0xb4, 0x88, 0xd8, 0xda, 0x94, 0x54, 0x95, 0x74,
+	0x44, 0x45, 0x95, 0xc9, 0x1e, 0x97, 0x9c, 0x06, 0x33, 0x19, 0x21, 0x71, 0x2c, 0x44, 0x5f, 0x0a,
+	0x73, 0x5f, 0x02, 0x8c, 0x1c, 0xad, 0x92, 0xa3, 0x54, 0xb1, 0x74, 0xae, 0x44, 0xee, 0x7e, 0x94,
+	0x63, 0x96, 0x23, 0x89, 0x04, 0x9b, 0xcc, 0xff, 0x26, 0x0c, 0x27, 0x16, 0xb4, 0x5d, 0xf5, 0x7f,
+	0x1d, 0xc2, 0x9f, 0xc0, 0x2c, 0x51, 0x65, 0xd1, 0xf5, 0xd9, 0xda, 0x77, 0xd8, 0xb6, 0xbc, 0x38,
+	0x6c, 0x59, 0x56, 0xe5, 0x05, 0x5b, 0xa9, 0x32, 0xc8, 0x66, 0x81, 0xed, 0x1d, 0xe4, 0xd2, 0x03,
+	0xc9, 0x82, 0x89, 0xd3, 0x64, 0xa0, 0x67, 0xa2, 0xef, 0xc0, 0xb8, 0x41, 0xde, 0xe9, 0x12, 0x93,
+	0xba, 0xf9, 0xf1, 0xcc, 0x68, 0x7e, 0x60, 0x47, 0x61, 0x89, 0xc1, 0x9e, 0x2e, 0xba, 0x02, 0x93,
+	0x66, 0x6b, 0x93, 0xc8, 0xdd, 0x0e, 0x91, 0xb9, 0xe8, 0xa3, 0x12, 0x6b, 0x89, 0xc1, 0x03, 0xf5,
+	0x71, 0x91, 0x8c, 0x9d, 0x27, 0x92, 0x4e, 0x34, 0x84, 0x89, 0x41, 0x8a, 0xa3, 0xf0, 0x43, 0x01,
+	0xe4, 0x7f, 0x15, 0x86, 0x6c, 0x73, 0xaf, 0xd8, 0xda, 0x52, 0xb5, 0xdd, 0x0e, 0x91, 0xdb, 0xdb,
+	0x44, 0x1d, 0x9b, 0x3e, 0xe0, 0x5c, 0xe9, 0x53, 0x81, 0x31, 0x83, 0x98, 0xdd, 0x0e, 0xb5, 0x03,
+	0x98, 0x9d, 0x7f, 0x71, 0x74, 0xdb, 0xc1, 0xa5, 0x0b, 0xd8, 0x56, 0xb7, 0x33, 0xeb, 0x3d, 0xeb,
+	0x72, 0x61, 0x17, 0x00, 0x2d, 0x43, 0x56, 0x76, 0x13, 0x48, 0x74, 0x39, 0xd8, 0x8d, 0x6d, 0x6e,
+	0x18, 0x74, 0x28, 0xd1, 0xf0, 0x84, 0x1c, 0x9c, 0xc8, 0xff, 0x01, 0xc0, 0x98, 0xb3, 0x10, 0x4a,
+	0xc1, 0x78, 0xe3, 0x7a, 0xa9, 0x54, 0x6e, 0x34, 0x58, 0x06, 0x3d, 0x01, 0x33, 0xd7, 0xab, 0x2b,
+	0xd5, 0xda, 0x8d, 0xaa, 0x58, 0xc6, 0xb8, 0x86, 0x59, 0x80, 0xd2, 0x30, 0xd1, 0xac, 0xd5, 0xc4,
+	0xd5, 0x62, 0xb3, 0xcc, 0x86, 0x50, 0x06, 0x26, 0xad, 0x51, 0xb9, 0x88, 0x57, 0x6f, 0xb1, 0x61,
+	0x34, 0x09, 0xd9, 0x52, 0x6d, 0x75, 0xb5, 0xd2, 0xa8, 0xd4, 0xaa, 0x62, 0xbd, 0x58, 0x5a, 0x29,
+	0x37, 0xd9, 0x48, 0x70, 0x56, 0x28, 0x17, 0x4b, 0xb5, 0x2a, 0x1b, 0xb5, 0x16, 0x6a, 0xde, 0x14,
+	0x17, 0x71, 0xf9, 0x1a, 0x1b, 0xb3, 0x51, 0x6f, 0x8a, 0xf5, 0xda, 0x8d, 0x32, 0x66, 0xe3, 0x88,
+	0x85, 0xe9, 0xab, 0xf5, 0x86, 0x78, 0xbd, 0xba, 0x5a, 0x2b, 0xad, 0x94, 0x17, 0xd8, 0x44, 0xfe,
+	0xaf, 0x00, 0x3e, 0x7d, 0x55, 0xa2, 0x64, 0x57, 0xda, 0x1f, 0x09, 0xce, 0x75, 0x98, 0x6a, 0x3b,
+	0x22, 0x37, 0x30, 0xd6, 0x19, 0xe4, 0x87, 0xcf, 0xc0, 0xb5, 0x0e, 0x64, 0xb7, 0x9f, 0xb0, 0xee,
+	0x1f, 0x58, 0x94, 0xd1, 0xf6, 0xb4, 0x4c, 0x54, 0x84, 0x31, 0xba, 0x27, 0x4a, 0xad, 0x2d, 0xf7,
+	0xae, 0x4d, 0x3f, 0x2a, 0x54, 0x42, 0xc2, 0xc3, 0xc3, 0x51, 0x6a, 0xc9, 0xf2, 0xef, 0x01, 0x38,
+	0xe9, 0xae, 0x1b, 0x24, 0xeb, 0x32, 0x8c, 0x7b, 0x21, 0x73, 0xdc, 0x7d, 0x6e, 0x18, 0x3c, 0xa0,
+	0x3f, 0xa0, 0x56, 0xdb, 0x4b, 0xcf, 0x16, 0x3d, 0x0f, 0xe3, 0xeb, 0x92, 0x2a, 0x8b, 0x8a, 0xc3,
+	0x07, 0x49, 0x01, 0xf6, 0x0e, 0x72, 0x31, 0x41, 0x52, 0xe5, 0xca, 0x02, 0x8e, 0x59, 0xa2, 0x8a,
+	0x9c, 0xff, 0x77, 0x04, 0x3e, 0x51, 0xd4, 0xf5, 0x8e, 0xd2, 0xb2, 0xb3, 0xd0, 0x01, 0x46, 0x3f,
+	0x82, 0x59, 0x93, 0x98, 0xa6, 0x95, 0xcd, 0x5b, 0xc4, 0x3a, 0x38, 0x87, 0x6e, 0x04, 0xee, 0x58,
+	0x88, 0xbe, 0x1b, 0xe6, 0x6e, 0xdb, 0x37, 0xbf, 0xe1, 0x68, 0xac, 0x90, 0xfd, 0xca, 0x02, 0x4e,
+	0x9b, 0x83, 0x91, 0x8c, 0x2e, 0xc2, 0xd8, 0x86, 0xa8, 0x6b, 0x86, 0x93, 0xc8, 0x19, 0x21, 0x73,
+	0x2c, 0xc0, 0x97, 0x12, 0xdc, 0x97, 0x60, 0x06, 0x5c, 0x7e, 0x00, 0x70, 0x74, 0xa3, 0xae, 0x19,
+	0x14, 0x3d, 0x09, 0xa3, 0x1b, 0x62, 0x4b, 0xa5, 0x76, 0x62, 0x66, 0x70, 0x64, 0xa3, 0xa4, 0x52,
+	0x34, 0x07, 0x53, 0x1b, 0xc6, 0x76, 0x9f, 0xe6, 0x22, 0xf6, 0xba, 0xd9, 0xde, 0x41, 0x0e, 0x2e,
+	0xe2, 0x35, 0x97, 0xea, 0x30, 0xdc, 0x30, 0xb6, 0xdd, 0x6f, 0xf4, 0x3a, 0x9c, 0x90, 0x49, 0x4b,
+	0x93, 0x89, 0xdc, 0x37, 0x8a, 0xba, 0xf4, 0x37, 0x5c, 0x07, 0x1a, 0x76, 0xa9, 0xc7, 0x59, 0x57,
+	0xdf, 0x43, 0x28, 0x07, 0x4b, 0x50, 0xec, 0x51, 0x25, 0xc8, 0x0e, 0xe5, 0x87, 0x20, 0x94, 0x00,
+	0x81, 0x62, 0xe4, 0xaf, 0x87, 0xf1, 0x73, 0xd7, 0xc3, 0xa1, 0x92, 0x96, 0x38, 0x67, 0x49, 0xfb,
+	0x2e, 0x4c, 0x4a, 0xba, 0x2e, 0x9a, 0x56, 0xfc, 0xec, 0xf2, 0x93, 0x9a, 0xff, 0xda, 0xb0, 0x37,
+	0x2b, 0x64, 0xbf, 0xac, 0xee, 0x90, 0x8e, 0xa6, 0x13, 0x1c, 0x97, 0x74, 0xbd, 0xb1, 0x42, 0xf6,
+	0xd1, 0x0c, 0x7c, 0xa2, 0x23, 0x99, 0x54, 0x94, 0x44, 0x3b, 0x36, 0xa2, 0x45, 0x06, 0x1c, 0xb4,
+	0x03, 0x94, 0xb1, 0x04, 0xc5, 0xc5, 0x92, 0x4a, 0x2d, 0xca, 0x40, 0x97, 0x21, 0x37, 0x74, 0xf0,
+	0xe2, 0xae, 0x64, 0xa8, 0xf6, 0xfe, 0x53, 0x16, 0xff, 0xe1, 0x0b, 0xc1, 0x83, 0xbe, 0xe1, 0x4a,
+	0xf3, 0x7f, 0x0f, 0xc1, 0x27, 0x7d, 0x49, 0xb7, 0xaa, 0x39, 0xbf, 0x88, 0x83, 0x71, 0x93, 0x18,
+	0x56, 0xf9, 0xb0, 0xf3, 0x2d, 0x89, 0xbd, 0x21, 0x5a, 0x84, 0x89, 0x8e, 0xab, 0xe5, 0x5e, 0x38,
+	0x6e, 0x78, 0x37, 0x1e, 0xca, 0x98, 0x8b, 0xdb, 0xb7, 0x45, 0xbf, 0x00, 0x10, 0x4a, 0x94, 0x1a,
+	0xca, 0x7a, 0x97, 0x12, 0xab, 0xda, 0x59, 0xa1, 0x7e, 0x65, 0x18, 0x6a, 0x8c, 0x6f, 0x85, 0x62,
+	0xdf, 0xaa, 0xac, 0x52, 0x63, 0x5f, 0x78, 0xf9, 0x58, 0xb8, 0xf4, 0x3b, 0xf0, 0x8d, 0xfc, 0x45,
+	0x23, 0xcf, 0x5d, 0x9c, 0xe7, 0x7f, 0xfa, 0xa6, 0x34, 0xfb, 0xee, 0xb7, 0x66, 0xbf, 0xf7, 0xd6,
+	0xcc, 0x6b, 0x57, 0xde, 0x9c, 0x7d, 0xeb, 0x35, 0x6f, 0x78, 0xe9, 0x67, 0xf3, 0x2f, 0xff, 0xfc,
+	0x22, 0xf6, 0x2d, 0x3a, 0xf5, 0x43, 0x38, 0x31, 0x04, 0x86, 0x58, 0x18, 0xb6, 0xe2, 0xe4, 0x6c,
+	0xda, 0xfa, 0x44, 0x93, 0x30, 0xba, 0x23, 0x75, 0xba, 0xc4, 0xb9, 0xba, 0xd8, 0x19, 0x5c, 0x09,
+	0x5d, 0x06, 0xf9, 0xcf, 0x42, 0xf0, 0x29, 0x9f, 0x83, 0xcb, 0x9a, 0xa2, 0x16, 0x5b, 0x2d, 0xa2,
+	0xd3, 0xc7, 0xbe, 0xb5, 0x81, 0x9c, 0x09, 0x9d, 0x21, 0x67, 0x6e, 0xc2, 0xa7, 0x14, 0xd5, 0x6b,
+	0xcb, 0x65, 0xd1, 0xab, 0x1f, 0xde, 0xf9, 0x3e, 0x7f, 0xc2, 0xf9, 0x7a, 0xc5, 0x07, 0x4f, 0xfa,
+	0x10, 0xbc, 0x49, 0x13, 0xbd, 0x08, 0x27, 0x74, 0xa2, 0xca, 0x8a, 0xda, 0x16, 0x5d, 0x57, 0x6d,
+	0x46, 0x48, 0xe0, 0xac, 0x3b, 0xed, 0x6e, 0xe7, 0xbf, 0x74, 0x6d, 0xf2, 0xff, 0x8c, 0x06, 0x32,
+	0xd3, 0x73, 0xe4, 0xff, 0x8c, 0x10, 0x9f, 0x85, 0xc9, 0x96, 0xa6, 0x6e, 0x28, 0xc6, 0x36, 0x91,
+	0xed, 0xa6, 0x3a, 0x81, 0x07, 0x13, 0xe8, 0x2a, 0x4c, 0xb6, 0x3a, 0x92, 0x69, 0x8a, 0xeb, 0x62,
+	0xcb, 0x25, 0xba, 0x6f, 0x9e, 0x22, 0xc2, 0x85, 0x92, 0x65, 0x24, 0x94, 0x70, 0xbc, 0xe5, 0x7c,
+	0xa0, 0x25, 0x98, 0xd0, 0x0d, 0x45, 0x33, 0x14, 0xba, 0x6f, 0x07, 0x2c, 0x3b, 0x5a, 0x97, 0x9b,
+	0x7b, 0x0d, 0xb7, 0xb7, 0xab, 0xbb, 0x9a, 0xbe, 0x5e, 0xa7, 0x6f, 0x3d, 0xae, 0x03, 0x4b, 0x9e,
+	0xab, 0x03, 0x3b, 0x89, 0xd3, 0xe0, 0x49, 0x9c, 0x36, 0xf5, 0x7b, 0x00, 0xe3, 0xee, 0x0e, 0xd1,
+	0x0a, 0x4c, 0xb8, 0xad, 0x82, 0xf3, 0x90, 0x48, 0xcd, 0x5f, 0xfa, 0x8a, 0x86, 0xa3, 0xa8, 0x52,
+	0xa2, 0xaa, 0x92, 0xbf, 0xef, 0x88, 0x38, 0x05, 0xc1, 0x03, 0x40, 0x65, 0x98, 0x91, 0xd6, 0x4d,
+	0xad, 0xd3, 0xa5, 0x44, 0xb4, 0x5e, 0xa3, 0xa7, 0xc8, 0xed, 0x88, 0x9d, 0xd7, 0x69, 0xcf, 0xcc,
+	0x12, 0x38, 0x0d, 0x6d, 0xfe, 0x16, 0x9c, 0x1c, 0x13, 0x1a, 0xab, 0x9d, 0x49, 0x0e, 0x6e, 0x2d,
+	0x38, 0xfd, 0xad, 0x1d, 0x58, 0xe5, 0xef, 0x00, 0xf8, 0xcc, 0x18, 0x95, 0x45, 0x49, 0xb1, 0x1a,
+	0xf3, 0x6b, 0x30, 0xe1, 0xa9, 0xba, 0x4d, 0xcd, 0x69, 0xf0, 0xc7, 0x71, 0xb9, 0x07, 0x83, 0x5e,
+	0x87, 0x51, 0xfb, 0xe9, 0xed, 0x52, 0xd5, 0xb3, 0x23, 0x6f, 0x16, 0x4b, 0xb8, 0x40, 0xa8, 0xa4,
+	0x74, 0x86, 0xcb, 0xad, 0x63, 0x98, 0xff, 0x35, 0x80, 0x39, 0xdf, 0xaa, 0x95, 0x71, 0x0c, 0xb4,
+	0x72, 0xbe, 0x93, 0xf1, 0xf5, 0x08, 0x03, 0x7b, 0xf4, 0x02, 0x9c, 0xb0, 0x8b, 0xab, 0xaf, 0xb4,
+	0xda, 0x7c, 0x80, 0xd3, 0xd6, 0xb4, 0x57, 0x59, 0xf3, 0xc7, 0x00, 0x72, 0x3e, 0x4c, 0x97, 0x57,
+	0x1a, 0xbb, 0x0a, 0x6d, 0x6d, 0x3e, 0x36, 0x15, 0xdd, 0x80, 0x09, 0x99, 0xec, 0x88, 0x92, 0x2c,
+	0x3b, 0x27, 0x97, 0x16, 0x7e, 0x60, 0x9d, 0xc9, 0x67, 0x07, 0xb9, 0x57, 0xdb, 0x5a, 0x81, 0x6e,
+	0x12, 0xba, 0x69, 0x25, 0x74, 0x41, 0x25, 0x74, 0x57, 0x33, 0xb6, 0xe6, 0x82, 0xff, 0x36, 0xe8,
+	0x5b, 0xed, 0x39, 0xba, 0xaf, 0x13, 0xb3, 0xb0, 0x40, 0x76, 0x8a, 0xb2, 0x6c, 0xe0, 0xb8, 0xec,
+	0x7c, 0x58, 0x14, 0x6c, 0xda, 0x2e, 0x3a, 0x14, 0x1c, 0x3e, 0x0b, 0x05, 0x7b, 0x86, 0x45, 0x9a,
+	0xff, 0x5b, 0x02, 0x66, 0x02, 0x1d, 0xe9, 0x98, 0x57, 0x2a, 0x38, 0xcb, 0x2b, 0x75, 0x24, 0x85,
+	0x82, 0xaf, 0xd4, 0x31, 0xdc, 0x11, 0x3a, 0x17, 0x77, 0x14, 0x83, 0x25, 0x28, 0x7d, 0xca, 0x6b,
+	0xea, 0xef, 0xda, 0x96, 0x61, 0xb6, 0xab, 0x8f, 0x79, 0xb3, 0x7d, 0xfd, 0x84, 0x8c, 0x73, 0x5a,
+	0xf6, 0x25, 0x06, 0x67, 0xba, 0x81, 0x57, 0xc4, 0x12, 0x4c, 0xbd, 0xad, 0x29, 0xaa, 0x28, 0xd9,
+	0xcd, 0x81, 0xfb, 0x02, 0x7f, 0xe1, 0x04, 0xa0, 0x41, 0x27, 0xb1, 0xc4, 0x60, 0xf8, 0x76, 0x7f,
+	0x84, 0x96, 0x60, 0xba, 0xff, 0x96, 0xb4, 0x5e, 0x3c, 0xd1, 0x53, 0xdf, 0xdf, 0x25, 0x06, 0xa7,
+	0x3c, 0xd3, 0x62, 0x6b, 0x0b, 0x2d, 0xc3, 0x4c, 0x1f, 0x49, 0xb5, 0xa0, 0x62, 0x67, 0x81, 0xea,
+	0x7b, 0x51, 0x95, 0x86, 0xb0, 0x4c, 0xa2, 0x52, 0x2e, 0x7e, 0x2e, 0xac, 0x86, 0xf5, 0x48, 0x6c,
+	0xc2, 0xfe, 0xa3, 0x57, 0xdc, 0xb0, 0x09, 0xcb, 0x65, 0xd9, 0x4b, 0xa7, 0x40, 0x73, 0x18, 0x6e,
+	0x89, 0xc1, 0x59, 0x39, 0x30, 0x83, 0xaa, 0x3e, 0xd4, 0x77, 0xba, 0xa4, 0x4b, 0x64, 0x2e, 0x79,
+	0x16, 0x1f, 0xfb, 0x78, 0xd7, 0x6c, 0x63, 0xa4, 0xc1, 0xa9, 0x20, 0x9e, 0xe8, 0xeb, 0x99, 0xec,
+	0x1e, 0x3d, 0x35, 0x3f, 0x77, 0x02, 0xf4, 0x38, 0x7e, 0x5b, 0x62, 0x30, 0x17, 0x58, 0xc6, 0xa7,
+	0x64, 0x6d, 0xc0, 0xeb, 0x9c, 0x45, 0x53, 0xeb, 0xec, 0x10, 0x99, 0x4b, 0x3d, 0x72, 0x03, 0x5e,
+	0xc7, 0x6c, 0x6d, 0xc0, 0xb3, 0x6e, 0xd8, 0xc6, 0xe8, 0xda, 0x80, 0xba, 0x9c, 0x0b, 0xcf, 0x65,
+	0x6c, 0xb8, 0x99, 0x13, 0xe0, 0x02, 0xe4, 0x67, 0x65, 0xb9, 0xe9, 0x9f, 0x10, 0x92, 0x30, 0xd4,
+	0xd5, 0x9d, 0xff, 0x66, 0xfe, 0x1c, 0x82, 0x9c, 0x9b, 0xfc, 0x6e, 0x71, 0x5e, 0xd4, 0x8c, 0x6d,
+	0x89, 0x52, 0x62, 0x98, 0x68, 0x0d, 0xa6, 0xbb, 0xba, 0xb8, 0xe1, 0x4d, 0xd8, 0x0c, 0x92, 0x1d,
+	0x7d, 0xb5, 0x0f, 0x1b, 0xfa, 0xba, 0x8d, 0x54, 0x57, 0xef, 0x4f, 0xa3, 0x57, 0xe1, 0x05, 0x3f,
+	0x9c, 0xa8, 0x4b, 0x86, 0xb4, 0x4d, 0x28, 0x71, 0x28, 0x35, 0x89, 0x27, 0x7d, 0xca, 0x75, 0x4f,
+	0x66, 0xed, 0xdf, 0x3a, 0x6b, 0x9f, 0x1b, 0xe1, 0x33, 0xbb, 0x61, 0x27, 0xfd, 0xc0, 0x11, 0xab,
+	0x61, 0x09, 0x40, 0xfa, 0x5c, 0x89, 0xd8, 0xae, 0x5c, 0x08, 0x18, 0xf4, 0x9d, 0xc9, 0xff, 0x05,
+	0xc0, 0xc9, 0x05, 0x7f, 0xe4, 0xdd, 0xbf, 0xe2, 0x50, 0xf3, 0xb1, 0xe8, 0x36, 0xf1, 0x15, 0x34,
+	0x1b, 0xe8, 0x30, 0x42, 0xe7, 0xe9, 0x30, 0x5e, 0xfa, 0x13, 0x80, 0xec, 0xf0, 0xc9, 0x20, 0x04,
+	0xb3, 0x8b, 0x35, 0xbc, 0x56, 0x6c, 0x36, 0xcb, 0x58, 0xac, 0xd6, 0xaa, 0x65, 0x96, 0x41, 0x1c,
+	0x9c, 0x1c, 0xcc, 0xe1, 0x72, 0xbd, 0xd6, 0xa8, 0x34, 0x6b, 0xf8, 0x16, 0x0b, 0xd0, 0x14, 0xbc,
+	0x30, 0x90, 0x5c, 0xc5, 0xf5, 0x92, 0xd8, 0x28, 0xe3, 0x37, 0x2a, 0x25, 0xeb, 0xdf, 0xaa, 0x80,
+	0xd5, 0x72, 0xf1, 0x8d, 0x62, 0xa3, 0x84, 0x2b, 0xf5, 0x26, 0x1b, 0x0e, 0x4a, 0x4a, 0xc5, 0x5b,
+	0xe5, 0x6a, 0xb5, 0xbc, 0x5a, 0xaf, 0xb3, 0x91, 0xe0, 0xea, 0x37, 0x8a, 0x8d, 0x35, 0x36, 0x2a,
+	0xfc, 0x11, 0xdc, 0x3b, 0xe4, 0xc1, 0xfd, 0x43, 0x1e, 0x7c, 0x7a, 0xc8, 0x33, 0x0f, 0x0e, 0x79,
+	0xe6, 0xf3, 0x43, 0x9e, 0xf9, 0xe2, 0x90, 0x67, 0x1e, 0x1e, 0xf2, 0xe0, 0x76, 0x8f, 0x07, 0xef,
+	0xf7, 0x78, 0xe6, 0xe3, 0x1e, 0x0f, 0xee, 0xf4, 0x78, 0xe6, 0x6e, 0x8f, 0x67, 0x3e, 0xe9, 0xf1,
+	0xcc, 0xbd, 0x1e, 0x0f, 0xee, 0xf7, 0x78, 0xf0, 0x69, 0x8f, 0x67, 0x1e, 0xf4, 0x78, 0xf0, 0x79,
+	0x8f, 0x67, 0xbe, 0xe8, 0xf1, 0xe0, 0x61, 0x8f, 0x67, 0x6e, 0x1f, 0xf1, 0xcc, 0xfb, 0x47, 0x3c,
+	0xf8, 0xe0, 0x88, 0x67, 0x7e, 0x7b, 0xc4, 0x83, 0x8f, 0x8e, 0x78, 0xe6, 0xe3, 0x23, 0x9e, 0xb9,
+	0x73, 0xc4, 0x83, 0xbb, 0x47, 0x3c, 0xf8, 0xe4, 0x88, 0x07, 0x3f, 0x7e, 0xf9, 0xb4, 0xd5, 0x9b,
+	0xaa, 0xfa, 0xfa, 0x7a, 0xcc, 0xae, 0x47, 0xaf, 0xfc, 0x67, 0x00, 0x34, 0xdd, 0xb7, 0x61, 0xb3,
+	0x19, 0x00, 0x00,
 }
 
 func (x PayloadFormatter) String() string {
@@ -1711,6 +1876,36 @@ func (this *TxAcknowledgment) Equal(that interface{}) bool {
 	if this.Result != that1.Result {
 		return false
 	}
+	if !this.DownlinkMessage.Equal(that1.DownlinkMessage) {
+		return false
+	}
+	return true
+}
+func (this *GatewayTxAcknowledgment) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GatewayTxAcknowledgment)
+	if !ok {
+		that2, ok := that.(GatewayTxAcknowledgment)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.GatewayIdentifiers.Equal(&that1.GatewayIdentifiers) {
+		return false
+	}
+	if !this.TxAck.Equal(that1.TxAck) {
+		return false
+	}
 	return true
 }
 func (this *GatewayUplinkMessage) Equal(that interface{}) bool {
@@ -1794,6 +1989,14 @@ func (this *ApplicationUplink) Equal(that interface{}) bool {
 	if this.LastAFCntDown != that1.LastAFCntDown {
 		return false
 	}
+	if len(this.DecodedPayloadWarnings) != len(that1.DecodedPayloadWarnings) {
+		return false
+	}
+	for i := range this.DecodedPayloadWarnings {
+		if this.DecodedPayloadWarnings[i] != that1.DecodedPayloadWarnings[i] {
+			return false
+		}
+	}
 	return true
 }
 func (this *ApplicationLocation) Equal(that interface{}) bool {
@@ -1923,6 +2126,14 @@ func (this *ApplicationDownlink) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if len(this.DecodedPayloadWarnings) != len(that1.DecodedPayloadWarnings) {
+		return false
+	}
+	for i := range this.DecodedPayloadWarnings {
+		if this.DecodedPayloadWarnings[i] != that1.DecodedPayloadWarnings[i] {
+			return false
+		}
+	}
 	return true
 }
 func (this *ApplicationDownlink_ClassBC) Equal(that interface{}) bool {
@@ -2049,6 +2260,36 @@ func (this *ApplicationInvalidatedDownlinks) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *ApplicationSessionSwitch) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ApplicationSessionSwitch)
+	if !ok {
+		that2, ok := that.(ApplicationSessionSwitch)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.SessionKeyID, that1.SessionKeyID) {
+		return false
+	}
+	if !this.DevAddr.Equal(that1.DevAddr) {
+		return false
+	}
+	if !this.SwitchedAt.Equal(that1.SwitchedAt) {
+		return false
+	}
+	return true
+}
 func (this *ApplicationUp) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -2313,6 +2554,30 @@ func (this *ApplicationUp_LocationSolved) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *ApplicationUp_SessionSwitch) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ApplicationUp_SessionSwitch)
+	if !ok {
+		that2, ok := that.(ApplicationUp_SessionSwitch)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.SessionSwitch.Equal(that1.SessionSwitch) {
+		return false
+	}
+	return true
+}
 func (this *MessagePayloadFormatters) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -2600,6 +2865,18 @@ func (m *TxAcknowledgment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.DownlinkMessage != nil {
+		{
+			size, err := m.DownlinkMessage.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMessages(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.Result != 0 {
 		i = encodeVarintMessages(dAtA, i, uint64(m.Result))
 		i--
@@ -2617,6 +2894,51 @@ func (m *TxAcknowledgment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *GatewayTxAcknowledgment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GatewayTxAcknowledgment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GatewayTxAcknowledgment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TxAck != nil {
+		{
+			size, err := m.TxAck.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMessages(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.GatewayIdentifiers.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMessages(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *GatewayUplinkMessage) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2679,6 +3001,15 @@ func (m *ApplicationUplink) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.DecodedPayloadWarnings) > 0 {
+		for iNdEx := len(m.DecodedPayloadWarnings) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DecodedPayloadWarnings[iNdEx])
+			copy(dAtA[i:], m.DecodedPayloadWarnings[iNdEx])
+			i = encodeVarintMessages(dAtA, i, uint64(len(m.DecodedPayloadWarnings[iNdEx])))
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
 	if m.LastAFCntDown != 0 {
 		i = encodeVarintMessages(dAtA, i, uint64(m.LastAFCntDown))
 		i--
@@ -2696,12 +3027,12 @@ func (m *ApplicationUplink) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x4a
 	}
-	n10, err10 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.ReceivedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.ReceivedAt):])
-	if err10 != nil {
-		return 0, err10
+	n13, err13 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.ReceivedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.ReceivedAt):])
+	if err13 != nil {
+		return 0, err13
 	}
-	i -= n10
-	i = encodeVarintMessages(dAtA, i, uint64(n10))
+	i -= n13
+	i = encodeVarintMessages(dAtA, i, uint64(n13))
 	i--
 	dAtA[i] = 0x42
 	{
@@ -2846,12 +3177,12 @@ func (m *ApplicationJoinAccept) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n14, err14 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.ReceivedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.ReceivedAt):])
-	if err14 != nil {
-		return 0, err14
+	n17, err17 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.ReceivedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.ReceivedAt):])
+	if err17 != nil {
+		return 0, err17
 	}
-	i -= n14
-	i = encodeVarintMessages(dAtA, i, uint64(n14))
+	i -= n17
+	i = encodeVarintMessages(dAtA, i, uint64(n17))
 	i--
 	dAtA[i] = 0x42
 	if m.PendingSession {
@@ -2920,6 +3251,15 @@ func (m *ApplicationDownlink) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.DecodedPayloadWarnings) > 0 {
+		for iNdEx := len(m.DecodedPayloadWarnings) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DecodedPayloadWarnings[iNdEx])
+			copy(dAtA[i:], m.DecodedPayloadWarnings[iNdEx])
+			i = encodeVarintMessages(dAtA, i, uint64(len(m.DecodedPayloadWarnings[iNdEx])))
+			i--
+			dAtA[i] = 0x52
+		}
+	}
 	if len(m.CorrelationIDs) > 0 {
 		for iNdEx := len(m.CorrelationIDs) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.CorrelationIDs[iNdEx])
@@ -3016,12 +3356,12 @@ func (m *ApplicationDownlink_ClassBC) MarshalToSizedBuffer(dAtA []byte) (int, er
 	var l int
 	_ = l
 	if m.AbsoluteTime != nil {
-		n18, err18 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.AbsoluteTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.AbsoluteTime):])
-		if err18 != nil {
-			return 0, err18
+		n21, err21 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.AbsoluteTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.AbsoluteTime):])
+		if err21 != nil {
+			return 0, err21
 		}
-		i -= n18
-		i = encodeVarintMessages(dAtA, i, uint64(n18))
+		i -= n21
+		i = encodeVarintMessages(dAtA, i, uint64(n21))
 		i--
 		dAtA[i] = 0x42
 	}
@@ -3164,7 +3504,7 @@ func (m *ApplicationInvalidatedDownlinks) MarshalToSizedBuffer(dAtA []byte) (int
 	return len(dAtA) - i, nil
 }
 
-func (m *ApplicationUp) Marshal() (dAtA []byte, err error) {
+func (m *ApplicationSessionSwitch) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3174,26 +3514,64 @@ func (m *ApplicationUp) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ApplicationUp) MarshalTo(dAtA []byte) (int, error) {
+func (m *ApplicationSessionSwitch) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ApplicationUp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ApplicationSessionSwitch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.ReceivedAt != nil {
-		n21, err21 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ReceivedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ReceivedAt):])
-		if err21 != nil {
-			return 0, err21
+	n24, err24 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.SwitchedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.SwitchedAt):])
+	if err24 != nil {
+		return 0, err24
+	}
+	i -= n24
+	i = encodeVarintMessages(dAtA, i, uint64(n24))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.DevAddr.Size()
+		i -= size
+		if _, err := m.DevAddr.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
-		i -= n21
-		i = encodeVarintMessages(dAtA, i, uint64(n21))
+		i = encodeVarintMessages(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.SessionKeyID) > 0 {
+		i -= len(m.SessionKeyID)
+		copy(dAtA[i:], m.SessionKeyID)
+		i = encodeVarintMessages(dAtA, i, uint64(len(m.SessionKeyID)))
 		i--
-		dAtA[i] = 0x62
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ApplicationUp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationUp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplicationUp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
 	if m.Up != nil {
 		{
 			size := m.Up.Size()
@@ -3203,6 +3581,16 @@ func (m *ApplicationUp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			}
 		}
 	}
+	if m.ReceivedAt != nil {
+		n25, err25 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ReceivedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ReceivedAt):])
+		if err25 != nil {
+			return 0, err25
+		}
+		i -= n25
+		i = encodeVarintMessages(dAtA, i, uint64(n25))
+		i--
+		dAtA[i] = 0x62
+	}
 	if len(m.CorrelationIDs) > 0 {
 		for iNdEx := len(m.CorrelationIDs) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.CorrelationIDs[iNdEx])
@@ -3414,6 +3802,27 @@ func (m *ApplicationUp_LocationSolved) MarshalToSizedBuffer(dAtA []byte) (int, e
 	}
 	return len(dAtA) - i, nil
 }
+func (m *ApplicationUp_SessionSwitch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplicationUp_SessionSwitch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.SessionSwitch != nil {
+		{
+			size, err := m.SessionSwitch.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMessages(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
+	return len(dAtA) - i, nil
+}
 func (m *MessagePayloadFormatters) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3527,6 +3936,21 @@ func NewPopulatedTxAcknowledgment(r randyMessages, easy bool) *TxAcknowledgment
 		this.CorrelationIDs[i] = randStringMessages(r)
 	}
 	this.Result = TxAcknowledgment_Result([]int32{0, 1, 2, 3, 4, 5, 6, 7, 8}[r.Intn(9)])
+	if r.Intn(5) != 0 {
+		this.DownlinkMessage = NewPopulatedDownlinkMessage(r, easy)
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedGatewayTxAcknowledgment(r randyMessages, easy bool) *GatewayTxAcknowledgment {
+	this := &GatewayTxAcknowledgment{}
+	v2 := NewPopulatedGatewayIdentifiers(r, easy)
+	this.GatewayIdentifiers = *v2
+	if r.Intn(5) != 0 {
+		this.TxAck = NewPopulatedTxAcknowledgment(r, easy)
+	}
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -3545,36 +3969,41 @@ func NewPopulatedGatewayUplinkMessage(r randyMessages, easy bool) *GatewayUplink
 
 func NewPopulatedApplicationUplink(r randyMessages, easy bool) *ApplicationUplink {
 	this := &ApplicationUplink{}
-	v2 := r.Intn(100)
-	this.SessionKeyID = make([]byte, v2)
-	for i := 0; i < v2; i++ {
+	v3 := r.Intn(100)
+	this.SessionKeyID = make([]byte, v3)
+	for i := 0; i < v3; i++ {
 		this.SessionKeyID[i] = byte(r.Intn(256))
 	}
 	this.FPort = r.Uint32()
 	this.FCnt = r.Uint32()
-	v3 := r.Intn(100)
-	this.FRMPayload = make([]byte, v3)
-	for i := 0; i < v3; i++ {
+	v4 := r.Intn(100)
+	this.FRMPayload = make([]byte, v4)
+	for i := 0; i < v4; i++ {
 		this.FRMPayload[i] = byte(r.Intn(256))
 	}
 	if r.Intn(5) != 0 {
 		this.DecodedPayload = types.NewPopulatedStruct(r, easy)
 	}
 	if r.Intn(5) != 0 {
-		v4 := r.Intn(5)
-		this.RxMetadata = make([]*RxMetadata, v4)
-		for i := 0; i < v4; i++ {
+		v5 := r.Intn(5)
+		this.RxMetadata = make([]*RxMetadata, v5)
+		for i := 0; i < v5; i++ {
 			this.RxMetadata[i] = NewPopulatedRxMetadata(r, easy)
 		}
 	}
-	v5 := NewPopulatedTxSettings(r, easy)
-	this.Settings = *v5
-	v6 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
-	this.ReceivedAt = *v6
+	v6 := NewPopulatedTxSettings(r, easy)
+	this.Settings = *v6
+	v7 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	this.ReceivedAt = *v7
 	if r.Intn(5) != 0 {
 		this.AppSKey = NewPopulatedKeyEnvelope(r, easy)
 	}
 	this.LastAFCntDown = r.Uint32()
+	v8 := r.Intn(10)
+	this.DecodedPayloadWarnings = make([]string, v8)
+	for i := 0; i < v8; i++ {
+		this.DecodedPayloadWarnings[i] = randStringMessages(r)
+	}
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -3583,12 +4012,12 @@ func NewPopulatedApplicationUplink(r randyMessages, easy bool) *ApplicationUplin
 func NewPopulatedApplicationLocation(r randyMessages, easy bool) *ApplicationLocation {
 	this := &ApplicationLocation{}
 	this.Service = randStringMessages(r)
-	v7 := NewPopulatedLocation(r, easy)
-	this.Location = *v7
+	v9 := NewPopulatedLocation(r, easy)
+	this.Location = *v9
 	if r.Intn(5) != 0 {
-		v8 := r.Intn(10)
+		v10 := r.Intn(10)
 		this.Attributes = make(map[string]string)
-		for i := 0; i < v8; i++ {
+		for i := 0; i < v10; i++ {
 			this.Attributes[randStringMessages(r)] = randStringMessages(r)
 		}
 	}
@@ -3599,24 +4028,24 @@ func NewPopulatedApplicationLocation(r randyMessages, easy bool) *ApplicationLoc
 
 func NewPopulatedApplicationJoinAccept(r randyMessages, easy bool) *ApplicationJoinAccept {
 	this := &ApplicationJoinAccept{}
-	v9 := r.Intn(100)
-	this.SessionKeyID = make([]byte, v9)
-	for i := 0; i < v9; i++ {
+	v11 := r.Intn(100)
+	this.SessionKeyID = make([]byte, v11)
+	for i := 0; i < v11; i++ {
 		this.SessionKeyID[i] = byte(r.Intn(256))
 	}
 	if r.Intn(5) != 0 {
 		this.AppSKey = NewPopulatedKeyEnvelope(r, easy)
 	}
 	if r.Intn(5) != 0 {
-		v10 := r.Intn(5)
-		this.InvalidatedDownlinks = make([]*ApplicationDownlink, v10)
-		for i := 0; i < v10; i++ {
+		v12 := r.Intn(5)
+		this.InvalidatedDownlinks = make([]*ApplicationDownlink, v12)
+		for i := 0; i < v12; i++ {
 			this.InvalidatedDownlinks[i] = NewPopulatedApplicationDownlink(r, easy)
 		}
 	}
 	this.PendingSession = bool(r.Intn(2) == 0)
-	v11 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
-	this.ReceivedAt = *v11
+	v13 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	this.ReceivedAt = *v13
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -3625,11 +4054,11 @@ func NewPopulatedApplicationJoinAccept(r randyMessages, easy bool) *ApplicationJ
 func NewPopulatedApplicationDownlink_ClassBC(r randyMessages, easy bool) *ApplicationDownlink_ClassBC {
 	this := &ApplicationDownlink_ClassBC{}
 	if r.Intn(5) != 0 {
-		v12 := r.Intn(5)
-		this.Gateways = make([]GatewayAntennaIdentifiers, v12)
-		for i := 0; i < v12; i++ {
-			v13 := NewPopulatedGatewayAntennaIdentifiers(r, easy)
-			this.Gateways[i] = *v13
+		v14 := r.Intn(5)
+		this.Gateways = make([]GatewayAntennaIdentifiers, v14)
+		for i := 0; i < v14; i++ {
+			v15 := NewPopulatedGatewayAntennaIdentifiers(r, easy)
+			this.Gateways[i] = *v15
 		}
 	}
 	if r.Intn(5) != 0 {
@@ -3643,9 +4072,9 @@ func NewPopulatedApplicationDownlink_ClassBC(r randyMessages, easy bool) *Applic
 func NewPopulatedApplicationDownlinks(r randyMessages, easy bool) *ApplicationDownlinks {
 	this := &ApplicationDownlinks{}
 	if r.Intn(5) != 0 {
-		v14 := r.Intn(5)
-		this.Downlinks = make([]*ApplicationDownlink, v14)
-		for i := 0; i < v14; i++ {
+		v16 := r.Intn(5)
+		this.Downlinks = make([]*ApplicationDownlink, v16)
+		for i := 0; i < v16; i++ {
 			this.Downlinks[i] = NewPopulatedApplicationDownlink(r, easy)
 		}
 	}
@@ -3656,10 +4085,10 @@ func NewPopulatedApplicationDownlinks(r randyMessages, easy bool) *ApplicationDo
 
 func NewPopulatedApplicationDownlinkFailed(r randyMessages, easy bool) *ApplicationDownlinkFailed {
 	this := &ApplicationDownlinkFailed{}
-	v15 := NewPopulatedApplicationDownlink(r, easy)
-	this.ApplicationDownlink = *v15
-	v16 := NewPopulatedErrorDetails(r, easy)
-	this.Error = *v16
+	v17 := NewPopulatedApplicationDownlink(r, easy)
+	this.ApplicationDownlink = *v17
+	v18 := NewPopulatedErrorDetails(r, easy)
+	this.Error = *v18
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -3668,9 +4097,9 @@ func NewPopulatedApplicationDownlinkFailed(r randyMessages, easy bool) *Applicat
 func NewPopulatedApplicationInvalidatedDownlinks(r randyMessages, easy bool) *ApplicationInvalidatedDownlinks {
 	this := &ApplicationInvalidatedDownlinks{}
 	if r.Intn(5) != 0 {
-		v17 := r.Intn(5)
-		this.Downlinks = make([]*ApplicationDownlink, v17)
-		for i := 0; i < v17; i++ {
+		v19 := r.Intn(5)
+		this.Downlinks = make([]*ApplicationDownlink, v19)
+		for i := 0; i < v19; i++ {
 			this.Downlinks[i] = NewPopulatedApplicationDownlink(r, easy)
 		}
 	}
@@ -3680,16 +4109,32 @@ func NewPopulatedApplicationInvalidatedDownlinks(r randyMessages, easy bool) *Ap
 	return this
 }
 
+func NewPopulatedApplicationSessionSwitch(r randyMessages, easy bool) *ApplicationSessionSwitch {
+	this := &ApplicationSessionSwitch{}
+	v20 := r.Intn(100)
+	this.SessionKeyID = make([]byte, v20)
+	for i := 0; i < v20; i++ {
+		this.SessionKeyID[i] = byte(r.Intn(256))
+	}
+	v21 := go_thethings_network_lorawan_stack_pkg_types.NewPopulatedDevAddr(r)
+	this.DevAddr = *v21
+	v22 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	this.SwitchedAt = *v22
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
 func NewPopulatedApplicationUp(r randyMessages, easy bool) *ApplicationUp {
 	this := &ApplicationUp{}
-	v18 := NewPopulatedEndDeviceIdentifiers(r, easy)
-	this.EndDeviceIdentifiers = *v18
-	v19 := r.Intn(10)
-	this.CorrelationIDs = make([]string, v19)
-	for i := 0; i < v19; i++ {
+	v23 := NewPopulatedEndDeviceIdentifiers(r, easy)
+	this.EndDeviceIdentifiers = *v23
+	v24 := r.Intn(10)
+	this.CorrelationIDs = make([]string, v24)
+	for i := 0; i < v24; i++ {
 		this.CorrelationIDs[i] = randStringMessages(r)
 	}
-	oneofNumber_Up := []int32{3, 4, 5, 6, 7, 8, 9, 10, 11}[r.Intn(9)]
+	oneofNumber_Up := []int32{3, 4, 5, 6, 7, 8, 9, 10, 11, 13}[r.Intn(10)]
 	switch oneofNumber_Up {
 	case 3:
 		this.Up = NewPopulatedApplicationUp_UplinkMessage(r, easy)
@@ -3709,6 +4154,8 @@ func NewPopulatedApplicationUp(r randyMessages, easy bool) *ApplicationUp {
 		this.Up = NewPopulatedApplicationUp_DownlinkQueueInvalidated(r, easy)
 	case 11:
 		this.Up = NewPopulatedApplicationUp_LocationSolved(r, easy)
+	case 13:
+		this.Up = NewPopulatedApplicationUp_SessionSwitch(r, easy)
 	}
 	if r.Intn(5) != 0 {
 		this.ReceivedAt = github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
@@ -3763,11 +4210,16 @@ func NewPopulatedApplicationUp_LocationSolved(r randyMessages, easy bool) *Appli
 	this.LocationSolved = NewPopulatedApplicationLocation(r, easy)
 	return this
 }
+func NewPopulatedApplicationUp_SessionSwitch(r randyMessages, easy bool) *ApplicationUp_SessionSwitch {
+	this := &ApplicationUp_SessionSwitch{}
+	this.SessionSwitch = NewPopulatedApplicationSessionSwitch(r, easy)
+	return this
+}
 func NewPopulatedMessagePayloadFormatters(r randyMessages, easy bool) *MessagePayloadFormatters {
 	this := &MessagePayloadFormatters{}
-	this.UpFormatter = PayloadFormatter([]int32{0, 1, 2, 3, 4}[r.Intn(5)])
+	this.UpFormatter = PayloadFormatter([]int32{0, 1, 2, 3, 4, 5}[r.Intn(6)])
 	this.UpFormatterParameter = randStringMessages(r)
-	this.DownFormatter = PayloadFormatter([]int32{0, 1, 2, 3, 4}[r.Intn(5)])
+	this.DownFormatter = PayloadFormatter([]int32{0, 1, 2, 3, 4, 5}[r.Intn(6)])
 	this.DownFormatterParameter = randStringMessages(r)
 	if !easy && r.Intn(10) != 0 {
 	}
@@ -3776,12 +4228,12 @@ func NewPopulatedMessagePayloadFormatters(r randyMessages, easy bool) *MessagePa
 
 func NewPopulatedDownlinkQueueRequest(r randyMessages, easy bool) *DownlinkQueueRequest {
 	this := &DownlinkQueueRequest{}
-	v20 := NewPopulatedEndDeviceIdentifiers(r, easy)
-	this.EndDeviceIdentifiers = *v20
+	v25 := NewPopulatedEndDeviceIdentifiers(r, easy)
+	this.EndDeviceIdentifiers = *v25
 	if r.Intn(5) != 0 {
-		v21 := r.Intn(5)
-		this.Downlinks = make([]*ApplicationDownlink, v21)
-		for i := 0; i < v21; i++ {
+		v26 := r.Intn(5)
+		this.Downlinks = make([]*ApplicationDownlink, v26)
+		for i := 0; i < v26; i++ {
 			this.Downlinks[i] = NewPopulatedApplicationDownlink(r, easy)
 		}
 	}
@@ -3809,9 +4261,9 @@ func randUTF8RuneMessages(r randyMessages) rune {
 	return rune(ru + 61)
 }
 func randStringMessages(r randyMessages) string {
-	v22 := r.Intn(100)
-	tmps := make([]rune, v22)
-	for i := 0; i < v22; i++ {
+	v27 := r.Intn(100)
+	tmps := make([]rune, v27)
+	for i := 0; i < v27; i++ {
 		tmps[i] = randUTF8RuneMessages(r)
 	}
 	return string(tmps)
@@ -3833,11 +4285,11 @@ func randFieldMessages(dAtA []byte, r randyMessages, fieldNumber int, wire int)
 	switch wire {
 	case 0:
 		dAtA = encodeVarintPopulateMessages(dAtA, uint64(key))
-		v23 := r.Int63()
+		v28 := r.Int63()
 		if r.Intn(2) == 0 {
-			v23 *= -1
+			v28 *= -1
 		}
-		dAtA = encodeVarintPopulateMessages(dAtA, uint64(v23))
+		dAtA = encodeVarintPopulateMessages(dAtA, uint64(v28))
 	case 1:
 		dAtA = encodeVarintPopulateMessages(dAtA, uint64(key))
 		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
@@ -3967,6 +4419,25 @@ func (m *TxAcknowledgment) Size() (n int) {
 	if m.Result != 0 {
 		n += 1 + sovMessages(uint64(m.Result))
 	}
+	if m.DownlinkMessage != nil {
+		l = m.DownlinkMessage.Size()
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	return n
+}
+
+func (m *GatewayTxAcknowledgment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.GatewayIdentifiers.Size()
+	n += 1 + l + sovMessages(uint64(l))
+	if m.TxAck != nil {
+		l = m.TxAck.Size()
+		n += 1 + l + sovMessages(uint64(l))
+	}
 	return n
 }
 
@@ -4028,6 +4499,12 @@ func (m *ApplicationUplink) Size() (n int) {
 	if m.LastAFCntDown != 0 {
 		n += 1 + sovMessages(uint64(m.LastAFCntDown))
 	}
+	if len(m.DecodedPayloadWarnings) > 0 {
+		for _, s := range m.DecodedPayloadWarnings {
+			l = len(s)
+			n += 1 + l + sovMessages(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -4122,6 +4599,12 @@ func (m *ApplicationDownlink) Size() (n int) {
 			n += 1 + l + sovMessages(uint64(l))
 		}
 	}
+	if len(m.DecodedPayloadWarnings) > 0 {
+		for _, s := range m.DecodedPayloadWarnings {
+			l = len(s)
+			n += 1 + l + sovMessages(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -4190,6 +4673,23 @@ func (m *ApplicationInvalidatedDownlinks) Size() (n int) {
 	return n
 }
 
+func (m *ApplicationSessionSwitch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SessionKeyID)
+	if l > 0 {
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	l = m.DevAddr.Size()
+	n += 1 + l + sovMessages(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.SwitchedAt)
+	n += 1 + l + sovMessages(uint64(l))
+	return n
+}
+
 func (m *ApplicationUp) Size() (n int) {
 	if m == nil {
 		return 0
@@ -4322,6 +4822,18 @@ func (m *ApplicationUp_LocationSolved) Size() (n int) {
 	}
 	return n
 }
+func (m *ApplicationUp_SessionSwitch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SessionSwitch != nil {
+		l = m.SessionSwitch.Size()
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	return n
+}
 func (m *MessagePayloadFormatters) Size() (n int) {
 	if m == nil {
 		return 0
@@ -4430,6 +4942,18 @@ func (this *TxAcknowledgment) String() string {
 	s := strings.Join([]string{`&TxAcknowledgment{`,
 		`CorrelationIDs:` + fmt.Sprintf("%v", this.CorrelationIDs) + `,`,
 		`Result:` + fmt.Sprintf("%v", this.Result) + `,`,
+		`DownlinkMessage:` + strings.Replace(this.DownlinkMessage.String(), "DownlinkMessage", "DownlinkMessage", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GatewayTxAcknowledgment) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GatewayTxAcknowledgment{`,
+		`GatewayIdentifiers:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.GatewayIdentifiers), "GatewayIdentifiers", "GatewayIdentifiers", 1), `&`, ``, 1) + `,`,
+		`TxAck:` + strings.Replace(this.TxAck.String(), "TxAcknowledgment", "TxAcknowledgment", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -4465,6 +4989,7 @@ func (this *ApplicationUplink) String() string {
 		`ReceivedAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ReceivedAt), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
 		`AppSKey:` + strings.Replace(fmt.Sprintf("%v", this.AppSKey), "KeyEnvelope", "KeyEnvelope", 1) + `,`,
 		`LastAFCntDown:` + fmt.Sprintf("%v", this.LastAFCntDown) + `,`,
+		`DecodedPayloadWarnings:` + fmt.Sprintf("%v", this.DecodedPayloadWarnings) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -4524,6 +5049,7 @@ func (this *ApplicationDownlink) String() string {
 		`ClassBC:` + strings.Replace(fmt.Sprintf("%v", this.ClassBC), "ApplicationDownlink_ClassBC", "ApplicationDownlink_ClassBC", 1) + `,`,
 		`Priority:` + fmt.Sprintf("%v", this.Priority) + `,`,
 		`CorrelationIDs:` + fmt.Sprintf("%v", this.CorrelationIDs) + `,`,
+		`DecodedPayloadWarnings:` + fmt.Sprintf("%v", this.DecodedPayloadWarnings) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -4586,6 +5112,18 @@ func (this *ApplicationInvalidatedDownlinks) String() string {
 	}, "")
 	return s
 }
+func (this *ApplicationSessionSwitch) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ApplicationSessionSwitch{`,
+		`SessionKeyID:` + fmt.Sprintf("%v", this.SessionKeyID) + `,`,
+		`DevAddr:` + fmt.Sprintf("%v", this.DevAddr) + `,`,
+		`SwitchedAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.SwitchedAt), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *ApplicationUp) String() string {
 	if this == nil {
 		return "nil"
@@ -4689,6 +5227,16 @@ func (this *ApplicationUp_LocationSolved) String() string {
 	}, "")
 	return s
 }
+func (this *ApplicationUp_SessionSwitch) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ApplicationUp_SessionSwitch{`,
+		`SessionSwitch:` + strings.Replace(fmt.Sprintf("%v", this.SessionSwitch), "ApplicationSessionSwitch", "ApplicationSessionSwitch", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *MessagePayloadFormatters) String() string {
 	if this == nil {
 		return "nil"
@@ -5341,6 +5889,42 @@ func (m *TxAcknowledgment) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DownlinkMessage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DownlinkMessage == nil {
+				m.DownlinkMessage = &DownlinkMessage{}
+			}
+			if err := m.DownlinkMessage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMessages(dAtA[iNdEx:])
@@ -5365,7 +5949,7 @@ func (m *TxAcknowledgment) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GatewayUplinkMessage) Unmarshal(dAtA []byte) error {
+func (m *GatewayTxAcknowledgment) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5388,15 +5972,15 @@ func (m *GatewayUplinkMessage) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GatewayUplinkMessage: wiretype end group for non-group")
+			return fmt.Errorf("proto: GatewayTxAcknowledgment: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GatewayUplinkMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GatewayTxAcknowledgment: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UplinkMessage", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GatewayIdentifiers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5423,18 +6007,15 @@ func (m *GatewayUplinkMessage) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.UplinkMessage == nil {
-				m.UplinkMessage = &UplinkMessage{}
-			}
-			if err := m.UplinkMessage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.GatewayIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BandID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TxAck", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessages
@@ -5444,23 +6025,27 @@ func (m *GatewayUplinkMessage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthMessages
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthMessages
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BandID = string(dAtA[iNdEx:postIndex])
+			if m.TxAck == nil {
+				m.TxAck = &TxAcknowledgment{}
+			}
+			if err := m.TxAck.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5486,7 +6071,7 @@ func (m *GatewayUplinkMessage) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationUplink) Unmarshal(dAtA []byte) error {
+func (m *GatewayUplinkMessage) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5509,17 +6094,17 @@ func (m *ApplicationUplink) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationUplink: wiretype end group for non-group")
+			return fmt.Errorf("proto: GatewayUplinkMessage: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationUplink: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GatewayUplinkMessage: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SessionKeyID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UplinkMessage", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessages
@@ -5529,31 +6114,33 @@ func (m *ApplicationUplink) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthMessages
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthMessages
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SessionKeyID = append(m.SessionKeyID[:0], dAtA[iNdEx:postIndex]...)
-			if m.SessionKeyID == nil {
-				m.SessionKeyID = []byte{}
+			if m.UplinkMessage == nil {
+				m.UplinkMessage = &UplinkMessage{}
+			}
+			if err := m.UplinkMessage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FPort", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BandID", wireType)
 			}
-			m.FPort = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessages
@@ -5563,18 +6150,137 @@ func (m *ApplicationUplink) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.FPort |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FCnt", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessages
 			}
-			m.FCnt = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BandID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessages(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationUplink) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessages
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationUplink: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationUplink: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionKeyID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SessionKeyID = append(m.SessionKeyID[:0], dAtA[iNdEx:postIndex]...)
+			if m.SessionKeyID == nil {
+				m.SessionKeyID = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FPort", wireType)
+			}
+			m.FPort = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FPort |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FCnt", wireType)
+			}
+			m.FCnt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
 					return ErrIntOverflowMessages
 				}
 				if iNdEx >= l {
@@ -5812,6 +6518,38 @@ func (m *ApplicationUplink) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecodedPayloadWarnings", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DecodedPayloadWarnings = append(m.DecodedPayloadWarnings, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMessages(dAtA[iNdEx:])
@@ -6569,6 +7307,38 @@ func (m *ApplicationDownlink) Unmarshal(dAtA []byte) error {
 			}
 			m.CorrelationIDs = append(m.CorrelationIDs, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecodedPayloadWarnings", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DecodedPayloadWarnings = append(m.DecodedPayloadWarnings, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMessages(dAtA[iNdEx:])
@@ -7028,6 +7798,159 @@ func (m *ApplicationInvalidatedDownlinks) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ApplicationSessionSwitch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessages
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationSessionSwitch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationSessionSwitch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionKeyID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SessionKeyID = append(m.SessionKeyID[:0], dAtA[iNdEx:postIndex]...)
+			if m.SessionKeyID == nil {
+				m.SessionKeyID = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DevAddr", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DevAddr.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwitchedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.SwitchedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessages(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *ApplicationUp) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -7473,6 +8396,41 @@ func (m *ApplicationUp) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionSwitch", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ApplicationSessionSwitch{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Up = &ApplicationUp_SessionSwitch{v}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMessages(dAtA[iNdEx:])
@@ -177,13 +177,197 @@ var DownlinkMessageFieldPathsTopLevel = []string{
 }
 var TxAcknowledgmentFieldPathsNested = []string{
 	"correlation_ids",
+	"downlink_message",
+	"downlink_message.correlation_ids",
+	"downlink_message.end_device_ids",
+	"downlink_message.end_device_ids.application_ids",
+	"downlink_message.end_device_ids.application_ids.application_id",
+	"downlink_message.end_device_ids.dev_addr",
+	"downlink_message.end_device_ids.dev_eui",
+	"downlink_message.end_device_ids.device_id",
+	"downlink_message.end_device_ids.join_eui",
+	"downlink_message.payload",
+	"downlink_message.payload.Payload",
+	"downlink_message.payload.Payload.join_accept_payload",
+	"downlink_message.payload.Payload.join_accept_payload.cf_list",
+	"downlink_message.payload.Payload.join_accept_payload.cf_list.ch_masks",
+	"downlink_message.payload.Payload.join_accept_payload.cf_list.freq",
+	"downlink_message.payload.Payload.join_accept_payload.cf_list.type",
+	"downlink_message.payload.Payload.join_accept_payload.dev_addr",
+	"downlink_message.payload.Payload.join_accept_payload.dl_settings",
+	"downlink_message.payload.Payload.join_accept_payload.dl_settings.opt_neg",
+	"downlink_message.payload.Payload.join_accept_payload.dl_settings.rx1_dr_offset",
+	"downlink_message.payload.Payload.join_accept_payload.dl_settings.rx2_dr",
+	"downlink_message.payload.Payload.join_accept_payload.encrypted",
+	"downlink_message.payload.Payload.join_accept_payload.join_nonce",
+	"downlink_message.payload.Payload.join_accept_payload.net_id",
+	"downlink_message.payload.Payload.join_accept_payload.rx_delay",
+	"downlink_message.payload.Payload.join_request_payload",
+	"downlink_message.payload.Payload.join_request_payload.dev_eui",
+	"downlink_message.payload.Payload.join_request_payload.dev_nonce",
+	"downlink_message.payload.Payload.join_request_payload.join_eui",
+	"downlink_message.payload.Payload.mac_payload",
+	"downlink_message.payload.Payload.mac_payload.decoded_payload",
+	"downlink_message.payload.Payload.mac_payload.f_hdr",
+	"downlink_message.payload.Payload.mac_payload.f_hdr.dev_addr",
+	"downlink_message.payload.Payload.mac_payload.f_hdr.f_cnt",
+	"downlink_message.payload.Payload.mac_payload.f_hdr.f_ctrl",
+	"downlink_message.payload.Payload.mac_payload.f_hdr.f_ctrl.ack",
+	"downlink_message.payload.Payload.mac_payload.f_hdr.f_ctrl.adr",
+	"downlink_message.payload.Payload.mac_payload.f_hdr.f_ctrl.adr_ack_req",
+	"downlink_message.payload.Payload.mac_payload.f_hdr.f_ctrl.class_b",
+	"downlink_message.payload.Payload.mac_payload.f_hdr.f_ctrl.f_pending",
+	"downlink_message.payload.Payload.mac_payload.f_hdr.f_opts",
+	"downlink_message.payload.Payload.mac_payload.f_port",
+	"downlink_message.payload.Payload.mac_payload.frm_payload",
+	"downlink_message.payload.Payload.rejoin_request_payload",
+	"downlink_message.payload.Payload.rejoin_request_payload.dev_eui",
+	"downlink_message.payload.Payload.rejoin_request_payload.join_eui",
+	"downlink_message.payload.Payload.rejoin_request_payload.net_id",
+	"downlink_message.payload.Payload.rejoin_request_payload.rejoin_cnt",
+	"downlink_message.payload.Payload.rejoin_request_payload.rejoin_type",
+	"downlink_message.payload.m_hdr",
+	"downlink_message.payload.m_hdr.m_type",
+	"downlink_message.payload.m_hdr.major",
+	"downlink_message.payload.mic",
+	"downlink_message.raw_payload",
+	"downlink_message.settings",
+	"downlink_message.settings.request",
+	"downlink_message.settings.request.absolute_time",
+	"downlink_message.settings.request.advanced",
+	"downlink_message.settings.request.class",
+	"downlink_message.settings.request.downlink_paths",
+	"downlink_message.settings.request.frequency_plan_id",
+	"downlink_message.settings.request.priority",
+	"downlink_message.settings.request.rx1_data_rate_index",
+	"downlink_message.settings.request.rx1_delay",
+	"downlink_message.settings.request.rx1_frequency",
+	"downlink_message.settings.request.rx2_data_rate_index",
+	"downlink_message.settings.request.rx2_frequency",
+	"downlink_message.settings.scheduled",
+	"downlink_message.settings.scheduled.coding_rate",
+	"downlink_message.settings.scheduled.data_rate",
+	"downlink_message.settings.scheduled.data_rate.modulation",
+	"downlink_message.settings.scheduled.data_rate.modulation.fsk",
+	"downlink_message.settings.scheduled.data_rate.modulation.fsk.bit_rate",
+	"downlink_message.settings.scheduled.data_rate.modulation.lora",
+	"downlink_message.settings.scheduled.data_rate.modulation.lora.bandwidth",
+	"downlink_message.settings.scheduled.data_rate.modulation.lora.spreading_factor",
+	"downlink_message.settings.scheduled.data_rate_index",
+	"downlink_message.settings.scheduled.downlink",
+	"downlink_message.settings.scheduled.downlink.antenna_index",
+	"downlink_message.settings.scheduled.downlink.invert_polarization",
+	"downlink_message.settings.scheduled.downlink.tx_power",
+	"downlink_message.settings.scheduled.enable_crc",
+	"downlink_message.settings.scheduled.frequency",
+	"downlink_message.settings.scheduled.time",
+	"downlink_message.settings.scheduled.timestamp",
 	"result",
 }
 
 var TxAcknowledgmentFieldPathsTopLevel = []string{
 	"correlation_ids",
+	"downlink_message",
 	"result",
 }
+var GatewayTxAcknowledgmentFieldPathsNested = []string{
+	"gateway_ids",
+	"gateway_ids.eui",
+	"gateway_ids.gateway_id",
+	"tx_ack",
+	"tx_ack.correlation_ids",
+	"tx_ack.downlink_message",
+	"tx_ack.downlink_message.correlation_ids",
+	"tx_ack.downlink_message.end_device_ids",
+	"tx_ack.downlink_message.end_device_ids.application_ids",
+	"tx_ack.downlink_message.end_device_ids.application_ids.application_id",
+	"tx_ack.downlink_message.end_device_ids.dev_addr",
+	"tx_ack.downlink_message.end_device_ids.dev_eui",
+	"tx_ack.downlink_message.end_device_ids.device_id",
+	"tx_ack.downlink_message.end_device_ids.join_eui",
+	"tx_ack.downlink_message.payload",
+	"tx_ack.downlink_message.payload.Payload",
+	"tx_ack.downlink_message.payload.Payload.join_accept_payload",
+	"tx_ack.downlink_message.payload.Payload.join_accept_payload.cf_list",
+	"tx_ack.downlink_message.payload.Payload.join_accept_payload.cf_list.ch_masks",
+	"tx_ack.downlink_message.payload.Payload.join_accept_payload.cf_list.freq",
+	"tx_ack.downlink_message.payload.Payload.join_accept_payload.cf_list.type",
+	"tx_ack.downlink_message.payload.Payload.join_accept_payload.dev_addr",
+	"tx_ack.downlink_message.payload.Payload.join_accept_payload.dl_settings",
+	"tx_ack.downlink_message.payload.Payload.join_accept_payload.dl_settings.opt_neg",
+	"tx_ack.downlink_message.payload.Payload.join_accept_payload.dl_settings.rx1_dr_offset",
+	"tx_ack.downlink_message.payload.Payload.join_accept_payload.dl_settings.rx2_dr",
+	"tx_ack.downlink_message.payload.Payload.join_accept_payload.encrypted",
+	"tx_ack.downlink_message.payload.Payload.join_accept_payload.join_nonce",
+	"tx_ack.downlink_message.payload.Payload.join_accept_payload.net_id",
+	"tx_ack.downlink_message.payload.Payload.join_accept_payload.rx_delay",
+	"tx_ack.downlink_message.payload.Payload.join_request_payload",
+	"tx_ack.downlink_message.payload.Payload.join_request_payload.dev_eui",
+	"tx_ack.downlink_message.payload.Payload.join_request_payload.dev_nonce",
+	"tx_ack.downlink_message.payload.Payload.join_request_payload.join_eui",
+	"tx_ack.downlink_message.payload.Payload.mac_payload",
+	"tx_ack.downlink_message.payload.Payload.mac_payload.decoded_payload",
+	"tx_ack.downlink_message.payload.Payload.mac_payload.f_hdr",
+	"tx_ack.downlink_message.payload.Payload.mac_payload.f_hdr.dev_addr",
+	"tx_ack.downlink_message.payload.Payload.mac_payload.f_hdr.f_cnt",
+	"tx_ack.downlink_message.payload.Payload.mac_payload.f_hdr.f_ctrl",
+	"tx_ack.downlink_message.payload.Payload.mac_payload.f_hdr.f_ctrl.ack",
+	"tx_ack.downlink_message.payload.Payload.mac_payload.f_hdr.f_ctrl.adr",
+	"tx_ack.downlink_message.payload.Payload.mac_payload.f_hdr.f_ctrl.adr_ack_req",
+	"tx_ack.downlink_message.payload.Payload.mac_payload.f_hdr.f_ctrl.class_b",
+	"tx_ack.downlink_message.payload.Payload.mac_payload.f_hdr.f_ctrl.f_pending",
+	"tx_ack.downlink_message.payload.Payload.mac_payload.f_hdr.f_opts",
+	"tx_ack.downlink_message.payload.Payload.mac_payload.f_port",
+	"tx_ack.downlink_message.payload.Payload.mac_payload.frm_payload",
+	"tx_ack.downlink_message.payload.Payload.rejoin_request_payload",
+	"tx_ack.downlink_message.payload.Payload.rejoin_request_payload.dev_eui",
+	"tx_ack.downlink_message.payload.Payload.rejoin_request_payload.join_eui",
+	"tx_ack.downlink_message.payload.Payload.rejoin_request_payload.net_id",
+	"tx_ack.downlink_message.payload.Payload.rejoin_request_payload.rejoin_cnt",
+	"tx_ack.downlink_message.payload.Payload.rejoin_request_payload.rejoin_type",
+	"tx_ack.downlink_message.payload.m_hdr",
+	"tx_ack.downlink_message.payload.m_hdr.m_type",
+	"tx_ack.downlink_message.payload.m_hdr.major",
+	"tx_ack.downlink_message.payload.mic",
+	"tx_ack.downlink_message.raw_payload",
+	"tx_ack.downlink_message.settings",
+	"tx_ack.downlink_message.settings.request",
+	"tx_ack.downlink_message.settings.request.absolute_time",
+	"tx_ack.downlink_message.settings.request.advanced",
+	"tx_ack.downlink_message.settings.request.class",
+	"tx_ack.downlink_message.settings.request.downlink_paths",
+	"tx_ack.downlink_message.settings.request.frequency_plan_id",
+	"tx_ack.downlink_message.settings.request.priority",
+	"tx_ack.downlink_message.settings.request.rx1_data_rate_index",
+	"tx_ack.downlink_message.settings.request.rx1_delay",
+	"tx_ack.downlink_message.settings.request.rx1_frequency",
+	"tx_ack.downlink_message.settings.request.rx2_data_rate_index",
+	"tx_ack.downlink_message.settings.request.rx2_frequency",
+	"tx_ack.downlink_message.settings.scheduled",
+	"tx_ack.downlink_message.settings.scheduled.coding_rate",
+	"tx_ack.downlink_message.settings.scheduled.data_rate",
+	"tx_ack.downlink_message.settings.scheduled.data_rate.modulation",
+	"tx_ack.downlink_message.settings.scheduled.data_rate.modulation.fsk",
+	"tx_ack.downlink_message.settings.scheduled.data_rate.modulation.fsk.bit_rate",
+	"tx_ack.downlink_message.settings.scheduled.data_rate.modulation.lora",
+	"tx_ack.downlink_message.settings.scheduled.data_rate.modulation.lora.bandwidth",
+	"tx_ack.downlink_message.settings.scheduled.data_rate.modulation.lora.spreading_factor",
+	"tx_ack.downlink_message.settings.scheduled.data_rate_index",
+	"tx_ack.downlink_message.settings.scheduled.downlink",
+	"tx_ack.downlink_message.settings.scheduled.downlink.antenna_index",
+	"tx_ack.downlink_message.settings.scheduled.downlink.invert_polarization",
+	"tx_ack.downlink_message.settings.scheduled.downlink.tx_power",
+	"tx_ack.downlink_message.settings.scheduled.enable_crc",
+	"tx_ack.downlink_message.settings.scheduled.frequency",
+	"tx_ack.downlink_message.settings.scheduled.time",
+	"tx_ack.downlink_message.settings.scheduled.timestamp",
+	"tx_ack.result",
+}
+
+var GatewayTxAcknowledgmentFieldPathsTopLevel = []string{
+	"gateway_ids",
+	"tx_ack",
+}
 var GatewayUplinkMessageFieldPathsNested = []string{
 	"band_id",
 	"message",
@@ -266,6 +450,7 @@ var ApplicationUplinkFieldPathsNested = []string{
 	"app_s_key.kek_label",
 	"app_s_key.key",
 	"decoded_payload",
+	"decoded_payload_warnings",
 	"f_cnt",
 	"f_port",
 	"frm_payload",
@@ -296,6 +481,7 @@ var ApplicationUplinkFieldPathsNested = []string{
 var ApplicationUplinkFieldPathsTopLevel = []string{
 	"app_s_key",
 	"decoded_payload",
+	"decoded_payload_warnings",
 	"f_cnt",
 	"f_port",
 	"frm_payload",
@@ -346,6 +532,7 @@ var ApplicationDownlinkFieldPathsNested = []string{
 	"confirmed",
 	"correlation_ids",
 	"decoded_payload",
+	"decoded_payload_warnings",
 	"f_cnt",
 	"f_port",
 	"frm_payload",
@@ -358,6 +545,7 @@ var ApplicationDownlinkFieldPathsTopLevel = []string{
 	"confirmed",
 	"correlation_ids",
 	"decoded_payload",
+	"decoded_payload_warnings",
 	"f_cnt",
 	"f_port",
 	"frm_payload",
@@ -379,6 +567,7 @@ var ApplicationDownlinkFailedFieldPathsNested = []string{
 	"downlink.confirmed",
 	"downlink.correlation_ids",
 	"downlink.decoded_payload",
+	"downlink.decoded_payload_warnings",
 	"downlink.f_cnt",
 	"downlink.f_port",
 	"downlink.frm_payload",
@@ -413,6 +602,17 @@ var ApplicationInvalidatedDownlinksFieldPathsTopLevel = []string{
 	"downlinks",
 	"last_f_cnt_down",
 }
+var ApplicationSessionSwitchFieldPathsNested = []string{
+	"dev_addr",
+	"session_key_id",
+	"switched_at",
+}
+
+var ApplicationSessionSwitchFieldPathsTopLevel = []string{
+	"dev_addr",
+	"session_key_id",
+	"switched_at",
+}
 var ApplicationUpFieldPathsNested = []string{
 	"correlation_ids",
 	"end_device_ids",
@@ -431,6 +631,7 @@ var ApplicationUpFieldPathsNested = []string{
 	"up.downlink_ack.confirmed",
 	"up.downlink_ack.correlation_ids",
 	"up.downlink_ack.decoded_payload",
+	"up.downlink_ack.decoded_payload_warnings",
 	"up.downlink_ack.f_cnt",
 	"up.downlink_ack.f_port",
 	"up.downlink_ack.frm_payload",
@@ -444,6 +645,7 @@ var ApplicationUpFieldPathsNested = []string{
 	"up.downlink_failed.downlink.confirmed",
 	"up.downlink_failed.downlink.correlation_ids",
 	"up.downlink_failed.downlink.decoded_payload",
+	"up.downlink_failed.downlink.decoded_payload_warnings",
 	"up.downlink_failed.downlink.f_cnt",
 	"up.downlink_failed.downlink.f_port",
 	"up.downlink_failed.downlink.frm_payload",
@@ -470,6 +672,7 @@ var ApplicationUpFieldPathsNested = []string{
 	"up.downlink_nack.confirmed",
 	"up.downlink_nack.correlation_ids",
 	"up.downlink_nack.decoded_payload",
+	"up.downlink_nack.decoded_payload_warnings",
 	"up.downlink_nack.f_cnt",
 	"up.downlink_nack.f_port",
 	"up.downlink_nack.frm_payload",
@@ -485,6 +688,7 @@ var ApplicationUpFieldPathsNested = []string{
 	"up.downlink_queued.confirmed",
 	"up.downlink_queued.correlation_ids",
 	"up.downlink_queued.decoded_payload",
+	"up.downlink_queued.decoded_payload_warnings",
 	"up.downlink_queued.f_cnt",
 	"up.downlink_queued.f_port",
 	"up.downlink_queued.frm_payload",
@@ -497,6 +701,7 @@ var ApplicationUpFieldPathsNested = []string{
 	"up.downlink_sent.confirmed",
 	"up.downlink_sent.correlation_ids",
 	"up.downlink_sent.decoded_payload",
+	"up.downlink_sent.decoded_payload_warnings",
 	"up.downlink_sent.f_cnt",
 	"up.downlink_sent.f_port",
 	"up.downlink_sent.frm_payload",
@@ -520,12 +725,17 @@ var ApplicationUpFieldPathsNested = []string{
 	"up.location_solved.location.longitude",
 	"up.location_solved.location.source",
 	"up.location_solved.service",
+	"up.session_switch",
+	"up.session_switch.dev_addr",
+	"up.session_switch.session_key_id",
+	"up.session_switch.switched_at",
 	"up.uplink_message",
 	"up.uplink_message.app_s_key",
 	"up.uplink_message.app_s_key.encrypted_key",
 	"up.uplink_message.app_s_key.kek_label",
 	"up.uplink_message.app_s_key.key",
 	"up.uplink_message.decoded_payload",
+	"up.uplink_message.decoded_payload_warnings",
 	"up.uplink_message.f_cnt",
 	"up.uplink_message.f_port",
 	"up.uplink_message.frm_payload",
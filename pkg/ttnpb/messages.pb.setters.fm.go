@@ -5,6 +5,8 @@ package ttnpb
 import (
 	fmt "fmt"
 	time "time"
+
+	go_thethings_network_lorawan_stack_pkg_types "go.thethings.network/lorawan-stack/pkg/types"
 )
 
 func (dst *UplinkMessage) SetFields(src *UplinkMessage, paths ...string) error {
@@ -296,6 +298,85 @@ func (dst *TxAcknowledgment) SetFields(src *TxAcknowledgment, paths ...string) e
 				var zero TxAcknowledgment_Result
 				dst.Result = zero
 			}
+		case "downlink_message":
+			if len(subs) > 0 {
+				var newDst, newSrc *DownlinkMessage
+				if (src == nil || src.DownlinkMessage == nil) && dst.DownlinkMessage == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.DownlinkMessage
+				}
+				if dst.DownlinkMessage != nil {
+					newDst = dst.DownlinkMessage
+				} else {
+					newDst = &DownlinkMessage{}
+					dst.DownlinkMessage = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.DownlinkMessage = src.DownlinkMessage
+				} else {
+					dst.DownlinkMessage = nil
+				}
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *GatewayTxAcknowledgment) SetFields(src *GatewayTxAcknowledgment, paths ...string) error {
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		switch name {
+		case "gateway_ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *GatewayIdentifiers
+				if src != nil {
+					newSrc = &src.GatewayIdentifiers
+				}
+				newDst = &dst.GatewayIdentifiers
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.GatewayIdentifiers = src.GatewayIdentifiers
+				} else {
+					var zero GatewayIdentifiers
+					dst.GatewayIdentifiers = zero
+				}
+			}
+		case "tx_ack":
+			if len(subs) > 0 {
+				var newDst, newSrc *TxAcknowledgment
+				if (src == nil || src.TxAck == nil) && dst.TxAck == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.TxAck
+				}
+				if dst.TxAck != nil {
+					newDst = dst.TxAck
+				} else {
+					newDst = &TxAcknowledgment{}
+					dst.TxAck = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.TxAck = src.TxAck
+				} else {
+					dst.TxAck = nil
+				}
+			}
 
 		default:
 			return fmt.Errorf("invalid field: '%s'", name)
@@ -472,6 +553,15 @@ func (dst *ApplicationUplink) SetFields(src *ApplicationUplink, paths ...string)
 				var zero uint32
 				dst.LastAFCntDown = zero
 			}
+		case "decoded_payload_warnings":
+			if len(subs) > 0 {
+				return fmt.Errorf("'decoded_payload_warnings' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.DecodedPayloadWarnings = src.DecodedPayloadWarnings
+			} else {
+				dst.DecodedPayloadWarnings = nil
+			}
 
 		default:
 			return fmt.Errorf("invalid field: '%s'", name)
@@ -706,6 +796,15 @@ func (dst *ApplicationDownlink) SetFields(src *ApplicationDownlink, paths ...str
 			} else {
 				dst.CorrelationIDs = nil
 			}
+		case "decoded_payload_warnings":
+			if len(subs) > 0 {
+				return fmt.Errorf("'decoded_payload_warnings' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.DecodedPayloadWarnings = src.DecodedPayloadWarnings
+			} else {
+				dst.DecodedPayloadWarnings = nil
+			}
 
 		default:
 			return fmt.Errorf("invalid field: '%s'", name)
@@ -811,6 +910,46 @@ func (dst *ApplicationInvalidatedDownlinks) SetFields(src *ApplicationInvalidate
 	return nil
 }
 
+func (dst *ApplicationSessionSwitch) SetFields(src *ApplicationSessionSwitch, paths ...string) error {
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		switch name {
+		case "session_key_id":
+			if len(subs) > 0 {
+				return fmt.Errorf("'session_key_id' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.SessionKeyID = src.SessionKeyID
+			} else {
+				dst.SessionKeyID = nil
+			}
+		case "dev_addr":
+			if len(subs) > 0 {
+				return fmt.Errorf("'dev_addr' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.DevAddr = src.DevAddr
+			} else {
+				var zero go_thethings_network_lorawan_stack_pkg_types.DevAddr
+				dst.DevAddr = zero
+			}
+		case "switched_at":
+			if len(subs) > 0 {
+				return fmt.Errorf("'switched_at' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.SwitchedAt = src.SwitchedAt
+			} else {
+				var zero time.Time
+				dst.SwitchedAt = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
 func (dst *ApplicationUp) SetFields(src *ApplicationUp, paths ...string) error {
 	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
 		switch name {
@@ -1163,6 +1302,39 @@ func (dst *ApplicationUp) SetFields(src *ApplicationUp, paths ...string) error {
 							dst.Up = nil
 						}
 					}
+				case "session_switch":
+					_, srcOk := src.Up.(*ApplicationUp_SessionSwitch)
+					if !srcOk && src.Up != nil {
+						return fmt.Errorf("attempt to set oneof 'session_switch', while different oneof is set in source")
+					}
+					_, dstOk := dst.Up.(*ApplicationUp_SessionSwitch)
+					if !dstOk && dst.Up != nil {
+						return fmt.Errorf("attempt to set oneof 'session_switch', while different oneof is set in destination")
+					}
+					if len(oneofSubs) > 0 {
+						var newDst, newSrc *ApplicationSessionSwitch
+						if !srcOk && !dstOk {
+							continue
+						}
+						if srcOk {
+							newSrc = src.Up.(*ApplicationUp_SessionSwitch).SessionSwitch
+						}
+						if dstOk {
+							newDst = dst.Up.(*ApplicationUp_SessionSwitch).SessionSwitch
+						} else {
+							newDst = &ApplicationSessionSwitch{}
+							dst.Up = &ApplicationUp_SessionSwitch{SessionSwitch: newDst}
+						}
+						if err := newDst.SetFields(newSrc, oneofSubs...); err != nil {
+							return err
+						}
+					} else {
+						if src != nil {
+							dst.Up = src.Up
+						} else {
+							dst.Up = nil
+						}
+					}
 
 				default:
 					return fmt.Errorf("invalid oneof field: '%s.%s'", name, oneofName)
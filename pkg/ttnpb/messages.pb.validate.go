@@ -398,6 +398,18 @@ func (m *TxAcknowledgment) ValidateFields(paths ...string) error {
 				}
 			}
 
+		case "downlink_message":
+
+			if v, ok := interface{}(m.GetDownlinkMessage()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return TxAcknowledgmentValidationError{
+						field:  "downlink_message",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
 		default:
 			return TxAcknowledgmentValidationError{
 				field:  name,
@@ -462,6 +474,118 @@ var _ interface {
 	ErrorName() string
 } = TxAcknowledgmentValidationError{}
 
+// ValidateFields checks the field values on GatewayTxAcknowledgment with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *GatewayTxAcknowledgment) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = GatewayTxAcknowledgmentFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "gateway_ids":
+
+			if v, ok := interface{}(&m.GatewayIdentifiers).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GatewayTxAcknowledgmentValidationError{
+						field:  "gateway_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "tx_ack":
+
+			if m.TxAck == nil {
+				return GatewayTxAcknowledgmentValidationError{
+					field:  "tx_ack",
+					reason: "value is required",
+				}
+			}
+
+			if v, ok := interface{}(m.GetTxAck()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GatewayTxAcknowledgmentValidationError{
+						field:  "tx_ack",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		default:
+			return GatewayTxAcknowledgmentValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// GatewayTxAcknowledgmentValidationError is the validation error returned by
+// GatewayTxAcknowledgment.ValidateFields if the designated constraints aren't met.
+type GatewayTxAcknowledgmentValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GatewayTxAcknowledgmentValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GatewayTxAcknowledgmentValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GatewayTxAcknowledgmentValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GatewayTxAcknowledgmentValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GatewayTxAcknowledgmentValidationError) ErrorName() string {
+	return "GatewayTxAcknowledgmentValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GatewayTxAcknowledgmentValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGatewayTxAcknowledgment.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GatewayTxAcknowledgmentValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GatewayTxAcknowledgmentValidationError{}
+
 // ValidateFields checks the field values on GatewayUplinkMessage with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
@@ -682,6 +806,8 @@ func (m *ApplicationUplink) ValidateFields(paths ...string) error {
 
 		case "last_a_f_cnt_down":
 			// no validation rules for LastAFCntDown
+		case "decoded_payload_warnings":
+
 		default:
 			return ApplicationUplinkValidationError{
 				field:  name,
@@ -1097,6 +1223,8 @@ func (m *ApplicationDownlink) ValidateFields(paths ...string) error {
 
 			}
 
+		case "decoded_payload_warnings":
+
 		default:
 			return ApplicationDownlinkValidationError{
 				field:  name,
@@ -1479,6 +1607,111 @@ var _ interface {
 	ErrorName() string
 } = ApplicationInvalidatedDownlinksValidationError{}
 
+// ValidateFields checks the field values on ApplicationSessionSwitch with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ApplicationSessionSwitch) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = ApplicationSessionSwitchFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "session_key_id":
+
+			if len(m.GetSessionKeyID()) > 2048 {
+				return ApplicationSessionSwitchValidationError{
+					field:  "session_key_id",
+					reason: "value length must be at most 2048 bytes",
+				}
+			}
+
+		case "dev_addr":
+			// no validation rules for DevAddr
+		case "switched_at":
+
+			if v, ok := interface{}(&m.SwitchedAt).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ApplicationSessionSwitchValidationError{
+						field:  "switched_at",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		default:
+			return ApplicationSessionSwitchValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// ApplicationSessionSwitchValidationError is the validation error returned by
+// ApplicationSessionSwitch.ValidateFields if the designated constraints
+// aren't met.
+type ApplicationSessionSwitchValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ApplicationSessionSwitchValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ApplicationSessionSwitchValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ApplicationSessionSwitchValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ApplicationSessionSwitchValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ApplicationSessionSwitchValidationError) ErrorName() string {
+	return "ApplicationSessionSwitchValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ApplicationSessionSwitchValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sApplicationSessionSwitch.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ApplicationSessionSwitchValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ApplicationSessionSwitchValidationError{}
+
 // ValidateFields checks the field values on ApplicationUp with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
@@ -1541,7 +1774,7 @@ func (m *ApplicationUp) ValidateFields(paths ...string) error {
 			}
 			if len(subs) == 0 {
 				subs = []string{
-					"uplink_message", "join_accept", "downlink_ack", "downlink_nack", "downlink_sent", "downlink_failed", "downlink_queued", "downlink_queue_invalidated", "location_solved",
+					"uplink_message", "join_accept", "downlink_ack", "downlink_nack", "downlink_sent", "downlink_failed", "downlink_queued", "downlink_queue_invalidated", "location_solved", "session_switch",
 				}
 			}
 			for name, subs := range _processPaths(subs) {
@@ -1691,6 +1924,22 @@ func (m *ApplicationUp) ValidateFields(paths ...string) error {
 						}
 					}
 
+				case "session_switch":
+					w, ok := m.Up.(*ApplicationUp_SessionSwitch)
+					if !ok || w == nil {
+						continue
+					}
+
+					if v, ok := interface{}(m.GetSessionSwitch()).(interface{ ValidateFields(...string) error }); ok {
+						if err := v.ValidateFields(subs...); err != nil {
+							return ApplicationUpValidationError{
+								field:  "session_switch",
+								reason: "embedded message failed validation",
+								cause:  err,
+							}
+						}
+					}
+
 				}
 			}
 		default:
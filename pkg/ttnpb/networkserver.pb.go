@@ -86,52 +86,70 @@ func init() {
 }
 
 var fileDescriptor_c77e7504ad1081b8 = []byte{
-	// 712 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0x4f, 0x48, 0x1b, 0x4f,
-	0x14, 0x9e, 0x89, 0xe2, 0xef, 0xc7, 0x20, 0x4a, 0xa7, 0xd2, 0x3f, 0x69, 0x3b, 0x95, 0x68, 0xa9,
-	0x48, 0x9d, 0x2d, 0xb1, 0x87, 0xe2, 0x4d, 0x49, 0x88, 0x05, 0x23, 0x55, 0xeb, 0xc5, 0x4b, 0xd8,
-	0x64, 0x9f, 0x9b, 0x25, 0x71, 0x76, 0xbb, 0x33, 0x89, 0x48, 0x11, 0xa4, 0x87, 0xe2, 0xb1, 0x50,
-	0x0a, 0x3d, 0x96, 0x9e, 0x3c, 0x4a, 0x2f, 0xf5, 0x54, 0x3c, 0x7a, 0x14, 0x7a, 0x91, 0x1e, 0xc4,
-	0xec, 0xf6, 0x20, 0xf4, 0xe2, 0xd1, 0x63, 0xc9, 0x26, 0x31, 0x26, 0x6b, 0xc4, 0xfe, 0xb9, 0xcd,
-	0xe4, 0x7d, 0xef, 0x7d, 0xdf, 0xfb, 0xe6, 0xcb, 0x92, 0x07, 0x45, 0xdb, 0xd5, 0x57, 0x75, 0x31,
-	0x26, 0x95, 0x9e, 0x2b, 0x68, 0xba, 0x63, 0x69, 0x02, 0xd4, 0xaa, 0xed, 0x16, 0x24, 0xb8, 0x65,
-	0x70, 0xb9, 0xe3, 0xda, 0xca, 0xa6, 0x7d, 0x4a, 0x09, 0x5e, 0x87, 0xf2, 0xf2, 0x78, 0x74, 0xcc,
-	0xb4, 0x54, 0xbe, 0x94, 0xe5, 0x39, 0x7b, 0x45, 0x33, 0x6d, 0xd3, 0xd6, 0x02, 0x58, 0xb6, 0xb4,
-	0x1c, 0xdc, 0x82, 0x4b, 0x70, 0xaa, 0xb5, 0x47, 0xef, 0x9a, 0xb6, 0x6d, 0x16, 0x21, 0x18, 0xaf,
-	0x0b, 0x61, 0x2b, 0x5d, 0x59, 0xb6, 0x90, 0xf5, 0xea, 0x9d, 0x7a, 0xf5, 0x6c, 0x06, 0xac, 0x38,
-	0x6a, 0xad, 0x5e, 0x8c, 0x85, 0x05, 0x82, 0x30, 0x32, 0x06, 0x94, 0xad, 0x1c, 0xd4, 0x31, 0x43,
-	0x61, 0x8c, 0x65, 0x80, 0x50, 0xd6, 0xb2, 0x05, 0x6e, 0x83, 0x65, 0x30, 0x0c, 0x5a, 0x01, 0x29,
-	0x75, 0x13, 0xea, 0x88, 0x98, 0x20, 0x37, 0x53, 0x20, 0xc0, 0xd5, 0x15, 0x24, 0xa0, 0x3c, 0x69,
-	0x18, 0xee, 0x3c, 0x48, 0xc7, 0x16, 0x12, 0xe8, 0x02, 0xf9, 0xdf, 0x80, 0x72, 0x46, 0x37, 0x0c,
-	0xf7, 0x16, 0x1e, 0xc4, 0x23, 0xbd, 0x53, 0x4f, 0xbf, 0x1f, 0xde, 0x7f, 0x62, 0xda, 0x5c, 0xe5,
-	0x41, 0xe5, 0x2d, 0x61, 0x4a, 0x5e, 0xf7, 0x4d, 0x6b, 0xe5, 0x71, 0x0a, 0xa6, 0xa6, 0xd6, 0x1c,
-	0x90, 0xbc, 0x31, 0xf3, 0x3f, 0xa3, 0x76, 0x88, 0x0b, 0x12, 0x99, 0x95, 0x34, 0x4f, 0xfa, 0xdb,
-	0x58, 0xe9, 0x0d, 0x5e, 0x73, 0x84, 0x37, 0x1c, 0xe1, 0xc9, 0xaa, 0x23, 0xd1, 0x87, 0xbc, 0xf5,
-	0x19, 0x78, 0x07, 0xb9, 0xb1, 0x81, 0xd7, 0xdf, 0x7e, 0xbc, 0x8b, 0xf4, 0xd1, 0x5e, 0x4d, 0x48,
-	0xad, 0x21, 0x3c, 0x7e, 0x18, 0x21, 0xdd, 0x93, 0x72, 0x56, 0xd2, 0x19, 0xd2, 0x3f, 0x63, 0x89,
-	0xc2, 0xa4, 0xe3, 0x14, 0xad, 0x5c, 0xf0, 0x14, 0x1d, 0x29, 0xef, 0xb5, 0x53, 0x9e, 0x6b, 0x5a,
-	0x74, 0x46, 0xf0, 0x63, 0x4c, 0x5f, 0x90, 0x81, 0x84, 0xbd, 0x2a, 0x8a, 0x96, 0x28, 0xcc, 0x95,
-	0xa0, 0x04, 0xf3, 0xe0, 0x14, 0xf5, 0x1c, 0xd0, 0xe1, 0xf6, 0xd6, 0x36, 0xd4, 0xcb, 0x12, 0x48,
-	0x15, 0xed, 0x40, 0x4c, 0xe7, 0xc8, 0xb5, 0x16, 0xfc, 0xf3, 0x92, 0xcc, 0xff, 0xe5, 0xc8, 0x4c,
-	0xdb, 0xc8, 0x19, 0x4b, 0xaa, 0xf0, 0xc8, 0xa4, 0x30, 0x12, 0x41, 0xb8, 0x9e, 0x35, 0x23, 0x14,
-	0x1d, 0xbe, 0xc4, 0x86, 0xc6, 0x4c, 0x19, 0x4f, 0x93, 0xee, 0x54, 0xd5, 0xdf, 0x24, 0xe9, 0x9d,
-	0xd6, 0x85, 0x51, 0x84, 0x45, 0xa7, 0x5a, 0xa0, 0x21, 0x13, 0x6b, 0xbf, 0xa7, 0x6b, 0xf1, 0xeb,
-	0xa4, 0x37, 0xfe, 0xb3, 0x9b, 0x5c, 0x9f, 0x95, 0x67, 0x7a, 0xe6, 0xc1, 0xb4, 0xa4, 0x72, 0xd7,
-	0xe8, 0x67, 0x4c, 0xba, 0x52, 0xa0, 0xe8, 0x50, 0x38, 0x0e, 0xea, 0x1c, 0xba, 0x66, 0xc6, 0xed,
-	0x8e, 0xfb, 0xc5, 0x0a, 0x41, 0x4a, 0x80, 0xe6, 0xaa, 0x29, 0xd1, 0x9b, 0x0b, 0x49, 0xed, 0x55,
-	0xf3, 0x2f, 0x96, 0xb1, 0x0c, 0xc9, 0xcf, 0x15, 0x2f, 0xb8, 0xaf, 0x6b, 0x35, 0x68, 0xb8, 0xef,
-	0xec, 0xb8, 0x4e, 0xdf, 0x44, 0x48, 0xd7, 0xc2, 0x45, 0xa2, 0x17, 0x7e, 0x4f, 0xf4, 0x57, 0x1c,
-	0xa8, 0xfe, 0x82, 0xa3, 0x97, 0xca, 0xe6, 0x7f, 0x28, 0x9b, 0xb7, 0xca, 0x9e, 0xc0, 0xa3, 0x4b,
-	0xe9, 0xd8, 0xf4, 0xbf, 0x62, 0x9a, 0xc0, 0xa3, 0xf4, 0x3d, 0x26, 0x3d, 0x09, 0x28, 0x82, 0x82,
-	0x2b, 0x66, 0xaf, 0x43, 0x3c, 0x62, 0xe9, 0xc0, 0x88, 0xd4, 0x68, 0x32, 0xac, 0xee, 0xca, 0x8b,
-	0x37, 0x37, 0x9d, 0xfa, 0x84, 0xf7, 0x2a, 0x0c, 0xef, 0x57, 0x18, 0x3e, 0xa8, 0x30, 0x74, 0x54,
-	0x61, 0xe8, 0xb8, 0xc2, 0xd0, 0x49, 0x85, 0xa1, 0xd3, 0x0a, 0xc3, 0x1b, 0x1e, 0xc3, 0x9b, 0x1e,
-	0x43, 0x5b, 0x1e, 0xc3, 0xdb, 0x1e, 0x43, 0x3b, 0x1e, 0x43, 0xbb, 0x1e, 0x43, 0x7b, 0x1e, 0xc3,
-	0xfb, 0x1e, 0xc3, 0x07, 0x1e, 0x43, 0x47, 0x1e, 0xc3, 0xc7, 0x1e, 0x43, 0x27, 0x1e, 0xc3, 0xa7,
-	0x1e, 0x43, 0x1b, 0x3e, 0x43, 0x9b, 0x3e, 0xc3, 0x6f, 0x7d, 0x86, 0x3e, 0xf8, 0x0c, 0x7f, 0xf4,
-	0x19, 0xda, 0xf2, 0x19, 0xda, 0xf6, 0x19, 0xde, 0xf1, 0x19, 0xde, 0xf5, 0x19, 0x5e, 0x7a, 0x74,
-	0xd5, 0x6f, 0xa8, 0x12, 0x4e, 0x36, 0xdb, 0x13, 0x78, 0x30, 0xfe, 0x2b, 0x00, 0x00, 0xff, 0xff,
-	0xd0, 0x32, 0x2f, 0xe5, 0xb7, 0x06, 0x00, 0x00,
+	// 1004 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0x4d, 0x6c, 0x1b, 0x45,
+	0x14, 0xde, 0x49, 0xab, 0x50, 0x86, 0xa8, 0x15, 0x43, 0x55, 0xa8, 0x0b, 0x43, 0xd8, 0xb6, 0x4a,
+	0x31, 0xed, 0x2e, 0x72, 0x39, 0xa0, 0xdc, 0x12, 0x12, 0x1c, 0x44, 0x12, 0x35, 0x76, 0x7b, 0xc0,
+	0x17, 0x6b, 0xed, 0x7d, 0x59, 0xaf, 0xbc, 0x99, 0x5d, 0x76, 0xc6, 0x36, 0x11, 0xb2, 0x54, 0x71,
+	0x80, 0x8a, 0x53, 0x11, 0x42, 0x70, 0x44, 0x9c, 0x22, 0x21, 0xa1, 0x8a, 0x4b, 0x2b, 0x21, 0xa1,
+	0x1e, 0x7b, 0xac, 0xc4, 0xa5, 0xe2, 0x50, 0xd5, 0x6b, 0x24, 0x7a, 0x41, 0xea, 0xb1, 0x47, 0xb4,
+	0x7f, 0xb6, 0xb3, 0xeb, 0x4d, 0x9c, 0x84, 0x03, 0xb7, 0x19, 0xbf, 0x6f, 0xde, 0xfb, 0xde, 0xf7,
+	0x3d, 0x3f, 0x1b, 0x5f, 0xb4, 0x6c, 0x57, 0xeb, 0x68, 0xec, 0x0a, 0x17, 0x5a, 0xbd, 0xa9, 0x6a,
+	0x8e, 0xa9, 0x32, 0x10, 0x1d, 0xdb, 0x6d, 0x72, 0x70, 0xdb, 0xe0, 0x2a, 0x8e, 0x6b, 0x0b, 0x9b,
+	0x9c, 0x14, 0x82, 0x29, 0x11, 0x54, 0x69, 0x5f, 0xcd, 0x5d, 0x31, 0x4c, 0xd1, 0x68, 0xd5, 0x94,
+	0xba, 0xbd, 0xa5, 0x1a, 0xb6, 0x61, 0xab, 0x01, 0xac, 0xd6, 0xda, 0x0c, 0x6e, 0xc1, 0x25, 0x38,
+	0x85, 0xcf, 0x73, 0xaf, 0x1b, 0xb6, 0x6d, 0x58, 0x10, 0xa4, 0xd7, 0x18, 0xb3, 0x85, 0x26, 0x4c,
+	0x9b, 0xf1, 0x28, 0x7a, 0x2e, 0x8a, 0x0e, 0x72, 0xc0, 0x96, 0x23, 0xb6, 0xa3, 0xa0, 0x9c, 0x26,
+	0x08, 0x4c, 0xaf, 0xea, 0xd0, 0x36, 0xeb, 0x10, 0x61, 0xce, 0xa7, 0x31, 0xa6, 0x0e, 0x4c, 0x98,
+	0x9b, 0x26, 0xb8, 0x71, 0x95, 0xd9, 0x34, 0x68, 0x0b, 0x38, 0xd7, 0x0c, 0x88, 0x10, 0x32, 0xc3,
+	0xaf, 0x16, 0x81, 0x81, 0xab, 0x09, 0x58, 0x82, 0xf6, 0x82, 0xae, 0xbb, 0x25, 0xe0, 0x8e, 0xcd,
+	0x38, 0x90, 0x32, 0x3e, 0xa1, 0x43, 0xbb, 0xaa, 0xe9, 0xba, 0xfb, 0x1a, 0x9a, 0x45, 0x97, 0x66,
+	0x16, 0xdf, 0xff, 0xf3, 0xf1, 0x9b, 0xef, 0x19, 0xb6, 0x22, 0x1a, 0x20, 0x1a, 0x26, 0x33, 0xb8,
+	0x12, 0xe9, 0xa6, 0xee, 0xae, 0xe3, 0x34, 0x0d, 0x55, 0x6c, 0x3b, 0xc0, 0x95, 0x38, 0xe7, 0x0b,
+	0x7a, 0x78, 0x28, 0x30, 0x3c, 0xb5, 0xce, 0x49, 0x03, 0x9f, 0x4a, 0x54, 0x25, 0x67, 0x94, 0x50,
+	0x11, 0x25, 0x56, 0x44, 0x59, 0xf6, 0x15, 0xc9, 0xcd, 0x29, 0xbb, 0x6d, 0x50, 0x32, 0xe8, 0xca,
+	0xa7, 0xbf, 0xf8, 0xe3, 0xaf, 0x6f, 0xa7, 0x4e, 0x92, 0x19, 0x95, 0x71, 0x35, 0x26, 0x5e, 0x78,
+	0x3c, 0x85, 0x8f, 0x2f, 0xf0, 0x75, 0x4e, 0x56, 0xf1, 0xa9, 0x55, 0x93, 0x35, 0x17, 0x1c, 0xc7,
+	0x32, 0xeb, 0x81, 0x15, 0x99, 0x25, 0xdf, 0x48, 0x96, 0x1c, 0x79, 0x74, 0xc3, 0xb9, 0x84, 0xde,
+	0x45, 0xe4, 0x3a, 0x3e, 0xbd, 0x64, 0x77, 0x98, 0x65, 0xb2, 0xe6, 0x46, 0x0b, 0x5a, 0x50, 0x02,
+	0xc7, 0xd2, 0xea, 0x40, 0x2e, 0x24, 0x9f, 0x26, 0x50, 0x9f, 0xb6, 0x80, 0x8b, 0x5c, 0x46, 0x61,
+	0xb2, 0x81, 0x5f, 0xde, 0x85, 0xbf, 0xd6, 0xe2, 0x8d, 0x23, 0xa6, 0xac, 0x26, 0x52, 0xae, 0x9a,
+	0x5c, 0xa4, 0x53, 0x2e, 0x33, 0x7d, 0x29, 0x18, 0xae, 0x8f, 0x86, 0x23, 0x94, 0xbb, 0xb0, 0x87,
+	0x0c, 0x71, 0x4e, 0x5e, 0xd8, 0x41, 0xf8, 0x78, 0xd1, 0x17, 0x78, 0x19, 0xcf, 0xac, 0x68, 0x4c,
+	0xb7, 0xe0, 0x86, 0xe3, 0x47, 0x48, 0x4a, 0xc5, 0xf0, 0xf3, 0xb5, 0x70, 0xfe, 0x32, 0x09, 0x7f,
+	0x82, 0xcf, 0x94, 0xc0, 0xb1, 0x5d, 0x71, 0xfd, 0xb3, 0x85, 0x7a, 0x93, 0xd9, 0x1d, 0x0b, 0x74,
+	0x63, 0x0b, 0x98, 0x20, 0xe9, 0x49, 0xd0, 0x04, 0x74, 0xb4, 0xed, 0x24, 0x30, 0x2b, 0x75, 0xe1,
+	0xab, 0x17, 0xf1, 0x2b, 0xeb, 0x7c, 0xd0, 0x6b, 0x09, 0x0c, 0x93, 0x0b, 0x77, 0x9b, 0xfc, 0x8a,
+	0xf0, 0xb1, 0x22, 0x08, 0x72, 0x3e, 0x3d, 0x6a, 0x62, 0x04, 0x1d, 0x0a, 0x7d, 0x36, 0x53, 0x3b,
+	0xb9, 0x19, 0x4c, 0x20, 0x90, 0xba, 0x3f, 0x81, 0xda, 0x50, 0x2c, 0xae, 0x7e, 0x3e, 0xfc, 0xfa,
+	0x56, 0x4d, 0x9d, 0x2b, 0x23, 0xc1, 0x31, 0xf7, 0xae, 0x1a, 0x42, 0xd3, 0xef, 0x06, 0xc7, 0x2e,
+	0xf9, 0x72, 0x0a, 0x1f, 0x2b, 0x8f, 0x23, 0x5d, 0x3e, 0x18, 0xe9, 0xdf, 0x51, 0xc0, 0xfa, 0x2e,
+	0xca, 0xed, 0x49, 0x5b, 0x39, 0x24, 0x6d, 0x65, 0x37, 0xed, 0x79, 0x94, 0xaf, 0xac, 0xc9, 0x2b,
+	0xff, 0x55, 0xa5, 0x79, 0x94, 0x27, 0xdf, 0x21, 0x3c, 0xbd, 0x04, 0x16, 0x08, 0x98, 0x70, 0xae,
+	0x33, 0xc6, 0x43, 0x5e, 0x0b, 0x84, 0x28, 0xe6, 0x97, 0xd3, 0xec, 0x26, 0x6e, 0x7c, 0xc4, 0xa0,
+	0x5f, 0x10, 0x3e, 0xb1, 0xa8, 0x89, 0x7a, 0xc3, 0x1f, 0xad, 0xb7, 0x93, 0xcc, 0xe2, 0xc8, 0x80,
+	0x21, 0x8f, 0xbd, 0x9a, 0x1b, 0x0b, 0x1d, 0xc5, 0x45, 0x0b, 0x6f, 0x3d, 0xe0, 0xbb, 0x22, 0x7f,
+	0x70, 0x78, 0xbe, 0x35, 0x3f, 0xb5, 0x6a, 0x80, 0xf0, 0x85, 0x1c, 0x10, 0x2e, 0x67, 0x12, 0x2e,
+	0xff, 0x3f, 0x08, 0xf3, 0x90, 0xf0, 0x5d, 0x84, 0x5f, 0x0a, 0x6a, 0x45, 0xf6, 0x5f, 0x1e, 0x4b,
+	0x24, 0x0c, 0x1e, 0x81, 0xf6, 0x46, 0x40, 0xfb, 0x63, 0xf9, 0xc3, 0xa3, 0xd2, 0xd6, 0x03, 0x26,
+	0xf3, 0x28, 0x5f, 0xf0, 0xa6, 0x71, 0x6e, 0x64, 0x13, 0x5d, 0x73, 0xed, 0x4d, 0xd3, 0x1a, 0x2e,
+	0xa4, 0xbf, 0xa3, 0x85, 0x94, 0xdf, 0x6b, 0x21, 0x0d, 0x1e, 0x85, 0xed, 0xcc, 0x66, 0xce, 0x7e,
+	0x04, 0x94, 0xbf, 0x09, 0xbf, 0xe9, 0x5f, 0x23, 0x52, 0x4d, 0x77, 0xe2, 0x84, 0xa0, 0x89, 0xb6,
+	0x53, 0x84, 0x4d, 0xbc, 0x1a, 0x9e, 0xbb, 0x95, 0x39, 0x72, 0xd1, 0x2f, 0xb1, 0x2f, 0x90, 0xfc,
+	0x8c, 0xf0, 0xf1, 0xe0, 0x27, 0x29, 0xe5, 0x9d, 0xff, 0x69, 0xb2, 0x85, 0x81, 0x77, 0x6f, 0xed,
+	0xd7, 0x2c, 0x97, 0x4b, 0x41, 0xb3, 0xab, 0x64, 0xfe, 0xe0, 0xae, 0xc5, 0xb4, 0x2b, 0xd1, 0x9f,
+	0x89, 0xf8, 0x4e, 0xfe, 0x41, 0xe1, 0xce, 0xcd, 0xef, 0xb5, 0x73, 0x0f, 0xec, 0xcb, 0xf7, 0xa1,
+	0x2f, 0xb7, 0x51, 0x2e, 0xdb, 0x17, 0xe5, 0x50, 0xbe, 0x28, 0x09, 0xb9, 0xfd, 0xed, 0x9b, 0xcf,
+	0x8d, 0xb7, 0x66, 0x0c, 0x96, 0xfc, 0x36, 0x5c, 0xad, 0xef, 0xec, 0xd7, 0xc6, 0x24, 0x1b, 0xd6,
+	0x0a, 0x1a, 0xdd, 0xcc, 0x17, 0x0f, 0xef, 0xc9, 0xe8, 0x28, 0x75, 0x2b, 0xe7, 0xf2, 0x67, 0xb3,
+	0xe6, 0xac, 0xbb, 0xf8, 0x13, 0x7a, 0xd0, 0xa3, 0xe8, 0x61, 0x8f, 0xa2, 0x47, 0x3d, 0x2a, 0x3d,
+	0xe9, 0x51, 0xe9, 0x69, 0x8f, 0x4a, 0xcf, 0x7a, 0x54, 0x7a, 0xde, 0xa3, 0xe8, 0xa6, 0x47, 0xd1,
+	0x2d, 0x8f, 0x4a, 0x3b, 0x1e, 0x45, 0x77, 0x3c, 0x2a, 0xdd, 0xf3, 0xa8, 0x74, 0xdf, 0xa3, 0xd2,
+	0x03, 0x8f, 0xa2, 0x87, 0x1e, 0x45, 0x8f, 0x3c, 0x2a, 0x3d, 0xf1, 0x28, 0x7a, 0xea, 0x51, 0xe9,
+	0x99, 0x47, 0xd1, 0x73, 0x8f, 0x4a, 0x37, 0xfb, 0x54, 0xba, 0xd5, 0xa7, 0xe8, 0x76, 0x9f, 0x4a,
+	0x3f, 0xf4, 0x29, 0xfa, 0xb1, 0x4f, 0xa5, 0x9d, 0x3e, 0x95, 0xee, 0xf4, 0x29, 0xba, 0xd7, 0xa7,
+	0xe8, 0x7e, 0x9f, 0xa2, 0xca, 0xe5, 0x49, 0xff, 0x20, 0x0b, 0xe6, 0xd4, 0x6a, 0xd3, 0x81, 0x44,
+	0x57, 0xff, 0x1d, 0x00, 0xd6, 0x73, 0xef, 0x58, 0x94, 0x0c, 0x00, 0x00,
 }
 
 func (this *GenerateDevAddrResponse) Equal(that interface{}) bool {
@@ -463,6 +481,8 @@ var _AsNs_serviceDesc = grpc.ServiceDesc{
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type GsNsClient interface {
 	HandleUplink(ctx context.Context, in *UplinkMessage, opts ...grpc.CallOption) (*types.Empty, error)
+	// ReportTxAcknowledgment reports the transmission result of a downlink message scheduled by the Network Server.
+	ReportTxAcknowledgment(ctx context.Context, in *GatewayTxAcknowledgment, opts ...grpc.CallOption) (*types.Empty, error)
 }
 
 type gsNsClient struct {
@@ -482,9 +502,20 @@ func (c *gsNsClient) HandleUplink(ctx context.Context, in *UplinkMessage, opts .
 	return out, nil
 }
 
+func (c *gsNsClient) ReportTxAcknowledgment(ctx context.Context, in *GatewayTxAcknowledgment, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.GsNs/ReportTxAcknowledgment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // GsNsServer is the server API for GsNs service.
 type GsNsServer interface {
 	HandleUplink(context.Context, *UplinkMessage) (*types.Empty, error)
+	// ReportTxAcknowledgment reports the transmission result of a downlink message scheduled by the Network Server.
+	ReportTxAcknowledgment(context.Context, *GatewayTxAcknowledgment) (*types.Empty, error)
 }
 
 // UnimplementedGsNsServer can be embedded to have forward compatible implementations.
@@ -494,6 +525,9 @@ type UnimplementedGsNsServer struct {
 func (*UnimplementedGsNsServer) HandleUplink(ctx context.Context, req *UplinkMessage) (*types.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method HandleUplink not implemented")
 }
+func (*UnimplementedGsNsServer) ReportTxAcknowledgment(ctx context.Context, req *GatewayTxAcknowledgment) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportTxAcknowledgment not implemented")
+}
 
 func RegisterGsNsServer(s *grpc.Server, srv GsNsServer) {
 	s.RegisterService(&_GsNs_serviceDesc, srv)
@@ -517,6 +551,24 @@ func _GsNs_HandleUplink_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _GsNs_ReportTxAcknowledgment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GatewayTxAcknowledgment)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GsNsServer).ReportTxAcknowledgment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.GsNs/ReportTxAcknowledgment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GsNsServer).ReportTxAcknowledgment(ctx, req.(*GatewayTxAcknowledgment))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _GsNs_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ttn.lorawan.v3.GsNs",
 	HandlerType: (*GsNsServer)(nil),
@@ -525,6 +577,10 @@ var _GsNs_serviceDesc = grpc.ServiceDesc{
 			MethodName: "HandleUplink",
 			Handler:    _GsNs_HandleUplink_Handler,
 		},
+		{
+			MethodName: "ReportTxAcknowledgment",
+			Handler:    _GsNs_ReportTxAcknowledgment_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "lorawan-stack/api/networkserver.proto",
@@ -542,6 +598,15 @@ type NsEndDeviceRegistryClient interface {
 	// Delete deletes the device that matches the given identifiers.
 	// If there are multiple matches, an error will be returned.
 	Delete(ctx context.Context, in *EndDeviceIdentifiers, opts ...grpc.CallOption) (*types.Empty, error)
+	// BatchGet returns up to 100 devices of an application.
+	// The results contain the device or the error for each request.
+	BatchGet(ctx context.Context, in *BatchGetEndDevicesRequest, opts ...grpc.CallOption) (*BatchEndDevicesResponse, error)
+	// BatchSet creates or updates up to 100 devices of an application.
+	// The results contain the device or the error for each request.
+	BatchSet(ctx context.Context, in *BatchSetEndDevicesRequest, opts ...grpc.CallOption) (*BatchEndDevicesResponse, error)
+	// BatchDelete deletes up to 100 devices of an application.
+	// The results contain the error for each request that failed.
+	BatchDelete(ctx context.Context, in *BatchDeleteEndDevicesRequest, opts ...grpc.CallOption) (*BatchEndDevicesResponse, error)
 }
 
 type nsEndDeviceRegistryClient struct {
@@ -579,6 +644,33 @@ func (c *nsEndDeviceRegistryClient) Delete(ctx context.Context, in *EndDeviceIde
 	return out, nil
 }
 
+func (c *nsEndDeviceRegistryClient) BatchGet(ctx context.Context, in *BatchGetEndDevicesRequest, opts ...grpc.CallOption) (*BatchEndDevicesResponse, error) {
+	out := new(BatchEndDevicesResponse)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.NsEndDeviceRegistry/BatchGet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nsEndDeviceRegistryClient) BatchSet(ctx context.Context, in *BatchSetEndDevicesRequest, opts ...grpc.CallOption) (*BatchEndDevicesResponse, error) {
+	out := new(BatchEndDevicesResponse)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.NsEndDeviceRegistry/BatchSet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nsEndDeviceRegistryClient) BatchDelete(ctx context.Context, in *BatchDeleteEndDevicesRequest, opts ...grpc.CallOption) (*BatchEndDevicesResponse, error) {
+	out := new(BatchEndDevicesResponse)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.NsEndDeviceRegistry/BatchDelete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // NsEndDeviceRegistryServer is the server API for NsEndDeviceRegistry service.
 type NsEndDeviceRegistryServer interface {
 	// Get returns the device that matches the given identifiers.
@@ -589,6 +681,15 @@ type NsEndDeviceRegistryServer interface {
 	// Delete deletes the device that matches the given identifiers.
 	// If there are multiple matches, an error will be returned.
 	Delete(context.Context, *EndDeviceIdentifiers) (*types.Empty, error)
+	// BatchGet returns up to 100 devices of an application.
+	// The results contain the device or the error for each request.
+	BatchGet(context.Context, *BatchGetEndDevicesRequest) (*BatchEndDevicesResponse, error)
+	// BatchSet creates or updates up to 100 devices of an application.
+	// The results contain the device or the error for each request.
+	BatchSet(context.Context, *BatchSetEndDevicesRequest) (*BatchEndDevicesResponse, error)
+	// BatchDelete deletes up to 100 devices of an application.
+	// The results contain the error for each request that failed.
+	BatchDelete(context.Context, *BatchDeleteEndDevicesRequest) (*BatchEndDevicesResponse, error)
 }
 
 // UnimplementedNsEndDeviceRegistryServer can be embedded to have forward compatible implementations.
@@ -604,6 +705,15 @@ func (*UnimplementedNsEndDeviceRegistryServer) Set(ctx context.Context, req *Set
 func (*UnimplementedNsEndDeviceRegistryServer) Delete(ctx context.Context, req *EndDeviceIdentifiers) (*types.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
 }
+func (*UnimplementedNsEndDeviceRegistryServer) BatchGet(ctx context.Context, req *BatchGetEndDevicesRequest) (*BatchEndDevicesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchGet not implemented")
+}
+func (*UnimplementedNsEndDeviceRegistryServer) BatchSet(ctx context.Context, req *BatchSetEndDevicesRequest) (*BatchEndDevicesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchSet not implemented")
+}
+func (*UnimplementedNsEndDeviceRegistryServer) BatchDelete(ctx context.Context, req *BatchDeleteEndDevicesRequest) (*BatchEndDevicesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchDelete not implemented")
+}
 
 func RegisterNsEndDeviceRegistryServer(s *grpc.Server, srv NsEndDeviceRegistryServer) {
 	s.RegisterService(&_NsEndDeviceRegistry_serviceDesc, srv)
@@ -663,6 +773,60 @@ func _NsEndDeviceRegistry_Delete_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _NsEndDeviceRegistry_BatchGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchGetEndDevicesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NsEndDeviceRegistryServer).BatchGet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.NsEndDeviceRegistry/BatchGet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NsEndDeviceRegistryServer).BatchGet(ctx, req.(*BatchGetEndDevicesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NsEndDeviceRegistry_BatchSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchSetEndDevicesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NsEndDeviceRegistryServer).BatchSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.NsEndDeviceRegistry/BatchSet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NsEndDeviceRegistryServer).BatchSet(ctx, req.(*BatchSetEndDevicesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NsEndDeviceRegistry_BatchDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchDeleteEndDevicesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NsEndDeviceRegistryServer).BatchDelete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.NsEndDeviceRegistry/BatchDelete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NsEndDeviceRegistryServer).BatchDelete(ctx, req.(*BatchDeleteEndDevicesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _NsEndDeviceRegistry_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ttn.lorawan.v3.NsEndDeviceRegistry",
 	HandlerType: (*NsEndDeviceRegistryServer)(nil),
@@ -679,6 +843,208 @@ var _NsEndDeviceRegistry_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Delete",
 			Handler:    _NsEndDeviceRegistry_Delete_Handler,
 		},
+		{
+			MethodName: "BatchGet",
+			Handler:    _NsEndDeviceRegistry_BatchGet_Handler,
+		},
+		{
+			MethodName: "BatchSet",
+			Handler:    _NsEndDeviceRegistry_BatchSet_Handler,
+		},
+		{
+			MethodName: "BatchDelete",
+			Handler:    _NsEndDeviceRegistry_BatchDelete_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "lorawan-stack/api/networkserver.proto",
+}
+
+// NsEndDeviceProfileRegistryClient is the client API for NsEndDeviceProfileRegistry service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type NsEndDeviceProfileRegistryClient interface {
+	// Get returns the profile that matches the given identifiers.
+	Get(ctx context.Context, in *GetEndDeviceProfileRequest, opts ...grpc.CallOption) (*EndDeviceProfile, error)
+	// List returns the profiles of an application, or the global profiles.
+	List(ctx context.Context, in *ListEndDeviceProfilesRequest, opts ...grpc.CallOption) (*EndDeviceProfiles, error)
+	// Set creates or updates the profile.
+	// Updates of the profile take effect for all end devices that use the profile on their next uplink.
+	Set(ctx context.Context, in *SetEndDeviceProfileRequest, opts ...grpc.CallOption) (*EndDeviceProfile, error)
+	// Delete deletes the profile that matches the given identifiers.
+	Delete(ctx context.Context, in *EndDeviceProfileIdentifiers, opts ...grpc.CallOption) (*types.Empty, error)
+}
+
+type nsEndDeviceProfileRegistryClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewNsEndDeviceProfileRegistryClient(cc *grpc.ClientConn) NsEndDeviceProfileRegistryClient {
+	return &nsEndDeviceProfileRegistryClient{cc}
+}
+
+func (c *nsEndDeviceProfileRegistryClient) Get(ctx context.Context, in *GetEndDeviceProfileRequest, opts ...grpc.CallOption) (*EndDeviceProfile, error) {
+	out := new(EndDeviceProfile)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.NsEndDeviceProfileRegistry/Get", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nsEndDeviceProfileRegistryClient) List(ctx context.Context, in *ListEndDeviceProfilesRequest, opts ...grpc.CallOption) (*EndDeviceProfiles, error) {
+	out := new(EndDeviceProfiles)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.NsEndDeviceProfileRegistry/List", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nsEndDeviceProfileRegistryClient) Set(ctx context.Context, in *SetEndDeviceProfileRequest, opts ...grpc.CallOption) (*EndDeviceProfile, error) {
+	out := new(EndDeviceProfile)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.NsEndDeviceProfileRegistry/Set", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nsEndDeviceProfileRegistryClient) Delete(ctx context.Context, in *EndDeviceProfileIdentifiers, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.NsEndDeviceProfileRegistry/Delete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// NsEndDeviceProfileRegistryServer is the server API for NsEndDeviceProfileRegistry service.
+type NsEndDeviceProfileRegistryServer interface {
+	// Get returns the profile that matches the given identifiers.
+	Get(context.Context, *GetEndDeviceProfileRequest) (*EndDeviceProfile, error)
+	// List returns the profiles of an application, or the global profiles.
+	List(context.Context, *ListEndDeviceProfilesRequest) (*EndDeviceProfiles, error)
+	// Set creates or updates the profile.
+	// Updates of the profile take effect for all end devices that use the profile on their next uplink.
+	Set(context.Context, *SetEndDeviceProfileRequest) (*EndDeviceProfile, error)
+	// Delete deletes the profile that matches the given identifiers.
+	Delete(context.Context, *EndDeviceProfileIdentifiers) (*types.Empty, error)
+}
+
+// UnimplementedNsEndDeviceProfileRegistryServer can be embedded to have forward compatible implementations.
+type UnimplementedNsEndDeviceProfileRegistryServer struct {
+}
+
+func (*UnimplementedNsEndDeviceProfileRegistryServer) Get(ctx context.Context, req *GetEndDeviceProfileRequest) (*EndDeviceProfile, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+}
+func (*UnimplementedNsEndDeviceProfileRegistryServer) List(ctx context.Context, req *ListEndDeviceProfilesRequest) (*EndDeviceProfiles, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
+}
+func (*UnimplementedNsEndDeviceProfileRegistryServer) Set(ctx context.Context, req *SetEndDeviceProfileRequest) (*EndDeviceProfile, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Set not implemented")
+}
+func (*UnimplementedNsEndDeviceProfileRegistryServer) Delete(ctx context.Context, req *EndDeviceProfileIdentifiers) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+
+func RegisterNsEndDeviceProfileRegistryServer(s *grpc.Server, srv NsEndDeviceProfileRegistryServer) {
+	s.RegisterService(&_NsEndDeviceProfileRegistry_serviceDesc, srv)
+}
+
+func _NsEndDeviceProfileRegistry_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEndDeviceProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NsEndDeviceProfileRegistryServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.NsEndDeviceProfileRegistry/Get",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NsEndDeviceProfileRegistryServer).Get(ctx, req.(*GetEndDeviceProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NsEndDeviceProfileRegistry_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListEndDeviceProfilesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NsEndDeviceProfileRegistryServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.NsEndDeviceProfileRegistry/List",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NsEndDeviceProfileRegistryServer).List(ctx, req.(*ListEndDeviceProfilesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NsEndDeviceProfileRegistry_Set_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetEndDeviceProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NsEndDeviceProfileRegistryServer).Set(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.NsEndDeviceProfileRegistry/Set",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NsEndDeviceProfileRegistryServer).Set(ctx, req.(*SetEndDeviceProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NsEndDeviceProfileRegistry_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EndDeviceProfileIdentifiers)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NsEndDeviceProfileRegistryServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.NsEndDeviceProfileRegistry/Delete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NsEndDeviceProfileRegistryServer).Delete(ctx, req.(*EndDeviceProfileIdentifiers))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _NsEndDeviceProfileRegistry_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ttn.lorawan.v3.NsEndDeviceProfileRegistry",
+	HandlerType: (*NsEndDeviceProfileRegistryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Get",
+			Handler:    _NsEndDeviceProfileRegistry_Get_Handler,
+		},
+		{
+			MethodName: "List",
+			Handler:    _NsEndDeviceProfileRegistry_List_Handler,
+		},
+		{
+			MethodName: "Set",
+			Handler:    _NsEndDeviceProfileRegistry_Set_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _NsEndDeviceProfileRegistry_Delete_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "lorawan-stack/api/networkserver.proto",
@@ -375,6 +375,109 @@ func (m *OAuthAuthorizationCode) GetExpiresAt() time.Time {
 	return time.Time{}
 }
 
+type OAuthDeviceAuthorization struct {
+	ClientIDs ClientIdentifiers `protobuf:"bytes,1,opt,name=client_ids,json=clientIds,proto3" json:"client_ids"`
+	// The user that authorized the device. This is empty while the authorization is pending.
+	UserIDs    UserIdentifiers `protobuf:"bytes,2,opt,name=user_ids,json=userIds,proto3" json:"user_ids"`
+	Rights     []Right         `protobuf:"varint,3,rep,packed,name=rights,proto3,enum=ttn.lorawan.v3.Right" json:"rights,omitempty"`
+	DeviceCode string          `protobuf:"bytes,4,opt,name=device_code,json=deviceCode,proto3" json:"device_code,omitempty"`
+	UserCode   string          `protobuf:"bytes,5,opt,name=user_code,json=userCode,proto3" json:"user_code,omitempty"`
+	// Whether the user denied the authorization.
+	Denied               bool      `protobuf:"varint,6,opt,name=denied,proto3" json:"denied,omitempty"`
+	CreatedAt            time.Time `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3,stdtime" json:"created_at"`
+	ExpiresAt            time.Time `protobuf:"bytes,8,opt,name=expires_at,json=expiresAt,proto3,stdtime" json:"expires_at"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *OAuthDeviceAuthorization) Reset()      { *m = OAuthDeviceAuthorization{} }
+func (*OAuthDeviceAuthorization) ProtoMessage() {}
+func (*OAuthDeviceAuthorization) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1454904971eaa7d7, []int{5}
+}
+func (m *OAuthDeviceAuthorization) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OAuthDeviceAuthorization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OAuthDeviceAuthorization.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OAuthDeviceAuthorization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OAuthDeviceAuthorization.Merge(m, src)
+}
+func (m *OAuthDeviceAuthorization) XXX_Size() int {
+	return m.Size()
+}
+func (m *OAuthDeviceAuthorization) XXX_DiscardUnknown() {
+	xxx_messageInfo_OAuthDeviceAuthorization.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OAuthDeviceAuthorization proto.InternalMessageInfo
+
+func (m *OAuthDeviceAuthorization) GetClientIDs() ClientIdentifiers {
+	if m != nil {
+		return m.ClientIDs
+	}
+	return ClientIdentifiers{}
+}
+
+func (m *OAuthDeviceAuthorization) GetUserIDs() UserIdentifiers {
+	if m != nil {
+		return m.UserIDs
+	}
+	return UserIdentifiers{}
+}
+
+func (m *OAuthDeviceAuthorization) GetRights() []Right {
+	if m != nil {
+		return m.Rights
+	}
+	return nil
+}
+
+func (m *OAuthDeviceAuthorization) GetDeviceCode() string {
+	if m != nil {
+		return m.DeviceCode
+	}
+	return ""
+}
+
+func (m *OAuthDeviceAuthorization) GetUserCode() string {
+	if m != nil {
+		return m.UserCode
+	}
+	return ""
+}
+
+func (m *OAuthDeviceAuthorization) GetDenied() bool {
+	if m != nil {
+		return m.Denied
+	}
+	return false
+}
+
+func (m *OAuthDeviceAuthorization) GetCreatedAt() time.Time {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return time.Time{}
+}
+
+func (m *OAuthDeviceAuthorization) GetExpiresAt() time.Time {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return time.Time{}
+}
+
 type OAuthAccessTokenIdentifiers struct {
 	UserIDs              UserIdentifiers   `protobuf:"bytes,1,opt,name=user_ids,json=userIds,proto3" json:"user_ids"`
 	ClientIDs            ClientIdentifiers `protobuf:"bytes,2,opt,name=client_ids,json=clientIds,proto3" json:"client_ids"`
@@ -386,7 +489,7 @@ type OAuthAccessTokenIdentifiers struct {
 func (m *OAuthAccessTokenIdentifiers) Reset()      { *m = OAuthAccessTokenIdentifiers{} }
 func (*OAuthAccessTokenIdentifiers) ProtoMessage() {}
 func (*OAuthAccessTokenIdentifiers) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1454904971eaa7d7, []int{5}
+	return fileDescriptor_1454904971eaa7d7, []int{6}
 }
 func (m *OAuthAccessTokenIdentifiers) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -452,7 +555,7 @@ type OAuthAccessToken struct {
 func (m *OAuthAccessToken) Reset()      { *m = OAuthAccessToken{} }
 func (*OAuthAccessToken) ProtoMessage() {}
 func (*OAuthAccessToken) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1454904971eaa7d7, []int{6}
+	return fileDescriptor_1454904971eaa7d7, []int{7}
 }
 func (m *OAuthAccessToken) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -546,7 +649,7 @@ type OAuthAccessTokens struct {
 func (m *OAuthAccessTokens) Reset()      { *m = OAuthAccessTokens{} }
 func (*OAuthAccessTokens) ProtoMessage() {}
 func (*OAuthAccessTokens) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1454904971eaa7d7, []int{7}
+	return fileDescriptor_1454904971eaa7d7, []int{8}
 }
 func (m *OAuthAccessTokens) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -599,7 +702,7 @@ type ListOAuthAccessTokensRequest struct {
 func (m *ListOAuthAccessTokensRequest) Reset()      { *m = ListOAuthAccessTokensRequest{} }
 func (*ListOAuthAccessTokensRequest) ProtoMessage() {}
 func (*ListOAuthAccessTokensRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1454904971eaa7d7, []int{8}
+	return fileDescriptor_1454904971eaa7d7, []int{9}
 }
 func (m *ListOAuthAccessTokensRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -674,6 +777,8 @@ func init() {
 	golang_proto.RegisterType((*ListOAuthClientAuthorizationsRequest)(nil), "ttn.lorawan.v3.ListOAuthClientAuthorizationsRequest")
 	proto.RegisterType((*OAuthAuthorizationCode)(nil), "ttn.lorawan.v3.OAuthAuthorizationCode")
 	golang_proto.RegisterType((*OAuthAuthorizationCode)(nil), "ttn.lorawan.v3.OAuthAuthorizationCode")
+	proto.RegisterType((*OAuthDeviceAuthorization)(nil), "ttn.lorawan.v3.OAuthDeviceAuthorization")
+	golang_proto.RegisterType((*OAuthDeviceAuthorization)(nil), "ttn.lorawan.v3.OAuthDeviceAuthorization")
 	proto.RegisterType((*OAuthAccessTokenIdentifiers)(nil), "ttn.lorawan.v3.OAuthAccessTokenIdentifiers")
 	golang_proto.RegisterType((*OAuthAccessTokenIdentifiers)(nil), "ttn.lorawan.v3.OAuthAccessTokenIdentifiers")
 	proto.RegisterType((*OAuthAccessToken)(nil), "ttn.lorawan.v3.OAuthAccessToken")
@@ -690,63 +795,67 @@ func init() {
 }
 
 var fileDescriptor_1454904971eaa7d7 = []byte{
-	// 890 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x56, 0x31, 0x6c, 0xdb, 0x46,
-	0x14, 0xbd, 0x13, 0x2d, 0xd9, 0x3a, 0xd9, 0x86, 0x4b, 0xb4, 0xa9, 0xe2, 0x34, 0x27, 0x45, 0xee,
-	0x20, 0x14, 0x15, 0x05, 0x38, 0x40, 0x51, 0x74, 0x13, 0xed, 0xc5, 0x40, 0x8a, 0x16, 0xd7, 0x78,
-	0x69, 0x07, 0x83, 0x26, 0xcf, 0xd4, 0xc1, 0x12, 0x8f, 0xbd, 0x3b, 0x3a, 0x49, 0xa7, 0x2c, 0x05,
-	0x82, 0x4e, 0x1e, 0x3b, 0x16, 0x9d, 0x32, 0x66, 0xcc, 0xd0, 0x21, 0xa3, 0x47, 0x6f, 0xcd, 0x52,
-	0xd5, 0x22, 0x87, 0xba, 0x5b, 0xc6, 0xc0, 0x53, 0xa1, 0x23, 0x15, 0xd1, 0x74, 0x5d, 0xc0, 0x59,
-	0x0a, 0x67, 0xfb, 0x77, 0xf7, 0xfe, 0x3b, 0x3d, 0xfe, 0x77, 0xff, 0x0b, 0xdd, 0x1e, 0x70, 0xe1,
-	0x3c, 0x70, 0x82, 0x8e, 0x54, 0x8e, 0xbb, 0xdf, 0x75, 0x42, 0xd6, 0xe5, 0x4e, 0xa4, 0xfa, 0x56,
-	0x28, 0xb8, 0xe2, 0xe6, 0xb2, 0x52, 0x81, 0x95, 0x41, 0xac, 0x83, 0xbb, 0xab, 0x3d, 0x9f, 0xa9,
-	0x7e, 0xb4, 0x6b, 0xb9, 0x7c, 0xd8, 0xa5, 0xc1, 0x01, 0x7f, 0x14, 0x0a, 0xfe, 0xf0, 0x51, 0x57,
-	0x83, 0xdd, 0x8e, 0x4f, 0x83, 0xce, 0x81, 0x33, 0x60, 0x9e, 0xa3, 0x68, 0xf7, 0x42, 0x90, 0x52,
-	0xae, 0x76, 0x72, 0x14, 0x3e, 0xf7, 0x79, 0x9a, 0xbc, 0x1b, 0xed, 0xe9, 0x95, 0x5e, 0xe8, 0x28,
-	0x83, 0x37, 0x7c, 0xce, 0xfd, 0x01, 0x9d, 0xa1, 0x14, 0x1b, 0x52, 0xa9, 0x9c, 0x61, 0x98, 0x01,
-	0xd6, 0x2e, 0x2a, 0x60, 0x1e, 0x0d, 0x14, 0xdb, 0x63, 0x54, 0xc8, 0x0c, 0x84, 0x2f, 0x82, 0x04,
-	0xf3, 0xfb, 0x2a, 0x3b, 0x6f, 0xfd, 0x0e, 0xd1, 0xda, 0x57, 0xbd, 0x48, 0xf5, 0x37, 0x06, 0x8c,
-	0x06, 0x6a, 0x12, 0x71, 0xc1, 0x7e, 0x70, 0x14, 0xe3, 0xc1, 0xd6, 0x8c, 0xcd, 0xfc, 0x06, 0x2d,
-	0x44, 0x92, 0x8a, 0x1d, 0xe6, 0xc9, 0x3a, 0x6c, 0xc2, 0x76, 0x6d, 0xbd, 0x61, 0x9d, 0xff, 0x44,
-	0xd6, 0xb6, 0xa4, 0x22, 0x97, 0x62, 0x7f, 0x78, 0x66, 0x97, 0x7f, 0x82, 0xa5, 0x15, 0x78, 0x34,
-	0x6a, 0x80, 0x78, 0xd4, 0x98, 0xd7, 0x80, 0x4d, 0x49, 0xe6, 0x23, 0x8d, 0x94, 0xe6, 0x77, 0x08,
-	0xb9, 0xfa, 0x5a, 0x4d, 0x5b, 0xd2, 0xb4, 0x77, 0x8a, 0xb4, 0xe9, 0x0f, 0xcb, 0x13, 0xdf, 0x2c,
-	0x10, 0x57, 0x33, 0xc8, 0xa6, 0x24, 0x55, 0x37, 0x43, 0xcb, 0xd6, 0x8f, 0x06, 0xaa, 0x5f, 0xa6,
-	0xec, 0xfa, 0xc9, 0x31, 0x3b, 0xa8, 0x92, 0x16, 0xae, 0x6e, 0x34, 0x8d, 0xf6, 0xf2, 0xfa, 0x07,
-	0x45, 0x62, 0x32, 0x39, 0x25, 0x19, 0xc8, 0xdc, 0x40, 0xc8, 0x15, 0xd4, 0x51, 0xd4, 0xdb, 0x71,
-	0x54, 0x7d, 0x4e, 0xff, 0x96, 0x55, 0x2b, 0xb5, 0x94, 0x35, 0xb5, 0x94, 0x75, 0x7f, 0x6a, 0x29,
-	0x7b, 0x61, 0x72, 0xf9, 0xe1, 0x9f, 0x0d, 0x48, 0xaa, 0x59, 0x5e, 0x4f, 0x4d, 0x48, 0xa2, 0xd0,
-	0x9b, 0x92, 0x94, 0xaf, 0x42, 0x92, 0xe5, 0xf5, 0x54, 0x6b, 0x88, 0x6e, 0x5e, 0x56, 0x06, 0x69,
-	0x7e, 0x8d, 0x96, 0x9d, 0x73, 0x3b, 0x75, 0xd8, 0x34, 0xda, 0xb5, 0xf5, 0x76, 0x51, 0xdd, 0x65,
-	0x14, 0xa4, 0x90, 0xdf, 0x3a, 0x81, 0xe8, 0xe3, 0x7b, 0x4c, 0xaa, 0x4b, 0xef, 0x24, 0xf4, 0xfb,
-	0x88, 0x4a, 0x65, 0xde, 0xbb, 0xba, 0x05, 0x56, 0xf2, 0x95, 0x3a, 0x1e, 0x35, 0xe0, 0xac, 0xf6,
-	0x9f, 0xa1, 0x32, 0x17, 0x1e, 0x15, 0xba, 0xec, 0x55, 0xbb, 0x79, 0x66, 0xdf, 0x16, 0xb7, 0x08,
-	0x20, 0xb9, 0x2a, 0x90, 0x5a, 0x27, 0xb7, 0x48, 0xe1, 0x26, 0x46, 0xe5, 0x01, 0x1b, 0x32, 0x55,
-	0x37, 0x9a, 0xb0, 0xbd, 0x64, 0x2f, 0x9c, 0xd9, 0xe5, 0x4f, 0x8c, 0xfa, 0xe9, 0x3c, 0x49, 0xb7,
-	0x4d, 0x13, 0xcd, 0x85, 0x8e, 0x4f, 0x75, 0x05, 0x97, 0x88, 0x8e, 0x5b, 0x7f, 0x1b, 0xe8, 0x86,
-	0x96, 0x77, 0x4e, 0xd8, 0x06, 0xf7, 0xe8, 0xbb, 0xef, 0x6b, 0x13, 0xcd, 0xb9, 0xdc, 0x4b, 0xbf,
-	0x47, 0x95, 0xe8, 0xd8, 0xfc, 0x02, 0x2d, 0x0a, 0xea, 0x31, 0x41, 0x5d, 0xb5, 0x13, 0x09, 0xa6,
-	0x8d, 0x5a, 0xd5, 0xba, 0x84, 0x71, 0x08, 0x61, 0x3c, 0x6a, 0xd4, 0x48, 0x76, 0xbe, 0x4d, 0xb6,
-	0x48, 0x6d, 0x0a, 0xde, 0x16, 0xcc, 0x7c, 0x1f, 0x95, 0xa5, 0x72, 0x14, 0xad, 0x57, 0x34, 0x61,
-	0xba, 0x28, 0xbc, 0x9e, 0xf9, 0xb7, 0x7e, 0x3d, 0xf4, 0x61, 0xc8, 0x04, 0x95, 0x13, 0x92, 0x85,
-	0xab, 0x90, 0x64, 0x79, 0x3d, 0xd5, 0xfa, 0x0b, 0xa2, 0x5b, 0x69, 0xad, 0x5d, 0x97, 0x4a, 0x79,
-	0x9f, 0xef, 0xd3, 0xeb, 0xdd, 0x97, 0xcd, 0x1b, 0xa8, 0xc4, 0x3c, 0x6d, 0xf7, 0xaa, 0x5d, 0x89,
-	0x47, 0x8d, 0xd2, 0xd6, 0x26, 0x29, 0x31, 0xaf, 0xf5, 0x87, 0x81, 0x56, 0x8a, 0x4a, 0xdf, 0x1d,
-	0x79, 0xe6, 0x1d, 0xb4, 0xe8, 0x68, 0x61, 0x3b, 0x6a, 0xa2, 0x2c, 0x33, 0x70, 0xcd, 0xc9, 0x89,
-	0x5d, 0x43, 0x4b, 0x82, 0xee, 0x09, 0x2a, 0xfb, 0x19, 0x46, 0x1b, 0x99, 0x2c, 0x66, 0x9b, 0x29,
-	0x68, 0xf6, 0x5e, 0x2a, 0x57, 0x9f, 0x03, 0xff, 0xa7, 0x93, 0xbf, 0x44, 0xef, 0x15, 0xcb, 0x2b,
-	0xcd, 0xcf, 0x51, 0x45, 0x4b, 0x9d, 0xf6, 0xfd, 0xe6, 0xbf, 0xf6, 0xfd, 0x5c, 0x0a, 0xc9, 0xf0,
-	0xad, 0xdf, 0x4a, 0xe8, 0xa3, 0x37, 0x7d, 0x3e, 0xcf, 0x39, 0xed, 0xef, 0xd7, 0xcf, 0x3a, 0x6f,
-	0x66, 0x88, 0xf1, 0x96, 0x33, 0x64, 0xee, 0xbf, 0x67, 0x48, 0x79, 0x36, 0x43, 0xec, 0x5f, 0xe1,
-	0xd1, 0x18, 0xc3, 0xe3, 0x31, 0x86, 0x2f, 0xc7, 0x18, 0x9c, 0x8c, 0x31, 0x38, 0x1d, 0x63, 0xf0,
-	0x6a, 0x8c, 0xc1, 0xeb, 0x31, 0x86, 0x8f, 0x63, 0x0c, 0x9f, 0xc4, 0x18, 0x3c, 0x8d, 0x31, 0x7c,
-	0x16, 0x63, 0xf0, 0x3c, 0xc6, 0xe0, 0x45, 0x8c, 0xc1, 0x51, 0x8c, 0xe1, 0x71, 0x8c, 0xe1, 0xcb,
-	0x18, 0x83, 0x93, 0x18, 0xc3, 0xd3, 0x18, 0x83, 0x57, 0x31, 0x86, 0xaf, 0x63, 0x0c, 0x1e, 0x27,
-	0x18, 0x3c, 0x49, 0x30, 0x3c, 0x4c, 0x30, 0xf8, 0x39, 0xc1, 0xf0, 0x97, 0x04, 0x83, 0xa7, 0x09,
-	0x06, 0xcf, 0x12, 0x0c, 0x9f, 0x27, 0x18, 0xbe, 0x48, 0x30, 0xfc, 0xf6, 0x53, 0x9f, 0x5b, 0xaa,
-	0x4f, 0x55, 0x9f, 0x05, 0xbe, 0xb4, 0x02, 0xaa, 0x1e, 0x70, 0xb1, 0xdf, 0x3d, 0xff, 0x1f, 0x35,
-	0xdc, 0xf7, 0xbb, 0x4a, 0x05, 0xe1, 0xee, 0x6e, 0x45, 0x7b, 0xeb, 0xee, 0x3f, 0x01, 0x00, 0x00,
-	0xff, 0xff, 0xda, 0xbf, 0x2e, 0x78, 0xac, 0x0b, 0x00, 0x00,
+	// 960 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x57, 0x3d, 0x6c, 0x23, 0x45,
+	0x18, 0xdd, 0xf1, 0xc6, 0x8e, 0x3d, 0x4e, 0x42, 0x58, 0x41, 0xf0, 0x25, 0xdc, 0xd8, 0xe7, 0x50,
+	0x58, 0x08, 0xaf, 0xa5, 0x9c, 0x84, 0x10, 0x9d, 0x9d, 0x34, 0x11, 0x87, 0x40, 0xc3, 0xa5, 0x81,
+	0xc2, 0xda, 0xec, 0x4e, 0xd6, 0xa3, 0xd8, 0x3b, 0xcb, 0xcc, 0x6c, 0xee, 0x8e, 0xea, 0x1a, 0xa4,
+	0x13, 0x55, 0x4a, 0x4a, 0x44, 0x75, 0xe5, 0x95, 0x57, 0x50, 0x5c, 0x99, 0x32, 0x12, 0x05, 0xd7,
+	0x60, 0xe2, 0xdd, 0x82, 0xd0, 0x5d, 0x79, 0x4a, 0x85, 0x3c, 0xbb, 0x3e, 0xaf, 0x1d, 0x8c, 0xf0,
+	0x09, 0x09, 0x92, 0x6e, 0x7e, 0xde, 0xf7, 0x76, 0x9e, 0xdf, 0x9b, 0x1f, 0xc3, 0x9b, 0x5d, 0xc6,
+	0xad, 0x7b, 0x96, 0x57, 0x17, 0xd2, 0xb2, 0x0f, 0x1b, 0x96, 0x4f, 0x1b, 0xcc, 0x0a, 0x64, 0xc7,
+	0xf4, 0x39, 0x93, 0xcc, 0x58, 0x91, 0xd2, 0x33, 0x13, 0x88, 0x79, 0x74, 0x7b, 0xbd, 0xe9, 0x52,
+	0xd9, 0x09, 0xf6, 0x4d, 0x9b, 0xf5, 0x1a, 0xc4, 0x3b, 0x62, 0x0f, 0x7c, 0xce, 0xee, 0x3f, 0x68,
+	0x28, 0xb0, 0x5d, 0x77, 0x89, 0x57, 0x3f, 0xb2, 0xba, 0xd4, 0xb1, 0x24, 0x69, 0x5c, 0x6a, 0xc4,
+	0x94, 0xeb, 0xf5, 0x14, 0x85, 0xcb, 0x5c, 0x16, 0x17, 0xef, 0x07, 0x07, 0xaa, 0xa7, 0x3a, 0xaa,
+	0x95, 0xc0, 0xcb, 0x2e, 0x63, 0x6e, 0x97, 0x8c, 0x51, 0x92, 0xf6, 0x88, 0x90, 0x56, 0xcf, 0x4f,
+	0x00, 0x9b, 0x97, 0x15, 0x50, 0x87, 0x78, 0x92, 0x1e, 0x50, 0xc2, 0x45, 0x02, 0x42, 0x97, 0x41,
+	0x9c, 0xba, 0x1d, 0x99, 0xcc, 0x57, 0x7f, 0x01, 0x70, 0xf3, 0xb3, 0x66, 0x20, 0x3b, 0xdb, 0x5d,
+	0x4a, 0x3c, 0x39, 0x6c, 0x31, 0x4e, 0xbf, 0xb1, 0x24, 0x65, 0xde, 0xee, 0x98, 0xcd, 0xf8, 0x02,
+	0xe6, 0x03, 0x41, 0x78, 0x9b, 0x3a, 0xa2, 0x04, 0x2a, 0xa0, 0x56, 0xdc, 0x2a, 0x9b, 0x93, 0x3f,
+	0x91, 0xb9, 0x27, 0x08, 0x4f, 0x95, 0xb4, 0xde, 0xb9, 0x68, 0x65, 0xbf, 0x03, 0x99, 0x55, 0x70,
+	0xd2, 0x2f, 0x6b, 0x61, 0xbf, 0xbc, 0xa8, 0x00, 0x3b, 0x02, 0x2f, 0x06, 0x0a, 0x29, 0x8c, 0xaf,
+	0x20, 0xb4, 0xd5, 0x67, 0x15, 0x6d, 0x46, 0xd1, 0xde, 0x9a, 0xa6, 0x8d, 0x17, 0x96, 0x26, 0xbe,
+	0x31, 0x45, 0x5c, 0x48, 0x20, 0x3b, 0x02, 0x17, 0xec, 0x04, 0x2d, 0xaa, 0xdf, 0xea, 0xb0, 0x34,
+	0x4b, 0xd9, 0xd5, 0x93, 0x63, 0xd4, 0x61, 0x2e, 0x36, 0xae, 0xa4, 0x57, 0xf4, 0xda, 0xca, 0xd6,
+	0xdb, 0xd3, 0xc4, 0x78, 0x38, 0x8b, 0x13, 0x90, 0xb1, 0x0d, 0xa1, 0xcd, 0x89, 0x25, 0x89, 0xd3,
+	0xb6, 0x64, 0x69, 0x41, 0xad, 0x65, 0xdd, 0x8c, 0x23, 0x65, 0x8e, 0x22, 0x65, 0xde, 0x1d, 0x45,
+	0xaa, 0x95, 0x1f, 0x7e, 0xfc, 0xf8, 0xb7, 0x32, 0xc0, 0x85, 0xa4, 0xae, 0x29, 0x87, 0x24, 0x81,
+	0xef, 0x8c, 0x48, 0xb2, 0xf3, 0x90, 0x24, 0x75, 0x4d, 0x59, 0xed, 0xc1, 0x1b, 0xb3, 0x6c, 0x10,
+	0xc6, 0xe7, 0x70, 0xc5, 0x9a, 0x18, 0x29, 0x81, 0x8a, 0x5e, 0x2b, 0x6e, 0xd5, 0xa6, 0xd5, 0xcd,
+	0xa2, 0xc0, 0x53, 0xf5, 0xd5, 0x33, 0x00, 0xdf, 0xbb, 0x43, 0x85, 0x9c, 0xf9, 0x4d, 0x4c, 0xbe,
+	0x0e, 0x88, 0x90, 0xc6, 0x9d, 0xf9, 0x23, 0xb0, 0x9a, 0x76, 0xea, 0xb4, 0x5f, 0x06, 0x63, 0xef,
+	0x3f, 0x84, 0x59, 0xc6, 0x1d, 0xc2, 0x95, 0xed, 0x85, 0x56, 0xe5, 0xa2, 0x75, 0x93, 0x6f, 0x60,
+	0x0d, 0xa7, 0x5c, 0xc0, 0xc5, 0x7a, 0xaa, 0x13, 0xc3, 0x0d, 0x04, 0xb3, 0x5d, 0xda, 0xa3, 0xb2,
+	0xa4, 0x57, 0x40, 0x6d, 0xb9, 0x95, 0xbf, 0x68, 0x65, 0xdf, 0xd7, 0x4b, 0xe7, 0x8b, 0x38, 0x1e,
+	0x36, 0x0c, 0xb8, 0xe0, 0x5b, 0x2e, 0x51, 0x0e, 0x2e, 0x63, 0xd5, 0xae, 0xfe, 0xa1, 0xc3, 0x35,
+	0x25, 0x6f, 0x42, 0xd8, 0x36, 0x73, 0xc8, 0xf5, 0xcf, 0xb5, 0x01, 0x17, 0x6c, 0xe6, 0xc4, 0xbf,
+	0x47, 0x01, 0xab, 0xb6, 0xf1, 0x31, 0x5c, 0xe2, 0xc4, 0xa1, 0x9c, 0xd8, 0xb2, 0x1d, 0x70, 0xaa,
+	0x82, 0x5a, 0x50, 0xba, 0xb8, 0x7e, 0x0c, 0x40, 0xd8, 0x2f, 0x17, 0x71, 0x32, 0xbf, 0x87, 0x77,
+	0x71, 0x71, 0x04, 0xde, 0xe3, 0xd4, 0x78, 0x0b, 0x66, 0x85, 0xb4, 0x24, 0x29, 0xe5, 0x14, 0x61,
+	0xdc, 0x99, 0xda, 0x3d, 0x8b, 0xaf, 0xbd, 0x7b, 0xc8, 0x7d, 0x9f, 0x72, 0x22, 0x86, 0x24, 0xf9,
+	0x79, 0x48, 0x92, 0xba, 0xa6, 0xac, 0xfe, 0x3c, 0x3a, 0xc5, 0x76, 0xc8, 0x11, 0xb5, 0xc9, 0xe4,
+	0x29, 0x36, 0x69, 0x0c, 0xf8, 0x77, 0x8d, 0xf9, 0x24, 0x15, 0xa5, 0xcc, 0x3f, 0x8b, 0xd2, 0x1b,
+	0x33, 0x23, 0x34, 0xa7, 0xcb, 0x65, 0x58, 0x74, 0x94, 0xde, 0x76, 0xca, 0x6c, 0x18, 0x0f, 0xa9,
+	0x9c, 0x6f, 0xc0, 0x82, 0x5a, 0x9c, 0x9a, 0x56, 0x7e, 0x63, 0xb5, 0x5a, 0x35, 0xb9, 0x06, 0x73,
+	0x0e, 0xf1, 0x28, 0x71, 0x94, 0xa9, 0x79, 0x9c, 0xf4, 0xfe, 0x47, 0xae, 0xfe, 0x0e, 0xe0, 0x46,
+	0xbc, 0x83, 0x6d, 0x9b, 0x08, 0x71, 0x97, 0x1d, 0x92, 0xab, 0x7d, 0xdb, 0x1a, 0x6b, 0x30, 0x43,
+	0x1d, 0x75, 0x88, 0x15, 0x5a, 0xb9, 0xb0, 0x5f, 0xce, 0xec, 0xee, 0xe0, 0x0c, 0x75, 0xaa, 0xbf,
+	0xea, 0x70, 0x75, 0x5a, 0xe9, 0xf5, 0x91, 0x67, 0xdc, 0x82, 0x4b, 0x96, 0x12, 0xd6, 0x96, 0x43,
+	0x65, 0x49, 0x52, 0x8b, 0x56, 0x4a, 0xec, 0x26, 0x5c, 0xe6, 0xe4, 0x80, 0x13, 0xd1, 0x49, 0x30,
+	0x71, 0x5c, 0x97, 0x92, 0xc1, 0x18, 0x34, 0xde, 0x1f, 0xb9, 0xf9, 0x6f, 0xf7, 0xff, 0x32, 0xc9,
+	0x9f, 0xc2, 0x37, 0xa7, 0xed, 0x15, 0xc6, 0x47, 0x30, 0xa7, 0xa4, 0x8e, 0x6e, 0xf3, 0xca, 0x5f,
+	0xde, 0xe6, 0xa9, 0x12, 0x9c, 0xe0, 0xab, 0x3f, 0x65, 0xe0, 0xbb, 0xaf, 0x6e, 0xef, 0x34, 0xe7,
+	0xe8, 0xd6, 0xbe, 0x7a, 0xd1, 0x79, 0xf5, 0x32, 0xd0, 0x5f, 0xf3, 0x65, 0xb0, 0xf0, 0xf7, 0x2f,
+	0x83, 0xec, 0xf8, 0x65, 0xd0, 0xfa, 0x11, 0x9c, 0x0c, 0x10, 0x38, 0x1d, 0x20, 0xf0, 0x7c, 0x80,
+	0xb4, 0xb3, 0x01, 0xd2, 0xce, 0x07, 0x48, 0x7b, 0x31, 0x40, 0xda, 0xcb, 0x01, 0x02, 0x0f, 0x43,
+	0x04, 0x1e, 0x85, 0x48, 0x7b, 0x1c, 0x22, 0xf0, 0x24, 0x44, 0xda, 0xd3, 0x10, 0x69, 0xcf, 0x42,
+	0xa4, 0x9d, 0x84, 0x08, 0x9c, 0x86, 0x08, 0x3c, 0x0f, 0x91, 0x76, 0x16, 0x22, 0x70, 0x1e, 0x22,
+	0xed, 0x45, 0x88, 0xc0, 0xcb, 0x10, 0x69, 0x0f, 0x23, 0xa4, 0x3d, 0x8a, 0x10, 0x38, 0x8e, 0x90,
+	0xf6, 0x7d, 0x84, 0xc0, 0x0f, 0x11, 0xd2, 0x1e, 0x47, 0x48, 0x7b, 0x12, 0x21, 0xf0, 0x34, 0x42,
+	0xe0, 0x59, 0x84, 0xc0, 0x97, 0x1f, 0xb8, 0xcc, 0x94, 0x1d, 0x22, 0x3b, 0xd4, 0x73, 0x85, 0xe9,
+	0x11, 0x79, 0x8f, 0xf1, 0xc3, 0xc6, 0xe4, 0x3f, 0x0f, 0xff, 0xd0, 0x6d, 0x48, 0xe9, 0xf9, 0xfb,
+	0xfb, 0x39, 0x95, 0xad, 0xdb, 0x7f, 0x0e, 0x00, 0xf4, 0x24, 0x3c, 0x0e, 0x82, 0x0d, 0x00, 0x00,
 }
 
 func (this *OAuthClientAuthorizationIdentifiers) Equal(that interface{}) bool {
@@ -929,6 +1038,56 @@ func (this *OAuthAuthorizationCode) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *OAuthDeviceAuthorization) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*OAuthDeviceAuthorization)
+	if !ok {
+		that2, ok := that.(OAuthDeviceAuthorization)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.ClientIDs.Equal(&that1.ClientIDs) {
+		return false
+	}
+	if !this.UserIDs.Equal(&that1.UserIDs) {
+		return false
+	}
+	if len(this.Rights) != len(that1.Rights) {
+		return false
+	}
+	for i := range this.Rights {
+		if this.Rights[i] != that1.Rights[i] {
+			return false
+		}
+	}
+	if this.DeviceCode != that1.DeviceCode {
+		return false
+	}
+	if this.UserCode != that1.UserCode {
+		return false
+	}
+	if this.Denied != that1.Denied {
+		return false
+	}
+	if !this.CreatedAt.Equal(that1.CreatedAt) {
+		return false
+	}
+	if !this.ExpiresAt.Equal(that1.ExpiresAt) {
+		return false
+	}
+	return true
+}
 func (this *OAuthAccessTokenIdentifiers) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -1379,6 +1538,107 @@ func (m *OAuthAuthorizationCode) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *OAuthDeviceAuthorization) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OAuthDeviceAuthorization) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OAuthDeviceAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n16, err16 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.ExpiresAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.ExpiresAt):])
+	if err16 != nil {
+		return 0, err16
+	}
+	i -= n16
+	i = encodeVarintOauth(dAtA, i, uint64(n16))
+	i--
+	dAtA[i] = 0x42
+	n17, err17 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CreatedAt):])
+	if err17 != nil {
+		return 0, err17
+	}
+	i -= n17
+	i = encodeVarintOauth(dAtA, i, uint64(n17))
+	i--
+	dAtA[i] = 0x3a
+	if m.Denied {
+		i--
+		if m.Denied {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.UserCode) > 0 {
+		i -= len(m.UserCode)
+		copy(dAtA[i:], m.UserCode)
+		i = encodeVarintOauth(dAtA, i, uint64(len(m.UserCode)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.DeviceCode) > 0 {
+		i -= len(m.DeviceCode)
+		copy(dAtA[i:], m.DeviceCode)
+		i = encodeVarintOauth(dAtA, i, uint64(len(m.DeviceCode)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Rights) > 0 {
+		dAtA19 := make([]byte, len(m.Rights)*10)
+		var j18 int
+		for _, num := range m.Rights {
+			for num >= 1<<7 {
+				dAtA19[j18] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j18++
+			}
+			dAtA19[j18] = uint8(num)
+			j18++
+		}
+		i -= j18
+		copy(dAtA[i:], dAtA19[:j18])
+		i = encodeVarintOauth(dAtA, i, uint64(j18))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.UserIDs.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintOauth(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.ClientIDs.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintOauth(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *OAuthAccessTokenIdentifiers) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1449,37 +1709,37 @@ func (m *OAuthAccessToken) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n18, err18 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.ExpiresAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.ExpiresAt):])
-	if err18 != nil {
-		return 0, err18
+	n24, err24 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.ExpiresAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.ExpiresAt):])
+	if err24 != nil {
+		return 0, err24
 	}
-	i -= n18
-	i = encodeVarintOauth(dAtA, i, uint64(n18))
+	i -= n24
+	i = encodeVarintOauth(dAtA, i, uint64(n24))
 	i--
 	dAtA[i] = 0x42
-	n19, err19 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CreatedAt):])
-	if err19 != nil {
-		return 0, err19
+	n25, err25 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CreatedAt):])
+	if err25 != nil {
+		return 0, err25
 	}
-	i -= n19
-	i = encodeVarintOauth(dAtA, i, uint64(n19))
+	i -= n25
+	i = encodeVarintOauth(dAtA, i, uint64(n25))
 	i--
 	dAtA[i] = 0x3a
 	if len(m.Rights) > 0 {
-		dAtA21 := make([]byte, len(m.Rights)*10)
-		var j20 int
+		dAtA27 := make([]byte, len(m.Rights)*10)
+		var j26 int
 		for _, num := range m.Rights {
 			for num >= 1<<7 {
-				dAtA21[j20] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA27[j26] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j20++
+				j26++
 			}
-			dAtA21[j20] = uint8(num)
-			j20++
+			dAtA27[j26] = uint8(num)
+			j26++
 		}
-		i -= j20
-		copy(dAtA[i:], dAtA21[:j20])
-		i = encodeVarintOauth(dAtA, i, uint64(j20))
+		i -= j26
+		copy(dAtA[i:], dAtA27[:j26])
+		i = encodeVarintOauth(dAtA, i, uint64(j26))
 		i--
 		dAtA[i] = 0x32
 	}
@@ -1715,12 +1975,35 @@ func NewPopulatedOAuthAuthorizationCode(r randyOauth, easy bool) *OAuthAuthoriza
 	return this
 }
 
+func NewPopulatedOAuthDeviceAuthorization(r randyOauth, easy bool) *OAuthDeviceAuthorization {
+	this := &OAuthDeviceAuthorization{}
+	v15 := NewPopulatedClientIdentifiers(r, easy)
+	this.ClientIDs = *v15
+	v16 := NewPopulatedUserIdentifiers(r, easy)
+	this.UserIDs = *v16
+	v17 := r.Intn(10)
+	this.Rights = make([]Right, v17)
+	for i := 0; i < v17; i++ {
+		this.Rights[i] = Right([]int32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 56, 19, 20, 21, 22, 23, 24, 25, 26, 27, 28, 29, 30, 31, 32, 33, 34, 35, 36, 37, 38, 39, 40, 41, 42, 43, 44, 45, 46, 47, 48, 49, 50, 51, 52, 53, 54, 55}[r.Intn(57)])
+	}
+	this.DeviceCode = randStringOauth(r)
+	this.UserCode = randStringOauth(r)
+	this.Denied = bool(r.Intn(2) == 0)
+	v18 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	this.CreatedAt = *v18
+	v19 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	this.ExpiresAt = *v19
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
 func NewPopulatedOAuthAccessTokenIdentifiers(r randyOauth, easy bool) *OAuthAccessTokenIdentifiers {
 	this := &OAuthAccessTokenIdentifiers{}
-	v15 := NewPopulatedUserIdentifiers(r, easy)
-	this.UserIDs = *v15
-	v16 := NewPopulatedClientIdentifiers(r, easy)
-	this.ClientIDs = *v16
+	v20 := NewPopulatedUserIdentifiers(r, easy)
+	this.UserIDs = *v20
+	v21 := NewPopulatedClientIdentifiers(r, easy)
+	this.ClientIDs = *v21
 	this.ID = randStringOauth(r)
 	if !easy && r.Intn(10) != 0 {
 	}
@@ -1729,22 +2012,22 @@ func NewPopulatedOAuthAccessTokenIdentifiers(r randyOauth, easy bool) *OAuthAcce
 
 func NewPopulatedOAuthAccessToken(r randyOauth, easy bool) *OAuthAccessToken {
 	this := &OAuthAccessToken{}
-	v17 := NewPopulatedUserIdentifiers(r, easy)
-	this.UserIDs = *v17
-	v18 := NewPopulatedClientIdentifiers(r, easy)
-	this.ClientIDs = *v18
+	v22 := NewPopulatedUserIdentifiers(r, easy)
+	this.UserIDs = *v22
+	v23 := NewPopulatedClientIdentifiers(r, easy)
+	this.ClientIDs = *v23
 	this.ID = randStringOauth(r)
 	this.AccessToken = randStringOauth(r)
 	this.RefreshToken = randStringOauth(r)
-	v19 := r.Intn(10)
-	this.Rights = make([]Right, v19)
-	for i := 0; i < v19; i++ {
+	v24 := r.Intn(10)
+	this.Rights = make([]Right, v24)
+	for i := 0; i < v24; i++ {
 		this.Rights[i] = Right([]int32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 56, 19, 20, 21, 22, 23, 24, 25, 26, 27, 28, 29, 30, 31, 32, 33, 34, 35, 36, 37, 38, 39, 40, 41, 42, 43, 44, 45, 46, 47, 48, 49, 50, 51, 52, 53, 54, 55}[r.Intn(57)])
 	}
-	v20 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
-	this.CreatedAt = *v20
-	v21 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
-	this.ExpiresAt = *v21
+	v25 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	this.CreatedAt = *v25
+	v26 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	this.ExpiresAt = *v26
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -1753,9 +2036,9 @@ func NewPopulatedOAuthAccessToken(r randyOauth, easy bool) *OAuthAccessToken {
 func NewPopulatedOAuthAccessTokens(r randyOauth, easy bool) *OAuthAccessTokens {
 	this := &OAuthAccessTokens{}
 	if r.Intn(5) != 0 {
-		v22 := r.Intn(5)
-		this.Tokens = make([]*OAuthAccessToken, v22)
-		for i := 0; i < v22; i++ {
+		v27 := r.Intn(5)
+		this.Tokens = make([]*OAuthAccessToken, v27)
+		for i := 0; i < v27; i++ {
 			this.Tokens[i] = NewPopulatedOAuthAccessToken(r, easy)
 		}
 	}
@@ -1766,10 +2049,10 @@ func NewPopulatedOAuthAccessTokens(r randyOauth, easy bool) *OAuthAccessTokens {
 
 func NewPopulatedListOAuthAccessTokensRequest(r randyOauth, easy bool) *ListOAuthAccessTokensRequest {
 	this := &ListOAuthAccessTokensRequest{}
-	v23 := NewPopulatedUserIdentifiers(r, easy)
-	this.UserIDs = *v23
-	v24 := NewPopulatedClientIdentifiers(r, easy)
-	this.ClientIDs = *v24
+	v28 := NewPopulatedUserIdentifiers(r, easy)
+	this.UserIDs = *v28
+	v29 := NewPopulatedClientIdentifiers(r, easy)
+	this.ClientIDs = *v29
 	this.Order = randStringOauth(r)
 	this.Limit = r.Uint32()
 	this.Page = r.Uint32()
@@ -1797,9 +2080,9 @@ func randUTF8RuneOauth(r randyOauth) rune {
 	return rune(ru + 61)
 }
 func randStringOauth(r randyOauth) string {
-	v25 := r.Intn(100)
-	tmps := make([]rune, v25)
-	for i := 0; i < v25; i++ {
+	v30 := r.Intn(100)
+	tmps := make([]rune, v30)
+	for i := 0; i < v30; i++ {
 		tmps[i] = randUTF8RuneOauth(r)
 	}
 	return string(tmps)
@@ -1821,11 +2104,11 @@ func randFieldOauth(dAtA []byte, r randyOauth, fieldNumber int, wire int) []byte
 	switch wire {
 	case 0:
 		dAtA = encodeVarintPopulateOauth(dAtA, uint64(key))
-		v26 := r.Int63()
+		v31 := r.Int63()
 		if r.Intn(2) == 0 {
-			v26 *= -1
+			v31 *= -1
 		}
-		dAtA = encodeVarintPopulateOauth(dAtA, uint64(v26))
+		dAtA = encodeVarintPopulateOauth(dAtA, uint64(v31))
 	case 1:
 		dAtA = encodeVarintPopulateOauth(dAtA, uint64(key))
 		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
@@ -1959,6 +2242,41 @@ func (m *OAuthAuthorizationCode) Size() (n int) {
 	return n
 }
 
+func (m *OAuthDeviceAuthorization) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ClientIDs.Size()
+	n += 1 + l + sovOauth(uint64(l))
+	l = m.UserIDs.Size()
+	n += 1 + l + sovOauth(uint64(l))
+	if len(m.Rights) > 0 {
+		l = 0
+		for _, e := range m.Rights {
+			l += sovOauth(uint64(e))
+		}
+		n += 1 + sovOauth(uint64(l)) + l
+	}
+	l = len(m.DeviceCode)
+	if l > 0 {
+		n += 1 + l + sovOauth(uint64(l))
+	}
+	l = len(m.UserCode)
+	if l > 0 {
+		n += 1 + l + sovOauth(uint64(l))
+	}
+	if m.Denied {
+		n += 2
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.CreatedAt)
+	n += 1 + l + sovOauth(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.ExpiresAt)
+	n += 1 + l + sovOauth(uint64(l))
+	return n
+}
+
 func (m *OAuthAccessTokenIdentifiers) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2126,6 +2444,23 @@ func (this *OAuthAuthorizationCode) String() string {
 	}, "")
 	return s
 }
+func (this *OAuthDeviceAuthorization) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&OAuthDeviceAuthorization{`,
+		`ClientIDs:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ClientIDs), "ClientIdentifiers", "ClientIdentifiers", 1), `&`, ``, 1) + `,`,
+		`UserIDs:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.UserIDs), "UserIdentifiers", "UserIdentifiers", 1), `&`, ``, 1) + `,`,
+		`Rights:` + fmt.Sprintf("%v", this.Rights) + `,`,
+		`DeviceCode:` + fmt.Sprintf("%v", this.DeviceCode) + `,`,
+		`UserCode:` + fmt.Sprintf("%v", this.UserCode) + `,`,
+		`Denied:` + fmt.Sprintf("%v", this.Denied) + `,`,
+		`CreatedAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.CreatedAt), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
+		`ExpiresAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ExpiresAt), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *OAuthAccessTokenIdentifiers) String() string {
 	if this == nil {
 		return "nil"
@@ -3158,6 +3493,344 @@ func (m *OAuthAuthorizationCode) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *OAuthDeviceAuthorization) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOauth
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OAuthDeviceAuthorization: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OAuthDeviceAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientIDs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOauth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOauth
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOauth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ClientIDs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserIDs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOauth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOauth
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOauth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UserIDs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType == 0 {
+				var v Right
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowOauth
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= Right(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Rights = append(m.Rights, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowOauth
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthOauth
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthOauth
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.Rights) == 0 {
+					m.Rights = make([]Right, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v Right
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowOauth
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= Right(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Rights = append(m.Rights, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rights", wireType)
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeviceCode", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOauth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOauth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOauth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeviceCode = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserCode", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOauth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOauth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOauth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UserCode = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denied", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOauth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Denied = bool(v != 0)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOauth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOauth
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOauth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CreatedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOauth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOauth
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOauth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.ExpiresAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOauth(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthOauth
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthOauth
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *OAuthAccessTokenIdentifiers) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
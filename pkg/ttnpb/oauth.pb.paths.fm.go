@@ -78,6 +78,30 @@ var OAuthAuthorizationCodeFieldPathsTopLevel = []string{
 	"state",
 	"user_ids",
 }
+var OAuthDeviceAuthorizationFieldPathsNested = []string{
+	"client_ids",
+	"client_ids.client_id",
+	"created_at",
+	"denied",
+	"device_code",
+	"expires_at",
+	"rights",
+	"user_code",
+	"user_ids",
+	"user_ids.email",
+	"user_ids.user_id",
+}
+
+var OAuthDeviceAuthorizationFieldPathsTopLevel = []string{
+	"client_ids",
+	"created_at",
+	"denied",
+	"device_code",
+	"expires_at",
+	"rights",
+	"user_code",
+	"user_ids",
+}
 var OAuthAccessTokenIdentifiersFieldPathsNested = []string{
 	"client_ids",
 	"client_ids.client_id",
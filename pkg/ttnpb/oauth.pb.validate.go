@@ -637,6 +637,144 @@ var _ interface {
 	ErrorName() string
 } = OAuthAuthorizationCodeValidationError{}
 
+// ValidateFields checks the field values on OAuthDeviceAuthorization with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *OAuthDeviceAuthorization) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = OAuthDeviceAuthorizationFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "client_ids":
+
+			if v, ok := interface{}(&m.ClientIDs).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return OAuthDeviceAuthorizationValidationError{
+						field:  "client_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "user_ids":
+
+			if v, ok := interface{}(&m.UserIDs).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return OAuthDeviceAuthorizationValidationError{
+						field:  "user_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "rights":
+
+		case "device_code":
+			// no validation rules for DeviceCode
+		case "user_code":
+			// no validation rules for UserCode
+		case "denied":
+			// no validation rules for Denied
+		case "created_at":
+
+			if v, ok := interface{}(&m.CreatedAt).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return OAuthDeviceAuthorizationValidationError{
+						field:  "created_at",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "expires_at":
+
+			if v, ok := interface{}(&m.ExpiresAt).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return OAuthDeviceAuthorizationValidationError{
+						field:  "expires_at",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		default:
+			return OAuthDeviceAuthorizationValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// OAuthDeviceAuthorizationValidationError is the validation error returned by
+// OAuthDeviceAuthorization.ValidateFields if the designated constraints
+// aren't met.
+type OAuthDeviceAuthorizationValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OAuthDeviceAuthorizationValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OAuthDeviceAuthorizationValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OAuthDeviceAuthorizationValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OAuthDeviceAuthorizationValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OAuthDeviceAuthorizationValidationError) ErrorName() string {
+	return "OAuthDeviceAuthorizationValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e OAuthDeviceAuthorizationValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOAuthDeviceAuthorization.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OAuthDeviceAuthorizationValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OAuthDeviceAuthorizationValidationError{}
+
 // ValidateFields checks the field values on OAuthAccessTokenIdentifiers with
 // the rules defined in the proto definition for this message. If any rules
 // are violated, an error is returned.
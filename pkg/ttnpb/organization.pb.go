@@ -222,7 +222,9 @@ type ListOrganizationsRequest struct {
 	// Limit the number of results per page.
 	Limit uint32 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
 	// Page number for pagination. 0 is interpreted as 1.
-	Page                 uint32   `protobuf:"varint,5,opt,name=page,proto3" json:"page,omitempty"`
+	Page uint32 `protobuf:"varint,5,opt,name=page,proto3" json:"page,omitempty"`
+	// Only return recently deleted organizations. This is only allowed for admins.
+	Deleted              bool     `protobuf:"varint,6,opt,name=deleted,proto3" json:"deleted,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
@@ -294,6 +296,13 @@ func (m *ListOrganizationsRequest) GetPage() uint32 {
 	return 0
 }
 
+func (m *ListOrganizationsRequest) GetDeleted() bool {
+	if m != nil {
+		return m.Deleted
+	}
+	return false
+}
+
 type CreateOrganizationRequest struct {
 	Organization `protobuf:"bytes,1,opt,name=organization,proto3,embedded=organization" json:"organization"`
 	// Collaborator to grant all rights on the newly created application.
@@ -494,10 +503,13 @@ func (m *GetOrganizationAPIKeyRequest) GetKeyID() string {
 
 type CreateOrganizationAPIKeyRequest struct {
 	OrganizationIdentifiers `protobuf:"bytes,1,opt,name=organization_ids,json=organizationIds,proto3,embedded=organization_ids" json:"organization_ids"`
-	Name                    string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Rights                  []Right  `protobuf:"varint,3,rep,packed,name=rights,proto3,enum=ttn.lorawan.v3.Right" json:"rights,omitempty"`
-	XXX_NoUnkeyedLiteral    struct{} `json:"-"`
-	XXX_sizecache           int32    `json:"-"`
+	Name                    string  `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Rights                  []Right `protobuf:"varint,3,rep,packed,name=rights,proto3,enum=ttn.lorawan.v3.Right" json:"rights,omitempty"`
+	// Time after which the API key is no longer valid. If not set, the API key does not expire.
+	ExpiresAt            *time.Time          `protobuf:"bytes,4,opt,name=expires_at,json=expiresAt,proto3,stdtime" json:"expires_at,omitempty"`
+	Restrictions         *APIKeyRestrictions `protobuf:"bytes,5,opt,name=restrictions,proto3" json:"restrictions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
 }
 
 func (m *CreateOrganizationAPIKeyRequest) Reset()      { *m = CreateOrganizationAPIKeyRequest{} }
@@ -546,11 +558,28 @@ func (m *CreateOrganizationAPIKeyRequest) GetRights() []Right {
 	return nil
 }
 
+func (m *CreateOrganizationAPIKeyRequest) GetExpiresAt() *time.Time {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return nil
+}
+
+func (m *CreateOrganizationAPIKeyRequest) GetRestrictions() *APIKeyRestrictions {
+	if m != nil {
+		return m.Restrictions
+	}
+	return nil
+}
+
 type UpdateOrganizationAPIKeyRequest struct {
 	OrganizationIdentifiers `protobuf:"bytes,1,opt,name=organization_ids,json=organizationIds,proto3,embedded=organization_ids" json:"organization_ids"`
 	APIKey                  `protobuf:"bytes,2,opt,name=api_key,json=apiKey,proto3,embedded=api_key" json:"api_key"`
-	XXX_NoUnkeyedLiteral    struct{} `json:"-"`
-	XXX_sizecache           int32    `json:"-"`
+	// The names of the API key fields that should be updated.
+	// If empty, only the name and rights are updated.
+	FieldMask            types.FieldMask `protobuf:"bytes,3,opt,name=field_mask,json=fieldMask,proto3" json:"field_mask"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
 
 func (m *UpdateOrganizationAPIKeyRequest) Reset()      { *m = UpdateOrganizationAPIKeyRequest{} }
@@ -585,6 +614,13 @@ func (m *UpdateOrganizationAPIKeyRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_UpdateOrganizationAPIKeyRequest proto.InternalMessageInfo
 
+func (m *UpdateOrganizationAPIKeyRequest) GetFieldMask() types.FieldMask {
+	if m != nil {
+		return m.FieldMask
+	}
+	return types.FieldMask{}
+}
+
 type ListOrganizationCollaboratorsRequest struct {
 	OrganizationIdentifiers `protobuf:"bytes,1,opt,name=organization_ids,json=organizationIds,proto3,embedded=organization_ids" json:"organization_ids"`
 	// Limit the number of results per page.
@@ -767,76 +803,80 @@ func init() {
 }
 
 var fileDescriptor_312da2e2e650bd3b = []byte{
-	// 1101 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x57, 0x4b, 0x6c, 0x1b, 0x45,
-	0x18, 0xde, 0xf1, 0x23, 0xa9, 0x27, 0x49, 0x13, 0xad, 0xa0, 0xda, 0xa6, 0xd1, 0xd8, 0x5a, 0x22,
-	0x48, 0xab, 0x78, 0x8d, 0xdc, 0x0b, 0x54, 0x40, 0xe4, 0x0d, 0x0f, 0x45, 0xa1, 0xb4, 0x6c, 0xe9,
-	0x85, 0xaa, 0x58, 0x63, 0xef, 0x78, 0x33, 0xb2, 0xbd, 0xbb, 0xcc, 0x8e, 0x53, 0x1c, 0x84, 0x54,
-	0x71, 0xaa, 0x38, 0x55, 0x9c, 0x10, 0x27, 0xc4, 0x01, 0x95, 0x5b, 0x8e, 0x15, 0x17, 0x72, 0x8c,
-	0x7a, 0xca, 0xb1, 0xe2, 0x10, 0xea, 0xf5, 0x25, 0x37, 0x7a, 0xac, 0x7c, 0x42, 0xfb, 0x70, 0xb3,
-	0xbb, 0x36, 0x96, 0xa0, 0x55, 0x4a, 0x4f, 0x9e, 0xc7, 0x37, 0xff, 0xff, 0x7f, 0x33, 0xdf, 0x37,
-	0xb3, 0x86, 0xcb, 0x2d, 0x8b, 0xe1, 0x5b, 0xd8, 0x2c, 0x3a, 0x1c, 0xd7, 0x9b, 0x25, 0x6c, 0xd3,
-	0x92, 0xc5, 0x0c, 0x6c, 0xd2, 0x1d, 0xcc, 0xa9, 0x65, 0x2a, 0x36, 0xb3, 0xb8, 0x25, 0x9e, 0xe6,
-	0xdc, 0x54, 0x42, 0xa4, 0xb2, 0x7d, 0x71, 0xb1, 0x62, 0x50, 0xbe, 0xd5, 0xa9, 0x29, 0x75, 0xab,
-	0x5d, 0x22, 0xe6, 0xb6, 0xd5, 0xb5, 0x99, 0xf5, 0x55, 0xb7, 0xe4, 0x83, 0xeb, 0x45, 0x83, 0x98,
-	0xc5, 0x6d, 0xdc, 0xa2, 0x3a, 0xe6, 0xa4, 0x34, 0xd2, 0x08, 0x42, 0x2e, 0x16, 0x23, 0x21, 0x0c,
-	0xcb, 0xb0, 0x82, 0xc5, 0xb5, 0x4e, 0xc3, 0xef, 0xf9, 0x1d, 0xbf, 0x15, 0xc2, 0x0b, 0x86, 0x65,
-	0x19, 0x2d, 0x72, 0x8c, 0x6a, 0x50, 0xd2, 0xd2, 0xab, 0x6d, 0xec, 0x34, 0x43, 0x44, 0x3e, 0x89,
-	0xe0, 0xb4, 0x4d, 0x1c, 0x8e, 0xdb, 0x76, 0x08, 0x18, 0x43, 0xb5, 0x6e, 0x99, 0x1c, 0xd7, 0x79,
-	0x95, 0x9a, 0x8d, 0x61, 0xa2, 0xd7, 0x46, 0x51, 0x54, 0x27, 0x26, 0xa7, 0x0d, 0x4a, 0x98, 0x13,
-	0x82, 0xd0, 0x28, 0x88, 0x51, 0x63, 0x8b, 0x87, 0xf3, 0xf2, 0xaf, 0x19, 0x38, 0x7b, 0x25, 0xb2,
-	0x8d, 0xe2, 0x26, 0x4c, 0x53, 0xdd, 0x91, 0x40, 0x01, 0xac, 0xcc, 0x94, 0xdf, 0x50, 0xe2, 0xdb,
-	0xa9, 0x44, 0xa1, 0x1b, 0xc7, 0xc9, 0xd4, 0x85, 0x81, 0x9a, 0xfd, 0x0e, 0xa4, 0x16, 0xc0, 0xfe,
-	0x61, 0x5e, 0x38, 0x38, 0xcc, 0x03, 0xcd, 0x8b, 0x22, 0xae, 0x43, 0x58, 0x67, 0x04, 0x73, 0xa2,
-	0x57, 0x31, 0x97, 0x52, 0x7e, 0xcc, 0x45, 0x25, 0xa0, 0xaf, 0x0c, 0xe9, 0x2b, 0x9f, 0x0d, 0xe9,
-	0xab, 0xa7, 0xbc, 0xe5, 0x77, 0xff, 0xcc, 0x03, 0x2d, 0x17, 0xae, 0xab, 0x70, 0x2f, 0x48, 0xc7,
-	0xd6, 0x87, 0x41, 0xd2, 0xff, 0x26, 0x48, 0xb8, 0xae, 0xc2, 0xc5, 0x73, 0x30, 0x63, 0xe2, 0x36,
-	0x91, 0x32, 0x05, 0xb0, 0x92, 0x53, 0xa7, 0x07, 0x6a, 0x86, 0xa5, 0xa4, 0xb2, 0xe6, 0x0f, 0x8a,
-	0x17, 0xe0, 0x8c, 0x4e, 0x9c, 0x3a, 0xa3, 0xb6, 0xc7, 0x4b, 0xca, 0xfa, 0x98, 0x53, 0x03, 0x35,
-	0xcb, 0xd2, 0xd2, 0xc1, 0xbc, 0x16, 0x9d, 0x14, 0x77, 0x20, 0xc4, 0x9c, 0x33, 0x5a, 0xeb, 0x70,
-	0xe2, 0x48, 0x53, 0x85, 0xf4, 0xca, 0x4c, 0x79, 0x75, 0xd2, 0x36, 0x29, 0x95, 0xa7, 0xf0, 0x0f,
-	0x4c, 0xce, 0xba, 0xea, 0xea, 0x40, 0x3d, 0xff, 0x23, 0x78, 0x5d, 0x5e, 0x66, 0xb2, 0xb4, 0x5c,
-	0x46, 0x5f, 0xdc, 0xc0, 0xc5, 0x9d, 0x37, 0x8b, 0x6f, 0xdf, 0x5c, 0x59, 0xbb, 0x74, 0xa3, 0x78,
-	0x73, 0x6d, 0xd8, 0x3d, 0xff, 0x75, 0x79, 0xf5, 0x9b, 0x65, 0x2d, 0x92, 0x4d, 0x7c, 0x0f, 0xce,
-	0x46, 0x75, 0x20, 0x4d, 0xfb, 0xd9, 0xcf, 0x25, 0xb3, 0xaf, 0x07, 0x98, 0x0d, 0xb3, 0x61, 0x69,
-	0x33, 0xf5, 0xe3, 0xce, 0xe2, 0xbb, 0x70, 0x3e, 0x51, 0x8c, 0xb8, 0x00, 0xd3, 0x4d, 0xd2, 0xf5,
-	0x8f, 0x3b, 0xa7, 0x79, 0x4d, 0xf1, 0x15, 0x98, 0xdd, 0xc6, 0xad, 0x0e, 0xf1, 0x8f, 0x2b, 0xa7,
-	0x05, 0x9d, 0x4b, 0xa9, 0xb7, 0x80, 0x7c, 0x0d, 0xce, 0x45, 0x89, 0x39, 0xa2, 0x0a, 0xe7, 0xa2,
-	0x16, 0xf4, 0x54, 0xe3, 0x15, 0xb4, 0x34, 0x69, 0x3b, 0xb4, 0xf8, 0x12, 0xf9, 0x77, 0x00, 0xcf,
-	0x7c, 0x44, 0x78, 0x0c, 0x42, 0xbe, 0xec, 0x10, 0x87, 0x8b, 0x3a, 0x5c, 0x88, 0x62, 0xab, 0xcf,
-	0x45, 0x97, 0xf3, 0x56, 0x0c, 0xea, 0x88, 0x6b, 0x10, 0x1e, 0x3b, 0xf4, 0x1f, 0x35, 0xfa, 0xa1,
-	0x07, 0xb9, 0x8c, 0x9d, 0xa6, 0x9a, 0xf1, 0x42, 0x69, 0xb9, 0xc6, 0x70, 0x40, 0x7e, 0x90, 0x82,
-	0xd2, 0xc7, 0xd4, 0x89, 0x51, 0x70, 0x86, 0x1c, 0x3e, 0xf5, 0x8e, 0xac, 0xd5, 0xc2, 0x35, 0x8b,
-	0x61, 0x6e, 0xb1, 0xb0, 0xfe, 0xe2, 0xa4, 0xfa, 0xaf, 0xb0, 0xeb, 0x0e, 0x61, 0x11, 0x16, 0x5a,
-	0x2c, 0xc4, 0x33, 0x17, 0x2c, 0x36, 0x60, 0xd6, 0x62, 0x3a, 0x61, 0xbe, 0x97, 0x72, 0xea, 0xd5,
-	0x81, 0x7a, 0x99, 0x6d, 0x6a, 0x42, 0x7c, 0x6b, 0xaa, 0x54, 0xd7, 0x16, 0x8a, 0xc9, 0x11, 0xdf,
-	0x2f, 0x5a, 0xb6, 0xe8, 0xff, 0x44, 0xbc, 0xad, 0xcd, 0x14, 0x23, 0x9d, 0x20, 0xbc, 0x88, 0x60,
-	0xb6, 0x45, 0xdb, 0x94, 0xfb, 0xa6, 0x9b, 0xf3, 0x0d, 0x75, 0x21, 0x2d, 0x1d, 0x4d, 0x6b, 0xc1,
-	0xb0, 0x28, 0xc2, 0x8c, 0x8d, 0x0d, 0xe2, 0xfb, 0x6d, 0x4e, 0xf3, 0xdb, 0xf2, 0x01, 0x80, 0x67,
-	0xd7, 0xfd, 0x48, 0xe3, 0x14, 0xa1, 0xc1, 0xd9, 0x68, 0x45, 0xe1, 0x6e, 0x4e, 0xd4, 0xdb, 0x18,
-	0x09, 0xc4, 0x62, 0x88, 0xd5, 0xc4, 0x09, 0xa5, 0xfe, 0xc3, 0x09, 0xa9, 0xb3, 0xd1, 0x24, 0xf1,
-	0xf3, 0x92, 0x77, 0x01, 0x3c, 0x7b, 0xdd, 0xbf, 0x88, 0x4e, 0x8a, 0xd2, 0x33, 0x4b, 0xfa, 0x37,
-	0x00, 0x51, 0x52, 0xd2, 0x95, 0xab, 0x1b, 0x9b, 0xa4, 0xeb, 0x9c, 0xac, 0x39, 0x9f, 0x4a, 0x28,
-	0x35, 0x59, 0x42, 0xe9, 0x88, 0x84, 0x7e, 0x01, 0x70, 0x29, 0x71, 0xa3, 0x04, 0xb5, 0x9f, 0x6c,
-	0xe9, 0x05, 0x38, 0xd5, 0x24, 0xdd, 0x2a, 0xd5, 0x83, 0x8b, 0x54, 0xcd, 0xb9, 0x87, 0xf9, 0xec,
-	0x26, 0xe9, 0x6e, 0xbc, 0xaf, 0x65, 0x9b, 0xa4, 0xbb, 0xa1, 0xcb, 0x2e, 0x80, 0xf9, 0x51, 0xad,
-	0xbf, 0x88, 0x5a, 0x87, 0xaf, 0x63, 0x6a, 0xdc, 0xeb, 0xf8, 0x0e, 0x9c, 0x0a, 0x3e, 0x19, 0xa4,
-	0x74, 0x21, 0xbd, 0x72, 0xba, 0xfc, 0x6a, 0x32, 0xb1, 0xe6, 0xcd, 0xaa, 0x73, 0x03, 0x15, 0x7e,
-	0x0f, 0xa6, 0xe5, 0xec, 0xb7, 0x5e, 0x2e, 0x2d, 0x5c, 0x23, 0x3f, 0x00, 0x30, 0x3f, 0xaa, 0xfe,
-	0x17, 0x41, 0xb2, 0x02, 0xa7, 0xb1, 0x4d, 0xab, 0xde, 0x73, 0x17, 0x58, 0xe2, 0x4c, 0x32, 0x78,
-	0x50, 0xd5, 0x98, 0x58, 0x53, 0xd8, 0xa6, 0x9b, 0xa4, 0x2b, 0xef, 0x01, 0xb8, 0x9c, 0xf4, 0xc5,
-	0x7a, 0xc4, 0xeb, 0x2f, 0x81, 0x3b, 0xfe, 0x02, 0x50, 0x4e, 0xb8, 0x23, 0xca, 0xe0, 0x64, 0x09,
-	0xd4, 0x9f, 0xc7, 0xdd, 0x3b, 0xe6, 0x36, 0x8c, 0xdd, 0xbf, 0x7f, 0x00, 0x28, 0x5f, 0xfb, 0xbf,
-	0x30, 0xfe, 0x64, 0x2c, 0xe3, 0xa5, 0xd1, 0x4f, 0xb8, 0x63, 0xcc, 0xa4, 0xc7, 0x45, 0xfd, 0x19,
-	0xec, 0xf7, 0x10, 0x38, 0xe8, 0x21, 0xf0, 0xb0, 0x87, 0x84, 0x47, 0x3d, 0x24, 0x1c, 0xf5, 0x90,
-	0xf0, 0xb8, 0x87, 0x84, 0x27, 0x3d, 0x04, 0x6e, 0xbb, 0x08, 0xdc, 0x71, 0x91, 0x70, 0xcf, 0x45,
-	0x60, 0xd7, 0x45, 0xc2, 0x7d, 0x17, 0x09, 0x7b, 0x2e, 0x12, 0xf6, 0x5d, 0x04, 0x0e, 0x5c, 0x04,
-	0x1e, 0xba, 0x48, 0x78, 0xe4, 0x22, 0x70, 0xe4, 0x22, 0xe1, 0xb1, 0x8b, 0xc0, 0x13, 0x17, 0x09,
-	0xb7, 0xfb, 0x48, 0xb8, 0xd3, 0x47, 0xe0, 0x6e, 0x1f, 0x09, 0x3f, 0xf4, 0x11, 0xf8, 0xa9, 0x8f,
-	0x84, 0x7b, 0x7d, 0x24, 0xec, 0xf6, 0x11, 0xb8, 0xdf, 0x47, 0x60, 0xaf, 0x8f, 0xc0, 0xe7, 0xab,
-	0x86, 0xa5, 0xf0, 0x2d, 0xc2, 0xb7, 0xa8, 0x69, 0x38, 0x8a, 0x49, 0xf8, 0x2d, 0x8b, 0x35, 0x4b,
-	0xf1, 0xff, 0x1a, 0x76, 0xd3, 0x28, 0x71, 0x6e, 0xda, 0xb5, 0xda, 0x94, 0xff, 0xe6, 0x5c, 0xfc,
-	0x3b, 0x00, 0x00, 0xff, 0xff, 0x7d, 0x46, 0xfc, 0x6f, 0xc3, 0x0d, 0x00, 0x00,
+	// 1165 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x57, 0x3d, 0x8c, 0x1b, 0x45,
+	0x1b, 0xde, 0xf1, 0x6f, 0x3c, 0x77, 0x97, 0x9c, 0x56, 0xdf, 0x17, 0x6d, 0x2e, 0xd1, 0xd8, 0x5a,
+	0x4e, 0xe0, 0x44, 0xe7, 0x35, 0x72, 0x1a, 0x88, 0x80, 0xc8, 0x7b, 0x90, 0xe8, 0x74, 0x84, 0x84,
+	0x0d, 0x69, 0x88, 0x82, 0x35, 0xf6, 0x8e, 0xf7, 0x46, 0xb6, 0x77, 0x97, 0xd9, 0xf1, 0x25, 0x0e,
+	0x42, 0x8a, 0xa8, 0x22, 0x68, 0x22, 0x2a, 0x44, 0x85, 0x28, 0x50, 0xe8, 0x52, 0x46, 0x34, 0xa4,
+	0xbc, 0xf2, 0xca, 0x88, 0xe2, 0x88, 0xd7, 0x14, 0x27, 0x51, 0x90, 0x32, 0x72, 0x85, 0xf6, 0xc7,
+	0xf1, 0xae, 0xed, 0x58, 0x0a, 0x89, 0x2e, 0x50, 0xdd, 0xcc, 0xec, 0xf3, 0xfe, 0x3c, 0xef, 0xbc,
+	0xcf, 0xbc, 0x3e, 0xb8, 0xda, 0xb6, 0x18, 0xbe, 0x8e, 0xcd, 0x92, 0xc3, 0x71, 0xa3, 0x55, 0xc6,
+	0x36, 0x2d, 0x5b, 0xcc, 0xc0, 0x26, 0xbd, 0x89, 0x39, 0xb5, 0x4c, 0xc5, 0x66, 0x16, 0xb7, 0xc4,
+	0xc3, 0x9c, 0x9b, 0x4a, 0x88, 0x54, 0xb6, 0x4f, 0xaf, 0x54, 0x0d, 0xca, 0xb7, 0xba, 0x75, 0xa5,
+	0x61, 0x75, 0xca, 0xc4, 0xdc, 0xb6, 0x7a, 0x36, 0xb3, 0x6e, 0xf4, 0xca, 0x3e, 0xb8, 0x51, 0x32,
+	0x88, 0x59, 0xda, 0xc6, 0x6d, 0xaa, 0x63, 0x4e, 0xca, 0x53, 0x8b, 0xc0, 0xe5, 0x4a, 0x29, 0xe2,
+	0xc2, 0xb0, 0x0c, 0x2b, 0x30, 0xae, 0x77, 0x9b, 0xfe, 0xce, 0xdf, 0xf8, 0xab, 0x10, 0x5e, 0x30,
+	0x2c, 0xcb, 0x68, 0x93, 0x31, 0xaa, 0x49, 0x49, 0x5b, 0xaf, 0x75, 0xb0, 0xd3, 0x0a, 0x11, 0xf9,
+	0x49, 0x04, 0xa7, 0x1d, 0xe2, 0x70, 0xdc, 0xb1, 0x43, 0xc0, 0x0c, 0xaa, 0x0d, 0xcb, 0xe4, 0xb8,
+	0xc1, 0x6b, 0xd4, 0x6c, 0x8e, 0x02, 0xbd, 0x36, 0x8d, 0xa2, 0x3a, 0x31, 0x39, 0x6d, 0x52, 0xc2,
+	0x9c, 0x10, 0x84, 0xa6, 0x41, 0x8c, 0x1a, 0x5b, 0x3c, 0xfc, 0x2e, 0xff, 0x9c, 0x82, 0x8b, 0x17,
+	0x23, 0x65, 0x14, 0x37, 0x61, 0x92, 0xea, 0x8e, 0x04, 0x0a, 0xa0, 0xb8, 0x50, 0x79, 0x43, 0x89,
+	0x97, 0x53, 0x89, 0x42, 0x37, 0xc6, 0xc1, 0xd4, 0xe5, 0xa1, 0x9a, 0xfe, 0x1a, 0x24, 0x96, 0xc1,
+	0xce, 0x5e, 0x5e, 0xd8, 0xdd, 0xcb, 0x03, 0xcd, 0xf3, 0x22, 0xae, 0x43, 0xd8, 0x60, 0x04, 0x73,
+	0xa2, 0xd7, 0x30, 0x97, 0x12, 0xbe, 0xcf, 0x15, 0x25, 0xa0, 0xaf, 0x8c, 0xe8, 0x2b, 0x9f, 0x8c,
+	0xe8, 0xab, 0x87, 0x3c, 0xf3, 0x3b, 0xbf, 0xe7, 0x81, 0x96, 0x0b, 0xed, 0xaa, 0xdc, 0x73, 0xd2,
+	0xb5, 0xf5, 0x91, 0x93, 0xe4, 0xf3, 0x38, 0x09, 0xed, 0xaa, 0x5c, 0x3c, 0x0e, 0x53, 0x26, 0xee,
+	0x10, 0x29, 0x55, 0x00, 0xc5, 0x9c, 0x9a, 0x1d, 0xaa, 0x29, 0x96, 0x90, 0x2a, 0x9a, 0x7f, 0x28,
+	0x9e, 0x82, 0x0b, 0x3a, 0x71, 0x1a, 0x8c, 0xda, 0x1e, 0x2f, 0x29, 0xed, 0x63, 0x0e, 0x0d, 0xd5,
+	0x34, 0x4b, 0x4a, 0xbb, 0x47, 0xb4, 0xe8, 0x47, 0xf1, 0x26, 0x84, 0x98, 0x73, 0x46, 0xeb, 0x5d,
+	0x4e, 0x1c, 0x29, 0x53, 0x48, 0x16, 0x17, 0x2a, 0x6b, 0xf3, 0xca, 0xa4, 0x54, 0x9f, 0xc2, 0x3f,
+	0x30, 0x39, 0xeb, 0xa9, 0x6b, 0x43, 0xf5, 0xe4, 0xf7, 0xe0, 0x75, 0x79, 0x95, 0xc9, 0xd2, 0x6a,
+	0x05, 0x7d, 0x76, 0x15, 0x97, 0x6e, 0xbe, 0x59, 0x7a, 0xfb, 0x5a, 0xf1, 0xec, 0x99, 0xab, 0xa5,
+	0x6b, 0x67, 0x47, 0xdb, 0x93, 0x5f, 0x54, 0xd6, 0xbe, 0x5c, 0xd5, 0x22, 0xd1, 0xc4, 0xf7, 0xe0,
+	0x62, 0xb4, 0x0f, 0xa4, 0xac, 0x1f, 0xfd, 0xf8, 0x64, 0xf4, 0xf5, 0x00, 0xb3, 0x61, 0x36, 0x2d,
+	0x6d, 0xa1, 0x31, 0xde, 0xac, 0xbc, 0x0b, 0x8f, 0x4c, 0x24, 0x23, 0x2e, 0xc3, 0x64, 0x8b, 0xf4,
+	0xfc, 0xeb, 0xce, 0x69, 0xde, 0x52, 0xfc, 0x1f, 0x4c, 0x6f, 0xe3, 0x76, 0x97, 0xf8, 0xd7, 0x95,
+	0xd3, 0x82, 0xcd, 0x99, 0xc4, 0x5b, 0x40, 0xbe, 0x0c, 0x97, 0xa2, 0xc4, 0x1c, 0x51, 0x85, 0x4b,
+	0x51, 0x09, 0x7a, 0x5d, 0xe3, 0x25, 0x74, 0x62, 0x5e, 0x39, 0xb4, 0xb8, 0x89, 0xfc, 0x2b, 0x80,
+	0x47, 0xcf, 0x13, 0x1e, 0x83, 0x90, 0xcf, 0xbb, 0xc4, 0xe1, 0xa2, 0x0e, 0x97, 0xa3, 0xd8, 0xda,
+	0x4b, 0xe9, 0xcb, 0x23, 0x56, 0x0c, 0xea, 0x88, 0x67, 0x21, 0x1c, 0x2b, 0xf4, 0x99, 0x3d, 0x7a,
+	0xce, 0x83, 0x5c, 0xc0, 0x4e, 0x4b, 0x4d, 0x79, 0xae, 0xb4, 0x5c, 0x73, 0x74, 0x20, 0xff, 0x91,
+	0x80, 0xd2, 0x87, 0xd4, 0x89, 0x51, 0x70, 0x46, 0x1c, 0x3e, 0xf6, 0xae, 0xac, 0xdd, 0xc6, 0x75,
+	0x8b, 0x61, 0x6e, 0xb1, 0x30, 0xff, 0xd2, 0xbc, 0xfc, 0x2f, 0xb2, 0x2b, 0x0e, 0x61, 0x11, 0x16,
+	0x5a, 0xcc, 0xc5, 0x0b, 0x27, 0x2c, 0x36, 0x61, 0xda, 0x62, 0x3a, 0x61, 0xbe, 0x96, 0x72, 0xea,
+	0xa5, 0xa1, 0x7a, 0x81, 0x6d, 0x6a, 0x42, 0xbc, 0x34, 0x35, 0xaa, 0x6b, 0xcb, 0xa5, 0xc9, 0x13,
+	0x5f, 0x2f, 0x5a, 0xba, 0xe4, 0xff, 0x89, 0x68, 0x5b, 0x5b, 0x28, 0x45, 0x36, 0x81, 0x7b, 0x11,
+	0xc1, 0x74, 0x9b, 0x76, 0x28, 0xf7, 0x45, 0xb7, 0xe4, 0x0b, 0xea, 0x54, 0x52, 0xda, 0xcf, 0x6a,
+	0xc1, 0xb1, 0x28, 0xc2, 0x94, 0x8d, 0x0d, 0xe2, 0xeb, 0x6d, 0x49, 0xf3, 0xd7, 0xa2, 0x04, 0xb3,
+	0x3a, 0x69, 0x13, 0x4e, 0x74, 0x29, 0x53, 0x00, 0xc5, 0x43, 0xda, 0x68, 0x2b, 0xef, 0x02, 0x78,
+	0x6c, 0xdd, 0x8f, 0x31, 0xab, 0x57, 0x34, 0xb8, 0x18, 0xcd, 0x35, 0xac, 0xf3, 0xdc, 0x4e, 0x9c,
+	0xd1, 0x1c, 0x31, 0x1f, 0x62, 0x6d, 0xe2, 0xee, 0x12, 0xff, 0xe0, 0xee, 0xd4, 0xc5, 0x68, 0x90,
+	0xf8, 0x4d, 0xca, 0xf7, 0x00, 0x3c, 0x76, 0xc5, 0x7f, 0xa2, 0x0e, 0x8a, 0xd2, 0x0b, 0x37, 0xfb,
+	0x2f, 0x00, 0xa2, 0xc9, 0x66, 0xaf, 0x5e, 0xda, 0xd8, 0x24, 0x3d, 0xe7, 0x60, 0x65, 0xfb, 0xb4,
+	0xb9, 0x12, 0xf3, 0x9b, 0x2b, 0x39, 0x6e, 0x2e, 0xf9, 0x27, 0x00, 0x4f, 0x9c, 0x27, 0x33, 0x72,
+	0x3f, 0xd8, 0xd4, 0x0b, 0x30, 0xd3, 0x22, 0xbd, 0x1a, 0xd5, 0x83, 0x27, 0x56, 0xcd, 0xb9, 0x7b,
+	0xf9, 0xf4, 0x26, 0xe9, 0x6d, 0xbc, 0xaf, 0xa5, 0x5b, 0xa4, 0xb7, 0xa1, 0xcb, 0x7f, 0x26, 0x60,
+	0x7e, 0xba, 0xd7, 0x5f, 0x45, 0xae, 0xa3, 0xb9, 0x99, 0x98, 0x35, 0x37, 0xdf, 0x81, 0x99, 0xe0,
+	0xc7, 0x84, 0x94, 0x2c, 0x24, 0x8b, 0x87, 0x2b, 0xff, 0x9f, 0x0c, 0xac, 0x79, 0x5f, 0xd5, 0xa5,
+	0xa1, 0x0a, 0xbf, 0x05, 0x59, 0x39, 0xfd, 0x95, 0x17, 0x4b, 0x0b, 0x6d, 0xbc, 0x5e, 0x24, 0x37,
+	0x6c, 0xca, 0x88, 0x53, 0xc3, 0xc1, 0x1b, 0x31, 0x7f, 0xae, 0xa7, 0x82, 0x99, 0x1e, 0xda, 0x54,
+	0xb9, 0x78, 0x0e, 0x2e, 0x32, 0xe2, 0x70, 0x46, 0x1b, 0xc1, 0xf4, 0x49, 0xfb, 0x2e, 0xe4, 0xc9,
+	0x24, 0x46, 0x65, 0x1b, 0x23, 0xb5, 0x98, 0x9d, 0xfc, 0x4d, 0x02, 0xe6, 0xa7, 0x65, 0xf8, 0x2a,
+	0xaa, 0x5d, 0x85, 0x59, 0x6c, 0xd3, 0x9a, 0x37, 0x91, 0x03, 0x6d, 0x1e, 0x9d, 0x4d, 0x66, 0x86,
+	0xaf, 0x0c, 0xb6, 0xe9, 0x26, 0xe9, 0x4d, 0x28, 0x3c, 0xf9, 0xfc, 0x0a, 0x7f, 0x00, 0xe0, 0xea,
+	0xa4, 0xc2, 0xd7, 0x23, 0xaf, 0xd6, 0x7f, 0x40, 0xe7, 0x7f, 0x01, 0x28, 0x9f, 0x27, 0xcf, 0x64,
+	0x70, 0xb0, 0x04, 0x1a, 0x2f, 0x63, 0x8a, 0xcc, 0x78, 0xd7, 0x63, 0x93, 0xe4, 0x37, 0x00, 0xe5,
+	0xcb, 0xff, 0x16, 0xc6, 0x1f, 0xcd, 0x64, 0x7c, 0x62, 0xfa, 0x67, 0xea, 0x18, 0x33, 0x6f, 0x4c,
+	0xaa, 0x3f, 0x82, 0x9d, 0x3e, 0x02, 0xbb, 0x7d, 0x04, 0x1e, 0xf6, 0x91, 0xf0, 0xa8, 0x8f, 0x84,
+	0xfd, 0x3e, 0x12, 0x1e, 0xf7, 0x91, 0xf0, 0xa4, 0x8f, 0xc0, 0x2d, 0x17, 0x81, 0xdb, 0x2e, 0x12,
+	0xee, 0xba, 0x08, 0xdc, 0x73, 0x91, 0x70, 0xdf, 0x45, 0xc2, 0x03, 0x17, 0x09, 0x3b, 0x2e, 0x02,
+	0xbb, 0x2e, 0x02, 0x0f, 0x5d, 0x24, 0x3c, 0x72, 0x11, 0xd8, 0x77, 0x91, 0xf0, 0xd8, 0x45, 0xe0,
+	0x89, 0x8b, 0x84, 0x5b, 0x03, 0x24, 0xdc, 0x1e, 0x20, 0x70, 0x67, 0x80, 0x84, 0xef, 0x06, 0x08,
+	0xfc, 0x30, 0x40, 0xc2, 0xdd, 0x01, 0x12, 0xee, 0x0d, 0x10, 0xb8, 0x3f, 0x40, 0xe0, 0xc1, 0x00,
+	0x81, 0x4f, 0xd7, 0x0c, 0x4b, 0xe1, 0x5b, 0x84, 0x6f, 0x51, 0xd3, 0x70, 0x14, 0x93, 0xf0, 0xeb,
+	0x16, 0x6b, 0x95, 0xe3, 0xff, 0x4f, 0xd9, 0x2d, 0xa3, 0xcc, 0xb9, 0x69, 0xd7, 0xeb, 0x19, 0x5f,
+	0x5b, 0xa7, 0xff, 0x1e, 0x00, 0xd1, 0x05, 0x33, 0xd9, 0xa7, 0x0e, 0x00, 0x00,
 }
 
 func (this *Organization) Equal(that interface{}) bool {
@@ -981,6 +1021,9 @@ func (this *ListOrganizationsRequest) Equal(that interface{}) bool {
 	if this.Page != that1.Page {
 		return false
 	}
+	if this.Deleted != that1.Deleted {
+		return false
+	}
 	return true
 }
 func (this *CreateOrganizationRequest) Equal(that interface{}) bool {
@@ -1127,6 +1170,16 @@ func (this *CreateOrganizationAPIKeyRequest) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if that1.ExpiresAt == nil {
+		if this.ExpiresAt != nil {
+			return false
+		}
+	} else if !this.ExpiresAt.Equal(*that1.ExpiresAt) {
+		return false
+	}
+	if !this.Restrictions.Equal(that1.Restrictions) {
+		return false
+	}
 	return true
 }
 func (this *UpdateOrganizationAPIKeyRequest) Equal(that interface{}) bool {
@@ -1154,6 +1207,9 @@ func (this *UpdateOrganizationAPIKeyRequest) Equal(that interface{}) bool {
 	if !this.APIKey.Equal(&that1.APIKey) {
 		return false
 	}
+	if !this.FieldMask.Equal(&that1.FieldMask) {
+		return false
+	}
 	return true
 }
 func (this *ListOrganizationCollaboratorsRequest) Equal(that interface{}) bool {
@@ -1436,6 +1492,16 @@ func (m *ListOrganizationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error
 	_ = i
 	var l int
 	_ = l
+	if m.Deleted {
+		i--
+		if m.Deleted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
 	if m.Page != 0 {
 		i = encodeVarintOrganization(dAtA, i, uint64(m.Page))
 		i--
@@ -1667,21 +1733,43 @@ func (m *CreateOrganizationAPIKeyRequest) MarshalToSizedBuffer(dAtA []byte) (int
 	_ = i
 	var l int
 	_ = l
+	if m.Restrictions != nil {
+		{
+			size, err := m.Restrictions.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintOrganization(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.ExpiresAt != nil {
+		n15, err15 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ExpiresAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpiresAt):])
+		if err15 != nil {
+			return 0, err15
+		}
+		i -= n15
+		i = encodeVarintOrganization(dAtA, i, uint64(n15))
+		i--
+		dAtA[i] = 0x22
+	}
 	if len(m.Rights) > 0 {
-		dAtA15 := make([]byte, len(m.Rights)*10)
-		var j14 int
+		dAtA17 := make([]byte, len(m.Rights)*10)
+		var j16 int
 		for _, num := range m.Rights {
 			for num >= 1<<7 {
-				dAtA15[j14] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA17[j16] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j14++
+				j16++
 			}
-			dAtA15[j14] = uint8(num)
-			j14++
+			dAtA17[j16] = uint8(num)
+			j16++
 		}
-		i -= j14
-		copy(dAtA[i:], dAtA15[:j14])
-		i = encodeVarintOrganization(dAtA, i, uint64(j14))
+		i -= j16
+		copy(dAtA[i:], dAtA17[:j16])
+		i = encodeVarintOrganization(dAtA, i, uint64(j16))
 		i--
 		dAtA[i] = 0x1a
 	}
@@ -1725,6 +1813,16 @@ func (m *UpdateOrganizationAPIKeyRequest) MarshalToSizedBuffer(dAtA []byte) (int
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.FieldMask.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintOrganization(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
 	{
 		size, err := m.APIKey.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -1952,6 +2050,7 @@ func NewPopulatedListOrganizationsRequest(r randyOrganization, easy bool) *ListO
 	this.Order = randStringOrganization(r)
 	this.Limit = r.Uint32()
 	this.Page = r.Uint32()
+	this.Deleted = bool(r.Intn(2) == 0)
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -2010,6 +2109,12 @@ func NewPopulatedCreateOrganizationAPIKeyRequest(r randyOrganization, easy bool)
 	for i := 0; i < v17; i++ {
 		this.Rights[i] = Right([]int32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 56, 19, 20, 21, 22, 23, 24, 25, 26, 27, 28, 29, 30, 31, 32, 33, 34, 35, 36, 37, 38, 39, 40, 41, 42, 43, 44, 45, 46, 47, 48, 49, 50, 51, 52, 53, 54, 55}[r.Intn(57)])
 	}
+	if r.Intn(5) != 0 {
+		this.ExpiresAt = github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	}
+	if r.Intn(5) != 0 {
+		this.Restrictions = NewPopulatedAPIKeyRestrictions(r, easy)
+	}
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -2021,6 +2126,8 @@ func NewPopulatedUpdateOrganizationAPIKeyRequest(r randyOrganization, easy bool)
 	this.OrganizationIdentifiers = *v18
 	v19 := NewPopulatedAPIKey(r, easy)
 	this.APIKey = *v19
+	v20 := types.NewPopulatedFieldMask(r, easy)
+	this.FieldMask = *v20
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -2028,8 +2135,8 @@ func NewPopulatedUpdateOrganizationAPIKeyRequest(r randyOrganization, easy bool)
 
 func NewPopulatedListOrganizationCollaboratorsRequest(r randyOrganization, easy bool) *ListOrganizationCollaboratorsRequest {
 	this := &ListOrganizationCollaboratorsRequest{}
-	v20 := NewPopulatedOrganizationIdentifiers(r, easy)
-	this.OrganizationIdentifiers = *v20
+	v21 := NewPopulatedOrganizationIdentifiers(r, easy)
+	this.OrganizationIdentifiers = *v21
 	this.Limit = r.Uint32()
 	this.Page = r.Uint32()
 	if !easy && r.Intn(10) != 0 {
@@ -2039,10 +2146,10 @@ func NewPopulatedListOrganizationCollaboratorsRequest(r randyOrganization, easy
 
 func NewPopulatedGetOrganizationCollaboratorRequest(r randyOrganization, easy bool) *GetOrganizationCollaboratorRequest {
 	this := &GetOrganizationCollaboratorRequest{}
-	v21 := NewPopulatedOrganizationIdentifiers(r, easy)
-	this.OrganizationIdentifiers = *v21
-	v22 := NewPopulatedOrganizationOrUserIdentifiers(r, easy)
-	this.OrganizationOrUserIdentifiers = *v22
+	v22 := NewPopulatedOrganizationIdentifiers(r, easy)
+	this.OrganizationIdentifiers = *v22
+	v23 := NewPopulatedOrganizationOrUserIdentifiers(r, easy)
+	this.OrganizationOrUserIdentifiers = *v23
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -2050,10 +2157,10 @@ func NewPopulatedGetOrganizationCollaboratorRequest(r randyOrganization, easy bo
 
 func NewPopulatedSetOrganizationCollaboratorRequest(r randyOrganization, easy bool) *SetOrganizationCollaboratorRequest {
 	this := &SetOrganizationCollaboratorRequest{}
-	v23 := NewPopulatedOrganizationIdentifiers(r, easy)
-	this.OrganizationIdentifiers = *v23
-	v24 := NewPopulatedCollaborator(r, easy)
-	this.Collaborator = *v24
+	v24 := NewPopulatedOrganizationIdentifiers(r, easy)
+	this.OrganizationIdentifiers = *v24
+	v25 := NewPopulatedCollaborator(r, easy)
+	this.Collaborator = *v25
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -2078,9 +2185,9 @@ func randUTF8RuneOrganization(r randyOrganization) rune {
 	return rune(ru + 61)
 }
 func randStringOrganization(r randyOrganization) string {
-	v25 := r.Intn(100)
-	tmps := make([]rune, v25)
-	for i := 0; i < v25; i++ {
+	v26 := r.Intn(100)
+	tmps := make([]rune, v26)
+	for i := 0; i < v26; i++ {
 		tmps[i] = randUTF8RuneOrganization(r)
 	}
 	return string(tmps)
@@ -2102,11 +2209,11 @@ func randFieldOrganization(dAtA []byte, r randyOrganization, fieldNumber int, wi
 	switch wire {
 	case 0:
 		dAtA = encodeVarintPopulateOrganization(dAtA, uint64(key))
-		v26 := r.Int63()
+		v27 := r.Int63()
 		if r.Intn(2) == 0 {
-			v26 *= -1
+			v27 *= -1
 		}
-		dAtA = encodeVarintPopulateOrganization(dAtA, uint64(v26))
+		dAtA = encodeVarintPopulateOrganization(dAtA, uint64(v27))
 	case 1:
 		dAtA = encodeVarintPopulateOrganization(dAtA, uint64(key))
 		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
@@ -2218,6 +2325,9 @@ func (m *ListOrganizationsRequest) Size() (n int) {
 	if m.Page != 0 {
 		n += 1 + sovOrganization(uint64(m.Page))
 	}
+	if m.Deleted {
+		n += 2
+	}
 	return n
 }
 
@@ -2298,6 +2408,14 @@ func (m *CreateOrganizationAPIKeyRequest) Size() (n int) {
 		}
 		n += 1 + sovOrganization(uint64(l)) + l
 	}
+	if m.ExpiresAt != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpiresAt)
+		n += 1 + l + sovOrganization(uint64(l))
+	}
+	if m.Restrictions != nil {
+		l = m.Restrictions.Size()
+		n += 1 + l + sovOrganization(uint64(l))
+	}
 	return n
 }
 
@@ -2311,6 +2429,8 @@ func (m *UpdateOrganizationAPIKeyRequest) Size() (n int) {
 	n += 1 + l + sovOrganization(uint64(l))
 	l = m.APIKey.Size()
 	n += 1 + l + sovOrganization(uint64(l))
+	l = m.FieldMask.Size()
+	n += 1 + l + sovOrganization(uint64(l))
 	return n
 }
 
@@ -2430,6 +2550,7 @@ func (this *ListOrganizationsRequest) String() string {
 		`Order:` + fmt.Sprintf("%v", this.Order) + `,`,
 		`Limit:` + fmt.Sprintf("%v", this.Limit) + `,`,
 		`Page:` + fmt.Sprintf("%v", this.Page) + `,`,
+		`Deleted:` + fmt.Sprintf("%v", this.Deleted) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -2487,6 +2608,8 @@ func (this *CreateOrganizationAPIKeyRequest) String() string {
 		`OrganizationIdentifiers:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.OrganizationIdentifiers), "OrganizationIdentifiers", "OrganizationIdentifiers", 1), `&`, ``, 1) + `,`,
 		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
 		`Rights:` + fmt.Sprintf("%v", this.Rights) + `,`,
+		`ExpiresAt:` + strings.Replace(fmt.Sprintf("%v", this.ExpiresAt), "Timestamp", "types.Timestamp", 1) + `,`,
+		`Restrictions:` + strings.Replace(fmt.Sprintf("%v", this.Restrictions), "APIKeyRestrictions", "APIKeyRestrictions", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -2498,6 +2621,7 @@ func (this *UpdateOrganizationAPIKeyRequest) String() string {
 	s := strings.Join([]string{`&UpdateOrganizationAPIKeyRequest{`,
 		`OrganizationIdentifiers:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.OrganizationIdentifiers), "OrganizationIdentifiers", "OrganizationIdentifiers", 1), `&`, ``, 1) + `,`,
 		`APIKey:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.APIKey), "APIKey", "APIKey", 1), `&`, ``, 1) + `,`,
+		`FieldMask:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.FieldMask), "FieldMask", "types.FieldMask", 1), `&`, ``, 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -3295,6 +3419,26 @@ func (m *ListOrganizationsRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deleted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrganization
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Deleted = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOrganization(dAtA[iNdEx:])
@@ -3962,6 +4106,78 @@ func (m *CreateOrganizationAPIKeyRequest) Unmarshal(dAtA []byte) error {
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field Rights", wireType)
 			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrganization
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOrganization
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrganization
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpiresAt == nil {
+				m.ExpiresAt = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.ExpiresAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Restrictions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrganization
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOrganization
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrganization
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Restrictions == nil {
+				m.Restrictions = &APIKeyRestrictions{}
+			}
+			if err := m.Restrictions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOrganization(dAtA[iNdEx:])
@@ -4081,6 +4297,39 @@ func (m *UpdateOrganizationAPIKeyRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FieldMask", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrganization
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOrganization
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrganization
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FieldMask.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOrganization(dAtA[iNdEx:])
@@ -47,6 +47,7 @@ var ListOrganizationsRequestFieldPathsNested = []string{
 	"collaborator.ids.user_ids",
 	"collaborator.ids.user_ids.email",
 	"collaborator.ids.user_ids.user_id",
+	"deleted",
 	"field_mask",
 	"limit",
 	"order",
@@ -55,6 +56,7 @@ var ListOrganizationsRequestFieldPathsNested = []string{
 
 var ListOrganizationsRequestFieldPathsTopLevel = []string{
 	"collaborator",
+	"deleted",
 	"field_mask",
 	"limit",
 	"order",
@@ -123,29 +125,42 @@ var GetOrganizationAPIKeyRequestFieldPathsTopLevel = []string{
 	"organization_ids",
 }
 var CreateOrganizationAPIKeyRequestFieldPathsNested = []string{
+	"expires_at",
 	"name",
 	"organization_ids",
 	"organization_ids.organization_id",
+	"restrictions",
+	"restrictions.end_device_ids",
+	"restrictions.source_cidrs",
 	"rights",
 }
 
 var CreateOrganizationAPIKeyRequestFieldPathsTopLevel = []string{
+	"expires_at",
 	"name",
 	"organization_ids",
+	"restrictions",
 	"rights",
 }
 var UpdateOrganizationAPIKeyRequestFieldPathsNested = []string{
 	"api_key",
+	"api_key.expires_at",
 	"api_key.id",
 	"api_key.key",
+	"api_key.last_used_at",
 	"api_key.name",
+	"api_key.restrictions",
+	"api_key.restrictions.end_device_ids",
+	"api_key.restrictions.source_cidrs",
 	"api_key.rights",
+	"field_mask",
 	"organization_ids",
 	"organization_ids.organization_id",
 }
 
 var UpdateOrganizationAPIKeyRequestFieldPathsTopLevel = []string{
 	"api_key",
+	"field_mask",
 	"organization_ids",
 }
 var ListOrganizationCollaboratorsRequestFieldPathsNested = []string{
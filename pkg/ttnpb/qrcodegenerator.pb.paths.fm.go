@@ -168,6 +168,7 @@ var GenerateEndDeviceQRCodeRequestFieldPathsNested = []string{
 	"end_device.mac_state.pending_application_downlink.confirmed",
 	"end_device.mac_state.pending_application_downlink.correlation_ids",
 	"end_device.mac_state.pending_application_downlink.decoded_payload",
+	"end_device.mac_state.pending_application_downlink.decoded_payload_warnings",
 	"end_device.mac_state.pending_application_downlink.f_cnt",
 	"end_device.mac_state.pending_application_downlink.f_port",
 	"end_device.mac_state.pending_application_downlink.frm_payload",
@@ -184,6 +185,7 @@ var GenerateEndDeviceQRCodeRequestFieldPathsNested = []string{
 	"end_device.mac_state.pending_join_request.downlink_settings.opt_neg",
 	"end_device.mac_state.pending_join_request.downlink_settings.rx1_dr_offset",
 	"end_device.mac_state.pending_join_request.downlink_settings.rx2_dr",
+	"end_device.mac_state.pending_join_request.join_eui",
 	"end_device.mac_state.pending_join_request.net_id",
 	"end_device.mac_state.pending_join_request.payload",
 	"end_device.mac_state.pending_join_request.payload.Payload",
@@ -235,6 +237,11 @@ var GenerateEndDeviceQRCodeRequestFieldPathsNested = []string{
 	"end_device.mac_state.pending_requests",
 	"end_device.mac_state.ping_slot_periodicity",
 	"end_device.mac_state.ping_slot_periodicity.value",
+	"end_device.mac_state.queued_force_rejoin_req",
+	"end_device.mac_state.queued_force_rejoin_req.data_rate_index",
+	"end_device.mac_state.queued_force_rejoin_req.max_retries",
+	"end_device.mac_state.queued_force_rejoin_req.period_exponent",
+	"end_device.mac_state.queued_force_rejoin_req.rejoin_type",
 	"end_device.mac_state.queued_join_accept",
 	"end_device.mac_state.queued_join_accept.keys",
 	"end_device.mac_state.queued_join_accept.keys.app_s_key",
@@ -266,6 +273,7 @@ var GenerateEndDeviceQRCodeRequestFieldPathsNested = []string{
 	"end_device.mac_state.queued_join_accept.request.downlink_settings.opt_neg",
 	"end_device.mac_state.queued_join_accept.request.downlink_settings.rx1_dr_offset",
 	"end_device.mac_state.queued_join_accept.request.downlink_settings.rx2_dr",
+	"end_device.mac_state.queued_join_accept.request.join_eui",
 	"end_device.mac_state.queued_join_accept.request.net_id",
 	"end_device.mac_state.queued_join_accept.request.payload",
 	"end_device.mac_state.queued_join_accept.request.payload.Payload",
@@ -390,6 +398,7 @@ var GenerateEndDeviceQRCodeRequestFieldPathsNested = []string{
 	"end_device.pending_mac_state.pending_application_downlink.confirmed",
 	"end_device.pending_mac_state.pending_application_downlink.correlation_ids",
 	"end_device.pending_mac_state.pending_application_downlink.decoded_payload",
+	"end_device.pending_mac_state.pending_application_downlink.decoded_payload_warnings",
 	"end_device.pending_mac_state.pending_application_downlink.f_cnt",
 	"end_device.pending_mac_state.pending_application_downlink.f_port",
 	"end_device.pending_mac_state.pending_application_downlink.frm_payload",
@@ -406,6 +415,7 @@ var GenerateEndDeviceQRCodeRequestFieldPathsNested = []string{
 	"end_device.pending_mac_state.pending_join_request.downlink_settings.opt_neg",
 	"end_device.pending_mac_state.pending_join_request.downlink_settings.rx1_dr_offset",
 	"end_device.pending_mac_state.pending_join_request.downlink_settings.rx2_dr",
+	"end_device.pending_mac_state.pending_join_request.join_eui",
 	"end_device.pending_mac_state.pending_join_request.net_id",
 	"end_device.pending_mac_state.pending_join_request.payload",
 	"end_device.pending_mac_state.pending_join_request.payload.Payload",
@@ -457,6 +467,11 @@ var GenerateEndDeviceQRCodeRequestFieldPathsNested = []string{
 	"end_device.pending_mac_state.pending_requests",
 	"end_device.pending_mac_state.ping_slot_periodicity",
 	"end_device.pending_mac_state.ping_slot_periodicity.value",
+	"end_device.pending_mac_state.queued_force_rejoin_req",
+	"end_device.pending_mac_state.queued_force_rejoin_req.data_rate_index",
+	"end_device.pending_mac_state.queued_force_rejoin_req.max_retries",
+	"end_device.pending_mac_state.queued_force_rejoin_req.period_exponent",
+	"end_device.pending_mac_state.queued_force_rejoin_req.rejoin_type",
 	"end_device.pending_mac_state.queued_join_accept",
 	"end_device.pending_mac_state.queued_join_accept.keys",
 	"end_device.pending_mac_state.queued_join_accept.keys.app_s_key",
@@ -488,6 +503,7 @@ var GenerateEndDeviceQRCodeRequestFieldPathsNested = []string{
 	"end_device.pending_mac_state.queued_join_accept.request.downlink_settings.opt_neg",
 	"end_device.pending_mac_state.queued_join_accept.request.downlink_settings.rx1_dr_offset",
 	"end_device.pending_mac_state.queued_join_accept.request.downlink_settings.rx2_dr",
+	"end_device.pending_mac_state.queued_join_accept.request.join_eui",
 	"end_device.pending_mac_state.queued_join_accept.request.net_id",
 	"end_device.pending_mac_state.queued_join_accept.request.payload",
 	"end_device.pending_mac_state.queued_join_accept.request.payload.Payload",
@@ -571,6 +587,10 @@ var GenerateEndDeviceQRCodeRequestFieldPathsNested = []string{
 	"end_device.picture.embedded.mime_type",
 	"end_device.picture.sizes",
 	"end_device.power_state",
+	"end_device.profile_ids",
+	"end_device.profile_ids.application_ids",
+	"end_device.profile_ids.application_ids.application_id",
+	"end_device.profile_ids.profile_id",
 	"end_device.provisioner_id",
 	"end_device.provisioning_data",
 	"end_device.queued_application_downlinks",
@@ -11,10 +11,13 @@ import (
 	reflect "reflect"
 	strconv "strconv"
 	strings "strings"
+	time "time"
 
 	_ "github.com/envoyproxy/protoc-gen-validate/validate"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/gogo/protobuf/types"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	golang_proto "github.com/golang/protobuf/proto"
 )
 
@@ -23,6 +26,7 @@ var _ = proto.Marshal
 var _ = golang_proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -282,9 +286,12 @@ func (Right) EnumDescriptor() ([]byte, []int) {
 }
 
 type Rights struct {
-	Rights               []Right  `protobuf:"varint,1,rep,packed,name=rights,proto3,enum=ttn.lorawan.v3.Right" json:"rights,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Rights []Right `protobuf:"varint,1,rep,packed,name=rights,proto3,enum=ttn.lorawan.v3.Right" json:"rights,omitempty"`
+	// Restrictions of the API key that the rights were listed for, if any.
+	// This is only set in responses of ListRights.
+	Restrictions         *APIKeyRestrictions `protobuf:"bytes,2,opt,name=restrictions,proto3" json:"restrictions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
 }
 
 func (m *Rights) Reset()      { *m = Rights{} }
@@ -326,6 +333,13 @@ func (m *Rights) GetRights() []Right {
 	return nil
 }
 
+func (m *Rights) GetRestrictions() *APIKeyRestrictions {
+	if m != nil {
+		return m.Restrictions
+	}
+	return nil
+}
+
 type APIKey struct {
 	// Immutable and unique public identifier for the API key.
 	// Generated by the Access Server.
@@ -336,9 +350,16 @@ type APIKey struct {
 	// User-defined (friendly) name for the API key.
 	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
 	// Rights that are granted to this API key.
-	Rights               []Right  `protobuf:"varint,4,rep,packed,name=rights,proto3,enum=ttn.lorawan.v3.Right" json:"rights,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Rights []Right `protobuf:"varint,4,rep,packed,name=rights,proto3,enum=ttn.lorawan.v3.Right" json:"rights,omitempty"`
+	// Time after which the API key is no longer valid. If not set, the API key does not expire.
+	ExpiresAt *time.Time `protobuf:"bytes,5,opt,name=expires_at,json=expiresAt,proto3,stdtime" json:"expires_at,omitempty"`
+	// Time at which the API key was last used.
+	// This is recorded by the Identity Server at a limited frequency, so it may lag behind.
+	LastUsedAt *time.Time `protobuf:"bytes,6,opt,name=last_used_at,json=lastUsedAt,proto3,stdtime" json:"last_used_at,omitempty"`
+	// Restrictions on the use of the API key.
+	Restrictions         *APIKeyRestrictions `protobuf:"bytes,7,opt,name=restrictions,proto3" json:"restrictions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
 }
 
 func (m *APIKey) Reset()      { *m = APIKey{} }
@@ -401,6 +422,86 @@ func (m *APIKey) GetRights() []Right {
 	return nil
 }
 
+func (m *APIKey) GetExpiresAt() *time.Time {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return nil
+}
+
+func (m *APIKey) GetLastUsedAt() *time.Time {
+	if m != nil {
+		return m.LastUsedAt
+	}
+	return nil
+}
+
+func (m *APIKey) GetRestrictions() *APIKeyRestrictions {
+	if m != nil {
+		return m.Restrictions
+	}
+	return nil
+}
+
+type APIKeyRestrictions struct {
+	// Source IP address ranges (in CIDR notation) from which the API key may be used.
+	// If empty, the API key may be used from any address.
+	// The source address is checked by the component that receives the request. For requests that are forwarded
+	// between components of the cluster, the source address is the address of the forwarding component.
+	SourceCIDRs []string `protobuf:"bytes,1,rep,name=source_cidrs,json=sourceCidrs,proto3" json:"source_cidrs,omitempty"`
+	// Device IDs of the end devices that the API key is limited to.
+	// This only applies to application API keys. If empty, the API key is not limited to specific end devices.
+	EndDeviceIDs         []string `protobuf:"bytes,2,rep,name=end_device_ids,json=endDeviceIds,proto3" json:"end_device_ids,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *APIKeyRestrictions) Reset()      { *m = APIKeyRestrictions{} }
+func (*APIKeyRestrictions) ProtoMessage() {}
+func (*APIKeyRestrictions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9bb69af2cf8904c5, []int{2}
+}
+func (m *APIKeyRestrictions) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *APIKeyRestrictions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_APIKeyRestrictions.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *APIKeyRestrictions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_APIKeyRestrictions.Merge(m, src)
+}
+func (m *APIKeyRestrictions) XXX_Size() int {
+	return m.Size()
+}
+func (m *APIKeyRestrictions) XXX_DiscardUnknown() {
+	xxx_messageInfo_APIKeyRestrictions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_APIKeyRestrictions proto.InternalMessageInfo
+
+func (m *APIKeyRestrictions) GetSourceCIDRs() []string {
+	if m != nil {
+		return m.SourceCIDRs
+	}
+	return nil
+}
+
+func (m *APIKeyRestrictions) GetEndDeviceIDs() []string {
+	if m != nil {
+		return m.EndDeviceIDs
+	}
+	return nil
+}
+
 type APIKeys struct {
 	APIKeys              []*APIKey `protobuf:"bytes,1,rep,name=api_keys,json=apiKeys,proto3" json:"api_keys,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
@@ -410,7 +511,7 @@ type APIKeys struct {
 func (m *APIKeys) Reset()      { *m = APIKeys{} }
 func (*APIKeys) ProtoMessage() {}
 func (*APIKeys) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9bb69af2cf8904c5, []int{2}
+	return fileDescriptor_9bb69af2cf8904c5, []int{3}
 }
 func (m *APIKeys) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -456,7 +557,7 @@ type Collaborator struct {
 func (m *Collaborator) Reset()      { *m = Collaborator{} }
 func (*Collaborator) ProtoMessage() {}
 func (*Collaborator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9bb69af2cf8904c5, []int{3}
+	return fileDescriptor_9bb69af2cf8904c5, []int{4}
 }
 func (m *Collaborator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -502,7 +603,7 @@ type GetCollaboratorResponse struct {
 func (m *GetCollaboratorResponse) Reset()      { *m = GetCollaboratorResponse{} }
 func (*GetCollaboratorResponse) ProtoMessage() {}
 func (*GetCollaboratorResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9bb69af2cf8904c5, []int{4}
+	return fileDescriptor_9bb69af2cf8904c5, []int{5}
 }
 func (m *GetCollaboratorResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -547,7 +648,7 @@ type Collaborators struct {
 func (m *Collaborators) Reset()      { *m = Collaborators{} }
 func (*Collaborators) ProtoMessage() {}
 func (*Collaborators) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9bb69af2cf8904c5, []int{5}
+	return fileDescriptor_9bb69af2cf8904c5, []int{6}
 }
 func (m *Collaborators) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -590,6 +691,8 @@ func init() {
 	golang_proto.RegisterType((*Rights)(nil), "ttn.lorawan.v3.Rights")
 	proto.RegisterType((*APIKey)(nil), "ttn.lorawan.v3.APIKey")
 	golang_proto.RegisterType((*APIKey)(nil), "ttn.lorawan.v3.APIKey")
+	proto.RegisterType((*APIKeyRestrictions)(nil), "ttn.lorawan.v3.APIKeyRestrictions")
+	golang_proto.RegisterType((*APIKeyRestrictions)(nil), "ttn.lorawan.v3.APIKeyRestrictions")
 	proto.RegisterType((*APIKeys)(nil), "ttn.lorawan.v3.APIKeys")
 	golang_proto.RegisterType((*APIKeys)(nil), "ttn.lorawan.v3.APIKeys")
 	proto.RegisterType((*Collaborator)(nil), "ttn.lorawan.v3.Collaborator")
@@ -606,83 +709,99 @@ func init() {
 }
 
 var fileDescriptor_9bb69af2cf8904c5 = []byte{
-	// 1210 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x96, 0x3b, 0x6c, 0xdb, 0x46,
-	0x18, 0xc7, 0x79, 0x7a, 0xe7, 0x1c, 0x39, 0x97, 0x4b, 0xec, 0x28, 0xb2, 0x73, 0x72, 0xe4, 0x3c,
-	0xdc, 0x34, 0x92, 0x5a, 0xa7, 0xaf, 0xa1, 0x28, 0x40, 0x4a, 0xb4, 0x42, 0x9b, 0x91, 0x54, 0x92,
-	0x4e, 0x90, 0x2c, 0x02, 0x6d, 0x33, 0x32, 0x61, 0x87, 0x14, 0x28, 0xc6, 0xa9, 0x3b, 0x05, 0x9d,
-	0x8c, 0x02, 0x05, 0x8a, 0x4e, 0x1d, 0x8b, 0x76, 0x09, 0xd0, 0x25, 0x5b, 0x33, 0x66, 0xf4, 0xe8,
-	0x31, 0x93, 0x11, 0x51, 0x4b, 0xc6, 0x8c, 0x86, 0xa7, 0x42, 0x24, 0x65, 0x3e, 0x24, 0xc5, 0x7d,
-	0x6c, 0xa7, 0xfb, 0x7e, 0xf7, 0xf1, 0xff, 0xff, 0xee, 0xfb, 0x0e, 0x82, 0x64, 0x5b, 0x37, 0xe4,
-	0x67, 0xb2, 0x56, 0xe8, 0x98, 0xf2, 0xfa, 0x56, 0x49, 0x6e, 0xab, 0x25, 0x43, 0x6d, 0x6d, 0x9a,
-	0x9d, 0x62, 0xdb, 0xd0, 0x4d, 0x1d, 0x4f, 0x9a, 0xa6, 0x56, 0x74, 0x99, 0xe2, 0xce, 0x9d, 0x2c,
-	0xdd, 0x52, 0xcd, 0xcd, 0xa7, 0x6b, 0xc5, 0x75, 0xfd, 0x49, 0x49, 0xd1, 0x76, 0xf4, 0xdd, 0xb6,
-	0xa1, 0x7f, 0xb7, 0x5b, 0xb2, 0xe1, 0xf5, 0x42, 0x4b, 0xd1, 0x0a, 0x3b, 0xf2, 0xb6, 0xba, 0x21,
-	0x9b, 0x4a, 0x69, 0x68, 0xe1, 0xa4, 0xcc, 0x16, 0x7c, 0x29, 0x5a, 0x7a, 0x4b, 0x77, 0x0e, 0xaf,
-	0x3d, 0x7d, 0x6c, 0xff, 0xb2, 0x7f, 0xd8, 0x2b, 0x17, 0x9f, 0x1f, 0x56, 0xa8, 0x6e, 0x28, 0x9a,
-	0xa9, 0x3e, 0x56, 0x15, 0xc3, 0x95, 0x99, 0x5f, 0x82, 0x09, 0xc1, 0x96, 0x8d, 0xbf, 0x86, 0x09,
-	0xc7, 0x40, 0x06, 0xcc, 0x45, 0x17, 0x26, 0x17, 0xa7, 0x8a, 0x41, 0x07, 0x45, 0x9b, 0x63, 0xd2,
-	0xc7, 0x0c, 0xfc, 0x05, 0x24, 0xf3, 0xf1, 0x1f, 0x40, 0x04, 0x01, 0xc1, 0x3d, 0x93, 0xff, 0x09,
-	0xc0, 0x04, 0xdd, 0xe0, 0x56, 0x94, 0x5d, 0x3c, 0x0d, 0x23, 0xea, 0x46, 0x06, 0xcc, 0x81, 0x85,
-	0x33, 0x4c, 0xc2, 0x3a, 0xcc, 0x45, 0xb8, 0x8a, 0x10, 0x51, 0x37, 0x30, 0x82, 0xd1, 0x2d, 0x65,
-	0x37, 0x13, 0xe9, 0x07, 0x84, 0xfe, 0x12, 0xcf, 0xc0, 0x98, 0x26, 0x3f, 0x51, 0x32, 0x51, 0x9b,
-	0x4d, 0x1e, 0x33, 0x31, 0x23, 0x92, 0x59, 0x14, 0xec, 0x4d, 0x9f, 0x9e, 0xd8, 0x7f, 0xd0, 0xc3,
-	0xc1, 0xa4, 0x23, 0xa7, 0x83, 0xbf, 0x81, 0x29, 0xb9, 0xad, 0x36, 0xb7, 0x94, 0x5d, 0xc7, 0xda,
-	0xc4, 0xe2, 0x74, 0x38, 0x95, 0x83, 0x32, 0x13, 0xd6, 0x61, 0x6e, 0x70, 0x4c, 0x48, 0xca, 0x6d,
-	0xb5, 0xbf, 0xc8, 0xff, 0x05, 0xe0, 0xd9, 0xb2, 0xbe, 0xbd, 0x2d, 0xaf, 0xe9, 0x86, 0x6c, 0xea,
-	0x06, 0xfe, 0x16, 0x46, 0xd5, 0x8d, 0x8e, 0xed, 0x70, 0x62, 0xb1, 0x10, 0xce, 0x55, 0x37, 0x5a,
-	0xb2, 0xa6, 0x7e, 0x2f, 0x9b, 0xaa, 0xae, 0xd5, 0x8d, 0xd5, 0x8e, 0x62, 0x70, 0x5e, 0xd5, 0x19,
-	0x74, 0xcc, 0xc4, 0x7f, 0xec, 0x2b, 0xdd, 0x3f, 0xcc, 0x51, 0x07, 0x87, 0x39, 0x20, 0xf4, 0x73,
-	0xf9, 0xcc, 0x46, 0xfe, 0xbd, 0xd9, 0xe5, 0x58, 0x2a, 0x8a, 0x62, 0xcb, 0xb1, 0x54, 0x0c, 0xc5,
-	0x97, 0x63, 0xa9, 0x38, 0x4a, 0x2c, 0xc7, 0x52, 0x09, 0x94, 0xcc, 0xff, 0x09, 0xe0, 0xa5, 0xaa,
-	0x62, 0xfa, 0xc5, 0x0b, 0x4a, 0xa7, 0xad, 0x6b, 0x1d, 0x05, 0x73, 0xff, 0xc3, 0x44, 0x2a, 0x28,
-	0xbe, 0xf0, 0x8f, 0xc4, 0x9f, 0xaa, 0x56, 0x84, 0x69, 0xbf, 0xd2, 0x0e, 0x66, 0x60, 0x7a, 0xdd,
-	0xbf, 0xe1, 0xde, 0xde, 0x6c, 0x38, 0x7d, 0xc0, 0x5f, 0xf0, 0xc8, 0xad, 0xa3, 0x49, 0x18, 0xb7,
-	0x3f, 0x8f, 0xcf, 0xc3, 0xb4, 0x2d, 0xa0, 0xa9, 0x6a, 0xf6, 0x5c, 0x21, 0x0a, 0x5f, 0x80, 0xe7,
-	0x04, 0xae, 0x7a, 0x57, 0x6a, 0xae, 0x8a, 0xac, 0xd0, 0xe4, 0x6a, 0x4b, 0x75, 0x04, 0xf0, 0x15,
-	0x78, 0xd9, 0xb7, 0x29, 0xb2, 0x92, 0xc4, 0xd5, 0xaa, 0x62, 0x93, 0xa1, 0x45, 0xae, 0x8c, 0x22,
-	0x78, 0x0e, 0xce, 0x8e, 0x0a, 0xd3, 0x0d, 0xae, 0xb9, 0xc2, 0x3e, 0x14, 0x51, 0x14, 0x4f, 0xc1,
-	0xf3, 0x3e, 0xa2, 0xc2, 0xf2, 0xac, 0xc4, 0xa2, 0x18, 0xbe, 0x0a, 0xaf, 0xf8, 0xb6, 0xe9, 0x55,
-	0xe9, 0x6e, 0x5d, 0xe0, 0x1e, 0xb1, 0x95, 0x66, 0x99, 0xe7, 0xd8, 0x9a, 0x24, 0xa2, 0x78, 0x28,
-	0x37, 0xdd, 0x68, 0xf0, 0x5c, 0x99, 0x96, 0xb8, 0x7a, 0x4d, 0x6c, 0xf2, 0x9c, 0x28, 0xa1, 0x04,
-	0xce, 0x43, 0x32, 0x8e, 0x28, 0x0b, 0x2c, 0x2d, 0xb1, 0x28, 0x89, 0x67, 0x61, 0xc6, 0xc7, 0x54,
-	0x69, 0x89, 0x7d, 0x40, 0x3f, 0x74, 0x33, 0xa4, 0x30, 0x81, 0xd9, 0x51, 0x51, 0xf7, 0xf4, 0x19,
-	0x3c, 0x03, 0x2f, 0xf9, 0xe2, 0xae, 0x36, 0xe7, 0x30, 0x0c, 0xd5, 0x66, 0x10, 0x74, 0xcf, 0x4e,
-	0x84, 0x2c, 0xd6, 0x85, 0x2a, 0x5d, 0xe3, 0x1e, 0xf9, 0x0d, 0x9c, 0xc5, 0xf3, 0x30, 0x37, 0x16,
-	0x71, 0xf3, 0xa4, 0x31, 0x86, 0x93, 0x7e, 0x97, 0x3c, 0x8f, 0x26, 0x71, 0x16, 0x4e, 0x3b, 0x7b,
-	0x3e, 0xd3, 0xce, 0x95, 0x9d, 0xc3, 0xd7, 0xe0, 0xdc, 0x70, 0x2c, 0x74, 0x73, 0x08, 0xdf, 0x84,
-	0xf3, 0x1f, 0xa0, 0x4e, 0x2e, 0xf0, 0x3c, 0xbe, 0x0d, 0x17, 0x3e, 0x00, 0x96, 0xeb, 0x3c, 0x4f,
-	0x33, 0x75, 0x81, 0x96, 0xea, 0x82, 0x88, 0xf0, 0x29, 0x69, 0x1b, 0x74, 0x79, 0x85, 0xae, 0xb2,
-	0x22, 0xfa, 0xca, 0xbb, 0x17, 0x3f, 0xe8, 0xb6, 0xc7, 0x05, 0xef, 0x66, 0x83, 0xd1, 0xfb, 0x5c,
-	0x99, 0x15, 0x9b, 0x02, 0x4b, 0x57, 0xd0, 0x45, 0xaf, 0x78, 0xa3, 0x98, 0x07, 0x02, 0x27, 0xb1,
-	0x68, 0x6a, 0xb4, 0x1e, 0x7f, 0x22, 0xc7, 0xe6, 0x34, 0x5e, 0x80, 0xd7, 0x4e, 0xc9, 0xe6, 0x90,
-	0x97, 0x46, 0x6b, 0x93, 0x04, 0x7a, 0x69, 0x89, 0x2b, 0x3b, 0xda, 0x32, 0xf8, 0x06, 0xcc, 0x8f,
-	0x67, 0x56, 0x1b, 0xae, 0xbc, 0xcb, 0xa3, 0xbf, 0x3a, 0xe0, 0x2a, 0xf5, 0x07, 0x35, 0x97, 0xcc,
-	0x8e, 0xbe, 0x71, 0x9e, 0xab, 0xad, 0xa0, 0x19, 0x7c, 0x19, 0x4e, 0x0d, 0xc7, 0xfa, 0x8d, 0x32,
-	0x8b, 0x2f, 0x42, 0xe4, 0x84, 0x9c, 0xf6, 0xb4, 0x77, 0xaf, 0xe0, 0x69, 0x88, 0x9d, 0x5d, 0xb7,
-	0xe3, 0x9d, 0xd6, 0x21, 0xde, 0xc8, 0x0d, 0xf6, 0x43, 0x6d, 0x93, 0xf3, 0x8a, 0x3e, 0x44, 0x9c,
-	0xb4, 0xcc, 0x9c, 0xe7, 0x6a, 0x08, 0x0a, 0xb6, 0xcb, 0x55, 0x9c, 0x81, 0x17, 0x83, 0xa4, 0xdb,
-	0x01, 0x79, 0x6f, 0x32, 0x07, 0x91, 0x40, 0x85, 0xe7, 0xbd, 0x2e, 0x0f, 0xc7, 0x7d, 0x55, 0xbb,
-	0x36, 0x6c, 0xd4, 0xae, 0xd8, 0x75, 0x6f, 0x74, 0x4f, 0x14, 0x4a, 0xb4, 0xb4, 0xea, 0xb6, 0xd6,
-	0x0d, 0x9c, 0x83, 0x33, 0xa1, 0x63, 0x75, 0xb7, 0xaa, 0x36, 0x70, 0xd3, 0x7b, 0xd5, 0x06, 0x40,
-	0xbf, 0xae, 0x0b, 0xde, 0x73, 0xe1, 0x1f, 0x65, 0xa7, 0xb8, 0x1f, 0xe1, 0xeb, 0xf0, 0xea, 0x88,
-	0x60, 0xa8, 0xc2, 0xb7, 0xbc, 0xe2, 0x8d, 0xc6, 0x4e, 0xca, 0xfc, 0xb1, 0xd7, 0xdb, 0xa3, 0xc9,
-	0x7b, 0xec, 0x3d, 0x86, 0x15, 0x44, 0x74, 0xdb, 0x73, 0x1b, 0x00, 0xdd, 0x52, 0x17, 0xc6, 0x7c,
-	0x71, 0xf8, 0xc1, 0x2d, 0xe2, 0x5b, 0xf0, 0xc6, 0x69, 0xa4, 0xfb, 0x6c, 0x95, 0xbc, 0x0b, 0x0a,
-	0xb0, 0xc1, 0x07, 0xf8, 0x13, 0x6f, 0x50, 0x46, 0x53, 0x6e, 0xb6, 0x4f, 0xbd, 0xbe, 0x0b, 0x70,
-	0x81, 0x07, 0x79, 0x71, 0x4c, 0x85, 0x43, 0x0f, 0xf3, 0x9d, 0x71, 0x2e, 0x2a, 0x95, 0x26, 0x1d,
-	0xec, 0x50, 0xf4, 0x99, 0x37, 0x76, 0x41, 0x96, 0xe7, 0xd1, 0xe7, 0x5e, 0x73, 0x89, 0x6c, 0xad,
-	0xd2, 0xe4, 0x6a, 0xf7, 0x39, 0x89, 0x15, 0xd1, 0x17, 0x38, 0x0d, 0xcf, 0xb8, 0xe3, 0xc8, 0xf3,
-	0xe8, 0xcb, 0x6c, 0x6c, 0xef, 0x0f, 0x42, 0x31, 0xbf, 0x83, 0xfd, 0x2e, 0x01, 0x07, 0x5d, 0x02,
-	0xde, 0x74, 0x09, 0xf5, 0xb6, 0x4b, 0xa8, 0x77, 0x5d, 0x42, 0xbd, 0xef, 0x12, 0xea, 0xa8, 0x4b,
-	0xc0, 0x73, 0x8b, 0x80, 0x3d, 0x8b, 0x50, 0x2f, 0x2c, 0x02, 0x5e, 0x5a, 0x84, 0x7a, 0x65, 0x11,
-	0xea, 0xb5, 0x45, 0xa8, 0x7d, 0x8b, 0x80, 0x03, 0x8b, 0x80, 0x37, 0x16, 0xa1, 0xde, 0x5a, 0x04,
-	0xbc, 0xb3, 0x08, 0xf5, 0xde, 0x22, 0xe0, 0xc8, 0x22, 0xd4, 0xf3, 0x1e, 0xa1, 0xf6, 0x7a, 0x04,
-	0xfc, 0xdc, 0x23, 0xd4, 0xaf, 0x3d, 0x02, 0x7e, 0xeb, 0x11, 0xea, 0x45, 0x8f, 0x50, 0x2f, 0x7b,
-	0x04, 0xbc, 0xea, 0x11, 0xf0, 0xba, 0x47, 0xc0, 0xa3, 0xdb, 0x2d, 0xbd, 0x68, 0x6e, 0x2a, 0xe6,
-	0xa6, 0xaa, 0xb5, 0x3a, 0x45, 0x4d, 0x31, 0x9f, 0xe9, 0xc6, 0x56, 0x29, 0xf8, 0x5f, 0xb8, 0xbd,
-	0xd5, 0x2a, 0x99, 0xa6, 0xd6, 0x5e, 0x5b, 0x4b, 0xd8, 0x7f, 0x83, 0xef, 0xfc, 0x1d, 0x00, 0x00,
-	0xff, 0xff, 0xe6, 0x94, 0x91, 0x73, 0xcf, 0x0b, 0x00, 0x00,
+	// 1470 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x57, 0x4d, 0x6c, 0xd3, 0x48,
+	0x14, 0xf6, 0xa4, 0x69, 0xda, 0x4e, 0x7f, 0x30, 0x03, 0x2d, 0xa1, 0x2d, 0x93, 0x36, 0x2d, 0x25,
+	0x40, 0x93, 0x40, 0xba, 0xbf, 0x08, 0x2d, 0xb2, 0x13, 0xb7, 0xb8, 0x0d, 0x49, 0xd7, 0x76, 0x41,
+	0x80, 0x8a, 0xe5, 0x26, 0x26, 0xb5, 0xda, 0xc6, 0x91, 0xed, 0x16, 0x0a, 0x5a, 0x09, 0xed, 0x09,
+	0xed, 0x61, 0x85, 0x38, 0xed, 0x71, 0xb5, 0x7b, 0x41, 0xda, 0x0b, 0xb7, 0xe5, 0xc8, 0x91, 0x23,
+	0xb7, 0x45, 0x7b, 0xe8, 0x52, 0xe7, 0xc2, 0x91, 0x23, 0xea, 0x69, 0xe5, 0x9f, 0xd4, 0x76, 0x92,
+	0x52, 0x96, 0xbd, 0x79, 0xe6, 0x7d, 0xef, 0x9b, 0xf7, 0x7d, 0xef, 0xcd, 0x28, 0x81, 0x78, 0x5d,
+	0xd5, 0xa4, 0x7b, 0x52, 0x35, 0xa9, 0x1b, 0x52, 0x69, 0x2d, 0x2d, 0xd5, 0x94, 0xb4, 0xa6, 0x54,
+	0x56, 0x0d, 0x3d, 0x55, 0xd3, 0x54, 0x43, 0x45, 0x03, 0x86, 0x51, 0x4d, 0xb9, 0x98, 0xd4, 0xd6,
+	0xcc, 0x30, 0x55, 0x51, 0x8c, 0xd5, 0xcd, 0x95, 0x54, 0x49, 0xdd, 0x48, 0xcb, 0xd5, 0x2d, 0x75,
+	0xbb, 0xa6, 0xa9, 0xf7, 0xb7, 0xd3, 0x36, 0xb8, 0x94, 0xac, 0xc8, 0xd5, 0xe4, 0x96, 0xb4, 0xae,
+	0x94, 0x25, 0x43, 0x4e, 0xb7, 0x7c, 0x38, 0x94, 0xc3, 0x49, 0x1f, 0x45, 0x45, 0xad, 0xa8, 0x4e,
+	0xf2, 0xca, 0xe6, 0x5d, 0x7b, 0x65, 0x2f, 0xec, 0x2f, 0x17, 0x1e, 0xab, 0xa8, 0x6a, 0x65, 0x5d,
+	0xf6, 0x50, 0x86, 0xb2, 0x21, 0xeb, 0x86, 0xb4, 0x51, 0x73, 0x01, 0x13, 0xad, 0x12, 0x94, 0xb2,
+	0x5c, 0x35, 0x94, 0xbb, 0x8a, 0xac, 0xb9, 0x3a, 0xe2, 0x3f, 0x03, 0x18, 0xe1, 0x6c, 0x61, 0xe8,
+	0x32, 0x8c, 0x38, 0x12, 0xa3, 0x60, 0xac, 0x23, 0x31, 0x90, 0x19, 0x4c, 0x05, 0x35, 0xa6, 0x6c,
+	0x1c, 0xdd, 0xbf, 0x47, 0xc3, 0xa7, 0xa0, 0x2b, 0xde, 0xf9, 0x23, 0x08, 0x91, 0x80, 0x73, 0x73,
+	0xd0, 0x2c, 0xec, 0xd3, 0x64, 0xdd, 0xd0, 0x94, 0x92, 0xa1, 0xa8, 0x55, 0x3d, 0x1a, 0x1a, 0x03,
+	0x89, 0xde, 0x4c, 0xbc, 0x99, 0x83, 0x5a, 0x64, 0x17, 0xe4, 0x6d, 0xce, 0x87, 0xe4, 0x02, 0x79,
+	0xf1, 0xbf, 0x43, 0x30, 0xe2, 0x80, 0xd0, 0x10, 0x0c, 0x29, 0xe5, 0x28, 0x18, 0x03, 0x89, 0x1e,
+	0x3a, 0x62, 0xee, 0xc4, 0x42, 0x6c, 0x8e, 0x0b, 0x29, 0x65, 0x44, 0xc2, 0x8e, 0x35, 0x79, 0xdb,
+	0x3e, 0xa1, 0x87, 0xb3, 0x3e, 0xd1, 0x08, 0x0c, 0x57, 0xa5, 0x0d, 0x39, 0xda, 0x61, 0x63, 0xbb,
+	0xf6, 0xe8, 0xb0, 0x16, 0x8a, 0x66, 0x38, 0x7b, 0xd3, 0xa7, 0x2b, 0xfc, 0x19, 0xba, 0xae, 0x40,
+	0x28, 0xdf, 0xaf, 0x29, 0x9a, 0xac, 0x8b, 0x92, 0x11, 0xed, 0xb4, 0x55, 0x0d, 0xa7, 0x1c, 0xef,
+	0x53, 0x0d, 0xef, 0x53, 0x42, 0xc3, 0x7b, 0x3a, 0xfc, 0xe4, 0x9f, 0x18, 0xe0, 0x7a, 0xdc, 0x1c,
+	0xca, 0x40, 0x34, 0xec, 0x5b, 0x97, 0x74, 0x43, 0xdc, 0xd4, 0xe5, 0xb2, 0x45, 0x11, 0xf9, 0x44,
+	0x0a, 0x68, 0x65, 0x2d, 0xe9, 0x72, 0x99, 0x32, 0x5a, 0xcc, 0xed, 0xfa, 0x4c, 0x73, 0xff, 0x02,
+	0x10, 0xb5, 0x82, 0xd0, 0x32, 0xec, 0xd3, 0xd5, 0x4d, 0xad, 0x24, 0x8b, 0x25, 0xa5, 0xac, 0x39,
+	0xfd, 0xef, 0xa1, 0x2f, 0xed, 0xd1, 0x89, 0xa7, 0xe0, 0x34, 0x59, 0x8e, 0x8f, 0x6b, 0xb1, 0xe8,
+	0xf9, 0xcc, 0xc8, 0x9d, 0xdb, 0x17, 0x92, 0xdf, 0x52, 0xc9, 0x59, 0x29, 0x79, 0xf7, 0x52, 0x6a,
+	0xf9, 0x7c, 0xda, 0x5a, 0x2e, 0x3f, 0xbc, 0x38, 0x3d, 0xf3, 0xc3, 0xa4, 0xb9, 0x13, 0xeb, 0xe5,
+	0x6d, 0x8a, 0x2c, 0x9b, 0xe3, 0x74, 0xae, 0xd7, 0xe1, 0xcb, 0x5a, 0x74, 0x68, 0x05, 0x0e, 0xc8,
+	0xd5, 0xb2, 0x58, 0x96, 0xb7, 0x94, 0x92, 0x2c, 0x2a, 0x65, 0x6b, 0x38, 0xac, 0x03, 0x2e, 0xef,
+	0xd1, 0x67, 0x9f, 0x82, 0xa9, 0xf8, 0xa4, 0x16, 0x8f, 0x4e, 0x66, 0xf0, 0x9d, 0xdb, 0x52, 0xf2,
+	0x81, 0x45, 0x99, 0xb8, 0x72, 0xe9, 0x76, 0x72, 0xf9, 0x4a, 0x63, 0x79, 0xf6, 0x61, 0x66, 0xda,
+	0x3e, 0xa1, 0x8f, 0xa9, 0x96, 0x73, 0x36, 0x09, 0x9b, 0xd3, 0xb9, 0x3e, 0x79, 0x7f, 0x55, 0xd6,
+	0xe3, 0x2c, 0xec, 0x72, 0x84, 0xe9, 0xe8, 0x3b, 0xd8, 0x2d, 0xd5, 0x14, 0x71, 0x4d, 0xde, 0x76,
+	0x94, 0xf4, 0x66, 0x86, 0xda, 0x1b, 0x45, 0xf7, 0x9a, 0x3b, 0xb1, 0x46, 0x1a, 0xd7, 0x25, 0xd5,
+	0x14, 0xeb, 0x23, 0xfe, 0x27, 0x80, 0x7d, 0x59, 0x75, 0x7d, 0x5d, 0x5a, 0x51, 0x35, 0xc9, 0x50,
+	0x35, 0xf4, 0x3d, 0xec, 0xb0, 0x8a, 0x06, 0xb6, 0xe9, 0xc9, 0x66, 0xae, 0xa2, 0x56, 0x91, 0xaa,
+	0xca, 0x03, 0xc9, 0xb2, 0xb2, 0xa8, 0x2d, 0xe9, 0xb2, 0xc6, 0x7a, 0xb7, 0x8c, 0x26, 0xf7, 0xe8,
+	0xce, 0x9f, 0xac, 0x81, 0x7a, 0xb5, 0x13, 0x23, 0x5e, 0xef, 0xc4, 0x00, 0x67, 0x71, 0xf9, 0x66,
+	0x32, 0xf4, 0xdf, 0x67, 0x72, 0x3e, 0xdc, 0xdd, 0x41, 0x86, 0xe7, 0xc3, 0xdd, 0x61, 0xb2, 0x73,
+	0x3e, 0xdc, 0xdd, 0x49, 0x46, 0xe6, 0xc3, 0xdd, 0x11, 0xb2, 0x2b, 0xfe, 0x07, 0x80, 0x27, 0xe6,
+	0x64, 0xc3, 0x5f, 0x3c, 0x27, 0xeb, 0x35, 0xb5, 0xaa, 0xcb, 0x88, 0xfd, 0x1f, 0x22, 0xba, 0x83,
+	0xc5, 0x27, 0x3f, 0xa9, 0xf8, 0x43, 0xab, 0xe5, 0x61, 0xbf, 0xbf, 0x52, 0x1d, 0xd1, 0xb0, 0xbf,
+	0xe4, 0xdf, 0x70, 0xbb, 0x37, 0xda, 0x4c, 0x1f, 0xd0, 0x17, 0x4c, 0x39, 0xf7, 0x61, 0x00, 0x76,
+	0xda, 0xc7, 0xa3, 0xa3, 0xb0, 0xdf, 0x2e, 0x40, 0x54, 0xaa, 0xf6, 0x43, 0x4b, 0x12, 0xe8, 0x18,
+	0x3c, 0xc2, 0xb1, 0x73, 0x57, 0x05, 0x71, 0x89, 0x67, 0x38, 0x91, 0x2d, 0xcc, 0x16, 0x49, 0x80,
+	0x4e, 0xc1, 0x93, 0xbe, 0x4d, 0x9e, 0x11, 0x04, 0xb6, 0x30, 0xc7, 0x8b, 0x34, 0xc5, 0xb3, 0x59,
+	0x32, 0x84, 0xc6, 0xe0, 0x68, 0xbb, 0x30, 0xb5, 0xc8, 0x8a, 0x0b, 0xcc, 0x4d, 0x9e, 0xec, 0x40,
+	0x83, 0xf0, 0xa8, 0x0f, 0x91, 0x63, 0xf2, 0x8c, 0xc0, 0x90, 0x61, 0x34, 0x0e, 0x4f, 0xf9, 0xb6,
+	0xa9, 0x25, 0xe1, 0x6a, 0x91, 0x63, 0x6f, 0x31, 0x39, 0x31, 0x9b, 0x67, 0x99, 0x82, 0xc0, 0x93,
+	0x9d, 0x4d, 0xdc, 0xd4, 0xe2, 0x62, 0x9e, 0xcd, 0x52, 0x02, 0x5b, 0x2c, 0xf0, 0x62, 0x9e, 0xe5,
+	0x05, 0x32, 0x82, 0xe2, 0x10, 0x1f, 0x84, 0xc8, 0x72, 0x0c, 0x25, 0x30, 0x64, 0x17, 0x1a, 0x85,
+	0x51, 0x1f, 0x66, 0x8e, 0x12, 0x98, 0x1b, 0xd4, 0x4d, 0x97, 0xa1, 0x1b, 0x61, 0x38, 0xdc, 0x2e,
+	0xea, 0x66, 0xf7, 0xa0, 0x11, 0x78, 0xc2, 0x17, 0x77, 0x6b, 0x73, 0x92, 0x61, 0x93, 0x37, 0x8d,
+	0xa0, 0x9b, 0xdb, 0xdb, 0x24, 0xb1, 0xc8, 0xcd, 0x51, 0x05, 0xf6, 0x96, 0x5f, 0x40, 0x1f, 0x9a,
+	0x80, 0xb1, 0x03, 0x21, 0x2e, 0x4f, 0x3f, 0x42, 0x70, 0xc0, 0xaf, 0x32, 0x9f, 0x27, 0x07, 0xd0,
+	0x30, 0x1c, 0x72, 0xf6, 0x7c, 0xa2, 0x9d, 0x96, 0x1d, 0x41, 0x93, 0x70, 0xac, 0x35, 0xd6, 0xd4,
+	0x39, 0x12, 0x9d, 0x81, 0x13, 0x1f, 0x41, 0xed, 0x37, 0xf0, 0x28, 0x9a, 0x86, 0x89, 0x8f, 0x00,
+	0xb3, 0xc5, 0x7c, 0x9e, 0xa2, 0x8b, 0x1c, 0x25, 0x14, 0x39, 0x9e, 0x44, 0x87, 0xd0, 0x2e, 0x52,
+	0xd9, 0x05, 0x6a, 0x8e, 0xe1, 0xc9, 0x6f, 0xbc, 0xbe, 0xf8, 0x81, 0xee, 0x78, 0x1c, 0xf3, 0x3a,
+	0x1b, 0x8c, 0x5e, 0x67, 0xb3, 0x0c, 0x2f, 0x72, 0x0c, 0x95, 0x23, 0x8f, 0x7b, 0xe6, 0xb5, 0xc3,
+	0xdc, 0xe0, 0x58, 0x81, 0x21, 0x07, 0xdb, 0xd7, 0xe3, 0x27, 0x72, 0x64, 0x0e, 0xa1, 0x04, 0x9c,
+	0x3c, 0x84, 0xcd, 0x41, 0x9e, 0x68, 0x5f, 0x9b, 0xc0, 0x51, 0xb3, 0xb3, 0x6c, 0xd6, 0xa9, 0x2d,
+	0x8a, 0xa6, 0x60, 0xfc, 0x60, 0xcc, 0xd2, 0xa2, 0x5b, 0xde, 0xc9, 0xf6, 0xa7, 0x36, 0x70, 0xb9,
+	0xe2, 0x8d, 0x82, 0x8b, 0x1c, 0x6e, 0xdf, 0xf1, 0x3c, 0x5b, 0x58, 0x20, 0x47, 0xd0, 0x49, 0x38,
+	0xd8, 0x1a, 0xb3, 0x06, 0x65, 0x14, 0x1d, 0x87, 0xa4, 0x13, 0x72, 0xc6, 0xd3, 0xde, 0x3d, 0x85,
+	0x86, 0x20, 0x72, 0x76, 0xdd, 0x89, 0x77, 0x46, 0x07, 0x7b, 0x57, 0xae, 0xb1, 0xdf, 0x34, 0x36,
+	0x31, 0xcf, 0xf4, 0x16, 0xc4, 0xfe, 0xc8, 0x8c, 0x79, 0xaa, 0x5a, 0x40, 0xc1, 0x71, 0x19, 0x47,
+	0x51, 0x78, 0x3c, 0x88, 0x74, 0x27, 0x20, 0xee, 0xdd, 0xcc, 0x46, 0x24, 0xe0, 0xf0, 0x84, 0x37,
+	0xe5, 0xcd, 0x71, 0x9f, 0x6b, 0x93, 0xad, 0x42, 0x6d, 0xc7, 0x4e, 0x7b, 0x57, 0x77, 0xbf, 0x42,
+	0x81, 0x12, 0x96, 0xdc, 0xd1, 0x9a, 0x42, 0x31, 0x38, 0xd2, 0x94, 0x56, 0x74, 0x5d, 0xb5, 0x01,
+	0x67, 0xbc, 0x57, 0xad, 0x01, 0xb0, 0x7c, 0x4d, 0x78, 0xcf, 0x85, 0xff, 0x2a, 0x3b, 0xe6, 0x9e,
+	0x45, 0xa7, 0xe1, 0x78, 0x9b, 0x60, 0x93, 0xc3, 0xe7, 0x3c, 0xf3, 0xda, 0xc3, 0xf6, 0x6d, 0x3e,
+	0xef, 0xcd, 0x76, 0x7b, 0xe4, 0x35, 0xe6, 0x1a, 0xcd, 0x70, 0x3c, 0x39, 0xed, 0xa9, 0x0d, 0x00,
+	0x5d, 0xab, 0x93, 0x07, 0x9c, 0xd8, 0xfa, 0xe0, 0xa6, 0xd0, 0x39, 0x38, 0x75, 0x18, 0xd2, 0x7d,
+	0xb6, 0xd2, 0x5e, 0x83, 0x02, 0xd8, 0xe0, 0x03, 0x7c, 0xc1, 0xbb, 0x28, 0xed, 0x51, 0x2e, 0xdb,
+	0x45, 0x6f, 0xee, 0x02, 0xb8, 0xc0, 0x83, 0x9c, 0x39, 0xc0, 0xe1, 0xa6, 0x87, 0x79, 0xe6, 0x20,
+	0x15, 0xb9, 0x9c, 0x48, 0x05, 0x27, 0x94, 0xfc, 0xc2, 0xbb, 0x76, 0x41, 0x6c, 0x3e, 0x4f, 0x7e,
+	0xe9, 0x0d, 0x17, 0xcf, 0x14, 0x72, 0x22, 0x5b, 0xb8, 0xce, 0x0a, 0x0c, 0x4f, 0x7e, 0x85, 0xfa,
+	0x61, 0x8f, 0xb3, 0x6f, 0xc1, 0xbe, 0x1e, 0x0e, 0x3f, 0xfe, 0x1d, 0x13, 0xf4, 0x6f, 0xe0, 0xd5,
+	0x2e, 0x06, 0xaf, 0x77, 0x31, 0x78, 0xb3, 0x8b, 0x89, 0xb7, 0xbb, 0x98, 0x78, 0xb7, 0x8b, 0x89,
+	0xf7, 0xbb, 0x98, 0xf8, 0xb0, 0x8b, 0xc1, 0x23, 0x13, 0x83, 0xc7, 0x26, 0x26, 0x9e, 0x99, 0x18,
+	0x3c, 0x37, 0x31, 0xf1, 0xc2, 0xc4, 0xc4, 0x4b, 0x13, 0x13, 0xaf, 0x4c, 0x0c, 0x5e, 0x9b, 0x18,
+	0xbc, 0x31, 0x31, 0xf1, 0xd6, 0xc4, 0xe0, 0x9d, 0x89, 0x89, 0xf7, 0x26, 0x06, 0x1f, 0x4c, 0x4c,
+	0x3c, 0xaa, 0x63, 0xe2, 0x71, 0x1d, 0x83, 0x27, 0x75, 0x4c, 0xfc, 0x52, 0xc7, 0xe0, 0xd7, 0x3a,
+	0x26, 0x9e, 0xd5, 0x31, 0xf1, 0xbc, 0x8e, 0xc1, 0x8b, 0x3a, 0x06, 0x2f, 0xeb, 0x18, 0xdc, 0x9a,
+	0xae, 0xa8, 0x29, 0x63, 0x55, 0x36, 0x56, 0x95, 0x6a, 0x45, 0x4f, 0x55, 0x65, 0xe3, 0x9e, 0xaa,
+	0xad, 0xa5, 0x83, 0xff, 0x7d, 0x6a, 0x6b, 0x95, 0xb4, 0x61, 0x54, 0x6b, 0x2b, 0x2b, 0x11, 0xfb,
+	0xf7, 0xf6, 0xcc, 0xbf, 0x03, 0x00, 0x78, 0x7a, 0x27, 0xfa, 0xe0, 0x0d, 0x00, 0x00,
 }
 
 func (x Right) String() string {
@@ -719,6 +838,9 @@ func (this *Rights) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if !this.Restrictions.Equal(that1.Restrictions) {
+		return false
+	}
 	return true
 }
 func (this *APIKey) Equal(that interface{}) bool {
@@ -757,6 +879,60 @@ func (this *APIKey) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if that1.ExpiresAt == nil {
+		if this.ExpiresAt != nil {
+			return false
+		}
+	} else if !this.ExpiresAt.Equal(*that1.ExpiresAt) {
+		return false
+	}
+	if that1.LastUsedAt == nil {
+		if this.LastUsedAt != nil {
+			return false
+		}
+	} else if !this.LastUsedAt.Equal(*that1.LastUsedAt) {
+		return false
+	}
+	if !this.Restrictions.Equal(that1.Restrictions) {
+		return false
+	}
+	return true
+}
+func (this *APIKeyRestrictions) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*APIKeyRestrictions)
+	if !ok {
+		that2, ok := that.(APIKeyRestrictions)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.SourceCIDRs) != len(that1.SourceCIDRs) {
+		return false
+	}
+	for i := range this.SourceCIDRs {
+		if this.SourceCIDRs[i] != that1.SourceCIDRs[i] {
+			return false
+		}
+	}
+	if len(this.EndDeviceIDs) != len(that1.EndDeviceIDs) {
+		return false
+	}
+	for i := range this.EndDeviceIDs {
+		if this.EndDeviceIDs[i] != that1.EndDeviceIDs[i] {
+			return false
+		}
+	}
 	return true
 }
 func (this *APIKeys) Equal(that interface{}) bool {
@@ -901,21 +1077,33 @@ func (m *Rights) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Restrictions != nil {
+		{
+			size, err := m.Restrictions.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRights(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.Rights) > 0 {
-		dAtA2 := make([]byte, len(m.Rights)*10)
-		var j1 int
+		dAtA3 := make([]byte, len(m.Rights)*10)
+		var j2 int
 		for _, num := range m.Rights {
 			for num >= 1<<7 {
-				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA3[j2] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j1++
+				j2++
 			}
-			dAtA2[j1] = uint8(num)
-			j1++
+			dAtA3[j2] = uint8(num)
+			j2++
 		}
-		i -= j1
-		copy(dAtA[i:], dAtA2[:j1])
-		i = encodeVarintRights(dAtA, i, uint64(j1))
+		i -= j2
+		copy(dAtA[i:], dAtA3[:j2])
+		i = encodeVarintRights(dAtA, i, uint64(j2))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -942,21 +1130,53 @@ func (m *APIKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Restrictions != nil {
+		{
+			size, err := m.Restrictions.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRights(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.LastUsedAt != nil {
+		n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastUsedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastUsedAt):])
+		if err5 != nil {
+			return 0, err5
+		}
+		i -= n5
+		i = encodeVarintRights(dAtA, i, uint64(n5))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.ExpiresAt != nil {
+		n6, err6 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ExpiresAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpiresAt):])
+		if err6 != nil {
+			return 0, err6
+		}
+		i -= n6
+		i = encodeVarintRights(dAtA, i, uint64(n6))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if len(m.Rights) > 0 {
-		dAtA4 := make([]byte, len(m.Rights)*10)
-		var j3 int
+		dAtA8 := make([]byte, len(m.Rights)*10)
+		var j7 int
 		for _, num := range m.Rights {
 			for num >= 1<<7 {
-				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA8[j7] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j3++
+				j7++
 			}
-			dAtA4[j3] = uint8(num)
-			j3++
+			dAtA8[j7] = uint8(num)
+			j7++
 		}
-		i -= j3
-		copy(dAtA[i:], dAtA4[:j3])
-		i = encodeVarintRights(dAtA, i, uint64(j3))
+		i -= j7
+		copy(dAtA[i:], dAtA8[:j7])
+		i = encodeVarintRights(dAtA, i, uint64(j7))
 		i--
 		dAtA[i] = 0x22
 	}
@@ -984,6 +1204,47 @@ func (m *APIKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *APIKeyRestrictions) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *APIKeyRestrictions) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *APIKeyRestrictions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EndDeviceIDs) > 0 {
+		for iNdEx := len(m.EndDeviceIDs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.EndDeviceIDs[iNdEx])
+			copy(dAtA[i:], m.EndDeviceIDs[iNdEx])
+			i = encodeVarintRights(dAtA, i, uint64(len(m.EndDeviceIDs[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.SourceCIDRs) > 0 {
+		for iNdEx := len(m.SourceCIDRs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.SourceCIDRs[iNdEx])
+			copy(dAtA[i:], m.SourceCIDRs[iNdEx])
+			i = encodeVarintRights(dAtA, i, uint64(len(m.SourceCIDRs[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *APIKeys) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1042,20 +1303,20 @@ func (m *Collaborator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if len(m.Rights) > 0 {
-		dAtA6 := make([]byte, len(m.Rights)*10)
-		var j5 int
+		dAtA10 := make([]byte, len(m.Rights)*10)
+		var j9 int
 		for _, num := range m.Rights {
 			for num >= 1<<7 {
-				dAtA6[j5] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA10[j9] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j5++
+				j9++
 			}
-			dAtA6[j5] = uint8(num)
-			j5++
+			dAtA10[j9] = uint8(num)
+			j9++
 		}
-		i -= j5
-		copy(dAtA[i:], dAtA6[:j5])
-		i = encodeVarintRights(dAtA, i, uint64(j5))
+		i -= j9
+		copy(dAtA[i:], dAtA10[:j9])
+		i = encodeVarintRights(dAtA, i, uint64(j9))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -1093,20 +1354,20 @@ func (m *GetCollaboratorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	var l int
 	_ = l
 	if len(m.Rights) > 0 {
-		dAtA9 := make([]byte, len(m.Rights)*10)
-		var j8 int
+		dAtA13 := make([]byte, len(m.Rights)*10)
+		var j12 int
 		for _, num := range m.Rights {
 			for num >= 1<<7 {
-				dAtA9[j8] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA13[j12] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j8++
+				j12++
 			}
-			dAtA9[j8] = uint8(num)
-			j8++
+			dAtA13[j12] = uint8(num)
+			j12++
 		}
-		i -= j8
-		copy(dAtA[i:], dAtA9[:j8])
-		i = encodeVarintRights(dAtA, i, uint64(j8))
+		i -= j12
+		copy(dAtA[i:], dAtA13[:j12])
+		i = encodeVarintRights(dAtA, i, uint64(j12))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -1178,6 +1439,9 @@ func NewPopulatedRights(r randyRights, easy bool) *Rights {
 	for i := 0; i < v1; i++ {
 		this.Rights[i] = Right([]int32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 56, 19, 20, 21, 22, 23, 24, 25, 26, 27, 28, 29, 30, 31, 32, 33, 34, 35, 36, 37, 38, 39, 40, 41, 42, 43, 44, 45, 46, 47, 48, 49, 50, 51, 52, 53, 54, 55}[r.Intn(57)])
 	}
+	if r.Intn(5) != 0 {
+		this.Restrictions = NewPopulatedAPIKeyRestrictions(r, easy)
+	}
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -1193,6 +1457,32 @@ func NewPopulatedAPIKey(r randyRights, easy bool) *APIKey {
 	for i := 0; i < v2; i++ {
 		this.Rights[i] = Right([]int32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 56, 19, 20, 21, 22, 23, 24, 25, 26, 27, 28, 29, 30, 31, 32, 33, 34, 35, 36, 37, 38, 39, 40, 41, 42, 43, 44, 45, 46, 47, 48, 49, 50, 51, 52, 53, 54, 55}[r.Intn(57)])
 	}
+	if r.Intn(5) != 0 {
+		this.ExpiresAt = github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	}
+	if r.Intn(5) != 0 {
+		this.LastUsedAt = github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	}
+	if r.Intn(5) != 0 {
+		this.Restrictions = NewPopulatedAPIKeyRestrictions(r, easy)
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedAPIKeyRestrictions(r randyRights, easy bool) *APIKeyRestrictions {
+	this := &APIKeyRestrictions{}
+	v3 := r.Intn(10)
+	this.SourceCIDRs = make([]string, v3)
+	for i := 0; i < v3; i++ {
+		this.SourceCIDRs[i] = randStringRights(r)
+	}
+	v4 := r.Intn(10)
+	this.EndDeviceIDs = make([]string, v4)
+	for i := 0; i < v4; i++ {
+		this.EndDeviceIDs[i] = randStringRights(r)
+	}
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -1201,9 +1491,9 @@ func NewPopulatedAPIKey(r randyRights, easy bool) *APIKey {
 func NewPopulatedAPIKeys(r randyRights, easy bool) *APIKeys {
 	this := &APIKeys{}
 	if r.Intn(5) != 0 {
-		v3 := r.Intn(5)
-		this.APIKeys = make([]*APIKey, v3)
-		for i := 0; i < v3; i++ {
+		v5 := r.Intn(5)
+		this.APIKeys = make([]*APIKey, v5)
+		for i := 0; i < v5; i++ {
 			this.APIKeys[i] = NewPopulatedAPIKey(r, easy)
 		}
 	}
@@ -1214,11 +1504,11 @@ func NewPopulatedAPIKeys(r randyRights, easy bool) *APIKeys {
 
 func NewPopulatedCollaborator(r randyRights, easy bool) *Collaborator {
 	this := &Collaborator{}
-	v4 := NewPopulatedOrganizationOrUserIdentifiers(r, easy)
-	this.OrganizationOrUserIdentifiers = *v4
-	v5 := r.Intn(10)
-	this.Rights = make([]Right, v5)
-	for i := 0; i < v5; i++ {
+	v6 := NewPopulatedOrganizationOrUserIdentifiers(r, easy)
+	this.OrganizationOrUserIdentifiers = *v6
+	v7 := r.Intn(10)
+	this.Rights = make([]Right, v7)
+	for i := 0; i < v7; i++ {
 		this.Rights[i] = Right([]int32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 56, 19, 20, 21, 22, 23, 24, 25, 26, 27, 28, 29, 30, 31, 32, 33, 34, 35, 36, 37, 38, 39, 40, 41, 42, 43, 44, 45, 46, 47, 48, 49, 50, 51, 52, 53, 54, 55}[r.Intn(57)])
 	}
 	if !easy && r.Intn(10) != 0 {
@@ -1228,11 +1518,11 @@ func NewPopulatedCollaborator(r randyRights, easy bool) *Collaborator {
 
 func NewPopulatedGetCollaboratorResponse(r randyRights, easy bool) *GetCollaboratorResponse {
 	this := &GetCollaboratorResponse{}
-	v6 := NewPopulatedOrganizationOrUserIdentifiers(r, easy)
-	this.OrganizationOrUserIdentifiers = *v6
-	v7 := r.Intn(10)
-	this.Rights = make([]Right, v7)
-	for i := 0; i < v7; i++ {
+	v8 := NewPopulatedOrganizationOrUserIdentifiers(r, easy)
+	this.OrganizationOrUserIdentifiers = *v8
+	v9 := r.Intn(10)
+	this.Rights = make([]Right, v9)
+	for i := 0; i < v9; i++ {
 		this.Rights[i] = Right([]int32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 56, 19, 20, 21, 22, 23, 24, 25, 26, 27, 28, 29, 30, 31, 32, 33, 34, 35, 36, 37, 38, 39, 40, 41, 42, 43, 44, 45, 46, 47, 48, 49, 50, 51, 52, 53, 54, 55}[r.Intn(57)])
 	}
 	if !easy && r.Intn(10) != 0 {
@@ -1243,9 +1533,9 @@ func NewPopulatedGetCollaboratorResponse(r randyRights, easy bool) *GetCollabora
 func NewPopulatedCollaborators(r randyRights, easy bool) *Collaborators {
 	this := &Collaborators{}
 	if r.Intn(5) != 0 {
-		v8 := r.Intn(5)
-		this.Collaborators = make([]*Collaborator, v8)
-		for i := 0; i < v8; i++ {
+		v10 := r.Intn(5)
+		this.Collaborators = make([]*Collaborator, v10)
+		for i := 0; i < v10; i++ {
 			this.Collaborators[i] = NewPopulatedCollaborator(r, easy)
 		}
 	}
@@ -1273,9 +1563,9 @@ func randUTF8RuneRights(r randyRights) rune {
 	return rune(ru + 61)
 }
 func randStringRights(r randyRights) string {
-	v9 := r.Intn(100)
-	tmps := make([]rune, v9)
-	for i := 0; i < v9; i++ {
+	v11 := r.Intn(100)
+	tmps := make([]rune, v11)
+	for i := 0; i < v11; i++ {
 		tmps[i] = randUTF8RuneRights(r)
 	}
 	return string(tmps)
@@ -1297,11 +1587,11 @@ func randFieldRights(dAtA []byte, r randyRights, fieldNumber int, wire int) []by
 	switch wire {
 	case 0:
 		dAtA = encodeVarintPopulateRights(dAtA, uint64(key))
-		v10 := r.Int63()
+		v12 := r.Int63()
 		if r.Intn(2) == 0 {
-			v10 *= -1
+			v12 *= -1
 		}
-		dAtA = encodeVarintPopulateRights(dAtA, uint64(v10))
+		dAtA = encodeVarintPopulateRights(dAtA, uint64(v12))
 	case 1:
 		dAtA = encodeVarintPopulateRights(dAtA, uint64(key))
 		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
@@ -1339,6 +1629,10 @@ func (m *Rights) Size() (n int) {
 		}
 		n += 1 + sovRights(uint64(l)) + l
 	}
+	if m.Restrictions != nil {
+		l = m.Restrictions.Size()
+		n += 1 + l + sovRights(uint64(l))
+	}
 	return n
 }
 
@@ -1367,6 +1661,39 @@ func (m *APIKey) Size() (n int) {
 		}
 		n += 1 + sovRights(uint64(l)) + l
 	}
+	if m.ExpiresAt != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpiresAt)
+		n += 1 + l + sovRights(uint64(l))
+	}
+	if m.LastUsedAt != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastUsedAt)
+		n += 1 + l + sovRights(uint64(l))
+	}
+	if m.Restrictions != nil {
+		l = m.Restrictions.Size()
+		n += 1 + l + sovRights(uint64(l))
+	}
+	return n
+}
+
+func (m *APIKeyRestrictions) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SourceCIDRs) > 0 {
+		for _, s := range m.SourceCIDRs {
+			l = len(s)
+			n += 1 + l + sovRights(uint64(l))
+		}
+	}
+	if len(m.EndDeviceIDs) > 0 {
+		for _, s := range m.EndDeviceIDs {
+			l = len(s)
+			n += 1 + l + sovRights(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1448,6 +1775,7 @@ func (this *Rights) String() string {
 	}
 	s := strings.Join([]string{`&Rights{`,
 		`Rights:` + fmt.Sprintf("%v", this.Rights) + `,`,
+		`Restrictions:` + strings.Replace(this.Restrictions.String(), "APIKeyRestrictions", "APIKeyRestrictions", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -1461,6 +1789,20 @@ func (this *APIKey) String() string {
 		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
 		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
 		`Rights:` + fmt.Sprintf("%v", this.Rights) + `,`,
+		`ExpiresAt:` + strings.Replace(fmt.Sprintf("%v", this.ExpiresAt), "Timestamp", "types.Timestamp", 1) + `,`,
+		`LastUsedAt:` + strings.Replace(fmt.Sprintf("%v", this.LastUsedAt), "Timestamp", "types.Timestamp", 1) + `,`,
+		`Restrictions:` + strings.Replace(this.Restrictions.String(), "APIKeyRestrictions", "APIKeyRestrictions", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *APIKeyRestrictions) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&APIKeyRestrictions{`,
+		`SourceCIDRs:` + fmt.Sprintf("%v", this.SourceCIDRs) + `,`,
+		`EndDeviceIDs:` + fmt.Sprintf("%v", this.EndDeviceIDs) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -1623,6 +1965,42 @@ func (m *Rights) Unmarshal(dAtA []byte) error {
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field Rights", wireType)
 			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Restrictions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRights
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRights
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRights
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Restrictions == nil {
+				m.Restrictions = &APIKeyRestrictions{}
+			}
+			if err := m.Restrictions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRights(dAtA[iNdEx:])
@@ -1841,6 +2219,231 @@ func (m *APIKey) Unmarshal(dAtA []byte) error {
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field Rights", wireType)
 			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRights
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRights
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRights
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpiresAt == nil {
+				m.ExpiresAt = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.ExpiresAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUsedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRights
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRights
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRights
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastUsedAt == nil {
+				m.LastUsedAt = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.LastUsedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Restrictions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRights
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRights
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRights
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Restrictions == nil {
+				m.Restrictions = &APIKeyRestrictions{}
+			}
+			if err := m.Restrictions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRights(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRights
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRights
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *APIKeyRestrictions) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRights
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: APIKeyRestrictions: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: APIKeyRestrictions: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceCIDRs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRights
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRights
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRights
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceCIDRs = append(m.SourceCIDRs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndDeviceIDs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRights
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRights
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRights
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EndDeviceIDs = append(m.EndDeviceIDs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRights(dAtA[iNdEx:])
@@ -3,25 +3,46 @@
 package ttnpb
 
 var RightsFieldPathsNested = []string{
+	"restrictions",
+	"restrictions.end_device_ids",
+	"restrictions.source_cidrs",
 	"rights",
 }
 
 var RightsFieldPathsTopLevel = []string{
+	"restrictions",
 	"rights",
 }
 var APIKeyFieldPathsNested = []string{
+	"expires_at",
 	"id",
 	"key",
+	"last_used_at",
 	"name",
+	"restrictions",
+	"restrictions.end_device_ids",
+	"restrictions.source_cidrs",
 	"rights",
 }
 
 var APIKeyFieldPathsTopLevel = []string{
+	"expires_at",
 	"id",
 	"key",
+	"last_used_at",
 	"name",
+	"restrictions",
 	"rights",
 }
+var APIKeyRestrictionsFieldPathsNested = []string{
+	"end_device_ids",
+	"source_cidrs",
+}
+
+var APIKeyRestrictionsFieldPathsTopLevel = []string{
+	"end_device_ids",
+	"source_cidrs",
+}
 var APIKeysFieldPathsNested = []string{
 	"api_keys",
 }
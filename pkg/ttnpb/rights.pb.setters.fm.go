@@ -16,6 +16,31 @@ func (dst *Rights) SetFields(src *Rights, paths ...string) error {
 			} else {
 				dst.Rights = nil
 			}
+		case "restrictions":
+			if len(subs) > 0 {
+				var newDst, newSrc *APIKeyRestrictions
+				if (src == nil || src.Restrictions == nil) && dst.Restrictions == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.Restrictions
+				}
+				if dst.Restrictions != nil {
+					newDst = dst.Restrictions
+				} else {
+					newDst = &APIKeyRestrictions{}
+					dst.Restrictions = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.Restrictions = src.Restrictions
+				} else {
+					dst.Restrictions = nil
+				}
+			}
 
 		default:
 			return fmt.Errorf("invalid field: '%s'", name)
@@ -66,6 +91,78 @@ func (dst *APIKey) SetFields(src *APIKey, paths ...string) error {
 			} else {
 				dst.Rights = nil
 			}
+		case "expires_at":
+			if len(subs) > 0 {
+				return fmt.Errorf("'expires_at' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.ExpiresAt = src.ExpiresAt
+			} else {
+				dst.ExpiresAt = nil
+			}
+		case "last_used_at":
+			if len(subs) > 0 {
+				return fmt.Errorf("'last_used_at' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.LastUsedAt = src.LastUsedAt
+			} else {
+				dst.LastUsedAt = nil
+			}
+		case "restrictions":
+			if len(subs) > 0 {
+				var newDst, newSrc *APIKeyRestrictions
+				if (src == nil || src.Restrictions == nil) && dst.Restrictions == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.Restrictions
+				}
+				if dst.Restrictions != nil {
+					newDst = dst.Restrictions
+				} else {
+					newDst = &APIKeyRestrictions{}
+					dst.Restrictions = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.Restrictions = src.Restrictions
+				} else {
+					dst.Restrictions = nil
+				}
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *APIKeyRestrictions) SetFields(src *APIKeyRestrictions, paths ...string) error {
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		switch name {
+		case "source_cidrs":
+			if len(subs) > 0 {
+				return fmt.Errorf("'source_cidrs' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.SourceCIDRs = src.SourceCIDRs
+			} else {
+				dst.SourceCIDRs = nil
+			}
+		case "end_device_ids":
+			if len(subs) > 0 {
+				return fmt.Errorf("'end_device_ids' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.EndDeviceIDs = src.EndDeviceIDs
+			} else {
+				dst.EndDeviceIDs = nil
+			}
 
 		default:
 			return fmt.Errorf("invalid field: '%s'", name)
@@ -64,6 +64,18 @@ func (m *Rights) ValidateFields(paths ...string) error {
 
 			}
 
+		case "restrictions":
+
+			if v, ok := interface{}(m.GetRestrictions()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return RightsValidationError{
+						field:  "restrictions",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
 		default:
 			return RightsValidationError{
 				field:  name,
@@ -170,6 +182,42 @@ func (m *APIKey) ValidateFields(paths ...string) error {
 
 			}
 
+		case "expires_at":
+
+			if v, ok := interface{}(m.GetExpiresAt()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return APIKeyValidationError{
+						field:  "expires_at",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "last_used_at":
+
+			if v, ok := interface{}(m.GetLastUsedAt()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return APIKeyValidationError{
+						field:  "last_used_at",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "restrictions":
+
+			if v, ok := interface{}(m.GetRestrictions()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return APIKeyValidationError{
+						field:  "restrictions",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
 		default:
 			return APIKeyValidationError{
 				field:  name,
@@ -234,6 +282,140 @@ var _ interface {
 	ErrorName() string
 } = APIKeyValidationError{}
 
+// ValidateFields checks the field values on APIKeyRestrictions with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *APIKeyRestrictions) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = APIKeyRestrictionsFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "source_cidrs":
+
+			if len(m.GetSourceCIDRs()) > 100 {
+				return APIKeyRestrictionsValidationError{
+					field:  "source_cidrs",
+					reason: "value must contain no more than 100 item(s)",
+				}
+			}
+
+			for idx, item := range m.GetSourceCIDRs() {
+				_, _ = idx, item
+
+				if utf8.RuneCountInString(item) > 43 {
+					return APIKeyRestrictionsValidationError{
+						field:  fmt.Sprintf("source_cidrs[%v]", idx),
+						reason: "value length must be at most 43 runes",
+					}
+				}
+
+				if !_APIKeyRestrictions_SourceCIDRs_Pattern.MatchString(item) {
+					return APIKeyRestrictionsValidationError{
+						field:  fmt.Sprintf("source_cidrs[%v]", idx),
+						reason: "value does not match regex pattern \"^[0-9A-Fa-f:.]+/[0-9]{1,3}$\"",
+					}
+				}
+
+			}
+
+		case "end_device_ids":
+
+			for idx, item := range m.GetEndDeviceIDs() {
+				_, _ = idx, item
+
+				if utf8.RuneCountInString(item) > 36 {
+					return APIKeyRestrictionsValidationError{
+						field:  fmt.Sprintf("end_device_ids[%v]", idx),
+						reason: "value length must be at most 36 runes",
+					}
+				}
+
+				if !_APIKeyRestrictions_EndDeviceIDs_Pattern.MatchString(item) {
+					return APIKeyRestrictionsValidationError{
+						field:  fmt.Sprintf("end_device_ids[%v]", idx),
+						reason: "value does not match regex pattern \"^[a-z0-9](?:[-]?[a-z0-9]){2,}$\"",
+					}
+				}
+
+			}
+
+		default:
+			return APIKeyRestrictionsValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// APIKeyRestrictionsValidationError is the validation error returned by
+// APIKeyRestrictions.ValidateFields if the designated constraints aren't met.
+type APIKeyRestrictionsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e APIKeyRestrictionsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e APIKeyRestrictionsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e APIKeyRestrictionsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e APIKeyRestrictionsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e APIKeyRestrictionsValidationError) ErrorName() string {
+	return "APIKeyRestrictionsValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e APIKeyRestrictionsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAPIKeyRestrictions.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = APIKeyRestrictionsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = APIKeyRestrictionsValidationError{}
+
+var _APIKeyRestrictions_SourceCIDRs_Pattern = regexp.MustCompile("^[0-9A-Fa-f:.]+/[0-9]{1,3}$")
+
+var _APIKeyRestrictions_EndDeviceIDs_Pattern = regexp.MustCompile("^[a-z0-9](?:[-]?[a-z0-9]){2,}$")
+
 // ValidateFields checks the field values on APIKeys with the rules defined in
 // the proto definition for this message. If any rules are violated, an error
 // is returned.
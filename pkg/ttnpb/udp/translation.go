@@ -316,6 +316,7 @@ func ToDownlinkMessage(tx *TxPacket) (*ttnpb.DownlinkMessage, error) {
 		DataRate:  tx.DatR.DataRate,
 		Frequency: uint64(tx.Freq * 1000000),
 		Downlink: &ttnpb.TxSettings_Downlink{
+			AntennaIndex:       uint32(tx.Ant),
 			InvertPolarization: tx.IPol,
 			TxPower:            float32(tx.Powe) + eirpDelta,
 		},
@@ -349,6 +350,7 @@ func FromDownlinkMessage(msg *ttnpb.DownlinkMessage) (*TxPacket, error) {
 	}
 	tx := &TxPacket{
 		Freq: float64(scheduled.Frequency) / 1000000,
+		Ant:  uint8(scheduled.Downlink.AntennaIndex),
 		IPol: scheduled.Downlink.InvertPolarization,
 		Powe: uint8(scheduled.Downlink.TxPower - eirpDelta),
 		Size: uint16(len(payload)),
@@ -342,6 +342,7 @@ func TestFromDownlinkMessage(t *testing.T) {
 					},
 				},
 				Downlink: &ttnpb.TxSettings_Downlink{
+					AntennaIndex:       2,
 					TxPower:            20,
 					InvertPolarization: true,
 				},
@@ -354,6 +355,7 @@ func TestFromDownlinkMessage(t *testing.T) {
 	a.So(err, should.BeNil)
 	a.So(tx.DatR, should.Resemble, datarate.DR{DataRate: ttnpb.DataRate{Modulation: &ttnpb.DataRate_LoRa{LoRa: &ttnpb.LoRaDataRate{Bandwidth: 500000, SpreadingFactor: 10}}}})
 	a.So(tx.Tmst, should.Equal, 1886440700)
+	a.So(tx.Ant, should.Equal, 2)
 	a.So(tx.NCRC, should.Equal, true)
 	a.So(tx.Data, should.Equal, "ffOO")
 }
@@ -373,6 +375,7 @@ func TestDownlinkRoundtrip(t *testing.T) {
 					},
 				},
 				Downlink: &ttnpb.TxSettings_Downlink{
+					AntennaIndex:       1,
 					TxPower:            16.15,
 					InvertPolarization: true,
 				},
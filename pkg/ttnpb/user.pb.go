@@ -9,6 +9,7 @@ import (
 	math "math"
 	math_bits "math/bits"
 	reflect "reflect"
+	strconv "strconv"
 	strings "strings"
 	time "time"
 
@@ -34,6 +35,44 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+type NotificationType int32
+
+const (
+	NOTIFICATION_TYPE_UNKNOWN NotificationType = 0
+	// A collaborator was added to an entity, or the rights of a collaborator were changed.
+	NOTIFICATION_TYPE_COLLABORATOR_CHANGED NotificationType = 1
+	// An API key was created for an entity.
+	NOTIFICATION_TYPE_API_KEY_CREATED NotificationType = 2
+	// An API key of an entity was changed.
+	NOTIFICATION_TYPE_API_KEY_CHANGED NotificationType = 3
+	// A gateway was disconnected from the Gateway Server for longer than configured.
+	NOTIFICATION_TYPE_GATEWAY_DISCONNECTED NotificationType = 4
+	// A user or OAuth client was requested and needs approval of an admin.
+	NOTIFICATION_TYPE_APPROVAL_REQUESTED NotificationType = 5
+)
+
+var NotificationType_name = map[int32]string{
+	0: "NOTIFICATION_TYPE_UNKNOWN",
+	1: "NOTIFICATION_TYPE_COLLABORATOR_CHANGED",
+	2: "NOTIFICATION_TYPE_API_KEY_CREATED",
+	3: "NOTIFICATION_TYPE_API_KEY_CHANGED",
+	4: "NOTIFICATION_TYPE_GATEWAY_DISCONNECTED",
+	5: "NOTIFICATION_TYPE_APPROVAL_REQUESTED",
+}
+
+var NotificationType_value = map[string]int32{
+	"NOTIFICATION_TYPE_UNKNOWN":              0,
+	"NOTIFICATION_TYPE_COLLABORATOR_CHANGED": 1,
+	"NOTIFICATION_TYPE_API_KEY_CREATED":      2,
+	"NOTIFICATION_TYPE_API_KEY_CHANGED":      3,
+	"NOTIFICATION_TYPE_GATEWAY_DISCONNECTED": 4,
+	"NOTIFICATION_TYPE_APPROVAL_REQUESTED":   5,
+}
+
+func (NotificationType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_5ce30de589ccb9af, []int{0}
+}
+
 // User is the message that defines an user on the network.
 type User struct {
 	UserIdentifiers `protobuf:"bytes,1,opt,name=ids,proto3,embedded=ids" json:"ids"`
@@ -316,7 +355,9 @@ type ListUsersRequest struct {
 	// Limit the number of results per page.
 	Limit uint32 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
 	// Page number for pagination. 0 is interpreted as 1.
-	Page                 uint32   `protobuf:"varint,4,opt,name=page,proto3" json:"page,omitempty"`
+	Page uint32 `protobuf:"varint,4,opt,name=page,proto3" json:"page,omitempty"`
+	// Only return recently deleted users. This is only allowed for admins.
+	Deleted              bool     `protobuf:"varint,5,opt,name=deleted,proto3" json:"deleted,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
@@ -381,6 +422,13 @@ func (m *ListUsersRequest) GetPage() uint32 {
 	return 0
 }
 
+func (m *ListUsersRequest) GetDeleted() bool {
+	if m != nil {
+		return m.Deleted
+	}
+	return false
+}
+
 type CreateUserRequest struct {
 	User                 `protobuf:"bytes,1,opt,name=user,proto3,embedded=user" json:"user"`
 	InvitationToken      string   `protobuf:"bytes,2,opt,name=invitation_token,json=invitationToken,proto3" json:"invitation_token,omitempty"`
@@ -678,11 +726,14 @@ func (m *GetUserAPIKeyRequest) GetKeyID() string {
 }
 
 type CreateUserAPIKeyRequest struct {
-	UserIdentifiers      `protobuf:"bytes,1,opt,name=user_ids,json=userIds,proto3,embedded=user_ids" json:"user_ids"`
-	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Rights               []Right  `protobuf:"varint,3,rep,packed,name=rights,proto3,enum=ttn.lorawan.v3.Right" json:"rights,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	UserIdentifiers `protobuf:"bytes,1,opt,name=user_ids,json=userIds,proto3,embedded=user_ids" json:"user_ids"`
+	Name            string  `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Rights          []Right `protobuf:"varint,3,rep,packed,name=rights,proto3,enum=ttn.lorawan.v3.Right" json:"rights,omitempty"`
+	// Time after which the API key is no longer valid. If not set, the API key does not expire.
+	ExpiresAt            *time.Time          `protobuf:"bytes,4,opt,name=expires_at,json=expiresAt,proto3,stdtime" json:"expires_at,omitempty"`
+	Restrictions         *APIKeyRestrictions `protobuf:"bytes,5,opt,name=restrictions,proto3" json:"restrictions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
 }
 
 func (m *CreateUserAPIKeyRequest) Reset()      { *m = CreateUserAPIKeyRequest{} }
@@ -731,11 +782,28 @@ func (m *CreateUserAPIKeyRequest) GetRights() []Right {
 	return nil
 }
 
+func (m *CreateUserAPIKeyRequest) GetExpiresAt() *time.Time {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return nil
+}
+
+func (m *CreateUserAPIKeyRequest) GetRestrictions() *APIKeyRestrictions {
+	if m != nil {
+		return m.Restrictions
+	}
+	return nil
+}
+
 type UpdateUserAPIKeyRequest struct {
-	UserIdentifiers      `protobuf:"bytes,1,opt,name=user_ids,json=userIds,proto3,embedded=user_ids" json:"user_ids"`
-	APIKey               `protobuf:"bytes,2,opt,name=api_key,json=apiKey,proto3,embedded=api_key" json:"api_key"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	UserIdentifiers `protobuf:"bytes,1,opt,name=user_ids,json=userIds,proto3,embedded=user_ids" json:"user_ids"`
+	APIKey          `protobuf:"bytes,2,opt,name=api_key,json=apiKey,proto3,embedded=api_key" json:"api_key"`
+	// The names of the API key fields that should be updated.
+	// If empty, only the name and rights are updated.
+	FieldMask            types.FieldMask `protobuf:"bytes,3,opt,name=field_mask,json=fieldMask,proto3" json:"field_mask"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
 
 func (m *UpdateUserAPIKeyRequest) Reset()      { *m = UpdateUserAPIKeyRequest{} }
@@ -770,6 +838,13 @@ func (m *UpdateUserAPIKeyRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_UpdateUserAPIKeyRequest proto.InternalMessageInfo
 
+func (m *UpdateUserAPIKeyRequest) GetFieldMask() types.FieldMask {
+	if m != nil {
+		return m.FieldMask
+	}
+	return types.FieldMask{}
+}
+
 type Invitation struct {
 	Email                string           `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
 	Token                string           `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
@@ -1280,567 +1355,1030 @@ func (m *ListUserSessionsRequest) GetPage() uint32 {
 	return 0
 }
 
-func init() {
-	proto.RegisterType((*User)(nil), "ttn.lorawan.v3.User")
-	golang_proto.RegisterType((*User)(nil), "ttn.lorawan.v3.User")
-	proto.RegisterMapType((map[string]string)(nil), "ttn.lorawan.v3.User.AttributesEntry")
-	golang_proto.RegisterMapType((map[string]string)(nil), "ttn.lorawan.v3.User.AttributesEntry")
-	proto.RegisterType((*Users)(nil), "ttn.lorawan.v3.Users")
-	golang_proto.RegisterType((*Users)(nil), "ttn.lorawan.v3.Users")
-	proto.RegisterType((*GetUserRequest)(nil), "ttn.lorawan.v3.GetUserRequest")
-	golang_proto.RegisterType((*GetUserRequest)(nil), "ttn.lorawan.v3.GetUserRequest")
-	proto.RegisterType((*ListUsersRequest)(nil), "ttn.lorawan.v3.ListUsersRequest")
-	golang_proto.RegisterType((*ListUsersRequest)(nil), "ttn.lorawan.v3.ListUsersRequest")
-	proto.RegisterType((*CreateUserRequest)(nil), "ttn.lorawan.v3.CreateUserRequest")
-	golang_proto.RegisterType((*CreateUserRequest)(nil), "ttn.lorawan.v3.CreateUserRequest")
-	proto.RegisterType((*UpdateUserRequest)(nil), "ttn.lorawan.v3.UpdateUserRequest")
-	golang_proto.RegisterType((*UpdateUserRequest)(nil), "ttn.lorawan.v3.UpdateUserRequest")
-	proto.RegisterType((*CreateTemporaryPasswordRequest)(nil), "ttn.lorawan.v3.CreateTemporaryPasswordRequest")
-	golang_proto.RegisterType((*CreateTemporaryPasswordRequest)(nil), "ttn.lorawan.v3.CreateTemporaryPasswordRequest")
-	proto.RegisterType((*UpdateUserPasswordRequest)(nil), "ttn.lorawan.v3.UpdateUserPasswordRequest")
-	golang_proto.RegisterType((*UpdateUserPasswordRequest)(nil), "ttn.lorawan.v3.UpdateUserPasswordRequest")
-	proto.RegisterType((*ListUserAPIKeysRequest)(nil), "ttn.lorawan.v3.ListUserAPIKeysRequest")
-	golang_proto.RegisterType((*ListUserAPIKeysRequest)(nil), "ttn.lorawan.v3.ListUserAPIKeysRequest")
-	proto.RegisterType((*GetUserAPIKeyRequest)(nil), "ttn.lorawan.v3.GetUserAPIKeyRequest")
-	golang_proto.RegisterType((*GetUserAPIKeyRequest)(nil), "ttn.lorawan.v3.GetUserAPIKeyRequest")
-	proto.RegisterType((*CreateUserAPIKeyRequest)(nil), "ttn.lorawan.v3.CreateUserAPIKeyRequest")
-	golang_proto.RegisterType((*CreateUserAPIKeyRequest)(nil), "ttn.lorawan.v3.CreateUserAPIKeyRequest")
-	proto.RegisterType((*UpdateUserAPIKeyRequest)(nil), "ttn.lorawan.v3.UpdateUserAPIKeyRequest")
-	golang_proto.RegisterType((*UpdateUserAPIKeyRequest)(nil), "ttn.lorawan.v3.UpdateUserAPIKeyRequest")
-	proto.RegisterType((*Invitation)(nil), "ttn.lorawan.v3.Invitation")
-	golang_proto.RegisterType((*Invitation)(nil), "ttn.lorawan.v3.Invitation")
-	proto.RegisterType((*ListInvitationsRequest)(nil), "ttn.lorawan.v3.ListInvitationsRequest")
-	golang_proto.RegisterType((*ListInvitationsRequest)(nil), "ttn.lorawan.v3.ListInvitationsRequest")
-	proto.RegisterType((*Invitations)(nil), "ttn.lorawan.v3.Invitations")
-	golang_proto.RegisterType((*Invitations)(nil), "ttn.lorawan.v3.Invitations")
-	proto.RegisterType((*SendInvitationRequest)(nil), "ttn.lorawan.v3.SendInvitationRequest")
-	golang_proto.RegisterType((*SendInvitationRequest)(nil), "ttn.lorawan.v3.SendInvitationRequest")
-	proto.RegisterType((*DeleteInvitationRequest)(nil), "ttn.lorawan.v3.DeleteInvitationRequest")
-	golang_proto.RegisterType((*DeleteInvitationRequest)(nil), "ttn.lorawan.v3.DeleteInvitationRequest")
-	proto.RegisterType((*UserSessionIdentifiers)(nil), "ttn.lorawan.v3.UserSessionIdentifiers")
-	golang_proto.RegisterType((*UserSessionIdentifiers)(nil), "ttn.lorawan.v3.UserSessionIdentifiers")
-	proto.RegisterType((*UserSession)(nil), "ttn.lorawan.v3.UserSession")
-	golang_proto.RegisterType((*UserSession)(nil), "ttn.lorawan.v3.UserSession")
-	proto.RegisterType((*UserSessions)(nil), "ttn.lorawan.v3.UserSessions")
-	golang_proto.RegisterType((*UserSessions)(nil), "ttn.lorawan.v3.UserSessions")
-	proto.RegisterType((*ListUserSessionsRequest)(nil), "ttn.lorawan.v3.ListUserSessionsRequest")
-	golang_proto.RegisterType((*ListUserSessionsRequest)(nil), "ttn.lorawan.v3.ListUserSessionsRequest")
+type UserTOTPStatus struct {
+	// Whether TOTP two-factor authentication is enabled.
+	Enabled   bool       `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	EnabledAt *time.Time `protobuf:"bytes,2,opt,name=enabled_at,json=enabledAt,proto3,stdtime" json:"enabled_at,omitempty"`
+	// The number of recovery codes that are not used yet.
+	RecoveryCodesRemaining uint32   `protobuf:"varint,3,opt,name=recovery_codes_remaining,json=recoveryCodesRemaining,proto3" json:"recovery_codes_remaining,omitempty"`
+	XXX_NoUnkeyedLiteral   struct{} `json:"-"`
+	XXX_sizecache          int32    `json:"-"`
 }
 
-func init() { proto.RegisterFile("lorawan-stack/api/user.proto", fileDescriptor_5ce30de589ccb9af) }
-func init() {
-	golang_proto.RegisterFile("lorawan-stack/api/user.proto", fileDescriptor_5ce30de589ccb9af)
+func (m *UserTOTPStatus) Reset()      { *m = UserTOTPStatus{} }
+func (*UserTOTPStatus) ProtoMessage() {}
+func (*UserTOTPStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ce30de589ccb9af, []int{21}
 }
-
-var fileDescriptor_5ce30de589ccb9af = []byte{
-	// 1649 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0x4d, 0x6c, 0x1b, 0xc7,
-	0x15, 0xde, 0xe1, 0x9f, 0xc8, 0x47, 0x5b, 0x3f, 0x1b, 0xdb, 0xda, 0x4a, 0xf5, 0x90, 0xd8, 0x0a,
-	0x85, 0x6c, 0x98, 0x54, 0x21, 0xa3, 0x6e, 0xea, 0xa6, 0x55, 0xb8, 0xb2, 0x1b, 0x08, 0x72, 0x01,
-	0x61, 0xed, 0xf4, 0xd0, 0x20, 0xdd, 0xae, 0xb8, 0x23, 0x6a, 0xc0, 0xe5, 0xee, 0x66, 0x77, 0x28,
-	0x85, 0x29, 0x0a, 0x04, 0x3d, 0x14, 0x46, 0x7b, 0xa8, 0x51, 0xa0, 0x40, 0x91, 0x1e, 0x5a, 0xf4,
-	0x50, 0x04, 0x3d, 0xf9, 0x98, 0xde, 0x82, 0x9e, 0x7c, 0x14, 0xd0, 0x4b, 0x80, 0x02, 0x6a, 0x44,
-	0x5e, 0x8c, 0x9e, 0x72, 0x0c, 0x74, 0x2a, 0x66, 0x67, 0x97, 0xbb, 0xa2, 0x18, 0x57, 0xb2, 0x45,
-	0xa0, 0x27, 0xcd, 0xcc, 0xfb, 0xde, 0xcf, 0xbe, 0x79, 0xdf, 0x7b, 0x43, 0xc1, 0xd7, 0x6d, 0xd7,
-	0x37, 0xf7, 0x4d, 0xa7, 0x16, 0x30, 0xb3, 0xd9, 0x5e, 0x31, 0x3d, 0xba, 0xd2, 0x0d, 0x88, 0x5f,
-	0xf7, 0x7c, 0x97, 0xb9, 0xf2, 0x34, 0x63, 0x4e, 0x3d, 0x42, 0xd4, 0xf7, 0x6e, 0x2f, 0x34, 0x5a,
-	0x94, 0xed, 0x76, 0xb7, 0xeb, 0x4d, 0xb7, 0xb3, 0x42, 0x9c, 0x3d, 0xb7, 0xe7, 0xf9, 0xee, 0xfb,
-	0xbd, 0x95, 0x10, 0xdc, 0xac, 0xb5, 0x88, 0x53, 0xdb, 0x33, 0x6d, 0x6a, 0x99, 0x8c, 0xac, 0x9c,
-	0x5a, 0x08, 0x93, 0x0b, 0xb5, 0x94, 0x89, 0x96, 0xdb, 0x72, 0x85, 0xf2, 0x76, 0x77, 0x27, 0xdc,
-	0x85, 0x9b, 0x70, 0x15, 0xc1, 0x17, 0x5b, 0xae, 0xdb, 0xb2, 0x49, 0x82, 0x22, 0x1d, 0x8f, 0xf5,
-	0x22, 0x61, 0x75, 0x54, 0xb8, 0x43, 0x89, 0x6d, 0x19, 0x1d, 0x33, 0x68, 0x47, 0x88, 0xca, 0x28,
-	0x82, 0xd1, 0x0e, 0x09, 0x98, 0xd9, 0xf1, 0x22, 0x00, 0x3e, 0xfd, 0xfd, 0x4d, 0x9b, 0x12, 0x87,
-	0x45, 0xf2, 0xa5, 0x31, 0x72, 0xd7, 0x61, 0x66, 0x93, 0x19, 0xd4, 0xd9, 0x89, 0xa3, 0xbc, 0x7e,
-	0x1a, 0x45, 0x9c, 0x6e, 0x27, 0x88, 0xc4, 0xdf, 0x38, 0x2d, 0xa6, 0x16, 0x71, 0x18, 0xdd, 0xa1,
-	0xc4, 0x8f, 0x41, 0x95, 0xd3, 0x20, 0x8f, 0x36, 0x59, 0xd7, 0x27, 0x5f, 0x1d, 0xaa, 0x4f, 0x5b,
-	0xbb, 0x2c, 0x32, 0xa0, 0xfe, 0xa7, 0x04, 0xb9, 0xb7, 0x03, 0xe2, 0xcb, 0xeb, 0x90, 0xa5, 0x56,
-	0xa0, 0xa0, 0x2a, 0x5a, 0x2e, 0xaf, 0x56, 0xea, 0x27, 0xef, 0xb0, 0xce, 0x21, 0x1b, 0x89, 0x77,
-	0x6d, 0xf6, 0x58, 0xcb, 0xff, 0x1a, 0x65, 0x66, 0xd1, 0xb3, 0xc3, 0x8a, 0x74, 0x70, 0x58, 0x41,
-	0x3a, 0xd7, 0x96, 0xd7, 0x01, 0x9a, 0x3e, 0x31, 0x19, 0xb1, 0x0c, 0x93, 0x29, 0x99, 0xd0, 0xd6,
-	0x42, 0x5d, 0xa4, 0xb3, 0x1e, 0xa7, 0xb3, 0xfe, 0x28, 0x4e, 0xa7, 0x56, 0xe4, 0xea, 0x4f, 0xfe,
-	0x5d, 0x41, 0x7a, 0x29, 0xd2, 0x6b, 0x30, 0x6e, 0xa4, 0xeb, 0x59, 0xb1, 0x91, 0xec, 0x79, 0x8c,
-	0x44, 0x7a, 0x0d, 0x26, 0x2f, 0x42, 0xce, 0x31, 0x3b, 0x44, 0xc9, 0x55, 0xd1, 0x72, 0x49, 0x9b,
-	0x3a, 0xd6, 0x72, 0x7e, 0x46, 0x59, 0xd5, 0xc3, 0x43, 0xf9, 0x26, 0x94, 0x2d, 0x12, 0x34, 0x7d,
-	0xea, 0x31, 0xea, 0x3a, 0x4a, 0x3e, 0xc4, 0x14, 0x8f, 0xb5, 0xbc, 0x9f, 0x55, 0x0e, 0x66, 0xf4,
-	0xb4, 0x50, 0xf6, 0x01, 0x4c, 0xc6, 0x7c, 0xba, 0xdd, 0x65, 0x24, 0x50, 0x0a, 0xd5, 0xec, 0x72,
-	0x79, 0x75, 0x69, 0x5c, 0x7a, 0xea, 0x8d, 0x21, 0xec, 0xbe, 0xc3, 0xfc, 0x9e, 0x76, 0xeb, 0x58,
-	0xbb, 0xf1, 0x11, 0xfa, 0xa6, 0xba, 0xe4, 0xab, 0xca, 0xd2, 0x2a, 0xfe, 0xe9, 0x3b, 0x66, 0xed,
-	0x83, 0x6f, 0xd5, 0xbe, 0xfb, 0xee, 0xf2, 0xda, 0xdd, 0x77, 0x6a, 0xef, 0xae, 0xc5, 0xdb, 0x1b,
-	0x3f, 0x5f, 0xbd, 0xf5, 0x8b, 0x25, 0x3d, 0xe5, 0x45, 0xfe, 0x01, 0x5c, 0x4a, 0xd7, 0x8b, 0x32,
-	0x15, 0x7a, 0x5d, 0x1c, 0xf5, 0xba, 0x2e, 0x30, 0x1b, 0xce, 0x8e, 0xab, 0x97, 0x9b, 0xc9, 0x46,
-	0xfe, 0x1e, 0x5c, 0xf5, 0x7c, 0xda, 0x31, 0xfd, 0x9e, 0x41, 0x3a, 0x26, 0xb5, 0x0d, 0xd3, 0xb2,
-	0x7c, 0x12, 0x04, 0x4a, 0x31, 0x95, 0x8d, 0x9f, 0x21, 0xfd, 0xb5, 0x08, 0x75, 0x9f, 0x83, 0x1a,
-	0x02, 0x23, 0xdb, 0xa0, 0x8e, 0x55, 0x36, 0x62, 0x4e, 0x86, 0xd7, 0x52, 0xfa, 0x9f, 0xd7, 0x92,
-	0x0b, 0xaf, 0x04, 0x8f, 0x71, 0xf1, 0xe3, 0xd8, 0x50, 0x83, 0xc9, 0x0b, 0x50, 0xf4, 0xcc, 0x20,
-	0xd8, 0x77, 0x7d, 0x4b, 0x01, 0x1e, 0x9d, 0x3e, 0xdc, 0xcb, 0x5b, 0xf0, 0x5a, 0xbc, 0x36, 0x52,
-	0x15, 0x51, 0x3e, 0xa3, 0xeb, 0xb9, 0x58, 0xf9, 0xed, 0x61, 0x55, 0xdc, 0x81, 0x79, 0x9f, 0xbc,
-	0xd7, 0xa5, 0x3e, 0x31, 0x46, 0x2c, 0x2b, 0x97, 0xaa, 0x68, 0xb9, 0xa8, 0x5f, 0x8d, 0xc4, 0x5b,
-	0x27, 0x54, 0xe5, 0x6f, 0x43, 0x3e, 0x60, 0x1c, 0x75, 0xb9, 0x8a, 0x96, 0xa7, 0x57, 0xaf, 0x8e,
-	0xde, 0xc4, 0x43, 0x2e, 0x0c, 0x2b, 0xe8, 0x97, 0x9c, 0x14, 0xba, 0x40, 0xcb, 0x57, 0x20, 0x6f,
-	0x5a, 0x1d, 0xea, 0x28, 0xd3, 0xa1, 0x71, 0xb1, 0x91, 0x6b, 0x20, 0x33, 0xd2, 0xf1, 0x5c, 0x9f,
-	0xa7, 0x78, 0xf8, 0xf1, 0x33, 0xe1, 0xc7, 0xcf, 0x0d, 0x25, 0x71, 0x04, 0x72, 0x13, 0xae, 0x9f,
-	0x86, 0x1b, 0x29, 0x9a, 0xcd, 0x9e, 0x31, 0x1f, 0x0b, 0xa7, 0x6c, 0xaf, 0x0f, 0x39, 0x37, 0xde,
-	0x09, 0x79, 0xdf, 0xa3, 0x3e, 0x09, 0xb8, 0x93, 0xb9, 0x97, 0x76, 0x72, 0x5f, 0x18, 0x69, 0x30,
-	0xf9, 0x4d, 0x98, 0xf1, 0x7c, 0x77, 0x87, 0xda, 0xc4, 0x88, 0x9a, 0x94, 0x22, 0x87, 0x66, 0xe7,
-	0x47, 0xf3, 0xb9, 0x25, 0xc4, 0xfa, 0x74, 0x84, 0x8f, 0xf6, 0x0b, 0xdf, 0x87, 0x99, 0x11, 0x96,
-	0xc9, 0xb3, 0x90, 0x6d, 0x93, 0x5e, 0xd8, 0xb7, 0x4a, 0x3a, 0x5f, 0xf2, 0xac, 0xef, 0x99, 0x76,
-	0x97, 0x84, 0xfd, 0xa7, 0xa4, 0x8b, 0xcd, 0xdd, 0xcc, 0xeb, 0x48, 0xbd, 0x0d, 0x79, 0xce, 0xd4,
-	0x40, 0xbe, 0x09, 0x79, 0x3e, 0xb0, 0x78, 0xbb, 0xe3, 0xcc, 0xba, 0x32, 0x8e, 0xcf, 0xba, 0x80,
-	0xa8, 0x7f, 0x42, 0x30, 0xfd, 0x16, 0x61, 0xe1, 0x11, 0x79, 0xaf, 0x4b, 0x02, 0x26, 0x3f, 0x80,
-	0x22, 0x97, 0x19, 0xaf, 0xd4, 0x30, 0xa7, 0xba, 0x21, 0x24, 0x90, 0xd7, 0x00, 0x92, 0x11, 0xf4,
-	0x95, 0x4d, 0xf3, 0x87, 0x1c, 0xf2, 0x23, 0x33, 0x68, 0x6b, 0x39, 0x6e, 0x42, 0x2f, 0xed, 0xc4,
-	0x07, 0xea, 0xd3, 0x0c, 0xcc, 0x3e, 0xa0, 0x41, 0x18, 0x62, 0x10, 0xc7, 0x78, 0xd2, 0x2a, 0x3a,
-	0xb7, 0x55, 0xf9, 0xaf, 0x08, 0xf2, 0xae, 0x6f, 0x11, 0x5f, 0xe4, 0x51, 0xfb, 0x2d, 0x3a, 0xd6,
-	0x7e, 0x83, 0xfc, 0xc7, 0x48, 0x97, 0x44, 0xec, 0x06, 0xb5, 0xf4, 0x62, 0x2d, 0x5e, 0x85, 0x9d,
-	0x55, 0xcf, 0xd7, 0xc2, 0x3f, 0xe3, 0xdb, 0x8f, 0x7e, 0xad, 0x36, 0xfe, 0x5c, 0xd0, 0x45, 0x2f,
-	0xd4, 0xc4, 0x5f, 0xc1, 0x13, 0xbd, 0x50, 0x13, 0x7f, 0x53, 0x23, 0x45, 0x2f, 0xd7, 0x52, 0x1b,
-	0x11, 0x9e, 0x8c, 0x21, 0x6f, 0xd3, 0x0e, 0x15, 0xa3, 0xe2, 0x72, 0xc8, 0xc2, 0x9b, 0x59, 0xe5,
-	0xf9, 0x94, 0x2e, 0x8e, 0x65, 0x19, 0x72, 0x9e, 0xd9, 0x12, 0xa3, 0xe0, 0xb2, 0x1e, 0xae, 0xd5,
-	0x0f, 0x60, 0x4e, 0x14, 0x7f, 0xfa, 0x5a, 0xef, 0x42, 0x8e, 0x7f, 0x4d, 0x94, 0xac, 0xb1, 0x45,
-	0x31, 0xe6, 0x1e, 0x43, 0x1d, 0xf9, 0x06, 0xcc, 0x52, 0x67, 0x8f, 0x32, 0x93, 0x0f, 0x0d, 0x83,
-	0xb9, 0x6d, 0xe2, 0x44, 0xf5, 0x37, 0x93, 0x9c, 0x3f, 0xe2, 0xc7, 0xea, 0x13, 0x04, 0x73, 0xa2,
-	0xaf, 0x5c, 0x94, 0xf3, 0x57, 0xae, 0x20, 0x07, 0xb0, 0x48, 0xc7, 0xa3, 0x51, 0xf6, 0x4e, 0xa4,
-	0xe4, 0xd5, 0xbf, 0x23, 0xf8, 0x5a, 0x92, 0x82, 0x89, 0xfa, 0xe2, 0x0d, 0xc2, 0x21, 0xfb, 0xd1,
-	0x65, 0xf0, 0x25, 0x3f, 0x71, 0x6d, 0x2b, 0x2c, 0x97, 0x92, 0xce, 0x97, 0xf2, 0x4d, 0x98, 0xf3,
-	0xc9, 0x9e, 0xdb, 0x26, 0x86, 0x69, 0xdb, 0x86, 0xd9, 0x6c, 0xf2, 0x61, 0x99, 0x0b, 0x9b, 0xf6,
-	0x8c, 0x10, 0x34, 0x6c, 0xbb, 0x11, 0x1e, 0xab, 0x1f, 0x21, 0xb8, 0x16, 0xb3, 0xad, 0xb1, 0xb5,
-	0xb1, 0x49, 0x7a, 0xc1, 0x64, 0x02, 0x1f, 0xd6, 0x75, 0xe6, 0xc5, 0x75, 0x9d, 0x4d, 0xd5, 0xf5,
-	0xaf, 0x10, 0x5c, 0x89, 0x9a, 0x95, 0x88, 0x6d, 0x32, 0xa1, 0x55, 0xa1, 0xd0, 0x26, 0x3d, 0x83,
-	0x5a, 0x51, 0x6f, 0x28, 0xf5, 0x0f, 0x2b, 0xf9, 0x4d, 0xd2, 0xdb, 0xb8, 0xa7, 0xe7, 0xdb, 0xa4,
-	0xb7, 0x61, 0xa9, 0xff, 0x40, 0x30, 0x9f, 0x30, 0x6c, 0x92, 0xb1, 0xc4, 0x2f, 0xbd, 0xcc, 0xb8,
-	0x97, 0xde, 0x1b, 0x50, 0x10, 0xcf, 0x5d, 0x25, 0x5b, 0xcd, 0x8e, 0x9b, 0xdc, 0x3a, 0x97, 0x6a,
-	0x97, 0x8f, 0x35, 0xf8, 0x1d, 0x9a, 0x52, 0xa3, 0xf1, 0x1d, 0xe9, 0xa8, 0x7f, 0x43, 0x30, 0x9f,
-	0x94, 0xe9, 0x24, 0x3f, 0xa2, 0x01, 0x53, 0xa6, 0x47, 0x0d, 0x3e, 0xc9, 0x04, 0x7d, 0xaf, 0x8d,
-	0x1a, 0x13, 0xde, 0xc7, 0xd8, 0x28, 0x98, 0x1e, 0xdd, 0x24, 0x3d, 0xf5, 0xf7, 0x59, 0x80, 0x8d,
-	0x61, 0xab, 0x91, 0xaf, 0x43, 0x3e, 0x6c, 0xb2, 0x62, 0x32, 0x26, 0x6f, 0x3e, 0x71, 0xca, 0x87,
-	0x64, 0xba, 0x49, 0x89, 0x0d, 0x7f, 0x7a, 0xa7, 0x66, 0xfe, 0xb9, 0x9e, 0xde, 0x64, 0x38, 0xe6,
-	0x4f, 0xfe, 0x08, 0xc8, 0x5d, 0xc4, 0x8f, 0x80, 0xfc, 0xcb, 0xfd, 0x08, 0x68, 0x40, 0x99, 0x73,
-	0xd9, 0x8b, 0xac, 0x14, 0xce, 0xf8, 0x86, 0x81, 0x58, 0x29, 0x7c, 0xb3, 0x24, 0x26, 0xb6, 0x7b,
-	0xca, 0xd4, 0x99, 0x6e, 0x3a, 0xb1, 0xa0, 0xf5, 0xd4, 0x07, 0xa2, 0x5d, 0x24, 0x57, 0x33, 0x6c,
-	0x17, 0x43, 0x82, 0xa3, 0x17, 0x13, 0x3c, 0x93, 0x22, 0xf8, 0x26, 0x94, 0x53, 0x96, 0xe4, 0x37,
-	0xa0, 0x9c, 0x8c, 0x97, 0xf8, 0x39, 0xb3, 0x30, 0x1a, 0x5e, 0xa2, 0xa1, 0xa7, 0xe1, 0xea, 0x1d,
-	0xb8, 0xfa, 0x90, 0x38, 0x56, 0x4a, 0x1c, 0x45, 0xf6, 0xe2, 0xe2, 0x51, 0x5f, 0x87, 0xf9, 0x7b,
-	0xc4, 0x26, 0x8c, 0x9c, 0x5b, 0xf3, 0x8f, 0x08, 0xae, 0xf1, 0x64, 0x3d, 0x24, 0x41, 0x40, 0x5d,
-	0x27, 0x95, 0xb3, 0x0b, 0x26, 0xd4, 0x6d, 0x80, 0x40, 0xf8, 0x48, 0xba, 0xd4, 0x15, 0xd1, 0x1b,
-	0xde, 0xec, 0x1f, 0x56, 0x4a, 0x71, 0x00, 0xf7, 0xf4, 0x52, 0x10, 0xc7, 0xa2, 0xfe, 0x2b, 0x03,
-	0xe5, 0x54, 0x74, 0xff, 0x07, 0x21, 0x8d, 0x90, 0x29, 0x7b, 0x11, 0x64, 0xca, 0xbd, 0x1c, 0x99,
-	0xd6, 0x4e, 0xf4, 0x86, 0xfc, 0x19, 0xb9, 0x94, 0xf4, 0x05, 0xf5, 0x2d, 0xb8, 0x94, 0x4a, 0x6e,
-	0x20, 0x7f, 0x07, 0x8a, 0xd1, 0x77, 0xc6, 0x85, 0xbb, 0x38, 0x2e, 0xbb, 0x11, 0x5e, 0x1f, 0x82,
-	0xd5, 0x7f, 0x22, 0x98, 0x8f, 0x27, 0x70, 0x6c, 0x6d, 0x32, 0x6d, 0xf9, 0xce, 0xc9, 0x27, 0x70,
-	0xf5, 0x58, 0xbb, 0xee, 0x2f, 0xea, 0xd2, 0x24, 0x9e, 0xa4, 0xda, 0x5f, 0xd0, 0xb3, 0x23, 0x8c,
-	0x0e, 0x8e, 0x30, 0xfa, 0xec, 0x08, 0x4b, 0x9f, 0x1f, 0x61, 0xe9, 0xf9, 0x11, 0x96, 0xbe, 0x38,
-	0xc2, 0xd2, 0x97, 0x47, 0x18, 0x7d, 0xd8, 0xc7, 0xe8, 0x71, 0x1f, 0x4b, 0x1f, 0xf7, 0x31, 0x7a,
-	0xda, 0xc7, 0xd2, 0x27, 0x7d, 0x2c, 0x7d, 0xda, 0xc7, 0xd2, 0xb3, 0x3e, 0x46, 0x07, 0x7d, 0x8c,
-	0x3e, 0xeb, 0x63, 0xe9, 0xf3, 0x3e, 0x46, 0xcf, 0xfb, 0x58, 0xfa, 0xa2, 0x8f, 0xd1, 0x97, 0x7d,
-	0x2c, 0x7d, 0x38, 0xc0, 0xd2, 0xe3, 0x01, 0x46, 0x4f, 0x06, 0x58, 0xfa, 0xc3, 0x00, 0xa3, 0x3f,
-	0x0f, 0xb0, 0xf4, 0xf1, 0x00, 0x4b, 0x4f, 0x07, 0x18, 0x7d, 0x32, 0xc0, 0xe8, 0xd3, 0x01, 0x46,
-	0x3f, 0xb9, 0xd5, 0x72, 0xeb, 0x6c, 0x97, 0xb0, 0x5d, 0xea, 0xb4, 0x82, 0xba, 0x43, 0xd8, 0xbe,
-	0xeb, 0xb7, 0x57, 0x4e, 0xfe, 0xd7, 0xc8, 0x6b, 0xb7, 0x56, 0x18, 0x73, 0xbc, 0xed, 0xed, 0x42,
-	0x78, 0xd1, 0xb7, 0xff, 0x1b, 0x00, 0x00, 0xff, 0xff, 0xfd, 0x4a, 0xda, 0xa5, 0x02, 0x14, 0x00,
-	0x00,
+func (m *UserTOTPStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (this *User) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
-
-	that1, ok := that.(*User)
-	if !ok {
-		that2, ok := that.(User)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+func (m *UserTOTPStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UserTOTPStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.UserIdentifiers.Equal(&that1.UserIdentifiers) {
-		return false
-	}
-	if !this.CreatedAt.Equal(that1.CreatedAt) {
-		return false
-	}
-	if !this.UpdatedAt.Equal(that1.UpdatedAt) {
-		return false
-	}
-	if this.Name != that1.Name {
-		return false
+}
+func (m *UserTOTPStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UserTOTPStatus.Merge(m, src)
+}
+func (m *UserTOTPStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *UserTOTPStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_UserTOTPStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UserTOTPStatus proto.InternalMessageInfo
+
+func (m *UserTOTPStatus) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
 	}
-	if this.Description != that1.Description {
-		return false
+	return false
+}
+
+func (m *UserTOTPStatus) GetEnabledAt() *time.Time {
+	if m != nil {
+		return m.EnabledAt
 	}
-	if len(this.Attributes) != len(that1.Attributes) {
-		return false
+	return nil
+}
+
+func (m *UserTOTPStatus) GetRecoveryCodesRemaining() uint32 {
+	if m != nil {
+		return m.RecoveryCodesRemaining
 	}
-	for i := range this.Attributes {
-		if this.Attributes[i] != that1.Attributes[i] {
-			return false
+	return 0
+}
+
+type UserTOTPEnrollment struct {
+	// The shared secret (base32 encoded) to configure in the authenticator app.
+	Secret string `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
+	// The otpauth:// URI of the shared secret, which can be shown as QR code.
+	URI                  string   `protobuf:"bytes,2,opt,name=uri,proto3" json:"uri,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UserTOTPEnrollment) Reset()      { *m = UserTOTPEnrollment{} }
+func (*UserTOTPEnrollment) ProtoMessage() {}
+func (*UserTOTPEnrollment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ce30de589ccb9af, []int{22}
+}
+func (m *UserTOTPEnrollment) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UserTOTPEnrollment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UserTOTPEnrollment.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(this.ContactInfo) != len(that1.ContactInfo) {
-		return false
-	}
-	for i := range this.ContactInfo {
-		if !this.ContactInfo[i].Equal(that1.ContactInfo[i]) {
-			return false
-		}
+}
+func (m *UserTOTPEnrollment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UserTOTPEnrollment.Merge(m, src)
+}
+func (m *UserTOTPEnrollment) XXX_Size() int {
+	return m.Size()
+}
+func (m *UserTOTPEnrollment) XXX_DiscardUnknown() {
+	xxx_messageInfo_UserTOTPEnrollment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UserTOTPEnrollment proto.InternalMessageInfo
+
+func (m *UserTOTPEnrollment) GetSecret() string {
+	if m != nil {
+		return m.Secret
 	}
-	if this.PrimaryEmailAddress != that1.PrimaryEmailAddress {
-		return false
+	return ""
+}
+
+func (m *UserTOTPEnrollment) GetURI() string {
+	if m != nil {
+		return m.URI
 	}
-	if that1.PrimaryEmailAddressValidatedAt == nil {
-		if this.PrimaryEmailAddressValidatedAt != nil {
-			return false
+	return ""
+}
+
+type EnableUserTOTPRequest struct {
+	UserIdentifiers `protobuf:"bytes,1,opt,name=user_ids,json=userIds,proto3,embedded=user_ids" json:"user_ids"`
+	// The current TOTP code generated with the enrolled secret.
+	Code                 string   `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EnableUserTOTPRequest) Reset()      { *m = EnableUserTOTPRequest{} }
+func (*EnableUserTOTPRequest) ProtoMessage() {}
+func (*EnableUserTOTPRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ce30de589ccb9af, []int{23}
+}
+func (m *EnableUserTOTPRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EnableUserTOTPRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EnableUserTOTPRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-	} else if !this.PrimaryEmailAddressValidatedAt.Equal(*that1.PrimaryEmailAddressValidatedAt) {
-		return false
+		return b[:n], nil
 	}
-	if this.Password != that1.Password {
-		return false
+}
+func (m *EnableUserTOTPRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EnableUserTOTPRequest.Merge(m, src)
+}
+func (m *EnableUserTOTPRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *EnableUserTOTPRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_EnableUserTOTPRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EnableUserTOTPRequest proto.InternalMessageInfo
+
+func (m *EnableUserTOTPRequest) GetCode() string {
+	if m != nil {
+		return m.Code
 	}
-	if that1.PasswordUpdatedAt == nil {
-		if this.PasswordUpdatedAt != nil {
-			return false
+	return ""
+}
+
+type DisableUserTOTPRequest struct {
+	UserIdentifiers `protobuf:"bytes,1,opt,name=user_ids,json=userIds,proto3,embedded=user_ids" json:"user_ids"`
+	// The current TOTP code or an unused recovery code. This is not required for admins.
+	Code                 string   `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DisableUserTOTPRequest) Reset()      { *m = DisableUserTOTPRequest{} }
+func (*DisableUserTOTPRequest) ProtoMessage() {}
+func (*DisableUserTOTPRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ce30de589ccb9af, []int{24}
+}
+func (m *DisableUserTOTPRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DisableUserTOTPRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DisableUserTOTPRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-	} else if !this.PasswordUpdatedAt.Equal(*that1.PasswordUpdatedAt) {
-		return false
+		return b[:n], nil
 	}
-	if this.RequirePasswordUpdate != that1.RequirePasswordUpdate {
-		return false
+}
+func (m *DisableUserTOTPRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DisableUserTOTPRequest.Merge(m, src)
+}
+func (m *DisableUserTOTPRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DisableUserTOTPRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DisableUserTOTPRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DisableUserTOTPRequest proto.InternalMessageInfo
+
+func (m *DisableUserTOTPRequest) GetCode() string {
+	if m != nil {
+		return m.Code
 	}
-	if this.State != that1.State {
-		return false
-	}
-	if this.Admin != that1.Admin {
-		return false
-	}
-	if this.TemporaryPassword != that1.TemporaryPassword {
-		return false
-	}
-	if that1.TemporaryPasswordCreatedAt == nil {
-		if this.TemporaryPasswordCreatedAt != nil {
-			return false
-		}
-	} else if !this.TemporaryPasswordCreatedAt.Equal(*that1.TemporaryPasswordCreatedAt) {
-		return false
-	}
-	if that1.TemporaryPasswordExpiresAt == nil {
-		if this.TemporaryPasswordExpiresAt != nil {
-			return false
-		}
-	} else if !this.TemporaryPasswordExpiresAt.Equal(*that1.TemporaryPasswordExpiresAt) {
-		return false
-	}
-	if !this.ProfilePicture.Equal(that1.ProfilePicture) {
-		return false
-	}
-	return true
+	return ""
 }
-func (this *Users) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
 
-	that1, ok := that.(*Users)
-	if !ok {
-		that2, ok := that.(Users)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if len(this.Users) != len(that1.Users) {
-		return false
-	}
-	for i := range this.Users {
-		if !this.Users[i].Equal(that1.Users[i]) {
-			return false
+type GenerateUserRecoveryCodesRequest struct {
+	UserIdentifiers `protobuf:"bytes,1,opt,name=user_ids,json=userIds,proto3,embedded=user_ids" json:"user_ids"`
+	// The current TOTP code.
+	Code                 string   `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GenerateUserRecoveryCodesRequest) Reset()      { *m = GenerateUserRecoveryCodesRequest{} }
+func (*GenerateUserRecoveryCodesRequest) ProtoMessage() {}
+func (*GenerateUserRecoveryCodesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ce30de589ccb9af, []int{25}
+}
+func (m *GenerateUserRecoveryCodesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GenerateUserRecoveryCodesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GenerateUserRecoveryCodesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return true
 }
-func (this *GetUserRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (m *GenerateUserRecoveryCodesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenerateUserRecoveryCodesRequest.Merge(m, src)
+}
+func (m *GenerateUserRecoveryCodesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GenerateUserRecoveryCodesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenerateUserRecoveryCodesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenerateUserRecoveryCodesRequest proto.InternalMessageInfo
+
+func (m *GenerateUserRecoveryCodesRequest) GetCode() string {
+	if m != nil {
+		return m.Code
 	}
+	return ""
+}
 
-	that1, ok := that.(*GetUserRequest)
-	if !ok {
-		that2, ok := that.(GetUserRequest)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type UserRecoveryCodes struct {
+	// The recovery codes, each of which can be used once instead of a TOTP code.
+	// Recovery codes are stored hashed, so they are only returned once.
+	Codes                []string `protobuf:"bytes,1,rep,name=codes,proto3" json:"codes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UserRecoveryCodes) Reset()      { *m = UserRecoveryCodes{} }
+func (*UserRecoveryCodes) ProtoMessage() {}
+func (*UserRecoveryCodes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ce30de589ccb9af, []int{26}
+}
+func (m *UserRecoveryCodes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UserRecoveryCodes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UserRecoveryCodes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.UserIdentifiers.Equal(&that1.UserIdentifiers) {
-		return false
-	}
-	if !this.FieldMask.Equal(&that1.FieldMask) {
-		return false
-	}
-	return true
 }
-func (this *ListUsersRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (m *UserRecoveryCodes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UserRecoveryCodes.Merge(m, src)
+}
+func (m *UserRecoveryCodes) XXX_Size() int {
+	return m.Size()
+}
+func (m *UserRecoveryCodes) XXX_DiscardUnknown() {
+	xxx_messageInfo_UserRecoveryCodes.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UserRecoveryCodes proto.InternalMessageInfo
+
+func (m *UserRecoveryCodes) GetCodes() []string {
+	if m != nil {
+		return m.Codes
 	}
+	return nil
+}
 
-	that1, ok := that.(*ListUsersRequest)
-	if !ok {
-		that2, ok := that.(ListUsersRequest)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type Notification struct {
+	ID               string           `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CreatedAt        time.Time        `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3,stdtime" json:"created_at"`
+	NotificationType NotificationType `protobuf:"varint,3,opt,name=notification_type,json=notificationType,proto3,enum=ttn.lorawan.v3.NotificationType" json:"notification_type,omitempty"`
+	// The entity that the notification is about.
+	EntityIDs EntityIdentifiers `protobuf:"bytes,4,opt,name=entity_ids,json=entityIds,proto3" json:"entity_ids"`
+	// The user that triggered the notification, if any.
+	SenderIDs *UserIdentifiers `protobuf:"bytes,5,opt,name=sender_ids,json=senderIds,proto3" json:"sender_ids,omitempty"`
+	// The subject of the notification within the entity, such as the API key or the collaborator.
+	Subject string `protobuf:"bytes,6,opt,name=subject,proto3" json:"subject,omitempty"`
+	// The rights of the subject, for notifications about API keys and collaborators.
+	Rights []Right `protobuf:"varint,7,rep,packed,name=rights,proto3,enum=ttn.lorawan.v3.Right" json:"rights,omitempty"`
+	// The time at which the notification was read by the user.
+	// This is not set for notifications that are not read yet.
+	ReadAt               *time.Time `protobuf:"bytes,8,opt,name=read_at,json=readAt,proto3,stdtime" json:"read_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *Notification) Reset()      { *m = Notification{} }
+func (*Notification) ProtoMessage() {}
+func (*Notification) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ce30de589ccb9af, []int{27}
+}
+func (m *Notification) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Notification) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Notification.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.FieldMask.Equal(&that1.FieldMask) {
-		return false
-	}
-	if this.Order != that1.Order {
-		return false
-	}
-	if this.Limit != that1.Limit {
-		return false
+}
+func (m *Notification) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Notification.Merge(m, src)
+}
+func (m *Notification) XXX_Size() int {
+	return m.Size()
+}
+func (m *Notification) XXX_DiscardUnknown() {
+	xxx_messageInfo_Notification.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Notification proto.InternalMessageInfo
+
+func (m *Notification) GetID() string {
+	if m != nil {
+		return m.ID
 	}
-	if this.Page != that1.Page {
-		return false
+	return ""
+}
+
+func (m *Notification) GetCreatedAt() time.Time {
+	if m != nil {
+		return m.CreatedAt
 	}
-	return true
+	return time.Time{}
 }
-func (this *CreateUserRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *Notification) GetNotificationType() NotificationType {
+	if m != nil {
+		return m.NotificationType
 	}
+	return NOTIFICATION_TYPE_UNKNOWN
+}
 
-	that1, ok := that.(*CreateUserRequest)
-	if !ok {
-		that2, ok := that.(CreateUserRequest)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+func (m *Notification) GetEntityIDs() EntityIdentifiers {
+	if m != nil {
+		return m.EntityIDs
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+	return EntityIdentifiers{}
+}
+
+func (m *Notification) GetSenderIDs() *UserIdentifiers {
+	if m != nil {
+		return m.SenderIDs
 	}
-	if !this.User.Equal(&that1.User) {
-		return false
+	return nil
+}
+
+func (m *Notification) GetSubject() string {
+	if m != nil {
+		return m.Subject
 	}
-	if this.InvitationToken != that1.InvitationToken {
-		return false
+	return ""
+}
+
+func (m *Notification) GetRights() []Right {
+	if m != nil {
+		return m.Rights
 	}
-	return true
+	return nil
 }
-func (this *UpdateUserRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *Notification) GetReadAt() *time.Time {
+	if m != nil {
+		return m.ReadAt
 	}
+	return nil
+}
 
-	that1, ok := that.(*UpdateUserRequest)
-	if !ok {
-		that2, ok := that.(UpdateUserRequest)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type Notifications struct {
+	Notifications        []*Notification `protobuf:"bytes,1,rep,name=notifications,proto3" json:"notifications,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *Notifications) Reset()      { *m = Notifications{} }
+func (*Notifications) ProtoMessage() {}
+func (*Notifications) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ce30de589ccb9af, []int{28}
+}
+func (m *Notifications) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Notifications) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Notifications.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.User.Equal(&that1.User) {
-		return false
-	}
-	if !this.FieldMask.Equal(&that1.FieldMask) {
-		return false
-	}
-	return true
 }
-func (this *CreateTemporaryPasswordRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (m *Notifications) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Notifications.Merge(m, src)
+}
+func (m *Notifications) XXX_Size() int {
+	return m.Size()
+}
+func (m *Notifications) XXX_DiscardUnknown() {
+	xxx_messageInfo_Notifications.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Notifications proto.InternalMessageInfo
+
+func (m *Notifications) GetNotifications() []*Notification {
+	if m != nil {
+		return m.Notifications
 	}
+	return nil
+}
 
-	that1, ok := that.(*CreateTemporaryPasswordRequest)
-	if !ok {
-		that2, ok := that.(CreateTemporaryPasswordRequest)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type CreateNotificationRequest struct {
+	Notification         Notification `protobuf:"bytes,1,opt,name=notification,proto3" json:"notification"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *CreateNotificationRequest) Reset()      { *m = CreateNotificationRequest{} }
+func (*CreateNotificationRequest) ProtoMessage() {}
+func (*CreateNotificationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ce30de589ccb9af, []int{29}
+}
+func (m *CreateNotificationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CreateNotificationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CreateNotificationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.UserIdentifiers.Equal(&that1.UserIdentifiers) {
-		return false
-	}
-	return true
 }
-func (this *UpdateUserPasswordRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (m *CreateNotificationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateNotificationRequest.Merge(m, src)
+}
+func (m *CreateNotificationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *CreateNotificationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateNotificationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateNotificationRequest proto.InternalMessageInfo
+
+func (m *CreateNotificationRequest) GetNotification() Notification {
+	if m != nil {
+		return m.Notification
 	}
+	return Notification{}
+}
 
-	that1, ok := that.(*UpdateUserPasswordRequest)
-	if !ok {
-		that2, ok := that.(UpdateUserPasswordRequest)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type ListNotificationsRequest struct {
+	UserIdentifiers `protobuf:"bytes,1,opt,name=user_ids,json=userIds,proto3,embedded=user_ids" json:"user_ids"`
+	// Only list notifications that are not read yet.
+	UnreadOnly bool `protobuf:"varint,2,opt,name=unread_only,json=unreadOnly,proto3" json:"unread_only,omitempty"`
+	// Limit the number of results per page.
+	Limit uint32 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	// Page number for pagination. 0 is interpreted as 1.
+	Page                 uint32   `protobuf:"varint,4,opt,name=page,proto3" json:"page,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListNotificationsRequest) Reset()      { *m = ListNotificationsRequest{} }
+func (*ListNotificationsRequest) ProtoMessage() {}
+func (*ListNotificationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ce30de589ccb9af, []int{30}
+}
+func (m *ListNotificationsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListNotificationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListNotificationsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.UserIdentifiers.Equal(&that1.UserIdentifiers) {
-		return false
-	}
-	if this.New != that1.New {
-		return false
-	}
-	if this.Old != that1.Old {
-		return false
+}
+func (m *ListNotificationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListNotificationsRequest.Merge(m, src)
+}
+func (m *ListNotificationsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListNotificationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListNotificationsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListNotificationsRequest proto.InternalMessageInfo
+
+func (m *ListNotificationsRequest) GetUnreadOnly() bool {
+	if m != nil {
+		return m.UnreadOnly
 	}
-	if this.RevokeAllAccess != that1.RevokeAllAccess {
-		return false
+	return false
+}
+
+func (m *ListNotificationsRequest) GetLimit() uint32 {
+	if m != nil {
+		return m.Limit
 	}
-	return true
+	return 0
 }
-func (this *ListUserAPIKeysRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *ListNotificationsRequest) GetPage() uint32 {
+	if m != nil {
+		return m.Page
 	}
+	return 0
+}
 
-	that1, ok := that.(*ListUserAPIKeysRequest)
-	if !ok {
-		that2, ok := that.(ListUserAPIKeysRequest)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type MarkNotificationsReadRequest struct {
+	UserIdentifiers `protobuf:"bytes,1,opt,name=user_ids,json=userIds,proto3,embedded=user_ids" json:"user_ids"`
+	// The IDs of the notifications to mark as read.
+	// If empty, all notifications of the user are marked as read.
+	IDs                  []string `protobuf:"bytes,2,rep,name=ids,proto3" json:"ids,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MarkNotificationsReadRequest) Reset()      { *m = MarkNotificationsReadRequest{} }
+func (*MarkNotificationsReadRequest) ProtoMessage() {}
+func (*MarkNotificationsReadRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ce30de589ccb9af, []int{31}
+}
+func (m *MarkNotificationsReadRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MarkNotificationsReadRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MarkNotificationsReadRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+}
+func (m *MarkNotificationsReadRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MarkNotificationsReadRequest.Merge(m, src)
+}
+func (m *MarkNotificationsReadRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MarkNotificationsReadRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MarkNotificationsReadRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MarkNotificationsReadRequest proto.InternalMessageInfo
+
+func (m *MarkNotificationsReadRequest) GetIDs() []string {
+	if m != nil {
+		return m.IDs
 	}
-	if !this.UserIdentifiers.Equal(&that1.UserIdentifiers) {
-		return false
+	return nil
+}
+
+type NotificationPreference struct {
+	NotificationType NotificationType `protobuf:"varint,1,opt,name=notification_type,json=notificationType,proto3,enum=ttn.lorawan.v3.NotificationType" json:"notification_type,omitempty"`
+	// Whether notifications of this type are recorded for the user.
+	Subscribed bool `protobuf:"varint,2,opt,name=subscribed,proto3" json:"subscribed,omitempty"`
+	// Whether notifications of this type are also delivered by email.
+	Email                bool     `protobuf:"varint,3,opt,name=email,proto3" json:"email,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *NotificationPreference) Reset()      { *m = NotificationPreference{} }
+func (*NotificationPreference) ProtoMessage() {}
+func (*NotificationPreference) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ce30de589ccb9af, []int{32}
+}
+func (m *NotificationPreference) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NotificationPreference) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NotificationPreference.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if this.Limit != that1.Limit {
-		return false
+}
+func (m *NotificationPreference) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NotificationPreference.Merge(m, src)
+}
+func (m *NotificationPreference) XXX_Size() int {
+	return m.Size()
+}
+func (m *NotificationPreference) XXX_DiscardUnknown() {
+	xxx_messageInfo_NotificationPreference.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NotificationPreference proto.InternalMessageInfo
+
+func (m *NotificationPreference) GetNotificationType() NotificationType {
+	if m != nil {
+		return m.NotificationType
 	}
-	if this.Page != that1.Page {
-		return false
+	return NOTIFICATION_TYPE_UNKNOWN
+}
+
+func (m *NotificationPreference) GetSubscribed() bool {
+	if m != nil {
+		return m.Subscribed
 	}
-	return true
+	return false
 }
-func (this *GetUserAPIKeyRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *NotificationPreference) GetEmail() bool {
+	if m != nil {
+		return m.Email
 	}
+	return false
+}
 
-	that1, ok := that.(*GetUserAPIKeyRequest)
-	if !ok {
-		that2, ok := that.(GetUserAPIKeyRequest)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type NotificationPreferences struct {
+	Preferences          []*NotificationPreference `protobuf:"bytes,1,rep,name=preferences,proto3" json:"preferences,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
+}
+
+func (m *NotificationPreferences) Reset()      { *m = NotificationPreferences{} }
+func (*NotificationPreferences) ProtoMessage() {}
+func (*NotificationPreferences) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ce30de589ccb9af, []int{33}
+}
+func (m *NotificationPreferences) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NotificationPreferences) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NotificationPreferences.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.UserIdentifiers.Equal(&that1.UserIdentifiers) {
-		return false
-	}
-	if this.KeyID != that1.KeyID {
-		return false
-	}
-	return true
 }
-func (this *CreateUserAPIKeyRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (m *NotificationPreferences) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NotificationPreferences.Merge(m, src)
+}
+func (m *NotificationPreferences) XXX_Size() int {
+	return m.Size()
+}
+func (m *NotificationPreferences) XXX_DiscardUnknown() {
+	xxx_messageInfo_NotificationPreferences.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NotificationPreferences proto.InternalMessageInfo
+
+func (m *NotificationPreferences) GetPreferences() []*NotificationPreference {
+	if m != nil {
+		return m.Preferences
 	}
+	return nil
+}
 
-	that1, ok := that.(*CreateUserAPIKeyRequest)
-	if !ok {
-		that2, ok := that.(CreateUserAPIKeyRequest)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type SetNotificationPreferencesRequest struct {
+	UserIdentifiers `protobuf:"bytes,1,opt,name=user_ids,json=userIds,proto3,embedded=user_ids" json:"user_ids"`
+	// The preferences to set. Preferences of notification types that are not
+	// included are not changed.
+	Preferences          []*NotificationPreference `protobuf:"bytes,2,rep,name=preferences,proto3" json:"preferences,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
+}
+
+func (m *SetNotificationPreferencesRequest) Reset()      { *m = SetNotificationPreferencesRequest{} }
+func (*SetNotificationPreferencesRequest) ProtoMessage() {}
+func (*SetNotificationPreferencesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ce30de589ccb9af, []int{34}
+}
+func (m *SetNotificationPreferencesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetNotificationPreferencesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetNotificationPreferencesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.UserIdentifiers.Equal(&that1.UserIdentifiers) {
-		return false
-	}
-	if this.Name != that1.Name {
-		return false
-	}
-	if len(this.Rights) != len(that1.Rights) {
-		return false
-	}
-	for i := range this.Rights {
-		if this.Rights[i] != that1.Rights[i] {
-			return false
-		}
+}
+func (m *SetNotificationPreferencesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetNotificationPreferencesRequest.Merge(m, src)
+}
+func (m *SetNotificationPreferencesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetNotificationPreferencesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetNotificationPreferencesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetNotificationPreferencesRequest proto.InternalMessageInfo
+
+func (m *SetNotificationPreferencesRequest) GetPreferences() []*NotificationPreference {
+	if m != nil {
+		return m.Preferences
 	}
-	return true
+	return nil
 }
-func (this *UpdateUserAPIKeyRequest) Equal(that interface{}) bool {
+
+func init() {
+	proto.RegisterEnum("ttn.lorawan.v3.NotificationType", NotificationType_name, NotificationType_value)
+	golang_proto.RegisterEnum("ttn.lorawan.v3.NotificationType", NotificationType_name, NotificationType_value)
+	proto.RegisterType((*User)(nil), "ttn.lorawan.v3.User")
+	golang_proto.RegisterType((*User)(nil), "ttn.lorawan.v3.User")
+	proto.RegisterMapType((map[string]string)(nil), "ttn.lorawan.v3.User.AttributesEntry")
+	golang_proto.RegisterMapType((map[string]string)(nil), "ttn.lorawan.v3.User.AttributesEntry")
+	proto.RegisterType((*Users)(nil), "ttn.lorawan.v3.Users")
+	golang_proto.RegisterType((*Users)(nil), "ttn.lorawan.v3.Users")
+	proto.RegisterType((*GetUserRequest)(nil), "ttn.lorawan.v3.GetUserRequest")
+	golang_proto.RegisterType((*GetUserRequest)(nil), "ttn.lorawan.v3.GetUserRequest")
+	proto.RegisterType((*ListUsersRequest)(nil), "ttn.lorawan.v3.ListUsersRequest")
+	golang_proto.RegisterType((*ListUsersRequest)(nil), "ttn.lorawan.v3.ListUsersRequest")
+	proto.RegisterType((*CreateUserRequest)(nil), "ttn.lorawan.v3.CreateUserRequest")
+	golang_proto.RegisterType((*CreateUserRequest)(nil), "ttn.lorawan.v3.CreateUserRequest")
+	proto.RegisterType((*UpdateUserRequest)(nil), "ttn.lorawan.v3.UpdateUserRequest")
+	golang_proto.RegisterType((*UpdateUserRequest)(nil), "ttn.lorawan.v3.UpdateUserRequest")
+	proto.RegisterType((*CreateTemporaryPasswordRequest)(nil), "ttn.lorawan.v3.CreateTemporaryPasswordRequest")
+	golang_proto.RegisterType((*CreateTemporaryPasswordRequest)(nil), "ttn.lorawan.v3.CreateTemporaryPasswordRequest")
+	proto.RegisterType((*UpdateUserPasswordRequest)(nil), "ttn.lorawan.v3.UpdateUserPasswordRequest")
+	golang_proto.RegisterType((*UpdateUserPasswordRequest)(nil), "ttn.lorawan.v3.UpdateUserPasswordRequest")
+	proto.RegisterType((*ListUserAPIKeysRequest)(nil), "ttn.lorawan.v3.ListUserAPIKeysRequest")
+	golang_proto.RegisterType((*ListUserAPIKeysRequest)(nil), "ttn.lorawan.v3.ListUserAPIKeysRequest")
+	proto.RegisterType((*GetUserAPIKeyRequest)(nil), "ttn.lorawan.v3.GetUserAPIKeyRequest")
+	golang_proto.RegisterType((*GetUserAPIKeyRequest)(nil), "ttn.lorawan.v3.GetUserAPIKeyRequest")
+	proto.RegisterType((*CreateUserAPIKeyRequest)(nil), "ttn.lorawan.v3.CreateUserAPIKeyRequest")
+	golang_proto.RegisterType((*CreateUserAPIKeyRequest)(nil), "ttn.lorawan.v3.CreateUserAPIKeyRequest")
+	proto.RegisterType((*UpdateUserAPIKeyRequest)(nil), "ttn.lorawan.v3.UpdateUserAPIKeyRequest")
+	golang_proto.RegisterType((*UpdateUserAPIKeyRequest)(nil), "ttn.lorawan.v3.UpdateUserAPIKeyRequest")
+	proto.RegisterType((*Invitation)(nil), "ttn.lorawan.v3.Invitation")
+	golang_proto.RegisterType((*Invitation)(nil), "ttn.lorawan.v3.Invitation")
+	proto.RegisterType((*ListInvitationsRequest)(nil), "ttn.lorawan.v3.ListInvitationsRequest")
+	golang_proto.RegisterType((*ListInvitationsRequest)(nil), "ttn.lorawan.v3.ListInvitationsRequest")
+	proto.RegisterType((*Invitations)(nil), "ttn.lorawan.v3.Invitations")
+	golang_proto.RegisterType((*Invitations)(nil), "ttn.lorawan.v3.Invitations")
+	proto.RegisterType((*SendInvitationRequest)(nil), "ttn.lorawan.v3.SendInvitationRequest")
+	golang_proto.RegisterType((*SendInvitationRequest)(nil), "ttn.lorawan.v3.SendInvitationRequest")
+	proto.RegisterType((*DeleteInvitationRequest)(nil), "ttn.lorawan.v3.DeleteInvitationRequest")
+	golang_proto.RegisterType((*DeleteInvitationRequest)(nil), "ttn.lorawan.v3.DeleteInvitationRequest")
+	proto.RegisterType((*UserSessionIdentifiers)(nil), "ttn.lorawan.v3.UserSessionIdentifiers")
+	golang_proto.RegisterType((*UserSessionIdentifiers)(nil), "ttn.lorawan.v3.UserSessionIdentifiers")
+	proto.RegisterType((*UserSession)(nil), "ttn.lorawan.v3.UserSession")
+	golang_proto.RegisterType((*UserSession)(nil), "ttn.lorawan.v3.UserSession")
+	proto.RegisterType((*UserSessions)(nil), "ttn.lorawan.v3.UserSessions")
+	golang_proto.RegisterType((*UserSessions)(nil), "ttn.lorawan.v3.UserSessions")
+	proto.RegisterType((*ListUserSessionsRequest)(nil), "ttn.lorawan.v3.ListUserSessionsRequest")
+	golang_proto.RegisterType((*ListUserSessionsRequest)(nil), "ttn.lorawan.v3.ListUserSessionsRequest")
+	proto.RegisterType((*UserTOTPStatus)(nil), "ttn.lorawan.v3.UserTOTPStatus")
+	golang_proto.RegisterType((*UserTOTPStatus)(nil), "ttn.lorawan.v3.UserTOTPStatus")
+	proto.RegisterType((*UserTOTPEnrollment)(nil), "ttn.lorawan.v3.UserTOTPEnrollment")
+	golang_proto.RegisterType((*UserTOTPEnrollment)(nil), "ttn.lorawan.v3.UserTOTPEnrollment")
+	proto.RegisterType((*EnableUserTOTPRequest)(nil), "ttn.lorawan.v3.EnableUserTOTPRequest")
+	golang_proto.RegisterType((*EnableUserTOTPRequest)(nil), "ttn.lorawan.v3.EnableUserTOTPRequest")
+	proto.RegisterType((*DisableUserTOTPRequest)(nil), "ttn.lorawan.v3.DisableUserTOTPRequest")
+	golang_proto.RegisterType((*DisableUserTOTPRequest)(nil), "ttn.lorawan.v3.DisableUserTOTPRequest")
+	proto.RegisterType((*GenerateUserRecoveryCodesRequest)(nil), "ttn.lorawan.v3.GenerateUserRecoveryCodesRequest")
+	golang_proto.RegisterType((*GenerateUserRecoveryCodesRequest)(nil), "ttn.lorawan.v3.GenerateUserRecoveryCodesRequest")
+	proto.RegisterType((*UserRecoveryCodes)(nil), "ttn.lorawan.v3.UserRecoveryCodes")
+	golang_proto.RegisterType((*UserRecoveryCodes)(nil), "ttn.lorawan.v3.UserRecoveryCodes")
+	proto.RegisterType((*Notification)(nil), "ttn.lorawan.v3.Notification")
+	golang_proto.RegisterType((*Notification)(nil), "ttn.lorawan.v3.Notification")
+	proto.RegisterType((*Notifications)(nil), "ttn.lorawan.v3.Notifications")
+	golang_proto.RegisterType((*Notifications)(nil), "ttn.lorawan.v3.Notifications")
+	proto.RegisterType((*CreateNotificationRequest)(nil), "ttn.lorawan.v3.CreateNotificationRequest")
+	golang_proto.RegisterType((*CreateNotificationRequest)(nil), "ttn.lorawan.v3.CreateNotificationRequest")
+	proto.RegisterType((*ListNotificationsRequest)(nil), "ttn.lorawan.v3.ListNotificationsRequest")
+	golang_proto.RegisterType((*ListNotificationsRequest)(nil), "ttn.lorawan.v3.ListNotificationsRequest")
+	proto.RegisterType((*MarkNotificationsReadRequest)(nil), "ttn.lorawan.v3.MarkNotificationsReadRequest")
+	golang_proto.RegisterType((*MarkNotificationsReadRequest)(nil), "ttn.lorawan.v3.MarkNotificationsReadRequest")
+	proto.RegisterType((*NotificationPreference)(nil), "ttn.lorawan.v3.NotificationPreference")
+	golang_proto.RegisterType((*NotificationPreference)(nil), "ttn.lorawan.v3.NotificationPreference")
+	proto.RegisterType((*NotificationPreferences)(nil), "ttn.lorawan.v3.NotificationPreferences")
+	golang_proto.RegisterType((*NotificationPreferences)(nil), "ttn.lorawan.v3.NotificationPreferences")
+	proto.RegisterType((*SetNotificationPreferencesRequest)(nil), "ttn.lorawan.v3.SetNotificationPreferencesRequest")
+	golang_proto.RegisterType((*SetNotificationPreferencesRequest)(nil), "ttn.lorawan.v3.SetNotificationPreferencesRequest")
+}
+
+func init() { proto.RegisterFile("lorawan-stack/api/user.proto", fileDescriptor_5ce30de589ccb9af) }
+func init() {
+	golang_proto.RegisterFile("lorawan-stack/api/user.proto", fileDescriptor_5ce30de589ccb9af)
+}
+
+var fileDescriptor_5ce30de589ccb9af = []byte{
+	// 2402 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x59, 0x4d, 0x6c, 0x1b, 0xc7,
+	0x15, 0xe6, 0xf0, 0x47, 0x22, 0x1f, 0xf5, 0x43, 0x6d, 0x6c, 0x89, 0x96, 0xe2, 0x95, 0xbc, 0x55,
+	0x02, 0x59, 0x30, 0xa9, 0x42, 0x46, 0x5d, 0xc7, 0x4d, 0x6b, 0x2f, 0x25, 0x5a, 0x21, 0x24, 0x4b,
+	0xea, 0x88, 0x8e, 0xe1, 0x1a, 0xc9, 0x66, 0xc5, 0x1d, 0xd1, 0x5b, 0x92, 0xbb, 0xcc, 0xee, 0x50,
+	0x0e, 0x13, 0x04, 0x08, 0x5a, 0xa0, 0x35, 0xda, 0x00, 0x31, 0x0c, 0x14, 0x2d, 0xd2, 0x43, 0x8b,
+	0x16, 0x6d, 0x73, 0xcc, 0x31, 0x3d, 0x14, 0xc8, 0xa5, 0x80, 0xd1, 0x5e, 0x0c, 0xf4, 0x12, 0xa0,
+	0x80, 0x1a, 0x51, 0x17, 0xa3, 0xbd, 0xe4, 0x18, 0xe8, 0x54, 0xcc, 0xce, 0x2e, 0xb9, 0xa4, 0x68,
+	0x45, 0x72, 0x44, 0xb4, 0x27, 0xee, 0xcc, 0xfb, 0x99, 0xb7, 0xef, 0xe7, 0x7b, 0x6f, 0x96, 0xf0,
+	0x7c, 0xd9, 0xb4, 0xd4, 0x7b, 0xaa, 0x91, 0xb2, 0xa9, 0x5a, 0x28, 0xcd, 0xa9, 0x55, 0x7d, 0xae,
+	0x66, 0x13, 0x2b, 0x5d, 0xb5, 0x4c, 0x6a, 0x0a, 0x43, 0x94, 0x1a, 0x69, 0x97, 0x23, 0xbd, 0x7d,
+	0x71, 0x5c, 0x2e, 0xea, 0xf4, 0x6e, 0x6d, 0x33, 0x5d, 0x30, 0x2b, 0x73, 0xc4, 0xd8, 0x36, 0xeb,
+	0x55, 0xcb, 0x7c, 0xab, 0x3e, 0xe7, 0x30, 0x17, 0x52, 0x45, 0x62, 0xa4, 0xb6, 0xd5, 0xb2, 0xae,
+	0xa9, 0x94, 0xcc, 0x1d, 0x78, 0xe0, 0x2a, 0xc7, 0x53, 0x3e, 0x15, 0x45, 0xb3, 0x68, 0x72, 0xe1,
+	0xcd, 0xda, 0x96, 0xb3, 0x72, 0x16, 0xce, 0x93, 0xcb, 0x3e, 0x51, 0x34, 0xcd, 0x62, 0x99, 0xb4,
+	0xb8, 0x48, 0xa5, 0x4a, 0xeb, 0x2e, 0x71, 0xaa, 0x93, 0xb8, 0xa5, 0x93, 0xb2, 0xa6, 0x54, 0x54,
+	0xbb, 0xe4, 0x72, 0x4c, 0x76, 0x72, 0x50, 0xbd, 0x42, 0x6c, 0xaa, 0x56, 0xaa, 0x2e, 0x83, 0x78,
+	0xf0, 0xfd, 0x0b, 0x65, 0x9d, 0x18, 0xd4, 0xa5, 0x4f, 0x77, 0xa1, 0x9b, 0x06, 0x55, 0x0b, 0x54,
+	0xd1, 0x8d, 0x2d, 0xcf, 0xca, 0xb3, 0x07, 0xb9, 0x88, 0x51, 0xab, 0xd8, 0x2e, 0xf9, 0x1b, 0x07,
+	0xc9, 0xba, 0x46, 0x0c, 0xaa, 0x6f, 0xe9, 0xc4, 0xf2, 0x98, 0x26, 0x0f, 0x32, 0x55, 0xf5, 0x02,
+	0xad, 0x59, 0xe4, 0xe9, 0xa6, 0x5a, 0x7a, 0xf1, 0x2e, 0x75, 0x15, 0x48, 0xff, 0x8e, 0x41, 0xf8,
+	0xa6, 0x4d, 0x2c, 0x61, 0x01, 0x42, 0xba, 0x66, 0x27, 0xd1, 0x14, 0x9a, 0x89, 0xcf, 0x4f, 0xa6,
+	0xdb, 0x63, 0x98, 0x66, 0x2c, 0xb9, 0xd6, 0xe9, 0x99, 0xc4, 0x7e, 0x26, 0xf2, 0x33, 0x14, 0x4c,
+	0xa0, 0x47, 0x3b, 0x93, 0x81, 0xc7, 0x3b, 0x93, 0x08, 0x33, 0x69, 0x61, 0x01, 0xa0, 0x60, 0x11,
+	0x95, 0x12, 0x4d, 0x51, 0x69, 0x32, 0xe8, 0xe8, 0x1a, 0x4f, 0x73, 0x77, 0xa6, 0x3d, 0x77, 0xa6,
+	0xf3, 0x9e, 0x3b, 0x33, 0x51, 0x26, 0xfe, 0xe0, 0x5f, 0x93, 0x08, 0xc7, 0x5c, 0x39, 0x99, 0x32,
+	0x25, 0xb5, 0xaa, 0xe6, 0x29, 0x09, 0x1d, 0x47, 0x89, 0x2b, 0x27, 0x53, 0x61, 0x02, 0xc2, 0x86,
+	0x5a, 0x21, 0xc9, 0xf0, 0x14, 0x9a, 0x89, 0x65, 0xfa, 0xf7, 0x33, 0x61, 0x2b, 0x98, 0x9c, 0xc7,
+	0xce, 0xa6, 0x30, 0x0b, 0x71, 0x8d, 0xd8, 0x05, 0x4b, 0xaf, 0x52, 0xdd, 0x34, 0x92, 0x11, 0x87,
+	0x27, 0xba, 0x9f, 0x89, 0x58, 0xa1, 0xe4, 0xe3, 0x61, 0xec, 0x27, 0x0a, 0x16, 0x80, 0x4a, 0xa9,
+	0xa5, 0x6f, 0xd6, 0x28, 0xb1, 0x93, 0x7d, 0x53, 0xa1, 0x99, 0xf8, 0xfc, 0x74, 0x37, 0xf7, 0xa4,
+	0xe5, 0x26, 0x5b, 0xd6, 0xa0, 0x56, 0x3d, 0x73, 0x61, 0x3f, 0x73, 0xfe, 0x43, 0xf4, 0xa2, 0x34,
+	0x6d, 0x49, 0xc9, 0xe9, 0x79, 0xf1, 0xf5, 0x3b, 0x6a, 0xea, 0xed, 0x6f, 0xa6, 0x5e, 0x7a, 0x6d,
+	0xe6, 0xea, 0x95, 0x3b, 0xa9, 0xd7, 0xae, 0x7a, 0xcb, 0xf3, 0xef, 0xcc, 0x5f, 0x78, 0x77, 0x1a,
+	0xfb, 0x4e, 0x11, 0xbe, 0x07, 0x03, 0xfe, 0x7c, 0x49, 0xf6, 0x3b, 0xa7, 0x4e, 0x74, 0x9e, 0xba,
+	0xc0, 0x79, 0x72, 0xc6, 0x96, 0x89, 0xe3, 0x85, 0xd6, 0x42, 0xf8, 0x0e, 0x9c, 0xae, 0x5a, 0x7a,
+	0x45, 0xb5, 0xea, 0x0a, 0xa9, 0xa8, 0x7a, 0x59, 0x51, 0x35, 0xcd, 0x22, 0xb6, 0x9d, 0x8c, 0xfa,
+	0xbc, 0xf1, 0x06, 0xc2, 0xcf, 0xb9, 0x5c, 0x59, 0xc6, 0x24, 0x73, 0x1e, 0xa1, 0x0c, 0x52, 0x57,
+	0x61, 0xc5, 0xab, 0x49, 0x27, 0x2c, 0xb1, 0xaf, 0x0c, 0x4b, 0xd8, 0x09, 0x89, 0xd8, 0xe5, 0x88,
+	0x57, 0x3d, 0x45, 0x32, 0x15, 0xc6, 0x21, 0x5a, 0x55, 0x6d, 0xfb, 0x9e, 0x69, 0x69, 0x49, 0x60,
+	0xd6, 0xe1, 0xe6, 0x5a, 0x58, 0x87, 0xe7, 0xbc, 0x67, 0xc5, 0x97, 0x11, 0xf1, 0x23, 0x1e, 0x3d,
+	0xe2, 0x09, 0xdf, 0x6c, 0x66, 0xc5, 0x25, 0x18, 0xb3, 0xc8, 0x9b, 0x35, 0xdd, 0x22, 0x4a, 0x87,
+	0xe6, 0xe4, 0xc0, 0x14, 0x9a, 0x89, 0xe2, 0xd3, 0x2e, 0x79, 0xbd, 0x4d, 0x54, 0xf8, 0x16, 0x44,
+	0x6c, 0xca, 0xb8, 0x06, 0xa7, 0xd0, 0xcc, 0xd0, 0xfc, 0xe9, 0xce, 0x48, 0x6c, 0x30, 0xa2, 0x93,
+	0x41, 0x3f, 0x62, 0x45, 0x81, 0x39, 0xb7, 0x70, 0x0a, 0x22, 0xaa, 0x56, 0xd1, 0x8d, 0xe4, 0x90,
+	0xa3, 0x9c, 0x2f, 0x84, 0x14, 0x08, 0x94, 0x54, 0xaa, 0xa6, 0xc5, 0x5c, 0xdc, 0x7c, 0xf9, 0x61,
+	0xe7, 0xe5, 0x47, 0x9a, 0x14, 0xcf, 0x02, 0xa1, 0x00, 0x67, 0x0f, 0xb2, 0x2b, 0xbe, 0x32, 0x4b,
+	0x1c, 0xd1, 0x1f, 0xe3, 0x07, 0x74, 0x2f, 0x34, 0x6b, 0xae, 0xfb, 0x21, 0xe4, 0xad, 0xaa, 0x6e,
+	0x11, 0x9b, 0x1d, 0x32, 0xf2, 0xcc, 0x87, 0x64, 0xb9, 0x12, 0x99, 0x0a, 0xd7, 0x60, 0xb8, 0x6a,
+	0x99, 0x5b, 0x7a, 0x99, 0x28, 0x2e, 0x48, 0x25, 0x05, 0x47, 0xed, 0x58, 0xa7, 0x3f, 0xd7, 0x39,
+	0x19, 0x0f, 0xb9, 0xfc, 0xee, 0x7a, 0xfc, 0xbb, 0x30, 0xdc, 0x51, 0x65, 0x42, 0x02, 0x42, 0x25,
+	0x52, 0x77, 0x70, 0x2b, 0x86, 0xd9, 0x23, 0xf3, 0xfa, 0xb6, 0x5a, 0xae, 0x11, 0x07, 0x7f, 0x62,
+	0x98, 0x2f, 0xae, 0x04, 0x2f, 0x23, 0xe9, 0x22, 0x44, 0x58, 0xa5, 0xda, 0xc2, 0x2c, 0x44, 0x58,
+	0xc3, 0x62, 0x70, 0xc7, 0x2a, 0xeb, 0x54, 0xb7, 0x7a, 0xc6, 0x9c, 0x45, 0xfa, 0x0d, 0x82, 0xa1,
+	0x25, 0x42, 0x9d, 0x2d, 0xf2, 0x66, 0x8d, 0xd8, 0x54, 0x58, 0x81, 0x28, 0xa3, 0x29, 0x5f, 0x0b,
+	0x30, 0xfb, 0x6b, 0x0e, 0x8b, 0x2d, 0x5c, 0x05, 0x68, 0xb5, 0xa0, 0xa7, 0x82, 0xe6, 0x75, 0xc6,
+	0x72, 0x43, 0xb5, 0x4b, 0x99, 0x30, 0x53, 0x81, 0x63, 0x5b, 0xde, 0x86, 0xf4, 0xb7, 0x20, 0x24,
+	0x56, 0x74, 0xdb, 0x31, 0xd1, 0xf6, 0x6c, 0x6c, 0xd7, 0x8a, 0x8e, 0xad, 0x55, 0xf8, 0x23, 0x82,
+	0x88, 0x69, 0x69, 0xc4, 0xe2, 0x7e, 0xcc, 0x7c, 0x80, 0xf6, 0x33, 0x3f, 0x47, 0xd6, 0x7d, 0x84,
+	0x03, 0xdc, 0x76, 0x45, 0xd7, 0x70, 0x34, 0xe5, 0x3d, 0x39, 0xc8, 0x8a, 0x23, 0x29, 0xe7, 0xa7,
+	0x3b, 0xfc, 0xe0, 0xd1, 0x54, 0xf7, 0x7d, 0x5e, 0x2e, 0xb8, 0x2f, 0xc5, 0x7f, 0x79, 0x9d, 0xe0,
+	0xbe, 0x14, 0xff, 0xf5, 0xb5, 0x14, 0x1c, 0x4f, 0xf9, 0x16, 0xdc, 0x3c, 0x41, 0x84, 0x48, 0x59,
+	0xaf, 0xe8, 0xbc, 0x55, 0x0c, 0x3a, 0x55, 0x38, 0x1b, 0x4a, 0x3e, 0xe9, 0xc7, 0x7c, 0x5b, 0x10,
+	0x20, 0x5c, 0x55, 0x8b, 0xbc, 0x15, 0x0c, 0x62, 0xe7, 0x59, 0x48, 0x42, 0xbf, 0x46, 0xca, 0x84,
+	0x12, 0xcd, 0x41, 0xff, 0x28, 0xf6, 0x96, 0xd2, 0xdb, 0x30, 0xc2, 0xcb, 0xc2, 0x1f, 0xf0, 0x2b,
+	0x10, 0x66, 0xef, 0xe9, 0xba, 0xb1, 0x6b, 0xba, 0x74, 0x89, 0xb0, 0x23, 0x23, 0x9c, 0x87, 0x84,
+	0x6e, 0x6c, 0xeb, 0x54, 0x65, 0xed, 0x44, 0xa1, 0x66, 0x89, 0x18, 0x6e, 0x66, 0x0e, 0xb7, 0xf6,
+	0xf3, 0x6c, 0x5b, 0x7a, 0x80, 0x60, 0x84, 0x23, 0xce, 0x49, 0x1d, 0xfe, 0xb5, 0x73, 0xcb, 0x00,
+	0x91, 0xbb, 0x23, 0xdf, 0x59, 0xd7, 0x3d, 0x29, 0x06, 0xe9, 0xcf, 0x08, 0xce, 0xb4, 0x5c, 0xd0,
+	0xd3, 0xb3, 0x18, 0x74, 0x18, 0xe4, 0x9e, 0x1b, 0x0c, 0xf6, 0xc8, 0x76, 0xcc, 0xb2, 0xe6, 0x24,
+	0x52, 0x0c, 0xb3, 0x47, 0x61, 0x16, 0x46, 0x2c, 0xb2, 0x6d, 0x96, 0x88, 0xa2, 0x96, 0xcb, 0x8a,
+	0x5a, 0x28, 0xb0, 0x36, 0x1a, 0x76, 0x52, 0x66, 0x98, 0x13, 0xe4, 0x72, 0x59, 0x76, 0xb6, 0xa5,
+	0x0f, 0x11, 0x8c, 0x7a, 0x75, 0x28, 0xaf, 0xe7, 0x96, 0x49, 0xdd, 0xee, 0x8d, 0xe1, 0xcd, 0x8c,
+	0x0f, 0x1e, 0x9e, 0xf1, 0xa1, 0x56, 0xc6, 0x4b, 0x3f, 0x41, 0x70, 0x6a, 0x89, 0xf8, 0x6c, 0xeb,
+	0x8d, 0x69, 0x53, 0xd0, 0x57, 0x22, 0x75, 0x45, 0xd7, 0x5c, 0xd4, 0x88, 0x35, 0x76, 0x26, 0x23,
+	0xcb, 0xa4, 0x9e, 0x5b, 0xc4, 0x91, 0x12, 0xa9, 0xe7, 0x34, 0xe9, 0xef, 0x41, 0x18, 0x6b, 0x55,
+	0x58, 0x2f, 0x6d, 0xf1, 0x66, 0xc0, 0x60, 0xb7, 0x19, 0xf0, 0x65, 0xe8, 0xe3, 0x83, 0x70, 0x32,
+	0x34, 0x15, 0xea, 0xd6, 0xd3, 0x31, 0xa3, 0x66, 0x06, 0xf7, 0x33, 0xf0, 0x10, 0xf5, 0x4b, 0x6e,
+	0x63, 0x77, 0x65, 0x58, 0x5d, 0xf9, 0x9a, 0x63, 0xf8, 0x88, 0xcd, 0x31, 0x46, 0x9a, 0xbd, 0xf0,
+	0x3a, 0x0c, 0x58, 0xc4, 0xa6, 0x96, 0x5e, 0x60, 0xe5, 0x6f, 0x3b, 0x28, 0x14, 0x9f, 0x97, 0x3a,
+	0x8d, 0xf0, 0xdc, 0xd3, 0xe2, 0xc4, 0x6d, 0x72, 0xd2, 0x7f, 0x10, 0x8c, 0xb5, 0xea, 0xa5, 0x97,
+	0xde, 0x94, 0xa1, 0x5f, 0xad, 0xea, 0x0a, 0x6b, 0xb6, 0x1c, 0x47, 0x46, 0xbb, 0x1b, 0xdb, 0x45,
+	0x47, 0x9f, 0x5a, 0xd5, 0x97, 0x49, 0xbd, 0x03, 0x8d, 0x42, 0xc7, 0x47, 0xa3, 0x5f, 0x84, 0x00,
+	0x72, 0x4d, 0xd0, 0x14, 0xce, 0x42, 0xc4, 0x69, 0x24, 0x49, 0xe4, 0x8b, 0xf0, 0x1b, 0x08, 0xf3,
+	0x5d, 0x36, 0x08, 0xf8, 0xe1, 0x96, 0x2f, 0xd8, 0xf5, 0xc2, 0x17, 0xba, 0x63, 0x5d, 0x2f, 0x5a,
+	0xe1, 0x6b, 0xbf, 0xe8, 0x84, 0x4f, 0xe2, 0xa2, 0x13, 0x79, 0xb6, 0x8b, 0x8e, 0x0c, 0x71, 0x86,
+	0x4a, 0x55, 0x57, 0x4b, 0xdf, 0x11, 0x53, 0x11, 0x3c, 0x21, 0x67, 0x2e, 0x6b, 0xa9, 0xd8, 0xac,
+	0x27, 0xfb, 0x8f, 0x94, 0x2a, 0x2d, 0x0d, 0x99, 0xba, 0xb4, 0xc2, 0x81, 0xaf, 0x15, 0x9a, 0x26,
+	0xf0, 0x35, 0xa1, 0x0a, 0x1d, 0x0e, 0x55, 0x41, 0x1f, 0x54, 0x2d, 0x43, 0xdc, 0xa7, 0x49, 0x78,
+	0x19, 0xe2, 0xad, 0x46, 0xe9, 0x8d, 0x6c, 0xe3, 0x9d, 0xe6, 0xb5, 0x24, 0xb0, 0x9f, 0x5d, 0xba,
+	0x04, 0xa7, 0x37, 0x88, 0xa1, 0xf9, 0xc8, 0xae, 0x65, 0x87, 0x27, 0x8f, 0x74, 0x19, 0xc6, 0x16,
+	0x9d, 0x91, 0xe0, 0xd8, 0x92, 0xbf, 0x46, 0x30, 0xca, 0x9c, 0xb5, 0x41, 0x6c, 0x5b, 0x37, 0x0d,
+	0x9f, 0xcf, 0x4e, 0xb8, 0x22, 0x2f, 0x02, 0xd8, 0xfc, 0x8c, 0x16, 0xde, 0x9e, 0xe2, 0x28, 0x77,
+	0xad, 0xb1, 0x33, 0x19, 0xf3, 0x0c, 0x58, 0xc4, 0x31, 0xdb, 0xb3, 0x45, 0xfa, 0x67, 0x10, 0xe2,
+	0x3e, 0xeb, 0xfe, 0x0f, 0x4c, 0xea, 0x28, 0xa6, 0xd0, 0x49, 0x14, 0x53, 0xf8, 0xd9, 0x8a, 0xa9,
+	0x1d, 0xd6, 0x23, 0xc7, 0x86, 0x75, 0x69, 0x09, 0x06, 0x7c, 0xce, 0xb5, 0x85, 0x6f, 0x43, 0xd4,
+	0x7d, 0x4f, 0x2f, 0x71, 0x27, 0xba, 0x79, 0xd7, 0xe5, 0xc7, 0x4d, 0x66, 0xe9, 0x1f, 0x08, 0xc6,
+	0xbc, 0x59, 0xc2, 0xd3, 0xd6, 0x1b, 0x5c, 0xbf, 0xd4, 0x3e, 0xe6, 0x4f, 0xed, 0x67, 0xce, 0x5a,
+	0x13, 0x38, 0xd0, 0x8b, 0xb1, 0x5b, 0xfa, 0x03, 0x82, 0x21, 0x66, 0x5a, 0x7e, 0x2d, 0xbf, 0xce,
+	0xee, 0xcc, 0x35, 0x9b, 0x4d, 0xe2, 0xc4, 0x50, 0x37, 0xcb, 0x44, 0x73, 0xde, 0x25, 0x8a, 0xbd,
+	0xa5, 0x13, 0x0c, 0xfe, 0x78, 0xb4, 0x8f, 0x49, 0x5e, 0x30, 0xb8, 0x8c, 0x4c, 0x85, 0xcb, 0x90,
+	0xb4, 0x48, 0xc1, 0xdc, 0x26, 0x56, 0x5d, 0x29, 0x98, 0x1a, 0xb1, 0x15, 0x8b, 0x55, 0xa8, 0xa1,
+	0x1b, 0x45, 0x77, 0x34, 0x1a, 0xf5, 0xe8, 0x0b, 0x8c, 0x8c, 0x3d, 0xaa, 0xb4, 0x04, 0x82, 0x67,
+	0x66, 0xd6, 0xb0, 0xcc, 0x72, 0xb9, 0x42, 0x0c, 0x2a, 0x8c, 0x42, 0x9f, 0x4d, 0x0a, 0x16, 0xa1,
+	0xee, 0x6d, 0xd3, 0x5d, 0x09, 0x67, 0x20, 0x54, 0xb3, 0x74, 0x6f, 0xcc, 0x68, 0xec, 0x4c, 0x86,
+	0x6e, 0xe2, 0x1c, 0x66, 0x7b, 0xd2, 0xfb, 0x08, 0x4e, 0x67, 0x1d, 0x83, 0x3c, 0x7d, 0xbd, 0x09,
+	0xe2, 0x0b, 0x10, 0x66, 0x6f, 0xe8, 0xda, 0x30, 0xb2, 0x9f, 0x19, 0xb2, 0x06, 0xe6, 0xe1, 0xf5,
+	0x3b, 0xec, 0x03, 0xd3, 0x3b, 0x97, 0xde, 0x9d, 0xc6, 0x0e, 0x59, 0xfa, 0x31, 0x82, 0xd1, 0x45,
+	0xdd, 0xee, 0xbd, 0x3d, 0x13, 0x6d, 0xf6, 0xb8, 0xa3, 0xd7, 0x35, 0xd7, 0x8a, 0x5f, 0x22, 0x98,
+	0x5a, 0x22, 0x06, 0xb1, 0x9a, 0x17, 0x9d, 0xb6, 0x20, 0xfc, 0x0f, 0xfd, 0x73, 0x1e, 0x46, 0x0e,
+	0x18, 0xc4, 0xc6, 0x08, 0x46, 0xe4, 0x05, 0x1c, 0xc3, 0x7c, 0x21, 0xbd, 0x1f, 0x86, 0x81, 0x55,
+	0x93, 0x1d, 0x5d, 0xe0, 0xc3, 0xc8, 0x28, 0x04, 0x75, 0xcd, 0x6d, 0x09, 0x7d, 0x8d, 0x9d, 0xc9,
+	0x60, 0x6e, 0x11, 0x07, 0x75, 0xed, 0x64, 0xbe, 0x89, 0xde, 0x82, 0x11, 0xc3, 0x77, 0x98, 0x42,
+	0xeb, 0x55, 0x3e, 0xde, 0x0f, 0xcd, 0x4f, 0x75, 0xba, 0xc5, 0x6f, 0x55, 0xbe, 0x5e, 0xf5, 0x7f,
+	0x97, 0x4a, 0x18, 0x1d, 0x34, 0xe1, 0x0e, 0x2b, 0x32, 0xaa, 0xd3, 0xba, 0xe3, 0x68, 0x0e, 0x9b,
+	0xe7, 0x3a, 0x35, 0x66, 0x1d, 0x0e, 0xbf, 0xab, 0xcf, 0xf8, 0x5d, 0xcd, 0x80, 0xdd, 0x65, 0x59,
+	0xb4, 0x59, 0x01, 0x72, 0x6e, 0x5b, 0xb8, 0xc1, 0xba, 0x81, 0xa1, 0xb9, 0x51, 0x8c, 0x1c, 0x2d,
+	0x8a, 0x83, 0xbc, 0x4f, 0x30, 0x31, 0x47, 0x1d, 0xd7, 0xc0, 0xd4, 0x9d, 0x83, 0x7e, 0xbb, 0xb6,
+	0xf9, 0x43, 0x52, 0xe0, 0x63, 0x4e, 0x33, 0xaf, 0x34, 0xec, 0xed, 0xfb, 0xa6, 0xfa, 0xfe, 0x67,
+	0x98, 0xea, 0x5f, 0x82, 0x7e, 0x8b, 0xa8, 0x4e, 0x9c, 0xa2, 0x47, 0x84, 0x9b, 0x3e, 0x26, 0x20,
+	0x53, 0x69, 0x03, 0x06, 0xfd, 0x7e, 0xb7, 0x85, 0x0c, 0x0c, 0xfa, 0x9d, 0xed, 0xc1, 0xff, 0xf3,
+	0x87, 0x45, 0x0b, 0xb7, 0x8b, 0x48, 0x25, 0x38, 0xc3, 0x6f, 0x4a, 0x6d, 0x4c, 0x6e, 0x81, 0xac,
+	0xc2, 0x80, 0x9f, 0xdb, 0x2d, 0x92, 0x43, 0xf5, 0x67, 0x06, 0xfc, 0x61, 0xc3, 0x6d, 0xf2, 0xd2,
+	0x5f, 0x10, 0x24, 0x59, 0xc7, 0x69, 0x7b, 0x8d, 0xde, 0x54, 0xe3, 0x24, 0xc4, 0x6b, 0x86, 0xe3,
+	0x69, 0xd3, 0x28, 0xf3, 0xeb, 0x44, 0x14, 0x03, 0xdf, 0x5a, 0x33, 0xca, 0xf5, 0x67, 0xea, 0x2d,
+	0x1f, 0x20, 0x78, 0xfe, 0x86, 0x6a, 0x95, 0x3a, 0xec, 0x57, 0x7b, 0xf4, 0xf1, 0xe0, 0x1c, 0xff,
+	0xbf, 0x24, 0xc8, 0x30, 0x21, 0x33, 0xbc, 0x9f, 0x89, 0x3c, 0x44, 0xc1, 0x84, 0xc6, 0xc0, 0x9f,
+	0x65, 0x2d, 0xa3, 0x49, 0x7f, 0x42, 0x30, 0xea, 0xb7, 0x66, 0xdd, 0x22, 0x5b, 0xc4, 0x22, 0x46,
+	0x81, 0x74, 0xaf, 0x67, 0x74, 0x02, 0xf5, 0x2c, 0x02, 0xd8, 0xb5, 0x4d, 0xf6, 0xf7, 0xc5, 0x26,
+	0xd1, 0x3c, 0xcf, 0xb6, 0x76, 0x18, 0x98, 0xf1, 0xd9, 0x35, 0xe4, 0x90, 0xf8, 0x42, 0x2a, 0xc0,
+	0x58, 0x77, 0x43, 0x6d, 0xe1, 0x15, 0x88, 0x57, 0x5b, 0x4b, 0x37, 0x8b, 0x5f, 0x3c, 0xcc, 0xc6,
+	0x96, 0x34, 0xf6, 0x8b, 0x4a, 0x7f, 0x45, 0x70, 0x6e, 0x83, 0xd0, 0xa7, 0x1c, 0xd4, 0x9b, 0x28,
+	0xe1, 0x76, 0xeb, 0x83, 0xc7, 0xb1, 0xde, 0xf1, 0xf3, 0x43, 0x14, 0x8c, 0xa2, 0xb6, 0xf7, 0x98,
+	0xfd, 0x69, 0x10, 0x12, 0x9d, 0x31, 0x11, 0xce, 0xc2, 0x99, 0xd5, 0xb5, 0x7c, 0xee, 0x7a, 0x6e,
+	0x41, 0xce, 0xe7, 0xd6, 0x56, 0x95, 0xfc, 0xed, 0xf5, 0xac, 0x72, 0x73, 0x75, 0x79, 0x75, 0xed,
+	0xd6, 0x6a, 0x22, 0x20, 0xcc, 0xc2, 0x8b, 0x07, 0xc9, 0x0b, 0x6b, 0x2b, 0x2b, 0x72, 0x66, 0x0d,
+	0xcb, 0xf9, 0x35, 0xac, 0x2c, 0xbc, 0x22, 0xaf, 0x2e, 0x65, 0x17, 0x13, 0x48, 0x78, 0x01, 0xce,
+	0x1d, 0xe4, 0x95, 0xd7, 0x73, 0xca, 0x72, 0xf6, 0xb6, 0xb2, 0x80, 0xb3, 0x72, 0x3e, 0xbb, 0x98,
+	0x08, 0x7e, 0x05, 0x9b, 0xab, 0x2d, 0xd4, 0xfd, 0xe4, 0x25, 0x39, 0x9f, 0xbd, 0x25, 0xdf, 0x56,
+	0x16, 0x73, 0x1b, 0x0b, 0x6b, 0xab, 0xab, 0xd9, 0x05, 0xa6, 0x32, 0x2c, 0xcc, 0xc0, 0x74, 0x37,
+	0x95, 0xeb, 0x78, 0xed, 0x55, 0x79, 0x45, 0xc1, 0xd9, 0xef, 0xdf, 0xcc, 0x6e, 0x30, 0xce, 0xc8,
+	0x78, 0xf8, 0xfe, 0xef, 0xc5, 0x40, 0xe6, 0x77, 0xe8, 0xd1, 0xae, 0x88, 0x1e, 0xef, 0x8a, 0xe8,
+	0xb3, 0x5d, 0x31, 0xf0, 0xf9, 0xae, 0x18, 0x78, 0xb2, 0x2b, 0x06, 0xbe, 0xd8, 0x15, 0x03, 0x5f,
+	0xee, 0x8a, 0xe8, 0xbd, 0x86, 0x88, 0xee, 0x37, 0xc4, 0xc0, 0x47, 0x0d, 0x11, 0x7d, 0xdc, 0x10,
+	0x03, 0x9f, 0x34, 0xc4, 0xc0, 0xa7, 0x0d, 0x31, 0xf0, 0xa8, 0x21, 0xa2, 0xc7, 0x0d, 0x11, 0x7d,
+	0xd6, 0x10, 0x03, 0x9f, 0x37, 0x44, 0xf4, 0xa4, 0x21, 0x06, 0xbe, 0x68, 0x88, 0xe8, 0xcb, 0x86,
+	0x18, 0x78, 0x6f, 0x4f, 0x0c, 0xdc, 0xdf, 0x13, 0xd1, 0x83, 0x3d, 0x31, 0xf0, 0xab, 0x3d, 0x11,
+	0xfd, 0x76, 0x4f, 0x0c, 0x7c, 0xb4, 0x27, 0x06, 0x3e, 0xde, 0x13, 0xd1, 0x27, 0x7b, 0x22, 0xfa,
+	0x74, 0x4f, 0x44, 0x3f, 0xb8, 0x50, 0x34, 0xd3, 0xf4, 0x2e, 0xa1, 0x77, 0x75, 0xa3, 0x68, 0xa7,
+	0x0d, 0x42, 0xef, 0x99, 0x56, 0x69, 0xae, 0xfd, 0x8f, 0xce, 0x6a, 0xa9, 0x38, 0x47, 0xa9, 0x51,
+	0xdd, 0xdc, 0xec, 0x73, 0xb0, 0xfb, 0xe2, 0x7f, 0x07, 0x00, 0x4d, 0x65, 0xcb, 0xa3, 0xb5, 0x1e,
+	0x00, 0x00,
+}
+
+func (x NotificationType) String() string {
+	s, ok := NotificationType_name[int32(x)]
+	if ok {
+		return s
+	}
+	return strconv.Itoa(int(x))
+}
+func (this *User) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*UpdateUserAPIKeyRequest)
+	that1, ok := that.(*User)
 	if !ok {
-		that2, ok := that.(UpdateUserAPIKeyRequest)
+		that2, ok := that.(User)
 		if ok {
 			that1 = &that2
 		} else {
@@ -1855,65 +2393,93 @@ func (this *UpdateUserAPIKeyRequest) Equal(that interface{}) bool {
 	if !this.UserIdentifiers.Equal(&that1.UserIdentifiers) {
 		return false
 	}
-	if !this.APIKey.Equal(&that1.APIKey) {
+	if !this.CreatedAt.Equal(that1.CreatedAt) {
 		return false
 	}
-	return true
-}
-func (this *Invitation) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+	if !this.UpdatedAt.Equal(that1.UpdatedAt) {
+		return false
 	}
-
-	that1, ok := that.(*Invitation)
-	if !ok {
-		that2, ok := that.(Invitation)
-		if ok {
-			that1 = &that2
-		} else {
+	if this.Name != that1.Name {
+		return false
+	}
+	if this.Description != that1.Description {
+		return false
+	}
+	if len(this.Attributes) != len(that1.Attributes) {
+		return false
+	}
+	for i := range this.Attributes {
+		if this.Attributes[i] != that1.Attributes[i] {
 			return false
 		}
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
+	if len(this.ContactInfo) != len(that1.ContactInfo) {
 		return false
 	}
-	if this.Email != that1.Email {
+	for i := range this.ContactInfo {
+		if !this.ContactInfo[i].Equal(that1.ContactInfo[i]) {
+			return false
+		}
+	}
+	if this.PrimaryEmailAddress != that1.PrimaryEmailAddress {
 		return false
 	}
-	if this.Token != that1.Token {
+	if that1.PrimaryEmailAddressValidatedAt == nil {
+		if this.PrimaryEmailAddressValidatedAt != nil {
+			return false
+		}
+	} else if !this.PrimaryEmailAddressValidatedAt.Equal(*that1.PrimaryEmailAddressValidatedAt) {
 		return false
 	}
-	if !this.ExpiresAt.Equal(that1.ExpiresAt) {
+	if this.Password != that1.Password {
 		return false
 	}
-	if !this.CreatedAt.Equal(that1.CreatedAt) {
+	if that1.PasswordUpdatedAt == nil {
+		if this.PasswordUpdatedAt != nil {
+			return false
+		}
+	} else if !this.PasswordUpdatedAt.Equal(*that1.PasswordUpdatedAt) {
 		return false
 	}
-	if !this.UpdatedAt.Equal(that1.UpdatedAt) {
+	if this.RequirePasswordUpdate != that1.RequirePasswordUpdate {
 		return false
 	}
-	if that1.AcceptedAt == nil {
-		if this.AcceptedAt != nil {
+	if this.State != that1.State {
+		return false
+	}
+	if this.Admin != that1.Admin {
+		return false
+	}
+	if this.TemporaryPassword != that1.TemporaryPassword {
+		return false
+	}
+	if that1.TemporaryPasswordCreatedAt == nil {
+		if this.TemporaryPasswordCreatedAt != nil {
 			return false
 		}
-	} else if !this.AcceptedAt.Equal(*that1.AcceptedAt) {
+	} else if !this.TemporaryPasswordCreatedAt.Equal(*that1.TemporaryPasswordCreatedAt) {
 		return false
 	}
-	if !this.AcceptedBy.Equal(that1.AcceptedBy) {
+	if that1.TemporaryPasswordExpiresAt == nil {
+		if this.TemporaryPasswordExpiresAt != nil {
+			return false
+		}
+	} else if !this.TemporaryPasswordExpiresAt.Equal(*that1.TemporaryPasswordExpiresAt) {
+		return false
+	}
+	if !this.ProfilePicture.Equal(that1.ProfilePicture) {
 		return false
 	}
 	return true
 }
-func (this *ListInvitationsRequest) Equal(that interface{}) bool {
+func (this *Users) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*ListInvitationsRequest)
+	that1, ok := that.(*Users)
 	if !ok {
-		that2, ok := that.(ListInvitationsRequest)
+		that2, ok := that.(Users)
 		if ok {
 			that1 = &that2
 		} else {
@@ -1925,22 +2491,24 @@ func (this *ListInvitationsRequest) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.Limit != that1.Limit {
+	if len(this.Users) != len(that1.Users) {
 		return false
 	}
-	if this.Page != that1.Page {
-		return false
+	for i := range this.Users {
+		if !this.Users[i].Equal(that1.Users[i]) {
+			return false
+		}
 	}
 	return true
 }
-func (this *Invitations) Equal(that interface{}) bool {
+func (this *GetUserRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*Invitations)
+	that1, ok := that.(*GetUserRequest)
 	if !ok {
-		that2, ok := that.(Invitations)
+		that2, ok := that.(GetUserRequest)
 		if ok {
 			that1 = &that2
 		} else {
@@ -1952,24 +2520,22 @@ func (this *Invitations) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if len(this.Invitations) != len(that1.Invitations) {
+	if !this.UserIdentifiers.Equal(&that1.UserIdentifiers) {
 		return false
 	}
-	for i := range this.Invitations {
-		if !this.Invitations[i].Equal(that1.Invitations[i]) {
-			return false
-		}
+	if !this.FieldMask.Equal(&that1.FieldMask) {
+		return false
 	}
 	return true
 }
-func (this *SendInvitationRequest) Equal(that interface{}) bool {
+func (this *ListUsersRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*SendInvitationRequest)
+	that1, ok := that.(*ListUsersRequest)
 	if !ok {
-		that2, ok := that.(SendInvitationRequest)
+		that2, ok := that.(ListUsersRequest)
 		if ok {
 			that1 = &that2
 		} else {
@@ -1981,19 +2547,31 @@ func (this *SendInvitationRequest) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.Email != that1.Email {
+	if !this.FieldMask.Equal(&that1.FieldMask) {
+		return false
+	}
+	if this.Order != that1.Order {
+		return false
+	}
+	if this.Limit != that1.Limit {
+		return false
+	}
+	if this.Page != that1.Page {
+		return false
+	}
+	if this.Deleted != that1.Deleted {
 		return false
 	}
 	return true
 }
-func (this *DeleteInvitationRequest) Equal(that interface{}) bool {
+func (this *CreateUserRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*DeleteInvitationRequest)
+	that1, ok := that.(*CreateUserRequest)
 	if !ok {
-		that2, ok := that.(DeleteInvitationRequest)
+		that2, ok := that.(CreateUserRequest)
 		if ok {
 			that1 = &that2
 		} else {
@@ -2005,19 +2583,22 @@ func (this *DeleteInvitationRequest) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.Email != that1.Email {
+	if !this.User.Equal(&that1.User) {
+		return false
+	}
+	if this.InvitationToken != that1.InvitationToken {
 		return false
 	}
 	return true
 }
-func (this *UserSessionIdentifiers) Equal(that interface{}) bool {
+func (this *UpdateUserRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*UserSessionIdentifiers)
+	that1, ok := that.(*UpdateUserRequest)
 	if !ok {
-		that2, ok := that.(UserSessionIdentifiers)
+		that2, ok := that.(UpdateUserRequest)
 		if ok {
 			that1 = &that2
 		} else {
@@ -2029,22 +2610,22 @@ func (this *UserSessionIdentifiers) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.UserIdentifiers.Equal(&that1.UserIdentifiers) {
+	if !this.User.Equal(&that1.User) {
 		return false
 	}
-	if this.SessionID != that1.SessionID {
+	if !this.FieldMask.Equal(&that1.FieldMask) {
 		return false
 	}
 	return true
 }
-func (this *UserSession) Equal(that interface{}) bool {
+func (this *CreateTemporaryPasswordRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*UserSession)
+	that1, ok := that.(*CreateTemporaryPasswordRequest)
 	if !ok {
-		that2, ok := that.(UserSession)
+		that2, ok := that.(CreateTemporaryPasswordRequest)
 		if ok {
 			that1 = &that2
 		} else {
@@ -2059,32 +2640,16 @@ func (this *UserSession) Equal(that interface{}) bool {
 	if !this.UserIdentifiers.Equal(&that1.UserIdentifiers) {
 		return false
 	}
-	if this.SessionID != that1.SessionID {
-		return false
-	}
-	if !this.CreatedAt.Equal(that1.CreatedAt) {
-		return false
-	}
-	if !this.UpdatedAt.Equal(that1.UpdatedAt) {
-		return false
-	}
-	if that1.ExpiresAt == nil {
-		if this.ExpiresAt != nil {
-			return false
-		}
-	} else if !this.ExpiresAt.Equal(*that1.ExpiresAt) {
-		return false
-	}
 	return true
 }
-func (this *UserSessions) Equal(that interface{}) bool {
+func (this *UpdateUserPasswordRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*UserSessions)
+	that1, ok := that.(*UpdateUserPasswordRequest)
 	if !ok {
-		that2, ok := that.(UserSessions)
+		that2, ok := that.(UpdateUserPasswordRequest)
 		if ok {
 			that1 = &that2
 		} else {
@@ -2096,24 +2661,28 @@ func (this *UserSessions) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if len(this.Sessions) != len(that1.Sessions) {
+	if !this.UserIdentifiers.Equal(&that1.UserIdentifiers) {
 		return false
 	}
-	for i := range this.Sessions {
-		if !this.Sessions[i].Equal(that1.Sessions[i]) {
-			return false
-		}
+	if this.New != that1.New {
+		return false
+	}
+	if this.Old != that1.Old {
+		return false
+	}
+	if this.RevokeAllAccess != that1.RevokeAllAccess {
+		return false
 	}
 	return true
 }
-func (this *ListUserSessionsRequest) Equal(that interface{}) bool {
+func (this *ListUserAPIKeysRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*ListUserSessionsRequest)
+	that1, ok := that.(*ListUserAPIKeysRequest)
 	if !ok {
-		that2, ok := that.(ListUserSessionsRequest)
+		that2, ok := that.(ListUserAPIKeysRequest)
 		if ok {
 			that1 = &that2
 		} else {
@@ -2128,9 +2697,6 @@ func (this *ListUserSessionsRequest) Equal(that interface{}) bool {
 	if !this.UserIdentifiers.Equal(&that1.UserIdentifiers) {
 		return false
 	}
-	if this.Order != that1.Order {
-		return false
-	}
 	if this.Limit != that1.Limit {
 		return false
 	}
@@ -2139,2189 +2705,6135 @@ func (this *ListUserSessionsRequest) Equal(that interface{}) bool {
 	}
 	return true
 }
-func (m *User) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *GetUserAPIKeyRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
-
-func (m *User) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
 
-func (m *User) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ProfilePicture != nil {
-		{
-			size, err := m.ProfilePicture.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintUser(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x92
-	}
-	if m.TemporaryPasswordExpiresAt != nil {
-		n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.TemporaryPasswordExpiresAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.TemporaryPasswordExpiresAt):])
-		if err2 != nil {
-			return 0, err2
+	that1, ok := that.(*GetUserAPIKeyRequest)
+	if !ok {
+		that2, ok := that.(GetUserAPIKeyRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i -= n2
-		i = encodeVarintUser(dAtA, i, uint64(n2))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x8a
 	}
-	if m.TemporaryPasswordCreatedAt != nil {
-		n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.TemporaryPasswordCreatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.TemporaryPasswordCreatedAt):])
-		if err3 != nil {
-			return 0, err3
-		}
-		i -= n3
-		i = encodeVarintUser(dAtA, i, uint64(n3))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x82
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if len(m.TemporaryPassword) > 0 {
-		i -= len(m.TemporaryPassword)
-		copy(dAtA[i:], m.TemporaryPassword)
-		i = encodeVarintUser(dAtA, i, uint64(len(m.TemporaryPassword)))
-		i--
-		dAtA[i] = 0x7a
+	if !this.UserIdentifiers.Equal(&that1.UserIdentifiers) {
+		return false
 	}
-	if m.Admin {
-		i--
-		if m.Admin {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x70
+	if this.KeyID != that1.KeyID {
+		return false
 	}
-	if m.State != 0 {
-		i = encodeVarintUser(dAtA, i, uint64(m.State))
-		i--
-		dAtA[i] = 0x68
+	return true
+}
+func (this *CreateUserAPIKeyRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	if m.RequirePasswordUpdate {
-		i--
-		if m.RequirePasswordUpdate {
-			dAtA[i] = 1
+
+	that1, ok := that.(*CreateUserAPIKeyRequest)
+	if !ok {
+		that2, ok := that.(CreateUserAPIKeyRequest)
+		if ok {
+			that1 = &that2
 		} else {
-			dAtA[i] = 0
+			return false
 		}
-		i--
-		dAtA[i] = 0x60
 	}
-	if m.PasswordUpdatedAt != nil {
-		n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.PasswordUpdatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.PasswordUpdatedAt):])
-		if err4 != nil {
-			return 0, err4
-		}
-		i -= n4
-		i = encodeVarintUser(dAtA, i, uint64(n4))
-		i--
-		dAtA[i] = 0x5a
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if len(m.Password) > 0 {
-		i -= len(m.Password)
-		copy(dAtA[i:], m.Password)
-		i = encodeVarintUser(dAtA, i, uint64(len(m.Password)))
-		i--
-		dAtA[i] = 0x52
+	if !this.UserIdentifiers.Equal(&that1.UserIdentifiers) {
+		return false
 	}
-	if m.PrimaryEmailAddressValidatedAt != nil {
-		n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.PrimaryEmailAddressValidatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.PrimaryEmailAddressValidatedAt):])
-		if err5 != nil {
-			return 0, err5
-		}
-		i -= n5
-		i = encodeVarintUser(dAtA, i, uint64(n5))
-		i--
-		dAtA[i] = 0x4a
+	if this.Name != that1.Name {
+		return false
 	}
-	if len(m.PrimaryEmailAddress) > 0 {
-		i -= len(m.PrimaryEmailAddress)
-		copy(dAtA[i:], m.PrimaryEmailAddress)
-		i = encodeVarintUser(dAtA, i, uint64(len(m.PrimaryEmailAddress)))
-		i--
-		dAtA[i] = 0x42
+	if len(this.Rights) != len(that1.Rights) {
+		return false
 	}
-	if len(m.ContactInfo) > 0 {
-		for iNdEx := len(m.ContactInfo) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ContactInfo[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintUser(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x3a
+	for i := range this.Rights {
+		if this.Rights[i] != that1.Rights[i] {
+			return false
 		}
 	}
-	if len(m.Attributes) > 0 {
-		for k := range m.Attributes {
-			v := m.Attributes[k]
-			baseI := i
-			i -= len(v)
-			copy(dAtA[i:], v)
-			i = encodeVarintUser(dAtA, i, uint64(len(v)))
-			i--
-			dAtA[i] = 0x12
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintUser(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintUser(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x32
+	if that1.ExpiresAt == nil {
+		if this.ExpiresAt != nil {
+			return false
 		}
+	} else if !this.ExpiresAt.Equal(*that1.ExpiresAt) {
+		return false
 	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintUser(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x2a
+	if !this.Restrictions.Equal(that1.Restrictions) {
+		return false
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintUser(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0x22
+	return true
+}
+func (this *UpdateUserAPIKeyRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	n6, err6 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.UpdatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.UpdatedAt):])
-	if err6 != nil {
-		return 0, err6
+
+	that1, ok := that.(*UpdateUserAPIKeyRequest)
+	if !ok {
+		that2, ok := that.(UpdateUserAPIKeyRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	i -= n6
-	i = encodeVarintUser(dAtA, i, uint64(n6))
-	i--
-	dAtA[i] = 0x1a
-	n7, err7 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CreatedAt):])
-	if err7 != nil {
-		return 0, err7
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	i -= n7
-	i = encodeVarintUser(dAtA, i, uint64(n7))
-	i--
-	dAtA[i] = 0x12
-	{
-		size, err := m.UserIdentifiers.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintUser(dAtA, i, uint64(size))
+	if !this.UserIdentifiers.Equal(&that1.UserIdentifiers) {
+		return false
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func (m *Users) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if !this.APIKey.Equal(&that1.APIKey) {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *Users) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *Users) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Users) > 0 {
-		for iNdEx := len(m.Users) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Users[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintUser(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	if !this.FieldMask.Equal(&that1.FieldMask) {
+		return false
 	}
-	return len(dAtA) - i, nil
+	return true
 }
-
-func (m *GetUserRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *Invitation) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
-
-func (m *GetUserRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
 
-func (m *GetUserRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.FieldMask.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	that1, ok := that.(*Invitation)
+	if !ok {
+		that2, ok := that.(Invitation)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i -= size
-		i = encodeVarintUser(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size, err := m.UserIdentifiers.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintUser(dAtA, i, uint64(size))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func (m *ListUsersRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.Email != that1.Email {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ListUsersRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *ListUsersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Page != 0 {
-		i = encodeVarintUser(dAtA, i, uint64(m.Page))
-		i--
-		dAtA[i] = 0x20
+	if this.Token != that1.Token {
+		return false
 	}
-	if m.Limit != 0 {
-		i = encodeVarintUser(dAtA, i, uint64(m.Limit))
-		i--
-		dAtA[i] = 0x18
+	if !this.ExpiresAt.Equal(that1.ExpiresAt) {
+		return false
 	}
-	if len(m.Order) > 0 {
-		i -= len(m.Order)
-		copy(dAtA[i:], m.Order)
-		i = encodeVarintUser(dAtA, i, uint64(len(m.Order)))
-		i--
-		dAtA[i] = 0x12
+	if !this.CreatedAt.Equal(that1.CreatedAt) {
+		return false
 	}
-	{
-		size, err := m.FieldMask.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if !this.UpdatedAt.Equal(that1.UpdatedAt) {
+		return false
+	}
+	if that1.AcceptedAt == nil {
+		if this.AcceptedAt != nil {
+			return false
 		}
-		i -= size
-		i = encodeVarintUser(dAtA, i, uint64(size))
+	} else if !this.AcceptedAt.Equal(*that1.AcceptedAt) {
+		return false
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func (m *CreateUserRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if !this.AcceptedBy.Equal(that1.AcceptedBy) {
+		return false
 	}
-	return dAtA[:n], nil
+	return true
 }
+func (this *ListInvitationsRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *CreateUserRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	that1, ok := that.(*ListInvitationsRequest)
+	if !ok {
+		that2, ok := that.(ListInvitationsRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Limit != that1.Limit {
+		return false
+	}
+	if this.Page != that1.Page {
+		return false
+	}
+	return true
 }
+func (this *Invitations) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *CreateUserRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.InvitationToken) > 0 {
-		i -= len(m.InvitationToken)
-		copy(dAtA[i:], m.InvitationToken)
-		i = encodeVarintUser(dAtA, i, uint64(len(m.InvitationToken)))
-		i--
-		dAtA[i] = 0x12
+	that1, ok := that.(*Invitations)
+	if !ok {
+		that2, ok := that.(Invitations)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	{
-		size, err := m.User.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Invitations) != len(that1.Invitations) {
+		return false
+	}
+	for i := range this.Invitations {
+		if !this.Invitations[i].Equal(that1.Invitations[i]) {
+			return false
 		}
-		i -= size
-		i = encodeVarintUser(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return true
 }
+func (this *SendInvitationRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *UpdateUserRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	that1, ok := that.(*SendInvitationRequest)
+	if !ok {
+		that2, ok := that.(SendInvitationRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return dAtA[:n], nil
-}
-
-func (m *UpdateUserRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Email != that1.Email {
+		return false
+	}
+	return true
 }
+func (this *DeleteInvitationRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *UpdateUserRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.FieldMask.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	that1, ok := that.(*DeleteInvitationRequest)
+	if !ok {
+		that2, ok := that.(DeleteInvitationRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i -= size
-		i = encodeVarintUser(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size, err := m.User.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintUser(dAtA, i, uint64(size))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func (m *CreateTemporaryPasswordRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.Email != that1.Email {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *CreateTemporaryPasswordRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return true
 }
+func (this *UserSessionIdentifiers) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *CreateTemporaryPasswordRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.UserIdentifiers.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	that1, ok := that.(*UserSessionIdentifiers)
+	if !ok {
+		that2, ok := that.(UserSessionIdentifiers)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i -= size
-		i = encodeVarintUser(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func (m *UpdateUserPasswordRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *UpdateUserPasswordRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if !this.UserIdentifiers.Equal(&that1.UserIdentifiers) {
+		return false
+	}
+	if this.SessionID != that1.SessionID {
+		return false
+	}
+	return true
 }
+func (this *UserSession) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *UpdateUserPasswordRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.RevokeAllAccess {
-		i--
-		if m.RevokeAllAccess {
-			dAtA[i] = 1
+	that1, ok := that.(*UserSession)
+	if !ok {
+		that2, ok := that.(UserSession)
+		if ok {
+			that1 = &that2
 		} else {
-			dAtA[i] = 0
+			return false
 		}
-		i--
-		dAtA[i] = 0x20
 	}
-	if len(m.Old) > 0 {
-		i -= len(m.Old)
-		copy(dAtA[i:], m.Old)
-		i = encodeVarintUser(dAtA, i, uint64(len(m.Old)))
-		i--
-		dAtA[i] = 0x1a
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if len(m.New) > 0 {
-		i -= len(m.New)
-		copy(dAtA[i:], m.New)
-		i = encodeVarintUser(dAtA, i, uint64(len(m.New)))
-		i--
-		dAtA[i] = 0x12
+	if !this.UserIdentifiers.Equal(&that1.UserIdentifiers) {
+		return false
 	}
-	{
-		size, err := m.UserIdentifiers.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if this.SessionID != that1.SessionID {
+		return false
+	}
+	if !this.CreatedAt.Equal(that1.CreatedAt) {
+		return false
+	}
+	if !this.UpdatedAt.Equal(that1.UpdatedAt) {
+		return false
+	}
+	if that1.ExpiresAt == nil {
+		if this.ExpiresAt != nil {
+			return false
 		}
-		i -= size
-		i = encodeVarintUser(dAtA, i, uint64(size))
+	} else if !this.ExpiresAt.Equal(*that1.ExpiresAt) {
+		return false
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return true
 }
-
-func (m *ListUserAPIKeysRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *UserSessions) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
 
-func (m *ListUserAPIKeysRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *ListUserAPIKeysRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Page != 0 {
-		i = encodeVarintUser(dAtA, i, uint64(m.Page))
-		i--
-		dAtA[i] = 0x18
+	that1, ok := that.(*UserSessions)
+	if !ok {
+		that2, ok := that.(UserSessions)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if m.Limit != 0 {
-		i = encodeVarintUser(dAtA, i, uint64(m.Limit))
-		i--
-		dAtA[i] = 0x10
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	{
-		size, err := m.UserIdentifiers.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if len(this.Sessions) != len(that1.Sessions) {
+		return false
+	}
+	for i := range this.Sessions {
+		if !this.Sessions[i].Equal(that1.Sessions[i]) {
+			return false
 		}
-		i -= size
-		i = encodeVarintUser(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return true
 }
-
-func (m *GetUserAPIKeyRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *ListUserSessionsRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
-
-func (m *GetUserAPIKeyRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
 
-func (m *GetUserAPIKeyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.KeyID) > 0 {
-		i -= len(m.KeyID)
-		copy(dAtA[i:], m.KeyID)
-		i = encodeVarintUser(dAtA, i, uint64(len(m.KeyID)))
-		i--
-		dAtA[i] = 0x12
-	}
-	{
-		size, err := m.UserIdentifiers.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	that1, ok := that.(*ListUserSessionsRequest)
+	if !ok {
+		that2, ok := that.(ListUserSessionsRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i -= size
-		i = encodeVarintUser(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func (m *CreateUserAPIKeyRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *CreateUserAPIKeyRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *CreateUserAPIKeyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Rights) > 0 {
-		dAtA20 := make([]byte, len(m.Rights)*10)
-		var j19 int
-		for _, num := range m.Rights {
-			for num >= 1<<7 {
-				dAtA20[j19] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j19++
-			}
-			dAtA20[j19] = uint8(num)
-			j19++
-		}
-		i -= j19
-		copy(dAtA[i:], dAtA20[:j19])
-		i = encodeVarintUser(dAtA, i, uint64(j19))
-		i--
-		dAtA[i] = 0x1a
+	if !this.UserIdentifiers.Equal(&that1.UserIdentifiers) {
+		return false
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintUser(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0x12
+	if this.Order != that1.Order {
+		return false
 	}
-	{
-		size, err := m.UserIdentifiers.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintUser(dAtA, i, uint64(size))
+	if this.Limit != that1.Limit {
+		return false
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func (m *UpdateUserAPIKeyRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.Page != that1.Page {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *UpdateUserAPIKeyRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return true
 }
+func (this *UserTOTPStatus) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *UpdateUserAPIKeyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.APIKey.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	that1, ok := that.(*UserTOTPStatus)
+	if !ok {
+		that2, ok := that.(UserTOTPStatus)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i -= size
-		i = encodeVarintUser(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size, err := m.UserIdentifiers.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Enabled != that1.Enabled {
+		return false
+	}
+	if that1.EnabledAt == nil {
+		if this.EnabledAt != nil {
+			return false
 		}
-		i -= size
-		i = encodeVarintUser(dAtA, i, uint64(size))
+	} else if !this.EnabledAt.Equal(*that1.EnabledAt) {
+		return false
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func (m *Invitation) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.RecoveryCodesRemaining != that1.RecoveryCodesRemaining {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *Invitation) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return true
 }
+func (this *UserTOTPEnrollment) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *Invitation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.AcceptedBy != nil {
-		{
-			size, err := m.AcceptedBy.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintUser(dAtA, i, uint64(size))
+	that1, ok := that.(*UserTOTPEnrollment)
+	if !ok {
+		that2, ok := that.(UserTOTPEnrollment)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i--
-		dAtA[i] = 0x3a
 	}
-	if m.AcceptedAt != nil {
-		n25, err25 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.AcceptedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.AcceptedAt):])
-		if err25 != nil {
-			return 0, err25
-		}
-		i -= n25
-		i = encodeVarintUser(dAtA, i, uint64(n25))
-		i--
-		dAtA[i] = 0x32
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	n26, err26 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.UpdatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.UpdatedAt):])
-	if err26 != nil {
-		return 0, err26
+	if this.Secret != that1.Secret {
+		return false
 	}
-	i -= n26
-	i = encodeVarintUser(dAtA, i, uint64(n26))
-	i--
-	dAtA[i] = 0x2a
-	n27, err27 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CreatedAt):])
-	if err27 != nil {
-		return 0, err27
+	if this.URI != that1.URI {
+		return false
 	}
-	i -= n27
-	i = encodeVarintUser(dAtA, i, uint64(n27))
-	i--
-	dAtA[i] = 0x22
-	n28, err28 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.ExpiresAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.ExpiresAt):])
-	if err28 != nil {
-		return 0, err28
+	return true
+}
+func (this *EnableUserTOTPRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	i -= n28
-	i = encodeVarintUser(dAtA, i, uint64(n28))
-	i--
-	dAtA[i] = 0x1a
-	if len(m.Token) > 0 {
-		i -= len(m.Token)
-		copy(dAtA[i:], m.Token)
-		i = encodeVarintUser(dAtA, i, uint64(len(m.Token)))
-		i--
-		dAtA[i] = 0x12
+
+	that1, ok := that.(*EnableUserTOTPRequest)
+	if !ok {
+		that2, ok := that.(EnableUserTOTPRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if len(m.Email) > 0 {
-		i -= len(m.Email)
-		copy(dAtA[i:], m.Email)
-		i = encodeVarintUser(dAtA, i, uint64(len(m.Email)))
-		i--
-		dAtA[i] = 0xa
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *ListInvitationsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if !this.UserIdentifiers.Equal(&that1.UserIdentifiers) {
+		return false
 	}
-	return dAtA[:n], nil
+	if this.Code != that1.Code {
+		return false
+	}
+	return true
 }
+func (this *DisableUserTOTPRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *ListInvitationsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *ListInvitationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Page != 0 {
-		i = encodeVarintUser(dAtA, i, uint64(m.Page))
-		i--
-		dAtA[i] = 0x10
+	that1, ok := that.(*DisableUserTOTPRequest)
+	if !ok {
+		that2, ok := that.(DisableUserTOTPRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if m.Limit != 0 {
-		i = encodeVarintUser(dAtA, i, uint64(m.Limit))
-		i--
-		dAtA[i] = 0x8
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *Invitations) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if !this.UserIdentifiers.Equal(&that1.UserIdentifiers) {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *Invitations) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if this.Code != that1.Code {
+		return false
+	}
+	return true
 }
+func (this *GenerateUserRecoveryCodesRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *Invitations) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Invitations) > 0 {
-		for iNdEx := len(m.Invitations) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Invitations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintUser(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+	that1, ok := that.(*GenerateUserRecoveryCodesRequest)
+	if !ok {
+		that2, ok := that.(GenerateUserRecoveryCodesRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *SendInvitationRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *SendInvitationRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *SendInvitationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Email) > 0 {
-		i -= len(m.Email)
-		copy(dAtA[i:], m.Email)
-		i = encodeVarintUser(dAtA, i, uint64(len(m.Email)))
-		i--
-		dAtA[i] = 0xa
+	if !this.UserIdentifiers.Equal(&that1.UserIdentifiers) {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *DeleteInvitationRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.Code != that1.Code {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *DeleteInvitationRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return true
 }
-
-func (m *DeleteInvitationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Email) > 0 {
-		i -= len(m.Email)
-		copy(dAtA[i:], m.Email)
-		i = encodeVarintUser(dAtA, i, uint64(len(m.Email)))
-		i--
-		dAtA[i] = 0xa
+func (this *UserRecoveryCodes) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return len(dAtA) - i, nil
-}
 
-func (m *UserSessionIdentifiers) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	that1, ok := that.(*UserRecoveryCodes)
+	if !ok {
+		that2, ok := that.(UserRecoveryCodes)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return dAtA[:n], nil
-}
-
-func (m *UserSessionIdentifiers) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *UserSessionIdentifiers) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.SessionID) > 0 {
-		i -= len(m.SessionID)
-		copy(dAtA[i:], m.SessionID)
-		i = encodeVarintUser(dAtA, i, uint64(len(m.SessionID)))
-		i--
-		dAtA[i] = 0x12
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	{
-		size, err := m.UserIdentifiers.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if len(this.Codes) != len(that1.Codes) {
+		return false
+	}
+	for i := range this.Codes {
+		if this.Codes[i] != that1.Codes[i] {
+			return false
 		}
-		i -= size
-		i = encodeVarintUser(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return true
 }
-
-func (m *UserSession) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *Notification) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
-
-func (m *UserSession) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
 
-func (m *UserSession) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ExpiresAt != nil {
-		n30, err30 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ExpiresAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpiresAt):])
-		if err30 != nil {
-			return 0, err30
+	that1, ok := that.(*Notification)
+	if !ok {
+		that2, ok := that.(Notification)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i -= n30
-		i = encodeVarintUser(dAtA, i, uint64(n30))
-		i--
-		dAtA[i] = 0x2a
 	}
-	n31, err31 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.UpdatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.UpdatedAt):])
-	if err31 != nil {
-		return 0, err31
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	i -= n31
-	i = encodeVarintUser(dAtA, i, uint64(n31))
-	i--
-	dAtA[i] = 0x22
-	n32, err32 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CreatedAt):])
-	if err32 != nil {
-		return 0, err32
+	if this.ID != that1.ID {
+		return false
 	}
-	i -= n32
-	i = encodeVarintUser(dAtA, i, uint64(n32))
-	i--
-	dAtA[i] = 0x1a
-	if len(m.SessionID) > 0 {
-		i -= len(m.SessionID)
-		copy(dAtA[i:], m.SessionID)
-		i = encodeVarintUser(dAtA, i, uint64(len(m.SessionID)))
-		i--
-		dAtA[i] = 0x12
+	if !this.CreatedAt.Equal(that1.CreatedAt) {
+		return false
 	}
-	{
-		size, err := m.UserIdentifiers.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintUser(dAtA, i, uint64(size))
+	if this.NotificationType != that1.NotificationType {
+		return false
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func (m *UserSessions) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if !this.EntityIDs.Equal(&that1.EntityIDs) {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *UserSessions) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *UserSessions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Sessions) > 0 {
-		for iNdEx := len(m.Sessions) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Sessions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintUser(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+	if !this.SenderIDs.Equal(that1.SenderIDs) {
+		return false
+	}
+	if this.Subject != that1.Subject {
+		return false
+	}
+	if len(this.Rights) != len(that1.Rights) {
+		return false
+	}
+	for i := range this.Rights {
+		if this.Rights[i] != that1.Rights[i] {
+			return false
 		}
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *ListUserSessionsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if that1.ReadAt == nil {
+		if this.ReadAt != nil {
+			return false
+		}
+	} else if !this.ReadAt.Equal(*that1.ReadAt) {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ListUserSessionsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return true
 }
+func (this *Notifications) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *ListUserSessionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Page != 0 {
-		i = encodeVarintUser(dAtA, i, uint64(m.Page))
-		i--
-		dAtA[i] = 0x20
+	that1, ok := that.(*Notifications)
+	if !ok {
+		that2, ok := that.(Notifications)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if m.Limit != 0 {
-		i = encodeVarintUser(dAtA, i, uint64(m.Limit))
-		i--
-		dAtA[i] = 0x18
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if len(m.Order) > 0 {
-		i -= len(m.Order)
-		copy(dAtA[i:], m.Order)
-		i = encodeVarintUser(dAtA, i, uint64(len(m.Order)))
-		i--
-		dAtA[i] = 0x12
+	if len(this.Notifications) != len(that1.Notifications) {
+		return false
 	}
-	{
-		size, err := m.UserIdentifiers.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	for i := range this.Notifications {
+		if !this.Notifications[i].Equal(that1.Notifications[i]) {
+			return false
 		}
-		i -= size
-		i = encodeVarintUser(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return true
 }
+func (this *CreateNotificationRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func encodeVarintUser(dAtA []byte, offset int, v uint64) int {
-	offset -= sovUser(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	that1, ok := that.(*CreateNotificationRequest)
+	if !ok {
+		that2, ok := that.(CreateNotificationRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Notification.Equal(&that1.Notification) {
+		return false
+	}
+	return true
 }
-func NewPopulatedUser(r randyUser, easy bool) *User {
-	this := &User{}
-	v1 := NewPopulatedUserIdentifiers(r, easy)
-	this.UserIdentifiers = *v1
-	v2 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
-	this.CreatedAt = *v2
-	v3 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
-	this.UpdatedAt = *v3
-	this.Name = randStringUser(r)
-	this.Description = randStringUser(r)
-	if r.Intn(5) != 0 {
-		v4 := r.Intn(10)
-		this.Attributes = make(map[string]string)
-		for i := 0; i < v4; i++ {
-			this.Attributes[randStringUser(r)] = randStringUser(r)
-		}
+func (this *ListNotificationsRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	if r.Intn(5) != 0 {
-		v5 := r.Intn(5)
-		this.ContactInfo = make([]*ContactInfo, v5)
-		for i := 0; i < v5; i++ {
-			this.ContactInfo[i] = NewPopulatedContactInfo(r, easy)
+
+	that1, ok := that.(*ListNotificationsRequest)
+	if !ok {
+		that2, ok := that.(ListNotificationsRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	this.PrimaryEmailAddress = randStringUser(r)
-	if r.Intn(5) != 0 {
-		this.PrimaryEmailAddressValidatedAt = github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
-	}
-	this.Password = randStringUser(r)
-	if r.Intn(5) != 0 {
-		this.PasswordUpdatedAt = github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	this.RequirePasswordUpdate = bool(r.Intn(2) == 0)
-	this.State = State([]int32{0, 1, 2, 3, 4}[r.Intn(5)])
-	this.Admin = bool(r.Intn(2) == 0)
-	this.TemporaryPassword = randStringUser(r)
-	if r.Intn(5) != 0 {
-		this.TemporaryPasswordCreatedAt = github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	if !this.UserIdentifiers.Equal(&that1.UserIdentifiers) {
+		return false
 	}
-	if r.Intn(5) != 0 {
-		this.TemporaryPasswordExpiresAt = github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	if this.UnreadOnly != that1.UnreadOnly {
+		return false
 	}
-	if r.Intn(5) != 0 {
-		this.ProfilePicture = NewPopulatedPicture(r, easy)
+	if this.Limit != that1.Limit {
+		return false
 	}
-	if !easy && r.Intn(10) != 0 {
+	if this.Page != that1.Page {
+		return false
 	}
-	return this
+	return true
 }
+func (this *MarkNotificationsReadRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func NewPopulatedUsers(r randyUser, easy bool) *Users {
-	this := &Users{}
-	if r.Intn(5) != 0 {
-		v6 := r.Intn(5)
-		this.Users = make([]*User, v6)
-		for i := 0; i < v6; i++ {
-			this.Users[i] = NewPopulatedUser(r, easy)
+	that1, ok := that.(*MarkNotificationsReadRequest)
+	if !ok {
+		that2, ok := that.(MarkNotificationsReadRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	if !easy && r.Intn(10) != 0 {
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return this
-}
-
-func NewPopulatedGetUserRequest(r randyUser, easy bool) *GetUserRequest {
-	this := &GetUserRequest{}
-	v7 := NewPopulatedUserIdentifiers(r, easy)
-	this.UserIdentifiers = *v7
-	v8 := types.NewPopulatedFieldMask(r, easy)
-	this.FieldMask = *v8
-	if !easy && r.Intn(10) != 0 {
+	if !this.UserIdentifiers.Equal(&that1.UserIdentifiers) {
+		return false
 	}
-	return this
-}
-
-func NewPopulatedListUsersRequest(r randyUser, easy bool) *ListUsersRequest {
-	this := &ListUsersRequest{}
-	v9 := types.NewPopulatedFieldMask(r, easy)
-	this.FieldMask = *v9
-	this.Order = randStringUser(r)
-	this.Limit = r.Uint32()
-	this.Page = r.Uint32()
-	if !easy && r.Intn(10) != 0 {
+	if len(this.IDs) != len(that1.IDs) {
+		return false
 	}
-	return this
-}
-
-func NewPopulatedCreateUserRequest(r randyUser, easy bool) *CreateUserRequest {
-	this := &CreateUserRequest{}
-	v10 := NewPopulatedUser(r, easy)
-	this.User = *v10
-	this.InvitationToken = randStringUser(r)
-	if !easy && r.Intn(10) != 0 {
+	for i := range this.IDs {
+		if this.IDs[i] != that1.IDs[i] {
+			return false
+		}
 	}
-	return this
+	return true
 }
-
-func NewPopulatedUpdateUserRequest(r randyUser, easy bool) *UpdateUserRequest {
-	this := &UpdateUserRequest{}
-	v11 := NewPopulatedUser(r, easy)
-	this.User = *v11
-	v12 := types.NewPopulatedFieldMask(r, easy)
-	this.FieldMask = *v12
-	if !easy && r.Intn(10) != 0 {
+func (this *NotificationPreference) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return this
-}
 
-func NewPopulatedCreateTemporaryPasswordRequest(r randyUser, easy bool) *CreateTemporaryPasswordRequest {
-	this := &CreateTemporaryPasswordRequest{}
-	v13 := NewPopulatedUserIdentifiers(r, easy)
-	this.UserIdentifiers = *v13
-	if !easy && r.Intn(10) != 0 {
-	}
-	return this
-}
-
-func NewPopulatedUpdateUserPasswordRequest(r randyUser, easy bool) *UpdateUserPasswordRequest {
-	this := &UpdateUserPasswordRequest{}
-	v14 := NewPopulatedUserIdentifiers(r, easy)
-	this.UserIdentifiers = *v14
-	this.New = randStringUser(r)
-	this.Old = randStringUser(r)
-	this.RevokeAllAccess = bool(r.Intn(2) == 0)
-	if !easy && r.Intn(10) != 0 {
+	that1, ok := that.(*NotificationPreference)
+	if !ok {
+		that2, ok := that.(NotificationPreference)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return this
-}
-
-func NewPopulatedListUserAPIKeysRequest(r randyUser, easy bool) *ListUserAPIKeysRequest {
-	this := &ListUserAPIKeysRequest{}
-	v15 := NewPopulatedUserIdentifiers(r, easy)
-	this.UserIdentifiers = *v15
-	this.Limit = r.Uint32()
-	this.Page = r.Uint32()
-	if !easy && r.Intn(10) != 0 {
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return this
-}
-
-func NewPopulatedGetUserAPIKeyRequest(r randyUser, easy bool) *GetUserAPIKeyRequest {
-	this := &GetUserAPIKeyRequest{}
-	v16 := NewPopulatedUserIdentifiers(r, easy)
-	this.UserIdentifiers = *v16
-	this.KeyID = randStringUser(r)
-	if !easy && r.Intn(10) != 0 {
+	if this.NotificationType != that1.NotificationType {
+		return false
 	}
-	return this
-}
-
-func NewPopulatedCreateUserAPIKeyRequest(r randyUser, easy bool) *CreateUserAPIKeyRequest {
-	this := &CreateUserAPIKeyRequest{}
-	v17 := NewPopulatedUserIdentifiers(r, easy)
-	this.UserIdentifiers = *v17
-	this.Name = randStringUser(r)
-	v18 := r.Intn(10)
-	this.Rights = make([]Right, v18)
-	for i := 0; i < v18; i++ {
-		this.Rights[i] = Right([]int32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 56, 19, 20, 21, 22, 23, 24, 25, 26, 27, 28, 29, 30, 31, 32, 33, 34, 35, 36, 37, 38, 39, 40, 41, 42, 43, 44, 45, 46, 47, 48, 49, 50, 51, 52, 53, 54, 55}[r.Intn(57)])
+	if this.Subscribed != that1.Subscribed {
+		return false
 	}
-	if !easy && r.Intn(10) != 0 {
+	if this.Email != that1.Email {
+		return false
 	}
-	return this
+	return true
 }
-
-func NewPopulatedUpdateUserAPIKeyRequest(r randyUser, easy bool) *UpdateUserAPIKeyRequest {
-	this := &UpdateUserAPIKeyRequest{}
-	v19 := NewPopulatedUserIdentifiers(r, easy)
-	this.UserIdentifiers = *v19
-	v20 := NewPopulatedAPIKey(r, easy)
-	this.APIKey = *v20
-	if !easy && r.Intn(10) != 0 {
+func (this *NotificationPreferences) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return this
-}
 
-func NewPopulatedInvitation(r randyUser, easy bool) *Invitation {
-	this := &Invitation{}
-	this.Email = randStringUser(r)
-	this.Token = randStringUser(r)
-	v21 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
-	this.ExpiresAt = *v21
-	v22 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
-	this.CreatedAt = *v22
-	v23 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
-	this.UpdatedAt = *v23
-	if r.Intn(5) != 0 {
-		this.AcceptedAt = github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
-	}
-	if r.Intn(5) != 0 {
-		this.AcceptedBy = NewPopulatedUserIdentifiers(r, easy)
+	that1, ok := that.(*NotificationPreferences)
+	if !ok {
+		that2, ok := that.(NotificationPreferences)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if !easy && r.Intn(10) != 0 {
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return this
-}
-
-func NewPopulatedListInvitationsRequest(r randyUser, easy bool) *ListInvitationsRequest {
-	this := &ListInvitationsRequest{}
-	this.Limit = r.Uint32()
-	this.Page = r.Uint32()
-	if !easy && r.Intn(10) != 0 {
+	if len(this.Preferences) != len(that1.Preferences) {
+		return false
 	}
-	return this
-}
-
-func NewPopulatedInvitations(r randyUser, easy bool) *Invitations {
-	this := &Invitations{}
-	if r.Intn(5) != 0 {
-		v24 := r.Intn(5)
-		this.Invitations = make([]*Invitation, v24)
-		for i := 0; i < v24; i++ {
-			this.Invitations[i] = NewPopulatedInvitation(r, easy)
+	for i := range this.Preferences {
+		if !this.Preferences[i].Equal(that1.Preferences[i]) {
+			return false
 		}
 	}
-	if !easy && r.Intn(10) != 0 {
-	}
-	return this
+	return true
 }
-
-func NewPopulatedSendInvitationRequest(r randyUser, easy bool) *SendInvitationRequest {
-	this := &SendInvitationRequest{}
-	this.Email = randStringUser(r)
-	if !easy && r.Intn(10) != 0 {
+func (this *SetNotificationPreferencesRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return this
-}
 
-func NewPopulatedDeleteInvitationRequest(r randyUser, easy bool) *DeleteInvitationRequest {
-	this := &DeleteInvitationRequest{}
-	this.Email = randStringUser(r)
-	if !easy && r.Intn(10) != 0 {
+	that1, ok := that.(*SetNotificationPreferencesRequest)
+	if !ok {
+		that2, ok := that.(SetNotificationPreferencesRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return this
-}
-
-func NewPopulatedUserSessionIdentifiers(r randyUser, easy bool) *UserSessionIdentifiers {
-	this := &UserSessionIdentifiers{}
-	v25 := NewPopulatedUserIdentifiers(r, easy)
-	this.UserIdentifiers = *v25
-	this.SessionID = randStringUser(r)
-	if !easy && r.Intn(10) != 0 {
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return this
-}
-
-func NewPopulatedUserSession(r randyUser, easy bool) *UserSession {
-	this := &UserSession{}
-	v26 := NewPopulatedUserIdentifiers(r, easy)
-	this.UserIdentifiers = *v26
-	this.SessionID = randStringUser(r)
-	v27 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
-	this.CreatedAt = *v27
-	v28 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
-	this.UpdatedAt = *v28
-	if r.Intn(5) != 0 {
-		this.ExpiresAt = github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	if !this.UserIdentifiers.Equal(&that1.UserIdentifiers) {
+		return false
 	}
-	if !easy && r.Intn(10) != 0 {
+	if len(this.Preferences) != len(that1.Preferences) {
+		return false
 	}
-	return this
-}
-
-func NewPopulatedUserSessions(r randyUser, easy bool) *UserSessions {
-	this := &UserSessions{}
-	if r.Intn(5) != 0 {
-		v29 := r.Intn(5)
-		this.Sessions = make([]*UserSession, v29)
-		for i := 0; i < v29; i++ {
-			this.Sessions[i] = NewPopulatedUserSession(r, easy)
+	for i := range this.Preferences {
+		if !this.Preferences[i].Equal(that1.Preferences[i]) {
+			return false
 		}
 	}
-	if !easy && r.Intn(10) != 0 {
-	}
-	return this
+	return true
 }
-
-func NewPopulatedListUserSessionsRequest(r randyUser, easy bool) *ListUserSessionsRequest {
-	this := &ListUserSessionsRequest{}
-	v30 := NewPopulatedUserIdentifiers(r, easy)
-	this.UserIdentifiers = *v30
-	this.Order = randStringUser(r)
-	this.Limit = r.Uint32()
-	this.Page = r.Uint32()
-	if !easy && r.Intn(10) != 0 {
+func (m *User) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return this
+	return dAtA[:n], nil
 }
 
-type randyUser interface {
-	Float32() float32
-	Float64() float64
-	Int63() int64
-	Int31() int32
-	Uint32() uint32
-	Intn(n int) int
+func (m *User) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func randUTF8RuneUser(r randyUser) rune {
-	ru := r.Intn(62)
-	if ru < 10 {
-		return rune(ru + 48)
-	} else if ru < 36 {
-		return rune(ru + 55)
-	}
-	return rune(ru + 61)
-}
-func randStringUser(r randyUser) string {
-	v31 := r.Intn(100)
-	tmps := make([]rune, v31)
-	for i := 0; i < v31; i++ {
-		tmps[i] = randUTF8RuneUser(r)
-	}
-	return string(tmps)
-}
-func randUnrecognizedUser(r randyUser, maxFieldNumber int) (dAtA []byte) {
-	l := r.Intn(5)
-	for i := 0; i < l; i++ {
-		wire := r.Intn(4)
-		if wire == 3 {
-			wire = 5
+func (m *User) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ProfilePicture != nil {
+		{
+			size, err := m.ProfilePicture.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintUser(dAtA, i, uint64(size))
 		}
-		fieldNumber := maxFieldNumber + r.Intn(100)
-		dAtA = randFieldUser(dAtA, r, fieldNumber, wire)
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
 	}
-	return dAtA
-}
-func randFieldUser(dAtA []byte, r randyUser, fieldNumber int, wire int) []byte {
-	key := uint32(fieldNumber)<<3 | uint32(wire)
-	switch wire {
-	case 0:
-		dAtA = encodeVarintPopulateUser(dAtA, uint64(key))
-		v32 := r.Int63()
-		if r.Intn(2) == 0 {
-			v32 *= -1
+	if m.TemporaryPasswordExpiresAt != nil {
+		n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.TemporaryPasswordExpiresAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.TemporaryPasswordExpiresAt):])
+		if err2 != nil {
+			return 0, err2
 		}
-		dAtA = encodeVarintPopulateUser(dAtA, uint64(v32))
-	case 1:
-		dAtA = encodeVarintPopulateUser(dAtA, uint64(key))
-		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
-	case 2:
-		dAtA = encodeVarintPopulateUser(dAtA, uint64(key))
-		ll := r.Intn(100)
-		dAtA = encodeVarintPopulateUser(dAtA, uint64(ll))
-		for j := 0; j < ll; j++ {
-			dAtA = append(dAtA, byte(r.Intn(256)))
-		}
-	default:
-		dAtA = encodeVarintPopulateUser(dAtA, uint64(key))
-		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
+		i -= n2
+		i = encodeVarintUser(dAtA, i, uint64(n2))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
 	}
-	return dAtA
-}
-func encodeVarintPopulateUser(dAtA []byte, v uint64) []byte {
-	for v >= 1<<7 {
-		dAtA = append(dAtA, uint8(v&0x7f|0x80))
-		v >>= 7
+	if m.TemporaryPasswordCreatedAt != nil {
+		n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.TemporaryPasswordCreatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.TemporaryPasswordCreatedAt):])
+		if err3 != nil {
+			return 0, err3
+		}
+		i -= n3
+		i = encodeVarintUser(dAtA, i, uint64(n3))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
 	}
-	dAtA = append(dAtA, uint8(v))
-	return dAtA
-}
-func (m *User) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.TemporaryPassword) > 0 {
+		i -= len(m.TemporaryPassword)
+		copy(dAtA[i:], m.TemporaryPassword)
+		i = encodeVarintUser(dAtA, i, uint64(len(m.TemporaryPassword)))
+		i--
+		dAtA[i] = 0x7a
 	}
-	var l int
-	_ = l
-	l = m.UserIdentifiers.Size()
-	n += 1 + l + sovUser(uint64(l))
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.CreatedAt)
-	n += 1 + l + sovUser(uint64(l))
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.UpdatedAt)
-	n += 1 + l + sovUser(uint64(l))
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovUser(uint64(l))
+	if m.Admin {
+		i--
+		if m.Admin {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x70
 	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovUser(uint64(l))
+	if m.State != 0 {
+		i = encodeVarintUser(dAtA, i, uint64(m.State))
+		i--
+		dAtA[i] = 0x68
 	}
-	if len(m.Attributes) > 0 {
-		for k, v := range m.Attributes {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovUser(uint64(len(k))) + 1 + len(v) + sovUser(uint64(len(v)))
-			n += mapEntrySize + 1 + sovUser(uint64(mapEntrySize))
+	if m.RequirePasswordUpdate {
+		i--
+		if m.RequirePasswordUpdate {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x60
 	}
-	if len(m.ContactInfo) > 0 {
-		for _, e := range m.ContactInfo {
-			l = e.Size()
-			n += 1 + l + sovUser(uint64(l))
+	if m.PasswordUpdatedAt != nil {
+		n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.PasswordUpdatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.PasswordUpdatedAt):])
+		if err4 != nil {
+			return 0, err4
 		}
+		i -= n4
+		i = encodeVarintUser(dAtA, i, uint64(n4))
+		i--
+		dAtA[i] = 0x5a
 	}
-	l = len(m.PrimaryEmailAddress)
-	if l > 0 {
-		n += 1 + l + sovUser(uint64(l))
+	if len(m.Password) > 0 {
+		i -= len(m.Password)
+		copy(dAtA[i:], m.Password)
+		i = encodeVarintUser(dAtA, i, uint64(len(m.Password)))
+		i--
+		dAtA[i] = 0x52
 	}
 	if m.PrimaryEmailAddressValidatedAt != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.PrimaryEmailAddressValidatedAt)
-		n += 1 + l + sovUser(uint64(l))
-	}
-	l = len(m.Password)
-	if l > 0 {
-		n += 1 + l + sovUser(uint64(l))
+		n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.PrimaryEmailAddressValidatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.PrimaryEmailAddressValidatedAt):])
+		if err5 != nil {
+			return 0, err5
+		}
+		i -= n5
+		i = encodeVarintUser(dAtA, i, uint64(n5))
+		i--
+		dAtA[i] = 0x4a
 	}
-	if m.PasswordUpdatedAt != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.PasswordUpdatedAt)
-		n += 1 + l + sovUser(uint64(l))
+	if len(m.PrimaryEmailAddress) > 0 {
+		i -= len(m.PrimaryEmailAddress)
+		copy(dAtA[i:], m.PrimaryEmailAddress)
+		i = encodeVarintUser(dAtA, i, uint64(len(m.PrimaryEmailAddress)))
+		i--
+		dAtA[i] = 0x42
 	}
-	if m.RequirePasswordUpdate {
-		n += 2
+	if len(m.ContactInfo) > 0 {
+		for iNdEx := len(m.ContactInfo) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ContactInfo[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintUser(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
 	}
-	if m.State != 0 {
-		n += 1 + sovUser(uint64(m.State))
+	if len(m.Attributes) > 0 {
+		for k := range m.Attributes {
+			v := m.Attributes[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintUser(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintUser(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintUser(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x32
+		}
 	}
-	if m.Admin {
-		n += 2
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintUser(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	l = len(m.TemporaryPassword)
-	if l > 0 {
-		n += 1 + l + sovUser(uint64(l))
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintUser(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x22
 	}
-	if m.TemporaryPasswordCreatedAt != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.TemporaryPasswordCreatedAt)
-		n += 2 + l + sovUser(uint64(l))
+	n6, err6 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.UpdatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.UpdatedAt):])
+	if err6 != nil {
+		return 0, err6
 	}
-	if m.TemporaryPasswordExpiresAt != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.TemporaryPasswordExpiresAt)
-		n += 2 + l + sovUser(uint64(l))
+	i -= n6
+	i = encodeVarintUser(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x1a
+	n7, err7 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CreatedAt):])
+	if err7 != nil {
+		return 0, err7
 	}
-	if m.ProfilePicture != nil {
-		l = m.ProfilePicture.Size()
-		n += 2 + l + sovUser(uint64(l))
+	i -= n7
+	i = encodeVarintUser(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.UserIdentifiers.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintUser(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *Users) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Users) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *Users) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Users) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if len(m.Users) > 0 {
-		for _, e := range m.Users {
-			l = e.Size()
-			n += 1 + l + sovUser(uint64(l))
+		for iNdEx := len(m.Users) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Users[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintUser(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *GetUserRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.UserIdentifiers.Size()
-	n += 1 + l + sovUser(uint64(l))
-	l = m.FieldMask.Size()
-	n += 1 + l + sovUser(uint64(l))
-	return n
+func (m *GetUserRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
 
-func (m *ListUsersRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.FieldMask.Size()
-	n += 1 + l + sovUser(uint64(l))
-	l = len(m.Order)
-	if l > 0 {
-		n += 1 + l + sovUser(uint64(l))
-	}
-	if m.Limit != 0 {
-		n += 1 + sovUser(uint64(m.Limit))
-	}
-	if m.Page != 0 {
-		n += 1 + sovUser(uint64(m.Page))
-	}
-	return n
+func (m *GetUserRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *CreateUserRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *GetUserRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.User.Size()
-	n += 1 + l + sovUser(uint64(l))
-	l = len(m.InvitationToken)
-	if l > 0 {
-		n += 1 + l + sovUser(uint64(l))
+	{
+		size, err := m.FieldMask.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintUser(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.UserIdentifiers.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintUser(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *UpdateUserRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ListUsersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.User.Size()
-	n += 1 + l + sovUser(uint64(l))
-	l = m.FieldMask.Size()
-	n += 1 + l + sovUser(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *CreateTemporaryPasswordRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.UserIdentifiers.Size()
-	n += 1 + l + sovUser(uint64(l))
-	return n
+func (m *ListUsersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *UpdateUserPasswordRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *ListUsersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.UserIdentifiers.Size()
-	n += 1 + l + sovUser(uint64(l))
-	l = len(m.New)
-	if l > 0 {
-		n += 1 + l + sovUser(uint64(l))
-	}
-	l = len(m.Old)
-	if l > 0 {
-		n += 1 + l + sovUser(uint64(l))
-	}
-	if m.RevokeAllAccess {
-		n += 2
+	if m.Deleted {
+		i--
+		if m.Deleted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
 	}
-	return n
-}
-
-func (m *ListUserAPIKeysRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.Page != 0 {
+		i = encodeVarintUser(dAtA, i, uint64(m.Page))
+		i--
+		dAtA[i] = 0x20
 	}
-	var l int
-	_ = l
-	l = m.UserIdentifiers.Size()
-	n += 1 + l + sovUser(uint64(l))
 	if m.Limit != 0 {
-		n += 1 + sovUser(uint64(m.Limit))
+		i = encodeVarintUser(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.Page != 0 {
-		n += 1 + sovUser(uint64(m.Page))
+	if len(m.Order) > 0 {
+		i -= len(m.Order)
+		copy(dAtA[i:], m.Order)
+		i = encodeVarintUser(dAtA, i, uint64(len(m.Order)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	{
+		size, err := m.FieldMask.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintUser(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *GetUserAPIKeyRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.UserIdentifiers.Size()
-	n += 1 + l + sovUser(uint64(l))
-	l = len(m.KeyID)
-	if l > 0 {
-		n += 1 + l + sovUser(uint64(l))
+func (m *CreateUserRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *CreateUserAPIKeyRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *CreateUserRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CreateUserRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.UserIdentifiers.Size()
-	n += 1 + l + sovUser(uint64(l))
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovUser(uint64(l))
+	if len(m.InvitationToken) > 0 {
+		i -= len(m.InvitationToken)
+		copy(dAtA[i:], m.InvitationToken)
+		i = encodeVarintUser(dAtA, i, uint64(len(m.InvitationToken)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.Rights) > 0 {
-		l = 0
-		for _, e := range m.Rights {
-			l += sovUser(uint64(e))
+	{
+		size, err := m.User.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
-		n += 1 + sovUser(uint64(l)) + l
+		i -= size
+		i = encodeVarintUser(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *UpdateUserAPIKeyRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *UpdateUserRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.UserIdentifiers.Size()
-	n += 1 + l + sovUser(uint64(l))
-	l = m.APIKey.Size()
-	n += 1 + l + sovUser(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *Invitation) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *UpdateUserRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateUserRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Email)
-	if l > 0 {
-		n += 1 + l + sovUser(uint64(l))
+	{
+		size, err := m.FieldMask.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintUser(dAtA, i, uint64(size))
 	}
-	l = len(m.Token)
-	if l > 0 {
-		n += 1 + l + sovUser(uint64(l))
-	}
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.ExpiresAt)
-	n += 1 + l + sovUser(uint64(l))
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.CreatedAt)
-	n += 1 + l + sovUser(uint64(l))
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.UpdatedAt)
-	n += 1 + l + sovUser(uint64(l))
-	if m.AcceptedAt != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.AcceptedAt)
-		n += 1 + l + sovUser(uint64(l))
-	}
-	if m.AcceptedBy != nil {
-		l = m.AcceptedBy.Size()
-		n += 1 + l + sovUser(uint64(l))
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.User.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintUser(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *ListInvitationsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Limit != 0 {
-		n += 1 + sovUser(uint64(m.Limit))
-	}
-	if m.Page != 0 {
-		n += 1 + sovUser(uint64(m.Page))
+func (m *CreateTemporaryPasswordRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *Invitations) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Invitations) > 0 {
-		for _, e := range m.Invitations {
-			l = e.Size()
-			n += 1 + l + sovUser(uint64(l))
-		}
-	}
-	return n
+func (m *CreateTemporaryPasswordRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SendInvitationRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *CreateTemporaryPasswordRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Email)
-	if l > 0 {
-		n += 1 + l + sovUser(uint64(l))
+	{
+		size, err := m.UserIdentifiers.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintUser(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *DeleteInvitationRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Email)
-	if l > 0 {
-		n += 1 + l + sovUser(uint64(l))
+func (m *UpdateUserPasswordRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *UserSessionIdentifiers) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.UserIdentifiers.Size()
-	n += 1 + l + sovUser(uint64(l))
-	l = len(m.SessionID)
-	if l > 0 {
-		n += 1 + l + sovUser(uint64(l))
-	}
-	return n
+func (m *UpdateUserPasswordRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *UserSession) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *UpdateUserPasswordRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.UserIdentifiers.Size()
-	n += 1 + l + sovUser(uint64(l))
-	l = len(m.SessionID)
-	if l > 0 {
-		n += 1 + l + sovUser(uint64(l))
+	if m.RevokeAllAccess {
+		i--
+		if m.RevokeAllAccess {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
 	}
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.CreatedAt)
-	n += 1 + l + sovUser(uint64(l))
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.UpdatedAt)
-	n += 1 + l + sovUser(uint64(l))
-	if m.ExpiresAt != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpiresAt)
-		n += 1 + l + sovUser(uint64(l))
+	if len(m.Old) > 0 {
+		i -= len(m.Old)
+		copy(dAtA[i:], m.Old)
+		i = encodeVarintUser(dAtA, i, uint64(len(m.Old)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	return n
-}
-
-func (m *UserSessions) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.New) > 0 {
+		i -= len(m.New)
+		copy(dAtA[i:], m.New)
+		i = encodeVarintUser(dAtA, i, uint64(len(m.New)))
+		i--
+		dAtA[i] = 0x12
 	}
-	var l int
-	_ = l
-	if len(m.Sessions) > 0 {
-		for _, e := range m.Sessions {
-			l = e.Size()
-			n += 1 + l + sovUser(uint64(l))
+	{
+		size, err := m.UserIdentifiers.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintUser(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *ListUserSessionsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ListUserAPIKeysRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ListUserAPIKeysRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListUserAPIKeysRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.UserIdentifiers.Size()
-	n += 1 + l + sovUser(uint64(l))
-	l = len(m.Order)
-	if l > 0 {
-		n += 1 + l + sovUser(uint64(l))
+	if m.Page != 0 {
+		i = encodeVarintUser(dAtA, i, uint64(m.Page))
+		i--
+		dAtA[i] = 0x18
 	}
 	if m.Limit != 0 {
-		n += 1 + sovUser(uint64(m.Limit))
+		i = encodeVarintUser(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.Page != 0 {
-		n += 1 + sovUser(uint64(m.Page))
+	{
+		size, err := m.UserIdentifiers.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintUser(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func sovUser(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *GetUserAPIKeyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
-func sozUser(x uint64) (n int) {
-	return sovUser((x << 1) ^ uint64((int64(x) >> 63)))
+
+func (m *GetUserAPIKeyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (this *User) String() string {
-	if this == nil {
-		return "nil"
-	}
-	repeatedStringForContactInfo := "[]*ContactInfo{"
-	for _, f := range this.ContactInfo {
-		repeatedStringForContactInfo += strings.Replace(fmt.Sprintf("%v", f), "ContactInfo", "ContactInfo", 1) + ","
-	}
-	repeatedStringForContactInfo += "}"
-	keysForAttributes := make([]string, 0, len(this.Attributes))
-	for k := range this.Attributes {
-		keysForAttributes = append(keysForAttributes, k)
-	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForAttributes)
-	mapStringForAttributes := "map[string]string{"
-	for _, k := range keysForAttributes {
-		mapStringForAttributes += fmt.Sprintf("%v: %v,", k, this.Attributes[k])
-	}
-	mapStringForAttributes += "}"
-	s := strings.Join([]string{`&User{`,
-		`UserIdentifiers:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.UserIdentifiers), "UserIdentifiers", "UserIdentifiers", 1), `&`, ``, 1) + `,`,
-		`CreatedAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.CreatedAt), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
-		`UpdatedAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.UpdatedAt), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
-		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
-		`Description:` + fmt.Sprintf("%v", this.Description) + `,`,
-		`Attributes:` + mapStringForAttributes + `,`,
-		`ContactInfo:` + repeatedStringForContactInfo + `,`,
-		`PrimaryEmailAddress:` + fmt.Sprintf("%v", this.PrimaryEmailAddress) + `,`,
-		`PrimaryEmailAddressValidatedAt:` + strings.Replace(fmt.Sprintf("%v", this.PrimaryEmailAddressValidatedAt), "Timestamp", "types.Timestamp", 1) + `,`,
-		`Password:` + fmt.Sprintf("%v", this.Password) + `,`,
-		`PasswordUpdatedAt:` + strings.Replace(fmt.Sprintf("%v", this.PasswordUpdatedAt), "Timestamp", "types.Timestamp", 1) + `,`,
-		`RequirePasswordUpdate:` + fmt.Sprintf("%v", this.RequirePasswordUpdate) + `,`,
-		`State:` + fmt.Sprintf("%v", this.State) + `,`,
-		`Admin:` + fmt.Sprintf("%v", this.Admin) + `,`,
-		`TemporaryPassword:` + fmt.Sprintf("%v", this.TemporaryPassword) + `,`,
-		`TemporaryPasswordCreatedAt:` + strings.Replace(fmt.Sprintf("%v", this.TemporaryPasswordCreatedAt), "Timestamp", "types.Timestamp", 1) + `,`,
-		`TemporaryPasswordExpiresAt:` + strings.Replace(fmt.Sprintf("%v", this.TemporaryPasswordExpiresAt), "Timestamp", "types.Timestamp", 1) + `,`,
-		`ProfilePicture:` + strings.Replace(fmt.Sprintf("%v", this.ProfilePicture), "Picture", "Picture", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *Users) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *GetUserAPIKeyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.KeyID) > 0 {
+		i -= len(m.KeyID)
+		copy(dAtA[i:], m.KeyID)
+		i = encodeVarintUser(dAtA, i, uint64(len(m.KeyID)))
+		i--
+		dAtA[i] = 0x12
 	}
-	repeatedStringForUsers := "[]*User{"
-	for _, f := range this.Users {
-		repeatedStringForUsers += strings.Replace(f.String(), "User", "User", 1) + ","
+	{
+		size, err := m.UserIdentifiers.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintUser(dAtA, i, uint64(size))
 	}
-	repeatedStringForUsers += "}"
-	s := strings.Join([]string{`&Users{`,
-		`Users:` + repeatedStringForUsers + `,`,
-		`}`,
-	}, "")
-	return s
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
-func (this *GetUserRequest) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *CreateUserAPIKeyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	s := strings.Join([]string{`&GetUserRequest{`,
-		`UserIdentifiers:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.UserIdentifiers), "UserIdentifiers", "UserIdentifiers", 1), `&`, ``, 1) + `,`,
-		`FieldMask:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.FieldMask), "FieldMask", "types.FieldMask", 1), `&`, ``, 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return dAtA[:n], nil
 }
-func (this *ListUsersRequest) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&ListUsersRequest{`,
-		`FieldMask:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.FieldMask), "FieldMask", "types.FieldMask", 1), `&`, ``, 1) + `,`,
-		`Order:` + fmt.Sprintf("%v", this.Order) + `,`,
-		`Limit:` + fmt.Sprintf("%v", this.Limit) + `,`,
-		`Page:` + fmt.Sprintf("%v", this.Page) + `,`,
-		`}`,
-	}, "")
-	return s
+
+func (m *CreateUserAPIKeyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (this *CreateUserRequest) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *CreateUserAPIKeyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Restrictions != nil {
+		{
+			size, err := m.Restrictions.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintUser(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
 	}
-	s := strings.Join([]string{`&CreateUserRequest{`,
-		`User:` + strings.Replace(strings.Replace(this.User.String(), "User", "User", 1), `&`, ``, 1) + `,`,
-		`InvitationToken:` + fmt.Sprintf("%v", this.InvitationToken) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *UpdateUserRequest) String() string {
-	if this == nil {
-		return "nil"
+	if m.ExpiresAt != nil {
+		n20, err20 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ExpiresAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpiresAt):])
+		if err20 != nil {
+			return 0, err20
+		}
+		i -= n20
+		i = encodeVarintUser(dAtA, i, uint64(n20))
+		i--
+		dAtA[i] = 0x22
 	}
-	s := strings.Join([]string{`&UpdateUserRequest{`,
-		`User:` + strings.Replace(strings.Replace(this.User.String(), "User", "User", 1), `&`, ``, 1) + `,`,
-		`FieldMask:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.FieldMask), "FieldMask", "types.FieldMask", 1), `&`, ``, 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *CreateTemporaryPasswordRequest) String() string {
-	if this == nil {
-		return "nil"
+	if len(m.Rights) > 0 {
+		dAtA22 := make([]byte, len(m.Rights)*10)
+		var j21 int
+		for _, num := range m.Rights {
+			for num >= 1<<7 {
+				dAtA22[j21] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j21++
+			}
+			dAtA22[j21] = uint8(num)
+			j21++
+		}
+		i -= j21
+		copy(dAtA[i:], dAtA22[:j21])
+		i = encodeVarintUser(dAtA, i, uint64(j21))
+		i--
+		dAtA[i] = 0x1a
 	}
-	s := strings.Join([]string{`&CreateTemporaryPasswordRequest{`,
-		`UserIdentifiers:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.UserIdentifiers), "UserIdentifiers", "UserIdentifiers", 1), `&`, ``, 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *UpdateUserPasswordRequest) String() string {
-	if this == nil {
-		return "nil"
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintUser(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
 	}
-	s := strings.Join([]string{`&UpdateUserPasswordRequest{`,
-		`UserIdentifiers:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.UserIdentifiers), "UserIdentifiers", "UserIdentifiers", 1), `&`, ``, 1) + `,`,
-		`New:` + fmt.Sprintf("%v", this.New) + `,`,
-		`Old:` + fmt.Sprintf("%v", this.Old) + `,`,
-		`RevokeAllAccess:` + fmt.Sprintf("%v", this.RevokeAllAccess) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *ListUserAPIKeysRequest) String() string {
-	if this == nil {
-		return "nil"
+	{
+		size, err := m.UserIdentifiers.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintUser(dAtA, i, uint64(size))
 	}
-	s := strings.Join([]string{`&ListUserAPIKeysRequest{`,
-		`UserIdentifiers:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.UserIdentifiers), "UserIdentifiers", "UserIdentifiers", 1), `&`, ``, 1) + `,`,
-		`Limit:` + fmt.Sprintf("%v", this.Limit) + `,`,
-		`Page:` + fmt.Sprintf("%v", this.Page) + `,`,
-		`}`,
-	}, "")
-	return s
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
-func (this *GetUserAPIKeyRequest) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *UpdateUserAPIKeyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	s := strings.Join([]string{`&GetUserAPIKeyRequest{`,
-		`UserIdentifiers:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.UserIdentifiers), "UserIdentifiers", "UserIdentifiers", 1), `&`, ``, 1) + `,`,
-		`KeyID:` + fmt.Sprintf("%v", this.KeyID) + `,`,
-		`}`,
-	}, "")
-	return s
+	return dAtA[:n], nil
 }
-func (this *CreateUserAPIKeyRequest) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&CreateUserAPIKeyRequest{`,
-		`UserIdentifiers:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.UserIdentifiers), "UserIdentifiers", "UserIdentifiers", 1), `&`, ``, 1) + `,`,
-		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
-		`Rights:` + fmt.Sprintf("%v", this.Rights) + `,`,
-		`}`,
-	}, "")
-	return s
+
+func (m *UpdateUserAPIKeyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (this *UpdateUserAPIKeyRequest) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *UpdateUserAPIKeyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.FieldMask.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintUser(dAtA, i, uint64(size))
 	}
-	s := strings.Join([]string{`&UpdateUserAPIKeyRequest{`,
-		`UserIdentifiers:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.UserIdentifiers), "UserIdentifiers", "UserIdentifiers", 1), `&`, ``, 1) + `,`,
-		`APIKey:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.APIKey), "APIKey", "APIKey", 1), `&`, ``, 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *Invitation) String() string {
-	if this == nil {
-		return "nil"
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.APIKey.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintUser(dAtA, i, uint64(size))
 	}
-	s := strings.Join([]string{`&Invitation{`,
-		`Email:` + fmt.Sprintf("%v", this.Email) + `,`,
-		`Token:` + fmt.Sprintf("%v", this.Token) + `,`,
-		`ExpiresAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ExpiresAt), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
-		`CreatedAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.CreatedAt), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
-		`UpdatedAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.UpdatedAt), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
-		`AcceptedAt:` + strings.Replace(fmt.Sprintf("%v", this.AcceptedAt), "Timestamp", "types.Timestamp", 1) + `,`,
-		`AcceptedBy:` + strings.Replace(fmt.Sprintf("%v", this.AcceptedBy), "UserIdentifiers", "UserIdentifiers", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.UserIdentifiers.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintUser(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
-func (this *ListInvitationsRequest) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *Invitation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	s := strings.Join([]string{`&ListInvitationsRequest{`,
-		`Limit:` + fmt.Sprintf("%v", this.Limit) + `,`,
-		`Page:` + fmt.Sprintf("%v", this.Page) + `,`,
-		`}`,
-	}, "")
-	return s
+	return dAtA[:n], nil
 }
-func (this *Invitations) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *Invitation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Invitation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AcceptedBy != nil {
+		{
+			size, err := m.AcceptedBy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintUser(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
 	}
-	repeatedStringForInvitations := "[]*Invitation{"
-	for _, f := range this.Invitations {
-		repeatedStringForInvitations += strings.Replace(f.String(), "Invitation", "Invitation", 1) + ","
+	if m.AcceptedAt != nil {
+		n28, err28 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.AcceptedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.AcceptedAt):])
+		if err28 != nil {
+			return 0, err28
+		}
+		i -= n28
+		i = encodeVarintUser(dAtA, i, uint64(n28))
+		i--
+		dAtA[i] = 0x32
 	}
-	repeatedStringForInvitations += "}"
-	s := strings.Join([]string{`&Invitations{`,
-		`Invitations:` + repeatedStringForInvitations + `,`,
-		`}`,
-	}, "")
-	return s
+	n29, err29 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.UpdatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.UpdatedAt):])
+	if err29 != nil {
+		return 0, err29
+	}
+	i -= n29
+	i = encodeVarintUser(dAtA, i, uint64(n29))
+	i--
+	dAtA[i] = 0x2a
+	n30, err30 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CreatedAt):])
+	if err30 != nil {
+		return 0, err30
+	}
+	i -= n30
+	i = encodeVarintUser(dAtA, i, uint64(n30))
+	i--
+	dAtA[i] = 0x22
+	n31, err31 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.ExpiresAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.ExpiresAt):])
+	if err31 != nil {
+		return 0, err31
+	}
+	i -= n31
+	i = encodeVarintUser(dAtA, i, uint64(n31))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Token) > 0 {
+		i -= len(m.Token)
+		copy(dAtA[i:], m.Token)
+		i = encodeVarintUser(dAtA, i, uint64(len(m.Token)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Email) > 0 {
+		i -= len(m.Email)
+		copy(dAtA[i:], m.Email)
+		i = encodeVarintUser(dAtA, i, uint64(len(m.Email)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
-func (this *SendInvitationRequest) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *ListInvitationsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	s := strings.Join([]string{`&SendInvitationRequest{`,
-		`Email:` + fmt.Sprintf("%v", this.Email) + `,`,
-		`}`,
-	}, "")
-	return s
+	return dAtA[:n], nil
 }
-func (this *DeleteInvitationRequest) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *ListInvitationsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListInvitationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Page != 0 {
+		i = encodeVarintUser(dAtA, i, uint64(m.Page))
+		i--
+		dAtA[i] = 0x10
 	}
-	s := strings.Join([]string{`&DeleteInvitationRequest{`,
-		`Email:` + fmt.Sprintf("%v", this.Email) + `,`,
-		`}`,
-	}, "")
-	return s
+	if m.Limit != 0 {
+		i = encodeVarintUser(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
-func (this *UserSessionIdentifiers) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *Invitations) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	s := strings.Join([]string{`&UserSessionIdentifiers{`,
-		`UserIdentifiers:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.UserIdentifiers), "UserIdentifiers", "UserIdentifiers", 1), `&`, ``, 1) + `,`,
-		`SessionID:` + fmt.Sprintf("%v", this.SessionID) + `,`,
-		`}`,
-	}, "")
-	return s
+	return dAtA[:n], nil
 }
-func (this *UserSession) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *Invitations) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Invitations) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Invitations) > 0 {
+		for iNdEx := len(m.Invitations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Invitations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintUser(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	s := strings.Join([]string{`&UserSession{`,
-		`UserIdentifiers:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.UserIdentifiers), "UserIdentifiers", "UserIdentifiers", 1), `&`, ``, 1) + `,`,
-		`SessionID:` + fmt.Sprintf("%v", this.SessionID) + `,`,
-		`CreatedAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.CreatedAt), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
-		`UpdatedAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.UpdatedAt), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
-		`ExpiresAt:` + strings.Replace(fmt.Sprintf("%v", this.ExpiresAt), "Timestamp", "types.Timestamp", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return len(dAtA) - i, nil
 }
-func (this *UserSessions) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *SendInvitationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SendInvitationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SendInvitationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Email) > 0 {
+		i -= len(m.Email)
+		copy(dAtA[i:], m.Email)
+		i = encodeVarintUser(dAtA, i, uint64(len(m.Email)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeleteInvitationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteInvitationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeleteInvitationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Email) > 0 {
+		i -= len(m.Email)
+		copy(dAtA[i:], m.Email)
+		i = encodeVarintUser(dAtA, i, uint64(len(m.Email)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UserSessionIdentifiers) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UserSessionIdentifiers) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UserSessionIdentifiers) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SessionID) > 0 {
+		i -= len(m.SessionID)
+		copy(dAtA[i:], m.SessionID)
+		i = encodeVarintUser(dAtA, i, uint64(len(m.SessionID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.UserIdentifiers.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintUser(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *UserSession) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UserSession) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UserSession) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpiresAt != nil {
+		n33, err33 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ExpiresAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpiresAt):])
+		if err33 != nil {
+			return 0, err33
+		}
+		i -= n33
+		i = encodeVarintUser(dAtA, i, uint64(n33))
+		i--
+		dAtA[i] = 0x2a
+	}
+	n34, err34 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.UpdatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.UpdatedAt):])
+	if err34 != nil {
+		return 0, err34
+	}
+	i -= n34
+	i = encodeVarintUser(dAtA, i, uint64(n34))
+	i--
+	dAtA[i] = 0x22
+	n35, err35 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CreatedAt):])
+	if err35 != nil {
+		return 0, err35
+	}
+	i -= n35
+	i = encodeVarintUser(dAtA, i, uint64(n35))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.SessionID) > 0 {
+		i -= len(m.SessionID)
+		copy(dAtA[i:], m.SessionID)
+		i = encodeVarintUser(dAtA, i, uint64(len(m.SessionID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.UserIdentifiers.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintUser(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *UserSessions) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UserSessions) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UserSessions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sessions) > 0 {
+		for iNdEx := len(m.Sessions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Sessions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintUser(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListUserSessionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListUserSessionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListUserSessionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Page != 0 {
+		i = encodeVarintUser(dAtA, i, uint64(m.Page))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Limit != 0 {
+		i = encodeVarintUser(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Order) > 0 {
+		i -= len(m.Order)
+		copy(dAtA[i:], m.Order)
+		i = encodeVarintUser(dAtA, i, uint64(len(m.Order)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.UserIdentifiers.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintUser(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *UserTOTPStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UserTOTPStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UserTOTPStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RecoveryCodesRemaining != 0 {
+		i = encodeVarintUser(dAtA, i, uint64(m.RecoveryCodesRemaining))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.EnabledAt != nil {
+		n38, err38 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.EnabledAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.EnabledAt):])
+		if err38 != nil {
+			return 0, err38
+		}
+		i -= n38
+		i = encodeVarintUser(dAtA, i, uint64(n38))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UserTOTPEnrollment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UserTOTPEnrollment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UserTOTPEnrollment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.URI) > 0 {
+		i -= len(m.URI)
+		copy(dAtA[i:], m.URI)
+		i = encodeVarintUser(dAtA, i, uint64(len(m.URI)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Secret) > 0 {
+		i -= len(m.Secret)
+		copy(dAtA[i:], m.Secret)
+		i = encodeVarintUser(dAtA, i, uint64(len(m.Secret)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EnableUserTOTPRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EnableUserTOTPRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EnableUserTOTPRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Code) > 0 {
+		i -= len(m.Code)
+		copy(dAtA[i:], m.Code)
+		i = encodeVarintUser(dAtA, i, uint64(len(m.Code)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.UserIdentifiers.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintUser(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *DisableUserTOTPRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DisableUserTOTPRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DisableUserTOTPRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Code) > 0 {
+		i -= len(m.Code)
+		copy(dAtA[i:], m.Code)
+		i = encodeVarintUser(dAtA, i, uint64(len(m.Code)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.UserIdentifiers.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintUser(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *GenerateUserRecoveryCodesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenerateUserRecoveryCodesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenerateUserRecoveryCodesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Code) > 0 {
+		i -= len(m.Code)
+		copy(dAtA[i:], m.Code)
+		i = encodeVarintUser(dAtA, i, uint64(len(m.Code)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.UserIdentifiers.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintUser(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *UserRecoveryCodes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UserRecoveryCodes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UserRecoveryCodes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Codes) > 0 {
+		for iNdEx := len(m.Codes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Codes[iNdEx])
+			copy(dAtA[i:], m.Codes[iNdEx])
+			i = encodeVarintUser(dAtA, i, uint64(len(m.Codes[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Notification) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Notification) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Notification) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ReadAt != nil {
+		n42, err42 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ReadAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ReadAt):])
+		if err42 != nil {
+			return 0, err42
+		}
+		i -= n42
+		i = encodeVarintUser(dAtA, i, uint64(n42))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.Rights) > 0 {
+		dAtA44 := make([]byte, len(m.Rights)*10)
+		var j43 int
+		for _, num := range m.Rights {
+			for num >= 1<<7 {
+				dAtA44[j43] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j43++
+			}
+			dAtA44[j43] = uint8(num)
+			j43++
+		}
+		i -= j43
+		copy(dAtA[i:], dAtA44[:j43])
+		i = encodeVarintUser(dAtA, i, uint64(j43))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Subject) > 0 {
+		i -= len(m.Subject)
+		copy(dAtA[i:], m.Subject)
+		i = encodeVarintUser(dAtA, i, uint64(len(m.Subject)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.SenderIDs != nil {
+		{
+			size, err := m.SenderIDs.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintUser(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size, err := m.EntityIDs.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintUser(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.NotificationType != 0 {
+		i = encodeVarintUser(dAtA, i, uint64(m.NotificationType))
+		i--
+		dAtA[i] = 0x18
+	}
+	n47, err47 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CreatedAt):])
+	if err47 != nil {
+		return 0, err47
+	}
+	i -= n47
+	i = encodeVarintUser(dAtA, i, uint64(n47))
+	i--
+	dAtA[i] = 0x12
+	if len(m.ID) > 0 {
+		i -= len(m.ID)
+		copy(dAtA[i:], m.ID)
+		i = encodeVarintUser(dAtA, i, uint64(len(m.ID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Notifications) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Notifications) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Notifications) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Notifications) > 0 {
+		for iNdEx := len(m.Notifications) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Notifications[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintUser(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CreateNotificationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CreateNotificationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CreateNotificationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Notification.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintUser(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ListNotificationsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListNotificationsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListNotificationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Page != 0 {
+		i = encodeVarintUser(dAtA, i, uint64(m.Page))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Limit != 0 {
+		i = encodeVarintUser(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.UnreadOnly {
+		i--
+		if m.UnreadOnly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.UserIdentifiers.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintUser(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MarkNotificationsReadRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MarkNotificationsReadRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MarkNotificationsReadRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.IDs) > 0 {
+		for iNdEx := len(m.IDs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.IDs[iNdEx])
+			copy(dAtA[i:], m.IDs[iNdEx])
+			i = encodeVarintUser(dAtA, i, uint64(len(m.IDs[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.UserIdentifiers.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintUser(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *NotificationPreference) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NotificationPreference) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NotificationPreference) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Email {
+		i--
+		if m.Email {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Subscribed {
+		i--
+		if m.Subscribed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.NotificationType != 0 {
+		i = encodeVarintUser(dAtA, i, uint64(m.NotificationType))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *NotificationPreferences) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NotificationPreferences) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NotificationPreferences) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Preferences) > 0 {
+		for iNdEx := len(m.Preferences) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Preferences[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintUser(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SetNotificationPreferencesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetNotificationPreferencesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetNotificationPreferencesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Preferences) > 0 {
+		for iNdEx := len(m.Preferences) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Preferences[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintUser(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.UserIdentifiers.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintUser(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintUser(dAtA []byte, offset int, v uint64) int {
+	offset -= sovUser(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func NewPopulatedUser(r randyUser, easy bool) *User {
+	this := &User{}
+	v1 := NewPopulatedUserIdentifiers(r, easy)
+	this.UserIdentifiers = *v1
+	v2 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	this.CreatedAt = *v2
+	v3 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	this.UpdatedAt = *v3
+	this.Name = randStringUser(r)
+	this.Description = randStringUser(r)
+	if r.Intn(5) != 0 {
+		v4 := r.Intn(10)
+		this.Attributes = make(map[string]string)
+		for i := 0; i < v4; i++ {
+			this.Attributes[randStringUser(r)] = randStringUser(r)
+		}
+	}
+	if r.Intn(5) != 0 {
+		v5 := r.Intn(5)
+		this.ContactInfo = make([]*ContactInfo, v5)
+		for i := 0; i < v5; i++ {
+			this.ContactInfo[i] = NewPopulatedContactInfo(r, easy)
+		}
+	}
+	this.PrimaryEmailAddress = randStringUser(r)
+	if r.Intn(5) != 0 {
+		this.PrimaryEmailAddressValidatedAt = github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	}
+	this.Password = randStringUser(r)
+	if r.Intn(5) != 0 {
+		this.PasswordUpdatedAt = github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	}
+	this.RequirePasswordUpdate = bool(r.Intn(2) == 0)
+	this.State = State([]int32{0, 1, 2, 3, 4}[r.Intn(5)])
+	this.Admin = bool(r.Intn(2) == 0)
+	this.TemporaryPassword = randStringUser(r)
+	if r.Intn(5) != 0 {
+		this.TemporaryPasswordCreatedAt = github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	}
+	if r.Intn(5) != 0 {
+		this.TemporaryPasswordExpiresAt = github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	}
+	if r.Intn(5) != 0 {
+		this.ProfilePicture = NewPopulatedPicture(r, easy)
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedUsers(r randyUser, easy bool) *Users {
+	this := &Users{}
+	if r.Intn(5) != 0 {
+		v6 := r.Intn(5)
+		this.Users = make([]*User, v6)
+		for i := 0; i < v6; i++ {
+			this.Users[i] = NewPopulatedUser(r, easy)
+		}
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedGetUserRequest(r randyUser, easy bool) *GetUserRequest {
+	this := &GetUserRequest{}
+	v7 := NewPopulatedUserIdentifiers(r, easy)
+	this.UserIdentifiers = *v7
+	v8 := types.NewPopulatedFieldMask(r, easy)
+	this.FieldMask = *v8
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedListUsersRequest(r randyUser, easy bool) *ListUsersRequest {
+	this := &ListUsersRequest{}
+	v9 := types.NewPopulatedFieldMask(r, easy)
+	this.FieldMask = *v9
+	this.Order = randStringUser(r)
+	this.Limit = r.Uint32()
+	this.Page = r.Uint32()
+	this.Deleted = bool(r.Intn(2) == 0)
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedCreateUserRequest(r randyUser, easy bool) *CreateUserRequest {
+	this := &CreateUserRequest{}
+	v10 := NewPopulatedUser(r, easy)
+	this.User = *v10
+	this.InvitationToken = randStringUser(r)
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedUpdateUserRequest(r randyUser, easy bool) *UpdateUserRequest {
+	this := &UpdateUserRequest{}
+	v11 := NewPopulatedUser(r, easy)
+	this.User = *v11
+	v12 := types.NewPopulatedFieldMask(r, easy)
+	this.FieldMask = *v12
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedCreateTemporaryPasswordRequest(r randyUser, easy bool) *CreateTemporaryPasswordRequest {
+	this := &CreateTemporaryPasswordRequest{}
+	v13 := NewPopulatedUserIdentifiers(r, easy)
+	this.UserIdentifiers = *v13
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedUpdateUserPasswordRequest(r randyUser, easy bool) *UpdateUserPasswordRequest {
+	this := &UpdateUserPasswordRequest{}
+	v14 := NewPopulatedUserIdentifiers(r, easy)
+	this.UserIdentifiers = *v14
+	this.New = randStringUser(r)
+	this.Old = randStringUser(r)
+	this.RevokeAllAccess = bool(r.Intn(2) == 0)
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedListUserAPIKeysRequest(r randyUser, easy bool) *ListUserAPIKeysRequest {
+	this := &ListUserAPIKeysRequest{}
+	v15 := NewPopulatedUserIdentifiers(r, easy)
+	this.UserIdentifiers = *v15
+	this.Limit = r.Uint32()
+	this.Page = r.Uint32()
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedGetUserAPIKeyRequest(r randyUser, easy bool) *GetUserAPIKeyRequest {
+	this := &GetUserAPIKeyRequest{}
+	v16 := NewPopulatedUserIdentifiers(r, easy)
+	this.UserIdentifiers = *v16
+	this.KeyID = randStringUser(r)
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedCreateUserAPIKeyRequest(r randyUser, easy bool) *CreateUserAPIKeyRequest {
+	this := &CreateUserAPIKeyRequest{}
+	v17 := NewPopulatedUserIdentifiers(r, easy)
+	this.UserIdentifiers = *v17
+	this.Name = randStringUser(r)
+	v18 := r.Intn(10)
+	this.Rights = make([]Right, v18)
+	for i := 0; i < v18; i++ {
+		this.Rights[i] = Right([]int32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 56, 19, 20, 21, 22, 23, 24, 25, 26, 27, 28, 29, 30, 31, 32, 33, 34, 35, 36, 37, 38, 39, 40, 41, 42, 43, 44, 45, 46, 47, 48, 49, 50, 51, 52, 53, 54, 55}[r.Intn(57)])
+	}
+	if r.Intn(5) != 0 {
+		this.ExpiresAt = github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	}
+	if r.Intn(5) != 0 {
+		this.Restrictions = NewPopulatedAPIKeyRestrictions(r, easy)
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedUpdateUserAPIKeyRequest(r randyUser, easy bool) *UpdateUserAPIKeyRequest {
+	this := &UpdateUserAPIKeyRequest{}
+	v19 := NewPopulatedUserIdentifiers(r, easy)
+	this.UserIdentifiers = *v19
+	v20 := NewPopulatedAPIKey(r, easy)
+	this.APIKey = *v20
+	v21 := types.NewPopulatedFieldMask(r, easy)
+	this.FieldMask = *v21
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedInvitation(r randyUser, easy bool) *Invitation {
+	this := &Invitation{}
+	this.Email = randStringUser(r)
+	this.Token = randStringUser(r)
+	v22 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	this.ExpiresAt = *v22
+	v23 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	this.CreatedAt = *v23
+	v24 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	this.UpdatedAt = *v24
+	if r.Intn(5) != 0 {
+		this.AcceptedAt = github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	}
+	if r.Intn(5) != 0 {
+		this.AcceptedBy = NewPopulatedUserIdentifiers(r, easy)
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedListInvitationsRequest(r randyUser, easy bool) *ListInvitationsRequest {
+	this := &ListInvitationsRequest{}
+	this.Limit = r.Uint32()
+	this.Page = r.Uint32()
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedInvitations(r randyUser, easy bool) *Invitations {
+	this := &Invitations{}
+	if r.Intn(5) != 0 {
+		v25 := r.Intn(5)
+		this.Invitations = make([]*Invitation, v25)
+		for i := 0; i < v25; i++ {
+			this.Invitations[i] = NewPopulatedInvitation(r, easy)
+		}
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedSendInvitationRequest(r randyUser, easy bool) *SendInvitationRequest {
+	this := &SendInvitationRequest{}
+	this.Email = randStringUser(r)
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedDeleteInvitationRequest(r randyUser, easy bool) *DeleteInvitationRequest {
+	this := &DeleteInvitationRequest{}
+	this.Email = randStringUser(r)
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedUserSessionIdentifiers(r randyUser, easy bool) *UserSessionIdentifiers {
+	this := &UserSessionIdentifiers{}
+	v26 := NewPopulatedUserIdentifiers(r, easy)
+	this.UserIdentifiers = *v26
+	this.SessionID = randStringUser(r)
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedUserSession(r randyUser, easy bool) *UserSession {
+	this := &UserSession{}
+	v27 := NewPopulatedUserIdentifiers(r, easy)
+	this.UserIdentifiers = *v27
+	this.SessionID = randStringUser(r)
+	v28 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	this.CreatedAt = *v28
+	v29 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	this.UpdatedAt = *v29
+	if r.Intn(5) != 0 {
+		this.ExpiresAt = github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedUserSessions(r randyUser, easy bool) *UserSessions {
+	this := &UserSessions{}
+	if r.Intn(5) != 0 {
+		v30 := r.Intn(5)
+		this.Sessions = make([]*UserSession, v30)
+		for i := 0; i < v30; i++ {
+			this.Sessions[i] = NewPopulatedUserSession(r, easy)
+		}
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedListUserSessionsRequest(r randyUser, easy bool) *ListUserSessionsRequest {
+	this := &ListUserSessionsRequest{}
+	v31 := NewPopulatedUserIdentifiers(r, easy)
+	this.UserIdentifiers = *v31
+	this.Order = randStringUser(r)
+	this.Limit = r.Uint32()
+	this.Page = r.Uint32()
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedUserTOTPStatus(r randyUser, easy bool) *UserTOTPStatus {
+	this := &UserTOTPStatus{}
+	this.Enabled = bool(r.Intn(2) == 0)
+	if r.Intn(5) != 0 {
+		this.EnabledAt = github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	}
+	this.RecoveryCodesRemaining = r.Uint32()
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedUserTOTPEnrollment(r randyUser, easy bool) *UserTOTPEnrollment {
+	this := &UserTOTPEnrollment{}
+	this.Secret = randStringUser(r)
+	this.URI = randStringUser(r)
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedEnableUserTOTPRequest(r randyUser, easy bool) *EnableUserTOTPRequest {
+	this := &EnableUserTOTPRequest{}
+	v32 := NewPopulatedUserIdentifiers(r, easy)
+	this.UserIdentifiers = *v32
+	this.Code = randStringUser(r)
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedDisableUserTOTPRequest(r randyUser, easy bool) *DisableUserTOTPRequest {
+	this := &DisableUserTOTPRequest{}
+	v33 := NewPopulatedUserIdentifiers(r, easy)
+	this.UserIdentifiers = *v33
+	this.Code = randStringUser(r)
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedGenerateUserRecoveryCodesRequest(r randyUser, easy bool) *GenerateUserRecoveryCodesRequest {
+	this := &GenerateUserRecoveryCodesRequest{}
+	v34 := NewPopulatedUserIdentifiers(r, easy)
+	this.UserIdentifiers = *v34
+	this.Code = randStringUser(r)
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedUserRecoveryCodes(r randyUser, easy bool) *UserRecoveryCodes {
+	this := &UserRecoveryCodes{}
+	v35 := r.Intn(10)
+	this.Codes = make([]string, v35)
+	for i := 0; i < v35; i++ {
+		this.Codes[i] = randStringUser(r)
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedNotification(r randyUser, easy bool) *Notification {
+	this := &Notification{}
+	this.ID = randStringUser(r)
+	v36 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	this.CreatedAt = *v36
+	this.NotificationType = NotificationType([]int32{0, 1, 2, 3, 4, 5}[r.Intn(6)])
+	v37 := NewPopulatedEntityIdentifiers(r, easy)
+	this.EntityIDs = *v37
+	if r.Intn(5) != 0 {
+		this.SenderIDs = NewPopulatedUserIdentifiers(r, easy)
+	}
+	this.Subject = randStringUser(r)
+	v38 := r.Intn(10)
+	this.Rights = make([]Right, v38)
+	for i := 0; i < v38; i++ {
+		this.Rights[i] = Right([]int32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 56, 19, 20, 21, 22, 23, 24, 25, 26, 27, 28, 29, 30, 31, 32, 33, 34, 35, 36, 37, 38, 39, 40, 41, 42, 43, 44, 45, 46, 47, 48, 49, 50, 51, 52, 53, 54, 55}[r.Intn(57)])
+	}
+	if r.Intn(5) != 0 {
+		this.ReadAt = github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedNotifications(r randyUser, easy bool) *Notifications {
+	this := &Notifications{}
+	if r.Intn(5) != 0 {
+		v39 := r.Intn(5)
+		this.Notifications = make([]*Notification, v39)
+		for i := 0; i < v39; i++ {
+			this.Notifications[i] = NewPopulatedNotification(r, easy)
+		}
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedCreateNotificationRequest(r randyUser, easy bool) *CreateNotificationRequest {
+	this := &CreateNotificationRequest{}
+	v40 := NewPopulatedNotification(r, easy)
+	this.Notification = *v40
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedListNotificationsRequest(r randyUser, easy bool) *ListNotificationsRequest {
+	this := &ListNotificationsRequest{}
+	v41 := NewPopulatedUserIdentifiers(r, easy)
+	this.UserIdentifiers = *v41
+	this.UnreadOnly = bool(r.Intn(2) == 0)
+	this.Limit = r.Uint32()
+	this.Page = r.Uint32()
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedMarkNotificationsReadRequest(r randyUser, easy bool) *MarkNotificationsReadRequest {
+	this := &MarkNotificationsReadRequest{}
+	v42 := NewPopulatedUserIdentifiers(r, easy)
+	this.UserIdentifiers = *v42
+	v43 := r.Intn(10)
+	this.IDs = make([]string, v43)
+	for i := 0; i < v43; i++ {
+		this.IDs[i] = randStringUser(r)
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedNotificationPreference(r randyUser, easy bool) *NotificationPreference {
+	this := &NotificationPreference{}
+	this.NotificationType = NotificationType([]int32{0, 1, 2, 3, 4, 5}[r.Intn(6)])
+	this.Subscribed = bool(r.Intn(2) == 0)
+	this.Email = bool(r.Intn(2) == 0)
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedNotificationPreferences(r randyUser, easy bool) *NotificationPreferences {
+	this := &NotificationPreferences{}
+	if r.Intn(5) != 0 {
+		v44 := r.Intn(5)
+		this.Preferences = make([]*NotificationPreference, v44)
+		for i := 0; i < v44; i++ {
+			this.Preferences[i] = NewPopulatedNotificationPreference(r, easy)
+		}
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedSetNotificationPreferencesRequest(r randyUser, easy bool) *SetNotificationPreferencesRequest {
+	this := &SetNotificationPreferencesRequest{}
+	v45 := NewPopulatedUserIdentifiers(r, easy)
+	this.UserIdentifiers = *v45
+	if r.Intn(5) != 0 {
+		v46 := r.Intn(5)
+		this.Preferences = make([]*NotificationPreference, v46)
+		for i := 0; i < v46; i++ {
+			this.Preferences[i] = NewPopulatedNotificationPreference(r, easy)
+		}
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+type randyUser interface {
+	Float32() float32
+	Float64() float64
+	Int63() int64
+	Int31() int32
+	Uint32() uint32
+	Intn(n int) int
+}
+
+func randUTF8RuneUser(r randyUser) rune {
+	ru := r.Intn(62)
+	if ru < 10 {
+		return rune(ru + 48)
+	} else if ru < 36 {
+		return rune(ru + 55)
+	}
+	return rune(ru + 61)
+}
+func randStringUser(r randyUser) string {
+	v47 := r.Intn(100)
+	tmps := make([]rune, v47)
+	for i := 0; i < v47; i++ {
+		tmps[i] = randUTF8RuneUser(r)
+	}
+	return string(tmps)
+}
+func randUnrecognizedUser(r randyUser, maxFieldNumber int) (dAtA []byte) {
+	l := r.Intn(5)
+	for i := 0; i < l; i++ {
+		wire := r.Intn(4)
+		if wire == 3 {
+			wire = 5
+		}
+		fieldNumber := maxFieldNumber + r.Intn(100)
+		dAtA = randFieldUser(dAtA, r, fieldNumber, wire)
+	}
+	return dAtA
+}
+func randFieldUser(dAtA []byte, r randyUser, fieldNumber int, wire int) []byte {
+	key := uint32(fieldNumber)<<3 | uint32(wire)
+	switch wire {
+	case 0:
+		dAtA = encodeVarintPopulateUser(dAtA, uint64(key))
+		v48 := r.Int63()
+		if r.Intn(2) == 0 {
+			v48 *= -1
+		}
+		dAtA = encodeVarintPopulateUser(dAtA, uint64(v48))
+	case 1:
+		dAtA = encodeVarintPopulateUser(dAtA, uint64(key))
+		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
+	case 2:
+		dAtA = encodeVarintPopulateUser(dAtA, uint64(key))
+		ll := r.Intn(100)
+		dAtA = encodeVarintPopulateUser(dAtA, uint64(ll))
+		for j := 0; j < ll; j++ {
+			dAtA = append(dAtA, byte(r.Intn(256)))
+		}
+	default:
+		dAtA = encodeVarintPopulateUser(dAtA, uint64(key))
+		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
+	}
+	return dAtA
+}
+func encodeVarintPopulateUser(dAtA []byte, v uint64) []byte {
+	for v >= 1<<7 {
+		dAtA = append(dAtA, uint8(v&0x7f|0x80))
+		v >>= 7
+	}
+	dAtA = append(dAtA, uint8(v))
+	return dAtA
+}
+func (m *User) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.UserIdentifiers.Size()
+	n += 1 + l + sovUser(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.CreatedAt)
+	n += 1 + l + sovUser(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.UpdatedAt)
+	n += 1 + l + sovUser(uint64(l))
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovUser(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovUser(uint64(l))
+	}
+	if len(m.Attributes) > 0 {
+		for k, v := range m.Attributes {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovUser(uint64(len(k))) + 1 + len(v) + sovUser(uint64(len(v)))
+			n += mapEntrySize + 1 + sovUser(uint64(mapEntrySize))
+		}
+	}
+	if len(m.ContactInfo) > 0 {
+		for _, e := range m.ContactInfo {
+			l = e.Size()
+			n += 1 + l + sovUser(uint64(l))
+		}
+	}
+	l = len(m.PrimaryEmailAddress)
+	if l > 0 {
+		n += 1 + l + sovUser(uint64(l))
+	}
+	if m.PrimaryEmailAddressValidatedAt != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.PrimaryEmailAddressValidatedAt)
+		n += 1 + l + sovUser(uint64(l))
+	}
+	l = len(m.Password)
+	if l > 0 {
+		n += 1 + l + sovUser(uint64(l))
+	}
+	if m.PasswordUpdatedAt != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.PasswordUpdatedAt)
+		n += 1 + l + sovUser(uint64(l))
+	}
+	if m.RequirePasswordUpdate {
+		n += 2
+	}
+	if m.State != 0 {
+		n += 1 + sovUser(uint64(m.State))
+	}
+	if m.Admin {
+		n += 2
+	}
+	l = len(m.TemporaryPassword)
+	if l > 0 {
+		n += 1 + l + sovUser(uint64(l))
+	}
+	if m.TemporaryPasswordCreatedAt != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.TemporaryPasswordCreatedAt)
+		n += 2 + l + sovUser(uint64(l))
+	}
+	if m.TemporaryPasswordExpiresAt != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.TemporaryPasswordExpiresAt)
+		n += 2 + l + sovUser(uint64(l))
+	}
+	if m.ProfilePicture != nil {
+		l = m.ProfilePicture.Size()
+		n += 2 + l + sovUser(uint64(l))
+	}
+	return n
+}
+
+func (m *Users) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Users) > 0 {
+		for _, e := range m.Users {
+			l = e.Size()
+			n += 1 + l + sovUser(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GetUserRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.UserIdentifiers.Size()
+	n += 1 + l + sovUser(uint64(l))
+	l = m.FieldMask.Size()
+	n += 1 + l + sovUser(uint64(l))
+	return n
+}
+
+func (m *ListUsersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.FieldMask.Size()
+	n += 1 + l + sovUser(uint64(l))
+	l = len(m.Order)
+	if l > 0 {
+		n += 1 + l + sovUser(uint64(l))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovUser(uint64(m.Limit))
+	}
+	if m.Page != 0 {
+		n += 1 + sovUser(uint64(m.Page))
+	}
+	if m.Deleted {
+		n += 2
+	}
+	return n
+}
+
+func (m *CreateUserRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.User.Size()
+	n += 1 + l + sovUser(uint64(l))
+	l = len(m.InvitationToken)
+	if l > 0 {
+		n += 1 + l + sovUser(uint64(l))
+	}
+	return n
+}
+
+func (m *UpdateUserRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.User.Size()
+	n += 1 + l + sovUser(uint64(l))
+	l = m.FieldMask.Size()
+	n += 1 + l + sovUser(uint64(l))
+	return n
+}
+
+func (m *CreateTemporaryPasswordRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.UserIdentifiers.Size()
+	n += 1 + l + sovUser(uint64(l))
+	return n
+}
+
+func (m *UpdateUserPasswordRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.UserIdentifiers.Size()
+	n += 1 + l + sovUser(uint64(l))
+	l = len(m.New)
+	if l > 0 {
+		n += 1 + l + sovUser(uint64(l))
+	}
+	l = len(m.Old)
+	if l > 0 {
+		n += 1 + l + sovUser(uint64(l))
+	}
+	if m.RevokeAllAccess {
+		n += 2
+	}
+	return n
+}
+
+func (m *ListUserAPIKeysRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.UserIdentifiers.Size()
+	n += 1 + l + sovUser(uint64(l))
+	if m.Limit != 0 {
+		n += 1 + sovUser(uint64(m.Limit))
+	}
+	if m.Page != 0 {
+		n += 1 + sovUser(uint64(m.Page))
+	}
+	return n
+}
+
+func (m *GetUserAPIKeyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.UserIdentifiers.Size()
+	n += 1 + l + sovUser(uint64(l))
+	l = len(m.KeyID)
+	if l > 0 {
+		n += 1 + l + sovUser(uint64(l))
+	}
+	return n
+}
+
+func (m *CreateUserAPIKeyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.UserIdentifiers.Size()
+	n += 1 + l + sovUser(uint64(l))
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovUser(uint64(l))
+	}
+	if len(m.Rights) > 0 {
+		l = 0
+		for _, e := range m.Rights {
+			l += sovUser(uint64(e))
+		}
+		n += 1 + sovUser(uint64(l)) + l
+	}
+	if m.ExpiresAt != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpiresAt)
+		n += 1 + l + sovUser(uint64(l))
+	}
+	if m.Restrictions != nil {
+		l = m.Restrictions.Size()
+		n += 1 + l + sovUser(uint64(l))
+	}
+	return n
+}
+
+func (m *UpdateUserAPIKeyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.UserIdentifiers.Size()
+	n += 1 + l + sovUser(uint64(l))
+	l = m.APIKey.Size()
+	n += 1 + l + sovUser(uint64(l))
+	l = m.FieldMask.Size()
+	n += 1 + l + sovUser(uint64(l))
+	return n
+}
+
+func (m *Invitation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Email)
+	if l > 0 {
+		n += 1 + l + sovUser(uint64(l))
+	}
+	l = len(m.Token)
+	if l > 0 {
+		n += 1 + l + sovUser(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.ExpiresAt)
+	n += 1 + l + sovUser(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.CreatedAt)
+	n += 1 + l + sovUser(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.UpdatedAt)
+	n += 1 + l + sovUser(uint64(l))
+	if m.AcceptedAt != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.AcceptedAt)
+		n += 1 + l + sovUser(uint64(l))
+	}
+	if m.AcceptedBy != nil {
+		l = m.AcceptedBy.Size()
+		n += 1 + l + sovUser(uint64(l))
+	}
+	return n
+}
+
+func (m *ListInvitationsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Limit != 0 {
+		n += 1 + sovUser(uint64(m.Limit))
+	}
+	if m.Page != 0 {
+		n += 1 + sovUser(uint64(m.Page))
+	}
+	return n
+}
+
+func (m *Invitations) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Invitations) > 0 {
+		for _, e := range m.Invitations {
+			l = e.Size()
+			n += 1 + l + sovUser(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SendInvitationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Email)
+	if l > 0 {
+		n += 1 + l + sovUser(uint64(l))
+	}
+	return n
+}
+
+func (m *DeleteInvitationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Email)
+	if l > 0 {
+		n += 1 + l + sovUser(uint64(l))
+	}
+	return n
+}
+
+func (m *UserSessionIdentifiers) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.UserIdentifiers.Size()
+	n += 1 + l + sovUser(uint64(l))
+	l = len(m.SessionID)
+	if l > 0 {
+		n += 1 + l + sovUser(uint64(l))
+	}
+	return n
+}
+
+func (m *UserSession) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.UserIdentifiers.Size()
+	n += 1 + l + sovUser(uint64(l))
+	l = len(m.SessionID)
+	if l > 0 {
+		n += 1 + l + sovUser(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.CreatedAt)
+	n += 1 + l + sovUser(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.UpdatedAt)
+	n += 1 + l + sovUser(uint64(l))
+	if m.ExpiresAt != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpiresAt)
+		n += 1 + l + sovUser(uint64(l))
+	}
+	return n
+}
+
+func (m *UserSessions) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Sessions) > 0 {
+		for _, e := range m.Sessions {
+			l = e.Size()
+			n += 1 + l + sovUser(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ListUserSessionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.UserIdentifiers.Size()
+	n += 1 + l + sovUser(uint64(l))
+	l = len(m.Order)
+	if l > 0 {
+		n += 1 + l + sovUser(uint64(l))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovUser(uint64(m.Limit))
+	}
+	if m.Page != 0 {
+		n += 1 + sovUser(uint64(m.Page))
+	}
+	return n
+}
+
+func (m *UserTOTPStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Enabled {
+		n += 2
+	}
+	if m.EnabledAt != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.EnabledAt)
+		n += 1 + l + sovUser(uint64(l))
+	}
+	if m.RecoveryCodesRemaining != 0 {
+		n += 1 + sovUser(uint64(m.RecoveryCodesRemaining))
+	}
+	return n
+}
+
+func (m *UserTOTPEnrollment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Secret)
+	if l > 0 {
+		n += 1 + l + sovUser(uint64(l))
+	}
+	l = len(m.URI)
+	if l > 0 {
+		n += 1 + l + sovUser(uint64(l))
+	}
+	return n
+}
+
+func (m *EnableUserTOTPRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.UserIdentifiers.Size()
+	n += 1 + l + sovUser(uint64(l))
+	l = len(m.Code)
+	if l > 0 {
+		n += 1 + l + sovUser(uint64(l))
+	}
+	return n
+}
+
+func (m *DisableUserTOTPRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.UserIdentifiers.Size()
+	n += 1 + l + sovUser(uint64(l))
+	l = len(m.Code)
+	if l > 0 {
+		n += 1 + l + sovUser(uint64(l))
+	}
+	return n
+}
+
+func (m *GenerateUserRecoveryCodesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.UserIdentifiers.Size()
+	n += 1 + l + sovUser(uint64(l))
+	l = len(m.Code)
+	if l > 0 {
+		n += 1 + l + sovUser(uint64(l))
+	}
+	return n
+}
+
+func (m *UserRecoveryCodes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Codes) > 0 {
+		for _, s := range m.Codes {
+			l = len(s)
+			n += 1 + l + sovUser(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Notification) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovUser(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.CreatedAt)
+	n += 1 + l + sovUser(uint64(l))
+	if m.NotificationType != 0 {
+		n += 1 + sovUser(uint64(m.NotificationType))
+	}
+	l = m.EntityIDs.Size()
+	n += 1 + l + sovUser(uint64(l))
+	if m.SenderIDs != nil {
+		l = m.SenderIDs.Size()
+		n += 1 + l + sovUser(uint64(l))
+	}
+	l = len(m.Subject)
+	if l > 0 {
+		n += 1 + l + sovUser(uint64(l))
+	}
+	if len(m.Rights) > 0 {
+		l = 0
+		for _, e := range m.Rights {
+			l += sovUser(uint64(e))
+		}
+		n += 1 + sovUser(uint64(l)) + l
+	}
+	if m.ReadAt != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.ReadAt)
+		n += 1 + l + sovUser(uint64(l))
+	}
+	return n
+}
+
+func (m *Notifications) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Notifications) > 0 {
+		for _, e := range m.Notifications {
+			l = e.Size()
+			n += 1 + l + sovUser(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CreateNotificationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Notification.Size()
+	n += 1 + l + sovUser(uint64(l))
+	return n
+}
+
+func (m *ListNotificationsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.UserIdentifiers.Size()
+	n += 1 + l + sovUser(uint64(l))
+	if m.UnreadOnly {
+		n += 2
+	}
+	if m.Limit != 0 {
+		n += 1 + sovUser(uint64(m.Limit))
+	}
+	if m.Page != 0 {
+		n += 1 + sovUser(uint64(m.Page))
+	}
+	return n
+}
+
+func (m *MarkNotificationsReadRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.UserIdentifiers.Size()
+	n += 1 + l + sovUser(uint64(l))
+	if len(m.IDs) > 0 {
+		for _, s := range m.IDs {
+			l = len(s)
+			n += 1 + l + sovUser(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *NotificationPreference) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NotificationType != 0 {
+		n += 1 + sovUser(uint64(m.NotificationType))
+	}
+	if m.Subscribed {
+		n += 2
+	}
+	if m.Email {
+		n += 2
+	}
+	return n
+}
+
+func (m *NotificationPreferences) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Preferences) > 0 {
+		for _, e := range m.Preferences {
+			l = e.Size()
+			n += 1 + l + sovUser(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SetNotificationPreferencesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.UserIdentifiers.Size()
+	n += 1 + l + sovUser(uint64(l))
+	if len(m.Preferences) > 0 {
+		for _, e := range m.Preferences {
+			l = e.Size()
+			n += 1 + l + sovUser(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovUser(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozUser(x uint64) (n int) {
+	return sovUser((x << 1) ^ uint64((int64(x) >> 63)))
+}
+func (this *User) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForContactInfo := "[]*ContactInfo{"
+	for _, f := range this.ContactInfo {
+		repeatedStringForContactInfo += strings.Replace(fmt.Sprintf("%v", f), "ContactInfo", "ContactInfo", 1) + ","
+	}
+	repeatedStringForContactInfo += "}"
+	keysForAttributes := make([]string, 0, len(this.Attributes))
+	for k := range this.Attributes {
+		keysForAttributes = append(keysForAttributes, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForAttributes)
+	mapStringForAttributes := "map[string]string{"
+	for _, k := range keysForAttributes {
+		mapStringForAttributes += fmt.Sprintf("%v: %v,", k, this.Attributes[k])
+	}
+	mapStringForAttributes += "}"
+	s := strings.Join([]string{`&User{`,
+		`UserIdentifiers:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.UserIdentifiers), "UserIdentifiers", "UserIdentifiers", 1), `&`, ``, 1) + `,`,
+		`CreatedAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.CreatedAt), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
+		`UpdatedAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.UpdatedAt), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Description:` + fmt.Sprintf("%v", this.Description) + `,`,
+		`Attributes:` + mapStringForAttributes + `,`,
+		`ContactInfo:` + repeatedStringForContactInfo + `,`,
+		`PrimaryEmailAddress:` + fmt.Sprintf("%v", this.PrimaryEmailAddress) + `,`,
+		`PrimaryEmailAddressValidatedAt:` + strings.Replace(fmt.Sprintf("%v", this.PrimaryEmailAddressValidatedAt), "Timestamp", "types.Timestamp", 1) + `,`,
+		`Password:` + fmt.Sprintf("%v", this.Password) + `,`,
+		`PasswordUpdatedAt:` + strings.Replace(fmt.Sprintf("%v", this.PasswordUpdatedAt), "Timestamp", "types.Timestamp", 1) + `,`,
+		`RequirePasswordUpdate:` + fmt.Sprintf("%v", this.RequirePasswordUpdate) + `,`,
+		`State:` + fmt.Sprintf("%v", this.State) + `,`,
+		`Admin:` + fmt.Sprintf("%v", this.Admin) + `,`,
+		`TemporaryPassword:` + fmt.Sprintf("%v", this.TemporaryPassword) + `,`,
+		`TemporaryPasswordCreatedAt:` + strings.Replace(fmt.Sprintf("%v", this.TemporaryPasswordCreatedAt), "Timestamp", "types.Timestamp", 1) + `,`,
+		`TemporaryPasswordExpiresAt:` + strings.Replace(fmt.Sprintf("%v", this.TemporaryPasswordExpiresAt), "Timestamp", "types.Timestamp", 1) + `,`,
+		`ProfilePicture:` + strings.Replace(fmt.Sprintf("%v", this.ProfilePicture), "Picture", "Picture", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Users) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForUsers := "[]*User{"
+	for _, f := range this.Users {
+		repeatedStringForUsers += strings.Replace(f.String(), "User", "User", 1) + ","
+	}
+	repeatedStringForUsers += "}"
+	s := strings.Join([]string{`&Users{`,
+		`Users:` + repeatedStringForUsers + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetUserRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetUserRequest{`,
+		`UserIdentifiers:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.UserIdentifiers), "UserIdentifiers", "UserIdentifiers", 1), `&`, ``, 1) + `,`,
+		`FieldMask:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.FieldMask), "FieldMask", "types.FieldMask", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ListUsersRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ListUsersRequest{`,
+		`FieldMask:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.FieldMask), "FieldMask", "types.FieldMask", 1), `&`, ``, 1) + `,`,
+		`Order:` + fmt.Sprintf("%v", this.Order) + `,`,
+		`Limit:` + fmt.Sprintf("%v", this.Limit) + `,`,
+		`Page:` + fmt.Sprintf("%v", this.Page) + `,`,
+		`Deleted:` + fmt.Sprintf("%v", this.Deleted) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CreateUserRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CreateUserRequest{`,
+		`User:` + strings.Replace(strings.Replace(this.User.String(), "User", "User", 1), `&`, ``, 1) + `,`,
+		`InvitationToken:` + fmt.Sprintf("%v", this.InvitationToken) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *UpdateUserRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&UpdateUserRequest{`,
+		`User:` + strings.Replace(strings.Replace(this.User.String(), "User", "User", 1), `&`, ``, 1) + `,`,
+		`FieldMask:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.FieldMask), "FieldMask", "types.FieldMask", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CreateTemporaryPasswordRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CreateTemporaryPasswordRequest{`,
+		`UserIdentifiers:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.UserIdentifiers), "UserIdentifiers", "UserIdentifiers", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *UpdateUserPasswordRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&UpdateUserPasswordRequest{`,
+		`UserIdentifiers:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.UserIdentifiers), "UserIdentifiers", "UserIdentifiers", 1), `&`, ``, 1) + `,`,
+		`New:` + fmt.Sprintf("%v", this.New) + `,`,
+		`Old:` + fmt.Sprintf("%v", this.Old) + `,`,
+		`RevokeAllAccess:` + fmt.Sprintf("%v", this.RevokeAllAccess) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ListUserAPIKeysRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ListUserAPIKeysRequest{`,
+		`UserIdentifiers:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.UserIdentifiers), "UserIdentifiers", "UserIdentifiers", 1), `&`, ``, 1) + `,`,
+		`Limit:` + fmt.Sprintf("%v", this.Limit) + `,`,
+		`Page:` + fmt.Sprintf("%v", this.Page) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetUserAPIKeyRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetUserAPIKeyRequest{`,
+		`UserIdentifiers:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.UserIdentifiers), "UserIdentifiers", "UserIdentifiers", 1), `&`, ``, 1) + `,`,
+		`KeyID:` + fmt.Sprintf("%v", this.KeyID) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CreateUserAPIKeyRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CreateUserAPIKeyRequest{`,
+		`UserIdentifiers:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.UserIdentifiers), "UserIdentifiers", "UserIdentifiers", 1), `&`, ``, 1) + `,`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Rights:` + fmt.Sprintf("%v", this.Rights) + `,`,
+		`ExpiresAt:` + strings.Replace(fmt.Sprintf("%v", this.ExpiresAt), "Timestamp", "types.Timestamp", 1) + `,`,
+		`Restrictions:` + strings.Replace(fmt.Sprintf("%v", this.Restrictions), "APIKeyRestrictions", "APIKeyRestrictions", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *UpdateUserAPIKeyRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&UpdateUserAPIKeyRequest{`,
+		`UserIdentifiers:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.UserIdentifiers), "UserIdentifiers", "UserIdentifiers", 1), `&`, ``, 1) + `,`,
+		`APIKey:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.APIKey), "APIKey", "APIKey", 1), `&`, ``, 1) + `,`,
+		`FieldMask:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.FieldMask), "FieldMask", "types.FieldMask", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Invitation) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Invitation{`,
+		`Email:` + fmt.Sprintf("%v", this.Email) + `,`,
+		`Token:` + fmt.Sprintf("%v", this.Token) + `,`,
+		`ExpiresAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ExpiresAt), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
+		`CreatedAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.CreatedAt), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
+		`UpdatedAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.UpdatedAt), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
+		`AcceptedAt:` + strings.Replace(fmt.Sprintf("%v", this.AcceptedAt), "Timestamp", "types.Timestamp", 1) + `,`,
+		`AcceptedBy:` + strings.Replace(fmt.Sprintf("%v", this.AcceptedBy), "UserIdentifiers", "UserIdentifiers", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ListInvitationsRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ListInvitationsRequest{`,
+		`Limit:` + fmt.Sprintf("%v", this.Limit) + `,`,
+		`Page:` + fmt.Sprintf("%v", this.Page) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Invitations) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForInvitations := "[]*Invitation{"
+	for _, f := range this.Invitations {
+		repeatedStringForInvitations += strings.Replace(f.String(), "Invitation", "Invitation", 1) + ","
+	}
+	repeatedStringForInvitations += "}"
+	s := strings.Join([]string{`&Invitations{`,
+		`Invitations:` + repeatedStringForInvitations + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SendInvitationRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SendInvitationRequest{`,
+		`Email:` + fmt.Sprintf("%v", this.Email) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DeleteInvitationRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DeleteInvitationRequest{`,
+		`Email:` + fmt.Sprintf("%v", this.Email) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *UserSessionIdentifiers) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&UserSessionIdentifiers{`,
+		`UserIdentifiers:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.UserIdentifiers), "UserIdentifiers", "UserIdentifiers", 1), `&`, ``, 1) + `,`,
+		`SessionID:` + fmt.Sprintf("%v", this.SessionID) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *UserSession) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&UserSession{`,
+		`UserIdentifiers:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.UserIdentifiers), "UserIdentifiers", "UserIdentifiers", 1), `&`, ``, 1) + `,`,
+		`SessionID:` + fmt.Sprintf("%v", this.SessionID) + `,`,
+		`CreatedAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.CreatedAt), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
+		`UpdatedAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.UpdatedAt), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
+		`ExpiresAt:` + strings.Replace(fmt.Sprintf("%v", this.ExpiresAt), "Timestamp", "types.Timestamp", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *UserSessions) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForSessions := "[]*UserSession{"
+	for _, f := range this.Sessions {
+		repeatedStringForSessions += strings.Replace(f.String(), "UserSession", "UserSession", 1) + ","
+	}
+	repeatedStringForSessions += "}"
+	s := strings.Join([]string{`&UserSessions{`,
+		`Sessions:` + repeatedStringForSessions + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ListUserSessionsRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ListUserSessionsRequest{`,
+		`UserIdentifiers:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.UserIdentifiers), "UserIdentifiers", "UserIdentifiers", 1), `&`, ``, 1) + `,`,
+		`Order:` + fmt.Sprintf("%v", this.Order) + `,`,
+		`Limit:` + fmt.Sprintf("%v", this.Limit) + `,`,
+		`Page:` + fmt.Sprintf("%v", this.Page) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *UserTOTPStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&UserTOTPStatus{`,
+		`Enabled:` + fmt.Sprintf("%v", this.Enabled) + `,`,
+		`EnabledAt:` + strings.Replace(fmt.Sprintf("%v", this.EnabledAt), "Timestamp", "types.Timestamp", 1) + `,`,
+		`RecoveryCodesRemaining:` + fmt.Sprintf("%v", this.RecoveryCodesRemaining) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *UserTOTPEnrollment) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&UserTOTPEnrollment{`,
+		`Secret:` + fmt.Sprintf("%v", this.Secret) + `,`,
+		`URI:` + fmt.Sprintf("%v", this.URI) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EnableUserTOTPRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EnableUserTOTPRequest{`,
+		`UserIdentifiers:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.UserIdentifiers), "UserIdentifiers", "UserIdentifiers", 1), `&`, ``, 1) + `,`,
+		`Code:` + fmt.Sprintf("%v", this.Code) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DisableUserTOTPRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DisableUserTOTPRequest{`,
+		`UserIdentifiers:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.UserIdentifiers), "UserIdentifiers", "UserIdentifiers", 1), `&`, ``, 1) + `,`,
+		`Code:` + fmt.Sprintf("%v", this.Code) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GenerateUserRecoveryCodesRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GenerateUserRecoveryCodesRequest{`,
+		`UserIdentifiers:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.UserIdentifiers), "UserIdentifiers", "UserIdentifiers", 1), `&`, ``, 1) + `,`,
+		`Code:` + fmt.Sprintf("%v", this.Code) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *UserRecoveryCodes) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&UserRecoveryCodes{`,
+		`Codes:` + fmt.Sprintf("%v", this.Codes) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Notification) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Notification{`,
+		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
+		`CreatedAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.CreatedAt), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
+		`NotificationType:` + fmt.Sprintf("%v", this.NotificationType) + `,`,
+		`EntityIDs:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.EntityIDs), "EntityIdentifiers", "EntityIdentifiers", 1), `&`, ``, 1) + `,`,
+		`SenderIDs:` + strings.Replace(fmt.Sprintf("%v", this.SenderIDs), "UserIdentifiers", "UserIdentifiers", 1) + `,`,
+		`Subject:` + fmt.Sprintf("%v", this.Subject) + `,`,
+		`Rights:` + fmt.Sprintf("%v", this.Rights) + `,`,
+		`ReadAt:` + strings.Replace(fmt.Sprintf("%v", this.ReadAt), "Timestamp", "types.Timestamp", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Notifications) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForNotifications := "[]*Notification{"
+	for _, f := range this.Notifications {
+		repeatedStringForNotifications += strings.Replace(f.String(), "Notification", "Notification", 1) + ","
+	}
+	repeatedStringForNotifications += "}"
+	s := strings.Join([]string{`&Notifications{`,
+		`Notifications:` + repeatedStringForNotifications + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CreateNotificationRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CreateNotificationRequest{`,
+		`Notification:` + strings.Replace(strings.Replace(this.Notification.String(), "Notification", "Notification", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ListNotificationsRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ListNotificationsRequest{`,
+		`UserIdentifiers:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.UserIdentifiers), "UserIdentifiers", "UserIdentifiers", 1), `&`, ``, 1) + `,`,
+		`UnreadOnly:` + fmt.Sprintf("%v", this.UnreadOnly) + `,`,
+		`Limit:` + fmt.Sprintf("%v", this.Limit) + `,`,
+		`Page:` + fmt.Sprintf("%v", this.Page) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MarkNotificationsReadRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MarkNotificationsReadRequest{`,
+		`UserIdentifiers:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.UserIdentifiers), "UserIdentifiers", "UserIdentifiers", 1), `&`, ``, 1) + `,`,
+		`IDs:` + fmt.Sprintf("%v", this.IDs) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *NotificationPreference) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&NotificationPreference{`,
+		`NotificationType:` + fmt.Sprintf("%v", this.NotificationType) + `,`,
+		`Subscribed:` + fmt.Sprintf("%v", this.Subscribed) + `,`,
+		`Email:` + fmt.Sprintf("%v", this.Email) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *NotificationPreferences) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForPreferences := "[]*NotificationPreference{"
+	for _, f := range this.Preferences {
+		repeatedStringForPreferences += strings.Replace(f.String(), "NotificationPreference", "NotificationPreference", 1) + ","
+	}
+	repeatedStringForPreferences += "}"
+	s := strings.Join([]string{`&NotificationPreferences{`,
+		`Preferences:` + repeatedStringForPreferences + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SetNotificationPreferencesRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForPreferences := "[]*NotificationPreference{"
+	for _, f := range this.Preferences {
+		repeatedStringForPreferences += strings.Replace(f.String(), "NotificationPreference", "NotificationPreference", 1) + ","
+	}
+	repeatedStringForPreferences += "}"
+	s := strings.Join([]string{`&SetNotificationPreferencesRequest{`,
+		`UserIdentifiers:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.UserIdentifiers), "UserIdentifiers", "UserIdentifiers", 1), `&`, ``, 1) + `,`,
+		`Preferences:` + repeatedStringForPreferences + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringUser(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *User) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowUser
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: User: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: User: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserIdentifiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthUser
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthUser
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UserIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthUser
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthUser
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CreatedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthUser
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthUser
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.UpdatedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUser
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUser
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUser
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUser
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attributes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthUser
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthUser
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Attributes == nil {
+				m.Attributes = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowUser
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowUser
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthUser
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthUser
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowUser
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthUser
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthUser
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipUser(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthUser
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Attributes[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContactInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthUser
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthUser
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContactInfo = append(m.ContactInfo, &ContactInfo{})
+			if err := m.ContactInfo[len(m.ContactInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrimaryEmailAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUser
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUser
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PrimaryEmailAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrimaryEmailAddressValidatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthUser
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthUser
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PrimaryEmailAddressValidatedAt == nil {
+				m.PrimaryEmailAddressValidatedAt = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.PrimaryEmailAddressValidatedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUser
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUser
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Password = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PasswordUpdatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthUser
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthUser
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PasswordUpdatedAt == nil {
+				m.PasswordUpdatedAt = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.PasswordUpdatedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequirePasswordUpdate", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RequirePasswordUpdate = bool(v != 0)
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.State |= State(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Admin = bool(v != 0)
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TemporaryPassword", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUser
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUser
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TemporaryPassword = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TemporaryPasswordCreatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthUser
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthUser
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TemporaryPasswordCreatedAt == nil {
+				m.TemporaryPasswordCreatedAt = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.TemporaryPasswordCreatedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TemporaryPasswordExpiresAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthUser
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthUser
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TemporaryPasswordExpiresAt == nil {
+				m.TemporaryPasswordExpiresAt = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.TemporaryPasswordExpiresAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProfilePicture", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthUser
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthUser
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ProfilePicture == nil {
+				m.ProfilePicture = &Picture{}
+			}
+			if err := m.ProfilePicture.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipUser(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthUser
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthUser
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Users) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowUser
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Users: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Users: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Users", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthUser
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthUser
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Users = append(m.Users, &User{})
+			if err := m.Users[len(m.Users)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipUser(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthUser
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthUser
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetUserRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowUser
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetUserRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetUserRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserIdentifiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthUser
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthUser
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UserIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FieldMask", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthUser
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthUser
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FieldMask.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipUser(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthUser
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthUser
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListUsersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowUser
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListUsersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListUsersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FieldMask", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthUser
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthUser
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FieldMask.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Order", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUser
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUser
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Order = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Page", wireType)
+			}
+			m.Page = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Page |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deleted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Deleted = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipUser(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthUser
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthUser
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CreateUserRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowUser
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreateUserRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreateUserRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthUser
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthUser
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.User.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InvitationToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUser
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUser
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InvitationToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipUser(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthUser
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthUser
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateUserRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowUser
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateUserRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateUserRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthUser
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthUser
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.User.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FieldMask", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthUser
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthUser
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FieldMask.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipUser(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthUser
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthUser
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CreateTemporaryPasswordRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowUser
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreateTemporaryPasswordRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreateTemporaryPasswordRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserIdentifiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthUser
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthUser
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UserIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipUser(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthUser
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthUser
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateUserPasswordRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowUser
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateUserPasswordRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateUserPasswordRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserIdentifiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthUser
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthUser
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UserIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field New", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUser
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUser
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.New = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Old", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUser
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUser
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Old = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevokeAllAccess", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RevokeAllAccess = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipUser(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthUser
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthUser
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListUserAPIKeysRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowUser
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListUserAPIKeysRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListUserAPIKeysRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserIdentifiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthUser
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthUser
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UserIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Page", wireType)
+			}
+			m.Page = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Page |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipUser(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthUser
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthUser
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	repeatedStringForSessions := "[]*UserSession{"
-	for _, f := range this.Sessions {
-		repeatedStringForSessions += strings.Replace(f.String(), "UserSession", "UserSession", 1) + ","
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	repeatedStringForSessions += "}"
-	s := strings.Join([]string{`&UserSessions{`,
-		`Sessions:` + repeatedStringForSessions + `,`,
-		`}`,
-	}, "")
-	return s
+	return nil
 }
-func (this *ListUserSessionsRequest) String() string {
-	if this == nil {
-		return "nil"
+func (m *GetUserAPIKeyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowUser
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetUserAPIKeyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetUserAPIKeyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserIdentifiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthUser
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthUser
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UserIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUser
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUser
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipUser(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthUser
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthUser
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	s := strings.Join([]string{`&ListUserSessionsRequest{`,
-		`UserIdentifiers:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.UserIdentifiers), "UserIdentifiers", "UserIdentifiers", 1), `&`, ``, 1) + `,`,
-		`Order:` + fmt.Sprintf("%v", this.Order) + `,`,
-		`Limit:` + fmt.Sprintf("%v", this.Limit) + `,`,
-		`Page:` + fmt.Sprintf("%v", this.Page) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func valueToStringUser(v interface{}) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
-		return "nil"
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("*%v", pv)
+	return nil
 }
-func (m *User) Unmarshal(dAtA []byte) error {
+func (m *CreateUserAPIKeyRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4344,10 +8856,10 @@ func (m *User) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: User: wiretype end group for non-group")
+			return fmt.Errorf("proto: CreateUserAPIKeyRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: User: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CreateUserAPIKeyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4369,23 +8881,124 @@ func (m *User) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthUser
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthUser
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.UserIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
+			if msglen < 0 {
+				return ErrInvalidLengthUser
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthUser
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UserIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUser
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUser
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType == 0 {
+				var v Right
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowUser
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= Right(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Rights = append(m.Rights, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowUser
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthUser
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthUser
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.Rights) == 0 {
+					m.Rights = make([]Right, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v Right
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowUser
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= Right(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Rights = append(m.Rights, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rights", wireType)
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4412,13 +9025,16 @@ func (m *User) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CreatedAt, dAtA[iNdEx:postIndex]); err != nil {
+			if m.ExpiresAt == nil {
+				m.ExpiresAt = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.ExpiresAt, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Restrictions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4445,77 +9061,69 @@ func (m *User) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.UpdatedAt, dAtA[iNdEx:postIndex]); err != nil {
+			if m.Restrictions == nil {
+				m.Restrictions = &APIKeyRestrictions{}
+			}
+			if err := m.Restrictions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowUser
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipUser(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthUser
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthUser
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowUser
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthUser
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthUser
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateUserAPIKeyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowUser
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateUserAPIKeyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateUserAPIKeyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Attributes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UserIdentifiers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4532,117 +9140,23 @@ func (m *User) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthUser
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthUser
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Attributes == nil {
-				m.Attributes = make(map[string]string)
-			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowUser
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowUser
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthUser
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthUser
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowUser
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthUser
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthUser
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipUser(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if skippy < 0 {
-						return ErrInvalidLengthUser
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.Attributes[mapkey] = mapvalue
+			if msglen < 0 {
+				return ErrInvalidLengthUser
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthUser
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UserIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContactInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field APIKey", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4669,16 +9183,15 @@ func (m *User) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContactInfo = append(m.ContactInfo, &ContactInfo{})
-			if err := m.ContactInfo[len(m.ContactInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.APIKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PrimaryEmailAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FieldMask", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowUser
@@ -4688,29 +9201,83 @@ func (m *User) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthUser
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthUser
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PrimaryEmailAddress = string(dAtA[iNdEx:postIndex])
+			if err := m.FieldMask.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 9:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipUser(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthUser
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthUser
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Invitation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowUser
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Invitation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Invitation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PrimaryEmailAddressValidatedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Email", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowUser
@@ -4720,31 +9287,27 @@ func (m *User) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthUser
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthUser
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PrimaryEmailAddressValidatedAt == nil {
-				m.PrimaryEmailAddressValidatedAt = new(time.Time)
-			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.PrimaryEmailAddressValidatedAt, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Email = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4772,11 +9335,11 @@ func (m *User) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Password = string(dAtA[iNdEx:postIndex])
+			m.Token = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 11:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PasswordUpdatedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4803,18 +9366,15 @@ func (m *User) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PasswordUpdatedAt == nil {
-				m.PasswordUpdatedAt = new(time.Time)
-			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.PasswordUpdatedAt, dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.ExpiresAt, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 12:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequirePasswordUpdate", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowUser
@@ -4824,17 +9384,30 @@ func (m *User) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.RequirePasswordUpdate = bool(v != 0)
-		case 13:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthUser
 			}
-			m.State = 0
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthUser
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CreatedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowUser
@@ -4844,16 +9417,30 @@ func (m *User) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.State |= State(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 14:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthUser
 			}
-			var v int
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthUser
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.UpdatedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AcceptedAt", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowUser
@@ -4863,17 +9450,33 @@ func (m *User) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Admin = bool(v != 0)
-		case 15:
+			if msglen < 0 {
+				return ErrInvalidLengthUser
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthUser
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AcceptedAt == nil {
+				m.AcceptedAt = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.AcceptedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TemporaryPassword", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AcceptedBy", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowUser
@@ -4883,29 +9486,86 @@ func (m *User) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthUser
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthUser
 			}
-			if postIndex > l {
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AcceptedBy == nil {
+				m.AcceptedBy = &UserIdentifiers{}
+			}
+			if err := m.AcceptedBy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipUser(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthUser
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthUser
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListInvitationsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowUser
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TemporaryPassword = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 16:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TemporaryPasswordCreatedAt", wireType)
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			var msglen int
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListInvitationsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListInvitationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowUser
@@ -4915,33 +9575,16 @@ func (m *User) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Limit |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthUser
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthUser
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.TemporaryPasswordCreatedAt == nil {
-				m.TemporaryPasswordCreatedAt = new(time.Time)
-			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.TemporaryPasswordCreatedAt, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 17:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TemporaryPasswordExpiresAt", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Page", wireType)
 			}
-			var msglen int
+			m.Page = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowUser
@@ -4951,31 +9594,67 @@ func (m *User) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Page |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipUser(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthUser
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthUser
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TemporaryPasswordExpiresAt == nil {
-				m.TemporaryPasswordExpiresAt = new(time.Time)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Invitations) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowUser
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.TemporaryPasswordExpiresAt, dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 18:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Invitations: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Invitations: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProfilePicture", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Invitations", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5002,10 +9681,8 @@ func (m *User) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ProfilePicture == nil {
-				m.ProfilePicture = &Picture{}
-			}
-			if err := m.ProfilePicture.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Invitations = append(m.Invitations, &Invitation{})
+			if err := m.Invitations[len(m.Invitations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5033,7 +9710,7 @@ func (m *User) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Users) Unmarshal(dAtA []byte) error {
+func (m *SendInvitationRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5056,17 +9733,17 @@ func (m *Users) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Users: wiretype end group for non-group")
+			return fmt.Errorf("proto: SendInvitationRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Users: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SendInvitationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Users", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Email", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowUser
@@ -5076,25 +9753,23 @@ func (m *Users) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthUser
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthUser
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Users = append(m.Users, &User{})
-			if err := m.Users[len(m.Users)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Email = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5120,7 +9795,7 @@ func (m *Users) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetUserRequest) Unmarshal(dAtA []byte) error {
+func (m *DeleteInvitationRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5143,50 +9818,17 @@ func (m *GetUserRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetUserRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeleteInvitationRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetUserRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeleteInvitationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UserIdentifiers", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowUser
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthUser
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthUser
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.UserIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FieldMask", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Email", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowUser
@@ -5196,24 +9838,23 @@ func (m *GetUserRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthUser
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthUser
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.FieldMask.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Email = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5239,7 +9880,7 @@ func (m *GetUserRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListUsersRequest) Unmarshal(dAtA []byte) error {
+func (m *UserSessionIdentifiers) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5262,15 +9903,15 @@ func (m *ListUsersRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListUsersRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: UserSessionIdentifiers: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListUsersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UserSessionIdentifiers: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FieldMask", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UserIdentifiers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5297,13 +9938,13 @@ func (m *ListUsersRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.FieldMask.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.UserIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Order", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5328,49 +9969,11 @@ func (m *ListUsersRequest) Unmarshal(dAtA []byte) error {
 			if postIndex < 0 {
 				return ErrInvalidLengthUser
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Order = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
-			}
-			m.Limit = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowUser
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Limit |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Page", wireType)
-			}
-			m.Page = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowUser
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Page |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SessionID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipUser(dAtA[iNdEx:])
@@ -5395,7 +9998,7 @@ func (m *ListUsersRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CreateUserRequest) Unmarshal(dAtA []byte) error {
+func (m *UserSession) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5418,15 +10021,15 @@ func (m *CreateUserRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CreateUserRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: UserSession: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CreateUserRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UserSession: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UserIdentifiers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5453,13 +10056,13 @@ func (m *CreateUserRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.User.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.UserIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InvitationToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5487,64 +10090,44 @@ func (m *CreateUserRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.InvitationToken = string(dAtA[iNdEx:postIndex])
+			m.SessionID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipUser(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthUser
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthUser
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *UpdateUserRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowUser
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthUser
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CreatedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: UpdateUserRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpdateUserRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5571,13 +10154,13 @@ func (m *UpdateUserRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.User.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.UpdatedAt, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FieldMask", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5604,7 +10187,10 @@ func (m *UpdateUserRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.FieldMask.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.ExpiresAt == nil {
+				m.ExpiresAt = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.ExpiresAt, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5632,7 +10218,7 @@ func (m *UpdateUserRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CreateTemporaryPasswordRequest) Unmarshal(dAtA []byte) error {
+func (m *UserSessions) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5655,15 +10241,15 @@ func (m *CreateTemporaryPasswordRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CreateTemporaryPasswordRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: UserSessions: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CreateTemporaryPasswordRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UserSessions: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UserIdentifiers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sessions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5690,7 +10276,8 @@ func (m *CreateTemporaryPasswordRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.UserIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Sessions = append(m.Sessions, &UserSession{})
+			if err := m.Sessions[len(m.Sessions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5718,7 +10305,7 @@ func (m *CreateTemporaryPasswordRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UpdateUserPasswordRequest) Unmarshal(dAtA []byte) error {
+func (m *ListUserSessionsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5741,10 +10328,10 @@ func (m *UpdateUserPasswordRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UpdateUserPasswordRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListUserSessionsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpdateUserPasswordRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListUserSessionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5782,7 +10369,7 @@ func (m *UpdateUserPasswordRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field New", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Order", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5810,13 +10397,124 @@ func (m *UpdateUserPasswordRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.New = string(dAtA[iNdEx:postIndex])
+			m.Order = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Page", wireType)
+			}
+			m.Page = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Page |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipUser(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthUser
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthUser
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UserTOTPStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowUser
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UserTOTPStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UserTOTPStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Old", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EnabledAt", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowUser
@@ -5826,29 +10524,33 @@ func (m *UpdateUserPasswordRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthUser
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthUser
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Old = string(dAtA[iNdEx:postIndex])
+			if m.EnabledAt == nil {
+				m.EnabledAt = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.EnabledAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RevokeAllAccess", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RecoveryCodesRemaining", wireType)
 			}
-			var v int
+			m.RecoveryCodesRemaining = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowUser
@@ -5858,12 +10560,11 @@ func (m *UpdateUserPasswordRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.RecoveryCodesRemaining |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.RevokeAllAccess = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipUser(dAtA[iNdEx:])
@@ -5888,7 +10589,7 @@ func (m *UpdateUserPasswordRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListUserAPIKeysRequest) Unmarshal(dAtA []byte) error {
+func (m *UserTOTPEnrollment) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5911,17 +10612,17 @@ func (m *ListUserAPIKeysRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListUserAPIKeysRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: UserTOTPEnrollment: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListUserAPIKeysRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UserTOTPEnrollment: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UserIdentifiers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Secret", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowUser
@@ -5931,30 +10632,29 @@ func (m *ListUserAPIKeysRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthUser
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthUser
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.UserIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Secret = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field URI", wireType)
 			}
-			m.Limit = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowUser
@@ -5964,30 +10664,24 @@ func (m *ListUserAPIKeysRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Limit |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Page", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUser
 			}
-			m.Page = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowUser
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Page |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUser
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.URI = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipUser(dAtA[iNdEx:])
@@ -6012,7 +10706,7 @@ func (m *ListUserAPIKeysRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetUserAPIKeyRequest) Unmarshal(dAtA []byte) error {
+func (m *EnableUserTOTPRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6035,10 +10729,10 @@ func (m *GetUserAPIKeyRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetUserAPIKeyRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: EnableUserTOTPRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetUserAPIKeyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EnableUserTOTPRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6076,7 +10770,7 @@ func (m *GetUserAPIKeyRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeyID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6104,7 +10798,7 @@ func (m *GetUserAPIKeyRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.KeyID = string(dAtA[iNdEx:postIndex])
+			m.Code = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6130,7 +10824,7 @@ func (m *GetUserAPIKeyRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CreateUserAPIKeyRequest) Unmarshal(dAtA []byte) error {
+func (m *DisableUserTOTPRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6153,10 +10847,10 @@ func (m *CreateUserAPIKeyRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CreateUserAPIKeyRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DisableUserTOTPRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CreateUserAPIKeyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DisableUserTOTPRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6194,7 +10888,7 @@ func (m *CreateUserAPIKeyRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6222,77 +10916,8 @@ func (m *CreateUserAPIKeyRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Code = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType == 0 {
-				var v Right
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowUser
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= Right(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.Rights = append(m.Rights, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowUser
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthUser
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthUser
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				if elementCount != 0 && len(m.Rights) == 0 {
-					m.Rights = make([]Right, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v Right
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowUser
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= Right(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.Rights = append(m.Rights, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rights", wireType)
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipUser(dAtA[iNdEx:])
@@ -6317,7 +10942,7 @@ func (m *CreateUserAPIKeyRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UpdateUserAPIKeyRequest) Unmarshal(dAtA []byte) error {
+func (m *GenerateUserRecoveryCodesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6340,10 +10965,10 @@ func (m *UpdateUserAPIKeyRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UpdateUserAPIKeyRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GenerateUserRecoveryCodesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpdateUserAPIKeyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GenerateUserRecoveryCodesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6381,9 +11006,9 @@ func (m *UpdateUserAPIKeyRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field APIKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowUser
@@ -6393,24 +11018,23 @@ func (m *UpdateUserAPIKeyRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthUser
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthUser
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.APIKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Code = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6436,7 +11060,7 @@ func (m *UpdateUserAPIKeyRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Invitation) Unmarshal(dAtA []byte) error {
+func (m *UserRecoveryCodes) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6459,15 +11083,15 @@ func (m *Invitation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Invitation: wiretype end group for non-group")
+			return fmt.Errorf("proto: UserRecoveryCodes: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Invitation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UserRecoveryCodes: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Email", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Codes", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6495,78 +11119,66 @@ func (m *Invitation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Email = string(dAtA[iNdEx:postIndex])
+			m.Codes = append(m.Codes, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowUser
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipUser(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthUser
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthUser
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Token = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowUser
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthUser
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthUser
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Notification) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowUser
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.ExpiresAt, dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 4:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Notification: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Notification: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowUser
@@ -6576,28 +11188,27 @@ func (m *Invitation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthUser
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthUser
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CreatedAt, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6624,13 +11235,32 @@ func (m *Invitation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.UpdatedAt, dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CreatedAt, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NotificationType", wireType)
+			}
+			m.NotificationType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NotificationType |= NotificationType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AcceptedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EntityIDs", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6657,16 +11287,13 @@ func (m *Invitation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.AcceptedAt == nil {
-				m.AcceptedAt = new(time.Time)
-			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.AcceptedAt, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.EntityIDs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AcceptedBy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SenderIDs", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6693,90 +11320,18 @@ func (m *Invitation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.AcceptedBy == nil {
-				m.AcceptedBy = &UserIdentifiers{}
+			if m.SenderIDs == nil {
+				m.SenderIDs = &UserIdentifiers{}
 			}
-			if err := m.AcceptedBy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SenderIDs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipUser(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthUser
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthUser
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ListInvitationsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowUser
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ListInvitationsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListInvitationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
-			}
-			m.Limit = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowUser
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Limit |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Page", wireType)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subject", wireType)
 			}
-			m.Page = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowUser
@@ -6786,67 +11341,96 @@ func (m *ListInvitationsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Page |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipUser(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthUser
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthUser
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Invitations) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowUser
-			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Subject = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType == 0 {
+				var v Right
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowUser
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= Right(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Rights = append(m.Rights, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowUser
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthUser
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthUser
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.Rights) == 0 {
+					m.Rights = make([]Right, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v Right
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowUser
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= Right(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Rights = append(m.Rights, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rights", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Invitations: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Invitations: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Invitations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6873,8 +11457,10 @@ func (m *Invitations) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Invitations = append(m.Invitations, &Invitation{})
-			if err := m.Invitations[len(m.Invitations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.ReadAt == nil {
+				m.ReadAt = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.ReadAt, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6902,7 +11488,7 @@ func (m *Invitations) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SendInvitationRequest) Unmarshal(dAtA []byte) error {
+func (m *Notifications) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6925,17 +11511,17 @@ func (m *SendInvitationRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SendInvitationRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: Notifications: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SendInvitationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Notifications: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Email", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Notifications", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowUser
@@ -6945,23 +11531,25 @@ func (m *SendInvitationRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthUser
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthUser
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Email = string(dAtA[iNdEx:postIndex])
+			m.Notifications = append(m.Notifications, &Notification{})
+			if err := m.Notifications[len(m.Notifications)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6987,7 +11575,7 @@ func (m *SendInvitationRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DeleteInvitationRequest) Unmarshal(dAtA []byte) error {
+func (m *CreateNotificationRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7010,17 +11598,17 @@ func (m *DeleteInvitationRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DeleteInvitationRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: CreateNotificationRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeleteInvitationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CreateNotificationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Email", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Notification", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowUser
@@ -7030,23 +11618,24 @@ func (m *DeleteInvitationRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthUser
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthUser
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Email = string(dAtA[iNdEx:postIndex])
+			if err := m.Notification.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7072,7 +11661,7 @@ func (m *DeleteInvitationRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UserSessionIdentifiers) Unmarshal(dAtA []byte) error {
+func (m *ListNotificationsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7095,10 +11684,10 @@ func (m *UserSessionIdentifiers) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UserSessionIdentifiers: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListNotificationsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UserSessionIdentifiers: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListNotificationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7135,10 +11724,10 @@ func (m *UserSessionIdentifiers) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SessionID", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnreadOnly", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowUser
@@ -7148,24 +11737,50 @@ func (m *UserSessionIdentifiers) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthUser
+			m.UnreadOnly = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthUser
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Page", wireType)
+			}
+			m.Page = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Page |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.SessionID = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipUser(dAtA[iNdEx:])
@@ -7190,7 +11805,7 @@ func (m *UserSessionIdentifiers) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UserSession) Unmarshal(dAtA []byte) error {
+func (m *MarkNotificationsReadRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7213,10 +11828,10 @@ func (m *UserSession) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UserSession: wiretype end group for non-group")
+			return fmt.Errorf("proto: MarkNotificationsReadRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UserSession: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MarkNotificationsReadRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7254,7 +11869,7 @@ func (m *UserSession) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SessionID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IDs", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7282,13 +11897,66 @@ func (m *UserSession) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SessionID = string(dAtA[iNdEx:postIndex])
+			m.IDs = append(m.IDs, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipUser(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var msglen int
+			if skippy < 0 {
+				return ErrInvalidLengthUser
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthUser
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NotificationPreference) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowUser
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NotificationPreference: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NotificationPreference: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NotificationType", wireType)
+			}
+			m.NotificationType = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowUser
@@ -7298,30 +11966,16 @@ func (m *UserSession) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.NotificationType |= NotificationType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthUser
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthUser
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CreatedAt, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subscribed", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowUser
@@ -7331,30 +11985,17 @@ func (m *UserSession) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthUser
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthUser
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.UpdatedAt, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			m.Subscribed = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Email", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowUser
@@ -7364,28 +12005,12 @@ func (m *UserSession) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthUser
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthUser
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.ExpiresAt == nil {
-				m.ExpiresAt = new(time.Time)
-			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.ExpiresAt, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.Email = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipUser(dAtA[iNdEx:])
@@ -7410,7 +12035,7 @@ func (m *UserSession) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UserSessions) Unmarshal(dAtA []byte) error {
+func (m *NotificationPreferences) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7433,15 +12058,15 @@ func (m *UserSessions) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UserSessions: wiretype end group for non-group")
+			return fmt.Errorf("proto: NotificationPreferences: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UserSessions: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NotificationPreferences: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sessions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Preferences", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7468,8 +12093,8 @@ func (m *UserSessions) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sessions = append(m.Sessions, &UserSession{})
-			if err := m.Sessions[len(m.Sessions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Preferences = append(m.Preferences, &NotificationPreference{})
+			if err := m.Preferences[len(m.Preferences)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7497,7 +12122,7 @@ func (m *UserSessions) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListUserSessionsRequest) Unmarshal(dAtA []byte) error {
+func (m *SetNotificationPreferencesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7520,10 +12145,10 @@ func (m *ListUserSessionsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListUserSessionsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SetNotificationPreferencesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListUserSessionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SetNotificationPreferencesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7561,9 +12186,9 @@ func (m *ListUserSessionsRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Order", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Preferences", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowUser
@@ -7573,62 +12198,26 @@ func (m *ListUserSessionsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthUser
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthUser
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Order = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
-			}
-			m.Limit = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowUser
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Limit |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Page", wireType)
-			}
-			m.Page = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowUser
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Page |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.Preferences = append(m.Preferences, &NotificationPreference{})
+			if err := m.Preferences[len(m.Preferences)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipUser(dAtA[iNdEx:])
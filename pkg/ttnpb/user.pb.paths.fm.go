@@ -68,6 +68,7 @@ var GetUserRequestFieldPathsTopLevel = []string{
 	"user_ids",
 }
 var ListUsersRequestFieldPathsNested = []string{
+	"deleted",
 	"field_mask",
 	"limit",
 	"order",
@@ -75,6 +76,7 @@ var ListUsersRequestFieldPathsNested = []string{
 }
 
 var ListUsersRequestFieldPathsTopLevel = []string{
+	"deleted",
 	"field_mask",
 	"limit",
 	"order",
@@ -195,7 +197,11 @@ var GetUserAPIKeyRequestFieldPathsTopLevel = []string{
 	"user_ids",
 }
 var CreateUserAPIKeyRequestFieldPathsNested = []string{
+	"expires_at",
 	"name",
+	"restrictions",
+	"restrictions.end_device_ids",
+	"restrictions.source_cidrs",
 	"rights",
 	"user_ids",
 	"user_ids.email",
@@ -203,16 +209,24 @@ var CreateUserAPIKeyRequestFieldPathsNested = []string{
 }
 
 var CreateUserAPIKeyRequestFieldPathsTopLevel = []string{
+	"expires_at",
 	"name",
+	"restrictions",
 	"rights",
 	"user_ids",
 }
 var UpdateUserAPIKeyRequestFieldPathsNested = []string{
 	"api_key",
+	"api_key.expires_at",
 	"api_key.id",
 	"api_key.key",
+	"api_key.last_used_at",
 	"api_key.name",
+	"api_key.restrictions",
+	"api_key.restrictions.end_device_ids",
+	"api_key.restrictions.source_cidrs",
 	"api_key.rights",
+	"field_mask",
 	"user_ids",
 	"user_ids.email",
 	"user_ids.user_id",
@@ -220,6 +234,7 @@ var UpdateUserAPIKeyRequestFieldPathsNested = []string{
 
 var UpdateUserAPIKeyRequestFieldPathsTopLevel = []string{
 	"api_key",
+	"field_mask",
 	"user_ids",
 }
 var InvitationFieldPathsNested = []string{
@@ -323,3 +338,205 @@ var ListUserSessionsRequestFieldPathsTopLevel = []string{
 	"page",
 	"user_ids",
 }
+var UserTOTPStatusFieldPathsNested = []string{
+	"enabled",
+	"enabled_at",
+	"recovery_codes_remaining",
+}
+
+var UserTOTPStatusFieldPathsTopLevel = []string{
+	"enabled",
+	"enabled_at",
+	"recovery_codes_remaining",
+}
+var UserTOTPEnrollmentFieldPathsNested = []string{
+	"secret",
+	"uri",
+}
+
+var UserTOTPEnrollmentFieldPathsTopLevel = []string{
+	"secret",
+	"uri",
+}
+var EnableUserTOTPRequestFieldPathsNested = []string{
+	"code",
+	"user_ids",
+	"user_ids.email",
+	"user_ids.user_id",
+}
+
+var EnableUserTOTPRequestFieldPathsTopLevel = []string{
+	"code",
+	"user_ids",
+}
+var DisableUserTOTPRequestFieldPathsNested = []string{
+	"code",
+	"user_ids",
+	"user_ids.email",
+	"user_ids.user_id",
+}
+
+var DisableUserTOTPRequestFieldPathsTopLevel = []string{
+	"code",
+	"user_ids",
+}
+var GenerateUserRecoveryCodesRequestFieldPathsNested = []string{
+	"code",
+	"user_ids",
+	"user_ids.email",
+	"user_ids.user_id",
+}
+
+var GenerateUserRecoveryCodesRequestFieldPathsTopLevel = []string{
+	"code",
+	"user_ids",
+}
+var UserRecoveryCodesFieldPathsNested = []string{
+	"codes",
+}
+
+var UserRecoveryCodesFieldPathsTopLevel = []string{
+	"codes",
+}
+var NotificationFieldPathsNested = []string{
+	"created_at",
+	"entity_ids",
+	"entity_ids.ids",
+	"entity_ids.ids.application_ids",
+	"entity_ids.ids.application_ids.application_id",
+	"entity_ids.ids.client_ids",
+	"entity_ids.ids.client_ids.client_id",
+	"entity_ids.ids.device_ids",
+	"entity_ids.ids.device_ids.application_ids",
+	"entity_ids.ids.device_ids.application_ids.application_id",
+	"entity_ids.ids.device_ids.dev_addr",
+	"entity_ids.ids.device_ids.dev_eui",
+	"entity_ids.ids.device_ids.device_id",
+	"entity_ids.ids.device_ids.join_eui",
+	"entity_ids.ids.gateway_ids",
+	"entity_ids.ids.gateway_ids.eui",
+	"entity_ids.ids.gateway_ids.gateway_id",
+	"entity_ids.ids.organization_ids",
+	"entity_ids.ids.organization_ids.organization_id",
+	"entity_ids.ids.user_ids",
+	"entity_ids.ids.user_ids.email",
+	"entity_ids.ids.user_ids.user_id",
+	"id",
+	"notification_type",
+	"read_at",
+	"rights",
+	"sender_ids",
+	"sender_ids.email",
+	"sender_ids.user_id",
+	"subject",
+}
+
+var NotificationFieldPathsTopLevel = []string{
+	"created_at",
+	"entity_ids",
+	"id",
+	"notification_type",
+	"read_at",
+	"rights",
+	"sender_ids",
+	"subject",
+}
+var NotificationsFieldPathsNested = []string{
+	"notifications",
+}
+
+var NotificationsFieldPathsTopLevel = []string{
+	"notifications",
+}
+var CreateNotificationRequestFieldPathsNested = []string{
+	"notification",
+	"notification.created_at",
+	"notification.entity_ids",
+	"notification.entity_ids.ids",
+	"notification.entity_ids.ids.application_ids",
+	"notification.entity_ids.ids.application_ids.application_id",
+	"notification.entity_ids.ids.client_ids",
+	"notification.entity_ids.ids.client_ids.client_id",
+	"notification.entity_ids.ids.device_ids",
+	"notification.entity_ids.ids.device_ids.application_ids",
+	"notification.entity_ids.ids.device_ids.application_ids.application_id",
+	"notification.entity_ids.ids.device_ids.dev_addr",
+	"notification.entity_ids.ids.device_ids.dev_eui",
+	"notification.entity_ids.ids.device_ids.device_id",
+	"notification.entity_ids.ids.device_ids.join_eui",
+	"notification.entity_ids.ids.gateway_ids",
+	"notification.entity_ids.ids.gateway_ids.eui",
+	"notification.entity_ids.ids.gateway_ids.gateway_id",
+	"notification.entity_ids.ids.organization_ids",
+	"notification.entity_ids.ids.organization_ids.organization_id",
+	"notification.entity_ids.ids.user_ids",
+	"notification.entity_ids.ids.user_ids.email",
+	"notification.entity_ids.ids.user_ids.user_id",
+	"notification.id",
+	"notification.notification_type",
+	"notification.read_at",
+	"notification.rights",
+	"notification.sender_ids",
+	"notification.sender_ids.email",
+	"notification.sender_ids.user_id",
+	"notification.subject",
+}
+
+var CreateNotificationRequestFieldPathsTopLevel = []string{
+	"notification",
+}
+var ListNotificationsRequestFieldPathsNested = []string{
+	"limit",
+	"page",
+	"unread_only",
+	"user_ids",
+	"user_ids.email",
+	"user_ids.user_id",
+}
+
+var ListNotificationsRequestFieldPathsTopLevel = []string{
+	"limit",
+	"page",
+	"unread_only",
+	"user_ids",
+}
+var MarkNotificationsReadRequestFieldPathsNested = []string{
+	"ids",
+	"user_ids",
+	"user_ids.email",
+	"user_ids.user_id",
+}
+
+var MarkNotificationsReadRequestFieldPathsTopLevel = []string{
+	"ids",
+	"user_ids",
+}
+var NotificationPreferenceFieldPathsNested = []string{
+	"email",
+	"notification_type",
+	"subscribed",
+}
+
+var NotificationPreferenceFieldPathsTopLevel = []string{
+	"email",
+	"notification_type",
+	"subscribed",
+}
+var NotificationPreferencesFieldPathsNested = []string{
+	"preferences",
+}
+
+var NotificationPreferencesFieldPathsTopLevel = []string{
+	"preferences",
+}
+var SetNotificationPreferencesRequestFieldPathsNested = []string{
+	"preferences",
+	"user_ids",
+	"user_ids.email",
+	"user_ids.user_id",
+}
+
+var SetNotificationPreferencesRequestFieldPathsTopLevel = []string{
+	"preferences",
+	"user_ids",
+}
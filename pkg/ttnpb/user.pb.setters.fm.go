@@ -319,6 +319,16 @@ func (dst *ListUsersRequest) SetFields(src *ListUsersRequest, paths ...string) e
 				var zero uint32
 				dst.Page = zero
 			}
+		case "deleted":
+			if len(subs) > 0 {
+				return fmt.Errorf("'deleted' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Deleted = src.Deleted
+			} else {
+				var zero bool
+				dst.Deleted = zero
+			}
 
 		default:
 			return fmt.Errorf("invalid field: '%s'", name)
@@ -621,6 +631,40 @@ func (dst *CreateUserAPIKeyRequest) SetFields(src *CreateUserAPIKeyRequest, path
 			} else {
 				dst.Rights = nil
 			}
+		case "expires_at":
+			if len(subs) > 0 {
+				return fmt.Errorf("'expires_at' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.ExpiresAt = src.ExpiresAt
+			} else {
+				dst.ExpiresAt = nil
+			}
+		case "restrictions":
+			if len(subs) > 0 {
+				var newDst, newSrc *APIKeyRestrictions
+				if (src == nil || src.Restrictions == nil) && dst.Restrictions == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.Restrictions
+				}
+				if dst.Restrictions != nil {
+					newDst = dst.Restrictions
+				} else {
+					newDst = &APIKeyRestrictions{}
+					dst.Restrictions = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.Restrictions = src.Restrictions
+				} else {
+					dst.Restrictions = nil
+				}
+			}
 
 		default:
 			return fmt.Errorf("invalid field: '%s'", name)
@@ -668,6 +712,16 @@ func (dst *UpdateUserAPIKeyRequest) SetFields(src *UpdateUserAPIKeyRequest, path
 					dst.APIKey = zero
 				}
 			}
+		case "field_mask":
+			if len(subs) > 0 {
+				return fmt.Errorf("'field_mask' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.FieldMask = src.FieldMask
+			} else {
+				var zero types.FieldMask
+				dst.FieldMask = zero
+			}
 
 		default:
 			return fmt.Errorf("invalid field: '%s'", name)
@@ -1049,3 +1103,568 @@ func (dst *ListUserSessionsRequest) SetFields(src *ListUserSessionsRequest, path
 	}
 	return nil
 }
+
+func (dst *UserTOTPStatus) SetFields(src *UserTOTPStatus, paths ...string) error {
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		switch name {
+		case "enabled":
+			if len(subs) > 0 {
+				return fmt.Errorf("'enabled' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Enabled = src.Enabled
+			} else {
+				var zero bool
+				dst.Enabled = zero
+			}
+		case "enabled_at":
+			if len(subs) > 0 {
+				return fmt.Errorf("'enabled_at' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.EnabledAt = src.EnabledAt
+			} else {
+				dst.EnabledAt = nil
+			}
+		case "recovery_codes_remaining":
+			if len(subs) > 0 {
+				return fmt.Errorf("'recovery_codes_remaining' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.RecoveryCodesRemaining = src.RecoveryCodesRemaining
+			} else {
+				var zero uint32
+				dst.RecoveryCodesRemaining = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *UserTOTPEnrollment) SetFields(src *UserTOTPEnrollment, paths ...string) error {
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		switch name {
+		case "secret":
+			if len(subs) > 0 {
+				return fmt.Errorf("'secret' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Secret = src.Secret
+			} else {
+				var zero string
+				dst.Secret = zero
+			}
+		case "uri":
+			if len(subs) > 0 {
+				return fmt.Errorf("'uri' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.URI = src.URI
+			} else {
+				var zero string
+				dst.URI = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *EnableUserTOTPRequest) SetFields(src *EnableUserTOTPRequest, paths ...string) error {
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		switch name {
+		case "user_ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *UserIdentifiers
+				if src != nil {
+					newSrc = &src.UserIdentifiers
+				}
+				newDst = &dst.UserIdentifiers
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.UserIdentifiers = src.UserIdentifiers
+				} else {
+					var zero UserIdentifiers
+					dst.UserIdentifiers = zero
+				}
+			}
+		case "code":
+			if len(subs) > 0 {
+				return fmt.Errorf("'code' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Code = src.Code
+			} else {
+				var zero string
+				dst.Code = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *DisableUserTOTPRequest) SetFields(src *DisableUserTOTPRequest, paths ...string) error {
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		switch name {
+		case "user_ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *UserIdentifiers
+				if src != nil {
+					newSrc = &src.UserIdentifiers
+				}
+				newDst = &dst.UserIdentifiers
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.UserIdentifiers = src.UserIdentifiers
+				} else {
+					var zero UserIdentifiers
+					dst.UserIdentifiers = zero
+				}
+			}
+		case "code":
+			if len(subs) > 0 {
+				return fmt.Errorf("'code' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Code = src.Code
+			} else {
+				var zero string
+				dst.Code = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *GenerateUserRecoveryCodesRequest) SetFields(src *GenerateUserRecoveryCodesRequest, paths ...string) error {
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		switch name {
+		case "user_ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *UserIdentifiers
+				if src != nil {
+					newSrc = &src.UserIdentifiers
+				}
+				newDst = &dst.UserIdentifiers
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.UserIdentifiers = src.UserIdentifiers
+				} else {
+					var zero UserIdentifiers
+					dst.UserIdentifiers = zero
+				}
+			}
+		case "code":
+			if len(subs) > 0 {
+				return fmt.Errorf("'code' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Code = src.Code
+			} else {
+				var zero string
+				dst.Code = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *UserRecoveryCodes) SetFields(src *UserRecoveryCodes, paths ...string) error {
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		switch name {
+		case "codes":
+			if len(subs) > 0 {
+				return fmt.Errorf("'codes' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Codes = src.Codes
+			} else {
+				dst.Codes = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *Notification) SetFields(src *Notification, paths ...string) error {
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		switch name {
+		case "id":
+			if len(subs) > 0 {
+				return fmt.Errorf("'id' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.ID = src.ID
+			} else {
+				var zero string
+				dst.ID = zero
+			}
+		case "created_at":
+			if len(subs) > 0 {
+				return fmt.Errorf("'created_at' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.CreatedAt = src.CreatedAt
+			} else {
+				var zero time.Time
+				dst.CreatedAt = zero
+			}
+		case "notification_type":
+			if len(subs) > 0 {
+				return fmt.Errorf("'notification_type' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.NotificationType = src.NotificationType
+			} else {
+				var zero NotificationType
+				dst.NotificationType = zero
+			}
+		case "entity_ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *EntityIdentifiers
+				if src != nil {
+					newSrc = &src.EntityIDs
+				}
+				newDst = &dst.EntityIDs
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.EntityIDs = src.EntityIDs
+				} else {
+					var zero EntityIdentifiers
+					dst.EntityIDs = zero
+				}
+			}
+		case "sender_ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *UserIdentifiers
+				if (src == nil || src.SenderIDs == nil) && dst.SenderIDs == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.SenderIDs
+				}
+				if dst.SenderIDs != nil {
+					newDst = dst.SenderIDs
+				} else {
+					newDst = &UserIdentifiers{}
+					dst.SenderIDs = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.SenderIDs = src.SenderIDs
+				} else {
+					dst.SenderIDs = nil
+				}
+			}
+		case "subject":
+			if len(subs) > 0 {
+				return fmt.Errorf("'subject' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Subject = src.Subject
+			} else {
+				var zero string
+				dst.Subject = zero
+			}
+		case "rights":
+			if len(subs) > 0 {
+				return fmt.Errorf("'rights' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Rights = src.Rights
+			} else {
+				dst.Rights = nil
+			}
+		case "read_at":
+			if len(subs) > 0 {
+				return fmt.Errorf("'read_at' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.ReadAt = src.ReadAt
+			} else {
+				dst.ReadAt = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *Notifications) SetFields(src *Notifications, paths ...string) error {
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		switch name {
+		case "notifications":
+			if len(subs) > 0 {
+				return fmt.Errorf("'notifications' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Notifications = src.Notifications
+			} else {
+				dst.Notifications = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *CreateNotificationRequest) SetFields(src *CreateNotificationRequest, paths ...string) error {
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		switch name {
+		case "notification":
+			if len(subs) > 0 {
+				var newDst, newSrc *Notification
+				if src != nil {
+					newSrc = &src.Notification
+				}
+				newDst = &dst.Notification
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.Notification = src.Notification
+				} else {
+					var zero Notification
+					dst.Notification = zero
+				}
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *ListNotificationsRequest) SetFields(src *ListNotificationsRequest, paths ...string) error {
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		switch name {
+		case "user_ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *UserIdentifiers
+				if src != nil {
+					newSrc = &src.UserIdentifiers
+				}
+				newDst = &dst.UserIdentifiers
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.UserIdentifiers = src.UserIdentifiers
+				} else {
+					var zero UserIdentifiers
+					dst.UserIdentifiers = zero
+				}
+			}
+		case "unread_only":
+			if len(subs) > 0 {
+				return fmt.Errorf("'unread_only' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.UnreadOnly = src.UnreadOnly
+			} else {
+				var zero bool
+				dst.UnreadOnly = zero
+			}
+		case "limit":
+			if len(subs) > 0 {
+				return fmt.Errorf("'limit' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Limit = src.Limit
+			} else {
+				var zero uint32
+				dst.Limit = zero
+			}
+		case "page":
+			if len(subs) > 0 {
+				return fmt.Errorf("'page' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Page = src.Page
+			} else {
+				var zero uint32
+				dst.Page = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *MarkNotificationsReadRequest) SetFields(src *MarkNotificationsReadRequest, paths ...string) error {
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		switch name {
+		case "user_ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *UserIdentifiers
+				if src != nil {
+					newSrc = &src.UserIdentifiers
+				}
+				newDst = &dst.UserIdentifiers
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.UserIdentifiers = src.UserIdentifiers
+				} else {
+					var zero UserIdentifiers
+					dst.UserIdentifiers = zero
+				}
+			}
+		case "ids":
+			if len(subs) > 0 {
+				return fmt.Errorf("'ids' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.IDs = src.IDs
+			} else {
+				dst.IDs = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *NotificationPreference) SetFields(src *NotificationPreference, paths ...string) error {
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		switch name {
+		case "notification_type":
+			if len(subs) > 0 {
+				return fmt.Errorf("'notification_type' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.NotificationType = src.NotificationType
+			} else {
+				var zero NotificationType
+				dst.NotificationType = zero
+			}
+		case "subscribed":
+			if len(subs) > 0 {
+				return fmt.Errorf("'subscribed' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Subscribed = src.Subscribed
+			} else {
+				var zero bool
+				dst.Subscribed = zero
+			}
+		case "email":
+			if len(subs) > 0 {
+				return fmt.Errorf("'email' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Email = src.Email
+			} else {
+				var zero bool
+				dst.Email = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *NotificationPreferences) SetFields(src *NotificationPreferences, paths ...string) error {
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		switch name {
+		case "preferences":
+			if len(subs) > 0 {
+				return fmt.Errorf("'preferences' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Preferences = src.Preferences
+			} else {
+				dst.Preferences = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *SetNotificationPreferencesRequest) SetFields(src *SetNotificationPreferencesRequest, paths ...string) error {
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		switch name {
+		case "user_ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *UserIdentifiers
+				if src != nil {
+					newSrc = &src.UserIdentifiers
+				}
+				newDst = &dst.UserIdentifiers
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.UserIdentifiers = src.UserIdentifiers
+				} else {
+					var zero UserIdentifiers
+					dst.UserIdentifiers = zero
+				}
+			}
+		case "preferences":
+			if len(subs) > 0 {
+				return fmt.Errorf("'preferences' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Preferences = src.Preferences
+			} else {
+				dst.Preferences = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
@@ -591,6 +591,8 @@ func (m *ListUsersRequest) ValidateFields(paths ...string) error {
 
 		case "page":
 			// no validation rules for Page
+		case "deleted":
+			// no validation rules for Deleted
 		default:
 			return ListUsersRequestValidationError{
 				field:  name,
@@ -1314,6 +1316,30 @@ func (m *CreateUserAPIKeyRequest) ValidateFields(paths ...string) error {
 
 			}
 
+		case "expires_at":
+
+			if v, ok := interface{}(m.GetExpiresAt()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return CreateUserAPIKeyRequestValidationError{
+						field:  "expires_at",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "restrictions":
+
+			if v, ok := interface{}(m.GetRestrictions()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return CreateUserAPIKeyRequestValidationError{
+						field:  "restrictions",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
 		default:
 			return CreateUserAPIKeyRequestValidationError{
 				field:  name,
@@ -1419,6 +1445,18 @@ func (m *UpdateUserAPIKeyRequest) ValidateFields(paths ...string) error {
 				}
 			}
 
+		case "field_mask":
+
+			if v, ok := interface{}(&m.FieldMask).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return UpdateUserAPIKeyRequestValidationError{
+						field:  "field_mask",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
 		default:
 			return UpdateUserAPIKeyRequestValidationError{
 				field:  name,
@@ -2608,3 +2646,1448 @@ var _ListUserSessionsRequest_Order_InLookup = map[string]struct{}{
 	"created_at":  {},
 	"-created_at": {},
 }
+
+// ValidateFields checks the field values on UserTOTPStatus with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *UserTOTPStatus) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = UserTOTPStatusFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "enabled":
+			// no validation rules for Enabled
+		case "enabled_at":
+
+			if v, ok := interface{}(m.GetEnabledAt()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return UserTOTPStatusValidationError{
+						field:  "enabled_at",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "recovery_codes_remaining":
+			// no validation rules for RecoveryCodesRemaining
+		default:
+			return UserTOTPStatusValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// UserTOTPStatusValidationError is the validation error returned by
+// UserTOTPStatus.ValidateFields if the designated constraints aren't met.
+type UserTOTPStatusValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UserTOTPStatusValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UserTOTPStatusValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UserTOTPStatusValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UserTOTPStatusValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UserTOTPStatusValidationError) ErrorName() string { return "UserTOTPStatusValidationError" }
+
+// Error satisfies the builtin error interface
+func (e UserTOTPStatusValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUserTOTPStatus.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UserTOTPStatusValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UserTOTPStatusValidationError{}
+
+// ValidateFields checks the field values on UserTOTPEnrollment with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *UserTOTPEnrollment) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = UserTOTPEnrollmentFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "secret":
+			// no validation rules for Secret
+		case "uri":
+			// no validation rules for URI
+		default:
+			return UserTOTPEnrollmentValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// UserTOTPEnrollmentValidationError is the validation error returned by
+// UserTOTPEnrollment.ValidateFields if the designated constraints aren't met.
+type UserTOTPEnrollmentValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UserTOTPEnrollmentValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UserTOTPEnrollmentValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UserTOTPEnrollmentValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UserTOTPEnrollmentValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UserTOTPEnrollmentValidationError) ErrorName() string {
+	return "UserTOTPEnrollmentValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UserTOTPEnrollmentValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUserTOTPEnrollment.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UserTOTPEnrollmentValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UserTOTPEnrollmentValidationError{}
+
+// ValidateFields checks the field values on EnableUserTOTPRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *EnableUserTOTPRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = EnableUserTOTPRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "user_ids":
+
+			if v, ok := interface{}(&m.UserIdentifiers).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return EnableUserTOTPRequestValidationError{
+						field:  "user_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "code":
+
+			if !_EnableUserTOTPRequest_Code_Pattern.MatchString(m.GetCode()) {
+				return EnableUserTOTPRequestValidationError{
+					field:  "code",
+					reason: "value does not match regex pattern \"^[0-9]{6}$\"",
+				}
+			}
+
+		default:
+			return EnableUserTOTPRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// EnableUserTOTPRequestValidationError is the validation error returned by
+// EnableUserTOTPRequest.ValidateFields if the designated constraints aren't met.
+type EnableUserTOTPRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EnableUserTOTPRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EnableUserTOTPRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EnableUserTOTPRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EnableUserTOTPRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EnableUserTOTPRequestValidationError) ErrorName() string {
+	return "EnableUserTOTPRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e EnableUserTOTPRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEnableUserTOTPRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EnableUserTOTPRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EnableUserTOTPRequestValidationError{}
+
+var _EnableUserTOTPRequest_Code_Pattern = regexp.MustCompile("^[0-9]{6}$")
+
+// ValidateFields checks the field values on DisableUserTOTPRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *DisableUserTOTPRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = DisableUserTOTPRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "user_ids":
+
+			if v, ok := interface{}(&m.UserIdentifiers).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return DisableUserTOTPRequestValidationError{
+						field:  "user_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "code":
+
+			if utf8.RuneCountInString(m.GetCode()) > 64 {
+				return DisableUserTOTPRequestValidationError{
+					field:  "code",
+					reason: "value length must be at most 64 runes",
+				}
+			}
+
+		default:
+			return DisableUserTOTPRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// DisableUserTOTPRequestValidationError is the validation error returned by
+// DisableUserTOTPRequest.ValidateFields if the designated constraints aren't met.
+type DisableUserTOTPRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DisableUserTOTPRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DisableUserTOTPRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DisableUserTOTPRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DisableUserTOTPRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DisableUserTOTPRequestValidationError) ErrorName() string {
+	return "DisableUserTOTPRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DisableUserTOTPRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDisableUserTOTPRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DisableUserTOTPRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DisableUserTOTPRequestValidationError{}
+
+// ValidateFields checks the field values on GenerateUserRecoveryCodesRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, an error is returned.
+func (m *GenerateUserRecoveryCodesRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = GenerateUserRecoveryCodesRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "user_ids":
+
+			if v, ok := interface{}(&m.UserIdentifiers).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GenerateUserRecoveryCodesRequestValidationError{
+						field:  "user_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "code":
+
+			if !_GenerateUserRecoveryCodesRequest_Code_Pattern.MatchString(m.GetCode()) {
+				return GenerateUserRecoveryCodesRequestValidationError{
+					field:  "code",
+					reason: "value does not match regex pattern \"^[0-9]{6}$\"",
+				}
+			}
+
+		default:
+			return GenerateUserRecoveryCodesRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// GenerateUserRecoveryCodesRequestValidationError is the validation error
+// returned by GenerateUserRecoveryCodesRequest.ValidateFields if the
+// designated constraints aren't met.
+type GenerateUserRecoveryCodesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GenerateUserRecoveryCodesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GenerateUserRecoveryCodesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GenerateUserRecoveryCodesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GenerateUserRecoveryCodesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GenerateUserRecoveryCodesRequestValidationError) ErrorName() string {
+	return "GenerateUserRecoveryCodesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GenerateUserRecoveryCodesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGenerateUserRecoveryCodesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GenerateUserRecoveryCodesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GenerateUserRecoveryCodesRequestValidationError{}
+
+var _GenerateUserRecoveryCodesRequest_Code_Pattern = regexp.MustCompile("^[0-9]{6}$")
+
+// ValidateFields checks the field values on UserRecoveryCodes with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *UserRecoveryCodes) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = UserRecoveryCodesFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "codes":
+
+		default:
+			return UserRecoveryCodesValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// UserRecoveryCodesValidationError is the validation error returned by
+// UserRecoveryCodes.ValidateFields if the designated constraints aren't met.
+type UserRecoveryCodesValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UserRecoveryCodesValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UserRecoveryCodesValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UserRecoveryCodesValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UserRecoveryCodesValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UserRecoveryCodesValidationError) ErrorName() string {
+	return "UserRecoveryCodesValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UserRecoveryCodesValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUserRecoveryCodes.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UserRecoveryCodesValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UserRecoveryCodesValidationError{}
+
+// ValidateFields checks the field values on Notification with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *Notification) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = NotificationFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "id":
+			// no validation rules for ID
+		case "created_at":
+
+			if v, ok := interface{}(&m.CreatedAt).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return NotificationValidationError{
+						field:  "created_at",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "notification_type":
+
+			if _, ok := NotificationType_name[int32(m.GetNotificationType())]; !ok {
+				return NotificationValidationError{
+					field:  "notification_type",
+					reason: "value must be one of the defined enum values",
+				}
+			}
+
+		case "entity_ids":
+
+			if v, ok := interface{}(&m.EntityIDs).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return NotificationValidationError{
+						field:  "entity_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "sender_ids":
+
+			if v, ok := interface{}(m.GetSenderIDs()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return NotificationValidationError{
+						field:  "sender_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "subject":
+
+			if utf8.RuneCountInString(m.GetSubject()) > 100 {
+				return NotificationValidationError{
+					field:  "subject",
+					reason: "value length must be at most 100 runes",
+				}
+			}
+
+		case "rights":
+
+			for idx, item := range m.GetRights() {
+				_, _ = idx, item
+
+				if _, ok := Right_name[int32(item)]; !ok {
+					return NotificationValidationError{
+						field:  fmt.Sprintf("rights[%v]", idx),
+						reason: "value must be one of the defined enum values",
+					}
+				}
+
+			}
+
+		case "read_at":
+
+			if v, ok := interface{}(m.GetReadAt()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return NotificationValidationError{
+						field:  "read_at",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		default:
+			return NotificationValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// NotificationValidationError is the validation error returned by
+// Notification.ValidateFields if the designated constraints aren't met.
+type NotificationValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e NotificationValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e NotificationValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e NotificationValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e NotificationValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e NotificationValidationError) ErrorName() string { return "NotificationValidationError" }
+
+// Error satisfies the builtin error interface
+func (e NotificationValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sNotification.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = NotificationValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = NotificationValidationError{}
+
+// ValidateFields checks the field values on Notifications with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *Notifications) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = NotificationsFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "notifications":
+
+			for idx, item := range m.GetNotifications() {
+				_, _ = idx, item
+
+				if v, ok := interface{}(item).(interface{ ValidateFields(...string) error }); ok {
+					if err := v.ValidateFields(subs...); err != nil {
+						return NotificationsValidationError{
+							field:  fmt.Sprintf("notifications[%v]", idx),
+							reason: "embedded message failed validation",
+							cause:  err,
+						}
+					}
+				}
+
+			}
+
+		default:
+			return NotificationsValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// NotificationsValidationError is the validation error returned by
+// Notifications.ValidateFields if the designated constraints aren't met.
+type NotificationsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e NotificationsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e NotificationsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e NotificationsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e NotificationsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e NotificationsValidationError) ErrorName() string { return "NotificationsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e NotificationsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sNotifications.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = NotificationsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = NotificationsValidationError{}
+
+// ValidateFields checks the field values on CreateNotificationRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *CreateNotificationRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = CreateNotificationRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "notification":
+
+			if v, ok := interface{}(&m.Notification).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return CreateNotificationRequestValidationError{
+						field:  "notification",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		default:
+			return CreateNotificationRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// CreateNotificationRequestValidationError is the validation error returned by
+// CreateNotificationRequest.ValidateFields if the designated constraints
+// aren't met.
+type CreateNotificationRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateNotificationRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateNotificationRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateNotificationRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateNotificationRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateNotificationRequestValidationError) ErrorName() string {
+	return "CreateNotificationRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateNotificationRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateNotificationRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateNotificationRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateNotificationRequestValidationError{}
+
+// ValidateFields checks the field values on ListNotificationsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ListNotificationsRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = ListNotificationsRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "user_ids":
+
+			if v, ok := interface{}(&m.UserIdentifiers).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ListNotificationsRequestValidationError{
+						field:  "user_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "unread_only":
+			// no validation rules for UnreadOnly
+		case "limit":
+
+			if m.GetLimit() > 1000 {
+				return ListNotificationsRequestValidationError{
+					field:  "limit",
+					reason: "value must be less than or equal to 1000",
+				}
+			}
+
+		case "page":
+			// no validation rules for Page
+		default:
+			return ListNotificationsRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// ListNotificationsRequestValidationError is the validation error returned by
+// ListNotificationsRequest.ValidateFields if the designated constraints
+// aren't met.
+type ListNotificationsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListNotificationsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListNotificationsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListNotificationsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListNotificationsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListNotificationsRequestValidationError) ErrorName() string {
+	return "ListNotificationsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListNotificationsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListNotificationsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListNotificationsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListNotificationsRequestValidationError{}
+
+// ValidateFields checks the field values on MarkNotificationsReadRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, an error is returned.
+func (m *MarkNotificationsReadRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = MarkNotificationsReadRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "user_ids":
+
+			if v, ok := interface{}(&m.UserIdentifiers).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return MarkNotificationsReadRequestValidationError{
+						field:  "user_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "ids":
+
+			if len(m.GetIDs()) > 100 {
+				return MarkNotificationsReadRequestValidationError{
+					field:  "ids",
+					reason: "value must contain no more than 100 item(s)",
+				}
+			}
+
+		default:
+			return MarkNotificationsReadRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// MarkNotificationsReadRequestValidationError is the validation error returned
+// by MarkNotificationsReadRequest.ValidateFields if the designated
+// constraints aren't met.
+type MarkNotificationsReadRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MarkNotificationsReadRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MarkNotificationsReadRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MarkNotificationsReadRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MarkNotificationsReadRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MarkNotificationsReadRequestValidationError) ErrorName() string {
+	return "MarkNotificationsReadRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MarkNotificationsReadRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMarkNotificationsReadRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MarkNotificationsReadRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MarkNotificationsReadRequestValidationError{}
+
+// ValidateFields checks the field values on NotificationPreference with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *NotificationPreference) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = NotificationPreferenceFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "notification_type":
+
+			if _, ok := NotificationType_name[int32(m.GetNotificationType())]; !ok {
+				return NotificationPreferenceValidationError{
+					field:  "notification_type",
+					reason: "value must be one of the defined enum values",
+				}
+			}
+
+		case "subscribed":
+			// no validation rules for Subscribed
+		case "email":
+			// no validation rules for Email
+		default:
+			return NotificationPreferenceValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// NotificationPreferenceValidationError is the validation error returned by
+// NotificationPreference.ValidateFields if the designated constraints aren't met.
+type NotificationPreferenceValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e NotificationPreferenceValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e NotificationPreferenceValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e NotificationPreferenceValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e NotificationPreferenceValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e NotificationPreferenceValidationError) ErrorName() string {
+	return "NotificationPreferenceValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e NotificationPreferenceValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sNotificationPreference.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = NotificationPreferenceValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = NotificationPreferenceValidationError{}
+
+// ValidateFields checks the field values on NotificationPreferences with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *NotificationPreferences) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = NotificationPreferencesFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "preferences":
+
+			for idx, item := range m.GetPreferences() {
+				_, _ = idx, item
+
+				if v, ok := interface{}(item).(interface{ ValidateFields(...string) error }); ok {
+					if err := v.ValidateFields(subs...); err != nil {
+						return NotificationPreferencesValidationError{
+							field:  fmt.Sprintf("preferences[%v]", idx),
+							reason: "embedded message failed validation",
+							cause:  err,
+						}
+					}
+				}
+
+			}
+
+		default:
+			return NotificationPreferencesValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// NotificationPreferencesValidationError is the validation error returned by
+// NotificationPreferences.ValidateFields if the designated constraints aren't met.
+type NotificationPreferencesValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e NotificationPreferencesValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e NotificationPreferencesValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e NotificationPreferencesValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e NotificationPreferencesValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e NotificationPreferencesValidationError) ErrorName() string {
+	return "NotificationPreferencesValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e NotificationPreferencesValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sNotificationPreferences.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = NotificationPreferencesValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = NotificationPreferencesValidationError{}
+
+// ValidateFields checks the field values on SetNotificationPreferencesRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, an error is returned.
+func (m *SetNotificationPreferencesRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = SetNotificationPreferencesRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "user_ids":
+
+			if v, ok := interface{}(&m.UserIdentifiers).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return SetNotificationPreferencesRequestValidationError{
+						field:  "user_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "preferences":
+
+			if len(m.GetPreferences()) < 1 {
+				return SetNotificationPreferencesRequestValidationError{
+					field:  "preferences",
+					reason: "value must contain at least 1 item(s)",
+				}
+			}
+
+			for idx, item := range m.GetPreferences() {
+				_, _ = idx, item
+
+				if v, ok := interface{}(item).(interface{ ValidateFields(...string) error }); ok {
+					if err := v.ValidateFields(subs...); err != nil {
+						return SetNotificationPreferencesRequestValidationError{
+							field:  fmt.Sprintf("preferences[%v]", idx),
+							reason: "embedded message failed validation",
+							cause:  err,
+						}
+					}
+				}
+
+			}
+
+		default:
+			return SetNotificationPreferencesRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// SetNotificationPreferencesRequestValidationError is the validation error
+// returned by SetNotificationPreferencesRequest.ValidateFields if the
+// designated constraints aren't met.
+type SetNotificationPreferencesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetNotificationPreferencesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetNotificationPreferencesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetNotificationPreferencesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetNotificationPreferencesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetNotificationPreferencesRequestValidationError) ErrorName() string {
+	return "SetNotificationPreferencesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetNotificationPreferencesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetNotificationPreferencesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetNotificationPreferencesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetNotificationPreferencesRequestValidationError{}
@@ -38,63 +38,88 @@ func init() {
 }
 
 var fileDescriptor_82df9ba9356987c4 = []byte{
-	// 892 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x56, 0x4d, 0x6c, 0xdc, 0x44,
-	0x18, 0xf5, 0x84, 0xb0, 0x82, 0x69, 0x14, 0xd1, 0xa1, 0x34, 0xd4, 0x89, 0xbe, 0x52, 0x93, 0x36,
-	0x62, 0xe9, 0x8e, 0x45, 0x52, 0x09, 0xa9, 0xb7, 0xf2, 0xa3, 0xa8, 0x02, 0x89, 0xaa, 0x3f, 0x17,
-	0x90, 0x88, 0xbc, 0xbb, 0x13, 0x67, 0xb4, 0x89, 0x6d, 0x3c, 0xb3, 0x89, 0x4c, 0xd4, 0xaa, 0xf4,
-	0x54, 0x89, 0x0b, 0x12, 0x48, 0x70, 0x44, 0x9c, 0x7a, 0xec, 0xb1, 0xc7, 0x1c, 0x7b, 0xac, 0xc4,
-	0xa5, 0xc7, 0xae, 0xcd, 0xa1, 0xc7, 0x1e, 0x2b, 0x0e, 0x08, 0x79, 0xc6, 0xf6, 0x66, 0xbd, 0x76,
-	0xd6, 0x37, 0x7b, 0xe6, 0xcd, 0x7b, 0xef, 0x7b, 0xfe, 0xbe, 0xd9, 0xc5, 0x17, 0x77, 0xfd, 0xd0,
-	0x39, 0x70, 0xbc, 0x8e, 0x90, 0x4e, 0x6f, 0x60, 0x3b, 0x01, 0xb7, 0x87, 0x82, 0x85, 0x5b, 0x82,
-	0x85, 0xfb, 0xbc, 0xc7, 0x04, 0x0d, 0x42, 0x5f, 0xfa, 0x64, 0x51, 0x4a, 0x8f, 0x66, 0x50, 0xba,
-	0xbf, 0x61, 0x76, 0x5c, 0x2e, 0x77, 0x86, 0x5d, 0xda, 0xf3, 0xf7, 0x6c, 0xd7, 0x77, 0x7d, 0x5b,
-	0xc1, 0xba, 0xc3, 0x6d, 0xf5, 0xa6, 0x5e, 0xd4, 0x93, 0x3e, 0x6e, 0xae, 0xb8, 0xbe, 0xef, 0xee,
-	0x32, 0x45, 0xef, 0x78, 0x9e, 0x2f, 0x1d, 0xc9, 0x7d, 0x2f, 0x23, 0x37, 0x97, 0xb3, 0xdd, 0x82,
-	0x83, 0xed, 0x05, 0x32, 0xca, 0x36, 0x3f, 0x9c, 0x36, 0xc8, 0xfb, 0xcc, 0x93, 0x7c, 0x9b, 0xb3,
-	0x30, 0x67, 0x80, 0x69, 0x50, 0xc8, 0xdd, 0x1d, 0x99, 0xef, 0xaf, 0x54, 0x57, 0xa9, 0x77, 0xd7,
-	0xff, 0x7d, 0x13, 0x2f, 0xdc, 0x11, 0x2c, 0xbc, 0xc9, 0x5c, 0x2e, 0x64, 0x18, 0x91, 0xdb, 0xb8,
-	0xf5, 0x79, 0xc8, 0x1c, 0xc9, 0xc8, 0x05, 0x3a, 0x59, 0x38, 0xd5, 0xeb, 0x1a, 0xfd, 0xc3, 0x90,
-	0x09, 0x69, 0x9e, 0x29, 0x43, 0xd2, 0x4d, 0xeb, 0xf4, 0x83, 0xbf, 0xff, 0xf9, 0x75, 0xee, 0x94,
-	0xd5, 0x52, 0x42, 0xe2, 0x2a, 0x6a, 0x93, 0xef, 0xf1, 0x1b, 0x9b, 0x4c, 0x12, 0x28, 0xe3, 0x37,
-	0x99, 0x9c, 0xcd, 0x77, 0x41, 0xf1, 0x2d, 0x93, 0x73, 0x9a, 0xcf, 0x3e, 0x54, 0x5f, 0x89, 0xf7,
-	0x05, 0xcd, 0x1e, 0xee, 0x92, 0x6f, 0xf0, 0xfc, 0xd7, 0x5c, 0x48, 0xf2, 0x41, 0x99, 0x20, 0x5d,
-	0x4d, 0x49, 0x44, 0x2e, 0xf1, 0x5e, 0x95, 0x84, 0xb0, 0x16, 0x95, 0xc6, 0x5b, 0x24, 0xf3, 0x4c,
-	0x5c, 0xdc, 0xba, 0x13, 0xf4, 0x2b, 0x63, 0xd0, 0xeb, 0xb3, 0x6d, 0xaf, 0x2a, 0x4a, 0x30, 0x27,
-	0x6c, 0xd3, 0xe3, 0xb6, 0xd3, 0x64, 0x7e, 0x47, 0x78, 0x49, 0x07, 0x7b, 0x9b, 0xed, 0x05, 0x7e,
-	0xe8, 0x84, 0xd1, 0x0d, 0x47, 0x88, 0x03, 0x3f, 0xec, 0x13, 0x5a, 0xfd, 0x05, 0xa6, 0x80, 0xb9,
-	0x8f, 0xb3, 0x54, 0x77, 0x13, 0xcd, 0xbb, 0x89, 0x7e, 0x99, 0x76, 0x93, 0x75, 0x45, 0x39, 0xa1,
-	0xd6, 0xe5, 0xda, 0x00, 0x6d, 0x99, 0x73, 0x6e, 0x05, 0xb9, 0xfa, 0x03, 0x84, 0x17, 0x75, 0xad,
-	0x85, 0xa1, 0x8f, 0xea, 0xb3, 0x68, 0xea, 0xa5, 0xa3, 0xbc, 0xac, 0x99, 0x56, 0xbd, 0x97, 0xdc,
-	0x41, 0x1a, 0xcf, 0x77, 0xb8, 0xf5, 0x05, 0xdb, 0x65, 0x92, 0x91, 0xf3, 0x55, 0x21, 0x5f, 0x1f,
-	0x8f, 0x43, 0xad, 0xe2, 0xfb, 0x4a, 0x91, 0xb4, 0xdf, 0x29, 0x29, 0xde, 0x5d, 0xff, 0x6f, 0x1e,
-	0xe3, 0x94, 0xe5, 0x5a, 0xaf, 0xc7, 0x84, 0x20, 0xdb, 0x18, 0xa7, 0xed, 0x72, 0x53, 0x4d, 0x4f,
-	0x13, 0xbd, 0x12, 0x40, 0x1f, 0xb4, 0xce, 0x2b, 0xbd, 0x73, 0x64, 0xa9, 0xac, 0x97, 0xcd, 0x25,
-	0xb9, 0x87, 0x17, 0xf4, 0x87, 0xbc, 0x76, 0xe3, 0xfa, 0x57, 0x2c, 0x22, 0x6b, 0xf5, 0x83, 0xa6,
-	0x11, 0xe3, 0x4c, 0x4b, 0x40, 0xbd, 0x9d, 0x67, 0x6a, 0x9d, 0x90, 0xa9, 0x13, 0xf0, 0xce, 0x80,
-	0x45, 0x6a, 0x18, 0x7f, 0xc4, 0xa7, 0xd2, 0x3a, 0xf5, 0x61, 0x41, 0x2e, 0xd5, 0xcd, 0x4c, 0x06,
-	0xc8, 0xd5, 0x97, 0xaa, 0xd5, 0x85, 0xd5, 0x56, 0xf2, 0xab, 0xa4, 0x81, 0x3c, 0xb9, 0x87, 0xdf,
-	0xde, 0x64, 0x99, 0x34, 0x59, 0xad, 0xb9, 0x0e, 0x9a, 0x55, 0xbd, 0xa1, 0x64, 0x3b, 0xe4, 0xe3,
-	0xd9, 0xb2, 0xf6, 0xe1, 0x80, 0x45, 0xea, 0xa2, 0xf8, 0x19, 0xe1, 0x05, 0xdd, 0xb4, 0x75, 0xe1,
-	0x8f, 0x5b, 0xba, 0x99, 0x8d, 0xab, 0xca, 0xc6, 0x15, 0xd3, 0x6e, 0x62, 0xc3, 0x09, 0xf8, 0xd6,
-	0x80, 0x45, 0x54, 0x0f, 0xff, 0xfa, 0xd1, 0x1c, 0x3e, 0xab, 0xda, 0xca, 0xdb, 0xe7, 0xfa, 0x77,
-	0xa1, 0xb8, 0x87, 0xbb, 0x78, 0xfe, 0x16, 0xf3, 0xfa, 0xe4, 0x62, 0x59, 0x36, 0x5d, 0x3d, 0x8e,
-	0xd7, 0xee, 0xcc, 0x32, 0x6c, 0x0c, 0xb1, 0x96, 0x94, 0xc3, 0xd3, 0xd6, 0x82, 0xcd, 0x8b, 0x45,
-	0xd5, 0x08, 0x4e, 0x76, 0x6b, 0x56, 0x76, 0xc0, 0x98, 0xa0, 0xe8, 0x80, 0xe5, 0x7a, 0x11, 0x61,
-	0x9d, 0x51, 0x2a, 0x8b, 0x64, 0x42, 0x85, 0x6c, 0x15, 0xf3, 0x3b, 0x15, 0xb4, 0x5e, 0x9f, 0x2e,
-	0xa5, 0x6e, 0x8e, 0x33, 0x81, 0xf6, 0x84, 0xc0, 0xfa, 0x6f, 0x73, 0xf8, 0xdd, 0x34, 0xc2, 0x5b,
-	0x4c, 0x88, 0xe3, 0xf9, 0x45, 0x59, 0x6d, 0x6b, 0x75, 0xdd, 0x9d, 0x1d, 0x28, 0x8a, 0x5b, 0xa9,
-	0x9a, 0xf7, 0x1c, 0xd4, 0xa4, 0xc7, 0x45, 0x86, 0x25, 0x3f, 0xa1, 0xa2, 0xe8, 0x4b, 0x27, 0x90,
-	0x36, 0xb9, 0xbb, 0x3e, 0x55, 0xb2, 0x9f, 0xb4, 0xed, 0xd9, 0xb2, 0xf6, 0x61, 0xf6, 0x94, 0xae,
-	0x7e, 0xf6, 0x17, 0x7a, 0x3a, 0x02, 0xf4, 0x6c, 0x04, 0xe8, 0xf9, 0x08, 0x8c, 0x17, 0x23, 0x30,
-	0x5e, 0x8e, 0xc0, 0x78, 0x35, 0x02, 0xe3, 0xf5, 0x08, 0xd0, 0xfd, 0x18, 0xd0, 0xc3, 0x18, 0x8c,
-	0x47, 0x31, 0xa0, 0xc7, 0x31, 0x18, 0x4f, 0x62, 0x30, 0x8e, 0x62, 0x30, 0x9e, 0xc6, 0x80, 0x9e,
-	0xc5, 0x80, 0x9e, 0xc7, 0x60, 0xbc, 0x88, 0x01, 0xbd, 0x8c, 0xc1, 0x78, 0x15, 0x03, 0x7a, 0x1d,
-	0x83, 0x71, 0x3f, 0x01, 0xe3, 0x61, 0x02, 0xe8, 0x97, 0x04, 0x8c, 0x3f, 0x12, 0x40, 0x7f, 0x26,
-	0x60, 0x3c, 0x4a, 0xc0, 0x78, 0x9c, 0x00, 0x7a, 0x92, 0x00, 0x3a, 0x4a, 0x00, 0x7d, 0x7b, 0xd9,
-	0xf5, 0xa9, 0xdc, 0x61, 0x72, 0x87, 0x7b, 0xae, 0xa0, 0x1e, 0x93, 0x07, 0x7e, 0x38, 0xb0, 0x27,
-	0xff, 0x81, 0x04, 0x03, 0xd7, 0x96, 0xd2, 0x0b, 0xba, 0xdd, 0x96, 0xaa, 0x76, 0xe3, 0xff, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0x91, 0xfb, 0x3c, 0x07, 0x89, 0x09, 0x00, 0x00,
+	// 1283 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x97, 0x3b, 0x6c, 0x14, 0x47,
+	0x18, 0xc7, 0x77, 0x08, 0x1c, 0x64, 0xb0, 0x4e, 0x30, 0x3c, 0x0c, 0x87, 0x19, 0x60, 0x02, 0x38,
+	0x1c, 0xbe, 0x5d, 0x38, 0x23, 0x05, 0x51, 0x44, 0x22, 0x80, 0x2c, 0x94, 0x07, 0x96, 0x6d, 0x9a,
+	0x44, 0x8a, 0xb3, 0x77, 0x37, 0x5e, 0xaf, 0xee, 0xbc, 0xbb, 0x99, 0x99, 0x33, 0xba, 0x38, 0x20,
+	0x07, 0xa5, 0x20, 0x8a, 0x14, 0x45, 0x4a, 0xa4, 0xa4, 0x48, 0x11, 0xa5, 0x88, 0x28, 0x52, 0x50,
+	0x52, 0x5a, 0xa9, 0x28, 0x52, 0x20, 0xa5, 0xa1, 0xe4, 0xf6, 0x52, 0x50, 0x52, 0x52, 0x45, 0xd1,
+	0xce, 0xec, 0xde, 0x63, 0x1f, 0xbe, 0x8d, 0xe8, 0xf6, 0x66, 0xbe, 0xf9, 0xff, 0xfe, 0xf3, 0xed,
+	0x37, 0xdf, 0xce, 0xc1, 0x33, 0x2d, 0x97, 0x99, 0x77, 0x4c, 0xa7, 0xc2, 0x85, 0x59, 0x6f, 0x1a,
+	0xa6, 0x67, 0x1b, 0x6d, 0x4e, 0xd9, 0x32, 0xa7, 0x6c, 0xdd, 0xae, 0x53, 0xae, 0x7b, 0xcc, 0x15,
+	0x2e, 0x2a, 0x0a, 0xe1, 0xe8, 0x61, 0xa8, 0xbe, 0x3e, 0x5b, 0xaa, 0x58, 0xb6, 0x58, 0x6d, 0xd7,
+	0xf4, 0xba, 0xbb, 0x66, 0x58, 0xae, 0xe5, 0x1a, 0x32, 0xac, 0xd6, 0x5e, 0x91, 0xbf, 0xe4, 0x0f,
+	0xf9, 0xa4, 0x96, 0x97, 0xa6, 0x2c, 0xd7, 0xb5, 0x5a, 0x54, 0xca, 0x9b, 0x8e, 0xe3, 0x0a, 0x53,
+	0xd8, 0xae, 0x13, 0x8a, 0x97, 0x8e, 0x85, 0xb3, 0x7d, 0x0d, 0xba, 0xe6, 0x89, 0x4e, 0x38, 0xf9,
+	0x56, 0xd2, 0xa0, 0xdd, 0xa0, 0x8e, 0xb0, 0x57, 0x6c, 0xca, 0x22, 0x05, 0x9c, 0x0c, 0x62, 0xb6,
+	0xb5, 0x2a, 0xa2, 0xf9, 0xa9, 0xf4, 0x5d, 0xaa, 0xd9, 0xea, 0x9f, 0xbb, 0xe1, 0xc4, 0x6d, 0x4e,
+	0xd9, 0x02, 0xb5, 0x6c, 0x2e, 0x58, 0x07, 0x2d, 0xc1, 0xc2, 0x35, 0x46, 0x4d, 0x41, 0xd1, 0x29,
+	0x7d, 0x74, 0xe3, 0xba, 0x1a, 0x57, 0xd1, 0x9f, 0xb7, 0x29, 0x17, 0xa5, 0x83, 0xf1, 0x90, 0x60,
+	0x92, 0xec, 0xbf, 0xff, 0xf7, 0x3f, 0x3f, 0xec, 0xd8, 0x4b, 0x0a, 0x12, 0xc4, 0xaf, 0x80, 0x32,
+	0xfa, 0x14, 0xbe, 0x31, 0x47, 0x05, 0xc2, 0xf1, 0xf8, 0x39, 0x2a, 0xc6, 0xeb, 0x9d, 0x92, 0x7a,
+	0xc7, 0xd0, 0x51, 0xa5, 0x67, 0x6c, 0xc8, 0xb7, 0x64, 0x37, 0xb8, 0x1e, 0x3e, 0xdc, 0x45, 0xb7,
+	0xe0, 0xce, 0x0f, 0x6c, 0x2e, 0xd0, 0xc9, 0xb8, 0x40, 0x30, 0x1a, 0x88, 0xf0, 0x08, 0x71, 0x28,
+	0x0d, 0xc1, 0x49, 0x51, 0x32, 0xf6, 0xa0, 0xd0, 0x33, 0xb2, 0x60, 0xe1, 0xb6, 0xd7, 0x48, 0x4d,
+	0x83, 0x1a, 0x1f, 0x6f, 0xfb, 0xb4, 0x94, 0xc4, 0xa5, 0x11, 0xdb, 0xfa, 0xb0, 0xed, 0x20, 0x33,
+	0x3f, 0x01, 0x38, 0xa9, 0x12, 0xbb, 0x44, 0xd7, 0x3c, 0x97, 0x99, 0xac, 0x33, 0x6f, 0x72, 0x7e,
+	0xc7, 0x65, 0x0d, 0xa4, 0xa7, 0xbf, 0x81, 0x44, 0x60, 0xe4, 0xe3, 0xb0, 0xae, 0xaa, 0x49, 0x8f,
+	0xaa, 0x49, 0xbf, 0x11, 0x54, 0x13, 0xb9, 0x24, 0x9d, 0xe8, 0x64, 0x26, 0x33, 0x81, 0x86, 0x88,
+	0x34, 0x97, 0xbd, 0x88, 0x7e, 0x1f, 0xc0, 0xa2, 0xda, 0x6b, 0xdf, 0xd0, 0xb9, 0xec, 0x5c, 0xe4,
+	0xf5, 0x52, 0x91, 0x5e, 0xa6, 0x4b, 0x24, 0xdb, 0x4b, 0xe4, 0x20, 0x48, 0xcf, 0x27, 0xb0, 0x70,
+	0x9d, 0xb6, 0xa8, 0xa0, 0xe8, 0x44, 0x5a, 0x92, 0x6f, 0x0e, 0x8e, 0x43, 0x26, 0xf1, 0x88, 0x24,
+	0xa2, 0xf2, 0xbe, 0x18, 0xf1, 0x2e, 0x6a, 0xc0, 0xdd, 0x0b, 0x94, 0x0b, 0x97, 0xbd, 0x86, 0xfa,
+	0x49, 0xa9, 0x5e, 0x22, 0x47, 0xe2, 0xea, 0x06, 0x0b, 0xa5, 0x3f, 0x83, 0xbb, 0xe6, 0xdb, 0xcc,
+	0x7a, 0x0d, 0x06, 0x96, 0x8c, 0x23, 0xe5, 0xc3, 0x09, 0x86, 0x17, 0x08, 0x57, 0xff, 0xdd, 0x09,
+	0x61, 0xa0, 0x75, 0xb5, 0x5e, 0xa7, 0x9c, 0xa3, 0x15, 0x08, 0x83, 0xb2, 0x5f, 0x90, 0x5d, 0x20,
+	0x0f, 0x35, 0x16, 0xa0, 0x16, 0x92, 0x13, 0x92, 0x7a, 0x14, 0x4d, 0x26, 0x77, 0xa6, 0x94, 0xef,
+	0xc1, 0x09, 0x55, 0x90, 0x57, 0xe7, 0x6f, 0xbe, 0x4f, 0x3b, 0x68, 0x3a, 0xbb, 0x61, 0xa8, 0x88,
+	0x41, 0x6d, 0xc4, 0x02, 0xd5, 0x74, 0x54, 0x1b, 0x64, 0x9b, 0xda, 0x30, 0x3d, 0xbb, 0xd2, 0xa4,
+	0x1d, 0xd9, 0x54, 0xbe, 0x80, 0x7b, 0x83, 0x7d, 0xaa, 0xc5, 0x1c, 0x9d, 0xcd, 0x3a, 0xfb, 0x61,
+	0x40, 0x44, 0x9f, 0x4c, 0xa7, 0x73, 0x52, 0x96, 0xf8, 0xd3, 0x28, 0x07, 0x1e, 0xdd, 0x83, 0x6f,
+	0xce, 0xd1, 0x10, 0x8d, 0x4e, 0x67, 0xb4, 0xb5, 0x7c, 0xbb, 0x9e, 0x95, 0xd8, 0x0a, 0x3a, 0x3f,
+	0x1e, 0x6b, 0x6c, 0x34, 0x69, 0x47, 0x96, 0xee, 0xb7, 0x00, 0x4e, 0xa8, 0xc3, 0x97, 0x95, 0xfc,
+	0xc1, 0xd1, 0xcc, 0x67, 0xe3, 0x8a, 0xb4, 0x71, 0xa9, 0x64, 0xe4, 0xb1, 0x61, 0x7a, 0xf6, 0x72,
+	0x93, 0x76, 0x74, 0xd5, 0xc4, 0xaa, 0x5b, 0x3b, 0xe0, 0x61, 0x59, 0x56, 0xce, 0xba, 0xad, 0xbe,
+	0x6f, 0xfd, 0xef, 0x49, 0x0d, 0xee, 0x5c, 0xa4, 0x4e, 0x03, 0x9d, 0x89, 0x63, 0x83, 0xd1, 0xe1,
+	0x78, 0xe5, 0xae, 0x14, 0x0f, 0x1b, 0x84, 0x90, 0x49, 0xe9, 0x70, 0x3f, 0x99, 0x30, 0xec, 0xfe,
+	0xa0, 0x2c, 0x04, 0x33, 0xec, 0xfe, 0xa9, 0x15, 0x30, 0x10, 0xe8, 0x57, 0xc0, 0xb1, 0x6c, 0x08,
+	0x27, 0x07, 0x25, 0xa5, 0x88, 0x46, 0x28, 0x68, 0xb9, 0xdf, 0x87, 0x12, 0x89, 0x56, 0xe3, 0xc9,
+	0xad, 0x64, 0x9d, 0xe6, 0x10, 0x50, 0x1e, 0x01, 0x54, 0x7f, 0xdc, 0x01, 0x0f, 0x04, 0x29, 0x5c,
+	0xa4, 0x9c, 0x0f, 0xe7, 0xaf, 0x13, 0xee, 0x6d, 0x3a, 0xab, 0xba, 0xc3, 0x05, 0xfd, 0xcd, 0x4d,
+	0xa5, 0x9d, 0xf7, 0x28, 0x28, 0x4f, 0x8d, 0xf3, 0x30, 0x16, 0x7d, 0x05, 0xfa, 0x9b, 0x3e, 0xbb,
+	0x8d, 0x68, 0x9e, 0x0e, 0xf6, 0x8e, 0xc4, 0x5e, 0x2c, 0x1b, 0xe3, 0xb1, 0xc6, 0x46, 0xf8, 0x14,
+	0x8c, 0x56, 0x7f, 0xd9, 0x05, 0xf7, 0x05, 0xac, 0xa5, 0x5b, 0x4b, 0xf3, 0xfd, 0x9c, 0x50, 0x75,
+	0x9b, 0x18, 0xdb, 0xd9, 0x70, 0x5a, 0x40, 0xa0, 0xb4, 0x28, 0x4c, 0xd1, 0xe6, 0xe4, 0xb8, 0x74,
+	0x35, 0x89, 0x0e, 0x25, 0x3a, 0x9c, 0x70, 0x85, 0x87, 0x38, 0x2c, 0xdc, 0x70, 0x98, 0xdb, 0x6a,
+	0x8d, 0x27, 0x91, 0x2c, 0x92, 0x12, 0x58, 0xa3, 0x8e, 0x88, 0xee, 0x03, 0x64, 0x2a, 0x95, 0x66,
+	0x50, 0x85, 0xfa, 0x1a, 0x04, 0x54, 0xb3, 0xd6, 0xa2, 0xc9, 0x23, 0xa3, 0xc6, 0x07, 0xe9, 0x50,
+	0x2f, 0xfc, 0x54, 0x1a, 0x7b, 0x81, 0xd6, 0xdd, 0x75, 0xca, 0x3a, 0xd7, 0xdc, 0x06, 0xe5, 0xe4,
+	0x82, 0x44, 0x97, 0xc9, 0x99, 0x6d, 0x2e, 0x00, 0xca, 0x43, 0x00, 0x08, 0x8e, 0xd4, 0x97, 0x70,
+	0xf7, 0x75, 0x9b, 0x4b, 0x1b, 0x89, 0x77, 0x1f, 0x4e, 0xc4, 0x7d, 0x64, 0xbd, 0xfb, 0x8b, 0x12,
+	0x7e, 0x9e, 0x9c, 0x1d, 0x03, 0x6f, 0xd8, 0x3c, 0xa2, 0xff, 0x0e, 0xe0, 0xa1, 0x39, 0xea, 0x50,
+	0x66, 0x0a, 0x3a, 0xb2, 0x13, 0x74, 0x21, 0xd9, 0x6a, 0x55, 0x58, 0x62, 0xd3, 0xff, 0x23, 0x3d,
+	0x97, 0xa5, 0xc3, 0x2a, 0xa9, 0x8c, 0x71, 0xc8, 0xc2, 0x55, 0x95, 0x7a, 0xb0, 0x2c, 0x68, 0x7c,
+	0x7f, 0xed, 0x82, 0x07, 0x3e, 0x72, 0x83, 0x2a, 0xa8, 0xcb, 0x73, 0xbc, 0xa8, 0xfe, 0x3a, 0xa0,
+	0x95, 0xfe, 0x2d, 0xfa, 0x5c, 0xfa, 0x47, 0x71, 0x78, 0xd1, 0xb8, 0x04, 0x1e, 0x95, 0xf6, 0x0e,
+	0x90, 0xa2, 0xe1, 0x0c, 0xad, 0x92, 0x9d, 0x6f, 0x13, 0x84, 0xed, 0xe1, 0xed, 0xb4, 0xf6, 0x30,
+	0x0c, 0xe9, 0xe7, 0xe3, 0x78, 0x3c, 0x72, 0x24, 0x8a, 0x18, 0x12, 0x76, 0x0e, 0x4d, 0x67, 0xe7,
+	0x62, 0xc4, 0x05, 0xfa, 0x06, 0xc0, 0x3d, 0x1f, 0x9a, 0xac, 0xb9, 0x40, 0xcd, 0x06, 0x9a, 0x89,
+	0x8b, 0x07, 0x33, 0x31, 0x1b, 0xe6, 0xd8, 0x3b, 0x62, 0xd8, 0x2d, 0xc8, 0x4c, 0x4e, 0x0f, 0x06,
+	0xa3, 0xa6, 0xbc, 0x2d, 0x7e, 0x07, 0x60, 0x71, 0x8e, 0x8a, 0x79, 0x46, 0x57, 0x28, 0xa3, 0x4e,
+	0x9d, 0xe6, 0xb8, 0xfe, 0x4c, 0x6f, 0x97, 0x8f, 0x21, 0x25, 0x52, 0x95, 0xae, 0x66, 0x50, 0x39,
+	0x71, 0x7e, 0x47, 0xcd, 0x78, 0x43, 0xf4, 0x3f, 0x00, 0x2c, 0x2e, 0x8e, 0x1a, 0xba, 0x98, 0xfc,
+	0x10, 0x8a, 0x0c, 0x64, 0x94, 0xa7, 0xdc, 0x16, 0xdf, 0x95, 0x16, 0x2f, 0x97, 0x66, 0xf3, 0x26,
+	0x6e, 0xc8, 0xeb, 0x15, 0x50, 0x7e, 0xef, 0x37, 0xf0, 0xa4, 0x8b, 0xc1, 0xd3, 0x2e, 0x06, 0xcf,
+	0xba, 0x58, 0x7b, 0xde, 0xc5, 0xda, 0x8b, 0x2e, 0xd6, 0x5e, 0x76, 0xb1, 0xf6, 0xaa, 0x8b, 0xc1,
+	0xa6, 0x8f, 0xc1, 0x03, 0x1f, 0x6b, 0x0f, 0x7d, 0x0c, 0x1e, 0xf9, 0x58, 0x7b, 0xec, 0x63, 0x6d,
+	0xcb, 0xc7, 0xda, 0x13, 0x1f, 0x83, 0xa7, 0x3e, 0x06, 0xcf, 0x7c, 0xac, 0x3d, 0xf7, 0x31, 0x78,
+	0xe1, 0x63, 0xed, 0xa5, 0x8f, 0xc1, 0x2b, 0x1f, 0x6b, 0x9b, 0x3d, 0xac, 0x3d, 0xe8, 0x61, 0xf0,
+	0x7d, 0x0f, 0x6b, 0x3f, 0xf7, 0x30, 0xf8, 0xb5, 0x87, 0xb5, 0x87, 0x3d, 0xac, 0x3d, 0xea, 0x61,
+	0xf0, 0xb8, 0x87, 0xc1, 0x56, 0x0f, 0x83, 0x8f, 0x67, 0x2c, 0x57, 0x17, 0xab, 0x54, 0xac, 0xda,
+	0x8e, 0xc5, 0x75, 0x87, 0x8a, 0x3b, 0x2e, 0x6b, 0x1a, 0xa3, 0xff, 0x5b, 0xbd, 0xa6, 0x65, 0x08,
+	0xe1, 0x78, 0xb5, 0x5a, 0x41, 0x56, 0xcb, 0xec, 0x7f, 0x03, 0x00, 0xd9, 0x3d, 0xac, 0xeb, 0xbf,
+	0x0f, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -122,6 +147,11 @@ type UserRegistryClient interface {
 	CreateTemporaryPassword(ctx context.Context, in *CreateTemporaryPasswordRequest, opts ...grpc.CallOption) (*types.Empty, error)
 	UpdatePassword(ctx context.Context, in *UpdateUserPasswordRequest, opts ...grpc.CallOption) (*types.Empty, error)
 	Delete(ctx context.Context, in *UserIdentifiers, opts ...grpc.CallOption) (*types.Empty, error)
+	// Restore a recently deleted user.
+	Restore(ctx context.Context, in *UserIdentifiers, opts ...grpc.CallOption) (*types.Empty, error)
+	// Purge the user. This permanently deletes the user and its API keys, memberships,
+	// attributes and contact info, and releases the ID. This is only allowed for admins.
+	Purge(ctx context.Context, in *UserIdentifiers, opts ...grpc.CallOption) (*types.Empty, error)
 }
 
 type userRegistryClient struct {
@@ -195,6 +225,24 @@ func (c *userRegistryClient) Delete(ctx context.Context, in *UserIdentifiers, op
 	return out, nil
 }
 
+func (c *userRegistryClient) Restore(ctx context.Context, in *UserIdentifiers, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.UserRegistry/Restore", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userRegistryClient) Purge(ctx context.Context, in *UserIdentifiers, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.UserRegistry/Purge", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // UserRegistryServer is the server API for UserRegistry service.
 type UserRegistryServer interface {
 	// Register a new user. This method may be restricted by network settings.
@@ -210,6 +258,11 @@ type UserRegistryServer interface {
 	CreateTemporaryPassword(context.Context, *CreateTemporaryPasswordRequest) (*types.Empty, error)
 	UpdatePassword(context.Context, *UpdateUserPasswordRequest) (*types.Empty, error)
 	Delete(context.Context, *UserIdentifiers) (*types.Empty, error)
+	// Restore a recently deleted user.
+	Restore(context.Context, *UserIdentifiers) (*types.Empty, error)
+	// Purge the user. This permanently deletes the user and its API keys, memberships,
+	// attributes and contact info, and releases the ID. This is only allowed for admins.
+	Purge(context.Context, *UserIdentifiers) (*types.Empty, error)
 }
 
 // UnimplementedUserRegistryServer can be embedded to have forward compatible implementations.
@@ -237,6 +290,12 @@ func (*UnimplementedUserRegistryServer) UpdatePassword(ctx context.Context, req
 func (*UnimplementedUserRegistryServer) Delete(ctx context.Context, req *UserIdentifiers) (*types.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
 }
+func (*UnimplementedUserRegistryServer) Restore(ctx context.Context, req *UserIdentifiers) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Restore not implemented")
+}
+func (*UnimplementedUserRegistryServer) Purge(ctx context.Context, req *UserIdentifiers) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Purge not implemented")
+}
 
 func RegisterUserRegistryServer(s *grpc.Server, srv UserRegistryServer) {
 	s.RegisterService(&_UserRegistry_serviceDesc, srv)
@@ -368,6 +427,42 @@ func _UserRegistry_Delete_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserRegistry_Restore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserIdentifiers)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserRegistryServer).Restore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.UserRegistry/Restore",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserRegistryServer).Restore(ctx, req.(*UserIdentifiers))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserRegistry_Purge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserIdentifiers)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserRegistryServer).Purge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.UserRegistry/Purge",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserRegistryServer).Purge(ctx, req.(*UserIdentifiers))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _UserRegistry_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ttn.lorawan.v3.UserRegistry",
 	HandlerType: (*UserRegistryServer)(nil),
@@ -400,6 +495,14 @@ var _UserRegistry_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Delete",
 			Handler:    _UserRegistry_Delete_Handler,
 		},
+		{
+			MethodName: "Restore",
+			Handler:    _UserRegistry_Restore_Handler,
+		},
+		{
+			MethodName: "Purge",
+			Handler:    _UserRegistry_Purge_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "lorawan-stack/api/user_services.proto",
@@ -878,3 +981,463 @@ var _UserSessionRegistry_serviceDesc = grpc.ServiceDesc{
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "lorawan-stack/api/user_services.proto",
 }
+
+// UserTOTPRegistryClient is the client API for UserTOTPRegistry service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type UserTOTPRegistryClient interface {
+	// Get the TOTP two-factor authentication status of the user.
+	Get(ctx context.Context, in *UserIdentifiers, opts ...grpc.CallOption) (*UserTOTPStatus, error)
+	// Enroll a new TOTP secret for the user. The secret is only used after
+	// it is enabled with a valid code.
+	Enroll(ctx context.Context, in *UserIdentifiers, opts ...grpc.CallOption) (*UserTOTPEnrollment, error)
+	// Enable TOTP two-factor authentication with the enrolled secret.
+	// This returns the initial recovery codes of the user.
+	Enable(ctx context.Context, in *EnableUserTOTPRequest, opts ...grpc.CallOption) (*UserRecoveryCodes, error)
+	// Disable TOTP two-factor authentication and remove the recovery codes of the user.
+	Disable(ctx context.Context, in *DisableUserTOTPRequest, opts ...grpc.CallOption) (*types.Empty, error)
+	// Generate new recovery codes for the user. This invalidates the existing recovery codes.
+	GenerateRecoveryCodes(ctx context.Context, in *GenerateUserRecoveryCodesRequest, opts ...grpc.CallOption) (*UserRecoveryCodes, error)
+}
+
+type userTOTPRegistryClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewUserTOTPRegistryClient(cc *grpc.ClientConn) UserTOTPRegistryClient {
+	return &userTOTPRegistryClient{cc}
+}
+
+func (c *userTOTPRegistryClient) Get(ctx context.Context, in *UserIdentifiers, opts ...grpc.CallOption) (*UserTOTPStatus, error) {
+	out := new(UserTOTPStatus)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.UserTOTPRegistry/Get", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userTOTPRegistryClient) Enroll(ctx context.Context, in *UserIdentifiers, opts ...grpc.CallOption) (*UserTOTPEnrollment, error) {
+	out := new(UserTOTPEnrollment)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.UserTOTPRegistry/Enroll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userTOTPRegistryClient) Enable(ctx context.Context, in *EnableUserTOTPRequest, opts ...grpc.CallOption) (*UserRecoveryCodes, error) {
+	out := new(UserRecoveryCodes)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.UserTOTPRegistry/Enable", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userTOTPRegistryClient) Disable(ctx context.Context, in *DisableUserTOTPRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.UserTOTPRegistry/Disable", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userTOTPRegistryClient) GenerateRecoveryCodes(ctx context.Context, in *GenerateUserRecoveryCodesRequest, opts ...grpc.CallOption) (*UserRecoveryCodes, error) {
+	out := new(UserRecoveryCodes)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.UserTOTPRegistry/GenerateRecoveryCodes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// UserTOTPRegistryServer is the server API for UserTOTPRegistry service.
+type UserTOTPRegistryServer interface {
+	// Get the TOTP two-factor authentication status of the user.
+	Get(context.Context, *UserIdentifiers) (*UserTOTPStatus, error)
+	// Enroll a new TOTP secret for the user. The secret is only used after
+	// it is enabled with a valid code.
+	Enroll(context.Context, *UserIdentifiers) (*UserTOTPEnrollment, error)
+	// Enable TOTP two-factor authentication with the enrolled secret.
+	// This returns the initial recovery codes of the user.
+	Enable(context.Context, *EnableUserTOTPRequest) (*UserRecoveryCodes, error)
+	// Disable TOTP two-factor authentication and remove the recovery codes of the user.
+	Disable(context.Context, *DisableUserTOTPRequest) (*types.Empty, error)
+	// Generate new recovery codes for the user. This invalidates the existing recovery codes.
+	GenerateRecoveryCodes(context.Context, *GenerateUserRecoveryCodesRequest) (*UserRecoveryCodes, error)
+}
+
+// UnimplementedUserTOTPRegistryServer can be embedded to have forward compatible implementations.
+type UnimplementedUserTOTPRegistryServer struct {
+}
+
+func (*UnimplementedUserTOTPRegistryServer) Get(ctx context.Context, req *UserIdentifiers) (*UserTOTPStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+}
+func (*UnimplementedUserTOTPRegistryServer) Enroll(ctx context.Context, req *UserIdentifiers) (*UserTOTPEnrollment, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Enroll not implemented")
+}
+func (*UnimplementedUserTOTPRegistryServer) Enable(ctx context.Context, req *EnableUserTOTPRequest) (*UserRecoveryCodes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Enable not implemented")
+}
+func (*UnimplementedUserTOTPRegistryServer) Disable(ctx context.Context, req *DisableUserTOTPRequest) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Disable not implemented")
+}
+func (*UnimplementedUserTOTPRegistryServer) GenerateRecoveryCodes(ctx context.Context, req *GenerateUserRecoveryCodesRequest) (*UserRecoveryCodes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GenerateRecoveryCodes not implemented")
+}
+
+func RegisterUserTOTPRegistryServer(s *grpc.Server, srv UserTOTPRegistryServer) {
+	s.RegisterService(&_UserTOTPRegistry_serviceDesc, srv)
+}
+
+func _UserTOTPRegistry_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserIdentifiers)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserTOTPRegistryServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.UserTOTPRegistry/Get",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserTOTPRegistryServer).Get(ctx, req.(*UserIdentifiers))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserTOTPRegistry_Enroll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserIdentifiers)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserTOTPRegistryServer).Enroll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.UserTOTPRegistry/Enroll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserTOTPRegistryServer).Enroll(ctx, req.(*UserIdentifiers))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserTOTPRegistry_Enable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnableUserTOTPRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserTOTPRegistryServer).Enable(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.UserTOTPRegistry/Enable",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserTOTPRegistryServer).Enable(ctx, req.(*EnableUserTOTPRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserTOTPRegistry_Disable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DisableUserTOTPRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserTOTPRegistryServer).Disable(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.UserTOTPRegistry/Disable",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserTOTPRegistryServer).Disable(ctx, req.(*DisableUserTOTPRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserTOTPRegistry_GenerateRecoveryCodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenerateUserRecoveryCodesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserTOTPRegistryServer).GenerateRecoveryCodes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.UserTOTPRegistry/GenerateRecoveryCodes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserTOTPRegistryServer).GenerateRecoveryCodes(ctx, req.(*GenerateUserRecoveryCodesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _UserTOTPRegistry_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ttn.lorawan.v3.UserTOTPRegistry",
+	HandlerType: (*UserTOTPRegistryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Get",
+			Handler:    _UserTOTPRegistry_Get_Handler,
+		},
+		{
+			MethodName: "Enroll",
+			Handler:    _UserTOTPRegistry_Enroll_Handler,
+		},
+		{
+			MethodName: "Enable",
+			Handler:    _UserTOTPRegistry_Enable_Handler,
+		},
+		{
+			MethodName: "Disable",
+			Handler:    _UserTOTPRegistry_Disable_Handler,
+		},
+		{
+			MethodName: "GenerateRecoveryCodes",
+			Handler:    _UserTOTPRegistry_GenerateRecoveryCodes_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "lorawan-stack/api/user_services.proto",
+}
+
+// NotificationServiceClient is the client API for NotificationService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type NotificationServiceClient interface {
+	// Create a notification for the users that are interested in the entity of the notification.
+	// This is used by other components of the cluster, and requires universal rights.
+	Create(ctx context.Context, in *CreateNotificationRequest, opts ...grpc.CallOption) (*types.Empty, error)
+	// List the notifications of the user, newest first.
+	List(ctx context.Context, in *ListNotificationsRequest, opts ...grpc.CallOption) (*Notifications, error)
+	// Mark notifications of the user as read.
+	MarkRead(ctx context.Context, in *MarkNotificationsReadRequest, opts ...grpc.CallOption) (*types.Empty, error)
+	// Get the notification preferences of the user. This includes the defaults
+	// of notification types that the user did not set preferences for.
+	GetPreferences(ctx context.Context, in *UserIdentifiers, opts ...grpc.CallOption) (*NotificationPreferences, error)
+	// Set notification preferences of the user.
+	SetPreferences(ctx context.Context, in *SetNotificationPreferencesRequest, opts ...grpc.CallOption) (*NotificationPreferences, error)
+}
+
+type notificationServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewNotificationServiceClient(cc *grpc.ClientConn) NotificationServiceClient {
+	return &notificationServiceClient{cc}
+}
+
+func (c *notificationServiceClient) Create(ctx context.Context, in *CreateNotificationRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.NotificationService/Create", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationServiceClient) List(ctx context.Context, in *ListNotificationsRequest, opts ...grpc.CallOption) (*Notifications, error) {
+	out := new(Notifications)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.NotificationService/List", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationServiceClient) MarkRead(ctx context.Context, in *MarkNotificationsReadRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.NotificationService/MarkRead", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationServiceClient) GetPreferences(ctx context.Context, in *UserIdentifiers, opts ...grpc.CallOption) (*NotificationPreferences, error) {
+	out := new(NotificationPreferences)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.NotificationService/GetPreferences", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationServiceClient) SetPreferences(ctx context.Context, in *SetNotificationPreferencesRequest, opts ...grpc.CallOption) (*NotificationPreferences, error) {
+	out := new(NotificationPreferences)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.NotificationService/SetPreferences", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// NotificationServiceServer is the server API for NotificationService service.
+type NotificationServiceServer interface {
+	// Create a notification for the users that are interested in the entity of the notification.
+	// This is used by other components of the cluster, and requires universal rights.
+	Create(context.Context, *CreateNotificationRequest) (*types.Empty, error)
+	// List the notifications of the user, newest first.
+	List(context.Context, *ListNotificationsRequest) (*Notifications, error)
+	// Mark notifications of the user as read.
+	MarkRead(context.Context, *MarkNotificationsReadRequest) (*types.Empty, error)
+	// Get the notification preferences of the user. This includes the defaults
+	// of notification types that the user did not set preferences for.
+	GetPreferences(context.Context, *UserIdentifiers) (*NotificationPreferences, error)
+	// Set notification preferences of the user.
+	SetPreferences(context.Context, *SetNotificationPreferencesRequest) (*NotificationPreferences, error)
+}
+
+// UnimplementedNotificationServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedNotificationServiceServer struct {
+}
+
+func (*UnimplementedNotificationServiceServer) Create(ctx context.Context, req *CreateNotificationRequest) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
+}
+func (*UnimplementedNotificationServiceServer) List(ctx context.Context, req *ListNotificationsRequest) (*Notifications, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
+}
+func (*UnimplementedNotificationServiceServer) MarkRead(ctx context.Context, req *MarkNotificationsReadRequest) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkRead not implemented")
+}
+func (*UnimplementedNotificationServiceServer) GetPreferences(ctx context.Context, req *UserIdentifiers) (*NotificationPreferences, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPreferences not implemented")
+}
+func (*UnimplementedNotificationServiceServer) SetPreferences(ctx context.Context, req *SetNotificationPreferencesRequest) (*NotificationPreferences, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPreferences not implemented")
+}
+
+func RegisterNotificationServiceServer(s *grpc.Server, srv NotificationServiceServer) {
+	s.RegisterService(&_NotificationService_serviceDesc, srv)
+}
+
+func _NotificationService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateNotificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.NotificationService/Create",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).Create(ctx, req.(*CreateNotificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationService_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListNotificationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.NotificationService/List",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).List(ctx, req.(*ListNotificationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationService_MarkRead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarkNotificationsReadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).MarkRead(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.NotificationService/MarkRead",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).MarkRead(ctx, req.(*MarkNotificationsReadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationService_GetPreferences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserIdentifiers)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).GetPreferences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.NotificationService/GetPreferences",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).GetPreferences(ctx, req.(*UserIdentifiers))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationService_SetPreferences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetNotificationPreferencesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).SetPreferences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.NotificationService/SetPreferences",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).SetPreferences(ctx, req.(*SetNotificationPreferencesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _NotificationService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ttn.lorawan.v3.NotificationService",
+	HandlerType: (*NotificationServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Create",
+			Handler:    _NotificationService_Create_Handler,
+		},
+		{
+			MethodName: "List",
+			Handler:    _NotificationService_List_Handler,
+		},
+		{
+			MethodName: "MarkRead",
+			Handler:    _NotificationService_MarkRead_Handler,
+		},
+		{
+			MethodName: "GetPreferences",
+			Handler:    _NotificationService_GetPreferences_Handler,
+		},
+		{
+			MethodName: "SetPreferences",
+			Handler:    _NotificationService_SetPreferences_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "lorawan-stack/api/user_services.proto",
+}
@@ -25,6 +25,7 @@ import dev from '../../../lib/dev'
 import Landing from '../landing'
 import Login from '../login'
 import Authorize from '../authorize'
+import Device from '../device'
 import CreateAccount from '../create-account'
 import ForgotPassword from '../forgot-password'
 import UpdatePassword from '../update-password'
@@ -62,6 +63,7 @@ class OAuthApp extends React.PureComponent {
               <Route path="/" exact component={Landing} />
               <Route path="/login" component={Login} />
               <Route path="/authorize" component={Authorize} />
+              <Route path="/device" component={Device} />
               <Route path="/register" component={CreateAccount} />
               <Route path="/forgot-password" component={ForgotPassword} />
               <Route path="/code" component={Code} />
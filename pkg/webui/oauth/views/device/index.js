@@ -0,0 +1,204 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+import React, { PureComponent, Fragment } from 'react'
+import { Container, Col, Row } from 'react-grid-system'
+import { defineMessages } from 'react-intl'
+import { connect } from 'react-redux'
+import { replace } from 'connected-react-router'
+import bind from 'autobind-decorator'
+import * as Yup from 'yup'
+
+import api from '../../api'
+import sharedMessages from '../../../lib/shared-messages'
+import { selectApplicationRootPath } from '../../../lib/selectors/env'
+
+import ErrorMessage from '../../../lib/components/error-message'
+import Form from '../../../components/form'
+import Input from '../../../components/input'
+import SubmitButton from '../../../components/submit-button'
+import Modal from '../../../components/modal'
+import Icon from '../../../components/icon'
+import Message from '../../../lib/components/message'
+import IntlHelmet from '../../../lib/components/intl-helmet'
+import { withEnv } from '../../../lib/components/env'
+import getCookieValue from '../../../lib/cookie'
+
+import style from './device.styl'
+
+const m = defineMessages({
+  connectDevice: 'Connect a Device',
+  enterUserCode: 'Please enter the code that is displayed on your device',
+  userCode: 'Code',
+  continue: 'Continue',
+  modalTitle: 'Request for Permission',
+  modalSubtitle: '{clientName} is requesting permissions to do the following:',
+  loginInfo: 'You are logged in as {userId}.',
+  authorize: 'Authorize',
+  deviceAuthorized: 'Device Authorized',
+  deviceAuthorizedDescription: 'You can now return to your device.',
+  deviceDenied: 'Device Denied',
+  deviceDeniedDescription: 'The device did not get access to your account.',
+})
+
+const validationSchema = Yup.object().shape({
+  user_code: Yup.string().required(sharedMessages.validateRequired),
+})
+
+const initialValues = { user_code: '' }
+
+const appRoot = selectApplicationRootPath()
+
+@connect(
+  undefined,
+  dispatch => ({
+    redirectToLogin: () => dispatch(replace('/login')),
+  }),
+)
+@withEnv
+@bind
+export default class Device extends PureComponent {
+  async handleLogout() {
+    const { redirectToLogin } = this.props
+    await api.oauth.logout()
+    redirectToLogin()
+  }
+
+  handleSubmit(values) {
+    // The device authorization is looked up by the server, which renders the page data.
+    window.location = `${appRoot}/device?user_code=${encodeURIComponent(values.user_code)}`
+  }
+
+  render() {
+    const {
+      env: { pageData },
+    } = this.props
+    const { client, user, user_code, done, authorized, error } = pageData || {}
+
+    if (error) {
+      return <ErrorMessage content={error} />
+    }
+
+    if (done) {
+      return (
+        <Container className={style.fullHeight}>
+          <Row justify="center" align="center" className={style.fullHeight}>
+            <Col sm={12} md={8} lg={5}>
+              <IntlHelmet title={authorized ? m.deviceAuthorized : m.deviceDenied} />
+              <Message
+                content={authorized ? m.deviceAuthorized : m.deviceDenied}
+                component="h1"
+                className={style.title}
+              />
+              <Message
+                content={authorized ? m.deviceAuthorizedDescription : m.deviceDeniedDescription}
+                component="h4"
+                className={style.description}
+              />
+            </Col>
+          </Row>
+        </Container>
+      )
+    }
+
+    if (!client) {
+      return (
+        <Container className={style.fullHeight}>
+          <Row justify="center" align="center" className={style.fullHeight}>
+            <Col sm={12} md={8} lg={5}>
+              <IntlHelmet title={m.connectDevice} />
+              <Message content={m.connectDevice} component="h1" className={style.title} />
+              <Message content={m.enterUserCode} component="h4" className={style.description} />
+              <Form
+                onSubmit={this.handleSubmit}
+                initialValues={initialValues}
+                validationSchema={validationSchema}
+                horizontal={false}
+              >
+                <Form.Field
+                  title={m.userCode}
+                  name="user_code"
+                  component={Input}
+                  autoFocus
+                  required
+                />
+                <Form.Submit component={SubmitButton} message={m.continue} />
+              </Form>
+            </Col>
+          </Row>
+        </Container>
+      )
+    }
+
+    const clientName = capitalize(client.ids.client_id)
+
+    const bottomLine = (
+      <div>
+        <span>
+          <Message
+            className={style.loginInfo}
+            content={m.loginInfo}
+            values={{ userId: user.ids.user_id }}
+          />
+          <Message
+            content={sharedMessages.logout}
+            component="a"
+            href="#"
+            onClick={this.handleLogout}
+          />
+        </span>
+      </div>
+    )
+
+    return (
+      <Fragment>
+        <IntlHelmet title={m.authorize} />
+        <Modal
+          title={m.modalTitle}
+          subtitle={{ ...m.modalSubtitle, values: { clientName } }}
+          bottomLine={bottomLine}
+          buttonMessage={m.authorize}
+          method="POST"
+          formName="authorize"
+          approval
+          logo
+        >
+          <Fragment>
+            <input type="hidden" name="csrf" value={getCookieValue('_csrf')} />
+            <input type="hidden" name="user_code" value={user_code} />
+            <div className={style.left}>
+              <ul>
+                {client.rights.map(right => (
+                  <li key={right}>
+                    <Icon icon="check" className={style.icon} />
+                    <Message content={{ id: `enum:${right}` }} />
+                  </li>
+                ))}
+              </ul>
+            </div>
+            <div className={style.right}>
+              <h3>{clientName}</h3>
+              <p>{client.description}</p>
+            </div>
+          </Fragment>
+        </Modal>
+      </Fragment>
+    )
+  }
+}
+
+// Capitalize the client_id until we have a display name field.
+function capitalize(string) {
+  return string.charAt(0).toUpperCase() + string.slice(1)
+}
@@ -23,7 +23,11 @@ import * as Yup from 'yup'
 
 import api from '../../api'
 import sharedMessages from '../../../lib/shared-messages'
-import { selectApplicationRootPath, selectApplicationSiteName } from '../../../lib/selectors/env'
+import {
+  selectApplicationConfig,
+  selectApplicationRootPath,
+  selectApplicationSiteName,
+} from '../../../lib/selectors/env'
 import PropTypes from '../../../lib/prop-types'
 
 import Button from '../../../components/button'
@@ -34,6 +38,7 @@ import Logo from '../../../containers/logo'
 import IntlHelmet from '../../../lib/components/intl-helmet'
 import Message from '../../../lib/components/message'
 import { id as userRegexp } from '../../../lib/regexp'
+import { getBackendErrorName } from '../../../lib/errors/utils'
 
 import style from './login.styl'
 
@@ -41,6 +46,10 @@ const m = defineMessages({
   createAccount: 'Create an account',
   forgotPassword: 'Forgot password?',
   loginToContinue: 'Please login to continue',
+  loginWithProvider: 'Login with {provider}',
+  twoFactorCode: 'Two-factor authentication code',
+  twoFactorCodeDescription:
+    'Enter the code from your authenticator app, or one of your recovery codes',
 })
 
 const validationSchema = Yup.object().shape({
@@ -50,12 +59,14 @@ const validationSchema = Yup.object().shape({
     .matches(userRegexp, sharedMessages.validateIdFormat)
     .required(sharedMessages.validateRequired),
   password: Yup.string().required(sharedMessages.validateRequired),
+  two_factor_code: Yup.string(),
 })
 
 @withRouter
 @connect(
   () => ({
     siteName: selectApplicationSiteName(),
+    providers: selectApplicationConfig().providers || [],
   }),
   {
     replace,
@@ -65,6 +76,12 @@ const validationSchema = Yup.object().shape({
 export default class OAuth extends React.PureComponent {
   static propTypes = {
     location: PropTypes.location.isRequired,
+    providers: PropTypes.arrayOf(
+      PropTypes.shape({
+        id: PropTypes.string.isRequired,
+        name: PropTypes.string.isRequired,
+      }),
+    ).isRequired,
     replace: PropTypes.func.isRequired,
     siteName: PropTypes.string.isRequired,
   }
@@ -73,6 +90,7 @@ export default class OAuth extends React.PureComponent {
     super(props)
     this.state = {
       error: '',
+      twoFactorRequired: false,
     }
   }
 
@@ -82,9 +100,16 @@ export default class OAuth extends React.PureComponent {
 
       window.location = url(this.props.location)
     } catch (error) {
-      this.setState({
-        error: error.response.data,
-      })
+      if (getBackendErrorName(error.response.data) === 'two_factor_required') {
+        this.setState({
+          error: '',
+          twoFactorRequired: true,
+        })
+      } else {
+        this.setState({
+          error: error.response.data,
+        })
+      }
     } finally {
       setSubmitting(false)
     }
@@ -108,10 +133,12 @@ export default class OAuth extends React.PureComponent {
     const initialValues = {
       user_id: '',
       password: '',
+      two_factor_code: '',
     }
 
     const { info } = this.props.location.state || ''
-    const { siteName } = this.props
+    const { siteName, providers, location } = this.props
+    const { twoFactorRequired } = this.state
 
     return (
       <div className={style.fullHeightCenter}>
@@ -147,10 +174,33 @@ export default class OAuth extends React.PureComponent {
                 type="password"
                 required
               />
+              {twoFactorRequired && (
+                <Form.Field
+                  title={m.twoFactorCode}
+                  description={m.twoFactorCodeDescription}
+                  component={Input}
+                  name="two_factor_code"
+                  autoComplete="one-time-code"
+                  autoFocus
+                  required
+                />
+              )}
               <Form.Submit component={SubmitButton} message={sharedMessages.login} />
               <Button naked message={m.createAccount} onClick={this.navigateToRegister} />
               <Button naked message={m.forgotPassword} onClick={this.navigateToResetPassword} />
             </Form>
+            {providers.length > 0 && (
+              <div className={style.providers}>
+                {providers.map(provider => (
+                  <Button.AnchorLink
+                    key={provider.id}
+                    href={`${appRoot}/login/${provider.id}?n=${encodeURIComponent(url(location))}`}
+                    message={{ ...m.loginWithProvider, values: { provider: provider.name } }}
+                    secondary
+                  />
+                ))}
+              </div>
+            )}
           </div>
         </div>
       </div>